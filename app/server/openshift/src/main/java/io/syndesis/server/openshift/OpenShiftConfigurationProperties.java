@@ -59,6 +59,9 @@ public class OpenShiftConfigurationProperties {
 
     private String managementUrlFor3scale;
 
+    private String trustedCaBundleConfigMap;
+    private String trustedCaBundleKey = "ca-bundle.crt";
+
     public void setDebug(final boolean debug) {
         this.debug = debug;
     }
@@ -202,4 +205,24 @@ public class OpenShiftConfigurationProperties {
     public void setManagementUrlFor3scale(String managementUrlFor3scale) {
         this.managementUrlFor3scale = managementUrlFor3scale;
     }
+
+    public String getTrustedCaBundleConfigMap() {
+        return trustedCaBundleConfigMap;
+    }
+
+    public void setTrustedCaBundleConfigMap(String trustedCaBundleConfigMap) {
+        this.trustedCaBundleConfigMap = trustedCaBundleConfigMap;
+    }
+
+    public String getTrustedCaBundleKey() {
+        return trustedCaBundleKey;
+    }
+
+    public void setTrustedCaBundleKey(String trustedCaBundleKey) {
+        this.trustedCaBundleKey = trustedCaBundleKey;
+    }
+
+    public boolean hasTrustedCaBundle() {
+        return trustedCaBundleConfigMap != null && !trustedCaBundleConfigMap.isEmpty();
+    }
 }
@@ -17,6 +17,7 @@ package io.syndesis.server.openshift;
 
 import java.io.IOException;
 import java.io.InputStream;
+import java.util.ArrayList;
 import java.util.Collections;
 import java.util.EnumSet;
 import java.util.HashMap;
@@ -32,16 +33,23 @@ import java.util.stream.Collectors;
 import io.fabric8.kubernetes.api.model.Affinity;
 import io.fabric8.kubernetes.api.model.ConfigMap;
 import io.fabric8.kubernetes.api.model.Container;
+import io.fabric8.kubernetes.api.model.ContainerBuilder;
 import io.fabric8.kubernetes.api.model.ContainerPort;
 import io.fabric8.kubernetes.api.model.Doneable;
 import io.fabric8.kubernetes.api.model.EnvVar;
 import io.fabric8.kubernetes.api.model.HasMetadata;
 import io.fabric8.kubernetes.api.model.KubernetesResourceList;
+import io.fabric8.kubernetes.api.model.LocalObjectReference;
+import io.fabric8.kubernetes.api.model.PodSpec;
 import io.fabric8.kubernetes.api.model.PodTemplateSpec;
 import io.fabric8.kubernetes.api.model.ProbeBuilder;
 import io.fabric8.kubernetes.api.model.Quantity;
 import io.fabric8.kubernetes.api.model.Secret;
 import io.fabric8.kubernetes.api.model.Toleration;
+import io.fabric8.kubernetes.api.model.Volume;
+import io.fabric8.kubernetes.api.model.VolumeBuilder;
+import io.fabric8.kubernetes.api.model.VolumeMount;
+import io.fabric8.kubernetes.api.model.VolumeMountBuilder;
 import io.fabric8.kubernetes.api.model.apiextensions.CustomResourceDefinition;
 import io.fabric8.kubernetes.api.model.apiextensions.CustomResourceDefinitionBuilder;
 import io.fabric8.kubernetes.api.model.apiextensions.CustomResourceDefinitionStatusBuilder;
@@ -51,10 +59,12 @@ import io.fabric8.kubernetes.client.Watcher;
 import io.fabric8.kubernetes.client.dsl.MixedOperation;
 import io.fabric8.kubernetes.client.dsl.Resource;
 import io.fabric8.openshift.api.model.Build;
+import io.fabric8.openshift.api.model.ConfigMapBuildSourceBuilder;
 import io.fabric8.openshift.api.model.DeploymentConfig;
 import io.fabric8.openshift.api.model.DeploymentConfigBuilder;
 import io.fabric8.openshift.api.model.DeploymentConfigStatus;
 import io.fabric8.openshift.api.model.DeploymentTriggerPolicyBuilder;
+import io.fabric8.openshift.api.model.DoneableBuildConfig;
 import io.fabric8.openshift.api.model.DoneableDeploymentConfig;
 import io.fabric8.openshift.api.model.Route;
 import io.fabric8.openshift.api.model.RouteSpec;
@@ -81,6 +91,13 @@ public class OpenShiftServiceImpl implements OpenShiftService {
     // Labels used for generated objects
     private static final Map<String, String> INTEGRATION_DEFAULT_LABELS = defaultLabels();
 
+    // Script generating the Java truststore out of the trusted CA bundle, rendered by the operator
+    private static final String TRUSTSTORE_CONFIG_MAP = "syndesis-truststore";
+    private static final String TRUSTED_CA_BUNDLE_VOLUME = "trusted-ca-bundle";
+    private static final String TRUSTSTORE_VOLUME = "truststore";
+    private static final String TRUSTSTORE_PATH = "/etc/syndesis/truststore";
+    private static final String TRUSTSTORE_JAVA_OPTIONS = "-Djavax.net.ssl.trustStore=" + TRUSTSTORE_PATH + "/cacerts -Djavax.net.ssl.trustStorePassword=changeit";
+
     public static final CustomResourceDefinition SYNDESIS_CRD = new CustomResourceDefinitionBuilder()
         .withApiVersion("apiextensions.k8s.io/v1beta2")
         .withKind("CustomResourceDefinition")
@@ -484,16 +501,100 @@ public class OpenShiftServiceImpl implements OpenShiftService {
                     .endSpec();
         }
 
+        trustedCaBundle(deploymentConfig, deploymentData.getImage());
+
         deploymentConfig
             .done();
     }
 
+    /**
+     * Mounts the truststore generated by an init container out of the trusted
+     * CA bundle, or unmounts it once the bundle isn't configured any more.
+     */
+    private void trustedCaBundle(DoneableDeploymentConfig deploymentConfig, String image) {
+        final PodSpec podSpec = deploymentConfig.buildSpec().getTemplate().getSpec();
+        final Container container = podSpec.getContainers().get(0);
+        final List<Container> initContainers = mutableCopy(podSpec.getInitContainers());
+        final List<Volume> volumes = mutableCopy(podSpec.getVolumes());
+        final List<VolumeMount> volumeMounts = mutableCopy(container.getVolumeMounts());
+        final List<EnvVar> env = mutableCopy(container.getEnv());
+
+        boolean unmounted = initContainers.removeIf(c -> TRUSTSTORE_VOLUME.equals(c.getName()));
+        unmounted |= volumes.removeIf(v -> TRUSTED_CA_BUNDLE_VOLUME.equals(v.getName()) || TRUSTSTORE_VOLUME.equals(v.getName()));
+        unmounted |= volumeMounts.removeIf(m -> TRUSTSTORE_VOLUME.equals(m.getName()));
+        unmounted |= env.removeIf(e -> "JAVA_OPTIONS".equals(e.getName()) && TRUSTSTORE_JAVA_OPTIONS.equals(e.getValue()));
+        if (!config.hasTrustedCaBundle() && !unmounted) {
+            return;
+        }
+
+        if (config.hasTrustedCaBundle()) {
+            initContainers.add(new ContainerBuilder()
+                .withName(TRUSTSTORE_VOLUME)
+                .withImage(image)
+                .withCommand("/bin/sh", "/etc/syndesis/trusted-ca/generate-truststore.sh")
+                .addNewVolumeMount()
+                    .withName(TRUSTED_CA_BUNDLE_VOLUME)
+                    .withMountPath("/etc/syndesis/trusted-ca")
+                .endVolumeMount()
+                .addNewVolumeMount()
+                    .withName(TRUSTSTORE_VOLUME)
+                    .withMountPath(TRUSTSTORE_PATH)
+                .endVolumeMount()
+                .build());
+            volumes.add(new VolumeBuilder()
+                .withName(TRUSTED_CA_BUNDLE_VOLUME)
+                .withNewProjected()
+                    .addNewSource()
+                        .withNewConfigMap()
+                            .withName(TRUSTSTORE_CONFIG_MAP)
+                        .endConfigMap()
+                    .endSource()
+                    .addNewSource()
+                        .withNewConfigMap()
+                            .withName(config.getTrustedCaBundleConfigMap())
+                            .addNewItem()
+                                .withKey(config.getTrustedCaBundleKey())
+                                .withPath("ca-bundle.crt")
+                            .endItem()
+                        .endConfigMap()
+                    .endSource()
+                .endProjected()
+                .build());
+            volumes.add(new VolumeBuilder()
+                .withName(TRUSTSTORE_VOLUME)
+                .withNewEmptyDir()
+                .endEmptyDir()
+                .build());
+            volumeMounts.add(new VolumeMountBuilder()
+                .withName(TRUSTSTORE_VOLUME)
+                .withMountPath(TRUSTSTORE_PATH)
+                .withReadOnly(true)
+                .build());
+            env.add(new EnvVar("JAVA_OPTIONS", TRUSTSTORE_JAVA_OPTIONS, null));
+        }
+
+        podSpec.setInitContainers(initContainers);
+        podSpec.setVolumes(volumes);
+        container.setVolumeMounts(volumeMounts);
+        container.setEnv(env);
+        deploymentConfig
+            .editSpec()
+                .editTemplate()
+                    .withSpec(podSpec)
+                .endTemplate()
+            .endSpec();
+    }
+
+    private static <T> List<T> mutableCopy(List<T> list) {
+        return list == null ? new ArrayList<>() : new ArrayList<>(list);
+    }
+
     private boolean removeDeploymentConfig(String projectName) {
         return openShiftClient.deploymentConfigs().withName(projectName).delete();
     }
 
-    private void ensureBuildConfig(String name, DeploymentData deploymentData, String builderStreamTag, String imageStreamNamespace, Map<String, String> buildNodeSelector) {
-        openShiftClient.buildConfigs().withName(name).createOrReplaceWithNew()
+    protected void ensureBuildConfig(String name, DeploymentData deploymentData, String builderStreamTag, String imageStreamNamespace, Map<String, String> buildNodeSelector) {
+        final DoneableBuildConfig buildConfig = openShiftClient.buildConfigs().withName(name).createOrReplaceWithNew()
             .withNewMetadata()
                 .withName(name)
                 .addToAnnotations(deploymentData.getAnnotations())
@@ -530,8 +631,33 @@ public class OpenShiftServiceImpl implements OpenShiftService {
                     .endTo()
                 .endOutput()
                 .withNodeSelector(buildNodeSelector)
-            .endSpec()
-         .done();
+            .endSpec();
+
+        // the assemble script generates the truststore Maven runs with out of
+        // the injected trusted CA bundle
+        if (config.hasTrustedCaBundle()) {
+            buildConfig
+                .editSpec()
+                    .editSource()
+                        .withConfigMaps(
+                            new ConfigMapBuildSourceBuilder()
+                                .withConfigMap(new LocalObjectReference(TRUSTSTORE_CONFIG_MAP))
+                                .withDestinationDir(TRUSTSTORE_VOLUME)
+                                .build(),
+                            new ConfigMapBuildSourceBuilder()
+                                .withConfigMap(new LocalObjectReference(config.getTrustedCaBundleConfigMap()))
+                                .withDestinationDir(TRUSTSTORE_VOLUME)
+                                .build())
+                    .endSource()
+                    .editStrategy()
+                        .editSourceStrategy()
+                            .addToEnv(new EnvVar("TRUSTED_CA_BUNDLE", "/tmp/src/" + TRUSTSTORE_VOLUME + "/" + config.getTrustedCaBundleKey(), null))
+                        .endSourceStrategy()
+                    .endStrategy()
+                .endSpec();
+        }
+
+        buildConfig.done();
     }
 
     private boolean removeBuildConfig(String projectName) {
@@ -21,9 +21,14 @@ import java.util.EnumSet;
 import java.util.List;
 import java.util.Objects;
 import java.util.concurrent.TimeUnit;
+import java.util.stream.Collectors;
 
+import io.fabric8.kubernetes.api.model.Container;
 import io.fabric8.kubernetes.api.model.EnvVarBuilder;
 import io.fabric8.kubernetes.api.model.IntOrString;
+import io.fabric8.kubernetes.api.model.KeyToPath;
+import io.fabric8.kubernetes.api.model.PodSpec;
+import io.fabric8.kubernetes.api.model.PodSpecBuilder;
 import io.fabric8.kubernetes.api.model.ProbeBuilder;
 import io.fabric8.kubernetes.api.model.Quantity;
 import io.fabric8.kubernetes.api.model.ReplicationControllerListBuilder;
@@ -32,6 +37,12 @@ import io.fabric8.kubernetes.api.model.SecretBuilder;
 import io.fabric8.kubernetes.api.model.Service;
 import io.fabric8.kubernetes.api.model.ServiceBuilder;
 import io.fabric8.kubernetes.api.model.StatusBuilder;
+import io.fabric8.kubernetes.api.model.Volume;
+import io.fabric8.kubernetes.api.model.VolumeMount;
+import io.fabric8.kubernetes.client.utils.Serialization;
+import io.fabric8.openshift.api.model.BuildConfig;
+import io.fabric8.openshift.api.model.BuildConfigBuilder;
+import io.fabric8.openshift.api.model.ConfigMapBuildSource;
 import io.fabric8.openshift.api.model.DeploymentConfig;
 import io.fabric8.openshift.api.model.DeploymentConfigBuilder;
 import io.fabric8.openshift.api.model.Route;
@@ -48,6 +59,7 @@ import static io.fabric8.kubernetes.client.utils.Serialization.asJson;
 import static io.syndesis.server.openshift.OpenShiftServiceImpl.openshiftName;
 
 import static org.assertj.core.api.Assertions.assertThat;
+import static org.assertj.core.api.Assertions.tuple;
 
 import okhttp3.mockwebserver.RecordedRequest;
 
@@ -462,6 +474,184 @@ public class OpenShiftServiceImplTest {
         assertThat(issuedRequests).contains(Request.with("DELETE", "/apis/build.openshift.io/v1/namespaces/test/buildconfigs/i-integration"));
     }
 
+    @Test
+    public void shouldMountTheTrustedCaBundle() {
+        config.setTrustedCaBundleConfigMap("trusted-ca");
+
+        final DeploymentData deploymentData = new DeploymentData.Builder()
+            .withImage("testimage")
+            .build();
+
+        final String name = "trusted";
+
+        expectDeploymentOf(name, baseDeploymentFor(name, deploymentData).build());
+
+        service.deploy(name, deploymentData);
+
+        final PodSpec podSpec = deployedPodSpec(gatherRequests());
+
+        assertThat(podSpec.getInitContainers()).hasSize(1);
+        final Container init = podSpec.getInitContainers().get(0);
+        assertThat(init.getName()).isEqualTo("truststore");
+        assertThat(init.getImage()).isEqualTo("testimage");
+        assertThat(init.getCommand()).containsExactly("/bin/sh", "/etc/syndesis/trusted-ca/generate-truststore.sh");
+        assertThat(init.getVolumeMounts()).extracting(VolumeMount::getName, VolumeMount::getMountPath)
+            .containsExactly(tuple("trusted-ca-bundle", "/etc/syndesis/trusted-ca"), tuple("truststore", "/etc/syndesis/truststore"));
+
+        assertThat(podSpec.getVolumes()).extracting(Volume::getName).containsExactly("secret-volume", "trusted-ca-bundle", "truststore");
+        final Volume bundle = podSpec.getVolumes().get(1);
+        assertThat(bundle.getProjected().getSources()).extracting(s -> s.getConfigMap().getName())
+            .containsExactly("syndesis-truststore", "trusted-ca");
+        assertThat(bundle.getProjected().getSources().get(1).getConfigMap().getItems()).extracting(KeyToPath::getKey, KeyToPath::getPath)
+            .containsExactly(tuple("ca-bundle.crt", "ca-bundle.crt"));
+        assertThat(podSpec.getVolumes().get(2).getEmptyDir()).isNotNull();
+
+        final Container container = podSpec.getContainers().get(0);
+        assertThat(container.getVolumeMounts()).extracting(VolumeMount::getName, VolumeMount::getMountPath, VolumeMount::getReadOnly)
+            .contains(tuple("truststore", "/etc/syndesis/truststore", true));
+        assertThat(container.getEnv()).contains(new EnvVarBuilder()
+            .withName("JAVA_OPTIONS")
+            .withValue("-Djavax.net.ssl.trustStore=/etc/syndesis/truststore/cacerts -Djavax.net.ssl.trustStorePassword=changeit")
+            .build());
+    }
+
+    @Test
+    public void shouldUnmountTheTrustedCaBundleOnceRemoved() {
+        final DeploymentData deploymentData = new DeploymentData.Builder()
+            .withImage("testimage")
+            .build();
+
+        final String name = "untrusted";
+
+        final DeploymentConfig mounted = new DeploymentConfigBuilder(baseDeploymentFor(name, deploymentData).build())
+            .editSpec()
+                .editTemplate()
+                    .withSpec(mountedPodSpec(name, deploymentData))
+                .endTemplate()
+            .endSpec()
+            .withNewStatus()
+                .withLatestVersion(1L)
+            .endStatus()
+            .build();
+
+        server.expect()
+            .get()
+            .withPath("/apis/apps.openshift.io/v1/namespaces/test/deploymentconfigs/" + openshiftName(name))
+            .andReturn(200, mounted)
+            .always();
+        server.expect()
+            .patch()
+            .withPath("/apis/apps.openshift.io/v1/namespaces/test/deploymentconfigs/" + openshiftName(name))
+            .andReturn(200, mounted)
+            .always();
+
+        service.deploy(name, deploymentData);
+
+        final List<String> patches = gatherRequests().stream()
+            .filter(r -> "PATCH".equals(r.method))
+            .map(r -> r.body)
+            .collect(Collectors.toList());
+
+        assertThat(patches).anyMatch(p -> p.contains("/spec/template/spec/initContainers"));
+        assertThat(patches).anyMatch(p -> p.contains("/spec/template/spec/volumes"));
+        assertThat(patches).anyMatch(p -> p.contains("/spec/template/spec/containers/0/volumeMounts"));
+        assertThat(patches).anyMatch(p -> p.contains("/spec/template/spec/containers/0/env"));
+        assertThat(patches).noneMatch(p -> p.contains("generate-truststore.sh"));
+    }
+
+    @Test
+    public void shouldBuildWithTheTrustedCaBundle() {
+        config.setTrustedCaBundleConfigMap("trusted-ca");
+        config.setTrustedCaBundleKey("custom.crt");
+
+        final DeploymentData deploymentData = new DeploymentData.Builder()
+            .build();
+
+        server.expect()
+            .post()
+            .withPath("/apis/build.openshift.io/v1/namespaces/test/buildconfigs")
+            .andReturn(200, new BuildConfigBuilder().build())
+            .always();
+
+        service.ensureBuildConfig("i-trusted", deploymentData, config.getBuilderImageStreamTag(), config.getImageStreamNamespace(), config.getBuildNodeSelector());
+
+        final BuildConfig buildConfig = gatherRequests().stream()
+            .filter(r -> "POST".equals(r.method) && "/apis/build.openshift.io/v1/namespaces/test/buildconfigs".equals(r.path))
+            .map(r -> Serialization.unmarshal(r.body, BuildConfig.class))
+            .findFirst()
+            .orElseThrow(() -> new AssertionError("No BuildConfig was created"));
+
+        assertThat(buildConfig.getSpec().getSource().getConfigMaps())
+            .extracting(s -> s.getConfigMap().getName(), ConfigMapBuildSource::getDestinationDir)
+            .containsExactly(tuple("syndesis-truststore", "truststore"), tuple("trusted-ca", "truststore"));
+        assertThat(buildConfig.getSpec().getStrategy().getSourceStrategy().getEnv()).contains(new EnvVarBuilder()
+            .withName("TRUSTED_CA_BUNDLE")
+            .withValue("/tmp/src/truststore/custom.crt")
+            .build());
+    }
+
+    PodSpec mountedPodSpec(final String name, final DeploymentData deploymentData) {
+        return new PodSpecBuilder(baseDeploymentFor(name, deploymentData).buildSpec().getTemplate().getSpec())
+            .addNewInitContainer()
+                .withName("truststore")
+                .withImage(deploymentData.getImage())
+                .withCommand("/bin/sh", "/etc/syndesis/trusted-ca/generate-truststore.sh")
+                .addNewVolumeMount()
+                    .withName("trusted-ca-bundle")
+                    .withMountPath("/etc/syndesis/trusted-ca")
+                .endVolumeMount()
+                .addNewVolumeMount()
+                    .withName("truststore")
+                    .withMountPath("/etc/syndesis/truststore")
+                .endVolumeMount()
+            .endInitContainer()
+            .addNewVolume()
+                .withName("trusted-ca-bundle")
+                .withNewProjected()
+                    .addNewSource()
+                        .withNewConfigMap()
+                            .withName("syndesis-truststore")
+                        .endConfigMap()
+                    .endSource()
+                    .addNewSource()
+                        .withNewConfigMap()
+                            .withName("trusted-ca")
+                            .addNewItem()
+                                .withKey("ca-bundle.crt")
+                                .withPath("ca-bundle.crt")
+                            .endItem()
+                        .endConfigMap()
+                    .endSource()
+                .endProjected()
+            .endVolume()
+            .addNewVolume()
+                .withName("truststore")
+                .withNewEmptyDir()
+                .endEmptyDir()
+            .endVolume()
+            .editFirstContainer()
+                .addNewVolumeMount()
+                    .withName("truststore")
+                    .withMountPath("/etc/syndesis/truststore")
+                    .withReadOnly(true)
+                .endVolumeMount()
+                .addToEnv(new EnvVarBuilder()
+                    .withName("JAVA_OPTIONS")
+                    .withValue("-Djavax.net.ssl.trustStore=/etc/syndesis/truststore/cacerts -Djavax.net.ssl.trustStorePassword=changeit")
+                    .build())
+            .endContainer()
+            .build();
+    }
+
+    static PodSpec deployedPodSpec(final List<Request> issuedRequests) {
+        return issuedRequests.stream()
+            .filter(r -> "POST".equals(r.method) && "/apis/apps.openshift.io/v1/namespaces/test/deploymentconfigs".equals(r.path))
+            .map(r -> Serialization.unmarshal(r.body, DeploymentConfig.class))
+            .findFirst()
+            .orElseThrow(() -> new AssertionError("No DeploymentConfig was created"))
+            .getSpec().getTemplate().getSpec();
+    }
+
     DeploymentConfigBuilder baseDeploymentFor(final String name, final DeploymentData deploymentData) {
         return new DeploymentConfigBuilder()
             .withNewMetadata()
@@ -0,0 +1,242 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1
+
+import (
+	"encoding/json"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this syndesis to the hub version, v1beta2, through v1beta1
+func (s *Syndesis) ConvertTo(hub conversion.Hub) error {
+	dst := hub.(*v1beta2.Syndesis)
+	if err := s.convertTo(dst); err != nil {
+		return err
+	}
+
+	return v1beta2.RestoreConversionData(s, dst, func(restored *v1beta2.Syndesis) (bool, error) {
+		check := &Syndesis{}
+		if err := check.convertFrom(restored); err != nil {
+			return false, err
+		}
+		return equality.Semantic.DeepEqual(check.Spec, s.Spec) && equality.Semantic.DeepEqual(check.Status, s.Status), nil
+	})
+}
+
+// ConvertFrom converts from the hub version, v1beta2, to this syndesis through v1beta1
+func (s *Syndesis) ConvertFrom(hub conversion.Hub) error {
+	src := hub.(*v1beta2.Syndesis)
+	if err := s.convertFrom(src); err != nil {
+		return err
+	}
+
+	return v1beta2.SaveConversionData(src, s)
+}
+
+func (s *Syndesis) convertTo(dst *v1beta2.Syndesis) error {
+	beta1 := &v1beta1.Syndesis{}
+	if err := s.convertToV1beta1(beta1); err != nil {
+		return err
+	}
+
+	// The conversion data is restored by this version, v1beta1 has nothing to restore
+	delete(beta1.Annotations, v1beta2.ConversionDataAnnotation)
+	return beta1.ConvertTo(dst)
+}
+
+func (s *Syndesis) convertFrom(src *v1beta2.Syndesis) error {
+	beta1 := &v1beta1.Syndesis{}
+	if err := beta1.ConvertFrom(src); err != nil {
+		return err
+	}
+
+	return s.convertFromV1beta1(beta1)
+}
+
+func (s *Syndesis) convertToV1beta1(dst *v1beta1.Syndesis) error {
+	//
+	// Copy any common fields by marhsalling to json
+	// then unmarshalling into the new struct
+	//
+	alphaJson, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	//
+	// Not interested in marshalling errors as only copying common fields
+	// The rest will be explicitly copied in the remaining code
+	//
+	_ = json.Unmarshal(alphaJson, dst)
+
+	//
+	// This will have been overwritten so correct it
+	//
+	dst.TypeMeta.APIVersion = v1beta1.SchemeGroupVersion.String()
+
+	// Migrate addons
+	for k, addon := range s.Spec.Addons {
+		switch k {
+		case "ops":
+			dst.Spec.Addons.Ops.Enabled = addon["enabled"] == "true"
+		case "todo":
+			dst.Spec.Addons.Todo.Enabled = addon["enabled"] == "true"
+		case "jaeger":
+			dst.Spec.Addons.Jaeger.Enabled = addon["enabled"] == "true"
+		}
+	}
+
+	// Migrate maven repositories
+	if len(s.Spec.MavenRepositories) != 0 {
+		dst.Spec.Components.Server.Features.Maven.Repositories = map[string]string{}
+		for k, v := range s.Spec.MavenRepositories {
+			dst.Spec.Components.Server.Features.Maven.Repositories[k] = v
+		}
+	}
+
+	// Migrate Integrations
+	if s.Spec.Integration.Limit != nil {
+		dst.Spec.Components.Server.Features.IntegrationLimit = *s.Spec.Integration.Limit
+	}
+	if s.Spec.Integration.StateCheckInterval != nil {
+		dst.Spec.Components.Server.Features.IntegrationStateCheckInterval = *s.Spec.Integration.StateCheckInterval
+	}
+
+	// Server
+	dst.Spec.Components.Server.Features.ManagementUrlFor3scale = s.Spec.Components.Server.Features.ManagementUrlFor3scale
+	dst.Spec.Components.Server.Resources.Memory = memoryLimit(s.Spec.Components.Server.Resources)
+
+	// Database
+	dst.Spec.Components.Database.Name = s.Spec.Components.Db.Database
+	dst.Spec.Components.Database.User = s.Spec.Components.Db.User
+	dst.Spec.Components.Database.Resources.Memory = memoryLimit(s.Spec.Components.Db.Resources.Resources)
+	dst.Spec.Components.Database.Resources.VolumeCapacity = s.Spec.Components.Db.Resources.VolumeCapacity
+
+	// Oauth
+	if s.Spec.Components.Oauth.DisableSarCheck != nil {
+		dst.Spec.Components.Oauth.DisableSarCheck = *s.Spec.Components.Oauth.DisableSarCheck
+	}
+	dst.Spec.Components.Oauth.SarNamespace = s.Spec.SarNamespace
+
+	// Meta
+	dst.Spec.Components.Meta.Resources.Memory = memoryLimit(s.Spec.Components.Meta.Resources.Resources)
+	dst.Spec.Components.Meta.Resources.VolumeCapacity = s.Spec.Components.Meta.Resources.VolumeCapacity
+
+	// Prometheus
+	dst.Spec.Components.Prometheus.Resources.Memory = memoryLimit(s.Spec.Components.Prometheus.Resources.Resources)
+	dst.Spec.Components.Prometheus.Resources.VolumeCapacity = s.Spec.Components.Prometheus.Resources.VolumeCapacity
+
+	// Grafana
+	dst.Spec.Components.Grafana.Resources.Memory = memoryLimit(s.Spec.Components.Grafana.Resources)
+
+	return nil
+}
+
+func (s *Syndesis) convertFromV1beta1(src *v1beta1.Syndesis) error {
+	beta1Json, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+
+	_ = json.Unmarshal(beta1Json, s)
+	s.TypeMeta.APIVersion = SchemeGroupVersion.String()
+
+	// Addons are a map of parameters in this version
+	s.Spec.Addons = nil
+	for name, enabled := range map[string]bool{
+		"ops":    src.Spec.Addons.Ops.Enabled,
+		"todo":   src.Spec.Addons.Todo.Enabled,
+		"jaeger": src.Spec.Addons.Jaeger.Enabled,
+	} {
+		if enabled {
+			if s.Spec.Addons == nil {
+				s.Spec.Addons = AddonsSpec{}
+			}
+			s.Spec.Addons[name] = Parameters{"enabled": "true"}
+		}
+	}
+
+	features := src.Spec.Components.Server.Features
+	s.Spec.MavenRepositories = nil
+	if len(features.Maven.Repositories) != 0 {
+		s.Spec.MavenRepositories = map[string]string{}
+		for k, v := range features.Maven.Repositories {
+			s.Spec.MavenRepositories[k] = v
+		}
+	}
+
+	s.Spec.Integration = IntegrationSpec{}
+	if features.IntegrationLimit != 0 {
+		limit := features.IntegrationLimit
+		s.Spec.Integration.Limit = &limit
+	}
+	if features.IntegrationStateCheckInterval != 0 {
+		interval := features.IntegrationStateCheckInterval
+		s.Spec.Integration.StateCheckInterval = &interval
+	}
+
+	components := src.Spec.Components
+	s.Spec.Components.Server.Features.ManagementUrlFor3scale = features.ManagementUrlFor3scale
+	s.Spec.Components.Server.Resources = memoryResources(components.Server.Resources.Memory)
+
+	s.Spec.Components.Db.Database = components.Database.Name
+	s.Spec.Components.Db.User = components.Database.User
+	s.Spec.Components.Db.Resources = ResourcesWithVolume{
+		Resources:      memoryResources(components.Database.Resources.Memory),
+		VolumeCapacity: components.Database.Resources.VolumeCapacity,
+	}
+
+	s.Spec.Components.Oauth.DisableSarCheck = nil
+	if components.Oauth.DisableSarCheck {
+		disableSarCheck := true
+		s.Spec.Components.Oauth.DisableSarCheck = &disableSarCheck
+	}
+	s.Spec.SarNamespace = components.Oauth.SarNamespace
+
+	s.Spec.Components.Meta.Resources = ResourcesWithVolume{
+		Resources:      memoryResources(components.Meta.Resources.Memory),
+		VolumeCapacity: components.Meta.Resources.VolumeCapacity,
+	}
+	s.Spec.Components.Prometheus.Resources = ResourcesWithVolume{
+		Resources:      memoryResources(components.Prometheus.Resources.Memory),
+		VolumeCapacity: components.Prometheus.Resources.VolumeCapacity,
+	}
+	s.Spec.Components.Grafana.Resources = memoryResources(components.Grafana.Resources.Memory)
+
+	return nil
+}
+
+func memoryLimit(resources Resources) string {
+	if m, ok := resources.Limits[v1.ResourceMemory]; ok {
+		return m.String()
+	}
+	return ""
+}
+
+func memoryResources(memory string) Resources {
+	m, err := resource.ParseQuantity(memory)
+	if memory == "" || err != nil {
+		return Resources{}
+	}
+	return Resources{ResourceRequirements: v1.ResourceRequirements{Limits: v1.ResourceList{v1.ResourceMemory: m}}}
+}
@@ -0,0 +1,147 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func memoryLimitOf(memory string) Resources {
+	return Resources{ResourceRequirements: v1.ResourceRequirements{
+		Limits: v1.ResourceList{v1.ResourceMemory: resource.MustParse(memory)},
+	}}
+}
+
+func Test_ConvertTo(t *testing.T) {
+	il := 5
+	ici := 10
+	dsc := true
+	src := &Syndesis{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "syndesis"},
+		Status:     SyndesisStatus{Phase: SyndesisPhaseInstalled, Version: "1.8"},
+		Spec: SyndesisSpec{
+			SarNamespace:  "sar namespace",
+			RouteHostname: "routehostname",
+			Addons: AddonsSpec{
+				"ops":    map[string]string{"enabled": "true"},
+				"todo":   map[string]string{"enabled": "true"},
+				"camelk": map[string]string{"enabled": "false"},
+				"jaeger": map[string]string{"enabled": "false"},
+			},
+			Integration:       IntegrationSpec{Limit: &il, StateCheckInterval: &ici},
+			MavenRepositories: map[string]string{"repo1": "repo1url", "repo2": "repo2url"},
+			Components: ComponentsSpec{
+				Server: ServerConfiguration{
+					Features:  ServerFeatures{ManagementUrlFor3scale: "ManagementUrlFor3scale"},
+					Resources: memoryLimitOf("500m"),
+				},
+				Db: DbConfiguration{
+					User:     "user",
+					Database: "database",
+					Resources: ResourcesWithVolume{
+						Resources: Resources{ResourceRequirements: v1.ResourceRequirements{
+							Limits: v1.ResourceList{v1.ResourceCPU: resource.MustParse("0.5")},
+						}},
+						VolumeCapacity: "1Gi",
+					},
+				},
+				Meta:       MetaConfiguration{Resources: ResourcesWithVolume{Resources: memoryLimitOf("300m"), VolumeCapacity: "5Gi"}},
+				Prometheus: PrometheusConfiguration{Resources: ResourcesWithVolume{Resources: memoryLimitOf("700m"), VolumeCapacity: "2Gi"}},
+				Grafana:    GrafanaConfiguration{Resources: memoryLimitOf("500m")},
+				Oauth:      OauthConfiguration{DisableSarCheck: &dsc},
+			},
+		},
+	}
+
+	dst := &v1beta2.Syndesis{}
+	require.NoError(t, src.ConvertTo(dst))
+
+	assert.Equal(t, "syndesis.io/v1beta2", dst.APIVersion)
+	assert.Equal(t, src.ObjectMeta, dst.ObjectMeta)
+	assert.Equal(t, v1beta2.SyndesisStatus{Phase: v1beta2.SyndesisPhaseInstalled, Version: "1.8"}, dst.Status)
+	assert.Equal(t, v1beta2.SyndesisSpec{
+		RouteHostname: "routehostname",
+		Addons: v1beta2.AddonsSpec{
+			Ops:  v1beta2.AddonSpec{Enabled: true},
+			Todo: v1beta2.AddonSpec{Enabled: true},
+		},
+		Components: v1beta2.ComponentsSpec{
+			Oauth: v1beta2.OauthConfiguration{SarNamespace: "sar namespace", DisableSarCheck: dsc},
+			Server: v1beta2.ServerConfiguration{
+				Features: v1beta2.ServerFeatures{
+					IntegrationLimit:              il,
+					IntegrationStateCheckInterval: ici,
+					ManagementUrlFor3scale:        "ManagementUrlFor3scale",
+					Maven: v1beta2.MavenConfiguration{
+						Repositories: map[string]string{"repo1": "repo1url", "repo2": "repo2url"},
+					},
+				},
+				Resources: v1beta2.Resources{Limit: v1beta2.ResourceParams{Memory: "500m"}, Request: v1beta2.ResourceParams{Memory: "500m"}},
+			},
+			Database:   v1beta2.DatabaseConfiguration{User: "user", Name: "database", Resources: v1beta2.ResourcesWithPersistentVolume{VolumeCapacity: "1Gi"}},
+			Meta:       v1beta2.MetaConfiguration{Resources: v1beta2.ResourcesWithPersistentVolume{Limit: v1beta2.ResourceParams{Memory: "300m"}, Request: v1beta2.ResourceParams{Memory: "300m"}, VolumeCapacity: "5Gi"}},
+			Prometheus: v1beta2.PrometheusConfiguration{Resources: v1beta2.ResourcesWithPersistentVolume{Limit: v1beta2.ResourceParams{Memory: "700m"}, Request: v1beta2.ResourceParams{Memory: "700m"}, VolumeCapacity: "2Gi"}},
+			Grafana:    v1beta2.GrafanaConfiguration{Resources: v1beta2.Resources{Limit: v1beta2.ResourceParams{Memory: "500m"}, Request: v1beta2.ResourceParams{Memory: "500m"}}},
+		},
+	}, dst.Spec)
+}
+
+func Test_ConvertFrom(t *testing.T) {
+	hub := &v1beta2.Syndesis{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "syndesis"},
+		Spec: v1beta2.SyndesisSpec{
+			DeletionPolicy: v1beta2.DeletionPolicyBackupThenDelete,
+			Addons:         v1beta2.AddonsSpec{Todo: v1beta2.AddonSpec{Enabled: true}},
+			Components: v1beta2.ComponentsSpec{
+				Oauth: v1beta2.OauthConfiguration{SarNamespace: "sar namespace"},
+				Server: v1beta2.ServerConfiguration{
+					Features:  v1beta2.ServerFeatures{IntegrationLimit: 3},
+					Resources: v1beta2.Resources{Limit: v1beta2.ResourceParams{Memory: "800Mi", CPU: "750m"}},
+				},
+				Database: v1beta2.DatabaseConfiguration{Name: "syndesis", Resources: v1beta2.ResourcesWithPersistentVolume{VolumeCapacity: "1Gi"}},
+			},
+		},
+		Status: v1beta2.SyndesisStatus{Phase: v1beta2.SyndesisPhaseInstalled, Version: "1.12"},
+	}
+
+	spoke := &Syndesis{}
+	require.NoError(t, spoke.ConvertFrom(hub))
+	assert.Equal(t, "syndesis.io/v1alpha1", spoke.APIVersion)
+	assert.Equal(t, AddonsSpec{"todo": Parameters{"enabled": "true"}}, spoke.Spec.Addons)
+	assert.Equal(t, "sar namespace", spoke.Spec.SarNamespace)
+	require.NotNil(t, spoke.Spec.Integration.Limit)
+	assert.Equal(t, 3, *spoke.Spec.Integration.Limit)
+	assert.Nil(t, spoke.Spec.Integration.StateCheckInterval)
+	assert.Equal(t, "800Mi", memoryLimit(spoke.Spec.Components.Server.Resources))
+	assert.Equal(t, "syndesis", spoke.Spec.Components.Db.Database)
+	assert.Equal(t, "1Gi", spoke.Spec.Components.Db.Resources.VolumeCapacity)
+	assert.Equal(t, SyndesisPhaseInstalled, spoke.Status.Phase)
+
+	// What v1alpha1 cannot hold is restored on the way back
+	result := &v1beta2.Syndesis{}
+	require.NoError(t, spoke.ConvertTo(result))
+	assert.Equal(t, hub.Spec, result.Spec)
+	assert.Equal(t, hub.Status, result.Status)
+	assert.Empty(t, result.Annotations)
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import (
+	"encoding/json"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this syndesis to the hub version, v1beta2
+func (s *Syndesis) ConvertTo(hub conversion.Hub) error {
+	dst := hub.(*v1beta2.Syndesis)
+	if err := s.convertTo(dst); err != nil {
+		return err
+	}
+
+	return v1beta2.RestoreConversionData(s, dst, func(restored *v1beta2.Syndesis) (bool, error) {
+		check := &Syndesis{}
+		if err := check.convertFrom(restored); err != nil {
+			return false, err
+		}
+		return equality.Semantic.DeepEqual(check.Spec, s.Spec) && equality.Semantic.DeepEqual(check.Status, s.Status), nil
+	})
+}
+
+// ConvertFrom converts from the hub version, v1beta2, to this syndesis
+func (s *Syndesis) ConvertFrom(hub conversion.Hub) error {
+	src := hub.(*v1beta2.Syndesis)
+	if err := s.convertFrom(src); err != nil {
+		return err
+	}
+
+	return v1beta2.SaveConversionData(src, s)
+}
+
+func (s *Syndesis) convertTo(dst *v1beta2.Syndesis) error {
+	//
+	// Copy any common fields by marhsalling to json
+	// then unmarshalling into the new struct
+	//
+	beta1Json, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	//
+	// Not interested in marshalling errors as only copying common fields
+	// The rest will be explicitly copied in the remaining code
+	//
+	_ = json.Unmarshal(beta1Json, dst)
+
+	//
+	// This will have been overwritten so correct it
+	//
+	dst.TypeMeta.APIVersion = v1beta2.SchemeGroupVersion.String()
+
+	components := &dst.Spec.Components
+	components.Server.Resources.Limit, components.Server.Resources.Request = memoryParams(s.Spec.Components.Server.Resources.Memory)
+	components.Database.Resources.Limit, components.Database.Resources.Request = memoryParams(s.Spec.Components.Database.Resources.Memory)
+	components.Meta.Resources.Limit, components.Meta.Resources.Request = memoryParams(s.Spec.Components.Meta.Resources.Memory)
+	components.Prometheus.Resources.Limit, components.Prometheus.Resources.Request = memoryParams(s.Spec.Components.Prometheus.Resources.Memory)
+	components.Grafana.Resources.Limit, components.Grafana.Resources.Request = memoryParams(s.Spec.Components.Grafana.Resources.Memory)
+
+	return nil
+}
+
+func (s *Syndesis) convertFrom(src *v1beta2.Syndesis) error {
+	beta2Json, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+
+	_ = json.Unmarshal(beta2Json, s)
+	s.TypeMeta.APIVersion = SchemeGroupVersion.String()
+
+	// Only a single memory setting is supported, used both as limit and request
+	components := src.Spec.Components
+	s.Spec.Components.Server.Resources.Memory = memory(components.Server.Resources.Limit, components.Server.Resources.Request)
+	s.Spec.Components.Database.Resources.Memory = memory(components.Database.Resources.Limit, components.Database.Resources.Request)
+	s.Spec.Components.Meta.Resources.Memory = memory(components.Meta.Resources.Limit, components.Meta.Resources.Request)
+	s.Spec.Components.Prometheus.Resources.Memory = memory(components.Prometheus.Resources.Limit, components.Prometheus.Resources.Request)
+	s.Spec.Components.Grafana.Resources.Memory = memory(components.Grafana.Resources.Limit, components.Grafana.Resources.Request)
+
+	return nil
+}
+
+func memoryParams(memory string) (limit v1beta2.ResourceParams, request v1beta2.ResourceParams) {
+	if memory == "" {
+		return
+	}
+	return v1beta2.ResourceParams{Memory: memory}, v1beta2.ResourceParams{Memory: memory}
+}
+
+func memory(limit v1beta2.ResourceParams, request v1beta2.ResourceParams) string {
+	if limit.Memory != "" {
+		return limit.Memory
+	}
+	return request.Memory
+}
@@ -0,0 +1,130 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_ConvertTo(t *testing.T) {
+	src := &Syndesis{
+		TypeMeta:   metav1.TypeMeta{Kind: "Syndesis", APIVersion: "syndesis.io/v1beta1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "syndesis"},
+		Status:     SyndesisStatus{Phase: SyndesisPhaseInstalled, Version: "1.11"},
+		Spec: SyndesisSpec{
+			RouteHostname: "routehostname",
+			Addons: AddonsSpec{
+				Ops:  AddonSpec{Enabled: true},
+				Todo: AddonSpec{Enabled: true},
+			},
+			Components: ComponentsSpec{
+				Oauth: OauthConfiguration{SarNamespace: "sar namespace", DisableSarCheck: true},
+				Server: ServerConfiguration{
+					Features: ServerFeatures{
+						IntegrationLimit:              5,
+						IntegrationStateCheckInterval: 10,
+						ManagementUrlFor3scale:        "ManagementUrlFor3scale",
+						Maven:                         MavenConfiguration{Repositories: map[string]string{"repo1": "repo1url"}},
+					},
+					Resources: Resources{Memory: "500m"},
+				},
+				Database:   DatabaseConfiguration{User: "user", Name: "database", Resources: ResourcesWithPersistentVolume{VolumeCapacity: "1Gi"}},
+				Meta:       MetaConfiguration{Resources: ResourcesWithPersistentVolume{Memory: "300m", VolumeCapacity: "5Gi"}},
+				Prometheus: PrometheusConfiguration{Resources: ResourcesWithPersistentVolume{Memory: "700m", VolumeCapacity: "2Gi"}},
+				Grafana:    GrafanaConfiguration{Resources: Resources{Memory: "500m"}},
+			},
+		},
+	}
+
+	dst := &v1beta2.Syndesis{}
+	require.NoError(t, src.ConvertTo(dst))
+
+	assert.Equal(t, metav1.TypeMeta{Kind: "Syndesis", APIVersion: "syndesis.io/v1beta2"}, dst.TypeMeta)
+	assert.Equal(t, src.ObjectMeta, dst.ObjectMeta)
+	assert.Equal(t, v1beta2.SyndesisStatus{Phase: v1beta2.SyndesisPhaseInstalled, Version: "1.11"}, dst.Status)
+	assert.Equal(t, v1beta2.SyndesisSpec{
+		RouteHostname: "routehostname",
+		Addons: v1beta2.AddonsSpec{
+			Ops:  v1beta2.AddonSpec{Enabled: true},
+			Todo: v1beta2.AddonSpec{Enabled: true},
+		},
+		Components: v1beta2.ComponentsSpec{
+			Oauth: v1beta2.OauthConfiguration{SarNamespace: "sar namespace", DisableSarCheck: true},
+			Server: v1beta2.ServerConfiguration{
+				Features: v1beta2.ServerFeatures{
+					IntegrationLimit:              5,
+					IntegrationStateCheckInterval: 10,
+					ManagementUrlFor3scale:        "ManagementUrlFor3scale",
+					Maven:                         v1beta2.MavenConfiguration{Repositories: map[string]string{"repo1": "repo1url"}},
+				},
+				Resources: v1beta2.Resources{Limit: v1beta2.ResourceParams{Memory: "500m"}, Request: v1beta2.ResourceParams{Memory: "500m"}},
+			},
+			Database:   v1beta2.DatabaseConfiguration{User: "user", Name: "database", Resources: v1beta2.ResourcesWithPersistentVolume{VolumeCapacity: "1Gi"}},
+			Meta:       v1beta2.MetaConfiguration{Resources: v1beta2.ResourcesWithPersistentVolume{Limit: v1beta2.ResourceParams{Memory: "300m"}, Request: v1beta2.ResourceParams{Memory: "300m"}, VolumeCapacity: "5Gi"}},
+			Prometheus: v1beta2.PrometheusConfiguration{Resources: v1beta2.ResourcesWithPersistentVolume{Limit: v1beta2.ResourceParams{Memory: "700m"}, Request: v1beta2.ResourceParams{Memory: "700m"}, VolumeCapacity: "2Gi"}},
+			Grafana:    v1beta2.GrafanaConfiguration{Resources: v1beta2.Resources{Limit: v1beta2.ResourceParams{Memory: "500m"}, Request: v1beta2.ResourceParams{Memory: "500m"}}},
+		},
+	}, dst.Spec)
+}
+
+func Test_ConvertRoundTrip(t *testing.T) {
+	hub := &v1beta2.Syndesis{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "syndesis", Annotations: map[string]string{"owner": "me"}},
+		Spec: v1beta2.SyndesisSpec{
+			DeletionPolicy: v1beta2.DeletionPolicyRetainData,
+			Components: v1beta2.ComponentsSpec{
+				Server: v1beta2.ServerConfiguration{Resources: v1beta2.Resources{
+					Limit:   v1beta2.ResourceParams{Memory: "800Mi", CPU: "750m"},
+					Request: v1beta2.ResourceParams{Memory: "256Mi", CPU: "450m"},
+				}},
+			},
+		},
+		Status: v1beta2.SyndesisStatus{
+			Phase:              v1beta2.SyndesisPhaseInstalled,
+			ObservedGeneration: 2,
+			Conditions: []metav1.Condition{
+				{Type: v1beta2.SyndesisConditionReady, Status: metav1.ConditionTrue, Reason: "AsExpected", LastTransitionTime: metav1.NewTime(time.Unix(1600000000, 0))},
+			},
+		},
+	}
+
+	// Unchanged through v1beta1, nothing is lost
+	spoke := &Syndesis{}
+	require.NoError(t, spoke.ConvertFrom(hub))
+	assert.Equal(t, "800Mi", spoke.Spec.Components.Server.Resources.Memory)
+	assert.Contains(t, spoke.Annotations, v1beta2.ConversionDataAnnotation)
+
+	result := &v1beta2.Syndesis{}
+	require.NoError(t, spoke.ConvertTo(result))
+	assert.Equal(t, hub.Spec, result.Spec)
+	assert.Equal(t, hub.Status, result.Status)
+	assert.Equal(t, hub.Annotations, result.Annotations)
+
+	// Changed through v1beta1, the change wins over what was recorded
+	spoke.Spec.Components.Server.Resources.Memory = "1Gi"
+	result = &v1beta2.Syndesis{}
+	require.NoError(t, spoke.ConvertTo(result))
+	assert.Equal(t, v1beta2.Resources{Limit: v1beta2.ResourceParams{Memory: "1Gi"}, Request: v1beta2.ResourceParams{Memory: "1Gi"}}, result.Spec.Components.Server.Resources)
+	assert.Equal(t, v1beta2.DeletionPolicy(""), result.Spec.DeletionPolicy)
+	assert.NotContains(t, result.Annotations, v1beta2.ConversionDataAnnotation)
+}
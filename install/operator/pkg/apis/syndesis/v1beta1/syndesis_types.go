@@ -282,7 +282,6 @@ const (
 // +k8s:openapi-gen=true
 // +kubebuilder:subresource:status
 // +kubebuilder:object:root=true
-// +kubebuilder:unservedversion
 // +kubebuilder:resource:path=syndesises,scope=Namespaced
 // +kubebuilder:printcolumn:name="Phase",description="The syndesis phase",type=string,JSONPath=`.status.phase`
 // +kubebuilder:printcolumn:name="Version",description="The syndesis version",type=string,JSONPath=`.status.version`
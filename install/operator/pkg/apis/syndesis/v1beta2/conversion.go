@@ -0,0 +1,87 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta2
+
+import (
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Annotation holding the spec and status of a v1beta2 resource converted to
+// an older version, so that what the older version cannot represent is not
+// lost when the resource is converted back
+const ConversionDataAnnotation = "syndesis.io/conversion-data"
+
+type conversionData struct {
+	Spec   SyndesisSpec   `json:"spec,omitempty"`
+	Status SyndesisStatus `json:"status,omitempty"`
+}
+
+// Hub marks v1beta2, the storage version, as the version all the others
+// are converted to and from
+func (*Syndesis) Hub() {}
+
+// Records the spec and status of the hub in the annotations of the object
+// it was converted to
+func SaveConversionData(src *Syndesis, dst metav1.Object) error {
+	data, err := json.Marshal(conversionData{Spec: src.Spec, Status: src.Status})
+	if err != nil {
+		return err
+	}
+
+	annotations := dst.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[ConversionDataAnnotation] = string(data)
+	dst.SetAnnotations(annotations)
+	return nil
+}
+
+// Restores the spec and status recorded in the annotations of src into the
+// hub it was converted to. They are only restored when unchanged confirms
+// that src still matches them, ie. it was not modified through its older
+// version, otherwise dst keeps the result of the conversion.
+func RestoreConversionData(src metav1.Object, dst *Syndesis, unchanged func(restored *Syndesis) (bool, error)) error {
+	delete(dst.Annotations, ConversionDataAnnotation)
+	if len(dst.Annotations) == 0 {
+		dst.Annotations = nil
+	}
+
+	data, ok := src.GetAnnotations()[ConversionDataAnnotation]
+	if !ok {
+		return nil
+	}
+
+	// A damaged annotation only loses what the older version cannot represent
+	saved := conversionData{}
+	if err := json.Unmarshal([]byte(data), &saved); err != nil {
+		return nil
+	}
+
+	restored := dst.DeepCopy()
+	restored.Spec = saved.Spec
+	restored.Status = saved.Status
+	if same, err := unchanged(restored); err != nil || !same {
+		return err
+	}
+
+	dst.Spec = saved.Spec
+	dst.Status = saved.Status
+	return nil
+}
@@ -0,0 +1,40 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta2
+
+import (
+	"encoding/json"
+)
+
+// The affinity and topology spread constraints of the components are encoded
+// as the core types they wrap
+
+func (a Affinity) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.Affinity)
+}
+
+func (a *Affinity) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &a.Affinity)
+}
+
+func (c TopologySpreadConstraint) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.TopologySpreadConstraint)
+}
+
+func (c *TopologySpreadConstraint) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &c.TopologySpreadConstraint)
+}
@@ -0,0 +1,51 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta2
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func Test_ComponentSchedulingEncodedAsPodSpec(t *testing.T) {
+	data := []byte(`{
+		"affinity": {"nodeAffinity": {"requiredDuringSchedulingIgnoredDuringExecution": {"nodeSelectorTerms": [{"matchExpressions": [{"key": "storage", "operator": "Exists"}]}]}}},
+		"topologySpreadConstraints": [{"maxSkew": 1, "topologyKey": "topology.kubernetes.io/zone", "whenUnsatisfiable": "DoNotSchedule"}]
+	}`)
+
+	scheduling := ComponentSchedulingSpec{}
+	require.NoError(t, json.Unmarshal(data, &scheduling))
+	require.NotNil(t, scheduling.Affinity)
+	require.NotNil(t, scheduling.Affinity.NodeAffinity)
+	require.Len(t, scheduling.TopologySpreadConstraints, 1)
+	assert.Equal(t, int32(1), scheduling.TopologySpreadConstraints[0].MaxSkew)
+
+	encoded, err := json.Marshal(scheduling)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), string(encoded))
+
+	// As read from the cluster by the runtime client
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&scheduling)
+	require.NoError(t, err)
+	converted := ComponentSchedulingSpec{}
+	require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(content, &converted))
+	assert.Equal(t, scheduling, converted)
+}
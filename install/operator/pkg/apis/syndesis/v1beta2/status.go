@@ -0,0 +1,223 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta2
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// Reason used by conditions that are in their expected, steady state
+	conditionReasonAsExpected = "AsExpected"
+	// Reason used by the Upgradeable condition while an upgrade is being processed
+	conditionReasonUpgradeInProgress = "UpgradeInProgress"
+)
+
+// Degraded reasons and the phase they are reported with
+var degradedPhases = map[SyndesisStatusReason]SyndesisPhase{
+	SyndesisStatusReasonDuplicate:              SyndesisPhaseNotInstalled,
+	SyndesisStatusReasonDeploymentNotReady:     SyndesisPhaseStartupFailed,
+	SyndesisStatusReasonUpgradeFailed:          SyndesisPhaseUpgradeFailureBackoff,
+	SyndesisStatusReasonTooManyUpgradeAttempts: SyndesisPhaseUpgradeFailed,
+}
+
+// Marks the installation as moving towards the given phase, ie. installing,
+// starting or upgrading.
+func (s *Syndesis) SetProgressing(phase SyndesisPhase, reason SyndesisStatusReason, description string) {
+	s.setCondition(SyndesisConditionProgressing, metav1.ConditionTrue, string(phase), description)
+	s.setCondition(SyndesisConditionReady, metav1.ConditionFalse, string(phase), description)
+	s.setCondition(SyndesisConditionDegraded, metav1.ConditionFalse, conditionReasonAsExpected, "")
+	s.updatePhase(reason, description)
+}
+
+// Marks the installation as fully deployed and running
+func (s *Syndesis) SetReady(description string) {
+	s.setCondition(SyndesisConditionReady, metav1.ConditionTrue, string(SyndesisPhaseInstalled), description)
+	s.setCondition(SyndesisConditionProgressing, metav1.ConditionFalse, string(SyndesisPhaseInstalled), "")
+	s.setCondition(SyndesisConditionDegraded, metav1.ConditionFalse, conditionReasonAsExpected, "")
+	s.updatePhase(SyndesisStatusReasonMissing, description)
+}
+
+// Marks the installation as failed for the given reason
+func (s *Syndesis) SetDegraded(reason SyndesisStatusReason, description string) {
+	s.setCondition(SyndesisConditionDegraded, metav1.ConditionTrue, string(reason), description)
+	s.setCondition(SyndesisConditionReady, metav1.ConditionFalse, string(reason), description)
+	// A failed upgrade is retried after a backoff period, so it's still progressing
+	progressing := metav1.ConditionFalse
+	if reason == SyndesisStatusReasonUpgradeFailed {
+		progressing = metav1.ConditionTrue
+	}
+	s.setCondition(SyndesisConditionProgressing, progressing, string(reason), description)
+	s.updatePhase(reason, description)
+}
+
+// Records the outcome of the last scheduled backup, a nil error meaning success
+func (s *Syndesis) SetBackupHealthy(err error) {
+	if err != nil {
+		s.setCondition(SyndesisConditionBackupHealthy, metav1.ConditionFalse, "BackupFailed", err.Error())
+	} else {
+		s.setCondition(SyndesisConditionBackupHealthy, metav1.ConditionTrue, "BackupSucceeded", "")
+	}
+}
+
+// Removes the BackupHealthy condition, used when no backup is scheduled
+func (s *Syndesis) RemoveBackupHealthy() {
+	meta.RemoveStatusCondition(&s.Status.Conditions, SyndesisConditionBackupHealthy)
+}
+
+// Records whether the reconciliation is paused by the reconcile-paused
+// annotation. The condition is only reported once it has been paused.
+func (s *Syndesis) SetPaused(paused bool) {
+	if paused {
+		s.setCondition(SyndesisConditionPaused, metav1.ConditionTrue, "ReconcilePaused", "Reconciliation paused by the "+ReconcilePausedAnnotation+" annotation")
+	} else if meta.FindStatusCondition(s.Status.Conditions, SyndesisConditionPaused) != nil {
+		s.setCondition(SyndesisConditionPaused, metav1.ConditionFalse, conditionReasonAsExpected, "")
+	}
+}
+
+// Records which resources differ from their rendered configuration, none
+// meaning that the installation hasn't drifted
+func (s *Syndesis) SetDrifted(resources []string) {
+	if len(resources) > 0 {
+		s.setCondition(SyndesisConditionDrifted, metav1.ConditionTrue, "ResourcesDrifted", "Resources differing from their rendered configuration: "+strings.Join(resources, ", "))
+	} else {
+		s.setCondition(SyndesisConditionDrifted, metav1.ConditionFalse, conditionReasonAsExpected, "")
+	}
+}
+
+// Removes the Drifted condition, used when drift detection is turned off
+func (s *Syndesis) RemoveDrifted() {
+	meta.RemoveStatusCondition(&s.Status.Conditions, SyndesisConditionDrifted)
+}
+
+// Records the overrides which could not be applied to the rendered resources,
+// none meaning that they all have been. The condition is only reported when
+// the resource has overrides.
+func (s *Syndesis) SetOverridden(failures []string) {
+	if len(s.Spec.Overrides) == 0 {
+		if meta.FindStatusCondition(s.Status.Conditions, SyndesisConditionOverridden) != nil {
+			meta.RemoveStatusCondition(&s.Status.Conditions, SyndesisConditionOverridden)
+		}
+	} else if len(failures) > 0 {
+		s.setCondition(SyndesisConditionOverridden, metav1.ConditionFalse, "OverridesFailed", "Overrides not applied: "+strings.Join(failures, "; "))
+	} else {
+		s.setCondition(SyndesisConditionOverridden, metav1.ConditionTrue, "OverridesApplied", "")
+	}
+}
+
+// Records the components whose pods are rejected by the pod security standard
+// enforced in the namespace, none meaning that they all comply. The condition
+// is only reported when the namespace enforces a standard.
+func (s *Syndesis) SetPodSecurityCompatible(level string, components []string) {
+	if level == "" {
+		if meta.FindStatusCondition(s.Status.Conditions, SyndesisConditionPodSecurityCompatible) != nil {
+			meta.RemoveStatusCondition(&s.Status.Conditions, SyndesisConditionPodSecurityCompatible)
+		}
+	} else if len(components) > 0 {
+		s.setCondition(SyndesisConditionPodSecurityCompatible, metav1.ConditionFalse, "IncompatibleComponents", "Components not complying with the "+level+" pod security standard enforced in the namespace: "+strings.Join(components, ", "))
+	} else {
+		s.setCondition(SyndesisConditionPodSecurityCompatible, metav1.ConditionTrue, "Compliant", "")
+	}
+}
+
+// Records the resources whose fields set by other actors were taken over by
+// the last install run, none meaning that there was no conflict. The condition
+// is only reported once there has been one.
+func (s *Syndesis) SetConflicted(resources []string) {
+	if len(resources) > 0 {
+		s.setCondition(SyndesisConditionConflicted, metav1.ConditionTrue, "FieldsTakenOver", "Fields set by other actors taken over on: "+strings.Join(resources, ", "))
+	} else if meta.FindStatusCondition(s.Status.Conditions, SyndesisConditionConflicted) != nil {
+		s.setCondition(SyndesisConditionConflicted, metav1.ConditionFalse, conditionReasonAsExpected, "")
+	}
+}
+
+// Whether drift detection is turned on by its annotation
+func (s *Syndesis) IsDriftDetection() bool {
+	return s.Annotations[DriftDetectionAnnotation] == "true"
+}
+
+// Whether the pruning of the resources is a dry run, by its annotation
+func (s *Syndesis) IsPruneDryRun() bool {
+	return s.Annotations[PruneDryRunAnnotation] == "true"
+}
+
+// Whether the reconciliation of the resource is paused by its annotation
+func (s *Syndesis) IsReconcilePaused() bool {
+	return s.Annotations[ReconcilePausedAnnotation] == "true"
+}
+
+// Records that the current generation of the resource has been applied.
+// The Ready condition only refers to this generation once the components
+// have rolled it out, see SetReady.
+func (s *Syndesis) SetObservedGeneration() {
+	s.Status.ObservedGeneration = s.Generation
+}
+
+// Whether the condition of the given type is present and true
+func (s *Syndesis) IsConditionTrue(conditionType string) bool {
+	return meta.IsStatusConditionTrue(s.Status.Conditions, conditionType)
+}
+
+func (s *Syndesis) setCondition(conditionType string, status metav1.ConditionStatus, reason string, message string) {
+	meta.SetStatusCondition(&s.Status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		ObservedGeneration: s.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// Derives the legacy phase, and the Upgradeable condition, from the conditions
+func (s *Syndesis) updatePhase(reason SyndesisStatusReason, description string) {
+	s.Status.Phase = PhaseFromConditions(s.Status.Conditions)
+	s.Status.Reason = reason
+	s.Status.Description = description
+
+	switch s.Status.Phase {
+	case SyndesisPhaseUpgrading, SyndesisPhasePostUpgradeRun, SyndesisPhasePostUpgradeRunSucceed, SyndesisPhaseUpgradeFailureBackoff:
+		s.setCondition(SyndesisConditionUpgradeable, metav1.ConditionFalse, conditionReasonUpgradeInProgress, description)
+	case SyndesisPhaseUpgradeFailed:
+		s.setCondition(SyndesisConditionUpgradeable, metav1.ConditionFalse, string(reason), description)
+	default:
+		s.setCondition(SyndesisConditionUpgradeable, metav1.ConditionTrue, conditionReasonAsExpected, "")
+	}
+}
+
+// Returns the phase corresponding to the given conditions.
+// A degraded installation reports the phase matching the reason of the failure,
+// a progressing one the phase it's moving towards and a ready one is installed.
+func PhaseFromConditions(conditions []metav1.Condition) SyndesisPhase {
+	if degraded := meta.FindStatusCondition(conditions, SyndesisConditionDegraded); degraded != nil && degraded.Status == metav1.ConditionTrue {
+		if phase, ok := degradedPhases[SyndesisStatusReason(degraded.Reason)]; ok {
+			return phase
+		}
+	}
+
+	if progressing := meta.FindStatusCondition(conditions, SyndesisConditionProgressing); progressing != nil && progressing.Status == metav1.ConditionTrue {
+		return SyndesisPhase(progressing.Reason)
+	}
+
+	if meta.IsStatusConditionTrue(conditions, SyndesisConditionReady) {
+		return SyndesisPhaseInstalled
+	}
+
+	return SyndesisPhaseMissing
+}
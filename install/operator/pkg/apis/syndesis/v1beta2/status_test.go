@@ -0,0 +1,178 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta2
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_PhaseDerivedFromConditions(t *testing.T) {
+	syndesis := &Syndesis{ObjectMeta: metav1.ObjectMeta{Generation: 3}}
+
+	syndesis.SetProgressing(SyndesisPhaseInstalling, SyndesisStatusReasonMissing, "")
+	assert.Equal(t, SyndesisPhaseInstalling, syndesis.Status.Phase)
+	assert.False(t, syndesis.IsConditionTrue(SyndesisConditionReady))
+	assert.True(t, syndesis.IsConditionTrue(SyndesisConditionProgressing))
+
+	syndesis.SetReady("")
+	assert.Equal(t, SyndesisPhaseInstalled, syndesis.Status.Phase)
+	assert.True(t, syndesis.IsConditionTrue(SyndesisConditionReady))
+	assert.False(t, syndesis.IsConditionTrue(SyndesisConditionProgressing))
+	assert.True(t, syndesis.IsConditionTrue(SyndesisConditionUpgradeable))
+
+	ready := meta.FindStatusCondition(syndesis.Status.Conditions, SyndesisConditionReady)
+	assert.Equal(t, int64(3), ready.ObservedGeneration)
+
+	syndesis.SetProgressing(SyndesisPhaseUpgrading, SyndesisStatusReasonMissing, "Upgrading from 1.11 to 1.12")
+	assert.Equal(t, SyndesisPhaseUpgrading, syndesis.Status.Phase)
+	assert.Equal(t, "Upgrading from 1.11 to 1.12", syndesis.Status.Description)
+	assert.False(t, syndesis.IsConditionTrue(SyndesisConditionUpgradeable))
+
+	syndesis.SetDegraded(SyndesisStatusReasonUpgradeFailed, "failed")
+	assert.Equal(t, SyndesisPhaseUpgradeFailureBackoff, syndesis.Status.Phase)
+	assert.Equal(t, SyndesisStatusReasonUpgradeFailed, syndesis.Status.Reason)
+	assert.True(t, syndesis.IsConditionTrue(SyndesisConditionDegraded))
+	assert.True(t, syndesis.IsConditionTrue(SyndesisConditionProgressing))
+
+	syndesis.SetDegraded(SyndesisStatusReasonTooManyUpgradeAttempts, "failed")
+	assert.Equal(t, SyndesisPhaseUpgradeFailed, syndesis.Status.Phase)
+	assert.False(t, syndesis.IsConditionTrue(SyndesisConditionProgressing))
+	assert.False(t, syndesis.IsConditionTrue(SyndesisConditionUpgradeable))
+}
+
+func Test_SetObservedGeneration(t *testing.T) {
+	syndesis := &Syndesis{ObjectMeta: metav1.ObjectMeta{Generation: 1}}
+	syndesis.SetReady("")
+
+	syndesis.Generation = 2
+	syndesis.SetObservedGeneration()
+
+	assert.Equal(t, int64(2), syndesis.Status.ObservedGeneration)
+	ready := meta.FindStatusCondition(syndesis.Status.Conditions, SyndesisConditionReady)
+	assert.Equal(t, int64(1), ready.ObservedGeneration)
+
+	// Ready refers to the new generation once it has been rolled out
+	syndesis.SetProgressing(SyndesisPhaseStarting, SyndesisStatusReasonMissing, "")
+	assert.False(t, syndesis.IsConditionTrue(SyndesisConditionReady))
+	syndesis.SetReady("")
+	ready = meta.FindStatusCondition(syndesis.Status.Conditions, SyndesisConditionReady)
+	assert.Equal(t, int64(2), ready.ObservedGeneration)
+}
+
+func Test_SetBackupHealthy(t *testing.T) {
+	syndesis := &Syndesis{}
+
+	syndesis.SetBackupHealthy(errors.New("upload failed"))
+	backup := meta.FindStatusCondition(syndesis.Status.Conditions, SyndesisConditionBackupHealthy)
+	assert.Equal(t, metav1.ConditionFalse, backup.Status)
+	assert.Equal(t, "upload failed", backup.Message)
+
+	syndesis.SetBackupHealthy(nil)
+	assert.True(t, syndesis.IsConditionTrue(SyndesisConditionBackupHealthy))
+
+	syndesis.RemoveBackupHealthy()
+	assert.Nil(t, meta.FindStatusCondition(syndesis.Status.Conditions, SyndesisConditionBackupHealthy))
+}
+
+func Test_SetPaused(t *testing.T) {
+	syndesis := &Syndesis{}
+
+	syndesis.SetPaused(false)
+	assert.Nil(t, meta.FindStatusCondition(syndesis.Status.Conditions, SyndesisConditionPaused))
+
+	syndesis.Annotations = map[string]string{ReconcilePausedAnnotation: "true"}
+	syndesis.SetPaused(syndesis.IsReconcilePaused())
+	assert.True(t, syndesis.IsConditionTrue(SyndesisConditionPaused))
+
+	syndesis.Annotations[ReconcilePausedAnnotation] = "false"
+	syndesis.SetPaused(syndesis.IsReconcilePaused())
+	paused := meta.FindStatusCondition(syndesis.Status.Conditions, SyndesisConditionPaused)
+	assert.Equal(t, metav1.ConditionFalse, paused.Status)
+}
+
+func Test_SetDrifted(t *testing.T) {
+	syndesis := &Syndesis{}
+
+	syndesis.SetDrifted([]string{"ConfigMap/syndesis-server-config", "Service/syndesis-ui"})
+	drifted := meta.FindStatusCondition(syndesis.Status.Conditions, SyndesisConditionDrifted)
+	assert.Equal(t, metav1.ConditionTrue, drifted.Status)
+	assert.Contains(t, drifted.Message, "ConfigMap/syndesis-server-config, Service/syndesis-ui")
+
+	syndesis.SetDrifted(nil)
+	assert.False(t, syndesis.IsConditionTrue(SyndesisConditionDrifted))
+
+	syndesis.RemoveDrifted()
+	assert.Nil(t, meta.FindStatusCondition(syndesis.Status.Conditions, SyndesisConditionDrifted))
+}
+
+func Test_SetOverridden(t *testing.T) {
+	syndesis := &Syndesis{}
+
+	syndesis.SetOverridden(nil)
+	assert.Nil(t, meta.FindStatusCondition(syndesis.Status.Conditions, SyndesisConditionOverridden))
+
+	syndesis.Spec.Overrides = []ResourceOverride{{APIVersion: "v1", Kind: "Service", Name: "syndesis-ui", Patch: "{}"}}
+	syndesis.SetOverridden([]string{"v1/Service/syndesis-ui: not rendered"})
+	overridden := meta.FindStatusCondition(syndesis.Status.Conditions, SyndesisConditionOverridden)
+	assert.Equal(t, metav1.ConditionFalse, overridden.Status)
+	assert.Contains(t, overridden.Message, "v1/Service/syndesis-ui: not rendered")
+
+	syndesis.SetOverridden(nil)
+	assert.True(t, syndesis.IsConditionTrue(SyndesisConditionOverridden))
+
+	syndesis.Spec.Overrides = nil
+	syndesis.SetOverridden(nil)
+	assert.Nil(t, meta.FindStatusCondition(syndesis.Status.Conditions, SyndesisConditionOverridden))
+}
+
+func Test_SetPodSecurityCompatible(t *testing.T) {
+	syndesis := &Syndesis{}
+
+	syndesis.SetPodSecurityCompatible("", nil)
+	assert.Nil(t, meta.FindStatusCondition(syndesis.Status.Conditions, SyndesisConditionPodSecurityCompatible))
+
+	syndesis.SetPodSecurityCompatible("restricted", []string{"syndesis-db"})
+	compatible := meta.FindStatusCondition(syndesis.Status.Conditions, SyndesisConditionPodSecurityCompatible)
+	assert.Equal(t, metav1.ConditionFalse, compatible.Status)
+	assert.Contains(t, compatible.Message, "syndesis-db")
+
+	syndesis.SetPodSecurityCompatible("restricted", nil)
+	assert.True(t, syndesis.IsConditionTrue(SyndesisConditionPodSecurityCompatible))
+
+	syndesis.SetPodSecurityCompatible("", nil)
+	assert.Nil(t, meta.FindStatusCondition(syndesis.Status.Conditions, SyndesisConditionPodSecurityCompatible))
+}
+
+func Test_SetConflicted(t *testing.T) {
+	syndesis := &Syndesis{}
+
+	syndesis.SetConflicted(nil)
+	assert.Nil(t, meta.FindStatusCondition(syndesis.Status.Conditions, SyndesisConditionConflicted))
+
+	syndesis.SetConflicted([]string{"Deployment syndesis-server (kubectl-edit)"})
+	conflicted := meta.FindStatusCondition(syndesis.Status.Conditions, SyndesisConditionConflicted)
+	assert.Equal(t, metav1.ConditionTrue, conflicted.Status)
+	assert.Contains(t, conflicted.Message, "syndesis-server")
+
+	syndesis.SetConflicted(nil)
+	assert.Equal(t, metav1.ConditionFalse, meta.FindStatusCondition(syndesis.Status.Conditions, SyndesisConditionConflicted).Status)
+}
@@ -52,6 +52,43 @@ type SyndesisSpec struct {
 	// Configuration of Affinity and Toleration for integrations pods
 	IntegrationScheduling SchedulingSpec `json:"integrationScheduling,omitempty"`
 
+	// Configuration of the scheduling of the pods of the backup, restore and
+	// database migration jobs, merged over infraScheduling
+	JobScheduling JobSchedulingSpec `json:"jobScheduling,omitempty"`
+
+	// What happens to the data of Syndesis when this resource is deleted (defaults to Delete)
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// Time windows restricting when disruptive operations, ie. upgrades and
+	// scheduled backups, may run. Without any they may run at any time.
+	// +optional
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+
+	// Ingress exposing Syndesis on Kubernetes, where routes are not available
+	// +optional
+	Ingress IngressSpec `json:"ingress,omitempty"`
+
+	// Patches applied to the rendered resources before they are installed, to
+	// set what the other fields do not expose
+	// +optional
+	Overrides []ResourceOverride `json:"overrides,omitempty"`
+
+	// Runs the components supporting it with multiple replicas, protected by
+	// pod disruption budgets and spread across zones
+	// +optional
+	HighAvailability HighAvailabilitySpec `json:"highAvailability,omitempty"`
+
+	// Restricts the traffic to the pods of the components and addons enabled
+	// to the connections they expect, for namespaces denying all by default
+	// +optional
+	NetworkPolicies NetworkPoliciesSpec `json:"networkPolicies,omitempty"`
+
+	// ConfigMap of additional certificate authorities trusted by the server,
+	// meta, the integration builds and the integrations
+	// +optional
+	TrustedCABundle TrustedCABundleSpec `json:"trustedCABundle,omitempty"`
+
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
 	// Important: Run "operator-sdk generate k8s" to regenerate code after modifying this file
 	// Add custom validation using kubebuilder tags: https://book.kubebuilder.io/beyond_basics/generating_crd.html
@@ -70,6 +107,20 @@ type SyndesisStatus struct {
 	TargetVersion      string               `json:"targetVersion,omitempty"`
 	Backup             BackupStatus         `json:"backup,omitempty"`
 
+	// The generation of the syndesis resource last processed by the operator
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Standard conditions (Ready, Progressing, Degraded, Upgradeable, BackupHealthy, Paused,
+	// Drifted, Overridden, PodSecurityCompatible) describing the state of the installation
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Deployment state of each component deployed by the operator, keyed by component name
+	// +optional
+	Components map[string]ComponentStatus `json:"components,omitempty"`
+
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
 	// Important: Run "operator-sdk generate k8s" to regenerate code after modifying this file
 	// Add custom validation using kubebuilder tags: https://book.kubebuilder.io/beyond_basics/generating_crd.html
@@ -82,6 +133,7 @@ type ComponentsSpec struct {
 	Oauth      OauthConfiguration      `json:"oauth,omitempty"`
 	Server     ServerConfiguration     `json:"server,omitempty"`
 	Meta       MetaConfiguration       `json:"meta,omitempty"`
+	UI         UIConfiguration         `json:"ui,omitempty"`
 	Database   DatabaseConfiguration   `json:"database,omitempty"`
 	Prometheus PrometheusConfiguration `json:"prometheus,omitempty"`
 	Grafana    GrafanaConfiguration    `json:"grafana,omitempty"`
@@ -97,6 +149,90 @@ type BackupConfig struct {
 	Schedule BackupSchedule `json:"schedule,omitempty"`
 }
 
+// +kubebuilder:validation:Enum=Delete;RetainData;BackupThenDelete
+type DeletionPolicy string
+
+const (
+	// Everything is removed, including the persistent volume claims
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+	// The persistent volume claims of the database, meta and prometheus are kept
+	DeletionPolicyRetainData DeletionPolicy = "RetainData"
+	// A last backup is taken before everything is removed
+	DeletionPolicyBackupThenDelete DeletionPolicy = "BackupThenDelete"
+)
+
+type MaintenanceWindow struct {
+	// Standard cron expression, evaluated in UTC, for the opening of the window
+	Schedule string `json:"schedule"`
+	// How long the window stays open, eg. 4h
+	Duration metav1.Duration `json:"duration"`
+}
+
+type IngressSpec struct {
+	// Class of the ingress controller exposing Syndesis, the default one if not set
+	ClassName string `json:"className,omitempty"`
+	// Name of the secret holding the TLS certificate of the route hostname
+	TLSSecret string `json:"tlsSecret,omitempty"`
+}
+
+// The ingress of the pods exposed by routes or ingresses, the oauth-proxies,
+// the todo example and the integrations, is left open to the routers.
+type NetworkPoliciesSpec struct {
+	// Enable the network policies
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+// Without a ConfigMap name, a ConfigMap of the namespace labelled
+// config.openshift.io/inject-trusted-cabundle, into which OpenShift injects
+// the trusted bundle of the cluster, is used when one is found.
+type TrustedCABundleSpec struct {
+	// Name of the ConfigMap holding the PEM encoded certificates
+	ConfigMapName string `json:"configMapName,omitempty"`
+	// Key of the certificates in the ConfigMap, ca-bundle.crt by default
+	Key string `json:"key,omitempty"`
+}
+
+// The ui and oauth-proxy run with multiple replicas in high-availability mode,
+// along with meta when its volume is ReadWriteMany. The server keeps a single
+// replica, its integration controllers not being meant to run concurrently,
+// unless scaled by components.server.autoscaling.
+type HighAvailabilitySpec struct {
+	// Enable high-availability mode
+	Enabled bool `json:"enabled,omitempty"`
+	// Number of replicas of the components (defaults to 2)
+	// +kubebuilder:validation:Minimum=2
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+	// Topology key the replicas are spread across, besides the nodes (defaults
+	// to topology.kubernetes.io/zone)
+	// +optional
+	TopologyKey string `json:"topologyKey,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=strategic;json
+type PatchType string
+
+const (
+	// Strategic merge patch, a JSON merge patch for the custom resources
+	PatchTypeStrategicMerge PatchType = "strategic"
+	// JSON patch, as defined by RFC 6902
+	PatchTypeJSON PatchType = "json"
+)
+
+type ResourceOverride struct {
+	// API version of the rendered resource patched
+	APIVersion string `json:"apiVersion"`
+	// Kind of the rendered resource patched
+	Kind string `json:"kind"`
+	// Name of the rendered resource patched
+	Name string `json:"name"`
+	// Type of the patch (defaults to strategic)
+	// +optional
+	Type PatchType `json:"type,omitempty"`
+	// The patch, in YAML or JSON
+	Patch string `json:"patch"`
+}
+
 type BackupStatus struct {
 	// When is the next backup planned
 	Next string `json:"next,omitempty"`
@@ -104,6 +240,22 @@ type BackupStatus struct {
 	Previous string `json:"previous,omitempty"`
 }
 
+// ComponentStatus describes the deployment state of a single component
+type ComponentStatus struct {
+	// Whether all the desired replicas of the component are ready
+	Ready bool `json:"ready"`
+	// Number of replicas requested for the component
+	DesiredReplicas int32 `json:"desiredReplicas"`
+	// Number of replicas of the component that are ready
+	ReadyReplicas int32 `json:"readyReplicas"`
+	// The image the component is running
+	Image string `json:"image,omitempty"`
+	// When the component last became ready or not ready
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+	// Why the component failed to deploy, if it did
+	Message string `json:"message,omitempty"`
+}
+
 type OauthConfiguration struct {
 	// Enable or disable SAR checks all together
 	DisableSarCheck bool `json:"disableSarCheck,omitempty"`
@@ -120,6 +272,19 @@ type OauthConfiguration struct {
 
 	// Environment variables to be applied to dc/syndesis-oauthproxy
 	Environment map[string]string `json:"environment,omitempty"`
+
+	// On Kubernetes, the OpenID Connect issuer authenticating the users. The
+	// client id and secret are read from the OAUTH2_PROXY_CLIENT_ID and
+	// OAUTH2_PROXY_CLIENT_SECRET keys of the CredentialsSecret
+	OidcIssuerURL string `json:"oidcIssuerUrl,omitempty"`
+
+	// Overrides applied to the pods of dc/syndesis-oauthproxy
+	PodOverrides PodOverrides `json:"podOverrides,omitempty"`
+	// Scheduling of the pods of dc/syndesis-oauthproxy, merged over infraScheduling
+	Scheduling ComponentSchedulingSpec `json:"scheduling,omitempty"`
+	// Leave the pods of dc/syndesis-oauthproxy out of the restricted pod security standard,
+	// for images that cannot run as a non-root user
+	DisableRestrictedPodSecurity bool `json:"disableRestrictedPodSecurity,omitempty"`
 }
 
 type DatabaseConfiguration struct {
@@ -137,10 +302,25 @@ type DatabaseConfiguration struct {
 
 	// Resource provision requirements of the database
 	Resources ResourcesWithPersistentVolume `json:"resources,omitempty"`
+
+	// Overrides applied to the pods of dc/syndesis-db
+	PodOverrides PodOverrides `json:"podOverrides,omitempty"`
+	// Scheduling of the pods of dc/syndesis-db, merged over infraScheduling
+	Scheduling ComponentSchedulingSpec `json:"scheduling,omitempty"`
+	// Leave the pods of dc/syndesis-db out of the restricted pod security standard,
+	// for images that cannot run as a non-root user
+	DisableRestrictedPodSecurity bool `json:"disableRestrictedPodSecurity,omitempty"`
 }
 
 type PrometheusConfiguration struct {
 	Resources ResourcesWithPersistentVolume `json:"resources,omitempty"`
+	// Overrides applied to the pods of dc/syndesis-prometheus
+	PodOverrides PodOverrides `json:"podOverrides,omitempty"`
+	// Scheduling of the pods of dc/syndesis-prometheus, merged over infraScheduling
+	Scheduling ComponentSchedulingSpec `json:"scheduling,omitempty"`
+	// Leave the pods of dc/syndesis-prometheus out of the restricted pod security standard,
+	// for images that cannot run as a non-root user
+	DisableRestrictedPodSecurity bool `json:"disableRestrictedPodSecurity,omitempty"`
 }
 
 type GrafanaConfiguration struct {
@@ -153,12 +333,130 @@ type ServerConfiguration struct {
 	ConnectionPool ConnectionPool `json:"connectionPool,omitempty"`
 	// JAVA_OPTIONS environment variable
 	JavaOptions string `json:"javaOptions,omitempty"`
+	// Overrides applied to the pods of dc/syndesis-server
+	PodOverrides PodOverrides `json:"podOverrides,omitempty"`
+	// Scheduling of the pods of dc/syndesis-server, merged over infraScheduling
+	Scheduling ComponentSchedulingSpec `json:"scheduling,omitempty"`
+	// Leave the pods of dc/syndesis-server out of the restricted pod security standard,
+	// for images that cannot run as a non-root user
+	DisableRestrictedPodSecurity bool `json:"disableRestrictedPodSecurity,omitempty"`
+	// Scales dc/syndesis-server with a horizontal pod autoscaler. Every replica
+	// runs the integration controllers, which can then act on an integration
+	// concurrently, eg. build it twice when published, so this is only meant
+	// for loads a single replica cannot serve.
+	Autoscaling AutoscalingSpec `json:"autoscaling,omitempty"`
 }
 
 type MetaConfiguration struct {
 	Resources ResourcesWithPersistentVolume `json:"resources,omitempty"`
 	// JAVA_OPTIONS environment variable
 	JavaOptions string `json:"javaOptions,omitempty"`
+	// Overrides applied to the pods of dc/syndesis-meta
+	PodOverrides PodOverrides `json:"podOverrides,omitempty"`
+	// Scheduling of the pods of dc/syndesis-meta, merged over infraScheduling
+	Scheduling ComponentSchedulingSpec `json:"scheduling,omitempty"`
+	// Leave the pods of dc/syndesis-meta out of the restricted pod security standard,
+	// for images that cannot run as a non-root user
+	DisableRestrictedPodSecurity bool `json:"disableRestrictedPodSecurity,omitempty"`
+	// Scales dc/syndesis-meta with a horizontal pod autoscaler, which requires
+	// its volume to be ReadWriteMany
+	Autoscaling AutoscalingSpec `json:"autoscaling,omitempty"`
+}
+
+// Horizontal pod autoscaler of a component, the operator leaving its number
+// of replicas to the autoscaler. The utilization targets are percentages of
+// the resource requests of the component, the server defaulting to a CPU
+// target and meta to a memory one.
+type AutoscalingSpec struct {
+	// Enable the autoscaling of the component
+	Enabled bool `json:"enabled,omitempty"`
+	// Lower limit of the number of replicas (defaults to 1)
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinReplicas int32 `json:"minReplicas,omitempty"`
+	// Upper limit of the number of replicas (defaults to 3)
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxReplicas int32 `json:"maxReplicas,omitempty"`
+	// Target average CPU utilization of the replicas
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TargetCPUUtilizationPercentage int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+	// Target average memory utilization of the replicas
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TargetMemoryUtilizationPercentage int32 `json:"targetMemoryUtilizationPercentage,omitempty"`
+}
+
+type UIConfiguration struct {
+	// Overrides applied to the pods of dc/syndesis-ui
+	PodOverrides PodOverrides `json:"podOverrides,omitempty"`
+	// Scheduling of the pods of dc/syndesis-ui, merged over infraScheduling
+	Scheduling ComponentSchedulingSpec `json:"scheduling,omitempty"`
+	// Leave the pods of dc/syndesis-ui out of the restricted pod security standard,
+	// for images that cannot run as a non-root user
+	DisableRestrictedPodSecurity bool `json:"disableRestrictedPodSecurity,omitempty"`
+}
+
+// Overrides of the pods of a component, applied to its rendered deployment.
+// The environment, volume mounts and probe timings are applied to the main
+// container of the pods
+type PodOverrides struct {
+	// Environment variables added to the container, replacing the ones of the same name
+	Env []v1.EnvVar `json:"env,omitempty"`
+
+	// Sources of environment variables added to the container
+	EnvFrom []v1.EnvFromSource `json:"envFrom,omitempty"`
+
+	// Labels added to the pods
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations added to the pods
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Number of replicas of the deployment
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Volumes added to the pods
+	Volumes []PodVolume `json:"volumes,omitempty"`
+
+	// Volume mounts added to the container
+	VolumeMounts []v1.VolumeMount `json:"volumeMounts,omitempty"`
+
+	// Timings of the liveness probe of the container
+	LivenessProbe *ProbeTimings `json:"livenessProbe,omitempty"`
+
+	// Timings of the readiness probe of the container
+	ReadinessProbe *ProbeTimings `json:"readinessProbe,omitempty"`
+}
+
+// A volume added to the pods, from one of the sources supported by the
+// overrides. Only these sources are part of the schema, the whole volume
+// schema being repeated for every component otherwise.
+type PodVolume struct {
+	// Name of the volume, referenced by the volume mounts
+	Name string `json:"name"`
+
+	// Config map populating the volume
+	ConfigMap *v1.ConfigMapVolumeSource `json:"configMap,omitempty"`
+
+	// Secret populating the volume
+	Secret *v1.SecretVolumeSource `json:"secret,omitempty"`
+
+	// Persistent volume claim mounted as the volume
+	PersistentVolumeClaim *v1.PersistentVolumeClaimVolumeSource `json:"persistentVolumeClaim,omitempty"`
+
+	// Empty directory sharing the lifetime of the pod
+	EmptyDir *v1.EmptyDirVolumeSource `json:"emptyDir,omitempty"`
+}
+
+// Timings of a probe, the ones left to zero keeping their rendered value
+type ProbeTimings struct {
+	InitialDelaySeconds int32 `json:"initialDelaySeconds,omitempty"`
+	TimeoutSeconds      int32 `json:"timeoutSeconds,omitempty"`
+	PeriodSeconds       int32 `json:"periodSeconds,omitempty"`
+	SuccessThreshold    int32 `json:"successThreshold,omitempty"`
+	FailureThreshold    int32 `json:"failureThreshold,omitempty"`
 }
 
 type UpgradeConfiguration struct {
@@ -245,6 +543,44 @@ type SchedulingSpec struct {
 	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
 }
 
+// Scheduling of the pods of a component. The types of affinity set replace
+// those of infraScheduling and the tolerations are added to its tolerations.
+type ComponentSchedulingSpec struct {
+	Affinity    *Affinity       `json:"affinity,omitempty"`
+	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+	// Labels of the nodes the pods are scheduled on
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Priority class of the pods
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+	// How the pods are spread across the topology domains, replacing
+	// the constraints of the high-availability mode
+	TopologySpreadConstraints []TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+}
+
+// Affinity of the pods, as in a pod spec. Its schema is left out of the CRD,
+// where repeated for each component it would make the CRD too large to be
+// applied.
+// +kubebuilder:validation:Type=object
+// +kubebuilder:validation:XPreserveUnknownFields
+type Affinity struct {
+	v1.Affinity `json:"-"`
+}
+
+// Topology spread constraint of the pods, as in a pod spec. Its schema is left
+// out of the CRD as the one of the affinity.
+// +kubebuilder:validation:Type=object
+// +kubebuilder:validation:XPreserveUnknownFields
+type TopologySpreadConstraint struct {
+	v1.TopologySpreadConstraint `json:"-"`
+}
+
+// Scheduling of the pods of the jobs spawned by the operator
+type JobSchedulingSpec struct {
+	ComponentSchedulingSpec `json:",inline"`
+	// Resources of the containers of the jobs
+	Resources v1.ResourceRequirements `json:"resources,omitempty"`
+}
+
 type AddonsSpec struct {
 	Jaeger    JaegerConfiguration    `json:"jaeger,omitempty"`
 	Ops       AddonSpec              `json:"ops,omitempty"`
@@ -317,6 +653,34 @@ const (
 	SyndesisStatusReasonMigrated               SyndesisStatusReason = "Migrated"
 )
 
+// Condition types reported in the status of a Syndesis resource
+const (
+	SyndesisConditionReady         = "Ready"
+	SyndesisConditionProgressing   = "Progressing"
+	SyndesisConditionDegraded      = "Degraded"
+	SyndesisConditionUpgradeable   = "Upgradeable"
+	SyndesisConditionBackupHealthy = "BackupHealthy"
+	SyndesisConditionPaused        = "Paused"
+	SyndesisConditionDrifted       = "Drifted"
+	SyndesisConditionOverridden    = "Overridden"
+	// Whether the pods comply with the pod security standard enforced in the namespace
+	SyndesisConditionPodSecurityCompatible = "PodSecurityCompatible"
+	// Whether fields of the resources set by other actors were taken over
+	SyndesisConditionConflicted = "Conflicted"
+)
+
+// Annotation pausing the reconciliation of a Syndesis resource when set to "true"
+const ReconcilePausedAnnotation = "syndesis.io/reconcile-paused"
+
+// Annotation switching an installed Syndesis resource to drift detection when
+// set to "true": the differences between the rendered and live resources are
+// reported, but not reverted
+const DriftDetectionAnnotation = "syndesis.io/drift-detection"
+
+// Annotation making the pruning of the resources no longer rendered a dry run
+// when set to "true": the resources are reported, but not deleted
+const PruneDryRunAnnotation = "syndesis.io/prune-dry-run"
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // Syndesis is the Schema for the Syndeses API
@@ -327,6 +691,7 @@ const (
 // +kubebuilder:resource:path=syndesises,scope=Namespaced
 // +kubebuilder:printcolumn:name="Phase",description="The syndesis phase",type=string,JSONPath=`.status.phase`
 // +kubebuilder:printcolumn:name="Version",description="The syndesis version",type=string,JSONPath=`.status.version`
+// +kubebuilder:printcolumn:name="Ready",description="Whether syndesis is ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +operator-sdk:csv:customresourcedefinitions:displayName="Syndesis"
 // +operator-sdk:csv:customresourcedefinitions:resources={{ServiceAccount,v1},{ClusterRole,rbac.authorization.k8s.io/v1},{Role,rbac.authorization.k8s.io/v1},{Deployment,apps/v1},{Secret,v1},{Subscription,operators.coreos.com/v1alpha1}}
@@ -57,6 +57,11 @@ func schema_pkg_apis_syndesis_v1beta2_ComponentsSpec(ref common.ReferenceCallbac
 							Ref: ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.MetaConfiguration"),
 						},
 					},
+					"ui": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.UIConfiguration"),
+						},
+					},
 					"database": {
 						SchemaProps: spec.SchemaProps{
 							Ref: ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.DatabaseConfiguration"),
@@ -81,7 +86,7 @@ func schema_pkg_apis_syndesis_v1beta2_ComponentsSpec(ref common.ReferenceCallbac
 			},
 		},
 		Dependencies: []string{
-			"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.DatabaseConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.GrafanaConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.MetaConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.OauthConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.PrometheusConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.ServerConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.UpgradeConfiguration"},
+			"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.DatabaseConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.GrafanaConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.MetaConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.OauthConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.PrometheusConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.ServerConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.UIConfiguration", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.UpgradeConfiguration"},
 	}
 }
 
@@ -187,11 +192,74 @@ func schema_pkg_apis_syndesis_v1beta2_SyndesisSpec(ref common.ReferenceCallback)
 							Ref:         ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.SchedulingSpec"),
 						},
 					},
+					"jobScheduling": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configuration of the scheduling of the pods of the backup, restore and database migration jobs, merged over infraScheduling",
+							Ref:         ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.JobSchedulingSpec"),
+						},
+					},
+					"deletionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "What happens to the data of Syndesis when this resource is deleted (defaults to Delete)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maintenanceWindows": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time windows restricting when disruptive operations, ie. upgrades and scheduled backups, may run. Without any they may run at any time.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.MaintenanceWindow"),
+									},
+								},
+							},
+						},
+					},
+					"ingress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ingress exposing Syndesis on Kubernetes, where routes are not available",
+							Ref:         ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.IngressSpec"),
+						},
+					},
+					"overrides": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Patches applied to the rendered resources before they are installed, to set what the other fields do not expose",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.ResourceOverride"),
+									},
+								},
+							},
+						},
+					},
+					"highAvailability": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Runs the components supporting it with multiple replicas, protected by pod disruption budgets and spread across zones",
+							Ref:         ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.HighAvailabilitySpec"),
+						},
+					},
+					"networkPolicies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Restricts the traffic to the pods of the components and addons enabled to the connections they expect, for namespaces denying all by default",
+							Ref:         ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.NetworkPoliciesSpec"),
+						},
+					},
+					"trustedCABundle": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMap of additional certificate authorities trusted by the server, meta, the integration builds and the integrations",
+							Ref:         ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.TrustedCABundleSpec"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.AddonsSpec", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.BackupConfig", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.ComponentsSpec", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.SchedulingSpec"},
+			"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.AddonsSpec", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.BackupConfig", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.ComponentsSpec", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.HighAvailabilitySpec", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.IngressSpec", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.JobSchedulingSpec", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.MaintenanceWindow", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.NetworkPoliciesSpec", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.ResourceOverride", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.SchedulingSpec", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.TrustedCABundleSpec"},
 	}
 }
 
@@ -254,10 +322,52 @@ func schema_pkg_apis_syndesis_v1beta2_SyndesisStatus(ref common.ReferenceCallbac
 							Ref: ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.BackupStatus"),
 						},
 					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The generation of the syndesis resource last processed by the operator",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard conditions (Ready, Progressing, Degraded, Upgradeable, BackupHealthy, Paused, Drifted, Overridden, PodSecurityCompatible) describing the state of the installation",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"components": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deployment state of each component deployed by the operator, keyed by component name",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.ComponentStatus"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.BackupStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.BackupStatus", "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2.ComponentStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
@@ -0,0 +1,95 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package diff
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/operator-framework/operator-sdk/pkg/log/zap"
+	"github.com/spf13/cobra"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal"
+	"github.com/syndesisio/syndesis/install/operator/pkg/generator"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/action"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+)
+
+type Diff struct {
+	*internal.Options
+	templatesDir string
+	out          io.Writer
+}
+
+func New(parent *internal.Options) *cobra.Command {
+	o := Diff{Options: parent}
+	cmd := cobra.Command{
+		Use:   "diff",
+		Short: "show the differences between the rendered and the live resources of the syndesis install",
+		Long: `show the differences between the resources rendered for the syndesis install and those on the cluster, without changing them.
+Exits with status 1 when some resources differ.`,
+		Run: func(c *cobra.Command, _ []string) {
+			o.out = c.OutOrStdout()
+			util.ExitOnError(o.Run())
+		},
+	}
+	cmd.PersistentFlags().StringVarP(&configuration.TemplateConfig, "operator-config", "", "/conf/config.yaml", "Path to the operator configuration file.")
+	cmd.PersistentFlags().StringVarP(&o.templatesDir, "templates-dir", "", "/conf/templates", "Path to the directory of the templates replacing or adding to the infrastructure, addons and database ones.")
+	cmd.PersistentFlags().AddFlagSet(zap.FlagSet())
+	cmd.PersistentFlags().AddFlagSet(util.FlagSet)
+	return &cmd
+}
+
+func (o *Diff) Run() error {
+	// Rendering the resources the way the operator does
+	if err := generator.UseTemplateOverrides(o.templatesDir); err != nil {
+		return err
+	}
+
+	if err := apis.AddToScheme(o.ClientTools().GetScheme()); err != nil {
+		return err
+	}
+
+	cl, err := o.ClientTools().RuntimeClient()
+	if err != nil {
+		return err
+	}
+
+	syndesis, err := v1beta2.InstalledSyndesis(o.Context, cl, o.Namespace)
+	if err != nil {
+		return err
+	}
+	if syndesis == nil {
+		return errors.New("No syndesis has been installed to compare its resources")
+	}
+
+	drifts, err := action.DetectDrift(o.Context, o.ClientTools(), syndesis)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range drifts {
+		fmt.Fprintf(o.out, "--- %s\n%s\n", d, d.Diff)
+	}
+	if len(drifts) > 0 {
+		return fmt.Errorf("%d resources differ from their rendered configuration", len(drifts))
+	}
+	return nil
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal"
+	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal/install"
 	"github.com/syndesisio/syndesis/install/operator/pkg/generator"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/capabilities"
 	"github.com/syndesisio/syndesis/install/operator/pkg/util"
@@ -50,7 +51,7 @@ func New(parent *internal.Options) *cobra.Command {
 		},
 	}
 
-	cmd.PersistentFlags().BoolVarP(&o.cluster, "cluster", "", false, "add the permission for all projects in the cluster(requires cluster admin privileges)")
+	cmd.PersistentFlags().BoolVarP(&o.cluster, "cluster", "", false, "add the permission for all projects in the cluster, for the user and the operator to run with --all-namespaces (requires cluster admin privileges)")
 	cmd.PersistentFlags().StringVarP(&o.User, "user", "u", "", "add permissions for the given User")
 	cmd.PersistentFlags().AddFlagSet(zap.FlagSet())
 	cmd.PersistentFlags().AddFlagSet(util.FlagSet)
@@ -85,6 +86,23 @@ func (o *Grant) grant() error {
 	}
 	resources = append(resources, gr...)
 
+	if o.cluster {
+		// The operator gets the same permissions in all namespaces, to run with --all-namespaces
+		operator := *o
+		operator.Role = install.RoleName
+		opr, err := generator.Render("./install/role.yml.tmpl", operator)
+		if err != nil {
+			return err
+		}
+		resources = append(resources, opr...)
+	}
+
+	grop, err := generator.Render("./install/grant/grant_cluster_role_operator.yml.tmpl", o)
+	if err != nil {
+		return err
+	}
+	resources = append(resources, grop...)
+
 	// Allow syndesis-server user to lookup kafka customresources at cluster level
 	kafka, err := generator.Render("./install/cluster_role_kafka.yml.tmpl", o)
 	if err != nil {
@@ -146,7 +164,7 @@ func (o *Grant) grant() error {
 	for _, res := range resources {
 		res.SetNamespace(o.Namespace)
 
-		_, _, err := util.CreateOrUpdate(o.Context, client, &res)
+		_, _, _, err := util.Apply(o.Context, client, &res)
 		if err != nil {
 			return errors.Wrap(err, util.Dump(res))
 		}
@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal"
+	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal/install"
 	"github.com/syndesisio/syndesis/install/operator/pkg/generator"
 	syntesting "github.com/syndesisio/syndesis/install/operator/pkg/syndesis/testing"
 	v1 "k8s.io/api/rbac/v1"
@@ -109,3 +110,36 @@ func TestGrant(t *testing.T) {
 		}
 	}
 }
+
+// test grant with --cluster options
+func TestGrantCluster(t *testing.T) {
+	ctx := context.TODO()
+	g := &Grant{
+		Role:    RoleName,
+		User:    user,
+		cluster: true,
+		Options: &internal.Options{
+			Namespace: ns,
+			Context:   ctx,
+		},
+	}
+
+	g.SetClientTools(syntesting.FakeClientTools())
+	cl, err := g.ClientTools().RuntimeClient()
+	require.NoError(t, err)
+
+	require.NoError(t, g.grant())
+
+	// Unlike the api server, the fake client keeps the namespace set on cluster resources
+	for _, name := range []string{RoleName, install.RoleName} {
+		r := &v1.ClusterRole{}
+		assert.NoError(t, cl.Get(ctx, client.ObjectKey{Name: name, Namespace: ns}, r), "cluster role %s", name)
+	}
+
+	crb := &v1.ClusterRoleBinding{}
+	require.NoError(t, cl.Get(ctx, client.ObjectKey{Name: "syndesis-operator-" + ns, Namespace: ns}, crb))
+	assert.Equal(t, install.RoleName, crb.RoleRef.Name)
+	require.Len(t, crb.Subjects, 1)
+	assert.Equal(t, "syndesis-operator", crb.Subjects[0].Name)
+	assert.Equal(t, ns, crb.Subjects[0].Namespace)
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/syndesisio/syndesis/install/operator/pkg/generator"
 	"github.com/syndesisio/syndesis/install/operator/pkg/util"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/yaml"
 )
@@ -54,6 +55,11 @@ type Install struct {
 	databaseImage  string
 	templateName   string
 
+	// namespaces watched by the operator
+	allNamespaces     bool
+	watchNamespaces   string
+	namespaceSelector string
+
 	// processing state
 	ejectedResources []unstructured.Unstructured
 }
@@ -126,6 +132,9 @@ func New(parent *internal.Options) *cobra.Command {
 	cmd.PersistentFlags().BoolVarP(&o.wait, "wait", "w", false, "waits for the application to be running")
 	cmd.PersistentFlags().BoolVarP(&o.devSupport, "dev", "", false, "enable development mode by loading images from image stream tags.")
 	cmd.PersistentFlags().IntVarP(&o.logLevel, "log-level", "", 0, "specify the level of logging to display, ie. 0 (default) = info, 1 = debug ...")
+	cmd.PersistentFlags().BoolVarP(&o.allNamespaces, "all-namespaces", "", false, "the operator watches the syndesis resources of all namespaces, requires the permissions granted by grant --cluster")
+	cmd.PersistentFlags().StringVarP(&o.watchNamespaces, "watch-namespaces", "", "", "a comma separated list of the namespaces watched by the operator, instead of its own namespace")
+	cmd.PersistentFlags().StringVarP(&o.namespaceSelector, "namespace-selector", "", "", "a label selector restricting the namespaces watched by the operator, with --all-namespaces")
 	cmd.PersistentFlags().StringVarP(&configuration.TemplateConfig, "operator-config", "", "/conf/config.yaml", "Path to the operator configuration file.")
 	cmd.PersistentFlags().AddFlagSet(util.FlagSet)
 	return &cmd
@@ -145,6 +154,18 @@ func (o *Install) before(_ *cobra.Command, args []string) (err error) {
 		return fmt.Errorf("unexpected argument: %s", args[0])
 	}
 
+	if o.allNamespaces && o.watchNamespaces != "" {
+		return fmt.Errorf("--all-namespaces and --watch-namespaces are mutually exclusive")
+	}
+	if o.namespaceSelector != "" {
+		if !o.allNamespaces {
+			return fmt.Errorf("--namespace-selector requires --all-namespaces")
+		}
+		if _, err := labels.Parse(o.namespaceSelector); err != nil {
+			return fmt.Errorf("invalid namespace selector: %v", err)
+		}
+	}
+
 	if o.eject != "" {
 		o.ejectedResources = []unstructured.Unstructured{}
 	}
@@ -211,6 +232,11 @@ type RenderScope struct {
 	Kind          string
 	EnabledAddons []string
 	DatabaseImage string
+	// Namespaces watched by the operator
+	Olm               bool
+	AllNamespaces     bool
+	WatchNamespaces   string
+	NamespaceSelector string
 }
 
 func (o *Install) install(action string, resources []unstructured.Unstructured) error {
@@ -224,7 +250,7 @@ func (o *Install) install(action string, resources []unstructured.Unstructured)
 		if o.ejectedResources != nil {
 			o.ejectedResources = append(o.ejectedResources, res)
 		} else {
-			_, result, err := util.CreateOrUpdate(o.Context, client, &res)
+			_, result, _, err := util.Apply(o.Context, client, &res)
 			if err != nil {
 				return errors.Wrap(err, util.Dump(res))
 			}
@@ -270,6 +296,10 @@ func (o *Install) render(fromFile string) ([]unstructured.Unstructured, error) {
 		Kind:          "Role",
 		EnabledAddons: addons,
 		DatabaseImage: o.databaseImage,
+
+		AllNamespaces:     o.allNamespaces,
+		WatchNamespaces:   o.watchNamespaces,
+		NamespaceSelector: o.namespaceSelector,
 	})
 	return resources, err
 }
@@ -161,6 +161,17 @@ func (o *Install) downgradeApiExtensions(resources []unstructured.Unstructured)
 
 	for _, resource := range resources {
 
+		if resource.GetKind() != "CustomResourceDefinition" {
+			//
+			// The admission webhooks v1 API came along with the extensions v1 API
+			// so the webhook configurations are left out on older clusters
+			//
+			if resource.GroupVersionKind().Group != "admissionregistration.k8s.io" {
+				downgraded = append(downgraded, resource)
+			}
+			continue
+		}
+
 		object, err := util.RuntimeObjectFromUnstructured(scheme, &resource)
 		if err != nil {
 			return downgraded, err
@@ -177,6 +188,17 @@ func (o *Install) downgradeApiExtensions(resources []unstructured.Unstructured)
 		//
 		reverseVersions(v1Crd)
 
+		//
+		// Without the webhooks the older versions cannot be converted so
+		// only the storage version is served
+		//
+		if v1Crd.Spec.Conversion != nil && v1Crd.Spec.Conversion.Strategy == apiextensionsv1.WebhookConverter {
+			v1Crd.Spec.Conversion = nil
+			for i := range v1Crd.Spec.Versions {
+				v1Crd.Spec.Versions[i].Served = v1Crd.Spec.Versions[i].Storage
+			}
+		}
+
 		v1beta1Crd := &apiextensionsv1beta1.CustomResourceDefinition{}
 		crd := &apiextensions.CustomResourceDefinition{}
 
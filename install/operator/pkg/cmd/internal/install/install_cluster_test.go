@@ -23,7 +23,6 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
 	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal"
-	"github.com/syndesisio/syndesis/install/operator/pkg/generator"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
 	syntesting "github.com/syndesisio/syndesis/install/operator/pkg/syndesis/testing"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -86,13 +85,22 @@ func TestDowngradeExtensionsApi(t *testing.T) {
 	configuration, err := configuration.GetProperties(context.TODO(), "../../../../build/conf/config-test.yaml", i.ClientTools(), syndesis)
 	assert.NoError(t, err)
 
-	resources, err := generator.RenderDir("./install/cluster", configuration)
+	i.Namespace = "syndesis"
+	i.apiServer = configuration.ApiServer
+	resources, err := i.render("./install/cluster")
 	assert.NoError(t, err)
 	assert.True(t, len(resources) > 0)
 
+	crds := 0
+	for _, r := range resources {
+		if r.GetKind() == "CustomResourceDefinition" {
+			crds++
+		}
+	}
+
 	newresources, err := i.downgradeApiExtensions(resources)
 	assert.NoError(t, err)
-	assert.Equal(t, len(resources), len(newresources))
+	assert.Equal(t, crds, len(newresources))
 	assert.NotEqual(t, resources, newresources)
 
 	for _, r := range newresources {
@@ -24,6 +24,12 @@ func (o *Install) installOperatorResources() error {
 	}
 	resources = append(resources, deployment...)
 
+	webhook, err := o.render("./install/operator_webhook.yml.tmpl")
+	if err != nil {
+		return err
+	}
+	resources = append(resources, webhook...)
+
 	if o.ejectedResources != nil {
 		o.ejectedResources = append(o.ejectedResources, resources...)
 	} else {
@@ -23,6 +23,7 @@ import (
 	v12 "github.com/openshift/api/image/v1"
 
 	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal"
+	syntesting "github.com/syndesisio/syndesis/install/operator/pkg/syndesis/testing"
 
 	v1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -54,7 +55,7 @@ func TestInstallOperator(t *testing.T) {
 
 	// Create a fake client to mock API calls and pass it to the cmd
 	objs := []runtime.Object{}
-	cl := clfake.NewFakeClient(objs...)
+	cl := syntesting.ApplyClient(clfake.NewFakeClient(objs...))
 	i.ClientTools().SetRuntimeClient(cl)
 
 	scheme := runtime.NewScheme()
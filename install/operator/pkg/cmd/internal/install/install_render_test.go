@@ -25,6 +25,7 @@ import (
 	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal"
 	"github.com/syndesisio/syndesis/install/operator/pkg/generator"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/capabilities"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 func TestInstallResourcesRender(t *testing.T) {
@@ -66,3 +67,170 @@ func TestInstallResourcesRender(t *testing.T) {
 
 	}
 }
+
+func TestOperatorDeploymentWatchNamespacesRender(t *testing.T) {
+	testCases := []struct {
+		name     string
+		install  Install
+		expected map[string]interface{}
+	}{
+		{
+			"Own namespace",
+			Install{},
+			map[string]interface{}{
+				"WATCH_NAMESPACE": map[string]interface{}{"valueFrom": map[string]interface{}{"fieldRef": map[string]interface{}{"fieldPath": "metadata.namespace"}}},
+			},
+		},
+		{
+			"Listed namespaces",
+			Install{watchNamespaces: "tenant-a,tenant-b"},
+			map[string]interface{}{
+				"WATCH_NAMESPACE": map[string]interface{}{"value": "tenant-a,tenant-b"},
+			},
+		},
+		{
+			"All namespaces with a selector",
+			Install{allNamespaces: true, namespaceSelector: "syndesis.io/tenant=true"},
+			map[string]interface{}{
+				"WATCH_NAMESPACE":          map[string]interface{}{"value": ""},
+				"WATCH_NAMESPACE_SELECTOR": map[string]interface{}{"value": "syndesis.io/tenant=true"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			o := tc.install
+			o.Options = &internal.Options{Namespace: "syndesis"}
+			o.image = "syndesis-operator"
+			o.tag = "latest"
+
+			resources, err := o.render("./install/operator_deployment.yml.tmpl")
+			require.NoError(t, err)
+			require.Len(t, resources, 1)
+
+			containers, _, err := unstructured.NestedSlice(resources[0].Object, "spec", "template", "spec", "containers")
+			require.NoError(t, err)
+			env := map[string]interface{}{}
+			for _, e := range containers[0].(map[string]interface{})["env"].([]interface{}) {
+				e := e.(map[string]interface{})
+				if name := e["name"].(string); strings.HasPrefix(name, "WATCH_NAMESPACE") {
+					delete(e, "name")
+					env[name] = e
+				}
+			}
+			assert.Equal(t, tc.expected, env)
+		})
+	}
+}
+
+func TestOperatorDeploymentTemplatesRender(t *testing.T) {
+	o := Install{Options: &internal.Options{Namespace: "syndesis"}, image: "syndesis-operator", tag: "latest"}
+	resources, err := o.render("./install/operator_deployment.yml.tmpl")
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+
+	volumes, _, err := unstructured.NestedSlice(resources[0].Object, "spec", "template", "spec", "volumes")
+	require.NoError(t, err)
+	assert.Contains(t, volumes, map[string]interface{}{
+		"name":      "syndesis-operator-templates",
+		"configMap": map[string]interface{}{"name": "syndesis-operator-templates", "optional": true},
+	})
+
+	containers, _, err := unstructured.NestedSlice(resources[0].Object, "spec", "template", "spec", "containers")
+	require.NoError(t, err)
+	assert.Contains(t, containers[0].(map[string]interface{})["volumeMounts"], map[string]interface{}{
+		"name":      "syndesis-operator-templates",
+		"mountPath": "/conf/templates",
+		"readOnly":  true,
+	})
+}
+
+func TestOperatorWebhookRender(t *testing.T) {
+	testCases := []struct {
+		name          string
+		apiServer     capabilities.ApiServerSpec
+		registeredFor bool
+	}{
+		{"Openshift", capabilities.ApiServerSpec{EmbeddedProvider: true}, true},
+		{"Kubernetes", capabilities.ApiServerSpec{}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			o := Install{
+				Options:   &internal.Options{Namespace: "tenant"},
+				apiServer: tc.apiServer,
+			}
+
+			services, err := o.render("./install/operator_webhook.yml.tmpl")
+			require.NoError(t, err)
+			require.Len(t, services, 1)
+			assert.Equal(t, "Service", services[0].GetKind())
+			assert.Equal(t, "tenant", services[0].GetNamespace())
+
+			configurations, err := o.render("./install/cluster/webhook.yml.tmpl")
+			require.NoError(t, err)
+			if !tc.registeredFor {
+				assert.Empty(t, configurations)
+				return
+			}
+			require.Len(t, configurations, 1)
+			webhooks, _, err := unstructured.NestedSlice(configurations[0].Object, "webhooks")
+			require.NoError(t, err)
+			require.Len(t, webhooks, 1)
+			service, _, err := unstructured.NestedStringMap(webhooks[0].(map[string]interface{}), "clientConfig", "service")
+			require.NoError(t, err)
+			assert.Equal(t, services[0].GetName(), service["name"])
+			assert.Equal(t, "tenant", service["namespace"])
+		})
+	}
+}
+
+func TestSyndesisCRDConversionRender(t *testing.T) {
+	testCases := []struct {
+		name       string
+		apiServer  capabilities.ApiServerSpec
+		conversion map[string]interface{}
+		served     map[string]bool
+	}{
+		{
+			"Openshift",
+			capabilities.ApiServerSpec{EmbeddedProvider: true},
+			map[string]interface{}{"name": "syndesis-operator-webhook", "namespace": "tenant", "path": "/convert"},
+			map[string]bool{"v1alpha1": true, "v1beta1": true, "v1beta2": true},
+		},
+		{
+			"Kubernetes",
+			capabilities.ApiServerSpec{},
+			nil,
+			map[string]bool{"v1alpha1": false, "v1beta1": false, "v1beta2": true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			o := Install{
+				Options:   &internal.Options{Namespace: "tenant"},
+				apiServer: tc.apiServer,
+			}
+
+			resources, err := o.render("./install/cluster/syndesis.yml.tmpl")
+			require.NoError(t, err)
+			require.Len(t, resources, 1)
+
+			service, _, err := unstructured.NestedMap(resources[0].Object, "spec", "conversion", "webhook", "clientConfig", "service")
+			require.NoError(t, err)
+			assert.Equal(t, tc.conversion, service)
+
+			versions, _, err := unstructured.NestedSlice(resources[0].Object, "spec", "versions")
+			require.NoError(t, err)
+			served := map[string]bool{}
+			for _, v := range versions {
+				v := v.(map[string]interface{})
+				served[v["name"].(string)] = v["served"].(bool)
+			}
+			assert.Equal(t, tc.served, served)
+		})
+	}
+}
@@ -25,7 +25,9 @@ import (
 
 	oappsv1 "github.com/openshift/api/apps/v1"
 	kubemetrics "github.com/operator-framework/operator-sdk/pkg/kube-metrics"
+	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	customMetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
@@ -53,7 +55,9 @@ import (
 	sdkVersion "github.com/operator-framework/operator-sdk/version"
 	"github.com/syndesisio/syndesis/install/operator/pkg/apis"
 	"github.com/syndesisio/syndesis/install/operator/pkg/controller"
+	"github.com/syndesisio/syndesis/install/operator/pkg/generator"
 	"github.com/syndesisio/syndesis/install/operator/pkg/openshift"
+	"github.com/syndesisio/syndesis/install/operator/pkg/webhook"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -110,6 +114,7 @@ func New(parent *internal.Options) *cobra.Command {
 	}
 
 	cmd.PersistentFlags().StringVarP(&configuration.TemplateConfig, "operator-config", "", "/conf/config.yaml", "Path to the operator configuration file.")
+	cmd.PersistentFlags().StringVarP(&options.templatesDir, "templates-dir", "", "/conf/templates", "Path to the directory of the templates replacing or adding to the infrastructure, addons and database ones.")
 	cmd.PersistentFlags().AddFlagSet(zap.FlagSet())
 	cmd.PersistentFlags().AddFlagSet(util.FlagSet)
 
@@ -141,7 +146,7 @@ func removeLegacyOperatorDeployment(ctx context.Context, clientTools *clienttool
 	}
 
 	if err := rtClient.List(ctx, &dcList, &options); err != nil {
-		if !k8errors.IsNotFound(err) {
+		if !k8errors.IsNotFound(err) && !util.IsNoKindMatchError(err) {
 			return err // genuine problematic error
 		}
 	} else {
@@ -161,16 +166,48 @@ func removeLegacyOperatorDeployment(ctx context.Context, clientTools *clienttool
 //
 type options struct {
 	*internal.Options
+	templatesDir string
+}
+
+// Returns the namespace the operator runs in. Out of a cluster it's only
+// known when the operator watches a single namespace, taken to be its own.
+func operatorNamespace(watchNamespaces []string) (string, error) {
+	namespace, err := k8sutil.GetOperatorNamespace()
+	if err == k8sutil.ErrNoNamespace || err == k8sutil.ErrRunLocal {
+		if len(watchNamespaces) == 1 {
+			return watchNamespaces[0], nil
+		}
+		return "", errors.Wrap(err, "failed to get the operator namespace while watching more than one namespace")
+	}
+	return namespace, err
 }
 
 func (o *options) run() error {
 	logf.SetLogger(zap.Logger())
 
 	printVersion()
-	namespace, err := k8sutil.GetWatchNamespace()
+	if err := generator.UseTemplateOverrides(o.templatesDir); err != nil {
+		return errors.Wrap(err, "failed to load the template overrides")
+	}
+
+	watchNamespace, err := k8sutil.GetWatchNamespace()
 	if err != nil {
 		return errors.Wrap(err, "failed to get watch namespace")
 	}
+	namespaces := util.WatchNamespaces(watchNamespace)
+
+	selector, err := util.WatchNamespaceSelector()
+	if err != nil {
+		return errors.Wrap(err, "failed to parse the watch namespace selector")
+	}
+	if !selector.Empty() && len(namespaces) > 0 {
+		return fmt.Errorf("%s requires all namespaces to be watched, %s should be empty", util.WatchNamespaceSelectorEnvVar, k8sutil.WatchNamespaceEnvVar)
+	}
+
+	namespace, err := operatorNamespace(namespaces)
+	if err != nil {
+		return err
+	}
 
 	// Get a config to talk to the apiserver
 	cfg, err := config.GetConfig()
@@ -218,10 +255,22 @@ func (o *options) run() error {
 	}
 
 	// Create a new Cmd to provide shared dependencies and start components
-	mgr, err := manager.New(o.ClientTools().RestConfig(), manager.Options{
-		Namespace:          namespace,
+	options := manager.Options{
 		MetricsBindAddress: fmt.Sprintf("%s:%d", metricsHost, metricsPort),
-	})
+	}
+	switch len(namespaces) {
+	case 0:
+		log.Info("Watching all namespaces", "selector", selector.String())
+	case 1:
+		log.Info("Watching a single namespace", "namespace", namespaces[0])
+		options.Namespace = namespaces[0]
+	default:
+		log.Info("Watching multiple namespaces", "namespaces", namespaces)
+		options.NewCache = cache.MultiNamespacedCacheBuilder(namespaces)
+		// Cluster resources can't be read from a cache limited to some namespaces
+		options.ClientDisableCacheFor = []client.Object{&consolev1.ConsoleLink{}, &rbacv1.ClusterRoleBinding{}}
+	}
+	mgr, err := manager.New(o.ClientTools().RestConfig(), options)
 	if err != nil {
 		return err
 	}
@@ -238,18 +287,24 @@ func (o *options) run() error {
 		return err
 	}
 
-	cli, err := o.ClientTools().RuntimeClient()
-	if err != nil {
-		return err
-	}
+	// The CRD converts the older versions through the webhook of the operator
+	// when installed by OLM, or where the cluster injects the CA bundle of the
+	// webhook. Elsewhere they are not served, and the resources stored with
+	// them are migrated to the storage version.
+	if !config.ApiServer.EmbeddedProvider {
+		cli, err := o.ClientTools().RuntimeClient()
+		if err != nil {
+			return err
+		}
 
-	am, err := versions.ApiMigrator(o.Context, cli, namespace)
-	if err != nil {
-		return err
-	}
+		am, err := versions.ApiMigrator(o.Context, cli, namespace)
+		if err != nil {
+			return err
+		}
 
-	if err = am.Migrate(); err != nil {
-		return err
+		if err = am.Migrate(); err != nil {
+			return err
+		}
 	}
 
 	// Setup all Controllers
@@ -257,11 +312,19 @@ func (o *options) run() error {
 		return err
 	}
 
+	// Setup the admission webhooks
+	if err := webhook.AddToManager(mgr); err != nil {
+		return err
+	}
+
 	// Setup metrics. Serves Operator/CustomResource GVKs and generates metrics based on those types
 	installationGVK := []schema.GroupVersionKind{v1beta2.SchemaGroupVersionKind}
 
-	// To generate metrics in other namespaces, add the values below.
-	ns := []string{namespace}
+	// Generate metrics in the watched namespaces, or in all of them
+	ns := namespaces
+	if len(ns) == 0 {
+		ns = []string{metav1.NamespaceAll}
+	}
 	// Generate and serve custom resource specific metrics.
 	err = kubemetrics.GenerateAndServeCRMetrics(cfg, ns, installationGVK, metricsHost, operatorMetricsPort)
 	if err != nil {
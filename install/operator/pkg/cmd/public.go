@@ -27,6 +27,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal"
 	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal/backup"
+	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal/diff"
 	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal/grant"
 	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal/install"
 	"github.com/syndesisio/syndesis/install/operator/pkg/cmd/internal/run"
@@ -67,6 +68,7 @@ func NewOperator(ctx context.Context) (*cobra.Command, error) {
 	cmd.AddCommand(backup.NewBackup(&options))
 	cmd.AddCommand(backup.NewRestore(&options))
 	cmd.AddCommand(olm.New(&options))
+	cmd.AddCommand(diff.New(&options))
 
 	return &cmd, nil
 }
@@ -3,28 +3,52 @@ package syndesis
 import (
 	"context"
 	"reflect"
+	"sync"
 	"time"
 
+	oappsv1 "github.com/openshift/api/apps/v1"
+	routev1 "github.com/openshift/api/route/v1"
 	synpkg "github.com/syndesisio/syndesis/install/operator/pkg"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	syndesisv1beta2 "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/action"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/capabilities"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/events"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/maintenance"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
 )
 
 var log = logf.Log.WithName("controller")
 
-var (
-	actions []action.SyndesisOperatorAction
+const (
+	// Changes to the syndesis resource and to the resources it owns trigger a
+	// reconciliation, this period is only a safety net for anything missed
+	resyncPeriod = 10 * time.Minute
+	// Used by the phases waiting on time rather than on a resource change
+	pollPeriod = 15 * time.Second
+	// Syndesis resources reconciled at the same time, a given one is never
+	// reconciled concurrently
+	maxConcurrentReconciles = 4
 )
 
 // Add creates a new Syndesis Controller and adds it to the Manager. The Manager will set fields on the Controller
@@ -42,10 +66,34 @@ func newReconciler(mgr manager.Manager) (*ReconcileSyndesis, error) {
 
 	clientTools := &clienttools.ClientTools{}
 	clientTools.SetRuntimeClient(mgr.GetClient())
+	clientTools.SetEventRecorder(mgr.GetEventRecorderFor(events.Component))
+
+	// Syndesis resources are reconciled concurrently, so create the clients
+	// upfront rather than lazily on first use
+	if _, err := clientTools.ApiClient(); err != nil {
+		return nil, err
+	}
+	if _, err := clientTools.DynamicClient(); err != nil {
+		return nil, err
+	}
+	if _, err := clientTools.CoreV1Client(); err != nil {
+		return nil, err
+	}
+	if _, err := clientTools.OlmClient(); err != nil {
+		return nil, err
+	}
+
+	namespaceSelector, err := util.WatchNamespaceSelector()
+	if err != nil {
+		return nil, err
+	}
 
 	return &ReconcileSyndesis{
-		clientTools: clientTools,
-		scheme:      mgr.GetScheme(),
+		clientTools:       clientTools,
+		scheme:            mgr.GetScheme(),
+		mgr:               mgr,
+		namespaceSelector: namespaceSelector,
+		actions:           map[types.NamespacedName][]action.SyndesisOperatorAction{},
 	}, nil
 }
 
@@ -53,7 +101,10 @@ func newReconciler(mgr manager.Manager) (*ReconcileSyndesis, error) {
 func add(mgr manager.Manager, r *ReconcileSyndesis) error {
 
 	// Create a new controller
-	c, err := controller.New("syndesis-controller", mgr, controller.Options{Reconciler: r})
+	c, err := controller.New("syndesis-controller", mgr, controller.Options{
+		Reconciler:              r,
+		MaxConcurrentReconciles: maxConcurrentReconciles,
+	})
 	if err != nil {
 		return err
 	}
@@ -64,10 +115,79 @@ func add(mgr manager.Manager, r *ReconcileSyndesis) error {
 		return err
 	}
 
-	actions = action.NewOperatorActions(mgr, r.clientTools)
+	// Watch for changes to the resources owned by a Syndesis resource
+	owned, err := ownedTypes(r.clientTools)
+	if err != nil {
+		return err
+	}
+	for _, t := range owned {
+		err = c.Watch(&source.Kind{Type: t}, &handler.EnqueueRequestForOwner{
+			OwnerType:    &syndesisv1beta2.Syndesis{},
+			IsController: true,
+		}, predicate.ResourceVersionChangedPredicate{})
+		if err != nil {
+			return err
+		}
+	}
+
+	if !r.namespaceSelector.Empty() {
+		// Labelling a namespace brings its syndesis resources in or out of the watch
+		err = c.Watch(&source.Kind{Type: &corev1.Namespace{}}, handler.EnqueueRequestsFromMapFunc(r.syndesisInNamespace),
+			predicate.Funcs{
+				UpdateFunc: func(e event.UpdateEvent) bool {
+					return !reflect.DeepEqual(e.ObjectOld.GetLabels(), e.ObjectNew.GetLabels())
+				},
+				DeleteFunc: func(event.DeleteEvent) bool { return false },
+			})
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// The types of the owned resources whose changes should trigger a reconciliation
+func ownedTypes(clientTools *clienttools.ClientTools) ([]client.Object, error) {
+	owned := []client.Object{
+		&appsv1.Deployment{},
+		&corev1.Secret{},
+		&corev1.ConfigMap{},
+		&corev1.PersistentVolumeClaim{},
+		&batchv1.Job{},
+	}
+
+	apiSpec, err := capabilities.ApiCapabilities(clientTools)
+	if err != nil {
+		return nil, err
+	}
+	if apiSpec.DeploymentConfigs {
+		owned = append(owned, &oappsv1.DeploymentConfig{})
+	}
+	if apiSpec.Routes {
+		owned = append(owned, &routev1.Route{})
+	} else {
+		// Syndesis is exposed by an ingress on Kubernetes
+		owned = append(owned, &networkingv1.Ingress{})
+	}
+
+	return owned, nil
+}
+
+// Returns when the syndesis resource should be reconciled again if nothing changed,
+// at the latest when its next maintenance window opens
+func requeueAfter(syndesis *syndesisv1beta2.Syndesis, now time.Time) time.Duration {
+	if syndesis.Status.Phase == syndesisv1beta2.SyndesisPhaseUpgradeFailureBackoff {
+		return pollPeriod
+	}
+
+	next, err := maintenance.NextWindow(syndesis.Spec.MaintenanceWindows, now)
+	if err == nil && !next.IsZero() && next.Sub(now) < resyncPeriod {
+		return next.Sub(now)
+	}
+	return resyncPeriod
+}
+
 var _ reconcile.Reconciler = &ReconcileSyndesis{}
 
 // ReconcileSyndesis reconciles a Syndesis object
@@ -76,6 +196,14 @@ type ReconcileSyndesis struct {
 	// that reads objects from the cache and writes to the apiserver
 	clientTools *clienttools.ClientTools
 	scheme      *runtime.Scheme
+	mgr         manager.Manager
+
+	// Selects the namespaces whose syndesis resources are reconciled
+	namespaceSelector labels.Selector
+
+	// The actions, and the state they keep, of each syndesis resource
+	actionsLock sync.Mutex
+	actions     map[types.NamespacedName][]action.SyndesisOperatorAction
 }
 
 // Reconcile the state of the Syndesis infrastructure elements
@@ -97,6 +225,7 @@ func (r *ReconcileSyndesis) Reconcile(ctx context.Context, request reconcile.Req
 			// Request object not found, could have been deleted after reconcile request.
 			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
 			// Return and don't requeue
+			r.forgetActions(request.NamespacedName)
 			return reconcile.Result{}, nil
 		}
 		// Error reading the object - requeue the request.
@@ -107,7 +236,54 @@ func (r *ReconcileSyndesis) Reconcile(ctx context.Context, request reconcile.Req
 		}, err
 	}
 
-	for _, a := range actions {
+	if syndesis.GetDeletionTimestamp() != nil {
+		// Being deleted, only the teardown is left to do
+		if err := action.Teardown(ctx, r.clientTools, syndesis); err != nil {
+			if err == action.ErrBackupInProgress {
+				log.Info("Waiting for the backup before deletion", "name", syndesis.Name)
+			} else {
+				log.Error(err, "Error tearing down", "name", syndesis.Name)
+			}
+			return reconcile.Result{
+				Requeue:      true,
+				RequeueAfter: 10 * time.Second,
+			}, nil
+		}
+		r.forgetActions(request.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+
+	if !controllerutil.ContainsFinalizer(syndesis, action.SyndesisFinalizer) {
+		// The update triggers another reconciliation
+		controllerutil.AddFinalizer(syndesis, action.SyndesisFinalizer)
+		return reconcile.Result{}, client.Update(ctx, syndesis)
+	}
+
+	if selected, err := r.isNamespaceSelected(ctx, syndesis.Namespace); err != nil {
+		log.Error(err, "Cannot read namespace", "namespace", syndesis.Namespace)
+		return reconcile.Result{
+			Requeue:      true,
+			RequeueAfter: 10 * time.Second,
+		}, nil
+	} else if !selected {
+		// Labelling the namespace triggers another reconciliation
+		reqLogger.V(2).Info("Namespace not selected")
+		r.forgetActions(request.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+
+	paused := syndesis.IsReconcilePaused()
+	if updated, err := r.updatePaused(ctx, syndesis, paused); err != nil || updated {
+		// The status update triggers another reconciliation
+		return reconcile.Result{}, err
+	}
+	if paused {
+		// Removing the annotation triggers another reconciliation
+		reqLogger.V(2).Info("Reconciliation paused")
+		return reconcile.Result{}, nil
+	}
+
+	for _, a := range r.actionsFor(request.NamespacedName) {
 		// Don't want to do anything if the syndesis resource has been updated in the meantime
 		// This happens when a processing takes more tha the resync period
 		if latest, err := r.isLatestVersion(ctx, syndesis); err != nil || !latest {
@@ -130,13 +306,80 @@ func (r *ReconcileSyndesis) Reconcile(ctx context.Context, request reconcile.Req
 		}
 	}
 
-	// Requeuing because actions expect this behaviour
 	return reconcile.Result{
-		Requeue:      true,
-		RequeueAfter: 15 * time.Second,
+		RequeueAfter: requeueAfter(syndesis, time.Now()),
 	}, nil
 }
 
+// Checks whether the namespace matches the selector of the namespaces to watch
+func (r *ReconcileSyndesis) isNamespaceSelected(ctx context.Context, name string) (bool, error) {
+	if r.namespaceSelector.Empty() {
+		return true, nil
+	}
+
+	client, _ := r.clientTools.RuntimeClient()
+	namespace := &corev1.Namespace{}
+	if err := client.Get(ctx, types.NamespacedName{Name: name}, namespace); err != nil {
+		return false, err
+	}
+	return r.namespaceSelector.Matches(labels.Set(namespace.Labels)), nil
+}
+
+// Maps a namespace to the syndesis resources it contains
+func (r *ReconcileSyndesis) syndesisInNamespace(namespace client.Object) []reconcile.Request {
+	cl, _ := r.clientTools.RuntimeClient()
+	list := &syndesisv1beta2.SyndesisList{}
+	if err := cl.List(context.TODO(), list, client.InNamespace(namespace.GetName())); err != nil {
+		log.Error(err, "Cannot list syndesis resources", "namespace", namespace.GetName())
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for _, syndesis := range list.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: syndesis.Namespace, Name: syndesis.Name},
+		})
+	}
+	return requests
+}
+
+// Returns the actions of a syndesis resource, creating them the first time it's reconciled
+func (r *ReconcileSyndesis) actionsFor(name types.NamespacedName) []action.SyndesisOperatorAction {
+	r.actionsLock.Lock()
+	defer r.actionsLock.Unlock()
+
+	actions, ok := r.actions[name]
+	if !ok {
+		actions = action.NewOperatorActions(r.mgr, r.clientTools)
+		r.actions[name] = actions
+	}
+	return actions
+}
+
+// Drops the actions of a syndesis resource which is gone
+func (r *ReconcileSyndesis) forgetActions(name types.NamespacedName) {
+	r.actionsLock.Lock()
+	defer r.actionsLock.Unlock()
+
+	if actions, ok := r.actions[name]; ok {
+		action.StopOperatorActions(actions)
+		delete(r.actions, name)
+	}
+}
+
+// Reports whether the reconciliation is paused in the Paused condition,
+// returning whether the status had to be updated
+func (r *ReconcileSyndesis) updatePaused(ctx context.Context, syndesis *syndesisv1beta2.Syndesis, paused bool) (bool, error) {
+	target := syndesis.DeepCopy()
+	target.SetPaused(paused)
+	if equality.Semantic.DeepEqual(target.Status, syndesis.Status) {
+		return false, nil
+	}
+
+	client, _ := r.clientTools.RuntimeClient()
+	return true, client.Status().Update(ctx, target)
+}
+
 func (r *ReconcileSyndesis) isLatestVersion(ctx context.Context, syndesis *syndesisv1beta2.Syndesis) (bool, error) {
 	refreshed := syndesis.DeepCopy()
 	client, _ := r.clientTools.RuntimeClient()
@@ -0,0 +1,141 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package syndesis
+
+import (
+	"testing"
+	"time"
+
+	oappsv1 "github.com/openshift/api/apps/v1"
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	syndesisv1beta2 "github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/action"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
+	syntesting "github.com/syndesisio/syndesis/install/operator/pkg/syndesis/testing"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gofake "k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func Test_requeueAfter(t *testing.T) {
+	now := time.Date(2021, time.March, 1, 1, 55, 0, 0, time.UTC)
+	window := func(schedule string) []syndesisv1beta2.MaintenanceWindow {
+		return []syndesisv1beta2.MaintenanceWindow{{Schedule: schedule, Duration: metav1.Duration{Duration: time.Hour}}}
+	}
+
+	testCases := []struct {
+		name    string
+		phase   syndesisv1beta2.SyndesisPhase
+		windows []syndesisv1beta2.MaintenanceWindow
+		want    time.Duration
+	}{
+		{"Installed", syndesisv1beta2.SyndesisPhaseInstalled, nil, resyncPeriod},
+		{"Upgrade backoff", syndesisv1beta2.SyndesisPhaseUpgradeFailureBackoff, nil, pollPeriod},
+		{"Window opening before the resync", syndesisv1beta2.SyndesisPhaseInstalled, window("0 2 * * *"), 5 * time.Minute},
+		{"Window opening after the resync", syndesisv1beta2.SyndesisPhaseInstalled, window("0 4 * * *"), resyncPeriod},
+		{"Invalid window", syndesisv1beta2.SyndesisPhaseInstalled, window("not a schedule"), resyncPeriod},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			syndesis := &syndesisv1beta2.Syndesis{
+				Spec:   syndesisv1beta2.SyndesisSpec{MaintenanceWindows: tc.windows},
+				Status: syndesisv1beta2.SyndesisStatus{Phase: tc.phase},
+			}
+			assert.Equal(t, tc.want, requeueAfter(syndesis, now))
+		})
+	}
+}
+
+func Test_ownedTypes(t *testing.T) {
+	kubernetes := &clienttools.ClientTools{}
+	kubernetes.SetApiClient(gofake.NewSimpleClientset())
+
+	testCases := []struct {
+		name        string
+		clientTools *clienttools.ClientTools
+		want        []client.Object
+		notWant     []client.Object
+	}{
+		{
+			"OpenShift",
+			syntesting.FakeClientTools(),
+			[]client.Object{&oappsv1.DeploymentConfig{}, &routev1.Route{}},
+			[]client.Object{&networkingv1.Ingress{}},
+		},
+		{
+			"Kubernetes",
+			kubernetes,
+			[]client.Object{&networkingv1.Ingress{}},
+			[]client.Object{&oappsv1.DeploymentConfig{}, &routev1.Route{}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			owned, err := ownedTypes(tc.clientTools)
+			require.NoError(t, err)
+			for _, o := range tc.want {
+				assert.Contains(t, owned, o)
+			}
+			for _, o := range tc.notWant {
+				assert.NotContains(t, owned, o)
+			}
+		})
+	}
+}
+
+func Test_forgetActions(t *testing.T) {
+	app := types.NamespacedName{Namespace: "syndesis", Name: "app"}
+	other := types.NamespacedName{Namespace: "other", Name: "app"}
+
+	testCases := []struct {
+		name      string
+		forget    types.NamespacedName
+		remaining []types.NamespacedName
+	}{
+		{"Known resource", app, []types.NamespacedName{other}},
+		{"Same name in another namespace", other, []types.NamespacedName{app}},
+		{"Unknown resource", types.NamespacedName{Namespace: "syndesis", Name: "gone"}, []types.NamespacedName{app, other}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &ReconcileSyndesis{
+				actions: map[types.NamespacedName][]action.SyndesisOperatorAction{
+					app:   {},
+					other: {},
+				},
+			}
+
+			r.forgetActions(tc.forget)
+			assert.Len(t, r.actions, len(tc.remaining))
+			for _, name := range tc.remaining {
+				assert.Contains(t, r.actions, name)
+			}
+
+			// The actions of a remaining resource are kept as they are
+			for _, name := range tc.remaining {
+				assert.Equal(t, r.actions[name], r.actionsFor(name))
+			}
+		})
+	}
+}
@@ -34,14 +34,21 @@ var assets = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 1919,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x55\x41\x8f\xf3\x34\x10\xbd\xe7\x57\x8c\x5a\xa4\x85\x43\x52\xca\x02\x5a\x59\xe2\x10\x6d\x17\xf1\x21\xf8\x1a\x6d\x17\x38\x20\x84\xa6\xce\xb4\xf1\xe2\xd8\xc6\x9e\xb4\xaa\xaa\xfe\x77\xe4\x74\xdb\x4d\xc2\xb6\x02\x69\xa5\xcf\xa7\x76\xc6\xf3\xde\x1b\xfb\x8d\x33\x4e\xc6\x30\xb3\xe6\x86\x61\x8b\x86\x81\x2d\x28\x13\x18\xb5\x06\xae\x54\x00\xeb\xc8\x23\x5b\x0f\x6a\x05\x5b\x82\x0a\x37\x04\x68\xce\xe1\xaa\x59\x26\x63\xd8\x56\x4a\x56\x20\xd1\xc0\x92\x20\x34\xcb\x20\xbd\x5a\x52\x19\xc1\xd0\x94\x1d\x40\xea\xe0\x99\xc0\x84\x65\x32\x4e\xf6\xfb\x34\xa2\x1b\xcb\x90\xe5\x4e\x2d\xc8\x6f\xc8\x67\x73\x5d\x2f\x1a\xe7\xac\x67\x38\x1c\x92\x24\xca\xfc\xb0\x02\xa9\x15\x19\x06\x6b\xf4\x2e\xc2\x19\x70\x1a\x79\x65\x7d\x0d\xa8\x3d\x61\xb9\x83\x0a\x03\x20\x3c\x23\xad\xc9\x9f\x98\x91\x95\x35\xc9\x18\x94\x39\x4b\x0d\x34\xec\x2f\xb4\x0a\x3c\xfd\xdd\x28\x4f\x43\x5d\x8b\x9d\x29\x29\xa8\x90\xe5\x65\x69\x4d\xc8\x7e\x6c\xf1\xb3\xfb\x56\xce\xdc\xe8\xdd\xe1\x90\xa4\x80\x4e\xfd\x4a\x3e\x28\x6b\x04\x6c\xa6\x09\xc0\x5f\xca\x94\x02\x62\x47\x4a\x52\x2e\xa5\x6d\x0c\x27\x00\x35\x31\x96\xc8\x28\x12\x00\x00\x83\x35\x89\x17\xc5\xe9\x49\xcf\x39\x13\x1c\x4a\x12\xb0\xdf\x67\x73\x47\x66\x51\xa9\x15\x17\xde\x3e\x93\xe4\x78\x2c\x7d\x4e\x74\x2e\x4c\x3a\xc4\x33\x72\xda\xee\x6a\x7a\x5f\x52\x80\xe0\x48\x1e\x61\x3c\x39\xad\x24\x06\x01\xd3\xf6\x7f\x20\x4d\x92\xad\x3f\x66\x01\x6a\x64\x59\xfd\x84\x4b\xd2\xe1\x14\xba\xc6\xcd\x54\xc7\x0b\xa5\x73\x79\x4f\x73\x5c\x7a\x80\x75\x0d\xad\x2b\xf4\x28\xae\x7b\x0f\x1f\xaf\xd4\x01\x48\x6b\x18\x95\x21\xdf\xe1\x4a\xaf\x72\xc5\xa5\x6a\x5c\x93\x80\x9b\xfd\xfe\xa2\x65\x3e\xc4\x2d\xf3\x93\xeb\x0e\x87\x9b\x4e\x79\x74\x7b\xaf\xb7\xf4\x55\x47\x61\x3d\x0b\xb8\xbb\xbd\xbb\xed\xe4\x4f\xdd\xd7\xc4\x5e\xc9\xd0\xc9\xa0\x5f\x07\x01\xbf\x8f\x02\xa3\xe7\xd1\x1f\x43\x8d\x45\xa3\x75\x61\xb5\x92\x3b\x01\xb9\xde\xe2\xae\x5b\x4b\x66\xd3\x17\x71\x24\xf9\x2d\x7f\xba\xff\xe1\xcf\x8f\xf9\xcf\x0f\x8b\x22\xbf\x7f\xe8\xc9\xd8\xa0\x6e\xae\x18\x66\x08\x55\xcc\x67\x2d\xd0\xbf\x31\xbe\xf7\xb6\x16\xbd\x30\xc0\x4a\x91\x2e\x1f\x69\x35\x8c\xbf\x64\x0a\xe4\x4a\x9c\xad\x92\x45\x86\x2b\x94\x17\xb4\xbf\x0f\x6f\x3b\x37\x6f\x90\xcf\x8b\x87\xc7\xfc\x69\xfe\x78\xa1\x69\x01\xa3\x81\xa7\x46\x97\x4c\x87\xeb\xe3\x34\x9f\xd6\x7f\x75\x5c\x1e\xeb\x06\x76\xbb\x70\xcf\x9f\xe4\xa4\x5a\xbf\xf6\xc4\xa4\xa9\xa7\x38\x10\xe4\xb3\xb5\x77\x32\xab\x6c\xe0\x34\x06\xbe\x2b\x4d\x10\x93\xc9\xe4\xe5\x44\xa4\xd5\xc7\x37\x27\xad\x08\x4b\x4d\x21\x64\x9f\x7d\xde\xeb\xe1\x8b\x2c\x6c\x64\x26\x75\x13\x22\x98\xb6\x12\xb5\x98\x7e\xfd\xd5\x37\x5f\xfe\xaf\xd1\xfb\xf6\xee\x76\xfa\xc6\xe8\x3d\xaf\x53\x69\x6b\x87\x92\x53\xf6\x2b\xee\xed\x70\xde\xb2\x95\x56\x0b\xf8\x65\x56\xb4\xdf\x13\x32\x65\x7c\xb6\x5f\x7f\xfe\x13\x00\x00\xff\xff\xff\xc9\x07\xd6\x7f\x07\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x55\x5d\x6b\xeb\x46\x10\x7d\xd7\xaf\x18\xec\x42\xda\x07\xc9\x4d\xd3\x96\x20\xe8\x83\x88\x53\x9a\xd2\xc6\x22\x4e\xdb\x87\x52\xca\x78\x35\xb6\x36\x77\xb5\xbb\x77\x77\x64\x63\x84\xfe\xfb\x65\xe5\x8f\x2b\xeb\xc6\x86\x40\xe0\x22\xbd\x78\x66\xe7\x9c\x33\x3b\x67\xe4\x71\x34\x86\xa9\xd1\x57\x0c\x1b\xd4\x0c\x6c\x40\x6a\xcf\xa8\x14\x70\x29\x3d\x18\x4b\x0e\xd9\x38\x90\x4b\xd8\x10\x94\xb8\x26\x40\x7d\x0c\x97\xf5\x22\x1a\xc3\xa6\x94\xa2\x04\x81\x1a\x16\x04\xbe\x5e\x78\xe1\xe4\x82\x8a\x00\x86\xba\xe8\x01\x52\x0f\x4f\x7b\x26\x2c\xa2\x71\xd4\x34\x71\x40\xd7\x86\x21\xc9\xac\x9c\x93\x5b\x93\x4b\x66\xaa\x9a\xd7\xd6\x1a\xc7\xd0\xb6\x51\x14\x64\x3e\x2c\x41\x28\x49\x9a\xc1\x68\xb5\x0d\x70\x1a\xac\x42\x5e\x1a\x57\x01\x2a\x47\x58\x6c\xa1\x44\x0f\x08\x2f\x48\x2b\x72\x07\x66\x64\x69\x74\x34\x06\xa9\x8f\x52\x3d\x0d\xfb\xf3\x9d\x02\x47\x1f\x6b\xe9\x68\xa8\x6b\xbe\xd5\x05\x79\xe9\x93\xac\x28\x8c\xf6\xc9\xef\x1d\x7e\x72\xd7\xc9\x99\x69\xb5\x6d\xdb\x28\x06\xb4\xf2\x6f\x72\x5e\x1a\x9d\xc2\xfa\x3a\x02\xf8\x20\x75\x91\x42\xe8\x48\x0a\xca\x84\x30\xb5\xe6\x08\xa0\x22\xc6\x02\x19\xd3\x08\x00\x40\x63\x45\xe9\x5e\x71\x7c\xd0\x73\xcc\x78\x8b\x82\x52\x68\x9a\x64\x66\x49\xcf\x4b\xb9\xe4\xdc\x99\x17\x12\x1c\xae\xe5\x94\x13\xad\xf5\x93\x1e\xf1\x94\xac\x32\xdb\x8a\xde\x97\x14\xc0\x5b\x12\x3b\xed\x8e\xac\x92\x02\x7d\x0a\x81\x15\xc0\x93\x22\xc1\xc6\xed\xb2\x00\x15\xb2\x28\xff\xc0\x05\x29\x7f\x08\x5d\xe2\x66\xaa\xc2\x40\xe9\x58\x7e\xa2\x39\xbc\x6a\x80\x75\x09\xad\x2f\x34\x3c\xfe\x64\x0e\x8f\x17\xea\x00\x84\xd1\x8c\x52\x93\xeb\x71\xc5\x17\xb9\xc2\x2b\x2b\x5c\x51\x0a\x57\x4d\x73\xd6\x32\x0f\xe1\xc8\x6c\x5f\x0a\x6d\x7b\xd5\x2b\x0f\x6e\x3f\xe9\x2d\xfe\xac\x23\x37\x8e\x53\xb8\xbd\xb9\xbd\xe9\xe5\x0f\xdd\x57\xc4\x4e\x0a\xdf\xcb\xa0\x5b\xf9\x14\xfe\x1d\x79\x46\xc7\xa3\xff\x7a\x99\x4e\x63\x5e\x2b\x95\x1b\x25\xc5\x36\x85\x4c\x6d\x70\xdb\xaf\x25\xbd\x3e\x15\xb1\x23\xf9\x27\x7b\xbe\xfb\xed\xff\xc7\xec\xcf\xfb\x79\x9e\xdd\xdd\xf7\x4e\x00\xac\x51\xd5\x17\x0c\x33\x84\xca\x67\xd3\x0e\xe8\x4b\x8c\x5f\x9d\xa9\xfa\xe4\xe1\x59\x4a\x52\xc5\x13\x2d\x87\xf1\x7d\x26\x47\x2e\xd3\xa3\x55\x92\xc0\x70\x81\xf2\x8c\xf6\xf7\xe1\xed\x96\xf5\x15\xf2\x59\x7e\xff\x94\x3d\xcf\x9e\xce\x34\x9d\xc2\x68\xe0\xa9\x51\x34\x84\xd8\x1f\xc0\xd5\x6e\x9b\xdf\xea\xb8\x2c\xd4\x0d\xec\x76\x66\xce\x5f\xe5\xa6\x3a\xbf\xf6\x7e\xc7\x10\xc7\x8e\xc2\x42\x90\x4b\x56\xce\x8a\xa4\x34\x9e\xe3\x10\xf8\xa5\xd0\x3e\x9d\x4c\x26\xfb\x1b\x11\x46\xed\xbe\x39\x71\x49\x58\x28\xf2\x3e\xf9\xe6\xdb\x93\x1e\xbe\x4b\xfc\x5a\x24\x42\xd5\x9e\xc9\x25\xca\x08\x54\xe9\xf5\x8f\x3f\xfc\xf4\xfd\x9b\x56\xef\xe7\xdb\x9b\xeb\x57\x56\xef\x65\x15\x0b\x53\x59\x14\x1c\xb3\x5b\xf6\x47\x03\x60\x9d\x61\x23\x8c\x4a\xe1\xaf\x69\xde\xfd\xcf\x91\x2e\xc2\x67\xbb\x69\x62\x20\x5d\xb4\x6d\xf4\x69\x00\xff\xc9\x07\xd6\x7f\x07\x00\x00"),
+		},
+		"/addons/jaeger/syndesis-jaeger-network-policy.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "syndesis-jaeger-network-policy.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 1251,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x93\x4f\x8b\xdb\x30\x10\xc5\xef\xfa\x14\x03\xb9\xb4\xb0\x76\xd9\x52\x42\xf0\xad\x2c\x3d\xb4\x94\x76\x61\x43\xef\x8a\x34\x49\xd4\xc8\x33\xea\x68\xb2\xc5\x04\x7f\xf7\x62\x5b\xde\xba\xb0\xdb\x3f\xa7\xed\xcd\x7e\x33\xf6\xfb\xbd\x19\xe9\x72\xa9\x20\xec\xc1\x92\x87\xfa\xae\x23\x8f\x39\xe4\xfa\x13\xea\x77\x96\xd3\x2d\xc7\xe0\x02\xe6\xfa\x1d\xd9\x5d\x44\x0f\x2f\x88\x75\xd1\xf6\xd6\x7b\xa6\x5c\x7f\xb0\x78\x40\xa9\x6f\x62\x40\xd2\xcf\x14\xbb\x97\x7d\x6f\x56\x66\x05\xdb\x23\x82\x8a\x75\x98\xc1\x0a\x82\xe3\x18\xd1\x29\x7a\xd8\x0b\xb7\xa0\x47\x84\x8c\x72\x8f\x72\x05\x2d\xaa\x1d\x19\x06\x31\x90\xe2\x41\xac\x06\xa6\x7c\x65\x56\xa3\xfe\xed\x8c\x12\xd0\xc3\xae\x5b\x7c\x67\x56\xa6\x02\x9b\xc2\x17\x94\x1c\x98\x1a\xa0\x89\x3b\xd0\xa1\x3e\x6d\x72\x1d\xf8\xd5\xfd\xb5\x01\x38\x05\xf2\x0d\x2c\x43\x75\x06\x46\x4f\x6f\xd5\x36\x06\x00\x80\x6c\x8b\x0d\xe4\x12\xad\xfa\x3a\x66\x1a\x2b\xd1\xee\x30\xe6\xa9\x0b\xc0\xa6\xf4\xb3\xad\x68\xf3\xeb\x60\xf8\xa7\xba\x76\x09\x1b\x08\xb4\x17\x9b\x55\xce\x4e\xcf\x82\x8f\xb4\x39\x6e\x13\x13\x92\x36\xf0\x80\x92\x13\xba\x09\x23\xb1\xbf\xc3\x61\x96\x2c\x33\x57\x6b\xd5\x1d\x3f\xfe\x82\x5a\x60\x17\x51\x8a\x56\x9f\xce\x3b\x14\x42\xc5\xd1\xeb\xe9\xe8\x69\x9c\xd5\xb6\x4b\x58\x7e\x5a\xc1\x7b\x3a\x08\xe6\x29\x59\x98\x9e\xe7\xd2\xb0\xd5\xd9\xbb\x7a\x8c\xf1\x49\xce\xbf\x19\xe2\x6f\x67\x34\xcb\x55\x39\x18\xcf\x0b\x31\x9c\xac\xe7\x41\x58\x5c\x9d\xd2\x9e\x58\xf4\xc1\xa1\x82\x24\xac\xec\x38\x36\xb0\xbd\xb9\x2d\xea\xb0\x67\xd1\x06\xae\xdf\xbc\x5e\x6f\xfe\xd7\x5d\xfe\x4b\x8e\xf5\x7a\xb3\x36\x97\x4b\x05\x48\xbe\xef\xcd\x8f\x01\x00\x36\x47\xe8\xb1\xe3\x04\x00\x00"),
 		},
 		"/addons/jaeger/syndesis-jaeger.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "syndesis-jaeger.yml.tmpl",
 			modTime:          time.Time{},
 			uncompressedSize: 2143,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x55\xc1\x6e\xe4\x36\x0c\xbd\xfb\x2b\x08\xcc\x61\xba\x40\xec\x36\xed\x22\x28\xdc\x53\x90\x1e\x36\xbd\x64\xd1\x6c\x7b\xa7\x25\xce\x58\x8d\x86\xf2\x8a\xf4\x24\x46\x30\xff\x5e\x48\xf6\xd8\x4e\x9a\xcd\x5e\xeb\xcb\x8c\x69\xea\xe9\xf1\xf1\x89\x7a\x7e\x2e\xc1\xed\x00\xd9\xc2\x0f\x1c\x14\xaa\xfb\x81\x2d\x89\x93\xea\xda\xda\xc0\x52\xfd\x81\xb4\xa7\x58\xdd\x78\x47\xac\x77\xec\x87\x0f\xef\x27\xde\x75\x14\x51\x43\xcc\xa9\xa7\x53\x51\x02\x76\xee\x6f\x8a\xe2\x02\xd7\xf0\x4f\x4e\xd2\x88\xc6\xf1\xbe\x72\xe1\xc7\xe3\x65\x01\xf0\xe0\xd8\xd6\x30\x02\x14\x00\x07\x52\xb4\xa8\x58\x17\x00\x00\x1e\x1b\xf2\x32\xfe\x07\xc0\xae\xab\x41\xa6\xad\xa7\xd8\xf9\x35\xe1\x7d\xef\xbb\x0e\x1d\xd5\xe0\x78\x17\x51\x34\xf6\x46\xfb\x48\x6f\xa4\x99\x70\xe8\x02\x13\xeb\x99\x72\xce\x61\x3c\xd0\x82\x5e\xce\x5f\xa4\x23\x33\x12\x14\x8d\xa8\xb4\x1f\x6a\x40\xef\x6f\xf9\x8e\x47\xf0\xf3\xcb\x98\x34\x69\x9e\x55\xbc\xee\xdc\x3d\xc5\x63\x12\xce\x1f\xee\xfb\xae\x0b\x51\xe1\x74\x9a\x28\x6d\xce\xbf\xf0\xbb\xdb\xed\x9c\xe9\xbd\x82\x06\x30\x81\x35\x06\x0f\xda\x12\xb8\x03\xee\x09\x8e\xa3\xc0\xe0\x18\x90\xe1\xdc\x83\x4f\x7d\x03\xc4\xc7\x57\x60\x79\x45\x0d\xdb\xe7\xe7\x6f\x36\xf1\x36\xa5\x5c\x4f\xa4\xe1\x74\xda\xce\xbc\x89\xed\xcc\x2e\x74\xea\x02\xcf\xad\x49\x8d\x3b\x84\x38\x2c\xef\x00\x07\x7c\x2a\x53\xb7\x49\x6a\xb8\xfc\x29\x3d\x67\x12\xbc\x8f\x24\xab\xb5\xc4\xd8\x78\xb2\x35\xec\xd0\x0b\x15\xcb\x5e\xc5\xa6\xd8\xc0\x97\xd6\x09\x38\x81\x48\x5f\x7b\x17\xc9\xa6\x42\x9b\xa0\x6d\x96\xa0\x1d\x9a\xe8\x6c\xf6\xb0\xa5\x1d\x26\x91\x0c\x0a\x49\x5d\x6c\xe0\xf2\x03\xdc\x72\xce\x5a\x7f\xba\xc8\x91\xb1\x7f\xe0\x58\x14\xd9\x50\xc2\x77\xec\xd4\xa1\x77\x42\x16\x9a\x21\x65\x15\x9b\xb5\x37\xca\x89\xe6\xb4\xb6\x0c\x93\xd2\x79\xf3\x84\xf9\xb5\xa7\x38\x40\x1f\x3d\xd0\x53\x47\x46\x05\x34\x8c\x10\x68\x0c\x89\xe4\xa4\x2d\x07\xec\xb5\x2d\x5f\x39\xa9\xcc\x8b\xb7\x20\x14\x8f\xce\xd0\x6f\xc5\x06\x7e\x9e\xe9\x4f\x45\x2e\xec\x7b\xa1\x08\x2d\x8e\x9a\x90\x28\xd9\x99\xe5\x24\xe6\x42\x7f\xaa\x74\x66\xdb\xf4\x9a\xed\x67\x22\xa1\x12\x20\xdc\xfc\x59\xc1\xa7\xf0\x48\x47\x8a\x17\xaf\xea\xd8\xc5\x70\x18\x51\x52\x7c\xde\x42\xb2\x6b\x41\xd4\xf9\xa5\xd6\xf7\x0b\x9b\x74\x98\x94\x7a\x43\xfd\x11\x6c\x1b\x1e\x99\xec\x76\xd2\xff\x3f\xd2\x8f\x28\x73\x29\x12\x40\xdb\x89\x8f\x5b\xc3\x9c\xad\x52\x65\x03\xa5\x71\x94\x4c\x93\x10\x4d\x9e\x65\x65\x48\xa1\x5e\xa8\x4c\x9a\xa6\x75\x19\x27\xc9\xc2\x94\x5a\x85\x71\x80\x86\x0c\xf6\x42\xb3\xe0\xc5\x06\x1e\x43\xef\x2d\x34\x34\x15\x4d\x36\x9d\xc8\x70\xa4\x18\x9d\xa5\x45\xbb\xbf\xa2\xcf\xa5\x9a\xe0\x3d\x19\x0d\x31\x05\x84\xc8\xf1\x1e\x50\x8a\xcd\x4a\x83\x72\xd6\x60\xc6\x4e\xf3\xc7\x93\x52\x26\x6d\xa9\x23\xb6\xc4\x9a\x01\x5b\x3c\x12\x70\x80\x48\x1e\xd3\xf9\x4b\x50\xe1\x85\xc3\xcf\x52\xb4\x41\x34\x8d\x2c\x49\x12\xac\x87\xce\x77\x67\x7c\x9a\x40\xeb\x63\xb7\x12\xb7\xa5\x48\x80\x02\x08\x2d\x9a\x87\xb4\xf5\x21\x44\x02\x42\x71\x7e\x58\x9b\x7c\x74\x10\x76\x2e\x3b\x68\x6e\xe3\x05\x34\x43\x87\x22\x8e\xf7\x93\x08\xd9\x32\xd0\xc5\xf0\x34\x54\xd5\xab\xfb\x62\x75\x3d\xdc\x8f\x1c\xfe\xff\xf7\xc3\x7b\x67\xe0\xc5\x65\x91\x66\xfd\x4c\xbc\x9c\x56\x9f\xd3\xc6\x27\xa5\xd4\xf0\xf1\xe3\x2f\x4b\x24\x06\x0d\x26\xf8\x1a\xbe\xdc\x7c\x9e\xa3\x8a\x71\x4f\xfa\x39\x67\x5f\x5e\x5d\xfd\x7a\x35\xde\x46\x34\x5a\xef\x85\x38\x2b\xbe\x39\x52\x3d\xf4\x0d\x45\x26\xa5\xd7\x95\xa1\xf7\xa5\xe3\x32\x30\x7d\x33\xfb\xed\x1b\x71\x19\xde\xff\x06\x00\x00\xff\xff\xf0\xf7\xc2\x86\x5f\x08\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x55\xc1\x6e\xe3\x36\x10\xbd\xeb\x2b\x06\xf0\xc1\x5d\x20\x52\x9b\x76\x11\x14\xea\x29\x48\x0f\x9b\x5e\xb2\x68\xb6\xbd\x8f\xc8\x67\x8b\x0d\x3d\xd4\x92\x94\x13\xc1\xf0\xbf\x17\xa4\x64\x59\x71\x37\xd9\x6b\x93\x43\xa2\xe1\xf0\xf1\xcd\x9b\xc7\xe1\xe1\x50\x92\xd9\x10\x8b\xa6\x1f\xc4\x45\xaa\x1e\x07\xd1\x08\x26\x54\xb7\x5a\x3b\x09\xd5\x1f\x8c\x2d\x7c\x75\x67\x0d\x24\x3e\x88\x1d\x3e\xbc\x9f\xf8\xd0\xc1\x73\x74\x3e\xa7\x1e\x8f\x45\x49\xdc\x99\xbf\xe1\x83\x71\x52\xd3\x3f\x39\x29\x7a\x56\x46\xb6\x95\x71\x3f\xee\xaf\x0b\xa2\x27\x23\xba\xa6\xf1\xa4\x82\x68\x87\xc8\x9a\x23\xd7\x05\x11\x91\xe5\x06\x36\x8c\xff\x13\x71\xd7\xd5\x14\xa6\xa3\xa7\xd8\xe9\x33\xe1\x7d\x6f\x3d\x0e\x1d\x6a\x32\xb2\xf1\x1c\xa2\xef\x55\xec\x3d\xbe\x91\xa6\xdc\xae\x73\x02\x89\x27\xca\x39\x47\x78\x87\x33\x7a\x39\xaf\x84\x0e\x6a\x24\x18\xa2\xe7\x88\xed\x50\x13\x5b\x7b\x2f\x0f\x32\x82\x9f\x3e\xc6\xa4\x49\xf3\xac\xe2\x6d\x67\x1e\xe1\xf7\xf0\xd5\x83\xdd\x3d\xf6\x5d\xe7\x7c\xa4\xe3\x71\xa2\xb4\x3a\xfd\xa5\xdf\xcd\x66\x63\x54\x6f\x23\x45\x47\xca\x49\xf4\xce\x52\x6c\x41\x66\xc7\x5b\xd0\x7e\x14\x98\x8c\x10\x0b\x9d\x7a\xf0\xa9\x6f\x08\xb2\xbf\x00\xcb\x3b\x6a\x5a\x1f\x0e\x6f\x36\xf1\x3e\xa5\xdc\x4e\xa4\xe9\x78\x5c\xcf\xbc\x21\x7a\x66\xe7\xba\x68\x9c\xcc\xad\x49\x8d\xdb\x39\x3f\x9c\xbf\x89\x76\xfc\x52\xa6\x6e\x23\xd4\x74\xfd\x53\xfa\x99\x16\x8d\x6c\x3d\xc2\x62\x2f\x84\x1b\x0b\x5d\xd3\x86\x6d\x40\x71\x3e\xab\x58\x15\x2b\xfa\xd2\x9a\x40\x26\x90\xc7\xd7\xde\x78\xe8\x54\x68\xe3\x62\x9b\x25\x68\x87\xc6\x1b\x9d\x3d\xac\xb1\xe1\x24\x92\xe2\x80\x50\x17\x2b\xba\xfe\x40\xf7\x92\xb3\x96\x4b\x57\x39\x32\xf6\x8f\x8c\x84\xc8\xa2\x90\xf0\x8d\x98\x68\xd8\x9a\x00\x4d\xcd\x90\xb2\x8a\xd5\xd2\x1b\xe5\x44\x73\x72\x45\xe9\x26\xa5\xf3\xe1\x09\xf3\x6b\x0f\x3f\x50\xef\x2d\xe1\xa5\x83\x8a\x81\xa2\x1b\x21\x58\x29\x84\x90\x20\x69\x2d\x8e\xfb\xd8\x96\x17\x4e\x2a\xf3\xe6\x35\x05\xf8\xbd\x51\xf8\xad\x58\xd1\xcf\x33\xfd\xa9\xc8\x33\xfb\x3e\xc0\x53\xcb\xa3\x26\x08\x11\x7a\x66\x49\x23\xcb\x33\xfd\xa9\xd2\x99\x6d\xd3\xc7\x6c\x3f\xe5\xc1\x11\xc4\x74\xf7\x67\x45\x9f\xdc\x33\xf6\xf0\x57\x17\x75\x6c\xbc\xdb\x8d\x28\x29\x3e\x1f\x91\x48\xc2\x53\x88\xc6\x9e\x6b\x7d\xbf\xb0\x49\x07\xd1\x6f\xa9\x3f\x82\xad\xdd\xb3\x40\xaf\x27\xfd\xff\x23\xfd\x88\x32\x97\x12\x1c\xc5\x76\xe2\x63\x96\x30\x27\xab\x54\xd9\x40\x69\x1c\x25\xd3\x24\x44\x95\x67\x59\xe9\x52\xa8\x0f\x28\x93\xa6\x69\x5f\xc6\x49\xb2\x08\x52\xab\xd8\x0f\xd4\x40\x71\x1f\x30\x0b\x5e\xac\xe8\xd9\xf5\x56\x53\x83\xa9\x68\xe8\x74\x23\xdd\x1e\xde\x1b\x8d\xb3\x76\x7f\x79\x9b\x4d\xa1\x9c\xb5\x50\xd1\xf9\x14\x08\x80\x91\x2d\x71\x28\x56\x0b\x0d\xca\x59\x83\x19\x3b\xcd\x1f\x8b\x88\x4c\x5a\xa3\x83\x68\x48\xcc\x80\x2d\xef\x41\xe2\xc8\xc3\x72\xba\x7f\x09\xca\xbd\x72\xf8\x49\x8a\xd6\x85\x98\x46\x56\x48\x12\x2c\x87\xce\x77\x67\x7c\x9a\x40\xcb\x6b\xb7\x10\xb7\x85\x07\x71\x20\xa6\x96\xd5\x53\x3a\x7a\xe7\x3c\x08\x1c\x8c\x1d\x96\x26\xcf\x2a\xa4\xe1\x9f\x1d\x34\xb7\xf1\x8a\x9a\xa1\xe3\x10\x8c\x6c\x27\x11\xb2\x65\xa8\xf3\xee\x65\xa8\xaa\x8b\xf7\x62\xf1\x3c\xa4\x31\x69\x14\xfe\xff\xef\xc3\x7b\x77\xe0\xd5\x63\x91\x66\xfd\x4c\xbc\x9c\x76\x9f\xd2\xc6\xdf\x94\x52\xd3\xc7\x8f\xbf\x9c\x23\xde\x45\xa7\x9c\xad\xe9\xcb\xdd\xe7\x39\x1a\xd9\x6f\x11\x3f\xe7\xec\xeb\x9b\x9b\x5f\x6f\xf2\x4a\xc0\x68\xbd\x57\xe2\x2c\xf8\xe6\x48\xf5\xd4\x37\xf0\x82\x88\xcb\xca\xd8\xda\xd2\x48\xe9\x04\x6f\x66\x7f\xfb\x45\x3c\x1c\x4a\x82\xe8\xe3\xb1\xf8\x77\x00\xf0\xf7\xc2\x86\x5f\x08\x00\x00"),
 		},
 		"/addons/knative": &vfsgen۰DirInfo{
 			name:    "knative",
@@ -61,109 +68,123 @@ var assets = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 102726,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x9d\x5d\x6f\xdb\x38\xd6\xc7\xef\xf3\x29\xf8\x08\x83\x07\x9d\x45\xd2\xd8\x4e\x9c\xb6\x01\xf6\x22\xf3\xb6\x28\xd0\xdd\xcd\xb6\xb3\x05\x76\xdb\x20\x60\x24\xc6\x66\x23\x4b\x1a\x92\x4a\xe3\xed\x66\x3e\xfb\x82\xf2\x8b\x44\x9a\xb2\xe4\xa8\x63\x4b\xee\xbf\x17\x33\x0d\x49\xc9\x3a\x87\x87\xe4\xf9\x9f\x5f\x6a\xd1\x84\xbf\x67\x42\xf2\x38\x3a\x27\x3c\x52\x6c\x24\x18\x55\xe1\xf4\x79\x2c\x46\xc7\xf7\x7d\x1a\x26\x63\xda\x3f\xb8\xe3\x51\x70\x4e\xfe\x22\xe8\x2d\x8d\xe8\x4f\x54\x8e\x6f\x62\x2a\x82\x83\x09\x53\x34\xa0\x8a\x9e\x1f\x10\x12\xd1\x09\x3b\x27\x72\x1a\x05\x4c\x72\x79\xc4\xa3\x5b\x41\x8f\x68\xc2\x8f\x82\xe5\x70\x42\x42\x7a\xc3\x42\xa9\x87\x13\x42\x93\x24\x1f\x9f\xb5\x2c\x7e\x78\xce\xe3\xe3\xf5\xbd\x6a\x9a\x30\xfd\xb8\xb7\x82\x4a\x25\x52\x5f\xa5\x82\x1d\xc8\x84\xf9\xf5\x9e\xe4\xf9\x27\x19\x47\x07\x84\xe8\xff\x9d\x93\xff\x66\xb7\xff\x92\xfd\x97\x10\x8f\x46\x51\xac\xa8\xe2\x71\x24\xbd\xf3\x65\x33\x21\x5e\xc8\xa5\xf2\xce\xc9\x87\x65\x0b\x29\xf4\x66\x23\x6e\x52\x1e\xaa\xd7\x91\x77\x4e\xfa\x87\x66\x8f\xf6\x92\x8c\x53\xe1\x33\xef\x9c\x78\x47\x47\x0b\x5f\x92\xa3\x23\xcf\x1a\xca\x22\x7a\x13\xea\x61\x4a\xa4\xcc\xea\x1b\xf3\xa0\xa4\x87\xfb\x71\xf4\x63\x1c\xc6\x42\xdf\x5f\x8c\x6e\xe8\xb3\xde\x21\x19\xf4\xfb\x87\x64\x30\x1c\x1e\x92\xfe\xf7\xf6\xc7\x68\x2f\xe9\xb1\x17\xb9\xb9\xe4\xff\xc9\x45\xc8\x84\x92\xf6\x58\xed\x6e\x3d\x76\xe9\x40\xaf\xd0\xff\xb8\xfc\xfb\xd5\xfc\x6f\x8f\x8b\xcb\x3d\x16\x70\xe5\xb0\xc6\x1b\x45\x4c\xbd\x0e\xbc\x73\x12\xa5\x61\x98\xb7\x0a\x9a\x8c\x7f\x8d\xe3\x50\xf1\xc4\x3b\x27\xbd\x65\x07\xd7\x43\x07\x85\x9f\x15\x13\xd9\x23\x6b\x4f\x0f\xcf\x06\x67\xbd\x57\x67\x67\xbd\xfe\xc9\xd2\xeb\x5e\xc8\xa3\x3b\x69\x4c\x56\x71\xaa\x32\x6f\x69\x83\xd8\x83\x62\x22\xa2\x21\xd1\xe3\x0d\xab\x3d\x1e\xf9\x61\x1a\xb0\xf7\x54\x48\x87\xc7\x3d\x45\x47\xd2\x0a\x06\x42\xbc\x45\xd0\xd9\x0e\xcc\x62\xb0\xe8\xb4\x2b\xf3\x66\xb6\x7f\x65\x3e\x76\xe1\xde\xe5\x15\x5e\x42\x23\x16\xae\x31\xce\x8f\xc3\x90\x26\x92\x69\xa7\xdd\xd2\x50\x9a\x0f\x3e\x12\x3c\xb8\x8c\xcd\xd0\xce\x3a\xc6\x8e\xb0\xfd\xac\xfd\x7e\x6a\x35\x3e\x14\xe7\x66\xde\x36\xd5\x6d\xc5\xa0\x30\x7d\x99\xcd\x9f\xd1\x94\x1b\x61\xba\x42\xb0\x84\x51\xbd\xcc\xbc\x54\x70\x73\x46\xa4\x1f\x27\x2c\x98\x4f\x88\xf5\xf0\x7a\xb0\xdd\xa8\x2f\x61\x21\xf3\x95\xdb\x13\xd9\x00\xc5\x1e\xb2\x0f\x3b\xf6\xe3\x28\x62\xfe\x6c\xd5\xaf\x8c\xba\xa7\x61\xca\x56\x86\x19\xa3\x1e\x4b\x8d\x57\x5c\x65\x0b\xc0\xfb\xf0\x21\x15\xfc\xea\xca\x73\x4e\xbd\x88\x3f\x17\xe6\xfc\xd0\x3d\xb3\x34\xe4\x54\x66\xab\x3c\x73\x81\xf9\x39\x37\x33\xc7\xac\xce\xb8\x8e\xaa\x37\x2c\x1a\xa9\x6c\x86\x7b\x2b\x7d\xac\xec\xb2\xe2\x96\x75\x29\xe2\x09\x53\x63\x96\x9a\xee\xf1\x6e\x79\x18\xda\x81\xb3\x3e\xc6\xce\x1c\x31\xd6\x1f\xd4\x8c\xb1\xfe\xfa\x18\x33\xef\xe3\x85\x6c\xc4\xa2\x60\xf5\x31\x68\xc8\x47\xd1\x85\xfc\x75\xbe\x35\x39\x42\xc3\xa3\xf7\xa3\x92\x1e\x3f\x15\x82\x45\xaa\xa4\x57\xef\xd0\x3f\x4f\x12\x35\x5d\xd3\xff\x6f\x26\xe2\x92\xee\x09\x7d\x28\xeb\xe1\x51\x49\x8f\xe0\xa3\xb1\x7a\x37\x3b\x19\x5c\xfd\x72\x1c\x7f\x76\x1f\x1a\x2a\x56\x34\x2c\xb9\x2a\x8b\xf9\x65\x64\x94\xba\x3d\xe4\x11\x73\x6e\x90\xba\xe3\x33\x0f\xd4\xca\xb6\x92\xef\xcd\xe6\xca\xd7\x47\xc1\x65\xcc\x23\xf5\xd7\x38\x33\x25\x6b\x30\x83\x2d\x61\xc2\x67\x91\xa2\x23\xa7\xa9\x5e\xa2\xaf\x16\x34\xe0\xa9\xbe\xfd\x70\xb5\xcf\x1d\xe8\x82\x45\x01\x13\x2c\x3b\x39\x6f\xc3\x58\x79\xee\x0d\xa9\x78\x54\x15\x7a\x7e\xe2\x62\xb6\x21\xe8\xcb\xc7\x7b\xb1\x63\x49\x26\x38\x93\x7f\xbf\x67\x42\xf0\x80\x39\xa6\x4a\x26\xd4\x67\x65\x5b\x8a\x54\xd4\xbf\x73\x3a\x5a\x2a\x96\x24\x2c\x78\xc3\x23\xf7\xfc\x29\x2a\x46\x4c\xad\x1e\xab\x2b\x4e\x62\x0f\x49\x36\x5b\x32\x9d\x3c\x13\x54\xb1\x67\x63\xa5\x92\x6b\xc9\xc4\x3d\x13\xd7\x82\xfd\x96\x32\xa9\xe4\xb5\x64\x7e\x1c\x05\xf2\xda\x8f\xd3\x48\x7d\xd1\xf9\x4e\xf6\xdc\x7f\xfe\xe8\x7d\xb7\xfc\xe1\xa3\x77\xf8\x29\xbe\xd1\x4d\x9f\xe2\x9b\x8f\xde\x61\x2a\xb8\xfe\x21\x15\xfc\xa3\xf7\xf8\x61\x38\xb9\xfa\xde\x4e\x9c\xf4\x9e\x17\x8b\xc9\xec\x8c\x52\x7c\xc2\xae\x67\xee\x5a\x1d\xa6\xb3\x69\x71\x4f\xc3\x5f\xa8\xaf\xb2\xbc\xac\xbf\x32\x64\xb6\x41\xfd\xb2\xbc\xdf\xe2\xd9\x49\xc2\x04\x99\x3d\xff\xea\x7d\x05\xbb\xcd\x72\x27\xef\xc2\x9a\xd0\xc3\x36\x78\xed\x50\x2a\xaa\x52\xf9\x7f\xbf\x7f\xf4\x06\xcf\x9f\xef\xc4\x8b\x4c\x88\x58\xd4\xf4\xe1\x0f\xe5\x8b\xc2\xca\xd1\xc6\x82\xc9\x71\x1c\x06\x8e\xf5\xa0\x0d\xf8\x45\xc4\x13\xd7\x2e\xa1\xfb\xde\xb2\xd1\x5c\x4e\x38\x2e\x7c\x37\xe6\xb7\xce\xfd\x65\x99\x38\xbc\xad\x88\x0a\x4f\x2d\x73\xe6\x2f\xf6\xd6\x4f\x45\xb6\x97\x38\x36\x7f\x19\x0b\xe5\x38\x65\xb3\x9d\xe3\x7a\x91\x96\xf0\x28\xe0\xf7\x3c\x48\x69\xe8\x95\x27\x38\x82\x46\x32\xa1\x65\x87\xe2\x32\xc5\xc9\xd2\x7b\xf3\xc1\x1f\xe8\x03\x77\xec\x8d\x37\xa9\x7f\x37\xdb\x08\x6c\xa7\xe8\x83\x70\x7e\x3c\x68\xdf\x95\x88\x1a\xc7\x55\xe5\x87\xe0\xf2\xa0\xfb\x70\x55\x6a\xe2\x94\x3e\xb0\x1a\xfb\x52\xc0\x7c\x3e\xa1\x59\x5a\xdb\x5b\x13\xef\x72\xac\x5d\xbf\x1a\xc6\x5a\x1c\xeb\x7e\x47\x57\x3c\xfa\x81\x4a\xe6\x8c\xfe\x59\xca\xb0\x6a\xf2\x32\x67\xf0\x7a\xab\x37\x2c\xb8\x63\xa3\x3d\x64\x67\x46\x38\xbb\x4a\xad\x28\x5d\xc5\x53\x77\xc0\x65\x09\x61\x59\x26\xa8\xfb\xde\xb0\xfb\xcc\x2c\xfd\x18\x07\xae\x0f\xea\x5e\xca\xde\xfb\x03\x52\xf6\x95\xc6\x3a\x39\xfb\x09\x72\x76\xe4\xec\x7f\x40\xce\x8e\xcc\x7c\x77\x99\xf9\xb3\x7a\x49\xa6\x4c\x27\x4f\x4f\xcc\xc9\x31\xd9\x8a\x00\xf8\x9e\xfc\x89\xf4\x7b\x3d\xc7\x29\x5a\x33\x85\x1d\x97\xae\xf3\xa7\xe5\xb7\xf4\x9e\x09\xbd\x9e\xbe\xba\x32\x18\x73\xa9\xe2\x91\xa0\x93\xeb\xdf\x52\x1a\x29\x1e\xb2\x67\xbd\xe7\xc3\xc3\x9a\x6e\x9e\x65\x6d\x0d\xe6\xf3\x66\x4a\x9e\x85\xac\x7d\xfe\x1e\xf6\xd4\x98\xcc\xf7\x31\x1e\xae\xf3\xfb\x8f\x5f\xd1\xef\xaf\x7a\x9d\x73\xfc\x13\x7c\xfb\xaa\xb6\x6f\x6d\xa5\xf6\x74\xdf\xf6\xbf\x05\xc7\xea\xac\xa4\xdc\x99\x3f\x77\x40\xf6\xbe\xa1\x8a\x45\xfe\x14\x52\xb7\xc3\x52\x77\xe2\x08\x66\xe8\xdc\x3d\xd4\xb9\xbb\x82\x8e\x2f\x2a\xc4\xe5\xa9\xa9\x28\xaa\xa8\x63\x49\x91\xff\x75\x15\x70\x2e\x8c\xbd\xd4\x9f\xf1\x7a\x95\x78\x6e\x4b\x72\x1c\x7f\xe1\xc1\x63\x4d\xdd\x31\x1b\x5b\x53\x7c\x00\x64\xe6\x1d\x8d\x41\xe6\xcb\x8a\xb8\x1d\xa2\x28\x82\xa2\x48\x6b\x40\x66\x93\x5d\xd0\x62\xf2\xb3\x6e\x16\xfc\x30\x7d\xeb\x9c\xcd\xee\xef\x93\x9d\x29\xd2\x00\x9f\x1a\x13\x5a\x53\x50\x02\x9f\x02\x9f\x42\x53\x9a\x06\x03\x9f\x3a\xcc\x86\xac\x6c\x2e\x2b\xdb\x2b\x14\xb6\x86\x4f\xab\x94\xc2\x19\x94\x02\x94\xc2\x96\xf1\x69\x23\x3d\x70\x02\x3d\xd0\x4e\x3d\x00\x68\x5b\x18\x06\x68\x0b\x68\xbb\xb9\xdf\x01\x6d\xc9\x5a\x7d\xf8\x74\xdf\x02\xda\x02\xda\x42\x60\x97\x98\x08\x68\x0b\x75\xdd\x21\x68\xdb\x3f\xad\xd0\xb4\x2f\x4c\x21\xb3\xaf\xd4\x96\xdd\xb3\x48\x1d\x0b\xa6\x8f\x32\x5a\xf9\xfb\xa2\x8e\xd1\x35\x15\x08\xc8\x6d\xde\xd1\xfc\x9f\xa0\x0e\x2b\x82\xf7\x25\x0a\x32\x28\xc8\x00\xdd\x76\x75\xb3\xec\x4c\xb9\x06\xf8\xd6\x98\xd0\x9a\xd2\x12\xf8\x16\xf8\x16\xea\xd2\x34\x18\xf8\xd6\x61\x36\x04\x66\x73\x81\xd9\x5e\xb5\xb0\xbd\x7f\xfd\x5a\x25\x17\x5e\x41\x2e\x40\x2e\x80\xdf\x16\x9b\x21\x0a\x96\x86\x82\xe1\xb6\x88\x29\x82\xe1\x82\xe1\xb6\xd2\xf1\x60\xb8\xed\x71\x2c\x18\x6e\xa1\x0f\x2a\x9b\xec\x46\x65\x83\xe1\x6e\x68\x44\x57\x25\xf6\xae\x18\xee\xa0\xe2\x6b\x9d\x06\x66\x0a\xbc\xbf\x0c\xf7\x41\xb1\x48\x56\x2a\x8f\x7c\x54\x4d\xc5\x01\x66\x9b\x77\x34\x66\xb6\x83\x41\x45\xb0\xf6\x51\x84\x41\x11\x06\xcc\xb6\x2b\x9b\x63\x67\xca\x31\x60\xb4\xc6\x84\xd6\x94\x8e\x60\xb4\x60\xb4\x50\x8f\xa6\xc1\x60\xb4\x0e\xb3\x21\x20\x9b\x0b\xc8\xf6\xaa\x83\xad\x31\xda\x4a\x79\x80\xd7\x8a\x40\x1e\x80\xd1\x1a\xcd\x10\x01\x60\xb2\x86\xb5\x60\xb2\xad\x24\x58\x60\xb2\xdd\x41\x87\x60\xb2\x60\xb2\x60\xb2\x7b\xab\xaa\xc1\x64\x37\x34\xa2\xab\x92\x7a\x67\x4c\xb6\xe2\xbb\xa2\x06\xa6\x2c\xd8\x5b\x26\x3b\x7b\x8f\x75\xf5\xef\x83\x1a\xe3\x6a\xaa\x0e\x70\xd9\xbc\xa3\x39\x97\x7d\x55\x11\xb0\xa7\x28\xbc\xa0\xf0\x02\x2e\xdb\xa5\x0d\xb2\x33\x65\x19\xb0\x59\x63\x42\x6b\x4a\x48\xb0\x59\xb0\x59\xa8\x48\xd3\x60\xb0\x59\x87\xd9\x10\x92\xcd\x85\x64\x7b\x15\xc2\xf6\xd8\x6c\x95\x44\xc0\x9b\x52\x20\x11\xc0\x66\x8d\x66\x08\x01\xf0\xd9\xa7\x66\xcc\xe0\xb3\xe0\xb3\x9b\xfb\x1d\x7c\x96\xac\x15\x86\x4f\xf7\x2d\xf8\x2c\xf8\x2c\x94\x75\x89\x89\xe0\xb3\x90\xd5\x1d\xe2\xb3\x27\x15\xdf\x05\x35\x30\xdf\xe5\xf3\x4d\xf0\xd9\xca\xd7\xae\xac\x0e\xae\xa9\x3f\x40\x6a\xf3\x8e\xc6\xa4\xf6\xe4\xac\x22\x74\x5f\xa0\x0c\x83\x32\x0c\x48\x6d\x37\xb7\xca\xce\x94\x6a\xc0\x6c\x8d\x09\xad\x29\x2b\xc1\x6c\xc1\x6c\xa1\x2c\x4d\x83\xc1\x6c\x1d\x66\x43\x5c\x36\x17\x97\xed\xd5\x0a\x5b\x63\xb6\x95\x62\x01\xaf\x48\x81\x58\x00\xb3\x35\x9a\x21\x09\xe6\x66\x82\xde\xb6\x88\x26\x82\xde\x82\xde\xb6\xd2\xf1\xa0\xb7\xed\x71\x2c\xe8\x6d\xa1\x0f\x1a\x9b\xec\x46\x63\x83\xde\x6e\x68\x44\x57\x05\xf6\xae\xe8\xed\x69\xd5\xb7\x44\x99\x6f\xf2\xf9\x76\xe8\xed\x71\xc0\x92\x30\x9e\x4e\x98\x96\x6e\x9b\x68\x11\xe3\xc2\x9a\xba\x04\x54\x37\xef\x68\x4c\x75\x4f\x4f\xd6\x87\xf4\x49\x0f\x85\x1a\x14\x6a\x40\x75\xf7\x6b\x0b\xed\x4c\x69\x07\xb4\xd7\x98\xd0\x9a\x32\x14\xb4\x17\xb4\x17\x4a\xd4\x34\x18\xb4\xd7\x61\x36\xc4\x68\x73\x31\xda\x5e\x0d\xb1\x35\xda\x5b\x29\x22\xf0\x72\x15\x88\x08\xd0\x5e\xa3\x19\x52\x01\x14\x18\x14\x18\x14\xd8\xed\x6f\x50\x60\x50\xe0\xce\x39\x16\x14\xb8\xd0\x07\xed\x4d\x76\xa3\xbd\x41\x81\x37\x34\xa2\xab\xc2\x7b\x67\x14\xb8\xe2\xfb\xa8\x4e\x4c\xce\xb0\xb7\x14\x78\xc2\x94\xe0\xbe\xac\xfd\x0d\x42\xce\xf1\x35\x55\x08\x98\x6f\xde\xd1\x98\xf9\x0e\x7b\x15\x01\x7c\x82\x72\x0d\xca\x35\x60\xbe\x5d\xde\x30\x3b\x53\xb6\x01\xe1\x35\x26\xb4\xa6\xc4\x04\xe1\x05\xe1\x85\xca\x34\x0d\x06\xe1\x75\x98\x0d\xa1\xd9\x5c\x68\xb6\x57\x31\x6c\x8d\xf0\x56\x4a\x06\xbc\xa6\x05\x92\x01\x84\xd7\x68\x86\x30\x68\x24\x0c\xc0\x73\x0b\xc3\xc0\x73\xc1\x73\x37\xf7\x3b\x78\x2e\x59\x2b\x14\x9f\xee\x5b\xf0\x5c\xf0\x5c\x28\xed\x12\x13\xc1\x73\x21\xb3\x3b\xc4\x73\x87\x15\xdf\x55\x75\x62\x09\x9a\xbd\xe5\xb9\x71\xc4\x55\x2c\x78\x34\xaa\x2f\x44\x4a\x2e\xa9\xa9\x45\x40\x75\xf3\x8e\xe6\x54\xf7\x45\x45\x18\x9f\xa1\x44\x83\x12\x0d\xa8\x6e\xf7\xb7\xcd\xce\x94\x70\xc0\x76\x8d\x09\xad\x29\x37\xc1\x76\xc1\x76\xa1\x38\x4d\x83\xc1\x76\x1d\x66\x43\x74\x36\x17\x9d\xed\xd5\x0d\xdb\x63\xbb\x55\xc2\x01\x2f\x76\x81\x70\x00\xdb\x35\x9a\x21\x0f\x40\x78\x41\x78\x41\x78\x41\x78\x37\x76\x3c\x08\x6f\x7b\x1c\x0b\xc2\x5b\xe8\x83\xde\x26\xbb\xd1\xdb\x20\xbc\x1b\x1a\xd1\x55\xb1\xbd\x2b\xc2\x7b\x56\xf5\xfd\x54\xe6\xdb\x88\xf6\x96\xf0\xea\x0c\x5e\x1e\x07\x4c\xfa\x82\x27\xfa\x8c\x58\x23\x42\x56\xc6\xd6\x54\x1f\x60\xba\x79\x47\x63\xa6\x7b\x76\x5a\x11\xb8\xaf\x50\x9a\x41\x69\x06\x4c\xb7\x8b\x1b\x65\x67\xca\x34\xa0\xb8\xc6\x84\xd6\x94\x94\xa0\xb8\xa0\xb8\x50\x95\xa6\xc1\xa0\xb8\x0e\xb3\x21\x2c\x9b\x0b\xcb\xf6\x2a\x85\xad\x51\xdc\x2a\xa9\x70\x8a\x17\xb9\x40\x2a\x80\xe2\x1a\xcd\x10\x04\x4f\x12\x04\xe0\xb6\x85\x61\xe0\xb6\xe0\xb6\x9b\xfb\x1d\xdc\x96\xac\x15\x88\x4f\xf7\x2d\xb8\x2d\xb8\x2d\x14\x76\x89\x89\xe0\xb6\x90\xd7\x1d\xe2\xb6\x2f\x2a\xbe\x75\xea\xd4\x54\x10\x7b\xcb\x6d\x53\xc9\x84\xfc\x7d\x9d\xe6\x98\x8f\xa8\xa9\x34\xc0\x68\xf3\x8e\xc6\x8c\xf6\x45\xbf\x22\x48\x07\x28\xbc\xa0\xf0\x02\x46\xdb\xf6\x4d\xb1\x33\xe5\x17\xf0\x58\x63\x42\x6b\x4a\x45\xf0\x58\xf0\x58\xa8\x45\xd3\x60\xf0\x58\x87\xd9\x10\x8c\xcd\x05\x63\x7b\x55\xc1\xd6\x78\x6c\xa5\x2c\xc0\x4b\x56\x20\x0b\xc0\x63\x8d\x66\x24\xff\x8b\x3f\x60\xaf\x60\xaf\xed\x23\x55\x60\xaf\xdd\x41\x84\x60\xaf\x60\xaf\x60\xaf\x7b\xab\xa6\xc1\x5e\x37\x34\xa2\xab\x52\x7a\x67\xec\xb5\xe2\x5b\xa1\x4e\xcd\x37\xfe\xec\x2d\x7b\xbd\x67\x42\xea\xc7\x5b\x23\x35\x16\x43\x6a\x6a\x0d\xd0\xd7\xbc\xa3\x39\x7d\x7d\x59\x11\xa6\x43\x94\x59\x50\x66\x01\x7d\x6d\xff\xb6\xd8\x99\x12\x0c\xf8\xab\x31\xa1\x35\xe5\x22\xf8\x2b\xf8\x2b\x14\xa3\x69\x30\xf8\xab\xc3\x6c\x88\xc6\xe6\xa2\xb1\xbd\xba\x60\x7b\xfc\xb5\x4a\x18\xe0\x75\x28\x10\x06\xe0\xaf\x46\x33\xd2\xff\xfc\x0f\x08\x2c\x08\x6c\xfb\x78\x15\x08\x6c\x77\x40\x21\x08\x2c\x08\x2c\x08\xec\xde\xea\x69\x10\xd8\x0d\x8d\xe8\xaa\x98\xde\x15\x81\x7d\x59\xf5\x8d\x4e\xe6\x7b\x79\xf6\x96\xc0\x7e\xe1\xc1\xe3\x3a\xa1\x91\xf5\xd7\x54\x19\x60\xaf\x79\x47\x63\xf6\xfa\x72\x58\x11\xa0\x2f\x51\x62\x41\x89\x05\xec\xb5\xcd\x1b\x62\x67\xca\x2e\xa0\xae\xc6\x84\xd6\x94\x88\xa0\xae\xa0\xae\x50\x89\xa6\xc1\xa0\xae\x0e\xb3\x21\x14\x9b\x0b\xc5\xf6\x2a\x82\xad\x51\xd7\x4a\x49\x80\x17\x96\x40\x12\x80\xba\x1a\xcd\x48\xfc\x09\x78\x2b\x78\x6b\x2b\xe9\x14\x78\x6b\x77\xb0\x20\x78\x2b\x78\x2b\x78\xeb\xde\x2a\x69\xf0\xd6\x0d\x8d\x68\xbd\x8c\x3e\xb0\x3e\x4e\xef\x29\x7a\x8b\xd0\xbe\x38\xe9\xe5\xd3\xed\x49\x7f\xcc\x26\xf4\xfd\xfc\x17\x0a\xcf\x49\xff\x2c\xef\x52\xd3\xd9\x12\x0f\xa8\xb8\xcb\xaf\x50\x74\x64\x46\x9e\x27\xa7\x51\xc0\x24\x2f\x06\x91\xc7\xa3\x5b\x41\xbd\x95\xc7\x50\x6c\x92\x84\x54\xf1\x68\x64\x98\xed\x85\x5c\x2a\x2b\x9c\x57\x9c\x12\xbe\x9f\x67\xe3\x8e\xd5\x94\x0b\xd3\x75\x1a\x60\x75\xb1\x15\x24\xc0\x64\x9a\x88\xf8\x13\xf3\x1d\x61\xb4\x94\x01\xf9\x98\x75\x71\x5a\xaf\x80\x90\x2d\xcf\x5b\x1e\xf1\x85\xb4\xca\x82\xf5\x7a\xb6\xfa\x9f\xa5\xc9\x17\x7d\xde\xf1\xd9\x71\x96\x9d\x5d\x8f\x87\x64\x79\xac\xd9\x85\xe0\x45\x36\x65\x6f\x9e\x3c\xf2\xc3\x34\x60\x17\x61\x99\xd4\x5d\xae\x90\xbf\x2d\x6e\x6d\xdf\x79\x92\x86\x8a\x97\x5c\x3d\xdf\xde\xbc\xa8\xec\xe2\x38\x51\xce\xc3\x85\x10\xef\xb7\x94\x89\x69\x63\xbb\xf3\xb8\xb6\x7f\x21\x40\xb0\x11\x7b\x70\xac\x7d\x4f\xde\xf1\xe4\x9f\x22\x7c\x37\x8d\xfc\xb2\xaa\xc1\xec\x24\xb2\xef\xa8\xe8\x28\x0b\x41\xf9\x8f\xc5\xa3\xdb\xb7\x5e\xac\x0d\xdb\x56\xdd\x5e\x7e\xd1\xfc\x38\x9a\x39\xc4\xea\x4c\x25\xfb\x75\x76\xd3\xb5\xb5\x88\x6d\x2f\x96\xc5\x92\x3f\x9a\x25\x65\x6b\x96\x8c\x3d\x72\xed\xc2\x69\x1c\xc5\x17\x49\x12\x72\x7f\x56\x8b\x78\x42\x1c\x7f\x8a\x6f\xd6\x44\xb0\x65\xa3\xed\xbc\x1a\xf5\x06\x97\x07\x27\x4c\xd1\x15\xff\x39\x3d\x98\x8d\xb4\x06\x3e\xda\x57\xae\x7f\x2a\xd7\xa4\x6e\x30\xad\xb5\x27\xd6\x3c\xf5\xac\x73\xaf\xb8\xf8\x0d\xe3\x0e\xc9\xfa\x07\xa8\xb1\x74\x17\x8b\xc9\x4f\xa5\x8a\x27\xa5\x39\xe2\xd7\x5a\x2f\x0b\xc7\x5d\x58\xe5\x3a\xc3\x55\x76\xe0\x10\xe2\x7d\x77\x7d\x4d\xc3\xd0\xf6\xd9\xd5\x1f\x7d\xaa\xd4\x28\xc0\x14\xd5\xd2\xe3\x21\x49\x05\x2f\x3b\x6b\xec\x22\xb5\xb1\x4a\x1d\x79\xed\x72\x91\xbe\x65\x73\x33\x4a\x56\xa8\xe3\xda\xc5\x02\x4d\x05\x6f\x7c\xc4\x7c\x1d\x27\x7c\xc3\x07\xcf\xf2\xef\x8b\x78\x7d\xcc\xf3\x3b\x9e\xcd\x53\x21\xb3\xbb\x9d\x49\x4f\x2f\x8a\x3f\x1f\x9d\x14\xd5\x96\xa7\xe2\x79\xbb\xe7\xbc\x4d\xc2\xfd\xbb\xac\x30\x5d\xb8\xd9\xdc\xe9\xd7\x0b\xa5\x6d\x6f\xcb\xde\xd0\x82\x34\xfd\x9e\xd5\x70\x62\x37\xf4\x27\xe6\xcf\x43\xeb\xe7\xbe\xdd\x70\xd2\xb3\x47\x58\x1a\x72\x60\xfd\xdc\x0f\xf2\x75\x7e\x55\xb4\x9f\x4f\xd8\xb5\xfb\x78\xa9\x7e\x0a\xfb\x43\xcf\xec\x0f\xb5\x9f\x62\x70\x6a\x37\x58\xf4\xfb\x45\x60\xdb\x59\x7c\x6e\xe7\x14\xfd\x27\xce\x2a\xd1\x5e\x41\x1c\x2c\xca\x02\xef\xe6\x1b\x39\x39\x22\xaf\xb5\x14\x20\x47\xe4\xe2\xf2\x75\x3e\x30\xcd\x98\x95\xf7\xaf\xcb\xe8\xf2\xae\x17\xf2\x82\xbe\xb8\x5f\x6a\x91\xd9\xd7\xe4\x3c\x1e\xfc\x2f\x00\x00\xff\xff\x0f\xef\x44\x8b\x46\x91\x01\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x9d\x5f\x6f\xdb\x38\xd6\xc6\xef\xfd\x29\xf4\x12\x2f\x16\xe9\xc2\x49\xfc\xa7\x4e\x1b\x03\x7b\xd1\xce\x4c\x17\x05\xba\xd8\xa2\x33\x98\x8b\x6d\x83\x80\x91\x4e\x6c\xb6\x32\xa9\x21\x29\x37\x46\xd7\xfb\xd9\x17\x94\x1d\x47\xb4\x29\x3b\x13\x55\x69\xbb\x7a\x10\x60\x26\x26\x29\x89\x3c\x3c\x3a\x3c\xcf\xf9\xb5\x2e\xcf\xc4\xef\xa4\x8d\x50\x72\x1c\x09\x69\x69\xa2\x89\xdb\x74\x71\xa2\xf4\xe4\x74\xde\xe7\x69\x36\xe5\xfd\xce\x27\x21\x93\x71\xf4\x77\xcd\xaf\xb9\xe4\x3f\x73\x33\xbd\x52\x5c\x27\x9d\x19\x59\x9e\x70\xcb\xc7\x9d\x28\x92\x7c\x46\xe3\xc8\x2c\x64\x42\x46\x98\x63\x21\xaf\x35\x3f\xe6\x99\x38\x4e\x36\xc3\xa3\x28\xe5\x57\x94\x1a\x37\x3c\x8a\x78\x96\xdd\x8d\x2f\x5a\x6e\x3f\x9c\x08\x75\xba\xbf\xd7\x2e\x32\x72\xd3\xbd\xd6\xdc\x58\x9d\xc7\x36\xd7\xd4\x31\x19\xc5\xf7\x9b\xc9\xc9\x47\xa3\x64\x27\x8a\xdc\xff\xc6\xd1\xbf\x8b\x87\x7f\x29\xfe\x1b\x45\x8c\x4b\xa9\x2c\xb7\x42\x49\xc3\xc6\x9b\xe6\x28\x62\xa9\x30\x96\x8d\xa3\xf7\x9b\x96\xbb\x8b\xd6\x23\xae\x72\x91\xda\xd7\x92\x8d\xa3\x7e\xd7\xef\x71\x56\x32\x2a\xd7\x31\xb1\x71\xc4\x8e\x8f\x6f\x6d\x19\x1d\x1f\xb3\xad\xa1\x24\xf9\x55\xea\x86\x59\x9d\xd3\x56\xdf\x54\x24\x15\x3d\x22\x56\xf2\x27\x95\x2a\xed\xee\xaf\x27\x57\xfc\xa8\xd7\x8d\x06\xfd\x7e\x37\x1a\x8c\x46\xdd\xa8\xff\x64\xfb\x31\xce\x4a\x6e\xec\x8b\xbb\xe5\x46\x7f\x89\x5e\xa4\xa4\xad\xd9\x1e\xeb\xcc\xed\xc6\x6e\x0c\xc8\x4a\xfd\xcb\xcd\xef\x17\xeb\xdf\x96\xb7\x97\x33\x4a\x84\x0d\xac\x86\x4d\x24\xd9\xd7\x09\x1b\x47\x32\x4f\xd3\xbb\x56\xcd\xb3\xe9\x6f\x4a\xa5\x56\x64\x6c\x1c\xf5\x36\x1d\xc2\x0d\x1d\x94\x3e\x5b\xd2\xc5\x94\x9d\xa5\x47\x67\x83\xb3\xde\xf9\xd9\x59\xaf\x3f\xdc\x58\x9d\xa5\x42\x7e\x32\xde\x66\x95\xb7\xaa\xb0\x96\x5b\x10\xdd\x58\xd2\x92\xa7\x91\x1b\xef\xad\x9a\x09\x19\xa7\x79\x42\xbf\x73\x6d\x02\x16\x67\x96\x4f\xfc\xfb\xbb\x1f\x76\xeb\x74\xdb\x06\x2c\x3c\xb5\x6c\xb4\x8b\x6e\x67\x9f\x7d\x0d\xeb\x6c\x9b\x77\x73\x05\xcb\xb8\xa4\x74\xcf\xe2\x62\x95\xa6\x3c\x33\xe4\x8c\x76\xcd\x53\xe3\x4f\x7c\xa2\x45\xf2\x56\xf9\xae\xed\x7e\xd8\x34\xe0\xb6\x9f\x9d\xdd\x9f\x6e\x35\xde\x94\xf7\x66\xdd\xb6\x70\x6d\xa5\xa6\x8d\x0b\x94\xf6\xcf\x6b\xba\x5b\x84\x6f\x0a\x4d\x19\x71\xf7\x9a\xb1\x5c\x0b\x7f\x47\x4c\xac\x32\x4a\xd6\x1b\xb2\x35\x79\x37\x78\xbb\xd1\xed\x07\xa5\x14\xdb\xb0\x25\xdc\x0f\xb3\x74\x53\x3c\xec\x34\x56\x52\x52\xec\x7c\x6a\x7b\xf3\xa2\x88\xcd\x79\x9a\xd3\xce\x30\x6f\xd4\xb2\x72\xf1\x56\xd8\xe2\x05\x60\xef\xdf\xe7\x5a\x5c\x5c\xb0\xe0\xd6\x6b\xf5\x99\x75\x02\x37\x28\x2f\x89\xf1\x54\x70\x53\xbc\xe5\xc5\xfe\xf9\xcf\xb9\x5a\x19\x66\x77\xc7\xdd\x5b\xfb\x86\xe4\xc4\x16\x3b\xec\xed\x5c\xe1\x71\x54\x75\x59\x39\x64\xbd\xd5\x6a\x46\x76\x4a\xb9\x6f\x1e\x76\x2d\xd2\x74\xdb\x71\xf6\xfb\xd8\x59\xc0\xc7\xfa\x83\x7b\xfa\x58\xbf\xd4\xe4\x2f\x5f\x24\x3b\xf7\x61\x29\x4d\x48\x26\xbb\xd3\xe0\xa9\x98\xc8\x17\xe6\xb7\x75\x68\xda\x59\xbb\x1b\x32\x9f\x54\xf4\xc4\xb9\xd6\x24\x6d\x45\xaf\x8b\xd0\xbf\xcc\x32\xbb\xd8\xd3\xff\x2f\xd2\xaa\xa2\x7b\xc6\x6f\xaa\x7a\x84\xac\xe8\xd1\x62\x32\xb5\xbf\xae\x4e\x86\x50\xbf\x99\xaa\xcf\x81\x10\xe6\xbc\x5f\x59\x9e\x56\x5c\x55\xf8\xfc\xc6\x33\x2a\xcd\x9e\x0a\x49\xc1\x00\xe9\x3a\x3e\x8b\xc4\xee\x84\x95\xbb\xd8\xec\xbf\xf9\xee\x28\x78\xab\x84\xb4\xff\x50\xc5\x52\x8a\x06\xdf\xd9\x32\xd2\x31\x49\xcb\x27\xc1\xa5\xb2\xcc\x5d\xad\x79\x22\x72\x37\xa3\xd1\x6e\x5f\xd8\xd1\x35\xc9\x84\x34\x15\x27\xe7\x75\xaa\x2c\x0b\x07\xa4\xf2\x51\x55\xea\xf9\x59\xe8\x55\x40\x70\x97\x4f\xff\x27\x22\x96\x21\x2d\xc8\xfc\x73\x4e\x5a\x8b\x84\x02\x5b\x65\x32\x1e\x53\x55\x48\x31\x96\xc7\x9f\x82\x86\x36\x96\xb2\x8c\x92\x37\x42\x86\xf7\xcf\x72\x3d\x21\xbb\x7b\xac\xee\x18\x89\x6e\xb2\x62\xb7\x4c\x3e\x3b\xd2\xdc\xd2\xd1\xd4\xda\xec\xd2\x90\x9e\x93\xbe\xd4\xf4\x47\x4e\xc6\x9a\x4b\x43\xb1\x92\x89\xb9\x8c\x55\x2e\xed\x17\x97\xef\x14\xf3\xfe\xdb\x07\xf6\xff\x9b\x0f\x1f\x58\xf7\xa3\xba\x72\x4d\x1f\xd5\xd5\x07\xd6\xcd\xb5\x70\x1f\x72\x2d\x3e\xb0\xe5\xfb\xd1\xec\xe2\xc9\x76\xe2\xe4\x62\x9e\xd2\xb3\xc2\x25\x98\x15\x33\x72\xcf\x15\x14\xd8\x03\x97\x4d\xeb\x39\x4f\x5f\xf1\xd8\x2a\xbd\xfd\x1a\x94\x02\xd4\xab\xcd\xfd\x6e\xe7\x1e\x65\xa4\xa3\xd5\xfc\x77\xef\xab\xe9\xba\xc8\x9d\xd8\x8b\xad\x0d\xed\x7e\x0f\x56\xeb\x1a\xcb\x6d\x6e\xfe\xef\x3f\x1f\xd8\xe0\xe4\xe4\x9b\x58\x91\xb4\x56\xfa\x9e\x36\x7c\x59\xfd\x52\xf8\x3e\x6f\xa7\x9a\xcc\x54\xa5\x49\xe0\x7d\x70\x6e\xf0\x4a\xab\x59\x28\x4a\xb8\xbe\x77\x34\x59\xcb\x89\xc0\x85\xbf\x4e\xc5\x75\x30\xbe\x6c\x12\x87\x77\x07\xbc\x82\xd9\x4d\xce\xec\x6f\x3a\x33\x53\xae\x29\x09\x84\x67\xd7\xa7\xb4\x0d\x9c\xb2\x45\xe4\xb8\xbc\x4d\x4b\x84\x4c\xc4\x5c\x24\x39\x4f\x59\x65\xb8\xb0\x9a\x4b\x93\xf1\xaa\x43\x71\x93\xe2\x14\xe9\xbd\x3f\xf1\x1b\x7e\x23\x02\xb1\xf1\x2a\x8f\x3f\xad\x02\xc1\xb6\x51\xdc\x11\xb9\x3e\x1e\x9c\x61\x2b\x44\x4d\xe0\xaa\xea\x43\x70\x73\xd0\xbd\xbf\xa8\x5c\xe2\x82\xdf\xd0\x3d\xe2\x52\x42\xb1\x98\xf1\xd4\xec\x9a\xd5\xf3\x77\x33\x75\xa6\xdf\x19\x50\x88\x63\x67\xf4\x40\x97\x9a\xbc\xe4\x86\x82\xde\xbf\x4a\x19\x76\x97\xbc\xc9\x19\x58\x6f\xf7\x86\x25\x73\x78\x5d\xcb\xee\xfe\x15\x7e\xb3\x45\x04\xbb\x2a\x57\x51\xfa\xe4\xbf\x70\x8b\xb0\xc3\x15\x09\x61\xc8\x79\x6f\xfb\xde\xd0\xbc\x58\x96\x9b\x46\x27\x14\x2e\x4a\x86\xfb\x41\x52\xf6\x5e\x03\x29\x7b\x7f\xf0\x80\x9c\x7d\x88\x9c\x1d\x39\x7b\x03\x39\x3b\x32\xf3\x6f\x97\x99\x1f\xdd\x2f\xc9\x34\xf9\xec\xe1\x89\x79\x74\x1a\x35\x9b\xca\xae\x9f\xf3\x24\xfa\x6b\xd4\xef\xf5\x7a\x6c\xcf\x91\xbe\x37\x85\x5d\x57\x6d\x77\xcc\xfa\xd0\xfc\x96\xcf\x49\xbb\xf7\xa9\x3a\xab\x7d\xa0\x32\x98\x0a\x63\xd5\x44\xf3\xd9\xe5\x1f\x39\x97\x56\xa4\x74\xd4\x3b\x19\x75\xef\x69\xe6\x55\xd6\x56\x63\x3f\xaf\x16\xd1\x51\x4a\xdf\x9f\xbd\x47\x3d\x3b\x8d\xd6\x71\x4c\xa4\xfb\xec\xfe\xd3\x57\xb4\xfb\x79\xef\x87\x33\xfc\x03\x6c\x7b\x7e\x6f\xdb\x6e\x2b\xb5\x87\xdb\xb6\xdf\x06\xc3\xba\x8c\xba\xda\x98\xbf\x6c\x19\xb3\xf4\xe9\xbb\x91\xbd\x6f\xb8\x25\x19\x2f\x20\x75\x7f\x60\xa9\x3b\x33\x8f\x27\x11\xa1\x73\xbf\xa1\xce\xfd\x56\xd0\xf1\x59\xa7\x62\x53\xd7\xe2\xd2\xbb\x4f\x09\x9d\x5e\xfc\xa9\x22\xff\xeb\x43\xc0\xb9\x34\xf6\xad\x7b\xc6\xeb\x5d\xe2\xf9\x58\x92\xe3\xf4\x8b\x48\x96\xf7\xd4\x1d\xab\xb1\x95\x3e\xb5\x0c\x07\x66\x80\xcc\xfa\x20\xf3\x79\xa9\xc9\x5f\xfe\xca\x6f\x47\x28\x8a\xa0\x28\xd2\x40\x51\xe4\x61\x20\xb3\x4e\x14\xec\x0f\x02\xdd\x94\xbc\x5c\xbc\x0b\xee\xe6\x8f\x1f\x27\x81\x4f\x81\x4f\x81\x4f\x81\x4f\x81\x4f\x81\x4f\x81\x4f\x81\x4f\x6b\xe2\xd3\x43\x4a\xc1\xbb\xfb\x3a\x98\x43\x29\x40\x29\xd4\x55\x0a\x8d\xe9\x81\x21\xf4\xc0\xf7\xa9\x07\x00\x6d\x01\x6d\x01\x6d\x01\x6d\x01\x6d\x01\x6d\x01\x6d\x01\x6d\x01\x6d\x01\x6d\x1b\x81\xb6\xfd\xa7\x9d\x8a\x5d\x5d\x6b\xda\x67\xed\xa0\xb6\x34\x27\x69\x4f\x35\xb9\xa3\x6c\xfd\x37\xce\xf7\xe8\x8f\xc0\xe8\x4a\xc7\x5a\x86\xa3\x33\xc8\x6d\x7d\x72\xdb\x1f\x95\xda\xfc\xf5\xaf\x9c\xf7\x39\x0a\x32\x28\xc8\x34\x50\x90\x69\x39\xba\x7d\xac\x60\x09\x7c\x0b\x7c\x0b\x7c\x0b\x7c\x0b\x7c\x0b\x7c\x0b\x7c\x0b\x7c\x5b\x13\xdf\x1e\x94\x0b\xe7\x90\x0b\x90\x0b\x0d\xc8\x85\x56\xf0\x5b\x88\x82\x2d\x51\x00\x86\x0b\x86\x0b\x86\x0b\x86\x0b\x86\x0b\x86\x0b\x86\x0b\x86\x0b\x86\x0b\x86\xdb\x08\xc3\x1d\x1c\xf8\x5a\xa7\x41\xaf\x25\x0c\xf7\xc6\x92\x34\x07\x95\xc7\xdd\xa8\x4a\x47\x5a\x86\xa3\x31\x98\x6d\x7d\x66\x3b\x18\x94\xda\xfc\xf5\xaf\x9c\xb5\x8f\x22\x0c\x8a\x30\x0d\x14\x61\xda\xce\x6c\x1b\x0a\x8e\x60\xb4\x60\xb4\x60\xb4\x60\xb4\x60\xb4\x60\xb4\x60\xb4\x60\xb4\x35\x19\xed\x41\x79\xe0\x5d\xb4\x8e\xe6\x90\x07\x90\x07\x75\xe5\x41\x3b\x18\x6d\xdb\x45\x00\x98\x2c\x98\x2c\x98\x2c\x98\x2c\x98\x2c\x98\x2c\x98\x2c\x98\x2c\x98\x2c\x98\x6c\x33\x4c\xf6\xc0\x77\x45\x0d\x86\xed\x60\xb2\xee\xe8\x9d\xe8\xc3\x7f\x1e\xd4\x1b\x57\xe9\x4c\xcb\x70\x44\x06\x97\xfd\x0a\x5c\xf6\xbc\xd4\xe6\xaf\x5f\x24\x3b\x9e\xbf\x3e\xd6\x51\x78\x41\xe1\xa5\x6e\xe1\xa5\xe5\x5c\xb6\xc9\x00\x09\x36\x0b\x36\x0b\x36\x0b\x36\x0b\x36\x0b\x36\x0b\x36\x0b\x36\x5b\x97\xcd\x1e\x92\x08\x23\x48\x04\x48\x84\x06\x24\x42\x2b\xd8\x6c\xcb\x85\xc0\x4a\x08\x80\xcf\x82\xcf\x82\xcf\x82\xcf\x82\xcf\x82\xcf\x82\xcf\x82\xcf\x82\xcf\x82\xcf\x36\xc2\x67\x87\x07\xbe\x0b\x6a\x70\xd6\x3e\x3e\x7b\xf0\x9f\x5d\xd9\x1d\x5c\xe9\x56\xcb\x70\x6c\x06\xa9\xad\x4f\x6a\x87\x67\xa5\x36\x7f\xfd\x2b\xd7\x7d\x86\x32\x0c\xca\x30\x0d\x94\x61\x40\x6a\x37\xa4\xb6\xb9\x50\x09\x66\x0b\x66\x0b\x66\x0b\x66\x0b\x66\x0b\x66\x0b\x66\x0b\x66\x5b\x93\xd9\x1e\x14\x0b\xcf\xc1\x6c\xc1\x6c\xc1\x6c\xeb\x33\xdb\x96\x4b\x02\x27\x09\x40\x6f\x41\x6f\x41\x6f\x41\x6f\x41\x6f\x41\x6f\x41\x6f\x41\x6f\x41\x6f\x41\x6f\x1b\xa1\xb7\x4f\x0f\x7d\x4b\xd4\x79\x4b\xe9\xed\x69\x42\x59\xaa\x16\x33\x72\xd2\xed\xcf\x68\x11\xef\xc2\x4a\x77\x5b\x86\x63\x36\xa8\x6e\x7d\xaa\xfb\x74\x58\x6a\xf3\xd7\x5f\xb8\xf4\xb0\x87\x42\x0d\x0a\x35\x0d\x14\x6a\x40\x75\x7d\xaa\xfb\xa8\x21\x14\xb4\x17\xb4\x17\xb4\x17\xb4\x17\xb4\x17\xb4\x17\xb4\x17\xb4\xb7\x26\xed\x3d\x28\x22\x3c\x77\x58\x47\x73\x88\x08\x88\x88\xba\x22\xa2\x9d\xb4\x17\x52\x21\x24\x15\x40\x81\x41\x81\x41\x81\x41\x81\x41\x81\x41\x81\x41\x81\x41\x81\x41\x81\x41\x81\x9b\xa1\xc0\x07\xbe\x8f\x6a\x38\x68\x07\x05\x9e\x91\xd5\x22\x36\x9e\xcc\xd8\x27\x47\x82\xe3\x2b\x9d\x6b\x19\x8e\xd0\x60\xbe\xf5\x99\xef\xa8\x57\x6a\xf3\xd7\xbf\x72\xe0\x21\xca\x35\x28\xd7\x34\x50\xae\x69\x39\xf3\x7d\xcc\x80\x09\xc2\x0b\xc2\x0b\xc2\x0b\xc2\x0b\xc2\x0b\xc2\x0b\xc2\x0b\xc2\x5b\x93\xf0\x1e\x94\x0c\x9e\x78\x5e\x47\x73\x48\x06\x48\x86\xba\x92\xa1\x15\x84\x17\xc2\x20\x20\x0c\xc0\x73\xc1\x73\xc1\x73\xc1\x73\xc1\x73\xc1\x73\xc1\x73\xc1\x73\xc1\x73\xc1\x73\x1b\xe1\xb9\xa3\x03\xdf\x55\x35\x1c\xb5\x84\xe7\x2a\x29\xac\xd2\x42\x4e\xee\x2f\x44\x2a\x2e\xa9\x74\xb1\x65\x38\x4e\x83\xea\x7e\x05\xaa\xfb\xac\xd4\xe6\xaf\x7f\xe5\xc6\xde\xdd\xd7\x87\x3d\x4a\x34\x28\xd1\xd4\x2d\xd1\xb4\x9d\xea\x3e\x7a\xd8\x04\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\xad\xc7\x76\xff\xcb\xde\x99\xf4\xb6\xad\x03\x71\xfc\xee\x4f\x21\x10\xef\xf0\x0e\x36\x9e\xd7\xd8\xc9\xcd\xc7\x5c\x1e\xd2\xa6\x0d\xd0\x43\x61\xa8\x32\xe3\x32\x96\x65\x55\x4b\x5b\x37\x50\x3f\x7b\xa1\xcd\x22\xc7\x5a\x9c\xc8\xca\xa1\xfc\x03\xbe\x88\xa2\x28\x2e\x33\x1c\xfe\xe7\x97\xaa\xcd\xc2\x61\x0e\xb6\x0b\xb6\x0b\xb6\xfb\x4a\xb6\x0b\x79\x50\x25\x0f\x40\x78\x41\x78\x41\x78\x41\x78\x41\x78\x41\x78\x41\x78\x41\x78\x41\x78\x41\x78\x3b\x21\xbc\x57\x4d\xdf\xa7\x5a\xe8\x41\x78\xe3\x04\xbf\xff\xdf\x9a\xfb\x96\x27\xdc\x38\x46\xd4\x88\x90\x93\xba\x95\x46\x15\x95\xef\xcc\x60\xba\xed\x99\xee\xd5\x54\x2a\x53\xc7\x9f\x1a\xee\x35\x52\x33\x48\xcd\x74\x90\x9a\xd1\x9c\xe9\xbe\xc5\x46\x09\x8a\x0b\x8a\x0b\x8a\x0b\x8a\x0b\x8a\x0b\x8a\x0b\x8a\x0b\x8a\xdb\x92\xe2\x36\x49\x85\xe9\x10\x52\x01\x52\xa1\x03\xa9\xa0\x05\xc5\x85\x20\x90\x04\x01\xb8\x2d\xb8\x2d\xb8\x2d\xb8\x2d\xb8\x2d\xb8\x2d\xb8\x2d\xb8\x2d\xb8\x2d\xb8\x6d\x27\xdc\x76\xde\xf0\xd5\xa9\xe9\x48\x0f\x6e\x1b\xfa\xdc\xf3\x7f\xd7\x69\x8e\xac\x46\xa5\x01\x45\xe5\xbb\x30\x18\x6d\x7b\x46\x3b\x1f\x49\x65\xea\xf8\x53\x23\x1d\x23\xf1\x82\xc4\x4b\x07\x89\x17\xcd\x19\xed\xa5\x37\x45\xf0\x58\xf0\x58\xf0\x58\xf0\x58\xf0\x58\xf0\x58\xf0\x58\xf0\xd8\x96\x3c\xb6\x51\x16\x4c\x20\x0b\x20\x0b\x3a\x90\x05\x5a\xf0\x58\x6d\x0f\xff\x60\xaf\x60\xaf\x60\xaf\x60\xaf\x60\xaf\x60\xaf\x60\xaf\x60\xaf\x60\xaf\x60\xaf\xdd\xb0\xd7\x86\xaf\x42\x4d\xa7\x7a\xb0\xd7\xef\xdc\xf3\xe3\xee\xd5\x48\x8d\xbc\x4a\xa5\x09\x45\xe5\xfb\x30\xe8\xeb\x05\xe8\xeb\x42\x2a\x53\xc7\x9f\x9a\xe9\x0c\x69\x16\xa4\x59\x3a\x48\xb3\x68\x4e\x5f\x2f\xbf\x2d\x82\xbf\x82\xbf\x82\xbf\x82\xbf\x82\xbf\x82\xbf\x82\xbf\x82\xbf\xb6\xe5\xaf\x4d\xc2\x40\x69\x3e\xdb\xcd\x21\x0c\x20\x0c\xda\x0a\x03\x2d\xf8\xab\xc6\xc7\x7f\x10\x58\x10\x58\x10\x58\x10\x58\x10\x58\x10\x58\x10\x58\x10\x58\x10\x58\x10\xd8\x4e\x08\xec\xa2\xe9\x8b\x4e\x73\x3d\x08\xec\xb3\x58\x47\x75\x42\x23\xb9\x5f\x69\x3c\x51\xf9\x0e\x0c\xf6\xda\x9e\xbd\x2e\x66\x52\x99\x3a\xfe\xd4\x40\x17\x48\xb1\x20\xc5\xd2\x41\x8a\x45\x73\xf6\x7a\xc9\x0d\x11\xd4\x15\xd4\x15\xd4\x15\xd4\x15\xd4\x15\xd4\x15\xd4\x15\xd4\xb5\x25\x75\x6d\x94\x04\xd7\xa0\xae\xa0\xae\xa0\xae\xaf\xa3\xae\x5a\x1e\xfc\xc1\x5b\xc1\x5b\xc1\x5b\xc1\x5b\xc1\x5b\xc1\x5b\xc1\x5b\xc1\x5b\xc1\x5b\xff\x16\xde\xda\x23\xaf\x8b\xf7\x14\x8f\xfb\xb1\x20\x61\x93\x61\xb1\xdc\xcc\xb7\xbe\xf2\x9d\xf9\x90\xfd\x41\xe1\x8d\x31\x3a\x8a\x54\xe6\x07\x87\xd4\xc5\xd7\xa6\xb7\x2d\x9e\x08\xcc\x8d\x6a\x79\xcc\x3f\x38\x6b\xee\x0b\xd9\x88\x98\x70\x1e\x3d\x93\x9d\x74\x23\xe0\x3b\xd7\x36\x03\xe1\x6c\x94\x61\x33\x5b\xf8\x01\x31\xe7\x93\x49\xb1\x1f\xb2\xd3\xf8\xe9\xec\x4b\xc2\xb4\x4e\x03\x9c\x3a\x9b\x24\x01\x76\x07\xd7\xdb\x3f\x71\x2b\xa8\x91\x01\x45\x9d\x3a\x3b\x3d\x2f\x81\x10\xd7\xe3\x8f\xc2\x11\xb9\xb4\x4a\x3c\x6e\x95\x7a\xff\xbf\xa1\xfb\x1c\xc7\x3b\x91\x86\xb3\xe4\x50\x10\xf5\x8d\x63\x58\xa3\x89\xe0\xfc\x34\x45\x37\x4f\xe1\x58\x76\xb8\xe6\x4b\xbb\x4a\xea\x1e\x3d\xe4\xff\xbc\x69\xda\xf2\x2e\xb4\x03\x51\xf1\x74\xb6\xbd\x31\xa7\xea\xe1\xbd\x1b\x94\x06\x17\xc3\x60\xdf\x42\xee\x1d\x5a\x8f\xbb\xb0\x6b\xe2\xc6\xcc\xe3\x1b\xfe\xb3\xc4\xf7\x99\xbf\x15\xee\x47\xcf\xbe\x3f\x38\x56\xc5\xb0\xb2\x48\x44\x5b\x0c\xcc\x4d\x62\x82\xfe\xbb\xbc\xeb\xb4\xe9\xdc\x37\xe8\x58\xe3\xf2\xea\x87\xb2\x70\x94\x4e\x08\xb9\x19\xfa\xfc\x83\xb9\x69\xcc\x45\xbc\xb5\xb3\xe4\x2e\x3f\x88\x8d\x94\xd7\xfd\x9f\x3d\xb4\x66\xad\xe3\xb4\xb6\xe2\xa5\xeb\xda\xc2\x4a\x73\x11\xaf\xb0\xe3\xa7\xfd\x97\x1a\x0b\x56\x6e\xd0\x39\xa7\xd3\x57\xf2\x9a\xf2\x19\xdc\xf1\xc0\x24\x2f\xad\x98\xc1\xa4\x26\xa9\x18\xf5\x5f\xd4\xab\xb2\x45\x7d\xc1\xb2\x9e\xbd\xb0\x72\x30\x52\x22\x00\x75\xfe\x63\x2b\xf1\xe0\xfa\x46\x7d\x07\xce\x70\xdd\xdc\x99\xac\xd0\x0f\xf6\x3b\xd6\xab\x98\xa9\x4b\xf9\x4b\x3e\x71\x4b\x92\xae\x53\xa6\x4a\x8e\x6a\xd9\xad\x7f\x56\x2b\xd3\x56\x8e\xb0\xf1\xef\x73\xaf\x66\x69\x2f\x11\x55\x6a\x75\x54\x9a\x80\x91\xd5\x52\xd4\x37\x42\x4f\x54\xc5\x9a\x71\x9d\x97\x9e\x1a\x5a\xe1\xa4\xef\x79\x36\x8c\x0a\x0f\x2d\x79\x36\x77\xd0\x38\xcf\x57\xed\xa0\xe7\x85\x98\xcb\x4c\x82\xc6\x81\xa7\x47\xed\xf5\x68\xa9\x89\xa0\x54\xfc\x84\x3d\xa6\xd2\x93\x39\xfb\x1f\x83\x89\xac\xb6\x58\xb0\xcf\xca\x59\x69\x33\xae\xb0\xb6\xdc\x53\x1b\xcb\x26\x7d\x95\x2b\x6d\xba\x2d\xb3\x99\xea\x0d\x6c\x24\x9d\x75\xe3\x9f\x72\xf8\x8d\x7f\x6c\xb4\x53\xaf\x67\xe4\x7a\x44\x0b\x26\x43\x5a\x83\x68\xc8\x31\xb9\x1e\xad\x0b\x3f\x97\xd6\x27\x99\xab\x55\x79\x78\x69\xee\x05\x7d\xe9\x15\x7d\x29\xed\xc5\x78\x4a\x0b\x08\xfd\x9e\x93\xeb\xc9\x50\xee\x77\xe9\x12\xfd\xda\x3b\x5c\x31\xac\x22\x2d\x70\x9f\x6d\xe4\xc6\xc0\xb8\x8d\xa5\x80\x31\x30\x96\x77\xb7\x45\xc5\x30\xf9\x33\x36\xf6\xe9\xce\xb9\xdb\x0e\x6d\x21\xe9\x8b\xfc\x1f\x37\xdd\x18\xf3\x9e\x61\x18\x46\xd4\xfb\x33\x00\x0f\xef\x44\x8b\x46\x91\x01\x00"),
 		},
 		"/addons/ops/addon-ops-db-alerting-rules.yml": &vfsgen۰CompressedFileInfo{
 			name:             "addon-ops-db-alerting-rules.yml",
 			modTime:          time.Time{},
 			uncompressedSize: 1539,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x54\x41\x6b\xdb\x4c\x10\xbd\xfb\x57\x0c\xce\x77\x48\x20\xb2\xbe\x1c\x7a\x59\x48\xa0\x90\x16\x7a\x4a\xc8\xa1\x57\x33\xd2\x8e\xe5\x6d\xa4\x9d\x65\x66\xe4\x44\x84\xfc\xf7\x22\x59\x96\x65\x48\x8b\xa1\x3d\xd4\xa7\xd5\x68\xe6\xbd\x37\x6f\x9f\x85\x29\x7c\x27\xd1\xc0\xd1\x41\xc3\x31\x18\x4b\x88\xd5\xaa\x64\x21\xd6\x55\xc9\x4d\xbe\xbb\x59\x3c\x87\xe8\x1d\x3c\x0a\x37\x64\x5b\x6a\xf5\xa9\xad\x69\xd1\x90\xa1\x47\x43\xb7\x00\xa8\xb1\xa0\x5a\xfb\x13\x00\xa6\xe4\x40\xbb\xe8\x49\x83\x0e\x95\xc3\xc3\x2a\x70\xfe\xfb\xb7\xd6\x25\x72\x10\xe2\x46\x50\x4d\xda\xd2\x5a\xa1\xa1\x29\x4d\xdc\xae\x27\xa8\x43\x89\x16\x38\x66\x47\xc9\x43\x9b\x70\x4d\x0e\xb0\x26\xb1\x4c\xda\x9a\xf6\x0c\xc7\xa6\xec\x99\x3a\x07\x4d\xf0\xbe\xa6\x17\x1c\xb1\x3f\xc6\x73\xb0\x34\x69\x69\xb9\x00\x88\xd8\xd0\x51\x74\x36\xc8\xcb\x7c\x91\x0d\x3c\x3d\xea\x9e\x4a\x13\x95\xbd\x05\x95\x70\x9b\x46\x33\xb2\x73\x87\x61\xf8\x0d\x67\x37\x3e\x00\x5c\xc0\xe7\xbe\x0b\x36\x2c\x80\xb1\x3b\xa2\xf8\x02\x42\x54\xc3\x58\x12\xd8\x16\x0d\x82\x82\xe7\x97\x38\x4d\x66\x7b\x13\x1c\x7c\x6d\x95\x1e\x62\x1d\x22\xdd\xa3\x61\x81\x4a\xdf\xc6\xc1\xfb\x79\x3f\x00\xc6\xc8\x36\x98\x30\x13\x30\xb8\x47\xaa\x58\x91\x83\xbb\xec\xa4\x0e\x03\x36\xec\xc1\xe1\x91\xd5\x2a\x21\x3d\xea\x7a\x7b\xfb\x6f\x1f\x8b\x55\x62\xff\xfe\x0e\x21\x0e\x5e\x68\xc2\x93\x97\x53\xad\x6f\xd9\x6f\xb1\x3a\xe1\x51\x4e\xeb\x56\x6a\x07\x5b\xb3\xa4\x2e\xcf\xab\x60\xdb\xb6\x18\xb2\x79\x30\x24\xf0\x74\xcc\x8b\x9a\x8b\xbc\x41\x35\x92\xdc\x73\x99\x37\x18\xb1\x0a\xb1\x5a\x53\xdc\x05\xe1\xd8\x50\x34\xcd\x8d\x53\x28\x35\x3f\xdc\xc2\x5a\x39\xad\xd0\x73\x79\xb1\x69\x95\x78\xd8\xc9\x8f\x86\x1d\x36\xf2\xa7\x86\xd1\x6b\x12\x07\x77\x27\x5a\x2f\xb5\x6d\x2e\x53\xb5\x6e\xd3\xdb\x0f\x2e\x6e\x97\xb3\x0b\x5b\xbe\x5f\x41\xd1\x5d\x4e\xeb\x5e\x43\x62\x7f\x3d\xd9\x75\x05\xb7\xb7\xf0\xff\x15\x3c\x3c\x01\x16\x4a\xd1\x7e\x0d\x33\x63\xdc\xb0\x38\xf8\xd4\xcc\x2a\xf3\x7f\xe2\xe4\x20\xed\x48\x82\x75\x0e\x4a\x09\x16\x4a\xac\xcf\x4a\xd8\x74\xa5\x5f\x5e\x13\x8b\x91\x9c\x1d\xb5\xc3\xe4\x61\xf0\xcf\xa3\x36\xd7\xb5\x45\x05\x1f\x14\x53\x22\x14\xf2\xb0\x11\x6e\x66\x1f\x27\x30\x94\x8a\xac\x6f\x29\x79\x47\xd2\xfd\x23\x71\x4a\xa3\x29\x34\x9a\x72\x46\x9c\xc6\x24\x7c\x18\x83\x3e\x2f\x37\x7f\x25\x0b\x3f\x03\x00\x00\xff\xff\x88\x74\x6f\xf8\x03\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x54\x41\x6b\xdb\x4c\x10\xbd\xfb\x57\x0c\xce\x77\x48\x20\xb2\xbe\x1c\x7a\x59\x48\xa0\x90\x16\x7a\x4a\xc8\xa1\x57\x33\xd2\x8e\xe5\x6d\xa4\x9d\x65\x66\xe4\x44\x84\xfc\xf7\x22\x59\x92\x6d\x48\x8b\xa1\x3d\x54\xba\xec\xce\xce\xcc\x7b\xf3\xf6\x49\x98\xc2\x77\x12\x0d\x1c\x1d\x34\x1c\x83\xb1\x84\x58\xad\x4a\x16\x62\x5d\x95\xdc\xe4\xbb\x9b\xc5\x73\x88\xde\xc1\xa3\x70\x43\xb6\xa5\x56\x9f\xda\x9a\x16\x0d\x19\x7a\x34\x74\x0b\x80\x1a\x0b\xaa\xb5\x5f\x01\x60\x4a\x0e\xb4\x8b\x9e\x34\xe8\x10\x99\x36\xab\xc0\xf9\xef\x4f\xad\x4b\xe4\x20\xc4\x8d\xa0\x9a\xb4\xa5\xb5\x42\x43\x8b\x34\x63\xbb\x1e\xa0\x0e\x25\x5a\xe0\x98\x1d\x28\x0f\x69\xc2\x35\x39\xc0\x9a\xc4\x32\x69\x6b\xda\x23\x1c\x92\xb2\x67\xea\x1c\x34\xc1\xfb\x9a\x5e\x70\xec\xfd\x71\x3f\x07\x4b\x93\x96\x96\x0b\x80\x88\x0d\x1d\x48\x67\x03\xbd\xcc\x17\xd9\x80\x13\x62\x35\x42\x69\xa2\xb2\x97\xa0\x12\x6e\xd3\x28\x46\x76\x6e\x71\xcf\x13\x60\x58\xbb\x71\x03\x70\x01\x9f\xfb\x2c\xd8\xb0\x00\xc6\xee\xd0\xc5\x17\x10\xa2\x1a\xc6\x92\xc0\xb6\x68\x10\x14\x3c\xbf\xc4\xb9\x32\xdb\x8b\xe0\xe0\x6b\xab\xf4\x10\xeb\x10\xe9\x1e\x0d\x0b\x54\xfa\x36\x16\xde\x1f\xe7\x03\x60\x8c\x6c\x83\x08\x23\xf5\xe9\x6d\x48\x15\x2b\x72\x70\x97\x9d\xc4\x61\xe8\x0d\xfb\xe6\xf0\xc8\x6a\x95\x90\x1e\x78\xbd\xbd\xfd\xb7\xb7\xc5\x2a\xb1\x7f\x7f\x87\x10\x07\x2d\x34\xe1\xc9\xe1\x1c\xeb\x53\xf6\x53\xac\x4e\x70\x94\xd3\xba\x95\xda\xc1\xd6\x2c\xa9\xcb\xf3\x2a\xd8\xb6\x2d\x06\x6f\x4e\x82\x04\x9e\x97\x79\x51\x73\x91\x37\xa8\x46\x92\x7b\x2e\xf3\x06\x23\x56\x21\x56\x6b\x8a\xbb\x20\x1c\x1b\x8a\xa6\xb9\x71\x0a\xa5\xe6\xd3\x2d\xac\x95\xd3\x0a\x3d\x97\x17\x9b\x56\x89\x87\x99\xfc\x28\xd8\x34\xd1\x89\xc0\x00\xf4\x9a\xc4\xc1\xdd\x09\xd7\x4b\x6d\x9b\xcb\x54\xad\xdb\xf4\xf6\x83\x8b\xdb\xe5\x44\x2a\xf3\xc5\xf2\xfd\x0a\x8a\xee\x72\x1e\xf7\x1a\x12\xfb\xeb\x59\xae\x2b\xb8\xbd\x85\xff\xaf\xe0\xe1\x09\xb0\x50\x8a\xf6\xeb\x36\x47\x88\x1b\x16\x07\x9f\x9a\xa3\xc8\xf1\x97\x38\x3d\x4a\x3b\x92\x60\x9d\x83\x52\x82\x85\x12\xeb\xb3\x1c\x36\x5f\xe9\x97\xd7\xc4\x62\x24\x67\x5b\x6d\xaa\x9c\x0a\xff\xdc\x6a\xc7\xbc\xb6\xa8\xe0\x83\x62\x4a\x84\x42\x1e\x36\xc2\xcd\xd1\xcf\x09\x0c\xa5\x22\xeb\x53\x4a\xde\x91\x74\xff\x88\x9d\xd2\x28\x0a\x8d\xa2\x9c\x61\xa7\xd1\x09\x1f\xda\xa0\xf7\xcb\xcd\x5f\xf1\xc2\xcf\x01\x00\x88\x74\x6f\xf8\x03\x06\x00\x00"),
 		},
 		"/addons/ops/addon-ops-db-dashboard.yml": &vfsgen۰CompressedFileInfo{
 			name:             "addon-ops-db-dashboard.yml",
 			modTime:          time.Time{},
 			uncompressedSize: 88655,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\xff\x73\xdb\x36\xb2\xff\xdd\x7f\x05\x86\xcd\xbb\x49\x7b\x72\x2c\xca\xdf\x33\x69\x66\x9c\xb8\x79\xd7\x99\xb4\x4d\xe3\x5c\xdf\xbc\x6b\xfc\x34\x10\x09\x49\x88\x49\x82\x05\x40\xdb\x4a\xce\xf7\xb7\xbf\x01\x44\x52\x22\x08\x52\x94\x44\x4a\x94\x8d\xfe\xd0\x58\x04\x88\x05\xb0\x8b\xdd\xcf\x2e\x80\x25\x0c\xf1\x1f\x88\x32\x4c\x82\x97\x00\x07\x1c\x8d\x28\x82\xdc\x9b\xbc\x20\x74\x74\x70\x6b\x43\x2f\x1c\x43\x7b\xef\x06\x07\xee\x4b\xf0\xdf\x14\x0e\x61\x00\x2f\x21\x1b\x0f\x08\xa4\xee\x9e\x8f\x38\x74\x21\x87\x2f\xf7\x00\x08\xa0\x8f\x5e\x02\x36\x09\x5c\xc4\x30\xdb\xc7\xc1\x90\xc2\x7d\x77\xb0\xef\xa6\xb5\x01\xf0\xe0\x00\x79\x4c\xd4\x06\x00\x86\xe1\xac\xba\x7c\x92\xfc\x78\x81\xc9\x41\x79\x29\x9f\x84\x48\xf4\x76\x48\x21\xe3\x34\x72\x78\x44\xd1\x1e\x0b\x91\x53\xa9\x23\x2f\xbe\x30\x12\xec\x01\x20\xfe\x79\x09\xfe\x2d\x5b\xff\x26\xff\x0f\x80\x05\x83\x80\x70\xc8\x31\x09\x98\xf5\x32\x7d\x0c\x80\xe5\x61\xc6\xad\x97\xe0\xcf\xf4\x09\x98\x2b\x95\x35\x06\x11\xf6\xf8\xcf\x81\xf5\x12\xd8\x9d\x6c\x89\x98\x23\x46\x22\xea\x20\xeb\x25\xb0\xf6\xf7\x93\x99\x04\xfb\xfb\x96\x52\x15\x05\x70\xe0\x89\x6a\x9c\x46\x48\x29\x1b\x63\xb7\xa0\x04\x3b\x24\x78\x4b\x3c\x42\x45\xfb\xdf\x9d\x20\xb7\x8b\xba\x6a\xcb\x1e\xf6\xb1\x18\x81\xdd\xed\x2a\x25\x62\xc6\xc4\x8b\x17\xb3\xb1\x83\xbf\x81\x0b\x0f\x51\xce\xd4\x56\xd8\x98\xdc\xc9\x31\xaa\x8d\x70\x38\x12\x33\xf6\xe7\xb5\xfa\x7c\x12\xca\xc6\xd3\xe9\xb7\xe6\xca\x1f\xd2\xbf\xaf\xe3\xbf\x1e\x92\xd7\x2d\x17\x31\x87\xe2\x50\x74\x47\xbc\x9f\x76\xc4\x42\x2e\xe6\x9a\x49\xb2\x46\x01\xe2\x3f\xbb\xd6\x4b\x70\x78\x38\x1b\xa2\x35\xa2\x30\x1c\x7f\x22\xc4\xe3\x38\x9c\x67\x8d\x85\x45\xd5\x5e\x6f\xf6\x9b\x23\x0a\x63\x6a\xf6\xf1\x49\xef\xa4\x7b\x74\x74\x7c\x78\x72\x3a\xab\xe1\xe1\xe0\x86\x65\x64\x60\x5e\x02\x24\x13\x44\x4f\xd1\x3d\x47\x34\x80\x1e\x10\xf5\x33\xf3\x67\xe1\xc0\xf1\x22\x17\xfd\x01\x29\xd3\x30\x32\x9d\x43\x65\xca\x63\x51\x56\x59\x21\x25\x7b\x7e\x36\xaf\xb3\x8d\xa9\x13\xcf\x66\x75\x93\x79\x4f\xdf\xb0\x42\x18\x20\xaf\x64\x70\x0e\x09\x38\x0a\x84\xfc\x58\xaf\xc6\xf6\xeb\x57\x83\xd7\xaf\x86\x24\xe0\xc0\x11\x52\xf7\xe3\x77\xe8\xe4\x0c\x76\xbb\xaf\x5f\x39\x28\xe0\x88\xbe\x7e\x76\x3b\x55\x29\xaf\x0e\xe2\x07\xaf\x0e\x44\xed\xd7\xaf\x0e\x06\xaf\x5f\x1d\x8c\xed\xd7\xd9\x69\x29\x62\x75\xcc\x40\xec\x7e\x20\xd9\xd5\x28\x0b\xc6\x82\x7f\xca\x9c\xdc\x09\xf6\x2b\xcf\xee\x35\xd2\x3a\x11\xcf\xe6\x05\x31\xcb\x26\x21\x1a\x27\xc7\x99\x67\x29\xf3\xb3\xb3\xec\x13\xb9\x26\xad\x31\xf7\xbd\x6c\xbf\x39\xe6\x52\x46\xad\x58\xbd\x5a\x5a\xee\x70\x74\xcf\xe7\xf8\xd2\x29\x98\x7d\xe8\x8c\xd1\x27\xec\x23\x12\x09\x16\x04\x91\xe7\x75\xb2\xdc\xf1\x08\x7d\x03\x9d\x9b\x11\x25\x51\x20\x7a\x3f\x84\x1e\x43\xf9\x3a\x7f\x40\x2f\x42\xc5\xc5\x1a\xe9\xfb\xae\x77\x7e\xee\x1c\x9d\xa8\xc2\x47\x47\x03\xf8\xbc\x77\x78\xda\x01\x76\xef\xbc\x03\x8e\xba\x1d\xd0\x7d\x71\x76\xfe\xbd\x5a\xef\x3b\xf7\xe8\x08\x1e\x96\x88\x69\x56\x37\x7e\xa0\xc4\x47\x7c\x8c\x22\xa6\x4a\x88\x83\x7d\x28\x05\xb4\x5b\x5d\x74\x86\x84\xfa\x50\xca\x2c\x1b\x13\xca\x15\xb9\x82\xd1\x08\xe5\xa5\xca\x87\xf7\xc9\x24\xe5\x35\xa5\x8f\x83\xa4\x50\x2d\x12\x8a\x51\x37\xb1\x82\xd7\x63\x8a\xd8\x98\x78\xee\x7b\x69\xff\x16\xd5\xfa\x05\xd2\x1b\x94\x2a\x88\x42\x21\xad\x65\x5d\xa8\xcf\x2a\xac\x8b\xb3\x13\x45\xa5\x71\x44\x6f\xa1\xa7\x13\xcb\xa2\x35\x03\xc3\x10\x07\xa3\x4f\xd3\x25\x60\x17\x95\xe5\x85\x31\x3b\xd0\x39\xcb\x75\x2b\x98\x02\x38\x01\x72\x39\x75\xd4\x6a\xb7\x09\x43\x33\x05\x0f\x9d\x6a\x8d\x53\x18\x8c\x2a\x34\xde\xcb\x36\x5e\x28\xf0\x3e\xbc\xbf\x84\x1c\x7e\x20\x38\xe0\x2c\x2f\x65\x96\x98\x45\x59\xf8\x4b\xac\x5a\x1c\x12\x04\xc8\xe1\xc8\xb5\x72\xf5\x3e\x89\x1e\x69\x26\x3e\x24\x8c\x0f\xf1\x7d\x7e\x45\xc4\x05\xef\x48\xc0\xaf\xf0\x57\xd9\xfc\x71\xf7\xbf\x94\x3a\x14\xe9\xdf\x95\xcf\x4b\x5f\x95\x53\xf5\x0b\x0c\x2b\xb0\x6e\x48\x89\x2f\xda\x10\x7d\xcf\x4f\x2a\x9f\x0e\xcc\xfa\xf5\xe0\x42\x53\x48\xd2\x17\x2b\xce\x39\x0b\x21\xbd\xf1\x70\xa0\x59\xef\x43\xec\x79\x29\x74\x92\x5a\xed\xd0\xee\x00\xdb\x3e\xeb\x00\xfb\xec\x5c\x68\x35\xfb\x2c\xa7\xd5\x86\x82\xb6\x7e\x19\x0b\x2a\xf3\xed\x4d\x9b\xeb\x75\x3b\xc0\x3e\x3f\xcc\x35\x34\xaf\x35\x0a\x97\x9d\x44\x3b\x6f\x89\x17\xf9\x1a\x1d\xc7\x21\x1d\x21\x5e\x61\xc2\xd1\x7d\x28\xfb\xe4\xc3\xfb\xe7\x3e\xbc\xef\x93\x5b\x44\xfb\x1c\xfb\xe8\x79\x38\xea\x33\xc4\x39\x0e\x46\xac\x2f\x4a\x62\x81\x13\x38\xf0\xdb\x17\x32\xf8\xf1\xb3\xf5\xec\x0b\x19\x7c\xb6\x3a\x62\x45\xb0\x10\x3a\x48\x3c\x4a\x7f\x7c\xb6\x1e\xfe\x7c\x96\xa8\x81\xeb\xef\xbf\x07\x84\x7e\x0e\x1a\xa1\x72\xec\x5f\x7f\xaf\x4e\x61\x46\xcd\x0b\x42\x7d\x86\x28\x46\x2a\x5a\xca\x6a\x2a\x2b\xed\x6f\x71\xb5\x77\xd0\xe1\x92\x8b\x76\xae\x8a\x87\x46\x28\x70\xdf\xa5\x64\xf3\x8d\x50\x34\x94\x50\xd4\xba\xa8\x2a\xa2\xa9\xfa\x67\x1a\x1e\x27\x50\xe2\x17\x78\x0f\xde\xce\xe6\x4d\x0f\x29\x18\x0e\x46\x1e\x62\x1c\x2a\x06\x4f\x2a\xaa\xf9\xe5\x7b\xa6\x2e\x5f\x59\xa3\xda\xf2\x25\x02\x4c\x5b\x3f\x2e\xb9\x72\x13\x5d\xb9\xd4\xe2\x95\x2f\xfd\x1a\x2b\x63\x27\xa2\x54\xe0\x50\x03\x99\x96\x80\x4c\xbd\x55\x20\xd3\x60\xc2\x95\x55\xf4\xf4\x20\xd3\x91\x06\x32\x9d\xac\xe0\x4a\x9c\x1a\xc8\xa4\x6b\xdc\x40\x26\x03\x99\x76\x1f\x32\xb1\x31\xa4\xc8\xed\x0f\xa2\xe1\x10\x51\xd6\x97\x7a\xb3\x21\xdc\xb4\x0e\xa9\xb6\x80\xa7\x86\xa0\xd1\x95\x9c\x1a\xf0\x66\x3a\x35\x06\x19\x19\x64\x64\x90\xd1\x86\x91\x91\xbd\x4a\x94\xf5\xcc\x40\x23\x5d\xe3\x06\x1a\x19\x68\xb4\xfb\xd0\xe8\x0e\x7a\x1b\xc1\x45\x2b\xd3\x79\xe4\xa0\xe8\x12\xb3\x9b\xfd\x0f\x70\x84\x0c\x2e\x32\xb8\xc8\xe0\xa2\x6d\xe1\x22\xf5\x61\x15\x5c\x74\x6e\x70\x91\xae\x71\x83\x8b\x0c\x2e\x7a\x04\xb8\x88\xd0\x9b\xbe\x8f\xfc\x66\x41\xd1\x4a\x44\xea\x41\x44\xad\xdc\x3c\x43\x3e\xa1\x13\x20\x96\x15\x18\x12\x0a\x10\x74\xc6\xe0\x2a\x77\x2e\xc4\x80\x22\x03\x8a\xe6\x6b\x19\x50\xd4\xc8\xc9\x23\xfb\x6c\x79\x50\x74\xda\x35\xa0\x48\xd7\xb8\x01\x45\x06\x14\xed\x3e\x28\x42\xc3\x21\x72\x38\xbe\x45\x7d\x69\x74\xfa\x0c\x7f\x45\x8d\x02\xa4\xf5\x09\xb6\x25\x7c\xb4\x35\x4c\x75\x89\xd9\x0d\x78\x2b\x66\x4f\xe2\x2a\x83\xa4\x36\x88\xa4\x72\xd7\x07\x0a\x81\x54\x8c\x84\x56\x05\x52\x31\x0e\x5b\x1b\x48\x55\xc2\x4b\x01\x09\x90\x81\x4b\x99\x67\xc2\x20\xf6\xd4\x3b\x45\x55\x4e\x6a\x1f\x1b\xb8\xa4\x6b\xdc\xc0\x25\x03\x97\x56\x85\x4b\xa5\x2b\x7d\x93\x68\x09\x46\x9c\xdc\x42\x27\x8a\xfc\x86\xd0\xd1\xf2\x04\xda\x82\x86\xd6\xc5\x3a\xdd\x8e\x5d\x00\x77\x2e\xd2\x39\x79\x54\x48\x67\x81\x7a\x5d\x4c\xf0\xb7\x12\x7a\xdd\x9a\x89\xfd\xef\x4f\x57\x25\xd4\xec\x86\x41\x1c\xf1\x3c\x18\x32\xa4\x87\x67\xa5\x96\x5e\x35\xe0\x42\x9b\xf4\x74\xdb\x45\xba\x53\x34\xbd\x72\x4b\x7f\x7a\x94\xd5\xff\xe9\x65\xce\x6b\xbd\x1c\x2f\xbc\x43\x40\xc9\xdd\xe2\xc9\x80\x1e\x86\xec\x6d\x02\x3a\x95\x21\x7f\x22\x1c\x7a\x40\xb4\xf5\xdd\x60\x68\x0f\xba\x19\x31\xc8\x0e\x61\x30\xbd\x0e\x9b\x9f\x4f\x17\xb2\xf1\x7b\x14\x8c\xf8\x38\x77\xba\x48\x96\xa1\xa2\xd7\xd6\xba\x52\x28\xec\x4c\x2e\xda\x57\xca\x5a\x35\x94\x25\x58\x6b\xab\xc8\xae\x88\xb5\x87\xe5\xac\xed\x1d\x66\x81\x9a\xf4\xf4\xf2\xdd\x80\x1e\x1e\x05\x17\xec\x53\xf1\xed\x71\x78\x3b\xd2\x17\x24\xc2\xaf\xb7\x92\x63\xec\xa2\x7f\x21\x4a\x0a\x8a\x7d\x78\xaf\x6f\xd5\xc7\x81\xbe\x60\xce\x90\xaa\x25\x84\xca\x15\x2e\x7a\xaa\x29\xba\x44\xcc\xd1\xbf\xc8\x85\xac\x15\x74\x50\xae\xf3\x85\x18\x5d\x60\x01\xed\x95\x6c\x51\x70\x87\x5d\x9e\xc3\xea\x45\x90\x39\x07\x0a\x73\xb0\xc9\x0a\x11\x75\x50\xc0\xe1\x48\x1f\x0f\x0f\xc5\xdb\x14\xba\x38\x12\xcd\x1f\xe7\xcb\xf4\x52\x4f\x51\xe0\x22\x8a\x24\x74\x18\x7a\x44\xb1\x40\x53\xab\xfb\xdb\x2d\xa2\x14\xbb\x55\x00\xbb\x5c\xdc\xa2\x2d\xb9\x90\xf3\x1a\xd7\x49\xd3\x0d\xc4\xcb\x3b\x6f\xf7\xa7\xeb\xa8\x5b\x1d\xf9\x39\xa8\x68\xb5\x33\x0e\x9d\x1b\xed\xb0\x19\x47\x61\x88\xdc\xf7\x53\xc8\x98\x2f\x5f\x1a\x76\xb1\xc8\xd7\xa0\x22\x0e\x79\x1f\x3a\x1c\xdf\x62\x3e\xe9\x3b\x24\x0a\x78\x16\x15\xb9\x90\x0b\x2c\xf4\xe3\x7f\x3e\x5b\xcf\xdc\x32\x98\x94\xab\x99\x01\x66\x09\x2e\xdb\x20\x75\x89\xda\xc0\x60\x02\x9e\x0b\x32\x68\x75\x00\x17\xa7\xa7\xd0\x2c\xc2\x46\x63\x5d\xdf\xbe\xc9\x7e\x3f\x3c\x2c\x11\xf4\x5a\x80\x41\x9e\xb4\x28\xec\x1a\xff\x0b\xf4\x93\x8f\x38\xc5\xc2\x5e\x58\xa1\x6a\x4d\xe6\x25\xe3\x6d\xbe\x4c\x68\x94\x25\xa2\x04\x19\xdf\x21\x07\xb8\x7c\xf4\x6e\xea\x3e\xe7\x3c\x7f\x51\xf6\x11\x8d\xe2\x4c\x33\x9a\x17\xaf\xc6\x78\xa8\x8d\x19\xa4\x30\xee\x03\x61\x7c\x44\xd1\xd5\xef\xef\x4b\x6e\x85\xa6\xa9\x4f\xbe\xa9\x76\x18\x52\x89\x63\x8b\x2d\xb1\x0a\x57\xa4\x2d\xed\x27\x18\x11\x07\x2e\xbe\xc5\x6e\x04\xbd\x62\x68\x97\xd4\x95\x59\x58\xb2\x1d\xbb\x87\xf7\x58\x83\xa7\x06\x91\x73\x33\xd5\xd7\xea\xb8\xe7\xb2\x6d\x88\xe9\x51\x21\x42\x1c\x04\xd2\xbc\x55\x8c\x38\x52\x70\xf0\xe7\x75\xe1\x10\x26\xf0\xbe\x8a\xbd\x2c\x82\x93\x00\x2c\x48\x44\x21\x2b\xc8\xcc\x48\xda\xde\x8b\x42\x32\x7a\x03\x59\x2e\x02\x07\x66\xf8\x4b\xfb\xda\x14\x81\x59\x1a\xcb\xac\x8f\x65\x2c\xd6\x8b\x5b\x1c\x86\xb6\xa8\x60\x0b\xab\x6c\xb5\x4e\xf4\x52\x27\xe1\x73\x01\x82\x94\x65\xef\xd1\x6d\x3a\xb2\x3d\x1d\xa1\x95\x9c\xa4\x5f\xe0\x3d\xf6\x23\x1f\x38\xc4\xf7\x93\xd5\x6b\x3c\xa6\xe4\xe1\xbd\xee\x61\x05\x97\xe9\xf0\x68\xbb\x2e\x93\xf1\x89\x1e\xbb\x4f\x34\x5b\xb7\x7a\xab\x0b\x1e\xb5\x87\xa4\x8f\x1b\x0b\x60\x2a\xf4\xc8\x00\x32\xd4\x0f\x22\x7f\x00\x9d\x1b\x14\xb8\x6c\x45\x78\xaa\xc4\xab\x85\x7f\x12\xbf\x2b\x90\x31\x28\x8a\x5e\xd7\xde\x8b\x99\x7b\x94\x90\x6f\x41\x84\x3b\xef\x03\xc5\x9d\x6b\xc2\x0b\x5a\x23\x95\xcb\xaa\xdc\x2e\x61\x70\xdd\x74\x77\xd2\xe7\xa9\xa4\x7f\x52\xbe\xbf\x59\x69\x1b\x64\xb3\xae\xcc\x85\x3c\x88\x63\xdc\x18\xe3\xc6\x2c\x8f\xff\x8d\x1f\x93\x3e\x6e\xce\x8f\xd9\xd6\xce\x97\x6d\x97\x83\xfd\xd3\x6c\x3a\xc2\x85\x5b\x5f\x9f\xa2\xd0\x53\x0f\x64\xaf\xb3\xeb\xd5\x06\xc7\x6c\xc3\x3b\x56\xf6\x82\xdd\xc8\xc3\x13\xe3\x7f\x19\xff\x6b\x25\xff\xab\x9d\x0e\x0f\x8b\xfc\xe7\x14\x72\x8d\x87\xc1\xa3\xb0\x3f\x44\xdc\x19\x23\xb7\x46\xe4\x2b\xe8\xe1\xe6\x08\xb6\xe5\x9c\x8e\x8a\x6a\xdf\x4d\xc7\xd5\xcc\x3e\x4e\xf1\x74\x52\xc4\x23\x1a\xd4\xc7\xc0\x85\xfc\x5b\x9b\x60\x5b\x19\xf8\x31\x1e\xd8\x12\xbe\x48\x2d\x1c\xc4\x01\x43\x94\x6f\x90\x83\x6b\x13\x6c\x2b\x07\x7f\x8e\x07\x56\xba\x63\xd6\x00\x07\xa3\xd0\x85\x9b\x64\xe0\xba\xf4\xda\xca\xbf\x7f\x4e\xc7\x55\xc2\xbe\xcb\x26\xd8\xe7\x22\x0f\x6d\x92\x7d\xeb\xd2\x6b\x2b\xfb\x2e\xa7\xe3\x2a\x61\xdf\x4f\x3b\x10\xcb\xd1\xba\x58\x26\x7e\xb3\x91\xf8\x8d\xd9\x69\x7e\xb2\x11\x9a\x16\x06\x26\x0a\x2f\xcf\x6f\x7a\xc7\x78\x51\xc8\xa2\x67\xb6\x8c\x4d\xc8\x02\x3c\x99\x90\xc5\x53\x73\x79\xb7\xb4\x4d\xf7\x91\xdc\x31\x90\x0c\x1d\x0c\x26\xe0\xaf\xa8\xa0\x83\x73\x11\x8e\xbc\xed\xd1\x9e\x47\xac\x05\xb7\xd7\x1c\xba\x6a\x79\xe4\x6a\x9b\x52\x10\x8f\xbc\x9a\x10\xbc\xd9\xe5\x43\xa9\x1f\x11\x74\x81\x74\x01\xc0\x45\x7c\x4e\xd9\xb8\x02\xdb\x75\x05\x48\xa8\x91\x36\xe3\x08\x18\x47\xe0\x51\x3b\x02\xda\x7b\x94\x0b\x52\x26\xf4\x8e\x8d\x1f\x60\xfc\x80\x27\xe3\x07\xac\xbd\x8f\x61\xfb\x4f\x7d\xc7\x44\xa2\xbb\x64\x74\xa5\xf0\x6e\xfe\x46\x52\x7e\x86\xea\xb8\xa3\x54\x8b\x4f\x60\xb6\x62\x6a\x14\x8c\x78\x70\xcd\xc8\xc5\xe5\x46\xe5\xc2\xec\xf1\xd4\x28\x17\xf1\xe0\x9a\x91\x8b\x9f\x76\xd9\x7d\x9c\x6e\x1e\x81\xb7\x63\x18\x8c\x90\x0b\x42\x44\x81\x7e\xfe\x8d\x1f\x69\xfc\xc8\x82\x91\x19\x3f\x72\xbe\x6c\xe7\x8e\xfc\xf6\xce\xca\x9d\xb4\xd3\xec\x37\xa3\x16\x1f\xf9\xa5\x30\x60\xb0\xee\x74\x37\xc6\xad\x9e\x3e\x93\x1c\x3b\x5f\xe0\x56\x9b\x13\xc1\xc6\xad\x06\x4f\xc2\xad\xbe\x87\x0e\xef\x3b\xc4\xf7\xf1\xaa\x89\x39\x96\x3c\x11\x5c\x07\xc1\xb6\x62\xe5\xb7\x72\x58\xcb\x61\xe3\xb9\xf9\x68\xc5\x3e\x9b\xec\x0f\x25\x9e\x37\x80\xce\xcd\x46\xbc\xa7\x7a\x28\xb6\x55\x26\x3e\xc6\x03\x6b\x42\x2a\x76\x7a\xe3\xad\x04\xe6\x18\x4f\xe9\x71\x7b\x4a\xe6\x76\xa4\xd9\x72\x6b\x83\x6f\xa0\x3d\x7b\xb7\xc8\x39\xc8\x7e\x09\xc6\x38\x07\xc6\x39\xd8\x71\xe7\xa0\x38\x33\x49\x9a\xb8\x4f\x14\xf3\xfb\xbe\x1b\x51\x28\xec\xf5\x4a\x29\xbc\x67\x29\x1b\x4b\xb2\x79\xd7\x41\xb5\xb6\x0c\x91\x5b\x49\x02\x99\x07\x85\x45\xf3\x51\x87\xbb\xd0\x46\x60\x78\x19\x8f\x0f\x90\x21\x30\x20\xb1\x3d\x20\xf1\x31\x04\xd3\x0d\x3a\x6c\x71\x20\xfd\xf0\xb4\x1c\x79\x9d\x65\x4d\xe4\xe2\x40\x3a\xf2\x43\xf0\x0e\x3f\xba\xfc\x19\x8b\x12\x1b\xda\x0d\x40\x65\x2d\xbf\x16\x6c\x7c\x1c\x19\xa4\xbc\x31\xa4\x6c\x3f\x79\xa4\x9c\x9b\x9e\x6d\x24\x12\xe4\xc8\x0f\xfb\x43\xa1\x72\xb6\x98\x47\xb0\x86\x4e\x3c\xe2\x34\x82\x6d\x84\xbc\xbf\x46\xfe\x00\x51\x09\x78\x8b\x6c\x96\x81\xbb\x4f\x3a\xa1\x9c\x39\x5c\xb2\x5d\x4c\xdc\x6e\x18\xa8\x8d\x98\x36\x01\x03\xf5\xf9\xad\x17\xe1\xc0\x73\x83\x03\x0d\x0e\x5c\x09\x07\x96\x2b\xb2\x34\xc1\x35\x0e\x1c\x8a\x20\x43\xe0\x87\xbc\x32\x8b\x17\x67\x9e\x25\x73\x53\xba\x8c\xea\x69\x7f\xd8\x36\x0b\x04\x35\x5f\x88\xde\x3c\x1a\x5d\xaf\x13\x35\xa2\xd1\xad\x7d\xf5\xa7\xc1\x8c\xd7\x05\x87\xd2\x6b\x65\xbe\x8c\xd9\x17\x1d\x7f\xaf\x87\xc3\xeb\xf2\x54\xbb\xc2\x9b\x63\x69\xaa\x73\xaa\xf1\x76\x17\xb2\x5a\x5f\xe1\xaf\xc8\x38\x21\xad\x72\x42\x7a\x25\x8b\x42\x2e\x39\xe3\x84\x3c\x1d\x27\x64\x5b\x81\xf9\xa3\x93\x72\x80\x7f\x96\xf5\x3f\xaa\x7c\xcf\x75\xe8\x61\x87\x33\xf0\x37\xf0\x9e\xa8\x67\xe3\x1e\x53\x7c\x3e\x3b\x31\xc8\xc5\xbc\xc0\xbb\xb1\x10\xa5\xd2\xf0\xe4\x89\x16\xba\x73\xf9\xf1\x36\x17\xeb\x3f\x5a\xb0\x37\xb3\xe5\xcf\xbe\x3e\x25\x17\x6f\xb5\x43\x31\x05\x1f\xfb\x6f\x89\x9f\xd7\x2e\x17\x2b\xf5\x2b\x9d\x44\x51\xe5\xcd\x93\xcb\x3e\xc5\x6a\x2a\xd4\xab\x01\x00\x2a\x9d\xb7\x76\x11\x74\x3d\xa1\x03\x37\x73\xfc\x7e\x7d\x72\xeb\x1d\xb4\x1e\x51\x12\x85\x6f\x26\x39\x1e\xe8\xf8\x00\xa6\xc6\x84\x42\x3f\xcf\xb3\xb4\x7c\x06\xea\x35\xe5\xd7\x2a\x7d\x30\x6f\x5f\x24\xf2\xcb\x55\x78\xc8\xbf\xb3\x52\xc7\xf2\x1f\xae\xaf\xd2\x27\xa9\xec\xf3\x7d\xda\x5b\xd0\x46\xa3\x49\x5e\x63\x89\xd1\x9d\x55\x82\x2c\xa2\xc8\x9f\xea\x65\x2b\x9c\x7e\xdf\x93\xfd\xa5\x21\x1c\x12\x0f\x3b\x82\xed\x96\x8b\x86\x30\xf2\x96\xbc\xec\x40\x11\x8b\x3c\xfe\xae\x9a\x8c\x31\xe4\x21\x87\x6b\x39\xa3\xe3\x95\x8e\xbb\x8b\xf9\x0b\xe6\xb5\x83\xb6\x86\x96\xd1\x19\x56\x23\xcf\xd5\xbd\xaa\x91\xc0\x0a\xbd\x5c\x44\xce\x47\x30\xd8\x1c\x35\x17\x0f\x87\x88\xa2\xc0\xd1\xac\xb0\xbc\x3c\x03\x70\xbd\x50\xc2\x93\x03\x6c\xb9\x02\x0e\x47\x7a\x36\x69\x97\xed\x0d\x92\x52\x38\x26\x2c\x2f\x82\xb2\x02\x09\x11\x85\xd3\xc5\x61\xfd\xf8\x1f\x7d\x1d\x69\xcd\x45\x85\x83\xff\x7b\x26\x5a\x7a\x76\x50\x61\xcd\x2e\xe5\x1e\xa5\x26\xc8\x2d\x5e\x7e\x75\x99\xa0\x54\x8e\x37\x63\x82\xd6\x27\x67\x4c\x50\xb5\x8e\x3d\x16\x13\xf4\xb6\x18\x86\x35\x64\x82\x34\x37\xab\x5a\x69\x82\x66\xda\xc1\x98\x20\x95\x9a\x31\x41\x0b\x4c\xd0\x5e\xc1\x58\xb7\x19\x82\x4e\x97\xfa\x81\x1e\x77\x16\xc7\xa0\x7d\xf6\x11\x31\xe2\x45\xf2\x68\xbc\xde\x09\x5f\x3b\x4c\xed\x44\x7e\xe4\x41\x8e\x6f\x91\x09\x53\x6f\xf1\xfe\x60\xfe\x64\x8e\x89\x42\x9b\xa3\x30\xba\x13\xd1\x6b\x45\x5c\xf3\x57\x0e\x9b\x8a\xb8\x6a\x8f\xd5\x2c\x0a\xb9\x9e\xd8\x26\xe6\x6a\x62\xae\x69\x91\x89\xb9\xc6\xb5\x4a\x0f\xc8\x48\x44\xd1\x77\x48\x14\xd4\x94\xef\x64\x30\x01\xcf\x85\x8d\xee\x80\xb9\x93\x31\x05\x07\x63\xea\x20\x3e\x3b\x0b\x93\xa5\x6a\xdc\xe1\x27\xe3\x0e\x7f\xfb\x26\x58\xff\xf0\x00\xf6\xcb\xcf\x9e\x98\xf0\xac\x09\xcf\x16\x50\x33\xbe\xf1\x0e\xfa\xc6\xb3\x5b\x22\xba\x43\x13\xc6\x2f\x4e\xcb\xda\x73\x7c\xcb\xdc\x21\x31\x8e\x73\xfa\xf6\xb6\x8e\x6f\x1d\x1f\x17\x4a\xf4\xf4\xf8\x56\xf6\x6b\x72\x0b\x8f\x6f\xbd\x89\x84\xf1\x60\xe0\x6f\xe0\x8d\x44\xb4\xe0\x37\xa9\x93\x4d\xbe\xd2\xa6\x0e\x5f\x1d\x2f\x38\x7f\x77\xdc\x35\x91\x80\x76\x47\x02\x76\xfd\x82\xcd\xc1\xff\x50\xcc\x11\xf8\xe1\x20\xaf\xe8\x13\x35\x5a\x35\xb3\xcc\xb6\x03\x0d\x8b\xf7\xc3\x07\xde\x4d\x9f\x22\xe8\x4a\xbf\xbd\x91\x9b\x33\xa5\xfb\xe3\xf5\x90\xdf\x89\x1b\xdc\xf2\xe3\x6b\x8d\xde\x8d\x29\xe7\xf2\x9d\x90\xea\x2d\xb2\xb9\x06\xfa\x3b\xc1\xe7\xa9\xf6\x78\x3c\x17\x65\x66\x80\x07\xdc\x61\x3e\x8e\x71\x90\xb9\x2c\xd3\x62\x6f\x8b\x96\x6d\x51\x5a\x9a\xa2\xb6\x78\x5a\x15\xc7\x77\xd7\xe2\x8f\x5d\xb4\xc7\x05\xdb\x25\x27\x63\x97\xf7\x2e\x17\x79\x2c\xbd\xed\x3a\x2c\x63\xec\xa2\x7f\x21\x4a\x6a\xf3\x67\x28\x1e\x8d\xf9\x55\xe1\xdd\xe2\x27\xe7\xef\x98\x9d\xcf\x15\x76\x3e\x93\xb8\xca\x85\xe7\x11\x07\xaa\x93\x27\xeb\x2e\xb7\x03\x9a\x01\x9e\x83\x91\x44\x9b\xb4\x3f\x98\x92\xe9\x43\x41\x66\x85\x54\xae\xd3\x03\xbe\xb8\xc6\xc6\xd7\xba\xfd\x6d\xf6\x2f\x77\x6d\xff\xb2\x44\xbe\x9f\xf8\x96\x65\x66\xf5\x98\x6d\xcb\x1c\x35\xb3\x6d\x59\xd3\xad\x92\x0d\x9b\x9a\x01\x74\x6e\x50\xe0\xf6\x87\x6c\x12\xac\x68\x72\x16\x5a\x9c\x95\x68\x18\xcb\x53\xa9\x63\x8f\xc5\xf2\xbc\x13\xb2\x01\x1c\xe8\x79\x0c\x0c\x26\x00\x82\x58\x68\xcc\xbd\x12\xa5\x86\x31\x44\xc6\x10\x3d\x62\x43\xd4\xac\x09\x32\xc6\x47\xfe\x67\x8c\x4f\x7e\x13\x84\xa3\x00\xb8\x98\x22\x87\x7b\x93\xed\x58\x20\xcd\xd7\xf9\x8d\x05\xaa\xd4\x53\x63\x81\xaa\x0c\xc2\x58\xa0\x72\x1b\xe1\x78\x08\xae\xf2\x01\xa5\x4a\x51\xb7\xa5\x1a\x37\xe6\xa7\x52\xc7\x1e\x9b\xf9\x19\x4c\x00\x1f\x23\x69\x77\x04\x13\x03\x17\x4c\x85\x68\x63\x16\xe8\xd2\x58\x20\x63\x81\x8c\x05\xda\x9e\x05\x1a\x23\xe7\x46\x6e\xa7\x2d\x63\x29\xaa\xf8\x3f\xab\xb5\x6c\x6c\xd0\x93\xb3\x41\x6e\x44\x71\x30\x02\x33\x79\xd9\x5c\x66\x97\x9f\x8c\xf5\x31\xd6\xe7\x29\x58\x9f\xbd\x82\xb1\x6e\xf3\xdc\x64\x6c\xe9\xcc\xcd\xb5\x96\x9e\xa5\xdc\xe2\xad\x2e\x93\xd3\x65\xee\x95\x27\x73\x35\xed\xe4\xa8\x50\x64\xe5\x39\xc1\xd3\x25\x33\x8b\xff\xc6\xc7\x79\xfd\xf2\x78\x6e\xa1\x29\x2d\x23\xe6\x50\x1c\xc6\xea\xd1\x12\x62\xeb\x02\x12\x80\xe2\x8c\x8b\xe0\x16\xa3\xbb\x6c\xeb\x1b\xbe\xca\x76\xb2\xe0\x2e\x62\xcf\x7c\x33\xb4\xe5\x47\x3b\x5b\x7b\x95\xad\x5d\xa7\x3a\x97\x48\xbb\x3a\xfd\x2b\xf6\xa2\x43\x1c\xd4\x95\x86\xb5\x5a\x16\xd6\xda\xa8\xaf\x97\x95\xb5\x39\xa7\xf3\x03\x0e\x02\xe4\x82\x81\x06\x7b\x02\xa0\xfd\xe2\xfe\xe2\x49\x5a\xee\xac\xa1\xfe\xdb\xfb\x0b\x60\xcc\xf2\x02\x94\x24\x03\xdd\x8a\xfc\xac\x4d\xbc\xad\xe2\x53\x9a\xff\xbc\x56\xc9\xd1\x1c\x0e\xda\x90\xe4\x6c\x4d\x6a\x1e\xa5\xc4\xbc\x27\xce\x0d\x10\xb4\x49\xa4\x0f\x6c\xd5\x2a\x35\x9a\x0d\xfd\x0d\x49\x0d\x0b\x60\xc8\xc6\xa4\xa6\x44\x6a\x4b\x4a\xce\xda\xc4\xdb\x2a\x3d\xbf\x79\x2e\x48\x06\xd7\xbc\xf4\x68\x36\xe3\x36\x24\x3d\xf2\x6e\x9b\xe4\xc6\x56\xe4\xa7\x06\xf2\x6d\x95\xa0\x4b\x4a\x04\x86\x05\xb3\x21\x32\xd0\xb8\x20\x69\xe2\xea\x7a\x41\x2a\x0c\x7a\x6c\x33\xeb\x34\x0c\x1c\xe4\x21\x17\xfc\x1e\xe5\xb9\x65\x6e\x72\xb7\xeb\x26\xf7\x86\x93\x4d\x3f\x81\xac\x59\xe6\xc6\x76\x5a\xf4\x64\x6f\x6c\x2f\x0a\xcc\xd9\x47\x26\x30\xd7\xaa\xc0\x9c\x8f\x83\xab\x10\x8a\xb1\x1f\x95\x07\xec\xaa\xdc\xc5\xce\x75\x27\x1b\xb4\xb3\xeb\x09\xda\x51\x14\x22\xa8\xb5\xc4\x3b\x15\xce\xd3\xa5\xf2\x61\xfd\x31\x5e\xd9\x17\x51\x12\x07\x1d\x7c\x0e\x9e\x6f\x82\xce\xdf\xe3\xef\x34\xe9\xe9\x50\x04\xdd\x7a\x08\xb5\x11\x23\xbf\x85\xce\x18\x81\x31\xe6\x40\xb8\x0b\x8f\x28\x35\xd1\x74\x60\xff\xc0\x1c\x7c\x84\x1c\x13\xb3\xd5\xde\x52\xb0\x3b\x5b\x01\xb1\x22\x8e\x02\x5c\x3f\x20\xb4\x6c\x8d\xe3\xd7\x20\xac\x8d\xc7\x52\x3c\x8e\xba\x33\x2d\xed\xec\x8e\x7b\x0b\x71\xed\x86\xb7\x82\x4f\x0f\xcb\x11\x67\xaf\x05\x49\x82\x7e\xf2\x43\x3e\xd9\x54\x12\xa1\x47\x0e\x58\xcd\x4e\xf2\x7a\xd0\x53\x7f\xf6\x7a\x76\x86\xb6\xcf\x26\x81\xa3\x49\x26\xb9\xf6\xf5\xd6\x75\x48\xac\x75\xc0\x7b\x5c\x98\x50\xab\xd9\x2c\x01\xd8\x43\x0c\x5c\x4d\x02\x67\x4c\x49\x80\xbf\xca\x4c\x8f\x80\x13\xe0\x62\x76\x53\x25\x90\x5a\x3a\x73\x1b\xdc\x3a\x5e\xc8\xce\xa2\xfc\xa3\x75\x8a\xcc\xf2\x34\x76\x51\x66\x92\x93\xed\x53\xd9\x59\x53\x56\x66\x53\x56\xc7\x6e\x71\x2b\xbd\x94\x74\xb0\x40\xcc\x82\x09\xb8\x3f\xad\x0f\x38\x36\xe3\x7c\xf8\x1b\x1d\x83\xf1\x3c\x32\x65\x6d\xf5\x3c\xb4\xc1\xee\x45\xae\xc7\xd9\xa9\x71\x3d\x92\x12\xe3\x7a\x18\xd7\x43\xfb\x35\xc3\x34\x5c\xcc\xf0\xd7\xa6\xf2\xe7\x37\x48\xb8\xc6\xc4\xf9\x5b\xc2\x9c\x97\xf1\x3c\x00\x31\x0f\x8b\x3e\x05\xb8\x71\x1f\xa5\x8d\xb0\x33\x33\x63\x06\x73\x6e\x17\x73\xba\xc8\x19\x4c\xb8\x6e\x3d\xb5\xe5\x14\x84\x41\x9e\x2b\x22\xcf\x3d\x85\x9c\x50\x1f\x62\xd1\x8b\xb9\x3a\xec\xce\x26\xcb\x62\xce\x18\xf9\xf0\x0f\x44\xd9\x74\xe3\xc9\x3e\x99\x15\xf1\xc9\x74\xd1\xba\x90\xce\x3c\xe9\xfc\x65\x5c\x8b\x4d\x02\x17\x31\x3c\xcf\x02\x0b\x07\x43\x0a\xad\x5c\x37\x38\xf2\x43\x0f\x72\x1c\x8c\x32\xc3\xb6\x3c\xcc\xd4\x2b\xdd\xdf\x8a\xf0\x5a\x4e\x0c\x38\xba\x9f\x1e\xe9\x89\xfb\xb1\xef\x0e\xf2\xd2\x90\x5e\xe8\x9d\xaf\x55\x26\x6d\x89\x51\x51\xe1\x6b\xb1\x58\x25\x2b\xdb\xfa\x42\xd4\x0e\x58\x24\xe4\x89\xde\x54\x7a\x96\xbf\xc3\x1c\x5f\x71\x2f\xd0\x79\x95\x87\x5c\x69\xd0\xea\x2d\x66\xe5\x56\xb6\xf5\x57\x84\xe8\xa4\x9c\x92\xc5\x6e\x70\xf8\x4f\xea\x5d\x4d\x02\xa7\x40\x6a\xd3\x1d\x48\x12\x30\x0e\x03\x5e\xa8\x87\x73\x2b\xc1\xfb\x23\x19\xc2\x8b\x1f\x54\xba\x15\x44\xc2\x9f\x84\x94\x7c\x41\x8e\x66\x43\x2c\x9d\x9b\x59\x9d\x52\x71\xa8\xe4\x48\xc9\xc3\x49\x43\x1c\xe0\xe4\x4e\xa0\x94\x96\xfe\x54\x7d\x3f\x8f\xc2\x18\x2c\xcd\x4d\xe6\x67\xeb\xa1\x03\x52\x5c\xa4\x42\xa0\x44\x08\x55\x03\x87\x03\xc7\x8b\x5c\x74\xe1\x15\x01\xfc\x74\xbb\xef\xd7\xa4\x69\xb5\x65\x3f\xf2\x38\x2e\x78\x3b\x11\xe4\xa0\xe8\xe5\x39\x71\x2e\x94\x98\x75\x87\x3e\x53\x59\xea\x0d\x57\x8a\x46\xe8\x5e\xb3\x97\x59\x41\x12\x63\xc0\xa0\xb6\xc8\xe1\x48\x0a\x1a\xfb\x3d\xe9\xbd\xda\x74\xa2\xf6\xd4\xe1\x8a\xe7\xc5\x2f\xc5\x72\x3f\x9d\x13\xa5\x30\x62\xe8\xd3\xb4\x51\xd5\x66\x54\x5d\x12\xcf\x93\xc9\xfc\x37\x83\x7e\xe8\x21\x77\x90\x9b\xc4\xb2\x35\xa2\x1f\xd1\xdc\xea\xb9\xf0\x34\x38\x39\x5d\x37\xcf\xfa\x7d\xa8\xe6\x37\x29\xd0\xa1\xa5\xe2\xab\x81\x3b\xa9\xf4\x26\x78\x71\x15\xe1\xcd\x2b\xaa\x55\x95\xb0\xd6\xaf\x58\x30\x4f\x8b\x66\x4a\x93\x96\x63\xbd\x4e\x24\x22\x50\x6e\x07\x92\x4a\xeb\xf5\xa5\xa2\x55\xaa\x64\x92\x56\xb5\x47\xf1\x48\x3a\x05\xc4\x96\xb1\x4a\x11\xe3\xc4\xaf\x6c\x93\x22\x4e\x0a\x62\x4d\x11\x27\x7d\x87\x44\x72\xb9\xf5\xba\xaa\xd0\xcb\xe2\x38\xfc\x6a\xe7\xba\x5b\xc1\x98\x49\xca\xe5\xeb\x51\x90\x48\x1c\xd9\xbe\x3e\x99\x52\xc5\x25\x9a\x2e\xc1\x9f\xf5\x1e\x73\xba\xcc\x0a\x3c\xea\xa6\x10\x8f\x76\x12\x56\x98\x86\xda\xd7\x5f\x8e\xa5\xd9\x6e\xd9\x79\x41\xaf\x97\xfe\x71\x39\xfd\xe3\xa6\xe9\xdb\x7e\xf9\xf8\xfd\xa6\xc7\x5f\x4e\xff\xb8\x69\xfa\xf6\xb8\x7c\xfc\xe3\x86\xe9\x9f\x94\xd3\x3f\x69\x9a\xbe\x9d\xcf\x9a\x97\x19\x7f\x2e\x11\x56\x45\x45\x6f\xb3\xce\x31\xeb\xd8\x7e\xe7\xd8\xef\xd8\xe3\xce\xc9\xb8\x93\xa3\x34\x07\x15\x55\x6f\xad\xba\x15\xd0\xa9\x89\x8a\x40\x4c\xe3\x0a\x56\x50\xe7\xe7\x2f\x8e\x5f\xd8\x47\x25\x0a\x3d\xae\xd0\x80\x57\x52\xe0\x65\xa8\x93\x57\xd9\xcb\xd0\x45\xc0\x2a\x20\xb4\xdb\x38\xec\xb0\xae\x73\x11\x87\x54\xb1\xd3\x89\x5b\x2c\xf1\x25\xd4\x31\xa6\xbe\xc4\x01\x78\xfe\x67\x77\xff\xfc\xf3\xe7\x17\xd7\x7f\xff\x5e\xfd\xcc\x72\x75\xcf\x42\x6d\xbf\x65\x9e\x45\xfa\x77\x12\x47\x7c\x98\xc5\x66\xb0\xe4\xca\x5c\x54\x66\x38\x0d\x04\x5b\x01\xb9\xdb\x3f\x9c\x57\x2f\x96\x84\x40\xe2\xb9\xa5\x6d\x26\xc4\xce\x8d\xdc\x73\x99\x6b\x6c\x8c\x5d\x17\x69\xc2\x5a\x09\x6f\x52\x3b\xad\x42\x06\xd5\xb2\x59\x76\x57\x79\x70\xa8\x3e\x50\x6c\x91\x6a\x1b\x2c\x5b\x7d\x70\xd8\x55\x6b\x28\x41\xe0\x9e\xf2\x7b\x5e\x9d\x5d\xcf\x4f\x0c\xf6\x51\x5f\x0f\x7d\x16\xf7\x42\x25\x7a\xa2\x12\x55\x7b\xd1\x3b\x52\x1f\x28\x77\x4c\x4e\x5d\x75\x9c\xf3\xfd\xd6\xf2\xee\x2b\x91\x9b\x56\xd6\x80\x92\x3b\x36\x97\x95\x78\x16\xc4\xbf\x8a\x11\x37\xd8\x07\x3f\x07\x43\x0a\xc1\x3e\xb8\x9c\x1d\x86\xb1\x22\x3c\xdd\xa7\xf8\x3a\xfc\xc7\xaf\xe7\xe7\xde\x5f\xe1\xac\xe8\x36\x8d\x33\x4e\x3f\x4f\xf9\xb0\xf7\xff\x01\x00\x00\xff\xff\x31\x5c\xce\x96\x4f\x5a\x01\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\xfb\x73\xdb\xb6\x93\xff\x5d\x7f\x05\x06\xc9\x7d\x27\xe9\xc8\xb1\x28\x3f\x62\x7b\x9c\xcc\xf8\x51\xdf\x74\x26\x69\x73\x89\xaf\x37\xd7\xc4\xa7\x81\x48\x48\x42\x4d\x12\x2c\x00\xda\x72\x7d\xee\xdf\xfe\x1d\x50\x14\xcd\x07\x48\x51\x12\x29\x51\x16\xaa\x4c\x13\x11\x20\x16\xd8\x5d\xec\x7e\x76\xf1\x10\xf2\xc8\xef\x98\x71\x42\xdd\x13\x40\x5c\x81\x87\x0c\x23\x61\x3f\xbc\xa3\x6c\xb8\x7b\x67\x20\xdb\x1b\x21\xa3\x75\x4b\x5c\xeb\x04\xfc\x27\x43\x03\xe4\xa2\x4b\xc4\x47\x7d\x8a\x98\xd5\x72\xb0\x40\x16\x12\xe8\xa4\x05\x80\x8b\x1c\x7c\x02\xf8\x83\x6b\x61\x4e\xf8\x0e\x71\x07\x0c\xed\x58\xfd\x1d\x2b\xaa\x0d\x80\x8d\xfa\xd8\xe6\xb2\x36\x00\xc8\xf3\x9e\xab\x07\x4f\xa6\x5f\xde\x11\xba\x5b\x5c\x2a\x1e\x3c\x2c\x7b\x3b\x60\x88\x0b\xe6\x9b\xc2\x67\xb8\xc5\x3d\x6c\x96\xea\xc8\xbb\x3f\x39\x75\x5b\x00\xc8\xbf\x4e\xc0\xff\x07\xb4\x1f\x83\xff\x03\x00\x91\xeb\x52\x81\x04\xa1\x2e\x87\x27\xd1\x63\x00\xa0\x4d\xb8\x80\x27\xe0\x7b\xf4\xe4\xf9\xa5\xb0\x46\xdf\x27\xb6\xf8\xc5\x85\x27\xc0\x68\x27\x4b\x24\x8f\x38\xf5\x99\x89\xe1\x09\x80\x3b\x3b\x53\x4e\x82\x9d\x1d\x98\xaa\x8a\x5d\xd4\xb7\x65\x35\xc1\x7c\x9c\x2a\x1b\x11\x2b\xa7\x84\x98\xd4\xbd\xa0\x36\x65\xb2\xfd\x57\x87\xd8\xea\xe0\x4e\xba\x65\x9b\x38\x44\x8e\xc0\xe8\x74\x52\x25\x92\x63\xf2\xc5\xb3\xe7\xb1\x83\x7f\x81\x33\x1b\x33\xc1\xd3\xad\xf0\x11\xbd\x0f\xc6\x98\x6e\x44\xa0\xa1\xe4\xd8\xf7\x9b\xf4\xf3\x07\x4f\x76\x1a\x46\xec\x87\xb1\xf2\xa7\xe8\xdf\x37\xe1\xbf\x9e\xa6\xaf\x43\x0b\x73\x93\x11\x4f\x8a\x42\xbe\x1f\x75\x04\x62\x8b\x08\x05\x93\xe0\xd0\xc5\xe2\x17\x0b\x9e\x80\xbd\xbd\xe7\x21\xc2\x21\x43\xde\xe8\x9a\x52\x5b\x10\x2f\x2e\x1a\x48\x64\xd5\x6e\xf7\xf9\xbb\xc0\x0c\x85\xd4\x8c\x83\xc3\xee\x61\x67\x7f\xff\x60\xef\xf0\xfd\x73\x0d\x9b\xb8\xb7\x3c\xa1\x03\x71\x0d\x08\x84\x20\x7b\x8a\xc7\x02\x33\x17\xd9\x40\xd6\x4f\xf0\x0f\x12\xd7\xb4\x7d\x0b\xff\x8e\x18\x57\x08\x32\xe2\x61\xec\x19\x00\x70\xaa\xca\x89\xa6\x24\x3d\xa9\xd9\x71\x6e\x26\x58\x9f\x65\x3c\x87\xad\x34\xdf\xa3\x37\xa0\x87\x5c\x6c\x17\x0c\xce\xa4\xae\xc0\xae\xd4\x1f\x78\x3a\x32\x3e\x9e\xf6\x3f\x9e\x0e\xa8\x2b\x80\x29\xb5\xee\xc3\x2b\x7c\x78\x84\x3a\x9d\x8f\xa7\x26\x76\x05\x66\x1f\x5f\xdf\x4d\x4c\xca\xe9\x6e\xf8\xe0\x74\x57\xd6\xfe\x78\xba\xdb\xff\x78\xba\x3b\x32\x3e\x26\xd9\x92\x27\xea\x50\x80\xc4\xfa\x42\x93\xb3\x51\x7e\xe0\x48\xca\x2f\x5e\x15\x00\x78\x2f\xc5\x9f\x7a\x36\x56\x68\xeb\x83\x7c\x16\x7b\x14\xa9\xdd\xb3\x6a\x1c\x1e\x24\x9e\x45\xc2\x4f\x72\xd9\xa1\xc1\x9c\x84\x23\xe1\xd8\xc9\x7e\x0b\x22\x02\x1d\x85\xa1\x79\x85\x4a\xe9\x08\x3c\x16\x31\xb9\xb4\x73\xb8\x8f\xcc\x11\xbe\x26\x0e\xa6\xbe\x14\x81\xeb\xdb\x76\xa2\xb5\x40\x0a\xe7\xc8\xbc\x1d\x32\xea\xbb\xb2\xf7\x03\x64\xf3\xa4\x72\x05\x75\x7e\x47\xb6\x8f\xf3\x8b\x15\xda\xf7\xaa\x7b\x7c\x6c\xee\x1f\x26\x7a\x0f\x00\x64\xc3\x3e\x7a\xd3\xdd\x7b\xdf\x06\x46\xf7\xb8\x0d\xf6\x3b\x6d\xd0\x79\x77\x74\xfc\x36\x5d\xef\x95\xb5\xbf\x8f\xf6\x0a\xd4\x34\x69\x1b\xbf\x30\xea\x60\x31\xc2\x7e\x52\xdb\xa1\x85\x4d\xe2\x20\x9b\xa7\x45\x59\xac\x3a\x03\xca\x1c\x24\x19\x06\xf9\x88\x32\x91\x2c\x1c\x22\x7f\x88\xb3\x5a\xe5\xa0\xf1\x94\x49\x59\x4b\xe9\x10\x77\x5a\x98\x2e\x92\x86\x51\xc5\x58\x39\xad\x47\x0c\xf3\x11\xb5\xad\x4f\x81\xff\x9b\x55\xeb\x33\x62\xb7\x38\x32\x10\xb9\x4a\x5a\xc9\xbc\xd8\x9b\x7f\x5e\x1c\x1d\x26\x9f\xc9\xf9\x7d\x87\x6c\x95\x5a\xe6\xcd\x19\xe4\x79\xc4\x1d\x5e\x4f\x0c\x94\x91\x57\x96\x55\xc6\xe4\x40\x63\x9e\xeb\x4e\x0a\x05\x08\x0a\x82\xe9\x14\x6f\x50\x7e\xe0\xdd\x54\xa0\x89\x82\xa7\x76\xb9\xc6\x19\x72\x87\x25\x1a\xef\x26\x0a\x9e\x72\x15\xde\x41\xe3\x4b\x24\xd0\x17\x4a\x5c\xc1\xb3\x5a\x06\x5d\xdf\xb6\x83\xc2\xcf\xa1\x69\x31\xa9\xeb\x62\x53\x60\x2b\xa9\xbf\xb2\xde\x35\x1e\x2b\xed\x81\x47\xb9\x18\x90\x71\x76\x46\x84\x05\x57\xd4\x15\xdf\xc8\xdf\x41\xf3\x07\x9d\xff\x48\xd5\x61\x58\xfd\x2e\xc3\xb3\x5e\x0d\x58\xf5\x19\x79\x25\x44\x37\x60\xd4\x91\xe4\x65\xdf\x13\x8d\xc8\x3f\x30\x90\xe3\x09\x80\xbf\xee\x9e\x29\x0a\x69\xf4\x62\x49\x9e\x73\x0f\xb1\x5b\x9b\xb8\x8a\xf9\x3e\x20\xb6\x1d\x41\xa7\xc0\xaa\xed\x19\x6d\x60\x18\x47\x6d\x60\x1c\x1d\x4b\xab\x66\x1c\x65\xac\xda\x40\x76\x5a\x3d\x8d\x25\x95\x78\x7b\x93\xe6\xba\x9d\x36\x30\x8e\xf7\xde\xc2\x02\xab\xd1\xca\x51\x4d\x18\xa0\x9d\x0b\x6a\xfb\x8e\xc2\xc6\x09\xc4\x86\x58\x94\x60\x38\x1e\x7b\x41\x9f\x1c\x34\x7e\xe3\xa0\x71\x8f\xde\x61\xd6\x13\xc4\xc1\x6f\xbc\x61\x8f\x63\x21\x88\x3b\xe4\x3d\x59\x12\x2a\x9c\xc4\x81\x8f\x7f\xd2\xfe\x87\x1f\xf0\xf5\x9f\xb4\xff\x03\xb6\xe5\x8c\xe0\x1e\x32\xb1\x7c\x14\x7d\xf9\x01\x9f\xbe\xbf\x9e\x9a\x81\x9b\xb7\x6f\x01\x65\x3f\xdc\x5a\xa8\x1c\x38\x37\x6f\xd3\x2c\x4c\x98\x79\x39\x9c\x1e\xc7\x8c\xe0\x34\x5a\x4a\x5a\x2a\x18\xf5\x37\xbf\xda\x15\x32\x05\x65\x69\x03\x25\x3f\xd0\xc6\x43\xec\x5a\x57\x11\xd9\x6c\x23\x0c\x0f\x02\x28\x0a\xcf\xca\xaa\x68\x64\xfe\xb9\x42\xc6\x53\x28\xf1\x19\x8d\xc1\xc5\x33\xdf\xd4\x90\x82\x13\x77\x68\x63\x2e\x50\xd2\x58\x4d\x0c\x55\x7c\xfa\x1e\xa5\xa7\x6f\x50\xa3\xdc\xf4\xa5\x12\x4c\xc3\x0f\x73\xce\xdc\xa9\xad\x9c\x6b\xf2\x06\x2f\xfd\x1a\x1a\x63\xd3\x67\x4c\xe2\xd0\x96\x62\xaa\x68\xc8\x94\x03\x99\xba\x8b\x40\xa6\xfe\x83\xc0\x7c\xcb\x21\xd3\x7e\xea\x99\xf4\x8a\x87\xf3\x43\xa6\xc3\xf7\xed\x96\xc2\xc2\x68\xc8\xa4\x21\x93\x86\x4c\x1b\x0f\x99\xf8\x08\x31\x6c\xf5\xfa\xfe\x60\x80\x19\xef\x05\x76\xb3\x26\xdc\xb4\x0c\xa9\xa6\x80\xa7\x9a\xa0\xd1\xb7\x80\x35\xe0\x7c\xc2\x1a\x8d\x8c\x34\x32\xd2\xc8\x68\xc5\xc8\xc8\xe8\x2c\x00\x8d\x8e\xda\x2d\x85\xfd\xd0\xd0\x48\x43\x23\x0d\x8d\x36\x1e\x1a\xdd\x23\x7b\x25\xb8\x68\x61\x3a\x2f\x1c\x14\x5d\x12\x7e\xbb\xf3\x05\x0d\xb1\xc6\x45\x1a\x17\x69\x5c\xb4\x2e\x5c\xb4\xbf\x00\x2e\x3a\x6e\xb7\x14\x26\x44\xe3\x22\x8d\x8b\x34\x2e\xda\x7c\x5c\x44\xd9\x6d\xcf\xc1\x4e\xbd\xa0\x68\x21\x22\xd5\x20\xa2\x46\x2e\x9e\x61\x87\xb2\x07\x20\xa7\x15\x18\x50\x06\x30\x32\x47\xe0\x5b\x66\x5f\x88\x06\x45\x1a\x14\x69\x50\x54\x29\x28\x52\xed\x3c\x32\x8e\xe6\x07\x45\xef\x3b\x1a\x14\x69\x50\xa4\x41\xd1\xcb\x04\x45\x78\x30\x90\xfb\x81\xee\x70\x2f\x70\x3a\x3d\x4e\xfe\xc6\xb5\x02\xa4\xe5\x09\x6e\xfd\x86\x24\x99\x60\x02\x17\x52\x5c\x01\xae\xd2\x48\x6a\x85\x48\x2a\x73\x7c\x20\x17\x48\x85\x48\x68\x51\x20\x15\xe2\xb0\x5c\x7e\x94\x05\x52\xa5\xf0\x92\x4b\x5d\xac\xe1\x52\x06\x2e\x75\x8d\xf9\xe1\xd2\x51\xf2\x04\x43\x64\x61\x34\x5c\xd2\x70\x49\xc3\xa5\xc5\xe0\x52\xe1\x4c\x5f\x25\x5a\x42\xbe\xa0\x77\xc8\xf4\x7d\xa7\x26\x74\x34\x3f\x81\xa6\xa0\xa1\x65\xb1\x4e\xa7\x6d\xe4\xc0\x9d\xb3\x88\x27\x2f\x0a\xe9\xb4\x97\x25\xf8\x5b\x01\xbd\x4e\xc5\xc4\xfe\xf7\xe7\x6f\x05\xd4\x8c\x14\xb5\x5c\x91\x2f\x08\xe2\xa8\x6d\x23\x8f\x63\x35\x3c\x2b\xf4\xf4\x29\x3d\x0d\x3c\x7d\x57\xb5\x5c\x94\x06\x2c\x0f\x29\x97\xa5\x4a\x8c\x24\xda\x89\x1d\xe6\xbc\x51\xeb\xf1\xcc\x33\x04\x8c\xde\xcf\x66\x06\xb2\x09\xe2\x17\x53\xd0\x99\x1a\xf2\x35\x15\xc8\x06\x52\x96\xaf\xfa\x03\xa3\xdf\xe9\xc0\xdc\x21\xf4\x27\xc7\x61\xb3\xfc\x94\x67\x86\x3f\x61\x77\x28\x46\x99\xdd\x45\xc1\xb1\x56\x9c\xf7\xda\x9c\x89\xbd\x64\xcb\x32\x2c\x4f\x23\xb3\x62\xd1\xa6\x53\x59\x52\xb4\x46\xb7\xa4\x68\xf7\x72\xf9\x12\x88\xb6\x9b\x40\x83\x61\xa4\x97\xed\x06\xb2\xc9\xd0\x3d\xe3\xd7\xf9\xa7\xc7\xd1\xdd\x50\x5d\x30\x55\x7e\x05\x2b\xc3\x43\xe7\x7f\x60\x46\x73\x8a\x1d\x34\x56\xb7\xea\x10\x57\x5d\x10\x73\xa4\xe9\x12\x99\x8b\x3f\x99\xf4\x54\x51\x74\x89\xb9\xa9\x7e\x51\x48\x5d\xcb\xe9\x60\x30\xcf\x67\x62\x74\x99\x3a\x99\x56\xca\x14\xdc\x13\x4b\x64\xb0\x7a\x1e\x64\xce\x80\xc2\x0c\x6c\x82\x1e\x66\xf2\x58\x34\x1a\xaa\xf3\xe1\x9e\xc4\x9b\x0c\x59\xc4\x97\x3d\x4a\x62\x78\x6f\x8a\x45\xb3\xaf\x31\xec\x5a\x98\x61\xe9\x12\xe1\xc0\xa6\x29\x0f\x34\xf1\xba\xbf\xdd\x61\xc6\x88\x55\x06\xb0\x07\x93\x5b\xb6\x15\x4c\xe4\x44\x63\xcf\xc1\x66\x7c\x7a\x67\x6a\x84\xf3\xa8\x53\xd2\x20\x07\x98\x22\x6f\xb6\x73\x81\xcc\x5b\xe5\xb0\xb9\xc0\x9e\x87\xad\x4f\x13\xc8\x98\x2d\x9f\x1b\x76\x71\xdf\x51\xa0\x22\x81\x44\x0f\xc9\x64\x11\x11\x0f\x3d\x93\xfa\xae\x48\xa2\x22\x0b\x09\x09\x8c\x3e\xfc\xf3\x03\xbe\xb6\x8a\x60\x52\xa6\x66\x02\x98\x4d\x71\xd9\x0a\xa9\x07\xa8\x0d\xf4\x1f\xc0\x1b\x49\x06\x2f\x0e\xe0\xc2\xeb\x29\x32\x22\xa8\x39\xd7\xf5\xf8\x18\xf4\xfb\xe9\x09\x96\x07\x82\x6d\xad\x0a\xb9\xaa\xb0\x69\xf2\xcf\xb1\x4f\x0e\x16\x8c\x48\x7f\x01\xbd\x61\x81\x66\x5c\x64\xcb\xa4\x45\x99\x23\x4b\x90\x88\x1d\x52\xce\x40\xf2\xeb\x6a\x12\x3e\x67\x22\x7f\x59\xf6\x15\x0f\xc3\x9b\x66\x14\x2f\x7e\x1b\x91\x81\x32\x67\x10\xc1\xb8\x2f\x94\x8b\x21\xc3\xdf\xfe\xeb\x53\xc1\xa9\xd0\xe8\xea\x93\xa4\x9a\xc3\xc9\x59\x92\x42\x4f\x9c\x86\x2b\x81\x2f\xed\x4d\x31\x22\x71\x2d\x72\x47\x2c\x1f\xd9\x30\xd7\xaf\x4e\xeb\x06\xb7\xb0\x24\x3b\x36\x46\x63\xa2\xc0\x53\x7d\xdf\xbc\x9d\xd8\xeb\xf4\xb8\x63\xb7\x6d\x48\xde\x25\x5a\x7b\x4e\x02\x29\xde\xca\x47\x1c\x11\x38\xf8\x7e\x93\x3b\x84\x07\x34\x2e\xe3\x2f\xf3\xe0\x64\x6a\x06\x65\x2f\xa2\x90\x1f\x18\xdc\x8c\xa4\xec\xbd\x2c\xa4\xc3\x73\xc4\x33\x19\xb8\x18\xfe\x52\xbe\x36\x41\x60\x50\xe1\x99\x63\x0c\x49\x14\x3d\xb5\x8b\xc7\xb8\xc6\x61\x28\x8b\x72\x96\xb0\x8a\x66\xeb\x83\x5a\xeb\x02\xf8\xac\x36\x5e\x93\xb2\x4f\xf8\x2e\x1a\x59\x4b\x45\x28\xc6\xbb\xf2\x41\xd2\x67\x34\x26\x8e\xef\x00\x93\x3a\xce\x74\xf6\xea\x88\x29\x1e\x31\x19\xdd\xf9\x43\xa6\xbd\xfd\xf5\x86\x4c\x3a\x26\x7a\xe9\x31\xd1\xf3\xbc\x55\x7b\xdd\x97\x1d\x21\xa9\xf3\xc6\x32\x42\x92\x76\xa4\x8f\x38\xee\xb9\xbe\xd3\x47\xe6\x2d\x76\x2d\xbe\x20\x3c\x4d\x60\xe1\x49\x7c\x12\xbe\x2b\x2f\x18\x01\x3f\xdc\x15\xf5\xe2\xc0\x49\x93\x87\xed\x7c\xbf\x58\x08\x90\xeb\xc3\xc0\x8f\x8f\x61\xe7\xea\x88\x82\x8a\x97\x09\x0a\xaf\x72\x99\x83\xcf\x09\x69\x17\x08\xb8\x6a\xba\x1b\x19\xf3\x94\xb2\x3f\x91\xdc\xcf\x53\x72\xcf\x35\x30\x6b\x0c\x65\xce\x64\x48\x8b\x75\x18\xa3\xc3\x18\x75\x18\xa3\x2c\x8a\x71\x24\x51\xf4\xd4\x2e\x1e\xa4\x8e\x63\xe6\x8f\x63\xd6\xb5\xf2\x65\x18\xad\x1c\xc1\x86\x4b\x5f\x87\xf3\x2d\x7d\x5d\xfb\x9e\x8d\x2b\x5c\xf5\x6a\x42\x60\x56\x63\xfc\xa5\x14\xc9\x8c\xd5\xc8\xbd\x43\x1d\x7f\xe9\xf8\x6b\xa1\xf8\xab\x99\x01\x8f\x5c\x12\x62\x48\x28\x22\x0c\xe1\x7b\xbd\x01\x16\xe6\x08\x5b\x15\x22\x5f\x49\x8f\xd4\x47\x70\x39\xc8\x5b\x1f\xaa\xbd\x9a\x30\xb2\x00\xc9\x2e\xb1\x8e\x93\xcf\x4e\x86\x85\xcf\xdc\xea\x04\x38\x53\x7e\x4b\x13\x6c\xaa\x00\xbf\x86\x03\x2b\x90\x60\x3a\x16\xa9\x44\x82\xc4\xe5\x98\x89\x15\x4a\x70\x69\x82\x4d\x95\xe0\x2f\xe1\xc0\x0a\x24\x78\x51\x87\x04\x7d\xcf\x42\xab\x14\xe0\xb2\xf4\x9a\x2a\xbf\xff\x9e\x8c\xab\x40\x7c\x97\x75\x88\xcf\xc2\x36\x5e\xa5\xf8\x96\xa5\xd7\x54\xf1\x5d\x4e\xc6\x55\x20\xbe\x9f\x53\xe2\x8b\x7d\x6b\x4c\x2e\x47\x19\x62\xe9\xfc\xcd\x4a\xf2\x37\x6b\x4c\x6d\xe8\x95\xe6\x46\xad\x34\x37\x21\x31\x11\xcb\x26\x76\x57\x95\xb1\x30\xba\x0b\xa4\x2c\x92\xe9\xa8\xd0\x2e\xeb\x94\x85\x4e\x59\xbc\xcc\x94\xc5\xb6\x85\xbc\x6b\x5a\xa6\xfb\x4a\xef\x39\x98\x0e\x5d\x2e\x61\xff\xe5\xe7\x74\x30\xc2\x77\x67\x65\xf7\x23\xb6\xab\xd0\x83\x8a\x53\x57\x0d\xcf\x5c\xad\x53\x0b\xc2\x91\x97\x53\x82\xf3\xb2\x4a\x10\xfb\xd6\x18\xf4\xff\x15\x23\x0b\x04\x21\x00\x38\x0b\xf7\x29\xeb\x50\x60\xbd\xa1\x00\xf5\xf8\x2a\x11\xb4\x0e\x04\x74\x20\xd0\x80\x40\x20\x6d\x2b\xe4\xd2\x65\x37\xbe\xd7\x2e\xc9\x98\x49\x1c\x70\xa0\xe3\x00\x1d\x07\x6c\x4d\x1c\xb0\xf4\x3a\x86\xe1\x6c\xfb\x8a\x49\x80\xf1\xa7\xa3\x2b\x84\x77\xf1\x13\x49\x59\x0e\x15\xc0\xc1\xd2\x67\x94\xda\x55\xe8\x84\x5e\x8a\xa9\x66\x29\x26\x50\x8c\x70\x70\xf5\xe8\xc5\xe5\x4a\xf5\x62\xd9\x35\x97\x79\xf5\x62\x59\x7a\x8d\xd6\x8b\x70\x70\xf5\xe8\xc5\xcf\x65\xf5\x22\xf6\xad\x31\xe1\x63\x10\x39\x72\x70\x31\x92\x77\x39\x59\xc0\xc3\x0c\xa8\xf9\xaf\xe3\x48\x1d\x47\xea\x38\xb2\xce\x38\x72\x5d\x5b\x7e\xbb\x47\xad\x1c\xc1\x86\x5b\x7e\x8f\xe6\xdc\xf2\xcb\x90\xcb\x91\xf2\x54\xc1\x86\x6f\xfc\x6d\x4c\x58\x7d\x1c\x7b\x96\x64\x4c\x20\xb1\xae\xde\x11\xac\x77\x04\x6f\xc7\x8e\xe0\x31\x32\x45\x4f\x9e\xe7\x26\xa2\x2a\xa8\x5c\xbc\x23\xb8\x0a\x82\x4d\xc5\xca\x17\xc1\xb0\xe6\xc3\xc6\x31\x7e\x14\x60\xe4\xd5\xad\xb3\x05\xfd\x61\xd4\xb6\xe5\x51\xd4\x95\x44\x4f\xd5\x50\x6c\x6e\xfc\x34\x61\x65\x1d\x5a\xb1\xd1\x0b\x6f\x05\x30\x47\x47\x4a\x2f\x3b\x52\x52\x16\xc5\x86\x9c\x28\x7a\x6a\x97\x1d\x85\x5e\x72\xd3\x4b\x6e\x73\x2d\xb9\x19\xdd\x05\x82\x83\xe4\x2f\xc1\x84\xf6\x5e\x07\x07\x3a\x38\xd8\xd4\xe0\x40\x7d\x71\x45\xe2\xe2\x3e\x59\x2c\xc6\x3d\xcb\x67\x48\xa6\x25\x92\x20\xad\x24\x0a\x7c\xbe\xb2\x71\x7a\x55\x60\x4d\x54\x2b\xbb\x21\xb2\x3e\x50\x58\x70\x09\x64\x16\x14\xe6\xf1\xa3\x00\x18\x96\x0e\x17\x62\xdf\x1a\x03\x0c\x2f\xc3\xf1\x01\x3a\x00\x1a\x24\x36\x07\x24\xf2\x55\x42\xab\x9a\x92\xe9\xab\x1c\x82\xb2\x68\x13\xd0\xe1\xba\x12\xe9\x7b\xef\x5b\x39\x42\x9d\x24\xd2\x8f\x3a\x73\x26\xd2\xb1\xe3\x81\x2b\x62\xe3\x97\x9b\x46\xef\xa8\xa0\xb2\x51\x5e\x5e\xa5\xa1\xb2\x52\x5e\x33\x16\x3e\xf6\x35\x52\x5e\x19\x52\x36\xb6\x1e\x29\x67\xd8\xb3\x42\xa0\x1c\xe5\xed\x04\x76\xbc\xde\x40\x9a\x9c\x85\x13\x99\x19\xd0\x1c\xbe\x1b\xa6\xd6\x57\xd3\x89\x17\x7c\x8d\x60\xec\x5b\x63\x20\xef\xaf\xbe\xd3\xc7\x2c\x00\xbc\x79\x3e\x4b\xc3\xdd\x95\xc0\xdd\x3c\xdf\xba\xee\x54\xa3\xde\x5c\xb2\xde\xcd\x25\x1b\x98\x31\xad\x03\x06\x1a\xdd\x45\x70\xe0\xb1\xc6\x81\x1a\x07\x2e\x84\x03\x8b\x0d\x59\x74\xc1\x35\x71\x4d\x86\x11\xc7\xe0\xa7\x44\x8b\xf1\xc9\x99\x15\x49\x8c\xa5\xf3\x98\x9e\xe6\xa7\x6d\x93\x40\x50\xf1\x0b\xd1\x73\x00\xc1\x8a\xd0\xe8\x72\x9d\xa8\x10\x8d\xae\xe9\x50\x6d\xad\x37\x5e\xab\xb7\x55\x54\x2b\xfc\x20\x67\x4f\x6a\xa3\x24\x25\xbc\xac\x4c\x95\x33\xbc\x3e\x91\x46\x36\xa7\x9c\x6c\x37\xe1\x56\x6b\xf9\xcb\xbd\x3a\x08\x69\x54\x10\xd2\x2d\x98\x14\xc1\x94\x5b\x25\x7a\xd7\x3b\xdc\xb7\x73\x87\xfb\xfe\x61\x2b\x47\xb0\x61\x62\xbe\x3b\x5f\x62\xfe\x82\xba\x03\x9b\x98\x82\x83\x7f\x81\x4f\x34\xbd\x37\xee\x25\xe5\xe7\x93\x8c\xc1\x16\x11\x39\xd1\x0d\xc4\x8c\x51\xa6\x24\x9a\x1b\xce\x65\xc7\x5b\x4d\x90\xa7\x54\x81\x19\x6b\x33\x7b\x3a\xc4\x5b\x55\x88\xd7\x5d\x28\xc4\x0b\x7f\x7b\x03\x5b\x8d\x8c\xf3\x9a\x15\x62\x45\x71\xa5\x39\x35\x54\x89\x01\xc8\x3f\xd0\xe2\xd7\xa1\x99\xf2\xd4\x66\xa0\xe4\x7e\x6b\x0b\x23\xcb\x96\x36\xb0\x0a\x94\x3e\x7b\xfb\xfd\xf2\xe4\x96\xdb\x68\x3d\x64\xd4\xf7\xce\x1f\x32\x32\x50\xc9\x21\x74\x26\x0c\x39\x59\x99\x4d\xff\x8b\x81\x7a\x45\xf9\x4d\x5b\xf1\x30\xf2\x2f\x12\x14\xc7\xe1\xa6\x62\x46\x2e\xd1\xb1\xec\x0f\xd7\x97\xe9\xd3\x80\xa8\xde\x7a\x6a\xcd\x68\xa3\xc6\x38\xe7\x72\xaa\x31\xd9\xd6\x1c\x8c\xb8\xcf\xb0\x33\x49\xbd\x41\x6f\xf2\xfb\x9e\xfc\x2f\x05\x61\x8f\xda\xc4\x94\x62\x87\x16\x1e\x20\xdf\x16\x05\xa1\x92\x62\xf7\x12\xc3\xdc\xb7\xc5\x55\x39\x1d\xe3\xd8\xc6\xa6\x50\x4a\x46\x25\x2b\x95\x74\x67\xcb\x17\xc4\xad\x83\xb2\x86\x52\xd0\x09\x51\x63\xdb\x52\x69\xc8\x53\x7b\x91\x5e\xce\x22\xe7\x60\xe4\xae\x8e\x9a\x45\x06\x03\xcc\xb0\x6b\x2a\x66\x58\x56\x9f\x01\xb8\x69\xcd\x60\x5e\xb4\x81\x2d\x53\x20\xd0\x50\x2d\x26\xd5\x20\xe0\x2d\x0e\xb4\x70\x44\x79\x56\x05\xe5\x07\x52\x0f\x33\x34\x49\x02\xc0\x0f\xff\xa8\xeb\x04\xde\x5c\x36\xb3\xfb\x7f\xaf\x65\x4b\xaf\x77\x61\x6b\xd6\x18\x6f\x5a\x05\x5c\xcf\x77\x41\x91\xc1\xae\xcf\x05\x45\x7a\xbc\x1a\x17\xb4\x3c\x39\xed\x82\xb6\xcb\x05\x45\xf1\xe2\xca\x5c\xd0\xf9\x86\xb8\xa0\x67\xeb\xa0\xac\xa1\x14\xb4\x76\x41\xda\x05\x05\x2e\xa8\x95\x33\xd6\x75\xa6\xa0\xa3\xa9\xbe\xab\xc6\x9d\xf9\x39\x68\x87\x7f\xc5\x9c\xda\x7e\xb0\x35\x5e\x11\xfe\x55\x91\xa6\x36\x7d\xc7\xb7\x91\xfc\xe9\x47\xd8\xca\x51\xdf\x2d\x4a\x53\xaf\xed\xfc\x60\x4a\x48\xc9\xf1\x26\x8a\x9e\xda\x65\x87\xa0\xb7\xc2\xbc\x8c\xad\x30\x9d\xea\x32\xae\xdd\x95\x65\x5c\x8d\xee\x02\x29\xd7\xc3\x84\xd2\x85\xa0\x49\xe7\x5c\x75\xce\x55\xe7\x5c\x83\x9c\xab\x72\x6f\x4a\x80\x28\x7a\x26\xf5\xdd\x8a\xee\x3b\x91\xbb\x52\xa4\x8f\x6e\x83\xb0\x85\x82\xe3\x8d\x55\x10\x3f\x70\xd4\x54\x61\x3b\xdf\xb7\xe9\x70\xf8\x45\x85\xc3\x8f\x8f\x52\xf4\x4f\x4f\x60\xa7\x78\xef\x89\x4e\xcf\xea\xf4\xac\x4e\xcf\x2e\x96\x9e\x6d\xe5\x8c\xb5\x19\xa7\x44\x3e\xe9\xb8\xb8\xc1\x71\x71\x5e\x34\xb2\xee\x88\x53\x6f\xdf\xda\xce\xed\x5b\x07\x07\xad\x1c\xc1\x86\xdb\xb7\xf6\xe7\xdb\xbe\x75\xee\xcb\xc4\xaa\xdc\xbc\x75\x1e\x20\x5a\xf0\x5b\x60\x93\x15\x77\x34\xbc\xd4\x8d\x5c\xb9\x09\x82\xe5\x53\x01\x4a\x01\xce\xd8\x7f\x77\xd0\xd1\x99\x80\x66\x67\x02\x36\xfd\x80\xcd\xee\xff\x30\x22\x30\xf8\x69\x37\xd1\x60\xc2\x8c\x96\xbd\x59\x66\xdd\x89\x86\xd9\xeb\xe1\x7d\xfb\xb6\xc7\x30\xb2\x82\xb8\xbd\xba\x2c\x41\xf8\xee\xcc\xfb\x30\xab\x21\x5f\xe1\x99\x99\xfa\x22\xd9\xe0\xc7\xd7\x6a\x3d\x1b\x53\x2c\xe5\x7b\xa9\xd5\x6b\x14\x73\x05\xf4\x37\x42\xce\x13\xeb\xf1\x72\x0e\xca\x3c\x03\x1e\x70\x4f\xc4\x28\xc4\x41\x25\x03\x32\x7d\x58\xa6\xae\xc3\x32\x9d\x02\xcd\x67\x45\x4b\x94\x10\x56\x1b\xa2\x54\x19\x69\x95\x1c\xdf\xbd\xc7\x1b\x1b\x4b\xc6\xbe\xad\x39\x04\xdb\x9a\xd3\x22\x6b\x5e\xbb\x9c\x15\xb1\x24\xde\x09\xdd\xc5\x0a\x03\x16\x79\x0a\xf8\x0f\xcc\x68\x65\xf1\x0c\x23\xc3\x91\xf8\x96\x7b\xb6\x38\x3e\x35\xb6\x22\xde\xd1\x2b\x9f\x0b\xac\x7c\x4e\xf3\x2a\x67\xb6\x4d\x4d\x94\x66\xde\x02\x2b\xa0\x09\xe0\xd9\x1f\x06\x68\x93\xf5\xfa\x13\x32\x3d\x24\xc9\x24\x71\x67\x29\x98\x3b\x39\x63\x42\x2a\x6c\xfc\xc0\xb9\xd1\xeb\x97\xdb\xb3\x7e\x59\xa0\xdf\x5b\xbe\x64\x99\x98\x3d\x2a\x51\xe7\x08\x5b\x2f\x5b\xea\x65\xcb\x60\xd9\xb2\xdd\x2a\xea\xea\xda\x5c\x8d\xfc\x01\x14\xec\x5a\xbd\x01\x7f\x70\x17\x74\x39\x33\x3d\xce\x42\x34\xb4\xe7\xd9\x2a\xcf\x73\x25\xf5\x0f\x98\xc8\xb6\xb9\xcc\xd5\x21\x10\x2a\xcd\xca\x1c\xd1\xa6\x9c\x2b\xd1\x8e\x48\x3b\xa2\x17\xec\x88\xea\x75\x41\xda\xf9\x68\xe7\xa3\x70\x3e\x72\x11\x44\x60\x17\x58\x84\x61\x53\xd8\x0f\xeb\xf1\x40\x17\xda\x03\x69\x0f\xa4\x3d\xd0\xda\x3c\x90\x69\x63\xe4\xd6\x95\x75\x9b\xab\xf1\x4d\x74\x3f\xff\x66\xef\x6a\x9e\x1a\xb7\xa1\xf8\x3d\x7f\x85\x46\xd3\x03\x74\x0d\x8b\x03\x09\xa5\x37\x0a\x74\x76\x2f\xbb\xdd\xd2\xee\xa5\x30\x19\xc7\x16\x58\x8b\xbf\xd6\xb2\x97\x0d\x2d\xff\x7b\x47\x8e\x3f\x65\x49\x51\x62\x27\x36\xac\x67\x72\x88\x2d\x5b\x4f\xef\xe9\x27\xbd\x0f\xe9\xc9\xdc\xa1\x30\xa8\x9f\x75\xd4\xcf\x7c\x01\x22\x1b\x25\x9e\x0f\x3d\x09\xc1\xb3\xc0\x12\x44\x3b\xd3\x40\x97\x83\x06\x1a\x34\xd0\xa0\x81\xba\xd3\x40\x36\x32\x1f\x92\xe5\xb4\x75\x34\x85\x8a\xff\xb3\x59\xcd\x83\x0e\xfa\xe1\x74\x90\x15\x87\xd8\xbb\x07\x05\x5e\xc8\xce\xb4\xcf\xd5\xa0\x7d\x06\xed\xf3\x23\x68\x9f\x91\x80\xd7\x2e\xf7\x4d\xa6\x9a\x4e\x71\xa3\xe4\x90\xb9\xb6\xeb\xcc\xb5\x0e\xb3\xba\x98\x6e\xaa\x72\x5c\x29\x7a\xd6\x7a\xcb\x04\xb7\x68\x48\x4d\x13\xa7\xa6\x4d\x4f\x46\x82\x8e\x5d\xa6\xa6\x9d\x8e\xd7\x4b\x4d\xfb\x18\xd9\xf5\xf9\xe5\xf5\x64\xa1\x31\x35\x23\x62\x86\x38\x48\xa7\x47\x48\x37\xf3\x5a\xc0\xf7\x40\x2d\xd5\x20\xcf\x84\x07\xdf\x30\x7a\x84\x6a\xfb\x45\x9b\xef\x0c\xe5\x76\xf8\x8a\x5c\xc4\x71\xa5\xf6\xd4\x80\xdc\xe1\xce\xd0\x21\x95\xed\xc5\xa6\xb2\xf5\x6b\x57\xe7\xea\x04\xa4\x7c\x54\x2e\xff\xa5\x5e\x74\x80\x99\x00\xee\x1a\xb9\x40\xb9\xb3\x76\xbb\xbf\x32\xfd\xa8\x7d\xea\xcd\x4e\x65\xdd\x9e\xd3\xf9\x07\xf6\x3c\x64\x81\xd4\xa1\x52\xf9\xe2\xfe\x6a\x21\x49\x1c\xcc\x73\x28\xf4\x2c\x2a\xf7\x9f\xb5\xb6\x01\x94\x1d\x06\xda\x09\x7e\x1a\x13\xef\x2b\x7c\xa4\xe7\x9f\xb7\x8a\x9c\xdf\x3a\x43\x4e\x67\xa8\x79\x95\x88\xa1\xa7\xb3\x00\x4a\xdb\x8f\xa3\xed\xa3\xe6\xa2\x33\xd4\x10\xcf\x08\x88\xed\x47\x9d\x20\xa7\x31\xf1\xbe\xa2\xe7\xa3\x63\x81\x8c\xb9\xed\xa3\xe7\xb2\x33\xf4\x24\x5f\x42\x4a\x7a\xa3\x13\xfc\xb4\x40\xbe\xaf\x08\xba\x0c\x7d\x6a\xc3\x82\x82\x45\x02\xb6\x0e\xa4\x2b\x55\x20\x95\xae\x7a\x13\x97\xbc\x30\x3c\x13\x39\xc8\x02\x9f\xe2\x7a\x6f\x0d\x99\xdc\xfd\xca\xe4\xf6\x7b\x9c\xe9\xac\xc9\x39\xec\x69\x68\xb2\xce\x47\xe9\xaa\xe3\xc8\xe4\x90\xb1\xbd\x9b\x8c\xed\x55\x81\x39\xfd\x64\x08\xcc\xf5\x2a\x30\xe7\x62\xef\x3a\x30\x28\xef\x27\xf2\x80\x9d\x4a\x2e\x76\xad\x39\xd5\xa0\x9d\xde\x4e\xd0\x2e\x44\x01\x32\xb8\x9a\xf8\x45\x85\xf3\x6a\xf6\xd2\xdc\x79\x20\x33\x1b\x6f\xec\x8b\x30\x07\x07\xbd\xbd\xf1\xf6\x76\x41\xe7\x4d\xfa\x9d\x26\x3e\x1d\x7a\x04\x55\x3b\x84\xfa\x68\x23\x5f\x18\xa6\x8d\x80\x8d\x23\x10\x1a\xaf\xea\x68\xa2\x25\x63\xef\x70\x04\xfe\xa4\x27\x14\x29\x5a\xb2\xc3\x52\x7b\x77\x4b\xed\xe9\x44\x1c\x7b\xb8\x7d\x83\x10\xea\x70\xa7\x66\x6d\xca\x8b\x98\x8f\xb6\x4f\x5a\xe2\x16\xbd\x84\x15\xf7\x1e\xda\xb5\x3b\x5e\x0a\x3e\x3d\x2e\xdd\xab\xf2\x9f\x58\x9c\xe3\x71\xb7\x16\x27\x3d\x24\xe8\xca\x0d\xa2\xc5\xae\x0e\x11\x2a\x0d\xbf\xd7\x68\xb0\x0e\x2b\xc9\xcd\x56\x92\xf9\x7b\xaf\x8b\x3d\xb4\x33\x9a\xdc\xce\x39\x4c\x52\x29\x7e\x2a\xdb\xde\xdd\x84\x44\xa3\x0d\xde\xb6\xf0\x40\xad\xad\xda\x86\xbf\x63\x07\x11\x70\xbd\xf0\x4c\x3b\xf4\x3d\xfc\x44\xed\x28\x0f\x44\x3e\xb0\x30\x79\x50\x09\xa4\x4a\x25\x27\xb1\x2f\xdb\x5e\x3a\x5e\xd9\x9d\xa2\xf3\x47\xdb\x84\xcc\xfa\x34\x5e\x22\x66\xb2\x9d\xed\x4b\xec\x34\xc4\x4a\x21\x32\x09\x58\x94\x57\x8b\x4b\x57\xfd\xf1\x52\x72\x66\x01\x95\x02\x51\x74\x53\x86\x80\x7b\xab\x01\xf7\x57\x16\x53\x77\x77\xca\x03\xb7\x68\xf0\x3c\xfa\xe6\x79\xe8\xe3\x0d\x5c\x8f\x5f\x4e\x07\xd7\x63\x70\x3d\x06\xd7\x23\x73\x3d\xb8\x5f\x33\xcc\xc3\xc5\x04\x3f\x31\xb6\x9d\x7a\xa4\xb8\x6c\x50\x32\xe1\x69\xe1\x67\x14\xdb\x21\xdc\xe2\xc1\xf9\x1d\xd9\x9c\x97\xa9\x1c\x00\xed\x00\x79\x08\xbb\x03\x1f\xa5\x74\xd5\x1b\xb3\xb3\x22\xb1\xc1\xe6\xec\xd6\xe6\xb4\x90\x39\x5f\x44\xa8\x37\x46\xdb\x60\x79\xb6\x66\x79\x8e\x18\x72\x74\xfa\xa0\x83\x9e\x76\xfb\xf1\x51\x21\x2c\x48\x4c\x1b\xb9\xc6\x67\x14\x92\x65\x12\x93\x3e\x2d\x8a\xa2\x45\x12\xd0\x85\x96\x11\x16\x9e\x74\x3d\x19\x17\x92\x85\x67\x21\x82\xcb\x5d\x00\xb1\x77\x17\x1a\xb0\xd6\x8c\x08\xb9\x01\x5d\x82\xf2\xee\x2b\x6c\x43\x07\x13\x36\xa5\xfb\x5f\x91\xbd\x56\x2d\xa0\x2d\x42\xdf\xe9\xcb\x79\x3b\x0e\xac\x39\xd4\xd8\x87\xf2\x84\xde\xf2\x53\x32\xb4\x65\x81\x0c\xd6\x7c\x15\xc3\x2a\x1b\xd9\xf0\x8b\xcf\x36\x00\xfa\x49\x96\x58\x7d\x78\xd6\x51\x9d\xa7\xb8\x0b\xe6\x3c\x65\x96\x95\x98\x2e\xa3\xa6\xd2\x55\x69\x0d\x5f\x63\x14\x2e\xe4\x94\x20\x79\xc0\xc1\xdf\xa1\x43\xe3\x75\x02\xd4\x66\xb3\xab\xe9\x7b\x24\x32\xbc\x08\x8e\x04\x62\xaf\x0a\x03\x1a\x8e\xf3\x39\x63\xe1\xf0\x67\xa8\xad\x0d\x09\x77\x11\x84\xfe\x17\x7a\x5e\x80\x36\x12\xc9\xa6\x78\x46\x0a\x07\x25\x47\x8a\x3e\x87\xee\xb0\x87\xb3\x9c\xc0\x04\x2d\xb3\x04\x7c\x64\x2f\x0e\x52\x63\xa9\x24\xcc\x1b\xf8\xac\x81\xdc\x2e\xda\x87\x7c\x10\xb2\x0a\x0e\x7b\xa6\x13\x5b\xe8\xdc\x11\x19\xf8\x19\x4a\xe1\x87\xac\x6a\xb6\x66\x37\x76\x22\x2c\x78\x3b\x03\xb2\x27\x7a\xb9\x04\x67\x21\x62\x9a\xb2\x5e\x4c\x59\xcc\x14\x0d\x43\x74\x8f\xbe\x73\xd6\x32\x15\x90\x98\x1a\x0c\x6c\x8d\x91\x71\x9f\x00\x8d\x7c\xca\x5a\xcf\x56\x9d\x4d\x7b\x2c\xbb\xf4\xbe\xf8\xa5\x14\xf7\x4b\x99\x30\x85\x31\x41\x7f\x2d\x2b\x65\x75\x86\xea\x90\xd8\xcb\x84\xf9\x1f\x31\xdc\xc0\x41\xd6\x7c\x7f\x9d\x31\xc2\xe7\xa8\x34\x7a\xce\x1d\x47\x32\x6e\x7e\x9a\xcd\x0c\xc7\x91\x0f\x1a\x15\xf8\x72\xcc\x9d\x1c\xbd\x99\xbd\xb8\x09\x78\xad\xb9\x04\xb5\x95\x82\x55\x93\x30\x87\xca\x4a\x39\xad\x92\x54\x4d\x56\x4d\x1b\x91\x41\x40\xda\x92\xfc\xa1\x66\x6d\x51\xd4\x4a\xf2\xa6\x64\x0f\x6d\xa8\x8f\x52\x4e\x34\x01\xb1\x75\xb4\x52\x4c\x22\xdf\x85\x23\x81\x38\xaa\xa2\x80\x46\x1c\xf9\x7c\xd4\xd2\x92\x99\xe9\xc7\xc9\x70\x1b\x1f\xb1\xa0\x4f\x8a\xd3\xf0\xab\x4e\xd6\x19\xa8\xa9\x58\x69\x05\xcc\x6b\x65\x81\x52\x94\x51\x12\x99\x23\x9b\xff\x81\x23\x49\x57\x8b\x86\x68\x3e\x04\xdf\x67\xb5\x08\x86\x19\x16\x94\x6f\x3a\xd8\x56\x60\x8b\x2b\x84\x0d\xc4\x50\x13\x44\xd3\xf1\xa7\x13\x69\xb3\xf4\x3a\xd0\xdb\xa5\x3f\x91\xd3\x9f\x6c\x9b\xbe\xee\xca\xf9\x77\xb7\x4c\x7f\x22\xa7\x3f\xd9\x36\x7d\xdd\x86\x9c\xd2\x82\x7f\x7b\xcb\xf4\xa7\x72\xfa\xd3\x6d\xd3\xd7\x2d\x29\x7d\xdd\xda\x70\xa2\xd7\x89\x36\x21\x9a\xee\x6a\x13\x57\xd3\x6d\x6d\x6a\x6b\xba\x25\x36\x15\xc7\x1b\x6b\x01\xde\x34\x51\x11\x91\xd8\x10\xe3\xb8\x82\x0a\xd3\xf9\xd9\xe1\xe4\x50\x3f\xa9\x09\xad\x10\x59\xfa\xc0\x48\xd2\x69\x1b\x7a\x25\x90\xaf\x03\xc6\x32\x33\x8d\x27\x3b\x89\x2f\xac\x62\xa1\x7d\x4b\xc3\x0e\x62\xcd\xa1\xe6\x5c\xa4\x21\x55\x6c\x6a\x69\x8d\xfb\xea\x00\xc9\x7d\x89\xb7\x60\xef\x9f\xa3\x83\xb3\x9b\x9b\xc3\xdb\x37\xfb\x6f\xe1\xda\x38\x4a\x3d\x8b\x71\xbf\x3d\x8b\xfc\xff\xed\x88\x01\xd4\x32\x56\x59\xc6\x2b\xbc\x5b\x1e\x44\x06\x3d\xff\xf1\xe0\xb8\x3c\xbd\xc0\xc4\x04\xa2\xf7\x21\xb7\x9a\x00\x9b\x0f\x28\xac\x56\x66\x63\xcb\x42\x9c\xb0\x56\xd6\x37\xb9\x9e\x66\x4d\x06\x56\xb3\x41\xbd\x14\xc0\xa2\xbf\x4a\x44\x8b\xfe\x58\x5d\xc4\xea\x06\xa8\xb3\x37\x8e\x8f\xd8\x27\xaa\xb3\x29\x1c\x33\xd7\xe5\xe9\xac\xd4\x71\x89\x10\x67\x7c\xd3\x67\x75\x2b\x58\xa2\xcc\x94\x0e\x75\xb6\x15\xe3\x13\xf6\x46\x75\x6a\x84\xa7\xcc\xf5\xf1\x51\xb9\xdd\xdc\xbe\x7b\xf2\x93\x45\x2b\x38\x0f\xfd\x47\x52\x3a\x95\xb8\x08\xe2\x5f\xa7\x16\x37\x38\x00\xef\x69\x98\x0f\x1c\x80\xcb\x62\x33\x0c\x8c\x93\x15\x5c\x78\xfe\x74\xf7\xee\xc3\xd9\x99\xf3\x35\x28\x8a\xb2\x01\xff\x2b\x98\x8e\x00\x00\xe0\x79\xf4\xff\x00\x31\x5c\xce\x96\x4f\x5a\x01\x00"),
 		},
 		"/addons/ops/addon-ops-home-dashboard.yml": &vfsgen۰CompressedFileInfo{
 			name:             "addon-ops-home-dashboard.yml",
 			modTime:          time.Time{},
 			uncompressedSize: 45748,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x9c\x5b\x73\xdb\xb8\x15\xc7\xdf\xf3\x29\x50\x6c\xdb\xc9\xb6\xb2\x4d\xca\x97\xd8\x9e\xc9\x83\x93\x34\xdd\xec\xd4\xa9\xbb\xc9\xa6\xd3\xae\x33\x1a\x88\x84\x24\x44\x24\xc0\x00\xa0\x22\x67\xeb\xfd\xec\x1d\x50\x14\x2f\x20\xa8\x4b\x7c\x93\x57\xe7\x25\xb1\x70\xc7\x01\xce\xc1\xcf\x10\xfe\x26\x09\xfb\x40\xa5\x62\x82\x9f\x22\xc6\x35\x1d\x4a\x4a\x74\x74\xb5\x2b\xe4\x70\x6f\xe2\x93\x28\x19\x11\xff\xc9\x98\xf1\xf0\x14\xfd\x5d\x92\x01\xe1\xe4\x15\x51\xa3\xbe\x20\x32\x7c\x12\x53\x4d\x42\xa2\xc9\xe9\x13\x84\x38\x89\xe9\x29\x52\x57\x3c\xa4\x8a\xa9\x1d\xc6\x07\x92\xec\x8c\x44\x4c\x77\xc2\xa2\x3c\x42\x11\xe9\xd3\x48\x99\xf2\x08\x91\x24\x29\x2b\x64\x29\xf3\x0f\xbb\x4c\xec\x2d\xce\xd5\x57\x09\x35\xe3\x1d\x48\xa2\xb4\x4c\x03\x9d\x4a\xfa\x44\x25\x34\x58\x71\x28\xbb\x9f\x94\xe0\x4f\x10\x32\xff\x9d\xa2\xff\x65\xed\xff\x9a\xfd\x8b\x10\x26\x9c\x0b\x4d\x34\x13\x5c\xe1\xd3\x22\x19\x21\x1c\x31\xa5\xf1\x29\xfa\xa5\x48\x41\x95\xdc\xac\x44\x3f\x65\x91\x7e\xc3\xf1\x29\xf2\x3b\xf5\x1c\x63\x27\x25\x52\x19\x50\x7c\x8a\xf0\xce\xce\xdc\x9a\x68\x67\x07\x5b\x45\x29\x27\xfd\xc8\x14\xd3\x32\xa5\x56\xde\x88\x85\x2d\x39\x2c\x10\xfc\xa5\x88\x84\x34\xed\xcb\x61\x9f\x3c\xf5\x3a\xa8\xeb\xfb\x1d\xd4\x3d\x3c\xec\x20\xff\x7b\xbb\x1b\x63\x26\x53\xf6\xac\x9c\x2e\xfa\x33\x3a\x8b\xa8\xd4\xca\x2e\x6b\xec\x6d\xca\x16\x06\xc4\x95\xfc\xeb\xe2\xe7\x8f\xf9\x4f\xd7\xf3\xea\x98\x86\x4c\x3b\x66\x83\x87\x9c\xea\x37\x21\x3e\x45\x3c\x8d\xa2\x32\x55\x92\x64\xf4\x5e\x88\x48\xb3\x04\x9f\x22\xaf\xc8\x60\xa6\xa8\x7f\x5c\x7e\xd6\x54\x66\x43\x36\xc9\x87\x47\xdd\x23\xef\xe0\xd0\xdb\xf7\x8e\xf6\x8b\x12\x11\xe3\x63\x55\x5b\xac\xea\x52\x65\xd6\x32\x13\xa2\x53\x4d\x25\x27\x11\x32\xe5\x6b\xb3\xc6\x9a\x0c\x95\xb5\xda\x08\xe1\xf9\xb6\xb2\x2d\x94\xed\xb2\xaa\x55\x3e\xd6\x1b\xb3\x0d\xa8\xca\xb2\x73\xfb\x15\x35\x70\x42\x38\x8d\x16\x8c\x7e\x28\x59\x78\x21\xea\xbb\x33\xcb\x18\x39\x76\xde\x17\x7c\x8a\xba\x07\x56\xe2\xb4\x6a\xde\x3c\xed\xca\xa4\x55\xd7\xb5\x36\x83\x6c\x09\xba\xf5\x49\x31\x9d\xad\x2c\x7e\x97\x1b\x05\xbd\xa3\x72\x42\x25\x76\x4e\x5d\x8a\x2f\x95\x39\x77\xdc\x33\x0b\x48\x30\xa2\xef\x59\x4c\x45\xaa\xad\xdd\x31\xcb\x37\x3b\xfc\x05\x09\xc6\x43\x29\x52\x6e\x86\x34\x20\x91\xa2\xcd\x32\x1f\x48\x94\xba\x3c\x65\x96\xeb\x58\xd8\xef\xc2\x83\x03\xb2\x4f\xec\x75\xcd\x3c\xa9\xbb\xff\xac\x83\xfc\xee\x49\x07\x1d\x78\x1d\xe4\xed\x1e\x9f\x34\xbc\xe9\xbb\xee\xc9\x49\x70\x70\xd4\xbe\x03\x06\x42\xc6\xc4\xcc\x09\x73\xc1\x69\xdd\x44\x43\x92\x0e\x69\x73\x35\x63\x32\x9d\x4f\xc3\xf7\xec\xd5\x8a\x19\x9f\x67\xda\x59\x6a\x24\xbe\xb8\x2c\x63\x16\x63\x24\xa9\x1a\x89\x28\xfc\x47\x16\x89\x97\x95\x3a\x27\x72\x4c\x33\x6b\x19\x3b\xb6\xee\x8d\x85\xfb\x71\xdf\xb1\x1f\x57\xdd\x8e\xfe\xe2\xed\x78\x50\x4f\xe2\x9a\xca\x09\x89\x5c\xfb\xa6\x19\x0e\x50\x23\x7a\xa3\x6a\x7c\xab\xee\xea\x1d\xf4\xc6\x78\x37\xda\x41\x3f\x7e\x38\xb7\x16\x3e\xeb\x37\x88\xd2\x90\x7e\x20\x85\xa1\x1a\x45\xc6\x94\x26\x66\x57\xbb\xed\x9d\x79\xbc\x24\xb1\xa9\x8e\x27\x44\xee\x7c\x12\xfd\xe7\xc5\xf9\xa5\x9a\x3e\x85\x66\xf1\x49\x0e\xa9\x7e\x11\x11\x3e\x6e\x6d\xb6\xe9\xa1\x4b\xe6\xd2\x0c\xf4\x8d\x22\xa9\x34\x16\xc6\x7b\xe1\x1e\x3f\x39\x98\x4e\x9f\x45\x31\xd9\xb3\x0e\xdb\x4f\x93\x18\xd7\xaa\x5d\xb7\xba\x45\x4c\x92\x84\xf1\xe1\xfb\x59\xbf\x7e\x5b\xde\x0a\x6b\x37\x3f\xcf\x26\xc6\x2b\x90\x16\x48\xd3\xa9\x6e\x0e\x7f\x32\xf7\xa8\xfa\x00\x3b\xab\x35\x2e\x09\x1f\xae\xd0\x78\x77\xe5\xd9\x4f\x5f\x11\x4d\x2e\x04\xe3\x5a\x35\xdd\x1c\x9b\x7d\x9c\x65\x9e\x8b\xec\xdc\xc7\x81\xe0\x9c\x06\x9a\xd6\xd7\x25\x2b\xf7\xde\x8c\xc8\xb1\xf5\x45\x52\xa0\x4c\xdd\x85\x92\x28\x1d\x32\x9e\xd3\x9f\x69\xfc\x68\xb7\xbb\x7b\x58\x6f\x38\x11\x4a\x0f\x98\x71\x4f\xec\xcc\x78\x2d\xb8\x7e\xc7\xbe\x66\x63\x3b\xf4\xfe\x64\x95\x91\xd4\x5d\x37\x4b\x5f\x58\x35\xb3\xf3\x39\x49\x56\x58\xf7\x81\x14\x71\x16\x56\xd3\x28\x72\x6c\xe8\x99\x55\xf0\xdb\xbd\x33\x47\xa6\x28\x2a\xae\xb8\x60\x2a\x21\x72\x1c\x31\xee\x88\xd6\x03\x16\x45\x75\x00\xdb\xf7\x3b\xc8\xf7\x8f\x3b\xc8\x3f\x3e\x31\xc7\x86\x7f\xdc\x38\x36\x06\xa6\x6f\x77\x10\x36\xbd\x54\xdb\x9b\x35\xd7\xf5\x3a\xc8\x3f\xd9\x6f\x34\x54\x8d\xf9\xad\x41\x33\x43\xb1\x97\x22\x4a\x63\xde\x5c\x95\x59\x3c\x59\xc1\xe0\x74\x9a\x64\x63\x4a\x93\x5f\x8d\x5b\xa8\x84\x04\xf4\xf9\x25\xfe\x63\xf1\xe1\x12\x77\x4c\x04\xbb\xc4\x56\x0c\xbb\xc4\xd7\xcd\x35\x28\xcf\x45\xcd\x62\xda\x53\x54\x32\x6a\xd3\x55\x16\x64\x95\x26\x5c\xb7\x05\xd8\x79\xec\x7f\x4d\x02\x9d\x59\xcc\x6f\x14\x89\xe8\x90\xf2\xf0\x75\xd1\x5d\xb3\x0f\x49\x07\x19\x93\xe2\xb3\x55\xb7\x43\x71\x50\x66\xa1\xdb\xef\xf8\x96\x49\x59\x4c\x5f\xcf\xb6\x67\xc3\x2d\x4d\xde\xbb\x11\x1b\x38\x7d\xb6\x8c\xdb\x9a\xe8\x54\xb9\x81\x4a\x31\x3e\x8c\xa8\xd2\xa4\x1e\x88\x66\x41\xa8\xea\x5d\xc7\xb6\x77\x65\x25\x56\xf3\x2e\x61\x40\x1c\x3f\x6f\x77\xac\x57\xff\xfc\xf7\xdb\xf6\x40\xe8\x72\xae\x25\xa1\xf6\xa6\x3d\x7a\xb7\xdc\xdd\xcf\x17\x0b\x3a\xf3\x57\xdd\x29\x59\x8d\xb7\xf9\x29\x12\xa4\x52\x52\xae\x1f\x00\x87\x5b\xb2\x5d\x3c\x9c\x03\xed\xb7\xf2\x70\x8e\xd3\x2b\xf0\x70\x42\x65\x60\xac\x71\x0f\x48\xec\xf8\xbd\x79\x83\x89\xd8\x4e\x5b\x81\x88\x8f\xd6\x27\x62\xe0\xb1\xea\x0c\xef\x9e\xc7\x00\xa9\x00\xa9\xda\x90\x4a\xa5\xf1\xd3\x4f\x93\xb8\x17\xd3\x58\xc8\xab\x5e\xaa\x68\xd8\xeb\x5f\x69\xaa\xd6\x26\xad\x0e\x91\x94\x3c\xbf\xc4\x23\x4a\x92\x4b\x7c\xfd\xfd\x5f\x7c\xcf\xdb\xb3\x9a\x8f\xc9\xf4\x96\x5a\xbf\x01\xd6\x2d\xe5\xb6\x9b\x52\xd9\x33\xaf\x73\xe2\xd9\x5c\x96\xe3\xd5\x8f\x1f\xce\xd1\x0f\x94\x24\x9b\x0c\x58\x4e\x37\x5b\xc4\x57\xc0\x20\xf3\xea\xc0\x20\x37\x66\x90\xe3\xf5\x19\xe4\x19\x30\x88\xab\x71\x60\x10\x60\x90\xed\x64\x10\x2e\xf8\xdd\x62\x48\xa5\x83\xc7\x4c\x22\x6f\x05\xdf\x01\x1a\xc9\xeb\x6d\x1f\x8d\x84\x34\x60\x31\xc9\x8e\x7f\x7f\x31\xa6\xa4\x9c\x01\xaa\xd4\xd2\xcc\x71\xe4\x77\xd7\x67\x95\x63\x60\x15\x57\xe3\xc0\x2a\xc0\x2a\x8f\x81\x55\x12\x29\x02\xaa\x54\x2f\x48\xd2\x5e\xaa\xc8\x90\xfe\xda\x46\x0a\x6d\x68\xf1\x90\xdf\x48\xdd\x25\x4f\xbc\xbc\xf8\x19\x30\x02\x30\xc2\x8d\x11\xf0\x02\xc9\x0d\x10\x47\xeb\x03\x84\xef\xad\x4f\x10\x8b\x7d\x67\xd9\x1b\xa4\xb3\x8b\x37\x9b\xf3\x06\x69\xc1\xfb\x22\xe7\x38\xd7\x7a\x5f\xf4\x9f\x0b\x7e\x31\xf6\x22\x36\xb6\xdf\x17\x91\x84\xc1\xfb\xa2\xd5\x66\xff\x90\xef\x8b\x4a\x70\x43\x92\x7e\xde\x53\x80\x6f\x80\x6f\x08\x59\x57\x4d\x92\x68\xfa\x74\xa4\x75\xd2\x9b\x05\x99\x9e\xa4\x9f\x53\xaa\xb4\xea\x29\x1a\x08\x1e\xaa\x5e\x20\x52\xae\xd7\x7f\x6a\xf4\xcb\x61\xfc\xf1\xfb\x8d\xbe\x0f\xba\xed\xc7\x42\x3f\xe5\x96\x43\x09\x95\x68\x66\xbd\x2d\x64\x40\x32\x19\xde\x23\xff\x5d\xcc\x23\xd4\xad\xbd\x43\x7f\x78\x3c\x54\x23\x21\xef\xe5\x7e\xe9\x91\xf1\x61\xf7\x1b\x9e\xa8\xd7\x2f\xa5\xe0\x86\xe9\xd1\x12\x0c\x95\x52\x48\x60\x18\x04\x0c\x33\x2b\x78\x1f\x0c\xd3\x51\xd9\xf3\xdf\x3f\xfc\x76\x89\xbb\xbb\xbb\xb7\xc1\x34\xf3\x2b\x2b\xf7\x6f\x80\xf7\xc0\x3c\xde\xae\xdf\xf1\x76\xbb\xb7\x8d\x3e\x7f\x33\xde\xf9\x58\xc0\xc7\x7b\x38\xec\x11\x51\x44\x12\x45\xdd\x40\x73\x77\xb2\xc2\x83\x25\x87\x64\x5d\xc8\x55\xca\x1f\x3f\xba\x57\xbb\xb8\x6d\x38\xa7\x9a\xb8\x57\x1a\xd4\x86\x70\xd7\xb7\x88\xe5\x5c\xbb\xf4\x70\xc9\x2e\x3d\xbc\xef\xab\xbe\x87\x90\x1b\xc6\xb6\x4b\x21\x04\x62\x43\x40\x69\xab\x1c\x88\x0d\x01\xb3\x37\x55\x6c\x68\x22\x18\x48\x0d\xad\x3c\x90\x1a\x82\xd4\xd0\x1e\xe4\xb6\x7c\x23\x0e\xcf\xfc\x8b\xb4\x75\xa4\x86\xcb\x70\x18\xb4\x86\xce\xc6\x37\x09\xc7\x80\xa8\x80\xa8\xda\x88\xea\xe6\xef\xfc\x67\xa0\x75\x37\x4a\x43\x77\xdb\x9b\xfc\x6d\x2e\xe8\x0c\x6b\x95\x00\x40\xca\x02\x00\x20\x45\xda\x3a\x3a\xc3\x65\x00\x02\x42\x43\x67\xe3\x00\x20\x00\x20\xdb\x08\x20\xb7\x2e\x33\x6c\x6d\xfe\x31\x63\x08\x88\x0c\xb7\x1a\x45\x40\x64\x78\x07\x22\xc3\x65\xa0\x02\x2a\x43\x67\xe3\x00\x2a\x00\x2a\x8f\x01\x54\x96\xaa\x0c\x73\x50\x00\x8d\x21\x50\x44\xa5\xd6\x76\x53\x04\xbc\x3b\x5a\x5d\x63\xb8\x8c\x1f\x4e\xd6\xe7\x87\xc5\xbe\xb3\x89\x1a\xc3\x96\x87\x47\xa0\x30\xdc\x7a\x36\x03\x85\x21\xa0\xdb\x3c\x73\x03\x5e\xe7\xe7\xef\x8b\x40\x5f\x08\xcf\xec\xef\x9a\xfc\x40\x5d\xb8\x3d\x64\xe8\x54\x17\x2e\x21\xc3\xee\x37\xfc\xf5\x09\xa0\x97\xea\x0c\x37\x84\x5e\x40\x5d\x58\xd6\x00\x7e\xb9\x0f\x7e\x01\x6d\x21\x68\x0b\x7f\x17\xda\xc2\xe3\x25\x47\x64\x5d\x80\xbf\xba\xb6\xf0\xd5\x0b\xf7\x3a\x83\xb2\x10\x6e\xf8\x16\x71\x9c\x6b\x8f\x9e\x2c\xd9\xa3\xfb\xf7\x7d\xc1\x67\x6d\xee\xbc\xdb\xdb\xbc\xdf\x73\x84\xa8\x1b\x6a\x08\x5d\x63\x5e\xeb\xb6\xef\xec\xeb\xe0\x87\xb7\x27\x27\xd1\xe7\xc4\xbe\xee\x0b\xfb\x70\xdb\xb7\xda\xec\x41\x42\x08\x2c\xfd\x48\x59\x7a\x0d\x09\x61\xd8\x07\x01\xa1\x95\x07\x02\x42\x10\x10\xda\x83\xdc\x96\xaf\xbb\x4b\xbf\x0f\x69\x90\xbd\x21\x05\xee\xad\xa5\xb5\x49\x08\x97\x71\xef\x37\xfc\x45\x0d\xc0\xb1\xea\x0c\x01\xc7\x00\xc7\x1e\x29\x8e\xc5\x64\xfa\x34\x26\xd3\x9e\x98\x50\xd9\x33\x84\xf1\x34\x19\xf6\x42\xa2\x49\x9f\x28\xda\x53\xec\x2b\x6d\xc7\x35\xe4\xe0\xb5\x0e\x0a\x89\x36\x85\x2a\x19\x1b\x71\xad\x79\xf7\x30\x77\xdb\x24\xf7\x2a\x5f\x05\x64\x56\x61\x93\x81\x0e\x9e\xf8\xdd\xc7\x37\xb9\x1e\x5c\x00\xde\x54\xca\xb8\x0c\x84\x40\xca\xe8\x6c\x1c\x40\x08\x40\x68\x3b\x41\xc8\x9c\xac\x25\x0d\xf1\x34\xee\x93\x60\x4c\x79\xb8\x40\xbd\x08\x50\x74\xa7\x50\x74\x16\x68\x36\xa1\x28\x8f\x1c\x59\x28\x00\x32\xda\x46\x32\x02\x00\xca\xd3\xd6\xd2\x48\x2e\x23\xa0\x6f\xd0\x38\x00\x01\x55\x67\x08\x04\x04\x04\xf4\xbb\x22\x20\xaa\x35\xe3\x43\x95\xfd\xe1\x86\xca\xa9\xbb\xde\x97\x78\x40\x3a\xeb\x93\xce\x39\x99\x02\xe6\x6c\x3d\xe6\x80\xc6\xb3\xda\xd0\x8d\x35\x9e\x4b\xf8\x67\xdf\x07\xfe\x71\x35\xfe\x38\xf8\xa7\xf2\x92\x3f\x10\x31\xbc\xe3\x47\x40\x38\xb3\x82\x73\xc2\xc9\xde\xf0\x37\xae\x75\xa6\x24\xd0\xbd\x40\xc4\x31\x5b\xfd\xf5\x7e\x76\xab\xd3\x7e\xa9\x73\x03\xd2\x79\x74\x7a\xc4\x97\x99\xe5\xe0\x65\x7e\x51\x07\x20\xa5\xc8\x00\x48\xc9\xd3\xd6\x92\x1b\x2e\x83\x94\x6f\x78\xa7\x0e\x90\x52\x9d\xe1\x86\x40\x8a\x14\x11\x40\x0a\x02\x48\x99\x15\x5c\x01\x52\xa4\x88\xa2\x3e\x09\xc6\x80\x29\xeb\x63\xca\x4f\xb9\xed\x00\x54\xec\x3a\x45\x79\xb3\x66\x66\x09\x4c\xc9\x7d\xaf\x5c\x76\xac\x82\x11\x8d\x49\x79\xb3\x5c\xfe\x6a\x8d\x95\xbe\x8a\x72\xed\x8f\x1c\x97\x35\x34\x19\xd6\x8d\x50\xec\xbd\xca\xb4\x70\x26\xfb\xc1\x8d\x61\x68\x1a\x27\x11\xd1\x8c\x0f\x6b\xe1\x00\x47\x4c\x69\xcb\xb2\x56\xb0\x20\x51\x34\xe7\x03\x7b\x27\xa0\xf2\x3e\xc9\x8e\x31\x15\xab\xc7\x57\x89\x14\x9f\x68\xb0\xe0\x98\xa9\x94\x59\x74\x9a\x61\xe3\xba\x4a\xa4\x32\xc8\x2a\x5d\x48\x11\x53\x3d\xa2\xa9\xed\x4d\x38\xa4\x03\xc6\x99\xce\xef\xec\x23\x83\x28\xbd\xac\x2f\xf5\x34\x4d\xb2\xbf\x71\xf7\x5b\xc5\xa3\x77\xff\x7a\x89\xaf\x3b\xa8\xf0\xf9\x46\xc4\x1a\xb1\xd0\x85\x47\xb9\xac\xec\xac\x3d\x2e\x9a\x8e\xb3\x90\x3e\x6f\xda\x6e\x39\x4e\x23\xcd\x5a\x6a\xcf\x8f\x6a\xde\x56\xb9\x3c\x10\xeb\x2c\x82\x10\xfe\x9c\x52\x79\x75\x2b\x73\x2f\xf7\xaf\xad\x65\x95\x74\x48\x9b\x47\xa6\xd9\x96\x63\x96\xfc\x2c\xa3\x77\x57\x3c\x68\x99\x9a\x12\x52\x3b\x5a\xd4\x64\x98\x6d\x35\xf5\xaf\xf9\xf0\xed\xa6\xe7\x3e\x60\xcf\xd7\xa4\xb7\x57\xca\x83\xd0\xcc\x28\x56\x66\xaa\xe8\xfb\x59\xa3\x8d\x03\xa9\xf8\xf9\x63\xfe\xd3\x75\xe9\x4e\x33\xa9\x60\xc5\x91\x8a\xb3\x5d\x7c\xd9\x39\x8c\xab\x1e\x99\x1f\xdd\xa5\xb8\xb6\xde\x4c\xc2\x82\x31\x95\xf5\xc6\x72\xa3\xf7\xe6\x67\x82\x1d\xfc\xf0\xa1\xc5\x38\xbe\x67\x25\xec\xdb\x09\x7e\x5c\xff\x7c\x68\x7d\xf6\xed\x84\x7d\xcf\x2e\x31\xaa\x7f\xee\x5a\x9f\xfd\xb0\x74\xde\x8f\xd5\xf9\x9b\x53\xaf\xb2\x57\xd7\x1a\x85\xdd\xe9\x91\xdd\xa9\x3d\x8a\xee\x81\x9d\x60\x1d\x49\xcf\x42\x7b\x9e\xd5\x71\x3b\x97\xe8\xab\xc8\x28\x0a\x57\x62\xf1\x02\x35\xe7\x0f\x22\x2e\x5d\x15\xa7\x6c\x76\x62\x07\x1f\x5e\xbe\x78\xfd\xee\xbf\x5f\xcb\x9c\x49\x19\xfb\x67\x4a\xef\xeb\x27\xff\x0f\x00\x00\xff\xff\xd6\x45\x7f\x8a\xb4\xb2\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x9c\xeb\x72\xdb\xb8\x15\xc7\xbf\xeb\x29\x50\x6c\xdb\x49\x5a\xca\x26\x25\x5f\x35\x93\x0f\x4e\xd2\x74\xb3\x53\xa7\xee\x26\x9b\x4e\xbb\xf6\x68\x60\x12\x92\x10\x91\x00\x03\x80\x8e\x9c\xad\xf7\xd9\x3b\xa0\x24\x5e\x40\x52\x37\xcb\xb7\xe4\x8c\x3d\xbb\x16\x00\x02\xe0\xc1\x39\x07\xbf\xc0\xf8\x9b\xc4\xec\x23\x95\x8a\x09\xde\x43\x8c\x6b\x3a\x94\x94\xe8\xf0\x7a\x47\xc8\xe1\xee\x95\x47\xc2\x78\x44\xbc\xd6\x98\xf1\xa0\x87\xfe\x2e\xc9\x80\x70\xf2\x9a\xa8\xd1\xa5\x20\x32\x68\x45\x54\x93\x80\x68\xd2\x6b\x21\xc4\x49\x44\x7b\x48\x5d\xf3\x80\x2a\xa6\xda\x8c\x0f\x24\x69\x8f\x44\x44\xdb\x41\xd6\x1e\xa1\x90\x5c\xd2\x50\x99\xf6\x08\x91\x38\xce\x1f\x48\x4b\xe6\x1f\x76\x98\xd8\x5d\x5c\xab\xaf\x63\x6a\xe6\x3b\x90\x44\x69\x99\xf8\x3a\x91\xb4\xa5\x62\xea\xaf\x38\x95\x9d\x4f\x4a\xf0\x16\x42\xe6\x7f\x3d\xf4\xbf\x74\xf4\xdf\xd2\xff\x22\x84\x09\xe7\x42\x13\xcd\x04\x57\xb8\x97\x15\x23\x84\x43\xa6\x34\xee\xa1\x5f\xb3\x92\xfc\xa1\x59\x8b\xcb\x84\x85\xfa\x2d\xc7\x3d\xe4\x39\xe5\x1a\x63\x27\x25\x12\xe9\x53\xdc\x43\xb8\xdd\x9e\x5b\x13\xb5\xdb\xd8\x6a\x4a\x39\xb9\x0c\x4d\x33\x2d\x13\x6a\xd5\x8d\x58\xd0\x50\xc3\x7c\xc1\x5f\x89\x50\x48\xd3\xbf\x1c\x5e\x92\x67\xae\x83\x3a\x9e\xe7\xa0\xce\xfe\xbe\x83\xbc\xe7\xf6\x30\xc6\x4c\xa6\xed\x49\xfe\xba\xe8\xcf\xe8\x24\xa4\x52\x2b\xbb\xad\xb1\xb7\x69\x9b\x19\x10\x17\xea\x6f\xb2\x9f\x2f\x66\x3f\xdd\xcc\x1f\xc7\x34\x60\xba\xe6\x6d\xf0\x90\x53\xfd\x36\xc0\x3d\xc4\x93\x30\xcc\x4b\x25\x89\x47\x1f\x84\x08\x35\x8b\x71\x0f\xb9\x59\x05\x33\x4d\xbd\xa3\xfc\xb3\xa6\x32\x9d\xb2\x29\xde\x3f\xe8\x1c\xb8\x7b\xfb\x6e\xd7\x3d\xe8\x66\x2d\x42\xc6\xc7\xaa\xb4\x58\xc5\xa5\x4a\xad\x65\x5e\x88\x4e\x34\x95\x9c\x84\xc8\xb4\x2f\xbd\x35\xd6\x64\x58\xee\xc0\x7c\xe1\xb9\x5b\xd9\x16\x4a\x7d\xb1\x68\x95\x0b\xa7\xb5\xc8\x80\x0a\xb7\x6c\xfb\x65\x4f\xe0\x98\x70\x1a\x2e\x98\xfd\x50\xb2\xe0\x4c\x94\xbd\xd3\x7c\xe1\x51\x8d\xe7\x7d\xc1\x3d\xd4\xd9\xb3\x0a\x27\x45\xf3\xce\xca\xae\x4d\x59\xa1\x28\x5b\xc5\x7c\x09\x3a\xa5\x22\xcd\x74\xba\xb2\xf8\xfd\xcc\x28\xe8\x3d\x95\x57\x54\xe2\xda\x57\x97\xe2\x4b\xe1\x9d\x9d\xfa\x37\xf3\x89\x3f\xa2\x1f\x58\x44\x45\xa2\x2d\xef\x30\x5f\xd8\x37\x1e\xfe\x92\xf8\xe3\xa1\x14\x09\x37\x53\x1a\x90\x50\x95\x62\x61\xda\xe6\x23\x09\x93\xba\x48\x99\xd6\xd6\x2c\xec\x0f\xc1\xde\x1e\xe9\x12\x7b\x5d\xd3\x48\xea\x74\x0f\x1d\xe4\x75\x8e\x1d\xb4\xe7\x3a\xc8\xdd\x39\x3a\xae\x44\xd3\x0f\x9d\xe3\x63\x7f\xef\xa0\xd9\x03\x06\x42\x46\xc4\xbc\x13\xe6\x82\xd3\xd2\xe3\x78\x48\x92\x21\xad\xae\x66\x44\x26\xf3\xd7\xf0\x5c\x7b\xb5\x22\xc6\xe7\x95\x76\x95\x1a\x89\x2f\x75\x96\x31\x4b\x36\x92\x54\x8d\x44\x18\xfc\x23\xcd\xc4\xcb\x5a\x9d\x12\x39\xa6\x52\xcd\xec\xd8\xe8\x1b\x0b\xfd\xb1\xeb\x94\xcb\xcc\xd4\x56\x75\x47\xaf\x71\x48\x16\xd8\xdd\x60\xb3\x7d\xc9\x2b\x12\xd6\xf9\x4d\x35\x1d\xd8\xae\x37\xcb\xd2\xf3\xfc\x56\xf4\xea\x36\x7a\x6b\xa2\x1b\xb5\xd1\x4f\x1f\x4f\xad\x85\x4f\xc7\xf5\xc3\x24\xa0\x1f\x49\x66\xa8\x4a\x93\x31\xa5\xb1\xf1\xea\x7a\x7b\x23\x84\x63\x22\x49\x64\x1e\xc7\x57\x44\xb6\x3f\x89\xcb\x17\xd9\xfe\xa5\xaa\x31\x35\xcb\x4f\x72\x48\xf5\xcb\x90\xf0\x71\x63\xb7\xd5\x08\x5d\xf2\x2e\x95\x3c\x55\x6d\x92\x48\x63\x61\xbc\x1b\xec\xf2\xe3\xbd\xc9\xe4\x30\x8c\xc8\x6e\x36\x59\x66\x0c\xd5\xfe\x74\x15\x15\x03\xa1\xb8\x45\xd8\x61\x11\x91\x38\x66\x7c\xf8\x61\x3a\xae\xd7\x54\xb7\xc2\xda\xcd\xf7\xb3\x2b\x13\x15\x48\x0b\xa4\xe9\x44\x57\xa7\x7f\x35\x8f\xa8\x52\xc5\x8d\xb3\x5a\xe7\x92\xf0\xe1\x0a\x9d\x77\x56\x7e\xfb\xc9\x6b\xa2\xc9\x99\x60\x5c\xab\x6a\x98\x63\x93\xff\xd2\xca\x53\x11\x98\x49\x63\x5f\x70\x4e\x7d\x4d\x83\xd2\xd8\x69\xbb\x0f\x74\x52\x9b\x32\x45\x9c\xa1\x4c\x39\x84\xe2\x30\x19\x32\x3e\xa3\x3f\xd3\xf9\xc1\x4e\x67\x67\xbf\xdc\x71\x2c\x94\x1e\x30\x13\x9e\xb8\xb6\xe2\x8d\xe0\xfa\x3d\xfb\x9a\xce\x6d\xdf\xfd\x93\xd5\x46\xd2\xfa\x67\x25\x5d\xf6\x68\x6a\xe7\x53\x12\xaf\xb0\xee\x03\x29\x22\x33\xbc\x79\xf1\x52\x27\xe6\x1b\xa7\x4e\xd0\x43\xf8\xdd\xee\x49\x4d\xa5\xc8\x1e\x5c\x71\xc1\x54\x4c\xe4\x38\x64\xbc\x26\x5b\x0f\x58\x18\x96\x01\xac\xeb\x39\xc8\xf3\x8e\x1c\xe4\x1d\x1d\x9b\x6d\xc3\x3b\xaa\x6c\x1b\x03\x33\xe9\xda\xe8\xc5\x66\x94\x62\x7f\xd3\xee\x3a\xae\x83\xbc\xe3\xee\x73\xbc\x20\xe7\xb7\x1a\xfc\x1a\xa7\x28\xf6\x4a\x84\x49\xc4\xab\xab\x32\xcd\x27\x2b\x18\x9c\x4e\xe2\x74\x4e\x49\xfc\x9b\x09\x0b\x15\x13\x9f\xbe\x38\xc7\x7f\xcc\x3e\x9c\x63\xc7\x64\xb0\xf3\x0c\x96\x66\x39\xec\x1c\xdf\x58\xd3\x2e\xed\x8b\x9a\x45\xb4\xaf\xa8\x64\xd4\xa6\xab\x34\xc9\x2a\x4d\xb8\x6e\x4a\xb0\xf3\xdc\xff\x86\xf8\x5a\x48\x3b\x93\x98\x2f\x1c\xd2\x21\xe5\xc1\x9b\x6c\xb8\xea\x18\x92\x0e\x52\x26\xc5\x27\xab\x66\xaf\x6c\xa3\x34\x66\xc3\x9e\xe3\x95\x3a\x4d\xdf\xe8\xcd\xd4\x3d\x2b\x61\x69\xea\xde\x8f\xd8\xa0\x36\x66\xf3\xbc\xad\x89\x4e\x54\x3d\x50\x29\xc6\x87\x21\x55\x9a\x94\x13\xd1\x34\x09\x15\xa3\xeb\xc8\x8e\xae\xb4\xc5\x6a\xd1\x25\x0c\x88\xe3\x17\xcd\x81\xf5\xfa\x9f\xff\x7e\xd7\x9c\x08\xeb\x82\xcb\xb9\xdb\x11\xdd\x2d\x0f\xf7\xcb\xd9\x82\xc1\x3c\x6b\xb0\x46\x4f\x49\x9f\x78\x37\xdb\x45\xfc\x44\x4a\xca\x35\x6e\xd5\x4c\xb2\x38\xc1\xed\xe3\x70\x43\x75\xd5\x11\x32\xa0\xdd\x94\x87\x67\x38\xdd\x68\x90\x3c\xee\x63\x2a\x7d\x63\x8d\x7b\x40\xe2\x6a\xee\x78\xcc\x44\xbc\xb7\x3e\x11\x1f\x38\xad\x9a\xac\xb8\x90\x88\x2f\x9a\x98\x0b\x78\xec\x4e\x78\x0c\x90\x0a\x90\xaa\x09\xa9\x54\x12\x3d\xfb\x74\x15\xf5\x23\x1a\x09\x79\xdd\x4f\x14\x0d\xfa\x97\xd7\x9a\xaa\xb5\x49\xcb\x21\x92\x92\x17\xe7\x78\x44\x49\x7c\x8e\x6f\x9e\xff\xc5\x73\xdd\x5d\xab\xfb\x88\x4c\xb6\xd4\xfb\x2d\xb0\x6e\x29\xb7\xdd\x96\xca\x0e\x5d\xe7\xd8\x2d\x8d\x9c\xe3\xd5\x4f\x1f\x4f\xd1\x8f\x94\xc4\x8f\x19\xb0\x6a\xc3\x6c\x11\x5f\x35\xda\x05\x18\x04\x18\x64\x5d\x06\x39\xb2\xca\x56\x60\x90\x43\x60\x10\x60\x10\x60\x10\x60\x90\x39\x83\x70\xc1\xef\x16\x43\x0a\x03\x3c\x65\x12\x79\x27\x78\x1b\x68\xe4\xbb\xa5\x91\x80\xfa\x2c\x22\xa1\xb2\x7d\xaf\x8a\x29\x09\x67\x80\x2a\x15\x54\xf1\x3a\xeb\xb3\xca\x11\xb0\x0a\xb0\x0a\xb0\xca\x53\x65\x95\x58\x0a\x9f\x2a\xd5\xf7\xe3\xa4\x9f\x28\x32\xa4\xbf\x35\x91\x42\x13\x5a\x3c\xe4\x6f\xa4\xee\x92\x27\x5e\x9d\xfd\x02\x18\x01\x18\x51\x8f\x11\x70\x03\xa9\xf6\x06\x92\x77\xb0\x3e\x40\x78\xee\xfa\x04\xb1\x38\x76\x96\xdd\x41\x3a\x39\x7b\xfb\x78\xee\x20\x2d\xb8\x5f\x54\x3b\xcf\xb5\xee\x17\xfd\xe7\x8c\x9f\x8d\xdd\x90\x8d\xed\xfb\x45\x24\x66\xab\xa6\x07\xb8\x5f\xf4\x70\xf7\x8b\x72\x70\x43\x92\x7e\xde\x55\xb8\xae\x7a\x21\x83\x01\xbe\x7d\xd3\xf8\x66\x8e\x9a\x24\xd1\xf4\xd9\x48\xeb\xd8\x9c\xcc\x5c\x51\xd9\x97\xf4\x73\x42\x95\x56\x7d\x45\x7d\xc1\x03\xd5\xf7\x45\xc2\xf5\xda\x67\x43\x37\xbf\xee\x47\x17\xcf\x9f\xdf\x02\xef\xee\x9c\xdf\xb6\x7d\x59\xe8\xe7\x99\xe5\x50\x4c\x25\x9a\x5a\xef\x3b\x64\x40\x72\x35\xc4\xad\x1a\xef\xbd\x1b\xfe\x3b\x9b\x67\xa8\x86\xfa\x39\x21\xd9\x5b\xf2\x63\xc6\x43\x35\x12\x52\x03\x1f\x56\xf8\xb0\xe3\x6e\xc0\x87\x9d\xf5\xf9\xf0\xa2\x89\x52\x80\x60\x1e\x8e\x60\xa8\x94\x42\x02\xc3\x00\xc3\xdc\x23\xc3\x38\x66\x8b\x4e\xd4\x1f\x7e\x3f\xc7\x9d\x9d\x9d\x6d\x30\xcd\xfc\xc8\xaa\xc6\xd4\xf7\xc3\x3c\xee\x8e\xe7\xb8\x3b\x9d\x6d\xa3\xcf\xdf\x4c\x74\x3e\x15\xf0\x29\x9f\xd9\xe5\xdd\xdf\x03\xf6\x88\x30\x24\xb1\xa2\xf5\x40\xb3\x70\x93\xb4\x5c\x61\x3d\x59\xe1\x5e\x63\x30\x4e\x37\xc9\x52\x3f\x05\xf9\xe3\x45\xfd\x6a\x67\xa7\x0d\xa7\x54\x93\xfa\x95\x06\xb5\x21\xa8\x0d\xd7\x55\x1b\xee\x37\x0e\x39\xf5\xd2\x7d\xa7\x55\x93\x2c\x17\xa2\x5c\xa1\x70\x83\xa3\xbe\x87\x90\x1b\x46\x76\x48\x81\xd8\x10\xc4\x86\x20\x36\x04\xb1\xe1\x93\x11\x1b\x9a\x0c\x06\x52\x43\x90\x1a\x82\xd4\x10\xa4\x86\x20\x35\xdc\x5c\x6a\xb8\x0c\x87\x41\x6b\x08\x5a\x43\xd0\x1a\x7e\xc7\x5a\xc3\x29\x68\xcd\x2f\xe1\x6f\xf7\x8a\x7f\x7d\xdf\xb7\x60\xba\x07\xbd\x8d\x07\x3a\x43\xd0\x19\x82\xce\x70\x5d\x9d\xe1\x32\x00\x39\x04\x00\x01\x00\x01\x00\x01\x00\x99\x02\xc8\xd6\x65\x86\x8d\xdd\x3f\x65\x0c\x01\x91\x21\x88\x0c\x41\x64\xb8\x5d\x91\xe1\x32\x50\x01\x95\x21\xa8\x0c\x41\x65\xf8\xed\xaa\x0c\x67\xa0\x00\x1a\x43\xd0\x18\x82\xc6\x10\x34\x86\x6b\x6b\x0c\x97\xf1\xc3\xb1\xd3\xaa\x89\xfa\x85\xfc\xb0\x38\x76\x1e\xa3\xc6\xb0\xe1\xe2\xd1\x82\x4b\x45\xa0\x30\x04\x85\x21\x28\x0c\x41\x61\x78\xcf\x0a\xc3\xd9\xfd\x22\xd0\x17\x3e\x72\x7d\x61\x19\x5b\xf3\xee\x6f\x45\x7f\xa0\x2e\x04\x75\xe1\x3d\xaa\x0b\x97\x90\x61\xc7\x5d\x9f\x0c\x2f\x9a\x08\x05\xe8\xe5\xe1\xe8\x05\xd4\x85\xa0\x2e\xbc\x2f\x75\xe1\xec\xb4\x0a\xb4\x85\xa0\x2d\xfc\x16\xb4\x85\x47\x8d\xa1\x38\xdd\x22\xcb\x02\xfc\xd5\xb5\x85\xaf\x5f\xd6\xaf\x33\x28\x0b\x41\x59\xb8\xae\xb2\xf0\xb8\x71\xc8\xa9\x8f\x76\xd7\xc7\xb8\x42\xe1\x06\x07\x7c\x96\x73\xdf\xc1\xf9\xde\xf6\x35\x84\x75\x73\x5e\xeb\xb4\xef\xe4\xeb\xe0\xc7\x77\xc7\xc7\xe1\xe7\xd8\x3e\xee\x0b\x2e\x57\xcd\x8b\x20\x21\x04\x09\x21\x48\x08\xbf\x75\x09\x61\x70\x09\x02\x42\x10\x10\x82\x80\x10\x04\x84\x96\x80\x30\xa0\x7e\xaa\x63\x01\xee\xad\x70\xef\xde\x06\xdc\xbb\xc1\x5f\xd4\xb8\x68\x42\x2e\xc0\x31\xc0\x31\xc0\xb1\xa7\x83\x63\x11\x99\x3c\x33\xba\x40\x61\x7e\x25\x6b\x8e\x16\x9f\xc5\xc3\x7e\x40\x34\xb9\x24\x8a\xf6\x15\xfb\x4a\x9b\x71\x0d\xd5\xf0\x9a\x83\x02\xa2\x4d\xa3\x42\xc5\xa3\x38\xd6\xbc\x7b\x98\xdb\x36\xc9\xbd\x9e\xad\x02\x32\xab\xf0\x98\x81\xae\x36\x2c\x17\xf1\x5c\xa3\x41\xe1\x8a\x5f\xf3\x15\x3f\xb7\x01\x86\xe0\x00\xb0\xf6\x00\xf0\x68\x03\x10\x3a\x04\x10\x02\x10\x02\x10\x02\x10\x9a\x83\x90\xd9\x59\x73\x1a\xe2\x49\x74\x49\xfc\x31\xe5\x81\x02\x28\x7a\x20\x28\x3a\xf1\x35\xbb\xa2\x68\x96\x39\xd2\x54\x00\x64\xf4\x3d\x92\x11\x00\xd0\x12\x00\xf2\x3a\x1b\x10\xd0\x06\x1a\x87\x8b\x26\xca\x01\x02\x02\x02\x02\x02\x7a\xea\x04\x44\xb5\x66\x7c\xa8\xd2\x3f\xdc\x50\xd8\x75\x9b\x01\xa8\x86\x7f\x80\x74\x36\x20\x9d\x53\x32\x01\xcc\xf9\xee\x31\x67\x85\xab\xfc\xa0\xf1\x5c\x5d\xe3\xb9\x84\x7f\xba\x1e\xf0\xcf\xd3\xe5\x9f\x9c\x6d\x90\x2f\x22\xb8\xc7\x0f\xf7\xf8\xad\x7b\xfc\xe9\x1d\xfe\xca\xb1\xce\x84\xf8\xba\xef\x8b\x28\x62\xab\xdf\xde\x37\x57\x93\x9c\xe6\x43\x9d\x5b\x90\xce\x52\x92\x79\x6c\x9c\xf2\x2a\xb5\x1c\xdc\xcc\x5f\x7a\x33\x1f\x20\x05\x20\xc5\x86\x94\x8e\xbb\x01\xa4\x74\x01\x52\xbe\x09\x48\x91\x22\x04\x48\x01\x48\x59\x1d\x52\xa4\x08\x43\xf3\x1b\x28\xc0\x94\xf5\x31\xe5\xe7\x99\xed\x00\x54\x32\x50\x69\x59\xed\xcd\x11\x98\x59\x02\x33\x44\xd7\xcd\x97\x1d\x2b\x7f\x44\x23\x92\x9f\x2c\xe7\xff\xb4\xc6\x4a\x5f\x87\x33\xed\x8f\x1c\xe7\x4f\x68\x32\x2c\x1b\x21\xf3\xbd\xac\x4d\xea\x46\x03\x49\x70\x65\x1a\x9a\x46\x71\x48\xcc\xb1\x63\x29\x1d\xe0\x90\x29\x6d\x59\xb6\x6c\x57\x4c\xc2\x70\xce\x07\xb6\x27\xa0\xfc\x3c\xc9\xce\x31\x05\xab\x47\xd7\xb1\x14\x9f\xa8\xbf\x60\x9b\x29\xb4\x29\x35\x29\xe5\x04\x73\x72\x43\x34\x51\x22\x91\xbe\x99\x0d\x3e\x93\x22\xa2\x7a\x44\xad\xab\xf9\xa6\x1d\x1d\x30\xce\xf4\xec\xcc\x3e\x34\xe7\x28\xfd\x74\xd1\xd4\xb3\x24\x4e\xff\xc6\xdd\xef\x85\x88\xde\xf9\xeb\x39\xbe\x71\x50\x16\xf3\x95\x8c\x35\x62\x41\x1d\x1e\xcd\x64\x65\x27\xcd\x79\xd1\x0c\x6c\x66\xf0\x6e\xde\xb5\xdd\x73\x94\x84\x9a\x35\x3c\x3d\xdf\xaa\x79\xd3\xc3\xf9\x86\x58\x66\x11\x84\xf0\xe7\x84\xca\xeb\xad\xbc\x7b\xee\xbf\x56\x3e\xc2\x92\x0e\x69\x75\xcb\x34\x6e\x39\x66\xf1\x2f\x32\x7c\x7f\xcd\xfd\x86\x57\x53\xe6\x0f\x65\x54\x7b\xd4\x64\x98\xba\x9a\xfa\xd7\x7c\xfa\x76\xd7\xf3\x18\xb0\xdf\xd7\x94\x37\x3f\x34\x4b\x42\x53\xa3\x58\x95\x89\xa2\x1f\xa6\x9d\x56\x36\xa4\xec\xe7\x8b\x96\xe5\x8e\xe9\x71\x73\x39\x90\xb2\xbd\x5d\x7c\x69\xef\x47\x85\x51\xe6\x5b\x77\x2e\xae\x2d\x77\x13\x33\x7f\x4c\x65\xb9\xb3\x99\xd1\xfb\xf3\x3d\xc1\x4e\x7e\x78\xbf\xec\xf2\xd8\x2b\xa4\x16\xf3\x5d\xca\x35\xe6\x1b\x7b\xc5\x39\x99\x1e\xac\xcf\x9e\x5d\xd0\x75\xed\x16\xa3\xf2\xe7\x8e\xf5\xd9\x0b\xf2\xe0\x2d\xac\x4f\x6a\xab\x7e\xc1\x57\xd7\x9a\x85\x3d\xe8\x81\x3d\xa8\x3d\x8b\xce\x9e\x5d\x60\x6d\x49\x87\xd6\xe7\xae\x5b\x9c\x77\xed\x12\x7d\x35\xff\xd0\x2a\x3a\xd6\x42\x35\xe7\x8f\x22\xca\x43\x15\x27\xe9\x21\x28\x3e\xf1\x3f\xbe\x7a\xf9\xe6\xfd\x7f\xbf\xe6\x35\x57\x79\xee\x3f\x6a\x21\x84\xd0\x4d\xeb\xff\x03\x00\xd6\x45\x7f\x8a\xb4\xb2\x00\x00"),
 		},
 		"/addons/ops/addon-ops-integrations-alerting-rules.yml": &vfsgen۰CompressedFileInfo{
 			name:             "addon-ops-integrations-alerting-rules.yml",
 			modTime:          time.Time{},
 			uncompressedSize: 1174,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\x41\x6b\xdc\x3c\x10\xbd\xef\xaf\x18\x9c\xef\x90\x40\x76\xfd\xf5\x90\x8b\x20\x81\x1e\x1a\xda\x5b\x09\xa5\x97\x52\x96\x59\x79\x6c\xab\x91\x34\x62\x34\xde\x64\x59\xfc\xdf\x8b\xbd\xbb\x5e\x2f\x84\x86\xf8\x24\x8d\x9e\xde\x7b\x7a\x23\x19\x93\xfb\x49\x92\x1d\x47\x03\x81\xa3\x53\x16\x17\x9b\x95\x65\x21\xce\x2b\xcb\xa1\xdc\x7e\x5a\x3c\xbb\x58\x19\xf8\x2e\x1c\x48\x5b\xea\xf2\x53\xe7\x69\x11\x48\xb1\x42\x45\xb3\x00\xf0\xb8\x21\x9f\x87\x11\x00\xa6\x64\x20\xef\x62\x45\xd9\xe5\xb1\x72\x9a\xac\x1c\x97\xff\x5e\xd5\x5d\x22\x03\x2e\xd6\x82\x59\xa5\xb3\xda\x09\x8d\xa0\x34\x69\x9b\x41\xc0\x3b\x8b\xea\x38\x2e\xcf\x96\x47\x98\xb0\x27\x03\xe8\x49\x74\x29\x9d\xa7\x83\xc2\x19\xb4\x7c\xa6\x9d\x81\xe0\xaa\xca\xd3\x0b\x1e\xb9\xdf\xe6\x33\x50\xa8\x74\x54\x2c\x00\x22\x06\x3a\x9b\x5e\xba\xa8\xd4\xc8\x88\xcf\xcb\x51\x6b\x60\x3e\xc8\xe5\x44\x76\x88\xa1\x11\xee\xd2\x31\x90\xe5\x47\x08\x60\xfc\xc6\xb1\x39\x4e\x00\xae\xe0\xf3\x80\x82\x9a\x05\x30\xee\x60\x46\x00\x2f\x4e\x5b\x40\x68\x5d\xd3\x82\xa0\x12\x70\x0d\x35\x3a\x4f\x15\xd0\xab\x6d\x31\x36\x13\xeb\x60\x65\x94\x33\xf0\xed\xcc\xf0\xe5\x84\xfa\xea\x9a\xf6\x11\x9d\xef\x84\x9e\x50\x69\xda\x04\x80\x31\xb2\x1e\xfc\x9a\x59\x19\x20\x50\xce\xd8\x90\x81\x62\x46\x08\xfb\xfd\x7f\x87\x0b\xb1\xb2\x1c\x95\x5e\xb5\xef\xc1\x45\x48\x5c\xcd\x96\x12\x57\x7d\x7f\x3b\x46\x93\x13\x5a\x9a\x2d\x4d\xb5\x01\xd0\x62\x7e\xef\x78\x66\xd8\xbb\x45\xdf\x51\xdf\x17\x17\xfe\x32\xa7\x75\x27\xde\x40\xab\x9a\xb2\x29\xcb\xc6\x69\xdb\x6d\xc6\x7b\x7d\x6a\x87\xe3\x69\x58\x6e\x3c\x6f\xca\x80\x59\x49\xca\x8a\x6d\x19\x30\x62\xe3\x62\xb3\xa6\xb8\x75\xc2\x31\x50\xd4\x5c\x2a\x27\x67\x73\x79\xea\xdc\x3a\x73\x5a\x61\xc5\xf6\x6a\xd6\x97\xc9\xdc\xe0\xbc\x3e\xa4\x2a\x97\xa9\xd2\x6b\x12\x03\x0f\x17\x86\xaf\x07\xcc\x35\x4b\xb3\xc6\x84\xb6\xa5\xb5\xc5\x40\x7e\x3d\xf5\xe8\x71\x3c\xfa\xfe\x0f\x6f\xee\x8b\x37\xef\x53\x71\x0b\xc3\x23\xba\x2f\x8e\xd1\x17\xfd\xaf\xbb\xf0\xfb\x06\xca\x0b\x99\x77\x54\x7e\xb0\xa2\xff\xb8\xc8\x0d\x3c\xc0\xff\xab\xbb\x99\x52\xcd\x62\xe0\x2e\xcc\x2a\xf3\x3f\xc5\xd4\x25\xda\x92\x38\xdd\x19\x78\x41\x89\xc3\x5b\xfe\x1b\x00\x00\xff\xff\xa4\xce\x7e\xbf\x96\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\x4d\x6b\xdb\x40\x10\xbd\xfb\x57\x0c\x4a\x0f\x09\xc4\x56\x7b\xc8\x65\x21\x81\x1e\x5a\xda\x5b\x09\xa5\x97\x52\xcc\x78\x35\x5e\x4d\xb3\xbb\xb3\xcc\xae\x9c\x98\xa0\xff\x5e\xa4\xd8\xb2\x0c\xa1\x21\xd2\x65\x3f\xde\xbe\xf7\x76\xde\x48\x98\xf8\x17\x69\x66\x89\x06\x82\x44\x2e\xa2\x1c\xdd\xca\x8a\x92\xe4\x95\x95\x50\xef\x3e\x2d\x1e\x38\x36\x06\x7e\xa8\x04\x2a\x2d\x75\xf9\xbe\xf3\xb4\x08\x54\xb0\xc1\x82\x66\x01\xe0\x71\x43\x3e\x0f\x23\x00\x4c\xc9\x40\xde\xc7\x86\x32\xe7\x71\xe5\x38\x59\xb1\xd4\xff\xdf\x2d\xfb\x44\x06\x38\x6e\x15\x73\xd1\xce\x96\x4e\x69\xa4\x48\x93\xb6\x19\x04\x3c\x5b\x2c\x2c\x71\x79\xb2\x3c\xc2\x54\x3c\x19\x40\x4f\x5a\x96\xda\x79\x7a\x51\x38\x81\x96\x0f\xb4\x37\x10\xb8\x69\x3c\x3d\xe2\x81\xfb\x75\x3e\x03\x55\xd1\x8e\xaa\x05\x40\xc4\x40\x27\xd3\x4b\x8e\x85\x9c\x8e\xf8\xbc\x1c\xb5\x38\xba\x83\x5c\x4e\x64\x87\x32\x38\x95\x2e\x1d\x0a\xb2\x7c\x0f\xc1\xe0\x17\x60\x1c\x9b\xc3\x04\xe0\x02\x3e\x0f\x28\xd8\x8a\x02\xc6\x3d\xcc\x08\xe0\x91\x4b\x0b\x08\x2d\xbb\x16\x14\x0b\x81\x6c\x61\x8b\xec\xa9\x01\x7a\xb2\x2d\x46\x37\xb1\x02\x2c\x5f\x6a\x63\xe0\xfb\x89\xe1\xcb\x11\xf5\x8d\x5d\xfb\x15\xd9\x77\x4a\xf7\x58\x68\x3a\x04\x80\x31\x4a\x19\xe5\x0e\x57\x3a\xbe\x81\x72\x46\x47\x06\xaa\x19\x21\x3c\x3f\x7f\x78\x69\x88\x95\x95\x58\xe8\xa9\xf4\x3d\x70\x84\x24\xcd\x6c\x2b\x49\xd3\xf7\xd7\x63\x69\x72\x42\x4b\xb3\xad\x69\x6d\x00\xb4\x98\xdf\xba\x9e\x19\xce\xee\xd0\x77\xd4\xf7\xd5\x99\xbf\x2c\x69\xdd\xa9\x37\xd0\x96\x92\xb2\xa9\x6b\xc7\xa5\xed\x36\x63\x5f\x1f\xe3\x60\x99\x86\xf5\xc6\xcb\xa6\x0e\x98\x0b\x69\xdd\x88\xad\x03\x46\x74\x1c\xdd\x9a\xe2\x8e\x55\x62\xa0\x58\x72\x5d\x24\xb1\xcd\xf5\x31\xb9\x75\x96\xb4\xc2\x46\xec\xc5\x2c\x97\xc9\xdc\x10\xcc\x60\xb8\x53\xd2\xf3\xaa\xd2\x53\x52\x03\x77\x67\x86\x2f\x07\xcc\xa5\xa8\x5b\x63\x42\xdb\xd2\xda\x62\x20\xbf\x9e\x32\x1a\xf2\xa1\xe6\xf9\xaf\x6c\x6e\xab\x57\xfb\xa9\xba\x86\xe1\x23\xba\xad\x0e\xa5\xaf\xfa\xdf\x37\xe1\xcf\x15\xd4\x67\x32\x6f\xa8\xfc\x94\x82\xfe\xfd\x22\x57\x70\x07\x1f\x57\x37\x33\xa5\xad\xa8\x81\x9b\x30\x5b\x99\xff\x29\x8e\x4f\xa6\x1d\x29\x97\xbd\x81\x47\xd4\xc8\xd1\x2d\xfe\x0d\x00\xa4\xce\x7e\xbf\x96\x04\x00\x00"),
 		},
 		"/addons/ops/addon-ops-integrations-camel-dashboard.yml": &vfsgen۰CompressedFileInfo{
 			name:             "addon-ops-integrations-camel-dashboard.yml",
 			modTime:          time.Time{},
 			uncompressedSize: 22099,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5c\x5b\x6f\xdb\x38\xf6\x7f\xcf\xa7\x20\x38\xf3\xff\xa3\x03\xd8\xad\xe5\xdc\x0d\xf4\x21\xed\x4c\x76\x0b\xb4\xdd\xee\x24\xd3\x05\xb6\x29\x02\x46\x3a\x96\x59\x53\xa4\x96\xa4\x1c\xbb\xdd\x7c\xf7\x05\xa9\x8b\x25\x8a\x72\xec\xb4\xe8\xee\xa4\x9a\x87\x69\xcc\xc3\x73\xc8\x73\xff\xe9\x46\x92\xd2\xf7\x20\x15\x15\x7c\x82\x28\xd7\x10\x4b\x20\x9a\xad\x9e\x0a\x19\x3f\x5b\x04\x84\xa5\x33\x12\xec\xcd\x29\x8f\x26\xe8\x2f\x92\x4c\x09\x27\xbf\x12\x35\xbb\x11\x44\x46\x7b\x09\x68\x12\x11\x4d\x26\x7b\x08\x71\x92\xc0\x04\xa9\x15\x8f\x40\x51\x35\xcc\x45\x11\x4d\x05\x57\xc3\x90\x24\xc0\x86\x51\xc5\x87\x10\x23\x37\xc0\x94\xe1\x43\x88\xa4\xe9\x9a\xd1\x8e\x94\x3f\x9e\x52\xf1\x6c\x33\x55\xaf\x52\x30\xfb\x9e\x4a\xa2\xb4\xcc\x42\x9d\x49\xd8\x53\x29\x84\x3b\x6e\xe9\xe9\x27\x25\xf8\x1e\x42\xe6\x9f\x09\xfa\xb7\x5d\xe7\x8b\xfd\x3f\x42\x98\x70\x2e\x74\xce\x87\x27\xd5\x30\x42\x98\x51\xa5\xf1\x04\x7d\xa8\x46\x50\x8d\x6a\x67\xdc\x64\x94\xe9\x57\x1c\x4f\x50\x30\x68\x52\x8c\xdd\x94\xc8\x64\x08\x78\x82\xf0\x70\x58\x5a\x17\x0d\x87\xd8\x99\x0a\x9c\xdc\x30\x33\x4d\xcb\x0c\x1c\xda\x8c\x46\x1d\x14\x1a\x0a\xfe\x52\x30\x21\x8d\x7c\x19\xdf\x90\x27\xa3\x01\x1a\x07\xc1\x00\x8d\x0f\x0f\x07\x28\xf8\xc5\x5d\xc6\x98\xcb\xcc\x3d\x5b\xab\x8b\xfe\x1f\x9d\x31\x90\x5a\xb9\x73\x8d\xdd\xcd\xdc\xca\x80\xb8\x46\xbf\xab\xfe\xfe\x58\xfc\x75\x57\xb2\xe3\x08\x54\x28\x69\x6a\xa4\x1b\xfe\x4a\x2e\x86\x88\x6a\x8f\x9a\x38\xe6\xa0\x5f\x45\x78\x82\x78\xc6\xd8\x7a\x54\x92\x74\x76\x29\x04\xd3\x34\xc5\x13\x34\xaa\x08\xd4\x4c\x1d\x1f\xac\x7f\x6b\xc8\x5d\x6e\x5c\x70\x78\x34\x3e\x1a\x1d\x1c\x1e\x8f\xc6\x47\x47\xd5\x0c\x46\xf9\x5c\x35\xbc\x58\xf7\xa1\x35\xa3\xd9\x29\x2c\x35\x48\x4e\x18\x32\xf3\x1b\xe6\xc0\x94\x87\x2c\x8b\xe0\x3d\x91\xca\xe3\x0a\x3c\x07\x48\x2f\x69\xe2\x73\x13\xd6\x24\x56\x4e\x04\x21\x84\xcb\x90\x75\xad\x5e\x8f\xe0\xba\xc1\x3f\x36\x65\xba\xbe\xa9\xcd\x2d\x5d\x53\x71\xe0\x94\x70\x60\x1b\xf4\x0f\x05\xd7\xc0\x4d\x98\xe3\x9f\xd0\xcf\xa9\x88\x9a\xba\xc7\x92\x46\xef\x44\x33\x2f\x2c\x61\x66\xfc\xe0\xec\xff\xb6\xe1\x9b\x62\x70\x59\xf7\x5f\x31\xb6\x32\x63\xf5\x88\x6a\xda\xdb\xf8\xb8\x21\x66\xed\xc4\xa6\x29\x12\x61\xb3\x03\x27\x44\xce\x23\x71\xcb\x9b\x7b\x17\x69\x95\xd3\xcd\x05\x34\xd5\x36\x10\x71\x73\xbe\x96\x84\xab\x94\xc8\xdc\x1c\x6d\x5f\x16\x76\xd7\xb0\xd4\x35\x8b\x0f\x3a\xec\x4a\xc2\x19\x98\xb0\x10\x99\x76\xa2\xbb\xb0\x3b\x13\xf2\x05\x09\xe7\xb1\x14\x19\x37\x1a\x4f\x09\x53\xd0\x9e\xf3\x9e\xb0\x0c\xba\xc9\x9e\xf0\xfa\x69\x7c\x7a\x1a\x1e\x1c\xb9\xd1\x65\x6b\xc4\x78\xff\x78\x80\x82\xf1\xe9\x00\x1d\x8c\x06\x68\xf4\xf4\xe4\xb4\x55\x27\x7e\x8a\x0e\x0e\xc8\x3e\xe9\x0e\xc0\x08\x42\x9a\x10\x1b\x54\x8d\xaa\x87\xa7\x42\x26\xc4\x86\x92\x72\xa2\x88\x64\x31\xb4\x63\x28\x21\xcb\x52\xbb\x60\xe4\xc6\x48\x42\x79\x49\x74\x49\x6a\x26\x6e\x7d\x16\x31\x4e\x9a\x49\x50\x33\xc1\xa2\xd7\xb6\x03\xdd\x37\xeb\x0d\x91\x73\xa8\xd2\xba\x33\x22\x37\x66\xc1\xbe\x27\x0b\xb6\x4d\x82\xf1\xe6\x24\x38\x76\xea\x90\x06\xb9\x20\xcc\x17\x4e\x5d\x09\x42\xd2\x94\xf2\xf8\x32\x0f\xdd\xa0\x8b\xd6\x0e\xa2\xa6\x9e\xb5\xee\xb1\x30\x3e\x41\x5a\x20\x9b\x06\x03\x77\xda\xa2\xf4\x67\x83\x70\x37\xd8\x4e\xb8\x24\x3c\xde\x42\xf8\xb8\x29\xbc\x33\x50\x13\xb2\xfc\x95\x68\xf2\x4e\x50\xae\x55\x3b\xc8\xb0\xb1\xa2\x25\xbe\x29\xea\x48\x28\x38\x87\x50\x83\x53\x04\xcd\xbc\x4b\xb3\x23\x8f\xe1\x3b\x8b\x4c\x2a\x94\x9e\xd2\x65\xbb\xcc\x14\x84\x73\xc1\xf5\x05\xfd\x6c\xd7\x3d\x1c\xfd\x9f\x33\x47\x82\x9f\xd7\x8e\x6f\x64\xb5\x36\x7c\x43\xd2\x2d\x7c\x3a\x95\x22\x31\x32\x8c\x52\x6d\x6b\xeb\x5c\x63\xfc\xf6\xd9\x99\x87\x28\x2a\xc6\x2d\x9d\x61\x2a\xeb\x9c\x51\xee\xa9\x03\x53\xca\x58\x13\xca\xec\x07\x03\x14\x04\x27\x03\x14\x9c\x9c\x9a\x32\x15\x9c\xb4\xca\xd4\xd4\xac\xed\x4f\x6f\xb3\x4a\x5d\x5e\x2e\x6e\x3c\x1a\xa0\xe0\x74\xbf\x25\xa8\x5e\x4d\x3a\xd3\xd1\x62\x97\x97\x82\x65\x09\xf7\x34\x0e\x22\x63\xd0\x5b\x18\x1c\x96\xa9\xdd\x93\xa6\x09\x3c\xf9\x05\x0d\xd1\x13\x2a\xae\x4b\x34\x70\x6d\x31\xeb\xf5\x85\x26\x52\x9b\xc6\xa1\x34\x49\xd2\x2f\x26\x35\x54\x4a\x42\x78\x7e\x85\x7f\xae\x7e\x5c\xe1\x01\x4a\x45\x64\xc6\x52\x11\x5d\xe1\x3b\xf4\xcc\x44\xf7\xc8\x55\xae\x51\x94\xcd\xaa\xd7\x0a\x24\x05\x17\x79\xd8\xe2\xa2\x34\xf1\x36\x3e\x54\xaf\x3d\xe7\x24\xd4\xd6\xae\x41\x6b\x0a\x83\x18\x78\x74\x5e\x2d\xd7\x5e\x43\xc2\xd4\x42\x3d\x7c\xb6\x6d\xd0\x54\x85\x5a\x79\xac\x5e\x76\xf1\x3f\x52\xa3\x19\xf6\x36\x6b\x45\x79\xcc\x8c\x29\x9b\xe5\x24\x2f\x25\xf5\x3c\x3a\x71\xf3\xc8\xce\xd8\x2e\x8f\x84\xc1\xa8\xf8\xf9\x8e\x29\x54\x56\xb3\x9d\xb2\xc8\x32\xbd\x2d\xca\x65\x98\x49\x0b\x56\x7a\x30\x62\x3b\x7d\x4b\xad\x1a\x1e\x99\x09\xa9\x7b\x4c\xd2\x18\x5b\x7a\xc6\xb6\xc0\x24\x27\x3d\x26\xf1\x09\xef\x31\x49\x8f\x49\xfe\xd4\x98\x44\xc8\xf8\x9a\xa4\xa6\x51\x14\x40\xe4\xb7\x65\x38\x33\x7e\x53\xaf\xf8\x94\xd1\x78\xa6\xb7\xc4\x22\x03\x64\x9a\xef\xf3\x2b\x2c\x45\xa6\x41\x5d\xe1\xbb\x1f\x11\x94\x94\x46\x43\x09\x28\x45\x62\x47\xbb\x1e\x9f\xf4\xf8\xa4\xc7\x27\xeb\xb1\x2e\x7c\x72\xb2\x3b\x3e\x39\xed\xf1\x89\x4f\x78\x8f\x4f\x7a\x7c\xf2\xb8\xf0\xc9\x39\xa1\x2c\x93\xa0\xfe\x4a\x78\xc4\x20\xea\xd1\x49\x61\xd8\x6d\xd0\x49\x69\x3b\x34\xcb\x8d\xd7\x83\x93\x1e\x9c\xf4\xe0\x64\x47\x70\x12\xb8\xcf\x3f\xb7\x40\x27\x41\xd0\xc3\x13\x9f\xf0\x1e\x9e\xf4\xf0\xe4\x71\xc1\x93\xdf\x21\x02\x46\x17\x16\x3b\xf4\xd8\xa4\xb0\xea\x36\xd8\xa4\x6e\xb8\x1e\x97\xf4\xb8\xa4\xc7\x25\xbb\xe2\x92\xa3\x07\xe0\x92\x71\x8f\x4b\x7c\xc2\x7b\x5c\xd2\xe3\x92\xc7\x85\x4b\x2e\x67\x12\x48\xa4\x5e\x30\x11\xce\xb7\xbe\x6b\xf2\x43\x02\x91\xc2\x52\xe8\x26\x37\x55\x8f\x45\x7a\x2c\xd2\x63\x91\x1d\xb1\xc8\xf8\x01\x6f\xbd\x06\xa3\x1e\x8b\xf8\x84\xf7\x58\xa4\xc7\x22\x8f\x12\x8b\xbc\x32\x19\x2e\xb3\x54\xf7\x78\x64\x1b\x3c\x42\xd7\xe6\xea\x31\x49\x37\x26\x21\x8c\x12\xf5\xb2\x44\x0d\x4e\x99\xba\xc9\x3f\xe6\x6a\xc3\x8c\x88\xa8\xd9\x6b\xe0\xb1\x9e\xb5\x3b\x91\xa1\x81\x9f\xcd\xe4\x72\xab\xd5\x6c\xec\xa0\xc7\x9e\x0e\xda\x7a\xa2\xd0\xf5\xdd\xc8\xe1\xe6\x0e\xda\xbc\x98\xcf\x03\xb6\xbd\x0b\xc2\x68\xcc\xcf\xd4\x65\xf1\x59\x9e\xaf\xa4\x90\x45\xdc\x41\x29\xdd\xd0\xf9\xd5\xe2\x6f\x49\xaa\x57\x1d\xcc\x86\xfe\x4f\x90\xa2\x83\x9c\x90\xa5\x5f\x6e\x42\x79\x07\x8b\xa4\xf1\x4c\x5f\xe4\x1f\x4b\xfa\xe8\x45\xb5\xf3\xc8\xd4\x42\x93\xae\x8a\x6a\x03\xef\x5e\xa0\x64\xea\xae\xf7\xcb\x40\x43\xb8\xa5\x51\x1e\x4a\xdb\xe0\x96\x56\x67\x6e\xb5\xa8\x0d\x8d\x17\x64\x08\x5c\x93\xd8\x0f\x9f\x53\x23\x56\x92\x88\x66\x86\xf7\xb0\x4d\xf3\xc7\xb5\x04\x1e\x81\x04\x5b\xbe\xa7\x4c\x38\x05\x25\x2f\xb2\x7f\x5b\x80\x94\x34\x02\x8f\x42\xb6\x78\x77\xa5\x93\xd2\x24\x9c\x7b\x57\x55\x1a\xd2\x14\xa2\xd7\x79\xdb\x6c\xd3\x77\xee\x3c\x92\x68\x78\xd2\xfd\x86\xe3\xa5\x89\x81\x07\xdf\xa4\xff\x70\x98\x7c\xfc\x9a\x0f\x2f\x76\xee\x38\x76\xbb\x3b\xb4\x9d\x7b\x60\xe8\x96\x46\x3a\x27\xf4\x6b\x5e\xb3\xf8\xee\x56\xca\xf7\xbb\xc1\x4c\x2f\x1e\xd4\x9d\x9d\x08\x37\xbb\x3e\xcf\x01\x65\x0b\x24\x1b\xda\xef\x10\x17\xf9\xea\x61\xbc\x98\xd1\xa9\x17\x5e\x57\x3d\xff\x4d\xf1\xf6\x28\x4a\x41\x22\x05\xa1\xe0\x6e\xcf\xaf\x3e\x92\xfe\xe2\x56\x3c\x22\x21\xf2\xd7\x3c\x65\x2e\x56\xdb\x9d\xc5\x96\xbb\xeb\x12\x45\x50\x1e\xd1\x05\x8d\x32\xd2\xe8\xd3\x77\x5e\xc4\x61\xbf\xd7\x6e\x6e\x6c\x49\x96\xd4\xd3\xfa\x6e\xb2\x70\x9e\xa7\xae\xab\x74\xed\x7b\xde\xd6\xf7\x3c\xeb\x8b\x25\x0f\x57\x77\x6d\xaf\xea\xf7\x87\x8f\x9d\x2a\xac\xc8\x72\x9b\x0b\xc1\x0d\xb7\x01\xd0\x7d\xb7\x02\xec\x04\x7b\x24\x42\x17\x37\x13\xf1\x0b\xa2\x5a\x17\xab\x68\xdd\x0d\xbd\x6c\x79\x3f\xc4\xa3\xf6\x6a\x35\x9b\xec\x54\x0b\xfe\x8b\x6a\x78\x49\x9d\x7a\x74\x26\xeb\xca\x1f\x77\x16\xec\x74\xa1\x1c\x43\x7b\x0d\x8b\x4a\xb1\x3d\xdf\x42\x8f\x1d\x67\x7a\x5f\x67\xb9\x07\x68\x1e\x6e\x07\x34\x1f\x86\x22\x1f\x00\x03\x7b\x98\xd7\xc3\xbc\xf6\x5d\x06\xe3\xfa\x77\x52\x84\xa0\xcc\x95\xf1\x25\x4d\xe0\xeb\x50\x0c\x7a\x86\x1e\x17\xa0\x7c\x03\x84\xa3\xb4\xb2\x10\xf2\xf4\xdf\xaf\xbf\xad\xf1\xbd\x81\xd3\x3d\x2a\xf5\xc8\xe9\x7f\x0e\x39\x25\x9e\x68\xee\x61\xd3\x9f\x1d\x36\xed\x39\xcb\x99\x3a\x62\xca\x82\xb1\xd6\xfe\x68\xed\x72\xac\xc2\x19\x24\xa4\x38\xad\xac\xf1\x1e\x0b\x56\x7a\x95\xa7\x75\x44\xe4\xfa\x74\xa6\xf6\xf1\x4a\xbe\xa3\x95\xbc\xc7\x2a\xad\x77\xa3\x21\x49\x19\xd1\x94\xc7\xbb\x1e\xfe\x45\x18\x2b\x1f\xd6\x79\x72\x6b\x0d\x6d\x5a\xd1\x52\xde\x21\x4d\x56\xa9\x14\x9f\x20\xdc\xf0\xcc\xa7\x36\x67\x53\x40\x3a\xc7\x8d\xbd\x93\x22\x01\x3d\x83\xac\x75\xc4\x54\x04\x53\xca\x69\x79\x3c\x97\x8d\xca\xeb\x3c\xd7\x9f\x64\xe9\x97\x4f\xe2\xe6\xf9\x15\xf6\x9e\xa8\x76\x85\xef\x06\xa8\xea\x61\xad\xa7\x09\xc5\x39\x65\x6e\x5d\x2c\x8e\xce\x3a\xeb\x7e\x66\x51\xe4\x05\x7e\x5b\x8a\x76\x25\x27\x19\xd3\xb4\x83\xbb\x7c\x80\xc6\xbb\x98\xd7\x30\xaa\xd9\x44\x10\xc2\xff\xca\x40\xae\xbe\xad\x11\xd6\x81\xed\x9e\x06\x27\x21\x86\xf6\x73\x2d\x13\xaf\x73\x9a\xfe\x21\xd9\xc5\x8a\x87\x5d\x90\x36\xef\x38\xae\x44\x4d\x62\x1b\x7c\xea\xef\xa5\x1e\xae\xe8\x32\x39\x5c\xc5\xcd\x78\x37\x53\xd1\x96\x72\xeb\x38\xc4\x4c\xc1\x65\x2e\x74\xe3\x53\xa3\x6f\x9d\x26\x74\x48\x3a\xfe\x1b\xee\x0f\xd9\xe9\x71\x38\xdb\x90\x3f\x5b\x30\x7f\x87\xc4\xea\x44\x82\xf7\x05\x5b\x2a\xa2\x6f\x9f\x6b\xef\x84\x7b\x5b\x6c\xab\x2c\x73\x0f\x87\x7b\x60\x7e\x7d\x53\x5b\xfc\xc0\x29\x57\xfd\xdd\x3e\x85\x51\xe7\xc7\x11\xd6\xba\x59\xf5\xcc\x5b\xdc\x0e\xf7\xeb\xf9\x52\x3e\xd2\x16\xb7\xd8\x2b\x26\xa5\xe1\xdc\x5e\x17\xd6\x84\x15\x46\xbf\x2e\xaf\x34\x5c\x10\x88\x0f\x9d\x17\xb7\x83\x91\x33\xb0\xef\x0e\x04\x49\xf3\xf7\xa1\xf3\x3b\x70\x07\xf6\x47\xee\x0c\x07\x45\x8f\x9d\xdf\x41\xed\x0c\xcb\x8f\x75\xfd\xcd\xb5\x54\x2d\x8e\x77\xda\x85\xbb\xe8\x91\xbb\xa8\xbb\x8b\xf1\x81\x3b\xe0\xdc\xcf\x3d\x8e\x5c\x3d\xeb\xfb\xf6\xba\xe8\xb3\xb0\xd7\xcf\xb5\xc3\x36\xab\xab\xa0\x8b\x22\x99\xd0\x10\xbd\xaa\xa5\x13\x1a\xa2\x97\xe6\x82\x75\xcd\x91\xd9\xdb\x39\x98\xef\xcb\x70\x01\xef\xff\xf1\x79\x4d\x59\xac\xf1\x98\x1d\xba\xdb\xfb\x4f\x00\x00\x00\xff\xff\x6a\x9f\x66\x88\x53\x56\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5c\x7d\x6f\xdb\xb8\x19\xff\xdf\x9f\x82\xe0\xdd\x86\x16\xb0\x5b\xcb\x8e\xf3\x62\xa0\x7f\xa4\xbd\xeb\x56\xa0\xbd\x75\x97\x5c\x07\xac\x29\x02\x46\x7a\x2c\xb3\xa6\x48\x8d\xa4\x1c\xbb\x5d\xbe\xfb\x40\xbd\x8b\xa2\x1c\x3b\x57\x74\x77\xad\xce\xc6\x35\xe2\xc3\x87\xe4\xf3\xfe\x93\x64\x92\xc4\xf4\x1d\x48\x45\x05\x9f\x23\xca\x35\x84\x12\x88\x66\xdb\x27\x42\x86\x4f\xd7\x1e\x61\xf1\x92\x78\x83\x15\xe5\xc1\x1c\xfd\x4d\x92\x05\xe1\xe4\x27\xa2\x96\x37\x82\xc8\x60\x10\x81\x26\x01\xd1\x64\x3e\x40\x88\x93\x08\xe6\x48\x6d\x79\x00\x8a\xaa\x51\x36\x14\xd1\x54\x70\x35\xf2\x49\x04\x6c\x14\x94\x7c\x08\x31\x72\x03\x4c\x19\x3e\x84\x48\x1c\x57\x8c\x69\x4b\x71\xf1\x84\x8a\xa7\xbb\xa9\x7a\x1b\x83\x59\xf7\x42\x12\xa5\x65\xe2\xeb\x44\xc2\x40\xc5\xe0\x1f\xb8\xa4\x27\x1f\x95\xe0\x03\x84\xcc\x3f\x73\xf4\xdf\x74\x15\x9f\xd3\xff\x23\x84\x09\xe7\x42\x67\xa2\xe0\x79\xd9\x8c\x10\x66\x54\x69\x3c\x47\xef\xcb\x96\x8a\x29\xef\x71\x93\x50\xa6\x5f\x71\x3c\x47\xde\xb0\x49\x31\x7a\x53\x22\x91\x3e\xe0\x39\xc2\xa3\x51\xa1\x5d\x34\x1a\x61\xab\x2b\x70\x72\xc3\x4c\x37\x2d\x13\xb0\x68\x4b\x1a\x74\x50\xa8\x2f\xf8\x0b\xc1\x84\x34\xe3\xcb\xf0\x86\x3c\x1a\x0f\xd1\xc4\xf3\x86\x68\x32\x9b\x0d\x91\xf7\xd8\x9e\xc6\xa8\xcb\xf4\x3d\xaf\xc4\x45\x7f\x45\xe7\x0c\xa4\x56\x76\x5f\xa3\x77\xd3\xb7\x54\x20\xae\xd1\xef\xca\xbf\x3f\xe4\x7f\xdd\x15\xec\x38\x00\xe5\x4b\x1a\x1b\x65\x1a\xfe\x72\x5c\x0c\x01\xd5\x0e\x31\x71\xc8\x41\xbf\x0a\xf0\x1c\xf1\x84\xb1\xaa\x55\x92\x78\x79\x29\x04\xd3\x34\xc6\x73\x34\x2e\x09\xd4\x74\x9d\x1c\x55\xd7\x1a\x32\x93\x1b\x13\xcc\x8e\x27\xc7\xe3\xa3\xd9\xc9\x78\x72\x7c\x5c\xf6\x60\x94\xaf\x54\xc3\x8a\x75\x1b\xa6\x6a\x34\x2b\x85\x8d\x06\xc9\x09\x43\xa6\x7f\x43\x1d\x98\x72\x9f\x25\x01\xbc\x23\x52\x39\x4c\x81\x57\x00\xf1\x25\x8d\x5c\x66\xc2\x9a\x84\xcd\xb9\xcd\x07\x17\x2e\xdb\x98\xc6\xac\xa5\xe6\xc1\x75\x85\x7f\x18\x0e\x76\xd9\x46\xe1\x81\x6d\x9a\x92\x03\xc7\x84\x03\xdb\x21\xbf\x2f\xb8\x06\x6e\xdc\x1c\xff\x80\x7e\x8c\x45\xd0\x94\x3d\x94\x34\x78\x2b\x9a\x71\x61\x3e\x78\x69\xec\x50\xef\x8a\x10\xbe\x6d\xd8\x26\x6f\xdc\xd4\xed\x97\xb7\x6d\x4d\x5b\xad\xa9\xf4\x9f\xca\xc6\x8d\x61\x2a\x23\x36\x55\x11\x89\x34\x3a\x70\x44\xe4\x2a\x10\xb7\xbc\xb9\x76\x11\x97\x31\xdd\x9c\x40\x53\xcd\xa0\xe1\x9e\xe6\x8b\xb5\x24\x5c\xc5\x44\x66\xea\x68\xdb\x32\xd7\xbb\x86\x8d\xae\x69\x7c\xd8\xa1\x57\xe2\x2f\xc1\xb8\x85\x48\xb4\xe5\xdd\xb9\xde\x99\x90\xcf\x89\xbf\x0a\xa5\x48\xb8\x91\x78\x41\x98\x6a\xce\xe8\x9b\xf0\x7e\x47\x58\x02\xdd\x64\x87\x7b\xfd\x30\x39\x3b\xf3\x8f\x8e\x1b\xc2\xa1\x3c\x47\x4c\xa6\x27\x43\xe4\x4d\xce\x86\xe8\x68\x3c\x44\xe3\x27\xa7\x67\xad\x3c\xf1\x43\x70\x74\x44\xa6\xa4\xdb\x01\x03\xf0\x69\x44\x98\xb2\xb3\x1e\x5e\x08\x19\x11\x23\x2d\x6e\xba\x36\x0e\x49\x12\x42\xdb\x87\x22\xb2\x29\xa4\xf3\xc6\xb6\x8f\x44\x94\x17\x44\x9b\xa4\x96\xe2\xd6\xa5\x11\x63\xa4\xa5\x04\xb5\x14\x2c\x78\x9d\x56\xa0\xfb\x7a\xbd\x21\x72\x05\x65\x58\x77\x7a\xe4\xce\x28\x98\x5a\x83\xdf\xda\xde\xbb\x23\x08\x26\x9d\x53\xa6\x89\xee\xa4\xd9\xc4\x35\xc8\x35\x61\x2e\x77\xea\x0a\x10\x12\xc7\x94\x87\x97\x99\xeb\x7a\x5d\xb4\xb6\x13\x35\xe5\xac\x55\x8f\xb5\xb1\x09\xd2\x02\xa5\x61\x50\x1f\xd0\x7c\xf0\xba\xb0\x67\x83\x70\x37\xdc\x6f\x70\x49\x78\xb8\xc7\xe0\x75\xad\xd5\xcb\x91\xed\xa8\x11\xd9\xfc\x44\x34\x79\x2b\x28\xd7\xaa\xed\x64\x98\x27\x8c\xa5\xc4\x37\x79\x1e\xf1\x05\xe7\xe0\x6b\xb0\x92\xa0\xe9\x77\x09\x1b\x67\x1c\x77\x26\x99\x58\x28\xbd\xa0\x9b\x76\x9a\xc9\x09\x2f\x05\xd7\x17\xf4\x53\x3a\xef\x6c\xfc\x17\xab\x8f\x04\x37\xaf\x84\xfb\x58\x53\x1d\xbe\x21\xf1\x1e\x36\x5d\x48\x11\x99\xe9\x8d\x50\x8d\x41\xcc\x17\xa7\x06\x9e\x23\xfc\xcb\xd3\x73\x07\x51\x94\x8c\x7b\x1a\xc3\x64\xd6\x15\xa3\xdc\x91\x07\x16\x94\xb1\x26\x94\x99\x7a\x43\xe4\x79\xa7\x43\xe4\x9d\x9e\x99\x34\xe5\x9d\xb6\xd2\xd4\xc2\x2c\xda\x1d\xde\x66\x96\xfa\x78\xd9\x70\x93\xf1\x10\x79\x67\xd3\xc7\x78\x47\x36\x19\x74\xf8\x2c\x4e\xb1\xcb\x0b\xc1\x92\xa8\x89\x6b\x72\xa2\x0c\x41\xef\xa1\x70\xd8\xc4\xe9\x9a\x34\x8d\xe0\xd1\x63\x34\x42\x8f\xa8\xb8\x2e\xd0\xc0\x75\x8a\x59\xaf\x2f\x34\x91\xda\x14\x0e\xa5\x49\x14\x7f\x36\xa1\xa1\x62\xe2\xc3\xb3\x2b\xfc\x63\x79\x71\x85\x87\x28\x16\x81\x69\x8b\x45\x70\x85\xef\xd0\x53\xe4\x8d\xc7\x63\x5b\xb8\x46\x52\x36\xb3\x5e\x2b\x90\x14\x6c\xe4\x91\x26\x17\xa5\x89\xb3\xf0\x35\x72\xcf\x4b\xe2\x6b\x21\xed\x5c\x62\x3e\x98\x41\x08\x3c\x78\x59\x4e\xd7\x9e\x43\xc2\x22\x85\x7a\xf8\x7c\x5f\xa7\x29\x13\xb5\x72\x68\xbd\xa8\xe2\xbf\xc5\x46\x32\xec\x2c\xd6\x8a\xf2\x90\x81\xd2\xa4\x99\x4e\xb2\x54\x52\x8f\xa3\x53\x3b\x8e\xd2\x1e\xfb\xc5\x91\x30\x18\x15\x3f\x3b\x30\x84\x8a\x6c\x76\x50\x14\xa5\x4c\xbf\xe4\xe9\xd2\x4f\x64\x0a\x56\x06\x0e\x9f\xfd\x0e\xc1\x48\x4b\xac\x1a\x1e\x59\x0a\xa9\x7b\x4c\xd2\xc2\x24\x47\x87\x63\x92\xd3\xe1\xc0\x91\x17\x7a\x4c\xd2\x63\x92\x1e\x93\xfc\x99\x31\x89\x90\xe1\x35\x89\x4d\xa1\xc8\x81\xc8\xcf\x1b\x7f\x69\xb0\xa4\x7a\xc5\x17\x8c\x86\x4b\xbd\x27\x16\x19\x22\x53\x7c\x9f\x5d\x61\x29\x12\x0d\xea\x0a\xdf\x7d\x8f\xa0\xa4\x50\x1a\x8a\x40\x29\x12\x82\xea\xf1\x49\x8f\x4f\x7a\x7c\x72\x20\x3e\x39\x3d\x1c\x9f\x9c\x0d\x07\x8e\x14\xd1\xe3\x93\x1e\x9f\xf4\xf8\xe4\x9b\xc2\x27\x2f\x09\x65\x89\x04\xf5\x77\xc2\x03\x06\x41\x8f\x4e\x0e\x40\x27\x85\xee\xd0\x32\x53\x5e\x0f\x4e\x7a\x70\xd2\x83\x93\x03\xc1\x89\x37\x39\x1c\x9d\x78\x5e\x0f\x4f\x7a\x78\xd2\xc3\x93\x6f\x1f\x9e\xfc\x0a\x01\x30\xba\x4e\x9f\x6c\xf4\xd8\xe4\x00\x6c\x52\x57\x5c\x8f\x4b\x7a\x5c\xd2\xe3\x92\x43\x71\xc9\xb1\xd5\xb8\x0f\x2e\x99\xf4\xb8\xa4\xc7\x25\x3d\x2e\xf9\xf6\x71\xc9\xe5\x52\x02\x09\xd4\x73\x26\xfc\xd5\xde\x4f\x4d\xbe\x4b\x20\x92\x6b\x0a\xdd\x64\xaa\xea\xb1\x48\x8f\x45\x7a\x2c\x72\x20\x16\x99\x8c\x1f\x80\x45\xc6\x3d\x16\xe9\xb1\x48\x8f\x45\xbe\x1b\x2c\xf2\xca\x80\x00\x99\xc4\xba\xc7\x23\xfb\xe0\x11\x5a\xa9\xab\xc7\x24\xdd\x98\x84\x30\x4a\xd4\x8b\x02\x35\x58\x69\xea\x86\x48\x67\x9d\x4c\x77\x55\xbd\x06\x1e\xea\x65\xbb\x12\x19\x1a\xb8\xd9\xcc\x4f\xd8\x5b\xa5\x66\x67\x05\x3d\x71\x54\x50\x6f\xb2\xe7\xbe\x91\x59\xad\xa9\x29\x58\x5a\x41\x9b\x37\xf3\x19\x80\x6e\xaf\x82\x30\x1a\xf2\x73\x75\x99\x6f\xcb\x6b\x09\x65\xb6\x46\xae\xc3\x0e\x4a\x61\x06\x57\x1c\xa5\xbb\x16\x7f\x8e\x62\xbd\xed\x60\x36\xf4\x7f\x83\x14\x1d\xe4\x88\x6c\xdc\xe3\x46\x94\x77\xb0\x48\xf3\x5b\xab\x8b\x6c\xb3\xa4\x8b\x9e\x67\x3b\xc7\x98\x5a\x68\xd2\x95\x51\x53\xc7\xbb\x17\x28\x99\xed\x06\x45\xa7\x16\xe1\x96\x06\x99\x2b\xed\x83\x5b\x5a\x95\xb9\x55\xa2\x76\x14\x5e\x90\x3e\x70\x4d\x42\xa7\x0a\x70\x6c\x0a\xbe\x24\x01\x4d\xcc\x52\x67\x6d\x9a\xdb\xaf\x25\xf0\x00\x24\x98\x3b\x33\xbc\x60\xc2\x4a\x28\xd9\x4d\xdf\x3f\xd6\x20\x25\x0d\xc0\x21\x50\x9a\xbc\xbb\xc2\x49\x69\xe2\xaf\x9c\xb3\x2a\x0d\x71\x0c\xc1\xeb\xac\x6c\xb6\xe9\x07\x57\x1e\x49\x34\x3c\xea\xfe\x85\xe3\xa5\xf1\x81\x07\x3f\xa4\x7f\x3f\x8b\x3e\x3c\xfe\x1d\x05\xe9\xe0\x8a\x93\x2e\xf7\x80\xb2\x33\xfc\x22\x4a\x32\xbf\x19\x80\xe0\xcf\xa3\xa5\x6c\xbd\x3b\xd4\xf4\xdc\x52\x53\xed\x6a\x47\x75\xb6\x3c\xdc\xac\xfa\x65\x06\x28\x5b\x20\xd9\xd0\x7e\x85\x30\x8f\x57\x07\xe3\xc5\x92\x2e\x9c\xf0\xba\xac\xf9\x6f\xf2\x5f\x8f\xa2\x18\x24\x52\xe0\x0b\x6e\x81\x74\x5d\x6e\x92\x6e\xda\x15\xab\x25\x91\x10\x38\xd2\x92\xa1\x99\xdd\x10\xed\xca\x92\xa6\xbb\xeb\x02\x45\x50\x1e\xd0\x35\x0d\x12\xc2\x70\x67\xea\x2b\xfa\xa6\xfb\xb5\x9b\x0b\xdb\x90\x0d\x75\x94\xbe\x9b\xc4\x5f\x65\xa1\x6b\x0b\x5d\xdb\xcf\xdb\xda\xcf\x53\xdd\x2c\x39\xb8\xba\x73\x7b\x99\xbf\xdf\x7f\xe8\x14\x61\x4b\x36\xfb\xdc\x08\xee\x78\x0c\x70\xef\xa3\x00\xf3\xc5\xe9\x91\x08\x5d\xdc\x4c\x84\xcf\x89\x6a\xdd\xac\xd6\xaa\xa1\x93\x2d\xab\x87\x78\xdc\x9e\xad\xa6\x93\x06\xe9\x6e\xb8\x5b\xcc\xff\xa3\x18\x4e\x52\xa7\x1c\xb5\xab\x66\x5c\x6d\xdd\x7e\x97\x82\x1d\x57\x31\x29\x68\xaf\x61\x5d\x0a\x36\x70\xa1\xd2\x9a\xea\xbe\x49\x9c\xe9\x4d\x0e\x07\x9a\xb3\xfd\x80\xe6\xc3\x50\xe4\x03\x60\x60\xcd\x5d\x7a\x98\xd7\xc3\xbc\x0c\xe6\xa5\x70\xe9\xad\x14\x3e\x28\x73\x67\x6c\xf6\x41\xfe\x3e\x14\x83\x9e\xa2\x6f\x0b\x50\xbe\x01\xc2\x51\x5c\x6a\x08\x39\xea\xef\x2e\x7c\x59\xbb\xfa\x03\x01\xa7\x7b\x44\xea\x91\xd3\x1f\x0e\x39\x45\xea\x6b\xe2\x8d\x1e\x36\x7d\x1d\xd8\x34\xb0\xa6\x33\x79\xc4\xa4\x05\xa3\xad\xe9\xb8\x32\x39\x56\xfe\x12\x22\x92\x9f\x56\xd6\xf8\x1d\x0b\x56\x7a\xcb\xf2\x43\x8f\x64\x75\x3a\x53\xfb\x78\x25\xd7\xd1\x4a\xce\x63\x95\xaa\xd5\x68\x88\x62\x46\x34\xe5\xe1\xa1\x87\x7f\x11\xc6\x8a\x97\x75\x6d\x23\xd4\xa0\xcd\xe7\xae\x27\xa4\xd1\x36\x96\xe2\x23\xf8\x7a\xc7\x73\xd2\xaa\xcf\x2e\x87\xb4\x8e\x1b\x7b\x2b\x45\x04\x7a\x09\x49\x5d\x0f\x79\x30\x2e\x28\xa7\xc5\xf1\x5c\xe9\x3d\xc9\x75\x9a\xbc\xd4\xa3\x24\xfe\xfc\x51\xdc\x3c\xbb\xc2\xce\x13\xd5\xae\xf0\xdd\x10\x95\x35\xcc\xae\x4e\xc5\x39\x65\x76\x5e\xcc\x8f\xce\x3a\xef\x7e\x67\x91\xc7\x05\xfe\xa5\x18\xda\x1e\x39\x4a\x98\xa6\x1d\xdc\x79\x66\xc3\xbc\x8b\xb9\x82\x51\xcd\x22\x82\x10\xfe\x4f\x02\x72\xfb\x65\x95\x50\x39\xb6\x15\xc7\x58\x42\x08\xed\xf7\x5a\xc6\x5f\x57\x34\xfe\x4d\xb2\x8b\x2d\xf7\x3b\x64\xcc\x2b\x8e\x3d\xa2\x26\x61\xea\x7c\xea\x9f\x85\x1c\xf6\xd0\x45\x70\xd8\x82\x9b\xf6\x6e\xa6\xbc\x2c\x65\xda\xb1\x88\x89\x82\xcb\x6c\xd0\x9d\x6f\x8d\xbe\x74\x98\xd0\x11\xe9\xf8\x6f\x34\x1d\xb1\xb3\x13\x7f\xb9\x23\x7e\xf6\x60\xfe\x0a\x81\xd5\x89\x04\xef\x73\xb6\x58\x04\x5f\x3e\xd6\xde\x8a\xe0\x21\x51\x66\x1f\x0e\xf7\xc0\xf8\xfa\xa2\xba\xf8\x8e\x43\xae\xfc\xfb\xc3\xc0\x72\xdd\x0c\x05\xd6\x23\xaa\x7a\xe7\x2d\x6e\x47\xd3\x7a\xbc\x14\xaf\xb4\xc5\x2d\x76\x0e\x13\x53\x7f\x05\xb2\x39\x58\xae\xf4\xeb\xe2\x4e\xc3\x06\x81\x78\xd6\x0c\x0f\xec\xd5\xca\xbc\xf9\x36\xea\xbe\xf9\x62\x2f\x6a\x5e\xcf\xac\x6b\xcf\x6e\x98\x8e\xed\x1e\xcd\x2c\x80\x27\xd6\xb5\x17\x54\x15\xb4\x66\x9f\x54\x57\xd7\x35\x3f\x3e\x68\x15\xf6\xa4\xc7\xf6\xa4\xf6\x2a\x26\x47\x76\x43\x33\xa6\xf0\x89\x75\x3d\x1d\xd7\xd7\xed\x34\xd1\x27\xc1\x9b\xa7\x19\x56\x77\x41\x17\x79\x30\xa1\x11\x7a\x55\x0b\x27\x34\x42\x2f\xcc\xc3\xfd\x8a\x23\x49\xdf\x1b\x62\x3e\x95\xfe\x1a\xde\xfd\xeb\x53\x45\x59\x57\x78\x6c\x80\x10\x42\x77\x83\xff\x0d\x00\x6a\x9f\x66\x88\x53\x56\x00\x00"),
 		},
 		"/addons/ops/addon-ops-integrations-home-dashboard.yml": &vfsgen۰CompressedFileInfo{
 			name:             "addon-ops-integrations-home-dashboard.yml",
 			modTime:          time.Time{},
 			uncompressedSize: 13331,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\x7b\x4f\x1b\x3b\x16\xff\x9f\x4f\x71\xe4\xde\xbd\x82\x55\x02\x99\x40\x60\x89\x54\xad\x28\xdc\xde\x76\x25\x7a\x59\xf1\xb8\xaa\x0a\x8a\xcc\xcc\x21\x33\xc5\x63\xcf\xda\x1e\x0a\x65\xf9\xee\x2b\x3b\x93\x79\x78\x26\x21\x49\xd3\x5e\xe8\xf2\x47\xdb\x8c\xcf\xf1\xeb\x3c\x7f\x3e\x76\x69\x12\x9d\xa1\x54\x91\xe0\x7d\x88\xb8\xc6\xa1\x44\xaa\xd9\xdd\xba\x90\xc3\x8d\x1b\x8f\xb2\x24\xa4\xde\xca\x75\xc4\x83\x3e\xfc\x2e\xe9\x15\xe5\xf4\x80\xaa\xf0\x52\x50\x19\xac\xc4\xa8\x69\x40\x35\xed\xaf\x00\x70\x1a\x63\x1f\xd4\x1d\x0f\x50\x45\xaa\x3d\x1a\x8a\xea\x48\x70\xd5\x0e\x45\x8c\xed\x20\xef\x06\xc0\xe8\x25\x32\x65\xba\x01\xd0\x24\x29\xfa\xd9\x96\xf1\xc7\x7a\x24\x36\xa6\x53\xf5\x5d\x82\x66\xd9\x57\x92\x2a\x2d\x53\x5f\xa7\x12\x57\x54\x82\xfe\x7c\x2b\x5a\xff\xac\x04\x5f\x01\x30\xff\xf4\xe1\xbf\x76\x9a\x7b\xfb\x37\x00\xa1\x9c\x0b\x3d\xea\x46\xfa\x79\x33\x00\x61\x91\xd2\xa4\x0f\x9f\xf2\x16\x28\x51\x2d\xc7\x65\x1a\x31\xfd\x9e\x93\x3e\x78\xad\x2a\xc5\x48\x4d\x89\x54\xfa\x48\xfa\x40\xda\xed\xb1\x6c\xa1\xdd\x26\x0e\x2b\x72\x7a\xc9\x0c\x9b\x96\x29\x3a\xb4\x30\x0a\x26\x50\x22\x5f\xf0\x7d\xc1\x84\x34\xe3\xcb\xe1\x25\x5d\xed\xb4\xa0\xeb\x79\x2d\xe8\xf6\x7a\x2d\xf0\xd6\xdc\x69\x8c\xb4\x0c\xef\x5e\xb1\x5d\xf8\x15\xf6\x18\x4a\xad\x5c\x5e\x23\x76\xc3\x9b\x0b\x90\x94\xe8\x0f\xf9\xef\x8b\xec\xd7\xc3\xb8\x3b\xc1\x20\xd2\x0d\xbb\x21\x43\x8e\xfa\x7d\x40\xfa\xc0\x53\xc6\x8a\x56\x49\x93\xf0\x44\x08\xa6\xa3\x84\xf4\xa1\x93\x13\x22\xc3\xda\xdd\x2c\xbe\x35\x8e\x14\x6b\x24\xdd\xdb\xee\x6d\xee\x76\x37\x77\x7a\x5d\x6f\x37\xe7\x60\x11\xbf\x56\x15\x65\x95\x55\x65\xa5\x65\x36\x84\xb7\x1a\x25\xa7\x0c\x0c\x7f\x65\xd7\x24\xe2\x3e\x4b\x03\x3c\xa3\x52\x35\x48\x9c\x5c\x23\x26\x27\x51\xdc\xa4\x0d\xa2\xe9\x50\x39\x86\x02\x40\xc6\x86\xe9\x0a\xb7\x6c\xa7\x65\xb9\x5e\x54\xc7\x74\x55\x50\xe2\x1d\x6b\x20\xef\x41\x12\xca\x91\x4d\xd9\xbf\x2f\x58\x1a\x5b\xfb\xfe\x54\x9d\xe6\x4a\x70\x7d\x1c\x7d\xb5\x53\x79\x9d\xce\xdf\xaa\x32\x19\xca\x28\x38\x12\x55\xb7\xb0\x84\x90\xf4\x61\xdb\xd9\xd7\x17\xa3\x9c\xae\xd3\x78\x5b\xd6\x6b\xd6\x76\x67\xda\xca\x06\x55\xd5\x83\xd1\xfd\x76\xaf\xd2\x96\x6b\xb7\xba\x78\x91\xe4\x5e\x5b\x1d\x23\xa1\x43\xcc\x76\x55\x36\x38\x4b\x53\xbe\x14\x8c\x91\x3e\x5c\x51\xa6\xaa\x7a\x54\xa1\xf8\xf2\x0e\x69\x80\xb2\x49\xcb\x4a\x48\x5d\x97\x84\x2f\x58\xc3\x16\x03\x54\x7e\x36\xc6\xc4\x8d\x2a\x7d\xc7\xb0\x6e\x37\xd5\xf1\x4d\x7c\x62\x11\x35\x6c\xc4\x9a\x5f\xcb\x25\x07\x54\xe3\x5b\x21\x63\x6a\x16\x47\x3e\x7e\xfc\xf8\xb1\x7d\x78\xd8\x3e\x38\x80\x77\xef\xfa\x71\xdc\x57\xae\xfd\x59\xf1\x68\xe3\x06\x93\xc7\x1c\x1b\x5f\x18\x05\x01\x72\x52\x21\x3f\xb4\x66\x5c\xee\xfb\xc2\xce\x21\x11\x41\x7d\x16\xdf\x84\xaf\x43\x11\x34\xa9\xa9\x60\xa8\x4b\xc8\xd2\x6c\xc8\xeb\x6e\xf5\x5a\xd0\xdb\x1a\xfd\xe9\xac\xef\xba\x41\xaf\xc4\xb9\xb9\xd3\x02\xaf\xbb\xdb\x82\xad\x8e\x61\xfd\xc7\x14\xde\x5e\xa7\x05\xde\x4e\xb7\x05\x66\xf4\xce\xfa\xee\xce\x1a\x71\x38\x2f\xea\x5a\x40\x3f\x8a\xa9\x75\x41\xaf\x53\xa3\xda\x68\xd3\x14\xc6\x33\x5a\x11\x03\xc9\xef\x02\xb4\x80\x7f\x9d\x1d\x42\x11\x7c\x1b\xfb\x9c\x4a\x56\x89\x0f\x1b\xc1\xe5\x46\x73\x2e\xfc\x7c\x13\xff\xf3\x86\xca\xb6\xc9\x00\x2a\xa1\x3e\xbe\xfe\x25\xff\xf9\xab\x21\x24\x22\x78\xfd\xcb\xfd\x60\xe0\x23\x63\x0f\x53\xcd\xa5\x51\x8f\x3a\x94\xa8\x42\xc1\x82\xba\x8b\x42\xd9\x9a\x94\x96\x11\x1f\xd6\xfb\xa7\x3c\xb2\xb6\x9b\xa0\xf4\x91\xeb\x05\xcd\x6d\xff\xe8\xf4\x27\x37\xb1\xf9\x1d\xbd\x64\x95\xdd\x1a\x31\xa6\x49\x12\xf1\xe1\xc9\x48\x3b\xde\x34\xb5\x9f\x51\x96\x36\x85\x89\x19\x15\xcf\xd3\xf8\x12\xe5\xbc\x8a\x9f\x9c\x1a\xa9\x1c\xa2\x9e\x21\x72\xe2\x6d\x62\xe1\x91\x89\xdc\xab\x5a\x24\xd7\xab\xbd\x16\xa8\x34\x5e\x95\x54\xe3\x6a\x22\x85\x8f\x4a\x0d\xfc\x24\x1d\x28\xf4\x05\x0f\xd4\x40\x0b\x4d\xd9\x7d\xe1\x28\xe7\xa4\x70\x95\x73\xd2\x82\xcf\xe2\xf2\xf5\x39\x69\x74\xb3\x73\xf2\xf0\xc9\x8b\x2f\xd6\xd6\xe0\xf2\x0e\x56\x13\x11\xac\xad\xd5\xf5\x4f\xae\x72\x05\x8e\x70\x52\x8d\x21\xe2\x4a\x53\xae\x27\x45\x0b\x33\xa3\xbc\xa1\xec\x2d\xf5\xb5\xc5\x7e\x75\xbd\x31\x1c\x22\x0f\x0a\x4b\xb9\xbf\x4f\x44\xf0\xd0\xe0\xd7\x12\xaf\x2c\x26\x23\x7b\x33\x4b\x3e\x8a\xf1\xad\x14\x71\x53\x5e\x35\xb4\xe3\x30\xba\xd2\xcd\x44\x6d\x41\x21\x39\x11\x09\xf4\x8c\x84\xf6\x8f\x4e\x21\x55\x74\x58\x15\x01\xd1\x92\x72\x65\x84\xd4\x2c\xa2\xdc\xa4\x46\xa4\x02\x11\xb5\x9e\x20\xee\xa9\x35\xce\x00\x7c\x7a\xdb\x2f\xc0\x67\xcc\xf7\x02\x7c\x9e\x43\x56\x9a\x96\x62\xe6\xc6\x3d\xfb\x34\x46\xb6\x3c\xe4\xe3\x9b\xe1\x9e\x05\xf6\x51\xa1\x71\xb2\xc5\xec\xed\x37\x29\x85\x04\x93\xd3\xa6\x9b\x1a\xb9\x69\x4e\xe3\x8f\x5a\x5b\x83\x5d\x2c\xc3\xdc\xea\x46\xfc\xec\x31\x50\x7d\xa7\x9d\xf5\x8e\xd7\x24\x01\xdb\xfe\xe8\x7e\x9f\x14\x82\x3a\xe7\x00\x19\x8a\x32\x3f\xc1\x42\xa9\xd1\x2f\xb0\xe6\xb7\x2a\xe4\x70\x40\x13\xea\x87\x38\xb0\xae\x37\xf8\xed\xd6\x0f\x29\x1f\xa2\x7a\x4b\x23\x86\xc1\xa2\xc8\xaa\x05\x46\x10\xaf\xcf\x89\x14\xa9\x46\x0b\xb5\x7a\xf1\xc5\xda\x78\xee\x8d\x19\x17\x71\xf2\x2d\xe8\x6e\xea\x1a\x0a\xd0\x07\x7f\x07\xaf\xd3\x31\xad\x6b\xe7\xfc\x05\x00\x16\x44\x17\x00\xc6\xa8\x0c\xfc\x03\x34\xd1\x4b\x3d\x3b\x14\xe8\x6d\x35\xc0\xc0\xae\xdb\x38\xa9\xfc\xb5\x3d\x11\x1c\x85\x51\x80\x06\x9b\xfc\x71\x83\x52\x8e\xaa\xbf\x75\xbc\x66\xa1\xe2\xd6\x0f\xa8\x91\xd5\xb1\xe0\xc2\x48\xb1\x56\x21\x1f\x21\x45\xbb\xb9\xe5\x41\xc5\x63\x4d\x75\xda\x90\x05\x96\x92\x07\x9f\x10\xea\x5a\x72\x1e\xcc\x80\x5a\xcd\xd2\x60\x91\x24\x09\xaf\xde\x34\x00\x29\x89\x0a\xe5\x4d\xb1\xea\xe6\xb9\x14\xe5\x91\x1e\x59\x65\x33\xc3\x63\xf9\xb6\x31\xd9\xce\x91\x69\x67\x03\x6a\x35\xb2\x35\xa9\x43\x9a\x34\xaf\xca\xb5\xd7\xd1\x8c\x78\x6b\x47\x3c\x3d\x6a\x58\xf2\x78\x48\x1b\x99\xdc\xd5\xd7\xb0\xe1\xa3\x73\x1c\xfc\xf1\xe7\x87\x47\x66\xe9\x34\xcc\xe2\x4a\x6d\x31\x7c\x7a\x38\x8a\xf4\x0a\x36\x40\xa1\xff\x93\x1f\x87\xfe\x62\x80\x0a\xaf\xf6\xbe\x5f\x9d\xee\x5b\x0e\x29\xff\x5f\x87\xe2\xef\x13\x9e\x27\x9f\xa3\x2d\x9e\x7f\xc3\xe8\x94\x28\xfe\x3c\xce\xdb\x73\xd8\xfb\xcb\x79\xfc\xe5\x3c\x0e\xaf\x0e\x9e\xf8\x91\xdc\x7e\x2e\x66\x47\xcd\x25\x72\x78\x09\x96\xcb\xb6\xa1\xfd\x1f\x7d\xb5\x35\xab\x05\xbc\x28\xde\xed\xb2\x24\xc5\x4f\xa8\xfe\xcf\xa8\xf4\xec\x76\x60\xae\xe4\xb1\x32\x41\x12\x0b\xd4\xe2\xd2\x78\x35\x4d\x16\xbe\xa4\x2c\x4a\x55\x0b\x14\xa7\xc2\x09\x15\x09\xf8\x3e\x95\xab\x29\x25\xab\x37\xf3\xb9\x54\x59\x78\x3f\xe6\xf6\xf7\xe9\x49\x37\x97\xdd\xfe\x37\xca\xee\xc7\x56\x58\x9f\x85\x48\xdd\x0a\xea\xf2\x44\xfa\x5d\x2a\xe7\xb0\xf1\x57\xd4\xca\x9f\x85\x26\x0f\x66\xaf\x85\x67\x25\xed\x3d\xc6\xa0\xf2\xa8\x73\x29\xb5\xec\x15\x67\x4e\xb3\x42\x93\x9e\x0c\xef\x66\xa7\x98\x84\x28\x3f\xc4\x98\x66\x6f\xbc\xcd\xde\xb6\x0b\x92\xbe\x63\xd9\x2b\x52\x59\x3c\x77\xad\xbf\x57\x6d\x7a\xab\xda\xf8\x4e\xb5\x58\x8d\xc6\x38\x61\x54\x9b\x43\xd4\x9c\x8f\xa6\x29\x63\x67\x59\xd1\xa9\x8e\x60\x88\x9f\x4a\x89\xbc\x5e\x45\x2e\x15\xb3\x26\x3c\xac\x2d\x17\xb3\x72\x96\x69\x5e\xe9\x3c\xd2\x3e\x92\x22\x46\x1d\x62\xad\x7c\x4c\x02\xbc\x8a\x78\x94\xbd\x3f\x26\xf6\x6d\xfb\xc0\x4e\xa5\x4c\x22\x9e\x9e\x15\x5a\x90\xbb\x4d\xed\x49\x76\x66\xd2\xee\x05\x41\xf6\x12\x79\xaf\xf9\xb1\x06\x64\x2b\x30\x4b\xf9\x30\x1e\xda\x1d\x39\x4e\x99\x8e\x26\xf4\x1e\x3f\x04\xe7\x93\x3a\x17\x57\x06\x2e\x06\x22\xff\x49\x51\xde\x2d\x57\x08\x85\x59\xbb\x37\x04\x12\x87\x78\xdb\x00\x03\x88\xba\x8e\x92\x53\xc9\x8e\xef\xb8\x3f\x61\x8f\xd9\x45\x84\x3b\xa2\xa6\x43\x6b\x7a\xea\xdf\xe3\x7d\xd4\x5e\xbe\x67\xae\xe1\x6e\xdc\xb4\x4f\xee\x94\xb9\xf0\x48\x3a\x0e\x31\x55\x78\x32\x1a\xb4\x76\xd5\x91\xff\xae\x3f\xa4\xd7\xa3\xa7\xe6\x25\xc7\xba\x1a\x5d\xbb\x11\x2e\xbe\xb4\x37\xc3\xb2\xa3\x6a\x91\xb5\x93\xc6\x61\x92\xc8\xbf\xb6\xd7\x35\xa5\xc1\x32\xa1\x0f\xc6\x81\xd1\x85\x9f\xa4\xe7\x44\x31\xaf\xe3\x34\x6c\xba\x0d\x5e\x5c\xfd\xee\x39\xdf\x9e\xdb\xb0\xd9\x71\x39\xc2\xea\x77\xd7\xf9\xf6\x4a\xff\x0d\xe1\xa2\xbc\xff\x28\xc6\x41\xc9\x68\xe7\x5a\x85\x3b\xe9\xb6\x3b\xa9\xbb\x8a\xee\x96\xdb\x50\x2d\x43\x91\x9d\xc0\xdd\x67\x79\xdd\x8d\x2a\xfa\x2a\x38\x56\x0c\xab\x48\x2e\xc7\x99\x4b\x41\x1b\x4a\x25\x54\xf3\xf9\x4e\x94\xce\x30\x24\xb5\x77\x82\xa4\x77\xad\xdb\x1f\xb6\xcf\xfe\xfc\x5a\x50\x6e\xf2\xcc\xd0\xb5\x4d\x0f\x2b\xff\x0b\x00\x00\xff\xff\xed\xf8\x51\xd1\x13\x34\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x7b\x6f\xdb\x38\x12\xff\xdf\x9f\x62\xc0\xdd\x5b\x24\x07\x39\xb1\x9c\x38\xbd\x1a\x28\x0e\x69\xb2\xdd\xf6\x80\x74\x7b\xc8\x63\x51\x34\x81\xc1\x48\x13\x5b\x0d\x45\xea\x48\x2a\x4d\x9a\xcb\x77\x3f\x50\x96\xf5\xa0\x28\x3b\x76\xd2\x5e\xb3\x1b\x38\xbb\x95\xc8\xe1\x6b\xe6\x37\xc3\x1f\x47\xa4\x49\x74\x82\x52\x45\x82\x0f\x21\xe2\x1a\xc7\x12\xa9\x66\x37\x1b\x42\x8e\x37\xaf\x7c\xca\x92\x09\xf5\x3b\x97\x11\x0f\x87\xf0\x9b\xa4\x17\x94\xd3\x7d\xaa\x26\xe7\x82\xca\xb0\x13\xa3\xa6\x21\xd5\x74\xd8\x01\xe0\x34\xc6\x21\xa8\x1b\x1e\xa2\x8a\x54\x77\xda\x15\xd5\x91\xe0\xaa\x3b\x11\x31\x76\xc3\xa2\x19\x00\xa3\xe7\xc8\x94\x69\x06\x40\x93\xa4\x6c\x97\x95\xcc\x5e\x36\x22\xb1\x39\xbf\x56\xdf\x24\x68\xa6\x7d\x21\xa9\xd2\x32\x0d\x74\x2a\xb1\xa3\x12\x0c\x96\x9b\xd1\xc6\x67\x25\x78\x07\xc0\xfc\x33\x84\xff\x66\x93\xb8\xcd\xfe\x0f\x40\x28\xe7\x42\x4f\x17\x42\x86\x45\x31\x00\x61\x91\xd2\x64\x08\x9f\x8a\x92\xb2\x51\x2e\x71\x9e\x46\x4c\xbf\xe3\x64\x08\xbe\x57\xaf\x31\x5a\x53\x22\x95\x01\x92\x21\x90\x6e\x77\xa6\x5b\xe8\x76\x89\x25\x8a\x9c\x9e\x33\x23\xa6\x65\x8a\x56\xdd\x24\x0a\x5b\x6a\xa2\x40\xf0\x3d\xc1\x84\x34\xfd\xcb\xf1\x39\x5d\xeb\x79\xd0\xf7\x7d\x0f\xfa\x83\x81\x07\xfe\xba\x3d\x8c\xd1\x96\x91\xdd\x2d\x97\x0b\xbf\xc0\x2e\x43\xa9\x95\x2d\x6b\xd4\x6e\x64\x0b\x05\x92\x4a\xfd\x5d\xf1\x7c\x96\x3f\xdd\xcd\x9a\x13\x0c\x23\xed\x58\x0d\x19\x73\xd4\xef\x42\x32\x04\x9e\x32\x56\x96\x4a\x9a\x4c\x8e\x84\x60\x3a\x4a\xc8\x10\x7a\x45\x45\x64\x44\xfb\x5b\xe5\xbb\xc6\xa9\x61\x8d\xa6\x07\x3b\x83\xad\x97\xfd\xad\x17\x83\xbe\xff\xb2\x90\x60\x11\xbf\x54\x35\x63\x55\x4d\x95\x69\xcb\x2c\x08\xaf\x35\x4a\x4e\x19\x18\xf9\xda\xaa\x49\xc4\x03\x96\x86\x78\x42\xa5\x72\x68\x9c\x5c\x22\x26\x47\x51\xec\xb2\x06\xd1\x74\x5c\x1f\xdb\xfc\xc8\x0c\x98\xb6\x72\xab\x38\xad\xea\xf5\xcc\xeb\xcc\x33\x41\x45\xf6\xae\x63\xb5\x20\x09\xe5\xc8\xe6\xac\x3f\x10\x2c\x8d\x33\x7c\x7f\xaa\x0f\x73\x21\xb8\x3e\x8c\xbe\x66\x43\xf9\xbd\xde\xdf\xea\x3a\x19\xcb\x28\xfc\x20\xea\x6e\x61\x7e\x64\x42\x86\xb0\x53\x15\x05\x20\x5f\x8c\x71\xfa\x56\xe1\x75\xd5\xae\x79\xd9\x8d\x29\xab\x14\x15\xf0\x29\x6d\xbf\x33\xa8\x95\x15\xd6\xad\x4f\x5e\x24\x85\xd7\xd6\xfb\x48\xe8\x18\xf3\x55\x55\x01\x67\x7e\x44\x05\x52\x30\x46\x86\x70\x41\x99\xaa\xdb\x51\x4d\xc4\x97\xb7\x48\x43\x94\x2e\x2b\x2b\x21\x75\x53\x13\x81\x60\x8e\x25\x86\xa8\x82\xbc\x8f\xd6\x85\x2a\x7d\xc3\xb0\x89\x9b\x7a\xff\x26\x3e\xb1\x88\x1a\x31\x92\xc1\xaf\x3e\x10\x18\x1f\xd5\xf8\x46\xc8\x98\x9a\xc9\x91\x8f\x1f\x3f\x7e\xec\x1e\x1c\x74\xf7\xf7\xe1\xed\xdb\x61\x1c\x0f\x95\x8d\xbf\x4c\x3d\xda\xb8\x41\x7b\x9f\x33\xf0\x4d\xa2\x30\x44\x5e\x05\xa9\xb5\x88\x79\xd3\x7d\x57\xe2\x1c\x12\x11\x36\x47\x09\x4c\xf8\x3a\x10\xa1\xcb\x4c\xa5\x40\x53\x43\xe6\x37\x0d\x79\xfd\xed\x81\x07\x83\xed\xe9\x7f\xbd\x8d\x97\x76\xd0\xab\x48\x6e\xbd\xf0\xc0\xef\xbf\xf4\x60\xbb\x67\x44\xff\x31\x47\x76\xd0\xf3\xc0\x7f\xd1\xf7\xc0\xf4\xde\xdb\x78\xf9\x62\xbd\xae\x01\xcb\x55\x73\x83\x07\x51\x4c\x33\x17\xf4\x2d\x30\xe4\xf8\x75\x40\xaa\xa8\x2b\x63\x20\xf9\x4d\x80\x16\xf0\xaf\x93\x03\x28\x83\xaf\xb3\xcd\xb1\x64\xb5\xf8\xb0\x19\x9e\x6f\xba\xf7\xc2\xcf\x57\xf1\x3f\xaf\xa8\xec\x9a\x1d\x40\x25\x34\xc0\x57\x3f\x17\x8f\xbf\x98\x8a\x44\x84\xaf\x7e\xbe\x1d\x8d\x02\x64\xec\x6e\x2e\x5c\x9c\x76\xd4\x13\x89\x6a\x22\x58\xd8\x74\xd1\x1a\x9a\x94\x96\x11\x1f\x37\xdb\xa7\x3c\xca\xb0\x9b\xa0\x0c\x90\xeb\x15\xe1\xb6\xf7\xe1\xf8\x4f\x0e\xb1\xe5\x1d\xbd\x82\x4a\x2b\x30\x03\x90\x98\x26\x49\xc4\xc7\x47\x53\xeb\xf8\xf3\xcc\x7e\x42\x59\x8a\xab\x1b\x9e\xa7\xf1\x39\xca\x65\x0d\xdf\x69\x51\x06\xd1\x54\x8e\x51\x37\x8d\xd6\xc0\x06\x5e\x27\x26\x92\x67\x91\x7b\x4d\x8b\xe4\x72\x6d\xe0\x81\x4a\xe3\x35\x49\x35\xae\x25\x52\x04\xa8\xd4\x28\x48\xd2\x91\xc2\x40\xf0\x50\x8d\xb4\xd0\x94\xdd\x96\x8e\x72\x4a\x4a\x57\x39\x25\x1e\x7c\x16\xe7\xaf\x4e\x89\xd3\xcd\x4e\xc9\xdd\x27\x3f\x3e\x5b\x5f\x87\xf3\x1b\x58\x4b\x44\xb8\xbe\xde\xb4\x3f\xb9\x28\x0c\x38\xe5\x49\x0d\x81\x88\x2b\x4d\xb9\x6e\x8b\x16\x66\x44\x79\x45\xd9\x1b\x1a\x68\x21\x9d\x76\x63\x38\x46\x1e\x96\x48\xb9\xbd\x4d\x44\x78\xe7\xf0\x6b\x89\x17\x19\x27\x23\xbb\xf7\xd6\x7c\x14\xe3\x1b\x29\x62\x87\x37\x11\x1d\xc5\x78\x38\x89\x2e\xb4\xbb\x52\x67\xa4\x90\x1c\x89\x04\x06\x46\x43\x7b\x1f\x8e\x21\x55\x74\x5c\x57\x01\xd1\x92\x72\x65\x94\xe4\x56\x51\x01\xa9\x69\x55\xc7\x11\x25\xaa\x28\xf8\xff\xf2\x1e\xbf\xbf\x3c\xf1\x19\xd4\x7a\x7f\x26\x3e\xcf\xc4\xe7\xe9\x10\x9f\xbe\x93\xa7\x38\x10\xd5\xce\x7b\xf6\x68\x8c\xec\xf1\x98\x4f\x60\xba\x7b\x12\xdc\x47\x4d\xcc\xe9\x62\x35\xbc\xfd\x2a\xa5\x90\x60\xf6\xb4\xf9\x50\x23\x57\xee\x6d\x7c\x21\xda\x1c\xb8\xf0\x5a\x44\x97\x81\x5b\x13\xc4\x8b\xd1\xf6\x83\x73\x20\xab\x16\x80\xf4\x36\x7a\x3e\xf1\x5a\xca\x17\xae\xf7\x87\x62\x50\xa7\x1c\x20\x67\x51\xe6\x11\x32\x2a\x35\x7d\x82\x0c\x7e\x6b\x42\x8e\x47\x34\xa1\xc1\x04\x47\x99\xeb\x8d\x7e\xbd\x0e\x26\x94\x8f\x51\xbd\xa1\x11\xc3\x70\x55\x66\xe5\x81\x51\xc4\xab\x53\x22\x45\xaa\x31\xa3\x5a\x83\xf8\x6c\x7d\x36\xf6\xe6\x3d\x27\x71\xf4\x10\x76\x37\x77\x0e\x25\xe9\x83\xbf\x83\xdf\xeb\x99\x09\xad\x9f\xf2\x67\x02\xd8\x4e\x00\x63\x54\x86\xfe\x01\x9a\xe8\xa5\x9e\x1c\x0b\xf4\xb7\x1d\x34\xb0\xbf\x7d\xcf\xf4\xd7\x4e\xa7\x25\xce\x1b\xe6\x81\x26\x29\xf3\xfb\x15\x4a\x39\xcd\xfe\x36\xf9\x5a\x96\x1f\xdd\xfe\x0e\x39\x32\x1b\x82\x0f\x60\x8a\xbe\x9b\x29\x66\x8b\x6b\xd5\xc6\xd2\x54\xf1\x50\x53\x9d\xaa\x6f\xb4\x0f\xfe\x40\xac\xeb\x91\xf7\xc1\x9c\xa8\x35\x90\xb6\xd2\x26\x09\x3f\xbd\x6e\x0e\x9f\x48\x54\x28\xaf\xca\x59\xbb\xc7\x52\x94\x47\x7a\xea\x91\x6e\x81\x45\xfb\xad\x73\xb3\x5d\x62\xa7\xbd\x1f\x51\x6b\x54\x67\x90\x3a\xa0\x89\x7b\x56\x36\x5e\xcd\x8f\x68\xbc\x36\x7a\x20\xc7\x1f\x1a\xdd\x95\x5d\x92\xa1\x63\xf6\x96\x97\xdc\x63\x8c\xfd\xdf\xff\x78\xbf\x60\x94\x9e\x63\x14\xab\xe4\xac\x53\xab\xf5\x3a\xf3\xc6\x2f\x7d\xf2\x60\x1a\xe9\x15\x6c\x82\xc2\x60\xbe\x6f\xda\xa1\xe8\xaf\xee\x98\xcb\xfb\xde\x2e\x59\xe0\x33\xab\xb3\xcc\x87\x1c\x52\xfe\x5a\x87\xe2\x6f\x13\x9e\xed\xfd\xb6\xa8\x3b\xca\xf8\xfc\x6b\x46\xe7\x44\xf1\xa7\x71\xde\x5e\x02\xef\xcf\xe7\xf1\xe7\xf3\x38\xfc\xb4\xbf\x08\x03\x2d\x47\x6f\xaf\xa5\x7c\xe1\x92\x97\x3c\x92\x67\xaf\xab\xe1\xc8\x9d\x22\x7f\x0e\x96\x0b\x82\xe5\xf2\x18\xda\x5b\x84\xa1\x07\xa3\x60\x45\x04\x3c\x1b\xfe\x1b\x19\xbe\x25\xfb\x7f\x4f\xa3\xe7\x5f\x07\x5a\x8d\xee\xda\x3c\x3a\x2d\x9a\x58\x21\x17\x97\xc6\x6b\x69\xb2\x6a\x1a\xeb\xae\x4c\x55\xad\x90\x9c\xca\xef\xa3\xb9\x19\xc6\xe3\x67\xae\xe6\xa4\xac\x5e\x5b\xfa\xf5\xee\xaf\xbc\xef\xf3\xf5\xf7\xc7\xd3\x6e\xa1\xbb\xbd\x07\xea\xee\xfb\x66\x58\x9f\x84\x4a\xed\x0c\xea\xe3\xa9\xf4\x9b\x64\xce\x61\x13\x9e\x2d\xe9\xb6\xe4\xbe\x65\xc9\xf6\xc0\x3d\x4b\x69\xef\x32\x06\xb5\x4b\x9d\x8f\x92\xcb\xee\x58\x63\x9a\x6c\xbd\xd9\x9e\x4c\x37\x5b\xbd\x72\x10\xa2\x82\x09\xc6\x34\xbf\xe3\x6d\x12\x74\xc5\x15\x82\x69\xfa\xd4\x34\x08\xa9\x2c\xaf\xbb\x36\xef\xab\x16\x76\x2c\x64\x72\xa5\x15\x4b\x6a\xcc\x46\x63\x9c\x30\xaa\xcd\x21\x6a\xc9\x4b\xd3\x94\xb1\x93\x3c\xe9\xd4\x64\x30\x24\x48\xa5\x44\xde\xcc\x22\x57\x92\x59\x8e\xc9\xda\xc9\xac\x42\x64\x9e\x57\x5a\x97\xb4\x3f\x48\x11\xa3\x9e\x60\x23\x7d\x4c\x42\xbc\x88\x4c\x5a\x72\x7a\x87\x38\xbb\xdb\x3e\xca\xce\x6e\xca\x6c\xc4\x73\x3d\xe1\xce\x83\xc2\x6d\x6c\xd4\xcf\x20\x6d\x7f\x20\xc8\x6f\x22\xef\xba\x2f\x6b\x40\x3e\x03\x33\x95\xf7\xb3\xae\xed\x9e\xe3\x94\xe9\xa8\xa5\xf5\xec\x22\x38\x6f\x6b\x5c\x7e\x32\xb0\x39\x10\xf9\x4f\x8a\xf2\xe6\x71\x95\x50\xc2\xda\x72\x4a\x22\x71\x8c\xd7\x0e\x1a\x40\xd4\x65\x94\x1c\x4b\x76\x78\xc3\x8b\x2f\x08\xb6\x84\x21\x60\xcd\x1e\x35\x1d\x67\xd0\x53\xff\x9e\xad\xc3\xee\x7a\xe6\x1a\xf6\xc2\x4d\x79\x7b\xa3\xdc\x85\xa7\xda\xb1\x2a\x53\x85\x47\xd3\x4e\x1b\x9f\x3a\x3a\x76\x62\xb5\x00\x68\xf6\x31\xad\xee\x58\x17\xd3\xcf\x6e\x84\x8b\x2f\xdd\xad\x49\x65\x14\xa2\x45\x5e\x4e\x9c\xdd\x24\x51\x70\x89\xb2\xde\x59\xae\xf4\xd1\x2c\x30\xda\xf4\x93\x0c\xea\x4e\x40\xfc\x4a\xc4\x31\x7f\xb5\x10\x64\xfe\x88\x1f\xd7\xdf\x07\xd6\xbb\x6f\x17\x6c\xf5\x6c\x89\xea\xaa\x00\x48\xdf\x7a\xf7\xc3\x32\x3c\x56\xec\x93\xe9\x6a\x54\x01\xed\x52\xb3\xb0\x07\xdd\xb1\x07\xb5\x67\xd1\xdf\xb6\x0b\xea\x69\x28\xf2\xc2\x7a\xdf\xea\x55\xe7\xed\x34\xd1\x57\xc1\xb1\x06\xac\x72\x73\x39\xcc\x5d\x0a\xba\x50\x49\xa1\x2a\xe8\xc2\x5b\x51\x39\xc3\x90\x34\xfb\x26\x48\x06\x97\xba\xfb\x7e\xe7\xe4\x8f\xaf\x65\xcd\x55\xb1\x33\xf4\x3b\x00\x00\x77\x9d\xff\x0d\x00\xed\xf8\x51\xd1\x13\x34\x00\x00"),
 		},
 		"/addons/ops/addon-ops-integrations-jvm-dashboard.yml": &vfsgen۰CompressedFileInfo{
 			name:             "addon-ops-integrations-jvm-dashboard.yml",
 			modTime:          time.Time{},
 			uncompressedSize: 73951,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x9d\x6b\x6f\xdb\x3a\x9a\xc7\xdf\xf7\x53\x10\xc2\xee\xa2\x1d\xc4\xad\xe5\x4b\x6e\x40\x5f\xf4\xa4\xdb\x99\x33\x68\xce\x76\x26\x3d\xdd\x17\x6d\x61\x30\x12\x2d\xb3\x91\x44\x2d\x49\xb9\xce\x74\xf3\xdd\x07\xa4\x2c\x59\x17\xca\x92\x1d\xc7\x97\xf8\x31\x30\x73\x1a\x51\xa4\xc4\x87\x7c\x1e\xfe\xfe\x14\x29\xe1\x88\x7e\x21\x5c\x50\x16\x5e\x22\x1a\x4a\xe2\x71\x82\xa5\x7f\xff\x9a\x71\xef\xcd\xd4\xc6\x7e\x34\xc1\xf6\x8b\x3b\x1a\xba\x97\xe8\xaf\x1c\x8f\x71\x88\xdf\x63\x31\xb9\x65\x98\xbb\x2f\x02\x22\xb1\x8b\x25\xbe\x7c\x81\x50\x88\x03\x72\x89\xc4\x7d\xe8\x12\x41\x45\x27\x29\x0a\x4b\xca\x42\xd1\xf9\x31\x0d\x3a\x6e\x96\x0b\x21\x1f\xdf\x12\x5f\xa8\x5c\x08\xe1\x28\x5a\x64\xd3\x47\xd2\x3f\x5e\x53\xf6\x66\x79\xaa\xbc\x8f\x88\xba\xeb\x31\xc7\x42\xf2\xd8\x91\x31\x27\x2f\x44\x44\x9c\x95\x6e\xe8\xf5\x0f\xc1\xc2\x17\x08\xa9\xff\x5c\xa2\xff\xd7\x57\xf9\xa5\xff\x1f\x21\x0b\x87\x21\x93\x49\x2e\xeb\x32\x3b\x8c\x90\xe5\x53\x21\xad\x4b\xf4\x35\x3b\x82\x72\xa9\xfa\x8c\xdb\x98\xfa\xf2\xf7\xd0\xba\x44\xf6\x49\x31\x45\xd9\x4c\xb0\x98\x3b\xc4\xba\x44\x56\xa7\x93\x5a\x16\x75\x3a\x56\xe9\x54\x12\xe2\x5b\x5f\x9d\x26\x79\x4c\x4a\x69\x13\xea\xd6\xa4\x50\x87\x85\x57\xcc\x67\x5c\x95\xcf\xbd\x5b\xfc\xb2\x7b\x82\x7a\xb6\x7d\x82\x7a\xc3\xe1\x09\xb2\x5f\x95\x2f\xe3\xd3\x80\xaa\xea\xd8\xdd\x6e\x29\x45\x99\x51\x95\xf2\x6e\x61\x08\xf4\x5f\xe8\x9d\x4f\xb8\x14\xe5\x52\xc4\x84\xfd\xd4\x15\x2e\x17\xa2\x1a\x4a\x15\x92\xd9\xdc\xca\xa5\x3f\x64\xff\xfe\x3e\xff\xd7\x43\x9a\xdd\x72\x89\x70\x38\x8d\xd4\x65\x55\xfe\xec\x82\x16\x71\xa9\x34\x58\xc6\xf2\x42\x22\x7f\x77\xad\x4b\x34\x38\xeb\xda\x8b\xa3\x1c\x47\x93\xcf\x8c\xf9\x92\x46\xf9\xf6\xb0\xa8\x3a\xb5\x37\x5c\xfc\x2d\x49\xd2\x47\xd4\x59\xc3\xd3\xde\x69\x77\x70\x6a\x9f\x0d\x4f\x2f\xb2\x33\x7c\x1a\xde\x89\x42\xc3\xe7\x9b\x5d\x5b\x5e\xdd\x29\x99\x49\xc2\x43\xec\x23\x75\x7e\xc1\x4e\x16\x0d\x1d\x3f\x76\xc9\x17\xcc\x85\xa1\xf5\xac\x3b\x42\xa2\xcf\x34\x30\xb5\xac\x25\xb1\x27\x4a\x9d\x4e\x99\x7d\xde\xc7\xcb\xcd\x91\xef\xf2\x79\x83\x7f\x2f\x96\x59\x6e\x9b\xdc\xb9\x69\xd3\x64\x39\xac\x08\x87\xc4\x5f\x52\x7f\x87\xf9\x3e\x8e\x04\x51\x76\x1d\x63\x5f\x14\xef\xdf\xe3\xd4\xfd\xc4\x8a\x9e\xa4\x13\x26\x06\x2f\xf9\xa9\x9a\x66\x50\x3a\x38\x33\xf4\xae\x7b\x75\x2c\xdf\xa1\x8a\xe6\x56\xb7\x62\x2f\xda\xb8\x54\x8d\xa2\x31\x38\x89\x08\x56\x8e\x10\xc6\xbe\x5f\x34\x13\x95\xba\xb7\x59\xff\x88\xa9\x73\x87\x3e\x60\xa7\xd4\xff\x33\x43\x72\xf6\x33\x67\xc1\x93\x1a\x3b\x61\x67\x42\x54\x33\xb3\xd8\x78\x39\x47\x79\xef\x6f\xd8\xb9\xf3\x38\x8b\x43\xb3\x35\xf5\x39\x5f\xb0\x1f\x1b\xfb\x8a\x4e\x35\xf4\x16\x1d\x0e\x7a\x83\xe1\x09\x1a\x0e\x92\xff\x75\x5f\x5f\x54\x02\x42\x72\x56\xff\xec\x04\xd9\xbd\x8b\x13\x34\xe8\xaa\xd3\xce\x6b\xce\x1b\x76\x4f\x90\x7d\xd6\x3b\x41\xaa\xd4\xee\xeb\x8b\xb3\x57\xf5\xdd\xad\x18\xfb\x3e\x71\x16\x10\x39\x21\x71\xc9\x96\x2e\x71\x68\x80\x75\x0b\x15\xba\x45\x9d\xdf\x27\x69\x9c\xeb\x88\x57\xb5\xd4\x98\xf1\x40\x37\xab\x55\xba\x8e\x87\x63\x8f\x54\xbb\x63\x80\x67\xa9\x5d\xab\x11\x31\xa0\x61\x9a\x58\x4e\x52\x01\xd0\x74\x03\xaa\x77\x4c\x38\x11\x13\xe6\xbb\x1f\xf5\xe0\xd7\x74\xd6\x35\xe6\x77\x24\x0b\x10\xb5\x9d\x7b\xa9\x43\xf5\x0d\x0e\x75\xda\xd2\x9f\xec\xda\x4b\x4e\x08\xf5\x26\xb2\x10\x8d\x51\xe6\x67\xa7\xfd\x52\xa8\x93\x84\x4f\xb1\x6f\xea\xe1\x59\x20\x2d\xf6\x8f\x00\x47\x11\x0d\xbd\xcf\x89\x33\xd9\x75\x69\xd5\x8e\x5d\x34\x40\x6e\xe4\x9a\xaa\xc6\x42\x92\x21\x49\x66\xb2\xd4\x7f\xd1\x3c\xb9\x54\xe3\x52\x9d\x97\x14\xce\x71\xe8\xb5\x28\xbc\x57\x2c\xbc\xd6\x3b\x02\x3c\x7b\x8f\x25\xfe\xc4\x68\x28\x45\xb5\xf7\x59\xca\x8a\x3a\xf1\x9a\xe9\xb1\xdf\x72\x58\x18\x12\x47\x12\xd7\xaa\x9c\xf7\x59\xdd\x91\xc1\xf0\x2c\xca\x70\xa6\xd8\xb2\x11\x13\x72\x4c\x67\xd5\xa6\x9d\x27\x7c\x60\xa1\xbc\xa1\xff\xd2\xd7\x1d\x76\xff\xb3\x74\x0e\x27\xe6\xbc\xfa\x78\x3e\xeb\x59\x39\xab\xb6\xe1\x35\x8e\x5a\xb4\xe9\x98\xb3\x40\x95\xa1\x2a\x55\xb5\xb6\x4c\x6a\x6c\xfd\xf1\xe6\x9d\x21\x91\x65\x19\x5b\x36\x86\x88\x30\xbf\xf3\x69\x68\x08\x10\x63\xea\xfb\x45\xc0\xea\xdb\x27\xc8\xb6\xcf\x4f\x90\x7d\x7e\xa1\x82\xa0\x7d\x5e\x89\x95\x63\x75\x6d\xb3\xdf\xab\xab\xe4\xcb\x4b\x8a\xeb\xa9\xb8\x7a\xd1\xaf\x14\x94\x0f\x33\xb5\x7e\xaa\xc3\xe4\x15\xf3\xe3\x20\xac\xb6\x8a\xc4\xdc\x23\xb2\x85\xc1\xc9\x2c\xd2\xf7\x24\x69\x40\x5e\xbe\x42\x1d\x14\x71\xe6\x10\x21\x46\x42\x62\x2e\x47\xea\xf0\x48\x10\x87\x85\xae\xf8\xa5\x7c\x42\x44\xd8\x21\x6f\xbf\x59\xff\x91\xfd\xf1\xcd\x3a\x41\x11\x73\xd5\xb1\x88\xb9\xdf\xac\x87\x6a\xd3\x2c\xc2\xf3\xbc\x3c\x4e\x49\x99\x66\x74\x34\x11\x12\x87\xd2\x88\xbc\xb9\x60\xa3\x86\x66\x6d\xc8\x5e\xe5\x14\x9f\x78\x24\x74\x3f\x64\x97\xab\x5e\x23\x20\x92\x53\xc7\x9c\xc6\xc9\x58\xa3\xa5\x65\xe8\x5d\x42\x12\x4d\x96\x83\x41\xb7\xdb\xb2\x7b\x65\xb1\x5e\x18\xda\x27\xa5\x8d\x3f\x23\x65\x12\x33\x68\x08\x1a\x7a\x3e\x11\x12\x17\x03\x4f\x12\x74\xf2\x1e\x77\x5e\xf6\x38\x7d\x46\x3b\x8f\x63\xaa\x5a\xd6\xdb\x15\x9d\x2d\x8d\x7b\x2b\xf9\x9b\xce\xf4\xc7\x3c\xb0\x3a\x31\xe7\x24\x94\x40\x52\x9b\x23\xa9\x8a\x69\x1e\x0b\x53\x2e\x96\xda\xf2\xef\xc4\xef\x82\x01\x58\x15\x8e\xcd\x0c\xc7\x1e\x01\x56\x17\x3d\x00\x2b\x53\xe1\x00\x56\x00\x56\x07\x0d\x56\x8f\xe7\x29\xf4\x17\xd5\x9b\xbb\x80\x55\xf3\x16\x68\x83\x55\x37\xca\xda\x68\x03\x68\x55\xf1\x39\x40\xab\x8d\xa1\x95\x01\x86\x1e\xc3\x56\x55\x52\xdb\x1c\x5a\xf5\x36\xca\x55\x11\xe1\x8e\x6a\x20\x20\xaa\xfc\xb1\x99\x9e\xc7\x5d\x09\xa9\x92\x39\xa9\x21\xa0\x93\xa9\x70\x40\x27\x40\xa7\x83\x46\x27\x11\x07\x2f\x7f\x4c\x83\x51\x40\x02\xc6\xef\x47\xb7\xf7\x92\x88\x51\x2c\x88\xdb\x92\x9c\x4e\x10\xe6\x04\xbf\xfd\x66\x4d\x08\x8e\xbe\x59\x0f\xaf\xfe\x62\x77\xbb\x6f\x8c\xa5\x06\x78\xb6\x6e\xa1\x8f\xe0\xb2\x0d\x80\xd7\x93\xc2\xd5\x59\xf7\xe4\xa2\x5b\x43\x58\x7f\x23\x38\x42\xaa\x31\x60\xee\x0a\x00\x0b\x00\xab\x90\xb4\xcf\x80\x75\xbe\x3a\x60\x9d\x6d\x18\xb0\x7a\x00\x58\x00\x58\xb9\x53\x9f\x02\xb0\x1a\x5a\x3a\xbd\x64\xe7\xa2\xe1\xb7\xd6\xfd\x74\x81\xf6\x76\x48\x7b\x21\x0b\x9f\x04\xf8\x72\xe5\x6e\x60\x2e\xce\xd0\x46\xc5\xd8\x6a\x80\xbd\xc3\x26\xc6\x3f\x58\xd8\x79\x9e\xd4\xd8\x10\x6c\x1a\x2f\x38\x5b\x72\xb9\x27\x06\xd4\x1d\x2d\xe7\x1b\x2c\x27\x0e\xbb\x77\x56\x1c\x6f\xd6\x5f\xce\xf7\xf7\x2f\xd7\xe8\x5a\x7b\xfe\x23\x56\xf3\x61\x9f\x62\x71\x95\xa2\x76\x69\x84\xbd\x4d\x16\x7a\x56\xcd\xe7\x62\x31\xf9\x48\x42\x4f\x6a\x73\x75\x2b\x69\xa4\x2e\x5b\x1b\x76\x5e\x13\x91\xa9\x1e\x20\xec\x4a\x33\x57\xdb\xd8\x27\x63\xf9\x91\x79\xbf\x61\x51\x99\x14\x9b\xa7\x5e\xe3\x99\xc1\xf4\x69\x22\x0d\xcd\x89\x9c\x7a\x93\x25\x05\xeb\xe4\xda\x92\x93\xd4\xac\xe8\xf5\x58\xf9\xcc\xd0\x73\x2b\x13\x8f\x75\x3d\x77\xb8\xbc\xe7\x16\x3d\x60\x1e\x8e\xab\xb7\x81\x7d\xea\x85\xef\xc4\xe7\x79\x0b\x9a\x06\x6c\x3c\xf5\x6a\x52\x52\xef\x36\x2e\x0d\x0f\xb4\xe9\x4c\x09\xda\x6a\xa6\xf2\xe6\x83\xbd\x21\x8f\x64\x12\xd7\x01\x85\x0e\x38\x8d\xa2\x45\x61\x87\x71\x19\xb4\x4a\xf8\x49\x5d\x59\x09\x25\x75\x1a\xa2\x42\xc9\x15\x5c\x5c\x02\xc1\x89\x1e\xc4\x9e\xd1\xd8\x56\xa4\x8a\xe5\xd8\xa5\xb1\xca\x3b\xac\xa6\x99\x1d\x95\x93\xd0\x25\x9c\x68\x7a\x19\xfb\xac\x34\x66\x25\x08\xf0\x3f\x53\xc2\x39\x75\x89\xa1\x42\x9a\x36\xea\xe2\x83\x90\xd8\xb9\x33\x5e\x55\x8d\xd1\x11\x71\x3f\x26\xd4\x58\x4d\xdf\xfd\x34\xdb\x36\x67\xc4\x2a\x2c\xa1\xcf\x7a\xe4\xe3\xc8\x5e\x05\x7f\x1a\x46\xf8\xa5\xa6\x74\x58\x10\x50\x29\x0f\xc3\x9e\xd9\xcd\x2e\x31\xdc\x6f\x5b\x32\xdc\x81\x4c\xca\xaa\x88\x5b\x6f\xac\xab\x15\x8c\xd5\x0e\xb2\x4b\x61\x44\x55\xe7\x43\x22\x67\x0d\x14\x14\x90\x7f\x12\x6f\x1e\x14\x0d\x19\x6f\x26\x74\xdc\xcc\x4f\x8a\xda\x4b\xf4\x94\xed\xb5\x29\xcf\xa6\x89\x7f\x12\xc1\xfc\x78\xbe\xc7\xc6\x3c\xdc\x60\xae\x81\xd3\x30\xe0\x08\xc6\xa5\x61\xe0\xd5\x63\xcd\x28\x45\x36\x27\x0e\x62\x1f\x4b\x3a\x25\x56\xed\xb8\x93\x9e\xab\x77\x06\x15\xef\x7d\xd6\xc1\x33\x6a\x1c\x93\x66\xf3\x84\xca\x46\x2f\xe7\x2e\x89\xa8\x06\x22\x09\xe6\xa3\x51\x65\xad\xc1\x62\x3e\xc9\x90\xab\x7e\xc8\xcd\x86\xd5\xaf\xdf\x6b\x2b\x77\x5f\x5b\x83\xfb\x51\xd2\xcf\x0d\x33\xc2\x81\x76\x2a\x83\xd0\xe7\xb2\x7e\x1e\xf7\x1e\xcf\xda\xcc\xca\x2d\x9c\xcb\x74\x11\xe5\x33\xf8\x96\x98\x26\x0c\x93\xc4\x3a\x18\xcc\x70\xc6\x98\x2d\x01\x9a\x6e\xd5\xbd\x16\xb6\x5d\x29\x16\xe5\x36\x91\x68\xa3\x6c\xb1\x12\xc6\xa4\xda\x7a\x2c\x6b\x2d\x53\xff\xd5\xb0\x59\x47\x99\x2a\xed\x23\x99\x66\x15\x7b\x61\xba\x10\xe8\xa2\xc5\x51\xd0\x45\xe6\x55\x1a\x4d\xc2\x68\xd8\x4e\x18\x81\xea\x01\xd5\x73\x50\xaa\x67\x33\xd3\xc2\x1b\x9e\xf6\x7d\xce\xb2\x68\x93\x06\x3f\x1e\x65\xb4\x23\xab\x3d\x7f\x71\x94\x3e\xd6\x00\x81\x04\x02\x29\xfd\x81\x40\x02\x81\xa4\x73\xef\xea\xf9\x9a\xdd\x5b\x4e\xe3\x76\xef\xbc\xc8\x7b\x8f\x7c\xc0\x46\x85\x03\x8f\xd7\x40\x46\xd6\xca\xc8\xf2\xaa\xb3\xda\x7e\xdb\x6f\xe8\xb7\xdd\x53\x90\x91\x20\x23\xf7\x47\x46\x72\x2c\xc9\xcb\x74\x8f\x9f\x13\xc5\xe9\xe6\xbe\x91\x6e\xfd\xb6\x5b\xfc\xbe\xda\xc1\xf7\xf5\xb1\x7c\xfe\x9e\xb0\xe5\x2b\x8a\x32\x66\xaf\x8e\xbe\x65\x66\x9f\x57\x67\x0b\xfa\x71\x1f\x99\xfe\xea\xd3\x9f\x80\xf2\x07\x8e\xf2\x26\x3e\xdd\x10\xc9\xd7\xbd\xb9\x0a\x21\xd3\x0a\xf4\x38\xa4\x4b\x38\xd9\xe0\x46\xcd\x94\x6c\xd9\x06\xc7\x04\xd2\xdf\x35\xe9\x03\xc3\x1e\x23\xc3\x1a\x77\x53\x34\x30\x6c\xbf\x07\x08\x0b\x08\xbb\x3f\x08\xfb\x63\x1a\x8c\x14\x6f\x61\x57\x8c\xe6\x5d\x6b\x0b\xaf\xfa\x5a\x79\x26\xd9\x57\x0c\xf4\xf4\x48\xda\x30\x32\x9a\x8c\xe6\x62\x12\xb0\x70\x1f\x6d\x96\xdc\xd9\x9a\x56\x7b\x82\x87\x19\x79\xab\x45\x04\xdf\xed\xa3\xcd\xd4\x7d\x3d\xe3\x47\x16\x9f\x13\xf3\x83\xc4\x01\x89\x33\xff\x2d\x93\x38\x55\x49\xb1\x43\x69\x00\xfa\x66\x91\x03\xf4\x0d\xe8\x9b\x2d\xe8\x1b\xdb\xf8\x8a\xc3\x06\x81\x73\x6a\x83\xc0\x01\x81\xb3\x3f\x02\x27\x9d\x9e\x67\x11\x09\x47\xe3\x2d\xbc\xc8\x78\xb5\x09\xf9\x66\x24\x55\x37\xbe\x3f\xd2\x27\x35\x67\x80\x67\x07\x69\x4d\xe3\x92\xa4\x8d\x2b\xa2\x7d\x64\xff\x0f\xd4\x27\xe8\xfd\xfc\x93\x2b\x6a\x20\x07\x11\x00\x22\x20\xf9\xed\x48\x04\x54\x8b\x06\x15\xb0\xcf\x2a\x60\x57\xeb\x99\x7a\x4d\xdf\xff\xe9\xb7\xfb\xfe\x8f\xe1\xb5\x00\xe8\x13\x63\xbe\x40\x2f\xff\x46\x70\xf4\x0a\x16\x31\x81\x40\x7a\xf4\x22\xa6\x5e\xc3\xfb\xb4\xec\xfe\x59\x3b\x81\xb4\x4f\x2f\x09\xd0\x56\xbd\xa9\x45\x31\xd0\x58\xc7\xad\xb1\x72\x5b\x14\x22\xc6\xfc\x75\xf6\xd3\xa8\x7c\x6f\xbf\x59\x9f\x6e\xd0\x7f\xbb\x24\x44\x37\xc9\x59\x5b\x9d\xfb\xdf\xaf\x6d\x33\x66\x9b\xae\xbe\x6f\x66\x0f\x0c\xbb\xa3\xcd\x33\x66\x0b\xae\xb2\x7b\x66\x0f\x6c\xf7\xbc\xb7\xd0\x14\x2c\x0b\x82\xf4\xc0\x05\x29\xec\xa1\x01\xcd\x09\x4f\x9e\x40\x58\x3d\xcd\xca\xba\x46\x61\x35\x68\xb9\xb4\x0e\x84\xd5\xdc\x40\x20\xac\x40\x58\x2d\xae\x09\xc2\x0a\x84\x15\x08\x2b\x10\x56\x20\xac\x40\x58\xe5\x4f\x00\x61\x05\xc2\x2a\x4b\x00\x61\x75\xd8\xc2\xca\xb8\xa4\xaf\xf9\x91\xd5\x05\x28\xab\x24\x0d\x94\x15\x28\xab\x2a\xc4\xde\xc4\x7c\x4a\xa7\x8c\x83\xba\x7a\x02\x75\xb5\x53\xe3\x3e\x03\x85\xb5\x53\xfb\x3d\x7b\x95\x55\xb4\x2e\x28\x2d\x50\x5a\xe9\x0f\x94\x16\x28\x2d\x9d\x7b\x67\xcb\x26\xcf\x1b\xb0\x7e\x50\x44\xd0\x35\x96\x4d\xa6\xaf\xbf\x84\xa5\x93\x20\x44\x37\xb0\x74\xf2\xa2\xa1\xc3\xf6\xcf\x41\x87\x26\x69\xa0\x43\x41\x87\x96\x50\xff\x9a\x48\x2c\x40\x7f\x6e\x56\x7f\xee\xca\xa8\x87\xae\x3b\x77\x65\xb7\xe7\xad\x37\x33\xab\x82\xce\x04\x9d\x99\xfe\x40\x67\x82\xce\x44\x20\xa4\x8e\x55\x48\x19\x97\x4a\x36\x09\xa9\x41\x1f\x84\x54\x92\x06\x42\x0a\x84\x54\x89\x5d\xaf\x58\x10\x71\x22\x04\x71\xd1\x95\x8f\x85\x80\xa7\x7a\x9b\x56\x55\x7b\x61\xe1\x43\x97\x58\x7b\x61\xc4\xe7\xad\xb7\xcc\x26\x06\xf1\x05\xe2\x2b\xfd\x81\xf8\x02\xf1\x85\x40\x7c\x1d\xab\xf8\x32\x2f\xa7\x6c\x54\x5f\x03\x50\x5f\x49\x1a\xa8\x2f\x50\x5f\x15\xac\x75\x09\xba\xc2\xce\x04\x14\xd7\x46\x15\xd7\x8e\xac\x7a\xf8\x2a\x6b\x47\x86\x7b\xee\xca\x2a\x35\x2b\xa8\x29\x50\x53\xe9\x0f\xd4\x14\xa8\x29\x9d\x7b\x57\x4b\x26\xfb\xa7\x0d\xe8\xde\x6f\xb7\x64\xb2\xc5\x97\x73\xff\x8a\xf9\x2d\xf6\x08\xba\x62\xbe\x4f\x1c\x1d\xd6\x8c\x81\xe6\x50\xd7\x50\xd6\xea\xc8\x95\x44\x97\xdd\x6b\xdb\x72\x67\xcb\x5b\xee\xa2\xe5\xd2\xc1\x16\x82\xca\x94\x9a\x78\xa2\x31\xe5\xa9\x5e\x4c\xaf\x13\x41\x33\x1d\xae\x66\xd2\x5f\x8f\x55\xa0\xea\x39\x23\x27\x8b\x02\xd9\x37\x64\x1d\x16\xb7\xff\x16\xd7\x36\xbf\x21\xdb\xcc\xae\xbf\x7e\x79\xce\x83\x81\x96\x73\x82\xe9\x10\x10\x35\x6d\x92\xb6\xaf\x4a\x7f\x34\x80\xd2\xd0\xa5\x53\xea\xc6\xd8\x5f\x0f\x40\x0f\x05\x33\x57\x85\x3f\x16\x6d\x15\xfd\xac\xae\x41\x5d\x3d\x35\xfc\xad\xf5\x39\xd8\x67\x88\x7e\xc7\x85\x32\x95\x83\x6d\x58\xc6\xee\x6e\xee\x1b\x3b\x00\x33\x00\x33\x5b\x80\x19\x11\x07\x2b\xa1\xcc\x9b\x83\x81\x23\x21\x71\x9d\x23\xad\xf7\x01\x7e\x3c\xf5\xd0\xb3\x00\xa8\x4f\x38\x16\x04\xbd\x8f\x39\x06\x88\xda\x03\x88\x02\x84\x5a\xb5\x12\x87\x8a\x50\xbb\x9a\x3d\x1b\x0c\x1a\xb8\xa5\xf4\xf1\xf3\x47\xcc\x9e\xe9\xe5\x58\x3e\xc3\x2e\x0d\xbd\xe7\x35\x6f\x06\xab\x36\x36\xba\x6a\xa3\xf5\x04\xe2\x60\xb8\xbc\xf3\xb6\xfd\x6c\x0b\x30\x37\x30\xf7\x16\x17\x5d\x38\x2a\x10\x12\x31\x52\xb1\xb0\xf5\xb2\x80\xed\x7e\xba\x5f\xdf\xd9\xc6\x57\x56\xd8\xbd\x83\x78\xce\x9d\x34\x0f\x32\x18\x01\x9e\x75\x2f\x2a\x7c\x28\xcf\xba\x9f\xf0\x93\x8b\xf0\x69\xf5\xe3\x84\x75\x40\xd0\xa3\x44\x50\xe3\xc4\x6f\x23\x83\xc2\x43\xec\x45\x02\x30\xe8\x3e\x30\xa8\x4b\x7c\x89\x5f\xae\x4f\xa2\x5f\x87\x4f\xfe\xe0\xda\x8c\x98\x6b\x90\xac\xae\xeb\x31\x83\x2c\xd2\x16\x40\x2f\x87\x41\xf9\x85\x6f\x80\xb2\x69\x85\x0f\x05\x65\xab\xcf\xd4\x37\xff\xed\x70\x23\x93\x1d\xc8\x74\x2e\x90\xee\x73\x99\x96\x1e\xf6\x96\x53\x95\xdd\x2f\xbe\x0d\xe3\x11\xd3\xd2\xbf\xc5\xe3\x31\xe1\xc9\xdb\x31\x61\x5a\x1a\x34\x41\xad\x26\x28\xef\x1b\xac\xed\xbb\xfd\x06\x45\xd0\xf2\x45\x2e\xa0\x08\x40\x11\x6c\x71\x56\xfa\x56\xc7\xc1\x64\xeb\x55\x2c\x88\x9b\xec\xbf\x5a\x75\xef\x95\x4b\x39\x71\xe4\xd1\x6f\x03\xcc\x1b\xd3\xc1\x11\x76\xa8\xbc\x3f\x20\x83\xa6\xb7\xbc\xa6\x51\x57\xd9\x1c\x58\xcb\x3a\x3b\x14\x4e\xef\xb5\xcd\x51\x42\x06\x6d\x17\xc1\x80\x6c\xca\xa5\xed\x95\x6c\xda\xca\x13\x00\xd8\xec\x76\x4c\xba\x08\x68\xff\x18\x69\xdf\xf4\xe6\x90\x26\xda\x3f\x07\xda\x5f\x24\x00\xed\xef\x2f\xed\x27\xb0\x73\x18\x78\xca\xe2\xd0\x30\x4c\x6d\x1c\xf8\x81\x4d\xe7\x69\xc0\xa6\x07\xc8\xa6\xb9\x49\xfd\x2a\x2b\xc2\xd2\x15\x00\x57\x00\xd7\x63\x01\x57\xe3\xca\x95\x46\x72\x1d\x02\xb9\x22\x20\xd7\xfd\x27\xd7\x75\xa6\x55\x03\xac\xee\x0c\xe6\xa9\x13\x63\x6a\xef\xa9\x4c\x54\x1f\x82\x49\x8f\x7c\xa6\xfa\x5a\xdb\x1c\xd4\x00\xa8\x01\x98\xa9\x06\xe0\x37\x98\x0d\x80\xff\x38\x81\xdf\xf4\x89\xa1\x46\xe0\x6f\xf9\x8e\x6b\x00\x7e\x00\xfe\x03\x9c\xaa\xde\x0d\x9f\x1e\xf1\x54\x35\xc0\xe9\xfc\x30\xc0\x29\x4c\x55\x03\xb9\xd6\x92\xeb\x8b\xd2\xe5\x54\x04\x54\x41\x4b\x59\xab\xdf\x5d\x04\x0e\x4b\x38\x13\x12\xe0\x2f\x84\x8b\x24\x38\x2c\x3e\xe5\x61\x09\x79\x9f\x04\x1d\x17\xf3\xbb\x45\x0e\x89\xbd\x62\xf7\xb3\xc4\x7d\xe8\x12\x41\xf3\xe1\x48\xc7\x75\x2f\x7d\xe5\x51\xe5\x6e\x24\x09\x22\x15\x2d\x42\xaf\x50\x7b\xcb\xa7\x42\x96\xba\x76\xc5\x36\xfe\x17\xe5\x8d\x66\x17\x5e\xd0\x52\xa5\xb7\x48\x32\x4b\x5e\x28\x7f\x1f\x71\xf6\x83\x38\x86\xfe\x32\x9d\x17\x9c\x3b\x67\x59\x87\x6c\x87\xe6\xda\x15\xc7\x34\xa4\xf3\xe8\x9b\xf4\xca\x51\x12\x52\x5e\xc6\xd1\xaf\x1f\xec\xf6\xed\xb7\xcc\x86\x9d\xbc\xe1\xbe\x59\x0f\x27\x28\x1b\x85\xcb\xfb\xc3\xd2\x6d\x5f\xe5\xc0\x4c\x43\xc7\x8f\x5d\xf2\xce\xaf\x63\xb9\x6c\x67\xc9\x1f\x69\xd1\xe5\x92\x83\xd8\x97\xb4\x26\xf7\x3c\x80\x5a\x61\x5d\xe6\x05\x96\x15\x87\x38\x84\xac\xff\x8b\x09\xbf\xdf\xac\x11\x16\x1d\xbb\xa2\x1d\x88\x47\x66\x06\x1e\xb0\xc4\x1d\x8d\xfe\xe4\xfe\xcd\x7d\xe8\xd4\x0d\x87\xc9\x90\x57\x2e\x51\x62\x4f\x77\x3e\xf1\x8f\xb4\x1e\xe5\xa2\x53\xe7\x28\x57\x5c\x1d\xaf\xcf\x34\x1f\x17\x13\xeb\x94\x12\x63\x41\x3e\x27\x85\x2e\x25\xef\x4d\xbb\x09\xed\xe0\x9a\x5f\xa7\xdf\xf1\x2f\xce\x9c\xc9\x12\xff\x69\x91\x79\x0b\x8e\x55\xcf\xb2\x4d\xbd\x2d\x62\xee\xe6\x9d\xed\x13\x2b\x4f\x54\xb7\x72\xb3\xa8\x9a\x6d\x1d\x07\xdb\xac\x31\x9e\xd2\xe9\xca\x16\xde\x33\xa7\xcb\xfe\x9d\xa2\xe0\xc3\x62\x54\xa3\xba\xc9\x72\xe3\xd9\x38\x51\x02\x56\xc8\x7e\x76\xfa\x79\x8f\xb1\x24\x9b\x1f\xb7\x8c\xc5\x44\xd4\xb9\xd3\x4a\x33\x57\x58\x68\xc0\xd3\xb4\x25\x46\xa9\x8e\x2a\x93\xa1\x35\x2c\xa9\x04\xbb\x5b\x3a\xd0\x2f\x1f\xb0\x83\xe2\xdf\xc3\xd2\xdf\x76\xf9\x40\xbf\x5b\x3e\xa3\x44\xf7\xbd\xd2\xdf\xb6\xbb\xf0\xff\xef\x79\xa3\x28\xa5\x98\xeb\xdd\x2b\xdd\x45\xf9\xa2\xa7\xe5\x8b\x96\xef\xa2\x37\x28\x1f\x28\xbd\x99\xe6\xcc\x2d\xd7\x33\x7f\xdf\xc6\x76\xfb\x17\xd3\x32\xdd\xba\xe5\xec\xa7\x20\x3c\x47\x4d\xa9\x82\xbb\x99\x3b\x1a\xea\xa0\xdf\x73\xae\x86\x3a\xe8\xef\x5f\xae\x17\xe7\xc7\x7a\xfa\xc8\x3a\xbb\x9a\x75\xa7\x64\xf0\xbf\x39\xfe\x9a\x2e\x58\x4d\x1f\x7a\x78\xf1\xef\x00\x00\x00\xff\xff\x19\x0b\x08\xd6\xdf\x20\x01\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x9d\x6b\x73\xdb\xb8\xd5\xc7\xdf\xeb\x53\x60\x30\xcf\xd3\x49\x76\xe8\x44\x94\x2c\xf9\x32\x93\x17\x89\xd3\x6c\xb7\x93\x6c\xdd\x75\x36\x6f\x92\x8c\x07\x26\x21\x09\x6b\x92\xe0\x00\xa0\x23\x37\xd5\x77\xef\x80\x37\xf3\x02\xca\x14\x2d\x5b\x92\x75\x9a\xb4\x8d\x78\x48\x5c\x0e\x80\x83\xdf\x1f\x04\x24\x12\xb2\x2f\x54\x48\xc6\x83\x53\xc4\x02\x45\xa7\x82\x12\xe5\xdd\xbe\xe2\x62\xfa\xfa\xc6\x26\x5e\x38\x23\x76\xef\x9a\x05\xee\x29\xfa\x55\x90\x09\x09\xc8\x7b\x22\x67\x57\x9c\x08\xb7\xe7\x53\x45\x5c\xa2\xc8\x69\x0f\xa1\x80\xf8\xf4\x14\xc9\xdb\xc0\xa5\x92\xc9\x83\x24\x29\xa2\x18\x0f\xe4\xc1\x5f\x37\xfe\x81\x9b\x3f\x85\x90\x47\xae\xa8\x27\xf5\x53\x08\x91\x30\xbc\x7b\x2c\xbe\x92\x7d\x78\xc5\xf8\xeb\xe5\x56\x75\x1b\x52\x5d\xea\x89\x20\x52\x89\xc8\x51\x91\xa0\x3d\x19\x52\x67\xa5\x02\xbd\xfa\x4b\xf2\xa0\x87\x90\xfe\xbf\x53\xf4\xdf\xb8\x0c\x3f\xe3\xff\x45\x08\x93\x20\xe0\x2a\xa9\x06\x3e\xcd\x2f\x23\x84\x3d\x26\x15\x3e\x45\x5f\xf3\x2b\x77\x0f\xa5\x77\x5c\x45\xcc\x53\xbf\x05\xf8\x14\xd9\x56\xd9\xa2\x7d\x26\x79\x24\x1c\x8a\x4f\x11\x3e\x38\xc8\x3c\x8b\x0e\x0e\x70\xe5\x56\x1a\x90\x2b\x4f\xdf\xa6\x44\x44\x2b\xb6\x19\x73\x1b\x2c\xcc\xe1\xc1\x19\xf7\xb8\xd0\xe9\x8b\xe9\x15\x79\xd1\xb7\xd0\xc0\xb6\x2d\x34\x18\x8d\x2c\x64\xbf\xac\x66\xe3\x31\x9f\xe9\xea\xd8\xfd\x7e\xc5\xa2\xdd\xa8\x53\x79\x7b\xe7\x08\xf4\x37\xf4\xd6\xa3\x42\xc9\x6a\x2a\x72\xc6\x7f\xc4\x15\xae\x26\xa2\x1b\x4a\x27\x92\xfb\x1c\x17\xec\x8b\xfc\xdf\xdf\xd3\x7f\x2d\xb2\xc7\xb1\x4b\xa5\x23\x58\xa8\xfd\xaf\x9f\xcf\x33\xc4\xd4\x65\xca\xe0\x19\x3c\x0d\xa8\xfa\xcd\xc5\xa7\xe8\xf0\xa8\x9f\xbb\x1d\x4f\x05\x09\x67\x9f\x39\xf7\x14\x0b\x8b\xed\x81\x99\xbe\x75\x30\xba\xfb\xac\x68\xd2\x47\xf4\x5d\xa3\xf1\x60\xdc\x3f\x1c\xdb\x47\xa3\xf1\x49\x7e\x87\xc7\x82\x6b\x59\x6a\xf8\x62\xb3\xc7\x9e\xd7\x25\xa5\x73\x45\x45\x40\x3c\xa4\xef\x2f\xf9\x09\xb3\xc0\xf1\x22\x97\x7e\x21\x42\x1a\x5a\x0f\x5f\x53\x1a\x7e\x66\xbe\xa9\x65\xb1\x22\xd3\x72\xde\xfa\x0f\xce\xfa\x78\x29\x1b\x5d\x96\x42\x97\x2f\x3a\xfc\xbb\xd5\x5b\xd6\x36\x12\xf7\xaa\x4d\x93\x3f\x81\x43\x12\x50\x6f\x49\xfd\x1d\xee\x79\x24\x94\x54\xfb\x75\x42\x3c\x59\x2e\xff\x54\x30\xf7\x9c\x97\x47\x92\xfe\x83\x67\x86\x51\xf2\x43\x37\xcd\x61\xe5\xe2\xdc\xd0\xbb\x6e\xf5\xb5\xc2\xa5\xbc\xfb\xdc\x35\xb1\x7d\xd7\xc6\x95\x6a\x94\x9d\x21\x68\x48\x89\x1e\x08\x41\xe4\x79\x25\x8b\x62\x2a\xee\x6d\xf8\xdf\x11\x73\xae\xd1\x07\xe2\x54\xfa\x7f\xee\x48\xc1\x7f\x14\x3c\x68\x35\xf8\x89\x38\x33\xaa\x9b\x99\x47\xc6\xec\x1c\x3d\x7a\xdf\x11\xe7\x7a\x2a\x78\x14\x98\xbd\x19\xdf\xf3\x85\x78\x91\xb1\xaf\xc4\x56\x43\x6f\x89\xc3\xc1\xe0\x70\x64\xa1\xd1\x61\xf2\xdf\xfe\xab\x93\x5a\x40\x48\xee\x1a\x1e\x59\xc8\x1e\x9c\x58\xe8\xb0\xaf\x6f\x3b\x6e\xb8\x6f\xd4\xb7\x90\x7d\x34\xb0\x90\x4e\xb5\xff\xea\xe4\xe8\x65\x73\x77\x2b\xc7\xbe\x73\xc1\x7d\xaa\x66\x34\xaa\xf8\xd2\xa5\x0e\xf3\x89\x27\xab\xdd\xa2\x69\xdc\xeb\x3f\x98\x0a\xc1\x85\xd1\x53\x13\x2e\xfc\xb8\x59\x71\x25\x9f\x29\x89\xa6\xb4\xde\x1d\x7d\x32\xcf\xfc\x5a\x8f\x88\x3e\x0b\x32\x63\xd5\xa4\x03\xa0\xa9\x00\xba\xff\xcc\x04\x95\x33\xee\xb9\x1f\xe3\xc9\xef\xbe\xbb\x3e\x11\x71\x4d\xf3\x00\xd1\xd8\xb9\x97\x0e\xa8\x61\x25\x71\x3d\xa0\xc6\x2d\xc7\x93\xdd\x98\xe5\x8c\xb2\xe9\x4c\x95\xa2\xf1\xdd\x38\x1b\x97\xf2\x8c\x23\x90\xb8\x21\x9e\xa9\x87\xe7\x81\xb4\xdc\x3f\x7c\x12\x86\x2c\x98\x7e\x4e\x06\x93\xdd\x64\xab\x77\xec\xb2\x03\x0a\x33\xd7\x8d\x6e\x2c\xa4\x38\x52\x74\xae\x4a\x65\xd6\x7f\xf1\x4d\xd6\xd0\x25\xc3\xc2\x6a\x97\xb8\x20\xc1\xb4\x45\xe2\x83\x92\x61\xd1\x38\x3a\x7c\x32\x7f\x4f\x14\x39\xe7\x2c\x50\xb2\xde\xfb\x70\x10\x79\x5e\x6c\xfc\xc4\xe3\xb9\x1f\x3b\x3c\x08\xa8\xa3\xa8\x5b\xca\x3b\xbe\xef\x33\x9d\x1b\x43\x0b\x0f\x73\x9c\x29\xb7\x6c\xc8\xa5\x9a\xb0\x79\xbd\x69\x53\xc3\x07\x1e\xa8\x0b\xf6\x9f\x38\xdf\x51\xff\xff\x2b\xf7\x08\x6a\x7e\x56\xd0\xca\xa3\x47\xd5\x47\x63\x1f\x7e\x22\x61\x8b\x36\x9d\x08\xee\xeb\xec\x75\xa5\x4a\x89\xe8\xbf\x38\x6e\xe0\x53\x84\x7f\x7f\xfd\xd6\x60\xe4\xf9\x83\x2d\x1b\x43\x86\x44\x5c\x7b\x2c\x30\x04\x88\x09\xf3\xbc\x32\x60\x0d\x6d\x0b\xd9\xf6\xb1\x85\xec\xe3\x13\x1d\x04\xed\xe3\x5a\xac\x9c\xe8\x42\x9b\xc7\xbd\xce\xa5\x98\x5e\x92\xdc\xa0\x6f\x21\xfb\x64\xf8\x12\x2f\x09\x33\xbd\x86\x3e\x8b\xe3\x30\x79\xc6\xbd\xc8\x2f\xa3\x53\x6a\x14\x53\xaa\x5a\x38\x9c\xce\xc3\xb8\x4c\x8a\xf9\xf4\xc5\x4b\x74\x80\x42\xc1\x1d\x2a\xe5\xa5\x54\x44\xa8\x4b\x7d\xf9\x52\x52\x87\x07\xae\xfc\xa9\xc7\x84\x0c\x89\x43\xdf\x7c\xc3\xff\x97\x7f\xf8\x86\x2d\x14\x72\x57\x5f\x0b\xb9\xfb\x0d\x2f\x2a\xb5\x29\x85\xe7\x34\x3d\xc1\x68\x39\x50\xa7\xd1\x44\x2a\x12\x28\x43\xe8\x2f\x05\x1b\x3d\x35\xc7\xf3\xc0\xa0\x76\x8b\x47\xa7\x34\x70\x3f\xe4\xd9\xd5\xf3\xf0\xa9\x12\xcc\x31\xdb\x04\x9d\xc4\x68\x89\x0d\xbd\x4b\x2a\x1a\x93\xe5\xe1\x61\xbf\xdf\xb2\x7b\xe5\xb1\x5e\xd6\xb2\xbb\xa3\x8d\x3f\x43\xed\x12\x33\x68\x48\x16\x4c\x3d\x2a\x15\x29\x07\x9e\x24\xe8\x14\x47\xdc\x71\x75\xc4\xc5\x77\xb4\x1b\x71\x5c\x57\x0b\xbf\x59\x71\xb0\x65\x71\x6f\xa5\xf1\x16\x3f\xf4\x7b\x1a\x58\x9d\x48\x08\x1a\x28\xdc\x33\xf4\x6e\x20\xa9\x4e\x24\x55\x73\xcd\x43\x61\xca\x25\x2a\x66\xd8\xb7\xf2\x37\xc9\x01\xac\x6a\x60\x35\x5e\x1f\x58\x9d\x0c\x00\xac\x00\xac\x00\xac\x9e\x1d\x58\x3d\x9c\xa7\xd0\x2f\xba\x37\xf7\x01\xab\x56\xc0\xaa\x0b\x4d\xaf\x68\x0d\x68\x75\x04\x68\xf5\x68\x68\x65\x80\x21\xcb\x70\x5b\x5b\xb6\xaa\x93\x5a\xa3\xbb\x56\x46\xab\xc1\x5a\xb9\x2a\xa4\xc2\xd1\x0d\x04\x44\x55\x25\x2a\x7b\xb0\x12\x52\xc5\xe8\x34\x1e\x01\x3a\x01\x3a\x01\x3a\x3d\x3b\x74\x92\x91\xff\xe2\xaf\x1b\xff\xd2\xa7\x3e\x17\xb7\x97\x57\xb7\x8a\xca\xcb\x48\x52\xb7\x25\x39\x59\x88\x08\x4a\xde\x7c\xc3\x33\x4a\xc2\x6f\x78\xf1\xf2\x17\xbb\xdf\x7f\x6d\x4c\xd5\x27\xf3\xae\x89\x3e\x80\xcb\xd6\x00\x5e\x8f\x0a\x57\x47\x7d\xeb\xa4\xdf\x40\x58\xff\xa0\x24\x44\xba\x31\x1e\x08\x58\xc7\x00\x58\x00\x58\x00\x58\x4f\x06\x58\xc7\xab\x03\xd6\xd1\x9a\x01\x6b\xd0\x64\x03\xc0\x02\xc0\x5a\x13\x60\x59\xed\xb2\x3c\x38\xb9\xe7\x3f\x9d\xca\xd3\xaf\x14\xa6\xb1\x67\x00\xed\x3d\x02\xed\x05\x3c\x78\x14\xe0\x2b\xa4\xfb\x00\xe6\xcb\xd6\xe2\x0c\x6d\x54\x8e\xad\x18\x37\x5a\x77\x93\x18\x7f\xe7\xc1\xc1\xf3\xa4\x46\xeb\x81\x19\xce\x97\x64\xf7\xc8\x80\xba\xa1\xed\x7c\x87\xbd\x06\xf7\x65\xdb\xf9\x8e\xd6\xb5\x9d\xef\x9f\x5f\x3e\xa1\x4f\xf1\xc8\x37\x77\xb9\x56\xbb\xf9\x88\xc7\x88\x3c\xcb\x50\xbb\x32\xc3\x5e\x25\x1b\x3d\xeb\xee\xd3\xbb\x61\x3f\xd2\x60\xaa\x62\x77\x95\xdc\x10\xef\xc6\xa4\x4d\x8f\xb5\x61\xe7\x8e\x88\xcc\x3c\xaf\xda\x76\x71\x33\xd7\xdb\xd8\xa3\x13\xf5\x91\x4f\xdf\x11\x59\x5b\x14\x4b\xad\x9f\xc8\xdc\xe0\xfa\xcc\xc8\x02\xb3\x51\xe8\xb7\xa0\xcd\x09\xc7\xe6\xc6\x94\x13\x6b\x9e\x74\xc1\xb8\x68\xdf\x73\x8f\x0c\x3d\xd7\x1e\xb4\xec\xb9\xa3\xc6\x3c\x99\x5b\x1b\x01\x69\x38\xae\x17\x83\x78\x6c\x1a\xbc\x95\x9f\xd3\x16\xac\x35\x94\xee\x73\x37\xd3\x06\x4b\x36\xba\xeb\x2d\x9f\xc0\x66\x83\x81\x05\x0d\xe9\xa5\x93\xbd\xe1\x19\xc5\x15\x69\x02\x8a\x38\xe0\xdc\x2b\x5a\xf4\xc6\xa7\xec\xa6\x9a\xe1\x07\x73\x55\x2d\x94\x34\x69\x88\x1a\x25\xd7\x70\x71\x09\x04\x27\x7a\x90\x4c\x8d\xce\xc6\xa1\x86\x6f\x41\x5c\x16\xe9\xa2\x96\xd5\x4e\x98\x51\x7b\xfd\x31\x41\x03\x97\x0a\xaa\x47\x1a\x9e\x78\xbc\x32\x67\x25\x08\xf0\xaf\x1b\x2a\x04\x73\xa9\xa1\x42\x31\x7a\x34\xc5\x07\xa9\x88\x73\x6d\xcc\x55\xaf\xea\x84\xd4\xfd\x98\x50\x63\xdd\xbe\xf9\x65\xb6\x07\xd0\xd1\xca\x7c\x53\x63\x89\x75\xbc\x8e\x1c\xd4\xf0\xc7\x7a\x80\x2b\x1d\xee\xfb\x4c\xa9\xdd\xf0\x67\x5e\xd8\x25\x8e\x7b\xf7\x44\x8e\xdb\x91\x45\x59\x9f\xcc\x97\x38\xeb\x6c\x05\x67\x15\x3e\x2d\x81\xec\x4a\x18\xd1\x3d\xe0\x43\x22\x67\xab\x13\x66\x5c\xd5\x3f\xe8\x34\x0d\x8a\x86\x07\x2f\x66\x6c\x72\x3f\x3f\x69\x6a\x2f\x55\x03\xab\xfc\xac\x4d\xb9\x49\xb1\x2f\xff\xa0\x92\x7b\x51\x7a\xc6\xa6\x16\x9e\xf4\x20\x9b\x11\x41\x5d\xc3\x9c\xa0\x6d\x5c\x28\xc3\xc4\x1b\xcf\x35\x97\x19\xb2\x39\x91\x1f\x79\x44\xb1\x1b\x8a\x7b\x0d\x5d\x2d\xc7\xbb\xf8\x64\x50\xb9\xec\xf3\x03\x32\x67\xc6\x39\x69\x9e\x1a\x2a\x75\xba\x8a\x9c\xeb\x24\xa2\x56\xdd\xa4\x2b\x9c\xce\x46\xb5\xbd\x06\x77\xeb\x49\x86\xa7\x9a\xa7\xdc\x7c\x5a\xfd\xfa\xbd\xb1\x72\xb7\x8d\x35\xb8\xbd\x4c\x42\x43\x3d\xfc\x63\x3f\x8e\x46\xd5\x22\xca\x99\xf6\x78\xe1\x5a\xb9\x8f\xdc\x92\x79\x9b\xd7\x9e\x77\x83\xcb\x94\x89\x1e\x33\x7a\x21\xd4\xe8\x0a\x6d\x6c\x82\xc1\x1c\x67\x8c\x8f\xf9\xcc\x70\x14\xad\xec\xdb\x92\x69\x61\xb5\xad\x44\xe2\x94\x27\xac\x84\xd1\xd4\x58\x8f\x65\xad\x65\xea\xbf\x31\x6c\x36\x0c\xc6\xd8\xf6\x91\xde\xe4\x15\xeb\x99\xc4\x66\xc1\x75\xc5\xb4\x41\x17\xed\xa7\x2e\xb2\x07\x1d\x84\xd1\xa8\x9d\x30\x02\xd5\x03\xaa\x67\xa7\x54\xcf\x7a\x96\x85\xd7\xbc\xec\xfb\x9c\x65\xd1\x3a\x1d\xbe\x3f\xca\x68\x43\x5e\x7b\xfe\xe2\x28\x7b\xad\x01\x02\x09\x04\x12\x08\x24\x10\x48\x25\x81\xb4\xa9\xaf\xcb\xb0\x07\xbd\x86\x76\xcd\x5e\xb0\x1d\xaf\xf5\x05\x1b\x93\x0e\x36\x86\x17\x78\xbd\xb6\xe7\xaf\xd7\x92\xd7\x6b\xc7\x6d\xfb\xed\xb0\x31\xcf\xa4\xdf\xf6\x4b\x9b\xda\x52\xdc\xa8\x97\x03\x5e\x9e\xc1\xcb\xb3\x27\x79\x79\x26\x88\xa2\x2f\xb2\x33\x7e\x4e\x18\x65\x87\xfb\x2e\xe3\xd6\x6f\x09\xe9\x8b\xaf\xb6\xff\xbd\x3b\x96\xa7\xdf\x13\x56\xab\x8d\x99\xd9\xed\x7b\x99\x3d\xad\xce\x13\xe8\xc7\xc2\xa7\xad\x61\xfa\xb3\xf3\x3f\x01\xe5\x77\x1c\xe5\x4d\x7c\xba\x26\x92\x2f\xec\x59\xb7\x97\x0c\xd8\x34\x68\x46\x01\x5b\xc2\xc9\x18\x77\xa0\x64\x6c\x63\x20\xfd\xed\x23\x7d\x60\xd8\x7d\x64\xd8\xe3\x0e\x0c\x3b\x1c\x00\xc2\x02\xc2\x6e\x0f\xc2\xea\xd5\x65\xcd\x5b\xc4\x95\x97\x69\xd7\x6a\xcb\xad\xd8\x6a\x9e\x39\xd6\xbd\x92\xec\x69\x06\x7a\x7c\x24\xb5\x56\x77\x9a\x4b\xa8\xcf\x83\x6d\xf4\x59\x52\xb2\x8e\x5e\x7b\xf7\xb8\x5e\x0b\x29\xb9\xde\x46\x9f\xe9\x72\x2d\xf1\xca\xae\xbf\xb2\xf8\x9c\xb8\x1f\x24\x0e\x48\x9c\x16\x12\xa7\xbf\x64\xe0\x3d\xb9\x34\xa8\x17\xa6\xe0\xf7\x92\x69\x61\x2d\xaf\xe1\x06\x2b\x61\x34\x35\xd6\x63\x59\x53\x82\xbe\x01\x7d\xf3\xd8\xfa\xc6\x1e\x77\x10\x38\xe3\x52\x85\xd2\x09\x16\x04\x0e\x08\x9c\x0d\x09\x9c\x6c\x79\x9e\x87\x34\xb8\x9c\x3c\xc1\x17\x19\xaf\xb6\x20\x7f\x3f\x92\xea\x82\x77\x84\xf8\x47\x90\x3e\x99\x3b\x7d\x32\xdf\x49\x6f\x1a\xb7\x24\xad\x5d\x11\x15\x3e\x6d\x0d\xfb\x7f\x60\x1e\x45\xef\xd3\x9f\x5c\xd1\x13\x39\x88\x00\x10\x01\x1b\x15\x01\xfd\xd5\x01\xba\xfe\x48\xc1\xf1\x25\xd3\xc2\x5a\x5e\xc5\xb5\xd5\x62\xf5\x4a\x18\x4d\x8d\xf5\x58\xd6\x96\xfb\xb1\x9f\x69\xd0\xef\x35\xb4\x6b\xba\x9f\x69\x38\x6a\xb5\x9f\xc9\xf0\xb5\x00\xe8\x9c\x73\x4f\xa2\x17\x7a\x0f\xe7\x4b\x6c\x0c\x38\xb0\x89\x09\x36\x31\xad\xb0\x89\x69\x70\xcf\xf7\x69\xd9\xc3\x52\xea\x29\xa0\x6c\xf9\x97\x04\xc4\x5e\xbd\x68\x44\x31\xd0\x58\xfb\xad\xb1\x0a\x47\x14\x42\xce\xbd\x2e\xe7\x69\xf4\x73\x6f\xbe\xe1\xf3\x0b\xf4\x77\x97\x06\xe8\x22\xb9\xab\xbb\x6e\xd8\xf9\x63\x33\x66\x9f\xae\x7e\x6e\x66\x0b\x1c\xbb\xa1\xc3\x33\x66\x0f\xae\x72\x7a\x66\x0b\x7c\xf7\xbc\x8f\xd0\x94\x3c\x0b\x82\x74\xc7\x05\x29\x9c\xa1\x81\x33\x34\xeb\x3e\x43\x03\xc2\x6a\x1f\x85\xd5\x71\x17\x61\x75\x58\x9a\x4d\xd3\x99\x14\x84\x15\x08\x2b\x10\x56\x20\xac\x40\x58\x81\xb0\x02\x61\x05\xc2\x0a\x84\x15\x08\x2b\x10\x56\x20\xac\xf6\x54\x58\xd9\xe3\x2e\xca\x6a\x78\x02\xca\x0a\x94\x15\x28\xab\x26\x65\x75\x11\x89\x1b\x76\xc3\x05\xa8\xab\x47\x50\x57\x1b\x75\xee\x33\x50\x58\x1b\xf5\xdf\xb3\x57\x59\x65\xef\x82\xd2\x02\xa5\x05\x4a\x0b\x94\x56\x49\x69\x6d\x6c\xdb\xe4\x71\xaf\xa1\x5d\xb3\x17\x26\xf6\x43\xb7\x4d\x66\x5f\x7f\x09\x5b\x27\x61\xeb\xe4\x1a\xb6\x4e\x9e\x34\xe6\x99\xea\xd0\x52\x4a\x29\x6c\x80\x0e\x05\x1d\xba\xe7\x3a\x34\xd6\xa1\x9f\xa8\x22\x72\x03\x88\xff\xac\xf5\xe7\xa6\x9c\xba\xeb\xba\x73\x53\x7e\x7b\xde\x7a\x33\xf7\x2a\xe8\x4c\xd0\x99\xa0\x33\x41\x67\x96\x74\x26\x08\xa9\x7d\x14\x52\xc7\x5d\x84\xd4\xe1\x10\x84\x14\x08\x29\x10\x52\x46\x21\x75\xc6\xfd\x50\x50\x29\xa9\x8b\xce\x3c\x22\x25\xba\x00\x55\xb5\x5e\x55\xb5\x15\x1e\xde\x75\x89\xb5\x15\x4e\x7c\xde\x7a\xcb\xec\x62\x10\x5f\x20\xbe\x40\x7c\x81\xf8\x02\xf1\xb5\xf7\xe2\xcb\x1e\x77\x52\x5f\xa5\x37\xb8\xe9\x9c\x0a\xea\x0b\xd4\x17\xa8\x2f\xad\xbe\x5c\x8a\xce\x88\x33\x03\xc5\xb5\x56\xc5\xb5\x21\xaf\xee\xbe\xca\xda\x90\xe3\x9e\xbb\xb2\xca\xdc\x0a\x6a\x0a\xd4\x14\xa8\x29\x50\x53\x25\x35\xb5\xa9\x2d\x93\xc3\x71\xaf\xa1\x5d\x53\x74\x1f\xb6\xdb\x32\xd9\xe2\x97\x73\x7f\x25\xe2\x8a\x4c\x29\x3a\xe3\x9e\x47\x1d\xcd\x9c\xd8\x18\x68\x76\x75\x0f\x65\xa3\x8e\x5c\x49\x74\xd9\x83\xb6\x2d\x77\x54\xb8\x56\xae\x7f\xdc\x72\x27\x25\xf9\x96\xce\xb6\xf5\x72\xb4\x11\x54\x26\x6b\x32\x12\x8d\x96\x46\x49\x55\x18\x87\x5d\x24\x53\x9c\x59\x63\xa5\x41\x33\x6d\xbd\x66\x8a\x7f\x3d\x56\x73\xfe\xd4\xb9\x74\xf2\x28\x90\xff\x86\xac\xc3\xa3\x40\x6d\xe3\x6f\xc8\xde\xcf\xae\x3f\x7f\x4e\x9d\xc5\x62\x09\xbe\xbe\xc5\x2d\x67\x9e\x8d\x22\x6a\xd6\x24\xb2\x25\xa3\x3e\x18\x40\x59\xe0\xb2\x1b\xe6\x46\xc4\xc3\x8d\x03\x7b\x19\x80\xee\x0a\x66\xae\x0a\x7f\x3c\x94\x4f\x49\x4d\xb8\x5f\xcf\xad\x50\xe3\x92\x69\x61\xb5\xad\xc4\x3d\xf0\x87\xf1\x7a\x2b\x61\x34\x35\xd6\xa2\xf0\x69\xc3\xe8\xb7\x5f\x28\x63\x0f\x3a\xb0\x8c\xdd\xb7\x01\x66\x00\x66\x76\x09\x66\x64\xe4\xaf\x84\x32\xaf\x77\x06\x8e\xa4\x22\x81\x6a\x0f\x4f\xf6\xbd\xf0\x44\x6e\xa6\xe8\x59\x00\xd4\x39\x89\x24\x45\xef\x23\x41\x00\xa2\xb6\x00\xa2\x64\x33\x7b\x00\x42\x3d\x2b\x84\xda\xd4\xea\xd9\xe1\x61\xaf\xa1\x55\xb3\xd5\xb3\xc1\xba\x56\xcf\xe2\x8d\x99\x1e\x27\x2e\x0b\xa6\xd8\x38\xb4\xe1\xec\xf1\x9e\x9f\x3d\x4e\xce\x1e\xdb\x83\xb6\x9d\x77\xd4\x98\x69\xdc\x79\x87\x47\xc0\xdc\xc0\xdc\x5b\xc5\xdc\x7a\xed\xd0\xd1\x81\x90\xca\x4b\x1d\x0b\x5b\x6f\x0b\x30\x90\x65\x4b\x18\xee\xb0\x18\x98\x94\x6c\xed\x3b\x2b\xec\xc1\x4e\xbc\xe7\x4e\x9a\x07\x19\x9c\x00\xef\xba\x77\xef\x5d\xb7\x09\x40\xd3\x8b\x85\x6b\xdf\x1f\x08\xea\xcb\x29\x77\xfd\xb0\x5e\xe9\x29\x65\xd7\x96\x4c\x0b\x6b\x6b\x2b\x61\x34\x35\xd6\x63\x59\x6b\xed\xfd\x7a\x27\x20\xe8\xe3\x23\xa8\x3d\xe8\xc2\xa0\xf0\x12\x1b\x5e\x62\x6f\xd7\x4b\x6c\x97\x7a\x8a\xbc\xe8\x4e\xa2\x5f\x47\x8f\xfe\xe2\xda\x8c\x98\x1d\x48\x36\xae\xeb\x3e\x83\x2c\x8a\x3d\x80\x5e\x8c\xfc\x97\x80\xb2\x3b\x8e\xb2\xf5\x77\xea\x29\xb3\x15\xae\x75\x02\xd9\xc2\x6f\x87\xd7\x6b\xba\x0a\x23\x62\xbc\x49\x42\xb4\x96\xd7\xb2\x75\x2d\x80\x74\x33\xd2\xdd\xd4\xb2\xf4\x68\xd0\x6b\x68\xd7\x6c\x59\x7a\xb8\xae\x65\xe9\x77\xd1\x64\x42\x45\xf2\xa3\xe2\xd8\x18\x80\x60\x59\x7a\xcf\x97\xa5\x93\x65\xe9\x71\xdb\xbe\x3b\x6c\xcc\x33\xee\xbb\xc3\x21\x28\x02\x50\x04\x5b\xa5\x08\xb4\x16\xb8\x8a\xe3\x60\x72\x72\x4d\x9f\xab\x4b\xce\x5f\xb5\xd4\x04\xf9\xd9\x2b\x97\x09\xea\xa8\xbd\x3f\x06\x58\x74\xa6\x43\x42\xe2\x30\x75\xbb\x43\x0e\xcd\x8a\xdc\xd1\xa9\xab\x1c\x0e\x2c\x7c\xda\x1a\xe1\xf4\x3e\xee\xc4\x28\x21\x03\x09\xb2\x69\xc7\x65\x93\x89\xf6\xd3\x8b\x85\x6b\x9d\x84\x13\x1c\x76\xdb\xcf\xc3\x6e\x40\xfb\xfb\x48\xfb\xe3\x0e\xb4\x7f\x0c\xb4\x0f\xb4\xbf\x0b\xb4\x9f\xc0\xce\x6e\xe0\xa9\xde\x49\xde\x91\x4d\x57\x01\xfe\xc2\x27\x60\x53\x60\xd3\x1d\x63\xd3\xc2\xa2\x7e\x7f\xc9\xe0\x7c\x72\xe6\xab\x17\xa6\xe0\xf7\x92\x69\x61\x2d\xaf\xe1\x06\x2b\x61\x34\x35\xd6\x63\x59\x53\x56\xfa\xf6\xff\xd8\xbb\x9e\xdf\xa6\x61\x28\x7c\xef\x5f\x61\xbd\x13\x87\x45\x4a\x9a\x95\xc2\x81\x03\xe2\x04\xd2\x10\x68\x50\x2e\x43\x55\x96\x78\x59\xd6\xfc\x22\x4e\xba\x06\xb4\xff\x1d\xd9\xf9\x65\xbf\x26\x6b\x89\x02\x6c\xaa\xa7\x1c\x66\xcf\x7e\xb6\x5f\xde\xf7\xfc\xf9\x5b\x93\x76\xe4\x54\x13\x57\x4d\x5c\x27\x22\xae\xd6\x7c\x0c\x73\x5d\x68\xe6\xaa\x99\xeb\x33\x60\xae\x63\x64\xd5\xc8\xe1\x33\xd3\x3a\x75\xa5\x53\x0b\xf4\xec\x09\xd5\xcf\xc1\xa5\x27\xae\x54\x5f\x08\x9f\xeb\xd3\x80\x3e\x0d\x1c\x3a\x0d\x68\xa5\x5a\x2b\xd5\x5a\xa9\x3e\x15\xa5\xda\x7a\x35\x86\xf0\x2b\x9f\xce\xaa\xb7\x5a\x4d\xf8\x35\xe1\x7f\x6a\x84\x7f\x9c\x54\xfd\x7f\xf8\xe9\x09\x4b\xd5\x9a\x9c\x6a\x72\x7a\x1c\x39\xd5\x52\xf5\x29\x4b\xd5\x33\x34\x1c\x57\x3d\x78\xd2\xe2\xde\xb2\xcd\x2e\x71\x00\x73\x6f\x69\xe4\xac\x68\xc6\xaa\xe4\xd0\x7d\x95\x07\xb0\xbc\x14\x0a\x32\x78\x4e\xb6\xe9\x7a\xe4\x8e\xaf\x86\x1f\xb0\x32\xf6\x28\x0b\xe4\x74\x24\x74\x07\xbf\x79\xe5\xd1\xde\x6c\x72\x1a\xa5\xfc\x1f\x5b\xb1\xaf\xac\x1e\xc2\x80\xe5\x28\xb4\xf7\x7c\x13\xae\x78\xe2\xe9\xbd\x09\x12\x5b\x52\xbb\x89\x21\x77\xdc\x34\x44\x65\x9a\x25\x77\xd4\xed\x89\x97\x6d\x6d\x58\x6a\xf3\x58\x40\x1e\x47\xcd\x79\x3b\x7a\x13\xc4\x41\x9d\x7d\xab\xa8\x5c\x8b\xb1\xd8\x8b\x22\xfd\x75\x97\x5c\xbf\xb9\x6a\x7d\x68\xc8\x8e\xbb\x82\x87\x33\xd2\xee\xc2\xf8\xf9\xb0\xe6\xb1\x2f\x84\x28\x08\x62\x37\x2c\x3c\xfa\x36\x1c\xe2\x72\x0d\x2e\xe0\x63\x63\x1a\x5b\x8e\x8a\x30\x0f\x06\x7a\xd7\x09\x14\xe2\xa1\xce\x1d\x2d\x53\xb7\x38\x42\xe0\x47\x41\xb3\x72\x5a\x27\x74\x81\x8d\x70\x0c\x19\xf5\xe9\xae\x87\x0f\x00\xdb\x04\xe9\xd7\x2c\xbc\x2c\x63\x77\x60\x8d\xf5\x96\x87\x2d\xe6\x8e\x2f\x82\x8f\x7d\x6e\xd6\x81\x4d\x37\xe0\xc0\x0b\xe7\xf5\xc3\x9d\xea\x7d\xb1\xf2\x0e\xfa\x63\xc1\xe8\x17\xc7\x3f\xc8\xbc\xa7\x86\x49\x60\x38\x03\x3f\x86\x6d\x84\xaf\x97\xee\xed\x23\xf8\x39\xa2\xf3\x3f\x00\xd6\x30\x97\x3d\x14\x6d\x69\xe2\x4d\x0f\xb6\x4f\x89\x37\x06\x66\x69\xe2\x4d\x01\xb0\x69\x9d\xf1\x37\x41\x67\x3e\x6d\xd0\xb5\xbf\x7f\x9f\xa1\xe0\x15\x14\x5e\xc1\x14\xdc\x54\xaf\x54\x81\x38\xb9\x37\x6c\x19\x31\x90\x27\x75\x3d\xf4\x9a\x49\x03\x77\x43\x33\xd5\x58\xdc\xd2\x8b\xb3\x19\xbe\x13\xeb\xe6\x1c\x85\x99\x21\x2c\x54\xd4\x80\x25\xed\xfe\xfc\x52\xe8\x00\xbf\xc0\x8a\xd4\xf2\x02\x95\x2d\x5c\x61\x9b\xb8\x85\x9a\x1c\x60\x8e\xca\x96\xd7\xac\x5a\x22\x05\xf5\xca\xd7\x52\x74\xff\xd1\x2c\xf0\xa0\x2f\xf1\xa0\x78\x16\xf3\x73\x5c\xa1\x22\x0d\x96\xa8\x6c\x9b\xf2\xbc\x7b\xef\xdb\xcf\x44\x1c\xd3\xe1\x3a\x4b\xee\x19\xcd\xda\xfe\xdd\x09\xee\xb2\x06\x1a\x31\xc8\x7b\x09\x6a\xc4\x20\x1f\x56\x17\x5d\xfb\x42\x88\x70\xb0\x7c\xb7\x33\xb7\xf4\xfc\x9b\xc4\xbf\xb6\x1d\x57\x9b\x11\x42\xc8\xc3\xec\xf7\x00\x19\x0b\x08\xd6\xdf\x20\x01\x00"),
 		},
 		"/addons/ops/addon-ops-integrations-service.yml": &vfsgen۰CompressedFileInfo{
 			name:             "addon-ops-integrations-service.yml",
 			modTime:          time.Time{},
 			uncompressedSize: 372,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x8f\xc1\x4a\x04\x41\x0c\x44\xef\xfd\x15\xf5\x03\xab\x78\x5a\xb6\xaf\x9e\xbc\x88\x20\x78\x8f\xbd\xa5\x04\x67\xd2\x4d\x92\x59\xd8\xbf\x97\x71\x76\x51\x10\x3c\x79\x4b\x52\x0f\xf2\x4a\x86\xbe\xd0\x43\xbb\x55\x9c\xee\xca\x87\xda\xb1\xe2\x99\x7e\xd2\xc6\x32\x33\xe5\x28\x29\xb5\x00\x26\x33\x2b\xe2\x6c\x47\x86\xc6\x4e\x2d\xf9\xee\x92\xda\x2d\x0a\x30\xc9\x2b\xa7\x58\x39\x40\xc6\xf8\x06\xbf\x2e\xd7\xe5\x46\xfb\xed\xdf\x69\xeb\xf3\xe8\x46\xcb\x8a\x1f\x1f\x7e\x61\x79\x1e\x5c\x89\x37\x97\x48\x5f\x5a\x2e\xce\x12\x83\x6d\x35\xd8\xc2\xfb\x69\x89\xa4\x3f\x3c\x15\xa0\x5d\xe7\x8a\xc7\x6e\x2c\xc0\xe8\x9e\x17\xdd\xdd\xa5\xda\xcc\x74\x6d\x9b\xd2\x06\x54\x1c\xf6\xfb\x43\x01\x82\x13\x5b\x76\xaf\xff\xd3\xe6\x33\x00\x00\xff\xff\x9a\x79\x38\x20\x74\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x8f\xc1\x4a\x04\x41\x0c\x44\xef\xfd\x15\xf5\x03\xab\x78\x5a\xb6\xaf\x9e\xbc\x88\x20\x78\x8f\xbd\xa5\x04\x67\xd2\x4d\x92\x59\xd8\xbf\x97\x71\x76\x51\x10\x3c\x79\x4b\x52\x0f\xf2\x4a\x86\xbe\xd0\x43\xbb\x55\x9c\xee\xca\x87\xda\xb1\xe2\x99\x7e\xd2\xc6\x32\x33\xe5\x28\x29\xb5\x00\x26\x33\x2b\xe2\x6c\x47\x86\xc6\x4e\x2d\xf9\xee\x92\xda\x2d\x0a\x30\xc9\x2b\xa7\x58\x39\x40\xc6\xf8\x06\xbf\x2e\xd7\xe5\x46\xfb\xed\xdf\x69\xeb\xf3\xe8\x46\xcb\x8a\x1f\x1f\x7e\x61\x79\x1e\x5c\x89\x37\x97\x48\x5f\x5a\x2e\xce\x12\x83\x6d\x35\xd8\xc2\xfb\x69\x89\xa4\x3f\x3c\x15\xa0\x5d\xe7\x8a\xc7\x6e\x2c\xc0\xe8\x9e\x17\xdd\xdd\xa5\xda\xcc\x74\x6d\x9b\xd2\x06\x54\x1c\xf6\xfb\x43\x01\x82\x13\x5b\x76\xaf\xff\xd3\xe6\x73\x00\x9a\x79\x38\x20\x74\x01\x00\x00"),
 		},
 		"/addons/ops/addon-ops-integrations-servicemonitor.yml": &vfsgen۰CompressedFileInfo{
 			name:             "addon-ops-integrations-servicemonitor.yml",
 			modTime:          time.Time{},
 			uncompressedSize: 406,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x90\x41\x4e\x03\x31\x0c\x45\xf7\x39\x85\x2f\x30\xad\xd8\xe6\x0c\xb0\x42\x62\x1f\x92\x4f\xb1\x3a\xb1\x23\xdb\x2d\x9a\xdb\xa3\x99\x01\xb5\x12\x02\x96\xb6\xdf\xe2\x3d\x97\xc1\x2f\x30\x67\x95\x4c\x5d\x85\x43\x8d\xe5\x74\xa8\x6a\x50\x3f\x54\xed\xc7\xeb\x43\x3a\xb3\xb4\x4c\xcf\xb0\x2b\x57\x3c\xed\x54\xea\x88\xd2\x4a\x94\x9c\x88\xa4\x74\x64\xf2\x45\x1a\x9c\x7d\x62\x09\x9c\xac\x04\xab\x78\x22\x9a\xcb\x2b\x66\x5f\x39\xa2\x32\xc6\x0d\xdc\x36\xdf\xc3\x81\xf5\xf8\xf7\xb5\x6a\x1f\x2a\x90\xc8\xe4\xbb\xcb\xf4\xa5\xfc\x03\x8d\x65\x20\x13\xcb\x9b\x15\x0f\xbb\xd4\xb8\x18\x36\xe8\xd6\x38\x9d\xb1\x64\xea\xdc\xda\x8c\x8f\x62\x48\x3e\x50\x57\x49\x48\x1b\xca\x12\x9b\xf1\x44\x43\x2d\x32\x75\x84\x71\x5d\x9d\x1c\x33\x6a\xa8\xed\x3d\xbd\x44\x7d\x7f\xbc\x0b\xfc\x2f\xe8\xd7\xa4\xbb\xa7\xa5\xcf\x00\x00\x00\xff\xff\x31\x2d\x51\x0e\x96\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x90\x41\x4e\x03\x31\x0c\x45\xf7\x39\x85\x2f\x30\x53\xb1\xcd\x19\x60\x85\xc4\x3e\x24\x9f\x62\x75\x62\x47\xb6\x5b\x34\xb7\x47\x33\x05\xb5\x12\x02\x96\xc9\x7f\x8b\xf7\x5c\x06\xbf\xc0\x9c\x55\x32\x75\x15\x0e\x35\x96\xe3\x5c\xd5\xa0\x3e\x57\xed\x87\xcb\x43\x3a\xb1\xb4\x4c\xcf\xb0\x0b\x57\x3c\x5d\xa9\xd4\x11\xa5\x95\x28\x39\x11\x49\xe9\xc8\xe4\xab\x34\x38\xfb\xc4\x12\x38\x5a\x09\x56\xf1\x44\xb4\x94\x57\x2c\xbe\x71\x44\x65\x8c\x1b\xb8\xff\x7c\x3f\x66\xd6\xc3\xdf\x6b\xd5\x3e\x54\x20\x91\xc9\xaf\x2e\xd3\x97\xf2\x0f\x34\xd6\x81\x4c\x2c\x6f\x56\x3c\xec\x5c\xe3\x6c\xd8\xa1\x5b\xe3\x74\xc2\x9a\xa9\x73\x6b\x0b\x3e\x8a\x21\xf9\x40\xdd\x24\x21\x6d\x28\x4b\xec\xc6\x13\x0d\xb5\xc8\xd4\x11\xc6\x75\x73\x72\x2c\xa8\xa1\xb6\xad\x44\xbd\x44\x7d\x7f\xbc\x0b\xfc\x2f\xe8\xd7\xa4\xbb\xa3\xa5\xcf\x01\x00\x31\x2d\x51\x0e\x96\x01\x00\x00"),
 		},
 		"/addons/ops/addon-ops-jvm-dashboard.yml": &vfsgen۰CompressedFileInfo{
 			name:             "addon-ops-jvm-dashboard.yml",
 			modTime:          time.Time{},
 			uncompressedSize: 100534,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x6b\x73\xdb\x38\x96\xfd\x9e\x5f\x81\x62\xcf\x6e\x39\x3b\x76\x47\xd4\xc3\xaf\xaa\xde\xaa\xc4\x4e\xba\xd3\x1b\x67\x32\xed\x74\x3e\x6c\x27\xa5\x82\x49\x48\x42\x4c\x12\x5c\x00\x92\xe5\xf1\xfa\xbf\x4f\x01\x7c\x88\x22\x41\x89\x7a\x8b\xd1\x75\x55\xcf\x44\x04\x08\x02\x07\xc0\xc5\x39\x97\x97\x00\x0e\xe9\x17\xc2\x05\x65\xc1\x25\xa2\x81\x24\x7d\x4e\xb0\xf4\x1e\x7f\x66\xbc\xff\x6a\x64\x63\x2f\x1c\x60\xfb\xc5\x3d\x0d\xdc\x4b\xf4\x2b\xc7\x3d\x1c\xe0\x6b\x2c\x06\x77\x0c\x73\xf7\x85\x4f\x24\x76\xb1\xc4\x97\x2f\x10\x0a\xb0\x4f\x2e\x91\x78\x0c\x5c\x22\xa8\x38\xa1\x41\x8f\xe3\x93\xef\x23\xff\xc4\x4d\xb3\x23\xe4\xe1\x3b\xe2\x09\x95\x1d\x21\x1c\x86\x93\xfc\xfa\x4a\xf2\xe3\x67\xca\x5e\xcd\x4e\x95\x8f\x21\x51\xd5\xed\x71\x2c\x24\x1f\x3a\x72\xc8\xc9\x0b\x11\x12\xa7\x5a\x4d\x7e\xfe\x2e\x58\xf0\x02\x21\xf5\x7f\x97\xe8\xff\x75\xf1\x4f\xfa\x7f\x11\xb2\x70\x10\x30\x89\x25\x65\x81\xb0\x2e\xd3\xcb\x08\x59\x1e\x15\xd2\xba\x44\x7f\xa5\x57\x50\x26\x55\xe7\xb8\x1b\x52\x4f\xbe\x0f\xac\x4b\x64\x1f\x4f\xa7\x28\x94\x04\x1b\x72\x87\x58\x97\xc8\x3a\x39\x49\xb0\x44\x27\x27\x56\x2e\x2b\x09\xf0\x9d\xa7\xb2\x49\x3e\x24\xb9\xb4\x01\x75\x4b\x52\xa8\xc3\x82\x2b\xe6\x31\xae\xca\xe7\xfd\x3b\x7c\xd4\x38\x46\x4d\xdb\x3e\x46\xcd\x4e\xe7\x18\xd9\x2f\xf3\x8f\xf1\xa8\x4f\x55\x73\xec\x46\x23\x97\xa2\xf0\x53\xa5\xbc\x9e\x00\x81\xfe\x13\xbd\xf6\x08\x97\x22\x5f\x8a\x18\xb0\x07\xdd\xe0\x7c\x21\xaa\x87\x54\x21\x29\xe6\x56\x26\xfd\xf9\xb8\x1c\xc3\x69\xa4\x3e\x71\xe6\x13\x39\x20\xc3\xc2\x93\x67\xc1\x44\xc6\x61\x84\x03\x11\x44\x8a\xa3\x90\x33\x87\x08\xd1\x1d\x86\x92\xfa\xa4\x2b\x88\xc3\x02\x57\x3c\x7d\x67\x77\xbf\x7c\xb5\xfe\xf6\x9d\xdd\x7d\xb5\x8e\x55\xa3\x45\x88\x1d\xa2\x2e\xa5\x3f\xbe\x5a\xcf\x7f\xd9\xfe\xb7\x97\xe8\xbf\x51\x23\x5f\x81\x22\xe2\x1a\xe9\x8b\xd3\xe8\xbf\x22\xe2\x09\xae\x7f\x10\x21\x31\x97\xe8\x9a\x48\xe2\x28\x78\x2b\x83\x2a\x24\x09\x55\x09\xb6\x9f\xbf\x45\xe2\xfe\xff\x90\x47\x11\x37\x5a\x15\x7f\x22\x71\xbf\x90\x8b\x8c\xe5\x3b\xc6\x7d\xac\x3a\xde\x8a\xe0\x40\x1a\xa4\x42\x4e\x2a\x3d\x32\xc9\x1a\x57\x79\xaa\x0f\xd3\x7f\x7f\x7b\x91\xeb\x55\xcb\x25\xc2\xe1\x34\xd4\x6d\xbb\x44\x56\x5a\xb8\x45\x5c\x2a\x0d\xdd\x66\xf5\x03\x22\xdf\xbb\xd6\x25\x6a\x9f\x35\xec\xc9\x55\x8e\xc3\xc1\x67\xc6\x3c\x49\xc3\xec\x9c\xb2\xa8\xca\xda\xcc\xfc\x96\x84\xe3\xf8\x69\x76\xe7\xb4\x79\xda\x68\x77\x5a\xed\x4e\xb3\x95\xe6\xf0\x68\x70\x2f\xa6\x26\x6f\x76\xd8\xe9\xbe\x54\x35\x25\x63\x49\x78\x80\x3d\xa4\xf2\x4f\x61\x62\xd1\xc0\xf1\x86\x2e\xf9\x82\xb9\x30\x0c\x3a\xd5\x01\x22\x67\x1c\x54\x87\xc5\x46\xa8\x30\x76\x94\x4d\xca\xa2\xf9\x6d\xba\xb0\xfc\xe4\x11\x93\xbc\x09\xee\xe9\x1d\x56\x88\x03\xe2\xcd\x68\x9c\xc3\x3c\x0f\x87\x82\x28\xd0\x7a\xd8\x13\xd3\x15\xef\x73\xea\x7e\x62\xd3\xa6\x4e\x27\x0c\x0c\x66\xec\x41\xe1\xde\xce\x5d\x1c\x1b\x46\xea\xa3\xba\x56\x36\xe3\xa3\xfe\xb3\x9b\x9d\xa9\x8b\x93\x66\x4c\x83\xc1\x49\x48\xf4\x28\x0c\x86\x9e\x37\x0d\x93\x1a\xa4\x0a\xa7\x7f\x0e\xa9\x73\x8f\xde\x61\x27\x67\xa0\x52\x20\x39\x7b\xc8\x20\x78\x5c\x82\x13\x76\x06\xe4\x33\xf5\x09\x1b\x1a\x1f\xe7\xa8\xc9\xfe\x06\x3b\xf7\x7d\xce\x86\x81\x19\x4d\x9d\xe7\x0b\xf6\x86\x26\xcb\x14\xa5\x1a\x86\x49\x64\x3d\xda\x9d\x63\xd4\x69\x47\xff\x35\x7e\xbe\x28\xd8\x8f\x28\x57\xeb\xec\x18\xd9\xcd\x8b\x63\xd4\x6e\xa8\x6c\xe7\x25\xf9\x3a\x8d\x63\x64\x9f\x35\x8f\x91\x2a\xb5\xf1\xf3\xc5\xd9\xcb\xf2\xe1\x56\xc9\xe4\x5a\x2e\x71\xa8\x8f\x75\x0f\x4d\x0d\x8b\xb2\x49\x1d\xa5\x71\xae\x0d\x64\x11\xa9\x5e\x6a\x5c\x72\xcf\xe9\xe3\x61\x9f\x14\x87\xa3\x8f\xc7\x09\xae\xc5\x25\xcb\xa7\x41\x92\x58\xb0\x99\x03\xf6\x60\xaa\x80\x1a\x1d\x03\x4e\xc4\x80\x79\xee\x07\x4d\x4b\xe6\xe5\xba\xc1\xfc\x9e\xa4\xb3\xbf\x74\x70\xcf\x9c\x50\x2d\xc3\x84\x3a\xad\x38\x9f\xec\xd2\x47\x0e\x08\xed\x0f\xe4\x94\xa9\x45\xe9\x3c\x3b\x6d\xe5\xec\x98\x24\x7c\x84\x3d\xd3\x08\x4f\xad\xe4\xf4\xf8\xf0\x71\x18\xd2\xa0\xff\x39\x9a\x4c\x76\x59\x5a\x71\x60\x4f\x03\x90\x59\x02\x47\xaa\xb3\x90\x64\x48\xad\x48\xb9\xf1\x8b\xe2\xe4\x5c\x8b\x73\x6d\x9e\x51\x38\xc7\x41\xbf\x42\xe1\xcd\xe9\xc2\x4b\x67\x87\x8f\xc7\xd7\x58\xe2\x4f\x8c\x06\x52\x14\x47\x9f\xa5\x50\xd4\x89\x37\x4c\x93\x33\xcb\x61\x41\x40\x1c\x49\x5c\xab\x90\xef\xb3\xaa\x91\x01\xf8\x90\x09\xd9\xa3\xe3\x62\x0f\xc6\x09\xef\x58\x20\x6f\xe9\xbf\x74\xf1\x9d\xc6\x7f\xe4\xf2\x70\x62\xbe\x57\x5f\xcf\xde\x7a\x96\xbf\x55\x43\x75\x83\xc3\x0a\x5d\xd7\xe3\xcc\x57\x65\xa8\xba\x17\x41\x95\x51\xc3\xac\x8f\xaf\x5e\x1b\x12\x59\x7a\x63\x45\xcc\x45\x88\xf9\xbd\x47\x03\x83\x1d\xe8\x51\xcf\x9b\xa6\x5d\x2d\xfb\x18\xd9\xf6\xf9\x31\xb2\xcf\x2f\x94\xad\xb3\xcf\x0b\x26\xb1\xa7\x9e\x6d\x9e\xde\xea\x29\xd9\xf2\xa2\xe2\x9a\xca\x7c\x5e\xb4\x0a\x05\x65\xad\x49\xe9\x74\xd4\xd6\xf0\x8a\x79\x43\x3f\x28\xf6\x8a\xc4\xbc\x4f\x64\x05\xc0\x13\x12\xbb\x12\x7b\x2d\x76\xc6\xc4\xee\xc6\xc5\x71\x4a\xf2\xfc\x44\x9b\x09\x21\x71\x20\x8d\xfc\x3a\x63\x45\xd4\x9a\xab\xa1\x6b\x16\xb2\x78\xa4\x4f\x02\x77\xc2\x21\x8b\xcf\xf0\x89\xe4\xd4\x31\xa7\x71\xd2\xd3\x84\xd0\x32\x8c\xa7\x98\x06\xdb\xed\x76\xa3\x51\x71\x40\xa5\x46\x5c\x18\x7a\x24\xa1\x11\x7f\x6a\x84\x73\x89\x1c\x07\x6a\x34\x12\x8d\x45\x71\x19\x4b\x18\x86\xa0\x41\xdf\x53\x2c\x79\xda\xe2\x44\xd6\x26\x3b\x07\xcf\xf3\x73\x50\xe7\xa8\x36\x07\x99\x66\xff\xbf\x2c\x38\xfd\x12\x83\xb7\xd0\x0c\xd4\x37\x7d\x8c\x2d\xaa\x33\xe4\x1a\x00\xa0\x50\x65\x70\x2d\x4c\xa1\x0a\xd0\xac\xca\xa2\x5c\x2c\x35\xf2\xaf\xc5\x7b\xc1\x80\x51\x4d\x5d\x1b\x1b\xae\xad\xc0\xa8\x2e\x9a\xc0\xa8\x4c\x85\x03\xa3\x02\x46\x55\x27\x46\xa5\x7d\x5a\xdd\x25\x58\xd5\x7f\xd9\x8d\x46\xde\x1f\x08\xd4\x6a\x16\xb5\xba\xd5\x1e\xcf\x2d\xd0\xab\xc2\x84\x04\x7a\xb5\x36\x7a\x65\x20\x44\xab\xf0\xab\x22\x5b\x5b\x1f\xbd\x6a\xae\x95\x5b\x85\x84\x3b\xaa\x83\x80\x55\x65\xaf\x8d\xb5\x13\x77\x21\x5a\x15\x39\xa4\x3a\x40\x9f\x4c\x85\x03\x7d\x02\xfa\x54\x07\xfa\x24\x86\xfe\xd1\xf7\x91\xdf\xf5\x89\xcf\xf8\x63\x77\x28\x88\xdb\xbd\x7b\x94\xa4\x22\x7b\x3a\x46\x98\x13\xfc\xcb\x57\x6b\x40\x70\xf8\xd5\x7a\x7e\xa9\xd8\xd4\xab\x5c\xa1\x3e\x1e\xaf\x54\xe6\x0a\xe4\x6c\x0d\xec\x6b\xa3\x0c\xeb\xac\x71\x7c\xd1\x28\xa1\x59\xbf\x11\x1c\x22\xd5\x21\xe6\xd7\x60\xe0\xa4\x02\x16\x05\x2c\x6a\x0f\x59\xd4\xf9\xe2\x2c\xea\x6c\xcd\x2c\xaa\x09\x2c\x0a\x58\xd4\x7a\x58\xd4\x9c\x0e\x4d\x1e\x79\x72\x31\xe7\x6f\xa9\xfa\x34\x80\xd2\xed\x8e\xd2\x05\x2c\xd8\x04\xab\xcb\x14\x7b\xa0\xc4\xee\x23\x0b\x4e\x7e\x4c\x72\x37\xc7\x58\xcc\x7d\xe0\x78\xc6\xe3\x36\xcc\x23\x77\x14\x57\xd7\x9e\x4d\x0c\xec\xe6\xd9\xf4\x7a\xb1\x7c\x5c\xdd\xef\x5f\x6e\xd0\x8d\x9e\xba\x2b\x84\xd5\x61\x8f\x62\x71\x95\x30\xe2\xa7\xe9\x1a\xdf\x45\xe1\x94\x45\xf8\x5c\x2c\x06\x1f\x48\xd0\x97\x1a\xae\x46\x21\x8d\x94\xdd\x56\x85\xe2\x2e\xc9\x64\xa9\x36\xf0\x76\xa1\x9b\x8b\x7d\xec\x91\x9e\xfc\xc0\xfa\x6f\xb0\x28\x38\xa8\xe2\xd4\x1b\x3c\x36\x40\x9f\x24\xd2\xc0\x9c\xc8\x69\x7f\x30\xa3\x60\x9d\x5c\x5a\x72\x94\x9a\x16\xbd\x1c\xa5\x3d\xab\x48\x69\x4d\x03\xb7\x33\x7b\xe0\x4e\x4f\x80\xd8\x1a\x17\x6b\x81\x47\xfd\x92\x65\x36\x99\xb9\xc6\x98\x70\x5f\xc3\x62\x4a\xd0\x88\x98\xca\x8b\x17\x62\xc3\x3d\x92\x49\x5c\xb6\xd8\x6b\x63\x32\x57\x37\x28\x4a\x60\x0c\x24\x56\x09\x0f\xd4\x95\x05\x33\x51\x46\xe3\x0b\x44\xb5\x40\xe5\x12\xe5\x85\xb5\xd2\x2a\x0e\xec\x50\xdd\xcd\xb1\x4b\x87\xaa\xf8\x4e\x31\xcd\x3c\xd7\x38\x09\x5c\xc2\x89\x26\x10\x3d\x8f\xe5\x96\x9d\x68\x1d\xfe\xc7\x88\x70\x4e\x5d\x62\xa8\xb7\x5e\xe4\xcb\xa6\xb8\x90\xd8\xb9\x37\x3e\x55\x2d\xb3\x21\x71\x3f\x44\xc4\xcd\xf0\xc6\x68\xd7\xee\xac\x6d\x32\x94\x02\x1b\xd0\xb9\x56\x7c\xf9\xd7\x2c\x10\x98\x39\x6b\x74\x09\x92\x0e\xf3\x7d\x2a\x65\x8d\xe0\x4c\x6b\x3c\x03\xb7\x37\x1b\xc7\xad\x4e\xbe\x4f\x65\x55\xcb\xb1\xba\x5a\x00\xab\x6a\x24\x39\x67\x43\x54\x73\xde\x45\x72\xd2\xc0\x62\x7c\xf2\x07\xe9\xc7\xdf\x71\x19\x6e\xbc\x1d\xd0\xde\x7c\xfe\xa3\x58\x77\x8e\xfd\xa4\x5f\xa4\xe4\x9d\x56\xe2\x0f\x22\x98\x37\x8c\xbf\x44\x31\x2f\x29\x98\x6b\xc2\x68\x58\x54\x04\xe3\xd2\xb0\x72\xea\xf5\xa4\x9b\x50\x2e\x67\xe8\x0f\x3d\x2c\xe9\x88\x94\x7e\x50\x95\xd2\x33\xfd\xfd\xcc\x74\xdd\xc7\x27\x78\x4c\x8d\xeb\xce\x38\x4e\x28\x7c\xd2\xe6\xdc\x47\xe6\xd4\xc0\x28\xfc\x78\xc5\x29\xbc\xd7\x9f\xb8\x6d\x0c\x77\x95\x2f\xab\xe9\xd2\xf9\xd7\xb7\xd2\xc6\x3d\x96\xb6\xe0\xb1\x1b\x8d\x73\x83\xe3\xd5\xd7\xf3\xc9\x20\xb4\xa7\xbf\x69\x9a\x1e\x23\x8f\x78\x5c\xc5\xf9\x35\x99\x5c\xa6\x87\xa8\x39\x83\xef\x88\xc9\x2f\x17\x25\x96\x91\xb9\x94\xb2\x18\x6f\x8b\x48\x4b\xa3\x38\xbd\x26\xd8\x2e\x64\x8a\x32\x5f\x63\x68\x50\xb6\xd8\x08\x63\x52\x69\x3b\x66\xf5\x96\x69\xfc\x62\x8f\xf6\xcb\x26\xa3\x4e\xfb\x40\x46\x69\xc3\x5e\x98\x1e\x04\xba\x66\x72\x15\x74\x8d\x29\x8c\x74\x9e\xae\xe9\x80\xae\x01\x5d\x13\x27\xd6\x4f\xd7\xac\xd3\xf9\x6a\xd6\x24\x20\x7c\xf6\xc0\xd9\x5d\x6f\xed\xb3\x23\xd0\x7e\x7c\xf9\x93\xbc\x78\x00\x09\x04\x12\x28\xf9\x03\x09\x04\x12\x08\x81\x04\x3a\x54\x09\x64\x8c\xf9\x9e\xa7\x81\x4e\xab\x69\x20\x35\x1c\x5f\x8b\xcf\x71\x0f\x1a\x47\x2c\xc8\x24\x90\x49\xfb\x23\x93\xe0\x7d\xcf\x6a\xb2\x07\x44\xce\x9e\x41\xb4\x3e\x49\x53\x11\x9c\xe4\x6b\xcc\x18\x9c\x91\x10\x0b\x81\xb3\x34\x36\xa5\x7b\xe9\x2d\x87\xdb\x48\x18\x9e\x51\x69\x66\x5e\x6f\x1c\x53\xbe\x2d\x4c\x97\xc0\x8d\x9b\x70\x4b\xb1\x79\xbb\x71\x6c\xc2\x3d\xc6\x26\x9c\x89\xcd\xbb\x8d\x63\x23\x1e\x70\xb8\xb7\xe0\xa8\xca\xcd\x40\xe7\xd7\xad\xa0\xb3\xcf\xa3\x27\xae\xdf\x0c\x8c\x7e\xdb\xbb\xd9\x85\xfe\x8e\xea\x34\x06\xc3\xc1\xa3\x40\x47\xa1\x10\x7f\x57\xd5\x34\xac\xd3\x29\xd4\xef\x7f\x00\x5f\xe0\x67\x2d\x7b\xa6\xd3\xc1\x11\x98\x34\x18\x1c\x81\x0b\x38\x02\x0d\x7c\x08\xdc\x80\x75\x75\x03\xee\x2a\x10\xde\x6e\x96\xce\x8c\x38\x12\xfe\x7c\xda\x99\xb1\x62\x24\x3c\x15\x0e\xc4\xc1\x83\xb3\x74\xe5\x38\x78\xbb\x35\x67\xdc\x36\x2a\x7a\x4b\xc1\x15\x3a\x75\x19\x5c\xa1\xd3\xd6\x69\x61\x57\xe8\xa3\x90\xc4\xef\x3a\xe1\xb0\x3b\x14\xb8\x4f\xb6\xe4\x81\x31\x0c\x1d\x13\x1b\x2f\x2e\xa8\x05\xc1\xa3\xeb\xbf\x3f\xfe\xd1\x44\xc9\xd4\x16\xd0\xb8\x01\x33\x9d\xa5\x4b\x21\x83\x47\xfd\x2e\x1b\x11\xae\x37\x5f\x3b\x5a\x16\xa7\xbf\xec\xc1\xb7\xe5\x7d\xa3\x1b\x02\xeb\xc4\x1e\xcc\xf4\x9f\x56\x24\x71\x3b\x94\x7a\x57\x9f\xfe\x04\x91\x57\x73\x91\x67\xd2\x2e\x6b\xd2\x78\x65\xe7\x00\x20\x64\xda\xed\x63\x18\xd0\x19\x1a\x6a\x29\x19\x68\xd9\x06\x13\x0f\x2a\x70\xd7\x2a\x10\xf4\xcd\x21\xea\x1b\xe3\xb6\xca\x73\xf4\xcd\x45\x0b\xe4\x0d\xc8\x9b\x38\x71\xeb\xf2\xc6\x63\xd8\xed\xe2\x11\xe1\xb8\x4f\xba\xb6\xbf\x87\x6f\x15\xa2\x8a\x9e\x14\x4e\xf9\xda\xa1\x94\xd9\x64\xf3\x57\x15\x13\x19\xd9\xea\xb0\x61\x20\xf7\xb0\x47\x9d\x70\x58\x73\x51\xf0\x81\xe1\xfc\xbe\x33\xa0\x0a\x92\x06\x83\x2a\xa8\xaa\x0a\xe6\xb0\x69\x78\x2d\x64\x68\x04\x08\x82\x6c\x1a\x08\x82\xfd\x16\x04\xe6\x1d\xc1\xe7\x28\x82\x56\x13\x14\x01\x28\x82\x38\x71\x7b\x8a\xe0\xfb\xc8\xef\x2a\x4e\x85\x5d\xd1\xf5\xe8\xa8\xaa\xdf\x19\x31\x8e\xf2\xb7\x26\x3f\xf6\x90\x7c\xaa\xea\xed\x8f\x92\xc8\x02\xe7\x62\xe2\xb3\x60\x49\xd4\xa3\x9b\xf7\x18\xf7\xa8\x82\x4b\x22\xbf\x81\x28\xf4\x2c\x78\x21\xc1\xf7\x4b\xe2\xae\x6e\xdd\x63\xd4\x55\xf5\x76\x13\xc0\xbe\x65\x4c\xcc\x03\x68\x19\xc4\xe6\xbe\xe5\x5b\x24\x42\xbd\x94\x7f\xee\x50\xbc\x7e\x8e\x7a\x06\xf4\x2b\xe8\xd7\xf8\x6f\x96\x7e\x2d\x8a\xc6\x1d\x8a\x3f\x50\xb0\x93\x3b\x76\xad\x60\x73\x53\xca\x63\xce\xbd\x36\x02\xd6\x4f\x77\x3d\xfb\xae\x70\x22\x9c\x15\x90\x07\x9d\xda\x73\x48\xd3\x2d\xcc\x2c\x3e\x0c\x82\x58\x8b\x5a\x3f\x9d\x91\xbb\xe6\x69\xfe\xab\x34\x4b\x12\xee\xd3\x00\xcb\xf8\x29\x1d\xdb\x3e\x6b\xe7\x77\x45\xd7\xb3\xd6\x3d\x79\xc0\x54\xd2\xa0\xaf\xf3\x39\x76\xc7\xb1\xcf\xf2\xf9\xb2\x39\x08\xbe\x3b\x6f\x5d\x94\x9b\x9e\xdd\xa8\xf3\x52\x9d\xbd\xba\x28\x35\x1e\xb0\x30\x2f\x0c\xaf\xea\x86\xb4\xfb\xf4\xd1\xb2\xd6\xfa\xb7\xa5\xa1\x2d\x20\x6c\x0f\x4e\xd8\x0a\x89\x25\xd9\x3a\x3b\x5d\x80\x7f\x3e\x3d\xe9\x2a\x3e\x1b\x1e\x9a\x11\xa2\x75\x61\x99\xe8\x56\xe3\x5d\x91\x6b\xae\x4c\x24\x69\xe0\xd2\x11\x75\x87\xd8\x5b\x8e\x48\xd6\x85\x2e\x2e\xfa\xfd\xc9\x9e\x73\x1c\xe0\x6a\x5b\xe5\x6a\x2e\xb9\x1b\x46\xcc\xc7\x7e\x77\x76\x7e\x95\x0f\x20\x4b\x7d\xff\xd6\x4f\x6f\xde\xd9\x6f\x8a\x4c\x8e\x06\x3d\x16\x51\xb0\xc6\xf9\x69\xbb\x59\xa0\x60\x1c\x47\xcc\xe6\xa7\xd3\xb7\xd7\x8d\xb7\x85\xdb\x1f\x30\xd7\xa7\xb4\xfc\xf4\xf6\xf5\x9b\x3d\xe4\x5d\xf0\x56\x64\xad\x6f\x45\x0a\x64\xb3\xec\x3b\x90\x66\xa3\xf4\xa1\xb3\xcf\x9f\xb7\x77\xcc\x4b\x07\xd4\x25\x6f\xfd\x50\x3e\x96\xdc\xac\xd2\xff\x97\x70\x56\x92\x0c\xb4\xb6\x22\xad\x2d\x3c\x71\x5b\xac\x76\xf6\xea\xa4\x4d\xad\x2a\x2b\x32\x0e\x85\xd5\x22\xb1\xfd\x8b\x9d\xf3\x96\x96\xaa\xad\x65\xd5\x42\x4b\x17\xa0\x7d\xa1\xe2\x34\x70\x38\xc1\x82\x1c\x79\xac\x7f\x87\x9d\xfb\x2e\x19\x91\x40\x8a\xae\x1e\x97\x95\x3f\x74\xf0\x57\xf8\xd0\x61\xed\x0e\xe3\xa7\x27\x4f\xad\xd2\x26\xc2\xbe\xea\xb9\xf3\xcd\x3a\xb8\x93\x3f\xb0\x3e\x7a\xab\x7b\x11\x1d\xd9\xfe\xcb\x3d\x72\x2b\x57\x52\x03\x15\x8f\xdc\x07\xb7\x73\xe6\x91\x87\xe6\x76\xb6\xf2\x1c\x16\xc4\x0c\x84\x4e\x81\x48\x58\x9f\x48\xa8\xec\xa5\x9e\x21\x12\x34\x8a\xa7\x15\xc5\x00\x84\x4e\xad\x44\xc5\xc1\xc3\x1c\x65\xcc\x07\x3d\xb0\x90\x04\xdd\xde\xe6\xfd\xca\x8b\x7d\xad\x3b\x9f\xc3\xaa\x7a\xef\x4f\x50\x54\xba\xe7\x15\x1e\xd7\x11\x4c\xe3\x9e\x91\xbb\x0a\x73\x4a\xb0\xec\x51\x8f\x44\xe3\x73\x81\x40\xa7\xe2\xcd\xd1\x3f\xf7\xf0\xb5\x89\x79\x04\x97\x7e\x54\xb2\x14\x7a\x3e\x1e\x2f\x0d\x9e\x1e\xcb\x7b\x8a\xdd\xec\x4d\x4e\xaf\x6b\xf0\xb2\xe9\x1d\xf5\x08\xba\x26\xc2\xe1\x34\x94\x8a\x26\xee\x8f\x08\x85\xd8\xa6\x83\x14\x99\xc5\xa2\x21\xb8\x69\x9f\x35\xe6\xae\x76\x6d\x6b\x9e\xcd\x16\x34\x76\xf3\x62\x9a\x4b\xcf\xdb\xb5\x4d\x11\x75\x41\x85\x24\x81\x43\xa2\x2f\x40\xf3\xd6\xb0\x78\x9e\x39\xfa\xc4\x98\x27\xd0\xd1\x6f\x24\xbf\x6d\x27\x6c\xea\x36\xb9\x08\x42\xfd\x12\x55\x7e\x99\x77\x3e\x7b\x58\x57\xdc\xf3\x00\x62\xc9\x62\x80\xd6\xa6\xf4\x7d\x1a\xdc\x86\x38\xc8\x77\xe5\xbe\x7a\x00\x26\x66\x2d\x73\x62\x40\xc8\x98\xd7\x1d\x14\x8e\xe1\x12\x0e\x0b\x89\xfb\x05\x9b\x42\x1e\x8c\x77\xe7\x73\x69\x8f\x83\x47\x1c\x69\x5e\x05\xa2\xbe\x24\x63\x5d\x9d\x4f\xb7\xe8\xad\x4b\x02\x74\xab\xf8\x7a\x71\x81\xd5\xdd\x5a\xcc\x57\xba\xfa\x3d\xd7\xd3\xef\xb1\xd2\x89\x8a\xd4\xd5\xb9\x0c\x3d\xb3\x71\x35\xbf\xb6\x97\x7f\x1b\x39\x82\xc4\x3e\x5f\xe1\x9b\xa6\xd5\x4e\x5d\xfc\x11\xfa\x24\x6a\xfa\xd2\xfd\x52\xee\x84\x59\x47\xbf\x2c\x7c\xa4\xe3\x8f\xd0\x23\xcb\x7b\xc4\xca\x3f\x50\x2b\x76\x46\xa9\x98\xd8\xa1\x63\xc2\xd8\x71\xe0\x9d\xa8\xb9\x77\x62\x2f\x36\x8d\x87\xaf\xab\x8c\x8d\xa8\xab\x03\x02\xb4\xf3\x21\x6a\x67\xe3\x3b\xee\x39\xda\xd9\x6e\x9d\x83\x7a\x8e\xd2\x40\x3d\x57\x53\xcf\x05\x82\x12\xa5\xbc\x97\x84\xe3\x98\x5e\xd8\x9d\xd3\xe6\x69\xa3\xdd\x69\xb5\x3b\xcd\x96\x21\xef\x27\x1c\x10\xef\x7d\xd1\x77\xb3\x6d\xd5\xfd\x0f\xcf\x45\xbf\x9a\xde\xf5\x65\x25\x77\x92\xa9\xd4\xd8\x83\xde\xae\xa7\x92\x00\xbd\xbd\x7f\x7d\x02\x7a\x7b\xdf\x7a\x04\xf4\x36\xe8\xed\xe8\x32\xe8\x6d\xd0\xdb\xa0\xb7\x0d\xb0\x81\xde\x3e\x48\xbd\x6d\x9b\x76\xe8\x9f\x2f\xb8\x2f\x40\x70\x47\x69\x20\xb8\x0f\x4e\x70\xdf\x0e\xf9\x88\x8e\x18\xaf\xf2\xaa\x3b\x97\xb7\xd4\xf6\x83\xfc\xae\xa7\xb0\x00\xf9\xbd\x7f\x7d\x02\xf2\x7b\xdf\x7a\x04\xe4\x37\xc8\xef\xe8\x32\xc8\x6f\x90\xdf\x20\xbf\x0d\xb0\xed\x2c\xde\xbe\xd5\x99\xa7\xf5\xa6\xd9\xe5\xaa\xa7\xa4\x4f\xc5\xd7\x7f\x64\xc1\x09\xc4\xd8\xc7\x29\xe0\xb7\x58\x31\xc6\xbe\x75\x3a\x7b\x28\x9f\x41\x98\x40\x9c\x06\x5e\x8b\x25\x83\xec\x03\x16\xac\x16\x67\x9f\x14\xb0\x8a\x0f\xe2\x86\x48\x2c\xe6\xf8\x1e\x26\x79\x4a\x17\x3c\xf0\x39\xe4\x3a\xa5\x3e\x82\xea\x30\xdc\x0e\xb5\xeb\x96\x43\xf0\x3c\xd4\xae\x53\xc0\xf9\x30\x7b\xf5\x02\xff\x43\xda\x60\xf0\x3f\x80\xff\xe1\x50\xfd\x0f\x20\xa3\x0f\x51\x46\x9b\xc2\xed\xe7\xc9\x68\xbb\xdd\x00\x1d\x1d\xa5\x81\x8e\xde\xc1\xdb\xff\x9c\x1b\x67\xdb\xea\xfb\x8a\xf9\x21\x27\x42\x10\x17\x5d\x79\x58\x88\xb9\x61\x00\x25\x37\x94\x2e\x04\xa0\xcb\x6b\xab\x35\x40\x97\xef\x65\xb7\x80\x2e\xdf\xc3\x4e\x01\x5d\x0e\xba\x3c\xbd\x0c\xba\x1c\x74\x39\xe8\x72\x03\x6c\xa0\xcb\x0f\x52\x97\x1b\xc3\xf2\xe7\x0b\xf3\x5d\x9f\xfc\x04\xc2\x1c\x84\xf9\xa4\xe7\xb6\x2e\xcc\x5d\x82\xae\xb0\x33\x98\x2d\xc6\xd3\x4c\xa5\x16\x1f\x04\x78\x6d\x45\x05\x08\xf0\xbd\xec\x16\x10\xe0\x7b\xd8\x29\x20\xc0\x41\x80\xa7\x97\x41\x80\x83\x00\x07\x01\x6e\x80\x6d\x67\x81\xf9\xed\xd6\x1c\xb5\xd7\x9a\x56\x26\x2b\x04\xe6\xff\x8a\xf9\x1d\xee\x13\x74\xc5\x3c\xc5\xb5\x95\x59\x33\x1a\x9a\xba\xc6\xe3\x97\xba\x1e\x36\x12\x86\xde\x6e\xcf\xee\xb8\x8b\x8a\x61\xe8\x15\x24\xb8\x29\x35\x9a\x88\xc6\x94\x4d\x1d\xca\xa6\x13\x4b\x1b\x0d\xa7\xb2\xed\x8b\x42\xe4\x58\x92\x23\xc5\x7d\xfa\x4e\x37\xc4\x43\xa1\x98\xa8\xc3\x02\x57\x44\xe7\x62\x6c\xe5\xb8\xe1\x75\x1f\x2e\xf6\xf4\x84\xb5\xc5\x7a\x7e\x46\x47\x4f\x4f\x8e\x6a\xd5\xf3\xb3\xa1\x7a\x19\x9d\xb7\xff\x04\x75\x62\x88\xab\x9e\xdc\xb4\x9d\xb3\x81\x67\x10\xce\xba\xd0\xca\x45\xc9\x1e\x0b\xb7\x4a\xf5\x76\x72\xb2\xae\x41\xeb\x1d\x24\xd5\x3b\x28\xea\x62\x0a\xfd\x9b\x47\x5d\xec\xc6\xfa\x8e\x93\x05\xee\x02\xdc\x65\x2d\xdc\x45\x0c\xfd\x45\x98\xcb\xab\x1a\xb0\x20\x21\x71\xd9\x1c\x31\xb1\xa4\xa2\x89\xce\xb3\x24\x3c\xea\xa3\x95\x99\xd2\x9c\xe5\x26\xeb\x5a\x2d\x40\x5b\xfd\xa8\xd0\xda\x60\xea\xe3\xf1\xe2\x98\xbe\xa9\x01\xfb\xfc\xa4\x9a\x81\xae\x87\xd1\x2b\x40\x60\xa0\x3b\x66\xa0\xc0\x3f\x17\x6d\x04\xf0\xcf\x6c\xda\x9e\xf2\x4f\x63\x88\xcb\x5c\xdf\x59\xc5\x8d\x27\x81\x7f\x02\xff\xdc\x1c\xff\x8c\x5f\x1d\x62\xcf\x63\x0e\x4e\xdf\xe9\x77\x75\x7f\x6f\x85\x3e\x2e\xf8\x2e\xb8\x02\x3f\x4c\x9a\xb2\x7e\x3a\x68\x00\x2e\xe4\xcc\x67\x3f\x04\x6e\x49\x4b\x6a\xce\xf8\x5e\x27\xdd\xff\xea\x93\xa9\x41\x40\xfa\xb6\x4e\xfa\xb6\xfe\x8e\x79\x27\xc4\x0f\xde\x32\x27\x77\xef\xea\x2d\x73\xc7\x9e\x4d\xb8\xec\x56\x73\x7a\xa1\x5f\xfe\x2d\xb3\xfe\xf8\xd1\x63\xd8\xa5\x41\xff\xc7\x7a\xbf\x0c\x01\xf1\x6b\x0d\x88\xb7\x9b\x55\x07\x6f\x73\xf6\xe0\x6d\x9d\x81\x5a\x40\xa0\x16\xd2\xc4\xed\xc6\x62\x3b\xca\xde\x11\xd1\x55\x26\x8f\xb8\x15\xf9\x2d\x62\x1c\x15\x6f\x4e\x7e\x6e\xda\x85\xba\xc4\xfb\xf7\xa8\x82\xeb\x8f\xb9\x6e\xd6\x21\x90\xf4\x2a\xea\x16\x64\x00\x01\xc2\x47\x27\x0d\xae\x4b\xf8\xa8\x89\xad\xae\x3d\x7a\x74\xeb\x94\x18\xa2\x47\x27\x77\x80\x4b\x17\xd8\xea\x36\xd8\x6a\xe1\x62\x25\xba\x0a\x81\xa1\x93\x04\xa0\xab\x5b\xa4\xab\x2e\xf1\x24\x3e\x5a\x9a\xb4\xfe\xd5\xf1\xbf\xbd\x54\xcc\xb5\xac\x9c\x05\xf9\xab\x2e\xaf\x26\x5f\x51\xe9\x26\x1f\x32\xfd\x8d\x3a\x1d\x1d\x75\xfc\xfc\x2e\xfe\x40\x80\x93\x06\xd7\x85\x00\x17\x83\x5d\xd7\x44\x7f\x5d\xe2\x50\x1f\x7b\x66\x7c\xea\x13\x2a\x00\xfc\xf8\x87\xf1\x7b\x5f\xcc\xe6\x62\x76\x6b\x7a\xaf\x83\x15\xfc\xde\x6f\x86\xbd\x1e\xe1\xd1\x91\x27\xe0\xf7\x06\x25\x51\xaa\x24\xf2\x01\x31\x65\x63\xf7\xb4\x31\x47\x47\xb4\x40\x47\x20\xd0\x11\x69\xe2\x76\xdd\xde\x77\xda\xdc\xad\xb8\x13\x89\x4b\x39\x71\xe4\x96\x5d\xd9\x1b\xd9\x3c\xa4\xd9\x5e\x61\x87\x8a\x18\x4b\x3d\x47\xba\x0e\x0e\xb1\x43\xe5\x63\x5d\xe0\x4c\xea\xbb\xf6\x4d\x3f\x8a\x90\xee\xa3\x38\xba\xd6\x98\xa3\x68\xf5\xaf\x1a\x44\x0d\xd2\x28\x93\xb6\x57\xd2\x68\x2b\xef\x06\x60\x67\x89\x43\xd2\x3e\xc0\xe8\x0f\x91\xd1\x9b\xc2\xde\xe7\x31\xfa\x73\x60\xf4\x93\x04\x60\xf4\xbb\x61\xf4\x0b\x7c\x1d\x99\xa7\x9d\x49\x54\x4b\xb6\xa4\xf8\x47\x1d\x88\xac\xaa\xee\x16\x84\x01\xb0\xd8\x38\x0d\x58\x6c\x0d\x59\x6c\xc6\xc5\x5f\x64\x95\x10\xfe\x02\x14\x17\x28\xee\xa1\x50\x5c\x63\xf4\xcb\x5c\x8e\xdb\x01\x8e\x8b\x80\xe3\xa6\x89\x35\xf4\x5a\xfb\x58\x55\x0c\xbc\xd6\x6b\xf2\x5a\xef\x04\xce\x03\xf7\x5a\xdf\x68\xcc\x81\xef\x03\xdf\x07\xaf\x35\x50\x7a\x03\x6c\x40\xe9\x0f\x93\xd2\x9b\xb6\x0b\x9c\x4b\xe9\xdb\x40\xe9\x11\x50\xfa\x34\xb1\x26\x6e\xeb\x94\x77\xae\xcd\x6d\xbd\x1b\x26\x7b\xc0\x6e\x6b\xa0\xb1\xf1\x65\xa0\xb1\xe0\xb6\x06\x8e\x5b\x97\xa8\xf4\xd3\xb3\xd9\x84\xca\x6e\xe5\x16\xf2\x92\xa8\xf4\xd4\x0e\xfe\x7e\xfd\xe6\xaa\x2e\x31\xe7\xeb\xd9\x6a\xb0\xf2\xe6\x21\xa7\xe7\xf3\xb0\xae\xb8\x7b\xc8\x7a\x4e\xd3\x04\x82\x0b\x04\x77\x91\x2f\x36\xb1\xc4\xb7\x5a\x18\x77\x1d\x16\x04\xf1\x61\x11\x5d\xec\x28\x6a\xf1\x54\x4a\x47\xb3\xa4\x75\x15\x32\xba\xfe\x0d\xaa\x75\xc5\x67\x30\xd2\xab\xe5\x76\x1f\x2c\xc1\xc9\xc7\xe3\xcd\x83\xb4\xcc\x8e\xd2\x35\xdf\x48\xf0\x1a\x4b\x7c\x87\x05\x41\x19\xb0\x61\x2b\xc1\xf5\x12\xe8\x7a\xf3\x5a\xd8\x67\x70\x3b\xcc\xf6\x45\xee\x71\xca\x8e\x28\xb3\xa0\xd0\x6a\x35\x26\x93\xd2\x12\xce\x80\xf8\xf8\x0b\xe1\x22\x3e\xef\xf9\x74\x92\x24\x1f\xa3\x69\xed\x62\x7e\x3f\xb9\x43\xe2\xfe\xf4\x00\xb4\xc4\x63\xe0\x12\x41\xb3\x53\xdd\xa2\x41\x8f\x63\xab\x50\x0d\x49\xfc\x50\x09\xe0\xa0\x3f\xd5\x6c\xcb\xa3\x42\xe6\x46\x75\x01\x14\xef\x4b\x7c\x8c\xb3\x61\x52\x4d\x98\x54\x61\x98\x24\x07\x44\xfb\x8f\x21\x67\xdf\x89\x63\x18\x28\xe9\xf9\xd0\x93\x3c\xb3\x46\x62\x35\xbf\xb4\x9e\x85\x3d\x1a\xd0\xd8\xa1\x10\x0d\xc7\x6e\x34\xc9\x8f\x86\xe1\x93\x20\x7c\x44\xa3\xf5\x27\x5a\x6c\x8e\x51\xba\x0e\xe5\xf7\x3f\x48\xb6\x35\xc8\xdb\x40\x1a\x38\xde\xd0\x25\xaf\xbd\x32\x6a\x97\x7e\x39\xfd\x31\x29\x3a\x5f\xb2\x3f\xf4\x24\x2d\xb9\x3b\xb6\x62\x56\x50\x76\x33\x0b\xa5\x71\xc1\x40\xc8\xfa\xbf\x21\xe1\x8f\x2b\xb7\x7b\x32\x6e\x0b\xee\x71\xd2\x27\x63\x83\x23\xcb\x12\xf7\x34\xfc\x93\x7b\xb7\x8f\x81\x53\xc6\x92\xa3\x05\x25\x5f\xa2\xc4\x7d\x3d\xc4\xc4\x3f\x93\xaa\xe7\x8b\x4e\xc6\x7e\xbe\xad\xea\x7a\xf9\x4d\xf1\xaa\x13\x01\x92\x4b\x1c\x0a\xf2\x39\x2a\x74\x26\xf7\x5e\xd7\x64\xc8\x1c\xa7\x5e\x5c\x80\x32\x93\x25\x99\xd2\x27\x3e\x31\xed\x6f\x91\x4e\x98\xe9\x7c\x33\x27\xcd\xca\x23\xf8\x75\x18\x7a\xd4\xc1\x85\x03\x2a\x2b\x8e\xe1\xef\xec\x6e\xc6\xe8\xcd\xb5\x30\x0f\xdc\x7c\xe8\x2a\x83\x57\x11\xbe\x02\x80\xf3\x2a\x65\x24\xe3\xa6\x5a\xa9\xf9\x47\x78\xb5\x7a\xc5\x79\xf3\x35\x9b\xfa\x5d\x3a\xf1\xa7\x5a\x77\x8c\x66\x57\xa0\xc2\xb4\x9d\x78\x51\x85\x64\x7e\x29\xcd\x2b\xce\x95\x09\xc7\xee\x7b\xc5\x51\xb0\xf2\xca\xf2\x3a\xa7\x5d\xa7\xb1\xfc\x5b\xb7\x8b\x3d\x6f\xe3\x0b\x4a\xe6\x50\xeb\x85\xe3\x97\x30\x27\xf8\x97\xaf\x56\x7c\x80\xf9\x31\x75\x97\x5a\x7e\x0c\x8c\x36\x9d\xbb\xbf\x7f\xb9\x41\x37\xba\x76\xd1\x4e\x08\xe8\xb7\xfc\x81\xdb\x73\x66\xb1\x4e\x9b\xdd\x8f\xe9\x44\xcf\x1d\xef\x5d\x38\xdb\x7b\x99\x75\x6b\xb3\xf0\xc2\x2a\xa7\xff\x60\xe6\x2e\x39\xb4\xe2\x13\xec\xb7\x35\x79\x3f\xb2\xe0\x64\x8b\x13\xd8\x74\x3e\xff\x8e\xe6\xf0\x6c\xa0\x37\x39\x8d\xf3\x8e\xed\x3d\x9b\xc6\xe9\xbf\x13\xd7\xc5\xf3\x44\xf3\x51\xdd\xad\x19\xb5\xd7\x8b\x5c\x50\x56\xc0\x1e\x4e\x5a\x59\x47\x8c\x25\x59\x7c\xdd\x32\x16\x13\x52\xe7\x5e\x3b\x6f\x33\x85\x05\x06\x77\x4a\xd2\x13\xdd\xc4\x0d\x97\xe7\x78\x56\x27\xe7\x98\xb2\x1b\xb9\x0b\xad\xfc\x05\xdb\x9f\xfe\xdd\xc9\xfd\xb6\xf3\x17\x5a\x8d\x7c\x8e\x9c\xcf\xa9\x99\xfb\x6d\xbb\x13\x5b\xf3\x2d\x0b\x0a\xf5\x49\xd7\xcc\x55\xe7\xd7\x22\xff\xd0\xd3\xfc\x43\xf3\xb5\x68\xb6\xf3\x17\x72\x5b\x1b\x9f\xb9\xf9\x76\x66\xeb\x6d\xec\xb7\x7f\x31\xed\xf9\xb6\xee\x38\x7b\x10\x19\xea\x37\x71\x1d\xde\xc6\xe4\x10\x9d\xa0\xf7\x41\x8f\x63\x74\x82\x7e\xff\x72\x33\xc9\x38\xd4\x6f\x65\xac\xe0\xa2\x3d\x1e\x9f\x79\xfe\x84\x56\x5b\xa3\xd4\x77\xd1\xd1\x97\x9e\x5f\xfc\x3b\x00\x00\xff\xff\x1c\xd0\xdc\x9a\xb6\x88\x01\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x6d\x73\xdb\xb8\x76\xfe\xae\x5f\x81\x41\xda\x4e\xdc\x4b\x67\x45\x59\x6f\xf6\xcc\x76\x26\x71\xe2\xbd\x69\x93\xbd\xe9\x3a\x37\x1f\x9a\x64\x34\x10\x09\x49\x88\x49\x80\x03\x80\x8a\xdc\x54\xff\xbd\x03\x92\x92\x45\x12\xa4\x29\x4a\xb2\x24\x0b\x9b\xd9\xdd\x50\x07\xaf\x0f\x80\x83\xe7\x39\x04\x49\x14\x90\x2f\x98\x0b\xc2\xe8\x15\x20\x54\xe2\x31\xc7\x48\x7a\xf7\xaf\x18\x1f\xff\x36\xb5\x91\x17\x4c\x90\xdd\xb8\x23\xd4\xbd\x02\x7f\x70\x34\x42\x14\xbd\x45\x62\x32\x64\x88\xbb\x0d\x1f\x4b\xe4\x22\x89\xae\x1a\x00\x50\xe4\xe3\x2b\x20\xee\xa9\x8b\x05\x11\xe7\x84\x8e\x38\x3a\xff\x31\xf5\xcf\xdd\x65\x72\x00\x3c\x34\xc4\x9e\x50\xc9\x01\x40\x41\xf0\x90\x3e\xfa\x65\x71\xf1\x8a\xb0\xdf\xca\xad\xf2\x3e\xc0\xaa\xb9\x23\x8e\x84\xe4\xa1\x23\x43\x8e\x1b\x22\xc0\x4e\xb5\x96\xbc\xfa\x21\x18\x6d\x00\xa0\xfe\x77\x05\xfe\x2f\xaa\xfc\x57\xf4\x5f\x00\x20\xa2\x94\x49\x24\x09\xa3\x02\x5e\x2d\x7f\x06\x00\x7a\x44\x48\x78\x05\xbe\x2e\x7f\x79\xc8\x94\xa4\x18\x86\xc4\x93\xef\x29\xbc\x02\xb6\x95\xb6\x28\x94\x04\x0b\xb9\x83\xe1\x15\x80\xe7\xe7\x0b\x2c\xc1\xf9\x39\xcc\x24\xc5\x14\x0d\x3d\x95\x4c\xf2\x10\x67\x6c\x13\xe2\x16\x58\x88\xc3\xe8\x35\xf3\x18\x57\xe5\xf3\xf1\x10\xbd\x6c\x5a\xa0\x65\xdb\x16\x68\x75\x3a\x16\xb0\xcf\xb2\xd5\x78\xc4\x27\xaa\x3b\x76\xb3\x99\xb1\x28\xfc\x54\x29\xaf\x1f\x80\x00\xff\x06\x5e\x7b\x98\x4b\x91\x2d\x45\x4c\xd8\xcf\xa8\xc3\xd9\x42\xd4\x08\xa9\x42\x96\x98\xc3\x15\xfb\xdc\x2a\xc6\x30\x8d\xd4\x27\xce\x7c\x2c\x27\x38\x14\xeb\xc0\x84\x67\x41\x8c\x03\x16\x58\x8a\x97\x01\x67\x0e\x16\x62\x10\x06\x92\xf8\x78\x20\xb0\xc3\xa8\x2b\x7e\xfd\x60\xc3\xdf\xbf\xc1\x7f\xf9\xc1\x86\xdf\xa0\xa5\x3a\x2d\x02\xe4\x60\xf5\xd3\xf2\xe2\x1b\x9c\x7f\xb5\xfd\xef\x67\xe0\x3f\x40\x13\x3e\x8a\x78\x84\xf4\x65\xd7\x02\x97\x5d\x1d\xe2\x0b\x5c\xff\xc2\x42\x22\x2e\xc1\x5b\x2c\xb1\xa3\xe6\x59\x65\x50\x85\xc4\x81\x2a\xc1\xf6\xb3\x59\x24\x1a\xff\x17\xbe\x17\x49\xa7\x55\xf1\xe7\x12\x8d\x73\xa9\xf0\x4c\xde\x30\xee\x23\x35\xf0\x30\x86\x03\x44\x20\xe5\x52\x12\xe9\xe1\x87\xa4\x49\x93\x53\x63\xb8\xfc\xfb\xf7\x46\x66\x54\xa1\x8b\x85\xc3\x49\x10\xf5\xed\x0a\xc0\x65\xe1\x10\xbb\x44\x6a\x86\x0d\x8e\x29\x96\xef\x5d\x78\x05\xda\xbd\xe6\x72\xe9\xc0\x31\x47\xc1\xe4\x33\x63\x9e\x24\xc1\xea\x9a\x82\x44\x25\x6d\xad\x5c\x4b\xcc\x51\x52\x9b\xdd\xe9\xb6\xba\xcd\x76\xe7\xa2\xdd\x69\x5d\x2c\x53\x78\x84\xde\x89\xd4\xe2\x5d\x9d\x76\xd1\x58\xaa\x7e\xe2\x99\xc4\x9c\x22\x0f\xa8\xf4\x29\x4c\x20\xa1\x8e\x17\xba\xf8\x0b\xe2\x42\x33\xe9\xa0\x44\xe3\x74\xf9\xea\x0f\x5c\x38\xa1\x54\x51\xaa\x3e\xe5\x93\x56\xd1\xfc\x6e\x35\xca\x16\x8f\x78\x48\x3b\x6f\x64\x72\xc0\x00\x51\xec\x95\x74\xce\x61\x9e\x87\x02\x81\x15\x68\x23\xe4\x89\x74\xc3\xc7\x9c\xb8\x9f\x58\xda\xd5\xa9\x3f\x70\xa2\x71\x63\x3f\x15\xee\xed\xcc\x8f\x33\xcd\x4c\xbd\x57\xbf\x15\xad\xf8\x78\xfc\xec\x56\x27\xf5\xe3\x43\x37\xd2\x60\x70\x1c\xe0\x68\x16\xd2\xd0\xf3\x52\x96\x68\x92\xaa\x61\xfb\xef\x90\x38\x77\xe0\x06\x39\x19\x07\xb5\x04\x92\xb3\x9f\x2b\x08\x5a\x05\x38\x21\x67\x82\x3f\x13\x1f\xb3\x50\x5b\x9d\xa3\xdc\xeb\x1b\xe4\xdc\x8d\x39\x0b\xa9\x1e\xcd\x28\xcd\x17\xe4\x85\x3a\xcf\x14\x5b\x35\xd3\x24\xf2\xd7\xad\x76\xc7\x02\x9d\x76\xfc\x6f\xf3\xd5\x65\xce\x7f\xc4\xa9\x2e\x7a\x16\xb0\x5b\x97\x16\x68\x37\x55\xb2\x7e\x41\xba\x4e\xd3\x02\x76\xaf\x65\x01\x55\x6a\xf3\xd5\x65\xef\xac\x78\xba\x55\x72\xb9\xd0\xc5\x0e\xf1\x91\x27\xb2\xd3\xa2\x68\x51\xab\x3f\x10\x73\xce\xb8\x16\xa9\xd1\xd2\xb9\x64\xea\x19\xa3\x70\x8c\xf3\xd3\xd1\x47\xb3\x05\xae\xf9\x2d\xcb\x27\x74\x61\xcc\x9a\x94\x33\xd5\x35\x40\xcd\x9f\x09\xc7\x62\xc2\x3c\xf7\x43\x44\x4b\x1e\x4b\xf5\x11\xf1\x3b\xbc\x5c\xfd\x85\x93\xbb\x74\x41\x5d\x58\xe9\xdf\xd4\x82\xea\x56\x5c\x4f\x76\x61\x95\x13\x4c\xc6\x93\x08\xcb\x34\x94\xd1\x3a\xeb\xa6\xea\x84\x8a\xe3\xf1\x29\xf2\x74\x33\x7c\xe9\x25\xd3\xf3\xc3\x47\x41\x40\xe8\xf8\x73\xbc\x98\xec\x22\x5b\x7e\x62\xa7\x01\x58\xd9\x02\xa7\x6a\xb0\x80\x64\x40\xe2\x59\x76\xef\x01\x89\x39\xd3\xe3\x4c\x9f\x4b\x0a\xe7\x88\x8e\x2b\x14\xde\x4a\x19\xe6\x85\xab\xc3\x47\xb3\xb7\x48\xa2\x4f\x8c\x50\x29\xf2\xb3\x0f\xd2\xd0\xf3\x22\xe3\x47\x16\x91\x33\xe8\x30\x4a\xb1\x23\xb1\x9b\xaa\x3b\x4a\xf7\x19\xcf\xb4\xae\x25\x60\x42\x8e\xc8\x2c\x3f\x82\x89\xe1\x86\x51\x79\x4b\xfe\x37\x2a\xbe\xd3\xfc\xd7\x4c\x1a\x8e\xf5\x79\x39\xce\x64\xed\x65\xb3\x46\x50\x7d\x44\x41\x85\xa1\x1b\x71\xe6\xab\xea\x55\xdb\x53\x85\x2c\x99\x85\xb2\xfe\xf9\xdb\x6b\x8d\x91\x2d\x33\x56\xc4\x5c\x04\x88\xdf\x79\x84\x6a\xfc\xc0\x88\x78\x5e\x9a\x76\x5d\xd8\x16\xb0\xed\xbe\x05\xec\xfe\xa5\xf2\x75\x76\x3f\xe7\x12\x47\xaa\xd1\xfa\xe5\xad\x6a\x59\x2d\x2f\x2e\xae\xd5\xb4\x80\x7d\x79\x71\x06\x4b\xbc\x49\xa3\x60\x6a\xc2\xc8\x1b\x5e\x33\x2f\xf4\xd3\xf4\x27\x31\xf2\x31\x96\x15\x00\x5f\x90\xd8\x8d\xd8\x6b\xa6\xfd\x29\xbf\x9b\x14\xc7\x09\x4e\x7b\xe0\xc4\x4d\x08\x89\xa8\xd4\xf8\xf4\x94\x17\x51\x7b\x6e\xe4\xe0\x5b\xb9\x24\x1e\x1e\x63\xea\x3e\x70\xc8\x7c\x1d\x3e\x96\x9c\x38\x7a\x1b\xc7\xa3\x88\x10\x42\xcd\x7c\x4a\x68\xb0\xdd\x6e\x37\x9b\x15\x27\xd4\xd2\x89\x8b\x5c\x75\x0f\x34\xe2\x9f\x11\x21\xce\x18\x39\xa2\x6a\x36\x62\x2a\x75\x13\x68\xc9\x30\x04\xa1\x63\x4f\xb1\xe4\xb4\xc7\x89\xbd\xcd\xea\x1a\xec\x67\xd7\x60\x94\xa2\xda\x1a\x64\x8a\x06\xc3\xdf\xd7\x5c\x7e\x0b\x87\xb7\xd6\x0a\x8c\x32\xfd\x99\x78\x54\x27\xe4\x11\x00\x0d\xcd\x7c\x37\x14\xaa\x16\x85\xca\x41\xb3\x29\x8b\x72\x91\x8c\xc8\xeb\x6b\xf1\x5e\x30\xc3\xa8\x72\x8c\xaa\xbb\x3d\x46\x75\xd9\x32\x8c\xca\x30\x2a\xc3\xa8\x8e\x9d\x51\x45\x31\xad\x41\x0d\x56\xf5\xef\x76\xb3\xd9\x34\xd4\x6a\x0d\x6a\x75\xab\xa0\x06\x4f\x40\xaf\x7a\x86\x5e\xed\x8c\x5e\x69\x08\x91\xa5\x49\x56\x95\x5f\xe5\xd9\x5a\x21\x5c\x6b\xd3\xab\xd6\x56\xb9\x55\x80\xb9\xa3\x06\xc8\xb0\xaa\x2c\xab\xb2\x5b\x6b\xd1\xaa\x88\x3e\x75\x3b\x86\x3e\x19\xfa\x64\xe8\xd3\xb1\xd2\x27\x11\xfa\x2f\x7f\x4c\xfd\x81\x8f\x7d\xc6\xef\x07\xa1\xc0\xee\x60\x78\x2f\x71\x45\xf6\x64\x01\xc4\x31\xfa\xfd\x1b\x9c\x60\x14\x7c\x83\xf3\x33\xc5\xa6\x7e\xcb\x14\xea\xa3\xd9\x46\x65\x6e\x40\xce\xb6\xc0\xbe\x76\xca\xb0\x7a\x4d\xeb\xb2\x59\x40\xb3\xfe\x8e\x51\x00\xd4\x80\xc0\xcd\x58\x54\xdf\xb0\x28\xc3\xa2\x0c\x8b\x7a\x32\x16\xd5\x5f\x9f\x45\xf5\xb6\xcc\xa2\x5a\x45\x36\xc3\xa2\x0c\x8b\x5a\x8f\x45\x59\xd5\xaa\x3c\xbf\x7c\xe4\x9f\x5a\xed\x69\x66\x1a\x53\x38\x01\x0c\xa5\xdb\x3e\xa5\xa3\x8c\xee\x82\xd5\xad\x14\x7b\xa2\xc4\xee\x4f\x46\xcf\x9f\x27\xb9\xb3\x36\xac\x70\x56\x52\xdd\x8e\x79\xe4\x9e\xce\xd5\xb5\x1b\x05\xf0\x2d\xce\xd5\xf5\xb6\x75\xae\xee\x3f\xbf\x7c\x04\x1f\xa3\xa5\xab\x9f\x72\x95\x8e\xd5\x21\x8f\x20\x71\xbd\x60\xc4\xbf\xd2\x2d\x1e\xc6\xc7\x29\xf3\xf0\xa9\x73\xc3\x1f\x30\x1d\xcb\x08\xae\x14\x0c\xd1\xb1\x48\x5c\x94\xad\x0a\xc5\xad\xc9\x64\x89\xe7\x65\xc7\x2e\x1a\xe6\xfc\x18\x7b\x78\x24\x3f\xb0\xf1\x1b\x24\x72\x01\xaa\xc4\xfa\x11\xcd\x34\xd0\x2f\x8c\x84\xea\x8d\x5c\xdd\x95\x2c\x2e\x38\x32\x17\x96\x1c\x5b\x97\x45\xaf\x18\xe7\xd5\x67\x6e\x4f\x33\x73\xbb\x15\x27\x6e\xa7\xb0\x4a\xe2\xe6\x16\x40\xe2\x8d\xf3\xad\x40\xd3\xb1\x6e\x78\xc0\xc3\xca\xcd\x8f\x6a\xcc\xf7\x34\xc3\xad\x0c\x84\x16\x94\x97\x6c\xc4\x9a\x3c\x92\x49\x54\xb4\xd9\x47\xce\xe4\x51\xdd\xa0\xa2\x3c\x8b\x44\x39\xc3\x4f\xe2\xca\x9c\x9b\x28\xa2\xf1\x39\xa2\x9a\xa3\x72\x0b\xe5\x85\xc6\x58\xd7\x66\x18\x28\x9a\xcb\x91\x4b\x42\xd5\xa2\xb4\xae\x08\x16\xfc\x38\x9f\x8d\x63\xea\x62\x8e\xd5\x62\x81\x23\x8f\x65\xb6\x9d\xf8\xee\xd7\x3f\xa6\x98\x73\xe2\x62\x4d\xbb\xa3\x4d\xbe\x68\x89\x0b\x89\x9c\x3b\x6d\xad\x6a\x9b\x0d\xb0\xfb\x21\x26\x6e\x79\xfb\xde\xc3\x59\x4f\xc9\x50\x72\x6c\x60\x1b\x37\xff\x5a\x39\x02\x63\xd5\x42\xd2\x61\xbe\x4f\xa4\x3c\x22\x38\x97\x2d\x2e\xc1\xed\xcd\xce\x71\x3b\xa6\xd8\xa7\x8f\x66\x25\x58\x5d\xaf\x81\xd5\xca\x55\x09\x49\xce\xf8\x10\xd5\x9d\x9b\x58\x4e\x66\x37\xbc\x68\x72\xfc\x85\xc7\xc9\x73\x5c\x9a\x8c\xb7\x13\x32\x7a\x9c\xff\x28\xd6\x9d\xea\x06\x94\xcb\x27\x52\xd2\x23\x0a\x7d\xf1\x17\x16\xcc\x0b\x93\x27\x51\x72\xbe\x49\x2d\xb1\x09\xe2\xd8\xd5\xf8\x7d\x65\x63\x5c\x6a\x76\xce\x68\x3f\x19\x2c\x28\x97\x13\xfa\xa1\x87\x24\x99\x62\xd8\x28\x98\x69\x4b\x7a\x16\x3d\x3f\x93\x6e\xfb\xec\x1c\xcd\x88\x76\xdf\x99\x25\x86\x4c\x9f\x86\xa1\x73\x17\xbb\xd3\x2c\x4c\xaa\xc3\xc9\x8e\x93\xbb\xaf\xff\x10\xb6\xd1\xe4\x2a\xde\x56\x97\x5b\xe7\xd7\xef\x85\x9d\xbb\x2f\xec\xc1\xfd\x20\x9e\xe7\x79\xdf\x0f\xfd\x68\x3d\x65\x9b\x28\x26\x2c\xfd\x4c\x53\x7a\x8e\xdc\xa3\x59\x95\x5b\x88\x0f\x8b\x4b\x57\x89\xf2\x32\x2a\xde\xa8\x85\x42\x19\x8b\xc8\xdc\x92\xb2\x68\xb3\xf9\x44\xf3\x7c\x58\x1a\xdb\x94\x69\x6e\x55\xed\x44\x0c\xca\x13\x76\x42\x6b\x2a\xec\x47\xd9\x68\xe9\xe6\x2f\xf2\xc8\xb8\x68\x31\x46\xb6\x0f\x78\xba\xec\x58\x43\x27\x16\x57\xa0\x5b\x2d\xdb\xe8\x9a\x93\xd4\x35\xdd\x1a\xba\xa6\x63\x74\x8d\xd1\x35\x47\xab\x6b\xb6\x19\x7c\xd5\x6b\x12\x23\x7c\x56\x85\xcf\x9e\x82\xdd\xc7\xad\x7d\xf6\x04\xda\xf3\x97\x3f\x8b\x1b\x0f\x46\x02\x19\x09\x64\x24\x90\x91\x40\x46\x02\x9d\xbc\x04\xb2\x5b\x35\x34\x50\xaa\xa0\x64\x1f\xcd\x37\x23\x52\xe4\xaf\xc5\xe7\x64\x04\x73\x03\x65\x64\x92\x91\x49\x87\x25\x93\xcc\xfd\x9e\xcd\xee\xf7\x18\x91\xf3\xa8\xc8\x39\x56\x49\x53\x11\x9c\xc5\xd3\x98\x09\x38\x53\x21\xd6\x02\xa7\x36\x36\x85\xef\xd2\xab\x87\xdb\x54\x88\x9a\x2b\xf3\xed\xce\x31\xe5\x4f\x85\x69\x0d\xdc\xb8\x10\x25\xd8\xbc\xdb\x39\x36\xc1\x01\x63\x13\x94\x62\x73\xb3\x73\x6c\xc4\x4f\x14\x1c\x2c\x38\xaa\x71\x25\xe8\xfc\xf1\x24\xe8\x1c\xf2\xec\x49\xda\x57\x82\xd1\xdf\x77\x8e\xd1\xba\xf8\x80\xbf\x01\x0d\xca\x07\x0b\x71\x30\xb9\x17\xe0\x65\x20\xc4\xdf\x14\xd8\x67\x25\x50\xbf\x5f\x03\xea\x95\xab\x83\x8a\x05\x7e\x8e\x64\x8f\x09\x04\x9a\x40\xe0\xc6\x81\x40\x08\xb7\x1b\x41\xcb\x4c\x94\x34\xb2\x29\xd3\xdc\xaa\xda\x05\x13\x06\xac\x18\x06\xdc\xd7\x0b\x66\xed\x56\xa3\x60\x5c\x17\x27\xe1\xfb\x5b\x3d\x09\x4f\x84\x03\xb5\xce\xc5\x9c\x83\x37\xe7\xe0\xd7\x38\x07\x6f\x5f\x14\xd6\x19\xcf\xdb\x66\xd7\x9c\x18\x31\x27\x46\x9e\xfe\xc4\xc8\xbd\x90\xd8\x1f\x38\x41\x38\x08\x05\x1a\xe3\x27\x8a\xc0\xe4\x5a\xae\x67\xe3\xf6\xa3\x6c\x3c\x6e\x7f\xcd\x28\xcc\xeb\xdd\x69\xa1\xa3\x05\x34\xe9\x40\x09\x6a\x6f\x60\x2d\x64\xd0\x74\x3c\x60\x53\xcc\xa3\x97\xaf\xbd\xac\x8b\xd3\x57\x7b\xf2\xfd\xec\xd0\xc0\x3a\xb7\x27\x25\x78\x5d\x67\xf0\x5a\xb9\x3a\x18\xa9\x77\xfd\xe9\x9f\x46\xe4\x1d\xb9\xc8\xd3\x69\x97\x2d\x69\xbc\x95\xf7\x83\xd8\x25\x6b\x2f\xd9\x69\x43\x4a\x4a\x34\x54\x2d\x19\x08\x6d\x68\x54\xe0\xe1\xa9\x40\xa3\x6f\x4e\x51\xdf\x74\x6b\xe8\x9b\xcb\xd4\x7b\x71\x92\x8d\xd4\xc8\x1b\x23\x6f\x9e\x44\xde\x78\x0c\xb9\x03\x34\xc5\x1c\x8d\xf1\xc0\xf6\x77\xcd\xc9\x6b\xdc\x55\x88\x1b\x7a\x6e\x1f\x90\x94\xd9\x65\xf7\x37\x15\x13\xc9\xb8\x2a\x95\xe5\xb0\x90\xca\x03\x1c\x51\x27\x08\x61\x71\xbf\x8f\x41\x14\x7c\x60\xc8\x35\xaa\xc0\xa8\x82\x0d\x55\xc1\x23\x6c\x1a\xc2\xed\x72\xe9\xcc\x24\x4a\xa3\x9e\x32\xcd\xad\xf2\xfe\x55\xee\x82\x11\x04\x46\x10\x9c\xb2\x20\xb0\x5b\x35\x14\xc1\x45\x2a\x53\xb2\x7d\x1a\x45\x60\x14\xc1\x6e\x15\x81\x3a\xbc\xac\x02\xad\xc8\x15\x03\x8f\x4c\xab\xc6\x9d\x01\xe3\x20\x9b\x75\x71\x71\x80\xe4\x53\x35\xef\x70\x94\xc4\x2a\x70\x2e\xc2\x3e\xa3\x35\x51\x8f\x33\x1f\x30\xee\x71\x03\x6b\x22\xff\x66\xb7\xc8\x07\x18\xdd\xd5\xc4\x5d\x65\x3d\x60\xd4\x55\xf3\x4a\x70\xdd\xe1\x01\xf6\x27\xc6\x44\x3f\x81\xea\x20\xf6\xe8\x5d\xbe\x75\x4e\xa8\xaf\x5c\x1d\x8c\x78\xfd\x1c\x8f\x8c\xd1\xaf\x46\xbf\x56\xd0\xaf\xcd\x92\x95\xf9\xe4\xe2\x2f\xdf\x98\x15\xdc\x53\xa6\xb9\x55\xde\xc3\x3d\x76\x42\x6b\x2a\xec\x47\xd9\x50\xee\x55\xc1\xae\x98\xd4\x92\xf2\x98\x73\x17\x39\x01\xf8\x62\x38\xb2\x87\xb9\x2f\xc2\x41\x8a\x95\x48\x82\x2f\x46\x0e\x6e\xb9\xb9\x95\xc5\x43\x4a\x13\x2d\x0a\x5f\xf4\xf0\xb0\xd5\xcd\x3e\x95\x06\x25\xe6\x3e\xa1\x48\x26\xb5\x74\x6c\xbb\xd7\xbe\xcc\x25\x22\x3e\x76\xcf\x7f\x22\x22\x09\x55\xf2\x08\xbe\x70\xec\x8e\x63\xf7\xb2\xe9\x56\x53\x60\x34\xec\x5f\x5c\x16\xbb\x9e\xfd\xa8\xf3\x42\x9d\xbd\xb9\x28\xed\xd7\x10\xa5\x76\xab\x7d\x7c\x0f\x2d\x47\x81\x82\xdb\xc2\xa3\x2d\x46\xd8\x9e\x9c\xb0\x55\x9f\xfb\xc1\xe2\x80\x19\xfb\xaf\x5f\x51\x13\xe7\xf3\x12\x06\xfa\x1a\x56\xdc\x23\xf6\xce\x32\xc1\xad\xea\x4c\x55\xae\xb9\x31\x91\x24\xd4\x25\x53\xe2\x86\xc8\x83\x85\xeb\xb2\x8c\x48\x1e\x0b\x5d\xac\xc6\xe2\x8e\x86\xe3\x58\xcf\xa4\x1f\x2b\x57\x87\xcc\xd5\x5c\x3c\x0c\xd5\x0e\x0c\x5f\xd8\x37\xbd\xfe\x75\xf6\x00\xd9\x32\xf6\x0f\x5f\xbc\xb9\xb1\xdf\xe4\x99\x1c\xa1\xa3\xe8\xe3\x32\x2f\x3a\xcd\x7e\xb7\xdd\xca\x9a\x25\x47\x31\xb3\x79\xd1\x7d\xf7\xb6\xf9\x2e\x97\xfd\x27\xe2\x34\xca\xfe\xee\xf5\x9b\x03\xe4\x5d\xe6\xae\xc8\x56\xef\x8a\xd8\xfd\x8a\xcf\x81\xb4\x9a\x85\x95\x96\x7f\x7f\xde\xde\x33\x2f\x55\x47\xa7\xdf\xf9\x81\xbc\x2f\xc8\xac\xec\xff\x83\x39\x2b\x30\x1b\x5a\x5b\x91\xd6\xe6\x6a\x7c\x2a\x56\x5b\xbe\x3b\x45\xb2\x58\x35\x3f\x76\x0e\xab\x85\xa5\x7c\xff\x7a\xdf\x79\x5b\x96\x1a\x79\xcb\xaa\x85\x16\x6e\x40\x87\x42\xc5\x09\x75\x38\x46\x02\xbf\xf4\xd8\x78\x88\x9c\xbb\x01\x9e\x62\x2a\xc5\x20\xba\x8f\x58\x91\x8d\x7f\xb5\xfd\xef\x67\x1b\x53\xf2\xad\x05\x8c\x7f\xfd\xf2\x54\x44\x65\x3e\xaf\x79\x6f\xa3\xf8\xae\x92\xdd\x3a\x86\x70\xf2\x07\x36\x06\xef\xa2\x51\x04\x2f\x6d\xff\xac\x22\xd5\x7f\x8a\xb0\x72\x25\x35\x50\xf1\x93\xfb\x26\xec\x7c\xc2\x61\x67\x98\xe5\xb0\x69\xe4\x53\xa6\xb9\x55\xde\xc7\x3d\x76\x43\x6b\x2a\xec\x47\xd9\x60\xee\x55\xcc\x18\x91\xf0\xec\x44\x42\xe5\x28\x75\x89\x48\x88\xc4\x40\xb7\xa2\x18\x78\xb2\x08\xf4\xca\xf2\x7a\x46\x54\xdc\x44\x98\xd3\x11\xe6\xc5\xa1\x07\x16\x60\x3a\x18\xb9\xe2\xb0\x1e\x6d\x7e\x9c\xc3\xaa\x76\x6f\x9d\xbe\x6e\x7c\x84\x44\xbd\x19\xf2\x08\xc1\xd4\xbe\x33\x72\x5f\xc7\x9c\x16\x58\x8e\x88\x87\xe3\xf9\x59\x11\x4e\xc0\x38\xc8\x67\x8e\xff\xba\xeb\x11\xd9\xda\x0c\x2e\x7c\xa8\xa4\x16\x7a\x3e\x9a\xd5\x06\x2f\x9a\xcb\x07\x8a\x5d\xf9\x4b\x4e\xdf\x66\xa0\x5b\xb9\x3a\x18\x0d\x7a\x43\x3c\x0c\xde\x62\xe1\x70\x12\x48\x45\x13\x0f\x47\x84\x9a\xb3\x4d\x27\x79\xb6\xa9\xb9\xbe\x3c\xcb\x67\x59\x01\x3e\x65\x9a\x5b\xe5\x5d\xdc\x5a\x2f\xd6\xef\x84\xd6\x54\xd8\x8f\xb2\xb1\x3c\x8d\xb7\xb6\xb5\x7a\x8d\x82\x71\x5d\x1c\xbb\xb9\x5c\xef\xad\x6d\xea\x19\x07\x41\x84\xc4\xd4\xc1\xf1\x13\xa0\x02\xea\x9d\xe6\xc3\xf7\xcc\xc1\x27\xc6\x3c\x01\x5e\xaa\x4f\xdb\x9c\x41\xad\x6b\x32\x2f\x75\x3b\xf1\x97\xba\xc5\x2f\x75\xab\x7c\x33\xaf\x5f\x58\x67\x34\xad\x2f\xf6\x7c\xcf\xee\x64\x6f\xba\xf9\x84\xde\x06\x88\x66\x87\xf2\x50\x23\x00\x0f\x6e\x6d\xe5\x8b\x01\x01\x63\xde\x60\x92\xfb\x0c\x97\x70\x58\x80\xdd\x2f\x48\x77\xe4\x41\x9b\x3b\x9b\x4a\x0d\x22\xf6\xb0\x23\xf5\xbb\x40\x72\x08\x75\x16\x79\xd9\x4f\xb7\xe0\x9d\x8b\x29\xb8\x55\x7c\x3d\xd5\x8e\x87\x61\xcd\xa7\x4b\x25\x9b\x17\x0e\xf7\xd1\xc4\x3d\x36\xf8\x54\x88\x05\x88\xab\x7e\xd2\x8d\xcc\xce\xd5\xbc\x5e\x87\x1f\xca\x27\x48\xec\x7e\x4d\xb1\xbf\xe9\xe7\x47\x9e\xc7\x98\xc4\x9f\x35\xc1\xee\xd6\x83\x30\xdb\x18\x97\xb5\x3f\xe9\xf8\x1c\x46\xa4\x7e\x44\xec\x7a\x8d\xc1\x58\xb9\x3a\x98\xc0\x84\x76\xe0\x4c\x74\xe2\xc8\xa3\x13\xe6\xeb\x91\xe6\xeb\x91\xe6\xeb\x91\xe6\xeb\x91\xeb\x7d\x3d\x52\xa7\x9d\xfb\x35\xb4\xb3\x7d\x91\xca\x95\xec\xb6\x46\x3d\x1b\xf5\x5c\xa8\x9e\xb3\xd3\x3c\xb1\xbc\x97\x98\xa3\x84\x5e\xd8\x9d\x6e\xab\xdb\x6c\x77\x2e\xda\x9d\x56\x3a\x3a\x13\xa7\xfd\xa4\x02\x8f\xef\xf3\xb1\x9b\xa7\x56\xdd\xff\xf0\x5c\xf0\x07\xa6\xe5\x92\x7b\x91\xa8\xd0\xd9\x1b\xbd\x6d\xf4\xb6\xd1\xdb\x46\x6f\x1b\xbd\x6d\xf4\xb6\xd1\xdb\x46\x6f\x1b\xbd\x6d\xf4\xb6\xd1\xdb\xcf\x5f\x6f\xdb\xdd\x5a\x82\xfb\xd2\x08\x6e\x23\xb8\x4f\x54\x70\xdf\x86\x7c\x4a\xa6\x8c\x57\xb9\xd5\x9d\x49\x5b\xe8\xfb\x8d\xfc\x36\xf2\xdb\xc8\x6f\x23\xbf\x8d\xfc\x36\xf2\xdb\xc8\x6f\x23\xbf\x8d\xfc\x3e\x35\xf9\xbd\xaf\xf3\xf6\x17\x9d\x46\xc1\xb8\x2e\xb4\x5e\x73\xbd\xf3\xf6\xc5\xce\x30\x7f\xbe\xfe\x4f\x46\xcf\xcd\x19\x7b\x73\xc6\x7e\x3b\x67\xec\x2f\xba\x85\x75\x46\x20\xf6\xfa\x26\x6a\x61\xa2\x16\xeb\x44\x2d\x56\x99\x7b\x44\xdf\x28\xa3\x79\x06\xb7\x4e\x00\x62\x51\xc0\x26\x31\x88\x8f\x58\x22\xf1\x48\xec\xe1\x21\x4d\xe1\x86\x67\x62\x0e\x8b\x98\x43\x32\x28\x26\xec\x70\x58\x61\x87\xa3\x1b\x96\x53\x88\x3c\x1c\xdd\xa0\x98\xe0\xc3\x32\xf8\x90\x8c\x9d\x89\x3f\x98\xf8\x83\x89\x3f\x98\xf8\x43\x2a\xfe\x60\x64\xf4\x29\xca\xe8\x7e\x0d\x19\x6d\xb7\x9b\x46\x47\x1b\x1d\xbd\x8e\x8e\xce\x4e\xf3\xc4\x52\xef\xee\x7f\xaf\xbf\x57\xf5\x7d\xcd\xfc\x80\x63\x21\xb0\x0b\xae\x3d\x24\xc4\xa3\xc7\x00\x0a\x32\x14\x6e\x04\x46\x97\x1b\x5d\x6e\x74\xb9\xd1\xe5\x46\x97\x1b\x5d\x6e\x74\xb9\xd1\xe5\x46\x97\x1b\x5d\x6e\x74\xf9\x69\xe9\x72\xbb\x5b\x4b\x98\xa7\x7a\x94\xec\xb8\x46\x98\x1b\x61\x7e\x22\xc2\xdc\xc5\xe0\x1a\x39\x93\x72\x31\xbe\x4c\x54\xe8\xf1\x8d\x00\x5f\x0a\xf0\xff\x67\xef\xdc\x7a\xdb\xb6\xbd\x00\xfe\xee\x4f\x41\x10\xff\x87\x14\x70\xd0\xc8\x8e\x73\x79\xf8\x3f\x24\x0d\xb0\xad\x40\x8a\x0e\xdd\xf2\xb2\x15\x06\x23\x33\x9e\x5a\x5d\x3c\x49\x4e\x93\x05\xfe\xee\x03\x29\xc9\xa2\x28\x52\xa6\x64\xd9\xb2\xe6\x03\xf4\xa1\xba\x98\xe2\x39\xe4\x21\x7f\xe7\xf0\x84\x04\x07\x1c\x1c\x70\x70\xc0\xc1\x01\x07\x07\x1c\x1c\x70\x70\xc0\xc1\x01\x3f\x42\x07\xbc\xab\xc4\xfc\xf3\xf1\x40\xd3\xae\xa9\xb7\x37\xb6\xda\x4a\xcc\xff\x89\x84\x8f\x64\x4e\xd1\x87\xc0\x65\xac\xcd\x86\x35\xe5\x40\xd3\xd7\x7c\x7c\x6d\xe8\x61\x6b\x3f\x5d\xd9\x70\xe7\xc2\xbd\xa2\xf8\xbc\xe1\xae\xaf\xcc\xbc\x74\x03\x17\x5c\xf5\x34\x31\x44\xe5\x13\xad\x13\x2e\x98\x61\x13\x27\x9b\x7f\x4c\x2b\x74\x7b\x5e\xf6\x81\x7a\xd3\xbd\xf1\x10\x43\x12\xd3\x13\xe6\x4c\xcc\xed\xe9\x82\x2c\x23\x46\xa2\x76\xe0\xcf\xa2\xe4\x5c\x0c\x33\xfe\xdd\xf2\xb8\x61\x23\xc6\xad\x41\xb1\x6f\x6f\x84\x8f\x58\xab\x15\x3a\x79\x7b\xb3\x99\x54\xab\xd5\xbb\x0a\x78\xbd\xc1\x86\x33\x4d\x87\x80\x9a\x0f\xc4\x91\x21\x93\xee\xe7\x6c\xe0\x0a\xe0\xec\x0b\x56\xd6\x85\xbd\x60\x11\xed\x93\x92\x3a\x39\x59\x17\xe3\x76\x85\x50\x3e\xd2\x4a\x21\x5c\x75\x8c\x7a\x47\x85\x2e\x57\x0d\xd0\xc5\x3a\xb3\x80\x5d\x80\x5d\x0e\x8b\x5d\xa2\xa5\x57\x87\x5c\xde\xf7\x80\x82\xa2\x98\xf8\xb1\x39\x25\x59\x1b\x29\x89\x3c\xcf\xd1\xd6\xa4\x34\x34\x6b\x29\xa5\x6a\xcd\x8f\x0a\xed\x8d\x4e\x3d\xf2\x52\x5f\xa7\xb7\x92\x4e\x85\xab\x83\xa1\xcf\xcf\x4c\x0c\x74\xb7\x4c\xb6\xc2\x06\x02\xed\x98\x40\x23\x3d\xb8\x01\x7f\x02\x7f\xf6\x95\x3f\xad\x8b\x06\x00\x7a\x7d\x0d\xfc\x09\xfc\xd9\x35\x7f\xa6\x4b\x87\xc4\x75\x03\x9b\xac\xd7\xf4\xa7\xbc\xbd\xf7\x82\x8f\x19\xad\xa8\xc7\xce\x06\x2c\xb3\x16\xa5\x02\x5d\x1a\xe2\xa0\x42\x71\x8b\x30\xf0\x82\xff\x84\xde\x32\x49\x2a\xd4\xd6\x07\xe2\xbb\xc9\x9a\xff\xfd\x67\x95\x40\x00\x7d\x7b\x87\xbe\xbd\xaf\x31\x77\x02\x7e\xed\x8b\xa1\x7c\xa4\x95\x43\xb8\xea\x18\xfd\xba\x5a\x65\x9e\x58\x03\x4d\xbb\x66\xab\xcc\xa3\xb6\x56\x99\xf9\x5f\x4b\xba\x01\x99\x39\xfe\x1c\x2b\x0d\x1c\xf6\x7b\x3b\xf2\xfd\xde\x92\xfd\xde\xac\x91\x69\xe7\x1d\x69\x3f\xca\x3b\xef\xb8\x50\x7a\x3a\x79\x83\xb7\x00\xde\xc2\xce\xbd\x05\xe6\x28\xd8\x6c\xbc\xa3\xd1\x94\x0d\x79\x74\x66\xc8\xb7\x28\x08\x51\xf9\xc7\xd9\xa5\x61\x21\x78\xa8\x9f\x91\x8d\xf8\x78\x4d\xc0\xa3\x8d\x04\x9c\x54\xb0\x61\x22\x69\xc5\x1f\x3d\x8f\xfa\x90\x48\xca\xa7\x34\x1a\x21\x85\x12\x20\x7d\xb4\x7f\xe9\xa3\x2a\x5a\x4d\x6f\x0a\xf7\xbe\x6e\x47\xf6\x7b\x47\x62\xa9\xa7\x14\x55\x5b\x78\xb4\x1a\x1e\xac\x10\xca\x47\x5a\x39\xaa\x5a\xeb\xe8\x43\xba\x40\xab\xbb\xa7\x55\x6b\xd4\x04\x57\xaf\x00\x57\x01\x57\xbb\xc0\xd5\x19\x75\x63\x72\xd2\x18\x5a\xff\x98\x78\x5f\xdf\x31\x72\xd5\x95\x53\x93\x5f\x79\x79\x78\xa8\x9f\x7e\xb6\xc9\x03\xa8\x15\x01\xde\xcc\xbf\x5c\xe4\x63\xc6\x5f\xc4\x35\x80\x4e\x26\xde\x3b\x00\xe0\x9e\x03\x70\x39\xd9\xb5\x25\xfc\x9d\x51\xdb\xf1\x88\xab\xd6\x4f\x1d\xb2\xc4\xb8\x4b\xae\x1c\x56\x4b\x69\x2c\x05\xf0\x71\xc6\xc7\x9d\xc5\xbd\xaf\x07\x9a\x76\xcd\xe2\xde\xe3\xb6\xe2\xde\xb7\xcb\xa7\x27\x1a\x26\x47\x9e\x60\xe5\x00\x04\x71\xef\x23\x8f\x7b\x27\x71\xef\x0b\xc3\xbe\x7b\x71\xa6\xfd\x26\xef\xbb\xe3\x31\xf8\x11\xe0\x47\x74\xe1\x47\x30\xf2\x7f\xe4\xc3\xdd\x96\x3b\x91\xcc\x9c\x90\xda\xf1\x9e\x43\xd9\x3b\xd9\x3c\x64\x74\x2e\x93\xfc\xb0\xb6\x2e\xb9\xaf\x3d\xb5\xc9\x82\xd8\x4e\xfc\xda\x17\x75\x66\xf5\x6d\xa8\xd2\xdb\x1a\x2a\x15\xae\x0e\xc6\x39\xba\xe3\x3a\x47\xc9\xec\x1f\x81\x6b\xd4\x73\xd7\x48\x45\xf4\xe9\x4d\xe1\x5e\x23\xe7\xa8\xc3\xac\x1f\xa9\xa7\x14\x55\x5b\x78\xb4\x1a\x9a\x0a\x01\x6b\x03\xb0\x36\x00\x6b\x03\x7c\x6d\x40\x45\xf4\x17\x0d\x88\xfe\x0a\x88\x1e\x88\xbe\x6b\xa2\xaf\xf1\xd7\x91\x32\x76\x66\x59\x2d\x62\x49\xe9\x45\x1f\x40\x96\x55\xb7\x21\xc5\xd6\x71\x0c\x84\x2b\xa0\x58\xa0\xd8\x9e\x51\xac\x10\xe2\x3f\xab\x30\xce\xbd\xd3\x61\xb9\x32\x82\xde\x0b\x8f\x56\xc3\x6a\x09\x3b\x14\x42\xf9\x48\x2b\x47\x55\x53\x02\xe2\x02\xe2\xee\x1a\x71\xad\x51\x13\xc6\x9d\x00\xe3\x02\xe3\x76\xcc\xb8\xdb\x45\xad\x3d\xc2\xc2\xe9\x10\xb5\x6e\x29\x6a\xdd\x89\x3a\x8f\x3c\x6a\x7d\xcf\x75\x0e\xbc\x0f\xbc\xbf\x89\xf7\x21\x6a\x0d\x51\x6b\x88\x5a\x1f\x4b\xd4\xda\xba\x6a\x82\xf4\x85\x6c\xac\x74\xaa\x05\xa4\x07\xa4\xdf\x27\xd2\xd7\x0f\x5b\xaf\xb9\xb3\xb5\xb0\x75\x37\x24\x7b\xc4\x61\x6b\xc0\x58\xc0\x58\x33\x8c\x85\xb0\x35\x84\xad\x4b\x61\xeb\xae\xb2\xd2\x2f\x2e\x07\x9a\x76\xcd\xb2\xd2\x27\x46\x59\xe9\xeb\x71\xf0\xe3\xdd\xed\x07\xac\x1c\x5c\x0e\x2f\xe7\x5c\xcb\xe3\xb5\xe0\xd5\x1a\x99\xea\xfa\x4a\xb8\xa7\xd4\xf5\xa5\x19\xbd\xb6\x73\x9a\x26\x00\x2e\x00\x6e\x0d\xc0\x65\xe9\x5c\x5f\xb8\x63\x3c\xb5\x03\xdf\x4f\x0f\x8b\x98\xb2\x1d\x9e\x9f\xe9\x9b\x16\x47\x45\x68\xdd\x06\x46\xdb\xdf\xa0\x9a\x57\xbc\x82\x48\x3f\x88\x33\xbd\xd4\x2f\xeb\xeb\x89\x6d\x47\xbd\x73\x25\xd5\x56\x82\xf2\x44\xbe\x5e\x6d\x24\x78\x47\x62\xf2\x48\x22\x8a\x04\x65\x1b\xd2\x37\x6c\x25\xd8\xea\x56\x82\x87\xca\xb5\xb0\xcf\xe0\x7e\xf6\x19\x1c\x48\x9f\x63\xab\x7e\x6c\x58\x60\xda\x1a\x9f\xe5\xe3\x15\x8e\xec\xbf\xa8\x47\x1e\x68\x18\x25\x6e\x6f\xbe\x2f\x33\x8e\xe2\x57\x9e\x0a\x8c\x67\x24\xfc\x9e\xff\x22\x26\xf3\x62\x07\xc4\xd1\xab\x3f\xa3\x91\x23\x8e\x82\xd8\xf1\x9f\x42\x82\x4b\xd5\x88\xa9\xb7\x60\x79\x5b\xfe\xbc\x20\x36\x76\x9d\x28\x96\x7a\x75\x49\x29\xee\x43\x7a\x8c\x73\x59\xfb\x02\x49\xbd\xe9\x0e\x88\xf6\x5e\x17\x61\xf0\x8d\xda\x71\xc5\xf9\xd0\xf9\x3b\x55\x3d\xd1\x2c\x2e\xcd\xde\xa3\x4f\x8e\xef\xa4\x01\x85\xa4\x3b\x4e\x13\x23\x3f\x59\x2e\xde\x22\x1a\x3e\x3b\x49\x14\x2a\x99\x6c\x86\x68\x3d\x0f\xc9\x9b\xdc\x66\xdb\x1a\xc8\x63\xa0\xe3\xdb\xee\x72\x46\x6f\x5c\x1d\xda\x65\x36\x80\x3f\x65\x45\xcb\x25\x7b\x4b\x37\x76\x34\xbf\x4e\x47\x31\xec\xeb\x7e\x1c\x2c\x62\xe5\x84\x81\x10\xfe\x7b\x49\xc3\xd7\xad\xe5\xce\xfb\xad\x64\xa6\x38\xa4\x73\xfa\xa2\x08\x64\xe1\xe8\xbb\xb3\xf8\x3d\x74\xbf\xbc\xfa\xb6\x46\xac\x74\x42\x91\x4b\x8c\xc9\x9c\x77\xb1\xe8\xd7\xac\xea\x72\xd1\x59\xdf\x97\x65\x65\xf7\xf5\x3f\x4a\x67\x9d\x44\x21\xd2\xc3\x65\x44\x7f\x23\xf3\x8d\xec\xdd\x96\x31\x08\xc7\xa9\x97\x27\x20\xc1\x58\x32\x93\x3e\xf5\x68\x4c\x2a\x0c\xa6\xf8\x5e\xa5\xd1\x6c\xdd\x83\x6f\x16\x0b\xd7\xb1\x49\xe9\x80\x4a\xc3\x3e\xfc\x2d\x78\xac\xe8\xbd\x85\x07\xb2\xbe\x4d\x54\x67\xac\x3c\x43\xf5\x95\x14\xb8\xa9\x52\x0a\xd9\xd5\xb5\x62\xe3\x0e\x0d\xcd\xea\x95\xbe\x2b\xbd\x9a\x4f\x34\x85\xd1\x5d\x36\xfc\x75\x29\x4c\x0f\x43\x54\x5d\x01\x03\xb3\xcd\x0c\xc9\x5e\x46\x71\xe0\xe1\x81\x46\x55\x45\x35\x31\x5b\xc9\x19\x7b\xee\x96\x7b\xc1\xd6\x33\xcb\x8d\xe4\xbb\x16\x75\xf9\xbf\xe9\x94\xb8\x05\x28\xdd\xc9\x84\x22\x1c\x6a\x5d\x3b\x7f\x89\x84\x94\xfc\xff\x4f\x9c\x1e\x60\x3e\x74\x66\x8d\xa6\x9f\xb2\x55\xe4\xb6\xfb\xf1\xe1\x1e\xdd\xf3\xec\xaa\x64\x27\x04\xf4\xb3\x7c\xe0\xf6\x06\x2b\xe6\xb3\x54\x75\x3b\xae\x0d\x5d\x3a\xde\xbb\x74\xb6\x77\x93\x79\x6b\xb7\xea\x85\x59\x4e\x33\xcb\x81\xe5\x1a\x59\x6e\x7a\x82\xfd\xbe\x8c\xf7\x53\xe0\x9f\xee\xd1\x80\x55\xe7\xf3\x77\x64\xc3\xd5\x8a\xde\xa5\x19\x8f\x0e\xdb\x8c\xd7\xff\xff\x3a\x90\x0c\x85\xc7\x8e\x0a\x46\x88\x9f\x92\x3d\x79\xb1\x1f\xfc\x38\x1d\x8b\x81\x18\x1c\x07\xe9\x7d\xac\x2c\x66\xe1\xd8\xdf\x69\x58\x2c\xcc\x5f\x7b\xdd\xc3\x81\xdc\x12\xd3\x2c\x0c\x27\x33\x1e\x9e\x14\x2d\x14\x5b\x82\x53\xcc\xfe\x15\xbc\x64\xf6\x0f\x5b\x5e\xf1\x7a\x22\x5d\x5b\xf2\x8d\xf1\x99\xfc\x86\x14\x73\x1a\x49\xd7\xd6\x2c\x1f\x6b\x84\x46\xe3\x0a\x9c\x0a\xbd\xbd\x56\x2d\xe4\x8f\x5e\xc8\x1f\x95\x6b\x31\x3a\x97\x6f\x14\x13\x8c\xf1\xa5\x74\x3d\x3e\x13\xeb\xad\x6c\xb7\x7f\x02\xbe\xc7\x08\x7e\x0c\x83\x1f\x91\x80\x7e\x79\xe8\xf0\x4b\x0a\x87\xe8\x14\xfd\xc2\x42\x07\xe8\x14\x7d\x7c\xb8\xcf\x5f\x5c\xf2\x55\x19\xec\x5f\x9f\xbf\xbc\x5c\xba\x5e\x8e\xd5\xf8\x79\x1d\xbb\x98\x0c\x10\x42\x68\x35\xf8\x77\x00\x1c\xd0\xdc\x9a\xb6\x88\x01\x00"),
 		},
 		"/addons/ops/addon-ops-meta-alerting-rules.yml": &vfsgen۰CompressedFileInfo{
 			name:             "addon-ops-meta-alerting-rules.yml",
 			modTime:          time.Time{},
 			uncompressedSize: 2098,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x54\xdf\x8b\x1b\x47\x0c\x7e\xbf\xbf\x42\x38\x29\xb1\xc1\xde\xf5\x3d\xf8\x21\x03\x67\xb8\x87\x94\x14\x0a\x3d\x8e\x50\x02\xa5\x18\x79\x57\xde\x9d\xdc\xee\x68\x22\xcd\xf8\x6a\x8c\xf3\xb7\x97\x19\xff\xa6\x21\x6d\xc3\xbd\xb4\xfb\x34\xab\x91\xf4\x7d\xfa\x24\x0d\x7a\xfb\x2b\x89\x5a\x76\x06\x7a\x76\x36\xb0\x58\xd7\x14\x15\x0b\xb1\x16\x15\xf7\xe5\xfa\xf6\xe6\xc9\xba\xda\xc0\x83\x70\x4f\xa1\xa5\xa8\x8f\xb1\xa3\x9b\x9e\x02\xd6\x18\xd0\xdc\x00\x74\xb8\xa4\x4e\xd3\x09\x00\xbd\x37\xa0\x1b\x57\x93\x5a\xcd\x96\xe3\x4f\x61\xb9\xfc\xf6\x6d\xd8\x78\x32\x60\xdd\x4a\x50\x83\xc4\x2a\x44\xa1\xec\xe4\x4f\xd8\x26\x01\x74\xb6\xc2\x60\xd9\x4d\xce\x94\xb3\x9b\x70\x47\x06\xb0\x23\x09\x13\x89\x1d\xed\x11\xce\x4e\x93\x27\xda\x18\xe8\x6d\x5d\x77\xf4\x8c\x87\xdc\x5f\xcf\x67\x60\x10\x24\xd2\xe0\x06\xc0\x61\x4f\x67\xd2\x93\x4c\x6f\x92\xca\x9f\x64\xa4\x94\x77\x0f\xa6\x9e\xaa\x24\x42\x23\x1c\xfd\x41\x8e\xc9\x3f\x0f\x87\xfc\xe5\xb3\x39\xfc\x00\xbc\x82\xfb\xe4\x05\x2b\x16\x40\xb7\x39\xe7\x49\x19\xe0\xfe\xe1\x27\x20\x57\x7b\xb6\x2e\xc0\x73\x6b\xab\x16\x5a\x54\x40\x68\x6d\xd3\x82\x60\x20\xe0\x15\xbc\xff\xf0\xe1\x01\x66\x1f\x3f\x82\x90\x7a\x76\x7a\xc2\x4a\xf4\x32\x09\x03\x3f\x46\xa5\x5f\x5c\x67\x1d\x3d\x92\x86\x7b\x6f\xdf\xdb\xa6\x7d\x77\x48\xfd\x4e\x84\xe5\x11\x03\x9d\xe2\x00\xd0\x39\x0e\x59\xb5\x0b\xb6\x59\x6e\x52\xc5\x86\x0c\xcc\x27\x57\x76\xc8\x18\xb0\x07\x01\x25\x59\xdb\x8a\x60\xbb\x7d\xbd\x9f\x9e\xe2\x60\xd9\xed\xc0\x3a\xf0\x5c\x5f\x5c\x79\xae\x77\xbb\x71\x56\x52\x3d\x5e\x45\x9d\x6c\xc9\x21\xd7\xee\x80\x12\xdd\x7d\xf5\xc3\x63\xc9\x50\x71\x4d\x30\xbf\x83\xd9\x74\x3a\x4a\xa2\x6c\xb7\xaf\xd7\xd8\xc5\x84\x97\xfd\x15\x3c\x09\x28\x55\xec\x6a\x60\x77\x96\xf5\xcd\x19\x2c\xcd\x60\xa2\x72\x81\x1f\xc5\xee\x76\x6f\x8a\xab\x4a\x95\xfd\x22\x4a\x67\xa0\x0d\xc1\xab\x29\xcb\xc6\x86\x36\x2e\xf3\x3a\x1d\xfb\x67\xf9\x74\x2c\x97\x1d\x2f\xcb\x1e\x35\x90\x94\x35\x57\x65\x8f\x0e\x1b\xeb\x9a\x05\xb9\xb5\x15\x76\x3d\xb9\xa0\x65\x60\x6f\x2b\x2d\x8f\x43\xb3\x50\xf6\x05\xd6\x5c\xbd\x5a\x45\x25\xce\xaa\x0a\x69\x40\x6f\x53\xf3\x8f\xf4\x73\x6d\x72\xdd\x3a\xfa\xc3\x8b\x81\xf9\x15\xe7\xa1\xc6\x7e\x98\xfc\x86\x89\xf4\x22\x35\x83\x64\x21\xf4\x39\x92\x06\x5d\xec\x75\xd1\x45\xc5\xd1\x85\xad\x06\x0c\x51\xef\xbe\x0c\x66\x45\x31\x18\xc3\x27\x5e\xde\x7d\x19\x5c\x4d\xe6\x60\xf7\xdb\xac\xff\x7d\x34\x82\xe5\x06\x86\x9f\x78\x39\xf6\x5c\x8f\x4f\xbd\x1a\xef\x85\x1c\x47\xb1\xe3\x43\xdb\x47\x23\x28\xbf\x9f\xd0\x0b\x31\x98\xc3\xb4\xb8\xbd\x60\xb1\x62\x31\x30\xeb\x2f\x2c\x97\x2f\xdd\xa9\xdd\xb4\x26\xb1\x61\x63\xe0\x19\xc5\xa5\xb7\xe8\xfb\xb6\x97\x84\xe0\xed\xf4\x87\x34\x9c\xc7\x32\xa1\xc5\x35\x01\x42\x87\x81\x5c\xb5\x49\x57\x73\xb8\xfd\xb7\xeb\xfb\xf3\x3e\xfa\x3f\xb0\xbc\xf9\xd9\x3a\x16\x9b\x44\xfb\x9f\x6c\x61\xf7\x97\x0e\x7c\x6d\x07\x5b\xab\x81\x1b\xc1\x7e\xf1\x39\xa2\x0b\xb6\xa3\xe1\xb4\x78\x3b\x86\xbf\xdf\x82\x65\xac\x9e\xe8\xdb\x6b\x30\x87\xdb\x62\xfa\x02\xb3\xfd\x67\x00\x00\x00\xff\xff\x26\x50\x05\xf0\x32\x08\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x54\xdd\x8b\xdb\x46\x10\x7f\xf7\x5f\x31\x38\x29\xb1\xc1\x92\xec\x07\x3f\x64\xe1\x0c\xf7\x90\x92\x42\xa1\xc7\x11\x4a\xa0\x14\xb3\x96\xc6\xd2\xe6\xa4\x9d\xcd\xcc\xae\xaf\xc6\x28\x7f\x7b\x59\x7d\xf8\x03\x4a\xd2\x86\x7b\x69\xec\x17\x69\x76\x76\x7e\x1f\x33\x23\xed\xcc\xef\xc8\x62\xc8\x2a\x68\xc8\x1a\x4f\x6c\x6c\x99\xe6\xc4\x48\x92\xe6\xd4\x64\x87\xd5\xe4\xc9\xd8\x42\xc1\x03\x53\x83\xbe\xc2\x20\x8f\xa1\xc6\x49\x83\x5e\x17\xda\x6b\x35\x01\xa8\xf5\x0e\x6b\x89\x4f\x00\xda\x39\x05\x72\xb4\x05\x8a\x91\x2e\x32\xbe\xa4\x86\xb2\xaf\x9f\xfa\xa3\x43\x05\xc6\xee\x59\x8b\xe7\x90\xfb\xc0\xd8\x95\x70\x67\x6c\x15\x01\x6a\x93\x6b\x6f\xc8\x26\x17\xca\x5d\x1a\x53\x8d\x0a\x74\x8d\xec\x13\x0e\x35\xf6\x08\x97\xa4\xe4\x09\x8f\x0a\x1a\x53\x14\x35\x3e\xeb\xa1\xf6\x3f\xd7\x53\x30\xf5\x1c\x70\x3a\x01\xb0\xba\xc1\x0b\xe9\xa4\xa3\x97\x44\xf9\x49\x87\x64\x6c\x39\x80\x89\xc3\x3c\x9a\x50\x32\x05\x37\xd8\x91\xfc\xfb\xeb\x91\x2b\x40\xf7\xac\x86\x17\x80\x57\x70\x1f\xb3\x60\x4f\x0c\xda\x1e\x2f\x75\x62\x05\xb8\x7f\xf8\x05\xd0\x16\x8e\x8c\xf5\xf0\x5c\x99\xbc\x82\x4a\x0b\x68\xa8\x4c\x59\x01\x6b\x8f\x40\x7b\x78\xff\xe1\xc3\x03\xac\x3f\x7e\x04\x46\x71\x64\xe5\x8c\x05\x90\xf4\x6e\x29\xf8\x39\x08\xfe\x66\x6b\x63\xf1\x11\xc5\xdf\x3b\xf3\xde\x94\xd5\xbb\xa1\xf4\x3b\x66\xe2\x47\xed\xf1\x7c\x0f\x40\x5b\x4b\xbe\x73\x6d\x50\x3a\xfe\x1b\x14\xd1\x25\x2a\xd8\x24\x37\x71\xe8\x30\xa0\x07\x01\x41\x3e\x98\x1c\xe1\x74\x7a\xdd\x4f\x4f\x3a\x44\xda\x16\x8c\x05\x47\xc5\xd5\x91\xa3\xa2\x6d\x17\x9d\x93\xe2\xf4\xcd\xad\x73\x2c\x26\x74\xda\x2d\x60\xa4\xdb\xab\x9f\x8d\x92\x21\xa7\x02\x61\x73\x07\xeb\xe5\x72\x1e\x4d\x39\x9d\x5e\x1f\x74\x1d\xb0\x6d\xfb\x7c\x01\x87\x0c\x82\x39\xd9\x02\xc8\x5e\x6c\x7d\x73\x01\x8b\x33\x18\xa9\x5c\xe1\x07\x36\x6d\xfb\x26\xbd\x51\x2a\xe4\xb6\x81\x6b\x05\x95\xf7\x4e\x54\x96\x95\xc6\x57\x61\xd7\xad\xd3\xd8\x3f\x43\xe7\xc7\x6c\x57\xd3\x2e\x6b\xb4\x78\xe4\xac\xa0\x3c\x6b\xb4\xd5\xa5\xb1\xe5\x16\xed\xc1\x30\xd9\x06\xad\x97\xcc\x93\x33\xb9\x64\xe3\xd0\x6c\x85\x5c\xaa\x0b\xca\x5f\xed\x83\x20\x75\xae\x32\x8a\xd7\xce\xc4\xe6\x8f\xf4\x3b\x6d\x7c\xdb\x3a\xfc\xcb\xb1\x82\xcd\x0d\xe7\x99\x84\x66\x16\xf3\x66\x91\xf4\x36\x36\x03\x79\xcb\xf8\x39\xa0\x78\xd9\xf6\xbe\xc8\x36\xa7\x60\xfd\x49\xbc\xf6\x41\xee\xbe\x4c\xd7\x69\x3a\x5d\xc0\x27\xda\xdd\x7d\x99\x8e\x72\xba\xd5\x98\xb6\x7f\xac\x9b\x3f\xe7\x73\xd8\x1d\x61\xf6\x89\x76\x0b\x47\xc5\xe2\xdc\xab\x45\x6f\xe4\x22\xb0\x59\x0c\x6d\x9f\xcf\x21\xfb\x7e\x42\x2f\xc4\x60\x03\xcb\x74\x75\xc5\x62\x4f\xac\x60\xdd\x5c\x45\xae\xbf\x74\xe3\x4f\xf0\x80\x6c\xfc\x51\xc1\xb3\x66\x6b\x6c\x39\xf9\xbe\xed\x45\x46\x78\xbb\xfc\x29\x0e\xe7\x28\x13\x2a\x7d\x40\xd0\x50\x6b\x8f\x36\x3f\xc6\xa3\x0d\xac\xfe\xeb\xfa\xfe\xda\xdf\xfe\x1f\x2c\x6f\x9c\xdc\xb3\xd8\xf8\xc9\xfb\x41\xb6\x70\x90\xf4\x8d\x1d\xac\x8c\x78\x2a\x59\x37\xdb\xcf\x41\x5b\x6f\x6a\x9c\x2d\xd3\xb7\x0b\xf8\xf6\x16\xec\x42\xfe\x84\x5f\x5f\x83\x0d\xac\xd2\xe5\x0b\xcc\xf6\xdf\x03\x00\x26\x50\x05\xf0\x32\x08\x00\x00"),
+		},
+		"/addons/ops/addon-ops-network-policies.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "addon-ops-network-policies.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 824,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x92\x41\x6b\xdb\x40\x10\x85\xef\xfb\x2b\x1e\xf8\x5a\xb9\xe4\xe4\x44\xd7\x92\x43\xa0\x94\x40\x4c\xef\xeb\xd5\xd8\x19\x24\xed\x2c\xb3\x63\xa7\x42\xe8\xbf\x97\xb5\x65\xc5\x05\x43\x7a\x93\xde\x7b\xb3\xf3\xcd\xec\x8e\x63\x05\xde\x63\xfd\x36\xc4\x86\x32\xe7\xf5\x2f\xb2\x0f\xd1\xf6\x55\x3a\x0e\x4c\x79\xfd\x1c\xfd\xae\xa3\x66\x9a\xdc\xca\xad\xb0\x7d\x27\xf4\x64\xca\x21\xc3\x2b\x21\x07\xf5\x89\x1a\xec\x06\x58\xb1\x24\xb2\x89\x72\x3c\x20\x9b\x0f\xed\x37\xec\x55\x7a\xb0\x65\xc8\x47\x44\xf4\x3d\xe5\xe4\x03\xb9\x95\xab\xe0\x13\xff\x26\xcd\x2c\xb1\x46\xbc\x74\xe5\x78\x58\xb7\x8f\x79\xcd\xf2\xfd\xf4\xe0\x80\x96\x63\x53\xe3\x16\x69\x70\x28\x00\xbe\xf1\xe6\x6b\x07\xe0\x7c\x68\x8d\x3c\xf3\x57\x33\xdd\xd9\xea\xfc\x8e\xba\x7c\x89\x01\x3e\xa5\xcf\xdc\xac\x5d\x7f\x4b\xc7\xaf\x7c\x1b\x12\xd5\xe0\xb8\x57\x9f\x4d\x8f\xc1\x8e\x4a\x77\x62\x41\xfa\x24\x91\xa2\xd5\xc8\xa4\x27\x0e\x54\xcd\x6b\x71\x40\x4e\x14\x2e\x3c\x49\x9a\x37\xea\x28\x98\xe8\x15\xb0\xf7\x16\xde\x7f\xfe\xc3\xfc\x35\xe1\xb9\xe8\xf9\x4f\x52\xca\x65\x95\x4b\x65\x85\x96\x86\xfa\x3e\xd9\x1c\x01\x24\x91\xfa\x42\x80\x97\xb8\x88\x27\xdf\x1d\xe9\x86\xa0\x5a\x0e\xa9\x9a\xdd\x3d\xb5\xdc\xc7\x3d\xbd\x8c\x4f\x7a\xe3\x70\x34\x3a\xa8\x37\x96\x38\xef\xa0\xe3\x30\x6c\x87\x74\x6d\x57\xe1\x25\x1e\xca\x24\x67\x9b\x2f\xdf\x57\xab\x3c\xa5\xcf\xe9\x96\xb7\xb4\x6c\x11\xe3\x34\xbb\x49\xd4\xe6\xb2\x12\x4d\x2a\x26\x41\xba\x1a\xdb\x1f\xaf\xb3\x5a\x9a\xab\xd5\x78\xda\x6c\x9e\xfe\x2f\xf8\xf0\xb8\x71\xe3\x58\x81\x62\x33\x4d\xee\xef\x00\xa4\xe4\xfd\x5e\x38\x03\x00\x00"),
 		},
 		"/addons/ops/addon-ops-server-alerting-rules.yml": &vfsgen۰CompressedFileInfo{
 			name:             "addon-ops-server-alerting-rules.yml",
 			modTime:          time.Time{},
 			uncompressedSize: 2112,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x54\xdf\x8b\xdc\x36\x10\x7e\xbf\xbf\x62\xd8\xa4\x64\x17\xd6\xf6\xde\xc3\x3e\x44\x70\x0b\xf7\x90\x92\x42\xa1\xc7\x11\x4a\xa0\x14\x23\xdb\xb3\xb6\x72\xb6\x46\x99\x91\xf6\xba\x2c\x9b\xbf\xbd\x48\xde\x9f\x6d\x68\x48\xc8\x4b\xe3\x27\x59\x1a\xcd\xf7\xcd\x37\xf3\x49\x3b\xf3\x3b\xb2\x18\xb2\x0a\x06\xb2\xc6\x13\x1b\xdb\xe6\x35\x31\x92\xe4\x35\x0d\xc5\xe6\xf6\xe6\xc9\xd8\x46\xc1\x03\xd3\x80\xbe\xc3\x20\x8f\xa1\xc7\x9b\x01\xbd\x6e\xb4\xd7\xea\x06\xa0\xd7\x15\xf6\x12\x57\x00\xda\x39\x05\xb2\xb5\x0d\x8a\x91\xb4\x73\xfc\xc9\x0d\x15\xff\x7d\xea\xb7\x0e\x15\x18\xbb\x66\x2d\x9e\x43\xed\x03\x63\x0a\x72\x27\x6c\x15\x01\x7a\x53\x6b\x6f\xc8\x66\x67\xca\x29\x8c\xa9\x47\x05\xba\x47\xf6\x19\x87\x1e\x47\x84\x73\x50\xf6\x84\x5b\x05\x83\x69\x9a\x1e\x9f\xf5\x21\xf7\xe7\xf3\x29\x98\x78\x0e\x38\xb9\x01\xb0\x7a\xc0\x33\xe9\x2c\xd1\xcb\x04\x79\x83\x9c\x25\xac\x98\x79\x84\x13\x87\x75\x94\xa1\x65\x0a\xee\x20\x48\xf6\x35\x09\x20\x7d\x69\xad\x0e\x3f\x00\x2f\xe0\x3e\x46\xc1\x9a\x18\xb4\xdd\x9e\x33\x8d\x39\xe0\xfe\xe1\x17\x40\xdb\x38\x32\xd6\xc3\x73\x67\xea\x0e\x3a\x2d\xa0\xa1\x33\x6d\x07\xac\x3d\x02\xad\xe1\xed\xbb\x77\x0f\xb0\x7c\xff\x1e\x18\xc5\x91\x95\x13\x5a\xa4\x98\x68\x28\xf8\x39\x08\xfe\x66\x7b\x63\xf1\x11\xc5\xdf\x3b\xf3\xd6\xb4\xdd\x9b\x43\xea\x37\xcc\xc4\x8f\xda\xe3\xe9\x1e\x80\xb6\x96\x7c\xd2\xee\x82\x6f\x12\x1d\x45\x74\x8b\x0a\x56\xd9\xd5\x3e\x24\x0c\x18\x41\x20\x16\x60\x6a\x84\xdd\xee\xe5\x38\x43\xf9\x61\x67\xbf\x07\x63\xc1\x51\x73\x71\xe4\xa8\xd9\xef\xe7\x49\x4d\x71\xfa\xea\xd6\x69\x2f\x06\xa4\xda\x2d\x60\xa4\x3b\x56\x3f\x3d\x96\x0c\x35\x35\x08\xab\x3b\x58\x2e\x16\xb3\x28\xca\x6e\xf7\x72\xa3\xfb\x10\xf1\x52\xbc\x80\x43\x06\xc1\x9a\x6c\x03\x64\xcf\xb2\xbe\x3a\x83\xc5\x49\x8c\x54\x2e\xf0\x03\x9b\xfd\xfe\x55\x7e\x55\xa9\x90\x2b\x03\xf7\x0a\x3a\xef\x9d\xa8\xa2\x68\x8d\xef\x42\x95\x4c\x75\xec\xa0\xa1\xd3\xb2\xa8\x7a\xaa\x8a\x41\x8b\x47\x2e\x1a\xaa\x8b\x41\x5b\xdd\x1a\xdb\x96\x68\x37\x86\xc9\x0e\x68\xbd\x14\x9e\x9c\xa9\xa5\x38\x8e\x4d\x29\xe4\x72\xdd\x50\xfd\x62\x1d\x04\x29\xa9\xca\x28\x5e\x3b\x13\x9b\x7f\xa4\x9f\x6a\xe3\xeb\xd6\xe1\x5f\x8e\x15\xac\xae\x38\x4f\x25\x0c\xd3\x18\x37\x8d\xa4\xcb\x71\xbe\x4a\xc6\x8f\x01\xc5\x4b\x39\xea\x22\x65\x4d\xc1\xfa\x9d\x78\xed\x83\xdc\x7d\x9a\x2c\xf3\x7c\x32\x87\x0f\x54\xdd\x7d\x9a\xfc\x63\x36\x27\xfb\x3f\x96\xc3\x9f\xb3\x19\x54\x5b\x98\x7e\xa0\x6a\xee\xa8\x99\x9f\xba\x35\x1f\xa5\x9c\x07\x36\xf3\x43\xe3\x67\x33\x28\xbe\x9d\xd2\x77\xe3\xb0\x82\x45\x7e\x7b\xc1\x63\x4d\xac\x60\x39\x5c\xec\x5c\xbe\x79\xa7\x96\xe3\x06\xd9\xf8\xad\x82\x67\xcd\x36\xbe\x4a\xdf\xea\x61\x64\x84\xd7\x8b\x9f\xe2\x88\x1e\x4b\x85\x4e\x6f\x10\x34\xf4\xda\xa3\xad\xb7\xf1\x68\x05\xb7\x5f\x6b\xe2\x5f\xc7\xdb\xff\x03\x0b\xa7\xc7\xeb\x58\x6c\x94\xed\x07\xf1\x62\xff\xaf\x0e\x7c\xce\x89\x9d\x11\x4f\x2d\xeb\xa1\xfc\x18\xb4\xf5\xa6\xc7\xe9\x22\x7f\x3d\x87\x2f\x3b\xa1\x0a\xf5\x13\x7e\xc9\x0a\x2b\xb8\xcd\x17\xdf\x61\xbe\xff\x0e\x00\x00\xff\xff\xad\x21\xb6\x53\x40\x08\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x54\xdd\x8b\xdb\x46\x10\x7f\xf7\x5f\x31\x38\x29\xb1\xc1\x92\xec\x07\x3f\x64\xe1\x0c\xf7\x90\x92\x42\xa1\xc7\x11\x4a\xa0\x14\xb3\x96\xc6\xd2\xe6\xa4\x9d\xcd\xcc\xae\xaf\xc6\x28\x7f\x7b\x59\x7d\xf8\xa3\x94\x1e\x09\x79\x69\xec\x17\x69\x76\x76\x7e\x1f\x33\x23\xed\xcc\xef\xc8\x62\xc8\x2a\x68\xc8\x1a\x4f\x6c\x6c\x99\xe6\xc4\x48\x92\xe6\xd4\x64\x87\xd5\xe4\xc9\xd8\x42\xc1\x03\x53\x83\xbe\xc2\x20\x8f\xa1\xc6\x49\x83\x5e\x17\xda\x6b\x35\x01\xa8\xf5\x0e\x6b\x89\x4f\x00\xda\x39\x05\x72\xb4\x05\x8a\x91\x2e\x32\xbe\xa4\x86\xb2\xff\x3e\xf5\x47\x87\x0a\x8c\xdd\xb3\x16\xcf\x21\xf7\x81\xb1\x2b\xe1\xce\xd8\x2a\x02\xd4\x26\xd7\xde\x90\x4d\x2e\x94\xbb\x34\xa6\x1a\x15\xe8\x1a\xd9\x27\x1c\x6a\xec\x11\x2e\x49\xc9\x13\x1e\x15\x34\xa6\x28\x6a\x7c\xd6\x43\xed\x7f\xaf\xa7\x60\xea\x39\xe0\x74\x02\x60\x75\x83\x17\xd2\x49\x47\x2f\x11\xe4\x03\x72\xd2\x61\x19\x5b\x0e\x70\xe2\x30\x8f\x36\x94\x4c\xc1\x0d\x86\x24\x5f\x53\x20\xf2\x05\xe8\x9e\xd5\xf0\x02\xf0\x0a\xee\x63\x16\xec\x89\x41\xdb\xe3\xa5\x52\x5f\x03\xee\x1f\x7e\x01\xb4\x85\x23\x63\x3d\x3c\x57\x26\xaf\xa0\xd2\x02\x1a\x2a\x53\x56\xc0\xda\x23\xd0\x1e\xde\x7f\xf8\xf0\x00\xeb\x8f\x1f\x81\x51\x1c\x59\x39\xa3\x01\x24\xbd\x67\x0a\x7e\x0e\x82\xbf\xd9\xda\x58\x7c\x44\xf1\xf7\xce\xbc\x37\x65\xf5\x6e\x28\xfd\x8e\x99\xf8\x51\x7b\x3c\xdf\x03\xd0\xd6\x92\xef\xbc\x1b\xd4\x8e\xff\x06\x45\x74\x89\x0a\x36\xc9\x4d\x1c\x3a\x0c\xe8\x41\x20\x0a\x30\x39\xc2\xe9\xf4\xba\x9f\xa1\x74\x88\xb4\x2d\x18\x0b\x8e\x8a\xab\x23\x47\x45\xdb\x2e\x3a\x37\xc5\xe9\x9b\x5b\xe7\x58\x4c\xe8\xb4\x5b\xc0\x48\xb7\x57\x3f\x1b\x25\x43\x4e\x05\xc2\xe6\x0e\xd6\xcb\xe5\x3c\x9a\x72\x3a\xbd\x3e\xe8\x3a\x60\xdb\xf6\xf9\x02\x0e\x19\x04\x73\xb2\x05\x90\xbd\xd8\xfa\xe6\x02\x16\x27\x31\x52\xb9\xc2\x0f\x6c\xda\xf6\x4d\x7a\xa3\x54\xc8\x6d\x03\xd7\x0a\x2a\xef\x9d\xa8\x2c\x2b\x8d\xaf\xc2\xae\x5b\xaa\xb1\x83\x86\xce\x8f\xd9\xae\xa6\x5d\xd6\x68\xf1\xc8\x59\x41\x79\xd6\x68\xab\x4b\x63\xcb\x2d\xda\x83\x61\xb2\x0d\x5a\x2f\x99\x27\x67\x72\xc9\xc6\xb1\xd9\x0a\xb9\x54\x17\x94\xbf\xda\x07\x41\xea\x5c\x65\x14\xaf\x9d\x89\xcd\x1f\xe9\x77\xda\xf8\xb6\x75\xf8\x97\x63\x05\x9b\x1b\xce\x33\x09\xcd\x2c\xe6\xcd\x22\xe9\x6d\x6c\x06\xf2\x96\xf1\x73\x40\xf1\xb2\xed\x7d\x91\x6d\x4e\xc1\xfa\x93\x78\xed\x83\xdc\x7d\x99\xae\xd3\x74\xba\x80\x4f\xb4\xbb\xfb\x32\xfd\xc7\x6c\x4e\xdb\x3f\xd6\xcd\x9f\xf3\x39\xec\x8e\x30\xfb\x44\xbb\x85\xa3\x62\x71\xee\xd6\xa2\xb7\x72\x11\xd8\x2c\x86\xc6\xcf\xe7\x90\x7d\x3b\xa5\xef\xc6\x61\x03\xcb\x74\x75\xc5\x63\x4f\xac\x60\xdd\x5c\x45\xae\xbf\x79\xe3\x4f\xf0\x80\x6c\xfc\x51\xc1\xb3\x66\x6b\x6c\x39\xf9\xd6\x1d\x46\x46\x78\xbb\xfc\x29\x8e\xe8\x28\x15\x2a\x7d\x40\xd0\x50\x6b\x8f\x36\x3f\xc6\xa3\x0d\xac\xbe\x76\x89\x7f\xed\x6f\xff\x0f\x56\x38\xce\xef\x59\x6c\xfc\xf4\xfd\x20\xbb\x38\x48\x7a\x61\x13\x2b\x23\x9e\x4a\xd6\xcd\xf6\x73\xd0\xd6\x9b\x1a\x67\xcb\xf4\xed\x02\x5e\xde\x84\x5d\xc8\x9f\xf0\xa5\x55\xd8\xc0\x2a\x5d\x7e\x87\xf9\xfe\x7b\x00\xad\x21\xb6\x53\x40\x08\x00\x00"),
 		},
 		"/addons/ops/addon-ops-servicemonitor.yml": &vfsgen۰CompressedFileInfo{
 			name:             "addon-ops-servicemonitor.yml",
 			modTime:          time.Time{},
 			uncompressedSize: 536,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x91\xc1\x4e\x03\x31\x0c\x44\xef\xfb\x15\xfe\x81\x6d\xc5\x35\x77\x0e\x48\x20\x21\x21\x71\x77\x93\xa1\x44\xdd\xd8\x91\xe3\x16\xfa\xf7\x28\xbb\x65\x8b\x44\x05\xc7\x8c\xc7\xce\x1b\x9b\x6b\x7e\x85\xb5\xac\x12\xa8\xa8\x64\x57\xcb\xb2\xdf\x44\x35\x68\xdb\x44\x2d\xdb\xd3\xdd\x70\xc8\x92\x02\xbd\xc0\x4e\x39\xe2\x69\x71\x0d\x05\xce\x89\x9d\xc3\x40\x24\x5c\x10\xa8\x9d\x25\xa1\xe5\x36\x66\x79\x33\x1e\x88\x26\xde\x61\x6a\xdd\x40\xc4\xb5\x5e\x1d\xb3\xf2\xfd\xd8\x64\xdd\xfe\x5d\x8d\x5a\xaa\x0a\xc4\x03\xb5\x05\x62\xbc\xb0\xfe\xb2\xfa\xb9\x22\xd0\x0c\xd0\xdc\x8e\xd1\x8f\x86\xd9\x74\x0d\x37\x1e\x70\x0e\x54\x72\x4a\x13\x3e\xd8\x30\xb4\x8a\xd8\x21\x21\xa9\x6a\x16\x9f\x89\x47\x72\xb6\x3d\xfc\x59\xcd\x03\x15\xb8\xe5\xd8\xc9\x1a\x26\x44\x57\x5b\x52\x15\xf6\xf8\xfe\xf8\x23\xe6\x7f\xb1\xe6\x86\xfb\xcf\x6a\x68\x7d\xe9\x6b\xd7\x48\x33\xd5\xcd\xd4\x17\x0b\x91\x56\x18\xf7\xbf\xe9\x41\x56\xf1\xc4\xd3\x11\xeb\x9c\x65\xd6\x7a\x89\xb4\xbb\xad\xf7\xe3\xdd\xae\xf4\x05\xc3\x86\xaf\x00\x00\x00\xff\xff\x64\xc0\xa3\x41\x18\x02\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x91\xc1\x4a\x03\x31\x10\x86\xef\xfb\x14\xf3\x02\x69\xf1\x9a\xbb\x07\x41\x41\x10\xbc\x4f\x93\xdf\x1a\xba\xc9\x84\xc9\x74\xb5\x6f\x2f\x49\xeb\x56\xb0\x28\xec\x65\x67\xbe\xc9\x7c\x7f\xc2\x35\xbd\x42\x5b\x92\xe2\x29\x4b\x49\x26\x9a\xca\x7e\x13\x44\x21\x6d\x13\x24\x6f\x97\xbb\xe9\x90\x4a\xf4\xf4\x02\x5d\x52\xc0\xd3\x99\x9a\x32\x8c\x23\x1b\xfb\x89\xa8\x70\x86\xa7\x76\x2a\x11\x2d\x35\x97\xca\x9b\xf2\x44\x34\xf3\x0e\x73\xeb\x00\x11\xd7\x7a\x25\x46\xe5\xfb\x67\x93\x64\xfb\x77\x37\x48\xae\x52\x50\xcc\x53\x3b\x4b\xb8\x8b\xeb\x2f\xd4\x4e\x15\x9e\x86\x40\x33\x3d\x06\x3b\x2a\x06\x74\x0d\xe7\x0e\x38\x79\xca\x29\xc6\x19\x1f\xac\x98\x5a\x45\xe8\x92\x28\xb1\x4a\x2a\x36\x8c\x1d\x19\xeb\x1e\xf6\x2c\x6a\x9e\x32\x4c\x53\xe8\x66\x0d\x33\x82\x89\x76\x86\x28\xb3\x85\xf7\xc7\x1f\x31\xff\x8b\x35\x06\xee\x3f\xab\xa2\xf5\x4b\x5f\xa7\x1c\x0d\xab\x9b\xa9\x2f\x08\x91\x54\x28\xf7\xdd\xf4\x50\xd6\xe2\xc2\xf3\x11\xeb\x39\xfd\x73\xeb\x4a\x17\x77\xb7\xeb\xfd\xf1\x6e\x77\x1a\x74\x81\x4e\x5f\x03\x00\x64\xc0\xa3\x41\x18\x02\x00\x00"),
 		},
 		"/addons/publicApi": &vfsgen۰DirInfo{
 			name:    "publicApi",
 			modTime: time.Time{},
 		},
+		"/addons/publicApi/addon-public-network-policies.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "addon-public-network-policies.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 1240,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x92\xcd\x6a\xc3\x30\x0c\xc7\xef\x79\x0a\xbd\x80\xb3\x8e\xf5\x50\x7c\x1d\x3b\x0c\xc6\x28\xb4\xec\xee\x3a\x6a\x2b\xea\x58\x46\x76\xba\x85\x90\x77\x1f\x59\xe2\x7d\x40\x47\x4b\x61\xb7\xdd\xa2\xe8\xeb\xf7\x97\xff\x5d\xa7\x80\xb6\x50\xae\x5a\x5f\x61\xa4\x58\x3e\x63\x7a\x65\x39\x2c\xd9\x91\x25\x8c\xe5\x83\x37\x1b\x87\x55\xdf\x17\x0a\x4c\xa0\x17\x94\x48\xec\x35\xf8\xb1\x8e\xfc\xae\x3c\x2c\x62\x49\x7c\x73\xbc\x2d\x00\x0e\xe4\x2b\x0d\xdf\x87\xb4\x05\x40\x8d\xc9\x54\x26\x19\x5d\x00\x00\x78\x53\xa3\x86\x38\x6d\x54\xa1\xd9\x38\xb2\x8a\x4d\x93\xf6\x41\xf8\x6d\x68\x00\x70\x66\x83\x2e\x8e\x0d\x00\x26\x84\xaf\x8e\xe9\x5f\x0e\x87\xdd\xe7\xf2\xa9\x0d\xa8\x81\xfc\x56\x4c\x4c\xd2\xd8\xd4\x08\x9e\x28\xb3\x5c\x07\xf6\xe8\xd3\x19\xbc\x18\xd0\x8e\x68\x81\xab\x15\x3a\xb4\x89\x25\xb3\xd6\x26\xd9\xfd\xd3\x0f\xfc\xd3\x02\xce\x4b\xb8\x8e\x6e\xa0\x72\x64\xdb\x75\x1b\x70\x42\x50\xf0\xe8\x77\x82\x71\x1c\x4c\xe3\x77\x4e\x05\x96\x34\x05\x1f\xa1\x70\x62\xcb\x4e\xc3\xfa\x7e\xf9\x09\x32\x14\x69\x58\xcc\xe7\x77\x7f\x66\x84\x88\x72\x44\xc9\x92\x4c\xa0\x7f\x23\x5c\x6e\x84\xf1\x78\x57\xbc\xfe\x56\xb8\xce\x78\xea\x94\x8c\x5f\xa5\x5c\x06\x7e\xed\x69\xb3\xe9\x2e\x75\xe6\x6c\x31\x2b\xba\x4e\x01\xfa\xaa\xef\x8b\xf7\x01\x00\x1f\x6b\x19\x27\xd8\x04\x00\x00"),
+		},
 		"/addons/publicApi/addon-public-oauthproxy.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "addon-public-oauthproxy.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 4444,
+			uncompressedSize: 4818,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x58\xdf\x6f\xdb\xb6\x13\x7f\xcf\x5f\x41\xe8\xa5\xdf\x2f\x50\x59\x69\xd7\x0e\x03\x01\x3f\x04\x6e\xba\x04\x5d\x13\x23\xf6\x86\xed\x29\x38\x53\x17\x99\x33\x45\x72\xe4\xc9\x9b\x66\xf8\x7f\x1f\x28\x59\x8a\x65\xc9\x89\x5b\x0c\xc8\x36\x3d\x45\xba\x3b\xf2\x73\xbf\x3f\x71\xcc\xc0\xca\x9f\xd0\x79\x69\x34\x67\xeb\x37\x67\x8c\xad\xa4\x4e\x39\x9b\xa1\x5b\x4b\x81\x17\x42\x98\x42\xd3\x19\x63\x39\x12\xa4\x40\xc0\xcf\x18\x63\x4c\x43\x8e\x9c\xf9\x52\xa7\xe8\xa5\x8f\x6d\xb1\x50\x52\xc4\x06\x0a\x5a\x5a\x67\xfe\x28\x2b\x25\x05\x0b\x54\xbe\x36\x60\x0c\xac\x7d\xb4\xd8\x7d\x6b\x5e\x47\xd2\x24\xcf\xc9\xa9\xb4\xc8\x99\xd4\x0f\x0e\x3c\xb9\x42\x50\xe1\x70\x40\x4d\x98\xdc\x1a\x8d\x9a\x9e\x84\xf7\x9c\xdf\x3d\x87\xff\xc1\xbe\x54\x80\xb4\x36\x04\x24\x8d\x6e\x31\xfa\xda\x93\x11\x28\xbb\x84\x91\xb1\xa8\xfd\x52\x3e\x50\x38\xb7\x12\xe9\x2c\x16\xe8\x28\xf6\x28\x1c\x52\xfc\x5c\x46\x63\x52\xfe\xd4\xd4\x7b\x8b\xa2\x86\x61\x8d\xa3\x1d\xa2\xb8\x7a\xe1\xec\xbb\x77\xef\xbe\xd9\x41\xb4\xce\x90\x11\x46\x71\x36\x9f\x4c\x77\xdf\x08\x5c\x86\x34\xed\xaa\x7a\x54\x28\xc8\xb8\xbf\x2b\xfe\x5f\x53\x24\xce\x14\x84\xdd\x38\xee\xd5\xcd\x5d\x90\xbe\x54\xd5\x0c\x64\x5f\x18\xed\x8d\x1a\xcc\xbe\x59\x87\xf4\xe3\xef\x31\x58\x1b\x57\x5e\x71\x16\x91\x2b\x30\x7a\x22\xbf\x60\x65\x27\xb1\x4b\xe3\x89\xb3\xcd\x86\x8d\x66\x0d\xc2\x8b\x34\x35\xda\x8f\xa6\x95\xc1\x85\x95\xa3\x2a\x26\x57\xc6\x53\x38\x92\x6d\xb7\x6d\x45\xf0\xa7\x72\x4d\x8f\x01\x93\xda\xa3\x28\x1c\x5e\xa6\x19\xce\xd1\xe5\x52\x57\x4e\x4e\x8d\x92\xa2\xe4\xec\x0e\x53\xe9\x50\x50\x73\xda\xa3\x06\x67\x0e\x51\x0b\x57\xda\x5a\x48\xa6\x39\xf2\xb0\xcb\x4f\xaa\xe8\x6e\x21\x80\xb5\xfe\x68\x1d\x7c\x40\xab\x4c\x99\xa3\xa6\x89\xd1\x0f\x32\xfb\xb7\x0d\x92\x2f\xea\x6e\x87\x56\x49\x01\x9e\xb3\x37\x2f\xd8\xa6\x95\x15\x39\x20\xcc\xca\xe6\x66\x87\xde\x14\x4e\x60\x1b\x68\xc6\x94\xcc\x25\xed\xbd\x87\xc4\xe4\xc6\x95\x9c\x45\x6f\xdf\x7f\xfb\x59\x46\xad\xc4\xe1\x6f\x05\xfa\x63\xba\xe7\x8f\xaa\x75\xf4\xef\xc2\x00\x05\xaa\xe3\x4e\x98\x5b\x05\x84\x8d\x6d\x37\xf9\xfd\x02\x38\x16\xa6\x53\x42\xf5\x05\xc5\xf0\xf5\x91\xdd\xcf\xf7\x6e\xb0\x10\x48\x8d\x6e\xcf\x85\xf8\x34\x3e\xb0\x6b\xea\x1c\x32\xe4\xec\x55\x67\x76\x4c\x1a\x3c\x7e\x74\x1b\x8c\x46\xd7\x41\x8b\x6d\xb7\xaf\xf6\xe3\xe4\xb2\x4e\xdc\xc2\xc5\x71\x6c\x9d\x59\xcb\x14\xdd\xb8\x6d\xc9\x9e\x8a\x50\x12\x35\xc5\x32\x1d\xfb\xd2\x13\xe6\x7c\xb7\x1b\xa1\x66\x37\x7c\xb3\x19\xdd\x5a\xd4\xb3\x60\x3c\x75\xe6\x57\x14\xb4\xdd\xf2\xd6\x9b\xca\x8d\xdd\x21\xbd\xb3\x0b\xeb\xc9\x21\xe4\xe3\x25\x91\xe5\x49\xd2\x5a\x85\x3b\xd0\x25\x60\x65\xb2\x7e\x93\xd4\x11\x49\x7a\xe6\xa4\x7c\xb5\x86\xc7\x09\x92\x48\x48\xf9\xc4\x3a\xb9\x06\xc2\xf0\xf7\x48\xb8\xfe\x85\xc1\x62\x85\xe5\xb0\xc1\x0a\xcb\x7e\x80\xc0\xfb\xb8\xf0\xe8\xe2\x05\x82\x43\x17\x93\x59\xa1\xee\xa9\xf9\x95\xb4\x6d\x30\xe3\x45\x41\x64\xfa\x4a\x60\x83\x06\xa8\xa0\x98\x5b\x1a\x43\x41\xa6\xa7\xd4\x26\x22\x16\x50\xc3\xb4\x2b\x59\x41\x0d\x9e\xfa\x44\x40\xbc\x28\x74\xaa\x70\xd0\xbf\xae\xf5\x1a\x5c\xe2\x0a\x9d\xd4\x2c\xc5\x27\xab\x62\x81\x4e\x23\xa1\x6f\x89\x4c\x9b\xc7\x44\xc0\x33\x27\xa6\xa8\x30\x03\xc2\xb8\x70\xca\x8f\x37\x51\x37\x3b\x11\xdf\x44\xa1\x90\xbd\x05\x81\x11\x8f\x06\xcb\x22\x7a\x1d\x35\xc3\x25\xe2\x91\x35\xa9\x8f\x5e\x47\x6b\x74\x8b\x88\x47\x19\x52\xb4\xdd\x9e\x6d\x36\x4c\x3e\xb0\xff\x69\x43\x4f\x6d\xc7\x0f\xd2\xc3\x42\xe1\x0c\xdc\x64\x89\x62\xf5\xff\x66\x41\x0e\x23\xf7\xe0\xc6\x3d\x74\xc7\xdb\x67\x06\xee\xa6\xd1\x3d\x01\x73\x80\x8c\x3a\xed\x42\x40\xbd\xde\xef\xb6\xa6\xc9\x6f\x2f\x7e\x9c\x5f\xbd\xbd\x9f\xde\xdd\xfe\xfc\xcb\xfd\xe4\xf6\xf6\xd3\xf5\xe5\xfd\xec\x72\x72\x77\x39\xef\xc0\x5f\x83\x2a\xf0\xa3\x33\x79\xb7\x63\xc3\x92\x08\x99\xfc\x84\xe5\x1d\x3e\x1c\xca\x7a\xfb\x27\x53\x66\x01\x2a\x16\xcd\x2e\xed\x3e\x2b\x2c\x77\x78\x8e\x02\x19\x46\xfd\xc3\xf5\xe5\xcd\xfc\x65\x51\x4f\x2f\x6f\x66\x57\xd7\x1f\xe7\xf7\x3b\xfc\x47\x20\xed\x11\xe8\xc6\xa1\x76\x02\x4f\x0f\xf9\xf4\x3e\x98\xba\xa4\x3b\x82\x21\xba\xcd\xaa\x7d\x07\xa9\xd4\xe8\xfd\xd4\x99\x05\x76\xbd\x0b\x53\xed\x7b\xa4\x43\x97\x7b\x54\xbe\x15\x00\x2d\x39\x4b\xaa\x91\x99\x2c\x11\x14\x2d\xff\x3c\x0c\xa5\x58\x62\x40\x78\x35\x9f\x4f\x67\x1d\x99\xd4\x92\x24\xa8\x0f\xa8\xa0\x9c\xa1\x30\x3a\x0d\xd4\xe2\x7d\x47\x87\x64\x8e\xa6\xa0\x47\xf1\xf9\x9e\x58\xc9\x35\xfe\x27\x1c\x59\x1b\x55\xe4\xf8\x39\x4c\xb5\x83\xec\xe7\xe1\xdb\xb4\x06\x77\xb0\x01\x06\xaa\xe0\x84\xff\xe7\xea\x67\x80\x30\xb1\x41\xd2\xc4\xf6\xc8\xd0\xdb\xf3\xf3\xcf\xb2\x23\x1b\xa2\x4e\x5d\x8b\x3d\x03\xdf\xf9\x8d\xe1\xe6\x54\x0a\x51\xc7\xe6\x8b\xe8\xc7\x81\xb3\x75\x33\x77\x31\xd6\xdf\x9e\x03\xd1\x1e\x44\x4e\x66\x59\x4b\x82\xe2\x1d\x15\xac\x39\xff\x64\x09\x3a\xc3\xb3\xbf\x02\x00\x00\xff\xff\xd7\x62\xcd\x88\x5c\x11\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x58\xdf\x6f\xdb\x36\x10\x7e\xef\x5f\x41\xe8\xa5\x1b\x50\x59\x69\xd7\x0e\x83\x00\x3f\x04\x6e\xba\x04\x6d\x13\x23\xf6\x86\xed\x29\x38\x53\x17\x9b\x33\x45\x72\xe4\xc9\x9b\x66\xf8\x7f\x1f\xa8\x5f\x96\x2c\x39\x4e\x82\x02\x19\x66\xf7\xa1\x16\xbf\x23\xbf\xbb\xfb\xee\x78\x4a\xc8\xc0\x88\x5f\xd1\x3a\xa1\x55\xcc\x36\x6f\x5f\x31\xb6\x16\x2a\x89\xd9\x0c\xed\x46\x70\x3c\xe7\x5c\x67\x8a\x5e\x31\x96\x22\x41\x02\x04\xf1\x2b\xc6\x18\x53\x90\x62\xcc\x5c\xae\x12\x74\xc2\x85\x26\x5b\x48\xc1\x43\x0d\x19\xad\x8c\xd5\x7f\xe7\x05\x48\xc2\x02\xa5\x2b\x0d\x18\x03\x63\xf6\x16\xd5\xb3\xfa\xe7\x48\xe8\xe8\xd4\x3a\xe5\x06\x63\x26\xd4\xbd\x05\x47\x36\xe3\x94\x59\x1c\x80\x71\x9d\x1a\xad\x50\xd1\x83\xf4\x4e\xf9\xdd\x73\xf8\x3f\xec\x8b\x37\x03\xa5\x34\x01\x09\xad\x1a\x8e\xae\xf4\x64\x04\xd2\xac\x60\xa4\x0d\x2a\xb7\x12\xf7\xe4\x8f\x2f\x96\xd4\x32\xe4\x68\x29\x74\xc8\x2d\x52\x78\x2a\xa3\x21\x49\xf7\xd8\xd4\x3b\x83\xbc\xa4\x61\xb4\xa5\x8a\x51\x58\xfc\x88\xd9\x4f\xef\xdf\xff\x50\x51\x34\x56\x93\xe6\x5a\xc6\x6c\x3e\x99\x56\xcf\x08\xec\x12\x69\xda\x85\x3a\x94\xc8\x49\xdb\x6f\x15\xff\xe7\x88\xc4\xea\x8c\xb0\x1b\xc7\x96\x6e\x6e\xfd\xea\x4b\xa9\x66\x20\xfb\x5c\x2b\xa7\xe5\x60\xf6\xf5\xc6\xa7\x1f\xff\x0a\xc1\x98\xb0\xf0\x2a\x66\x01\xd9\x0c\x83\x07\xf2\x0b\x46\x74\x12\xbb\xd2\x8e\x62\xb6\xdd\xb2\xd1\xac\x66\x78\x9e\x24\x5a\xb9\xd1\xb4\x10\xc4\xb9\x11\xa3\x22\x26\x97\xda\x91\x97\x0c\xdb\xed\x1a\x45\xc4\x0f\xe5\x9a\xf6\x01\x13\xca\x21\xcf\x2c\x5e\x24\x4b\x9c\xa3\x4d\x85\x2a\x24\x3e\xd5\x52\xf0\x3c\x66\xb7\x98\x08\x8b\x9c\xea\xdd\xf6\x88\x98\x59\x44\xc5\x6d\x6e\xca\x45\xd2\xf5\x96\x87\x55\xfe\x28\x45\x6f\xb7\x21\x13\xf7\x6c\xf4\x11\x8d\xd4\x79\x8a\x8a\xdc\x6e\x77\x20\x0f\x30\xc6\xb5\x05\xb1\xc7\x16\xe6\x28\x1d\x0e\xda\x1c\x55\xd4\x7e\x83\x89\x56\xf7\x62\x59\x6e\xa3\x92\xdd\xee\xa5\x64\xf6\x9c\x1a\x7a\x72\xc7\xb0\x68\xa4\xe0\xe0\x62\xf6\xf6\xa0\xf4\x87\xb3\xe0\x31\x8c\xa5\x40\x7c\xf5\xa5\x13\x86\xe1\x40\x9c\x0e\xc5\x73\xbc\x6c\x65\xf8\xdb\xe4\xe0\x09\xc7\x16\x8a\x38\x1e\x1b\x47\x16\x08\x97\x79\x1d\x96\xf2\xea\xb9\xf5\x4d\x1f\x08\x0f\x99\x1f\xa2\x2d\x3a\x9d\x59\x8e\xad\xa8\x4a\x91\x8a\xba\xa7\x57\xc1\xc7\x54\xdb\x3c\x66\xc1\xbb\x0f\x3f\x7e\x15\x41\xb3\x62\xf1\xcf\x0c\xdd\x31\xec\xd9\x1e\x3a\xc4\xa9\x12\xba\x2f\xeb\xd4\x48\x20\xac\x77\xe9\x4a\xbf\x2f\xff\xe3\x99\x3f\x9d\x82\x27\x94\xc2\xf3\x32\x56\xd9\x35\x6a\xaf\x5a\x35\x81\x50\x68\x5b\x2e\x84\x8f\x29\x9a\xfa\x23\x52\x58\x62\xcc\x5e\x77\xba\xf1\xa4\xe6\xe3\x46\x37\xde\x68\x74\xe5\x51\x6c\xb7\x7b\xdd\xb2\x04\xbb\xec\xc4\x8d\xb1\x90\x85\xa1\xb1\x7a\x23\x12\xb4\xe3\xa6\x35\xf5\x20\x5c\x0a\x54\x14\x8a\x64\xec\x72\x47\x98\xc6\xd5\xb4\x01\xe5\xbc\x18\x6f\xb7\xa3\x1b\x83\x6a\xe6\xfb\xda\xd4\xea\x3f\x90\xd3\x6e\x17\x37\xde\x14\xb5\x5f\x6d\xd2\xdb\x3b\x33\x8e\x2c\x42\x3a\x5e\x11\x99\x38\x8a\x1a\x2b\x7f\x06\xda\x08\x8c\x88\x36\x6f\xa3\x32\x22\x51\xcf\x9c\xa4\x2b\x06\x9b\x71\x84\xc4\x23\x92\x2e\x32\x56\x6c\x80\xd0\xff\x7f\xc4\x6d\xff\x40\x6f\xb1\xc6\x7c\xd8\x60\x8d\x79\x3f\x40\xe0\x5c\x98\x39\xb4\xe1\x02\xc1\xa2\x0d\x49\xaf\x51\xf5\x60\x6e\x2d\x4c\x13\xcc\x70\x91\x11\xe9\x3e\x08\x8c\x47\x80\xf4\xc0\xd4\xd0\x18\x32\xd2\x3d\x50\x93\x88\x90\x43\x49\xd3\xac\x85\xf7\x27\xf2\x9e\xba\x88\x43\xb8\xc8\x54\x22\x71\xd0\xbf\xae\xf5\x06\x6c\x64\x33\x15\x95\x73\x9f\x8b\xd6\xd9\x02\xad\x42\x42\xd7\x8c\x86\x4d\x1e\x23\x0e\x27\x76\x4c\x50\xe2\x12\x08\xc3\xcc\x4a\x37\xde\x06\xdd\xec\x04\xf1\x36\xf0\x42\x76\x06\x38\x06\x71\x30\x28\x8b\xe0\x4d\x50\xb7\x99\x20\x0e\x8c\x4e\x5c\xf0\x26\xd8\xa0\x5d\x04\x71\xb0\x44\x0a\x8a\xf6\xe6\xef\xdf\xef\x94\xa6\x87\xe6\x8d\x8f\xc2\xc1\x42\xe2\x0c\xec\x64\x85\x7c\xfd\x7d\x3d\x72\x0c\x33\x77\x60\xc7\x3d\x76\xc7\xcb\x67\x06\xf6\xba\xc6\x3e\x82\xb3\xa7\x8c\x2a\xe9\x52\x40\xb5\x69\x57\x5b\x5d\xe4\x37\xe7\xbf\xcc\x2f\xdf\xdd\x4d\x6f\x6f\x7e\xfb\xfd\x6e\x72\x73\xf3\xf9\xea\xe2\x6e\x76\x31\xb9\xbd\x98\xb7\xc0\x8c\x6d\x40\x66\xf8\xc9\xea\xb4\x5b\xb1\xfe\x8a\xf4\x99\xfc\x8c\xf9\x2d\xde\x1f\xae\xf5\x6e\xdf\xa5\xd4\x0b\x90\x21\x2f\x67\x8a\x1a\x54\x7f\xd6\x98\x57\x7c\x8e\x12\x19\x66\xfd\xe5\xea\xe2\x7a\xfe\xb2\xac\xa7\x17\xd7\xb3\xcb\xab\x4f\xf3\xbb\x8a\xff\x11\x4a\xad\x57\x92\xda\xa1\xa6\x03\x1f\x8c\xa2\xf5\xb7\x24\x53\x4a\xba\xb3\x30\xf4\x02\xe3\xbf\x16\x21\x11\x0a\x9d\x9b\x5a\xbd\x68\x6e\xae\xf2\x9f\xef\x6a\x3f\x23\x75\x1f\x56\x73\x71\xff\x68\xc6\x0c\xd0\x2a\x66\x51\xd1\x32\xa3\x15\x82\xa4\xd5\x3f\x07\x10\xc7\x57\xe8\x19\x5e\xce\xe7\xd3\x59\x67\x4d\x28\x41\x02\xe4\x47\x94\x90\xcf\x90\x6b\x95\xf8\xc1\xea\x43\x07\x43\x22\x45\x9d\xd1\x7e\xf9\xac\xb5\x2c\xc5\x06\xff\x17\x8e\x6c\xb4\xcc\x52\xfc\xea\x6f\xa7\x83\xec\xa7\xfe\xd9\xb4\x24\x77\x70\x03\x0c\xa8\xe0\xf8\x8d\xdc\xbc\x21\x1f\x1d\x9d\x86\xc7\xa7\xf6\x58\xf4\xee\xec\xec\xab\xe8\xac\x0d\x0d\x51\x5d\x8b\x96\x81\xeb\xfc\xd5\xe6\xfa\x04\xe1\xc6\xac\x8c\x4d\xeb\x88\xf0\xa9\xce\x96\x2d\xa8\xcb\xb1\x7c\x76\x8a\x44\xb1\x51\x35\xbe\x16\xfd\xbd\x3f\xc2\x92\x15\xcb\x65\x33\x1e\x85\xd5\xb8\x58\xbe\x15\x4d\x56\xa0\x96\xed\x91\xf1\xdf\x01\x00\x14\xf2\xc0\x90\xd2\x12\x00\x00"),
 		},
 		"/addons/todo": &vfsgen۰DirInfo{
 			name:    "todo",
@@ -172,9 +193,16 @@ var assets = func() http.FileSystem {
 		"/addons/todo/04-todo-example.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "04-todo-example.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 3586,
+			uncompressedSize: 4086,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\x5f\x6f\xdb\x36\x10\x7f\xcf\xa7\x20\x8c\x01\x7e\x89\xe4\x64\xc3\x86\x82\x6f\xae\xe3\x36\x79\x48\x2b\x44\x5e\x5f\x86\x21\xa0\xa5\xb3\x4c\x84\xe2\x69\xe4\x31\xa9\x61\xe4\xbb\x0f\x12\xf5\x3f\x51\x9d\x01\x6b\x51\x3f\x99\x77\xc7\xfb\xf7\xfb\x1d\x75\xc7\xa3\xdc\xb1\x30\x3e\xe8\x14\xac\xb4\xe1\x32\x4d\x51\xdb\x70\x83\x29\x86\x6b\x2d\xb6\x0a\x52\xf6\xfc\x7c\x16\x30\x51\xc8\x2f\x60\xac\x44\xcd\xd9\xe3\xe5\x19\x63\x0f\x52\xa7\x9c\xc5\x60\x1e\x65\x02\x67\x8c\xe5\x40\x22\x15\x24\xf8\x19\x63\x8c\x29\xb1\x05\x65\xfd\x7f\xc6\x44\x51\x70\x66\xeb\x18\xb5\xac\x39\x86\x12\x17\x95\x9e\x30\xc5\x57\x74\x09\xe6\x05\x6a\xd0\xd4\xb3\xd0\x22\x87\xf6\x68\x0b\x48\x7c\xa0\x02\x0d\xd5\x31\x83\xea\xc0\xd9\xbb\x8b\x77\x17\xb5\xd3\xc2\x20\x61\x82\x8a\xb3\xcd\x2a\xaa\x65\x24\x4c\x06\x14\x0d\x4d\x2d\x28\x48\x08\xcd\xf7\xc8\x7e\xd4\x49\x83\x8e\x20\xc4\x02\xb4\xdd\xcb\x1d\x95\x37\x7a\xcd\xbd\x2b\xb5\x3f\x47\x6b\xf7\x68\x6b\xab\xe0\x78\xec\xe8\x52\x65\x78\x8d\x96\xca\x6b\xcf\xcf\x1e\x05\x41\x7b\xce\x16\x2d\x22\xfc\x5b\xbd\xa6\xae\x12\xa9\x2d\x24\xce\xc0\x3a\xcd\x60\x03\x26\x97\x5a\x90\x44\x1d\xa1\x92\xc9\x81\xb3\xa5\x52\xf8\xd4\xb8\xea\xd4\x9c\x41\x9a\x81\xf7\x85\x8d\xab\x31\x37\x5f\x14\x56\xfe\x9e\x40\x66\x7b\xe2\xec\xf2\xe2\x62\x0c\x8b\xcc\x45\x36\x0d\xcb\x4d\xa9\x8d\xc9\x80\xc8\xff\x57\x70\x26\x5a\xaf\x10\x1f\x5c\x51\xb7\xa1\x76\xa2\x30\x11\x8a\xb3\x9d\x50\xb6\xac\xcf\x92\x20\x57\x47\x25\x91\xb5\xf1\x03\x26\x09\xf2\xf6\x58\x29\x39\x53\x82\xc0\xd2\xb8\xe6\xad\x93\x2a\x9d\xac\xf9\x7d\xa9\x5d\xa1\xde\xc9\xec\x47\xd4\x5c\xa0\xa5\x15\xe6\xb9\x24\xce\x8e\x9e\x56\x06\x2c\x3a\x93\x80\xed\x24\xae\x25\x47\x0c\x46\x0a\xe5\xa7\xb7\xb2\x6a\xb2\xc9\x24\x75\xd5\x3b\x23\x39\x9b\xef\x89\x0a\xcb\x17\x8b\x4c\xd2\xde\x6d\xc3\x04\xf3\x45\x93\x9f\xc4\x45\x45\x71\xf8\x2a\xf2\x42\x41\x98\x49\x9a\x37\x94\x3b\x14\xc0\xd9\x47\x49\xd5\x19\x1d\x15\xae\x63\x36\x76\x31\x5e\x50\x64\x23\xb2\x56\xe9\xab\x9d\x97\x31\xb8\x47\xc1\xbb\xb7\x64\x04\x41\xd6\xc2\xeb\x6b\x88\x47\x52\xc6\x76\x06\xf3\xee\xd4\x04\xbb\xc2\xe4\x01\x4c\x15\xb2\xa7\xf3\xb1\x8e\xc7\x89\xc7\xbd\x32\x67\xf5\xc8\x36\xe5\xc5\x55\x5c\x4f\x23\x23\xb3\x0c\x4c\x8f\x4a\xde\xc4\x73\x60\xb5\x17\x3a\x83\x31\x85\x44\x51\xd8\x49\x06\x5d\x41\xa1\xf0\x90\x83\xa6\x1f\x47\x23\x03\x85\x92\x89\xb0\x9c\x5d\x7e\xf7\x97\xfd\x35\x1c\x3b\xce\xb6\xc0\x28\x99\x4b\xb2\x7d\x10\x73\xc8\xd1\x1c\x38\x9b\xfd\xfa\xfb\x1f\xb7\x72\xd6\x6a\x0c\xfc\xe3\xc0\x4e\xd9\x5e\x74\xa6\x1e\x98\x3b\x48\x0c\x08\xaa\xd1\x83\xbc\x28\x09\xd6\xdc\x1d\x76\xba\x2a\x5d\x6b\xa4\xea\x05\x1d\x04\x18\xa0\x97\xa0\x26\x21\x35\x98\xb0\xac\x30\xf4\xaf\x22\x68\x32\x87\x02\x65\x59\xf8\xfc\xaf\x59\x6b\x13\x74\x8a\xd9\xf9\x6c\xb1\x95\x7a\x61\xf7\xb3\xf3\x59\x90\xcc\xce\x67\xbf\xc4\x9b\x9b\xfb\x78\x75\x77\x13\x6d\xe2\xfb\x68\xb9\xb9\x5e\x38\x2b\x32\x98\xfd\x3d\x6f\x63\x57\xd9\x4b\xd4\x1b\x99\x83\x25\x91\x17\x9c\x69\xa7\x54\xd7\xb8\x01\x3d\xa6\xd0\x3b\x85\xe0\x5b\x50\xec\x33\xa8\xca\xac\x29\x71\x10\x3d\x60\xa0\x1f\xfb\x02\x2f\xf4\x4c\xdc\x7c\xbe\xfa\x7c\x7f\xf5\xfe\x3e\x5e\xdf\x7d\x59\xdf\x8d\x8c\x18\x7b\x14\xca\x41\x97\x7b\x90\x6e\x4f\xf8\xf9\xb4\xbc\x5d\x4f\x7a\xa9\xde\xaa\x93\x2e\xfe\x8c\xbf\x91\xc8\xdb\x5c\x44\xcb\x38\x9e\x72\xd1\xdf\x0a\x56\x4d\x53\x6d\x78\x25\x48\x6c\x85\x85\x30\xae\x43\x44\xc2\xda\x27\x34\x69\xfb\xf2\x4c\xb6\x6e\x75\xbd\xbe\x5d\xfe\xa7\x8c\x2b\x82\x46\x4e\xa9\x6e\x67\x78\x12\x07\x3b\xb0\x79\xb1\x06\xf4\xae\x72\x36\x67\xf3\x81\xf8\x95\x01\xf6\xbf\x97\x63\xec\x7f\xcd\x80\x56\xb3\x3c\xd2\xbe\x36\xd0\xa7\x6e\xf5\x56\xda\x7e\xab\x5a\x4a\x46\xe3\x2d\x77\x5c\x69\xf9\xb5\x1b\xa8\x7a\xbb\xd3\x2d\xd8\x72\x0a\x23\xbf\xb0\xa5\xf0\xb8\xe8\x29\x03\x85\xd9\xa9\x8b\x75\x9b\x3f\x48\xd5\x7d\x79\x52\x6d\x1b\xf9\x4a\x39\x4b\x60\x3e\x48\x63\xa9\xf7\xac\x59\x12\x86\x26\x20\xb2\xc9\x1e\x52\xa7\xc0\x7c\xaa\xb2\x4f\x61\x27\x9c\xa2\xa0\x15\x77\x86\xe5\xae\x28\xe9\xb0\x42\x4d\xf0\xb5\x5b\x13\x46\x99\x7e\x34\x22\x81\x08\x8c\xc4\x34\x86\x04\x75\x6a\x39\xfb\xad\x5e\x3d\xa1\x5c\x69\x9b\x15\xea\x8d\x5f\xbc\x46\x55\xf1\xc5\xcb\x22\x61\x44\x3e\x7c\x98\x1c\x61\x2e\x48\x26\x9c\x91\x71\xfd\x8f\x72\x8b\x5b\x59\xde\x08\xd7\x60\xcc\xca\xf1\xd7\xfe\xc4\x72\xc1\x06\xec\xae\xf7\x8b\xae\x27\x55\x2d\x37\x5d\xda\x67\xc7\x23\xe8\x72\x0c\xff\x0d\x00\x00\xff\xff\x5b\xe1\x67\x75\x02\x0e\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x57\x5f\x6f\xdb\x36\x10\x7f\xf7\xa7\x38\x08\x03\xbc\x01\x91\x9c\x6e\xd8\x50\x10\x28\x36\xd7\x71\xdb\x00\x4b\x6b\x58\x5e\x5f\xda\x22\xa0\xa5\xb3\x4c\x94\x22\x35\xf2\x94\xd4\x10\xfc\xdd\x07\xea\xbf\x55\xab\x49\xb7\x75\x18\x94\x07\xf3\xee\x78\x7f\x7f\xc7\xbb\x14\x85\xd8\x41\x10\x1e\x54\x8c\x56\xd8\x60\x1e\xc7\x5a\xd9\x60\xa3\x63\x1d\x2c\x15\xdf\x4a\x8c\xe1\x78\x9c\xf8\xc0\x33\xf1\x16\x8d\x15\x5a\x31\xb8\x7b\x32\x01\xf8\x28\x54\xcc\x20\x44\x73\x27\x22\x9c\x00\xa4\x48\x3c\xe6\xc4\xd9\x04\x00\x40\xf2\x2d\x4a\x5b\xfd\x06\xe0\x59\xc6\xc0\xd6\x36\x6a\x5a\x73\x0c\x84\x9e\x95\x7c\xd2\xb1\x3e\xc3\x8b\x74\x9a\x69\x85\x8a\x7a\x12\x8a\xa7\xd8\x1e\x6d\x86\x51\x65\x28\xd3\x86\x6a\x9b\x7e\x79\x60\xf0\xf4\xf2\xe9\x65\xad\x34\x33\x9a\x74\xa4\x25\x83\xcd\x62\x55\xd3\x88\x9b\x04\x69\x75\x2a\x6a\x51\x62\x44\xda\x7c\x0b\xef\x07\x99\x34\x3a\x27\x0c\x74\x86\xca\xee\xc5\x8e\xdc\x8d\x5e\x72\xd7\x8e\xfb\xff\x48\xed\x5e\xdb\x5a\xca\x2f\x8a\x0e\x2e\xa5\x87\xaf\xb4\x25\x57\x91\xe3\xb1\xd4\x90\x71\xda\x33\x98\xb5\x15\x61\x5f\xca\x35\x75\x91\x08\x65\x31\xca\x0d\x2e\xe3\x04\x37\x68\x52\xa1\x38\x09\xad\x56\x5a\x8a\xe8\xc0\x60\x2e\xa5\xbe\x6f\x54\x75\x6c\x06\x18\x27\x0e\x7f\x00\xa4\x1b\x55\x43\x6c\x7e\x16\x98\x23\xdc\xa3\x48\xf6\xc4\xe0\xc9\xe5\xe5\xb0\x2c\x22\xe5\xc9\x78\x59\xae\x1d\x37\x24\x83\x3c\xfd\x57\x8b\x33\x92\x7a\xa9\xf5\xc7\x3c\xab\xd3\x50\x2b\x91\x3a\xe2\x92\xc1\x8e\x4b\xeb\xe2\xb3\xc4\x29\xaf\xad\x12\x4f\x5a\xfb\x3e\x08\xc2\xb4\x3d\xba\x1a\x24\x0c\x24\x27\xb4\x34\x8c\x79\x9b\x0b\x19\x8f\xc6\xfc\xdc\x71\x17\x5a\xed\x44\xf2\x5f\xc4\x9c\x69\x4b\x0b\x9d\xa6\x82\x18\x14\x15\xac\x0c\x5a\x9d\x9b\x08\x6d\x47\xc9\x5b\x70\x84\x68\x04\x97\x25\xb5\x92\x6a\xbc\x49\x04\x35\x3f\x01\x72\x23\x18\x4c\xf7\x44\x99\x65\xb3\x59\x22\x68\x9f\x6f\x83\x48\xa7\xb3\xc6\x3f\xa1\x67\xce\x33\x1f\x3f\xf1\x34\x93\x18\x24\x82\xa6\xf5\x6d\x3a\x64\xc8\xe0\xa5\xa0\xf2\xac\x73\xca\xf2\x56\x73\x07\xbc\x33\x10\xd9\xf0\xa4\x65\x56\x15\x9e\x3a\x1b\xac\xaa\x42\xa5\xde\x92\xe1\x84\x49\x5b\xde\x2a\x86\x70\x40\x05\xd8\x19\x9d\x76\xa7\xc6\xd8\x95\x8e\x3e\xa2\x29\x4d\xf6\x78\x95\xad\xa2\x18\x79\xdc\x4b\x71\x38\x1e\x4f\xc2\x0b\x4b\xbb\xa5\x12\x32\x22\x49\xd0\xb4\x65\xf5\x6b\x91\x0a\x03\x8b\x3d\x57\x09\x4e\x26\x45\xe1\x83\x9b\x1f\x57\x98\x49\x7d\x48\x51\x91\xfd\x6c\x5a\xf0\x2c\xb3\x7d\x28\x75\xb2\x9f\x21\x89\x2b\xa5\xa9\xec\xfa\xd6\xee\x99\x56\x6c\x5d\x83\xe9\xbb\xc2\x73\x39\xf1\x58\xe1\x39\xe5\x1e\xf3\x4e\x33\xef\x5d\x78\x2e\x0f\x1e\xf3\x7a\x39\xf7\x8e\x17\xde\x4e\xa0\x8c\x57\x9c\xf6\x1e\xf3\x1c\xec\x02\xc2\x34\x73\x25\x09\xca\x53\xa4\x15\x71\xa1\xd0\xd8\x77\xbf\x7e\xff\x5b\xe0\x74\x3c\x7b\xf6\xbe\x54\xf2\xde\xfb\xe1\x43\x50\x7a\xe5\x1d\x3f\x4c\xcb\x0c\xa0\xb4\x78\x36\xec\xd1\x76\xea\x72\x70\xa6\xa7\x4a\x95\x2a\x3e\x1e\xbf\x55\x77\x19\xcc\xa4\x88\xb8\x65\xf0\x64\x30\xf0\xce\xd7\xd3\xc9\x00\xa4\x9c\xa2\xfd\xef\x27\xde\x9c\xf7\xe7\x4b\x1e\x3d\x66\x04\x0d\xbb\xa1\x02\xde\x1a\x23\x83\x9c\xb0\x9f\xf1\xbf\x93\x93\xaf\xb7\xdf\xbd\x3c\x35\x01\x40\x8a\x54\x34\xab\x46\x9d\x1d\x4c\xb5\x39\x30\xf0\x7e\xfc\xf9\x97\x1b\xe1\xb5\x1c\x83\x7f\xe6\x68\xc7\x64\x2f\x3b\xd1\x73\x51\xb6\x20\x68\xd0\xd9\x68\x39\x6d\x9b\x91\xd6\x71\x7f\x27\x00\x6c\x51\x1d\x38\x84\x54\x20\x0e\x50\x91\x39\x64\x5a\xb8\x2d\x60\xfa\xce\x6b\x65\xfc\x8e\xe1\x5d\x78\xb3\xad\x50\x33\xbb\xf7\x2e\x3c\x3f\xf2\x2e\xbc\xef\xc2\xcd\xf5\x6d\xb8\x58\x5f\xaf\x36\xe1\xed\x6a\xbe\x79\x35\xcb\xad\xeb\x88\x0f\xcd\x73\x09\x50\xc6\x21\xb4\xda\x88\x14\x2d\xf1\x34\x63\xa0\x72\x29\x5b\xfe\x29\xae\xc7\xb1\xf4\xe5\x5a\x3e\xa6\x9e\x7d\xe8\xbb\xaf\x0d\xf1\xc4\xba\xeb\xb9\xbb\x3e\xc1\x7d\x7e\xdd\x42\x9b\x37\x57\x6f\x6e\xaf\x9e\xdf\x86\xcb\xf5\xdb\xe5\x7a\x20\x04\x70\xc7\x65\x8e\x9d\xef\x7e\xbc\x7d\x40\xcf\xeb\xf9\xcd\x72\x54\x4b\x39\x7b\x1e\x54\xf1\x47\xb8\x5c\xff\x43\x15\xab\x79\x18\x8e\xa9\xe8\x6f\x79\x8b\x26\xa9\x36\xb8\xe2\xc4\xb7\xdc\x62\x10\xd6\x26\x56\xdc\xda\x7b\x6d\x1a\xa0\x8e\x1b\x0b\x17\xaf\x96\x37\xf3\xaf\xf2\xb8\x04\xe8\x2a\x97\xb2\x19\xf3\x73\x79\xcf\x0f\x7d\x68\x0c\x9e\xb8\xee\x2b\xaf\x32\x98\x42\x07\xc8\x91\x56\x1e\x6b\xe8\xd3\x56\x2d\xbb\x7a\xc0\x3d\xd7\xda\x0f\xdd\xea\xfd\x8b\xd2\x7d\x7e\x07\xc9\xc1\x7a\xdc\xff\xaa\x48\xdd\xf6\x72\xc2\xea\xed\xc2\x37\x68\x5d\x17\xba\xb1\xc6\x60\x16\xe3\xdd\xac\xc7\xf4\xa5\x4e\x1e\xba\x58\xa7\xf9\x85\x90\xcd\xd6\x0c\x10\x2b\xdb\xa4\x7f\x21\x73\x4b\x68\x5e\x08\x63\xa9\xe5\x1b\xd7\xdd\x86\x46\x4a\x64\xa3\x3d\xc6\xb9\x44\xf3\xba\xf4\x3e\xc6\x1d\xcf\x25\xf9\x2d\xb9\x13\x74\xbb\xbf\xa0\xc3\x42\x2b\xc2\x4f\xdd\xda\x37\xf0\xf4\xa5\xe1\x11\xae\xd0\x08\x1d\x87\x18\x69\x15\x5b\x06\x3f\x5d\x36\x73\x4b\x69\x3a\x37\xbb\xdc\xa2\xd5\x2d\xcb\x8f\xdc\x6d\x1a\x56\x89\xa4\x8a\xb6\xe2\x86\xf7\xb7\x69\x00\x9e\x93\x4e\x39\x89\x88\x01\x99\xbc\x4b\x5a\xef\x91\x71\x81\x9f\xdc\x71\x4a\x07\x78\x1d\xee\x75\x0f\xac\x91\xa7\xb8\xaf\xb7\x9a\x96\x5b\xc5\x72\xdd\xb9\xdd\x9b\x25\x45\x81\x2a\x3e\x1e\x27\x7f\x0d\x00\xa5\x26\xac\xc5\xf6\x0f\x00\x00"),
+		},
+		"/addons/todo/05-todo-network-policy.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "05-todo-network-policy.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 495,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x8e\x4d\x6a\xc3\x30\x10\x85\xf7\x3a\xc5\x5c\xc0\x6a\xba\x33\xda\x95\xd2\x45\xa1\x94\x40\x4c\xf7\x8a\x34\x4d\x07\xcb\x33\x42\x12\x2d\xc6\xf8\xee\xc5\xbf\x24\x50\x9a\x9d\x9f\xbf\xa7\xf9\xde\x30\x54\x40\x9f\x60\xd9\x83\x3e\xf5\xec\x31\x53\xd6\x4f\xde\x0b\x67\xdd\x88\x17\xfd\xc2\xf6\x1c\xf0\x9a\xbe\x63\xf9\x91\xd4\x1e\x25\x90\x23\xcc\x5b\x63\x1c\x55\x05\x36\xd2\x07\xa6\x4c\xc2\x06\x78\xe9\x11\x5f\x74\x5b\x67\x4d\xf2\xf0\xfd\xa8\x00\x5a\x62\x6f\xe0\xfa\x48\xaf\x00\x3a\x2c\xd6\xdb\x62\x8d\x02\x00\x60\xdb\xa1\x81\x22\x5e\xe6\x18\xec\x19\x43\x5e\x10\x80\x8d\xd1\x40\x5e\xd7\xac\xff\xb6\x38\x59\x66\xbe\xbf\xbd\x65\x4e\xba\x28\x8c\x5c\xf6\x46\x8e\xe8\x96\xcb\x51\xfc\x09\x03\xba\x22\x69\x53\x75\xb6\xb8\xaf\xb7\x1b\xfb\xdf\xfe\xff\x17\xdc\xdb\x30\xb9\x03\xb9\xbe\xe9\x23\xae\xa2\x0a\x5e\xf9\x92\x30\x2f\x02\x5a\xbe\x37\x14\x25\x95\x35\xcc\x31\x49\x11\x27\xc1\x40\xf3\x7c\xdc\x95\x53\xc9\x40\x7d\xa8\x0f\x6a\x18\x2a\x40\xf6\xe3\xa8\x7e\x07\x00\xef\x8d\x4c\xff\xef\x01\x00\x00"),
 		},
 		"/backup": &vfsgen۰DirInfo{
 			name:    "backup",
@@ -185,25 +213,32 @@ var assets = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 1541,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x53\xcb\x6e\xdb\x30\x10\xbc\xeb\x2b\x16\x3e\x04\xed\x41\x51\xdc\xc7\x45\x46\x0f\x6e\x1d\x37\x09\xec\x58\x88\xe3\x5e\x82\x20\x58\x51\x2b\x8b\x0d\x5f\x20\x29\x07\x86\xea\x7f\x2f\x24\x3f\xaa\x26\xb2\x81\x1e\xaa\x13\xb1\xdc\x99\x9d\x1d\x0d\xd1\xf0\x1f\x64\x1d\xd7\x2a\x86\x14\x3d\x2b\xa2\x55\x3f\x78\xe6\x2a\x8b\xe1\x46\xa7\x81\x24\x8f\x19\x7a\x8c\x03\x00\x85\x92\x62\xa8\xaa\xf3\x1b\x9d\x6e\x36\x81\x33\xc4\xea\x72\x8a\xec\x59\xe7\xf9\x84\x4b\xee\x63\xf8\x14\x00\x18\xb4\x28\x04\x09\xee\x64\x0c\xfd\x00\xc0\x7b\x31\x27\xa6\x55\xe6\x86\xb9\x27\x3b\xe6\x8a\xbb\x82\xb2\x18\xfa\x17\x17\xf5\x35\x49\x23\xd0\x53\xcd\x06\xd0\x1e\x59\x7f\xaf\xc6\x6e\x8b\x02\x53\x12\x6e\xdf\x02\xf0\x53\xa7\x61\x47\xe3\x5e\x63\x73\x26\xbb\xe2\x8c\x86\x8c\xe9\x52\xf9\xdb\xa6\xd9\xad\x55\x46\x8e\xbb\x50\x1b\xb2\xe8\xb5\xdd\xf5\x5a\x72\x1e\xad\x4f\xb4\xe0\x6c\x1d\xc3\x4c\x8d\x91\x8b\xd2\xd2\xee\x7a\xa5\x45\x29\xe9\x30\x3e\xdc\x69\xf4\xd2\x84\x66\x59\x6b\x3f\xe8\x22\x69\xfc\x7a\xc4\x6d\x0c\xd5\x5e\x3a\xd3\xca\x23\x57\x64\xdf\xe0\x6b\x27\x4b\x13\x66\x69\xc8\xb4\x34\x5c\x90\x3d\xf0\x70\x89\xcb\xed\x72\xd7\xf5\xe9\xe0\xc3\x5e\xcb\xb4\xde\xa9\xe5\x47\x08\xb2\xae\x24\xe8\x8b\x18\xa2\x57\xa2\xe0\x84\x5e\xb5\x6a\x93\x6c\xfb\x92\xef\xa3\xc5\x34\x79\xba\x9a\xcd\xef\x5b\x1c\x2b\x14\xe5\x56\xd2\x95\x76\xbe\xa5\xe8\x15\x2c\x99\xdd\x75\xc0\x7a\x55\x75\x9e\x68\xeb\x37\x9b\xde\x31\xe0\x62\x7e\x79\xd7\x39\x6f\xe1\xc8\x9e\x98\x37\x9c\xcf\x3b\x61\x09\x3a\xf7\xa2\x6d\x76\x1c\x3a\xfa\xda\x09\xac\xb3\x72\x1c\x34\xbe\x9e\x5c\xde\x0e\xa7\x97\x9d\xd0\x31\x17\x74\x1a\x3e\x9c\x4c\x3a\xdc\xc9\x51\x38\x3a\xea\xcc\xb7\xc5\xfc\x7e\x36\x7d\x9a\x25\xf7\x1d\x9b\xf6\xc2\x30\xd7\x56\xa2\xff\xc2\x4a\xe7\xb5\xfc\xc3\xc2\xb4\x94\xa8\xb2\xf6\x0f\x8e\x52\xae\xa2\x14\x5d\x71\xa8\xa1\x5d\xfe\x95\xa3\x5e\xc8\xda\x3a\x7a\x91\x36\x3e\x62\x46\x36\xc8\xe6\x95\x9c\xbb\x02\xce\xce\xc0\x09\x22\x03\x9f\xeb\xa3\xd7\x25\x2b\xf6\xb1\x8b\xcc\x32\xcc\x4a\x69\x9a\x50\x0b\xf2\x87\xb5\xde\xc6\x5e\xe8\xe5\xb2\x33\xf4\x93\xe6\xe2\xff\x46\xbf\x7e\xf5\x98\xcd\x94\x58\xc7\xe0\x6d\x49\xff\x66\xdb\x1b\xa3\x7e\xb5\x88\xdf\xbd\x14\x5c\x50\xc3\x3a\x80\x4c\x03\xcf\xe1\xe1\x01\xc2\x1c\x7a\x47\x3d\x82\xc7\xc7\x01\xf8\x82\xd4\xde\xd7\x01\xa4\x96\xf0\x79\x00\x39\x1f\xec\x6a\x1f\x6b\x32\x45\xef\x5b\x93\x18\x7a\xa8\x2a\x68\x72\x37\xe2\x76\xb3\x89\xaa\x2a\x84\xae\x18\xc2\x8e\xa4\xff\xe1\x22\xf8\x1d\x00\x00\xff\xff\x12\x52\x4d\x68\x05\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x53\x4d\x6f\xea\x48\x10\xbc\xf3\x2b\x4a\x1c\xa2\xdd\x83\x71\xd8\x8f\x8b\xd1\x1e\xd8\x25\x6c\x12\x41\xb0\x42\xd8\x4b\x14\x45\xe3\x71\x1b\xcf\x66\xbe\x34\x33\x26\x42\x5e\xff\xf7\x95\x09\xf0\x78\x0f\x13\xe9\x1d\x9e\xb8\x8c\xba\xbb\xaa\xab\x8b\x32\xb3\xe2\x1f\x72\x5e\x18\x9d\x20\x63\x81\x97\xf1\x66\xd8\x7b\x13\x3a\x4f\x70\x6f\xb2\x9e\xa2\xc0\x72\x16\x58\xd2\x03\x34\x53\x94\xa0\xae\x07\xf7\x26\x6b\x9a\x9e\xb7\xc4\xdb\x72\xc6\xf8\x9b\x29\x8a\x99\x50\x22\x24\xf8\xad\x07\x58\xe6\x98\x94\x24\x85\x57\x09\x86\x3d\x20\x04\xb9\x24\x6e\x74\xee\xc7\x45\x20\x37\x15\x5a\xf8\x92\xf2\x04\xc3\xeb\xeb\xb6\x4d\xca\x4a\x16\xa8\x65\x03\x4e\x57\x02\x67\x6b\xdb\x12\x20\x59\x46\xd2\x1f\x46\x80\x7f\x4d\x16\x75\x0c\x1e\x34\xb6\x3f\x4f\x6e\x23\x38\x8d\x39\x37\x95\x0e\x0f\xbb\x61\xbf\xd5\x39\x79\xe1\x23\x63\xc9\xb1\x60\xdc\x7e\xd6\x91\x0f\xcc\x85\xd4\x48\xc1\xb7\x09\x16\x7a\xca\x84\xac\x1c\xed\xdb\x1b\x23\x2b\x45\xc7\xf5\xd1\x5e\x63\x50\x36\xb2\xeb\x56\xfb\xbe\x01\x90\xb2\x61\x3b\x11\x2e\x41\x7d\x90\xce\x8d\x0e\x4c\x68\x72\x67\xf8\xd6\xc9\xca\x46\x79\x16\x71\xa3\xac\x90\x74\x90\x03\x08\xc5\xd6\x1f\xe6\xdf\xb5\xaf\xa3\x0f\x07\x2d\xf3\xf6\xa6\x23\x21\x10\x41\xb5\x95\x94\x85\x32\x41\xfc\x8d\x28\x7c\xa2\x57\x6f\x4e\x49\x3e\xe6\xd2\xbf\x27\xab\x79\xfa\x7a\xbb\x58\x3e\x1d\x7b\xc0\x86\xc9\xea\x43\xd2\xad\xf1\xa1\x69\x2e\xc1\xd2\xc5\x63\x07\xac\x5f\xd7\x83\xd4\xb8\xd0\x34\xfd\x4b\xc0\xd5\xf2\xe6\xf1\x1c\x58\xd7\x83\x95\x27\xf7\xc9\xbe\xf1\x72\xd9\x09\x4b\x99\xf7\xef\xc6\xe5\x97\xa1\x93\x3f\x3b\x81\x6d\x56\x2e\x83\xa6\x77\xb3\x9b\x87\xf1\xfc\xa6\x13\x3a\x15\x92\x3e\x87\x8f\x67\xb3\x73\x64\xbf\x60\xd2\xd3\x45\x67\xfe\x5a\x2d\x9f\x16\xf3\xd7\x45\xfa\xd4\x71\x69\x3f\x8a\x0a\xe3\x14\x0b\x7f\xf0\xca\x07\xa3\xbe\xb0\x70\xa3\x14\xd3\xf9\xe9\x1f\x1c\x67\x42\xc7\x19\xf3\xe5\xb1\xc6\xdc\xfa\xab\x1c\xf5\x23\x7e\xaa\xa3\x1f\x1b\x1b\x62\x6e\xd5\x0e\xb9\xfb\x4a\x06\xbe\xc4\xd5\x15\xbc\x24\xb2\xf8\xbd\x7d\x06\x53\xf1\xf2\x10\xbb\xd8\xae\xa3\xbc\x52\x76\x17\x6a\x49\xe1\x78\xd6\x79\xec\xa5\x59\xaf\x3b\x43\x3f\xdb\x35\x7e\x6c\xf4\x01\x47\x2c\x5f\x68\xb9\x4d\x10\x5c\x45\xdf\x67\xdb\x99\x51\xff\x1d\xdf\xc0\x4f\xef\xa5\x90\xb4\x63\x1d\x21\x37\x10\x05\x9e\x9f\x11\x15\xe8\x5f\xf4\x08\x2f\x2f\x23\x84\x92\xf4\xc1\xd7\x11\x32\x47\xec\x6d\x84\x42\x8c\xf6\xb5\x5f\x5b\x32\x4d\x3f\x9f\x6c\xe2\x2c\xa0\xae\xb1\xcb\xdd\x44\xb8\xa6\x89\xeb\x3a\x42\x57\x0c\x01\x2f\x89\x2c\x86\xbf\x5c\xf7\xfe\x1f\x00\x12\x52\x4d\x68\x05\x06\x00\x00"),
 		},
 		"/backup/syndesis-restore-job.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "syndesis-restore-job.yml.tmpl",
 			modTime:          time.Time{},
 			uncompressedSize: 1184,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x93\x4f\x6f\xdb\x30\x0c\xc5\xef\xf9\x14\x44\x4e\xdb\xc1\x75\x8b\x6d\x17\xe7\x94\xb6\xd9\xda\x6e\x69\x8c\xd8\xd9\xa5\x28\x0a\x59\x66\x62\xad\x92\x28\x48\xb4\x8b\x22\xcb\x77\x1f\x9c\x7f\xf0\xda\xd4\x39\x09\x14\x1f\xdf\x8f\xca\xb3\x70\xea\x37\xfa\xa0\xc8\x26\x50\x08\x96\x55\xdc\x5c\x0c\x9e\x95\x2d\x13\xb8\xa3\x62\x60\x90\x45\x29\x58\x24\x03\x00\x2b\x0c\x26\xb0\x5e\x9f\xdd\x51\xb1\xd9\x0c\x82\x43\xd9\x96\x0b\x21\x9f\x69\xb9\xfc\xa5\x8c\xe2\x04\xbe\x0e\x00\x98\x75\x86\x92\x6c\x19\xc6\x4b\x46\xff\x5d\x59\x15\x2a\x2c\x13\xb8\x38\x3f\x6f\xaf\xd1\x38\x2d\x18\x5b\x31\x40\xd7\xa1\xfd\xbd\x71\xd9\x15\xb5\x28\x50\x87\x43\x0b\xc0\x1f\x2a\xa2\x13\x8d\x07\xa4\xed\x19\x7d\xa3\x24\x8e\xa5\xa4\xda\xf2\xfd\xb6\x39\xbc\xda\x12\x83\x0a\x11\x39\xf4\x82\xc9\xef\x7b\x3d\x06\x16\x9e\x53\xd2\x4a\xbe\x26\x70\x8f\x0d\x1e\xae\x1a\xd2\xb5\xc1\xa3\x75\xb4\xe7\x63\xe3\x22\xb7\x6a\xb9\x8f\x4c\x68\x1c\xbf\x5e\x2b\x9f\xc0\xfa\x80\x2d\xc9\xb2\x50\x16\xfd\x3b\x7d\xeb\x48\x1e\xa3\xb2\x88\x24\x19\xa7\xf4\xd1\x10\x40\x19\xb1\xda\x6d\x76\xdb\x9e\x8e\x8f\x70\x80\x99\xb6\x0b\x75\x1e\x23\x02\xd3\x56\x52\xc1\x55\x02\xf1\x1b\x2a\xe8\x01\xb6\x4d\x77\xc8\xae\x2f\xfd\x31\x9f\x64\xf9\x6c\x3e\x79\xba\x99\x65\x79\x67\x4c\x23\x74\xbd\xa3\xba\xa1\xc0\x1d\xa8\xf7\xca\x74\x36\x3f\xa1\x1c\xae\xd7\x67\x29\x79\xde\x6c\x86\x3d\xda\x45\x36\x99\x9f\xd6\x2e\x02\xfa\x7e\x6d\x3a\xce\xb2\x0f\x7c\x45\x08\x2f\xe4\xcb\x7e\xfd\xf5\xe5\x69\x75\x1b\x9e\x7e\xe5\xe5\xf8\xea\xe7\x22\x7d\xca\x6f\xa7\x93\x2c\x1f\x4f\xd3\xd3\x73\x72\x65\xda\xa0\x19\xd7\x3f\xec\x6a\x91\xe5\xb3\xe9\xd3\x2c\xcd\x3f\xd8\xe6\xaa\x0e\x4c\x66\xe6\x58\x91\x0d\xdd\x59\x92\x8c\x11\xb6\xec\xfe\xab\x71\xa1\x6c\x5c\x88\x50\x1d\x6b\xc2\xaf\xfe\x0b\xcf\x30\x92\x5d\x9a\xbf\x1d\xcf\x4f\x2f\x95\xd2\x08\xec\x6b\x1c\x01\x94\x04\x6a\x09\x0f\x0f\x10\x2d\x61\xb8\xcf\x59\xec\x56\x51\xed\x34\x89\x72\x9b\x63\x8d\x8c\x43\x78\x7c\x1c\x01\x57\x68\x21\x68\x44\x07\xdf\x46\x50\x78\x14\xcf\x23\x58\xaa\xd1\xbe\xf6\x65\x04\x25\x59\xfc\xdc\x31\x8b\xc9\x71\x2c\x9d\xd9\x12\x6f\xbf\xc7\xb3\x50\x0d\xfe\x05\x00\x00\xff\xff\x8d\x73\xa9\x6c\xa0\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x92\x41\x6f\xdb\x30\x0c\x85\xef\xf9\x15\x44\x4e\xdb\xc1\x75\x8b\x6d\x17\xe7\x94\xb6\xd9\xda\x6e\x69\x8c\xd8\xd9\xa5\x28\x0a\x59\x66\x62\xad\x92\x28\x48\x4c\x8a\x22\xcb\x7f\x1f\xd4\x24\x46\xb6\x7a\x86\x2f\x02\xc9\x8f\xef\x49\x7e\xc2\xa9\x9f\xe8\x83\x22\x9b\x41\x25\x58\x36\xe9\xe6\x62\xf0\xac\x6c\x9d\xc1\x1d\x55\x03\x83\x2c\x6a\xc1\x22\x1b\x00\x58\x61\x30\x83\xed\xf6\xec\x8e\xaa\xdd\x6e\x10\x1c\xca\x58\xae\x84\x7c\xa6\xe5\xf2\x87\x32\x8a\x33\xf8\x3c\x00\x60\xd6\x05\x4a\xb2\x75\x18\x2f\x19\xfd\x57\x65\x55\x68\xb0\xce\xe0\xe2\xfc\x3c\xb6\xd1\x38\x2d\x18\x23\x0c\x70\xaa\x00\xf0\x4e\x25\x96\x00\xb4\xa8\x50\x87\xe3\x08\xc0\x2f\xaa\x92\x8e\xc1\xa3\xa5\xf8\x05\xf4\x1b\x25\x71\x2c\x25\xad\x2d\xdf\xbf\x0d\x87\x57\x5b\x63\x50\x21\x21\x87\x5e\x30\xf9\xc3\xac\xc7\xc0\xc2\x73\x4e\x5a\xc9\xd7\x0c\xee\x71\x83\xc7\xd6\x86\xf4\xda\x60\x2b\x9d\x1c\xfc\xb1\x71\x89\x5b\x45\xdf\x87\x06\x00\x1a\xc7\xaf\xd7\xca\x67\xb0\x3d\xda\x96\x64\x59\x28\x8b\xfe\x1d\x1f\x15\xc9\x63\x52\x57\x89\x24\xe3\x94\x6e\x05\x01\x94\x11\xab\xfd\x43\xdf\xc6\x53\xfb\x08\x47\x33\xd3\x78\xa1\x76\x23\x40\x02\x26\x56\x72\xc1\x4d\x06\xe9\x3f\xae\xa0\xc7\xb0\xdd\x9c\x2e\xd9\xcf\xe5\xdf\xe6\x93\xa2\x9c\xcd\x27\x4f\x37\xb3\xa2\x6c\xdb\x00\x1b\xa1\xd7\x7b\x57\x37\x14\x78\xb7\xeb\x21\xf3\xd9\xbc\x83\x1c\x6e\xb7\x67\x39\x79\xde\xed\x86\x3d\xec\xa2\x98\xcc\xbb\xd9\x45\x40\xdf\xcf\xe6\xe3\xa2\xe8\x66\x73\x11\xc2\x0b\xf9\xba\x9f\xbf\xbe\xec\xa6\x63\x78\xfa\xc9\xcb\xf1\xd5\xf7\x45\xfe\x54\xde\x4e\x27\x45\x39\x9e\xe6\xdd\x7b\x4a\x65\x62\xd0\x8c\xeb\x5f\x76\xb5\x28\xca\xd9\xf4\x69\x96\x97\xff\xb9\xcd\xd5\x3a\x30\x99\x99\x63\x45\x36\x9c\xee\x92\x64\x8c\xb0\xf5\xe9\x5f\x4d\x2b\x65\xd3\x4a\x84\xa6\xad\x09\xbf\xfa\x2b\x3c\xc3\x44\x9e\xba\xf9\xdd\x9e\x01\x3e\xbc\x34\x4a\x23\xb0\x5f\xe3\x08\xa0\x26\x50\x4b\x78\x78\x80\x64\x09\xc3\x43\xce\x52\xb7\x4a\xd6\x4e\x93\xa8\xdf\x72\xac\x91\x71\x08\x8f\x8f\x23\xe0\x06\x2d\x04\x8d\xe8\xe0\xcb\x08\x2a\x8f\xe2\x79\x04\x4b\x35\x3a\xd4\x3e\x8d\xa0\x26\x8b\x1f\x4f\xc4\x52\x72\x9c\x4a\x67\xde\x1c\x07\x16\x9e\xcf\x42\x33\xf8\x33\x00\x8d\x73\xa9\x6c\xa0\x04\x00\x00"),
 		},
 		"/database": &vfsgen۰DirInfo{
 			name:    "database",
 			modTime: time.Time{},
 		},
+		"/database/syndesis-db-network-policy.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "syndesis-db-network-policy.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 1411,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x94\x4f\x8b\xdb\x30\x10\xc5\xef\xfa\x14\x0f\x72\x5d\xbb\xf4\x1f\x14\xdf\xda\x92\x43\xa1\x94\x85\x0d\xbd\xcb\xd2\x24\x51\x63\x6b\x84\x46\xd9\xc4\x84\x7c\xf7\x22\x2b\xd9\x75\x69\xca\xfa\x50\xd8\x9b\xad\x79\x9a\xf9\xcd\x30\x7a\xa7\x53\x05\xb7\x46\xfd\x30\x78\x4b\xe2\xa4\xfe\x41\xe9\xc0\x71\x77\xcf\x9d\x33\x8e\xa4\x5e\x7a\xdd\x76\x64\xcf\x67\xb5\x50\x0b\x7c\x21\x71\x96\x04\x69\x4b\x10\x8a\x8f\x14\xef\xc6\x6f\xab\x93\x6e\xb5\x10\x9c\x40\x1b\x43\x22\x64\xd1\x0e\xe8\x29\xe9\xa2\x70\x3e\xd1\x26\xea\xe4\xd8\x8b\x5a\x60\x2f\xce\x6f\xe0\x12\xb4\x40\x8f\xd7\x21\xbc\x8f\x86\x8a\x3a\xb1\x65\xd0\x51\xf7\xa1\x23\x1c\xb6\xe4\x41\x85\xe3\x0e\xda\xdb\x51\xd2\x6a\xb3\xdb\x87\x3b\xb5\x40\x24\x49\x1c\x69\x8c\xf4\xee\x52\x04\xbf\xb8\x15\x48\xd0\x07\x5f\x50\xf2\x1d\x0e\x14\x75\xe2\xa8\x16\xaa\x82\x0e\xee\x27\x45\x71\xec\x1b\xf8\xd2\xb5\xf3\x9b\x7a\xf7\x49\x6a\xc7\x6f\x1e\xdf\x2a\x60\xe7\xbc\x6d\x30\x1d\xc9\xa0\x30\x36\x95\x89\x1b\x05\x00\x5e\xf7\xd4\x40\x2e\xf3\xab\x6c\x3b\x9e\x76\xba\xa5\x4e\x8a\x02\xd0\x21\x3c\x4b\x2e\x67\xd7\xdf\x5c\xec\xa5\x78\x1a\x02\x35\x70\x7e\x1d\xb5\xa4\xb8\x37\x69\x1f\xe9\x86\xcc\x70\x1f\xd8\x93\x4f\xcf\xc9\x0a\x8f\x04\x32\x85\x25\xb0\x7d\xa0\x8e\x4c\xe2\x78\x85\xeb\x75\x32\xdb\xef\x7f\xf0\xde\x26\x7e\x99\x79\x26\x4e\xc6\xe8\x9c\x19\x56\x43\xa0\x4b\xcd\x0a\xdf\xfc\x26\x92\x94\x4c\xae\x7c\x5f\x43\xeb\xc8\xfd\x15\xad\xba\xd5\xc2\x3f\xdb\x98\x07\x3d\x07\xbc\x6c\xfb\x2b\x43\xe4\xcd\x7b\x1d\x84\xc9\xf3\x55\x7f\x79\xc6\x67\x6b\xd9\x4b\xbd\x62\xcb\x13\xbf\xf8\x6f\x9c\xd9\x0c\xe6\x30\x8e\xba\x0c\x47\x7e\x6e\xfd\xe5\x31\xe4\x45\xcb\xa6\x34\x8d\x55\xd8\xd1\xd0\x64\x0b\xa9\xf2\xf3\x9e\x44\xf0\xe4\x21\x0d\x96\x47\x27\xe9\x3a\xbd\xc0\x31\x3d\xe5\xa8\x10\x22\x27\x36\xdc\x35\x58\x7d\xbd\xbf\x9c\x16\x51\x83\x8f\x1f\xde\xbf\x53\xa7\x53\x05\xf2\xf6\x7c\x56\xbf\x07\x00\xee\x7b\x3d\x3f\x83\x05\x00\x00"),
+		},
 		"/database/syndesis-db.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "syndesis-db.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 15751,
+			uncompressedSize: 16110,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x3b\x6b\x77\xe2\x38\x96\xdf\xeb\x57\xdc\xcd\x54\xad\xab\x76\x4d\x78\x24\x90\x40\x77\x76\x97\x80\x2b\x49\x37\x01\x1a\x93\x54\xf7\x7e\xe1\x08\xfb\x02\x9a\x08\xc9\x25\xc9\x49\xd1\xd5\xf9\xef\x7b\x64\x6c\x6c\xc0\xe4\xd5\x7d\x38\x33\x3b\xf9\x50\x05\xd2\xd5\x7d\xeb\x3e\x24\x51\x00\x12\xd0\x5b\x94\x8a\x0a\xde\x80\xfb\xf2\x3b\x80\x3b\xca\xfd\x06\xb4\x04\x9f\xd0\xe9\x35\x09\xde\x01\xcc\x51\x13\x9f\x68\xd2\x78\x07\x00\xc0\xc9\x1c\x1b\xa0\x16\xdc\x47\x45\x55\xc1\x1f\x17\xe6\xa8\x25\xf5\x54\xc1\x8b\xd6\x44\x40\x8c\x8c\x91\xa9\xe5\x02\x00\x12\x04\xe9\x8a\x78\x2c\xf9\x7a\x48\x45\xf1\xb9\x79\xbd\x08\xb0\x01\x94\x4f\x24\x51\x5a\x86\x9e\x0e\x25\xe6\x80\x79\x62\x1e\x08\x8e\x5c\xe7\xb2\xf7\x0e\x20\x15\xe2\x6b\x88\x92\xa2\x3a\x5c\x90\x39\x6b\xc0\x1f\x31\x32\x80\x60\x3a\x32\x40\x63\xa2\xb0\xb1\x1a\x5c\x82\x2f\x1a\x70\x00\xae\xd3\x71\x5a\xc3\x2c\xd8\xa1\x4f\xb4\x51\x89\x9d\x1d\x1c\x29\xfa\x3b\x7e\xcc\x81\xfa\x04\x44\x81\x99\x84\xcf\x83\xde\x75\x76\xc9\x41\x86\x5c\xcc\x71\x96\x03\x80\x02\xc4\x38\xd6\x87\xcd\x5f\xa8\xc8\x14\x1b\x70\xd0\x69\x9e\x3b\x9d\x83\xad\x69\x1f\x95\x27\x69\xa0\x23\x1b\x1f\x74\xc9\x1c\x41\x4c\x40\xcf\x10\xf2\x88\x1b\x4a\x86\xc3\xdd\x64\x2e\x9a\x37\x17\xce\x73\x64\xda\x54\xdd\x81\x0a\x88\x87\x10\x2a\xf4\x61\xbc\xd8\xa0\xf8\xee\x0d\xbe\xf7\x0f\xe4\x56\x79\x7b\x41\x91\x79\xc0\xd0\x1f\xa7\x3b\x21\x65\xdd\x47\x86\x1a\x63\x90\x82\x3f\x3e\x54\xb3\xd4\xf1\xfe\xf6\x6f\xc5\x31\xe5\xc5\x31\x51\xb3\x78\x24\xe4\x9a\x32\x30\x03\x50\xf0\xe0\x20\x50\x5f\x19\x14\x66\x50\xae\x9c\x1c\x96\x0e\x4b\x87\x65\x28\xdc\xc0\xfb\x7e\xcf\x1d\x5e\x0c\x1c\xf7\x97\xce\xe8\xc6\x75\x06\x50\xf8\x0a\x05\x7f\x6d\xb8\xdd\x1c\x36\xcf\x9b\xae\x63\x90\x58\xb1\xf3\x96\xad\x83\x1f\xc0\x17\x2b\xfb\xa1\x37\x13\x70\xf0\x85\x50\x4d\xf9\x14\x26\x42\x42\x5f\x28\x3d\x95\xa8\x40\xa1\xbc\x47\x79\x78\x78\x98\x5a\x5b\x31\xc4\x00\xca\xf1\x77\x5f\xf0\x44\x65\x4b\x34\xff\x61\xfe\x96\xc2\x1a\x6c\x89\x46\x0e\x00\x62\xb0\x48\x92\x1f\x7f\x74\x7a\x9f\x57\x28\xdb\x83\x5e\x1f\x56\x9c\x5e\x7d\x06\xe7\xd7\x2b\x77\xe8\xae\x16\xff\xb0\x0e\x19\x89\xba\x1b\x2a\xc1\x4c\x7c\xff\x5f\x44\xdb\x9e\x44\xb2\xae\xed\xdd\xba\x6e\x0d\x9c\xe6\xd0\x49\xb5\xbd\xad\xe3\x18\x22\x12\x31\x99\x85\x2f\x57\xc3\x4b\xe8\x37\x5d\xf7\x4b\x6f\xd0\x06\x2b\x2b\xb4\xdb\xbc\xee\x77\x9c\xf6\xf9\x28\x99\xb6\x52\x5c\x17\x83\x66\x77\x08\xcd\x4e\x07\xfa\x83\xab\xdb\xab\x8e\x73\xe1\xb8\xd0\xeb\x6e\x93\x07\x2d\x76\x1a\x32\x96\xa3\xe0\xa7\xd0\x85\x9b\xf4\xf3\x8f\x3f\x5a\x4e\xef\xb3\xb5\xc9\xbf\xdb\xba\x74\xae\x9b\xd0\xbc\x19\x5e\xf6\x06\x57\xff\xdb\x1c\x5e\xf5\xba\xbb\xa5\x1d\x36\xcf\x3b\x91\xeb\x75\x7b\xc3\xc4\xb1\x3c\xc1\x35\xf1\x34\x7c\x9c\x50\xa9\xf4\xc8\xec\x75\xb8\x6d\x0e\x5a\x97\xcd\x81\x0d\x8c\x6c\x0d\x99\x38\x41\xf8\x22\x03\x83\xc4\x1f\x29\x11\x4a\x2f\x0b\x65\x8c\x85\x26\xec\xa3\x51\x83\xf3\x29\xe5\xe5\xaa\xeb\x3a\x83\x21\x5c\x75\x87\xbd\x15\xf1\xdb\x66\xe7\xc6\x71\xe1\xa3\xf5\x93\x40\xcb\xb6\x7e\x22\xde\x9d\x12\xdc\xb2\xad\x01\xfa\x70\x49\xb4\x65\x5b\xfe\xd8\xb2\xbd\x50\x4a\xe4\x7a\xa4\xe9\x1c\x95\x26\xf3\xe0\xd3\x8b\x44\xd4\xc2\x17\xf0\x91\xfa\xe0\x3a\x83\xab\x66\x64\xa5\xeb\xe6\xe0\x37\xf8\xd9\xf9\xcd\x06\x4d\xd4\xdd\xba\x74\x26\x82\xf9\x86\x3f\xe7\xc2\x19\xbc\x8c\xc2\x03\xe5\xc8\xa8\xd2\x3b\xa9\x18\x80\x94\x4a\x20\xa9\x87\x09\x05\x1b\x16\x48\x64\xfa\x6d\xfa\xa0\xd2\x2f\x1e\x4d\x57\xf1\xf1\xdf\xd3\x89\x40\x0a\x3f\xf4\xb4\x27\xfc\x4d\xbc\x63\x21\xee\x90\x6b\xb9\xa0\x7e\x32\xb3\x43\xfb\x59\xae\x97\x2c\xc6\x28\x6c\xc3\x51\xc4\x89\xe1\x20\xa2\xfc\x69\x65\xa3\xe3\x8a\x6d\x35\xc7\x12\x43\xb8\xa5\x1c\x17\x44\xfa\x36\x74\x88\x32\x1b\x9c\xf8\x44\xd9\x70\x29\x1e\x90\x31\xb8\x16\x21\xd7\x84\x72\xcb\xae\x9c\x54\xed\x4a\xa9\x7c\x64\xd7\x4f\x4b\x15\xdb\x3a\xb7\xec\xa3\x4f\x66\x7f\xb4\x7a\xdd\xcf\x9d\xab\xd6\xd0\xd0\xff\x04\xed\x9e\xd1\xe8\xe5\x55\xf7\xe2\xaf\xe4\xb6\x5e\xb6\xad\xa6\x24\xe1\xdf\x05\x38\x4a\x13\x8d\x36\x38\x54\x21\xc3\x15\xf7\xd0\x22\x63\x94\x1c\x35\xb8\x24\xbc\xa7\x53\x2e\xb8\x0d\x5d\x12\x10\xb8\x25\x8c\xe1\xc2\xb2\x8f\xeb\x75\xc3\x7f\xd5\xae\x9f\x54\x4e\x6d\xab\xf5\x9f\x7b\x15\xa0\x6e\x5b\xcd\x70\x8c\x52\xc3\x17\xca\x51\xd9\x30\xa0\xda\x9b\xd1\xac\x00\x33\x22\x7d\xc1\x39\x59\xd8\xf0\x65\x46\x8d\x8c\xae\xe0\x62\x4e\xa0\x25\x88\xd2\x96\x5d\xa9\x54\x13\x01\xca\x27\xb6\xd5\xdc\xab\x00\xa7\xa7\xb6\x75\x2e\xb8\x1f\xeb\x5f\xd9\xd0\x67\xa1\xa4\xe3\x50\xc1\x00\xfd\x0d\x55\xc3\x71\xb9\xb4\xd2\x75\x7d\xdf\xac\x1e\x1d\xd9\x56\x8b\x2c\x42\x95\x2a\x57\xd9\x70\x4e\x05\xa7\x1e\x7c\x96\x62\x0a\xee\x42\x92\x99\x0d\x5f\x08\x63\x24\xfe\x37\x61\xbd\x72\x1a\x71\x5e\xb2\xeb\xa7\xfb\x57\x72\xad\x6e\x5b\xad\x19\x09\x02\x64\x0c\xb5\x0d\x7d\x69\x9c\xc4\x38\xc7\x25\x65\xec\x79\x17\xaf\x1c\x45\x2e\x7e\x6c\xd7\x4f\x8e\x4f\xf7\xcd\x7c\xa5\x64\x5b\x2d\xc1\xa6\x94\x43\x0b\x19\x23\x52\xd9\x30\x5c\x78\x33\x25\xf8\x92\xfd\x97\x6f\xd5\xa3\xaa\xf1\xf4\x52\xc5\xae\x9f\x26\x72\x1c\xef\x4d\x8e\x93\x8a\x6d\xb5\x53\x9f\xc8\xfa\xd0\x35\x59\x90\xcd\xa8\x72\x5a\x8f\xa3\xe2\xc9\xb1\x6d\x35\xf7\xc9\x68\xd5\x06\xab\x4d\x38\x49\xb7\x64\x47\xe8\x50\xbd\x42\xcf\x95\x65\x48\x34\xce\x7e\x7a\x12\x71\xbf\x3f\x77\x31\xbb\xab\x2d\xe6\x94\x87\x6a\x15\xd3\x5b\x33\x49\x95\xa6\x84\x9b\xb4\x83\xf4\xdb\x06\xbb\xe5\xd2\x69\x92\x81\xaa\x4b\x65\xd7\xf6\xc7\x6e\xd9\xb6\xda\x21\xe7\x59\x77\x18\x4a\x42\x19\xca\xa7\x15\xbe\x95\x47\x8f\xd2\x3c\x5a\xdb\xb3\xce\x8f\xaa\xb6\xf5\x39\xd4\x69\x12\xad\x56\x4b\x25\x70\x99\x0f\x85\x5c\xde\x5d\x4d\xa6\x0a\x3a\x48\x02\x68\x53\x65\xfa\x7c\x6d\xd9\x47\xab\x34\x74\x5a\x3e\xda\x77\x90\x81\xba\x6d\x5d\x12\xc9\x08\x5f\xc9\xb0\x1e\x39\x6a\x51\xe4\x28\xdb\xf5\xd3\x93\x98\xb9\xfd\xf9\x88\x89\x55\x3f\x09\x85\xc1\x0c\xfa\x33\x64\x81\xca\x3a\xcb\x15\x57\x74\xca\xe9\x66\xfc\xa8\xd4\x8e\xed\x72\xbd\x5e\xb6\xeb\x27\xf5\xe3\x3d\xbb\x43\xe5\xc4\xb6\x7e\x26\x81\xa7\x08\xf7\x17\xf0\x99\xcc\x29\x5b\x44\xe5\x89\x5c\x44\xb6\xd7\x08\x1d\xc2\x33\x25\xca\x85\x24\xdc\x2f\xdc\x9a\x08\xff\x5c\x68\x29\x57\x92\x6a\xeb\xf4\xb8\xbc\x6f\x2f\x29\x97\x6c\xeb\x67\xc1\xa7\x6a\x4a\xa2\xc2\x76\x38\x43\xf8\x29\xf4\xa7\x98\x57\x64\xad\x9b\xe3\xb8\x66\xfc\xc7\xb0\x5d\xab\xee\xd9\x1c\x86\x60\x87\xc8\xbb\x39\x12\x7f\x2d\xcc\xcc\x8c\x1d\xe4\xdd\x0b\x94\x5e\x4e\x02\xe4\x49\x75\xdf\xdc\x57\xeb\xb6\xd5\x11\x77\x62\x41\x56\x2e\x14\xc5\x3c\xb8\x45\xf4\x51\xbe\x20\xe9\x97\x8f\x92\x9a\x71\xdf\xb9\xc8\x10\xec\x93\x90\xc1\xa5\x18\x8f\x4d\xad\x88\xde\x9d\xd2\x62\x32\x41\x09\x43\x01\x3f\x13\x26\xf8\xd3\x51\xbe\x47\xee\xee\x29\x63\x68\x6a\x97\x55\x41\x70\x74\xba\xe7\x8a\xc0\x24\x94\x3e\x6a\x94\x70\x4d\xbd\x19\x41\xb6\x32\x45\x5f\x50\xae\x61\x20\xc2\xfc\x3d\xb0\x6a\x34\x42\xae\x8d\x1f\x9d\x46\x51\xf4\xd4\xc8\x50\xd9\xb7\x2d\x8e\x6c\xab\x2f\xc5\x5c\x70\x2d\x0c\xe7\x6b\x3e\x52\xad\x57\xd7\xab\xad\x3d\x36\x40\x65\xdb\xfa\x25\xa4\xcc\x43\x9f\x40\x4b\x22\xde\xd9\xb9\x9e\xd0\x12\x2c\x9c\x8f\x69\x66\x53\xd6\x8c\x43\x94\xea\x46\x99\xb5\x65\x24\xac\xee\x2f\xe3\xd7\x6c\x6b\x40\x4d\xe4\xcb\x96\xb1\x82\x6b\x84\x73\x64\x4c\xd8\xe0\x12\xae\x8d\x40\xe1\xef\xab\x1a\x45\x59\x76\xb9\x9a\x34\x70\xa7\xa5\xfa\x9e\x35\x7d\x5c\xb3\x2d\xd7\x23\x12\x3d\x29\x1e\xf2\x95\x3c\x08\xf5\x0c\xe5\x44\x48\xdf\xb2\x8f\x8f\x4b\x49\xd3\x53\xaf\xed\xbb\x59\x38\x3e\x31\xbc\xce\x24\x89\x42\x5c\xd2\xf6\x64\xe3\x47\x74\xa8\x42\xd1\x97\x24\x5b\x99\x0b\x86\xea\x41\x48\x3d\x5b\x3c\x1f\x18\xa1\xb6\x8a\x28\xf5\x7d\xf7\x18\xa5\x63\x23\x9f\x44\x32\xa7\x7c\x0a\x0e\x99\x32\xcc\xb7\xc8\x3a\xc7\x95\x5a\x2d\x69\xa3\xeb\xa5\xea\x9e\x4b\xf5\x93\xb2\x6d\xb9\x4c\x10\x6e\x1a\x68\x11\x48\x8a\x9a\xc8\xc5\xf2\x98\x22\xeb\x38\x95\xa3\xd2\x2a\x98\xec\xbd\x44\xa9\x1f\xd9\x96\x1b\x08\xad\xd5\x83\x10\x3e\xda\x49\xf9\xb5\xac\x6a\xe1\x42\x8a\x87\xfc\x2a\xcb\xd5\x70\x89\x0c\x39\xb1\xec\xf2\xf1\xca\x31\x2a\xcb\x56\xa2\xbe\xbf\x62\xa5\x66\x5b\xb7\x28\xa3\xec\xd1\x41\x68\xa3\xa2\x72\x2b\x8f\x54\x96\xa1\xef\xc4\xd4\x23\x47\xfb\xee\x2f\x4b\xd1\x79\x04\xd7\x94\x87\xe1\x3c\xc7\x15\xd2\x94\x1d\xa7\x3b\xc3\x66\xb9\xf6\x3a\x47\x88\x4f\x93\x7b\x03\x18\x38\xfd\x4e\xb3\xe5\xc0\xe7\x9b\x6e\x2b\x3a\xbf\x27\xbe\x3f\x62\x48\xfc\x8f\x99\xcb\xc7\xe5\xe9\x3c\xe1\xfe\x28\x3d\x93\xbf\x27\xd2\x9b\x11\x69\x67\xc0\x92\xd3\xf9\x9c\xa9\x60\x26\x78\xee\x1a\x9c\x13\xca\xf2\x26\xb2\x27\xfb\x3b\xa7\x35\xd1\xa1\xca\x9b\x96\xcb\xdb\x9a\x78\xe6\xd3\xbb\xcc\xd4\xc0\x19\xde\x0c\xba\x2e\xdc\x0b\xea\x67\x86\x3b\xcd\xee\xc5\x4d\xf3\xc2\x01\x2b\x60\xc1\x54\x7d\x65\x56\xba\xa8\xe9\xc2\xfb\xf3\x5e\xfb\xb7\xf7\xe9\x2d\x99\xd3\xea\x34\x07\x4e\x66\x7d\x74\x94\x1f\xd3\x4b\x15\x7d\xee\x5c\x5c\x75\x37\xa1\x1a\x67\xe0\x09\xee\x11\xfd\x31\x2b\xc5\x1f\x7f\x80\x05\x96\x0d\x56\x07\x89\xdf\x80\x3e\x43\xa2\x70\x75\x49\x61\xd9\x79\x56\xb0\xc1\x82\x89\x14\x73\xb0\xcc\xf2\x58\xff\x66\xf0\x9e\x92\xa5\xce\x1b\xcb\xa9\xe8\x73\x32\x11\xe9\x3c\x9e\x88\x3e\xdb\x60\x1d\xae\x48\x03\x55\x19\x9c\x19\x33\x44\x50\x83\x48\xb1\xf1\xe2\xa5\x96\xcd\xb8\x95\x39\xe5\x07\xa0\x5c\xa1\xd4\x40\xb9\x16\xd1\xfd\xc7\x47\x23\xb6\xbd\xba\xde\x48\xbd\x3d\x1a\x2f\x65\xd6\x3a\xdd\x76\xfa\x65\xa9\xf3\x1f\xde\xbd\xc4\x6d\xe3\x3b\x9f\x4d\xcf\xed\xdd\x0c\x21\x73\xb7\xa4\xf1\x9b\xb6\x37\xa6\x53\x97\xce\x9b\x4d\x7c\x3a\x77\x65\xc6\x45\xcd\xfc\xa7\x1c\x2f\x73\x9d\x61\xef\x33\x48\xf4\x84\xcc\x7a\x5b\xd3\xcd\x7c\x79\xff\x7e\xed\xa2\x3f\xbe\xd5\x4c\xd9\xce\x5c\x85\xd9\xa9\x91\x33\xd4\xd7\x96\x47\xaf\x1e\x62\xb7\xf9\x61\x27\x95\xd4\xdd\x8d\xab\xc3\x6d\xaf\xd3\x1c\x5e\x75\x9c\xec\xc5\x60\xce\x35\xe8\xea\x46\x70\xa9\x6e\x7f\x79\x0b\x1a\x08\xa5\x5d\x4d\xa4\x7e\xe6\x0a\xf8\xfb\xf7\x02\xd0\x09\x1c\xba\xc9\xcd\x7f\x1b\xe7\xa2\x4d\x34\x81\xc7\x47\x33\x1f\x83\x15\xef\x89\x2c\x32\x3a\x2e\x46\xdb\xb0\x98\xd0\x2c\x6e\xde\x36\xc3\xbf\xff\x17\x40\x31\x90\xc2\x2b\x96\x8b\x13\xbf\x58\xce\x90\x41\xa6\x10\x1e\x1f\x9f\xc1\x98\xf3\x60\xe0\x49\xa4\xdc\x37\x38\xff\x1f\xbe\xaa\x88\xdf\x53\xac\xbd\xa6\x58\x4d\x06\xf1\xe5\xf9\x57\x76\x68\x80\x52\x0b\x33\x31\x1d\x91\x50\x8b\x7b\xe2\x85\xe1\x7c\x34\xa7\x7c\xe4\x87\x26\x26\x08\x0e\x67\x50\xca\x40\x31\xca\x71\x14\x48\x9c\xd0\x6f\x70\x06\xd6\x07\x0d\x1f\x08\x7c\xa0\xf0\x01\xe1\x83\x07\x56\x0a\x39\xa5\x7c\x3a\xf2\x04\x63\xe8\x69\x21\xe1\x0c\xc4\x64\x92\x28\x2d\x43\x89\x7c\x1b\x3d\x08\x79\x87\x52\xc1\x19\xd4\xb6\x01\x38\x09\x34\x9d\x23\x9c\x41\xb9\xaa\xb6\xa7\xe3\xff\xf4\x4c\xa2\x9a\x09\xe6\xc3\x19\x54\xaa\x3b\xc1\x94\x47\x18\x8e\x26\x24\xe6\xa8\x74\x58\xde\x06\x25\x9c\xb0\xc5\xef\xb8\x86\xb2\x5c\xda\x0d\xb7\x85\xb3\xb4\x9b\xbe\x27\x94\x1e\xf9\xc8\xc8\x22\x42\x3a\xdf\x2d\x50\x04\xc9\xe8\x9c\x6a\x23\x51\xa9\x54\x7a\xc2\x57\x5d\x94\xf7\x34\x0a\x1d\xcf\xbc\x3d\xfb\x07\xf4\x5f\x15\xa0\xd7\x88\x43\x8f\xd4\x31\x5b\x85\x98\xf5\xd4\x5d\x93\xa7\x0d\x42\xea\x06\x54\x8f\x8f\x2a\xc9\x80\x14\x5a\x78\x82\x35\x60\xd8\xea\xc7\x63\x9a\xc8\x29\xea\xfe\x3a\xa8\xc2\xa5\x1f\xfe\x55\x72\x3f\xb1\x21\x15\x2a\x63\xa2\xe6\x64\x42\x39\xd5\x8b\x06\x74\x93\x87\x28\x4b\x65\xb5\x58\xa8\x34\xca\x2b\xc3\xef\xb2\x60\x88\x03\x8b\x20\xfe\x39\x61\x84\x7b\x28\x1b\xf0\xfd\x71\xb7\xc1\xfb\x66\x4c\x69\xe4\xfa\xd6\xf4\xfa\xd8\x62\x84\xce\xff\xc9\xcd\x4f\x3c\x0f\x95\xba\x16\x3e\xae\x9c\xe0\xfb\xf7\x34\xbf\xb4\x12\x0c\xea\xb0\x9d\xbc\x12\x1c\xe0\x32\x67\xaa\xc3\xa5\x1a\x9a\x2b\x14\x71\xba\x90\x09\x40\x22\xae\xc4\xaf\x21\x2a\x9d\x79\x2b\xa8\xb4\x90\xd1\x3b\xbd\xd7\x12\x6b\x91\x80\x78\x54\x2f\x1e\x1f\xdf\x6d\x25\xc3\x97\xac\x77\x97\x84\x5b\x8c\x28\x15\xb3\xab\x32\x43\xdd\xc8\x78\xaf\x65\x6a\x03\x69\x9c\xe9\xde\xca\xa2\xe1\x21\x66\xed\x7e\x35\xf0\x7a\xa6\x62\x34\xdf\xbf\xff\x09\x56\x3a\x91\xd7\x26\x7a\xda\xd8\xcb\x73\xa2\xbd\x59\x67\xcd\xaf\x0d\x11\x49\xf8\x14\xe1\xfd\x1d\x2e\x6c\x78\x7f\x4f\x58\x88\xa6\x5c\xff\x13\x74\x97\x88\x23\x8c\xf0\xf8\xd8\x88\x3e\x2f\xf1\xae\x00\x32\xb5\x45\xaa\xfc\x8d\x8d\x4c\x82\x40\x1d\x8a\x00\xb9\x9a\xd1\x89\x36\xdb\x23\xb3\xb5\xdb\x18\x30\xb1\x98\x23\xd7\xad\xe4\x55\xe4\x3f\xf3\xae\x96\x18\x30\xea\x11\xd5\x88\x5f\xe5\xed\x33\x0c\x6b\x49\x34\x4e\x17\x09\xa9\xa5\x50\x03\x5c\xd6\xbb\xab\x80\xb0\x11\x21\x00\xa2\xa4\xbb\xf6\x9a\x78\x8e\x73\x11\x3d\x68\xae\x54\x6b\xd7\x34\x7d\x6e\xb8\x1d\x4d\xb2\xb0\xa5\x04\x54\xe3\x3c\x60\x44\xaf\x9e\x08\xaf\xdb\x73\xdb\x7a\xbb\xf4\xf2\x12\xdd\xbc\x42\x3f\x59\x33\x2d\x4d\x13\x55\x11\x4d\xcf\x13\x21\xd7\xdd\x0d\x37\xc3\x09\x09\x99\x5e\x01\x47\x4d\x09\xe5\x28\x33\x5c\x1b\x77\xbf\xcf\x0a\x91\xa4\xf1\x8d\xa7\xa0\x6b\x2d\x4e\xb4\x7f\x9e\x8f\x29\x37\x0a\x65\x66\x17\xe6\xe2\x4e\x5e\x54\xbe\x05\x7f\x9f\x28\xf5\x20\xa4\xff\x1c\x8d\xe4\x29\xe6\x5b\x68\x64\xc2\xe9\x4e\xfc\x5b\xcf\x43\xdf\x42\xc8\x8d\x9b\xa2\x5c\xa1\xe8\x3c\x4a\x77\xd6\xf7\xef\xcf\x84\xc2\x2b\x03\x08\x8f\x8f\xd6\xe6\xe2\x7e\xc8\x58\x5f\x30\xea\x2d\x1a\xd0\x64\x0f\x64\x91\x75\x3e\x46\x27\xe8\x2d\x3c\xb6\xf1\x20\x7e\xd5\x57\x6e\xbe\x93\xc7\x6f\x59\x1f\x4c\xdd\x6b\x3e\x27\xdc\xdf\x9e\x28\x40\xd4\x89\xae\xfa\xd0\xec\x4c\xc1\xcb\x03\xdf\xd1\x2f\x66\x1b\xdd\x75\x4e\xa5\xc9\xa2\xc1\x9f\xe4\xf3\x29\x4e\xcd\x9c\x55\xf0\xac\xfc\x89\xfe\x85\xf1\xb1\xb3\x0d\xbe\x4d\xbc\x28\x86\x0a\xa5\xf9\x00\xc1\x74\xe4\x69\x66\xea\x85\x00\x0a\x85\xb9\xf0\x11\x26\x44\x69\x6b\xcd\x0e\xf7\xc8\x51\xa9\xbe\x14\xe3\x0d\x5b\x98\xaa\x94\x12\xd6\x36\x6d\x88\x8b\x9e\xe0\xbe\x6a\x40\xad\xb4\x06\xa3\xbd\xc0\x15\xde\x1d\x6e\xd9\x6b\xab\x02\x87\x55\x4e\xda\xaa\xd6\x61\xbd\xaa\x4f\x44\x5c\xc5\x8e\x7e\x1e\xae\xfc\x9a\x1e\xa2\x70\x4b\x7c\xba\x43\xa6\x3c\xf3\xec\x30\xce\x2e\xc3\x14\xa0\x40\xb7\x87\x36\x7d\xaa\x00\x7f\xed\x73\xf7\xe7\x2d\x53\xcd\x80\xfc\x0d\xda\xe7\xf0\x8b\x70\xc1\x33\x15\x1e\x50\x05\x07\x17\x21\x91\x84\x6b\x44\xff\x00\x3e\x26\x19\x09\xce\xce\xe2\x3c\xf6\x69\x6d\x75\x57\x68\x6c\x40\x8f\x43\xcf\xed\x81\x9e\xa1\x44\x83\x83\x0b\x48\xb1\x2c\x51\xdb\x40\xb5\x02\x12\xed\x6e\x18\x87\x52\x69\x32\x66\xb8\x66\x8c\xad\xc4\x09\xb9\xc9\x13\x32\x49\xf1\xe5\x95\x63\xc7\xe0\x39\xbc\x8e\xd6\xad\x85\xaf\xc8\xb0\x41\xf8\x7a\x5c\xad\xfe\xcd\x06\xa2\xbc\xf4\xfd\x36\x6e\x07\x4b\x4c\x7f\x11\xbf\x09\xb6\x4d\x8e\x97\x35\x78\x74\x45\xba\xb1\xa3\xe6\x66\xac\x4f\xf4\xac\xb1\x19\xef\x4c\xb8\x58\xe3\x66\xfb\xb4\x68\x03\xe4\x29\x6c\x49\xf4\x7c\x0a\xe3\xf6\xaf\x7a\xf2\x31\x8b\x40\x9b\x0a\xa6\x20\x85\xd0\x45\x25\xbd\x62\x1a\x3e\x0a\xde\x64\x5a\x7c\x86\xeb\xf8\x8c\x2b\xc1\xbc\xa3\xf2\x30\x7b\x6e\xe4\xf6\x6e\x06\x2d\x67\xd4\x6d\x5e\xe7\xa6\xed\x94\x6e\xa3\x58\x7c\x59\x1d\xd2\x78\x79\x39\xf1\x3f\x4c\x78\x84\xcd\x84\xd2\x0d\x13\xe8\x8a\x89\x0c\xff\xad\x14\x33\x81\xfb\xcc\xa7\x6a\x63\x6b\xad\xca\x82\x8b\x91\xf3\x6b\xbf\x37\x18\x3a\x83\x91\xf3\xeb\xd0\xe9\xb6\x47\xbf\xdc\x38\x83\xdf\x46\xfd\xe6\xf0\x32\x4f\x92\x22\xea\x54\x8d\x45\xfc\x66\x62\x2f\xca\x62\xf6\xd7\x7b\x6f\x29\x04\x9c\x18\xd1\x1b\x2b\x82\x9d\xbf\x80\x7c\x59\xb6\x9a\x10\xca\x42\x89\xc3\xe4\x34\x6e\x3d\x20\x3e\x9b\xa9\xea\xe5\xd3\x93\x57\x66\xbf\xa7\xf2\xcc\x5e\xb8\x39\x2a\xbd\x2a\x81\x6e\x21\x5d\x6a\x7c\x5b\xcb\x6f\x8a\xd9\x51\xcf\xf3\xaa\xb8\x69\x3a\x9f\x57\x87\xad\x5c\xd7\x7d\x26\x06\xe4\xfc\x92\x36\x25\xb8\xd6\x98\xbc\x78\x6d\xd4\xd9\x2c\x0f\xfe\x1b\x6f\xa3\x9e\x47\x6c\x23\xc4\x06\x79\xc7\x77\xeb\xe4\x3c\x33\xb4\xd9\x83\x8d\x33\x44\x76\xb0\x19\xf7\x69\xd7\xc2\xc7\x06\x54\xcb\xe5\xb7\x45\xea\x17\x02\xee\xe4\xe2\x99\x70\xfd\x24\x80\x96\x74\x3a\x5d\xf5\x95\x85\xe4\xcc\x34\x22\xd4\x9a\x11\x3e\xc5\x77\xff\x17\x00\x00\xff\xff\x1d\x77\x65\x0d\x87\x3d\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x5b\x7b\x77\xe2\x38\x96\xff\x3f\x9f\xe2\x6e\x4d\xf5\xba\x6a\x47\x84\x57\x20\x40\x77\x76\x97\x80\xf3\xe8\x26\x40\x63\x92\xea\xde\x7f\x38\xc2\x16\xa0\x89\x90\x5c\x92\x9c\x2a\x3a\xcd\x77\xdf\x23\x63\x63\x03\x26\xa4\xd2\x75\x38\x33\xbb\xe5\x9c\x6e\x2c\x5d\xdd\x97\x7e\xba\xba\x7a\x38\x07\xd8\xa7\x0f\x44\x2a\x2a\x78\x03\x9e\x8a\x27\x00\x8f\x94\x7b\x0d\x68\x09\x3e\xa1\xd3\x3b\xec\x9f\x00\xcc\x89\xc6\x1e\xd6\xb8\x71\x02\x00\xc0\xf1\x9c\x34\x40\x2d\xb8\x47\x14\x55\x39\x6f\x9c\x9b\x13\x2d\xa9\xab\x72\x6e\xd8\x26\x24\x62\x78\x4c\x98\x5a\x35\x00\xc0\xbe\x9f\xb4\x88\xca\xe2\xd7\x53\x2a\xf2\x87\xea\xf5\xc2\x27\x0d\xa0\x7c\x22\xb1\xd2\x32\x70\x75\x20\x49\x06\x99\x2b\xe6\xbe\xe0\x84\xeb\x4c\xf5\x4e\x00\x12\x23\x3e\x07\x44\x52\xa2\x4e\x17\x78\xce\x1a\xf0\x67\xc4\x0c\xc0\x9f\x8e\x0c\xd1\x18\x2b\x12\x2b\x1f\x93\x2f\x1a\xf0\x0e\x1c\xbb\x63\xb7\x86\x69\xb2\x53\x0f\x6b\xe3\x12\x94\x2e\x1c\x29\xfa\x07\xf9\x90\x41\xf5\x11\xb0\x02\x53\x09\x57\x83\xde\x5d\xba\xc9\xbb\x94\xb8\x48\xe3\xb4\x06\x00\x39\x88\x78\x6c\x16\x9b\x27\x50\x78\x4a\x1a\xf0\xae\xd3\xbc\xb4\x3b\x69\x46\xab\xc7\x23\xca\x95\xd4\xd7\x61\x1f\xbf\xeb\xe2\x39\x01\x31\x01\x3d\x23\x90\x25\xdc\x48\x32\x1a\xee\x17\x73\xdd\xbc\xbf\xb6\x0f\x89\x69\x53\xf5\x08\xca\xc7\x2e\x81\x40\x11\x0f\xc6\x8b\x2d\x89\x27\x6f\xc0\xde\x3f\x11\xac\xb2\xc6\x82\xc2\x73\x9f\x11\x6f\x9c\x8c\x84\x44\x75\x8f\x30\xa2\x49\x44\x92\xf3\xc6\xa7\x6a\x96\x00\xef\x6f\xff\x96\x1f\x53\x9e\x1f\x63\x35\x8b\x4a\x02\xae\x29\x03\x53\x00\x39\x17\xde\xf9\xea\x33\x83\xdc\x0c\x8a\xa5\xf3\xd3\xc2\x69\xe1\xb4\x08\xb9\x7b\x78\xdf\xef\x39\xc3\xeb\x81\xed\xfc\xda\x19\xdd\x3b\xf6\x00\x72\x9f\x21\xe7\x6d\x14\xb7\x9b\xc3\xe6\x65\xd3\xb1\x0d\x13\x2b\x02\x6f\xd1\x7a\xf7\x23\x78\x22\x12\x04\x40\xdc\x99\x80\x77\x9f\x30\xd5\x94\x4f\x61\x22\x24\xf4\x85\xd2\x53\x49\x14\x28\x22\x9f\x88\x3c\x3d\x3d\x4d\x7a\x5b\x31\x42\x7c\x28\x46\xef\x9e\xe0\xb1\xcb\x56\x6c\xfe\xc3\xfc\x5b\x19\x6b\xb8\xc5\x1e\x79\x07\x10\x91\x85\x96\xfc\xf4\x93\xdd\xbb\x8a\x0a\x00\xda\x83\x5e\x1f\xd6\x9a\xde\x5e\x81\xfd\xdb\xad\x33\x74\xd6\x8d\x7f\xdc\xa4\x0c\x4d\xdd\x4f\x15\x73\xc6\x9e\xf7\xff\xc4\xdb\xae\x24\x78\xd3\xdb\xfb\x7d\xdd\x1a\xd8\xcd\xa1\x9d\x78\x7b\xdb\x7b\x6b\x8a\xd0\xcb\x71\x2d\x7c\xba\x1d\xde\x40\xbf\xe9\x38\x9f\x7a\x83\x36\x58\x69\xa3\x9d\xe6\x5d\xbf\x63\xb7\x2f\x47\x71\xb5\x95\xf0\xba\x1e\x34\xbb\x43\x68\x76\x3a\xd0\x1f\xdc\x3e\xdc\x76\xec\x6b\xdb\x81\x5e\x77\x57\x3c\x68\xb1\xb7\x23\x23\x3b\x72\x5e\x42\x9d\xbb\x4f\x7e\xff\xf4\x93\x65\xf7\xae\xac\x6d\xfd\x9d\xd6\x8d\x7d\xd7\x84\xe6\xfd\xf0\xa6\x37\xb8\xfd\x9f\xe6\xf0\xb6\xd7\xdd\x11\xb1\xa6\x1e\x36\x2f\x3b\x36\xdc\x5e\x41\xb7\x37\x8c\x81\xe5\x0a\xae\xb1\xab\xe1\xc3\x84\x4a\xa5\x47\x26\xf4\xc2\x43\x73\xd0\xba\x69\x0e\x10\x30\xbc\x53\x64\xa6\x1f\xcc\x17\x29\x1a\x82\xbd\x91\x12\x81\x74\xd3\x54\xa6\xb3\x88\x99\x18\x88\x71\x83\xfd\x31\xd1\xe5\xb6\xeb\xd8\x83\x21\xdc\x76\x87\xbd\xb5\xf0\x87\x66\xe7\xde\x76\xe0\x83\xf5\xb3\x20\x16\xb2\x7e\xc6\xee\xa3\x12\xdc\x42\xd6\x80\x78\x70\x83\xb5\x85\x2c\x6f\x6c\x21\x37\x90\x92\x70\x3d\xd2\x74\x4e\x94\xc6\x73\xff\xe3\xab\x4c\xd4\xc2\x13\xf0\x81\x7a\xe0\xd8\x83\xdb\x66\xd8\x4b\x77\xcd\xc1\xef\xf0\x8b\xfd\x3b\x02\x8d\xd5\x63\x4a\x6f\x61\x7a\x4a\x13\xcf\xe8\x67\x5f\xdb\x83\xd7\x49\xf8\x42\x39\x61\x54\xe9\xbd\x52\x0c\x41\x22\xc5\x97\xd4\x25\xb1\x04\x04\x0b\x82\x65\xf2\x36\xfd\xa2\x92\x17\x97\x26\xad\xf8\xf8\x1f\x49\x85\x2f\x85\x17\xb8\xda\x15\xde\x36\xdf\xb1\x10\x8f\x84\x6b\xb9\xa0\x5e\x5c\xb3\xc7\xfb\x69\xad\x51\xf8\x16\xb1\x40\x46\xa3\x50\x13\xa3\x41\x28\xf9\xe3\xba\x8f\xce\x4a\xc8\x6a\x8e\x25\x09\xe0\x81\x72\xb2\xc0\xd2\x43\xd0\xc1\xca\x84\x53\xec\x61\x85\xe0\x46\x7c\x21\x8c\xc1\x9d\x08\xb8\xc6\x94\x5b\xa8\x74\x5e\x41\xa5\x42\xb1\x8c\xea\xb5\x42\x09\x59\x97\x16\x2a\x7f\x34\xe3\xa3\xd5\xeb\x5e\x75\x6e\x5b\x43\x23\xff\x23\xb4\x7b\xc6\xa3\x37\xb7\xdd\xeb\xef\xa9\x6d\xbd\x88\xac\xa6\xc4\xc1\x3f\x04\xd8\x4a\x63\x4d\x10\xd8\x54\x11\x46\xd6\xda\x43\x0b\x8f\x89\xe4\x44\x83\x83\x83\x27\x3a\xe5\x82\x23\xe8\x62\x1f\xc3\x03\x66\x8c\x2c\x2c\x74\x56\xaf\x1b\xfd\x2b\xa8\x7e\x5e\xaa\x21\xab\xf5\xf7\xa3\x1a\x50\x47\x56\x33\x18\x13\xa9\xe1\x13\xe5\x44\x21\x18\x50\xed\xce\x68\xda\x80\x19\x96\x9e\xe0\x1c\x2f\x10\x7c\x9a\x51\x63\xa3\x23\xb8\x98\x63\x68\x09\xac\xb4\x85\x4a\xa5\x4a\x6c\x40\xf1\x1c\x59\xcd\xa3\x1a\x50\xab\x21\xeb\x52\x70\x2f\xf2\xbf\x42\xd0\x67\x81\xa4\xe3\x40\xc1\x80\x78\x5b\xae\x86\xb3\x62\x61\xed\xeb\xfa\xb1\x55\x2d\x97\x91\xd5\xc2\x8b\x40\x25\xce\x55\x08\x2e\xa9\xe0\xd4\x85\x2b\x29\xa6\xe0\x2c\x24\x9e\x21\xf8\x84\x19\xc3\xd1\x7f\x63\xd5\x4b\xb5\x50\xf3\x02\xaa\xd7\x8e\xef\xe4\x6a\x1d\x59\xad\x19\xf6\x7d\xc2\x18\xd1\x08\xfa\xd2\x80\xc4\xa0\xfb\x86\x32\x76\x18\xe2\xa5\x72\x08\xf1\x33\x54\x3f\x3f\xab\x1d\x5b\xf9\x52\x01\x59\x2d\xc1\xa6\x94\x43\x8b\x30\x86\xa5\x42\x30\x5c\xb8\x33\x25\xf8\x4a\xfd\xd7\x0f\xd5\x72\xc5\x20\xbd\x50\x42\xf5\x5a\x6c\xc7\xd9\xd1\xec\x38\x2f\x21\xab\x9d\x60\x22\x8d\xa1\x3b\xbc\xc0\x5b\xaa\x9e\xd5\xea\x51\x54\x3c\x3f\x43\x56\xf3\x98\x8a\x56\x10\x58\x6d\xcc\x71\x32\x24\x3b\x42\x07\xea\x1b\xfc\x5c\x5a\x85\x44\x03\xf6\x9a\x01\xfb\x31\xe1\x62\x46\x57\x5b\xcc\x29\x0f\x54\x64\x00\x82\xd6\x4c\x52\xa5\x29\xe6\x66\xda\x21\xf4\xeb\x96\xba\xc5\x42\x2d\x9e\x81\x2a\x2b\x67\x57\x8f\xa7\x6e\x11\x59\xed\x80\xf3\x34\x1c\x86\x12\x53\x46\xe4\xcb\x0e\xdf\x99\x47\xcb\xc9\x3c\x5a\x3d\xb2\xcf\xcb\x15\x64\x5d\x05\x3a\x99\x44\x2b\x95\x42\x01\x1c\xe6\x41\x2e\x53\x77\x47\xe3\xa9\x82\x0e\xc1\x3e\xb4\xa9\x32\xeb\x7c\x6d\xa1\xf2\x7a\x1a\xaa\x15\xcb\xc7\x0e\x32\x50\x47\xd6\x0d\x96\x0c\xf3\xb5\x0d\x1b\x10\x29\x57\x8d\x72\x85\x22\xaa\xd7\xce\x23\xe5\x8e\x87\x11\x13\xab\x7e\x16\x8a\xf8\x33\xe8\xcf\x08\xf3\x93\xa1\xa8\x10\xdc\x72\x45\xa7\x9c\x6e\xc7\x8f\x52\xf5\x0c\x15\xeb\xf5\x22\xaa\x9f\xd7\xcf\x8e\x0c\x87\xd2\x39\xb2\x7e\xc1\xbe\xab\x30\xf7\x16\x70\x85\xe7\x94\x2d\xc2\xf4\x44\x2e\x10\x38\x06\x21\xd0\xc1\x3c\x89\x80\x70\x2d\x31\xf7\x72\x0f\x94\x67\xa2\x65\xc3\xae\x62\x29\xce\xb6\x6a\x67\xc5\x63\xa3\xa4\x58\x40\xd6\x2f\x82\x4f\xd5\x14\x87\x89\xed\x70\x46\xe0\xe7\xc0\x9b\x92\xac\x24\x6b\xb3\x3b\xce\xaa\x06\x3f\x06\xdc\xd5\xca\x91\xbb\xc3\x08\xec\x60\xf9\x38\x27\xd8\x4b\x23\xc7\x68\x6f\xca\x5f\xe1\xf4\x62\x1c\x20\xcf\x2b\xc7\xd6\xbe\x52\x47\x56\x47\x3c\x8a\x05\x5e\x43\x28\x8c\x79\xf0\x40\x88\x47\xe4\x61\xe5\xcb\xc5\x72\x84\x98\xf3\x63\xcf\x45\x46\x60\x1f\x07\x0c\x6e\xc4\x78\x6c\x72\x45\xe2\x3e\x2a\x2d\x26\x13\x22\x61\x28\xe0\x17\xcc\x44\x12\xf8\x33\x2d\xe9\xe1\xc7\x27\xca\x18\x31\xb9\xcb\x3a\x21\x28\xd7\x8e\x9c\x11\xd4\xaa\xc8\xea\x13\x4d\x24\xdc\x51\x77\x86\x09\x5b\x77\x45\x5f\x50\xae\x61\x20\x82\x29\x79\x71\xa1\x11\x70\x6d\x06\x6f\x2d\x8c\xa2\x35\x63\x43\xe9\xd8\x7d\x51\x46\x56\x5f\x8a\xb9\xe0\x5a\xc8\xc5\x16\x46\x2a\xf5\xca\x66\xb6\x75\x3c\xbd\x6a\x45\x64\xfd\x1a\x50\xe6\x12\x0f\x43\x4b\x12\xf2\x88\x32\x91\xd0\x12\x2c\x98\x8f\x69\xa2\x73\xb1\x6a\x00\x51\xa8\x1b\x67\x9a\x09\xff\xef\x16\xaa\x1c\x4d\xeb\x72\x15\x59\x03\x6a\x22\x5f\x2a\xa0\xdc\x09\xae\x09\x5c\x12\xc6\x04\x02\x07\x73\x6d\x0c\x0a\xfe\x58\xe7\x28\xca\x42\xc5\x4a\x21\x0e\xdf\x85\xfa\x91\x3d\x7d\x56\x45\x96\xe3\x62\x49\x5c\x29\xbe\x64\x3b\x79\x10\xe8\x19\x91\x13\x21\x3d\x0b\x9d\x9d\x15\xe2\x45\x4f\x3d\xf2\xef\xf1\x46\xdc\xd9\xb9\xd1\x75\x26\x71\x18\xe2\xe2\x65\x4f\x3a\x7e\x84\x9b\x2a\x94\x78\x12\xa7\x33\x73\xc1\x88\xfa\x22\xa4\x9e\x2d\x0e\x07\x46\xa8\xae\x23\x4a\xfd\xec\xc8\x11\xa5\x70\x66\xec\x93\x04\xcf\xcd\x9e\xad\x8d\xa7\x8c\xa0\x57\x68\x5c\xaa\x56\xe3\x65\x74\xbd\x50\x39\x72\xaa\x7e\x5e\x44\x96\xc3\x04\xe6\x66\x01\x2d\x7c\x49\x89\xc6\x72\xb1\xda\xa6\x48\x03\xa7\x54\x2e\xac\x83\xc9\xd1\x53\x94\x7a\x19\x59\x8e\x2f\xb4\x56\x5f\x84\xf0\x08\x8a\xd3\xaf\x55\x56\x0b\xd7\x52\x7c\xc9\xce\xb2\x1c\x0d\x37\x84\x11\x8e\x2d\x54\x3c\x5b\x03\xa3\x54\x0d\x81\x51\x3f\x9a\xfe\xd5\x2a\xb2\x1e\x88\x0c\xb7\xa9\x3a\x04\xda\x44\x51\xb9\x33\x8f\x94\x42\xe4\x16\xce\x4d\x3e\x52\x3e\x72\x3e\x52\x2c\x84\xfb\x11\x5c\x53\x1e\x04\xf3\x0c\x28\x24\x53\x76\x34\xdd\x9d\x9b\x8d\xb5\xea\xb7\x01\x21\xda\x4d\xee\x0d\x60\x60\xf7\x3b\xcd\x96\x0d\x57\xf7\xdd\x56\xb8\x7f\x8f\x3d\x6f\xc4\x08\xf6\x3e\xac\x89\x01\x56\xbb\xf3\x98\x7b\xa3\x64\x4f\xfe\x09\x4b\xb3\xc7\x83\x52\x64\xf1\xee\x7c\x46\x95\x3f\x13\x3c\xb3\x0d\x99\x63\xca\xb2\x2a\xd2\x3b\xfb\x7b\xab\x35\x36\x3b\x07\x19\xd5\x72\x75\x5a\x13\xd5\x7c\x3c\x49\x55\x0d\xec\xe1\xfd\xa0\xeb\xc0\x93\xa0\x5e\xaa\xb8\xd3\xec\x5e\xdf\x37\xaf\x6d\xb0\x7c\xe6\x4f\xd5\x67\x66\x25\x8d\x9a\x0e\xbc\xbf\xec\xb5\x7f\x7f\xbf\x2e\x69\xdb\xad\x4e\x73\x60\xaf\xdf\x61\xb5\x95\x1f\xc9\x4b\x1c\x7d\x69\x5f\xdf\x76\xb7\xa9\x1a\x17\xe6\xec\xc1\xc5\xfa\x43\xda\x8a\x3f\xff\x04\x0b\x2c\x04\x56\x87\x60\xaf\x01\x7d\x46\xb0\x22\xeb\x43\x0a\x0b\x65\xf5\x02\x02\x0b\x26\x52\xcc\xc1\x82\x3f\xff\x8c\xfd\x6f\x0a\x9f\x28\x5e\xf9\xbc\xb1\xaa\x0a\x7f\xc7\x15\xa1\xcf\xa3\x8a\xf0\x37\x02\xeb\x74\x2d\x1a\xa8\x4a\xf1\x4c\x75\x43\x48\x35\x08\x1d\x1b\x35\x5e\x79\xd9\x94\x5b\xa9\x5d\x7e\x00\xca\x95\xd9\x32\xa6\x5c\x8b\xf0\xfc\xe3\x83\x71\x0e\x5a\x1f\x6f\x24\x68\x0f\xcb\x0b\xa9\xb6\x76\xb7\x9d\xbc\xac\x7c\xfe\xe3\xc9\x6b\x60\x1b\x9d\xf9\x6c\x23\xb7\x77\x3f\x8c\xfc\x66\xdc\x05\x9a\x7c\xd5\x69\x98\x98\x6a\x86\x5f\xaa\x8d\x31\x9d\xd9\x32\x05\x51\x53\xff\x31\x03\x65\x8e\x3d\xec\x5d\x81\x24\xae\x90\x69\xb4\x35\x9d\xd4\xcb\xfb\x04\x57\xe6\x89\x4e\x35\x13\xb5\x53\x47\x61\xeb\x23\xb0\x8d\xa3\xaf\x8d\xe6\xe1\xad\x87\x08\x36\x3f\xee\x95\x92\xc0\xdd\x40\x1d\x1e\x7a\x9d\xe6\xf0\xb6\x63\xc7\x0d\xcc\x79\x66\xc6\x31\xe8\xfa\x44\x70\xe5\x6e\x6f\x75\x8a\xea\x0b\xa5\x1d\x8d\xa5\x3e\x70\x04\xfc\xfc\x9c\x03\x3a\x81\x53\x27\x3a\xcc\x3f\x6d\x93\xb9\x68\x63\x8d\x61\xb9\x34\x04\x11\x59\xfe\x09\xcb\x3c\xa3\xe3\x7c\x38\x0c\xf3\xb1\xcc\xfc\xf6\x69\x33\xfc\xfb\x7f\x02\xe4\x7d\x29\xdc\x7c\x31\x3f\xf1\xf2\xf1\x11\xae\x11\x43\x98\x22\xb0\x5c\x1e\xe0\x98\x71\x61\xe0\x45\xa6\xdc\x33\x3c\xff\x0f\xde\xaa\x88\xee\x53\x6c\xdc\xa6\x58\x57\xfa\xd1\xe1\xf9\x67\x76\x6a\x2e\x5d\x24\x3d\xcc\xc4\x74\x84\x03\x2d\x9e\xb0\x1b\x04\xf3\xd1\x9c\xf2\x91\x17\x98\x98\x20\x38\x5c\x40\x21\x45\xc5\x28\x27\x23\x5f\x92\x09\xfd\x0a\x17\x60\xfd\xa0\xe1\x07\x0c\x3f\x50\xf8\x81\xc0\x0f\x2e\xc4\x07\xcb\x4c\x4c\xa7\x94\x4f\x47\xae\x60\x8c\xb8\x5a\x48\xb8\x00\x31\x99\x44\xb5\x69\x49\xf8\xeb\xe8\x8b\x90\x8f\x44\x2a\xb8\x80\xea\x2e\x01\xc7\xbe\x39\xa6\x85\x0b\x28\x56\xd4\x6e\x75\xf4\x3f\x3d\x93\x44\xcd\x04\xf3\xe0\x02\x4a\x95\xbd\x64\xca\xc5\x8c\x8c\x26\x38\xd2\xa8\x70\x5a\xdc\x25\xc5\x1c\xb3\xc5\x1f\x64\x83\x65\xb1\xb0\x9f\x6e\x87\x67\x61\xbf\x7c\x57\x28\x3d\xf2\x08\xc3\x0b\x63\x4f\x61\xbe\xdf\xa0\x90\x92\xd1\x39\xd5\xc6\xa2\x42\xa1\xf0\x02\x56\x1d\x22\x9f\xa8\x4b\x76\x90\xba\x83\x8c\x7f\x42\xfc\x2a\x9f\xb8\x8d\x28\xf4\x48\x1d\xa9\x95\x8b\x54\x4f\xe0\x1a\xb1\x34\x34\x0d\xa8\x9c\x95\x4b\x71\x81\x14\x5a\xb8\x82\x35\x60\xd8\xea\x47\x65\x1a\xcb\x29\xd1\xfd\x4d\x52\x73\x54\x6b\x7a\xe8\x7b\xd9\xfd\xc2\x80\x54\x44\x99\x2e\x6a\x4e\x26\x94\x53\xbd\x68\x40\x37\xbe\x88\xb2\x1a\xec\x2d\x16\x28\x4d\xe4\xad\xd1\xd7\xe4\xda\x41\x64\x35\x13\xd8\xbb\xc4\x0c\x73\x97\xc8\x06\x3c\x2f\xf7\x77\x78\xdf\x80\x40\x69\xc2\xf5\x83\x59\xeb\x93\x16\xc3\x74\xfe\x2f\xde\xfd\xd8\x75\x89\x52\x77\xc2\x23\x91\x72\x39\x78\x7e\x4e\xe6\x97\x56\xcc\x41\x9d\xb6\xa3\x4b\x71\xa7\x03\xb2\x9a\xb1\xd5\xe9\xca\x0d\xcd\x35\x8b\x68\xba\x90\x31\x41\x6c\xae\x24\x9f\x03\xa2\x62\x98\x99\x47\x69\x21\xc3\x7b\x7a\xdf\x2a\xac\x85\x7d\xec\x52\xbd\x58\x2e\x4f\x76\x26\xc3\xd7\xb4\x77\x56\x82\x5b\x0c\x2b\x15\xa9\x1b\xe9\x12\x16\x99\x7b\x87\xdf\xae\xd4\x16\xd3\x68\xa6\x7b\xab\x8a\x46\x87\x48\xb5\xa7\x75\xc1\xb7\x2b\x15\xb1\x79\x7e\xfe\x0b\xaa\x74\x42\xd4\x2e\x97\x99\x63\x79\x8e\xb5\x3b\xeb\x6c\xe0\xda\x08\x91\x98\x4f\x09\xbc\x7f\x24\x0b\x04\xef\x9f\x30\x0b\x08\x34\x2e\xfe\x8a\x5c\xf3\x3c\x3f\x87\x1c\x61\xb9\x34\x8e\x88\xf9\x2e\x97\xbb\xb9\x45\xe2\xfc\xb5\xc9\x6d\xe2\x33\xb1\x98\x1b\x77\x2d\xb7\x87\x37\xf6\x7d\x95\x4f\x8d\xf1\x84\xf6\x24\xce\x82\x32\xdb\x9c\x0a\x9f\x70\x35\xa3\x13\x6d\x06\x5a\x26\x83\x55\x2e\x93\x52\xe8\x5f\x3b\x52\x48\xe2\x33\xea\x62\xd5\x80\xe2\x16\x1c\xb2\xfd\xbc\x17\x24\xd9\x46\x1d\x36\xeb\x55\x1a\xa7\x3a\xed\xfb\x38\xf0\x25\x39\x1b\x03\x6b\xd7\x7a\xa5\x25\xd6\x64\xba\x88\x0d\x5f\xf5\xc5\x80\xac\x52\xff\x34\xbe\x5e\x41\x1d\x47\xd2\x68\xa0\xc4\x54\x00\x61\xb6\x92\x72\xae\x41\xd9\x5c\x84\x37\xc1\x4b\x95\xea\x1d\x4d\xee\x69\xee\x86\xe1\x34\x6d\xc1\x90\xa6\xc1\x0a\xa0\xc9\xdc\x67\x58\xaf\x6f\x59\x6f\xc2\x77\x17\xac\xfb\xfb\xf6\xb0\xcf\x5f\xe9\xf7\x88\x70\x8d\x4a\xf3\x67\x2e\x00\x53\x97\x34\x5d\xd7\x6c\x3f\x75\xb7\x46\x15\x99\xe0\x80\xe9\x35\x71\xb8\xae\x33\x87\x23\x29\xad\x8d\xcd\x4f\xc9\x6b\x92\x09\xa5\x6e\x90\x9a\xab\xa6\x29\x0a\x80\x30\x04\x1d\x0e\xcb\xf7\x8a\xc8\xe5\xf2\x65\xde\xf1\xa5\xd4\xb7\xf0\xef\x63\xa5\xbe\x08\xe9\x1d\x92\x11\xdf\x66\x7d\x8b\x8c\xd4\x8c\xb4\x97\xff\xce\x0d\xdb\xb7\x08\x72\xc2\x75\xa4\x37\xce\x34\x8a\xce\xc3\x8c\xc1\x7a\x7e\x3e\x30\x9b\xdc\x1a\x42\x58\x2e\xad\xed\xc6\xfd\x80\xb1\xbe\x60\xd4\x5d\x34\xa0\xc9\xbe\xe0\x45\x1a\x7c\x8c\x4e\x88\xbb\x70\xd9\xd6\x37\x05\xeb\xa5\xf9\x66\x31\x00\xf9\x9a\xc6\x60\xfc\xcf\x15\xf3\x39\xe6\xde\x6e\x45\x0e\xc2\xdb\xf3\xeb\xa5\x7c\xf2\xe4\x20\xe7\x66\x91\xef\x59\x72\xa7\xf7\x0a\x36\x9a\xf9\x92\x38\x5a\xf8\x7f\x51\xcf\x97\x34\x35\x75\x56\xce\xb5\x4e\x76\xca\x4d\x45\xff\xda\x60\xec\x62\x4b\x6f\x13\x2f\xf2\x81\x22\xd2\xfc\x30\xdf\x92\xb8\x9a\x81\xd2\xc2\x87\x5c\x6e\x6e\xae\xbf\x4e\xcc\x1d\xc7\x14\x47\x46\x9f\x08\x27\x4a\xf5\xa5\x18\x6f\xf5\x85\x49\xec\x29\x66\x6d\xb3\x92\x73\x88\x2b\xb8\xa7\x1a\x50\x8d\x17\x89\x51\xd8\x74\x7d\x47\xb8\x8f\x44\x6f\x9b\xb6\xb3\x88\x49\xa6\xe0\x9d\x05\x4f\x4c\xbf\x11\xd9\x72\x49\xec\xd8\x5a\xe5\x00\xec\x5f\x16\x99\x47\x12\xec\xd1\x3d\x36\x65\x75\xcf\x9e\xce\xd9\xd7\x31\x39\xc8\xd1\x93\x83\x98\xca\xc1\xf7\xfd\x62\xe0\x70\xcf\xc4\x0b\x72\xf3\xfc\x0d\xda\x97\xf0\xab\x70\xc0\x35\x49\xb2\xd9\x21\x7d\x77\x1d\x60\x89\xb9\x26\xc4\x7b\x07\x1f\xe2\xb9\x09\x2e\x2e\xa2\x19\x2d\xbd\x17\xf8\x37\xe8\x0a\x4d\x1a\xd0\xe3\xd0\x73\x7a\xe6\x1b\x1d\x49\x0c\x0f\x2e\x20\xe1\xb2\x62\x8d\x80\x6a\x05\x38\x1c\xdd\x30\x0e\xa4\xd2\x78\xcc\xe2\xe9\x73\xcf\x14\x9a\x3d\x8d\xa6\xa7\xc7\xe7\xe7\xd7\x26\xb0\x1d\xa3\xfc\xe9\x5d\xd8\x6e\x23\x7c\x99\x3f\xd7\x0f\xbe\x9d\x57\xab\x7f\xbf\xc5\x28\x6b\x22\x7f\x9b\xb6\x83\x15\xa7\xef\xa4\x6f\xcc\x6d\x5b\xe3\xd5\x32\x26\x3c\x65\xde\xd0\x39\x07\x73\x53\xd6\xc7\x7a\xd6\xd8\x8e\x77\x26\x5c\xa4\x48\x33\xb2\xe5\xdc\x16\xc9\x4b\xdc\xe2\xe8\xf9\x12\xc7\xdd\x0f\xa3\xb2\x39\x0b\x5f\x9b\x05\x7a\x4e\x0a\xa1\xf3\x4a\xba\xf9\x24\x7c\xe4\xdc\xc9\x34\xff\x92\x8c\x64\x9b\xf0\x40\xe6\x61\x42\xe9\xc8\xe9\xdd\x0f\x5a\xf6\xa8\xdb\xbc\xcb\x9c\xb6\x13\xb9\x8d\x7c\xfe\x50\x2f\xad\xf2\x90\xc6\xeb\xd3\x89\xff\x66\xc2\xc5\x6c\x26\x94\x6e\x98\x40\x97\x8f\x6d\xf8\x2f\xa5\x98\x09\xdc\x17\x1e\x55\x5b\x43\x6b\x9d\x16\x5c\x8f\xec\xdf\xfa\xbd\xc1\xd0\x1e\x8c\xec\xdf\x86\x76\xb7\x3d\xfa\xf5\xde\x1e\xfc\x3e\xea\x37\x87\x37\x59\x96\xe4\x89\x4e\xdc\x98\x27\x5f\x4d\xec\x25\x32\x9f\xfe\x00\xf2\x2d\x89\x80\x1d\x31\x7a\x63\x46\xb0\xdb\x77\xc9\x57\x9a\xaf\x98\xad\x26\x98\xb2\x40\x92\x61\xbc\xa1\xb9\x19\x10\x0f\xce\x54\xf5\x62\xed\xfc\x70\x8c\xad\x16\x5e\x39\xcf\x1c\x45\x9b\x72\xe1\x9b\x26\xd0\x1d\xa6\x2b\x8f\xef\x7a\xf9\x4d\x31\x3b\x5c\xfd\x7c\x53\xdc\x2c\x15\xee\xe8\x37\x87\xad\x4c\xe8\x66\x58\x95\x81\xa3\xed\x48\xb3\x8a\x93\x29\x59\xb9\xd7\xb7\x35\x59\x43\x74\x76\xd2\x78\x9b\xf4\xdc\xe1\x10\xeb\x67\xed\x80\x6e\x8a\x73\x4d\xd1\xf6\x1a\x2c\x89\xbb\xb9\x7d\x6a\x46\xeb\x34\xb3\x13\xd9\x80\x4a\xb1\xf8\x92\x0d\xfb\x23\xf5\x2b\x09\xf7\x6a\xb1\xd5\x3e\x6a\x79\x72\x90\x20\xda\x04\xe0\x42\x67\x6d\x04\x68\x49\xa7\xd3\xf5\x8a\x33\x17\x2d\xed\x57\xfb\x43\xad\x99\xd9\x2f\x3b\x79\x7e\xce\x01\xe1\xde\x72\x79\xf2\xbf\x03\x00\x24\x23\xdc\xeb\xee\x3e\x00\x00"),
 		},
 		"/infrastructure": &vfsgen۰DirInfo{
 			name:    "infrastructure",
@@ -212,86 +247,114 @@ var assets = func() http.FileSystem {
 		"/infrastructure/02-syndesis-image-streams.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "02-syndesis-image-streams.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 632,
+			uncompressedSize: 733,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x50\x3b\x6f\xf2\x50\x0c\xdd\xf3\x2b\x2c\x16\xa6\xe4\xd3\xc7\x78\xd7\xb2\x30\xb5\x52\xaa\xee\x6e\xe2\x80\x4b\xee\x43\xd7\x0e\x12\x8a\xf2\xdf\xab\x3c\x2e\x69\x11\x2a\xa3\x7d\x8e\x7d\x1e\x39\x60\xe0\x0f\x8a\xc2\xde\x19\x60\x8b\x47\x2a\x7c\x20\x27\x27\x6e\xb4\x60\xff\xef\xf2\x3f\x03\x38\xb3\xab\x0d\x1c\x46\xb4\xd4\x48\x68\x33\x00\x4b\x8a\x35\x2a\x9a\x0c\x00\xc0\xa1\x25\x03\x72\x75\x35\x09\x4b\x2e\x3b\x9e\xd6\x2d\x7e\x52\x2b\x33\x05\x00\x43\x58\x39\xcb\x2e\x8d\xa3\xd6\x33\x5c\xaf\x81\x0c\xb0\x6b\x22\x8a\xc6\xae\xd2\x2e\xd2\x03\x5a\xe5\x6d\xf0\x8e\x9c\x1a\x90\x1d\xe7\x5f\x78\xc1\x0c\x40\x02\x55\xb3\x11\xc5\xe3\xcd\x52\xdf\x73\x03\xc5\x9e\x2e\x65\x17\x82\x8f\x3a\x0c\x13\x90\x2f\x81\x36\x2d\x2a\x89\x6e\x16\x76\x13\xbd\x4d\x97\x0f\x5a\x79\xc7\xe3\x0d\x9c\xef\xb7\x3f\x1b\x31\xf3\xb3\xed\x4d\x9a\x5a\xa1\x7b\xc1\xbe\x1f\xfd\xbd\x36\x50\x94\x29\xd2\x4b\xca\x23\x45\xb9\x3b\x14\x93\x20\x0c\xc3\x5f\xa6\xf6\xbe\x3a\x53\x9c\x98\xf7\x8e\xfa\xfe\xf9\xeb\xed\xaf\x76\xca\xea\x44\x75\xd7\x52\xbd\x78\x05\x60\x3b\x76\xf5\xe6\x5b\xae\xae\xab\xb4\x24\x9e\x01\x8d\x1d\xad\x31\xdd\x7a\x99\xa6\xef\x00\x00\x00\xff\xff\x84\xc6\x81\xb7\x78\x02\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x91\x3d\x8f\xdb\x30\x0c\x86\x77\xff\x8a\x17\x97\xe1\x26\xbb\x68\x46\xaf\xbd\xe5\xd0\xa1\x05\x5c\x74\x67\x6c\x3a\x61\x4f\x96\x04\x91\x0e\x10\x08\xfe\xef\x85\xbf\x2e\xc5\xe1\xd0\x8c\x12\x1f\xea\x7d\x48\x1d\x8a\x03\x5e\x07\x3a\x33\xd4\x12\xd3\xa0\xa0\xc4\xf0\xc1\x40\x57\x12\x47\x27\xc7\x10\x0f\xbb\x30\xbe\x8f\x27\x4e\x9e\x8d\x15\x31\x85\x5e\x1c\x17\x87\x22\xe7\x12\xd2\x2f\x0d\xd5\x3f\xc0\x34\x15\x25\x28\xca\x6f\x4e\x2a\xc1\xd7\x90\x39\xa3\x0a\x91\xbd\x5e\xa4\xb7\x4a\xc2\x97\xeb\xd7\x02\x78\x13\xdf\xd5\xab\x41\xb3\x08\x14\xc0\xc0\x46\x1d\x19\xd5\x05\x00\x78\x1a\xb8\x86\xde\x7c\xc7\x2a\x5a\xea\x51\x96\x6b\x47\x27\x76\xba\x22\x00\xc5\x78\x67\xb6\xbb\xfd\x38\x67\x3d\xaa\xdb\x2d\x72\x0d\xf1\x7d\x22\xb5\x34\xb6\x36\x26\xfe\x04\x6b\xc3\x10\x83\x67\x6f\x35\xf4\x28\xe5\x1f\xba\x52\x01\x68\xe4\x76\x15\x31\x3a\xbf\x2b\xe5\x2c\x3d\xaa\x17\xbe\x36\x63\x8c\x21\xd9\x34\x2d\x85\x72\x1b\xe8\xc9\x91\xb1\xda\xd3\x46\xf7\x29\x0c\x7b\xe7\x27\x5b\xf9\x45\xe7\xf7\xe2\xda\xff\xbc\x7b\xcd\x1b\xa9\xd7\xc7\x9e\x37\x26\x67\x76\xca\x1f\x03\x73\x86\xd1\xf9\x47\x8f\xaa\xd9\x47\xfa\xb6\xcf\xa3\x55\x73\x7c\xad\x96\x40\x4c\xd3\xff\xa4\x5e\x42\xfb\xc6\x69\x21\x3f\x1a\xe5\xfc\xf8\xe9\xbb\xe2\xbc\x9d\xa6\xbd\x70\x37\x3a\xee\x36\x57\x40\x86\x79\x57\x3f\x83\x93\xf6\x76\x8f\xd6\x9d\xab\x61\x69\xdc\x7f\x26\x67\xf6\xf7\xce\xfd\x94\x73\x09\xf6\x1d\xa6\xa9\xf8\x3b\x00\x8c\xae\x7d\xe8\xdd\x02\x00\x00"),
 		},
 		"/infrastructure/02-syndesis-secrets.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "02-syndesis-secrets.yml.tmpl",
 			modTime:          time.Time{},
 			uncompressedSize: 1759,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x93\xc1\x8e\xda\x30\x10\x86\xef\x3c\xc5\xbc\x40\x52\xf5\x1a\x69\x0f\x69\x70\xbb\x11\x34\x49\xb1\xdb\x8a\x53\x64\x92\x01\xac\x4d\x6c\xcb\x36\x5b\x21\xba\xef\x5e\x2d\x75\x4a\xa1\x81\x40\x39\xec\x11\x3c\xfe\x66\xfe\xc9\xe7\x00\xb8\x16\xdf\xd0\x58\xa1\x64\x04\xcf\xef\x47\x00\x4f\x42\xd6\x11\x50\xac\x0c\xba\x11\x40\x8b\x8e\xd7\xdc\xf1\x68\x04\x00\x20\x79\x8b\x11\xd8\xad\xac\xd1\x0a\x1b\x58\x34\xcf\x68\x02\xdb\x15\x03\x34\x7c\x81\x8d\xfd\x5d\x0c\xc0\xb5\x3e\x54\xfb\xff\xba\x9f\xa1\x50\xef\x86\xce\xdd\x56\x63\x04\x42\x2e\x0d\xb7\xce\x6c\x2a\xb7\x31\x38\x02\xb0\xce\x08\xb9\x1a\xff\x99\xaa\x6a\x04\x4a\x47\x1d\x77\x18\x6f\xdc\x1a\xa5\x13\x15\x77\x42\xc9\x09\x6e\x23\xd8\xed\x42\xda\x31\x13\xd5\x6a\x25\x51\x3a\x1b\xd2\xfd\xec\x61\x72\xb8\x4b\x64\x65\xb6\xda\xdf\x7b\x79\x39\x45\x1f\x1d\xdf\x81\xbd\x73\xe7\xab\x46\x2d\x78\x13\x54\x4a\x2e\xc5\xea\xed\x76\x9e\x17\x24\xa3\x8f\xe9\x47\x56\xe6\xf1\x57\xf6\x58\x26\xd3\x94\x64\xac\xa4\x24\x99\x11\x16\xc1\xcf\xc0\xa3\x77\xbb\x30\xd7\x28\xe9\x5a\x2c\x5d\xce\x37\x6e\xed\x37\xb3\xcf\xea\x97\x5c\xe4\x94\x7d\x9a\x11\xfa\x65\x5a\x16\x31\xa5\xdf\xf3\xd9\xf8\x98\xd0\xb7\xe8\xd7\x51\x16\xdc\x62\x58\x70\x6b\x7f\x28\x53\xff\x0b\xa3\xf1\xe7\x62\x4a\xc6\x1f\xfe\x87\x4a\x79\xab\x1b\xac\x17\x27\x74\x1f\x36\xcf\x27\x29\xe9\x0d\xdb\x07\xdd\xe7\x0e\x13\xa5\x9e\x04\x1e\x05\xa7\xf3\x6c\x4c\x68\x4a\x4b\x92\x25\xb3\x79\xc1\xca\x09\x99\x0f\xe3\xbc\x62\xdd\x91\xf7\xeb\xe0\x6c\xf7\x29\x58\xcc\x48\xf9\x3a\x2f\xc9\x58\x9a\xc4\x2c\xcd\xb3\x9b\x1a\x24\x17\x9e\x55\x5f\x2b\x1f\xe2\x8e\x36\x7d\x2f\x50\x73\xc3\x5b\xfb\x17\xed\xa2\x79\x0f\xd7\xf8\xd6\x6b\xdc\xc3\x8d\x9e\x5d\x36\x6d\x90\x76\xc6\xaf\x5e\xc3\xce\xc1\xce\x7a\xd5\x6f\xd6\x39\xcc\x90\x4f\x83\x46\x0d\x80\xaf\xf0\xe8\xa2\x49\xd7\xe3\x4f\xfd\xf9\x15\x00\x00\xff\xff\x55\xb3\x5d\x61\xdf\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x93\xc1\x8e\xda\x30\x10\x86\xef\x3c\xc5\xbc\x00\xa9\x7a\x8d\x94\x43\x1a\xdc\x6e\x04\x4d\x52\xec\xb6\xe2\x14\x99\x64\x00\x6b\x13\xdb\xb2\xcd\x56\x88\xee\xbb\x57\x50\xa7\x14\x9a\x0d\xbb\x70\xe8\x31\x99\x99\x7f\xe6\x9f\xf9\x3c\x06\xae\xc5\x37\x34\x56\x28\x19\xc2\xd3\xfb\x11\xc0\xa3\x90\x75\x08\x14\x2b\x83\x6e\x04\xd0\xa2\xe3\x35\x77\x3c\x1c\x01\x00\x48\xde\x62\x08\x76\x27\x6b\xb4\xc2\x8e\x2d\x9a\x27\x34\x63\xdb\x25\x03\x34\x7c\x89\x8d\xfd\x9d\x0c\xc0\xb5\x3e\x65\xfb\x7f\xdd\x67\x20\xd4\xbb\x6b\x71\xb7\xd3\x18\x82\x90\x2b\xc3\xad\x33\xdb\xca\x6d\x0d\x8e\x00\xac\x33\x42\xae\x27\x7f\xa6\xaa\x1a\x81\xd2\x51\xc7\x1d\xc6\x5b\xb7\x41\xe9\x44\xc5\x9d\x50\x72\x8a\xbb\x10\xf6\xfb\x80\x76\x9a\x89\x6a\xb5\x92\x28\x9d\x0d\xe8\x71\xf6\x20\x39\xd5\x12\x59\x99\x9d\xf6\x75\xcf\xcf\x97\xd2\x67\xe1\x3b\x64\xef\xdc\xf9\xba\x51\x4b\xde\x8c\x2b\x25\x57\x62\xfd\xff\x76\x9e\x17\x24\xa3\x0f\xe9\x47\x56\xe6\xf1\x57\xf6\x50\x26\xb3\x94\x64\xac\xa4\x24\x99\x13\x16\xc2\xcf\xb1\x97\xde\xef\x83\x5c\xa3\xa4\x1b\xb1\x72\x39\xdf\xba\x8d\xdf\xcc\xd1\xab\x5f\x72\x91\x53\xf6\x69\x4e\xe8\x97\x59\x59\xc4\x94\x7e\xcf\xe7\x93\x73\x85\xbe\x45\x1f\x46\x59\x72\x8b\x41\xc1\xad\xfd\xa1\x4c\xfd\xaf\x18\x8d\x3f\x17\x33\x32\xf9\x70\x8b\x2a\xe5\xad\x6e\xb0\x5e\x5e\xa8\x7b\xb3\x79\x3e\x4d\x49\xaf\xd9\x3e\xd1\xa3\xef\x20\x51\xea\x51\xe0\x99\x71\xba\xc8\x26\x84\xa6\xb4\x24\x59\x32\x5f\x14\xac\x9c\x92\xc5\x75\x39\x8f\x58\x17\xf2\x7c\x9d\x98\xed\x4e\xc1\x62\x46\xca\xc3\xbc\x24\x63\x69\x12\xb3\x34\xcf\xde\xd4\x20\x19\x78\x56\x7d\xad\xbc\x89\x3b\xda\x5c\x3e\x95\x43\xb1\xe6\x86\xb7\xf6\x2f\xb5\x41\xf2\xa2\xd7\xf0\xd6\x4b\x5c\x74\x8d\x88\x0b\x12\x86\x49\x8b\x6e\xe4\xab\x97\xb0\xe8\xad\x5c\xf5\x93\x15\x0d\xdf\xe1\x45\x9e\xae\x12\x15\xbd\xfa\xc0\x2f\x71\x34\x48\x52\x74\x33\x3f\xbf\x06\x00\x55\xb3\x5d\x61\xdf\x06\x00\x00"),
 		},
 		"/infrastructure/02-syndesis-service-accounts.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "02-syndesis-service-accounts.yml.tmpl",
 			modTime:          time.Time{},
 			uncompressedSize: 1354,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x90\x31\x4b\x03\x41\x10\x85\xfb\xfd\x15\x43\xfa\x3b\xb1\xdd\xce\xd2\x4e\x08\xd8\x8f\x7b\x73\xe7\xe0\xdd\xec\x32\x3b\x7b\x10\x96\xfd\xef\x42\xbc\x60\x88\x82\x4d\x90\x08\x96\x33\xef\x7d\xf0\xf8\x3a\xc0\xc4\xcf\xa4\x99\xa3\x78\x58\xef\x1d\xc0\x1b\xcb\xe0\x61\x4f\xba\x72\xa0\x87\x10\x62\x11\x73\x00\x0b\x19\x0e\x68\xe8\x1d\x00\x80\xe0\x42\x1e\xf2\x41\x06\xca\x9c\xbb\x81\x46\x2c\xb3\x1d\xa3\x19\x5f\x68\xce\x1f\x35\x00\x4c\xe9\xb3\xb7\xfd\x4e\x67\xcf\xf1\xee\xa7\xdc\x0e\x89\x3c\xb0\x8c\x8a\xd9\xb4\x04\x2b\x4a\xdf\xd4\x42\x5c\x52\x14\x12\x3b\x1b\x75\x01\xd5\xca\x23\xf4\x8f\x0b\x4e\xf4\x54\xe6\x79\x4f\x41\xc9\x32\xb4\xe6\x00\xf8\xe2\xeb\x5d\xad\x24\x43\x6b\xb5\x2a\xca\x44\x5f\xb9\x23\xd6\x6d\x1e\x76\xb5\xf6\xad\xed\x4e\x90\xbb\x92\xd5\x4c\xba\x92\xde\x96\xd4\x6d\xd3\xdf\x93\xc9\x62\x34\x29\x1a\x47\xf9\x37\x7a\x15\xa3\x49\xe3\x42\xf6\x4a\x25\xdf\x96\xd0\xb3\x5d\xbf\x28\xf5\x3d\x00\x00\xff\xff\x17\xf2\xc1\xb1\x4a\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xd0\xc1\x4a\x04\x31\x0c\x06\xe0\x7b\x9f\x22\xec\x7d\x46\xbc\xf6\xe6\xd1\x9b\xb0\xe0\x3d\x76\x32\x63\x70\x26\x2d\x69\x3a\xb0\x94\xbe\xbb\xa8\xb3\xac\xac\x82\x97\x45\x56\xf0\xd8\xf4\xff\x43\xf8\x3a\xc0\xc4\x8f\xa4\x99\xa3\x78\x58\x6f\x1d\xc0\x0b\xcb\xe0\x61\x4f\xba\x72\xa0\xbb\x10\x62\x11\x73\x00\x0b\x19\x0e\x68\xe8\x1d\x00\x80\xe0\x42\x1e\xf2\x41\x06\xca\x9c\xbb\x81\x46\x2c\xf3\x5b\x0c\x60\xc6\x27\x9a\xf3\x47\x0c\x00\x53\x3a\xe5\xb6\xd9\xf1\xd9\x73\xbc\xf9\xe9\xdf\x0e\x89\x3c\xb0\x8c\x8a\xd9\xb4\x04\x2b\x4a\xdf\xc4\x42\x5c\x52\x14\x12\x3b\x2d\xeb\xce\x4a\xb5\xf2\x08\xfd\xfd\x82\x13\x3d\x94\x79\xde\x53\x50\xb2\x0c\xad\x39\x00\x3e\x9b\x7a\x57\x2b\xc9\xd0\x5a\xad\x8a\x32\xd1\xd7\xde\x7b\xad\xdb\x1c\x76\xb5\xf6\xad\xed\x8e\x25\x77\x21\xd5\x4c\xba\x92\x5e\x17\xea\x76\xd3\xdf\xc3\x64\x31\x9a\x14\x8d\xa3\xfc\x8b\x5e\x44\x34\x69\x5c\xc8\x9e\xa9\xe4\xeb\x02\xfd\x74\xd7\x2f\xa2\xbe\x0e\x00\x17\xf2\xc1\xb1\x4a\x05\x00\x00"),
 		},
 		"/infrastructure/03-syndesis-server-config.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "03-syndesis-server-config.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 4793,
+			uncompressedSize: 5000,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\xdf\x6f\x1b\xb7\x0f\x7f\xf7\x5f\x21\x04\x05\xfa\xfd\x62\xbd\x73\x9c\x76\x40\x71\xc0\x1e\xb2\xb8\xeb\xbc\x26\x4b\x16\x37\x43\x5f\xe9\x13\x7d\x66\xad\x93\x54\x49\xe7\xc6\xc9\xfc\xbf\x0f\xba\x9f\xb2\x63\xc7\x4e\xba\xbc\xf9\xc4\x0f\x3f\x24\x45\x4a\xa4\x1c\x31\xd0\xf4\x37\x1a\x4b\x4a\x26\x6c\x31\xe8\x31\x36\x27\xc9\x13\x76\xa6\xe4\x94\xb2\x0b\xd0\x3d\xc6\x72\x74\xc0\xc1\x41\xd2\x63\x8c\x31\x90\x52\x39\x70\xa4\xa4\xad\x16\x18\x23\x15\xdb\xa5\xe4\x68\xc9\xf6\x0b\x9d\x19\xe0\x18\xe5\x8a\x63\xc2\xe6\x88\xba\x04\x09\x98\xa0\x68\x15\x40\xeb\x84\x35\x2a\xf5\x5a\xf3\x19\x93\xea\xef\x93\xbb\xa5\xc6\x84\x91\x9c\x1a\xb0\xce\x14\xa9\x2b\x0c\x6e\x81\xa5\x2a\xd7\x4a\xa2\x74\x1d\x59\x64\xd1\x2c\xd0\x94\x60\x09\x39\x3e\x90\x44\x69\x19\x79\x8f\xb1\x20\x64\xad\x05\xa5\x65\xcc\xf1\x32\x17\x09\xfb\x27\xaa\xad\x71\xd4\x42\x2d\x73\x6f\xa2\x5e\x61\x4c\x28\xe0\x11\xc7\x5c\x45\x25\x03\x7b\x7d\x7f\x1f\x8f\x1b\xaf\x86\x98\xab\x21\x38\x58\xad\x5e\xd7\x0a\xa9\x32\x36\xe9\xdd\xdf\x47\x8c\xa6\xec\x7f\x52\x39\x16\x9f\x0a\xa1\xbe\x9f\xab\x14\xc4\xef\xca\xba\xff\xaf\x56\x2d\x37\x78\x09\xf2\x4b\x43\x19\x49\x9b\xb0\x99\x73\xda\x26\xfd\x7e\x68\xe2\x5a\x15\x0e\xbd\xa2\x8f\x6f\xb5\x2a\xa9\x51\x58\xdc\x43\x93\xf4\xfb\xc2\x9b\x9c\x29\xeb\x92\x77\x27\xc7\xc7\x6f\x5a\xf6\x5d\xeb\x7b\xad\x4a\xde\x1a\x4d\x21\x9d\x61\xb7\x49\xa9\x28\xac\x43\xd3\x2d\x34\xe9\x68\x08\xcf\x2a\x40\x2b\xcf\xe1\x36\x04\xa3\x74\x86\xd0\x26\x6c\x70\x7c\xdc\x6b\x96\x52\xb3\xd4\x41\x22\xe6\xb8\xdc\xd8\xfd\xb3\xa6\x20\x6c\x3c\x2e\xb3\xdd\x8a\x3e\x54\xca\x9f\x70\xd9\x65\xc6\x6a\x43\x32\xeb\xf8\xee\x48\xcf\x49\xae\x7b\x01\x13\x81\x3c\x61\x53\x10\x16\x5b\x81\xcf\xbb\x55\x85\x49\x31\x04\x17\x46\x24\xec\xf5\x57\x3e\x49\x93\x1d\x3e\xf9\xc2\x98\x80\xc5\xf8\xe6\xfa\xbc\x73\xa3\xd4\xb5\x68\xaa\xfd\xd9\x15\x4f\xa7\x6b\xd1\xac\x2b\x6b\xb0\xf6\xbb\x32\xfc\x00\xe5\xab\x1a\xba\x4e\xc0\x0d\x95\x27\x43\x80\xb5\x51\xe5\x86\x32\x59\xac\x95\x75\x99\x41\xfb\x4d\xc4\xc3\x12\x11\xa8\xcc\x68\x0e\x86\xc2\xf0\x19\xab\x6b\xfc\xb1\x74\x9c\x29\x29\x31\xf5\x07\xed\x4a\x29\x11\x7c\x7e\xa6\x1c\x55\xe1\x58\x50\xc4\xd5\xe1\x69\x00\x91\xab\x10\x7b\x33\xbe\xcf\xc4\x7a\xe8\x95\xd7\x61\x21\x3f\x37\x96\x11\x17\xb8\x23\x0a\xe2\x02\x9f\xeb\x7f\x40\xfb\x52\x9e\x9f\x23\xcc\x87\xe8\xea\x5d\x9a\x19\xb4\x33\x25\xf8\x66\x10\x02\x61\x1e\xf1\x06\x16\xb9\x06\xf7\xd4\x80\xb6\x5b\x7b\xa9\xd8\x2e\xe0\x96\xf2\x22\xf7\xbf\xc7\x74\x87\x9b\x41\xe5\x95\x38\xd2\x4a\x89\xc8\xd2\xdd\x23\x07\xf0\x20\xfe\x17\x0c\xe3\x9c\xa6\xe8\x6b\x68\x4b\x08\x91\xa8\x65\xcf\xf0\xbe\xa1\x7d\x31\xcf\x49\xfa\x0d\xf2\x65\xfc\xc0\xf3\x4a\x14\xf9\xe3\xf1\x64\xcf\x3b\xda\x7d\x9e\x5b\x4c\x0b\x43\x6e\xd9\x5d\x56\x13\xb0\x94\xee\xbd\xe7\x73\x90\x90\xe1\x7a\xef\xd7\xca\xb8\x84\xbd\x1f\xbc\x1f\xb4\x4b\x0f\xe9\x03\x3e\x67\x0a\x6c\xdb\x17\xd7\x8a\xa4\xb3\x1d\x70\x86\x20\xdc\x2c\x54\xb4\x28\x2d\x39\x5a\xe0\x66\xcb\xf9\x6a\x95\xe4\x93\x7d\x36\x72\x25\xc9\xa9\xf5\xae\x56\xcd\x7b\x1c\xa7\x50\x08\x57\xaf\x4e\x11\xfc\x48\x15\xb8\xb2\x4d\x73\xbb\x0d\xc6\x74\x31\x11\x94\x46\xa0\x69\x3f\x76\x2e\xa1\x0c\xa7\xf7\x48\x0d\x9d\x72\xae\xa4\x8d\x3f\x55\xd0\xf8\x43\x45\xb4\x5e\x2d\xdb\xd9\xeb\x64\xaf\x0f\x3f\x3b\xdb\xf6\xb6\xa2\x86\x82\x93\xdb\x15\xf5\xe3\x15\xf9\x5b\xbd\x89\xf1\x69\xcd\x51\x4f\x45\xdb\x62\xfb\x03\x30\x43\xd3\x84\x16\xd8\xe7\x13\xa1\xb2\x6c\x97\x03\x1b\x35\x50\x92\x44\x90\x3a\x5a\x90\x5b\x46\xce\x40\x7a\x40\xc2\x2a\xb5\x06\xb5\xc7\xc3\xbf\x0a\x34\xcb\x1b\x43\x81\x8b\xdf\xfc\x52\x0c\x9a\xe2\x72\xc2\x09\x37\x65\x8f\xee\x96\xe4\x6c\x90\xd5\x83\xa9\x54\x50\xb8\x59\xd4\x8e\xea\x75\xa4\x25\x38\x79\xf7\xee\x6d\x1f\x34\xf5\x76\xa5\xd0\xcf\xf5\x94\x62\xbc\x75\xdc\x0f\xe6\xd4\x03\xee\xae\x36\xa5\x17\xb0\x40\x19\x5f\xa3\x56\xb6\x3c\x16\x68\x5b\x83\xb9\x17\x75\x7b\x6e\x02\x4c\xb5\xea\xed\x18\x90\x19\xb2\x57\xc4\xdf\xb0\x57\x85\x11\x2c\xf9\xe5\x87\xed\x56\xd4\x9e\x93\xad\x56\x49\xf9\xd3\x33\xd7\xf2\x3a\x4c\xb6\x65\x32\x57\x1a\xa5\x9d\xd1\x34\xb8\xc6\x40\xd3\xaf\x60\xf1\xa6\x9c\x58\x0f\xac\xf2\x4b\x8d\x72\xec\x69\x2e\xc0\x0f\xee\xab\x55\x5f\x81\xa6\xfe\x62\xd0\xdd\xbe\x3e\x03\x56\x43\x5a\xdf\xe6\xad\xc6\x95\x51\x5f\x31\x5d\x9b\x5f\x28\x87\x0c\xc7\xce\x20\xe4\x7f\x1e\xae\x35\x29\x48\x70\x34\xa3\x4e\xf9\x33\x64\x61\xce\x4f\x28\xb9\xbf\x67\x0e\xb2\xcb\x5d\x89\x3e\x19\xc5\xa5\x7a\x78\xbd\x74\xaf\xbc\x0b\xcc\x95\x59\x5e\xe3\xb7\x02\xad\xbb\xa0\x84\x9d\xb4\xcf\x8f\x87\xb0\x73\xca\xa9\x04\xfd\x3c\x38\x09\xde\x31\x0b\x94\x97\xba\x7a\x3f\xb3\xa3\xe8\xcb\x97\xe4\xa7\x1b\x8b\x1f\x07\x1f\xcf\x58\xf3\x31\x76\xfe\xa2\x1d\x22\x2f\xda\x77\x27\x8b\xbe\xe4\xb7\x6f\x07\xc7\xf9\xd1\x33\xea\xf4\x94\xfb\x0b\x48\x49\x10\xa7\x26\x2b\xbc\x83\x61\xd9\x40\x2b\x2d\xd1\x2d\xc4\x7b\x17\x59\x67\x28\x75\x51\x3a\xc3\x74\x6e\x8b\xdc\xfa\xba\xfa\x31\xcb\x6c\xb5\x3a\xda\xfa\x26\x7d\x8a\x1b\x47\x0f\xaa\x98\x31\x92\x0e\x33\x53\xee\xd6\x39\x2d\x50\xa2\xb5\x57\x46\x4d\x70\x24\xc9\x11\x88\x21\x0a\x58\x8e\x31\x55\x92\xfb\x67\xe3\x49\x93\x37\x0e\x6a\xb3\x19\x56\xcd\xb4\x7d\x9f\x4b\x67\x94\x10\x68\xc2\x76\x08\xb7\xa3\xce\x9c\xbd\x42\xe3\x9f\x5d\x87\x1f\x96\x51\xe8\x6b\x4e\x6b\x45\x9c\xc3\xed\xb0\xad\xa4\xff\x96\x3a\xd8\xa2\xb1\x03\x87\x67\x7e\x3f\xbd\x82\x59\xc0\x13\x8e\xfa\xe8\x31\x1a\x6f\xef\x89\x6d\x2e\x43\x89\x06\x9c\x0a\xfe\x0e\x68\x9a\xd8\xe7\xba\x87\x55\x0d\xab\x4b\xfa\xbf\x01\x00\x00\xff\xff\x0a\xa0\x6a\x30\xb9\x12\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\x5d\x53\x1b\xb9\x12\x7d\xf7\xaf\xe8\xa2\x52\xc5\xbd\x75\x33\x63\x9b\xe4\x56\xa5\xa6\x6a\x1f\x08\x64\xb3\x2c\xb0\xb0\x18\xb6\xf2\xda\x1e\xb5\xc7\x8a\x35\x92\x22\x69\x1c\x1c\xaf\xff\xfb\x96\xe6\x53\xf8\x03\x03\x59\x0a\x5e\x46\xea\x3e\xdd\xa7\xfb\x8c\xd4\xe3\x08\x50\xf3\xbf\xc8\x58\xae\x64\x02\xf3\x61\x0f\x60\xc6\x25\x4b\xe0\x44\xc9\x09\xcf\x2e\x51\xf7\x00\x72\x72\xc8\xd0\x61\xd2\x03\x00\x40\x29\x95\x43\xc7\x95\xb4\xd5\x02\x00\x57\xb1\x5d\x48\x46\x96\xdb\x7e\xa1\x33\x83\x8c\xa2\x5c\x31\x4a\x60\x46\xe4\x11\x00\x04\x8e\x49\xb4\x0e\xa8\x75\x02\x8d\x4b\xbd\xd6\x3c\xc6\x5c\xf5\xf7\xed\xbb\x85\xa6\x04\xb8\x9c\x18\xb4\xce\x14\xa9\x2b\x0c\x6d\x31\x4b\x55\xae\x95\x24\xe9\x3a\xb0\xc8\x92\x99\x93\x29\x8d\x25\xe6\xb4\xb1\x13\xa5\x25\xf3\x1e\x40\x40\x59\x6b\xc1\xd3\x92\x73\xbc\xc8\x45\x02\x7f\x47\x75\x34\x46\x5a\xa8\x45\xee\x43\xd4\x2b\x00\x42\x21\x8b\x18\xe5\x2a\x2a\x11\xe0\x70\xb9\x8c\x47\x75\x90\xf8\x94\x72\x75\x8a\x0e\x57\xab\xc3\xda\x21\x55\xc6\x26\xbd\xe5\x32\x02\x3e\x81\xff\x48\xe5\x20\x3e\x16\x42\x7d\xbf\x50\x29\x8a\xdf\x94\x75\xff\x5d\xad\x5a\x6c\xf4\x3b\xc4\xae\x0c\xcf\xb8\xb4\x09\x4c\x9d\xd3\x36\xe9\xf7\xc3\x10\x37\xaa\x70\xe4\x1d\x3d\xbf\xd5\xaa\x84\x26\x61\x69\x0f\x4c\xd2\xef\x0b\x1f\x72\xaa\xac\x4b\xde\x1f\x0d\x06\x6f\x5b\xf4\x5d\xeb\x7b\xa3\x4a\xd6\x06\x4d\x31\x9d\x52\x57\xa4\x54\x14\xd6\x91\xe9\x16\x9a\x76\x34\x80\x27\x95\x41\xbb\x9f\xe3\x7d\x68\x4c\xd2\x19\x4e\x36\x81\xe1\x60\x50\x2f\x93\x4c\xcd\x42\x07\x8d\x98\xd1\x62\xad\xfa\x27\x8d\x20\x6c\x3c\x2a\xbb\xdd\x6e\x7d\xaa\x9c\xcf\x69\xd1\x75\xc6\x6a\xc3\x65\xd6\xe1\xfd\xe0\x7a\xc6\x65\xf7\xec\xb3\xc0\xb1\x20\x96\xc0\x04\x85\x6d\x14\x58\x29\xc7\xaa\xc2\xa4\x01\x61\x80\xc2\x88\x04\x0e\xbf\xb2\x71\x9a\xec\xc8\xc9\x0b\x63\x8c\x96\xe2\xbb\x9b\x8b\x2e\x0d\xff\x57\x58\x32\x55\x7d\x0e\xf7\xfa\x5a\x32\x0f\x9d\x35\x5a\xfb\x5d\x19\xf6\x04\xe7\xeb\xda\xf4\x21\x00\x33\xbc\x7c\x33\x04\x5a\x1b\x55\x69\x28\x93\xc5\x5a\x59\x97\x19\xb2\xdf\x44\x7c\x5a\x5a\x04\x2e\x53\x3e\x43\xc3\x43\xfa\x00\xb5\xc6\x1f\x6b\xc7\x89\x92\x92\x52\xff\xa2\x5d\x2b\x25\x82\xc7\x5b\x9e\x93\x2a\x1c\x04\x22\xf6\xff\x69\x6b\x10\xb9\xca\x62\x6f\xc7\xf7\x85\x78\x48\xbd\xca\x3a\x14\xf2\x4b\xb9\x9c\x31\x41\x3b\x58\x70\x26\xe8\xa5\xf9\x07\xb0\xaf\x95\xf9\x05\xe1\xec\x94\x5c\x5d\xa5\xa9\x21\x3b\x55\x82\xad\x93\x10\x84\xb3\x88\x35\x66\x91\x6b\xec\x9e\x4b\x68\x7b\xb4\xd7\xe2\x76\x89\xf7\x3c\x2f\x72\x2f\x85\x11\xff\x41\xeb\xa4\xf2\x6a\x3b\xd2\x4a\x89\xc8\xf2\x1f\xf4\x5c\x36\x6b\xf8\xaf\x48\xe3\x82\x4f\xc8\x6b\x68\x0b\x85\x48\xd4\x7b\x2f\xc8\xbe\x81\x7d\xb5\xcc\xb9\xf4\x15\xf6\x32\xde\xc8\xbc\xda\x8a\xfc\xeb\xf1\xec\xcc\x3b\xd8\x7d\x99\x5b\x4a\x0b\xc3\xdd\xa2\x3b\xac\xc6\x68\x79\xba\xf7\x9c\xcf\x51\x62\x46\x0f\xef\x7e\xad\x8c\x4b\xe0\xc3\xf0\xc3\xb0\x5d\xda\x84\x0f\xf0\x9c\x29\x1a\x38\x92\x4c\x2b\x2e\x5d\x3b\x24\x01\x4c\x09\x85\x9b\x86\x8e\x96\xa4\xe5\x8e\xcf\x69\xfd\xca\xf9\x6a\x95\x64\xe3\x7d\x31\x72\x25\xb9\x53\x0f\x6f\xb5\x6a\xde\x63\x34\xc1\x42\xb8\x7a\x75\x42\xe8\x47\xaa\x20\x95\x6d\x9e\xdb\x63\x00\xe8\x62\x2c\x78\x1a\xa1\xe6\xfb\x6d\x67\x12\x4b\x3a\xbd\x47\x34\x74\xcc\x98\x92\x36\x3e\xaf\x4c\xe3\x4f\x15\xd0\x43\xb5\x6c\x47\x07\xd8\x32\xfc\xec\x68\xe7\x36\x69\x00\x60\xc1\xb8\xdb\xc5\xfa\x71\x45\xfe\x5a\x17\x31\x3e\xae\x31\xea\xa9\x68\x1b\xb7\xdf\x91\x32\x32\x0d\xb5\x20\x3e\x1b\x0b\x95\x65\xbb\x12\x58\xd3\x40\x09\x12\x61\xea\xf8\x9c\xbb\x45\xe4\x0c\xa6\xbb\x3c\x83\x32\x55\x6e\x49\xef\xf1\xea\xd7\x19\xfe\x59\x90\x59\xdc\x19\x1e\xa4\xf8\xcd\x2f\xc5\xa8\x79\x5c\x4e\x38\xcb\xe5\x93\x7d\xb7\x34\x67\x0d\xac\x1e\x4c\xa5\xc2\xc2\x4d\xa3\x76\x54\xaf\x52\x8e\x4a\xe3\xe4\xfd\xfb\x77\x7d\xd4\xbc\xb7\xab\x85\x7e\xe2\xe7\x29\xc5\x5b\xc7\xfd\x60\x4e\xdd\x60\xfe\x48\x4b\x2f\x71\x4e\x32\xbe\x21\xad\x6c\xf9\x42\x91\x6d\x03\xe6\x7e\xab\xab\xb9\x09\x6c\xaa\x55\x1f\xc7\xa0\xcc\x08\xde\x70\xf6\x16\xde\x14\x46\x40\xf2\xcb\x4f\xc7\xf5\xff\xcb\xa5\xc7\x84\xd5\xca\x6b\xb3\x42\xae\xf7\x6b\x9a\x50\xf3\x0c\x2b\xa4\x34\x49\x3b\xe5\x93\xe0\x18\x43\xcd\x3f\xa2\xa5\x3b\x23\xf6\x9e\xbb\x6d\x6a\x57\x9a\xe4\xc8\xc3\x5c\xa2\x1f\xdc\x57\xab\xbe\x42\xcd\xfb\xf3\x61\x77\xfa\xfa\x0e\x58\x8d\x69\x7d\x9a\xb7\x1e\xd7\x46\x7d\xa5\xf4\xc1\xe4\xc5\x73\xcc\x68\xe4\x0c\x61\xfe\xc7\xd3\xbd\xc6\x05\x17\x8c\xcc\x59\xe7\x7c\x8b\x59\xd8\xf3\x23\x9e\x2c\x97\xe0\x30\xbb\xda\xd5\xe8\xa3\xb3\xb8\x74\x0f\x8f\x97\xee\x2b\xef\x92\x72\x65\x16\x37\xf4\xad\x20\xeb\x2e\x79\x02\x47\x83\xc1\x4e\xb3\x0b\x9e\xf3\xd2\xe8\xff\xc3\xa3\xd6\xa8\x94\xc7\x95\xf6\x03\x82\x4d\xe0\x20\xfa\xf2\x25\xf9\xdf\x9d\xa5\xcf\xc3\xcf\x27\xd0\x3c\x8c\x9c\x3f\x68\x4f\x89\x15\xed\x77\x27\x44\x5f\xf2\xfb\x77\xc3\x41\x7e\xb0\x79\x86\x3c\xd2\x94\x4a\x2f\xc7\xcc\x1f\x40\x4a\xa2\x38\x36\x59\xe1\x79\x84\xb2\xc1\x76\xb7\xb4\x6e\x4d\x7c\x76\x91\x75\x86\xa7\x2e\x4a\xa7\x94\xce\x6c\x91\x5b\xaf\xab\x9f\x8b\x0c\xab\xd5\xc1\xd6\x6f\xd2\xe7\xa4\x71\xb0\xa1\x62\x00\x2e\x1d\x65\xa6\xac\xd6\x05\x9f\x93\x24\x6b\xaf\x8d\x1a\xd3\x99\xe4\x8e\xa3\x38\x25\x81\x8b\x11\xa5\x4a\x32\xff\xd9\x78\x34\xd8\x2c\xe4\xad\xf1\xdf\x9c\xec\xe4\xf8\x63\x21\x99\xa0\xb8\xfd\x11\xc4\x4b\x30\x88\xe4\x6a\x3b\xac\xec\x5a\xb3\x52\xa0\x4f\xc5\x83\x50\xb8\x6b\x88\xe7\xb4\xd8\x87\x75\x4e\x8b\x12\x61\xbd\x0e\x0c\x55\xf7\x1e\x57\x77\x7b\x35\x1b\xd4\x8b\xa9\x92\xce\x28\x21\xc8\x84\xb7\x3b\xde\x9f\x75\xd5\xb3\xd7\x64\xee\x2c\x99\xa7\xbf\xfb\x81\x73\x29\xfa\x90\x5a\x8e\xf7\xa7\xed\x8b\xf1\xef\x42\x07\x1d\x1f\x39\x74\x74\xe2\xe5\xe1\x1d\xcc\x1c\xc5\x8b\x42\x6c\xc2\x34\x35\x7e\xe4\x4e\x5c\xbf\xb5\x33\x92\x64\xd0\xa9\xe0\xd7\x8d\xe6\x4e\xbe\xad\xaf\xe4\x6a\x4c\x59\x2e\x23\x20\xc9\x56\xab\xde\x3f\x03\x00\xae\x85\xc9\x70\x88\x13\x00\x00"),
 		},
 		"/infrastructure/03-syndesis-ui.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "03-syndesis-ui.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 4636,
+			uncompressedSize: 6084,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x57\x5b\x6f\xdb\xb8\x12\x7e\xf7\xaf\x18\xa8\x38\xc8\x39\xc0\xb1\x1d\x27\x48\x70\xa0\xb7\x36\xe9\xd9\x4d\xb1\x6e\x84\xba\xe9\xee\xdb\x62\x22\x8d\x65\x76\x29\x92\x4b\x8e\xe4\x78\xbd\xfe\xef\x0b\x4a\xb2\x4c\xf9\xd2\x0b\x5a\x14\x6d\x9e\x22\xf2\x1b\x72\xbe\xb9\x7c\x43\x0f\x01\x8d\x78\x47\xd6\x09\xad\x62\xa8\x26\x03\x80\x3f\x84\xca\x62\x98\x91\xad\x44\x4a\x03\x80\x82\x18\x33\x64\x8c\x07\x00\x00\x0a\x0b\x8a\xc1\xad\x54\x46\x4e\xb8\x61\x29\xea\x55\x89\x8f\x24\x5d\x83\x00\x40\x63\x76\x90\x76\x6d\xfb\x39\x12\x7a\xfc\xb1\x7d\x5e\x19\x8a\x41\xa8\xb9\x45\xc7\xb6\x4c\xb9\xb4\x74\x04\x96\xea\xc2\x68\x45\x8a\xf7\xfd\x71\x86\xd2\xc6\x17\xa3\x2d\xb7\x6e\x0d\xeb\x8f\x18\xfe\x77\xde\x1e\x65\xac\x66\x9d\x6a\x19\xc3\xdb\x9b\xa4\x5d\x63\xb4\x39\x71\xd2\x02\x5b\xa8\x23\x49\x29\x6b\xfb\xb5\xe8\x9d\xf0\xbb\x9f\x0a\x34\xc6\x8d\xb4\x21\xe5\x16\x62\xce\xde\x2c\x48\xce\x2d\x19\xa9\x57\x05\x29\xbe\xd1\x6a\x2e\xf2\x83\x2c\x7d\x5f\xf9\x38\x5e\x35\xbb\x2c\x59\x32\x52\xa4\xe8\x62\x98\x7c\xcb\x80\xd7\x30\xb6\xc8\x94\xaf\xb6\x57\x59\x2d\xa5\x50\x79\x82\x16\x8b\x2e\x7e\x00\x42\x31\xd9\x0a\xe5\x8c\x52\xad\xb2\xce\x51\xff\x57\xe0\xd3\xac\xb4\x39\xc5\x70\x71\xf5\xaf\x70\xf5\x41\x61\x85\x42\xe2\xa3\xdc\xdb\x63\x51\x90\x2e\xb9\x3b\xeb\xfa\xfc\xbc\xdb\x2b\x4d\x86\x4c\x09\x59\xa1\xb3\x83\xcb\x2c\x39\x5d\xda\x94\x02\xc7\xa4\x28\x04\x07\xdf\xbe\x10\x0a\x6d\x57\x31\x44\x17\x57\xd7\x53\x11\x75\x3b\x96\xfe\x2c\xc9\x9d\xc2\x9e\xef\xa0\x4d\xb6\xdf\x34\x81\xa8\xd7\x98\x0a\x23\x91\x69\x6b\xda\xaf\xb5\xc3\x7a\x3b\x95\xb3\x4f\xc9\xdb\x67\xd4\xde\x67\xa4\x39\xac\xb6\xa6\xc2\x6a\x75\x7b\x9e\xa6\xba\x54\xfc\xba\x5f\x9d\x19\xcd\xb1\x94\xdc\x81\x53\xad\x18\x85\x22\x1b\x10\x1c\x1e\x29\xe9\xf5\x5a\xcc\x61\x74\x4b\xd5\xac\x34\x5e\x6b\x36\x9b\xc0\x51\x51\xa0\xaf\x91\x33\x38\x1b\xac\xd7\x24\x1d\x1d\xdd\x5d\xaf\x61\x34\xdb\x52\xba\xd9\xf2\x71\xa3\x87\xbb\xd1\x9d\x87\xc0\x66\x53\xdb\xab\xac\x67\x4e\xaa\x8a\x07\xcf\xe0\x57\x02\x45\x94\x01\x7a\x97\xe7\x22\x87\x0a\x65\x49\xc0\x1a\xd2\x05\xaa\xbc\xfe\x8f\xad\xc8\x73\xb2\x80\xa0\x68\x09\x59\x27\x24\xb0\x5c\x88\x74\x01\x6e\x29\x38\x5d\x08\x95\x03\x2f\x08\x76\x5c\x60\x2e\x31\x1f\x0d\x9e\xc1\xab\xd2\x71\x73\xdc\x16\x54\xfb\x5e\x87\x03\x84\x03\xa5\xd9\xdf\xee\x44\x46\x36\x74\xa5\xf1\x60\x14\x38\xbd\x0d\xe1\xed\xcb\x77\xbf\xcf\x1e\x92\xe4\xfe\xcd\xdb\x60\x17\x1a\xe7\xeb\x98\xf4\x62\x7a\xb6\x1f\xb6\xa4\x94\x32\xd1\x52\xa4\xab\x18\x9e\xcb\x25\xae\xc2\x52\x92\xa2\x22\x45\xce\x25\x56\x3f\x52\xdc\x3b\x7f\xc1\x6c\x7e\x22\xee\x2f\x02\x18\xe4\x45\x0c\xd1\x38\xda\x5f\xef\x0f\x85\xce\x05\x25\x58\xa0\xbc\x25\x89\xab\xae\x61\x2f\x43\x8c\x25\xcc\xc4\xb7\xf7\x61\x32\xe8\xdb\xf5\xba\x73\xb8\x2b\xea\xe4\xd8\x99\x95\x96\x65\x41\x53\xdf\x1c\x7b\x76\x85\x5f\x4b\x6a\xff\xc6\xda\xb0\xef\xe1\xa1\xd5\x9a\xc7\xce\xa6\xe3\x74\x3b\x8d\x76\x7f\x4d\x8e\x9b\x8d\x61\x73\x6c\xb0\xff\x0c\x66\xc4\xbe\x2c\x1f\x4b\xeb\xd8\x4b\x25\x2c\x05\x2f\x00\x41\xea\x65\x2b\x4f\x30\xd7\x9a\x8d\x15\xaa\x06\x3a\x46\xcb\xf0\xef\xab\x73\x98\x8a\xff\xf4\xa2\x7c\xa0\x8d\x70\x54\x1f\x21\xd0\xbd\x8b\xab\xab\xa9\xe8\xed\x1d\x53\xc9\xd0\xe2\xea\x3c\x30\x68\xe8\xf4\x54\xa1\x21\x3a\x45\x13\x1f\x89\x42\x20\x16\xc3\x83\x50\x9d\x0a\x54\xdb\xb0\xdd\x2b\xa6\x11\xc4\x66\xee\xdf\xd4\x4d\x75\x4a\x78\x86\x4d\x7f\x34\xa0\xfd\x89\x86\x25\xeb\x02\x59\xa4\x31\xb0\x2d\xe9\x50\xec\xbc\x28\xf6\xa8\x1d\x6a\x2a\xc0\xdc\xea\x22\x64\xda\x3c\x4f\x6a\xa9\x9a\xb1\x25\x2c\xde\xe2\x21\xc7\xb3\xe0\xa4\xd8\xcf\x15\xc7\x67\x7b\x20\x67\x30\x6d\x7b\xff\xde\x90\x9a\xf9\x37\x50\x62\xf5\x7b\x4a\x03\x05\x68\x22\x71\xb7\xe3\x38\xd8\x7b\x43\xd5\xf4\x4f\x3e\xa2\x02\x2f\x7f\xf0\x57\x2e\x63\xde\x95\x47\xe3\x79\xd4\x84\x75\xab\x1f\xfd\x34\x7d\x30\x49\x1f\x48\xd1\x76\xee\x0c\x4e\xfe\x68\xb8\xd9\x56\xff\xc7\x03\x1a\x36\xc0\xf7\x15\xd7\x9d\xd3\x8d\x8b\xa3\xf7\xce\xd3\xfc\xbb\x3d\x63\xdd\x85\x2a\x42\x23\x5e\xa0\xa3\x28\x86\xc8\x0b\xb9\x8b\xc7\xe3\xf5\x7a\x37\xbf\xdf\xe8\x92\xe9\x67\xed\xd8\x93\xdf\x6c\xa2\xff\xf6\x2c\x5f\xaa\xcc\x68\xa1\xd8\x5b\x8f\xd1\x88\x71\x35\x09\x11\x7e\x86\x6a\x49\x0f\x56\x7a\x40\xd8\x07\x37\xdd\x4e\xff\x4c\xd3\xf4\xc7\x3e\xbc\x6b\x9b\x10\xeb\x39\x16\x68\x0c\xd9\x28\x0e\x18\x01\x44\x8f\xe8\x68\x8a\xc6\x08\x95\xb7\x3f\x01\x5b\x17\x3e\x81\x61\x4b\x63\x8c\x2c\xd1\x8d\x83\xfb\xda\x73\x5f\x61\x85\x77\xca\xd7\x2d\x0b\xad\xbe\xf0\xf8\xf7\x58\xe1\x91\x3b\x7e\x9b\xfe\xf2\xd5\xae\x78\x2a\xe4\x31\x16\xb3\xfb\xd7\x5f\x8f\x85\xd3\x6a\xef\x8e\x4c\x38\x3f\x08\xdb\x24\x24\x96\x2a\x41\xcb\xa9\xce\x7c\x9d\xcd\x51\xba\x9d\x56\x6f\x82\x8c\xce\x09\x7d\xa1\xbb\x08\xf6\x12\x2a\x75\xee\x9f\x6a\xc7\x8c\xd7\xeb\x21\xf8\xc9\x91\x58\x9d\x95\x29\x8b\xbf\x28\x7c\x56\x46\x8f\x16\x55\xd6\x98\xf6\x4e\x44\x63\xfc\x70\xf0\x7c\xff\x5f\x3a\x82\x7b\x25\x85\xa2\x3e\x89\x39\x56\x22\xd5\xea\xf2\xa2\x2e\xef\xf6\x6b\x78\x79\xf1\x74\x79\x31\x32\x2a\x3f\x0a\x9e\x5c\xf7\xc0\x93\xeb\xa7\xc9\xf5\x21\x98\x75\x99\x2e\xee\x52\xad\xda\xc6\x31\x92\x86\xf5\xda\xd0\x5b\x1d\xe2\x4d\x43\xee\x45\x29\x64\x16\xf5\x87\xdd\xc6\x07\xa0\xd6\xb4\x36\x12\xfe\xf1\xfa\x05\xd1\xd8\xa6\xfc\x07\x09\x45\xaf\x1e\x76\xb1\x68\x57\x36\x83\x7f\x02\x00\x00\xff\xff\x88\x83\x06\xe7\x1c\x12\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x58\xe1\x6e\xdc\xb8\x11\xfe\xbf\x4f\x31\x50\x50\xf8\x0e\xc8\x6a\x6d\x07\x36\x0a\x01\x87\x36\xe7\xa4\x3d\x5f\x6f\x2f\x8b\x6c\x7c\x2d\x70\x77\x28\x66\xa5\x59\x89\x09\x45\xb2\x24\xb5\x9b\xed\x56\xef\x5e\x50\xa2\x56\xd4\xae\xec\xd8\xbd\xa0\xc8\xd9\x02\x6c\x91\xdf\x0c\x67\xbe\x19\xce\x90\x9a\x02\x2a\xf6\x13\x69\xc3\xa4\x48\x60\x73\x31\x01\xf8\xc0\x44\x96\xc0\x92\xf4\x86\xa5\x34\x01\x28\xc9\x62\x86\x16\x93\x09\x00\x80\xc0\x92\x12\x30\x3b\x91\x91\x61\x66\x5a\xb1\x66\x94\xe3\x8a\xb8\x69\x11\x00\xa8\x54\x0f\xf1\x63\xdd\x6b\xcc\xe4\xec\x53\xf3\x76\xa7\x28\x01\x26\xd6\x1a\x8d\xd5\x55\x6a\x2b\x4d\x23\xb0\x54\x96\x4a\x0a\x12\xb6\x57\xd6\xda\x63\x14\xa5\xad\x2d\x4a\x6a\xeb\xcd\x9a\x36\x2f\x09\xfc\xf1\xdc\xab\x52\x5a\x5a\x99\x4a\x9e\xc0\xbb\x9b\x85\x1f\xb3\xa8\x73\xb2\x0b\x0f\xf4\x50\x43\x9c\x52\x2b\xf5\xe7\x72\xef\x1e\xbb\xf7\xfb\x29\xb0\x35\xc4\xaf\x48\x71\xb9\x2b\x49\x58\x53\xd7\x93\x61\x80\x50\x29\x33\x0b\xa2\xd4\x63\x1b\x71\xe2\x86\x46\x65\x62\xa9\x48\x98\x82\xad\xad\x33\x60\x54\xc1\x8d\x14\x6b\x96\xb7\x6a\x44\x56\xd7\x83\xc8\x7b\xdb\x50\x64\x03\xfb\xdc\xcb\x66\x59\x29\xc7\x6c\x23\x01\x80\x42\x48\x8b\x96\x49\x71\x48\x07\x56\x62\x4e\x43\x0b\xac\x66\x79\x4e\xda\x24\x70\xf6\xf3\x3e\x5a\x6b\x59\x46\xc9\x3e\x72\x99\x17\x25\xd1\xad\xc3\x2f\xad\x26\x2c\xdf\x61\x1e\x3d\x8f\x5c\xce\x45\x49\x14\x90\x95\x70\xb4\x64\x6c\x54\x3f\x8f\xd6\x8c\x78\xb6\x40\x5b\x38\x84\xa2\x34\xb6\x54\x2a\x37\x1d\xbb\x34\x88\x53\x29\x2c\x32\x41\xda\xfc\xfc\xa7\xaf\xfe\x1c\x3b\x55\xdf\x7c\xf3\x4b\xa8\xeb\x97\xe8\xeb\x5f\xe3\xc6\xc6\xa8\xfe\xf5\x6c\x40\xc0\x97\x96\xd8\xe3\xdb\xcf\xf9\x79\x08\x51\xbc\xf4\x73\xf1\x77\x2c\x2f\x5e\x6e\x90\x71\x5c\x31\xce\xec\x2e\x7e\x2d\x70\xc5\xa9\xf3\x4c\x93\xe2\x2c\x45\x93\xc0\x7e\xff\x90\xd4\x5b\x8f\x83\xba\x0e\x73\x6c\xa8\xe2\xe2\x88\xb6\x7e\xcb\x74\x66\x05\x59\xe3\x31\x00\x25\xda\xb4\xf8\x61\xc0\xf0\x38\xc7\x9f\x66\xf9\x51\x04\x1e\x99\xff\xdb\xe3\xf9\xd0\x3a\x0d\x17\xf7\x7b\x6f\xac\x46\x4b\xf9\xae\x73\xbc\x4d\x8d\xb7\x92\x73\x26\xf2\x3b\x95\xa1\xed\x32\x43\x87\x63\x3d\x4f\x25\x7e\x5c\x56\x3a\xa7\x04\x2e\xaf\xfe\x10\x8e\xde\x09\x6c\xa3\xce\xfd\xdc\x91\xdb\xc7\x2b\x7b\xfd\x0b\xd4\x58\x06\x71\x60\xc2\x92\xde\x20\x5f\x52\x2a\x45\xd6\xc4\xf8\xe9\x4b\x77\x73\x96\x95\x24\x2b\x7b\xd0\x75\x7d\xde\x55\x61\x80\xaa\x71\x76\x41\x9a\xc9\xec\x64\x31\x4d\x46\x56\x3a\xa5\xc0\x30\xce\x4a\xd6\x15\x75\xbf\x32\x95\x52\xef\x12\x88\x2e\xaf\xae\xe7\x2c\x3a\xcc\x68\xfa\x57\x45\xe6\x3e\xec\x79\x0f\x1d\x90\x7f\x94\xca\x5d\x39\xe9\x94\x0c\xdb\xe1\x69\x8d\xb8\x3f\x87\x3f\x9d\x5b\x4f\xa8\x17\x8f\x4c\x45\x0f\x3c\x34\x44\xf7\x98\xb6\xb5\xbf\x4c\x53\x59\x09\xfb\xe3\xb0\xa2\x64\xb4\xc6\x8a\xdb\x27\x97\x13\xf7\x58\xa9\x24\x97\xf9\x6e\xa9\x34\x61\x76\x23\x85\x4b\x35\x26\xc2\x00\x4c\x5d\x86\x2e\x3f\xd0\x36\xcc\xa7\x5e\xf2\x6f\xb4\x4b\xe0\x43\xb5\x22\x2d\xc8\x52\x53\x0c\x0b\x69\xac\xab\x7a\x01\x7a\x5b\x90\xb8\x13\x06\x2d\x33\x6b\xd6\x26\xdb\x32\x2d\x28\xab\x38\xbd\x14\xbb\x2d\xee\x02\x6c\x13\x9c\xe5\x51\x07\x7f\xa0\xfc\x78\x8a\x1e\x11\xa8\x27\x45\xe0\x71\x9e\x3f\x5c\x89\xdf\xf5\x50\xa8\xeb\xdf\x2b\x1f\xc3\xdd\xe5\x7e\xfb\x16\xdd\x2f\x3b\x1d\xe9\x74\xfb\x3d\x5b\x8f\x9c\x38\x82\x43\x46\x02\x67\xe0\x3a\xf8\xa0\xc8\x87\xb3\x03\x82\x6f\x3a\x03\x4d\x7c\x77\x1b\x37\xa7\x0e\xa8\xeb\x46\x7e\x60\x1f\x00\x89\x4d\x32\x79\x06\x7f\x27\x10\x44\x19\x20\xa4\xcd\x61\x09\x36\xc8\x2b\x02\x2b\x21\x2d\x50\xe4\xcd\x7f\xfe\x64\x03\x08\x82\xb6\x90\x1d\xea\x3e\x6c\x0b\x96\x16\x60\xb6\xcc\xa6\x05\x13\x39\xd8\x82\xa0\xf7\x05\xd6\x1c\xf3\x78\xf2\x0c\xbe\xaf\x8c\x6d\xd5\x75\xa0\xc6\xf6\x86\x0e\x60\x06\x84\xb4\x6e\x75\xc3\x32\xd2\xa1\x29\x8d\x08\xc5\x81\xd1\x1d\x85\xaf\x5e\xff\xf4\xcf\xe5\xdd\x62\xf1\xe6\xed\xbb\x60\x16\x5a\xe3\x1b\x4e\x06\x9c\x9e\x05\xa0\x66\xe9\x45\xc5\xf9\x42\x72\x96\xee\x12\x78\xc9\xb7\xb8\x0b\x83\xce\xd9\x86\x04\x19\xb3\xd0\x72\x15\x34\x27\xf7\x14\xd6\xaa\xbf\x92\x1d\x0e\x02\x28\xb4\x45\x02\xd1\x2c\x3a\x1e\x1f\x1e\xba\xbb\x1f\x26\x98\x65\xc8\x5f\x11\xc7\xdd\xa1\x3b\xbc\x08\x31\xae\xd6\xb0\xff\xbf\x0d\xe1\x26\x0e\xae\x19\x1d\xf7\x87\xa4\x3e\xba\x4c\x78\xee\x25\xaf\x4a\x9a\xbb\xfa\x7b\x24\x57\xba\x31\x77\x9c\x4d\x60\x26\x95\x75\xd5\x67\xaa\xa5\xb4\x33\xa3\xd3\x59\x9b\x76\x01\xbe\x3b\x10\xb6\x13\xd3\x56\x6d\x30\xff\x0c\x96\x64\x5d\x5a\xae\x2a\x6d\xac\x2b\x95\xb0\x65\xb6\x00\x04\x2e\xb7\xbe\x17\xc2\x5a\x4a\xab\x34\x13\x0d\xd0\x58\xd4\x16\xbe\xba\x3a\x87\x39\xfb\x3a\xd0\x34\xd2\x88\xc7\x9b\x71\xd8\x64\x2f\xaf\xae\xe6\x7d\x05\xbc\xaf\x25\x87\x12\x57\xe7\x81\x40\xeb\x4e\x80\x9d\x7a\x47\xe7\xa8\x86\x0a\x4e\x8a\xc5\xf4\x84\xaa\x31\xa2\x7c\x93\x73\x7b\x6a\xe4\x88\x76\xb8\xa4\xf8\xfb\x63\xdb\x8d\xdb\x7b\xd2\x4d\xb3\xdd\x06\x27\xbd\x93\xe2\xf4\xb0\xf6\x69\xbb\xbb\x5a\x45\xc7\x87\x2f\xac\xac\x2c\xd1\xb2\x34\x01\xab\x2b\x3a\x2d\x95\xae\x6b\x07\xf8\xe9\xa0\x50\x76\xa3\xee\x5e\xd5\x63\xba\x2b\xdf\xf0\x7a\x15\x4c\xb7\x0c\x9d\x9d\x5e\xb3\xc2\x92\xe0\x40\x46\x61\xea\x2b\xc7\x1b\x45\x62\xe9\x6e\x75\x0b\x2d\xdf\x53\x1a\xd4\x8f\x96\xad\xdb\xde\xc7\x80\xac\xa3\x1b\xea\xc8\x05\x31\xb8\xa2\x06\xf6\xfe\xce\xbf\x46\x58\xcc\xbd\x55\x5d\x6d\x8e\xfc\x3d\x76\x32\x16\xb0\x07\xc3\xf5\x40\xb0\xf6\xfb\x51\x96\x03\x4a\x6f\xba\x5d\xf4\x69\x42\xc3\x8d\xf4\x65\xf1\xda\x1b\xdd\x9a\x18\xbf\x37\xee\x13\xc9\x7f\xbc\x8e\xbd\xff\x0b\x10\xa1\x62\xdf\xa2\xa1\x28\x81\xc8\x35\x25\x93\xcc\x66\xfb\x7d\x7f\x0e\x78\x2b\x2b\x4b\xdf\xf9\x63\x66\x5d\x47\xcf\x07\x92\xaf\x45\xa6\x24\x13\xd6\x49\xcf\x50\xb1\xd9\xe6\x22\x44\xb8\x5e\x2c\x39\xdd\x69\xee\x00\xe1\x8e\xb8\x39\xcc\x0c\x75\xaa\x76\xa7\x1c\xc3\x0f\x1b\x28\xc4\x3a\x1f\x4b\x54\x8a\x74\x94\x04\x1e\x01\x44\x2b\x34\x34\x47\xa5\x98\xc8\xfd\xa7\x3a\x6f\xc2\x23\x3c\xf4\x6e\xcc\xd0\x72\x34\xb3\xe8\xf9\xb1\xde\xef\x71\x83\xb7\xc2\x7d\x56\x70\xdf\x71\x7e\xa3\xfa\xf7\xb8\xc1\x91\x35\xfe\x31\xff\xe1\xb3\x2d\xf1\xb1\xe4\x63\x5e\x2c\xdf\xfc\xf8\xf9\xbc\x30\x52\x1c\xad\x91\x31\xe3\x1a\xaa\x0f\xc2\x42\xd3\x86\xd1\x76\x2e\x33\x97\x67\x6b\xe4\xa6\x4b\x66\x80\xba\x97\x8b\xd6\x84\x2e\xd1\x4d\x04\x47\x01\xe5\x32\xcf\x99\xc8\xc7\x84\x7d\x23\x89\x17\x5a\x66\x55\x6a\xd9\xbf\x07\xb7\xae\x68\xa5\x51\x64\xad\xe8\x40\x23\x2a\xe5\xda\x84\xf3\xf7\x2f\x95\x21\x78\x23\x38\x13\x34\x74\x62\x8d\x1b\x96\x4a\xf1\xe2\xd2\xa1\x66\xfe\x6d\xfa\xe2\xf2\xe3\x8b\xcb\x58\x89\x7c\x14\x7c\x71\x3d\x00\x5f\x5c\x7f\xbc\xb8\x3e\x05\x5b\x59\xa5\xc5\x6d\x2a\x85\xdf\x38\x8a\xd3\xb4\x19\x9b\x3a\xa9\x53\xbc\x6a\x9d\xfb\xb6\x62\x3c\x8b\x86\x6d\xaf\x3e\x74\x8e\xb0\xa5\xfe\xef\x6c\x74\x21\x1f\xf5\xee\xcb\xa3\x62\x90\x0f\x3d\x17\x13\x00\x00\x80\x7a\xf2\xdf\x01\x00\xfd\x3d\x1c\x82\xc4\x17\x00\x00"),
 		},
 		"/infrastructure/04-amq-example.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "04-amq-example.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 1775,
+			uncompressedSize: 2053,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\xc1\x6e\xdb\x30\x0c\xbd\xe7\x2b\x78\xeb\x65\x76\x16\x14\x48\x0b\xdf\x82\x74\x18\x76\xe8\xea\xc6\xd9\x7a\x1c\x18\x99\x4d\xb4\xca\xa2\x2a\xb1\x69\xb3\x20\xff\x3e\xd8\x8e\x03\x3b\x71\xb6\x62\xc0\x74\x24\xf9\x9e\x9e\x1e\x45\x69\xbb\x8d\x40\x3f\x42\x9c\x7a\xce\x5f\x94\xe8\x5f\x94\xef\x76\x83\x08\xd0\xe9\xef\xe4\x83\x66\x9b\xc0\x7a\x34\x00\x78\xd2\x36\x4f\x20\x23\xbf\xd6\x8a\x06\x00\x05\x09\xe6\x28\x98\x0c\x00\x00\xd0\x5a\x16\x14\xcd\x36\xd4\x01\x80\x9c\x82\xf2\xda\x49\x45\x31\x5f\x11\x2c\x3c\x3f\x91\xbf\x08\x70\xe7\xc8\x3e\x68\x4f\xe0\xd8\x4b\x5c\x95\x1b\x5c\x90\x39\x40\xd1\x39\xa3\x15\xd6\xd0\x1a\x56\x65\x2c\x16\xd4\x04\x22\x2c\x9e\x23\x51\x6e\x00\x10\x1c\xa9\x1a\x5a\x12\xee\x59\xa2\x7d\x35\x3b\xb2\xaf\xda\xd3\x9e\xba\xac\x48\x60\x3c\x1a\x8f\xc6\xfb\x88\xa0\x5f\x92\xa4\x47\xf1\x06\x1e\x84\x0b\x77\x82\xbd\x3c\x83\xad\xe3\x81\x0c\x29\x61\xdf\x1c\x27\x6c\x6c\x4e\x41\x87\x58\xf3\x90\xde\xb0\x70\xa6\x73\x8a\x23\xb7\xd1\xb9\x10\x97\xaa\xc3\x4a\x3f\x4a\x89\x69\xf9\x7f\x43\xce\xf0\xa6\x20\x2b\x53\xb6\x8f\x7a\x79\xd2\x88\x13\x23\x93\xc3\xf6\x3d\x6a\xfe\x96\xef\x53\xdb\xd7\x88\x4e\x13\x3c\x55\xdd\x0b\x09\x8c\x7a\xed\xf8\x5f\xa2\x82\x78\x14\x5a\x6e\x9a\x7d\x64\xe3\x28\x81\x19\x29\x4f\x28\x75\xff\x85\x0a\x67\x50\xa8\x29\xe9\x7a\x77\xea\xdf\x39\xb9\xef\x91\xfc\x5e\xd9\x67\xfc\xac\xe0\x07\x4f\xcb\xa5\xd8\x0a\x6a\x4b\xbe\x25\x2f\x02\xb2\xeb\xb6\xda\xe6\xde\x4e\x6e\xef\x7f\x7c\xcb\x3e\xcd\x5a\x29\x80\x35\x9a\x17\x4a\xa0\xbd\x73\x17\x91\x4e\xb2\xec\xe1\x6e\x76\xd3\x87\x12\x76\x59\xe9\xa5\x9c\xc1\xce\x67\x93\xaf\x59\x7a\x37\x9b\x67\x7d\xe8\x66\x0c\x3f\xb4\x07\xaa\x5c\xba\xc0\x25\x25\x70\xb1\xdd\x42\x9c\x35\x76\x4d\xb9\x70\x6c\xc9\x4a\x88\x27\xb7\xf7\xf1\x97\xb2\x06\x76\xbb\x8b\x63\x5c\xfa\x62\x4c\xca\x46\xab\x4d\x02\x13\xf3\x8a\x9b\xb6\xf9\x67\x4c\x85\xee\x3b\xd1\x1c\xe4\xe0\x6e\x3d\xcd\xd7\x57\x57\xd7\x9d\x73\xd4\x6c\x3f\xd9\xf0\x93\xc6\x4e\xc6\x79\x16\x56\x6c\x12\x98\x4f\xd3\x3f\x71\xb6\x5f\x9d\x36\x69\xfd\x8a\xfd\x23\xe1\x65\x0f\xe1\xb1\xc7\xe7\x29\x3d\x61\xae\x2d\x85\x90\x7a\x5e\x50\xd2\xc1\xd0\x5b\xfb\xf6\xd5\x4b\x71\x51\xa0\xcd\x8f\xc3\x11\x0c\x17\xda\x0e\x17\x18\x56\x27\x99\x48\x9d\x16\xb3\x93\x21\x16\xcf\x15\xa8\x2b\x21\x6e\x31\x08\xf9\x42\xdb\xea\x1b\xf8\xec\x51\x51\x4a\x5e\x73\x9e\x91\x62\x9b\x87\x04\xc6\x1f\xeb\x99\xf6\x7a\xb9\x3c\x0c\x45\xb4\x1f\xfb\xfa\x79\x9c\xae\xd0\x2e\x69\x50\x7e\x72\x64\xcb\x8f\xed\x77\x00\x00\x00\xff\xff\x7f\x08\xdb\x22\xef\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\x4d\x6f\xe2\x48\x10\xbd\xf3\x2b\xea\x96\xcb\xda\x2c\x8a\x44\x22\xdf\x10\x59\xad\x56\xda\x4c\x1c\xcc\x4c\x8e\xa3\xa2\x5d\x81\x9e\xf4\x57\xba\x2b\x24\x0c\xe2\xbf\x8f\x8c\x31\xb4\xc1\x0c\xa3\x91\x46\xe6\x42\x75\xbd\xe7\xae\xf7\xba\x9f\xd7\xeb\x04\xe4\x33\xa4\xb9\xb7\xe5\x9b\x60\xf9\x9d\xca\xcd\xa6\x97\x00\x3a\xf9\x85\x7c\x90\xd6\x64\xb0\x1c\xf4\x00\x5e\xa4\x29\x33\x28\xc8\x2f\xa5\xa0\x1e\x80\x26\xc6\x12\x19\xb3\x1e\x00\x00\x1a\x63\x19\x59\x5a\x13\xea\x02\x40\x49\x41\x78\xe9\xaa\x5a\x06\xd3\x05\xc1\xcc\xdb\x17\xf2\x57\x01\x1e\x1c\x99\x27\xe9\x09\x9c\xf5\x9c\x6e\xdb\x15\xce\x48\xed\xa1\xe8\x9c\x92\x02\x6b\x68\x0d\xdb\xae\x18\xd4\xd4\x14\x12\xd4\xaf\x09\x0b\xd7\x03\x08\x8e\x44\x0d\xad\x08\x77\x2c\xc9\xae\xdb\x3a\x32\xef\xd2\xd3\x8e\xba\xea\xc8\x60\x38\x18\x0e\x86\xbb\x0a\xa3\x9f\x13\xe7\x47\xf5\x06\x1e\xd8\x6a\x77\x82\xbd\x3e\x83\xad\xeb\x81\x14\x09\xb6\xbe\x19\x27\xac\x4c\x49\x41\x86\x54\xda\x3e\x7d\xa0\x76\xaa\x35\x45\xaf\xf1\xe0\x8e\x9c\xb2\x2b\x4d\x86\xc3\x89\x07\xe8\x5c\xe8\x47\x46\x1c\x7a\xb7\x70\x52\x81\x3a\x31\x69\x35\x7f\x58\xc8\x67\xae\xde\xde\x49\x30\xb6\xe6\x59\xce\x6b\x1a\x53\xb9\x7f\x6c\xee\x89\x39\xd9\x7e\xa4\x8e\x09\x2f\xad\x77\x29\xd0\x65\x6e\xcb\x58\x4f\xdb\x13\x11\x32\x18\x1c\x49\xdc\x2d\x5e\xd5\x03\xa0\x91\xc5\xe2\xff\xd6\xee\xbb\xf7\x7f\x79\x82\xcb\x33\x44\x36\xfc\x39\xa1\x62\x93\x00\x02\x7b\x64\x9a\xaf\x9a\xe1\x78\xe5\x28\x83\x09\x09\x4f\xc8\xf5\x89\x67\xd2\x4e\x21\x53\xd3\xd2\x76\xf6\xd4\xdd\xf3\x0a\x5d\xde\xfc\xaf\x0c\xd0\xf4\x75\xb8\x0d\x10\x3b\x5e\x3d\xc2\x1a\x46\x69\xc8\x47\xdb\xab\xe6\x5f\x1e\xfe\x1e\x6e\xea\xe8\xfe\xf1\xeb\xe7\xe2\x9f\x49\xb4\x04\xb0\x44\xf5\x46\x19\xc4\x6f\x6e\x23\xf2\x51\x51\x3c\x3d\x4c\xee\xba\x50\x6c\x5d\x51\x69\xc9\x67\xb0\xd3\xc9\xe8\x53\x91\x3f\x4c\xa6\x45\x17\xba\x09\x9e\xbf\xe2\x08\xa9\x1e\xa9\x71\x4e\x19\x5c\xad\xd7\x90\x16\x8d\x5c\x63\xab\x9d\x35\xd5\xd5\x4f\x47\xf7\x8f\xe9\x7f\x55\x0f\x6c\x36\x57\xc7\xb8\xfc\x4d\xa9\xdc\x2a\x29\x56\x19\x8c\xd4\x3b\xae\x62\xf1\xcf\x88\xda\x64\x57\xcb\xe4\xe4\xa0\x6e\x9d\x7d\xb7\x37\x37\xb7\xd1\x7a\xc3\xf6\xcd\x2a\xfb\x22\xb1\xb5\xe2\xbc\x65\x2b\xac\xca\x60\x3a\xce\x7f\xc6\x19\xe7\x6c\x4c\x5a\xe7\xf6\x6f\x12\x5e\x77\x10\x1e\x6b\x7c\x9e\xd2\x13\x96\xd2\x50\x08\xb9\xb7\xb3\xfd\xb5\xa8\x7f\xf4\x11\x9f\xbe\xe6\x0c\x6a\x8d\xa6\x3c\x2e\x27\xd0\x9f\x49\xd3\x9f\x61\x58\x9c\xac\x24\xe2\xb4\xd9\x3a\xee\xa3\x7e\xdd\x82\xda\x5b\x48\x23\x06\x26\xaf\xa5\xd9\x7e\xf8\xfe\xf5\x28\x28\x27\x2f\x6d\x59\x90\xb0\xa6\x0c\x19\x0c\xff\x6e\xe2\xce\x58\xee\x8a\x3c\xf6\x72\x3e\xdf\x5f\x97\x64\x17\x08\x75\xc0\x8f\x17\x68\xe6\x14\x25\xc8\x7a\x9d\x00\x99\x72\xb3\xe9\xfd\x18\x00\x1f\x35\x7f\x6b\x05\x08\x00\x00"),
 		},
 		"/infrastructure/04-syndesis-meta.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "04-syndesis-meta.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 6153,
+			uncompressedSize: 10098,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x58\x5f\x6f\xe3\x36\x12\x7f\xcf\xa7\x18\xa4\x05\xf6\x65\x2d\x25\x7b\x6d\x93\x0a\xd8\x07\x9f\xed\xee\x66\x11\x27\x82\xed\xcd\xe1\x9e\x02\x86\x1a\xdb\x4c\x28\x92\x47\x52\xde\x0a\x3e\x7f\xf7\x03\x45\x4b\x96\x64\xd9\x1b\x07\xc5\xa1\xad\x9f\x6c\x72\xf8\x9b\x7f\x1c\xce\x6f\xdc\x03\xa2\xd8\x03\x6a\xc3\xa4\x88\x60\x75\x79\x06\xf0\xc2\x44\x12\xc1\x14\xf5\x8a\x51\x3c\x03\x48\xd1\x92\x84\x58\x12\x9d\x01\x00\x70\xf2\x84\xdc\xf8\xef\x00\x44\xa9\x08\x4c\x2e\x12\x34\xcc\x6c\xd7\xca\x9f\x01\x93\xe1\xf7\xf6\x6d\xae\x30\x02\x26\xe6\x9a\x18\xab\x33\x6a\x33\x8d\x1d\x62\x54\xa6\x4a\x0a\x14\x76\x07\xd6\x73\x66\x15\xa2\x82\xa4\xb8\xbf\x6e\x14\x52\x6f\xa5\x92\xda\x6e\x0d\xee\x15\x3f\x22\xb8\xbe\xd8\x2a\x51\x5a\x5a\x49\x25\x8f\x60\x36\x88\xb7\x6b\x96\xe8\x05\xda\x78\x2b\x58\x89\x7a\x35\x4b\x6b\x55\xb1\x60\x90\x23\xb5\x52\xff\x51\x91\x38\xe8\xe2\xc1\x0c\xc5\x6e\xcd\x58\x14\xf6\x41\xf2\x2c\xc5\x01\x27\x2c\xdd\xcb\x57\x77\x74\xfe\x7c\x79\xdc\xe5\x8b\x50\x8a\xc6\x8c\x65\x82\x55\xd6\xd6\xeb\x60\x5a\xc2\x0c\x4a\x0c\x13\x8c\xd1\x92\x60\x82\x46\x66\x9a\xa2\x09\x7c\x18\xfa\xd5\xf1\xcd\xa6\x38\xae\x4b\x81\xd2\x59\x8d\xff\xc9\xd0\xd8\xea\x37\x80\xb1\x52\x93\x05\x46\x27\x29\x1a\x10\x45\x28\xb3\xf9\x66\x73\xb6\x5e\xf7\x80\xcd\xe1\xf5\x67\xa7\x5e\xe1\x80\x13\x63\xb6\x66\x9a\xda\xd2\x5d\x91\xb4\x53\x8c\x69\x01\x3a\x83\x50\x24\x6f\x31\xcd\xe9\xde\x9a\xb4\xaa\x16\x4e\x33\x66\x0b\xb1\x5e\xbf\xd1\x84\xdb\xe2\x6e\x96\x71\x69\x15\x5a\x4a\x2c\x5d\xde\x36\x6e\xaf\x53\xa0\x89\x58\x20\xfc\xf8\x82\xf9\x7b\xf8\x71\x45\x78\x86\x10\x7d\x7c\xab\x4e\x0f\x5a\xa0\xc1\x66\x13\x15\xdf\x3d\x66\x25\xb0\x8d\x30\x34\x82\xdd\xac\x55\xa2\x94\x09\xa4\x42\x61\x96\x6c\x6e\xdd\xfd\xaf\x55\xef\x10\x15\x97\x79\x8a\xc2\x0e\xa4\x98\xb3\xc5\xdf\xe0\xa1\xd5\xa8\x38\xa3\xc4\x44\x70\xf9\xff\x7d\x22\x7d\xf9\x68\x62\x71\x91\xef\x8a\xbc\x55\xf5\x00\x9c\xa5\xac\x5e\xf5\x2e\xe2\xa9\xd4\x79\x04\xe7\x1f\x7e\xfe\x65\xcc\xce\xab\x9d\xfd\x17\xa2\x2e\x7b\xb1\x13\xf5\x61\x9d\x20\xd5\x48\xac\x0f\xa8\xc5\x54\x71\x62\xb1\xba\xaf\x8d\xac\xee\x67\xf6\x50\x64\x5e\x13\x9d\x13\xb2\x7c\x52\x30\xeb\x59\xf5\x99\x2c\xc8\x40\x9f\x52\x99\x09\x7b\xd7\xbc\x07\x6e\x13\x75\x25\x4b\xa5\xb0\x84\x09\xd4\x35\x0f\x7b\x07\xdb\x90\xff\xa0\x58\xd5\xc3\x51\x8a\x7f\xe9\x3f\xf4\x1f\xfb\x71\xfc\x38\xbc\x99\xd4\xb6\x01\x8a\x5a\x8c\x20\x4c\xaa\x22\x32\x1d\xc7\x6f\xef\xfb\xc3\xd1\xe4\xf1\xf3\xfd\x78\xf4\xbd\xd3\x21\xfe\x6e\x0f\x19\x70\x1f\xcf\x6e\xee\xef\xa6\x5d\x10\xe7\xbd\xe1\x33\x59\x91\x40\xa0\x0d\x94\xc6\x39\xea\x9b\x78\xf5\xd3\xd4\x12\xfa\xf2\xd1\xea\x0c\xa1\x37\xcc\x0c\xea\x60\x29\x53\xfc\x18\xda\x54\x1d\x7d\x46\xbf\x90\x15\xb9\x57\x96\x49\x61\x36\x9b\xf3\x0e\x73\xee\xfa\xe3\xd1\x34\xee\x0f\x3a\xdc\xf9\x4d\xcb\x34\x6a\x2c\x03\xcc\x19\xf2\x64\x82\xf3\xf6\xfa\x76\x27\x26\x76\x19\x55\xd7\x33\x70\x2a\x8c\x22\x14\xcf\xd6\xeb\xc6\x6b\xdd\x4f\x12\x29\x4c\xf0\x85\xe0\x02\x75\x30\x12\xe4\x89\x63\x52\x7b\x28\xeb\xe1\x1a\x7d\x1a\x4d\x1e\x47\x77\xc3\xf8\xfe\xe6\x6e\x56\x7b\x4a\x5b\x0d\xa0\x09\x39\x90\xdc\x3f\x14\x5f\x35\x6b\xe0\xee\xe2\x5c\x0f\xdb\xb1\xc3\xe7\x0d\x9d\xc8\x0d\x1e\x00\x74\x3c\x2e\x0a\xc3\xea\x42\x3e\x17\x68\x3d\x5a\xa2\x45\x97\x3f\x7d\xf8\xe5\x3a\x24\x8a\x85\x56\x13\x8a\xa6\x85\x2c\x8e\x46\x60\xda\x1f\xc7\xb7\xa3\xc9\xe3\xec\xdf\x71\xe7\xd5\x3b\xe2\xcf\x94\xa4\x8a\xa3\x9e\xe5\x0a\xbb\x2f\x41\x4b\x45\xdc\x9f\xf4\xc7\x6f\xd3\x11\x13\x4d\x52\xa7\x64\xd7\xa8\x5d\x96\x86\xb8\x9a\x66\xca\x11\xe5\x03\x2e\x3e\xf4\x1f\x87\xa3\x7f\x7e\xfd\xd4\xa9\xd5\x5d\xfb\xba\xd9\x2c\x2d\x88\xd5\x3b\x78\xe7\xd4\xb4\x13\x52\xee\xae\xd7\x47\x5a\xf5\x8d\x13\x82\xcd\xe6\x5d\x65\x68\x0b\x20\xce\x38\x8f\x25\x67\x34\x8f\xa0\xcf\xbf\x91\xbc\xfe\x1a\x68\x24\x09\x13\x68\x4c\xac\xe5\x13\x36\x6b\xc1\xdd\x82\x4f\x68\xdb\x05\xa2\x8a\xca\x08\x97\x48\xb8\x5d\xb6\xf7\xfc\x5c\x70\x79\x7d\xd9\xda\x30\x74\x89\x2e\x3e\x9f\x67\xb3\xb8\xb1\xc5\x04\xb3\x8c\xf0\x21\x72\x92\x4f\x91\x4a\x91\xb8\x0e\x79\x51\x93\xe1\x6c\x85\x7f\x3a\x0b\xff\x71\x71\xd1\x10\x52\xa8\x99\x4c\xaa\xed\x0f\xcd\xdd\x39\x61\x3c\xd3\x38\x5b\x6a\x34\x4b\xc9\x93\x08\x7e\x3e\x6b\x9a\x64\x9a\x2f\x7c\xd5\x26\xf6\x06\x2d\xff\x69\x8d\x5b\x15\x52\xc7\xc0\xd6\x0d\xd8\xf6\xdf\x03\xa6\x68\x35\xa3\xe6\xd8\xc9\x5f\xaf\xae\x7e\xed\x38\xa9\xb4\x4c\xd1\x2e\x31\x33\x6f\x34\xe8\xea\xea\xba\x03\xf6\x59\x72\xf9\xc2\xc8\xab\x30\x3b\x38\x0d\x74\xf2\x1a\xa8\xf1\x95\xd7\x11\xf7\x5b\x87\x11\x8c\x8b\x33\xad\x27\x13\x80\xaa\xec\x34\x9c\x41\xfc\xb5\x05\xd2\xc5\xa8\x4e\xb7\x72\xe2\x51\xfe\x00\x3b\x4b\xa4\xb6\xa5\x3f\x80\x51\x9a\x89\x45\xef\x49\x4a\x0b\x24\xb3\x32\x25\x96\x51\xc2\x79\x0e\x8a\xd1\x17\x03\x99\x72\x74\xcd\x11\x5d\xd7\xa9\x83\x3c\xe5\x30\xd7\x32\x85\x20\xa4\x25\x91\x2f\x3f\xdf\xa4\x7e\x61\x62\x31\x64\xfa\x20\x57\xf1\x23\xd6\xd8\xd1\x2a\xd3\x45\x81\x3c\x66\xcf\x8b\x35\xdc\x4d\xdd\x19\xdf\xc3\x1b\x4c\x66\xcf\x8a\x12\x0a\x7f\xb7\xa7\xe0\xd4\x19\x91\x3f\xd6\x41\xe8\x3a\x31\x55\xd7\x1f\x13\xcd\xc4\x53\xb7\x74\x77\x84\x13\x7e\xcf\x7f\xbf\x3e\x26\x2a\xea\xa8\xa9\x06\x64\xaf\x16\x10\xab\xd9\x62\x51\x31\xd3\xde\x96\xbe\xfb\x01\x6c\xb0\x74\x13\xe4\xa1\x1e\x58\xca\x16\xbd\x68\x2b\xea\x55\xb2\xdd\x4a\xd1\x50\x6b\x51\xaa\xae\x4f\x04\xae\x2d\xee\x53\x64\x17\x81\x46\x54\xbb\x83\x31\x6f\x91\x3b\x3f\x41\x16\xa6\x4c\xad\x46\x92\xce\x48\x3d\xe1\x1d\x41\x88\xdc\x44\x62\x6c\x4b\xa8\xa0\x7b\x45\xc5\xdc\x2b\x14\x53\x37\xa5\xc6\x5a\x3e\x23\x75\x5e\xb7\x86\xd9\xc2\xcd\x83\xd3\x6c\xcd\x96\xbf\xc1\x3f\x50\x96\x2c\xaa\x2b\xe2\xad\x3f\xf7\xf1\x2b\x89\x4d\x33\x21\x47\xd3\xe1\xcf\xbf\xeb\xca\xc6\x8e\xcf\x1c\xfe\x93\x6f\x50\xde\xf3\xbf\xe6\xff\x03\xbb\xe2\xdb\x19\xde\x7a\x3d\x23\xf8\x6f\xaf\xd4\x54\x4c\x92\xbb\xc0\xaa\x36\x35\xf8\x01\xfe\x85\x20\x05\xcf\xe1\x1b\x11\x16\xec\x12\xc1\x58\x62\x33\xf3\x1e\x84\xf4\xbf\xe7\x19\xe7\x85\xb2\x00\x3e\xa3\xa0\x08\x06\x69\xa6\x99\xcd\x41\x8a\xf7\x60\x50\x18\x66\xd9\x0a\x41\xce\xe7\x41\x85\x3a\x45\x2c\xa8\x86\x89\xc2\x30\x91\xd4\x04\xbe\x09\x38\x8f\x6b\xed\xa0\xd8\x0a\x69\xa6\x35\x0a\x1b\x16\xe3\x9e\xd3\x10\x2e\x6d\xca\x43\xa5\x65\x92\x51\xe7\x54\xcf\x31\xce\xbc\x97\x4a\xc1\xac\x2c\x60\x9c\x40\xa5\xeb\x37\xa9\x21\x41\x4b\x18\x2f\xf3\x90\x12\x41\x16\xe8\x5e\xdd\x3d\xd7\xeb\x24\xa6\x74\x24\x6a\x0c\xce\xc5\x34\xd6\x78\x5f\x50\x24\x4a\xb2\x46\x3f\xf1\x3c\xb1\x7e\xb0\x0a\x44\x04\x73\xc2\x0d\x9e\xfd\x2f\x00\x00\xff\xff\x7a\x4b\x52\x4d\x09\x18\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x5a\x7b\x6f\x1b\x37\x12\xff\x5f\x9f\x62\xb0\xe9\x21\x29\x10\xad\x9a\x5c\xf3\xe8\x02\xc6\x9d\x2a\xbb\x79\x34\xb2\x05\xcb\x4e\x71\x68\x0b\x83\xe6\x8e\x24\xc6\x5c\x72\x4b\x72\xe5\x2c\x74\xfb\xdd\x0f\xdc\x27\x77\xb5\x92\x2d\x5f\xaf\x4d\x2f\xd2\x1f\x11\x39\xfc\x71\x9e\x9c\x19\xd2\x8f\x06\x8f\x60\x9c\x18\xa9\x29\xe1\x18\x82\x14\x3c\x85\xdb\x15\x0a\x60\x46\xc3\x5a\xf2\x24\x42\xa0\x44\xc0\x35\x42\x24\x13\x61\x30\x84\xeb\x14\x14\xc6\x9c\x51\xa2\x41\x0a\x90\x66\x85\x0a\x84\x0c\x51\x0f\x1e\x0d\x36\x9b\x21\x7c\x45\x1a\xc0\xe0\x08\x88\x08\xc1\x9f\xa7\x22\x44\xcd\xb4\x3f\x91\x51\x2c\x05\x0a\xa3\xfd\x29\x1a\xe2\x57\x9b\x33\xb1\xf4\x4f\x04\xb9\xb6\x8b\x9e\xe0\x6f\x7b\x56\x9c\xa3\x96\x89\xa2\xa8\xfd\x8f\x39\x7f\x63\x4a\x51\xeb\xa9\x0c\x11\xbc\x73\x24\xe1\x4f\x8a\x19\x9c\x12\x91\x7a\x5f\x67\xd9\x60\x08\x24\x66\x1f\x51\x69\x26\x45\x00\xeb\x67\x03\x80\x1b\x26\xc2\x00\xe6\xa8\xd6\x8c\xe2\x00\x20\x42\x43\x42\x62\x48\x30\x00\x00\xe0\xe4\x1a\xb9\x2e\xfe\x0f\x40\xe2\x38\x00\x5d\xb2\x52\x8e\x55\x3f\x7d\x26\x47\x77\xcd\x9b\x34\xc6\x00\x98\x58\x28\xa2\x8d\x4a\xa8\x49\x14\xf6\x90\xd1\x4a\xc6\x06\x6c\x68\xd9\xca\x49\x05\x89\x70\x7b\x5c\xc7\x48\x0b\x2e\x63\xa9\x4c\xc9\xf0\x30\xff\x11\xc0\xeb\x6f\xca\x4d\x62\x25\x8d\xa4\x92\x07\x70\x31\x99\x95\x63\x86\xa8\x25\x9a\x59\x49\x58\x93\x16\xdb\xac\x8c\x89\xf3\x01\x8d\x1c\xa9\x91\xea\xf7\xd2\xc4\x4e\x11\x77\x5a\x68\x66\xc7\xb4\x41\x61\x0a\x43\x4f\x38\x61\xd1\x96\xbd\xfa\xb5\xf3\xe5\xd9\xb1\xb1\x17\xa9\x1d\xb6\xb6\xda\x66\xf3\x10\x7f\xcf\xb2\x7c\xb9\xaa\x08\x2a\x61\x15\xfe\x96\xa0\xae\x7c\xc2\x7e\xb4\x91\x8a\x2c\x31\x38\x68\xa3\x09\x89\x09\x65\x26\xcd\xb2\x3c\xac\xd9\xe2\x80\xa0\x9c\x17\x1b\x4e\x38\xd1\xba\x64\xb3\xe4\x21\x1f\x3a\x25\xd1\x81\xcc\x74\x00\x2d\x43\x28\xc2\x87\xb0\x66\xf7\x2e\x59\x5a\xd7\x03\x87\x31\x53\x42\x6c\x36\x0f\x64\xe1\x43\x7e\xc6\x64\x59\x6f\xa0\x45\xc4\xd0\xd5\x87\x96\xf7\xda\x0d\x14\x11\x4b\x84\xaf\x6e\x30\x7d\x0a\x5f\xad\x09\x4f\x10\x82\xa3\x87\xee\x69\x3f\x9b\x4d\x8e\x06\x59\x66\x85\xaf\x30\x6b\x82\x52\xc3\xd0\xab\xec\x63\x8c\xb9\x4c\x23\xab\xa1\xad\x33\x96\xc4\xb1\x1e\x39\x61\xdc\xd0\xe6\xcb\x91\x6b\xec\x5d\xe3\xcb\x18\x85\x5e\xb1\x85\xb1\x01\xd7\x0b\x30\x91\x62\xc1\x96\x0e\x3f\xee\x61\x50\xf2\x26\x15\x3c\xc9\x53\x4e\xb3\x4c\x83\x7f\x8c\xeb\x79\x12\xdb\xc3\xf1\x6b\x37\x3f\x95\xd2\x12\x21\xa4\x21\x86\x49\xa1\x6b\x9c\x7d\x18\xb5\x92\x58\x44\x96\xd8\x66\xdc\x28\xb6\x5c\xa2\xd2\x01\x3c\xfe\x79\xe3\x2d\x94\x8c\xbc\x60\xe3\xd9\x9c\xe3\x05\xde\x3b\x4b\x3f\x37\x0a\x49\x74\x41\x96\xde\x53\xcf\x9e\x5e\x5e\xe0\xb5\x0e\x8b\x80\x13\x83\xda\x78\xd9\x53\x6f\xc1\x90\x87\x33\x62\x56\x96\x26\x46\xea\x1b\x8c\x62\x3b\xed\xdb\xe3\xc4\xa7\x52\x18\xc2\x04\x2a\xfd\xf3\x3f\x9e\xfc\xd3\xb7\x60\x47\x47\xbf\xb4\xd1\x7e\xf1\xbe\xfe\xd5\xcf\xf9\xf4\xb2\x5f\x1f\x6f\xdb\x72\x5b\x1b\xed\x23\xad\x99\x0e\xc0\x33\x2a\x41\xaf\x83\x51\x59\xe2\xaf\x96\x3a\x77\xca\x5f\x55\x37\x79\x58\xdc\xaf\x72\x99\x32\x71\x5e\xd5\x44\x95\x5a\xb8\xc6\xda\x8f\x6a\x90\xb7\x6c\xb9\x1a\xaf\x09\xe3\xe4\x9a\x71\x66\xd2\xff\x49\xcd\xb3\x47\x88\xad\xfd\xfb\xd8\xde\x82\x78\xd6\x31\x74\x73\x64\x95\x4a\x74\x03\x25\xcb\x76\x1f\x64\xfd\x5e\x71\xb7\x5f\xdc\xd3\xe4\x1d\x01\xfe\x7b\x1f\xdc\xbf\x93\x1b\x46\x5b\xc7\xc5\x93\x3f\xda\xf0\x95\xe5\xb5\x51\xc4\xe0\x32\xad\x74\x5e\xc4\xd1\xb9\xe4\x9c\x89\xe5\x65\x1c\x12\x53\x85\x91\x72\xc7\x1a\x13\x45\xe4\xf3\x3c\x51\xb6\x5a\x78\xfe\xe2\x6f\xee\xe8\xa5\x20\x85\x04\xbc\x9c\xab\xf4\xbd\xc3\x05\x76\x70\x82\x54\xa1\x65\xa2\x63\xad\x86\xda\x55\xe9\x1f\xa8\xc0\x2c\x6b\xeb\x65\x46\x14\x89\x1c\xd7\x65\xc2\xa0\x5a\x13\x3e\x47\x2a\x45\x98\x87\xc5\xe1\x2a\xab\xe6\x0c\x8b\x50\x26\xa6\xc6\x7a\xf9\x4d\x55\x87\x03\x24\xb9\x91\x66\xa8\x98\x0c\x9d\xcd\xda\x31\xd8\x53\xf7\x01\x70\x16\x31\xb7\xee\xb3\xf9\x31\x92\x2a\x0d\xc0\x7b\xfe\xe2\xe5\x94\x79\xf5\xcc\x76\x8d\xe8\xd2\x7e\x63\x49\xff\x6c\x33\xb4\x1c\xb7\xeb\x2d\xfd\xde\xd4\x93\x94\xaa\x8c\x59\x09\xda\xee\x1e\xb6\xd3\xd6\xee\x43\xea\xee\xa3\xe3\x80\x14\x76\xef\x93\xa6\x24\xad\xbb\x07\xfb\xd5\x45\xef\x3a\xa6\xd4\xf6\xe4\xa7\xed\x24\x67\x27\x51\xfd\xe9\xd6\x03\x30\x32\x96\x5c\x2e\xd3\x79\xac\x90\x84\x13\x29\x6c\x84\x33\xe1\xfa\xdc\xd0\x1e\x2b\xf3\x1b\xbc\x75\x83\xa9\x59\xf9\x23\xa6\x01\xdc\x24\xd7\xa8\x04\x1a\xcc\xd3\xfd\x4a\x6a\x63\xb3\xba\x43\x6d\xef\x2b\x2e\x85\x26\x86\xe9\x05\xb3\xce\x18\xc0\x9c\xae\x30\x4c\x38\x8e\x45\x7a\x4b\x52\x87\x36\xb7\xf5\xbc\x53\x71\xef\x49\x57\xa5\xbe\xef\x61\xf7\x03\x0d\x7a\x3f\xd9\xf7\xe7\xee\x8b\x86\xb4\xa9\xdb\xff\x82\x1a\xd9\xd3\xcc\x5d\xa8\x44\x1b\x0c\x27\xe3\xef\x13\x11\x72\xf4\x8b\x0e\x60\x4a\x62\xa7\x8b\xb3\x5f\x26\x98\x99\xd4\xd5\x70\xc3\xed\xb0\x2c\x01\x8d\xc5\xb1\x1d\xa8\xeb\x38\x79\x55\x1c\xc0\xe3\xcd\x66\x8f\xef\xe7\x25\x3b\x64\xd9\x63\x67\x21\x95\x51\x44\x44\xe8\x2a\x65\x08\xa3\x6b\x26\x46\x7a\xd5\x1e\x43\x43\x47\x95\xb0\xa3\x9c\x09\x0c\x87\x94\x8c\x96\x28\xd0\xa6\xbb\x61\xc3\x98\xdf\x5a\x5b\xb4\xa6\x53\x1b\xdf\x2d\xe5\xb7\x04\xca\xb1\x86\xd7\xb9\x6a\x1c\x1a\x28\xee\xea\x6c\xdf\x10\xec\x64\x61\x17\x66\x57\x49\x77\x81\x15\xf4\xed\x33\xd7\x7e\x9a\xde\x24\x18\x74\x37\xea\x0f\x08\x00\x14\xeb\x3e\x59\xdf\x8f\x3f\x8e\xaf\xc6\xb3\xd9\xd5\xf1\xbb\x73\x67\x1a\x20\x6f\x59\x03\x18\x85\x4d\xe9\xd1\xb3\xfc\xc3\xd9\xf8\xf8\xe4\xfc\xea\xed\xd9\xf4\xe4\xae\xd5\x23\xfc\x6c\x7a\x10\x72\x06\xce\x66\x17\xef\xce\x4e\xe7\x7d\x10\xde\xf0\xf8\x13\x59\x13\x5f\xa0\xf1\x63\x85\x0b\x54\xef\x66\xeb\x6f\xe7\x86\xd0\x9b\x23\xdb\x32\xc1\xf0\x38\xd1\xa8\xfc\x95\x8c\xf0\x68\x64\xa2\x78\xef\x6d\xc3\x7b\xb2\x26\x67\x71\xde\x8d\x66\xd9\xa1\x11\x01\x05\x2b\x9f\x73\x5e\xb4\xe6\x7e\xee\x28\xf6\xf6\x04\x8f\x76\x59\x6f\x44\x09\x45\x65\xf4\x9e\xb5\x33\xa2\xf5\xad\x54\xe1\x11\x5d\xd9\x7b\x08\x66\x6a\x83\x7b\x3d\xfa\x3a\x1d\x4f\x4f\xe6\xb3\xf1\xa4\x47\xdf\x3f\x28\x19\xb9\x36\xb6\x9f\xbc\xcb\x3d\xc7\x45\x77\xbc\x9c\x29\xfc\xb8\xca\xdb\x79\x97\xab\x63\x42\xad\xd3\xb5\x34\x33\x0e\x43\x29\xb4\xff\x9e\xe0\x12\x55\x95\xd9\xb2\xac\x87\xbf\xf7\xe3\x93\x37\x27\xe7\x57\x27\xa7\xc7\xb3\xb3\x77\xa7\x17\x35\xc5\x96\xb2\xdb\x90\x13\xc9\x8b\xc4\x71\xa9\x58\x96\x6d\xcb\x16\x80\xb7\xd9\xdc\x6f\x71\xa3\xb5\xad\xba\xa6\x0d\x68\xef\x63\x83\x51\x6d\xb5\xe1\xa7\x1c\x6d\x48\x2b\xb4\xe0\xd9\xb7\xcf\x5f\xbe\x1e\x91\x98\x8d\x8c\x22\x14\x75\x07\x59\xec\xd5\xc0\x7c\x3c\x9d\x7d\x38\x39\xbf\xba\xf8\xd7\xec\xe4\x40\x79\xe6\x24\x8a\x39\xaa\x8b\x34\xc6\x7e\x27\xe8\x6c\x31\x1b\x9f\x8f\xa7\x0f\xdb\x23\x2f\xc4\xed\x26\xcd\x85\x1b\x5b\xf4\xde\xc7\xb4\xf7\xff\x38\xbe\x3a\x3e\xf9\xfe\xf2\x4d\xef\xae\x36\x2e\xbd\x9e\x64\x00\xf6\x8e\x64\xcb\x20\x07\xa6\x8a\x8a\xd1\x0e\xc0\x2c\xe1\x7c\x26\x39\xa3\x69\x00\x63\x7e\x4b\x52\xf7\xb8\xb2\x45\x12\x13\xa8\xf5\x4c\xc9\x6b\xa7\x1d\xb3\x5f\xeb\x05\x6f\xd0\x74\x03\x24\xce\x23\x63\xb4\x42\xc2\x8d\x9b\x3c\xaa\x57\x81\x00\x5e\x3f\x7b\xed\x16\x17\xf6\xa3\xe9\x0a\xad\x7e\xde\x5e\x5c\x54\x2f\x02\x25\x8b\x82\x19\x46\xf8\x31\x72\x92\x36\x6d\x47\xd3\x96\xd8\xf6\x62\x8d\x5f\x1c\x87\x7f\x77\x3a\x27\xfb\x8d\xdb\x7d\xd3\xf3\xf6\xec\x82\x30\x9e\x28\xbc\x58\x29\xd4\x2b\xc9\xc3\x00\x5e\x38\xf3\xce\x53\x4a\xe5\x4c\x75\x1e\xdb\x7a\x30\xe9\x7d\x36\x01\xd8\xfd\xf0\xd2\x0f\xd8\x95\xbf\x00\x8c\xd0\x28\x46\xf5\xbe\x95\xdf\xbd\x7a\xf5\x5d\xcf\xca\x58\xc9\x08\xcd\x0a\x13\xfd\x40\x86\x5e\xbd\x7a\xdd\x5a\x59\x30\xf4\x49\x72\x79\xc3\xc8\xbd\x30\x7b\x3a\xd3\xfe\xee\xd4\xed\x3a\x37\x9b\xdd\x91\xd5\x34\x20\x1f\x6c\x87\xeb\x4f\xf3\x35\xad\x00\xb3\x5f\x1a\x27\x87\xe1\x4c\x66\x97\x1d\x90\xbe\xbe\xf8\x70\x2e\xcf\x0b\x94\xdf\x81\xcf\x0a\xa9\xcb\xe9\x23\xd0\xb1\x62\x62\x39\xbc\x96\xd2\x80\xbd\xc3\x8c\x88\x61\x94\x70\x9e\x42\xcc\xe8\x8d\x86\x24\xb6\xd7\x5f\xf6\x06\xcf\x96\x12\x7e\x1a\x71\xb0\xb7\xd1\xe0\x8f\x68\x5e\x32\x38\x60\xb7\x52\xdd\x30\xb1\x3c\x66\x6a\x67\x31\x75\x57\x3d\x5a\x60\x0e\x0b\xb2\x9d\xe5\xa3\x5b\x6a\x6d\x71\x51\x41\xe1\x67\x73\x08\x8e\x2d\xd9\x0e\xad\x92\x7a\x76\x75\x8a\xd9\x5d\xbb\xee\x2a\x7e\x01\xda\xa7\xf8\x99\xe0\x69\x5e\xa1\xf7\xd5\xc5\x85\x64\x8e\x0e\xf7\x8a\x1d\xf7\xbd\x81\xba\xfa\x07\xa0\x76\xe8\x74\x4f\x5d\x7d\x97\x89\x68\xa5\x9b\x36\x6e\x4f\xa9\x3e\x2c\x6d\xf6\x60\x75\xdf\xa7\x7b\x89\x95\xfc\x84\xd4\x60\xab\xbd\x02\x28\x03\xa2\x3d\x38\xdc\xc5\x7c\x8f\x00\x3b\x0c\x76\x0f\x88\x76\xd6\xdf\x2b\x6b\xa7\x51\x2c\xd3\x96\x41\xf7\x1e\xb1\xfa\x37\x84\x1b\x4c\xef\x42\xff\x11\xd3\x5e\xcc\x2a\xb5\xd6\x1a\xf4\xa9\x32\xfd\x7a\xee\xca\x8c\x51\x6c\xd2\x3c\xd6\x37\xee\x65\x59\x69\x54\x21\x4d\xdf\x5d\x6e\xfd\xac\x55\xbe\x58\x17\x57\x5b\x85\xec\x93\xbc\x27\x68\x41\x6d\xd5\x67\xfb\xd1\x2b\xbc\xbc\xd9\x2e\xe1\x4a\xdd\x35\x23\xdd\xfb\xd8\xfa\xd0\x2b\x63\xad\x1a\xaf\xf3\x99\x75\x3e\x87\x7e\xd8\x76\xe6\x7a\x7c\xd1\x69\x49\xec\x5b\x5d\x00\xed\xa7\xba\xc1\x4e\xb7\x72\x5e\xec\x3a\x44\x79\x93\x92\x67\x8c\xb3\x18\xc5\xdc\x3e\x10\xce\x0a\xdf\x2e\xf5\x51\xe8\xbd\xf3\x14\xda\xf3\xa4\xe8\xbc\x85\x3a\x5c\xfd\x1f\xfc\x25\x84\x21\xcb\x92\xaf\xca\x61\xbd\xf2\xed\x73\xd0\x67\x9a\xbd\x86\x29\x43\xb5\xcf\x2e\x4d\x3d\xde\xd1\xb5\xa3\xd8\x3a\x8a\xb7\xd4\xfa\xa5\xa9\x6f\xef\xc9\x0c\xd0\x30\xde\xc9\xfe\x01\xfc\x7b\x58\xed\x94\x5f\x11\x07\x83\x4e\x25\xde\x94\xb6\x8f\xe0\x27\x2c\xff\x18\x8c\x08\x03\x66\x85\xa0\x0d\x31\x89\x7e\x9a\x47\xb0\xfd\xbd\x48\x38\xcf\x37\xf3\xe1\x2d\x0a\x8a\xa0\x91\x26\x8a\x99\x14\xa4\x78\x0a\x1a\x85\x66\x86\xad\x11\xe4\x62\xe1\xd7\xa8\x73\xc4\xbc\x53\xd0\xc1\x68\x14\x4a\xaa\xfd\xa2\x88\xb1\x8a\x71\xca\x99\x7c\x6a\x44\x13\xa5\x50\x98\x51\x7e\x9f\x62\x77\x18\xad\x4c\xc4\x47\xb1\x92\x61\x42\x6d\x49\x33\xb4\xb9\x36\x1d\x46\x52\x30\x23\xed\x62\xdf\x12\xd4\x7b\xfd\x20\x15\x84\x68\x08\xe3\x95\x1d\x22\x22\xc8\x12\xed\xb9\x13\x0c\xf6\x34\x21\x95\x20\x0d\x91\xbd\x99\xb2\x17\xcb\x61\xeb\xa4\x41\x11\xc6\xb2\x7d\x9f\x5d\xf4\x39\xee\xc2\x5a\x11\x01\x2c\x08\xd7\x38\xf8\xcf\x00\x4d\x9a\xb3\xd8\x72\x27\x00\x00"),
 		},
 		"/infrastructure/04-syndesis-oauth-proxy.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "04-syndesis-oauth-proxy.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 4281,
+			uncompressedSize: 8314,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x57\x6d\x6f\xdb\x36\x10\xfe\x9e\x5f\x41\x68\x03\xda\x6e\x95\x95\x66\xed\x30\x08\xf0\x87\x22\x4d\xd7\xa0\x6b\x62\xc4\xde\xb0\x61\x2f\xc1\x99\x3a\x4b\xac\x29\x92\x23\x4f\x4a\x34\xc5\xff\x7d\xa0\x64\x3b\x96\xa5\xbc\x74\x18\x50\x6c\xfa\x60\x58\xba\xb7\x87\x77\xc7\xe3\xc3\x90\x81\x11\x3f\xa1\x75\x42\xab\x98\x95\x2f\x0e\x18\x5b\x0a\x95\xc4\x6c\x8a\xb6\x14\x1c\x0f\x18\xcb\x91\x20\x01\x82\xf8\x80\x31\xc6\x24\xcc\x51\xba\xf6\x3f\x63\x60\x4c\xcc\x5c\xa5\x12\x74\xc2\xad\xbf\x6d\x5e\x47\x42\x47\x0f\xc9\xa9\x32\x18\x33\xa1\x16\x16\x1c\xd9\x82\x53\x61\x71\x40\x8d\xeb\xdc\x68\x85\x8a\x6e\x9d\x85\x1a\x0a\xca\x8c\xd5\xd7\x55\x63\x00\x4a\x69\x02\x12\x5a\x6d\xc1\xb9\x76\x09\x23\x90\x26\x83\x91\x36\xa8\x5c\x26\x16\xe4\x1d\x36\x22\x95\x86\x1c\x2d\x85\x0e\xb9\x45\x0a\x15\xe4\x38\xe8\x3f\x24\xd9\x62\xbf\x53\xe3\x80\x31\x67\x90\xb7\x81\x8d\xb6\xb4\xc6\x10\x36\x2f\x31\xfb\xee\xe5\xcb\x6f\xd6\xa0\x8c\xd5\xa4\xb9\x96\x31\x9b\x1d\x4f\xd6\xdf\x08\x6c\x8a\x34\xe9\xaa\x3a\x94\xc8\x49\xdb\x7f\x2b\xd5\x0f\xe4\xb0\xdb\x08\x60\x8c\xeb\x66\x6c\xa7\x35\xde\xa0\x91\xba\xca\x51\xd1\xb1\x56\x0b\x91\xfe\x67\x7a\xe4\x71\xf5\xb3\x68\xa4\xe0\xe0\x62\xf6\xe2\x73\x14\xa2\x51\x27\x0b\x84\x69\xb5\x09\x69\xd1\xe9\xc2\x72\xdc\xe6\x94\x31\x29\x72\x41\x3b\xef\xbe\x06\xb9\xb6\x55\xcc\x82\xa3\x57\xdf\x7e\x10\xc1\x56\x62\xf1\xcf\x02\xdd\x5d\xba\x87\xb7\xaa\x6d\xa2\x2f\xfc\x6e\x00\x6a\x53\x4c\x98\x1b\x09\x84\x1b\xdb\x6e\x9d\xfb\xb5\xbe\x2b\x3f\x8f\xc9\xd1\x27\xd4\xfd\x1f\xa4\x74\xb7\xc2\xfe\xe1\x5a\x11\x08\x85\x76\x07\x7b\xb8\xee\x90\x9e\xa9\x7f\x44\x0e\x29\xc6\xec\x49\x5d\xb3\xd1\x74\x13\xfb\x78\x13\xd8\x8d\xce\xbd\xd1\xe8\xd4\x6b\xb1\xd5\xea\xc9\xbe\xe5\xa4\x90\x72\xa2\xa5\xe0\x55\xcc\x5e\xcb\x2b\xa8\x76\x97\x0d\x36\xed\xa4\xd0\x43\x09\x43\x63\x75\x29\x12\xb4\xe3\xed\x46\xec\xa9\x70\xad\x97\x02\x9b\xd1\x35\x6e\x50\x5f\xee\xc3\x5e\xeb\x49\x81\x8a\x42\x91\x8c\x5d\xe5\x08\xf3\x78\x3d\x1b\x81\x73\x5d\x28\x8a\xeb\x7a\x74\x6e\x50\x4d\x7d\x90\x89\xd5\x1f\x91\xd3\x6a\x15\x77\x73\xb9\x76\xd2\xf3\x5d\x18\x47\x16\x21\x1f\x67\x44\x26\x8e\xa2\xad\x95\x8f\x81\x36\x02\x23\xa2\x4f\x36\xca\xc1\x18\xb4\x9f\x60\x57\x0c\x04\x21\xe9\x9a\x09\x3f\x8e\x90\x78\x44\xd2\x45\xc6\x8a\x12\x08\xfd\xff\x11\xb7\xfd\xb5\x78\x8b\x25\x56\xc3\x06\x4b\xec\x27\xd6\x80\x73\x21\x70\x8e\xce\x85\xa4\x97\xa8\x7a\x1a\x6e\x29\xcc\xb6\x94\xe1\xbc\x20\xd2\x77\x28\x35\x49\xb6\x98\xe2\xf5\x38\x92\x3a\xd5\x45\x1f\x5f\x4f\xef\xd7\x3f\xa2\xdf\xbf\xfe\x6d\xf4\xd4\xa8\xf4\xe6\xa3\x49\x6f\x50\xd3\x8d\x2b\xd3\x1b\xa2\xc5\xcd\x95\x5e\xb4\x3f\x47\xcf\x1e\x76\xe4\xab\x54\xbe\x88\xdc\x15\xa4\x29\xda\xd1\x57\x8f\xb6\x10\x2a\xc1\xeb\x51\x46\xb9\x7c\xb4\x09\xb7\x98\xa0\x22\x01\xd2\x45\x1c\xa4\x9c\x03\x5f\x3e\xda\xb8\x6c\xcf\xa8\x87\xf5\x79\x73\x38\x8d\x3e\xba\x7b\x95\x8d\xc5\x85\x14\x69\xd6\xcf\xf5\x76\xd7\x85\x1c\xda\x86\x30\x4b\xd1\x34\x85\xef\x29\x8f\x3c\x9c\x17\x2a\x91\x38\xd8\x49\x5d\xeb\x12\x6c\x64\x0b\x15\xb5\x54\xc3\x45\xcb\x62\x8e\x56\x21\xa1\xdb\xb2\x91\xed\x66\x8c\x38\x34\x1e\xeb\x9a\x89\x05\x7b\xaa\x34\xdd\x37\x70\xde\x08\x07\x73\x89\x53\xb0\xc7\x19\xf2\xe5\x33\xb6\x5a\xdd\x83\xc5\x81\x1d\xd7\x81\x1f\x17\xce\x00\xc7\x20\x0e\xea\xfa\x1e\xe7\x53\xb0\x67\x1b\xdd\xd5\x2a\x78\x1e\x6c\x0e\xa2\x20\x0e\x8c\x4e\x5c\xf0\x3c\x28\xd1\xce\x83\x38\x48\x91\x82\x95\x87\x8c\x2a\xd9\x87\xf0\x05\x5b\x83\x4c\xd8\x42\x5b\xa6\xf4\x55\xbc\xd9\x39\x85\xf3\x7b\x02\xc1\xa2\x6d\xb7\x0f\x03\xc7\x28\x13\xae\x39\xb5\x84\x45\xc7\xf0\x9a\x2c\x30\x83\x36\x17\xce\x17\x9e\x5d\x65\x82\x67\x4c\x2b\x59\xed\x45\xe1\xa0\xd8\x1c\x59\x2a\x4a\x54\x6c\x5e\x31\x60\x5c\x16\x8e\xd0\x86\x90\xe4\x62\xb7\x09\x50\x95\xbb\xe3\x76\x33\xf7\xcf\x5f\xff\x38\x7b\x77\x74\x39\xb9\x38\xff\xf9\x97\xcb\xe3\xf3\xf3\xf7\xa7\x27\x97\xd3\x93\xe3\x8b\x93\x59\x27\x52\x09\xb2\xc0\xb7\x56\xe7\xdd\x91\xed\x99\x82\xaf\xee\x7b\xac\x2e\x70\xb1\x2f\xeb\xb1\x8f\x54\xea\x39\xc8\x90\x6f\x28\x54\xf7\x59\x62\xb5\xc6\x73\x27\x90\x61\xd4\x3f\x9c\x9e\x9c\xcd\x3e\x2f\xea\xc9\xc9\xd9\xf4\xdd\xe9\xdb\xd9\xe5\x1a\x7f\x07\x52\x5d\x87\xcc\x82\x4a\x91\x7d\x59\x82\xbd\xf4\xee\x9f\xb7\x7f\x1b\x80\x2c\x1e\xdf\xd7\xed\x27\xaa\x14\x56\x2b\xcf\x3d\x3b\x4d\xb6\xc9\x45\x5d\xdf\xba\xdd\x6f\xc3\xc6\xff\xad\x4a\x1b\x6e\xb5\x6a\x10\xf5\xba\x76\x87\xc4\x6f\x22\x6c\x19\xc3\x64\x9f\xd3\xef\x66\xca\x14\x73\x29\x78\x47\x30\x44\xf9\x59\x43\xcc\x20\x11\x0a\x9d\x9b\x58\x3d\xc7\x6e\xea\xfd\xf1\xf6\x3d\xd2\x7e\x3d\x7a\xd7\x89\xad\x00\x28\x8b\x59\xd4\x1c\xd4\x51\x86\x20\x29\xfb\x6b\xbf\xce\x3c\x43\x8f\xf0\xdd\x6c\x36\x99\x76\x64\x42\x09\x3f\x8c\xdf\xa0\x84\x6a\x8a\x5c\xab\xc4\x93\xdf\x57\x1d\x1d\x12\x39\xea\x82\x6e\xc5\x87\x3b\x62\xe9\xb7\xdc\xff\x61\x21\xa5\x96\x45\x8e\x1f\xfc\x18\xde\xab\x7e\xee\xbf\x4d\x5a\x70\x7b\xe4\x60\xa0\x0b\xee\xbb\x45\xb6\xcf\x00\xa5\x67\x83\xb4\x9e\xed\xd0\xf5\xa3\xc3\xc3\x0f\xa2\x23\x1b\x22\xf7\x5d\x8b\x1d\x83\xf5\x39\xf3\xba\x3d\x67\xce\x06\x90\xee\x73\xbc\x36\x1f\x03\x14\xf9\xe1\x05\xb6\x63\xa5\x8b\xab\xfd\x36\x14\xb8\xeb\x81\xac\xf0\x2c\x64\x7b\x8b\x6e\xaf\x02\xed\x6d\xf3\x38\xf3\xd3\xe3\xe0\xef\x00\x00\x00\xff\xff\x97\x88\x62\x59\xb9\x10\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\x7b\x6f\xdb\xba\x15\xff\x3f\x9f\x82\xf0\xdd\x70\xdb\xbb\xca\x4a\xb2\xf6\xa2\x10\xe0\x3f\x82\x34\x77\x09\x9a\x26\x46\xec\x0c\x1b\xf6\x08\x8e\xa9\x63\x89\x35\x45\x72\x24\xe5\xc4\x73\xf4\xdd\x07\xea\x61\x4b\xb2\xfc\x48\xef\xdd\x6e\xd7\xad\x0a\x0a\x8b\x3a\x3c\x8f\x1f\xcf\x8b\xa4\x47\x40\xb1\x3f\xa2\x36\x4c\x8a\x80\xcc\x4f\x8e\x08\x99\x31\x11\x06\x64\x84\x7a\xce\x28\x1e\x11\x92\xa0\x85\x10\x2c\x04\x47\x84\x10\xc2\x61\x82\xdc\x14\xbf\x09\x01\xa5\x02\x62\x16\x22\x44\xc3\x4c\x39\x56\xbd\xf6\x99\xf4\xf7\x7d\xb7\x0b\x85\x01\x61\x62\xaa\xc1\x58\x9d\x52\x9b\x6a\xec\x20\xa3\x32\x51\x52\xa0\xb0\x6b\x66\x9e\x84\xd4\xc6\x4a\xcb\xa7\xc5\xd1\x72\xe9\x11\x36\x25\x42\x5a\xd2\xff\x98\x4e\x50\x0b\xb4\x68\xb2\x2c\xe7\x04\x42\x48\x0b\x96\x49\xb1\xd2\xda\x14\xb6\xf5\x81\xab\x18\xfa\x52\xa1\x30\x31\x9b\x5a\x27\x29\xff\x24\x22\x8f\xa2\xb6\x9e\x41\xaa\xd1\x7a\x02\x12\xec\x14\xec\x59\x6e\x72\xe1\x28\xc2\x52\xda\x56\xda\x23\x42\x8c\x42\x5a\xa8\xa0\xa4\xb6\x26\xa8\xf4\xde\xd4\xf9\x3b\x32\xbe\x1e\x11\x66\x88\x45\x9d\x30\x01\x16\x43\x32\x59\x10\x1b\x23\x61\x22\xd2\x68\x0a\x2c\xbd\x9c\x51\x40\xde\x1f\xbf\x3f\x2e\x4d\x53\x5a\x5a\x49\x25\x0f\xc8\xf8\x7c\x58\x8e\x59\xd0\x11\xda\x61\x4e\xfa\xf6\xe4\xfd\x71\x2e\x17\xb9\xc1\x2c\x6b\xb2\x79\xfb\xf6\xf7\x07\xb2\xc9\x49\x9b\x96\x1b\xe4\x48\xad\xd4\xbf\x94\x6b\x1c\xb6\xe6\xfd\x0f\xa8\xb8\x5c\x24\x28\xac\x03\xaf\xe9\xce\xa0\x94\xf1\x6b\x3e\xbd\xa6\xad\x43\xb0\x39\xa7\xe9\x12\x9d\x0c\xce\xa5\x98\xb2\xa8\x01\xc1\xd7\x1f\x27\x87\x39\x68\x85\xec\xa8\xa4\xe9\x5f\xb2\x28\x3e\x9b\x03\xe3\x30\x61\x9c\xd9\x45\xff\x42\xc0\x84\x63\xb5\xf0\x1a\x15\x67\x14\x4c\x40\x96\xcb\x5d\xb3\xee\x4a\x3a\x92\x65\x6d\x0f\x5c\xb3\x38\xd9\xea\x55\xdd\x0b\xee\x66\x13\x92\x80\xa5\xf1\x75\x03\xf1\x6e\xcc\xf7\xa3\xfe\x22\x40\x5b\x66\xfc\xfc\x75\x3e\x44\x5e\x8e\x4d\x89\x06\x88\xb0\x81\x08\x79\xb5\x7f\xd9\x5e\x97\xda\x1a\xab\xc1\x62\xb4\xa8\x10\x2b\x7c\xec\x4e\x72\xce\x44\x74\xaf\x42\xb0\x95\x8b\xe9\xfa\xd8\x1a\xe0\x04\x9e\x46\xa9\x8e\x30\x20\xa7\xef\x7e\x5b\x1f\xbd\x17\x50\x2c\x3c\x2f\xbf\x55\x38\x6d\x59\xc0\x2d\x9a\xb8\xec\xeb\x94\x68\xa1\xbc\xa6\xae\x5c\x62\xaf\xc9\x59\xd6\xb4\x64\x08\x1a\x92\x9a\xab\x30\x61\x51\xcf\x81\x8f\x90\x4a\x11\xe6\x6e\xf8\x72\x23\xab\x6f\x96\x25\x28\x53\xbb\xe2\xf5\xe3\x71\x95\x9c\x09\x49\x73\x58\x87\xa8\x99\x0c\x6b\xc2\x9a\x3e\xef\xe2\xc1\xc8\x54\x53\xac\xa9\xc8\x59\xc2\x6c\xed\xdd\xd5\xe5\x44\xea\x45\x40\x7a\xa7\xef\x7e\xfc\xc4\x7a\xab\x2f\x1a\xff\x91\xa2\xd9\x46\x7b\xec\x48\x5f\x0e\x5c\xc3\x39\xda\x2b\xd2\xbd\x62\x2b\x3f\x5d\x5b\x66\x31\x51\xbc\xe6\x43\xcd\x94\xb9\x99\x36\xb7\x87\xf1\xfe\xb0\x7a\x41\x0a\x7d\x61\x14\x96\x13\xbe\x28\x59\xba\x3f\x2b\x95\xe4\x32\x5a\x8c\x94\x46\x08\xcf\xa5\x70\x1e\xcd\x44\x7d\xc5\x3c\x17\x46\xa3\x19\x3e\xd6\x5d\x71\x3d\xf3\x23\x2e\x02\x32\x5b\x75\x0d\x4e\xe7\x58\x1a\xeb\x72\x7b\x8d\xfa\x31\x46\x71\x2f\x0c\x58\x66\xa6\xcc\x2d\x67\x40\x46\x34\xc6\x30\xe5\x78\x26\x16\x8f\x50\x59\xb2\xc2\x7d\xd4\x2a\xe1\x3b\x92\xeb\xe1\x6b\xf0\x45\xe0\x1e\x86\xc0\xee\x7a\x33\x5e\x93\x92\x2c\xfb\x6f\xc7\xa5\x19\x48\xee\xa1\x52\x58\x60\x02\x75\x4d\xbc\x57\xd6\xf7\xd6\xc4\xce\x26\xb3\x4c\x7d\x09\xb8\xe4\xf6\x7d\x03\xcb\xf3\x4a\x17\xd3\xbf\x75\x9c\xfa\xb7\x2c\xa4\x57\x8e\x92\x64\xd9\xf7\xed\xd9\xc3\x94\xf3\xa1\xe4\x8c\x2e\x02\x72\xc6\x1f\x61\x51\x37\x13\x74\xd4\x82\xc7\x23\x9e\xa7\xb4\x9c\xb3\x10\xf5\x40\xb2\x90\x6e\x7c\x75\x83\x1e\x33\x26\x45\xed\xa5\x9a\x0f\x0e\xd0\x2d\x27\xbe\xbf\xbb\x6e\xae\xb4\x73\x23\xcf\x8b\xad\x55\x1e\x84\xa1\xeb\x9b\x07\xc7\xfd\xfc\x09\xf2\x46\xb8\x4d\x99\x37\x42\x9e\xd2\x38\x65\x4f\x03\x3f\x07\xf1\x88\x90\x8d\x18\xbf\x2a\x7a\xf0\xfe\xf8\x7a\x34\x72\x45\xca\x76\x08\xd5\x18\x32\x8d\xd4\xe6\x06\x38\x0d\x4c\xe0\xfb\x0d\x43\xee\x64\x6a\xf1\xb2\x0c\x5b\x92\x65\x85\x3c\x9f\x02\xe7\x13\xa0\xb3\x52\x70\x23\xcd\xee\x60\xff\x72\xee\xeb\x7f\x8e\x21\x95\x72\xc6\xd0\x6d\x79\x52\x8d\x83\x29\x70\x83\x95\x06\x22\xec\x54\x60\x8e\xda\xa0\xd7\x8c\xda\xea\x2b\x26\xc0\xb8\x17\xca\x04\x98\x18\xfc\xb0\x4d\x98\xf3\xd5\x41\x6e\xf5\x43\x37\x9b\x54\x19\xab\x11\x92\xca\xc2\x55\x60\xb8\x6d\x1a\x6a\x1f\x14\xf3\x5f\x3c\x29\x01\xa5\x50\xbf\x60\x5e\xda\x21\x44\x81\x31\x1e\x50\x8a\xc6\x78\x56\xce\x50\x6c\x50\x98\x19\x53\x2b\x3f\xf7\x26\xa9\xb5\x72\x0b\x91\x03\xc0\xd3\x18\xe1\xd3\xc0\xe7\x32\x92\xa9\xdd\x4f\xf7\x97\xbf\xfb\x7f\xfb\xdd\x5f\xfb\xaf\x94\x88\x9e\x3f\xab\xe8\x19\xa5\x7d\x36\xf3\xe8\xd9\xda\xe9\xf3\xa3\x9c\x16\xff\x9d\xbe\xde\xcf\xc8\x41\x38\x3f\xf1\xcd\x23\x44\x11\xea\xfe\x0f\x07\xcf\x60\x22\xc4\xa7\x7e\x6c\x13\x7e\xf0\x14\xaa\x31\x44\x61\x19\x70\xb3\xdd\x11\xb7\x4d\x76\xee\xc6\x0e\x81\x90\xe6\x5b\xb3\xfe\x67\xb3\x93\xd8\x05\x39\x67\x51\x5c\xc7\x1a\xc5\xfc\x27\x2d\x93\x7a\xba\xf2\x48\x71\x08\x70\x87\xd3\x66\x16\xab\x76\x52\x7b\x12\xe7\xf9\xda\xe4\x22\x55\xb4\x12\x28\x8a\x79\x53\x5c\xc1\xf5\xf6\xec\x7e\x7c\x79\xfa\x30\xbc\xbb\xfd\xd3\x9f\x1f\xce\x6f\x6f\x3f\x5e\x5d\x3c\x8c\x2e\xce\xef\x2e\xc6\x35\x62\x42\xe6\xc0\x53\x6c\xab\xec\x9e\x42\xe9\x8f\xb8\xe8\xd0\x7b\x63\x0f\x18\x71\x39\x01\xee\x15\xb8\x6d\x10\xcf\x5c\xa5\xcd\xf5\x69\x29\xe2\x72\x83\x06\x11\x21\xf9\xcd\x1c\xf4\x83\x63\xfa\xa6\xf8\x99\xab\x45\x82\xc1\x2e\x60\x2e\xc4\x9c\x69\x29\xdc\xae\x25\xcb\x3a\x10\x58\x2e\xd7\x6c\xdb\x49\x3d\xe7\xbf\x26\xc9\x5f\x57\xbb\x4a\x11\x36\xc9\xcb\xb3\x96\xea\xd5\x61\xbc\xaa\x9c\xb5\x33\x91\x4d\x7c\x54\x3a\xe1\xac\x59\x9c\xba\x8e\x45\xdc\xe3\xfa\x38\x26\xd0\x98\xa1\x96\x93\xda\x0e\xc9\xfd\xb9\x2c\xf4\x07\xb4\xcd\x41\x42\x54\xb7\x68\x42\x14\xd8\x38\x20\xbe\x72\x7d\x76\x35\xe6\x1e\x26\x98\x8b\x9c\x0f\xc8\x61\xb1\xde\x3a\xbc\x6b\xd0\xb4\xb7\x1e\x27\x75\xe6\x9c\xcd\xf1\xeb\xd4\xb1\xb3\xce\x1d\xd6\x98\xfc\x7b\x9a\x92\xea\xf8\xe7\x8b\x0b\x17\xe5\x0c\x85\xf5\x58\x38\x30\x0b\x63\x31\x09\xca\x23\x47\xa0\x54\xa6\xc2\x06\xcb\x65\xff\x56\xa1\x18\x39\x21\x43\x2d\x3f\x23\xb5\x59\x16\xac\x22\x32\xaf\x89\x25\x93\xc3\xeb\xd4\xaf\x5e\x14\x2d\x37\xf9\xc1\xe9\xc0\x47\x4b\x7d\xcb\x8d\xaf\x34\x9b\x83\x45\xf7\xbb\x4f\xf5\xa6\x2d\x6e\xc6\x0c\x17\xdd\x13\x66\xb8\xf8\x7f\xdd\xfd\x5f\xa8\xbb\x65\xd7\x5f\x45\x9d\x47\xa1\x70\x08\x35\x63\xce\x73\x7c\xe7\x53\xae\x63\xf0\x26\xa9\x08\x39\x76\x7a\xd2\x2a\x66\xf3\xd9\x73\xd0\xbe\x4e\x85\x5f\xd4\x41\xe3\x37\xf7\xca\xcd\x60\xf4\x29\xe4\x1c\x97\x4b\xb7\x93\x7f\x95\xdf\x22\x6c\x4f\x38\x1f\x98\x71\x5b\xe9\x11\xe8\xf3\x18\xe9\xec\x75\xbb\x36\x35\x75\x31\xa0\x07\xcb\x9e\x4b\x17\x46\x01\xc5\x5e\xd0\x5b\x2e\x77\x64\xb3\x11\xe8\x9b\x8a\x36\xcb\x7a\x6f\x7a\xd5\x41\x50\x2f\xe8\x29\x19\x9a\xde\x9b\xde\x1c\xf5\xa4\x17\xf4\x22\xb4\x3d\x57\xf0\x36\xeb\x9d\xbb\x3d\x28\x95\x0c\xc9\x54\x6a\x22\xe4\x63\x50\x45\x4e\x6a\x50\x7b\x13\x04\x8d\xba\x68\x5b\x09\x18\x62\x63\x66\xf2\x53\x23\xa6\xd1\x10\x7c\xb2\x1a\x88\x72\x17\x0f\xc6\x1d\x89\x93\xc7\x98\xd1\x98\x48\xc1\x9b\xd1\xf8\x1d\xa1\x20\xc8\x04\x49\xe4\xea\x8a\xbb\x9e\x00\x42\x79\x6a\x2c\x6a\x0f\xc2\x84\x89\x6f\xa3\xcb\xd9\xa7\xf5\xf5\xd5\xc5\xcd\xf8\xd7\xd5\x7a\x78\x71\x33\xba\xbc\xfa\x69\xfc\x50\xea\xdf\x50\xe9\x9b\xe9\xd2\x6a\xb7\x53\xff\xe9\x2e\x6d\x43\xf4\xaa\x03\xca\x0b\xb5\x1f\x23\x70\x1b\xff\xb3\x45\x62\x68\x8c\x4e\xc3\xcb\xf1\x78\x38\xfa\x25\xda\xa4\x9f\xdf\xca\x7d\x75\x86\xcc\x25\x4f\x13\xfc\xe4\xd2\x70\x6b\xf5\x13\x37\x36\x2c\x94\x6b\x35\x07\x1d\x5e\xd0\x71\x62\xd6\x71\x39\xbb\xf5\x70\xbd\xfb\x80\xbd\x7e\x70\x7e\x7a\x7c\xfc\x89\x35\xbe\x75\x1d\xb3\x37\x67\xd4\x26\x94\x15\xe7\xac\xa8\x38\x37\x1d\x3a\x57\xdd\xde\xce\xbb\xec\x35\x64\x35\xb1\xde\x1e\x0c\xda\x99\xa7\xa9\x70\x31\x76\xb3\x93\xc3\x1a\xc5\xba\x76\x9b\xf7\x38\x56\x33\x77\x88\x50\xea\xe6\x95\xb7\x02\xc5\x55\xe9\x79\xec\xb6\x8a\x47\xcb\xa5\x47\x50\x84\x59\x76\xf4\xaf\x01\x00\x1c\x13\x35\xa2\x7a\x20\x00\x00"),
 		},
 		"/infrastructure/04-syndesis-server.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "04-syndesis-server.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 6035,
+			uncompressedSize: 8288,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x58\x5b\x6f\xdb\x38\x16\x7e\xef\xaf\x20\x3c\x58\x64\x06\x58\xdb\x4d\x3b\x9d\x66\x04\xf4\x41\xb5\x95\xc4\x8d\x2f\x1a\x49\xc9\xa2\x4f\xc6\x09\x75\x6c\xb3\xa1\x48\x2d\x49\xb9\x63\x04\xf9\xef\x0b\x89\x96\x23\xcb\x92\x9d\xec\x16\x8b\xc1\xe8\xc9\x26\xcf\xf5\x3b\x17\xf2\xb0\x4b\x20\x65\x77\xa8\x34\x93\xc2\x21\xeb\xf3\x37\x84\x3c\x30\x11\x3b\x24\x44\xb5\x66\x14\xdf\x10\x92\xa0\x81\x18\x0c\x38\x6f\x08\x21\x84\xc3\x3d\x72\x6d\x7f\x13\x02\x69\xea\x10\xbd\x11\x31\x6a\xa6\xb7\x6b\xe5\xdf\x1e\x93\xfd\x53\xfb\x66\x93\xa2\x43\x98\x58\x28\xd0\x46\x65\xd4\x64\x0a\x1b\xc8\xa8\x4c\x52\x29\x50\x98\x67\x61\x5d\x8d\x6a\x8d\xaa\x20\x16\x90\x60\xd3\x8e\x4e\x91\x5a\x4b\x53\xa9\xcc\xd6\xe8\x6e\xf1\xc7\x21\x17\x6f\xb7\x8a\x52\x25\x8d\xa4\x92\x3b\x24\x1a\xf8\xdb\x35\x03\x6a\x89\xc6\xdf\x12\xee\x48\xad\xa2\x95\x31\x69\xb1\xa0\x91\x23\x35\x52\xfd\x28\x34\x8e\xb8\xb9\x1f\x27\x48\x53\xdd\x93\x29\x0a\xbd\x62\x0b\x93\xb3\x56\x22\x37\xc4\x94\xcb\x4d\x82\xc2\x0c\xa4\x58\xb0\xe5\xdf\x24\x84\x0a\x53\xce\x28\x68\x87\x9c\xff\xbf\xc1\x2f\x48\x8d\x02\x83\xcb\x4d\xa9\x4e\xa1\x96\x99\xa2\xb8\xc3\x91\x10\xce\x12\x66\x2a\xff\x73\xdc\x13\xa9\x36\x0e\xe9\xbc\xfb\xf0\xdb\x84\x75\x76\x3b\x0a\xff\x9d\xa1\x6e\xa3\x7d\xfb\x4c\x6a\xc1\x0d\x90\x2a\x04\x63\x61\x35\x98\xa4\x1c\x0c\x96\xbc\xfb\xb1\x3d\x8c\x6f\x1b\x36\x2f\xc1\xe7\x15\xb1\x7e\x25\x9c\xd5\xc8\xda\x68\x16\xed\xc6\xa5\x54\x66\xc2\x4c\x5b\xb2\xc1\x7e\x54\x0a\x03\x4c\xa0\xaa\xf8\xd8\x6d\xcd\xa0\xf2\x43\xb1\xae\x42\x52\x32\x7c\x71\xef\xdc\xb9\xeb\xfb\xf3\xe1\x28\xa8\x6c\x13\xb2\x06\x9e\xa1\x43\xfa\xf1\xae\x9c\x74\x1b\xfb\xcc\x8f\x46\xb3\x69\xd8\xc4\xde\xe9\x0e\xbf\xc1\x1a\x7a\x02\x4d\x2f\x55\xb8\x40\x35\xf2\xd7\xbf\x86\x06\xe8\xc3\x27\xa3\x32\x24\xdd\x61\xa6\x51\xf5\x56\x32\xc1\x4f\x7d\x93\xa4\xe4\xf1\xb1\x17\x96\x38\x0e\x4a\x10\x75\x2f\x2c\xfc\xe9\x7d\x81\x35\xcc\x52\xc3\xa4\xd0\x4f\x4f\x9d\x06\x83\xa6\xee\xc4\x0b\x7d\x77\xe0\x1d\x5a\x73\xa9\x64\xe2\xec\x2d\x13\xb2\x60\xc8\xe3\x00\x17\xf5\xf5\xed\x8e\x0f\x66\xe5\xec\x52\xac\x97\xab\xd0\x29\x50\x6c\x50\xec\x4d\x87\xfe\x6c\x34\x8d\xc2\x79\xe4\x85\xd1\x3c\xbc\xf5\xfd\x59\x10\xcd\xbd\xa9\xfb\x79\xec\x0d\x9b\xb0\x39\x7b\x7c\x24\xc7\x7c\xbd\x44\xc8\x13\x4c\xf7\x22\xd4\x26\xcc\xd2\xbc\x6f\x93\xa7\xa7\xb3\x06\xe5\x83\xd9\x34\x0a\x66\xe3\xb1\x17\x84\xf3\xd1\x34\xf2\xae\x02\x37\x0f\xc9\x0f\xd1\x6e\xfb\xe9\x48\x18\x5c\x2a\x28\x90\x6f\x31\xc2\x9f\x85\xd1\x55\xe0\x85\x7f\x8c\xe7\xa1\x3b\xf1\xc7\xde\xf0\xf3\xdc\x77\xc3\xf0\x5f\xb3\xa0\xcd\x82\x46\x03\x86\x60\xe0\x1e\x34\xf6\x42\x48\x52\x8e\xf1\xbd\x0f\x5a\x7f\x97\x2a\x6e\xf1\x7d\x3c\xf2\xa6\xd1\x3c\x8c\xdc\xc8\x9b\xbb\xb7\xd1\xb5\x37\x8d\x46\x03\xeb\xbf\x3b\xbe\x9a\x05\xa3\xe8\x7a\xd2\x98\x9b\xd7\x09\xd0\xf0\xda\x3d\x6f\xca\xa3\x63\x52\x6f\xbc\xaf\x2f\xcb\x2e\x9d\x37\x2e\x73\x83\x9b\xc6\x0c\x6b\xac\xd8\xae\xe5\x39\x20\x7e\xc0\x8d\x43\x28\x67\x28\x4c\x68\xc0\xa0\x9b\x99\x15\x0a\xc3\x68\x11\x92\x1b\xdc\x9c\xf2\xc1\x9b\x0e\x82\xaf\xfe\x0b\x50\x71\xbd\xb0\x3f\xf8\x3c\xe8\xfb\x37\x83\xf0\x83\x0f\x71\xcc\xc4\xf2\x24\x42\x15\xe9\x7f\x05\x74\x3c\x41\xd5\x26\x7d\x21\x32\xd1\xa8\x31\x3d\x3b\x8d\x79\x51\xad\x2e\xcb\x3e\xb8\xf6\x06\x37\x45\xd5\x05\x77\xee\xf8\x7f\x2a\xb5\x4a\x91\x15\x6e\x0c\x56\x48\x1f\xf2\x45\xb5\x06\xde\x52\x75\x33\xdf\x9b\x86\xd7\xa3\xcb\x68\x3e\x71\xa7\xee\x95\x37\xc9\x1d\xbb\x0d\xc6\xf3\xcb\x59\xf0\x3e\x1c\xb8\xe3\x86\x4e\xf8\x0a\x93\x26\x20\x60\x89\x79\xfb\xbf\x55\xfc\x52\xaa\xf7\x9a\x02\xc7\xc2\x96\xc7\xc7\x2e\x61\x8b\x8a\x18\x37\x8e\xa5\xd0\xbd\x2f\x80\x4b\x54\x3d\x4f\xc0\x3d\xc7\xf8\xe9\xa9\xf1\xd8\xf0\xae\xbc\x60\x5e\xf6\xcc\x1d\xc5\x09\x91\x03\xc9\xed\xa5\xe7\x56\xb1\x3d\xb9\xcf\x41\xab\xf6\x94\x63\xcc\x9d\x3d\x9d\xc8\x35\xb6\x08\xcc\x6f\xbb\x4e\xbf\xbf\x4b\xc4\x6f\x85\xb4\x2e\x2d\xa5\x39\xe7\xbf\xbe\xfb\xed\xa2\x0f\x29\xeb\x1b\x05\x14\x75\x4d\xb2\x38\x8a\x80\x6d\x94\xc1\x3c\xfa\xea\x37\xc6\xe9\x88\x3f\xb6\x3b\xaa\x68\x93\x62\xf3\x51\x58\x53\xe1\xbb\x81\xdb\x5c\xf1\x27\x75\xf8\xa0\x20\xc9\x95\x3c\x7b\xd4\x16\xa9\x1b\x01\x86\xad\xb1\x31\xfa\x3f\x91\x09\xa8\x07\x54\xc4\xac\xc0\x10\x0a\x99\x46\x4d\x80\x28\x7c\xbe\x63\x10\xb9\x20\x66\x85\xbb\xba\x27\xb6\xee\xff\x49\xb4\xb4\x5c\xf9\xa6\xc0\xef\xf9\x25\x68\xc1\x96\x99\x2d\x16\xc2\x74\x7e\xc7\xe3\x0c\xe3\x06\x1c\x6e\xa6\x6e\x34\xba\xf3\x8e\x1d\x86\x9d\xfc\x2e\xb2\xef\x5e\xee\xdc\x10\xd7\xdb\x73\xb7\x25\x86\x77\xee\x7c\xe8\x7d\xbe\xbd\x3a\x2a\xf3\x05\x12\x59\x02\xcb\xbc\x2a\x49\x5e\x55\x07\xc9\x58\xee\x9e\xa8\xd9\x51\x4e\xb6\xad\xcc\x7a\xda\x15\x22\xfc\x8c\x73\x5f\x72\x46\x37\x0e\x71\xf9\x77\xd8\x54\x6f\x74\x9c\xad\x51\xa0\xd6\xbe\x92\xf7\xb8\xdf\x8e\xf3\x12\xb8\x42\x53\xef\xd1\x69\x7d\x3c\xdc\x6d\x14\xb7\xa6\xa2\x24\xd6\xe7\xfd\xb5\x9d\xda\x6a\x34\xb9\xcc\x6b\x84\x78\xef\x16\xbb\x0f\xaf\x4b\x29\xa6\x87\xbd\xbe\xec\x60\x06\xff\x34\xfd\x94\x03\x13\x67\x7b\x34\x4c\x30\xc3\x80\x0f\x91\xc3\x26\x44\x2a\x45\xac\x1d\xf2\xfe\xed\xbe\x91\x29\x2a\x26\xe3\xdd\xf6\xbb\xfd\xdd\x05\x30\x9e\x29\x8c\x56\x0a\xf5\x4a\xf2\xd8\x21\x1f\x2a\xfb\x0a\x21\x66\xaf\x84\xaa\x40\xa4\xd3\x5f\x21\x70\xb3\xea\x34\x03\x79\x7e\x71\x7e\xda\x91\xf3\xaa\xa5\x95\xb1\xbe\x84\x6e\x37\x1c\x1c\x0c\xef\xf6\xab\x8d\xf0\x6d\x6c\x75\x5b\x2c\x5b\x82\x46\x31\xaa\x8f\x71\xfe\xfe\xf1\xe3\xef\x0d\x9c\xa9\x92\x09\x9a\x15\x66\x47\x99\x2f\x3e\x7e\xbc\x68\x60\xfe\x26\xb9\x7c\x60\x50\xd9\xf9\x2e\xd5\x03\x13\xcb\x21\x53\xad\x13\xca\x5a\xf2\x2c\xc1\x49\x3e\x4e\xe9\xa6\xc1\xc7\x36\x90\xae\x25\xdb\x53\x9a\xe4\x3c\xf6\xe6\x5f\x95\xdd\xa7\xe5\x73\x42\xf9\xfd\x44\x42\x34\xe4\x0f\x19\x12\xca\x41\x6b\x62\x24\xe9\x5c\x65\xa0\x40\x18\xc4\xb8\x43\x7e\xb6\x13\x31\xf9\xf4\x69\x37\xf1\xfe\xb2\xc7\x1e\xad\x98\x26\xb1\x44\x2d\xce\x4c\xe1\x13\x91\x82\xcc\xc2\x19\x01\x9d\x37\x3a\x85\x45\x63\x23\x0b\xf6\x27\xc6\xa4\x68\x75\x7b\xec\x0b\x25\x13\x3b\x75\xe7\xaa\xcb\x89\x9c\xfc\x7c\xf1\xf6\x1f\x84\x66\x4a\xa1\x30\x7c\xf3\x4b\x8f\x9c\x95\xda\xcf\x72\x79\x6c\x29\xa4\xc2\xd8\x2a\xd8\x4b\xeb\x83\x89\x9e\x34\x4e\xf5\xa4\x32\xad\x9f\xea\x49\x41\x29\xb4\x37\xce\xe5\xf4\x26\x05\x5f\xed\x2a\x93\x7f\x34\xcd\x5e\x2f\x6d\xe0\xdf\x1e\x88\x6a\x7a\x5b\xf8\xef\x2c\x0e\xac\xa4\x1f\x66\x73\x29\xaf\x6e\xb5\x4d\xc1\x86\x61\xbe\x2d\x45\xed\xfa\x04\x52\xa7\xa1\x58\xea\x37\xe6\x4a\xd6\x1a\xc5\x96\xcb\x5d\xc3\xed\x6e\x5f\x57\xec\x2b\xd9\x60\x05\x62\x89\x6d\xa7\x54\xd7\x1e\x20\x96\xa8\xb8\x0d\x54\xcc\x85\xcc\xc8\x04\x0c\xa3\x0e\xc9\x8f\xbc\xc3\x77\x8a\x29\xd4\xdc\x6b\x7b\xa5\x58\xd4\xa6\x04\xfb\xa0\x57\x9c\x6b\xa1\x51\x08\x49\x04\xd5\xfa\xb3\x0e\x9f\xd5\xa4\x39\x1c\x0c\x6a\x73\x56\x23\x2c\xe6\x76\x3b\x74\xce\x52\x14\xe1\x8a\x2d\x8c\xaf\xe4\x37\xa4\xe6\x39\x18\x16\x91\xd1\xb3\xaf\x6f\x6a\x2f\x8f\x05\x0c\xad\x4f\x8f\x15\x4b\x0f\x5e\x1d\xdb\xdf\x67\xfe\x7a\xef\x91\xcf\x6f\x53\x06\x96\xbb\x74\xb1\x1e\x74\x2c\xbc\xe5\x19\xb6\x1f\xb2\xa3\x01\x3b\x11\xae\xf2\xd2\xf2\x9f\x00\x00\x00\xff\xff\x06\x73\x2d\x98\x93\x17\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x59\x5b\x6f\xdb\x38\x16\x7e\xcf\xaf\x20\x34\x58\xa4\x05\x6a\xb9\x69\xa7\xd3\x8c\x80\x60\x57\xb5\xd5\xc4\x8d\x2f\x1a\x49\xe9\xa2\x98\x19\x18\x0c\x75\x6c\xb3\x91\x48\x2d\x49\xb9\x35\x0c\xff\xf7\x05\x75\xb1\x25\x59\xb6\x93\x49\x1f\x66\x50\x3f\x34\xe2\xb9\x7e\xe7\x42\xf2\xb0\x83\x70\x42\x3f\x83\x90\x94\x33\x0b\x2d\x2f\xce\x10\x7a\xa0\x2c\xb4\x90\x0f\x62\x49\x09\x9c\x21\x14\x83\xc2\x21\x56\xd8\x3a\x43\x08\xa1\x08\xdf\x43\x24\xf3\xff\x23\x84\x93\xc4\x42\x72\xc5\x42\x90\x54\x16\xdf\xca\x3f\x4d\xca\xbb\xa7\xd6\xd5\x2a\x01\x0b\x51\x36\x13\x58\x2a\x91\x12\x95\x0a\x68\x21\x23\x3c\x4e\x38\x03\xa6\x76\xc2\x3a\x12\xc4\x12\x44\x46\xcc\x70\x0c\x6d\x2b\x32\x01\x92\x5b\x9a\x70\xa1\x0a\xa3\x3b\xd9\x1f\x16\xba\x7c\x5d\x28\x4a\x04\x57\x9c\xf0\xc8\x42\x41\xcf\x2d\xbe\x29\x2c\xe6\xa0\xdc\x82\x70\x4b\x9a\x2b\x5a\x28\x95\x64\x1f\x24\x44\x40\x14\x17\x3f\x0a\x8d\x23\x6e\xae\xd7\x1d\x44\x67\xc8\xec\x43\x12\xf1\x55\x0c\x4c\xc9\xcd\xe6\xac\x1e\x3d\x9c\x24\xb2\x5b\x09\xe1\x8e\x36\x63\x87\x48\x42\x2b\x8f\xc9\x13\x60\x72\x41\x67\x4a\x1b\xd1\x2a\xa0\xc7\xd9\x8c\xce\x73\x31\x2c\xdc\x6c\x6a\x69\x51\xd8\xc6\x05\x7a\x81\x59\x58\xb3\x51\xff\xb1\xf4\xd3\x44\x43\xfe\x12\x99\x7e\xe9\x6c\xaf\xf4\x54\x9a\x3a\xd1\x40\x98\x76\xaa\xb8\x24\x38\xa2\x6c\x6e\x3a\x0c\xdf\x47\x90\xab\x41\x08\x33\xc6\x15\x56\x94\x33\xb9\xd5\x75\x4c\x4f\xc1\x86\x10\x8d\xf1\x1c\xea\xce\x29\x41\xe7\x73\x10\xd2\x42\xe7\xbf\xaf\x8d\x99\xe0\xb1\x61\xad\x0d\x9d\xf1\x86\x65\x0c\x34\xbd\xaf\x04\xe0\x38\xc0\x73\xe3\x95\xa1\xc3\x6d\x58\x46\x23\x16\x56\x84\x15\x48\x65\x6c\x5e\x19\x33\x0a\x51\xe8\x62\xb5\xd0\x54\x09\x10\x53\x41\x9c\xe8\x65\x53\xa7\x9e\x49\x38\x53\x98\x32\x10\xf2\xf7\x7f\xbf\xf8\x8f\xa9\xc5\x5d\x5d\xfd\xd1\x94\xf7\x87\xf1\xf2\x4f\x33\xb3\xd5\xd8\xfc\x79\x5e\xc1\xb8\xf0\xf5\xaf\xa1\xd6\xc8\xbc\x82\x0e\x42\x0b\x19\x4a\xa4\x60\x34\xf4\x94\x51\xfd\x27\x16\xf8\xb3\x70\x12\x90\x44\x94\x60\x69\xa1\xf5\xfa\xd1\x22\x46\x94\x79\x05\x1f\x2a\x01\xcc\xab\xab\x2e\xf2\xa2\x81\xed\xae\x61\x94\x36\x57\x72\xb8\xa0\x41\x28\xc6\x8a\x2c\x86\xb5\x30\xb4\x07\xe2\x74\x28\x1e\x8d\x72\xc3\x85\xe7\x07\xfe\x94\xae\x5a\x86\xef\xa3\x20\x95\xc0\x0a\xe6\xab\x12\x80\x3c\x8f\x3c\x20\x02\xb0\x82\x26\xe2\x4d\x6a\x01\x92\xa7\x82\x40\x05\xbf\x88\xc6\xb4\xdc\x02\x0a\x98\x21\xe6\x62\x65\x21\xe3\xcd\xbb\x5f\x46\xd4\xd8\xae\x08\xf8\x5f\x0a\xf2\x10\xed\xeb\x1d\x69\x9b\x4d\xdb\x50\x97\x9d\xa0\x94\x52\xdf\x45\xf7\x0b\xed\x70\x8c\x4f\xe3\xfe\x84\xa2\x7b\x42\x98\x0a\xe2\xed\x1e\xaa\x7f\x7a\x89\x12\xb0\x09\xe1\x29\x53\xe3\xd6\xb2\xdc\x2b\xc7\x40\xa4\x52\x41\xd8\xb3\x3f\xa4\x2c\x8c\xc0\xcc\x37\x93\x11\x4e\x34\x7f\x01\x97\xfe\x51\x46\x55\x6f\xdb\x32\x77\x4a\x3b\x45\xfd\x2b\x2d\x47\x2a\x5e\x73\x26\x6b\x9c\x16\x3a\x3f\x51\xbd\x59\x6f\x47\x9b\xcd\x79\x85\x95\xf0\x38\xc6\x2c\xdc\x29\xd2\xaa\xba\xf7\x94\x75\xe5\xa2\xfe\x0d\x14\xe9\x96\x6e\x76\x33\x33\x20\xec\x10\xdc\x9d\x03\x03\x9d\x79\x9d\x9d\x69\x66\x8d\x77\xc9\xa3\x34\x86\x91\x06\xab\x16\xe9\x9a\x4b\x99\xac\xce\x7d\x06\x4e\x85\x06\xa1\x58\xf3\xe9\xed\xc5\x3a\x68\xc2\x21\x99\x4d\x98\x4e\x09\xcb\xe9\xeb\x29\xac\xff\xed\xb6\x30\xeb\xac\xa9\xa8\x19\xf8\x72\x1d\x21\x60\xcb\x36\x6f\x3f\xd9\x9f\xed\xa9\xed\xba\xd3\xfe\xc0\xab\x2c\x23\xb4\xc4\x51\x0a\x16\xea\x86\xbb\x3e\x70\x88\x7d\xe2\x06\x83\xc9\xd8\x6f\x63\x37\x3a\xfd\xaf\x78\x89\x4d\x06\xca\x4c\x04\xcc\x40\x0c\xdc\xe5\xcf\xbe\xc2\xe4\xe1\x4a\xef\x79\xa8\xd3\x4f\x25\x08\x73\xc1\x63\xb8\xea\xaa\x38\x41\xeb\xf5\xb1\x9c\xf9\x84\x97\x78\x92\x64\x07\x8f\xcd\xe6\xa9\x59\x8d\x72\x63\xbe\x67\xd6\x48\x19\x99\x59\xa8\x7d\x0d\xf3\xd5\x21\xfc\xbb\x04\x13\x10\x4a\x1e\xe1\x75\xb1\x94\xdf\xb8\x08\xaf\xc8\x02\xb3\x39\x50\xb5\x0d\x99\xd1\x82\xd8\xd8\x1e\x39\xbe\x6b\xf7\x9c\x7d\xb8\x3e\x0a\x1e\x57\x63\xa4\xff\x65\xc7\x19\x0f\x66\xcd\xef\xc5\x4a\x9e\x89\x65\x23\xcb\x8e\x33\x32\xc1\x04\x5a\x14\x3b\xe3\xbe\x3b\x19\x8c\x03\x7f\x1a\x38\x7e\x30\xf5\xef\x5c\x77\xe2\x05\x53\x67\x6c\x7f\x18\x3a\xfd\xb6\xe0\x9d\x2a\xe0\x8f\x80\x75\x1b\x93\x66\x00\x52\x15\x27\xbd\x46\x3d\x97\xca\x7b\x93\x71\xe0\x4d\x86\x43\xc7\xf3\xa7\x83\x71\xe0\x5c\x7b\xb6\xce\x99\x1f\xa2\x3d\xdf\xa9\x06\x4c\xc1\x5c\xe4\x67\xd2\x03\x46\xb8\x13\x3f\xb8\xf6\x1c\xff\xb7\xe1\xd4\xb7\x47\xee\xd0\xe9\x7f\x98\xba\xb6\xef\xff\x77\xe2\x1d\xb2\xa0\xd5\x80\x3e\x56\xf8\x1e\x4b\x30\x7d\x1c\x27\x11\x84\xf7\x65\x06\x1c\xf0\x7d\x38\x70\xc6\xc1\xd4\x0f\xec\xc0\x99\xda\x77\xc1\x8d\x33\x0e\x06\xbd\xdc\x7f\x7b\x78\x3d\xf1\x06\xc1\xcd\xa8\x4d\xbf\x71\x13\x63\xe2\xdf\xd8\x17\x6d\x79\x74\x4c\xea\xad\xf3\xe5\x71\xd9\x25\xf5\xe6\xad\x6e\x61\xd5\x9a\x61\xad\x2d\xa5\x93\xf3\xec\x11\x3f\xc0\xca\x42\x24\xa2\xc0\x94\xaf\xb0\x02\x3b\x55\x0b\x60\x8a\x92\x2c\x24\xb7\xb0\x3a\xe5\x83\x33\xee\x79\x5f\xdc\x47\xa0\x62\x3b\x7e\xb7\xf7\xa1\xd7\x75\x6f\x7b\xfe\x3b\x17\x87\x21\x65\x73\xe3\x09\xd2\xff\x0e\xe8\x38\x8c\x88\x55\xf2\x48\x64\x82\x41\x6b\x7a\x1a\xad\x79\x51\xad\xae\x9c\xbd\x77\xe3\xf4\x6e\xb3\xaa\xf3\x3e\xdb\xc3\x67\x95\x5a\xa5\xc8\xb2\x20\xf7\x16\x40\x1e\xf4\x47\xb1\xc4\xd1\x81\xaa\x9b\xb8\xce\xd8\xbf\x19\x7c\x0c\xa6\x23\x7b\x6c\x5f\x3b\x23\xed\xd8\x9d\x37\x9c\x7e\x9c\x78\x6f\xfd\x9e\x3d\x74\x9e\x65\xd2\x08\x33\x3c\x07\x7d\x4e\xbd\x13\xd1\x47\x2e\xde\xea\xbb\x17\x64\xb6\xec\xed\x0f\x76\x18\x72\x26\xcd\x4f\x18\xe6\x20\xf6\x2e\x67\x55\xa3\x3f\xd9\xce\xb5\xe3\x4d\xcb\x9e\xb9\xa5\x38\x21\xb2\xc7\xa3\xfc\x36\x71\x27\xe8\x66\xd3\xe6\x97\xb1\x5e\x3f\x8e\x79\x17\xdb\xbd\x7b\x40\x5d\xa0\x1e\x7d\x58\xdd\xed\xde\xd5\xf9\x9a\x49\xeb\x90\x52\x9a\x75\xf1\xf3\x9b\x5f\x2e\xbb\x38\xa1\x5d\x25\x30\x01\xd9\x90\xcc\x8e\x22\x90\x37\x4a\x6f\x1a\x7c\x71\x9d\x27\xfa\x93\x77\x47\x11\xac\x12\x68\xdf\x0a\x1b\x2a\x5c\xdb\xb3\x47\x7f\x4d\x87\x8b\x05\x8e\xb5\x92\x9d\x47\x87\x22\x75\xcb\xb0\xa2\x4b\x68\x8d\xfe\x4f\x68\x84\xc5\x03\x08\xa4\x16\x58\x21\x82\x53\x09\x12\x61\x24\x60\x77\x08\x42\x7c\x86\xd4\x02\xb6\x75\x8f\xf2\xba\x7f\x85\x24\xcf\xb9\xf4\x22\x83\x6f\x88\x64\x67\x8f\x34\xdf\x91\x10\x95\xfa\xf6\x16\x51\x08\x5b\x70\xb8\x1d\xdb\xc1\xe0\xb3\x73\x6c\x33\x6c\x19\x10\xd0\x59\xeb\x84\xa5\x0e\xf0\x67\x7b\xda\x77\x3e\xdc\x5d\x1f\x95\xf9\x08\x89\xe5\x91\x1e\xe9\x61\xc8\x5e\x32\x96\xab\x8f\x3e\xf0\x97\x3a\x1b\x22\xdc\x34\x8a\x5c\x1e\x51\xb2\xb2\x90\x1d\x7d\xc3\xab\xea\x91\x33\xa2\x4b\x60\x20\xa5\x2b\xf8\xfd\xf6\x0a\x97\xff\x74\x09\x5c\x83\x6a\xf6\xe8\xa4\x39\x2b\x2c\xff\x25\xd9\xa9\x29\x2b\x89\xe5\x45\x77\x99\x0f\xeb\x1a\x34\x5a\xe6\x0d\xe0\xb0\x76\xcc\xae\xc3\x6b\x13\x02\xc9\x7e\xaf\x2f\xe0\x3d\x57\xf0\x5d\x75\x93\x08\x53\x56\x6d\x8b\xf9\x9d\x8a\xe2\xa8\x0f\x11\x5e\xf9\x40\x38\x0b\xa5\x85\xde\xbe\xae\x1b\x99\x80\xa0\x3c\xdc\x2e\xbf\xa9\xaf\xce\x30\x8d\x52\x01\xc1\x42\x80\x5c\xf0\x28\xb4\xd0\xbb\xca\xba\x00\x1c\xd2\x27\x42\x95\x21\x62\x74\x17\x80\x23\xb5\x30\xda\x81\xbc\xb8\xbc\x38\xed\xc8\x45\xd5\xd2\xca\x8c\xb7\x84\x6e\x7b\x7b\xd9\x9b\xe4\xb6\xce\x73\x0f\xb1\x35\x6d\xc9\xd9\x62\x50\x82\x12\x79\x8c\xf3\xd7\xf7\xef\x7f\x6d\xe1\x4c\x04\x8f\x41\x2d\x20\x3d\xca\x7c\xf9\xfe\xfd\x65\x0b\xf3\x57\x1e\xf1\x07\x8a\x2b\x2b\xdf\xb8\x78\xa0\x6c\xde\xa7\xe2\xe0\x15\xea\xd4\x3d\x34\x6f\x20\x9d\x9c\xec\xe0\xb5\xb1\x22\xbb\x4b\x76\x13\xe1\xa7\x5c\x8b\x5a\x74\x57\xee\x9f\x87\x14\x1f\xba\xaf\x22\x54\x4f\xc4\x09\x8b\x56\xd9\xa5\xba\xed\x2a\x8b\xd0\x4f\xc8\x07\x85\x7e\xe3\x3e\x22\x11\x96\x12\x29\x8e\x8c\xeb\x14\x0b\xcc\x14\x40\x68\xa0\x17\xf9\x90\x08\x5d\x5d\x6d\x87\x40\x2f\x6b\xec\xc1\x82\x4a\x14\x72\x90\xec\x5c\x65\xb0\x23\xce\xd0\xc4\x9f\x20\x2c\x75\xa3\x16\x90\xf5\x5e\x34\xa3\xdf\x21\x44\x59\x37\xae\xb1\xeb\x79\x33\xca\x74\x68\xd5\xe5\x90\x0a\xbd\xb8\x7c\xfd\x2f\x44\x52\x21\x80\xa9\x68\xf5\xd2\x44\xe7\xa5\xf6\x73\x2d\x8f\xce\x19\x17\x10\xe6\x0a\x2a\xf2\x5a\x86\x5c\xed\x83\xae\xea\x00\xeb\x54\xdb\xf4\x4a\xa1\xe6\x50\xdb\x69\x8e\xb2\x21\x59\xe3\xb4\xa5\x7f\x24\x49\x9f\x2e\xad\xe7\xde\xed\x89\x2a\x7d\x7d\xbe\xc5\x5e\x2e\xe9\x87\xd9\x5c\xca\x6b\x5a\x9d\x57\x49\xc5\xe0\x53\x55\x44\xca\x1a\xb0\xce\x4e\x1f\xea\x9f\x5b\x58\x8f\x19\x2d\x25\x82\x7f\x05\xa2\xa0\x36\xfb\x42\xa8\x70\xbc\xfe\xb1\x73\xc8\xfc\x16\x17\x0e\x94\xe6\x23\x44\xd4\xa3\x72\xd4\xd7\x96\xc0\x22\x44\x15\xc4\x7b\x29\xa4\x8d\xcf\x2e\x43\xc7\xa5\xdf\x42\x5b\xb2\xec\x76\xaa\x2d\x82\x26\x11\xaa\x1d\xe7\xa6\xcf\x10\x27\x6a\x95\x35\xe4\x75\xf5\x7d\xa5\x08\x2a\xe3\xaa\x6d\xe6\xbd\x7d\x9c\x2a\xde\x29\xf3\x31\x6e\xee\x7b\x2f\x1b\xf7\x1c\x3f\x93\x1d\x97\xde\xc9\xdf\xc3\x72\x41\xd9\x19\xb6\x82\x17\x4e\x15\x8f\xb1\xa2\xa4\xe8\x9e\xe5\xf7\xed\x96\xa4\xbb\x77\x85\xbe\xd3\x4c\xdb\xed\xca\xac\x71\xb7\xd5\x6f\x6b\x16\xaa\x3f\xad\x9d\xed\x45\xbf\xfd\x89\xad\x1a\x92\xed\xb4\x29\xeb\x08\xe6\x24\x01\xe6\xeb\x57\x3d\x37\x4f\xe5\x5d\xfc\x72\xd4\x06\x3b\x5f\x2b\xa0\x35\x1e\x3f\x5b\x1e\x08\x2b\xaf\x9f\x15\x9b\xf7\x5e\xc1\x5b\x4b\xf7\x6f\xfa\x7c\xb6\x7b\x05\x57\x78\x5e\x58\x56\x26\xaf\x51\xbc\x65\x9e\xb5\x05\xef\x68\xe8\x4e\x04\x6e\xbd\x06\x16\x6e\x36\x67\xff\x1f\x00\x52\x78\x1d\xcd\x60\x20\x00\x00"),
 		},
 		"/infrastructure/05-syndesis-cluster-security.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "05-syndesis-cluster-security.yml.tmpl",
 			modTime:          time.Time{},
 			uncompressedSize: 1957,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x54\xc1\x6e\xe3\x36\x10\xbd\xf3\x2b\x1e\xa2\x43\x5b\xa0\x52\xd1\x5b\xe1\x5b\x9a\x43\x51\xa0\x69\x8a\xa4\xc8\x9d\xa2\xc6\x16\xd7\x14\x87\xcb\x21\xed\xf5\x06\xf9\xf7\x05\x25\xd9\xf1\x3a\x4e\x76\x11\x60\x81\xc5\x9e\x04\x71\x86\x33\xef\xbd\x99\xc7\x4a\x55\xf8\xd3\xfa\xce\xfa\x95\x60\x6b\x9d\x43\x4b\xd0\x21\x38\x4b\x1d\xda\x1d\x52\x4f\xe0\x40\x51\x27\x8e\xf8\x59\x76\xbe\x23\xb1\x52\x1f\x8e\xb4\x31\x9c\x7d\xfa\x45\x55\x48\x0c\xed\x1c\x6f\x9f\xee\xf8\x0e\x42\x71\x63\x0d\xed\xf3\xa4\x04\x85\x10\xa2\xdd\x58\x47\x2b\x92\x06\xff\xf7\x56\xc0\xc6\xe4\x28\xaa\x42\x97\xa3\xf5\xab\xb1\x86\xf5\x92\xb4\x73\xe0\xe5\x71\x49\x69\x54\x01\xfd\xb7\x07\xc7\x8e\x22\x96\x1c\x91\x4a\x89\xc4\x90\x6c\x0c\x51\xf7\xeb\x67\xb0\x7f\x92\x53\x14\x18\xb2\x24\xf4\x7a\x43\x05\x76\x4f\x10\x3d\x1c\x63\x42\x4f\xde\x10\x6c\x82\x15\xb4\x9c\x7d\x57\x8a\x97\xc4\x48\xef\xb3\x15\x9b\x08\xc6\x65\x49\x14\x11\xd9\xd1\x09\xee\x8b\x55\xd4\x3e\x5d\xc0\xf0\x30\x14\x0d\x38\x62\x65\x37\xe4\xc7\xe0\x51\x97\x59\xde\xab\xbb\xfb\xf9\xf6\x8c\xe6\xe6\x9f\xeb\x3d\xf7\xc2\x75\xa4\x7b\x5d\x10\x97\xd1\xe0\x6a\x6a\x7c\xcb\x8e\xe6\xc1\x41\xcb\xc4\x62\x3f\x9d\x42\x97\xe2\x29\x6b\x55\x61\xab\xc7\x11\x30\x9c\x95\x84\xb5\x5e\xea\xb5\x40\x9b\xc8\x22\xf0\x7a\x20\x09\xda\x14\x36\xaa\xc2\xbf\x9c\xa8\x51\x15\x2e\x71\xdc\xa9\x9d\xbf\x65\xd6\xc7\x48\xa6\xd5\x61\xef\x76\xaa\x3a\xda\x82\x40\x71\xb0\x22\x96\xbd\x40\xa7\xf1\xe8\xd0\xa6\x16\xc3\x81\xe0\x68\x43\x4e\x55\xaa\x86\x0e\xf6\x9e\x62\x49\x5e\x20\xb6\xda\x34\x3a\xa7\x9e\xa3\xfd\xa8\x93\x65\xdf\xac\xff\x90\xc6\xf2\x6f\x9b\xdf\x15\xb0\xb6\xbe\x5b\x9c\x51\x42\x01\x03\x25\xdd\xe9\xa4\x17\x0a\xc0\xd8\x6d\x71\x2a\x4c\xfd\xf0\xd0\xdc\x04\xf2\x77\xbd\x5d\xa6\xff\x22\xbf\x23\x93\x1e\x1f\xeb\xb5\x5e\xae\xf5\x78\xcb\xe9\x96\x9c\x4c\x15\x50\xdc\xf0\x54\x62\x3e\xdb\xff\x16\x44\x5f\x8a\xa7\x5d\xa0\x05\xac\x5f\x46\x2d\x29\x66\x93\x72\xa4\x33\x69\x86\x87\xc0\x9e\x7c\x7a\x86\x57\x01\x92\xdb\x82\x72\xc4\x54\xcf\xf4\xef\xa6\xf1\x5e\xce\xd3\x7d\x91\xee\x21\x32\xca\xbe\xc0\x59\xf6\x0a\xe3\x26\xdf\xd2\x72\xa2\xfd\x4c\xe1\x57\xe4\x7c\x12\x4e\x07\xfb\x57\xe4\x1c\x5e\x19\xe0\x1b\xd6\x39\xe4\xd6\x59\x53\x73\x29\x17\x22\x7f\x28\x3b\x76\xea\xe8\x5e\x8f\x7b\x1d\x69\x63\x69\x8b\xc4\x6b\xf2\xb2\x7f\x38\xb2\x50\x44\x31\xe2\xf8\x4c\x4c\x99\xaa\x9a\x9e\x9c\xfd\x5a\xce\x76\x3e\x5e\xca\xe6\xc7\xf5\xc1\xac\xe8\x41\xd0\x17\x1c\x51\xe2\x75\x47\x8e\x56\xe5\x1d\xfd\xbe\xac\xf1\x7c\x29\xde\xea\x92\x73\x95\xbe\x91\x61\xce\x08\xfa\x35\x96\xf9\x14\x00\x00\xff\xff\xee\x35\xd1\x6f\xa5\x07\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x54\x4d\x6f\xdb\x38\x10\xbd\xf3\x57\x3c\x44\x87\xdd\x05\x56\x5a\xec\x6d\xe1\x5b\x36\x87\xc5\x02\x4d\x53\x24\x45\xee\x23\x6a\x6c\xb1\xa6\x38\x2a\x87\xb2\xeb\x06\xf9\xef\x05\x65\xf9\xa3\x8e\x93\x16\x01\x0a\x14\x3d\x19\x16\x87\x33\xef\xbd\x79\x8f\x85\x29\xf0\xaf\x0b\x8d\x0b\x0b\xc5\xda\x79\x8f\x9a\x41\x7d\xef\x1d\x37\xa8\x37\x48\x2d\x43\x7a\x8e\x94\x24\xe2\x77\xdd\x84\x86\xd5\x69\xb9\xff\x44\xd6\xca\x10\xd2\x1f\xa6\x40\x12\x90\xf7\xb2\x3e\xdc\x09\x0d\x94\xe3\xca\x59\xde\xd5\x69\x3e\x54\x46\x1f\xdd\xca\x79\x5e\xb0\x56\x78\xdf\x3a\x85\x58\x3b\x44\x35\x05\x9a\x21\xba\xb0\xc8\x65\x70\x41\x13\x79\x0f\x99\x1f\xb7\xd4\xca\x64\xd0\xff\x07\x48\x6c\x38\x62\x2e\x11\x29\xb7\x48\x02\x1d\xac\x65\x6e\xfe\x3c\xd4\x27\x89\xbf\xe9\x29\x0a\x74\x83\x26\xb4\xb4\xe2\x0c\xbb\x65\x28\x75\xc7\x98\xd0\x72\xb0\x0c\x97\xe0\x14\xb5\x0c\xa1\xc9\xe4\x72\xcf\xc8\x1f\x07\xa7\x2e\x31\xac\x1f\x34\x71\x44\x14\xcf\x27\xb8\x2f\x16\x91\x42\xba\x80\x95\xae\xa3\xd0\x40\x22\x16\x6e\xc5\x61\x3c\x3c\x9a\x32\xc9\x7b\x75\x77\x3f\xdd\x9e\xd0\xdc\xbc\xb9\xde\x71\xcf\x5c\x47\xba\xd7\x19\x71\xcd\x20\x5c\x6d\x07\xdf\x8a\xe7\x69\x71\xa0\x51\x56\xec\xb7\x93\xe9\x72\x3c\x65\x6d\x0a\xac\x29\xa4\x51\x28\xef\x34\x61\x49\x73\x5a\x2a\xc8\x46\x51\x45\xa0\x8e\xb5\x27\x9b\xd9\x98\x02\x6f\x25\x71\x65\x0a\x5c\xe2\x78\x52\x3d\xfd\x26\xf9\x1a\xc9\xd6\x3a\x12\xfc\xc6\x14\x47\x2e\xe8\x39\x76\x4e\xd5\x49\x50\x50\x1a\x3f\xed\xc7\x94\x6a\xa5\x67\x78\x5e\xb1\x37\x85\x29\x41\xbd\xbb\xe7\x98\x8b\x67\x88\x35\xd9\x8a\x86\xd4\x4a\x74\x9f\x29\x39\x09\xd5\xf2\x1f\xad\x9c\xfc\xb5\xfa\xdb\x00\x4b\x17\x9a\xd9\x19\x25\x0c\xd0\x71\xa2\x86\x12\xcd\x0c\x80\x91\xd4\xec\x54\x98\xf2\xe1\xa1\xba\xe9\x39\xdc\xb5\x6e\x9e\xde\x45\xf9\xc0\x36\x3d\x3e\x96\x4b\x9a\x2f\x69\xbc\xe5\xa9\x66\xaf\xdb\x0e\xc8\x69\x38\xb4\x98\xbe\xed\xfe\x66\x44\xdf\x3a\x4f\x9b\x9e\x67\x70\x61\x1e\x49\x53\x1c\x6c\x1a\x22\x9f\x29\xb3\xd2\xf5\x12\x38\xa4\x27\x78\x0d\xa0\x43\x9d\x51\x8e\x98\xca\x89\xfe\xdd\x76\xbd\x97\xd3\x76\x9f\xa5\xbb\x17\x62\x94\x7d\x86\xb3\xec\x0d\x46\x27\xdf\xf2\x3c\x8f\x38\xa3\xf0\x0b\x72\x1e\x84\xa3\xde\xfd\x17\x65\xe8\x5f\x58\xe0\x2b\xec\xdc\x0f\xb5\x77\xb6\x94\xec\x87\x3e\xca\xa7\xec\xb1\xd3\x44\xb7\x39\x03\x82\xc8\x2b\xc7\x6b\x24\x59\x72\xd0\xdd\xc3\x31\x28\x47\xe4\x20\x8e\xcf\xc4\xb6\xd2\x14\xdb\x27\x67\x67\xcb\x29\xce\xc7\xa6\xac\x7e\xdd\x1c\x4c\x8a\xee\x05\x7d\x26\x11\xf9\xbc\x6c\xd8\xf3\x22\xbf\xf5\x3f\x57\x34\x9e\x9a\xe2\xb5\x29\x39\xd7\xe9\x07\x05\xe6\x8c\xa0\xdf\x13\x99\x2f\x03\x00\xee\x35\xd1\x6f\xa5\x07\x00\x00"),
 		},
 		"/infrastructure/05-syndesis-security.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "05-syndesis-security.yml.tmpl",
 			modTime:          time.Time{},
 			uncompressedSize: 8283,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x59\x4d\x93\xf2\x36\x0c\xbe\xf3\x2b\x3c\x7b\xec\xb0\x30\xbd\x75\xb8\xb5\x97\xde\xdf\xce\xf4\xd2\xe9\x41\x38\x22\xa8\x38\x96\x6b\x2b\xd0\x7d\x7f\x7d\x27\x09\x01\xe7\x03\xc8\x06\x36\xcb\xce\x7b\x22\x91\x85\x2d\x3d\xfa\xc0\x8f\x78\x55\xe0\xe8\x4f\xf4\x81\xd8\xae\x94\x5f\x83\x5e\x40\x2e\x5b\xf6\xf4\x1d\x84\xd8\x2e\x76\xbf\x84\x05\xf1\x72\xff\xf3\x4c\xa9\x1d\xd9\x64\xa5\xbe\xb1\xc1\x99\x52\x19\x0a\x24\x20\xb0\x9a\x29\xa5\x94\x85\x0c\x57\x2a\xbc\xd9\x04\x03\x85\x57\x4c\x48\xd8\x97\x2b\x06\xd6\x68\x42\xa5\xa5\x14\x38\x77\x56\x3b\xca\xea\xd7\xe2\x9c\x5b\xeb\xf2\xe6\x70\xa5\xc8\x6e\x3c\x04\xf1\xb9\x96\xdc\x63\x8f\x9a\xe6\xcc\xb1\x45\x2b\x91\x4d\x01\xfd\x1e\x0b\x9b\x7c\x6e\xb0\x34\xa8\x74\xfe\x77\xcf\xb9\x3b\xda\xf7\xaa\x34\x64\x68\x16\xe0\x40\x6f\x71\xc1\x3e\x2d\xc5\x1e\x03\xe7\x5e\xe3\x49\xeb\xe5\xa7\x97\xf2\x69\x8f\x7e\x1d\x56\xea\x2f\x95\xa2\xcc\x95\xa1\x20\x73\xa5\x3d\x82\xe0\x5c\xe5\x2e\x29\x3f\x13\x34\x78\xfe\xd4\x6c\x0c\xea\x02\xd9\xb9\x3a\x80\xe8\xad\xfa\xbb\xdf\x90\x97\x97\xfe\xa3\x1d\x27\xe1\xf8\x58\x38\x44\x1a\xeb\x57\xb4\x89\x63\xb2\x52\xbf\xbb\x22\xaa\x41\xd0\xca\x9e\x4d\x9e\xa1\x36\x40\x59\xbd\xa8\xd9\x6e\x28\xcd\xc0\x9d\x37\xd3\x1e\xa5\xb5\x35\x68\xcd\x79\xbd\xe3\x27\x38\xeb\xd1\x19\xd2\x65\x22\x6a\xb6\xe2\x8b\xfd\x7c\xb8\xba\xb8\x0c\x1a\x0c\x3e\xca\xe0\xb9\x72\xd7\xec\x06\x77\xc4\xaf\x63\x79\x02\x98\xb1\x0d\x67\x44\x13\x74\x86\xdf\x32\xb4\x7d\x92\xc8\xe8\x93\x5f\xd1\x77\x23\x49\x43\x33\x08\x08\x6e\x72\x13\xa9\xc6\xa2\x49\xa1\xc0\xff\x04\x6d\xd1\x45\x1e\x0f\x88\x45\x39\xb0\xdf\x39\x36\xa4\x09\x7b\x40\xb9\x01\xd3\x13\x24\xca\xd1\x05\xb2\xe9\xb1\xa1\xf6\x83\x44\x36\xf5\x18\x02\x3e\xac\xe0\x6e\x98\x75\xa9\xee\xd6\x64\x13\xb2\xe9\xa9\xb3\xec\xa3\x28\x19\xca\x48\x3c\xd8\xf4\x14\x89\xa2\xf7\x07\x07\x1a\xc3\xb2\x48\xbf\x3c\x44\xad\x6a\x69\x38\x8d\x5f\x1b\x0a\x97\x02\xd3\xd4\xa9\x6c\xfb\x37\x67\x81\x7e\x61\xfc\x85\x3e\xcc\x86\xb4\x9e\x57\xb5\xce\xc9\x24\x0b\x76\x68\xc3\x96\x36\x72\x31\x46\xa5\x5e\xd5\x3e\x43\x8f\x68\x79\xc0\xf5\x96\x79\xd7\x58\x9b\x38\x9e\xe3\x9c\x59\x92\x0d\x02\x56\x08\x04\x6f\x2c\xaf\xc9\x82\x7f\x6b\x78\xb8\xd4\x86\x6d\xab\x9c\x2a\xe7\x1e\x6b\x6c\x58\x26\x28\x40\xa6\x05\x69\x85\xdf\xa3\x8f\xaa\x93\x77\x7c\x56\x15\xbf\x10\x03\xce\x3b\xb7\xbe\x66\x66\x75\xe4\x8d\xc6\xd6\x5d\xdd\x90\x05\x43\xdf\xeb\x1f\xc9\x09\x33\x6e\xa4\xa3\x45\xcd\xaf\x41\xef\x2e\x3b\xdc\xcd\xca\xae\x4e\xbd\xcb\xa8\xf4\x1b\x1b\xa2\xa8\xb5\xf5\x84\xe9\x11\x2d\x89\x32\x48\x71\x80\x69\xa5\x5e\x10\x8f\x70\xba\xdf\x45\xa2\xea\xb1\x2b\xcf\xc0\xb9\xa8\xc9\xc7\x9b\x2c\x9b\xb7\xc1\x68\x49\x20\x9d\x3c\xb5\x46\xc0\x40\x99\x63\xdf\xbe\xb7\x0e\xcc\x87\x31\xa8\xdf\x15\x6f\xcf\xb9\x0c\x39\xb0\xd4\x9b\x1c\x7d\xc1\xcc\x19\x18\x64\xa0\xf3\xac\x8b\xeb\x4b\x52\x7f\x27\xb4\xf6\x68\x36\xb7\x5a\x5a\x55\xb8\xee\x68\x3f\xf7\xaf\xa6\xe1\x8f\xa8\x84\xd9\x48\x42\xfe\x5b\x75\x63\xbb\xc5\xcb\x2b\x0e\xfc\xdc\xf4\x3c\xe4\xeb\x7f\x50\xcb\x91\xa1\x57\x2e\xfe\x51\xf1\xd1\x5f\x2b\x3e\x7a\xd9\xb3\x82\xdd\xb3\xc1\x6f\xb8\xa9\x3c\x6a\x4c\x2c\xae\x4d\x29\xea\xa4\xb8\x02\xf9\x6c\x74\x70\x6e\x45\x65\x4f\x78\xc0\x09\xc3\x71\x6d\x00\x72\x89\x12\x74\x06\x06\xef\x1a\x37\x44\x53\x8b\xfb\x38\x7d\xff\x68\xa2\x35\x0b\xa9\x2a\xf2\xb8\x92\xa2\x9c\x98\x4b\xa8\x1f\xcb\xaa\xfb\xb1\x38\xd1\x48\x38\xbe\xd8\x88\x63\xa4\x97\x5f\x7f\x7a\x31\xd2\xf1\x3b\x06\x13\xf7\xd5\xd7\x74\x84\x7b\x52\x3b\xdb\x9c\xf1\xde\xc3\x3f\x90\x3f\x7e\xae\x81\xef\x63\x4f\xf7\x5a\x39\x8a\x42\xbc\x9b\x36\x75\x45\x2d\xba\xf4\x09\x6e\x3c\x0e\xcc\xd1\x34\xe5\x5e\xaf\x9f\x83\x81\x4c\xda\x46\x5a\xe4\xe2\xf6\xd9\x1f\xcc\x1b\x9c\xe7\x0c\x65\x8b\xf9\xf4\x97\xd5\xb6\x5a\x1f\x77\x38\x9b\x37\x9a\x3f\x34\xb6\x78\x07\x87\x88\xe0\x18\xc4\x21\x3e\x88\x42\x9c\xe8\xcf\x13\x45\x65\xd0\x1f\xae\xd1\x26\xfd\xf5\x70\xed\xbf\xd6\xc3\x94\xac\xf9\x49\xc1\x9d\x8e\x2e\x47\x30\x0c\x49\xf5\xff\x03\x00\x00\xff\xff\xef\x3f\x97\x0b\x5b\x20\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x59\x4d\x93\xf2\x36\x0c\xbe\xf3\x2b\x3c\x39\x76\xf8\x98\xde\x3a\xdc\xda\x4b\xef\x6f\x67\x7a\xe9\xf4\xa0\x38\x22\xb8\x38\xb6\x6b\x29\x6c\xf7\xfd\xf5\x9d\x7c\x81\x13\x02\x84\x10\xb2\xbb\xf3\x9e\x48\x24\xd9\x96\x1e\xc9\x8a\x1f\xb3\x12\xe0\xd4\x9f\xe8\x49\x59\xb3\x15\x3e\x06\xb9\x86\x9c\xf7\xd6\xab\xef\xc0\xca\x9a\xf5\xe1\x17\x5a\x2b\xbb\x39\xfe\xbc\x10\xe2\xa0\x4c\xb2\x15\xdf\xac\xc6\x85\x10\x19\x32\x24\xc0\xb0\x5d\x08\x21\x84\x81\x0c\xb7\x82\xde\x4d\x82\xa4\x68\x85\x89\x62\xeb\x4b\x8d\x86\x18\x35\x55\x56\x42\x80\x73\x67\xb3\x5a\xd6\xbc\x16\xeb\xdc\xd3\xf3\xbb\xc3\xad\x50\x66\xe7\x81\xd8\xe7\x92\x73\x8f\x3d\x66\xd2\x66\xce\x1a\x34\x7c\x9e\x6c\x45\xe8\x8f\x58\xf8\xe4\x73\x8d\xa5\x43\x65\xf0\xbf\x7b\x9b\xbb\xda\xbf\x95\x90\x90\xa1\x5e\x83\x03\xb9\xc7\xb5\xf5\x69\x29\xf6\x48\x36\xf7\x12\x4f\x56\xd1\x4f\x51\xa9\x38\xa2\x8f\x69\x2b\xfe\x12\x29\xf2\x52\x68\x45\xbc\x14\xd2\x23\x30\x2e\x45\xee\x92\xf2\x37\x41\x8d\xe7\x5f\x69\xb5\x46\x59\x20\xbb\x14\x6f\xc0\x72\x2f\xfe\xee\x77\x24\x8a\xfa\x97\x76\x36\xa1\xfa\xb1\x08\x48\x49\x6c\x5e\xd1\x24\xce\x2a\xc3\xcd\xbb\x2b\xb2\x4a\x8c\x86\x8f\x56\xe7\x19\x4a\x0d\x2a\x6b\x94\xd2\x9a\x9d\x4a\x33\x70\x8d\x80\x50\x7a\xe4\xce\xd4\x20\xa5\xcd\x9b\x19\x3f\x20\x58\x8f\x4e\x2b\x59\x16\xa2\xb4\x86\x7d\x01\x9e\xa7\x9b\xca\x0d\x49\xd0\x38\x95\xc3\x4b\xe1\x6e\xf9\x0d\xce\x51\xbf\xe7\x09\x60\x66\x0d\x9d\x11\x4d\xd0\x69\xfb\x9e\xa1\xe9\x93\x04\x4e\x9f\xe2\x0a\xc6\x06\x92\x96\x25\x31\x30\xee\x72\x1d\x98\x86\xa2\x59\xa1\xc0\xff\x18\x4d\xd1\x45\xa6\x07\xc4\x20\xbf\x59\x7f\x70\x56\x2b\xa9\xb0\x07\x94\x4b\x49\x6b\xfc\x27\x28\x94\x3a\x04\x65\xd2\xba\xa1\xf6\x83\xa4\x4c\xea\x91\x08\x69\x26\xb7\xae\xed\xbb\x58\x99\x44\x99\xb4\x01\x16\x8f\x41\x96\xb4\xca\x14\x7b\x30\xe9\x29\x13\x45\xef\x27\x07\x12\x69\x53\x94\x5f\xde\xc8\x8b\x56\xb5\xd1\x36\x0d\x5f\x5b\x06\xd7\x12\xd3\xb6\xa9\x0a\xe9\xdf\xdc\x32\xf4\x0b\xc3\x01\x7d\x98\x0d\x69\x3d\x2b\x11\xe7\x4a\x27\x6b\xeb\xd0\xd0\x5e\xed\xf8\x6a\x8e\x4a\xbb\xaa\x7d\x52\x8f\x68\xf3\x86\xf1\xde\xda\x43\x4b\x37\x73\x3e\xc7\x05\xb3\x51\x86\x18\x0c\x2b\x60\xbc\xa3\x8e\x95\x01\xff\x1e\x1a\xd1\x46\x6a\x6b\x3a\xdb\xa9\x0a\x6e\x5a\x67\x69\x93\x20\x83\xd2\x1d\x48\x2b\xfc\xa6\x5e\xaa\x29\xde\xbe\xcc\x0d\xab\xaa\xe2\x0b\x31\x60\xbd\x73\xeb\xab\xd1\xbe\x26\x6f\x35\xb6\x4b\xed\x4e\x19\xd0\xea\x3b\xfa\x0e\x3c\xaf\xaf\xb8\x91\x81\x16\x9f\xf4\x18\xe4\x81\xae\xe8\xfb\xaa\xf2\xd2\xa6\x99\x65\x54\xf9\x8d\x4d\x51\xd0\xda\x2e\x75\x93\xb4\x24\x95\x41\x8a\x03\x5c\x2b\xed\x88\x3d\x42\x46\x97\xa2\x4a\x7b\x29\xcf\xc0\xb9\xa0\xc9\x07\x1a\xda\xb4\x4f\x83\x81\x8a\x21\xbd\x1e\xd5\x8b\x4a\x6b\x04\x0c\x2a\x73\xd6\x73\xc7\xd3\x81\xf5\x30\x06\xf5\xa7\xf2\xed\x6d\xce\x43\x16\x2c\xed\x66\x47\x9f\x31\x73\x1a\x06\x39\xe8\xbc\x95\xc5\xf1\x25\x69\xc6\x50\x67\x8e\x7a\x77\x74\xa4\xd5\x0e\x97\xd8\x95\xcf\x1e\xea\x43\x5f\x07\x6d\x5f\xb1\x13\x16\x23\x09\xf9\x6f\xd5\x89\xed\x1e\x2f\x2f\x28\x23\xfa\xcf\x4d\xcf\x29\x8f\xff\x41\xc9\x35\x43\xaf\xee\x1c\xfe\xa8\xf8\xe8\xaf\x15\x1f\xbd\x1e\x59\xc1\xee\xad\xc6\x6f\xb8\x2b\x46\x77\x6e\x2c\x6e\xdd\x52\x34\x45\x71\x03\xf2\xc5\xe8\xe4\xdc\xcb\xca\x51\xe1\x1b\xce\x98\x8e\x5b\x17\x20\x51\xd4\x5f\xf3\x17\x17\x06\x0f\x5d\x37\x04\xb7\x16\xfd\x87\xfe\xa1\x54\xad\xff\x6a\xe2\x24\x0d\x77\x64\xad\x49\x91\xeb\xa7\xa2\x77\xd4\x8f\x65\x5b\xf8\xb1\x38\xd1\x48\x38\xbe\xd8\x15\xc7\xc8\x28\xbf\xfe\xed\xc5\xc8\xc0\x9f\xb8\x98\x18\xb9\x62\x14\x85\x5f\xd1\xa1\x5f\xdb\xf6\xc9\xe1\x21\xc2\x3d\xab\x9f\xe7\xcd\x3d\xcd\xe2\x63\xc9\xc9\x35\xf9\x54\x75\xf3\xb4\x83\x41\x13\xec\x71\x72\xa2\xe6\xf5\xcc\x99\x7e\x04\x6d\xba\x4b\x97\x3e\x20\x8c\xe9\xc0\x1c\x4d\x53\x46\xae\x17\x45\x1d\x3e\xf0\xb1\x0c\xe4\xd9\x28\x9e\x21\x17\xf7\xd7\x7e\x31\x6f\x70\xde\x66\xc8\x7b\xcc\xe7\x3f\xac\x0e\xe1\x0e\x67\xf7\x46\xf3\x87\xd6\x14\x0f\x70\x88\x00\x8e\x26\xf7\x37\xe0\x7f\x19\x85\x38\xd1\x9f\x4f\x94\x95\x41\x7f\xb8\x06\x93\x3c\xfe\x5f\x6b\x73\xad\xf2\xe2\xea\xff\xd4\xe0\xce\x47\x97\x4f\x63\x86\x95\xfa\xff\x03\x00\xef\x3f\x97\x0b\x5b\x20\x00\x00"),
 		},
 		"/infrastructure/06-syndesis-prometheus.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "06-syndesis-prometheus.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 6802,
+			uncompressedSize: 7169,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x59\x6d\x6f\x13\x3b\x16\xfe\x9e\x5f\x71\x54\x2a\xb5\x88\x26\x2d\x20\xd0\x32\xab\x0a\x41\xd8\x45\x2b\xb5\x34\x4b\x58\xbe\xb0\xdc\xd1\x89\xe7\x34\x31\xf5\xd8\xbe\xb6\xa7\xb7\xd1\x30\xff\xfd\xca\xf3\x16\x27\x9d\x90\x26\xb4\x12\x42\x33\x52\x12\xfb\xf8\xf1\x73\x5e\x7d\x66\xd2\x07\xd4\xfc\x33\x19\xcb\x95\x8c\xe0\xfa\x69\x0f\xe0\x8a\xcb\x24\x82\xa1\x92\x97\x7c\x7a\x8e\xba\x07\x90\x92\xc3\x04\x1d\x46\x3d\x00\x00\x81\x13\x12\xb6\xfa\x0e\x80\x5a\x47\x60\xe7\x32\x21\xcb\x6d\x3d\xd6\xfc\x1c\x70\x75\xbc\x69\xde\xcd\x35\x45\xc0\xe5\xa5\x41\xeb\x4c\xc6\x5c\x66\xa8\x43\x8c\xa9\x54\x2b\x49\xd2\x2d\xc0\xfa\xda\xa8\x94\xdc\x8c\xb2\x0a\x57\x62\x4a\x9d\xb3\x7d\x56\xea\xd2\x03\x58\x28\xb1\x98\x1d\xcc\x53\x11\xc1\xf7\x7e\xbd\xe9\x54\xa8\x09\x8a\x46\x3b\x00\xcb\x0c\x6a\x8a\xb9\x74\x64\xae\x51\x44\x7e\x0c\x5e\x34\x9a\x00\xd0\x35\x8a\x0c\x1d\x57\x32\x90\x79\x61\x7b\xbd\xa5\xe5\x15\x83\xd6\x68\x00\x7d\xf8\xa6\x26\x71\x45\x79\xc1\xa5\x9d\x06\xb0\x0e\x1d\x67\xb7\x17\xfa\xab\x0f\x0e\xcd\x94\xdc\xca\xb0\x9f\x10\x8a\xa1\x98\x29\xeb\xa2\x57\x27\xaf\x4e\x1a\x16\xfe\x4a\xc9\x19\xce\x62\x43\xa5\xff\xba\x80\xfb\x60\x55\x66\x18\xc5\xb5\x87\xe1\x4b\x5c\x32\x8c\xe3\xaf\x81\x14\x80\xa1\x29\xdd\x44\x30\x55\xf1\xe1\xe0\xc9\xe3\xa5\x29\x64\xde\x12\x11\x24\x46\xe9\xdd\x91\x67\xce\xe9\x87\xc2\x96\xe4\x1e\x0a\x5a\x1b\xc5\xc8\xda\x07\x84\xaf\xc3\xe4\xa1\x76\x70\x36\x99\x6c\xc0\xee\x0c\x60\x1f\xf8\x53\x53\x26\x41\x5f\xab\xa4\x0d\x7e\x7f\x5f\x65\x13\x32\x92\x1c\xd9\xd8\x26\xdd\x51\x67\x94\xa0\x08\xb4\x4a\x82\xd1\x2a\x9d\xad\x46\x46\x4b\xd2\xed\xcc\xea\xa0\x07\xca\xf3\xc1\x85\x26\x39\x9e\xf1\x4b\x37\x32\xea\x1b\x31\x57\x14\x21\x99\x2d\x83\xdf\xd7\xbd\x38\x50\x40\xab\x24\x46\x29\x95\x4f\x4d\x25\xe3\xc0\x21\x5c\xc5\x55\xa1\xf8\xda\x69\xba\x2b\x22\xdd\x69\x70\x93\xd1\x0e\x1c\x4a\x8a\x71\x53\xe9\x62\xae\x62\x37\xdf\x76\xeb\xc0\x67\x3b\x30\x58\x6b\x05\x8d\x6e\xd6\x4d\xc4\x90\x16\xc8\x42\x75\xa1\x2e\x63\x95\xba\x11\x94\xca\x1a\xce\x6c\x89\x12\xc7\x5d\xb4\x57\xa2\xb3\x8b\x2f\x26\x89\xf1\x69\x18\x1f\xc1\xb6\xe4\x95\x71\x77\x27\xdf\x30\xfa\xf2\x47\xf4\xf5\xc9\xe3\xc3\xd7\x51\xf4\xff\xe4\xc9\xe3\xd7\xff\x3c\xf4\x1f\x2b\x92\xe5\xea\xb4\x3c\xbe\xf6\x9f\x46\xfb\xcf\x7e\x68\x85\x56\x81\x40\xaa\xdf\x52\x29\xc5\x52\xec\x74\x6a\xb7\xbe\xe5\x8a\xd5\xbc\xfe\x19\xc0\xc0\x80\x87\x4d\x14\x6e\xf6\xcb\x2a\x52\x9b\xe0\xbb\xc6\x4b\x17\xd6\x96\x1c\xbc\x36\x9e\xc7\x3d\x50\x68\xa0\x1e\xf2\xc8\xfd\x96\xde\x6c\xa8\xcf\xbb\x43\x5f\xa7\xbf\xee\xb9\xe8\xcb\xdb\x11\x74\x6f\x62\x49\xa3\x41\xa7\x4c\x04\x07\xd1\x41\xd7\xfe\x4c\x49\x47\x37\x2e\x3a\x54\x66\x1a\xa3\x46\x36\xa3\x98\x61\x4a\x22\xfe\xd7\x0d\x9b\xa1\x9c\x92\xfd\xa4\x1c\x8a\xef\xeb\xe7\xff\x8d\x5c\x50\xf2\x9d\xab\x45\xd5\xad\x10\xc6\x0e\x8d\xfb\xc4\x53\xb2\x0e\x53\xdd\x21\x70\x86\xd6\x35\x30\x43\x95\x6a\x41\x8e\x92\xbb\x2e\xf0\xdb\x66\x86\x5a\xf1\x6e\xf3\x95\x25\xbe\xb7\xb6\x93\x1f\x93\xb9\xe6\x8c\x6e\xf5\xf1\x6b\xfb\xe5\x5f\xb8\xcb\xb7\x9a\x58\xdd\xc0\x2b\xd3\xf4\xbf\xfd\xba\xf5\x5f\xd1\xa0\x92\x89\xe0\x1f\x27\xcd\x4f\xa3\x9c\x62\x4a\x44\xf0\x69\x38\xaa\xc7\xaa\x74\x1e\x95\x82\x65\xc7\xec\x47\x2d\x09\x62\x3e\xa2\xee\x49\xfb\xcd\x6a\x39\x74\x59\xad\x8d\x50\x98\xbc\x45\x81\x92\x91\x89\x20\x2f\xd6\x3b\x76\xe4\x9d\x6d\x1d\x49\xf7\x59\x89\x2c\xa5\xa1\x40\x9e\xfe\x66\x6e\x46\xe6\x5b\xea\x73\x95\x34\x1d\x5f\xd9\xe5\x8d\x1b\xb0\x61\x83\x64\x07\xa3\x16\x60\xf0\x91\xaa\x02\x62\x07\x95\x61\xde\xb4\x20\x45\x51\xa2\x9a\x46\xa0\x51\xdc\xd0\x9f\x19\xd9\xf0\x81\xca\x3a\x65\x70\x4a\xd1\x0e\xdb\x0d\x7d\x0d\xe1\x6e\x5e\x14\xbd\x3c\xef\x03\xbf\x84\x6d\x11\xc6\xd5\xe6\x43\x81\xd6\xd6\x94\x6b\x3e\xe5\xd0\x07\x4c\x77\x22\xb6\x02\xeb\xc9\x91\x4c\x76\xa7\xe9\x79\xd4\xf4\xae\xdb\x81\x5d\x88\xd5\x40\x79\xfe\x53\x74\xce\xca\x58\x2e\x8a\xce\x1c\x4e\xd1\xb1\xd9\xd9\x52\xb4\xfb\x6d\x8c\x2f\xb3\xb0\x7f\x45\xf3\x23\xd8\xf7\x8f\xf2\x04\xd1\xe9\xcf\xed\xec\xaf\x3c\x2f\x31\xa1\x28\xbc\x39\x1a\xe4\x56\xa0\xb6\x3c\x74\x3a\xe1\x1d\x69\xa1\xe6\xbe\x59\xb4\xc5\x6a\xf6\xa3\xd6\xf6\x38\x28\x01\x0b\xd9\x72\x39\x09\x4b\x9d\x6b\x06\x4a\x93\xb4\xfe\xb9\xc8\xe7\x5f\x27\x40\xf5\xc2\x27\xe0\xf3\x7b\x15\x12\xdf\xcb\x71\x86\x36\x82\xa7\x2b\xf1\xd1\x6d\xf7\xb5\x51\xd3\xad\xdc\x66\xf5\xb6\x62\x1e\x38\xf3\x7e\x0c\x7a\x97\xfd\x7e\x10\x85\x25\x9c\x33\xe8\x68\x3a\x6f\x0c\x51\xf9\xe8\x23\x31\x43\xe8\x28\x8c\xbf\x3b\x48\x37\x65\xb7\xce\xa3\x46\x0a\x40\xf0\x94\x87\x75\xd8\x47\x61\xaa\xcc\x3c\x82\xbd\x67\x2f\x5e\x9e\xf3\xbd\x76\xe6\x76\xcd\x0e\x65\x4f\xbc\x68\x18\xcc\x00\x8e\x52\x2d\xd0\x51\xb3\x60\x39\xbc\x6f\x07\xf1\x7a\x5f\x6f\xb6\xfd\x16\x01\xbd\xa5\xab\xea\x05\x6d\x60\xfb\xdb\x56\x2d\xde\x1b\xc6\x54\x26\xdd\x87\x1f\x26\xaa\xbf\x7c\x47\x8c\x5c\x92\x09\x74\x5d\xdb\x45\xf9\x9b\xa7\xe5\x59\x78\x90\xe7\x1b\x6b\xe3\x7f\xbc\x28\x14\x45\xd8\x8a\x97\xcb\x47\x99\x10\x23\x25\x38\x9b\x47\xf0\x46\xfc\x85\xf3\x70\x03\x34\xcb\x0f\x46\xfe\xa0\x3f\xe8\xd7\x6f\x6e\x07\x97\x5c\xd0\xe9\x31\x39\x76\xbc\x60\x17\x7c\xf5\xaf\x70\x97\x3b\xff\x72\x71\x7d\x64\x0e\xfc\x6b\xad\x81\x21\xdf\x28\x71\x25\x4f\x9f\x9f\x24\xa1\xb0\xe0\xd7\x24\xc9\xda\x91\x51\x93\x36\x34\xaa\xdb\xbf\x87\x7c\x4f\x6e\x79\xb0\x69\x2b\xdb\x6e\xb1\xb9\xb8\xe4\x8e\xa3\x78\x47\x02\xe7\x63\x62\x4a\x26\x36\x82\x97\xa1\x4c\xd0\xb3\x36\x34\x5b\x4f\xac\xb4\xa0\x4d\x88\x63\xc2\x1f\x8e\xdc\xf3\x50\xe6\x11\xbc\x7b\x0b\xff\x55\x63\x60\xbe\x3d\x00\x6e\x61\xef\x7d\x86\x06\xa5\x23\x4a\xf6\xe0\xb0\x49\x37\x38\x3d\xad\x93\x34\x7c\x1a\x79\x04\x1f\x94\xa3\x08\x2e\x24\x5c\x8c\x2f\xc0\xcd\xc8\x90\xc7\x90\x0a\x16\x28\x15\xf4\x11\x70\x67\x01\xcb\x08\x80\x49\x66\xac\xc3\x89\x08\x93\xa1\xa3\x2a\x74\x57\x86\x30\xe3\xf3\x7c\x8b\x53\xfb\xcc\xf3\x1f\x9c\x97\x4b\xeb\xe2\xb0\xb8\x98\xce\x76\x82\x1b\x8e\xfe\xb7\x82\xd5\x55\xa1\x76\xe6\xfc\xb1\x02\xbb\x3f\xd6\x0d\xe0\x2a\xef\xaa\x95\x3b\xf7\x95\x64\x89\x79\x53\x20\x3a\x0a\x4b\xdf\x97\xd1\x40\x14\x20\xf5\xcb\x47\xe8\x66\x11\x04\x89\x7a\x47\xb4\xf6\xff\x9a\x6e\xbc\xe5\x3a\xd0\x8a\x55\xbc\x03\xca\x5b\x10\xd6\x5d\x8f\x52\xa1\xf2\xe0\x63\x97\xa7\x9b\x4b\xeb\x56\x7a\xb1\xe6\x4f\xb6\xe5\xad\x36\x22\xd4\x87\xb4\x54\xae\xeb\xa0\x76\x86\x4f\xa7\x6d\x6d\xef\xd7\x47\x6f\xd5\xdf\x0d\xcb\xf7\x19\xbd\x3c\xef\x03\xc9\xa4\x28\x7a\x7f\x0f\x00\x0e\x65\x74\xec\x01\x1c\x00\x00"),
+		},
+		"/infrastructure/07-syndesis-autoscalers.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "07-syndesis-autoscalers.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 2445,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x59\x6f\x6f\xdb\xbe\x11\x7e\xef\x4f\x71\xc8\x02\x24\x41\x6b\x27\xfd\x15\x2d\x56\x0d\x41\xd1\xba\x5b\x31\x20\x69\xbc\xba\xeb\x9b\xae\x13\xce\xd4\xc5\x66\x42\x91\x1c\x79\xca\x62\xb8\xfe\xee\x03\xf5\xcf\x92\xad\xfc\x73\x13\xac\x68\xde\xc4\x26\x8f\xcf\x3d\xe4\xdd\x3d\x3c\xc9\x7d\x40\x2b\xbf\x92\xf3\xd2\xe8\x08\xae\x5e\xf4\x00\x2e\xa5\x4e\x22\x18\x1a\x7d\x2e\xa7\xa7\x68\x7b\x00\x29\x31\x26\xc8\x18\xf5\x00\x00\x14\x4e\x48\xf9\xe2\x33\x00\x5a\x1b\x81\x9f\xeb\x84\xbc\xf4\xe5\x58\xf5\x75\x20\xcd\xe1\x5d\xf3\x3c\xb7\x14\x81\xd4\xe7\x0e\x3d\xbb\x4c\x70\xe6\xa8\xc3\x4c\x98\xd4\x1a\x4d\x9a\x57\x60\x7d\xeb\x4c\x4a\x3c\xa3\xac\xc0\xd5\x98\x52\xe7\x6c\x5f\xe4\x7b\xe9\x01\xac\x36\xb1\x9a\x1d\xcc\x53\x15\xc1\x8f\x7e\xe9\x74\xaa\xcc\x04\x55\xb5\x3b\x00\x2f\x1c\x5a\x8a\xa5\x66\x72\x57\xa8\xa2\x7c\xf0\x95\xaf\xe7\xe9\x0a\x55\x86\x2c\x8d\x6e\xd8\xbc\xf2\xbd\x5e\x6b\x79\xc1\xc0\xaf\x60\xfb\x70\x61\x26\x71\x41\x79\x6d\x1f\xe5\x42\x46\x96\x62\x73\x61\xb1\x98\xd1\x4d\x89\xd7\x86\xc3\x84\x32\x02\xd5\xcc\x78\x8e\xde\x1c\xbd\x39\xea\x35\xe6\x53\x62\x27\x45\xec\x28\x8f\x5f\x17\x70\x1f\xbc\xc9\x9c\xa0\xb8\x8c\x30\x7c\x8b\x73\x86\x71\xfc\xbd\xe5\xc7\xd1\x94\xae\x23\x98\x9a\x78\x7f\xf0\xec\xa0\x35\x85\x82\xf3\x44\x4a\x9c\xb1\xdb\x23\xcf\x98\xed\x53\x61\x6b\xe2\xa7\x82\xb6\xce\x08\xf2\xfe\x09\xe1\xcb\x34\x79\x2a\x0f\xec\x93\xc9\x1d\xd8\x9d\x09\x1c\x12\x7f\xea\xf2\x22\xe8\x5b\x93\xf8\x66\xda\x5d\x66\x13\x72\x9a\x98\x7c\xec\x93\xee\xac\x73\x46\x85\x2a\x30\x49\xcb\x6d\x80\xf6\x16\x05\x6d\x64\x79\x3e\xb3\x3e\x18\x80\x16\x8b\xc1\x99\x25\x3d\x9e\xc9\x73\x1e\x39\x73\x41\x82\x97\xcb\x26\x99\x07\x26\x7f\xd0\xbd\xb8\xb1\x01\x6b\x92\x18\xb5\x36\x5c\xd4\x7b\x23\x20\xd2\xc4\x45\xa5\x7f\xef\x3c\xba\x4b\x22\xdb\x79\xe0\x2e\xa3\x2d\x38\x14\x9b\xa8\x94\x2e\x38\x0f\x2a\xfa\x30\xd7\x8d\x98\x3d\xe6\x29\x58\xe4\x59\x37\x11\x47\x56\xa1\xa0\xd6\x5c\x21\x63\x85\xb3\x08\x72\x57\x4e\x0a\x9f\xa3\xc4\x71\x17\xed\xb5\xec\xec\xe2\x8b\x49\xe2\x42\x19\xc6\xcf\xe1\xa1\xe4\x8d\xe3\xfb\x93\xaf\x18\x7d\xfb\x77\xf4\xfd\xd9\xc1\xfe\xdb\x28\xfa\x57\xf2\xec\xe0\xed\x5f\xf6\xc3\xbf\x35\xcb\x7c\x75\x9a\x5f\x5f\xbb\x2f\xa2\xdd\x3f\x6e\x3d\x85\x7a\x03\xad\x8d\x56\x54\x72\xb3\x14\x3b\x83\x7a\x5b\xba\x6c\x9c\xdc\xf6\x80\x8d\x03\xdc\xaf\x2f\xe9\x3b\xe3\xb2\x8e\x54\x17\xf8\xb6\xf9\xd2\x85\xb5\x45\x2e\x87\xb5\x8f\x40\xa1\x82\x7a\xca\x2b\xf7\x22\xbd\x7e\x2a\xed\xbf\xb8\x4a\x7f\xdd\x7b\x31\xc8\x5b\xa8\xe6\x2e\x27\x9e\x2c\x3a\x64\xe3\x22\xd8\x8b\xf6\xba\xfc\x0b\xa3\x99\xae\x39\xda\x37\x6e\x1a\xa3\x45\x31\xa3\x58\x60\x4a\x2a\xfe\xeb\xb5\x98\xa1\x9e\x92\xff\x62\x18\xd5\x8f\x9b\xe7\xff\x86\x52\x51\xf2\x23\xc8\x7c\xa5\xba\x85\xc5\x98\xd1\xf1\x17\x99\x92\x67\x4c\x6d\x87\xc1\x09\x7a\xae\x60\x86\x26\xb5\x8a\x98\x92\xfb\x2e\x08\x6e\x33\x47\xb5\x79\xf7\xf1\xe5\x12\xdf\xbb\xb1\x93\x1f\x93\xbb\x92\x79\x16\xb7\xfb\xf8\x1b\xfb\xe5\x5f\xb8\xcb\xf7\x96\x44\xd9\xc0\x1b\x57\xf5\xbf\x7d\xb8\xa1\x8f\x0e\x36\x11\xfc\xf9\xa8\xfa\xea\x0c\x1b\x61\x54\x04\x5f\x86\xa3\x72\xac\x28\xe7\x51\x6e\x98\x77\xcc\x45\x4a\x29\x12\x21\xa3\x1e\x69\xf7\x77\x6f\x8b\x91\xb3\x72\x37\xca\x60\xf2\x1e\x15\x6a\x41\x2e\x82\xc5\xf2\xe6\xc0\x8e\xc2\x98\x67\xd2\xfc\xd5\xa8\x2c\xa5\xa1\x42\x99\xfe\x66\x61\x46\x11\xa4\xe3\xd4\x24\x54\x07\x7b\xb1\x18\x8c\x2b\xb0\x61\x85\xe4\x07\xa3\xd5\x13\xdd\x67\x2a\x04\xc4\x0f\x8a\x83\x79\x57\x83\x2c\x97\xbd\x42\x18\x4a\x83\x6a\xe3\x8e\xfe\x93\x91\x6f\x3e\x50\x79\x36\x0e\xa7\x14\x6d\xe1\x6e\x18\x34\x44\xf2\x7c\xb9\xec\x2d\x16\x7d\x90\xe7\xf0\x50\x84\x71\xe1\x7c\xa8\xd0\xfb\x92\xb2\x6f\x0c\x7d\xca\x43\xfa\x70\x62\x6b\xb0\x81\x1c\xe9\x64\x7b\x9a\x81\x47\x49\xef\xaa\x1e\xd8\x86\x58\x09\xb4\x58\xfc\x14\x9d\x93\x3c\x97\xab\xf3\x5a\xab\xe1\x14\x59\xcc\x4e\x5a\xd9\x1e\xdc\xb8\x20\xb3\xb0\x7b\x49\xf3\xe7\xb0\x1b\x1e\xe5\x09\xa2\xe3\x9f\xf3\x5c\x40\xe7\x98\xb0\x5c\x46\xf9\xe7\x02\xb9\x36\x28\x4f\x1e\x5a\x41\x68\xd7\x39\x5a\xeb\x07\xc6\x92\xf6\xe1\x69\x26\x54\x4d\xa3\xf2\x3f\x90\x55\x66\x1e\xfa\xc9\x61\xf5\x6a\xe3\x77\x2a\xfa\xd0\x77\x49\x81\x3e\x82\x17\xff\x1f\x3d\x0e\xe5\xe6\x90\x69\x3a\xaf\x5c\x16\x9b\xfc\x4c\xc2\x11\x32\xd5\xaa\xb1\x26\x23\x00\x4a\xa6\xb2\xfd\x5e\x26\xa5\xd4\xb8\x79\x04\x3b\x7f\xbc\x7a\x7d\x2a\x77\xea\x99\x4d\xc9\x69\xda\x1e\x55\xa6\x4c\xa9\x55\xc8\x54\xe7\x71\x2b\xce\x9b\xd1\xbc\xe9\x7c\xee\x73\x46\x0f\x88\xec\x16\x47\xda\x8c\x70\x11\xd5\xbc\x2f\x79\x27\x84\xc9\x34\x7f\xba\x35\x63\xc3\x5f\x68\xe3\x50\x6a\x72\xad\xd7\x68\xb7\xbc\x42\x93\x69\x2e\xe0\x7b\x8b\xc5\x9d\x05\xfd\xf7\x60\x0a\xcb\xe5\xde\xfa\xf2\x51\xa6\xd4\xc8\x28\x29\xe6\x11\xbc\x53\xff\xc5\x79\xd3\x01\xba\xcd\x37\x73\x7b\xfd\xf2\x75\xe3\xe0\x5c\x2a\x3a\x3e\x24\x16\x87\x2b\x76\x87\xed\xf7\x8e\x7b\x9b\x8b\x4b\x9d\x1f\xb0\x4f\x26\x03\x47\xe1\x76\x97\x46\x1f\xbf\x3c\x4a\x9a\xc6\x4a\x5e\x91\x26\xef\x47\xce\x4c\xa8\x4d\x61\xc6\x6c\x3f\x12\xaf\xbf\x1f\xb1\xed\x16\xa7\xde\xa4\x96\x2c\x51\x7d\x20\x85\xf3\x31\x09\xa3\x13\x1f\xc1\xeb\xa6\x4d\xa3\xd1\xaa\x68\xd6\x91\x18\x75\x81\x3a\xc2\x44\x3e\x1d\xb9\x97\x4d\x9b\x3f\xc1\x87\xf7\xf0\x0f\x33\x06\x11\xee\x34\x90\x1e\x76\x3e\x66\xe8\x50\x33\x51\xb2\x03\xfb\x55\x91\xc1\xf1\x71\x59\x9a\x07\xad\xd5\x9f\x0c\x53\x04\x67\x1a\xce\xc6\x67\xc0\x33\x72\x14\x30\xb4\x81\x15\x4a\x01\xfd\x1c\x24\x7b\xc0\x3c\x03\x60\x92\x39\xcf\x38\x51\xd4\xda\xf5\x86\x16\x40\xa7\x1e\x40\xa3\xce\xef\x91\x99\xab\xab\xe6\x24\x40\x0d\x4e\xf3\xa5\x8d\xab\xa6\xf8\x13\x36\xdb\x0a\x6e\x38\xfa\xe7\x1a\x56\x97\x2e\x6d\xcd\xf9\x73\x01\xf6\x78\xac\x2b\xc0\x75\xde\x45\xff\x71\x1a\x94\x64\x2d\x59\x6f\xfe\x59\x20\xc8\x68\x8b\x50\x1a\x96\x8f\x90\x67\x11\x1c\x76\x2a\xca\x6d\x68\xf5\x8f\x0c\xdd\x78\x6d\x1d\xe8\xb5\x79\x77\x28\xda\x3d\x08\xdb\xae\xfe\xbf\x1d\x36\x11\x86\xee\x96\xd6\x07\xed\x4b\x54\xbf\x0c\xb5\x5d\xdd\x0b\x81\x9d\x9c\x4e\x6b\x05\xef\x97\xd7\x6a\xd1\xc4\x0c\xf3\x47\xde\xde\xff\x02\x00\x00\xff\xff\x2c\x92\xb2\x56\x92\x1a\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x54\xcd\x6e\xdb\x3c\x10\xbc\xeb\x29\x16\x4e\x0e\xdf\x07\xd4\x0a\xea\xa3\x80\x1e\x0c\xb7\x40\x2f\x06\x0c\x27\x71\xcf\x6b\x6a\x25\x2d\x2a\x91\x2c\x97\x72\xe2\x18\x7e\xf7\x42\x7f\x96\xe2\xbf\x5c\x1a\xa0\x28\x72\x93\xc8\xe1\x70\x66\x56\x9a\xdd\x6e\x0c\xb7\x68\x79\x45\x4e\xd8\x68\x88\xbe\xc0\x08\x4b\x6f\x44\x61\xce\x3a\xbd\xdb\x4c\xd6\xe4\x71\x32\xda\xef\x83\x0a\xca\x09\x84\x53\xcb\xf7\xe4\x36\xe4\xc2\x69\x0b\x24\x27\xab\x49\x8b\x18\x92\x9d\x70\x75\x34\xa4\xe3\xf6\xe9\x89\x7d\x06\xe1\xfd\x56\xc7\x24\x2c\xe1\xcc\x14\xd6\x68\xd2\x5e\xc2\xa3\x3b\x58\xa7\xed\x91\x4a\xc3\x37\x8d\xeb\x9c\x2a\x92\x31\xf4\x17\x46\xb0\xdb\xbd\x72\xb3\xdf\x07\x00\x3f\x59\xc7\x11\x7c\x37\x8e\x5f\x8c\xf6\x98\x2f\x4c\xdc\x0b\x0f\x00\x0a\xf2\x18\xa3\xc7\x28\x00\x00\xd0\x58\x50\x04\xd2\x0a\x1a\x4b\xad\xa2\xde\xc9\x71\x4d\xb9\x34\x28\x00\xb4\xb6\x87\xb5\x6b\xdd\x6b\xc8\xe6\xee\xad\x7d\xbf\xb5\x14\x01\xeb\xc4\xa1\x78\x57\x2a\x5f\x3a\x3a\x03\x53\x5d\x20\xe7\x34\x89\x25\xd5\xe8\xa9\xcd\x3c\xa0\x4b\xc9\x2f\x29\x89\xba\x9c\x6e\xc3\xaf\x64\x73\xb3\x2d\xaa\x44\xeb\x30\x2a\xf0\x30\x30\xb4\x56\xee\x36\x9f\xdb\x9d\x26\xaa\xfe\x4c\xcd\x43\xb9\xd0\xc5\xb3\xa1\xb1\xa4\x25\xe3\xc4\x57\xa6\x2e\x12\xcd\x8c\x4e\x38\x1d\xcc\x1e\xe0\x7a\xd8\x05\xeb\x25\xd9\x9c\x15\x4a\x3d\xd5\x70\xde\x2f\x40\x7b\xbe\xc0\xe7\x23\x0c\x3e\x9f\x60\xc8\x3b\x56\x72\x48\x24\x6c\x42\x9a\x2d\x1e\x1f\x3d\xe7\xfc\x82\x9e\x8d\x5e\x90\x53\xa4\x3d\xa6\x9d\xcf\x31\x34\xe3\x59\x92\x98\xd2\xa9\x6e\x30\xae\x7d\xed\xbe\x81\xce\x80\xb2\xe5\x61\xc5\xd7\xfc\x3d\x02\x5a\xaa\xc1\x75\x83\x3d\xdc\x90\xc3\x94\x06\x9b\x8d\x93\xeb\x2a\xe1\xe4\x37\xea\x9d\xcd\xa9\x30\x6e\xfb\x07\xcd\x15\x35\xe1\xbb\xf8\xbb\xa2\xf5\xd4\xe2\xf1\xd3\x4d\x70\x03\x0f\x19\x81\xeb\x26\x6e\x92\xfa\x57\x06\xc9\xd0\x11\xb0\x17\xd8\x98\xbc\x2c\xe8\x13\x3c\x65\xac\x32\x28\x4a\xf1\xe0\x33\xd2\xb0\x26\x58\x12\xc6\x3f\x1c\x7b\x9a\xa3\xde\x06\x37\x6f\x74\xd1\x9c\x3c\x9e\x6f\x22\xd4\xf1\xa1\x8d\xe0\x3f\xfa\x05\xb7\x97\x09\xba\xc0\x25\x5c\xd5\xc2\xa6\x4a\x91\xc8\xdc\xc4\x04\xa3\x57\x7a\x46\xff\xbf\x7f\xb1\x55\x51\xfd\x5d\xb5\xd6\x2a\xfa\xd7\x4a\xed\x10\xf4\x47\xa5\x7d\x54\xda\xf5\x4a\xfb\x3d\x00\x44\x01\x99\x24\x8d\x09\x00\x00"),
+		},
+		"/infrastructure/07-syndesis-disruption-budgets.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "07-syndesis-disruption-budgets.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 1498,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x94\x4f\x8b\xdb\x30\x10\xc5\xef\xfe\x14\x83\xe9\xd5\x5a\x7c\x35\xf4\x90\xfe\x81\x1e\x1a\x28\xbb\x34\x3d\x8f\xa5\xd9\x64\xa8\x2c\xa9\xfa\x13\x62\x8c\xbf\x7b\x71\x6c\xe3\x04\x0c\xd9\x4b\xc0\x47\x8f\x9e\x9e\x7e\x8f\xc7\xb8\xeb\x0a\xe0\x77\x10\x6f\xad\x51\x14\x38\x88\x1f\x7c\x3c\xed\xce\xc8\x1a\x6b\xd6\x1c\x5b\xf1\xdd\x60\xad\x49\xf5\x7d\x36\x68\x3f\xa1\xe3\x03\xf9\xc0\xd6\x40\xf5\x19\x72\x67\x35\xcb\xf6\xe5\x5c\xd6\x14\xb1\xcc\x27\xd5\xe0\xb8\x73\xfc\x46\xfe\x4c\x5e\xfc\xb2\xea\x1b\x07\x9f\x5c\x64\x6b\xbe\x24\x75\xa4\x18\x0e\xe5\x8a\xe1\xad\xdf\x6c\x45\x66\x78\xba\x80\x45\x56\x41\xd7\xdd\x5d\xeb\xfb\x0c\xe0\x2f\x1b\x55\xc1\xca\x53\x19\x40\x43\x11\x15\x46\xac\x32\x00\x00\x83\x0d\x55\x10\xa6\xc0\x45\xe2\xeb\x54\x63\x4d\x3a\x8c\x0a\x00\x74\x6e\x91\x4c\xb3\xf9\x53\xb0\x7d\x79\x74\x1e\x5b\x47\x15\xb0\x79\xf7\x18\xa2\x4f\x32\x26\x4f\x2b\x32\x69\x1b\x67\x0d\x99\xb8\x98\x8d\x3c\xc1\x91\x1c\x59\x1a\xbc\xfc\x36\x38\x16\xa2\xa9\x82\xf2\x3a\x0d\xa4\x49\x46\xeb\x67\xde\x06\xa3\x3c\xfd\xbc\x8b\xb0\x1e\xe2\x71\x8c\x0f\x11\x3e\xb1\x0f\x8b\x29\x9e\x9c\xb7\x97\x76\x5b\xbd\xdc\x71\x6d\xba\x9f\x1b\xd2\x69\x19\xe9\xdf\xcd\x86\x7f\x9d\xaf\x04\xb1\xa7\x88\xe2\x95\x82\x4d\x5e\x52\x10\x07\xab\x53\x43\x3b\x29\x29\x84\xbd\x55\x04\xf9\x2b\xa1\xfa\xe3\x39\xd2\x1e\x4d\x9b\x3f\x77\x11\x87\xc3\x6d\x55\x3e\x11\x6d\xba\xec\x2b\xe3\xf2\xa3\xec\xba\x02\xc8\xa8\xbe\xcf\xfe\x0f\x00\x97\x4d\x52\xae\xda\x05\x00\x00"),
+		},
+		"/infrastructure/08-syndesis-network-policies.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "08-syndesis-network-policies.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 4802,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x97\xcb\x6e\xdb\x3a\x10\x86\xf7\x7a\x8a\x01\xbc\x8d\x7c\x6c\x9c\x20\x71\xb4\x2b\x8a\x2c\x82\x16\x85\x81\x04\xdd\x53\xd4\xd8\x22\x2c\x71\xd8\x21\x95\x44\x35\xf4\xee\x85\x2e\x76\x14\xd5\xa9\x2f\x85\x0b\xa6\x5d\x52\x43\x93\x1f\x89\x6f\x68\xfc\xeb\x75\x08\x6a\x01\xe3\xfb\x52\x27\x68\x95\x1d\x7f\x41\xf7\x44\xbc\x9a\x53\xa6\xa4\x42\x3b\xbe\xd5\x22\xce\x30\xa9\xaa\x60\x14\x8c\xe0\xf6\xd9\x90\xc5\x04\xe2\x12\x5c\x8a\xc0\x54\x38\xbc\x00\xe2\x66\xa4\xf4\x92\xd1\xda\x8b\x66\x40\xa2\x70\x69\x68\x98\x9e\x4b\x10\x52\xa2\x71\xb6\xfe\x1e\x8c\x40\x92\xd6\x28\x9d\x22\x6d\x81\x16\x2f\xeb\xb0\x85\xa7\x54\x38\x7c\xc4\x66\x39\xc5\xa0\x45\x8e\xd6\x08\x89\xc1\x28\x08\x41\x18\xf5\x15\xd9\x2a\xd2\x11\xe8\x96\x52\xe9\xe5\x78\x35\xb3\x63\x45\xff\x3d\x4e\x03\x80\x95\xd2\x49\x04\xfd\x23\x94\x01\x40\x8e\x4e\x24\xc2\x89\x28\x00\x80\x66\xd1\x08\x6c\x77\xde\xb0\xe1\x6c\x30\x9b\x6a\x26\x62\xcc\x6c\x3b\x13\x40\x18\xf3\x32\xb5\xfb\xb6\x19\xd6\x9b\xee\xab\xbb\xd2\x60\x04\x4a\x2f\x58\x58\xc7\x85\x74\x05\xe3\x8e\x69\x92\x72\x43\x1a\xb5\x7b\x8b\xcb\x1a\x94\x2d\x93\xa1\xe4\x1e\x33\x94\x8e\x78\x03\x99\x0b\x27\xd3\xcf\xaf\xb8\x77\x93\xef\x67\x3f\x12\xab\xc6\xc9\x94\x2c\x1f\x4a\x83\xdd\xde\x21\xdc\xb5\x16\x34\xa3\xce\x88\x4d\xc9\x10\xbb\x2d\x63\x08\x86\xc9\x91\xa4\x2c\x82\x87\x8f\xf3\x60\x63\xe2\xa7\x22\x46\xd6\xe8\xd0\x56\xd5\x16\xab\xfe\x65\x04\x97\xd3\xd9\xa4\x99\x87\x99\xc5\x9f\xaa\xb3\xcb\xcb\xff\xdb\xaa\xae\x75\x3d\x97\x30\x85\xf2\x4b\x94\x42\x79\x25\x48\xa1\x4e\x10\x63\xc1\x94\x6f\xd0\xc2\x5d\x47\x78\xf3\x18\x87\x41\x9f\x60\x36\xfc\xda\xd6\x81\x7a\x93\xd9\xe4\x6c\xc2\x59\xe4\x47\x64\xbf\xa4\xdb\x32\xf9\x23\x5e\xef\x9a\xfe\x02\xf9\xfe\x30\x88\xd2\x0e\x97\x2c\xea\x3f\x65\xcf\xec\xaf\x8b\x7e\xb9\xdf\x11\xf9\x63\xfe\xf6\x8a\xde\x9f\xf7\xbd\xa6\xf5\x47\x39\xc3\x94\xa3\x4b\xb1\xb0\x7e\x89\xf7\x8a\xcb\x1f\xfd\x06\xd7\xf5\xef\x48\x78\x33\xb9\x99\x34\xa9\xe8\x21\xc5\xfe\x0b\x6a\x01\xeb\x98\xa4\xf4\x12\x84\x86\x0f\xf3\xbb\x3a\x20\x09\x78\xc2\x38\x25\x5a\x75\x69\xa8\x8e\x38\xfd\x28\x14\x8c\x06\x61\xe8\xa2\x0b\x41\x39\x3a\x56\xd2\x82\x60\x04\x2b\x59\x98\x36\x7e\xed\xba\xfe\xf3\xa5\xa4\xfe\xe9\xbc\x68\x8a\x1e\xd0\x49\xcd\xb0\x8f\xee\xc0\x8d\x8f\xd5\xfd\xd8\x27\xce\x9f\x26\x19\x74\xf9\x71\x8d\x72\x7d\x7d\xb3\xcd\x77\x73\xa6\xa4\x90\x4e\x7d\xc7\x33\xa4\xb4\x98\x69\x85\x1c\x8a\xfc\xdb\x19\x2c\xc5\x67\x91\x9b\x6c\xb8\xcb\xef\xb9\xb7\x7b\xcd\x77\xf4\x8a\x0e\xfb\xe1\x28\x31\xae\xa6\x57\xd3\xab\x83\x67\xf6\xd3\xfd\x7a\x1d\x02\xea\xa4\xaa\x82\x1f\x03\x00\x1b\x6c\x20\xae\xc2\x12\x00\x00"),
+		},
+		"/infrastructure/09-syndesis-truststore.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "09-syndesis-truststore.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 1535,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x53\xdf\x6f\xdb\x36\x10\x7e\xd7\x5f\xf1\x55\x36\xd0\x78\x09\xad\xed\xd5\x45\x0a\x38\xae\xd7\x25\x58\x52\x20\x71\xf3\xd2\x0d\x01\x2d\x9e\x6c\xd6\x12\x29\x90\x94\x3b\x43\xd1\xff\x3e\x50\x16\xa5\xda\x19\x30\xfb\x85\xba\x1f\xdf\x7d\xf7\xdd\x5d\x5d\x33\xc8\x0c\xd3\xa7\x83\x12\x64\xa5\x9d\xae\x4c\x65\x1d\x89\xc5\xfc\xa6\x52\x22\xa7\xe9\x42\xab\x4c\x6e\xee\x79\xf9\xc0\x0b\x6a\x9a\x68\x14\x8d\xf0\x99\x14\x19\xee\xc8\xc2\x6d\x09\x77\x7c\xcf\xe1\x7c\x9a\x75\xda\x10\x74\xd6\x9a\x2d\x99\x3d\x99\x2b\x14\xe4\xf8\x55\x6b\x91\xca\xd1\xc6\x70\x27\xb5\xc2\xba\x92\xb9\xb0\xd1\x08\x5c\x89\x73\xa7\xbd\x82\xae\x5c\xc0\x11\x94\xf1\x2a\x77\xd0\xaa\x87\xbe\x7b\xbe\xef\xf3\xda\xc2\x24\xa2\x11\x16\x73\xac\x5b\xce\x57\x28\x8d\xfe\x4e\xa9\x23\x01\x45\xff\x38\x38\x0d\xb7\x95\x16\x36\x35\xb2\x74\xd1\x28\x62\xe0\xa5\x7c\x26\x63\xa5\x56\x33\xec\x7f\x8b\x80\x9d\x54\x62\x86\xbe\xdb\x08\x2d\x71\xc1\x1d\x9f\x45\x00\x90\xf3\x35\xe5\xf6\xf8\x06\x78\x59\xce\x60\x3b\xcd\x3a\x5b\xf8\x9c\x4a\x9d\xfc\x9f\xdf\x1d\x4a\x9a\x41\xaa\xcc\x70\xeb\x4c\x95\xba\xca\x50\x0b\xa3\x78\x41\x43\x26\x1b\x64\x8d\x80\x81\xcc\xa6\x1b\xc0\x4f\xfe\xa9\xdd\xce\xf0\xca\xba\x5a\xa3\x77\xc9\x5a\xaa\xc4\x6e\x43\x6d\x72\x60\x14\x75\x5f\x47\x99\xae\xe3\x71\xbd\x7a\xfc\xfa\xb4\x5a\x7e\x7a\x59\xcc\x5f\x6e\xbe\x3e\x7c\xfa\x73\x39\x63\x09\xb9\x34\x09\x04\x92\x4e\x5e\x96\xf2\x24\xe5\xec\x98\x38\x4d\x8d\x6b\xe2\x0e\x6b\x60\xd0\xe3\x3d\xad\xbe\x3c\xfe\x37\x50\xcb\x34\x49\x79\x4a\xc6\xd9\x26\x0e\x7c\x3a\xc3\x75\x5b\xba\xdc\xc9\xe4\x3b\xdf\xf3\x10\xd6\xc5\xc8\x0c\xdf\xf0\x0e\x2c\x43\x3c\xae\x3b\x57\x13\xe3\xef\x0f\x7e\x0b\x54\x17\x04\xf8\xcc\x97\xad\x2e\x5a\x36\x77\xf3\xe7\xf9\xcb\x1f\x5f\xee\x97\x33\x36\xbe\x10\xd2\x78\x71\x11\x9f\x3c\x0d\x71\x91\x4b\xb5\x3b\x02\x5f\xa4\xba\x28\xfc\x66\xb1\x7d\x8b\x34\x89\xdb\x7f\xdf\xec\x40\x35\x1e\xd7\x7d\xa9\x26\xc9\xe5\x3a\xb1\x94\x56\x46\xba\x43\xe0\x1d\x72\x32\xd9\x3d\x8a\x9d\x90\x06\xac\x3c\x65\x50\x0f\xc2\x34\xf1\x24\x24\xa5\xe5\x69\x9b\x67\x71\x21\x6a\x5b\x68\x81\xea\xf2\xc7\x39\x4e\x10\x76\x84\x1d\x1d\x9c\xd6\x39\xb4\xca\x0f\x90\x45\xa9\x8d\x3b\x5e\x6d\x26\x8d\x75\xf0\xcd\xc8\x4c\xa6\xdc\xb5\xc7\xc5\x91\xc9\x9c\x02\xfa\xe0\xb3\xd7\xe3\x8b\x62\xe7\xa8\x28\xc1\xc4\xa4\xf3\xf3\x1f\x3b\xb0\x3d\x84\x34\x5e\xeb\x9f\x90\x6c\x13\xe3\x7d\xc2\xfc\xef\x66\xf9\xf9\xf6\x01\x8b\xe5\xe3\xea\xf6\xf7\xdb\xc5\x7c\xb5\xf4\x46\x96\xa0\x86\xba\xbc\x44\x03\x85\x8f\xf8\x15\x35\x4a\x23\x95\xc3\x47\xf8\xd1\x20\xf6\xab\x16\x43\x21\xf6\x9b\x16\x4f\xd0\xbc\xf7\x0d\x1e\x97\xaf\x6f\x3e\xd3\xe6\x84\xbe\x54\x78\x43\xc3\x03\xfd\xe2\x41\x3e\x40\xe8\x2e\x0f\xf8\x16\xb6\x68\x88\xf5\x9b\x84\xd7\x57\xa4\x5a\x39\xa9\xaa\x20\x01\x7a\xfd\xd8\x51\x3b\x0f\x0f\xa6\x74\x69\x74\x51\x3a\xb0\x76\x1e\x25\xb7\x16\xe9\x96\xab\x0d\x49\x07\xb6\xa3\x43\x6b\x3e\x1f\x0a\xfe\xea\x51\x01\xc6\x73\xc9\x2d\xe2\x70\x1f\x2c\x1c\xda\xf8\x62\xcd\x2d\x85\xe5\x38\xa5\xe8\x3b\x99\xc4\x60\x7e\x48\x6f\xbc\x1d\xb8\xd0\x2a\xb0\x37\x05\x98\x39\xef\xd4\x9f\x5d\x5d\x33\x90\x12\x4d\x13\xfd\x3b\x00\x96\x20\xf1\xae\xff\x05\x00\x00"),
 		},
 		"/install": &vfsgen۰DirInfo{
 			name:    "install",
@@ -302,7 +365,7 @@ var assets = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 167,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\x8b\x41\x0a\xc2\x40\x0c\x45\xf7\x73\x8a\x7f\x01\x2b\xba\xcc\xce\x85\x27\x10\xdc\xa7\x26\xc8\xa0\x4d\x87\x49\x2a\x48\x99\xbb\xcb\xd4\xee\x1e\xff\xbd\xcf\x25\xdf\xb5\x7a\x9e\x8d\xe0\x5f\x13\xf5\xec\x43\x9e\x8f\x9f\xd3\xa8\xc1\xe7\xf4\xca\x26\x84\xdb\x6e\xd2\xa4\xc1\xc2\xc1\x94\x00\xe3\x49\x09\x5c\x4a\xf2\xa2\x8f\xbe\xb0\xc8\x6c\xde\x69\x5d\x0f\xa8\x6c\x4f\xc5\x70\x35\x1e\xdf\x2a\x97\xcd\xa1\xb5\x04\x74\x3f\xb4\x46\x1b\x02\xfa\x2f\x08\x51\x17\xdd\xcf\x6a\xd2\x5b\x0f\x8e\xc5\x29\xfd\x02\x00\x00\xff\xff\x7a\x58\x98\xc9\xa7\x00\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\x8b\x41\xaa\x02\x41\x0c\x44\xf7\x39\x45\x2e\xf0\xe7\xa3\xcb\xec\x5c\x78\x02\xc1\x7d\xc6\x04\x69\x74\xd2\x4d\x27\x23\xc8\xd0\x77\x97\xe8\x50\x9b\xa2\xde\x2b\x6e\xe5\xaa\xdd\x4b\x35\x42\x7f\x9b\xa8\x17\x9f\x4a\xfd\x7f\x1d\x66\x0d\x3e\xc2\xa3\x98\x10\x5e\x76\x02\x8b\x06\x0b\x07\x13\x20\x1a\x2f\x4a\xc8\xad\x81\x37\xbd\xe5\xc2\x22\xd5\x3c\xdb\xb6\xfd\x61\x67\xbb\x2b\x4e\x67\xe3\xf9\xa9\x72\xfa\x32\x1c\x03\x10\x93\x4f\x63\xa4\x98\xd1\x9f\x41\x18\x7d\xd5\xfd\xac\x26\xe9\x7a\x70\xac\x4e\xf0\x19\x00\x7a\x58\x98\xc9\xa7\x00\x00\x00"),
 		},
 		"/install/cluster": &vfsgen۰DirInfo{
 			name:    "cluster",
@@ -313,42 +376,49 @@ var assets = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 736,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x92\xcf\x6a\xdc\x30\x10\x87\xef\x7a\x8a\x01\x1f\x7a\x5a\x97\xdc\x8a\x6f\x65\x43\xe9\x3f\x9a\x90\x85\xdc\x27\xf6\xac\x77\x12\x5b\x12\x33\xa3\x25\xc5\xf8\xdd\x8b\x2c\xa5\xec\x52\x9a\x93\xd1\x37\x3f\xcd\xa7\x19\xdc\xb8\x06\xbe\x1d\xc1\x4e\x24\x04\xac\x10\xa6\x19\x34\xc5\x18\xc4\x32\xf4\x40\xaf\x91\x7a\x03\x0b\x30\xd2\x86\x5c\x03\xfb\x87\x5b\x38\x4a\x98\xf3\x11\xee\x22\x09\x5a\x90\xaf\xe9\xc9\x35\x6e\x59\x76\xc0\x47\xf0\xc1\xa0\xfd\x1c\xf9\x40\x72\x26\x69\xef\xa6\xf9\x50\x9b\xae\xab\x73\x59\xfa\x25\xdf\x7f\x46\x1a\x49\x5c\xe3\x30\xf2\x23\x89\x72\xf0\x1d\x60\x64\x7a\x35\xf2\xf9\xa4\xed\xcb\x27\x6d\x39\x7c\x3c\xdf\xb8\x17\xf6\x43\x07\xfb\xa4\x16\xe6\x07\xd2\x90\xa4\xa7\x5b\x3a\xb2\x67\xe3\xe0\xdd\x4c\x86\x03\x1a\x76\x0e\xc0\xe3\x4c\x5d\xed\xae\x6d\xf9\x9a\x60\xcf\x7e\x6c\x39\x38\x8d\xd4\xe7\xd8\x28\x21\xc5\xb7\xdc\x45\xbd\x34\xd0\x1c\x01\x28\xda\xef\xe5\xa5\x19\x4c\xac\xf6\xe3\x02\xfe\x64\xb5\xad\x10\xa7\x24\x38\xfd\xf5\x6e\x4c\xd9\x8f\x69\x42\x79\xa3\x0e\x40\xfb\x10\xa9\x83\x5f\x59\x11\xb1\xa7\x21\xb3\xf4\x24\x75\xa4\xaa\x55\x43\x4b\xda\xc1\xb2\x3a\x80\x73\xd9\xcd\x56\xda\x01\x0e\xc3\x36\x32\x4e\xf7\xc2\xde\x48\xf6\x61\x4a\xb3\xaf\x17\x77\xf0\xac\xc1\xdf\xa3\x9d\x3a\x68\x4b\x97\x36\x9e\x50\x69\xab\x02\x0c\xa4\xbd\x70\xb4\x6d\xd7\x65\x02\x60\xaf\x86\xbe\xa7\x0f\x5a\xbd\x35\x5b\xf6\x78\xb8\x44\xf6\x3b\x3f\x5e\x4d\xd8\x8f\xff\xf5\xd5\xf7\xbe\x63\x7c\xbc\x4a\x14\xcf\x35\xfb\x47\x54\x42\xe7\x9b\xb2\x9e\xfc\x63\x0d\x1d\x98\x24\xaa\xfb\x0a\x82\x23\x55\xe2\x96\x85\xfc\xb0\xae\xee\x4f\x00\x00\x00\xff\xff\x2f\x32\xc0\xc4\xe0\x02\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x92\x4f\x6b\xdc\x40\x0c\xc5\xef\xf3\x29\x04\x7b\xe8\x69\x5d\x72\x2b\x73\x2b\x1b\x4a\xff\xd1\x84\x2c\xe4\xae\xd8\x5a\xaf\x12\x7b\x66\x90\x34\x4b\x8a\xf1\x77\x2f\x33\x9e\x94\x84\xd0\x9e\x8c\x7e\x7a\x7a\x6f\x24\xbc\x73\x3b\xf8\x76\x02\x3b\x93\x10\xb0\x42\x9c\x66\xd0\x9c\x52\x14\x2b\x30\x00\x3d\x27\xea\x0d\x2c\xc2\x48\x15\xb9\x1d\x1c\xee\xae\xe1\x24\x71\x2e\x25\xdc\x24\x12\xb4\x28\x5f\xf3\x83\xdb\xb9\x65\xd9\x03\x9f\x20\x44\x83\xee\x73\xe2\x23\xc9\x85\xa4\xbb\x99\xe6\x63\x33\x5d\x57\xe7\x4a\xe8\x97\x32\xff\x88\x34\x92\xb8\x9d\xc3\xc4\xf7\x24\xca\x31\x78\xc0\xc4\xf4\x6c\x14\x4a\xa5\xdd\xd3\x27\xed\x38\x7e\xbc\x5c\xb9\x27\x0e\x83\x87\x43\x56\x8b\xf3\x1d\x69\xcc\xd2\xd3\x35\x9d\x38\xb0\x71\x0c\x6e\x26\xc3\x01\x0d\xbd\x03\x08\x38\x93\x6f\xee\xda\x6d\x5f\x13\xec\x39\x8c\x1d\x47\xa7\x89\xfa\x22\x1b\x25\xe6\xe4\xe1\x5d\x7f\x33\xd0\x22\x01\xd8\x62\xbf\x57\x8f\x0a\x26\x56\xfb\xf1\x0a\xfe\x64\xb5\xda\x48\x53\x16\x9c\xfe\xe6\x56\xa6\x1c\xc6\x3c\xa1\xbc\x50\x07\xa0\x7d\x4c\xe4\xe1\x17\xce\xa4\x09\x7b\x1a\x0a\xcb\x0f\xd2\x56\x6a\xb1\x6a\x68\x59\x3d\x2c\xab\x03\xb8\x6c\xb7\xa9\xad\x3d\xe0\x30\xd4\x95\x71\xba\x15\x0e\x46\x72\x88\x53\x9e\x43\x1b\xdc\xc3\xa3\xc6\x70\x8b\x76\xf6\xd0\x6d\x2e\x5d\x3a\xa3\x52\xed\x02\x0c\xa4\xbd\x70\x2a\x06\x2f\x1b\x00\x07\x35\x0c\x3d\x7d\x50\xd8\x26\x9a\xb6\x9c\xc1\xc3\xf1\x35\xb2\xdf\xe5\xf1\x6a\xc2\x61\xfc\x67\x5e\x7b\xef\x7f\x12\xef\xdf\x28\xb6\x9c\xb7\xec\x5d\xd0\x26\xba\x5c\xd5\x42\xcb\x8f\x35\x78\x30\xc9\xd4\xee\x15\x05\x47\x6a\xc4\x2d\x0b\x85\x61\x5d\xdd\x9f\x01\x00\x2f\x32\xc0\xc4\xe0\x02\x00\x00"),
 		},
-		"/install/cluster/syndesis.yml": &vfsgen۰CompressedFileInfo{
-			name:             "syndesis.yml",
+		"/install/cluster/syndesis.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "syndesis.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 223195,
+			uncompressedSize: 452119,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x7f\x73\xdc\x36\x92\x37\xfe\x7f\x5e\x05\xca\xf7\xad\xb3\xf4\xbd\x19\xd9\xb9\x6c\x6d\xdd\xe3\x4d\xe5\x4a\x6b\x3b\x59\x5f\x1c\x5b\x67\xc9\x4e\xd5\xee\xed\x73\x85\x21\x31\x33\x88\x49\x80\x01\xc0\x91\xb5\x3f\xde\xfb\x53\x68\x00\xfc\x31\x43\x02\x20\x47\x72\xa4\x04\xf8\x27\xb1\x86\x6c\x36\x80\x46\xa3\xd1\xdd\x1f\xf4\x17\xcb\xe5\xf2\x0b\x5c\xd1\x0f\x44\x48\xca\xd9\x33\x84\x2b\x4a\x3e\x29\xc2\xf4\xbf\xe4\xd9\xc7\xff\x90\x67\x94\x3f\xd9\x7d\xf9\xc5\x47\xca\xf2\x67\xe8\x79\x2d\x15\x2f\xdf\x11\xc9\x6b\x91\x91\x17\x64\x4d\x19\x55\x94\xb3\x2f\x4a\xa2\x70\x8e\x15\x7e\xf6\x05\x42\x98\x31\xae\xb0\xfe\xb3\xd4\xff\x44\x28\xe3\x4c\x09\x5e\x14\x44\x2c\x37\x84\x9d\x7d\xac\x57\x64\x55\xd3\x22\x27\x02\x88\xbb\x4f\xef\x9e\x9e\x7d\x75\xf6\xf4\x0b\x84\x0a\xbc\x22\x85\x7d\x17\x57\xd5\x33\x24\x6f\x58\x4e\x24\x95\x5f\x20\xc4\x70\x49\xda\x3f\x10\x79\xe6\xfe\xf7\x8c\xf2\x2f\x64\x45\x32\xfd\xda\x46\xf0\xba\xf3\x9a\xfe\xc9\xbc\x69\x89\x9a\xce\x5c\xb6\x54\x11\x2a\xa8\x54\xdf\xf7\xfe\xfc\x9a\x4a\x05\x3f\x55\x45\x2d\x70\xd1\xfd\x28\xfc\x59\x52\xb6\xa9\x0b\x2c\x7a\xec\xc9\x8c\x57\xe4\x19\x7a\xa3\xbf\x55\xe1\x8c\xe4\x5f\x20\x64\x3b\x08\xdf\x5e\x22\x9c\xe7\x30\x64\xb8\xb8\x10\x94\x29\x22\x9e\xf3\xa2\x2e\xdd\x50\x2d\x51\x4e\x64\x26\x68\xa5\x60\x48\xae\xb6\xa4\xa1\x8e\xaa\x2d\x96\x04\x9e\x42\xe8\x27\xc9\xd9\x05\x56\xdb\x67\xe8\x4c\x2a\xac\x6a\x79\xd6\xfd\xd5\x0c\xd2\x45\xe7\x2f\xea\x46\xb3\x25\x95\xa0\x6c\x13\xfc\x90\x65\x78\xf4\x53\xfd\xdf\xcd\xc7\x3e\xf4\xfe\x76\xf0\x39\xf3\xd0\xee\x4b\x5c\x54\x5b\xfc\xa5\x19\xbf\x6c\x4b\x4a\xfc\xcc\xbe\xc1\x2b\xc2\xce\x2f\x5e\x7d\xf8\xea\xb2\xf7\x67\xd4\x67\xd3\xcd\x0d\xa2\x12\xa9\x2d\x41\xe6\x61\xb4\xe6\xc2\xfc\x13\x7e\x26\x12\x9d\x5f\xbc\x6a\x08\x54\x82\x57\x44\x28\xea\x26\xdf\xb4\x8e\xcc\x77\xfe\xba\xf7\xb9\xc7\x9a\x23\xf3\x14\xca\xb5\xb0\x13\xf3\x59\x3b\x00\x24\xb7\x9d\x40\x7c\x8d\xd4\x96\x4a\x24\x48\x25\x88\x24\xcc\x88\x7f\x8f\x30\xd2\x0f\x61\x86\xf8\xea\x27\x92\xa9\x33\x74\x49\x84\x26\x83\xe4\x96\xd7\x45\xae\xd7\xc8\x8e\x08\x85\x04\xc9\xf8\x86\xd1\xbf\x35\xb4\x25\x52\x1c\x3e\x5a\x60\x45\xac\x44\xb6\x0d\x24\x88\xe1\x02\xed\x70\x51\x93\x05\xc2\x2c\x47\x25\xbe\x41\x82\xe8\xaf\xa0\x9a\x75\xe8\xc1\x23\xf2\x0c\xfd\xc0\x05\x41\x94\xad\xf9\x33\xb4\x55\xaa\x92\xcf\x9e\x3c\xd9\x50\xe5\xd6\x7a\xc6\xcb\xb2\x66\x54\xdd\x3c\x81\x65\x4b\x57\xb5\xe2\x42\x3e\xc9\xc9\x8e\x14\x4f\x24\xdd\x2c\xb1\xc8\xb6\x54\x91\x4c\xd5\x82\x3c\xc1\x15\x5d\x02\xeb\x0c\xd6\xfb\x59\x99\xff\x8b\xb0\xda\x41\x3e\xee\xf1\x7a\x20\x12\xa6\xc1\x52\xf4\xcc\x80\x5e\x93\x7a\xb6\xb1\x7d\xd5\xf4\xa2\x1d\x68\xfd\x27\x3d\x3a\xef\x5e\x5e\x5e\x21\xf7\x69\x98\x8c\xfd\xd1\x87\x71\x6f\x5f\x94\xed\x14\xe8\x01\xa3\x6c\x4d\x84\x99\xc4\xb5\xe0\x25\xd0\x24\x2c\xaf\x38\x65\x0a\xfe\x91\x15\x94\xb0\xfd\xe1\x97\xf5\xaa\xa4\x4a\xcf\xfb\xcf\x35\x91\x4a\xcf\xd5\x19\x7a\x0e\x0a\x10\xad\x08\xaa\xab\x1c\x2b\x92\x9f\xa1\x57\x0c\x3d\xc7\x25\x29\x9e\x63\x49\xee\x7c\x02\xf4\x48\xcb\xa5\x1e\xd8\xb8\x29\xe8\xea\xee\xfd\x87\xcd\xa8\x75\x7e\x70\x2a\x76\x64\xbe\xdc\x02\xbd\xac\x48\xd6\x5b\x32\xfa\xaf\x42\x0b\xb5\xc2\x8a\xe8\xa5\xd0\xd3\xbe\xae\x0d\xaf\x55\xdd\x70\x9e\x37\xfb\xc9\xde\xdf\x1b\x75\x3a\xf6\xee\x94\xe7\x46\x47\x29\x30\x2e\xc1\x1f\x33\x5e\x56\x9c\x69\xc1\x3b\xfc\xec\x78\xb7\x75\xcb\x57\xc3\x8c\xfa\xdf\x82\x37\xb1\xc2\x2b\x2c\xc9\xd8\xef\xc1\xce\xea\x46\x4b\xbc\xb9\x05\x0a\x17\x82\xac\xe9\xa7\xa3\xe8\x08\xb2\xa1\x52\x89\x9b\x23\x89\x58\xf5\x34\x4e\x25\x3c\xb0\x08\x2c\x06\xbd\xf4\x7d\x4f\x4c\x91\xba\xce\x3b\xec\xe6\xed\x3a\xf4\xd0\xd2\x76\x55\xeb\xff\x0d\x11\x91\x4f\x7b\x07\xc6\xb5\x0a\x2b\xbd\xa7\x3c\x43\xff\xf7\xe4\x7f\xfe\xed\x1f\xcb\xd3\xff\x3c\x39\xf9\xcb\xd3\xe5\xff\xf9\xeb\xbf\x9d\xfc\xcf\x19\xfc\xcf\xff\x7f\xfa\x9f\xa7\xff\x70\xff\xf8\xb7\xd3\xd3\x93\x93\xbf\x7c\xff\xc3\x77\x57\x17\x2f\xff\x4a\x4f\xff\xf1\x17\x56\x97\x1f\xcd\xbf\xfe\x71\xf2\x17\xf2\xf2\xaf\x91\x44\x4e\x4f\xff\xf3\xff\x0b\x30\xf6\x69\xa9\x2d\x47\xc1\x88\x22\x72\x49\x99\x5a\x72\xb1\x34\x3d\x7a\x86\x94\xa8\x89\xf7\xf5\xfe\xae\xf2\x1a\xe6\xce\xfe\x71\x65\x55\x54\x89\x3f\xd1\xb2\x2e\x11\x2e\x79\xcd\x94\xd6\x51\x7a\xcd\xd6\xca\x4f\xb8\x23\x51\x08\x17\x05\xbf\xd6\xfa\x7e\x40\xc3\xb7\xbc\x6b\x25\x9f\xf3\x4c\x6a\xfd\x9e\x91\x4a\xc1\xff\xac\xe9\xa6\x16\x60\x35\x3c\x29\x31\xc3\x1b\xb2\xb4\x1f\x5f\x36\xe4\xb5\x9e\x57\x98\x32\x22\x9e\x3c\xf6\xb2\xe4\x55\x51\x5d\xbe\xcd\xa6\x95\x44\xf8\x21\x8a\xf0\x3b\x67\x72\xec\x09\x31\x65\x7d\x21\x0e\x70\x64\xa5\xac\x23\xc4\x5a\x2c\xf4\x56\x7d\x86\x5e\xad\x51\xf3\x15\x2a\x11\x2f\xa9\x52\x24\xd7\xd6\x76\x68\xfe\x51\x23\xaa\x0b\x44\x95\x36\x04\x70\x5d\x80\x79\x84\xec\xd2\xa3\xda\x62\xc6\x4a\xd3\x25\x9f\xaa\x82\x66\x54\x15\x37\x01\xb2\xda\xf6\xa0\x6b\x4a\xf2\x05\xe2\x6a\x4b\xc4\x35\x95\x44\x93\xc4\x0c\xd1\xb2\x2a\x48\xe9\x0c\xef\xa5\xb1\x3c\xac\xc9\x7b\x16\x20\xfb\x20\x16\xeb\x4e\x9f\x12\xc9\x73\x5c\xe1\x8c\x2a\xcf\x0e\x88\xe2\x76\xc1\xa8\xef\x2a\xbc\x39\x6a\xab\xad\x25\x11\x47\x10\x08\x70\xb8\x11\x78\x8d\x19\x9e\x6b\x1b\x25\x3b\x20\x29\xd1\x64\x07\xa0\x64\x07\x3c\x70\x11\x4e\x76\xc0\x6f\xc8\x0e\x08\x3e\x14\x78\x20\x78\x12\x0f\x2c\xae\x92\xec\xbb\x89\x5c\x0b\xef\x95\xc9\x0b\x90\x54\xe7\xfd\x52\x9d\x69\xf7\x4f\xbb\xff\x03\x17\xe1\xb4\xfb\xff\x86\x76\xff\x87\xe8\x05\x08\x7c\x80\xe3\x5a\x6d\x67\x87\x37\xa8\xc4\xab\x82\x5c\x62\xf1\x7c\x4b\xb2\x8f\x21\x2e\x57\x9c\x17\x04\xef\x47\x65\x9b\x67\xee\xb2\x9b\x95\xe0\x25\x51\x5b\x52\x8f\xf4\x25\xb9\x2b\x7c\x4f\x27\x6d\x9f\x0c\x96\x2e\xdf\xc9\x60\x79\xb8\x22\x9c\x0c\x96\x64\xb0\x78\x49\xde\x73\x83\xa5\x92\x3f\x17\xbf\x0a\x0f\xc8\x9d\x8e\x92\xfc\x77\x9a\x06\x29\x38\x48\xd9\x96\xe4\x75\x41\x72\x9f\x2b\x6e\xdc\x68\x95\x90\xbd\x36\x77\x98\xd7\x04\xab\x5a\x1c\x6f\x4e\x1a\x45\xa2\x55\xd8\x7b\x51\x7c\xcb\xc5\x57\x32\xc3\x85\x27\x5d\x08\xdd\xe2\x42\xbf\x57\xa2\x92\x0c\xf4\x64\xdd\x24\x03\x1d\x25\x03\xfd\x81\x8b\x70\x32\xd0\x7f\x43\x06\xfa\x2f\x6d\x4d\xd7\xc9\x4c\x0c\x8f\x51\xb5\x11\x38\x1f\x31\xa9\x1e\xd8\x40\xdd\x9a\x91\xf4\x2b\x3b\x57\x7a\x7e\xcc\x49\xc9\x5f\x1c\xa0\x33\x50\xe0\x80\x90\x93\xaa\xe0\x37\xaf\xf4\x16\x28\xba\x50\xbc\xf8\xf7\x77\x97\x75\x55\x71\xa1\x0e\xdf\xeb\xed\x17\x5a\xdf\x8a\x9a\x2c\xf4\x26\xc1\x60\xa7\x00\x81\xd3\x23\x41\x70\x29\x11\x16\x04\x65\x5b\xcc\x36\x24\xd7\x2a\xb5\x96\x24\x47\x05\xcf\x70\x31\x30\x48\x00\x71\xe1\x95\x56\xb7\x08\x00\x82\x12\xfd\x2b\xfa\xaf\xf3\x0f\xe7\xff\xfb\xe2\xe5\x1f\xdf\x7f\x07\x3a\x9d\xe1\x55\x01\xa8\xba\xf8\xbe\x00\x43\x97\xc0\x4f\x83\xcb\x1b\x1b\x8d\xc1\x09\xa4\xed\x30\x4e\xc5\x6e\x80\x31\xef\x3b\xdf\x8d\x5b\x29\x80\x94\x81\xc0\x86\x9e\x46\xb1\xc3\x23\x7e\x07\x3f\x1d\x8f\x64\x95\x78\x47\xd8\x3b\x52\x71\x49\x15\x17\x83\x1d\x88\x35\xd2\xbc\xd2\xef\x61\x81\x57\x84\xc9\x2d\x5d\xab\xe7\x9c\x49\x5e\x90\xf7\x62\xa0\x93\x1e\xe2\xcd\xfb\x3f\x60\xa9\x86\x0e\xc3\x9e\x77\xc7\x15\xda\x01\x32\xb2\xd9\x73\x9b\x97\xb4\x2c\x57\x75\x51\xb4\xa0\x49\x90\x32\x03\x1e\x9b\xc4\x05\xaf\x15\xf9\x13\x97\x0a\x10\x92\x53\xde\x94\x58\xcc\x13\x67\x45\xa4\x1a\x5d\xdc\xe3\x0b\x69\x0c\x10\x06\x98\xd0\x3e\xa1\xf1\x35\xd1\x1d\xda\x29\x3c\xaf\xb9\xc8\xc8\xfb\xb1\x9d\xd0\xb7\xfa\x0b\x2c\x95\x7d\xf1\x5b\x4c\x8b\x5a\x0c\xbc\xbf\xe6\xa2\xc4\xea\x19\xca\xb1\x22\x4b\x45\xcb\x43\x7b\xd9\xc3\x1a\x00\x6f\x27\x4a\x1e\x96\x13\xfb\xaf\xb0\xd8\x10\x35\x88\x58\x0d\xbc\x69\xcd\x87\x73\xa5\x48\x59\x0d\x1d\xca\x5c\xe7\x29\x53\x5f\xfd\xfb\x08\xe5\x61\xed\xb2\x9b\xcc\xce\xa0\x0c\x1d\xfc\x11\x3c\x5b\xf9\x33\xb4\xc6\x85\x05\x30\x4b\xc5\x05\xde\x90\xde\x9f\xea\xd5\x81\x35\x61\x65\x11\xfd\xfd\x9f\x09\x6a\xfd\xe5\x8a\xa8\x84\xb4\x4e\x48\xeb\xa6\x25\xa4\x75\x42\x5a\xb7\x6d\x3a\xd2\xba\xf7\xf5\xb7\x95\x51\xac\xfa\x69\xc4\x59\x13\x4e\x30\xce\x97\x0c\x33\x3d\x29\xd6\x58\x3f\xf4\x93\xf8\x6d\xe6\x9f\xb0\xde\x6b\xe6\x9e\x77\x8d\xf4\xbc\x65\x45\xf0\xa4\xea\xcf\x07\xca\x78\x51\x90\x4c\x71\xf1\x5e\x8c\x38\x29\x50\xdc\xd9\xd2\x8e\xc2\x71\xdc\x80\x75\x79\xbe\x21\x6c\xe4\x30\x11\xc9\x8b\x21\x53\x14\xaf\xd8\x5b\x76\x0b\x98\xee\xb7\x15\x11\x58\xf1\x63\x40\x5e\xb0\x1f\x01\x91\xe3\xa7\xec\xe7\x9a\x88\x9b\x63\xa7\x4b\xe2\xb2\x2a\x88\xb8\xc0\x02\x97\xb7\x41\xe8\x4a\x3f\x79\x47\x6e\x03\x84\x3e\x32\xac\xe8\x6e\xb6\x73\xe8\x16\x64\x33\x94\xe0\x57\xcd\x4e\x79\xbb\x7b\xe6\xaa\x7a\x55\xd0\xec\xbc\x9a\xed\x85\xb4\x19\x88\x4b\x89\xc5\x32\xf3\xe7\x20\xee\x7b\x4d\x24\x51\x60\x6f\xb4\xce\x13\xcc\x6e\x10\xae\xf5\xff\x2a\x9a\xe9\xdd\x0c\x00\x94\x5a\x99\x8e\x12\x45\x08\x67\x19\x91\x46\xf1\x9f\x5f\xbc\x3a\xeb\x3a\xb0\xb7\xc4\x10\x60\x84\xe4\xb2\x79\x90\xa3\x0d\x51\xa8\xe2\xf9\xfe\xb6\xdd\xa3\xba\xc1\x94\x49\xb3\x1d\x5f\x76\xce\x99\x47\x2d\xcf\x5b\x51\x84\x81\xf3\xb2\x6b\xfd\xad\x92\x28\xf4\xae\xfb\x9e\x33\xf4\xb6\xee\xdf\x60\x55\x12\x44\x3e\x55\x5c\x0e\x78\x97\xda\xd6\x18\xee\x17\x20\x3a\x30\xe6\x77\xb6\xb8\x15\xcf\xf9\x7d\x5d\x3c\x9e\x1f\x57\x38\xfb\x58\x57\x01\x03\xe2\xd2\x26\x3f\xd8\xa7\x27\x9a\x08\x2e\x75\x62\xb8\x7b\x07\x93\xef\x1e\x87\xe3\x8b\xf9\x20\xca\x04\x67\x3f\xf1\xd5\x20\x01\xc2\xea\x11\xdd\xbf\x44\x5b\x5e\x8b\x91\x88\xd2\x12\xe5\x98\x8e\xfe\x56\xd2\x9c\xd1\xcd\x76\xd8\xbb\xbc\x44\xd7\x84\x7c\x1c\x7f\x97\x33\xb5\x1d\xfd\xf5\x86\xe0\x71\x96\xc8\x8e\x88\x1b\xf4\xd5\xa1\x53\x0a\x1d\xe1\xb7\xf3\x5d\x66\xd3\x1b\xfd\xe7\xcd\x83\xfa\x68\x01\xde\x5f\xc5\x91\x0b\x75\x11\x84\x8b\xc2\xd8\xfc\xda\x50\x6f\xa9\x0e\x6d\x24\x23\x86\x2c\x0a\xdf\x9f\xe3\xbd\x05\x27\x62\x29\x7d\x32\x27\xbf\x17\xab\xf7\xef\x5e\x47\xaa\x9c\x57\xeb\x6e\x54\xb1\x96\x44\x1f\x48\x1d\xa1\x86\x23\xa4\x95\x2c\xc1\x3e\x85\x63\x35\x93\x7e\x10\x17\x05\xc9\xd1\xea\xa6\x7b\xdf\xdc\x70\x8b\xb0\x4e\x26\xa8\xcf\x37\x1d\x0d\x79\xc1\xa5\xda\x08\x72\xf9\xdf\xaf\xdb\x4e\x98\x9d\xc5\xa3\x36\x6f\x27\x30\xd4\xe3\xc9\x5d\x41\xa8\xa7\x6f\x47\xc1\x6d\x60\xe3\xcb\x25\x08\x9b\x65\xd7\xf1\x38\x4a\x34\x32\xc9\x89\x94\xdc\x17\xf9\x8a\xec\x24\x6a\x02\x57\xe7\x30\x64\x3f\xf0\xb1\xb0\x9e\x6b\xe3\x8a\xc8\xb5\x25\x7a\x47\x70\xfe\xa3\xa0\x8a\xbc\x65\x9e\x0d\xba\x7d\x56\xdb\xd9\x3f\x60\xe6\x0f\x8a\x77\xc8\x06\x9f\x9d\xd4\xf3\x5b\x0c\xd9\x39\x92\xaf\x3b\xd7\x45\x8e\xb5\x39\xd9\x26\xd1\x89\x21\x93\x72\x61\xdf\x78\x17\xde\x84\xef\x1a\x72\x97\xc6\x33\xfa\xbc\xc0\x32\xd0\xa1\xdb\x0a\x83\xd6\x43\x31\x9a\x09\x1f\xf1\xdf\x0a\xd2\x5b\xe5\xef\xa5\xd6\x97\xa5\xd9\xb7\x3b\xaa\x07\xcc\x5a\xf0\x34\x5c\xd3\xa2\x00\xff\x8f\xdf\x6c\xd3\xef\x1b\x35\xe5\xbc\x58\x41\xcd\x70\xac\xf1\x76\x5f\xae\x27\xb9\x35\xdd\x15\x14\x8d\xc0\x03\xc7\x60\xc7\xef\xdf\x68\x24\x4d\x9e\x34\xf9\xc3\xd6\xe4\xf7\x02\x99\xd9\x53\xf7\x2f\xe1\xcc\x8a\xb8\x70\xef\xa3\xcb\xf3\x77\x08\xfc\x2a\xd2\x9c\x14\xf8\x86\xa8\xad\x27\xa7\x33\xc6\x83\xe0\x8f\x9b\x0f\x32\x76\xd5\x77\xa5\x28\x8e\xb6\x78\x47\x50\x45\x44\x49\x25\xdc\xed\x0c\xe9\x82\x0a\x15\x04\x1f\x04\x8e\xba\x6d\x43\x14\xc2\x70\xd7\xb4\xb6\x50\x2b\x9e\x4b\x44\x4d\xd6\xcc\x86\xee\x08\xd3\x23\x0b\xb1\x13\xca\x10\x17\xb9\xde\xe4\xb8\xde\xdd\x36\x02\x33\xe5\xdd\xe0\x5a\xef\x4e\x1b\x9d\xa3\xd2\x1d\x1b\x86\x62\x64\xfb\x83\xf6\xab\x00\xb7\x26\xf5\xee\x7b\x36\xa9\xf7\xa4\xde\x5d\xbb\x1f\xe8\xa1\x98\x34\xc5\xfe\xf3\x1d\xad\xfc\xe3\x16\x36\x03\x74\x4d\x2c\x9d\x6e\xa2\x9e\xcf\xbb\x1d\xb7\x4f\xa0\x7e\xe6\xdf\xeb\xf1\x4c\xbe\x41\xee\x7e\xb0\xa8\x0f\x56\x97\x2b\x22\xb4\xba\xef\x72\x07\xd5\x03\x0a\xb3\xab\x04\xa4\x3b\xc3\x0c\x65\x82\xe0\x00\x5e\x24\x16\xf4\xd0\xe1\xe2\x32\x32\xc3\x70\xb0\x7f\xee\x15\x38\x5b\xc1\x1e\xed\x8e\x56\x4d\xe0\xd9\x38\xae\x26\x4d\x49\x98\xff\x39\x80\xb3\x1e\xe3\xe6\x85\x0e\x1d\xf4\xfe\xdd\xeb\xdb\x58\xe7\x90\x4f\x39\x49\x42\xf4\x96\x2f\x89\x52\x94\x6d\xfc\x83\x13\xb7\x9a\x50\x4f\x7f\x9e\x8b\x4d\x5d\x0e\xbb\x68\xbd\x6c\xb5\x14\x4c\x8f\x10\xaf\x94\x33\x71\xec\x49\x1b\x51\xdf\xa2\x71\xad\x33\xf5\x36\x9d\x37\xf8\xd2\x04\x2c\x0e\xae\x2a\xb2\x9f\xd0\x12\xec\xdb\xa5\x49\x01\xba\x26\xf6\x75\xc4\xc8\x35\x12\x9d\x14\xd8\x48\x0e\xc3\x9a\x03\x81\x59\xe3\xcb\xad\x3d\xe8\xd0\x8c\x9d\x0f\x4d\x1b\xb3\x81\xd0\x48\x97\x47\x58\xca\x30\xe7\x91\xc3\x10\xdc\x49\x51\x83\xfa\x09\x19\x47\x66\x3e\x8e\xdf\xbd\xc3\xdf\x5b\x06\xba\x18\xf1\x99\xfb\x67\xaf\x1e\x6b\x0e\x1c\x09\x32\x79\xc0\xb8\x8e\xdb\xc7\x65\x0c\x9d\x69\x2f\x21\x19\xe4\xc5\x1f\x21\x6d\x50\xbf\x0a\x0e\x48\x58\x70\xa3\x51\x2d\x9f\xaa\x81\x7c\xe8\x1f\xe8\x28\x2a\xa1\xc7\xc3\xb7\xfa\x61\x54\xba\xa7\xf5\xd6\xfc\xfc\x9d\x56\xe7\x5a\xfb\xf5\x33\x4c\xe3\xbe\x4e\xd9\x5a\x60\x1b\xc1\xa5\x6c\x00\x15\xb3\x17\x03\xec\x00\xdb\xf4\xc7\xcf\xd7\x50\x37\xea\x06\x06\xe3\x8a\x17\xc4\xfe\xa4\x47\x03\x48\x4b\x25\x6a\x48\xba\x1b\x98\xb1\x26\x48\x38\x9c\xc3\xe0\x17\x33\x6c\xbf\x1c\x11\x37\x6e\x98\x84\x94\x48\xa8\x28\x05\x79\xa5\xee\xcf\xb2\xe9\x3e\x12\x75\x41\xe4\x70\x1a\x40\x58\xea\x19\xcf\xc9\xb9\x97\xad\x03\xd6\x5e\x34\xc8\x4c\xfd\xf2\x38\x4b\x01\x44\xa5\x36\xcf\x2a\x3e\x90\x9e\x17\xcf\xbc\x79\x8a\xac\x89\x10\x24\x7f\x51\xeb\x95\xd8\x8a\xc5\xab\x0d\xe3\xcd\x9f\x5f\x7e\x22\x59\x3d\x2c\xab\xa3\xfd\x84\x4c\x69\x1b\xba\x17\xc6\xd5\x6f\x3e\xa6\x65\xb7\x89\xe9\x07\x52\x59\xa0\xa7\x1c\x46\xca\x66\x27\x4a\xac\xa8\x5c\xdf\x40\xff\x9b\xb1\x23\x9f\x2a\x41\xac\x2f\xa7\x89\xdc\x06\xc8\xae\x6e\x6c\x1a\x2b\x25\x45\xbe\x40\xab\x5a\x21\xaa\x20\xc7\x35\xdb\x72\x2e\x09\xc2\x66\x82\xe0\xab\x3b\xca\x21\xaf\x38\x40\x93\x33\x70\x80\x95\x5c\x74\xb3\x52\x1c\x6b\x67\x30\x26\x2d\x51\x2a\x51\xc9\xbd\x1e\x27\xd4\x9d\x21\x97\xcc\xad\x3f\x72\x4d\xd5\xd6\x78\x9e\xe0\x6c\x21\x15\x92\x75\xa9\x3f\x79\x4d\xe8\x66\xab\xe4\x22\x40\x94\x9e\x91\x33\x58\xb1\x04\x67\xdb\x0e\x4b\x25\x21\xca\x78\xeb\x2c\xfb\xad\x54\x06\xcd\xa5\x4e\x00\xf7\xa4\xc9\x30\xb6\x19\xbf\x8b\x66\x6b\xdf\x97\xb3\x90\xa1\x38\x30\xc5\x0b\x44\x54\x76\x76\xba\x68\x60\xca\x18\x7a\xbf\xba\x41\x54\x81\x36\x0a\x52\x55\x5b\xc1\xeb\x8d\x19\x41\x52\x74\xa3\xce\x4e\x20\x40\xbb\x69\xa3\x8e\x6d\xd0\x23\x33\xa8\x8f\xc2\x72\x0a\x23\x56\x97\x06\xc7\x6c\xa7\xba\xc4\x2a\xdb\xda\xec\xde\x8c\x0b\x41\x64\xc5\x19\xd0\x85\x5f\x5e\xb6\xfd\xfa\x43\x90\x6b\x43\xf2\x44\x9e\xb6\x02\xb0\xa5\x9b\xad\x9b\x7f\x2c\x4c\xe2\x98\x96\xaa\x56\x6e\xfc\x48\x67\xaa\x48\x19\xb4\x54\xfb\xba\x95\x21\x52\x56\xea\xa6\x23\x99\x1d\xdd\xa5\x88\x28\x5d\x9f\xc3\x56\xb3\x16\x34\xd8\x9f\xa5\xe9\x11\x2d\x0d\xea\xdb\xca\x31\x7a\x8a\x4e\x40\x54\xa9\x7a\x2c\x61\x41\x2e\x79\x75\x1a\x82\x6e\x23\x74\x8e\x58\xdd\xe8\x1b\x0f\x83\x88\x71\xf7\xfd\x20\x4d\xcb\x88\x5e\x1d\x92\x47\xf3\x12\x7f\xf6\x73\x2b\x9d\x30\x9f\x67\xbb\x6d\xfd\x39\x31\xb2\x26\x89\xc9\x7a\x86\x4e\x2e\x10\x96\x92\x67\x14\x92\x11\xf5\xe8\x46\x10\x45\x03\x62\x6a\xa6\x22\x3c\xe8\xd3\x3a\x8b\xe0\xcc\xdd\x5f\x00\x71\x6f\x1d\x74\xdd\x79\xe4\xfb\x43\xd0\x55\x48\x91\x74\x61\x53\xd0\x54\x1e\x4b\x5b\xc6\x32\xa6\xd7\x28\x76\x15\x8d\x76\x60\x94\x71\xe4\x49\x13\x3a\x6c\xb8\x33\xff\x90\xc8\x6f\x80\x8f\xd2\x22\x5a\x16\x08\xa3\x8f\xe4\x26\xb4\x35\xf4\x28\x6a\x25\xc8\x9a\x24\x6f\x43\x56\x10\xd8\x0a\x41\x52\x3e\x12\xb0\x03\x27\x90\xb4\xe8\x9a\xe8\x37\xa6\xca\x94\x69\x1f\x49\xe0\xf8\xb1\xdf\x0e\x4c\x17\x98\x7f\xe8\x1f\x74\x1a\x34\xbb\x1d\xdd\x49\x84\xc1\xd7\x51\xe8\xf3\xb9\xe2\xf1\xbd\x46\x53\x5d\x03\x6d\xe3\xc1\xb4\xfe\xa1\xb6\x97\x9f\xe5\xc0\x3f\x46\x64\x1e\x4b\x33\xe9\x7a\x8d\x6e\xe9\x61\xba\x67\xa0\x1f\x1c\x24\x13\x96\xa8\xc3\x56\x7d\xc0\x05\xcd\x1b\x56\xa7\x08\xb9\x6e\x7a\x9f\x7b\xc5\x16\xe8\x0d\x57\xfa\x3f\x2f\x3f\x51\xa9\xe4\x02\xbd\xe0\x44\xbe\xe1\x0a\xfe\x39\x6d\xa8\x11\xfa\x4e\x19\x50\xd8\xeb\x89\x6f\xce\x9c\x24\x33\x0e\x47\x4c\xd1\x39\x43\x58\x08\x7c\xa3\x07\xb5\x8b\xf8\x9a\xb0\xb2\x4c\x7b\x65\x4c\x95\x66\x89\x53\x89\x5e\x31\x6d\xc4\xda\xc1\x55\xdb\xd0\x4d\x47\xc3\x7d\xb3\xec\x95\xb5\x04\x88\x17\xe3\x6c\x09\x56\xc3\x99\xfd\xe2\x44\xa2\x5d\xfe\xcc\x7c\x6b\x1e\xbb\x33\x3e\x45\xaf\x21\xbb\xd1\x0d\xb2\x7a\x5b\x6c\x7e\xa7\x34\x8b\xaf\xd5\xe2\xe0\x53\x13\x89\x02\x63\x10\xb3\xc6\x2e\xf2\x60\x8d\xd6\x05\xba\xde\xd2\x6c\x0b\xa7\xab\x89\x44\x57\xc6\xbb\x2f\x2a\x41\xb4\x7d\x80\x25\x5c\x98\x63\x1c\xf8\xfa\xa0\x42\xe7\xf1\x6a\xc0\x9d\x05\xce\x48\x8e\x72\x30\xf5\x0d\x24\x11\x2b\xb2\xa1\x19\x2a\x89\xd8\x4c\x1d\xd3\x4a\x5b\x09\xd3\xc4\x7a\xe2\x76\x6c\xda\xcc\xa5\xec\x5e\x9c\x36\x5a\x71\x9e\xd5\x6e\x5b\x6a\x4d\x3c\xe1\x69\x27\x8a\xd1\xaf\x44\x7b\x85\xf7\x5f\x89\xef\x39\x18\x7c\xdf\xea\xf3\xd5\xbd\xb2\xf5\xe0\xc4\x97\x6c\xbd\x64\xeb\x25\x5b\x2f\xd9\x7a\x9e\xce\x25\x5b\x6f\xb8\x6f\xc9\xd6\x4b\xb6\x5e\xb2\xf5\x46\xdb\x6f\xd1\xd6\x9b\xf4\x01\xe3\x61\x9c\xec\xf4\xfc\xd1\xf8\x88\xf7\xbc\x9c\xc6\xc8\xb4\xe9\x4c\x51\x7d\xeb\xbb\x3b\xb5\x81\x76\x69\x77\xff\x2b\x70\xa3\xda\x24\x5f\x81\xd9\x86\xa0\x2f\x97\x5f\x3e\x7d\x1a\x23\xa1\xfe\x9b\x99\x0e\x5b\x6c\x0a\x15\x9a\x20\x51\xcb\x8e\x4f\x39\xf8\xa8\x99\x85\x50\x0c\x22\x76\x5e\xe3\xc4\x65\x2c\x2a\x74\x74\xf4\xd1\x6e\x75\x4d\xf8\xa8\x17\x9e\x6a\x42\x84\x68\x15\x92\xe5\x6e\x44\x48\xe8\xad\x4d\xa1\x92\x28\x84\x55\x2f\xa4\x40\x4b\x73\x0d\x00\xaa\x78\x1e\xa3\xa0\xed\xbd\x37\xcd\x1d\xf2\x88\x33\x1b\x3d\xd2\xd2\x77\xe6\xe5\x3e\x40\xba\xdb\xb7\x2e\xf7\x19\xc1\xe6\x5e\xdc\x15\x69\x7a\xc0\x4b\xcd\x31\x3d\xb8\xae\xe7\x60\x64\x8d\x72\xd7\x9d\x23\x6e\x2e\xd0\x09\x39\xdb\x9c\xa1\xbc\x76\x97\xed\x9a\x4b\x7c\x4e\xcd\x38\xc8\x1b\xa9\xc8\x30\x6a\xb9\x6d\xa5\x36\x69\x04\xfc\x47\x0f\x88\xbd\x98\x8f\xec\x08\x53\x35\x2e\x8a\x1b\x44\x76\x34\x53\xcd\xb8\x0e\x5e\xce\xd7\x6f\x54\x99\xa8\xba\x7f\x7d\xc6\x1f\x33\xf6\x75\xc1\xd4\x6c\xbc\x77\xcd\xad\xc9\x63\x27\x57\xa5\xa9\xc6\xa8\x13\x7d\x4e\x81\x87\x41\x0e\xdf\xbe\x0b\xc5\xf5\xd0\x94\xad\x71\xff\x8c\x5a\x17\x85\x9e\x19\x13\xe6\x3b\x64\x38\xfe\xac\xdf\x0b\xb1\x99\xf8\xf7\x00\xd2\x17\x22\x97\x51\x44\xcf\xdf\xbc\xd0\xa3\xa9\xe9\x5c\xf1\x8a\x17\x7c\x73\xd3\x9d\x1f\xd0\x3a\xed\xb5\xcf\x71\x67\x0d\x8c\x64\xbd\xb2\x67\x16\x2d\x6b\x6f\xf6\x26\x3d\x45\xbe\x7c\x2d\x79\x43\x62\x5b\xf2\x86\xc4\xb6\xe4\x0d\x19\x6b\xc9\x1b\x92\xbc\x21\xc9\x1b\x92\xbc\x21\xe3\x5c\x24\x6f\xc8\x3d\xf4\x86\xa4\xc8\x57\xb2\xf5\xbc\x2d\xd9\x7a\xc9\xd6\xdb\x6f\xc9\xd6\x4b\xb6\x5e\xb2\xf5\x92\xad\x37\xce\x45\xb2\xf5\xee\xa1\xad\x37\xe9\x03\xb1\xa4\x63\x81\xb8\x07\x0e\xeb\x88\x18\xcd\xf1\x55\xe6\x2a\x9e\xcf\x06\xc1\x55\x3c\xf7\x60\xe0\x20\xc8\xe0\xe9\x43\xc6\x97\x05\xcf\xb0\x32\xa5\x0c\x80\x98\x8d\xd6\x49\x5c\x9a\x68\xca\x02\xfd\x8d\x33\x62\x30\x43\x5a\x01\x48\x3e\x50\x21\xa6\x6d\x70\x05\xb3\x26\x74\x22\x4f\x3d\xe8\x8e\x84\xae\xf3\x93\x4d\xe8\xba\x84\xae\xfb\x1c\xe8\xba\x2d\x96\xe6\x26\x2e\x63\x22\x8c\x82\xed\x42\x62\xd0\x6a\x30\xad\x36\xff\x10\x85\xb5\x0b\x0e\xc3\x5d\x23\xf1\xb4\xc0\x5b\x91\x04\x74\x73\x2b\x58\x66\x1c\x72\x9b\x22\x41\xf2\x8b\x7e\xff\x82\xfb\x92\xf1\x0b\xc0\x11\x04\xe7\x39\xc9\x51\x45\xc4\xd2\x08\x32\x47\x6b\xca\xf2\x81\xde\x05\x89\xda\xf1\x0c\xae\xc8\x09\x88\xb9\x7e\xb7\x26\x87\x3a\xbb\xf1\xd9\xde\x46\xb4\x8f\x9f\x8b\xb2\x14\x1a\x39\xf9\x5c\xf8\x39\x38\xbd\xbb\xed\x7e\xae\x5b\x09\x3c\x00\x50\x7c\x03\xf1\x1d\x11\xed\x29\xb6\xb9\x16\x22\xfe\xcc\x01\x7b\x2f\x95\x28\xc3\xd2\x6c\x3e\xb1\x06\xed\x1c\xff\xc7\xdc\x18\x2a\xda\x1f\x84\x7d\x42\xe6\xd2\x80\x82\x06\x37\x8f\x7e\xe3\x6b\x3b\x98\x83\xce\xb9\xc3\xe0\xf7\x24\xe2\x7a\x25\x9a\xe0\xf7\x84\xd7\x66\x1c\x10\x06\xa5\x63\xa8\x43\xd3\x4f\x4c\x21\xc7\xdd\x54\xf7\x08\xb8\xf9\xbc\xce\xbb\x19\x67\x65\xeb\xea\x9b\xe5\x66\x98\xe7\xc4\x43\x73\x1c\x79\x68\x7f\xaa\x34\xdf\xd6\x82\x69\x7c\x7a\x93\x29\xa2\x43\x2f\xe0\x6c\xbf\x1e\x3a\xe6\xac\x39\xd7\xbf\x87\xf6\x87\xa5\x11\x0b\xd1\x71\xf6\xcd\x18\x97\x43\xf7\xe0\x90\xc3\x6f\x06\xe1\x3d\x17\xe1\xb0\xd3\x6f\x06\x5d\x2d\xc3\xc7\x78\x0a\x8f\x9a\xbc\x39\x7e\x3f\xb4\x3f\x75\xd6\x95\x44\xc1\x65\xe3\xbc\x80\x33\x46\xa2\xef\x37\x1c\xf0\x04\xce\xa0\xb9\xef\x3b\x3c\x74\xb1\xcd\x20\x3a\xe4\x3f\x3c\x92\xcf\x11\x1f\x62\x87\xe5\x39\xa2\x35\xe4\x47\x9c\xed\x4a\x43\x77\xe3\x4e\x43\xf3\x5c\x6a\x68\xae\x5b\x0d\x1d\xb7\x62\xe6\xb9\xd7\xd0\x2c\x17\x1b\x9a\xec\x66\x43\xf3\x5c\x6d\x68\x9e\xbb\x0d\xcd\x1c\x0d\x30\xe3\x62\x6e\xa9\xed\xb7\xb9\x37\xf7\xed\x33\x3b\x79\xde\x0f\x6d\x50\xc3\xbc\xb1\x95\x4a\x0c\x37\x56\xfd\x5d\x1b\x39\xb0\x54\xff\x39\xcd\x16\xc1\x54\x48\x7d\xac\xb1\xae\xf4\x0e\x1d\xe7\x21\xeb\x7c\x72\x12\x69\xcd\x19\x95\x48\xcb\xdd\x0e\x17\x84\x29\x9b\x00\x6c\x8f\xfa\x9a\xeb\x7d\x8b\x7a\x9a\x6d\x77\xbd\xe5\xd2\x58\x62\xc6\x59\x40\x25\x7a\xf4\x91\xdc\x3c\x5a\xf4\x34\xe2\x34\x57\xb9\x44\x8f\x5e\xb1\x47\xc6\x5c\x3c\x50\xd8\xce\x12\x9d\x66\xee\xb3\xe2\x06\x3d\x02\x3a\x8f\x06\x32\x5b\x67\x19\xec\xb3\x9d\xd3\x13\x5e\x61\xee\xfa\xf4\x79\x89\x08\xed\xeb\x8d\x2f\xd0\x39\x5f\xda\x9f\xe2\xbd\xf1\xce\x5e\xbd\x3c\xb4\x37\xd1\x89\xf3\xe6\xd8\x82\x76\xa7\xa1\x0b\x92\x3a\x9d\xec\x65\x30\x43\xea\x44\x49\x30\x93\xe8\x91\xf3\x13\x3f\x96\x2d\xbf\x21\xdf\x93\x6b\x13\x77\x86\x19\x7a\x61\xaa\xde\x53\x36\x09\xfa\xfb\xf8\x03\xc4\x9e\x07\xc9\x7a\xcd\x6d\x79\xe5\x15\x69\x5d\xea\x39\x3a\x71\xfe\x90\x90\xcf\xa6\x6d\x5c\x40\x2e\x7f\x8f\x08\x53\x74\xd9\x50\x6a\xbc\x24\xf1\xb9\x11\x3c\x97\x3d\x50\xcf\x9e\xc4\x38\x87\x7f\xa4\x67\xba\x6d\xad\xbc\x2e\xd0\xf5\x96\x88\x5e\xdf\xa9\xb4\x75\x79\x73\x84\xe3\xe5\x59\xd4\x8c\x69\x2e\x39\x73\x0e\x6e\xa3\xcc\x4c\x35\x66\xeb\x9c\x03\xf6\xa3\x49\xc2\x78\x41\x86\x46\x3b\xd7\x1d\x3f\x27\x56\xa6\x60\xb6\x49\xe9\x89\x9f\x27\x66\x17\xad\x7e\xd3\x79\xa3\x61\x44\x49\x6e\x4b\x1b\x48\xd7\x9b\x78\x0d\xf6\x12\x96\x5b\x97\x51\x2a\x41\x1e\x70\x51\xf0\xeb\x78\x5d\x38\x71\xe5\x4c\xb1\x81\x96\x5d\xf6\x6e\x3b\x9c\x37\x13\xc8\x76\x7d\x27\x40\xb6\x3d\xe7\xe8\x03\xc7\xb1\x4d\x72\x60\x27\x30\xdb\x70\x37\x12\x98\x2d\x0a\xcc\x06\x3f\x1a\x37\x9a\x43\xb5\x45\xc4\x7a\x0c\xe6\x6d\x02\xaa\x2d\x1c\x93\x32\x35\xf6\x1b\x54\x1b\xfa\x71\x4b\x60\x0d\x0b\x02\x13\x58\xd6\x85\xa2\x55\x9b\x28\x13\xb4\xb3\x61\xf7\x31\x2e\x81\xb5\x0d\xfb\xf6\x75\x86\xe6\x14\x67\xdb\x89\xcb\x0d\x0b\x9b\x66\x23\x61\xff\x58\x98\x00\x28\xd4\xfc\x01\xc4\x9a\xf3\x15\x98\xe8\x32\xbd\xfd\x40\xd8\x0b\x5b\x41\xbf\x71\xc8\xc1\x0e\x76\xa2\x37\xf8\x42\x0b\x8e\xde\x82\x9d\x36\x0d\xe7\x64\x1c\x58\x18\xc6\xef\xb7\x6b\x2a\x0f\x9b\x72\x3f\x8d\xf9\x70\x22\x4f\xc3\x06\x92\xf5\xac\xc6\x99\x5b\xd6\x8c\x0a\x12\x0d\x98\x59\x87\x66\x4d\x90\x62\xcf\xec\x89\x32\x67\x82\x24\xcd\x42\x6a\xcc\x98\xaf\x3b\xfb\xef\x37\xf3\x0d\x99\xd6\x80\x81\x01\x6d\x4c\x98\x4e\x6d\xa6\x86\xff\x5b\x8c\x38\x4e\x0e\xb9\xdd\x55\xb8\x6d\x56\xa8\xed\x17\x81\x29\x8e\x87\xd7\x9a\x90\x59\xb4\x81\x19\x19\x5a\x8b\xc6\x93\xa2\x06\x53\x7a\x37\x47\xc0\xbb\x08\xa7\x45\x84\xd2\x52\x0e\xfc\x78\x0b\x86\xcd\xa0\xef\x33\x42\x87\x47\x87\xcc\x7e\xb9\x54\xf8\xc1\x30\x99\x09\x7a\x4d\x1c\x88\x50\x88\xcc\x04\xbc\x66\x06\x03\x47\xc2\x63\x13\xe5\x52\xb7\xf9\xa1\xb1\x5f\x2a\x15\x7e\x30\x1c\x76\x4c\x78\x6e\x28\xc4\xd4\x0b\x6c\xcd\x58\x02\x81\xa4\xf8\x89\x14\x07\xf8\xfb\x3c\x49\xf1\x57\xdb\xc9\xd1\x36\x43\xc7\x1f\xc8\x9a\x48\x12\xc2\x5e\x29\x2f\xdc\xb4\x87\x9f\x17\x3e\x2b\x40\x75\x5c\x70\x6a\xc6\x9c\xde\x59\x50\xea\x8e\x02\x52\x77\x1a\x8c\x8a\x0b\x44\x4d\x09\xcd\x47\x04\xa1\xfa\x81\xa5\x68\xca\xc7\x07\xa0\x26\xae\x80\x49\x8f\x4f\x0b\x3a\xdd\x51\xc0\xe9\x2e\x82\x4d\xb7\x1f\x68\x9a\xa0\xbd\x27\xae\xef\x29\xfa\x6a\x62\x60\xe9\xee\x82\x4a\xf1\x01\xa5\x18\xb4\x83\x69\x13\x82\x49\xdd\x20\x51\x14\x6d\x7f\x20\xe9\xd0\xa3\x12\x49\x74\x38\x88\x34\x18\x1c\x8a\x1f\x82\x01\xbf\x8b\x37\x30\x14\x45\x79\x3f\x78\x74\x2b\x41\xa1\x09\x92\x1e\x1f\x53\x88\x1f\xb3\x5b\x0d\x14\x44\x02\xaa\x98\xa2\xc7\x81\xaa\xba\xab\xe4\x00\x59\xe5\xe9\x85\xf1\x85\xe3\x1d\xa7\x39\xaa\x6a\xa8\x6f\x1a\x87\xae\xf2\xd0\xb4\xb8\xab\x84\xae\x6a\xd1\x55\xbd\xe9\xe9\xe0\x6f\xe6\x85\x44\x02\x10\xab\x00\x51\x07\xc0\x9a\x06\xb1\x0a\x10\xb5\x00\xac\x69\x10\xab\x00\x4d\x07\xc0\x7a\x40\x10\xab\xb1\x79\x4e\x38\xab\x84\xb3\x4a\x38\xab\x84\xb3\x4a\x38\xab\x84\xb3\x4a\x38\xab\x29\xef\x27\x9c\xd5\x3e\xb5\x84\xb3\x0a\xb5\x84\xb3\xea\xb4\x84\xb3\x4a\x38\xab\x84\xb3\x4a\x38\xab\x84\xb3\x4a\x38\xab\x84\xb3\xba\xa3\x57\x12\xce\x2a\xe1\xac\x12\xce\x0a\x25\x9c\x55\xc2\x59\x25\x9c\x55\x04\xd9\x84\xb3\xba\x37\x38\xab\x5e\xc8\x66\x18\x6c\x15\xe8\x71\x1f\xae\x14\x09\xb6\x0a\xc5\xbe\x68\x51\x44\x83\xad\xba\x5d\x98\x12\xf8\x8a\x44\x5c\x85\x82\x5e\x5d\x3c\x56\x2c\xe2\x2a\x40\xb3\x8f\xc7\x9a\x82\xb8\x0a\x10\x3e\xac\x32\x16\x46\x5c\x85\x48\x3a\x3c\x56\x42\x5c\x25\xc4\x55\xa0\x25\xc4\x55\x44\x67\x12\xe2\x2a\xd0\x12\xe2\xca\xb6\x84\xb8\x4a\x88\xab\x78\x22\x09\x71\xe5\xef\x61\x42\x5c\x25\xc4\x55\x42\x5c\x05\x5b\x42\x5c\x25\xc4\x55\x87\xc1\x84\xb8\x4a\x88\xab\x98\x96\x10\x57\x09\x71\xd5\x3e\x9b\x10\x57\x09\x71\x95\x10\x57\xbd\xcf\x7f\x2e\xc4\x55\xe0\x01\xc5\x0b\x40\x64\x8c\xfa\x63\xbc\x1a\xe4\x00\x4a\xa0\x17\x2a\xe8\xaa\xab\x86\x2e\x6c\xf6\x4a\x61\x00\x13\x28\xee\xbe\xe8\x51\xb3\x5a\x58\xf4\xee\xa5\x2c\xee\xa5\x03\xc8\x50\x02\x2e\x05\xfb\xba\xd9\xef\x17\x64\xbd\x26\x99\xfa\x06\xd5\xd2\x37\x9b\x8d\x45\x00\xcb\xc5\xed\xb5\x5f\xbb\xff\xfb\x66\x4c\x6c\x62\xdc\x08\x86\x03\x9f\x84\xf4\x86\xe9\x25\x3c\x8e\x28\xcb\x69\xd6\x38\x44\x4c\x77\x0d\x25\x3d\x48\x65\xd8\x50\x37\x2b\xc1\xec\x0f\xf0\xb8\xf1\x91\x77\x08\x49\xeb\xe3\x6f\xf4\xcf\xc2\xad\x12\x2f\xe1\xc6\x90\x20\xe8\x0d\xb7\x21\x28\xb2\x40\x17\x00\xd2\x68\xff\x02\x86\xc7\x1b\x6e\x82\x51\xc4\xc7\x6b\xd4\x7a\x0b\xb8\x5e\x7a\x43\xf8\x7d\xeb\x68\x31\xfd\xed\x55\x79\x6d\x45\xda\x6d\xc9\xfe\x55\xc6\xcf\xbc\x63\xf9\x91\xdc\xb4\xc7\x5b\xeb\xe2\x81\x0d\xda\xaf\xc2\x1b\x21\x73\xc7\x41\x73\xda\xfc\x83\x75\xb4\xf2\x72\x45\x99\x61\xd2\x7c\xd6\x4d\xba\x97\xa8\xe6\xca\x4d\x0f\xcb\xe1\x9f\xc0\xde\xb1\x83\x1f\xe3\xf5\xe9\xcd\xc0\xdb\x71\x1f\xcf\xbe\xd7\xc6\x3f\xf8\xce\x70\x3d\xa8\x28\x0b\xf2\xd7\xba\x64\xd0\xcb\x9f\x6b\x5c\x9c\xa1\x17\x64\x8d\xeb\x22\x90\xcf\xac\xb8\x7b\xdc\x12\x38\x30\xea\xaf\x69\x91\x67\x58\xe4\x80\xb3\x33\x8a\xc4\x4b\x51\x72\x23\x5f\x26\x3e\x90\x61\xd6\xa8\xb1\x56\x52\x0c\x80\x0a\x55\x58\x28\x9a\xd5\x05\xf6\x1f\x17\xf5\xda\xdf\x70\xe1\xf5\x63\x44\xcd\x5d\x2b\xee\x97\x24\xe3\xcc\x5f\x84\xbb\xaf\xb0\xf7\xdf\xec\xce\x26\xd8\x40\x44\x50\x08\x87\xf8\x47\x9b\x96\x64\x7f\xe1\x9d\x58\x2c\x9d\x95\x7d\xbe\x76\xba\xad\x51\x18\x81\xd5\xa3\xb6\x44\x5c\x53\x49\xba\x81\x5a\x2a\x11\x35\x71\xf0\xd3\x76\x17\x69\xb5\x80\x5f\x5d\xfe\xf1\x46\x5b\x4e\x5a\x76\x16\x88\x2a\x67\x35\x48\xd2\x94\x60\x75\xcb\xd0\x4e\x6b\x43\xd6\x4b\x75\xcd\x05\xd9\x11\x81\x4e\x72\x0e\xf4\x20\xe0\x7a\x7a\x86\xfe\x4c\x04\x07\xb1\x65\x64\x63\xa2\x7d\x76\xd9\x06\x2f\x1d\x5d\xe9\x4d\x8e\x60\x5b\xd2\xf5\x29\x3a\x31\x31\x5c\x5a\x96\x24\xa7\x58\x91\xe2\xe6\xd4\xc4\xaf\x5d\x8c\xd8\xd7\xed\x4e\xe2\xc5\xef\x7f\x17\x14\xb4\x50\xc2\x05\x74\x21\x5a\xba\x3e\x80\x81\xd9\x53\xd3\xc6\xe6\xdc\x13\x15\xbb\xbd\x07\x75\xf4\xa8\x03\xaf\x93\x8b\xde\x39\x24\xc4\xa8\xe8\x46\xc8\x7e\xd2\x72\x8a\x91\x20\x1b\xbd\x6e\xed\x8a\x3b\x72\x65\x46\x5a\x66\xc3\xe6\x9d\xe7\x65\x98\x25\xbb\x6c\x9b\x6c\x8b\xc3\x59\xe9\xcd\xc5\x73\xce\xd6\x74\x53\xdb\x11\xe7\x6b\xe4\xe2\xf1\x20\xa3\x1d\x5b\x6d\x0d\xa7\x96\xe6\x03\x43\xb3\x32\x78\x30\xf2\xdb\x49\xd8\x0b\xf6\xef\x87\xb5\x1c\x63\xe0\x22\xda\x08\x5e\x83\x93\x68\x14\xec\x3f\x3c\x49\x61\xb3\x4d\x1f\x4f\x66\xdf\x41\x00\x67\x9b\xf1\xca\xae\x6b\xaf\xa7\xd0\x1e\x2e\x7f\xdb\x37\x04\xa4\xfa\xab\xa9\xfe\xea\x7d\xbf\x17\x60\xf4\x22\x80\x03\x27\x70\xc8\xbb\x17\x73\x13\xc0\x67\xc0\xfa\x9f\x33\xeb\x59\x6c\x25\xb3\x9b\xaf\x47\x44\x19\xb5\x19\x23\x7b\x10\x31\xbb\x93\x34\x3d\xa2\x65\x55\xd0\x8c\x2a\x97\x88\xfa\x14\x9d\x80\xa8\x52\xf5\x58\xc2\x82\x5c\xf2\xca\x73\x2b\x8a\x6b\xe7\xc6\x07\x1a\x64\x10\x31\xee\xbe\x1f\xa4\x69\x19\xd1\xab\x43\xf2\x68\x5e\x26\xdd\x21\x00\xec\x12\x96\x79\xad\x23\xd7\xf6\xd2\x38\x40\xd6\xda\x0c\x8c\xbd\x5b\x03\x60\x74\xa3\x1d\xd2\x9f\xeb\xd6\x80\x5b\x49\xe5\x39\x6f\xd2\x76\xfa\x43\x30\x0b\xe0\xbe\x32\x1e\xc7\xc7\xd2\xf8\x32\xa3\x33\x45\x8e\x4b\xc1\x19\x65\x7c\x1a\xd6\x32\x65\xe0\x34\xed\xb8\x0c\x9c\xab\x83\xd4\x9b\x5e\x32\xcd\xd4\x30\xfc\x83\x4b\xbd\x79\xe7\xf7\xc6\x4c\xec\xff\x68\xc6\x4d\xeb\xa5\x99\x3a\xa2\x43\x19\x37\x8b\xa3\x50\xe6\xdf\x29\x13\x02\x7d\xfd\x40\x52\x6f\xce\x0f\x12\x6e\x66\x42\xff\x03\x09\x37\x93\xf0\x51\xdd\xbe\x8d\x26\xdc\xd8\x2f\x4e\x24\xfa\xf9\x13\x6e\x8e\x66\xf3\x3b\xa5\x59\x7c\xad\x0e\x71\xf9\x13\x89\x02\x63\x5b\xbc\x03\x43\xd7\x64\x2a\x58\xa3\xd5\x39\x9d\x22\x6a\xd0\xec\xb7\x95\x4d\x8f\xaf\x04\xb1\x4e\x22\xcc\x9c\xeb\xe6\x08\x10\xfd\x1d\x00\xe8\x53\xb6\x11\xb4\x5f\x4d\xb6\xd1\xb7\x70\xa3\xd7\x7d\xb2\xf5\xcc\x1d\x63\xc9\xd6\x4b\xb6\x5e\xb2\xf5\x92\xad\x37\xde\xb9\x64\xeb\x0d\xf7\x2d\xd9\x7a\xc9\xd6\x4b\xb6\xde\x68\xfb\x2d\xda\x7a\x9f\xe3\xb2\x82\x1f\xef\xe4\xb2\x02\x6d\xa0\xb9\xd4\xcb\x5f\xc1\x6d\x05\x8d\x4f\x39\xf8\xe8\xaf\xf2\xa2\x82\x54\x10\xf6\xf3\x14\x84\x65\x43\xf7\x0e\x04\xc8\xc6\xd7\x81\x6d\xee\x1d\x08\x85\x35\xdd\xad\x04\xde\xe7\xe2\x8f\x19\xfb\xba\x20\x62\x9f\x19\xbd\xd5\x79\xf8\xe4\x0a\x97\x11\xc4\xa8\x13\x7d\x4e\x31\x37\x17\x68\x39\x7c\xfb\x2e\x26\x47\x39\x7a\x6b\xdc\x3f\xa3\xf6\x00\x04\x87\x0c\xc7\x9f\xf5\x7b\x21\xb6\x01\x4c\xb4\x89\x32\xc7\xdc\x61\x6e\x9a\x01\x8f\x00\x9d\x2b\x9b\x29\xdd\x9d\x1f\xd0\x3a\x10\x44\x9c\xe0\x0b\xc0\x48\xd6\x2b\x7b\x66\xd1\xb2\xf6\x66\x6f\xd2\x53\xe4\xcb\xd7\x92\x37\x24\xb6\x25\x6f\x48\x6c\x4b\xde\x90\xb1\x96\xbc\x21\xc9\x1b\x92\xbc\x21\xc9\x1b\x32\xce\x45\xf2\x86\xdc\x43\x6f\x48\x8a\x7c\x25\x5b\xcf\xdb\x92\xad\x97\x6c\xbd\xfd\x96\x6c\xbd\x64\xeb\x25\x5b\x2f\xd9\x7a\xe3\x5c\x24\x5b\xef\x1e\xda\x7a\x93\x3e\x10\x4b\x3a\x6e\x20\x97\x87\x0e\xeb\x88\x18\x4d\x80\xd5\xc8\x62\xbc\x47\x15\xe2\x1d\xc5\xc0\x41\x90\xc1\xd3\x87\xe6\x4e\x93\xb8\xfa\xbb\xae\xca\xae\x87\x62\xaa\xbf\x9b\xd0\x75\x09\x5d\xf7\x60\xd0\x75\xa9\xea\x6e\xaa\xba\x9b\xaa\xee\xa6\xaa\xbb\xa9\xea\xee\x40\x4b\x55\x77\x27\xb5\x54\x75\x37\x55\xdd\x1d\x69\xa9\xea\xee\x94\x96\xaa\xee\xa6\xaa\xbb\x53\x5a\xaa\xba\x9b\xaa\xee\x0e\xbd\x96\xaa\xee\xa6\xaa\xbb\xa9\xea\xee\x2d\xbf\x92\xaa\xee\xa6\xaa\xbb\xa9\xea\x2e\x4a\x55\x77\x53\xd5\xdd\xfb\x02\x64\x4b\x55\x77\x03\xcf\xa5\xaa\xbb\x09\xcc\xf6\x19\xc1\x6c\xf0\x63\xbf\x9a\x6e\x44\xac\x27\xb2\xd6\x6e\x8b\x6a\x0b\xc7\xa4\x22\x6b\xed\xb6\xd5\x74\x23\xd8\x4c\xb5\x76\x03\x2d\xd5\xda\x4d\xb5\x76\xfd\x9d\x49\xb5\x76\x03\x2d\xd5\xda\xb5\x2d\xd5\xda\xbd\xdf\x39\xf0\xa9\xd6\x6e\xaa\xb5\xfb\x30\x52\xe1\x53\xad\xdd\x54\x6b\x37\xb2\xa5\xbc\xf0\xfb\x98\x17\x9e\x6a\xed\xa6\x5a\xbb\xa9\xd6\x6e\xaa\xb5\x1b\x6a\xa9\xd6\x6e\xaf\xa5\x5a\xbb\xa9\xd6\xee\x6f\xac\xd6\xae\xc9\x6b\x67\x8a\x1e\x07\xaa\xea\xae\x92\x03\x64\x95\xa7\x17\xc6\x17\x8e\x77\x9c\xe6\xa8\xaa\x95\x32\x0b\x25\x02\x5d\xe5\xa1\x69\x71\x57\x09\x5d\xd5\xa2\xab\x7a\xd3\xd3\xc1\xdf\xcc\x0b\x89\x04\x20\x56\x01\xa2\x0e\x80\x35\x0d\x62\x15\x20\x6a\x01\x58\xd3\x20\x56\x01\x9a\x0e\x80\xf5\x80\x20\x56\x63\xf3\x9c\x70\x56\x09\x67\x95\x70\x56\x09\x67\x95\x70\x56\x09\x67\x95\x70\x56\x53\xde\x4f\x38\xab\x7d\x6a\x09\x67\x15\x6a\x09\x67\xd5\x69\x09\x67\x95\x70\x56\x09\x67\x95\x70\x56\x09\x67\x95\x70\x56\x09\x67\x75\x47\xaf\x24\x9c\x55\xc2\x59\x25\x9c\x15\x4a\x38\xab\x84\xb3\x4a\x38\xab\x08\xb2\x09\x67\x75\x6f\x70\x56\xbd\x90\xcd\x30\xd8\x2a\xd0\xe3\x3e\x5c\x29\x12\x6c\x15\x8a\x7d\xd1\xa2\x88\x06\x5b\x75\xbb\x30\x25\xf0\x15\x89\xb8\x0a\x05\xbd\xba\x78\xac\x58\xc4\x55\x80\x66\x1f\x8f\x35\x05\x71\x15\x20\x7c\x58\x65\x2c\x8c\xb8\x0a\x91\x74\x78\xac\x84\xb8\x4a\x88\xab\x40\x4b\x88\xab\x88\xce\x24\xc4\x55\xa0\x25\xc4\x95\x6d\x09\x71\x95\x10\x57\xf1\x44\x12\xe2\xca\xdf\xc3\x84\xb8\x4a\x88\xab\x84\xb8\x0a\xb6\x84\xb8\x4a\x88\xab\x0e\x83\x09\x71\x95\x10\x57\x31\x2d\x21\xae\x12\xe2\xaa\x7d\x36\x21\xae\x12\xe2\x2a\x21\xae\x7a\x9f\xff\x5c\x88\xab\xc0\x03\x8a\x17\x80\xc8\x18\xf5\xc7\x78\x35\xc8\x01\x94\x40\x2f\x54\xd0\x55\x57\x0d\x5d\xd8\xec\x95\xc2\x00\x26\x50\xdc\x7d\xd1\xa3\x66\xb5\xb0\xe8\xdd\x4b\x59\xdc\x4b\x07\x90\xa1\x04\x5c\x0a\xf6\x75\xb3\xdf\x2f\xc8\x7a\x4d\x32\xf5\x0d\xaa\xa5\x6f\x36\x1b\x8b\x00\x96\x8b\xdb\x6b\xbf\x76\xff\xf7\xcd\x98\xd8\xc4\xb8\x11\x0c\x07\x3e\x09\xe9\x0d\xd3\x4b\x78\x1c\x51\x96\xd3\xac\x71\x88\x98\xee\x1a\x4a\x7a\x90\xca\xb0\xa1\x6e\x56\x82\xd9\x1f\xe0\x71\xe3\x23\xef\x10\x92\xd6\xc7\xdf\xe8\x9f\x85\x5b\x25\x5e\xc2\x8d\x21\x41\xd0\x1b\x6e\x43\x50\x64\x81\x2e\x00\xa4\xd1\xfe\x05\x0c\x8f\x37\xdc\x04\xa3\x88\x8f\xd7\xa8\xf5\x16\x70\xbd\xf4\x86\xf0\xfb\xd6\xd1\x62\xfa\xdb\xab\xf2\xda\x8a\xb4\xdb\x92\xfd\xab\x8c\x9f\x79\xc7\xf2\x23\xb9\x69\x8f\xb7\xd6\xc5\x03\x1b\xb4\x5f\x85\x37\x42\xe6\x8e\x83\xe6\xb4\xf9\x07\xeb\x68\xe5\xe5\x8a\x32\xc3\xa4\xf9\xac\x9b\x74\x2f\x51\xcd\x95\x9b\x1e\x96\xc3\x3f\x81\xbd\x63\x07\x3f\xc6\xeb\xd3\x9b\x81\xb7\xe3\x3e\x9e\x7d\xaf\x8d\x7f\xf0\x9d\xe1\x7a\x50\x51\x16\xe4\xaf\x75\xc9\xa0\x97\x3f\xd7\xb8\x38\x43\x2f\xc8\x1a\xd7\x45\x20\x9f\x59\x71\xf7\xb8\x25\x70\x60\xd4\x5f\xd3\x22\xcf\xb0\xc8\x01\x67\x67\x14\x89\x97\xa2\xe4\x46\xbe\x4c\x7c\x20\xc3\xac\x51\x63\xad\xa4\x18\x00\x15\xaa\xb0\x50\x34\xab\x0b\xec\x3f\x2e\xea\xb5\xbf\xe1\xc2\xeb\xc7\x88\x9a\xbb\x56\xdc\x2f\x49\xc6\x99\xbf\x08\x77\x5f\x61\xef\xbf\xd9\x9d\x4d\xb0\x81\x88\xa0\x10\x0e\xf1\x8f\x36\x2d\xc9\xfe\xc2\x3b\xb1\x58\x3a\x2b\xfb\x7c\xed\x74\x5b\xa3\x30\x02\xab\x47\x6d\x89\xb8\xa6\x92\x74\x03\xb5\x54\x22\x6a\xe2\xe0\xa7\xed\x2e\xd2\x6a\x01\xbf\xba\xfc\xe3\x8d\xb6\x9c\xb4\xec\x2c\x10\x55\xce\x6a\x90\xa4\x29\xc1\xea\x96\xa1\x9d\xd6\x86\xac\x97\xea\x9a\x0b\xb2\x23\x02\x9d\xe4\x1c\xe8\x41\xc0\xf5\xf4\x0c\xfd\x99\x08\x0e\x62\xcb\xc8\xc6\x44\xfb\xec\xb2\x0d\x5e\x3a\xba\xd2\x9b\x1c\xc1\xb6\xa4\xeb\x53\x74\x62\x62\xb8\xb4\x2c\x49\x4e\xb1\x22\xc5\xcd\xa9\x89\x5f\xbb\x18\xb1\xaf\xdb\x9d\xc4\x8b\xdf\xff\x2e\x28\x68\xa1\x84\x0b\xe8\x42\xb4\x74\x7d\x00\x03\xb3\xa7\xa6\x8d\xcd\xb9\x27\x2a\x76\x7b\x0f\xea\xe8\x51\x07\x5e\x27\x17\xbd\x73\x48\x88\x51\xd1\x8d\x90\xfd\xa4\xe5\x14\x23\x41\x36\x7a\xdd\xda\x15\x77\xe4\xca\x8c\xb4\xcc\x86\xcd\x3b\xcf\xcb\x82\xd7\x8a\xfc\x89\x4b\xa5\x0f\x13\x87\xb3\x71\x60\x92\x91\x4f\x8a\x08\x86\x0b\xb4\xb5\xef\x80\x53\x23\xcb\x88\x94\xe8\xf2\x86\xe5\x44\x0e\xb8\x1c\x46\xfb\x37\xc2\x98\x54\x58\xd5\x7b\x9a\xa7\xc7\x89\xfb\xd2\x25\x3c\x68\x0f\x31\x16\x33\xbd\x92\x44\xec\x48\x0e\x44\x40\x57\x0c\xb2\x35\x6e\x8a\xad\x70\xf6\xb1\xae\x0e\x87\xc2\x6f\xbc\x31\xf2\x69\xc4\x68\xeb\x31\x0e\x16\x94\x95\x62\xfd\x8a\xfd\x1a\xaa\x0a\xcc\xd8\x88\x25\x15\x90\x8e\x4a\x90\x1d\xe5\xfb\xc3\x35\xfe\xf5\x6b\x6c\xd5\xb1\x7d\xcf\xb1\x60\x32\x4e\xe6\xf0\xe0\x11\xaf\xee\xe7\xe3\xc5\x02\x54\x4d\x46\xde\x57\x1b\x81\xf3\x01\xa9\x34\x2f\xae\x38\x2f\x08\x66\x7b\xbf\x16\x58\x2a\xfb\xe2\xb7\x98\x16\xb5\x18\x78\xdf\x29\xb2\x1c\x2b\xb2\xd4\x1b\xce\x14\xd6\xaa\x2d\x96\xa3\x3c\x0d\xbe\x21\x08\x96\x13\xfb\xaf\xb0\xd8\x10\xf5\x81\x08\x39\x75\xe4\x6a\xd3\xf7\x73\xa5\xb4\xce\x1a\x90\x0a\x9f\x76\xde\x4d\xfe\xe0\xe0\xdc\x1f\xfc\xd1\x2c\xc9\x67\x68\x8d\x0b\x69\x06\x5b\x2a\x2e\xf0\x86\xf4\xfe\x54\xaf\x9a\x44\x03\xc7\x81\xd5\x04\xe8\xef\xff\xfc\x42\x1f\x8b\xbb\x3e\x67\xc8\xea\x79\xce\x8b\xba\x74\x87\xcd\xe5\xc0\xdd\x14\x76\xed\x9b\x59\xb3\x44\x7f\x92\x9c\x5d\x60\xb5\x7d\x86\xce\x0c\xfd\xb3\xee\xaf\xa0\x08\xd1\x45\xe7\x2f\x07\x9d\xf7\x7d\xc8\x0e\xe1\xe8\xa7\xfa\xbf\x9b\x8f\x7d\xe8\xfd\xed\xe0\x73\xe6\xa1\xdd\x97\x2b\xa2\xb0\x49\x78\x94\xd9\x96\x94\xd8\x0d\x12\xaf\x08\x3b\xbf\x78\xf5\xe1\xab\xcb\xde\x9f\x47\x74\xa6\x53\x3f\xe6\x61\x30\x59\xe1\x9f\xf0\x33\x91\xe8\xfc\xe2\x55\x43\x60\x58\xe7\xe1\x8a\x0e\x4a\x66\xef\x73\x8f\x35\x47\xe6\xa9\x9e\x7e\xb6\xfd\xd7\x0a\xda\x30\xe0\xee\x61\x68\xac\x45\xd8\xc8\xf7\x24\x10\xdc\x40\x56\xa2\xce\xd0\xa5\x16\x27\x21\xdd\xfe\x9c\x71\xb6\x23\x42\x21\x41\x32\xbe\x61\xf4\x6f\x0d\x6d\xe9\xb2\xa2\x20\x57\x60\x5f\x37\x81\x00\xe9\xad\xcc\x58\xec\x60\x60\x95\xf8\x06\x09\xa2\xbf\x82\x6a\xd6\xa1\xe7\xe2\x92\x3f\x70\xc8\x27\x5b\xf3\x67\x68\xab\x54\x25\x9f\x3d\x79\xb2\xa1\xea\xec\xe3\x7f\xc8\x33\xca\x9f\x64\xbc\x2c\x6b\x46\xd5\xcd\x93\x8c\x33\x25\xe8\xaa\xd6\x67\x8f\x27\x39\xd9\x91\xe2\x89\xa4\x9b\x25\x16\xd9\x96\x2a\x92\xa9\x5a\x90\x27\xb8\xa2\x4b\x60\x9d\xc1\xf9\xe6\xac\xcc\xff\xa5\x91\xff\xc7\x03\x8b\xec\x60\xf5\x7d\xa4\x2c\xf7\xcd\xc0\xf7\x94\xe5\x36\x25\xa3\x03\x39\x6c\x07\xda\xb9\x19\xdf\xbd\xbc\xbc\x6a\x72\x7c\x60\x32\xf6\x47\x1f\xc6\xbd\x63\xcf\xb7\x53\xa0\x07\x8c\x32\xb8\xf0\x05\x4c\x6b\xc1\x4b\x73\xcd\x06\xcb\x4d\x4e\x23\x5c\x3f\x50\xd0\xc3\x3c\x12\x59\xaf\x4a\xaa\xa4\xbb\x37\x04\x72\x12\xd0\x73\xcc\x6c\xe2\xa6\xc9\x5f\xcc\xcf\xd0\x2b\x86\x9e\xe3\x92\x14\xcf\xb1\x24\x77\x3e\x01\x7a\xa4\xe5\x52\x0f\x6c\xdc\x14\x94\x44\xe1\x1c\x2b\xfc\x2c\xca\xac\xa9\x48\x16\x63\xd4\x54\x24\xeb\x2d\x19\xfd\x57\x71\x84\x45\x83\xf3\x7c\xd0\x21\xb7\x77\x06\x37\x8a\x55\x3f\x8d\x38\x43\x6b\x82\xf5\x20\x59\x37\xac\x3e\xa3\x6a\x33\x98\xe1\x55\x31\xe4\x16\xf5\x1b\x47\x3f\x61\xbd\xd7\x0c\x1b\x28\x61\x9f\x98\x91\x9e\xb7\xac\xf0\xb8\x74\x7c\x16\x41\x43\x87\x17\xc6\x93\xfe\x5e\xd0\x10\x25\xaf\x2d\x6e\x47\xe1\x38\x6e\x68\x89\x37\xe4\x7c\x43\x98\xc7\xd7\x17\xc1\x8b\x21\x53\x14\xaf\xd8\x5b\xe6\x39\x4d\xc5\x52\x7a\x1b\x74\xdd\x44\x50\x72\x87\xaa\xe3\xa7\x0c\x92\x11\x8f\x9d\x2e\x89\xcb\xaa\x20\xe2\x02\x0b\xec\xb9\x90\x25\x9e\xd0\x95\x7e\x72\x3e\x9d\xc0\x49\xee\x23\x83\x13\xfe\xdc\xc5\x72\x0b\xb2\x19\x60\x90\x57\x23\x1f\xbf\x0f\xcc\x55\xf5\xaa\xa0\xd9\x79\x35\x22\x31\x61\x16\x73\x2a\x35\x8f\x4b\x89\xc5\x32\xdb\x92\xec\x63\xe4\x95\x72\x74\x0d\xd9\x64\xda\xde\x10\xb5\x41\x2a\x30\x08\x3d\xe0\x5a\xff\xaf\xa2\x26\xe2\x56\x4b\x22\xb4\x32\xf5\x38\x03\xec\x61\x5a\x2b\xfe\xf3\x8b\x57\x67\x3d\xe7\x15\x31\x04\x18\x21\xb9\x6c\x1e\xe4\x68\x43\x54\x28\xc2\x68\x43\xc7\xc6\xd4\xc3\xe2\x8d\x0b\x1d\x1e\xb5\x3c\x6f\x45\x11\x06\x3c\x10\xae\xf5\xb7\x4a\xa2\xd0\xbb\xee\x7b\xce\xd0\x6b\xbc\x12\xed\x9d\x6c\x5c\x7a\x23\x14\x8d\xe1\x7e\x01\xa2\x03\x63\x7e\x67\x8b\x5b\xf1\x9c\xdf\xd7\xc5\xe3\xf9\x71\xcc\x25\xd2\x9f\x13\x17\xc9\x31\x4f\x4f\x34\x11\x1c\x4e\x27\xc2\x8b\xa1\x27\xbf\xb9\x5a\x50\x1f\x5f\xac\x03\x23\x13\x9c\xfd\xc4\x57\x83\x04\x08\xab\x47\x74\xff\x12\x6d\x79\x2d\x46\xb0\x2f\x4b\x94\x63\x3a\xfa\x5b\x49\x73\x36\x0a\xea\x5a\xa2\x6b\x42\x3e\x8e\xbf\xcb\x99\xda\x8e\xfe\x7a\x43\xf0\x38\x4b\x64\x47\xc4\x0d\xfa\x6a\x18\xf9\x32\xd7\x51\x93\xf1\xb2\xe2\x4c\xdb\xf6\x81\x69\x7e\xde\x3c\xa8\x8f\x16\xb5\x34\x61\xd8\x8c\xb3\x35\xdd\xd4\xc2\x06\x30\xcc\x95\x63\xa4\x43\x75\x68\x23\x19\x31\x64\x51\x50\x58\xb4\xc5\xbd\x1a\xf4\xc2\x84\xdf\xd5\xcd\x39\x31\x5f\xac\xde\xbf\x7b\x1d\xa9\x72\x5e\xad\xbb\x91\xcf\x5a\x12\x48\xe5\x72\xde\x50\xc7\x11\xd2\x4a\x96\x60\x9f\xc2\xb1\x9a\x49\x3f\x88\x8b\xc2\xe0\xc7\xe4\xd8\x38\xb8\x16\x61\x9d\x4c\x50\x9f\x6f\x3a\x1a\xf2\x82\x4b\xb5\x11\xe4\xf2\xbf\x5f\xb7\x9d\x30\x3b\x8b\x47\x6d\x46\xb0\x73\xe0\xca\x09\xf0\xf4\xce\x9d\x3f\x2b\xc1\x77\x14\xdc\x06\xbd\x9c\x31\xcb\xae\xe3\x71\x94\x68\x5c\xaa\x7e\x41\x4b\x1a\x80\xc5\xc6\xe7\xfc\x67\x55\x1d\x4e\x44\x9a\x90\x26\x52\x92\x92\x8b\x88\xdc\xa6\x68\x92\x91\x39\x22\xf6\x24\x9e\x86\xa5\xdf\x76\xbc\xa8\x4b\x72\x0e\x6b\xe2\x07\x3e\xe4\x8f\xee\xb6\xf1\x9d\xc6\xb5\x25\x7a\x47\x70\xfe\xa3\xa0\x8a\xbc\x65\x1e\x0b\xac\x7d\x56\x1f\xa4\x7e\xc0\xcc\x9f\x0a\xd4\x21\x1b\x7c\x36\x72\x88\x4c\xcf\x9f\xe3\x0a\x67\xde\xcb\x95\x27\x93\x8c\xc9\x34\x9e\x93\x5f\x7c\x37\x53\xff\xc6\xab\x59\x27\x7c\xd7\x90\xbb\x34\xae\xef\xe7\x05\x96\x81\x0e\xc5\x45\xec\xc3\x5d\xa9\x45\x11\xa9\x85\xb5\x3d\x0d\x8e\xd0\x8a\x0b\xe5\xdb\x22\x9a\xa8\xdf\x31\x7b\x84\x3e\xcc\x44\x32\xf6\x5e\xea\x8d\xb6\x34\x06\x5f\x87\x21\x38\x0f\x81\x8b\x0a\x50\xdf\x2b\x38\x21\xf9\xb6\x5f\xfd\xbe\xe1\xdc\xb9\x3f\x83\x5b\xca\xb1\x56\xff\x46\xe0\x35\x66\x78\xae\xb5\x12\xb1\x93\xa6\x4d\x6f\xec\xc1\xb4\xe9\x0d\x3e\x18\x18\x96\x63\xf3\x40\x4b\xb2\xef\x12\x77\x2d\x3c\x92\x3f\xe1\x1d\x36\xce\xe8\x58\xd3\xf1\xbf\xce\x3f\x9c\xff\xef\xdb\x8b\xab\x57\x6f\xdf\x5c\x22\xc2\x76\x54\x70\x06\x08\x8b\x1d\x16\x54\x1f\x9a\xef\xd8\x8e\x4d\xab\x6f\xec\xc1\xb4\xfa\x06\x1f\x4c\x26\xe7\x68\x4b\x26\xe7\xaf\xc1\xe4\x0c\x85\x10\x70\xad\xb6\xb3\xc3\x81\x82\xe4\x84\x29\x8a\x0b\x79\x49\x32\x41\x3c\xca\x63\xcf\x82\x04\xcc\x6b\xc7\x69\xa3\xb9\x30\xee\x86\x9c\x88\x85\x75\xef\xdc\x04\x2e\x0e\xe9\x7a\x97\x25\x7c\xdf\x96\x2c\x51\x5c\xb8\x3a\x0f\x2d\x87\x0b\x44\xc9\x59\xf3\x0d\x0f\x59\x3d\x62\x0b\x1b\xea\x44\x34\x5f\xa0\x8c\xf3\x8f\x94\xa0\x7f\x75\x7f\x33\xdf\x3a\xca\xdc\xce\xc4\x4d\xa5\xf8\x73\x5e\x96\xd3\x46\x0e\x6a\x99\x1c\x76\xdb\xf9\xfe\xa0\xe7\xf0\xc3\xd5\xeb\x4b\x4f\x17\x33\x3d\xaf\x6b\x08\x84\x80\x15\x2e\x49\x56\x0b\x82\xfe\x74\x75\x75\x71\x89\x6c\xe4\x3e\x1b\x4a\xfe\x98\xd4\x49\x1b\xc0\xb9\xc4\xe2\xf9\x84\xf0\xcd\x4b\x70\xae\x23\x2e\xdc\xfb\xe8\xf2\xfc\x1d\x82\x00\x90\x29\x92\xa2\xf8\x86\xa8\xad\x67\x0a\xe3\xe2\x25\x8d\x6d\x32\xce\xd7\x54\x25\x14\xb5\x62\xf7\x3a\x7b\x68\x22\x49\x7b\x65\x95\xc1\x38\xc0\xbc\xe6\xd9\x13\xe7\x97\x5c\xc2\x8a\xad\x04\xff\x74\x54\xb5\x26\xd9\x09\x3c\x4d\x90\xbd\x4e\xcc\x4b\x71\xb4\xc5\x3b\xc8\x25\x2f\xa9\xbd\xb3\x45\x1f\x45\x15\x2a\x08\xf6\x5e\x72\xbd\x21\xaa\x73\xb5\x8b\x41\x82\xb1\xce\x95\x49\x95\xe0\x3f\x19\x24\x0d\xe2\x22\x37\x05\x8c\x56\x44\x9f\xdd\x98\x1f\x5e\xd6\x86\xe1\xda\x34\x2a\x2a\x9d\x7f\xf7\x48\x79\x0e\x85\x3b\x05\x2f\xb5\x58\x8e\x65\x7f\xa6\xc3\xa5\x69\xc9\xbc\x1d\x6c\xc9\xbc\x4d\xe6\x6d\x32\x6f\xa7\x77\x25\xf0\x00\x64\x3b\xcf\x4f\x77\xe3\x8c\x91\x4c\xcf\xd3\x05\xe7\xb1\x7e\xdb\xe7\xcd\x4b\x48\xbf\x85\x2a\x2c\x70\x49\x14\x11\x36\x44\x4b\x59\x13\x63\x5c\x1a\xf6\x7c\x16\x05\x47\x25\x66\x78\x43\x6c\x20\xd7\x91\x6e\x36\xb9\xd6\x0b\x4c\xcb\xce\x55\x11\xbe\x85\x47\x19\x2a\x69\x51\x50\x69\xb0\x61\x47\x6e\x36\x2d\x4f\x57\xb4\x24\xbc\x0e\x68\xd8\xbd\x9b\x43\x3e\xd1\xb2\x2e\x11\xab\xcb\x15\x11\x70\x0b\x47\x87\x31\x6b\xcc\x47\x8f\x15\x72\xf7\x8d\x5e\x63\xaa\x8c\x73\xb9\xc3\x5d\x9b\x0f\x5b\x71\xee\x47\x6a\xc5\x81\xa6\x10\xa2\x79\x41\xe6\x77\x1a\x97\xbc\x36\x57\x8f\x58\x9c\x1d\xa0\x10\x5b\x86\x03\x3d\xa5\xd2\x61\x7e\xc1\xf0\xa7\x0a\xd8\x71\x86\xd4\xad\xf5\xb1\x20\xf8\xe3\x0b\xa2\xec\x0c\x6f\x05\x91\x5b\x5e\x04\x50\xf3\xbd\xee\x86\xba\xe9\xf2\x59\x79\x1d\xba\x1f\xc4\x1e\x79\x74\xd7\xd0\x8a\xac\xf5\x49\x07\xa3\x92\x48\xa9\x97\x07\x95\xa8\xe0\x9b\x0d\xac\x2f\xc0\x5f\xc3\x09\x33\x40\xb1\xe2\x52\x52\x7d\xc2\xe8\xb0\xa3\x3b\x7c\x2b\x23\x57\xe2\x4f\xaf\xe9\x9a\xe8\x5e\xcf\x90\x8e\xc2\xbe\x6a\xa0\xa7\x1d\xfe\x6e\x7b\x82\xed\x07\xb5\xaa\xba\xa4\x7f\x9b\xc3\xaa\xa4\x7f\x23\x2d\x48\x1b\xa6\xa7\x27\x9c\x81\x49\x10\x04\x67\xdb\x05\xa2\x2c\x2b\x6a\xb8\x5d\x76\xc5\xd5\xd6\x08\x33\x66\x7a\x3a\x97\xb5\xec\xa9\xbe\xdb\xe9\x36\x65\x9a\xf9\x57\xf9\x58\x9e\xd3\x70\x97\xcd\x5b\x1d\x85\x05\x7c\x76\xf5\x72\x89\x29\xdc\xd0\x13\xbc\x07\xe3\x76\x27\x32\x62\xcf\x77\x69\xe4\xc7\x1e\x2f\x70\xad\x6d\x17\xb6\x99\x30\x6e\x87\x67\x7a\x47\x64\x81\x56\x0d\x7e\xd7\xfd\x98\x87\xee\x50\xe9\xa4\x77\xc2\x5d\xc4\xcc\x1b\x59\x40\x91\x2e\x01\xc3\x75\x55\xf0\x9b\x57\x7a\xb0\xbd\x97\x67\x0c\xf6\xf2\xc7\x2d\xf8\x25\xd0\x35\xb1\x74\xcc\xac\x59\x42\xb7\xc2\x5f\x87\xe0\xeb\xf0\x29\xaf\xc7\xdd\x0f\x07\x9b\x6d\x97\x3b\x77\xe1\x96\x3e\xe0\x87\x8e\x3e\x98\xa1\x0c\x90\xcc\xb7\xb3\x8b\xb6\x5c\x5c\x2a\xac\x08\xf8\x8a\xf4\x0c\x88\x1d\xf6\xd8\x5c\x07\xfd\x73\xaf\xc0\xce\x0f\xee\x22\x17\x55\x6e\xc0\x1a\x26\xd9\x6b\xd2\x94\xc4\x28\x50\x6d\x9f\x95\x84\xa9\xf7\xa2\xf8\x96\x8b\xaf\x64\x86\x27\x29\x15\xf3\x42\x87\x0e\x7a\xff\xee\xf5\x6d\x58\xe4\x25\xde\x91\x29\x57\xc9\xff\xa0\x9f\xd7\x8b\x4b\x2f\x4c\xff\xe0\xc4\x1f\x94\xdb\x93\xce\xb9\xd8\xd4\xe5\x70\x5a\xa3\x97\xad\x96\x82\xe9\x11\xe2\x55\x63\x02\xdb\x24\x03\x44\x43\xe6\x12\xea\x4b\x1a\x5a\xd5\xb4\x88\xb8\x07\x6a\xc2\x09\x1e\x57\x15\xd9\x07\x81\x05\xfb\x76\x69\x60\x73\xd7\xc4\xbe\x8e\x18\xb9\x46\x82\x54\x5c\x52\xc5\x45\xe8\x86\x13\x34\x41\x73\x20\xd8\x6a\x5b\xc2\x61\x46\xe7\xde\x2a\x38\x61\xcc\x06\xd2\x89\xbb\x3c\xc2\x52\x86\x39\x8f\x1c\x86\xe8\xbb\x97\x8e\x0d\xba\xa7\xd8\xf8\x6f\xca\x4b\x96\x9c\x87\x73\x1e\x0a\x3c\x50\x8f\xdd\x29\x80\x3e\xaf\xbb\xfc\xd6\x1d\x81\xc7\x0d\x8c\xe7\xc7\x9c\x94\xfc\xc5\x01\xc4\x15\x8d\x58\xdb\x97\x80\x91\x7b\xf1\x47\x38\x46\xe9\x57\xc1\x59\x04\x3a\x35\x70\x3d\xc8\xf0\x6e\x02\x17\x41\xfc\x40\xed\x0e\x1a\xe0\xe1\x5b\xfd\x30\x2a\xdd\xd3\xda\xfa\x7a\xfe\x4e\xef\xd8\x7a\x83\xeb\x03\xef\xe3\xbe\x4e\xd9\x5a\xe0\xb6\x6e\x4e\x10\x1a\x61\x40\x10\xcd\xc7\x5d\x9d\x12\x18\x8c\xce\x1d\x76\x6b\xb8\xcd\x71\x2d\xb0\x54\xa2\x06\x2c\xf2\xc0\x8c\x35\xd8\x89\x61\x68\x97\x5f\xcc\xdc\x15\x94\xc3\x82\xd5\xbf\xfa\xdf\x31\x09\x48\xf1\x8d\xe0\x35\x5c\xa4\xdb\x94\xf5\xe9\x14\xe1\x11\x75\x31\x76\x6b\x7e\x58\xea\x19\xcf\xc9\xb9\x97\xad\x03\xd6\x5e\xc0\x3f\x56\xc4\xd4\x98\x19\x67\x49\x0f\xa8\xcf\x9f\x69\xae\x12\x1c\x87\x75\xc5\x2d\xd9\x0a\xae\xac\xbb\x8b\x72\x4a\x70\x81\x84\x45\x34\x09\xe3\x4e\x34\x1f\x03\x2f\x9b\x83\x3a\x45\xdc\x21\xaa\x45\x1d\x4a\xe7\x18\x2f\x26\xd4\xb4\x31\xb7\x3b\x35\x63\x47\x3a\xd5\x2e\x66\x15\x69\x5a\xa0\x55\xad\x10\x55\x00\xfd\xcf\xb6\x9c\x4b\xe2\x2a\xb0\xc0\x57\x77\x94\xc7\xd4\x3a\xe7\x0c\x8e\xe6\x25\x17\x5d\xb0\x9e\x63\xcd\xd4\xcd\x68\x89\x52\x89\x4a\x1e\x2c\x62\xdc\xcc\x90\xbb\xe3\x42\x7f\xa4\xb9\x83\x75\x03\xc7\x47\xa9\x90\xac\x4b\xfd\x49\x53\x91\x2b\x78\x77\x2a\x14\x0c\xd2\x2b\x16\xaa\x0f\xb5\x2c\x95\x84\x28\x93\x1b\xe0\xd2\x22\x1a\x81\x08\x5a\xc4\x1d\x5c\xcb\x49\x73\xf1\x82\xdd\xef\x17\xa3\x65\xbb\x42\x67\x81\x81\x29\x5e\x20\xa2\xb2\xb3\xd3\x05\x68\x93\x5a\xe9\x99\xd2\xbd\x5f\xdd\x20\xaa\x40\x1b\x05\xa9\xaa\xad\xe0\xf5\xc6\x8c\xa0\xab\xd6\xd4\xdc\xd9\x61\xab\x76\xb1\x1c\xec\x76\xb6\x41\x8f\xcc\xa0\x86\x2e\x13\xb6\x71\x04\xcd\x0a\x5d\x37\x45\xb9\x7a\x77\x73\xf6\x8b\x3f\xed\x5f\x94\x1d\xba\x01\xd9\x91\x3c\x91\x9d\x4b\x78\xb7\x74\xb3\x75\xf3\x8f\x6d\xca\x8a\x96\xaa\x56\x6e\x6e\xbb\xf8\xd3\x39\xb3\xb7\x2f\xb7\x92\xd9\xad\x69\x46\x44\x19\x75\x61\x19\xb2\x97\x35\x9a\xfd\x59\x9a\x1e\xd1\xb2\x2a\x68\x46\x95\x2b\xd6\xf7\x14\x9d\x80\xa8\x52\xf5\x58\xc2\x82\x5c\xf2\xea\x34\x7c\x81\xed\xb9\xb9\x27\x3a\xc8\x20\x62\xdc\x7d\x3f\x48\xd3\x32\xa2\x57\x87\xe4\xd1\xbc\x4c\x29\xb2\x62\xd8\x25\xcc\x97\x47\xd2\xb6\xbd\x52\x37\x20\x6b\x6d\x95\x1a\x28\x45\xb8\x57\xea\x30\x82\x28\x1a\x10\x53\x33\x15\xf7\xb6\xdc\xd1\x79\x93\xff\xd2\x1f\x82\xae\x42\x8a\xa4\x0b\x9b\x82\xa6\xf2\x58\x9a\xfb\x9e\xa3\xab\xe9\x1c\x57\xa6\x68\x94\xf1\x69\xf5\xe8\x53\x95\xa2\xa6\x1d\x57\xa5\xe8\xea\xa0\x3c\x51\xaf\xe0\xd0\x24\xc2\xe8\x01\x96\x27\x7a\xe7\xbf\xb1\x76\x62\xff\x47\xab\x12\xb5\x37\xd9\x4e\x1d\xd1\xa1\xaa\x44\x8b\x23\x8a\x0c\x21\xf4\x9d\x32\x77\x65\xbd\x7e\x20\xe5\x89\xce\x0f\x8a\x12\x4d\x5e\x59\xa6\x05\x8a\x12\x4d\xaa\x21\xdd\xed\xdb\x68\x51\x22\xfb\xc5\x89\x44\x3f\x7f\x51\xa2\xa3\xd9\xfc\x4e\x69\x16\x5f\xab\xc5\xc1\xa7\x26\x12\x05\xc6\x20\x43\x14\xbb\xe0\x92\x35\x5a\xdd\xc5\xbc\xc1\x2b\x73\x0f\xdb\xca\x96\x10\xad\x04\xb1\x17\xe9\x62\xe6\x62\x34\xa6\x22\xd3\x2c\x5e\xfd\x15\x99\x4c\x8d\xa5\x89\x24\x53\x45\x26\x68\xbf\x9a\x8a\x4c\xdf\xea\xf3\xd5\xbd\xb2\xf5\xe0\xc4\x97\x6c\xbd\x64\xeb\x25\x5b\x2f\xd9\x7a\x9e\xce\x25\x5b\x6f\xb8\x6f\xc9\xd6\x4b\xb6\x5e\xb2\xf5\x46\xdb\x6f\xd1\xd6\x9b\xf4\x01\xe3\x61\x9c\xec\xf4\xfc\xd1\xf8\x88\xf7\xbc\x9c\xbd\x6a\x73\x51\x7d\xeb\xbb\x3b\xb5\x81\xe6\xca\xd3\x5d\x81\x1b\xd5\xe6\x97\x0a\xcc\x36\x04\x7d\xb9\xfc\xf2\xe9\xd3\x18\x09\xed\x14\x16\xf9\xea\xdf\xa3\x47\x2c\x9c\x25\x87\x26\xd5\x5c\x6b\x7d\xca\xc1\x47\xcd\x2c\x84\x62\x10\xd3\xb2\x83\x42\xe2\x32\x16\x15\x3a\x3a\xfa\x68\xb7\xba\x26\x7c\xd4\x0b\x4f\xb5\xd5\x06\x57\xc1\x4a\x74\x9d\x88\x90\x20\x50\xc0\xa6\x24\x0a\x61\xd5\x0b\x29\xd0\xd2\xdc\x8e\x0a\xa5\x8f\x22\x14\xb4\xbd\x0e\xdc\x45\x41\x73\xc4\x99\x8d\x1e\x69\xe9\x3b\xf3\x72\x1f\x20\xdd\xed\x5b\x97\xfb\x8c\xd8\x1b\x8e\x56\xa4\xe9\x01\x2f\x35\xc7\xa1\x02\x3e\xc8\x29\x77\xdd\x39\xe2\xe6\x02\x9d\x90\xb3\xcd\x19\xca\x4d\xe2\x30\x66\xf6\x6e\xf3\xd3\x45\xa7\xee\x4e\x80\x6c\xa9\x4d\x1a\x01\xff\xd1\x03\xa2\xc4\x0d\xe4\x20\xef\x08\x53\x35\x2e\x8a\x1b\x53\x2a\xa8\x19\xd7\xb5\xe0\x21\x8a\x54\x99\xa8\xba\x7f\x7d\xc6\x1f\x33\xf6\x75\xc1\xd4\x84\xcb\x77\x56\xbc\xcf\x46\x4f\xae\x4a\x53\x8d\x51\x27\xfa\x9c\x02\x0f\x83\x1c\xbe\x7d\x17\x53\xc7\x31\x7a\x6b\xdc\x3f\xa3\xf6\x8a\xac\x1e\x32\x1c\x7f\xd6\xef\x85\xd8\xce\x0e\x8b\xe6\x9a\x28\x73\x19\xc0\x59\xb5\xcd\x14\xd8\x05\x3a\x57\xb6\x9a\x64\x77\x7e\x40\xeb\x40\x10\x71\x82\x2f\x00\x23\x59\xaf\xec\x99\x45\xcb\xda\x9b\xbd\x49\x4f\x91\x2f\x5f\x4b\xde\x90\xd8\x96\xbc\x21\xb1\x2d\x79\x43\xc6\x5a\xf2\x86\x24\x6f\x48\xf2\x86\x24\x6f\xc8\x38\x17\xc9\x1b\x72\x0f\xbd\x21\x29\xf2\x95\x6c\x3d\x6f\x4b\xb6\x5e\xb2\xf5\xf6\x5b\xb2\xf5\x92\xad\x97\x6c\xbd\x64\xeb\x8d\x73\x91\x6c\xbd\x7b\x68\xeb\x4d\xfa\x40\x2c\xe9\xb8\x81\x5c\x1e\x3a\xac\x23\x62\x34\xc7\x23\xb8\x2b\x9e\xcf\x06\xc1\x55\x3c\xf7\x60\xe0\x20\xc8\xe0\xe9\x43\xc6\x97\x05\x87\xcb\x57\x21\xd4\xa1\x89\xd9\x68\x9d\xc4\xa5\x89\xa6\x2c\xd0\xdf\x38\x23\x06\x33\xa4\x15\x80\xe4\x03\xa5\xb1\xdb\x06\x95\xe9\x34\xa1\x13\x79\xea\x41\x77\x24\x74\x9d\x9f\x6c\x42\xd7\x25\x74\xdd\xe7\x40\xd7\x6d\xb1\x34\xf7\xde\x1a\x13\x61\x14\x6c\x17\x12\x83\x56\x83\x69\xb5\xf9\x87\x28\xac\x5d\x70\x18\xee\x1a\x89\xa7\x05\xde\x8a\x24\xa0\x9b\x5b\xc1\x32\xe3\x90\xdb\x14\x09\x92\x5f\xf4\xfb\x17\xdc\x97\x8c\x5f\x00\x8e\x20\x38\xcf\x49\x8e\x2a\x22\x96\x46\x90\x39\x5a\x53\x96\x0f\xf4\x2e\x48\xd4\x8e\x67\x70\x45\x4e\x40\xcc\xf5\xbb\x35\x39\xd4\xd9\x8d\xcf\xf6\x36\xa2\x7d\xfc\x5c\x94\xa5\xd0\xc8\xc9\xe7\xc2\xcf\xc1\xe9\xdd\x6d\xf7\x73\xdd\x4a\xe0\x01\x80\x9a\xc4\x88\xef\x88\x68\x4f\xb1\xcd\xb5\x10\xf1\x67\x0e\xd8\x7b\xa9\x44\x19\x96\x66\xf3\x89\x35\x68\xe7\xf8\x3f\xe6\xc6\x50\xd1\xe1\xf5\x7b\x7d\x42\xe6\xd2\x80\x82\x06\x37\x8f\x7e\xe3\x6b\x3b\x98\x83\xce\xb9\xc3\xe0\xf7\x24\xe2\x7a\x25\x9a\xe0\xf7\x84\xd7\x66\x1c\x10\x06\xa5\x63\xa8\x43\xd3\x4f\x4c\x21\xc7\xdd\x54\xf7\x08\xb8\xf9\xbc\xce\xbb\x19\x67\x65\xeb\xea\x9b\xe5\x66\x98\xe7\xc4\x43\x73\x1c\x79\x68\x7f\xaa\x34\xdf\xd6\x82\x69\x7c\x7a\x93\x29\xa2\x43\x2f\xe0\x6c\xbf\x1e\x3a\xe6\xac\x39\xd7\xbf\x87\xf6\x87\xa5\x11\x0b\xd1\x71\xf6\xcd\x18\x97\x43\xf7\xe0\x90\xc3\x6f\x06\xe1\x3d\x17\xe1\xb0\xd3\x6f\x06\x5d\x2d\xc3\xc7\x78\x0a\x8f\x9a\xbc\x39\x7e\x3f\xb4\x3f\x75\xd6\x95\x44\xc1\x65\xe3\xbc\x80\x33\x46\xa2\xef\x37\x1c\xf0\x04\xce\xa0\xb9\xef\x3b\x3c\x74\xb1\xcd\x20\x3a\xe4\x3f\x3c\x92\xcf\x11\x1f\x62\x87\xe5\x39\xa2\x35\xe4\x47\x9c\xed\x4a\x43\x77\xe3\x4e\x43\xf3\x5c\x6a\x68\xae\x5b\x0d\x1d\xb7\x62\xe6\xb9\xd7\xd0\x2c\x17\x1b\x9a\xec\x66\x43\xf3\x5c\x6d\x68\x9e\xbb\x0d\xcd\x1c\x0d\x30\xe3\x62\x4a\x06\xf4\xdb\xdc\xcb\x19\xf7\x99\x9d\x3c\xef\x87\x36\xa8\x61\xde\xd8\x4a\x25\x86\x1b\xab\xfe\xae\x8d\x1c\x58\xaa\xff\x9c\x66\x8b\x60\x2a\xa4\x3e\xd6\x58\x57\x7a\x87\x8e\xf3\x90\x75\x3e\x39\x89\xb4\xe6\x8c\x4a\xa4\xe5\x6e\x87\x0b\xc2\x94\x4d\x00\xb6\x47\x7d\xb8\x7c\x7e\xcf\xa2\x9e\x66\xdb\x5d\x6f\xb9\x34\x96\x98\x71\x16\x50\x89\x1e\x7d\x24\x37\x8f\x16\x3d\x8d\x38\xcd\x55\x2e\xd1\xa3\x57\xec\x91\x31\x17\x0f\x14\xb6\xb3\x44\xa7\x99\xfb\xac\xb8\x41\x8f\x80\xce\xa3\x81\xcc\xd6\x59\x06\xfb\x6c\xe7\xf4\x84\x57\x98\x2b\x56\x34\x2f\x11\xa1\x7d\xbd\xf1\x05\x3a\xe7\x4b\xfb\x53\xbc\x37\xde\xd9\xab\x97\x87\xf6\x26\x3a\x71\xde\x1c\xbc\xd1\xb3\xa3\x4e\x43\x17\x24\x75\x3a\xd9\xcb\x60\x86\xd4\x89\x92\x60\x26\xd1\x23\xe7\x27\x7e\x2c\x5b\x7e\x43\xbe\x27\xd7\x26\xee\x0c\x33\xf4\xc2\x54\xbd\xa7\x6c\x12\xf4\xf7\xf1\x07\x88\x3d\x0f\x92\xf5\x9a\x4b\x73\x7d\xee\x8a\xb4\x2e\xf5\x1c\x9d\x38\x7f\x48\xc8\x67\xd3\x36\x2e\x20\x97\xbf\x47\x84\x29\xba\x6c\x28\x35\x5e\x92\xf8\xdc\x08\x9e\xcb\x1e\xa8\x67\x4f\x62\x9c\xc3\x3f\xd2\x33\xdd\xb6\x56\x5e\x17\xe8\x7a\x4b\x44\xaf\xef\x54\xa2\x9c\xac\x29\x83\xa8\x61\x7c\xc0\xab\x66\x4c\x73\xc9\x99\x73\x70\x1b\x65\x06\x6a\xc2\x39\xe7\x80\xfd\x68\x92\x30\x5e\x90\xa1\xd1\xce\x75\xc7\xcf\x89\x41\xe1\x62\x66\x52\x7a\xe2\xe7\x89\xd9\x45\xab\xdf\x6c\x4a\xe0\xe9\x11\x25\xb9\x2d\x24\x26\x5d\x6f\xe2\x35\xd8\x4b\x58\x6e\x5d\x46\xa9\x04\x79\xb0\x35\x0c\x62\x29\x4d\x5c\x39\x53\x6c\xa0\x65\x97\xbd\xdb\x0e\xe7\xcd\x04\xb2\x5d\xdf\x09\x90\x6d\xcf\x39\xfa\xc0\x71\x6c\x93\x1c\xd8\x09\xcc\x36\xdc\x8d\x04\x66\x8b\x02\xb3\xc1\x8f\xc6\x8d\xe6\x50\x6d\x11\xb1\x1e\x83\x79\x9b\x80\x6a\x0b\xc7\xa4\x4c\xa9\xa5\x06\xd5\x86\x7e\xdc\x12\x58\xc3\x82\xc0\x04\x96\x75\xa1\x68\xd5\x26\xca\x04\xed\x6c\xd8\x7d\x8c\x4b\x60\x6d\xc3\xbe\x7d\x9d\xa1\x39\xc5\xd9\x76\xe2\x72\xd3\xbc\x40\x9a\x8d\x84\xfd\x63\x61\x02\xa0\x50\x61\x14\x10\x6b\xce\x57\x60\xa2\xcb\xf4\xf6\x03\x61\x2f\x60\xa7\x96\xad\x43\x0e\x76\xb0\x13\xbd\xc1\x17\x5a\x70\xf4\x16\xec\xb4\x69\x38\x27\xe3\xc0\xc2\x30\x7e\xbf\x1d\x71\x41\x48\x53\x5c\xb3\x31\x1f\x4e\xe4\x69\xd8\x40\xb2\x9e\xd5\x38\x73\xcb\x9a\x51\x41\xa2\x01\x33\xeb\xd0\xac\x09\x52\xec\x99\x3d\x51\xe6\x4c\x90\xa4\x59\x48\x8d\x19\xf3\x75\x67\xff\xfd\x66\xbe\x21\xd3\x1a\x30\x30\xa0\x8d\x09\xd3\xa9\x84\xda\xf0\x7f\x8b\x11\xc7\xc9\x21\xb7\xbb\x0a\xb7\xcd\x0a\xb5\xfd\x22\x30\xc5\xf1\xf0\x5a\x13\x32\x8b\x36\x30\x23\x43\x6b\xd1\x78\x52\xd4\x60\x4a\xef\xe6\x08\x78\x17\xe1\xb4\x88\x50\x5a\xca\x81\x1f\x6f\xc1\xb0\x19\xf4\x7d\x46\xe8\xf0\xe8\x90\xd9\x2f\x97\x0a\x3f\x18\x26\x33\x41\xaf\x89\x03\x11\x0a\x91\x99\x80\xd7\xcc\x60\xe0\x48\x78\x6c\xa2\x5c\xea\x36\x3f\x34\xf6\x4b\xa5\xc2\x0f\x86\xc3\x8e\x09\xcf\x0d\x85\x98\x7a\x81\xad\x19\x4b\x20\x90\x14\x3f\x91\xe2\x00\x7f\x9f\x27\x29\xfe\x6a\x3b\x39\xda\x66\xe8\xf8\x03\x59\x13\x49\x42\xd8\x2b\xe5\x85\x9b\xf6\xf0\xf3\xc2\x67\x05\xa8\x8e\x0b\x4e\xcd\x98\xd3\x3b\x0b\x4a\xdd\x51\x40\xea\x4e\x83\x51\x71\x81\xa8\x29\xa1\xf9\x88\x20\x54\x3f\xb0\x14\x4d\xf9\xf8\x00\xd4\xc4\x15\x30\xe9\xf1\x69\x41\xa7\x3b\x0a\x38\xdd\x45\xb0\xe9\xf6\x03\x4d\x13\xb4\xf7\xc4\xf5\x3d\x45\x5f\x4d\x0c\x2c\xdd\x5d\x50\x29\x3e\xa0\x14\x83\x76\x30\x6d\x42\x30\xa9\x1b\x24\x8a\xa2\xed\x0f\x24\x1d\x7a\x54\x22\x89\x0e\x07\x91\x06\x83\x43\xf1\x43\x30\xe0\x77\xf1\x06\x86\xa2\x28\xef\x07\x8f\x6e\x25\x28\x34\x41\xd2\xe3\x63\x0a\xf1\x63\x76\xab\x81\x82\x48\x40\x15\x53\xf4\x38\x50\x55\x77\x95\x1c\x20\xab\x3c\xbd\x30\xbe\x70\xbc\xe3\x34\x47\x55\x0d\x25\x6c\xe3\xd0\x55\x1e\x9a\x16\x77\x95\xd0\x55\x2d\xba\xaa\x37\x3d\x1d\xfc\xcd\xbc\x90\x48\x00\x62\x15\x20\xea\x00\x58\xd3\x20\x56\x01\xa2\x16\x80\x35\x0d\x62\x15\xa0\xe9\x00\x58\x0f\x08\x62\x35\x36\xcf\x09\x67\x95\x70\x56\x09\x67\x95\x70\x56\x09\x67\x95\x70\x56\x09\x67\x35\xe5\xfd\x84\xb3\xda\xa7\x96\x70\x56\xa1\x96\x70\x56\x9d\x96\x70\x56\x09\x67\x95\x70\x56\x09\x67\x95\x70\x56\x09\x67\x95\x70\x56\x77\xf4\x4a\xc2\x59\x25\x9c\x55\xc2\x59\xa1\x84\xb3\x4a\x38\xab\x84\xb3\x8a\x20\x9b\x70\x56\xf7\x06\x67\xd5\x0b\xd9\x0c\x83\xad\x02\x3d\xee\xc3\x95\x22\xc1\x56\xa1\xd8\x17\x2d\x8a\x68\xb0\x55\xb7\x0b\x53\x02\x5f\x91\x88\xab\x50\xd0\xab\x8b\xc7\x8a\x45\x5c\x05\x68\xf6\xf1\x58\x53\x10\x57\x01\xc2\x87\x55\xc6\xc2\x88\xab\x10\x49\x87\xc7\x4a\x88\xab\x84\xb8\x0a\xb4\x84\xb8\x8a\xe8\x4c\x42\x5c\x05\x5a\x42\x5c\xd9\x96\x10\x57\x09\x71\x15\x4f\x24\x21\xae\xfc\x3d\x4c\x88\xab\x84\xb8\x4a\x88\xab\x60\x4b\x88\xab\x84\xb8\xea\x30\x98\x10\x57\x09\x71\x15\xd3\x12\xe2\x2a\x21\xae\xda\x67\x13\xe2\x2a\x21\xae\x12\xe2\xaa\xf7\xf9\xcf\x85\xb8\x0a\x3c\xa0\x78\x01\x88\x8c\x51\x7f\x8c\x57\x83\x1c\x40\x09\xf4\x42\x05\x5d\x75\xd5\xd0\x85\xcd\x5e\x29\x0c\x60\x02\xc5\xdd\x17\x3d\x6a\x56\x0b\x8b\xde\xbd\x94\xc5\xbd\x74\x00\x19\x4a\xc0\xa5\x60\x5f\x37\xfb\xfd\x82\xac\xd7\x24\x53\xdf\xa0\x5a\xfa\x66\xb3\xb1\x08\x60\xb9\xb8\xbd\xf6\x6b\xf7\x7f\xdf\x8c\x89\x4d\x8c\x1b\xc1\x70\xe0\x93\x90\xde\x30\xbd\x84\xc7\x11\x65\x39\xcd\x1a\x87\x88\xe9\xae\xa1\xa4\x07\xa9\x0c\x1b\xea\x66\x25\x98\xfd\x01\x1e\x37\x3e\xf2\x0e\x21\x69\x7d\xfc\x8d\xfe\x59\xb8\x55\xe2\x25\xdc\x18\x12\x04\xbd\xe1\x36\x04\x45\x16\xe8\x02\x40\x1a\xed\x5f\xc0\xf0\x78\xc3\x4d\x30\x8a\xf8\x78\x8d\x5a\x6f\x01\xd7\x4b\x6f\x08\xbf\x6f\x1d\x2d\xa6\xbf\xbd\x2a\xaf\xad\x48\xbb\x2d\xd9\xbf\xca\xf8\x99\x77\x2c\x3f\x92\x9b\xf6\x78\x6b\x5d\x3c\xb0\x41\xfb\x55\x78\x23\x64\xee\x38\x68\x4e\x9b\x7f\xb0\x8e\x56\x5e\xae\x28\x33\x4c\x9a\xcf\xba\x49\xf7\x12\xd5\x5c\xb9\xe9\x61\x39\xfc\x13\xd8\x3b\x76\xf0\x63\xbc\x3e\xbd\x19\x78\x3b\xee\xe3\xd9\xf7\xda\xf8\x07\xdf\x19\xae\x07\x15\x65\x41\xfe\x5a\x97\x0c\x7a\xf9\x73\x8d\x8b\x33\xf4\x82\xac\x71\x5d\x04\xf2\x99\x15\x77\x8f\x5b\x02\x07\x46\xfd\x35\x2d\xf2\x0c\x8b\x1c\x70\x76\x46\x91\x78\x29\x4a\x6e\xe4\xcb\xc4\x07\x32\xcc\x1a\x35\xd6\x4a\x8a\x01\x50\xa1\x0a\x0b\x45\xb3\xba\xc0\xfe\xe3\xa2\x5e\xfb\x1b\x2e\xbc\x7e\x8c\xa8\xb9\x6b\xc5\xfd\x92\x64\x9c\xf9\x8b\x70\xf7\x15\xf6\xfe\x9b\xdd\xd9\x04\x1b\x88\x08\x0a\xe1\x10\xff\x68\xd3\x92\xec\x2f\xbc\x13\x8b\xa5\xb3\xb2\xcf\xd7\x4e\xb7\x35\x0a\x23\xb0\x7a\xd4\x96\x88\x6b\x2a\x49\x37\x50\x4b\x25\xa2\x26\x0e\x7e\xda\xee\x22\xad\x16\xf0\xab\xcb\x3f\xde\x68\xcb\x49\xcb\xce\x02\x51\xe5\xac\x06\x49\x9a\x12\xac\x6e\x19\xda\x69\x6d\xc8\x7a\xa9\xae\xb9\x20\x3b\x22\xd0\x49\xce\x81\x1e\x04\x5c\x4f\xcf\xd0\x9f\x89\xe0\x20\xb6\x8c\x6c\x4c\xb4\xcf\x2e\xdb\xe0\xa5\xa3\x2b\xbd\xc9\x11\x6c\x4b\xba\x3e\x45\x27\x26\x86\x4b\xcb\x92\xe4\x14\x2b\x52\xdc\x9c\x9a\xf8\xb5\x8b\x11\xfb\xba\xdd\x49\xbc\xf8\xfd\xef\x82\x82\x16\x4a\xb8\x80\x2e\x44\x4b\xd7\x07\x30\x30\x7b\x6a\xda\xd8\x9c\x7b\xa2\x62\xb7\xf7\xa0\x8e\x1e\x75\xe0\x75\x72\xd1\x3b\x87\x84\x18\x15\xdd\x08\xd9\x4f\x5a\x4e\x31\x12\x64\xa3\xd7\xad\x5d\x71\x47\xae\xcc\x48\xcb\x6c\xd8\xbc\xf3\xbc\x0c\xb3\x64\x97\x6d\x93\x6d\x71\x38\x2b\xbd\xb9\x78\xce\xd9\x9a\x6e\x6a\x3b\xe2\x7c\x8d\x5c\x3c\x1e\x64\xb4\x63\xab\xad\xe1\xd4\xd2\x7c\x60\x68\x56\x06\x0f\x46\x7e\x3b\x09\x7b\xc1\xfe\xfd\xb0\x96\x63\x0c\x5c\x44\x1b\xc1\x6b\x70\x12\x8d\x82\xfd\x87\x27\x29\x6c\xb6\xe9\xe3\xc9\xec\x3b\x08\xe0\x6c\x33\x5e\xd9\x75\xed\xf5\x14\xda\xc3\xe5\x6f\xfb\x86\x80\x54\x7f\x35\xd5\x5f\xbd\xef\xf7\x02\x8c\x5e\x04\x70\xe0\x04\x0e\x79\xf7\x62\x6e\x02\xf8\x0c\x58\xff\x73\x66\x3d\x8b\xad\x64\x76\xf3\xf5\x88\x28\xa3\x36\x63\x64\x0f\x22\x66\x77\x92\xa6\x47\xb4\xac\x0a\x9a\x51\xe5\x12\x51\x9f\xa2\x13\x10\x55\xaa\x1e\x4b\x58\x90\x4b\x5e\x79\x6e\x45\x71\xed\xdc\xf8\x40\x83\x0c\x22\xc6\xdd\xf7\x83\x34\x2d\x23\x7a\x75\x48\x1e\xcd\xcb\xa4\x3b\x04\x80\x5d\xc2\x32\xaf\x75\xe4\xda\x5e\x1a\x07\xc8\x5a\x9b\x81\xb1\x77\x6b\x00\x8c\x6e\xb4\x43\xfa\x73\xdd\x1a\x70\x2b\xa9\x3c\xe7\x4d\xda\x4e\x7f\x08\x66\x01\xdc\x57\xc6\xe3\xf8\x58\x1a\x5f\x66\x74\xa6\xc8\x71\x29\x38\xa3\x8c\x4f\xc3\x5a\xa6\x0c\x9c\xa6\x1d\x97\x81\x73\x75\x90\x7a\xd3\x4b\xa6\x99\x1a\x86\x7f\x70\xa9\x37\xef\xfc\xde\x98\x89\xfd\x1f\xcd\xb8\x69\xbd\x34\x53\x47\x74\x28\xe3\x66\x71\x14\xca\xfc\x3b\x65\x42\xa0\xaf\x1f\x48\xea\xcd\xf9\x41\xc2\xcd\x4c\xe8\x7f\x20\xe1\x66\x12\x3e\xaa\xdb\xb7\xd1\x84\x1b\xfb\xc5\x89\x44\x3f\x7f\xc2\xcd\xd1\x6c\x7e\xa7\x34\x8b\xaf\xd5\x21\x2e\x7f\x22\x51\x60\x6c\x8b\x77\x60\xe8\x9a\x4c\x05\x6b\xb4\x3a\xa7\x53\x44\x0d\x9a\xfd\xb6\xb2\xe9\xf1\x95\x20\xd6\x49\x84\x99\x73\xdd\x1c\x01\xa2\xbf\x03\x00\x7d\xca\x36\x82\xf6\xab\xc9\x36\xfa\x16\x6e\xf4\xba\x4f\xb6\x9e\xb9\x63\x2c\xd9\x7a\xc9\xd6\x4b\xb6\x5e\xb2\xf5\xc6\x3b\x97\x6c\xbd\xe1\xbe\x25\x5b\x2f\xd9\x7a\xc9\xd6\x1b\x6d\xbf\x45\x5b\xef\x73\x5c\x56\xf0\xe3\x9d\x5c\x56\xa0\x0d\x34\x97\x7a\xf9\x2b\xb8\xad\xa0\xf1\x29\x07\x1f\xfd\x55\x5e\x54\x90\x0a\xc2\x7e\x9e\x82\xb0\x6c\xe8\xde\x81\x00\xd9\xf8\x3a\xb0\xcd\xbd\x03\xa1\xb0\xa6\xbb\x95\xc0\xfb\x5c\xfc\x31\x63\x5f\x17\x44\xec\x33\xa3\xb7\x3a\x0f\x9f\x5c\xe1\x32\x82\x18\x75\xa2\xcf\x29\xe6\xe6\x02\x2d\x87\x6f\xdf\xc5\xe4\x28\x47\x6f\x8d\xfb\x67\xd4\x1e\x80\xe0\x90\xe1\xf8\xb3\x7e\x2f\xc4\x36\x80\x89\x36\x51\xe6\x98\x3b\xcc\x4d\x33\xe0\x11\xa0\x73\x65\x33\xa5\xbb\xf3\x03\x5a\x07\x82\x88\x13\x7c\x01\x18\xc9\x7a\x65\xcf\x2c\x5a\xd6\xde\xec\x4d\x7a\x8a\x7c\xf9\x5a\xf2\x86\xc4\xb6\xe4\x0d\x89\x6d\xc9\x1b\x32\xd6\x92\x37\x24\x79\x43\x92\x37\x24\x79\x43\xc6\xb9\x48\xde\x90\x7b\xe8\x0d\x49\x91\xaf\x64\xeb\x79\x5b\xb2\xf5\x92\xad\xb7\xdf\x92\xad\x97\x6c\xbd\x64\xeb\x25\x5b\x6f\x9c\x8b\x64\xeb\xdd\x43\x5b\x6f\xd2\x07\x62\x49\xc7\x0d\xe4\xf2\xd0\x61\x1d\x11\xa3\x09\xb0\x1a\x59\x8c\xf7\xa8\x42\xbc\xa3\x18\x38\x08\x32\x78\xfa\xd0\xdc\x69\x12\x57\x7f\xd7\x55\xd9\xf5\x50\x4c\xf5\x77\x13\xba\x2e\xa1\xeb\x1e\x0c\xba\x2e\x55\xdd\x4d\x55\x77\x53\xd5\xdd\x54\x75\x37\x55\xdd\x1d\x68\xa9\xea\xee\xa4\x96\xaa\xee\xa6\xaa\xbb\x23\x2d\x55\xdd\x9d\xd2\x52\xd5\xdd\x54\x75\x77\x4a\x4b\x55\x77\x53\xd5\xdd\xa1\xd7\x52\xd5\xdd\x54\x75\x37\x55\xdd\xbd\xe5\x57\x52\xd5\xdd\x54\x75\x37\x55\xdd\x45\xa9\xea\x6e\xaa\xba\x7b\x5f\x80\x6c\xa9\xea\x6e\xe0\xb9\x54\x75\x37\x81\xd9\x3e\x23\x98\x0d\x7e\xec\x57\xd3\x8d\x88\xf5\x44\xd6\xda\x6d\x51\x6d\xe1\x98\x54\x64\xad\xdd\xb6\x9a\x6e\x04\x9b\xa9\xd6\x6e\xa0\xa5\x5a\xbb\xa9\xd6\xae\xbf\x33\xa9\xd6\x6e\xa0\xa5\x5a\xbb\xb6\xa5\x5a\xbb\xf7\x3b\x07\x3e\xd5\xda\x4d\xb5\x76\x1f\x46\x2a\x7c\xaa\xb5\x9b\x6a\xed\x46\xb6\x94\x17\x7e\x1f\xf3\xc2\x53\xad\xdd\x54\x6b\x37\xd5\xda\x4d\xb5\x76\x43\x2d\xd5\xda\xed\xb5\x54\x6b\x37\xd5\xda\xfd\x8d\xd5\xda\x35\x79\xed\x4c\xd1\xe3\x40\x55\xdd\x55\x72\x80\xac\xf2\xf4\xc2\xf8\xc2\xf1\x8e\xd3\x1c\x55\xb5\x52\x66\xa1\x44\xa0\xab\x3c\x34\x2d\xee\x2a\xa1\xab\x5a\x74\x55\x6f\x7a\x3a\xf8\x9b\x79\x21\x91\x00\xc4\x2a\x40\xd4\x01\xb0\xa6\x41\xac\x02\x44\x2d\x00\x6b\x1a\xc4\x2a\x40\xd3\x01\xb0\x1e\x10\xc4\x6a\x6c\x9e\x13\xce\x2a\xe1\xac\x12\xce\x2a\xe1\xac\x12\xce\x2a\xe1\xac\x12\xce\x6a\xca\xfb\x09\x67\xb5\x4f\x2d\xe1\xac\x42\x2d\xe1\xac\x3a\x2d\xe1\xac\x12\xce\x2a\xe1\xac\x12\xce\x2a\xe1\xac\x12\xce\x2a\xe1\xac\xee\xe8\x95\x84\xb3\x4a\x38\xab\x84\xb3\x42\x09\x67\x95\x70\x56\x09\x67\x15\x41\x36\xe1\xac\xee\x0d\xce\xaa\x17\xb2\x19\x06\x5b\x05\x7a\xdc\x87\x2b\x45\x82\xad\x42\xb1\x2f\x5a\x14\xd1\x60\xab\x6e\x17\xa6\x04\xbe\x22\x11\x57\xa1\xa0\x57\x17\x8f\x15\x8b\xb8\x0a\xd0\xec\xe3\xb1\xa6\x20\xae\x02\x84\x0f\xab\x8c\x85\x11\x57\x21\x92\x0e\x8f\x95\x10\x57\x09\x71\x15\x68\x09\x71\x15\xd1\x99\x84\xb8\x0a\xb4\x84\xb8\xb2\x2d\x21\xae\x12\xe2\x2a\x9e\x48\x42\x5c\xf9\x7b\x98\x10\x57\x09\x71\x95\x10\x57\xc1\x96\x10\x57\x09\x71\xd5\x61\x30\x21\xae\x12\xe2\x2a\xa6\x25\xc4\x55\x42\x5c\xb5\xcf\x26\xc4\x55\x42\x5c\x25\xc4\x55\xef\xf3\x9f\x0b\x71\x15\x78\x40\xf1\x02\x10\x19\xa3\xfe\x18\xaf\x06\x39\x80\x12\xe8\x85\x0a\xba\xea\xaa\xa1\x0b\x9b\xbd\x52\x18\xc0\x04\x8a\xbb\x2f\x7a\xd4\xac\x16\x16\xbd\x7b\x29\x8b\x7b\xe9\x00\x32\x94\x80\x4b\xc1\xbe\x6e\xf6\xfb\x05\x59\xaf\x49\xa6\xbe\x41\xb5\xf4\xcd\x66\x63\x11\xc0\x72\x71\x7b\xed\xd7\xee\xff\xbe\x19\x13\x9b\x18\x37\x82\xe1\xc0\x27\x21\xbd\x61\x7a\x09\x8f\x23\xca\x72\x9a\x35\x0e\x11\xd3\x5d\x43\x49\x0f\x52\x19\x36\xd4\xcd\x4a\x30\xfb\x03\x3c\x6e\x7c\xe4\x1d\x42\xd2\xfa\xf8\x1b\xfd\xb3\x70\xab\xc4\x4b\xb8\x31\x24\x08\x7a\xc3\x6d\x08\x8a\x2c\xd0\x05\x80\x34\xda\xbf\x80\xe1\xf1\x86\x9b\x60\x14\xf1\xf1\x1a\xb5\xde\x02\xae\x97\xde\x10\x7e\xdf\x3a\x5a\x4c\x7f\x7b\x55\x5e\x5b\x91\x76\x5b\xb2\x7f\x95\xf1\x33\xef\x58\x7e\x24\x37\xed\xf1\xd6\xba\x78\x60\x83\xf6\xab\xf0\x46\xc8\xdc\x71\xd0\x9c\x36\xff\x60\x1d\xad\xbc\x5c\x51\x66\x98\x34\x9f\x75\x93\xee\x25\xaa\xb9\x72\xd3\xc3\x72\xf8\x27\xb0\x77\xec\xe0\xc7\x78\x7d\x7a\x33\xf0\x76\xdc\xc7\xb3\xef\xb5\xf1\x0f\xbe\x33\x5c\x0f\x2a\xca\x82\xfc\xb5\x2e\x19\xf4\xf2\xe7\x1a\x17\x67\xe8\x05\x59\xe3\xba\x08\xe4\x33\x2b\xee\x1e\xb7\x04\x0e\x8c\xfa\x6b\x5a\xe4\x19\x16\x39\xe0\xec\x8c\x22\xf1\x52\x94\xdc\xc8\x97\x89\x0f\x64\x98\x35\x6a\xac\x95\x14\x03\xa0\x42\x15\x16\x8a\x66\x75\x81\xfd\xc7\x45\xbd\xf6\x37\x5c\x78\xfd\x18\x51\x73\xd7\x8a\xfb\x25\xc9\x38\xf3\x17\xe1\xee\x2b\xec\xfd\x37\xbb\xb3\x09\x36\x10\x11\x14\xc2\x21\xfe\xd1\xa6\x25\xd9\x5f\x78\x27\x16\x4b\x67\x65\x9f\xaf\x9d\x6e\x6b\x14\x46\x60\xf5\xa8\x2d\x11\xd7\x54\x92\x6e\xa0\x96\x4a\x44\x4d\x1c\xfc\xb4\xdd\x45\x5a\x2d\xe0\x57\x97\x7f\xbc\xd1\x96\x93\x96\x9d\x05\xa2\xca\x59\x0d\x92\x34\x25\x58\xdd\x32\xb4\xd3\xda\x90\xf5\x52\x5d\x73\x41\x76\x44\xa0\x93\x9c\x03\x3d\x08\xb8\x9e\x9e\xa1\x3f\x13\xc1\x41\x6c\x19\xd9\x98\x68\x9f\x5d\xb6\xc1\x4b\x47\x57\x7a\x93\x23\xd8\x96\x74\x7d\x8a\x4e\x4c\x0c\x97\x96\x25\xc9\x29\x56\xa4\xb8\x39\x35\xf1\x6b\x17\x23\xf6\x75\xbb\x93\x78\xf1\xfb\xdf\x05\x05\x2d\x94\x70\x01\x5d\x88\x96\xae\x0f\x60\x60\xf6\xd4\xb4\xb1\x39\xf7\x44\xc5\x6e\xef\x41\x1d\x3d\xea\xc0\xeb\xe4\xa2\x77\x0e\x09\x31\x2a\xba\x11\xb2\x9f\xb4\x9c\x62\x24\xc8\x46\xaf\x5b\xbb\xe2\x8e\x5c\x99\x91\x96\xd9\xb0\x79\xe7\x79\x59\xf0\x5a\x91\x3f\x71\xa9\xf4\x61\xe2\x70\x36\x0e\x4c\x32\xf2\x49\x11\xc1\x70\x81\xb6\xf6\x1d\x70\x6a\x64\x19\x91\x12\x5d\xde\xb0\x9c\xc8\x01\x97\xc3\x68\xff\x46\x18\x93\x0a\xab\x7a\x4f\xf3\xf4\x38\x71\x5f\xba\x84\x07\xed\x21\xc6\x62\xa6\x57\x92\x88\x1d\xc9\x81\x08\xe8\x8a\x41\xb6\xc6\x4d\xb1\x15\xce\x3e\xd6\xd5\xe1\x50\xf8\x8d\x37\x46\x3e\x8d\x18\x6d\x3d\xc6\xc1\x82\xb2\x52\xac\x5f\xb1\x5f\x43\x55\x81\x19\x1b\xb1\xa4\x02\xd2\x51\x09\xb2\xa3\x7c\x7f\xb8\xc6\xbf\x7e\x8d\xad\x3a\xb6\xef\x39\x16\x4c\xc6\xc9\x1c\x1e\x3c\xe2\xd5\xfd\x7c\xbc\x58\x80\xaa\xc9\xc8\xfb\x6a\x23\x70\x3e\x20\x95\xe6\xc5\x15\xe7\x05\xc1\x6c\xef\xd7\x02\x4b\x65\x5f\xfc\x16\xd3\xa2\x16\x03\xef\x3b\x45\x96\x63\x45\x96\x7a\xc3\x99\xc2\x5a\xb5\xc5\x72\x94\xa7\xc1\x37\x04\xc1\x72\x62\xff\x15\x16\x1b\xa2\x3e\x10\x21\xa7\x8e\x5c\x6d\xfa\x7e\xae\x94\xd6\x59\x03\x52\xe1\xd3\xce\xbb\xc9\x1f\x1c\x9c\xfb\x83\x3f\x9a\x25\xf9\x0c\x29\x51\x9b\xb1\x96\x8a\x0b\xbc\x21\xdd\xbf\xd4\xab\x26\xcd\xc0\x7d\xdf\xea\x01\xf4\xf7\x7f\x7e\xd1\xaa\x04\xad\x6c\x2a\x45\xf2\x37\xb8\x74\x4f\x7e\xa4\x2c\x7f\x86\x1e\x19\x17\x56\x55\xd4\x02\x17\xf6\x9f\xda\x10\xa1\xe6\x3c\x8a\xfe\xf2\xd7\x2f\xcc\x87\x49\x6e\x07\xd6\xfc\xf1\xff\x05\x00\x00\xff\xff\x55\x56\x1e\xc6\xdb\x67\x03\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\xdd\x93\x1b\x37\x72\x7f\x9f\xbf\x02\xa5\xa4\xa2\xdd\xdc\x92\xb2\xcf\x2f\x09\x2f\x95\xab\x3d\x49\x76\x36\xa7\x8f\xcd\xae\x64\x3f\xf8\x2e\x29\x70\xa6\x49\xc2\x9a\x01\xc6\x00\x86\x2b\x9e\xed\xff\x3d\xd5\x00\xe6\x8b\x9c\x0f\x0c\xc9\xbd\x93\x6c\x70\x54\xa5\x25\x67\xf0\x43\xa3\xd1\x68\x34\xba\xd1\x98\x68\x36\x9b\x45\x34\x67\xdf\x82\x54\x4c\xf0\x05\xa1\x39\x83\x8f\x1a\x38\x7e\x53\xf3\x0f\xff\xa6\xe6\x4c\x3c\xdb\x7e\x19\x7d\x60\x3c\x59\x90\xe7\x85\xd2\x22\xbb\x03\x25\x0a\x19\xc3\x0b\x58\x31\xce\x34\x13\x3c\xca\x40\xd3\x84\x6a\xba\x88\x08\xa1\x9c\x0b\x4d\xf1\x67\xb5\x88\x7e\xfa\x69\x46\xd8\x8a\x50\x9e\x90\x0b\x2e\x34\x99\xbf\x4d\xb3\x4b\x32\xbf\xce\xd9\x3d\xc8\x2d\xc8\xf9\xcb\x6c\x09\x49\x02\xc9\xad\x14\x5b\x96\x80\x24\xbf\xfc\x12\x11\x42\x88\x02\xb9\x65\x31\xcc\x97\xa0\xe9\x5c\xe4\xc0\xd5\x86\xad\x34\x52\xc3\xf8\x0f\x10\xeb\x59\x4c\x97\x05\x4f\x52\x58\x90\x27\x5a\x16\xf0\xc4\x54\x05\x3c\x29\x01\x62\xc1\xb5\x14\x69\x0a\x72\xb6\x06\x3e\xff\x50\x2c\x61\x59\xb0\x34\x01\x89\x20\xdb\xb2\xc1\xdb\x2f\xe6\x5f\xcd\xbf\x88\x08\x49\xe9\x12\x52\x85\x0d\x20\x84\xe6\xf9\x82\xa8\x1d\x4f\x40\x31\x15\x11\xc2\x69\x06\xf5\x0f\xa0\xe6\xe5\x9f\x73\x26\x22\x95\x43\x7c\x74\x43\x63\xc1\x4b\x52\x4c\xcd\x4a\x4b\xaa\x61\xbd\x5b\x90\xef\x60\xb9\x11\xe2\x83\xf9\xf5\xc1\xfe\x6d\x89\x6b\x16\xba\x83\x2d\x83\x07\xd7\x7b\x8e\x78\x42\x66\x64\xfb\x65\xe3\x4f\x64\x61\xf9\x3d\x4e\x19\x70\xfd\x5c\xf0\x15\x5b\x97\x8f\x57\xcc\xae\x7f\xd8\x6f\xf2\x4c\xe4\x20\xa9\x16\x72\xe6\x48\xd9\x7b\x52\xe5\x34\x86\x05\xf9\xe9\xa7\xf9\x9b\xf2\x9b\xeb\x06\xfb\x2f\xa7\x7a\xb3\x20\xcf\x2c\xe1\xba\xdd\x55\x6b\x29\x8a\x06\xbb\x91\xa5\x0e\xd4\xd2\x63\x45\xef\xde\xdd\x36\x3f\xa5\x4c\xe9\x3f\xb7\x7e\x7e\xc5\x94\x36\xb7\xf2\xb4\x90\x34\xad\xe1\x00\xfb\x8f\x10\xc5\xf8\xba\x48\xa9\xac\x6f\x44\x84\xa8\x58\xe4\xb0\x20\x15\xc9\x49\x44\x88\x63\xac\xe1\xe5\x8c\xd0\x24\x31\x02\x4e\xd3\x5b\xc9\xb8\x06\xf9\x5c\xa4\x45\x56\x72\x7a\x46\x12\x50\xb1\x64\x39\x3e\xb2\x20\xef\x36\x50\xa1\x93\x7c\x43\x15\x38\x0e\xfc\xa0\x04\xbf\x35\x1c\x98\x2b\x4d\x75\xa1\xe6\xcd\xbb\xd8\xd4\x05\xb9\x6d\xfc\xa2\x77\x48\x96\xd2\x92\xf1\xf5\x68\x45\x8e\xe0\xde\xaa\xda\xf7\x6d\x65\xdf\xb6\x7e\x3b\xa8\xce\x3e\xb4\xfd\x92\xa6\xf9\xc6\x49\x8e\x8a\x37\x90\xd1\x52\x40\x70\x3c\x5e\xdf\xde\x7c\xfb\xd5\x7d\xeb\x67\xd2\x26\xb3\xec\x1b\xc2\x14\xd1\x1b\x20\xf6\x61\xb2\x12\xd2\x7e\x35\xb7\x41\x91\xeb\xdb\x9b\x0a\x20\x97\x28\x69\x9a\x95\x9d\x6f\xaf\x86\x86\x6a\xfc\xba\x57\xdd\x53\xa4\xc8\x3e\x45\x12\x54\x4d\x60\xab\x75\x0c\x80\xc4\x35\x82\x88\x15\xd1\x1b\xa6\x88\x84\x5c\x82\x02\x6e\x95\x55\x0b\x98\xe0\x43\x94\x13\xb1\x44\x5d\x33\x27\x76\x0c\x2b\xa2\x36\xa2\x48\x13\x37\x00\x35\x91\x10\x8b\x35\x67\x7f\xab\xb0\x15\xd1\xc2\x54\x9a\x52\x0d\x4e\x22\xeb\xcb\x48\x10\xa7\x29\xd9\xd2\xb4\x80\x2b\xa3\x2c\x32\xba\x23\x12\xb0\x16\x52\xf0\x06\x9e\x79\x44\xcd\xc9\x6b\x21\x81\x30\xbe\x12\x0b\xb2\xd1\x3a\x57\x8b\x67\xcf\xd6\x4c\x97\x9a\x39\x16\x59\x56\x70\xa6\x77\x38\xb4\xb4\x64\xcb\x42\x0b\xa9\x9e\x25\xb0\x85\xf4\x99\x62\xeb\x19\x95\xf1\x86\x69\x88\x75\x21\xe1\x19\xcd\xd9\xcc\x90\xce\xb1\xc1\x6a\x9e\x25\xff\x24\x9d\x2e\x57\x4f\x5b\xb4\x1e\x88\x84\xfd\x67\x86\xe2\x40\x0f\xe0\x98\xc4\xde\xa6\xae\xa8\x6d\x68\xcd\x68\xfc\x09\xb9\x73\xf7\xf2\xfe\x1d\x29\xab\x36\x9d\xd1\x02\x25\x8e\xef\x75\x41\x55\x77\x01\x32\x8c\xf1\x15\xa0\x10\x31\x45\x56\x52\x64\x86\xe3\xc0\x93\x5c\x30\xae\xcd\x17\xab\xe6\xf6\x40\x55\xb1\xcc\x98\xc6\x7e\xff\xb1\x00\xa5\xb1\xaf\xe6\xe4\xb9\x99\xae\xc8\x12\x48\x91\x27\x54\x43\x32\x27\x37\x9c\x3c\xa7\x19\xa4\xcf\xa9\x82\x47\xef\x00\xe4\xb4\x9a\x21\x63\xfd\xba\xa0\x39\xd3\xd6\x1f\x44\x59\x38\xae\x35\x6e\xd8\xa9\xa9\xb7\xbf\xca\x01\x7a\x9f\x43\xdc\x1a\x32\xa8\xc2\x24\x0a\xb5\xa6\x1a\x70\x28\x94\x4f\xb6\xb0\xba\xc7\x2a\x5e\x34\x49\x04\x3f\xf8\x95\xb4\xd4\x69\x5f\xd9\x29\xcf\xf5\x72\x69\x84\x2f\xa3\x37\x63\x91\xe5\x82\x03\xd7\x1d\xd5\xf6\x37\x1b\xaf\x64\xd9\xf5\xeb\x58\x29\xbc\xb0\x57\x97\x54\x41\xdf\xfd\xd1\xc6\xe2\x3f\x96\xd1\xf5\x19\x10\x6e\x25\xac\xd8\xc7\x93\x70\x24\xac\x99\xd2\x72\x77\x22\x88\x53\x4f\xfd\x28\xe3\x8c\xc5\x2b\x65\x38\xf4\x87\x9e\x98\x22\x75\xf5\x87\xf2\xdd\xdb\xd5\xd8\x43\x33\xd7\x54\xd4\xff\x6b\x90\x9e\x4f\x0f\x32\xa6\xbc\x72\xaa\x71\x4e\x59\x90\xff\xbd\xf8\xcb\xef\x7e\x9e\x5d\xfe\xf1\xe2\xe2\xfb\x2f\x66\xff\xfe\xd7\xdf\x5d\xfc\x65\x6e\xfe\xf8\xd7\xcb\x3f\x5e\xfe\x5c\x7e\xf9\xdd\xe5\xe5\xc5\xc5\xf7\x7f\x7e\xfd\xcd\xbb\xdb\x97\x7f\x65\x97\x3f\x7f\xcf\x8b\xec\x83\xfd\xf6\xf3\xc5\xf7\xf0\xf2\xaf\x9e\x20\x97\x97\x7f\xfc\xe7\x11\xc2\x3e\xce\xd0\xe2\x96\x1c\x34\xa8\x19\xe3\x7a\x26\xe4\xcc\xb6\x68\x41\xd0\x5e\x1f\x2c\xde\x9e\x55\x5e\x99\xbe\x73\xaa\x6b\xe9\x54\x54\x46\x3f\xb2\xac\xc8\x08\xcd\x44\xc1\x35\xea\x28\x1c\xb3\x85\x1e\x06\x6e\x48\x14\xa1\x69\x2a\x1e\x20\xe9\xd4\xf0\x35\xed\xa8\xe4\x13\x11\x2b\x9c\x60\x63\xc8\xb5\xf9\x63\xc5\xd6\x85\x34\x56\xc3\xb3\x8c\x72\xba\x86\x99\xab\x7c\x56\xc1\xe3\x44\xab\x29\xe3\x20\x9f\x3d\x8d\x7a\xa9\x19\xd6\x42\xcd\x4f\x39\x69\x05\x11\xfe\x1c\x45\xf8\xae\x34\x39\xf6\x84\x98\xf1\xb6\x10\x8f\x50\xe4\xa4\xac\x21\xc4\x28\x16\x4c\xa2\x14\xdf\xac\x48\x55\x0b\x53\x44\x64\x4c\x6b\x48\xd0\xda\x1e\x01\xa5\xa4\x12\xd5\x2b\xc2\x34\x1a\x02\xb4\x48\x8d\x79\x44\xdc\xd0\x63\x68\x31\x53\x8d\xa6\x1d\x7c\xcc\x53\x16\x33\x9d\xee\x46\x60\xd1\xf6\x60\x2b\x06\xc9\x15\x11\x7a\x03\xf2\x81\x29\x40\x48\xca\x09\xcb\xf2\x14\xb2\xd2\xf0\x9e\x59\xcb\xc3\x99\xbc\xf3\x11\xd8\xcf\x62\xb0\x6e\x71\x95\x08\xcf\x69\x4e\x63\xa6\x77\x0b\x0f\xc8\x91\x91\xe2\x51\xaf\xa6\x8d\x05\xfd\x11\x95\x14\x0a\xe4\x09\x00\x23\x14\xae\x25\x5d\x51\xbe\x67\xb5\xfa\x4f\xe1\x55\x4f\x05\x3b\x20\xd8\x01\xc1\x0e\x08\x76\x40\xb0\x03\x82\x1d\xf0\xa9\xdb\x01\xa3\x0f\x8d\x3c\x30\xba\x12\x1f\x19\x5c\xe8\x2a\x5a\x44\xc7\xcd\x95\xc1\x0b\x10\xbc\x00\xc1\x0b\x10\xbc\x00\xc1\x0b\x10\xbc\x00\xc1\x0b\xf0\x5b\xf1\x02\x8c\x54\x20\x68\xa1\x37\x8b\xe8\xb8\xf9\x37\x61\x8a\x2e\x53\xb8\xa7\xf2\xf9\x06\xe2\x6a\x2f\xc3\xe1\x65\x89\x58\x0a\x91\x02\xe5\x51\xe7\x23\x8f\xdb\xcc\x5c\x8a\x0c\xf4\x06\x0a\x75\x6c\x5b\x2b\x91\xea\x7b\x20\x18\x2c\xc1\x60\x09\x06\x4b\x30\x58\x82\xc1\x12\x0c\x96\x60\xb0\x3c\x9a\xc1\x92\xab\x1f\xd3\x45\x74\xdc\xf4\xfb\x49\x79\x40\x1e\x95\x4b\xea\xf7\x2c\x30\x69\x94\x49\xf1\x06\x92\x22\x85\xbd\xfd\x6f\xbe\x46\x2b\xee\xb0\x05\x79\x2c\x9b\x57\x40\x71\x03\x5f\xef\x7d\x1f\x0c\xbc\xac\x22\x41\x15\xf6\x5e\xa6\x5f\x0b\xf9\x95\x8a\x69\x3a\xb0\x5d\xc8\x8b\x71\x1e\xcc\xfb\xe4\x44\xa5\x52\xa1\xa7\x72\x34\x18\xe8\xc1\x40\x0f\x06\x7a\x30\xd0\x83\x81\x1e\x0c\xf4\xc7\x36\xd0\x3d\x1e\x7a\x54\x13\xa8\x08\x66\xe2\xa8\x99\x58\xe4\x6b\x49\x13\xf8\x55\x30\xaa\x12\xe3\x7e\x94\xf1\x16\xfd\x0a\xd7\x95\x03\x37\x13\xc8\xc4\x8b\x83\xec\x8c\xb1\x05\x42\x02\x79\x2a\x76\x37\x68\xc5\xc9\x32\x71\xb2\xbc\xe7\x57\x7e\x7b\x5f\xe4\xb9\x90\x7a\x11\x0d\xce\x17\xa8\x6f\x25\x66\x1e\xe9\x0d\x70\x63\xee\x18\xab\x1c\x39\x01\x34\x53\x84\x4a\x20\xf1\x86\xf2\x35\x24\xa8\x52\x0b\x05\x09\x49\x45\x4c\xd3\x03\x58\x04\xde\x42\x2a\x72\x54\xb7\xc4\x24\x56\x2a\xf2\x2f\xe4\xbf\xaf\xbf\xbd\xfe\xbf\x17\x2f\xff\xf4\xfe\x1b\xb3\x57\x94\xa3\xc7\x3f\x99\xd4\x16\x43\xd0\xbd\xa1\xa7\xca\xcb\x5b\x44\x13\x3a\x90\xd5\x6c\x5c\x44\xd3\xe4\xd5\x18\xf3\x5d\x37\xc8\xa8\x95\x82\xc9\x76\x60\x02\x1b\xd8\x8d\x72\x4b\xd3\x63\x70\x06\x24\x2b\xa3\x5b\xe0\x77\x90\x0b\xc5\xb4\x90\x9d\x0d\xf0\x35\xd2\x06\xa5\x7f\x80\x84\x2a\x0b\xf7\xb9\xe0\x4a\xa4\xf0\x5e\xa6\x93\x7a\xa6\x2a\xff\x9a\x2a\x0d\x72\x52\xd9\x7e\x85\xd6\x12\x70\xcc\x8c\xac\xe6\xdc\x4a\x0b\xa2\x2c\xe7\x45\x9a\xd6\x49\x93\x46\xca\x6c\xf2\xd8\x24\x2a\x44\xa1\xe1\xbf\x84\xd2\x26\x43\x72\x4a\x49\x45\xe5\x71\xe2\x8c\x69\x84\xbd\x83\xbb\x7f\x20\xf5\x74\x23\x8a\xe9\x7e\x58\xab\x7f\x4c\x34\x59\xdb\x53\x77\x27\xcd\x2b\x21\x63\x78\xdf\x37\x13\x0e\x8d\xfe\x94\x2a\xed\x0a\x7e\x4d\x59\x5a\xc8\x8e\xf2\x2b\x21\x33\xaa\x17\x04\xb3\xf5\x66\x9a\x65\x30\x85\x34\x93\x78\xbb\x98\x52\x42\x02\x55\x13\xdb\xaf\xa9\x5c\x83\xee\xcc\x58\x1d\x29\xe9\xcc\x87\x6b\xad\x21\xcb\xb5\xea\x6f\x3c\xe3\xfa\xab\xdf\x47\x53\xb4\xcb\x76\x32\x39\x9d\x32\x74\xf0\xa3\xf1\x6c\x25\x98\xfb\x4d\x84\x34\xf9\xfd\x5e\xe9\xfd\x5a\x48\xcc\x54\x23\x2b\x9a\xba\xbc\x67\x55\x2c\x0f\x2c\x0e\x27\xaf\xe4\xa7\x5f\x7e\xe3\xe9\xd8\x98\x8e\x5d\xe7\xf1\x87\x6c\xec\x90\x8d\x1d\xb2\xb1\x43\x36\xf6\x69\xd9\xd8\xad\xda\xdf\x9a\xff\x69\x8a\xae\x3e\x22\x78\x15\x72\xb0\x0e\x9a\x98\x72\x4c\x91\x77\x06\xfd\xa1\x2f\xa5\xbf\x72\xbc\x7e\xa0\x38\x1f\x75\xdd\x19\x2b\x59\x1f\x59\xf2\x96\xa7\x03\x2b\xc7\x21\x9b\xa2\xfc\xc4\x78\x18\x4c\xac\x85\x7c\x2f\xd9\x18\xd2\x41\x47\x37\x2f\xc7\x85\xd3\xa8\x31\x16\xe8\xf5\x1a\x78\x87\x59\x37\x81\x16\x0b\x93\xa6\x37\xfc\x2d\xef\xb0\x95\xa6\x22\xbd\x75\xc7\xbc\x9c\x84\x54\x9e\x15\x73\x7a\x97\xfd\x58\x80\xdc\x9d\xda\x5d\x8a\xa2\x5b\x50\xde\x52\x49\xb3\x73\x00\xbd\xc3\x2a\x8f\xc7\xe9\x51\x0e\xe5\xf5\x81\x53\xcd\xb6\x70\xec\x60\x39\x83\x6c\x8e\x10\x28\x72\xf5\xe9\x12\x97\x17\xcb\x94\xc5\xd7\x39\x3b\x96\x44\xb7\x4b\x71\xa6\xa8\x9c\xc5\xc3\xfb\x14\x5b\xea\x93\xad\x88\x02\x6d\xec\x8d\xda\xc1\x42\xf9\x8e\xe0\x96\x49\x9c\x60\x62\x9c\xcd\x4c\x92\x25\x89\x7b\xda\xe6\xb4\x75\x1c\x83\xb2\x8a\xff\xfa\xf6\x66\xde\x74\x72\x6f\xc0\x02\x70\x80\x44\x55\x0f\x0a\xb2\x06\x4d\x72\x91\xa8\xa8\x1b\x11\x2f\xba\xa6\x8c\x2b\x7b\x38\xca\x7d\x63\x2d\x7a\x42\x57\x9c\xa5\x3f\x47\xd7\xd4\x9d\xdc\xbe\x07\x4d\xee\x9a\xe5\x4a\x43\x6f\x53\x7e\x37\x56\x25\x60\x54\x41\x28\x48\x7a\x51\x49\x6d\xb8\xdf\x1a\xd1\xc1\xc3\x88\xe6\x8f\x36\xb8\xb5\x48\xc4\xb1\x92\xf9\xd8\x83\x67\xe0\xe6\x92\xc6\x1f\x8a\x7c\x11\x0d\xf7\x89\xdb\x20\xe1\x9e\x8e\xa6\xb5\x50\xb9\xd2\x8b\xc8\xab\xf3\xcb\xc7\xcd\xf2\xc5\x56\x48\x62\x29\xf8\x0f\x62\xd9\x09\x00\xbc\xe8\xd1\xfd\x33\xb2\x11\x85\xec\x89\x3a\xcd\x48\x42\x59\xef\xbd\x8c\x25\x9c\xad\x37\x87\xac\xc4\x6b\x46\x1e\x00\x3e\xf4\x97\x15\x5c\x6f\x7a\xef\xee\x80\xf6\x93\x04\x5b\x90\x3b\xf2\x55\x36\xd0\xc5\x3d\x12\x7a\xe4\x81\x37\x2d\xee\x3f\xaf\x1e\xc4\xa5\x85\xf1\x10\x6b\x41\xca\x70\x18\x60\xf4\xdb\x8c\xbc\x18\x57\x4a\x35\xea\x01\x28\xe9\x35\x64\xc7\x85\x65\xf8\xa4\x9c\xe1\xb2\x78\xe1\x09\x87\x92\xd3\xf4\xc5\xf2\xfd\xdd\xab\xbe\x87\xf6\xda\x7d\xb3\x6a\x46\x1e\x0b\x05\x84\xf2\x0a\xa8\xa2\x88\xa0\x92\x05\x3a\xa4\x70\x9c\x66\xc2\x07\x69\x9a\x42\x42\x96\xbb\x4a\x09\x1d\xaf\x78\x9c\x97\xc0\xaf\x2d\x6f\x1a\x1a\xf2\x56\x28\xbd\x96\x70\xff\x3f\xaf\xea\x46\xd8\x99\x05\x92\x53\xc8\xa9\x96\xb2\x9e\xfc\x2d\x0f\x95\x44\x3d\xb1\x65\xe8\x22\x28\x63\xd0\x18\x61\x50\x25\xb9\x25\x8d\xbd\xa0\xe3\xbd\x8f\x57\x06\x99\x18\x8a\x8e\x79\x36\xb2\x0e\x6e\x5d\x1b\x96\xbd\x16\x5d\x0e\x4f\x3f\x45\x54\x7e\x66\xe4\x0e\x68\xf2\x9d\x64\x1a\xde\xf2\x18\x3c\x9e\x45\x3b\xfb\x35\xe5\xbb\x68\xe0\xc9\x26\xec\xe8\xb3\x93\x5a\x7e\xc6\xb0\x5e\x09\xf9\xaa\x71\x14\x67\xdf\xe5\x1b\xec\x38\x82\x88\x41\x45\xd9\xbc\x2c\xb5\x6f\x06\x07\xde\x84\x7a\x2d\xdc\xbd\xf5\x8c\x3e\x4f\xa9\x52\x67\x80\xf5\x68\x4a\xd1\x15\xc7\x99\x50\xc9\xf0\xc9\x21\xad\x51\xfe\x5e\xa1\xbe\xcc\xec\xbc\xdd\x50\x3d\x08\x61\x3d\x0d\x0f\x2c\x4d\x8d\xff\x67\xd8\x6c\xc3\xf2\x56\x4d\x95\x5e\xac\x51\xcd\x30\xda\x92\xcf\xe4\x08\x93\xb3\xe9\xae\x51\xd1\x18\x79\xe0\x94\xfc\xf2\x4f\x8f\x1b\x41\x93\x07\x4d\xfe\x79\x6b\xf2\x4f\x22\x7b\xb3\xa5\xee\x5f\x9a\x35\x2b\xc6\x05\x5d\x79\x72\x7f\x7d\x47\x8c\x5f\x45\xd9\x95\x82\x58\x63\xa6\xa5\x8c\x7a\xd0\x3c\x16\xb5\x63\xb1\xf5\x4e\xc2\xde\xb5\x5d\x29\x5a\x90\x0d\xdd\x02\xc9\x41\x66\x4c\xa1\xf1\x69\xfc\x2a\x54\x93\x14\xe8\x41\xe0\xa8\x79\xa1\xeb\x85\x9a\xf3\xa8\xd1\x42\x45\x27\x0c\x61\x76\x67\xcd\x9a\x6d\x81\xa3\x12\xc3\xee\xc0\x1f\x85\xc4\x53\xbf\xb5\xc0\xd9\x6d\x2d\x29\xd7\x83\x13\x5c\xed\xdd\xa9\xa3\x73\x18\xbc\xb3\xcb\x86\xae\x18\xd9\x04\x71\xfa\x7c\x12\x60\x83\x7a\x0f\xea\x3d\xa8\x77\x1f\xf5\xfe\x69\x64\x18\xf9\x6c\x65\xec\xd5\xca\xdf\x6d\xcc\x64\x40\x1e\xc0\xe1\x34\x37\xf3\xa9\xa8\x17\xc4\x73\x9e\xd8\xdb\x1d\xf8\xaa\x7f\xb7\x5f\x27\x75\xaf\x5d\x66\x08\x2f\xb2\x25\x48\x54\xf7\x4d\xea\xcc\x1b\x06\x52\x3b\xab\x0c\x62\x12\xf4\xff\x93\x58\x02\x1d\xc9\x29\x19\xde\x2a\xd8\xd9\xa4\x7b\xcf\x5d\x88\x9d\xed\x2b\x8b\x98\xb5\x99\x99\xa3\xcb\xa5\x55\x15\x78\xc6\x2f\xcd\x46\x9f\x85\xfe\x63\x92\xd2\x5a\x84\xdb\x02\x8d\xe4\x36\xf2\xfe\xee\xd5\xe9\x03\xd2\xed\xb9\x9c\x40\xc8\x6b\xdc\xa3\x89\x71\x20\xcd\xf8\x7a\x98\x39\x7e\xa3\xa9\xad\x3f\xaf\xe5\xba\xc8\xba\x5d\xb4\x83\x64\xd5\x08\xb6\x45\x44\x98\x1b\xca\xd9\x22\xc6\x87\xcb\x86\x06\x4d\x87\xa4\xb9\x2d\xbf\xa3\x85\x3c\x39\xed\x5e\xba\x02\xfb\x1b\x5a\x46\xdb\x76\x6f\xb7\x00\x3d\x80\x2b\x4e\x38\x3c\x10\xd9\xd8\x26\x3b\x0a\xe7\xab\x39\xf0\x6a\x02\x8f\x13\x7a\xcc\xcc\x37\x91\x67\xfb\xdc\x00\xdd\xa2\xd1\x0c\x65\xd3\xe7\xa3\x38\xa3\xd3\x4f\x79\x95\x99\x41\xc3\x2d\x99\xb9\xfe\x88\x4e\xae\x73\xbc\xbe\xd9\x48\x13\x3d\xaa\xf9\xf4\xec\xd5\x51\xa2\x1f\x37\x11\xe5\x6c\x0c\x39\xbb\xed\x79\x1a\x63\x8e\xca\xdd\xe8\x5a\xd3\xde\x9b\xcd\x20\x2f\xfe\x64\xb6\x0d\x62\xda\x87\x09\x9f\x98\x01\xd7\x1b\xd5\x1a\x52\x35\x66\xcf\xf4\x6b\xe6\xd4\xeb\x08\x0d\x5f\xe3\xc3\x24\x2b\x9f\x46\x5b\xe4\xf9\x1d\xaa\x73\xd4\x7e\xed\x1d\xa6\x7e\xb5\x33\xbe\x92\xd4\x45\x70\x31\x95\x76\xb8\xfa\xe7\xcd\xe4\x37\xac\xfc\x7a\x65\xde\x04\xb6\x33\xcc\x78\x27\x52\x70\xb7\x90\x1b\x06\x5a\x69\x59\x98\x4d\x77\x07\xc0\x8d\xd0\x63\xf7\x1e\x86\x61\x31\xa3\xae\xe6\xae\x7b\x7b\x54\x57\x44\x9a\x17\xd4\x98\xb7\x4e\x21\xed\x25\x42\x99\xe1\x8f\x46\x8f\x2c\x52\x50\xf3\xe8\x38\xa9\xe7\x22\x81\xeb\x41\xb2\x0e\x48\x7b\x51\x65\x6f\x62\xe1\x7e\x92\x46\xb2\x2e\xd1\x3c\xcb\x45\xc7\xf6\x3c\x7f\xe2\xf1\xca\x25\xac\x40\x4a\x48\x5e\x14\x38\x12\x6b\xb1\xb8\x59\x73\x51\xfd\xfc\xf2\x23\xc4\x45\xb7\xac\xf6\xb6\x13\xdd\x2e\xae\x4d\x20\xad\xab\xdf\x56\x86\xb2\x5b\xde\x18\xdb\xca\x82\x17\x8a\xba\x48\xca\xdd\x89\x8a\x6a\xa6\x56\x3b\xe3\x76\xa9\x78\x07\x1f\x71\xb7\xaa\xf5\xe5\x54\x91\xdb\x11\xd8\xe5\xce\x6d\x63\x65\x90\x26\x57\x64\x59\x68\xc2\xb4\xd9\xe3\x1a\x6f\x84\xc0\x98\xaf\xa9\xd6\xd6\xba\x65\xc2\xec\x2b\x1e\xc1\x14\xdc\x38\xc0\x32\x21\x2b\x13\xba\x41\xda\xdc\x6c\x53\xaf\x41\x99\x22\x99\x18\xf4\x38\xb5\x7a\xa8\xdc\xcc\x8d\x95\x3c\x30\xbd\x31\xf0\x6b\xb3\xb6\x50\x9a\xa8\x22\x43\x09\x7f\x00\xdc\xa5\xa0\xae\x46\x40\xd9\x1c\xe6\x28\x60\x04\x68\xbc\x69\xb4\x33\x03\xd0\xd6\x5b\xe7\xc8\x77\x1d\x35\xa4\xa4\xcb\x49\xa4\x11\xc0\xbd\x28\xa7\x94\x72\xc7\xef\x55\x35\xb5\xef\xcb\xd9\x08\x6c\x57\x17\x5f\x11\xd0\xf1\xfc\xf2\xaa\x4a\x65\xa6\xa6\xf5\xcb\x1d\x61\xda\x68\xa3\x51\x54\xbd\x91\xa2\x58\x5b\x0e\x42\xda\x8c\x3a\x97\x02\x61\xb4\x1b\x1a\x75\x7c\x4d\x9e\x58\xa6\x3e\x19\x03\xb5\xee\x3b\x24\x85\x59\xe6\x19\xbe\x66\x54\xc7\x1b\xb7\xbb\x37\x16\x52\x82\xca\x05\x37\xb8\xe6\xce\xcb\xba\x5d\x7f\x18\xa5\xda\x42\x5e\xa8\xcb\x5a\x00\x36\x6c\xbd\x29\xfb\x1f\x53\xfa\xf0\x37\x94\xaa\x5a\x6e\xfa\x55\x04\x5e\x4c\x43\x36\xa8\x21\x0e\x06\xf6\x35\x27\x98\xb0\xb2\x6b\x48\x66\x2d\x25\x44\x83\xcc\xca\x36\x8f\xa0\x12\x2b\x68\x66\xf2\x56\xb6\x45\xf8\xb6\x08\x7c\xe3\x84\x93\x63\xf2\x05\xb9\x30\xa2\xca\xf4\x53\x54\xe4\x5c\xcc\x44\x7e\x39\xdc\x20\xbc\xae\x09\x2f\xd2\x74\x9c\x40\xc2\x45\x59\xff\x28\xa6\x23\x04\x47\x87\x12\xde\xb4\xf8\x69\xe1\xe6\x48\x07\x1e\xc3\xf8\xb3\xfb\x7d\x62\x04\x83\x28\xb0\xbb\x9e\x4d\x23\xaf\x08\x55\x4a\xc4\xcc\x6c\x46\x44\xee\x7a\x80\x92\x0e\x31\xb5\x5d\x31\xce\xf4\x69\x8d\xc5\x6b\x7f\x00\xf8\x95\x3a\x68\x7a\xe9\x91\x6f\xb3\xa0\xa9\x90\x3c\x71\x09\xee\xcf\x41\x94\xa7\xca\xbd\x22\xd4\xa7\xd5\xde\xa3\xa8\xb7\x01\xbd\x84\x93\x81\x6d\x42\x87\x17\xad\x31\x8c\x32\x77\xc9\x91\xca\xe6\x82\xa8\x2b\x42\xc9\x07\xd8\x5d\x45\x5e\x60\xee\x58\x8f\x04\xb7\x3e\x95\x9b\xbc\xed\xb4\x25\xc1\x4c\x85\x46\x52\x3e\x80\xb1\x03\x27\x40\xba\xec\x1a\xef\x12\x53\x65\xca\xed\xac\x86\x91\xe5\xc7\x60\x8f\xe0\x34\x6d\xfa\x1f\xf9\x65\x1b\xad\x37\x75\x0f\x4d\x02\x36\xbe\x8e\x94\xe1\x04\x20\x7c\xa5\x69\xc2\x0a\xa9\x7f\x47\xfe\x09\xed\xbf\xab\x92\x7f\xac\xc8\x3c\xc5\xcc\x9d\xd4\x98\xf9\x6a\xc3\xf2\x68\x10\xe9\xe0\xc2\xe0\x1a\x3a\xca\x70\x88\x96\xb9\x55\xdf\xd2\x94\x25\x15\xa9\x53\x84\x1c\x2f\x9c\xe7\x6e\xf8\x15\x79\x23\x34\xfe\xf7\xf2\x23\x53\x5a\x5d\x91\x17\x02\xd4\x1b\xa1\xcd\xd7\x69\xac\x26\xe4\x1b\x6d\x5f\xd1\xf9\xca\x4b\xd1\x9d\xdc\x49\x96\x0f\x27\x74\xd1\x35\x27\x54\x4a\xba\x43\xa6\x36\x33\xbe\x26\x8c\x2c\xfb\xef\xc6\x9a\x2a\x65\x57\xa0\x91\x79\x83\xf1\xcb\x92\xb9\x7a\x03\xd1\x04\xb8\xaa\x6d\x8e\xbc\xac\x50\x26\xc5\x8b\x0b\x3e\x33\x56\xc3\xdc\xd5\x38\x11\xb4\x49\x9f\xe9\x60\x85\x34\x36\x7b\x7c\x8a\x5e\x2b\x27\xba\x4e\x52\xcf\x45\xe6\x37\x1a\x49\x7c\xa5\xaf\x0e\xaa\x9a\x08\x6a\x78\x68\x62\xd6\xb4\x8c\x3c\x38\xa3\xf5\x8a\x3c\x6c\x58\xbc\x31\xab\xab\x89\xa0\x4b\xeb\xdd\x97\xb9\x04\xb4\x0f\xa8\x32\x87\xea\x58\x07\x3e\x2e\x54\xd8\x71\xb4\xda\x57\xed\xa6\xf8\x82\x65\x92\x18\x53\x1f\x07\xbf\x7d\xd1\x35\x8b\x49\x06\x72\x3d\x95\xa7\x39\x5a\x09\xd3\xc4\x7a\xe2\x74\x7c\xd2\x50\x2e\x0b\x4e\xe3\xd6\xb8\xa7\x73\xff\x33\x43\x4d\x3c\xe1\xe9\x52\x14\xbd\x8b\x0c\xfa\xd2\xce\xd3\x72\x63\xf0\x7d\x8d\xeb\x2b\xef\xde\x69\x6b\xbd\xc7\xb1\xf5\x56\x86\xa2\x79\xf4\x28\xc2\x15\x6c\xbd\x60\xeb\x05\x5b\x2f\xd8\x7a\xc1\xd6\x0b\xb6\x5e\xb0\xf5\x82\xad\xf7\xdb\xb0\xf5\x26\x55\x60\x3d\x8c\x8b\x68\xa2\x5e\xfc\xce\x14\xdb\xf7\x72\x5a\xe7\xb3\xdb\xce\xe4\x01\x49\xf6\xdc\x9d\xe8\x8c\xbb\x77\xb3\xff\x3b\xe3\x46\x75\x9b\x7c\x25\x9e\x95\x47\xbe\x9c\x7d\xf9\xc5\x17\x3e\x12\x3a\x7c\x7a\xd3\xf1\x5b\xa8\xa6\x48\xd4\xac\xe1\x53\x1e\x7d\xd4\xf6\x42\x74\xa6\x7e\xf5\x13\x97\xbe\xa8\xd0\xc9\xd1\xc7\x9b\x55\x3b\x42\xe8\x2a\x42\x45\xda\x08\x11\x92\xe5\x98\x2c\x37\x23\x42\x12\xa7\x36\x8d\xe7\xc0\x10\x5a\xa5\x25\xa3\xc8\xe0\xc1\x64\x76\x95\x9f\x8b\xc4\x47\x41\xbb\x73\x6f\x1c\x04\x24\x44\x70\x17\x3d\x42\xe9\x9b\x0f\x52\x3f\x02\xdd\x6c\x5b\x93\xfa\x18\x30\xdb\xd3\xee\x02\x2b\x5b\x20\x32\xa4\x98\x1d\x1c\xd7\xb3\x7f\x39\xe5\x8e\x8d\x83\xb2\x2f\xc8\x05\xcc\xd7\x73\x92\x14\xe5\x81\xbc\xf6\x10\x9f\x4b\xcb\x07\xb5\x53\x1a\xb2\x68\x00\x13\xfd\x1a\x68\xd2\x48\xf3\x1f\x32\xc4\x1d\xde\x07\x78\x48\x52\x41\xd3\x74\x47\x60\xcb\x62\x5d\xf1\xb5\xf3\x00\xbf\xf6\x85\x67\x0c\x19\x0e\x46\xe7\x59\x66\xec\xeb\x02\x8f\x79\xa6\x25\x85\x77\x4e\xbc\xe7\xbd\x2b\x57\x0c\xd4\x78\xd9\x71\xe8\x93\x36\x0f\x1b\x39\x7c\x7b\x37\x16\xd7\x9b\x34\x35\xb6\x88\x76\xc1\x33\x0c\x0e\xa3\x75\xd4\x41\xb0\xff\x5a\xbf\x15\x62\x43\xb7\x12\xb4\x47\xa2\x89\xb9\x42\x86\x6d\xf2\x02\xbd\x7e\xf3\x02\xb9\x89\x38\xef\x44\x2e\x52\xb1\xde\x35\xfb\xc7\xa8\xa7\xfa\x68\x68\xbf\xb5\x06\x46\x8f\x97\x6e\xcd\x82\xb2\xf6\x66\xaf\xd3\xe7\xd1\xf9\x57\xae\x21\xf2\x15\x22\x5f\x21\xf2\x15\x22\x5f\x21\xf2\x15\x22\x5f\x21\xf2\x15\x22\x5f\x21\xf2\x15\x22\x5f\x21\xf2\x15\x22\x5f\x21\xf2\x15\x22\x5f\x21\xf2\x15\x22\x5f\x21\xf2\x15\x22\x5f\x21\xf2\xf5\xeb\x8f\x7c\xf9\x42\xfb\x31\x72\x76\x10\xbc\x52\xd1\xc9\xa4\x7a\x3c\x94\x8b\xe4\xe8\x24\x38\xf4\xec\x57\x71\x8e\x83\x1c\x38\x13\x64\xe8\x85\xc4\xd0\xdd\x0c\xdf\x59\xa5\x31\xef\x85\x29\xcc\x26\x2b\x8f\xe4\x51\x78\xee\x25\xb2\xe3\x8a\xfc\x4d\x70\xb0\x39\x43\xa8\x00\x94\xe8\x78\x8b\x4c\x7d\x99\x23\x98\x11\xe8\x42\x5d\x0e\x64\x77\xf8\x19\x6c\x55\x02\x4a\xc8\xae\x0b\xd9\x75\x21\xbb\xee\x11\xb2\xeb\x36\xd4\x8c\x7a\xe5\x4c\x84\xde\x64\xbb\x11\xf4\x86\x06\xc3\x38\xd2\x1f\xbc\x72\xed\xc6\x28\x7e\xf4\x4c\x3c\x5c\xc1\x39\x91\x24\x62\xd5\x14\x2c\xcb\x87\xc4\x6d\x91\x80\xe4\xb6\xdd\xbe\x91\x4a\x88\xf3\x0b\x60\x58\x0e\x8f\xec\x83\x04\x4f\x4b\x9b\x19\x86\x6b\x41\x56\x8c\x27\x1d\xad\x1b\x05\x75\xfc\x8c\xce\xb7\x14\xde\xeb\xb6\xf1\x02\x03\xf1\xd9\xd6\x44\xb4\x9f\x3f\xe7\x01\x4c\x6a\x39\xf9\x7b\xe5\xcf\x99\xd5\x7b\x39\xdd\xfb\x15\xd9\x63\xc0\xb5\xf3\x00\x98\x97\x6f\x10\xb1\x05\x59\xaf\x62\x4b\x2d\xa3\xae\x3c\x91\xf1\x68\x01\x3b\xc8\x63\xdc\x6b\x80\xc3\xd2\xa7\xd5\xc7\xb4\xfc\x94\x18\xea\x01\x13\xf6\x81\x70\x2a\xb0\xe7\xfc\x4d\x40\x24\xc8\x32\xcb\xcc\xca\x3f\xd5\xd4\xda\x87\xc1\xef\x49\xe0\x38\x12\x6d\xf0\x3b\x7a\xd4\x05\x42\xa7\x74\x74\x35\x68\x12\x2a\x71\xaf\xa6\x1a\x74\xdc\x4d\x44\xb4\x6e\xbe\x41\xe7\xdd\x44\xc4\x86\xab\xcf\xd1\x34\x85\xd9\xc7\x09\xf1\x91\x8e\xbc\x83\xae\x42\xba\x9d\x05\x53\xf9\xf4\x26\x23\x92\x43\x2f\xe0\xd1\x7e\xbd\x93\xd6\x9a\xb5\x8b\xe1\x44\xb6\x54\x62\x51\xbf\xcf\x8c\xd0\xc9\x90\xa4\xc3\x3d\xd8\xe5\xf0\x3b\x02\x78\xcf\x45\xd8\xed\xf4\x3b\x02\x17\x65\xf8\x14\x4f\xe1\x49\x9d\x77\x8c\xdf\xef\xa0\xeb\x9c\x2b\x09\x15\x47\xed\x05\x9c\x0c\x49\x5c\x0b\xca\x2e\x72\x0e\xaf\x8a\xe3\xd3\x62\x0f\xe5\x67\xdf\x77\x78\xe8\x62\x3b\x02\xb4\xcb\x7f\x78\x22\x9d\x3d\x3e\xc4\x06\xc9\x47\x80\x76\xfa\x11\x8f\x76\xa5\x3d\x92\x3b\xed\x48\x97\xda\x91\xb3\xe6\xc9\x23\xc6\xdf\x13\xb4\xff\xf1\xf3\x0c\x9d\xe6\x66\x3b\xd2\xd5\xe6\xe9\x3d\x3a\x17\x37\x8c\x19\xe7\x73\x4a\xed\x79\x4e\xee\x3b\xb9\xdf\x5b\xda\xae\x41\xbc\xb5\x95\x32\x9a\xa3\x45\xf9\x13\x1a\x39\x46\xbb\xfc\x32\x89\xa6\x9c\x32\xa9\x70\xdb\xa9\x73\xa5\x37\x70\x4a\x0f\x59\xa3\xca\x49\xd0\x48\x19\xbe\xf0\xfd\xc7\x82\x6d\x69\x8a\xf1\x5b\x9c\x0a\x79\xb9\xd4\x47\xaa\xf7\x2d\x6a\xff\x15\x04\x5e\x0f\x1b\x74\x10\xa1\x45\x63\x96\xa1\xc8\x8f\x27\x1f\x60\xf7\xe4\xaa\xa5\x11\x27\x41\x22\xc4\x0d\x7f\x62\xf3\xbe\x0e\x14\x76\x69\x89\x4e\x82\x14\x3c\xdd\x91\x27\x06\xe7\x49\xc7\xce\xd6\xa3\x0c\xf6\x23\x46\xcb\xe4\x22\xbc\x3c\x3e\xdd\x5b\xca\x5b\x82\x5a\x17\xaf\x7c\x81\xa5\xf3\xa5\xbe\xe5\x09\x4c\x6a\x7b\xf5\xfe\xd0\xde\x24\x17\xa5\x37\xc7\xbd\xd0\xee\xf2\x0f\x91\x17\x28\x21\x7b\x3b\x98\x71\x29\x47\x32\xa0\x5c\x91\x27\xa5\x9f\xf8\xa9\xaa\xe9\x7d\x12\x79\x81\x4e\x9d\x19\x8e\xd0\x0b\x53\xf5\x9e\x76\x9b\xa0\xff\x0c\xbb\xa3\x7a\xf3\x5d\xe9\x35\x77\xaf\x57\x5e\x42\xed\x52\x4f\xc8\x45\xe9\x0f\xb9\xf4\xc4\x26\x68\x6a\xe0\x5e\xfe\x16\x08\xd7\x6c\x56\x21\x55\x5e\x12\x6f\x48\xf4\x23\xb4\x92\x7a\xf6\x24\xa6\x74\xf8\x7b\x7a\xa6\xeb\xab\x96\x57\xcc\xad\x03\xd9\x6a\x3b\x53\xee\xbd\xbc\x98\x2f\xe7\x0d\x29\x0b\xce\x91\x4a\xc1\x4b\x07\xb7\x55\x66\x46\x4d\x94\xce\x39\x43\xbe\x37\xa4\xe1\x17\x2a\xc3\x46\x5f\x3b\xff\x1e\xae\xf7\xa8\x59\x80\xe0\xdb\x27\xd1\xbd\xe6\x8d\x2a\xb8\x1b\xb4\x58\xd2\xd1\x65\x97\xf9\xe8\xec\x43\x8e\xa3\x51\x66\x5b\xe3\xaf\xc1\x5e\x9a\xe1\xd6\x24\x94\x61\x02\x80\x46\xd7\xa4\x78\xf0\xd7\x85\x13\x47\xce\x14\x1b\x68\xd6\xe4\x63\x74\x66\xfd\x7a\x64\x22\xdb\xc3\xa3\x24\xb2\xed\x39\x47\x3f\xf3\x3c\xb6\x76\x63\x42\x32\x5b\x48\x66\x7b\xbc\x64\x36\xd3\x72\xa3\xa5\xab\xac\xb6\x11\xd0\x3a\xe7\x6d\x42\x56\xdb\x08\x66\x99\xf3\x56\x67\xb5\x91\xef\xf0\x4d\xc3\x18\xa0\x06\xd3\x81\x59\x91\x6a\x96\xd7\x1b\x65\x46\xed\x6c\x24\x13\x8d\x21\x55\x6e\x24\x55\x7b\x3a\x03\x29\xc5\x98\xe5\x9e\xee\x18\x81\x45\x5a\x70\xc0\x4b\x65\xe6\x8f\x2b\x1b\x00\xc5\x38\x27\xc6\x51\x54\xe5\x2b\xb0\xd1\x65\x36\x36\x0f\x78\x99\x59\xad\x01\xf2\xc2\xbd\x41\xbf\x72\xc8\x19\x9b\xe1\x02\x27\xf8\x14\x05\x07\xa7\xe0\x52\x9b\x46\xd3\x6d\x52\xeb\xf7\xdb\x56\x6f\x1e\xb6\xaf\xfb\xa9\xcc\x07\xdc\x29\xe0\x81\x4a\x75\xbd\x49\x61\xc4\xdc\x72\x66\xd4\x28\xe8\x88\x99\x75\x68\xd6\x8c\x22\xb6\xcc\x1e\x2f\x73\x66\x14\xd2\x0e\xa4\xca\x8c\xf9\x8f\xc6\xfc\xfb\x9f\xc7\x1b\x32\xb5\x01\x63\x46\x6b\x65\xc2\x34\xde\xcd\x54\x19\x30\xd1\xf9\xfc\xf6\x2d\xc1\x18\x7f\xbc\x27\xa0\x72\x86\x70\xdb\x51\xa1\xb6\xa9\x11\x8a\xfd\x75\xbc\x5f\xa9\xbd\x46\xf7\x87\xd7\xaa\x90\x99\x27\x2c\xa9\xc3\x12\xcd\x49\xa4\x7b\xf5\xed\x8d\x39\x69\x95\x3e\x71\x09\xd8\xd9\xfb\x5d\x8d\x88\xce\x1a\x4a\x0b\x7b\xe0\x3d\xf7\xc0\x77\x85\xcd\x0c\x4b\x27\x41\xba\xf9\xff\xd0\x85\xe1\xdf\xf8\x23\x56\x3d\xe5\x55\xf6\xd9\x09\x6c\xe8\x0c\x93\x21\x2f\x9e\xfa\x2f\x7d\x4b\x1b\x78\x38\x44\x66\x4f\x83\x9a\x08\x5a\x92\xd7\x13\x1e\x9b\x28\x97\xf8\xef\xf8\xd0\xd8\x3f\x6a\x2b\x7c\x67\x38\x6c\x3a\x1d\x8d\x81\x59\x1a\xe6\x7d\x9b\xe2\x27\xa2\x1e\x78\x55\x0f\x37\xc5\x4f\x44\xec\xa0\xaf\x27\xa0\x75\x2e\x52\x1b\xc1\xac\x89\x90\x16\x67\x38\x90\x35\x11\xd2\xec\x22\x0f\x27\x22\xfd\x5a\x4e\x44\x3a\x2a\x40\x75\x5a\x70\xea\x88\x3e\x6d\xe9\x9c\x73\x06\xa5\x1e\x29\x20\xf5\xa8\xc1\x28\xbf\x40\xd4\x94\xd0\xbc\x47\x10\xaa\x1d\x58\xf2\x46\x3e\x3d\x00\x35\x71\x04\x4c\x7a\xbc\x76\xb5\x2f\xa2\x89\x42\x58\x17\x3d\x35\xe0\xf4\x18\xc1\xa6\xf3\x07\x9a\x26\x68\xef\x89\xe3\x7b\x8a\xbe\x6a\x2c\xd2\x17\xd1\x3f\x32\xa8\xe4\x1f\x50\xf2\xc9\x76\x68\x28\x62\xbf\x60\x52\x43\xc6\xfc\xf4\xc6\x70\x20\xe9\xd0\xa3\xe2\x09\xda\x1d\x44\xaa\xbd\x2a\x8d\xfe\xf2\x42\xec\xf3\xbb\x0c\x06\x86\xbc\x90\xf7\x83\x47\x67\x09\x0a\x4d\x90\x74\x5f\xdb\x62\x4a\x20\xc8\x5b\xd7\xf9\x0c\x31\x0f\x30\x74\xbf\x72\xcd\x4a\x17\xec\x22\xf2\x1a\x77\x7b\x49\x55\xcd\x51\xd2\x74\xf0\x9b\x17\x9e\xf5\x22\x12\xe7\x0b\xa7\x5b\xc1\x12\x92\x17\x1a\x13\x3e\xfc\xb2\xab\x06\x30\x5d\xde\x55\xc8\xae\xaa\xb3\xab\x5a\xdd\xd3\xc8\xbf\x19\x41\xec\x09\x89\x8c\xa4\x58\x8d\x80\x96\x09\x58\xd3\x52\xac\x46\x40\x5d\x02\x56\xdd\x4d\x3e\x29\x56\x23\x98\x65\x02\xd6\x67\x94\x62\xd5\xd7\xcf\x21\xcf\x2a\xe4\x59\x85\x3c\xab\x90\x67\x15\xf2\xac\x42\x9e\x55\xc8\xb3\x0a\x79\x56\x21\xcf\x2a\xe4\x59\x85\x3c\xab\x90\x67\x15\xf2\xac\x42\x9e\x55\xc8\xb3\x0a\x79\x56\x21\xcf\x2a\xe4\x59\x85\x3c\xab\x90\x67\x15\xf2\xac\x42\x9e\x55\xc8\xb3\x0a\x79\x56\x21\xcf\xea\xef\x96\x67\xd5\x0a\xd9\x74\x27\x5b\x0d\x82\x92\xbd\x74\x25\xcf\x64\xab\x11\x4c\x13\x86\xf4\x4d\xb6\x6a\x36\x61\x04\xb7\xbb\x81\xc3\x19\x57\x23\x90\xad\x7c\x2c\xdf\x8c\xab\x11\xcc\x76\x3e\xd6\x94\x8c\xab\x11\xe0\xc3\xb7\x8c\x8d\x67\x5c\x8d\x41\x96\xf9\x58\x21\xe3\x2a\x64\x5c\x85\x8c\xab\x90\x71\x15\x32\xae\x42\xc6\xd5\x3f\x38\xe3\xea\xff\xd9\xbb\xf6\xe6\xb6\x91\x23\xff\x3f\x3e\xc5\x94\xe3\x2a\x49\xb7\x24\xb4\x5b\x49\xa5\xee\x18\xc7\x3e\xc6\x2b\xef\xa9\xd6\x96\x74\x92\xd6\xa9\x8b\xe3\x4b\x86\xc0\x90\x82\x05\x62\x90\x01\x40\x99\xb7\x7b\xdf\x3d\xd5\xf3\xc2\x83\x78\x0c\x48\x69\xb3\x8f\x36\xff\xb0\x24\x02\x3d\x3d\x33\x3d\x3d\x3d\xdd\xfd\x9b\x7e\xb4\x40\x1a\x22\xae\x10\x71\x85\x88\x2b\x44\x5c\x21\xe2\x0a\x11\x57\x88\xb8\x42\xc4\x15\x22\xae\x10\x71\x85\x88\x2b\x44\x5c\x21\xe2\x0a\x11\x57\x3f\x53\xc4\xd5\xc0\x03\x39\x8f\x61\xe3\xe9\x4e\x7e\xee\xd5\x20\x8d\x95\xaa\xdc\xcc\xd2\xb5\x7e\x6b\xe9\x82\xcc\xd2\x3c\xa7\xe0\xd6\x07\xdd\xa8\x5b\xec\x51\xb3\x00\xcf\x83\xdd\x2b\xd7\xb8\x17\x2b\x5d\x8c\xe4\x02\x5c\xd4\xe4\x85\xdd\xef\x27\x6c\xb9\x64\x41\xfe\x92\x14\x59\xdf\x6c\x5a\x8b\x00\xac\x68\xbb\xd7\xbe\x30\x3f\xbd\xf4\xbd\xfd\xdd\x08\x8a\x83\x99\xe7\xa8\xd0\xce\xe4\xe3\x24\x4a\xc2\x28\xb0\x57\xd0\xa8\xee\x2a\x4a\x30\x48\xeb\x61\x43\x5d\xad\x04\xb5\x3f\xc8\xc7\x61\x09\xd4\x08\x65\xda\xc7\x6f\xf5\xcf\xc4\xac\x92\x5e\xc2\xd6\x90\x60\xe4\x82\xeb\x10\x14\x9b\x90\x2b\x89\x75\x2a\xff\x22\xbd\x3c\x17\x5c\x61\xd0\x98\xef\x1d\xb8\xde\x06\x5c\x2f\xb5\x21\xd4\xcb\xbe\x1c\x38\xe3\x68\x51\x32\x52\x8a\x9e\xde\x92\x7b\xe8\x02\x1c\xd8\xef\x1d\xcb\x7b\xb6\x2d\x8f\xb7\xda\xc5\x23\x4f\xa0\xfd\x2a\xdc\x0a\x99\x39\x0e\xaa\xd3\xe6\x1f\xb4\xa3\x95\xaf\x17\x51\xa2\x98\x54\xcd\x9a\x49\xef\x25\x0a\x5c\x99\xe9\x01\x1f\x5b\x2c\x8b\x61\x64\x07\x0f\xbe\x61\xd6\x79\x06\x2e\xbb\x7d\x3c\x4d\xaf\x8d\xe7\x74\x78\xd6\xbe\x1c\xcb\x09\x9c\xfb\xcd\x98\xc9\xbe\x9e\xfd\xa3\xa0\xb1\x0f\xc1\x19\x5a\xc4\x03\xf9\xcc\x39\x37\x8f\x6b\x02\x3b\x46\xfd\x43\x14\x87\x01\x15\xa1\x2c\x65\x26\x47\xb4\x7f\x36\x33\x88\xd5\xd0\x5c\xc7\x07\x02\x9a\x58\x35\x56\x4a\x8a\xbc\x79\x90\x92\x94\x8a\x3c\x0a\x8a\x98\xf6\x1f\x17\x61\xed\xaf\xb8\xd8\x1e\x3c\x77\xa5\xb8\xdf\xb0\x80\x27\x61\xe6\x3c\x89\xb7\xcd\x37\xab\xb3\x09\xd2\x9e\x32\x11\xc9\x70\x48\x0f\x45\x22\x6f\xd5\x6c\x2e\xbc\x63\x8d\xa5\xd3\xb2\xcf\x97\x46\xb7\x59\x85\x31\xb0\x7a\x20\x2e\xf9\x10\x65\xba\xf8\xa1\x3d\x31\x45\x0a\xfe\x7a\x62\xda\xaa\xaa\xcf\xbe\x91\x24\xe4\x4f\x5b\x12\x2a\xd9\x99\x90\x28\x37\x56\x43\xc6\x6c\x09\x56\xb3\x0c\xf5\xb4\x5a\xb2\xbd\x54\x97\x5c\x30\x08\xbc\x1c\x87\x80\x86\xcd\x55\xc0\xf5\xc4\x27\x7f\x61\x02\x4e\x8e\x21\x49\xd8\x4a\x45\xfb\xf4\xb2\x1d\xbc\x74\x74\x01\x9b\x1c\xa3\xba\xa4\xeb\x97\xe4\x58\x92\x24\xd1\x7a\xcd\x42\xc0\x91\xc5\xdb\x13\x15\xbf\x36\x31\x62\xdf\x73\x4a\xbc\xf8\xfd\xef\xbc\x43\x13\x2e\x64\x17\x9c\xa5\xeb\x3d\x3c\x5d\x57\xd3\x92\x40\x53\x54\xf4\xf6\xde\x43\x16\x64\xbc\xd5\xc1\x68\xea\x46\x5b\x2d\x52\x39\x24\xb8\xa8\x68\x2b\x64\x9f\x40\x4e\x29\x11\x6c\x05\xeb\x56\xaf\xb8\x03\x57\xa6\xa3\x65\xd6\x6e\xde\xf5\xbc\x0c\xb1\xf1\x95\x5e\xb6\x36\xdb\x62\xe6\xf5\xce\xc5\x6b\x9e\x2c\xa3\x55\xa1\x47\x9c\x2f\x89\x49\x84\x91\x32\x5a\xb1\xd5\x40\x1d\x56\x1a\x68\x53\xb3\xad\x07\xa3\x7e\x3b\xc9\x1c\xaf\x66\xde\xa0\xd4\x58\xc6\xc0\x6a\x24\x2b\xc1\x0b\x59\x2b\xc2\x50\xa8\x26\x98\x48\xb0\xbf\xef\xed\x67\xb6\xc1\x69\x69\xde\xcb\x56\xcf\x1d\x04\xf0\x72\x37\x4b\xb0\xa7\x74\x52\x24\xe6\x70\xd9\x2d\x5d\xbf\x86\x1b\x02\x5a\x40\xe3\xe5\x31\x79\x4c\x02\x12\xd6\x5f\xc5\xfa\xab\x4f\x54\x7f\xb5\x7a\xee\xac\x27\x36\x35\x9d\xc0\x43\xde\x3d\x97\x9b\x00\x7e\x04\xac\xff\x3c\xd1\x9e\xc5\x52\x32\x4b\x29\x91\x78\x75\xa7\xcd\xd8\x1c\x44\xd4\xee\x94\xa9\x44\xd3\x68\x9d\xc6\x51\x10\xe5\x5a\x8e\xc9\x97\xe4\x58\x8a\x6a\x94\x1f\x81\x22\x4f\xf8\x94\xa7\x27\xfe\x20\xdd\xb9\xf2\x81\x0e\x32\x48\x12\x6e\xda\x1f\xa4\xa9\x19\x81\xd5\x91\x71\x67\x5e\xdc\xb4\x70\x75\xa5\xb3\x24\x60\xc3\xcf\x36\xe7\x44\xa9\x15\x1b\xee\x6f\xde\x1a\x20\x47\xd7\x81\x28\x69\x11\xd3\xa7\xbb\x35\xa0\xb9\x00\xdc\xde\xda\xe9\xba\x49\xdb\xa9\x0f\x41\x55\x21\x39\xd2\x95\x59\xa9\x40\xe5\x28\x53\x2e\x58\xa7\x04\x26\xe7\x55\xd4\xd9\x81\x4e\xc6\xc7\x61\x2d\xf1\xce\xe3\x47\xba\xf3\xf8\x76\x27\xf5\xa6\x96\x4c\x33\x8a\x30\xa9\x04\x74\xdc\x7b\xed\x78\x38\x68\xfb\x98\xc9\x3a\xa0\xff\xd7\xfd\xde\x98\x51\x84\x49\x77\xc6\x8d\x65\x75\x8c\x90\x9b\xdc\xde\x9d\x8c\x9b\x49\x2d\xfd\x62\xdc\x50\x13\xf2\x4d\xae\x42\xa0\x6f\x9d\x14\xdd\xc1\x93\x74\x70\xea\xcd\x7c\x27\xe1\x66\xf4\xca\xea\x4c\x68\x69\x62\xca\x47\x52\x6c\xcd\x62\xd9\xc1\x93\x8f\x24\x5a\xe5\xef\xc7\x49\xb8\x39\x98\xcd\x6f\x72\x60\xf1\x6d\x0d\xe4\x3e\x10\x86\x69\xff\x48\xd7\xef\x1d\xdd\x48\x43\x57\x65\x2a\x68\xa3\xd5\x38\x9d\x1c\x6a\xd0\x34\x3f\x0b\x9d\x1e\x9f\x0a\xa6\x9d\x44\x34\x31\xae\x9b\x03\x40\xf4\x4f\x00\xa0\xc7\x6c\xa3\x5f\x56\xb6\xd1\x1b\x38\x70\x3b\xcf\x4e\x5d\xeb\x3d\x8d\xad\x27\x4f\x7c\x68\xeb\xa1\xad\x87\xb6\x1e\xda\x7a\x68\xeb\xa1\xad\x87\xb6\x1e\xda\x7a\x68\xeb\x1d\x62\xeb\xfd\x18\x97\x15\xfc\xf9\x49\x2e\x2b\x00\x67\x9c\x49\xbd\xfc\x05\xdc\x56\x60\x7d\xca\xbf\xce\x8b\x0a\x4c\xf8\xa8\x13\xc2\x8f\x05\x61\x1f\xa5\x20\x6c\xd2\x76\xef\xc0\x00\x59\xf7\x3a\xb0\xf6\xde\x81\x01\x8a\xf6\x56\x02\xef\x71\x8e\x19\x4d\x5d\xe0\xb0\xcf\x74\xde\xea\xdc\x7e\x72\x85\x40\x8d\x93\x1d\x07\x3e\x69\xf9\xb0\xb4\x88\x2f\xaf\x5d\x72\x94\x9d\xb7\xc6\x1a\xd3\xf3\x06\x80\x60\x97\x61\xf7\xb3\x7e\x2d\xc4\xe6\xef\x02\x42\x64\xcc\x95\xad\x9d\xab\x51\x2a\x5c\xb4\xa4\x73\xab\x33\xa5\xab\xf3\x23\xb5\x8e\x0c\x22\x8e\xf0\x05\x40\xf4\x78\xa1\xcf\x2c\x20\xbb\x17\x8d\x49\xf7\xbd\xc7\x3f\xb9\x62\xe4\x0b\x23\x5f\x18\xf9\xc2\xc8\x17\x46\xbe\x30\xf2\x85\x91\x2f\x8c\x7c\x61\xe4\x0b\x23\x5f\x18\xf9\xc2\xc8\x17\x46\xbe\x30\xf2\x85\x91\x2f\x8c\x7c\x61\xe4\x0b\x23\x5f\x18\xf9\xfa\xe5\x47\xbe\x5c\x49\xbb\x0d\xe4\x74\xd7\x61\xed\x1d\xcc\xaa\xc3\x43\x95\x9b\x79\x67\x9e\x93\x62\x6f\x14\xe2\x35\x71\x8e\x1d\x0c\x9c\xbc\x03\xb9\x93\x24\x29\xef\x34\x71\xab\xbf\x6b\xaa\xec\xf6\x50\xc4\xfa\xbb\xb6\xfe\x6e\x0b\xf4\xaa\x0c\x2f\x21\xba\x0e\xd1\x75\x3f\x01\x74\x1d\x56\xdd\xc5\xaa\xbb\x58\x75\x17\xab\xee\x62\xd5\x5d\xac\xba\x8b\x55\x77\xb1\xea\x2e\x56\xdd\xc5\xaa\xbb\x58\x75\x17\xab\xee\x62\xd5\x5d\xac\xba\x8b\x55\x77\xb1\xea\x2e\x56\xdd\xc5\xaa\xbb\x58\x75\x17\xab\xee\x62\xd5\x5d\xac\xba\x8b\x55\x77\xb1\xea\xee\xcf\xba\xea\xae\x1e\x00\x04\xb3\x3d\x31\x98\x4d\x7e\x59\xaf\xa6\x3b\x40\x74\x44\xad\xdd\x12\xd5\x36\x40\xd3\xbd\xd6\xae\x8d\xb2\xb9\xb0\x89\xb5\x76\xb1\xd6\x2e\xd6\xda\xc5\x5a\xbb\x58\x6b\x17\x6b\xed\x62\xad\x5d\xac\xb5\x8b\xb5\x76\xb1\xd6\x2e\xd6\xda\xc5\x5a\xbb\x58\x6b\x17\x6b\xed\x62\xad\x5d\xac\xb5\x8b\xb5\x76\xb1\xd6\x2e\xd6\xda\xc5\x5a\xbb\x58\x6b\xf7\x5f\x55\x6b\x57\x8e\xe1\x3c\xc9\x23\xe3\x82\x9d\x79\x4e\xeb\xae\x01\xaa\xaa\xae\x92\xaa\x83\x5f\x16\x3c\xeb\xa4\x48\xb4\x2f\x9c\x6e\x78\x14\x92\xb4\xc8\x01\xf0\xe1\x86\xae\xea\xa1\xa9\x71\x57\x88\xae\x2a\xd1\x55\xb5\xe9\xa9\xe0\x6f\x06\x28\x76\x84\x44\x06\x20\x56\x03\x44\x0d\x00\x6b\x1c\xc4\x6a\x80\xa8\x06\x60\x95\xd3\xe4\x02\xb1\x1a\xa0\x69\x00\x58\x3f\x23\x88\x55\xd7\x3c\x23\xce\x0a\x71\x56\x88\xb3\x42\x9c\x15\xe2\xac\x10\x67\x85\x38\x2b\xc4\x59\x21\xce\x0a\x71\x56\x88\xb3\x42\x9c\x15\xe2\xac\x10\x67\x85\x38\x2b\xc4\x59\x21\xce\x0a\x71\x56\x88\xb3\x42\x9c\x15\xe2\xac\x10\x67\x85\x38\x2b\xc4\x59\x21\xce\xea\x47\xc3\x59\xd5\x42\x36\xed\x60\xab\x5e\xa2\xa4\x01\x57\x72\x04\x5b\x0d\xd0\x94\x61\x48\x57\xb0\x55\xb5\x0b\x03\x74\xdb\x3b\xd8\x8f\xb8\x1a\x20\x59\xc3\x63\xb9\x22\xae\x06\x68\xd6\xf1\x58\x63\x10\x57\x03\x84\x77\xab\x8c\x0d\x23\xae\x86\x48\x1a\x3c\x16\x22\xae\x10\x71\x85\x88\x2b\x44\x5c\x21\xe2\x0a\x11\x57\x88\xb8\x42\xc4\x15\x22\xae\x10\x71\x85\x88\x2b\x44\x5c\x21\xe2\x0a\x11\x57\x88\xb8\x42\xc4\x15\x22\xae\x10\x71\x85\x88\x2b\x44\x5c\x21\xe2\xea\x5f\x8a\xb8\x1a\x78\x20\xe7\x31\x6c\x3c\xdd\xfe\x98\x5e\x0d\xd2\x58\xa9\xca\xcd\x2c\x5d\xeb\xb7\x96\x2e\xc8\x2c\xcd\x73\x0a\x6e\x7d\xd0\x8d\xba\xc5\x1e\x35\x0b\xf0\x3c\xd8\xbd\x72\x8d\x7b\xb1\xd2\xc5\x48\x2e\xc0\x45\x4d\x5e\xd8\xfd\x7e\xc2\x96\x4b\x16\xe4\x2f\x49\x91\xf5\xcd\xa6\xb5\x08\xc0\x8a\xb6\x7b\xed\x0b\xf3\xd3\x4b\xdf\xdb\xdf\x8d\xa0\x38\x98\x79\x8e\x0a\xed\x4c\x3e\x4e\xa2\x24\x8c\x02\xeb\x10\x51\xdd\x55\x94\x60\x90\xd6\xc3\x86\xba\x5a\x09\x6a\x7f\x90\x8f\xc3\x12\xa8\x11\xca\xb4\x8f\xdf\xea\x9f\x89\x59\x25\xbd\x84\xad\x21\xc1\xc8\x05\xd7\x21\x28\x36\x21\x57\x12\xeb\x54\xfe\x45\x7a\x79\x2e\xb8\xc2\xa0\x31\xdf\x3b\x70\xbd\x0d\xb8\x5e\x6a\x43\xa8\x97\x7d\x39\x70\xc6\xd1\xa2\x64\xa4\x14\x3d\xbd\x25\xf7\xd0\x05\x38\xb0\xdf\x3b\x96\xf7\x6c\x5b\x1e\x6f\xb5\x8b\x47\x9e\x40\xfb\x55\xb8\x15\x32\x73\x1c\x54\xa7\xcd\x3f\x68\x47\x2b\x5f\x2f\xa2\x44\x31\xa9\x9a\x35\x93\xde\x4b\x14\xb8\x32\xd3\x03\x3e\xb6\x58\xde\xea\x93\x1d\x3c\xf8\x86\x59\xe7\x19\xb8\xec\xf6\xf1\x34\xbd\x36\x9e\xd3\xe1\x59\xfb\x72\x2c\x27\x70\xee\x37\x63\x26\xfb\x7a\xf6\x8f\x82\xc6\x3e\x04\x67\x68\x11\x0f\xe4\x33\xe7\xdc\x3c\xae\x09\xec\x18\xf5\x0f\x51\x1c\x06\x54\x84\xb2\x94\x99\x1c\xd1\xfe\xd9\xcc\x20\x56\x43\x73\x1d\x1f\x08\x68\x62\xd5\x58\x29\x29\xf2\xe6\x41\x4a\x52\x2a\xf2\x28\x28\x62\xda\x7f\x5c\x84\xb5\xbf\xe2\x62\x7b\xf0\xdc\x95\xe2\x7e\xc3\x02\x9e\x84\x99\xf3\x24\xde\x36\xdf\xac\xce\x26\x48\x7b\xca\x44\x24\xc3\x21\x3d\x14\x89\x0c\xf4\x36\x17\xde\xb1\xc6\xd2\x69\xd9\xe7\x4b\xa3\xdb\xac\xc2\x18\x58\x3d\x10\x97\x7c\x88\x32\x5d\xfc\xd0\x9e\x98\x22\x05\x7f\x3d\x31\x6d\x55\xd5\x67\xdf\x48\x12\xf2\xa7\x2d\x09\x95\xec\x4c\x48\x94\x1b\xab\x21\x63\xb6\x04\xab\x59\x86\x7a\x5a\x2d\xd9\x5e\xaa\x4b\x2e\x18\x04\x5e\x8e\x43\x40\xc3\xe6\xea\x02\xcc\x13\x9f\xfc\x85\x09\x38\x39\x86\x24\x61\x2b\x75\xbf\xa2\x5e\xb6\x83\x97\x8e\x2e\x60\x93\x63\x54\x97\x74\xfd\x92\x1c\x4b\x92\x24\x5a\xaf\x59\x08\x38\xb2\x78\x7b\xa2\xe2\xd7\x26\x46\xec\x7b\x4e\x89\x17\xbf\xff\x9d\x77\x68\xc2\x85\xec\x82\xb3\x74\xbd\x87\xa7\xeb\x6a\x5a\x12\x68\x8a\x8a\xde\xde\x7b\xc8\x82\x8c\xb7\x3a\x18\x4d\xdd\x68\xab\x45\x2a\x87\x04\x17\x15\x6d\x85\xec\x13\xc8\x29\x25\x82\xad\x60\xdd\xea\x15\x77\xe0\xca\x74\xb4\xcc\xda\xcd\xbb\x9e\x97\x05\x2f\x72\xf6\x5f\x3c\xcb\xe1\x30\x31\xf3\x7a\xe7\x00\xce\xf1\xec\x73\xce\x44\x42\x63\x72\xa7\xdf\x01\xfb\x82\x06\x01\xcb\x32\x72\xb3\x4d\x42\x96\xb5\xb8\x1c\x3a\xfb\xd7\xc1\x58\x96\xd3\xbc\x68\x68\x9e\x1a\x27\xa6\xa5\x1b\xf9\xa0\x3e\xc4\x68\xcc\xf4\x22\x63\x62\xc3\x42\x49\x44\x5e\x02\xd1\xca\x56\xb7\x29\xb6\xa0\xc1\x7d\x91\xce\xbc\x71\xc6\x5b\xc2\x3e\x77\x18\x6d\x35\xc6\xa5\x05\xa5\xa5\x18\x5e\xd1\xad\x91\x34\xa6\x49\xd2\x61\x49\x0d\x48\x47\x2a\xd8\x26\xe2\xcd\xe1\xea\x6e\xfd\x81\x6a\x75\xac\xdf\x33\x2c\xa8\x8c\x93\x7d\x78\xe8\x11\xaf\x6a\xf3\xde\x08\xa2\x4b\x2e\x02\xf6\x5d\xba\x12\x34\x6c\x91\x4a\xd5\xe0\x82\xf3\x98\xd1\xa4\xf1\x6d\x4c\xb3\x5c\xbf\xf8\x86\x46\x71\x21\x5a\xde\x37\x8a\x0c\x32\x64\xa6\xb0\xe1\x8c\x61\x2d\xbd\xa3\x19\x9b\x8d\x79\x43\x30\x9a\x8d\xec\x7f\x4e\xc5\x8a\xe5\xef\x99\xc8\xc6\x8e\x5c\xa1\xfa\x3e\xcf\x73\xd0\x59\x2d\x52\xd1\xa7\x9d\x37\xa3\x1b\x6c\x9d\xfb\x9d\x3f\xaa\x25\x39\x23\xdf\x7f\x0f\xf1\x11\xff\x32\x5e\x13\x7f\x9e\x46\x37\xf0\x67\xe1\x9f\xad\x17\x2c\x0c\x59\x78\x25\xf8\x26\x0a\x99\x20\xff\xaf\x7c\xb7\x59\xce\x05\x5d\xb1\x19\x59\xd2\x38\x53\x41\xf3\xac\x58\xd8\x64\x84\x99\x57\xd3\x16\xe4\x7b\x78\x6b\x5a\xf3\x4b\x43\x2f\xc5\x6b\x1e\x17\x6b\x73\x20\x9d\xee\xaa\xb4\x4c\xeb\x07\x35\xb3\x9a\xe8\xa7\x8c\x27\x57\x34\xbf\x9b\x11\x5f\xd1\xf7\xab\xdf\x4a\x65\x49\xae\x2a\x7f\xd9\x19\xa0\xbe\x86\xf4\x30\x77\x36\x55\xff\x5e\x35\xf6\xbe\xf6\xb7\xa1\xe6\xfe\x7c\xc7\x60\x3b\x2a\x9b\x04\xaf\x07\xa3\xe1\xb6\xb3\xcd\x00\x92\x34\xe1\xe5\xec\xc3\xab\xe3\xff\xf4\xa1\x81\x3f\xfe\xf1\xd9\x35\xbc\xf3\xec\xe4\xa3\x7e\x4a\xbf\xad\x38\xba\xae\xd0\xdb\xe1\x47\x3d\xb2\xf9\x6a\xc1\x72\xaa\x92\x34\xe1\x7e\x89\x35\x35\x93\xc6\x53\x96\xcc\xaf\xce\xdf\xff\xf6\xa6\xf6\xe7\x0e\x3d\x6f\x36\x7e\xf5\xb0\x34\xb3\xe5\xaf\xf2\x6b\x96\x91\xf9\xd5\xb9\xd7\xaf\xa7\x69\x1a\xb5\xae\xa6\x5a\x73\x47\xc0\x91\x7a\xaa\xb6\xa7\xe8\xf9\x80\x4d\x45\x31\x60\xee\x8e\xb0\x16\xae\x34\x3e\x6a\x84\x09\x6c\x3d\x70\x51\xac\xf4\xcc\xf8\x44\xc9\x7a\x66\x6c\x8a\x80\x27\x1b\x26\x20\x8f\x21\xe0\xab\x24\xfa\x3f\x4b\x3b\x33\x99\x5c\x32\xbf\xa1\xa9\x4f\xa5\x40\xc3\xf6\x2b\x0d\x14\xe5\xae\x5f\xd3\x2d\x11\x0c\x5a\x21\x45\x52\xa1\x67\x62\xa9\xef\xb8\xbc\x75\x7c\xc9\x67\xe4\x2e\xcf\xd3\x6c\x76\x7a\xba\x8a\x72\xff\xfe\xdf\x33\x3f\xe2\xa7\x01\x5f\xaf\x0b\xf0\x56\x9e\xc2\xbd\x6a\x22\x5a\x14\x70\x5e\x3a\x0d\xd9\x86\xc5\xa7\x59\xb4\x9a\x52\x11\xdc\x45\x39\x0b\xf2\x42\xb0\x53\x9a\x46\x53\xc9\x7a\x02\x1d\xce\xfc\x75\xf8\x1b\xbb\x1e\x8f\x6a\xbc\xee\x48\x84\x3e\x98\x47\x49\xd8\x37\x03\xdf\x46\x49\xa8\xd3\x48\x2a\x30\xc9\x72\xa0\x8d\x6b\xf4\xfa\xec\xe6\xd6\xe6\x25\xc9\xc9\xa8\x11\x25\x7a\xdc\xcb\x17\xb3\x72\x0a\x60\xc0\xa2\x44\x5e\x52\x03\x93\x28\x13\x19\x81\x26\x4b\x42\x95\x87\x09\xbf\x04\x71\xb4\x9b\xfb\x92\x15\x8b\x35\xa4\x3c\xea\xbb\x4e\x60\xae\x7c\xf2\x9a\x26\x3a\xd9\x54\xe5\x5c\x86\x3e\xdc\x0e\xfa\x1a\xae\x54\x7f\x4d\x33\xf6\xe4\x13\x00\x23\x9d\x4d\xef\xa3\x24\x74\x9b\x82\x35\xcb\x69\x48\x73\x3a\x6b\x79\xb8\xa1\xc8\x55\x61\x81\x9e\xf9\x32\x0b\xf4\x26\x65\x41\x6d\xc9\xc0\xb2\x15\x07\x58\x61\x34\x0c\x5b\x9d\x88\xb5\xd6\x2f\xe5\xff\x34\x06\x9d\x0f\x6e\xeb\x25\xa3\x20\xa5\xda\x75\x0c\xe7\x6a\x30\xdd\x13\xba\x88\xdb\x5c\xb9\xdd\x8d\xc3\xe7\x13\x85\xd3\x4b\xdb\x37\x43\x6f\xc2\x47\x49\xcf\x65\x12\xf7\xb8\xa1\xfa\xac\x18\xf3\x2f\xe0\x31\xf8\xb7\xb9\xf8\x4e\x44\x43\x94\x76\x26\xba\xfa\xd1\xa3\x70\x18\x37\xd1\x9a\xae\xd8\x7c\xc5\x92\xfc\x20\x5e\x14\x99\x38\x3e\x4f\x2e\x93\x16\x4b\x6a\x2c\x25\xe3\x3d\x3a\x88\x92\x39\x08\x1e\x3e\x65\xf2\xca\xfa\x43\xa7\x2b\xa3\xeb\x34\x66\xe2\x8a\x0a\xba\x7e\x0c\x42\xb7\xf0\xe4\xfe\x74\x3a\x94\x83\xf9\xdc\x83\xef\x71\xc3\xf6\x5d\x2c\x8f\x20\x9b\x03\x0c\xf2\x34\xfb\xe9\x32\x97\x16\x8b\x38\x0a\xe6\x69\xb4\x2f\x8b\x61\x94\xc1\x00\x4e\x33\x2a\xa6\xc1\x1d\x0b\xee\xbb\x1e\x6c\xa8\xcf\x68\x29\x33\xe0\xc0\xde\x10\x05\x93\x8e\x8f\x44\xc6\xd6\x68\x01\x3f\xe6\x32\xa2\x10\x92\x22\x63\x82\x04\x1d\x7d\xd3\xda\x5a\x39\x00\x60\xdf\x9c\x5f\x9d\xfb\x35\x87\x1b\x53\x04\x12\xc6\xc2\xcc\x3e\xc8\xc9\x8a\xe5\x43\x51\x51\x1d\xee\x96\x34\x6e\xa8\xb8\x30\xe1\xce\x03\xa6\xe2\x51\xe6\x73\xd0\x6b\xd2\x3a\xda\x37\x2c\x27\xd7\xd5\xf7\x8c\xa1\x67\x3d\x29\x3a\xa4\xc9\x3e\xa7\x3c\xeb\x38\x87\xeb\x45\xad\xf7\x52\x72\x25\x45\x07\xcc\x5f\xff\xc9\x16\x77\xce\x43\xbe\xaf\x64\x3e\xf5\xe2\xe9\xf9\xb2\xcb\x8d\x53\x9f\x13\x13\x7d\x52\x4f\x7b\xe3\x7a\x68\xb0\x45\x33\xcf\x69\xf2\xcd\xe3\xf2\xf8\xa2\x9d\x2e\x81\xe0\xc9\x27\xbe\x68\x25\xc0\x92\xa2\x43\xf7\x4f\xc9\x1d\x2f\x44\x07\x5e\x67\x4a\x42\x1a\x75\x7e\xb7\x8e\xc2\xa4\x13\x88\x06\x28\x35\x76\xdf\xfd\x2e\x4f\xf2\xbb\xce\x6f\xb7\x8c\x76\xb3\x04\x6e\xed\x2d\xf9\xed\xda\x1b\x2d\xa1\x3d\x53\x1c\xf0\x75\xca\x13\x88\x16\xcd\xbc\xde\xd1\x7f\x6d\x1f\x84\xa3\x45\x91\xa9\xd0\x71\xc0\x93\x65\xb4\x2a\x84\x0e\xba\x80\xcd\x0f\x27\xa5\x92\xea\x0e\x51\xd2\x69\xc8\x0e\x0b\x0b\x58\xdc\x8b\x56\xcf\xd1\x28\x25\x7f\xcd\x60\x9c\x20\xe3\xe1\x8a\x87\x37\x2c\x28\x84\xfb\xb5\xa7\x6f\x19\xdd\x30\x9b\x8e\x02\x7d\x09\x83\x53\xe3\x21\x98\x86\x0b\xc2\x0b\x99\x70\x01\x4f\x08\xdb\x4e\x27\x6d\x79\xdb\x1d\xc9\x34\x0f\x60\xe9\x27\x21\x15\xe1\x44\xca\xb7\xb4\xf1\x4a\x43\x1c\x0e\x48\xa2\x48\x20\x08\x41\x65\x9d\x03\xc1\x7b\x31\x44\xb0\x65\x1c\xa0\x34\x88\xf5\x53\x7f\xbd\xf8\xee\xfa\xad\xe3\xf8\x9c\x2f\xcb\xe4\x9a\x09\xec\x5a\x00\x3b\xb0\x0e\x6f\x33\x81\x04\x52\xb0\x18\xed\xd3\xcf\x7a\x0c\xe1\x41\x1a\x43\xa1\xb7\xc5\xd6\xea\xec\xfd\xf5\xb4\x76\xaa\xb8\xf5\xe5\xa2\xb2\xa1\x5c\xf1\x2c\x5f\x09\x76\xf3\xdf\x6f\xad\x14\xea\x8d\x98\x85\x87\xb0\x93\xf2\xf0\x72\xc3\x84\x88\x42\x96\x39\xb2\x65\x9f\xd7\xc1\xf4\xd0\x6c\x82\xed\x12\xd9\x49\x74\x78\xbd\xc0\x47\xca\x5d\x5f\x3a\xca\x21\xb9\xac\x0e\x03\xd4\x32\x00\xf3\x92\x25\x7d\x91\x65\x65\x00\x7a\xc9\xf4\x28\xc2\xea\x87\x25\x9b\x99\x3b\x3b\x67\xc9\x26\x12\x3c\x91\xf9\xa8\x1b\x2a\x22\xd8\xae\x1b\x8c\xe9\xe4\x4f\x26\xfa\xc3\x5f\x44\xa7\x97\x1b\x07\x0d\x87\x32\x73\x5a\x00\x33\x10\x46\x10\x5e\xef\xe0\xec\xc2\x26\xf7\xef\x69\x3d\x6b\x20\x21\xac\xa5\x4b\x03\x44\x41\xff\x4a\x02\x90\x63\x47\xc9\x6b\xd3\x63\xdf\x1b\x7a\xcb\x55\x58\x86\xd6\x6e\x47\x07\xab\xab\xb8\xad\x5f\x3e\x79\xe7\x7a\xb5\xd7\x02\xae\x39\x7e\xfd\xb7\xf3\xaf\xcf\x2e\x6e\xcf\xdf\x9c\x9f\x5d\x0f\x75\x6e\x94\x8c\x3b\x05\x74\x5b\xbb\x78\xf4\x5e\xf7\x85\xc8\x8c\x20\x96\x40\x36\xed\xf3\xe3\xf7\xf3\xeb\xbf\x5d\xcc\xdf\x9d\x9d\x38\xe3\xe5\xd8\xe7\x94\x26\x20\xb7\x32\x77\xab\x1e\xe2\x32\x59\x8e\x95\x31\x74\xa2\x69\xc6\x39\x33\xc9\x97\x76\x31\x68\x54\xda\x56\x86\x36\xa2\x80\xed\x4f\x5a\xc6\xa2\xa9\xfd\xdd\xec\x96\x0b\xb9\x07\xf3\x78\x03\x7b\x91\x0b\x96\x15\x3e\x76\x08\x0d\xbf\x51\x92\x16\xb9\x9e\x40\x99\x2d\x00\xb9\x43\x45\x12\xdc\xc1\x95\x0b\x4e\xe9\x8d\x2a\x7d\xbb\x3a\x21\xd9\x36\xc9\xe9\x67\xeb\x5e\xcb\x02\x9a\x9a\x0b\x22\x28\x09\x79\xe1\xb2\xde\xe0\xf3\xfc\xf9\x84\x44\x6c\x46\x9e\x57\x88\xfb\xe4\x4c\xd3\xab\x48\x83\x43\x69\x55\xbd\xc6\xc0\xcc\x94\x4c\x69\x59\x98\x40\x10\x9e\x8a\x30\x86\x43\x27\x5f\x92\x07\x1d\x16\x81\xa1\x31\x03\xee\x44\x99\x59\x70\x4f\xc2\xf3\x32\x6f\x08\x76\xaf\x67\xcf\xfc\x23\xaf\xf3\xc5\x03\x96\xd2\x1b\xc1\xd7\xb3\xc1\x47\x1b\xcb\xe9\x46\x39\xc4\x4d\x84\xa4\x22\x95\xb6\xc3\x8e\xc0\x39\x9d\x48\x55\xf1\x6e\x83\xdd\x1c\x69\xb8\x9c\x49\x79\x71\x46\x4f\x8d\xd1\x95\xf0\x51\xd6\xf9\x3b\x9a\x7e\xcb\xb6\xd7\x6c\xe9\xf6\x52\x73\x30\x24\x12\x55\xe7\x92\x81\x1e\x95\xba\x5d\x91\x75\x93\xfd\xf1\x7c\xef\x01\xfc\xac\xf1\x7c\xab\xf3\x02\xe1\xfe\x7c\xc9\xbe\x2b\xa3\xa3\x85\x6c\xec\xce\xd4\xca\xee\x51\x75\x8b\xd2\x6b\x36\x57\x21\x8f\x11\x24\x49\x23\x3c\x72\x5f\x2c\x98\x48\x58\xce\x64\x88\x2a\xe4\x41\x06\xd1\xa9\x80\xa5\x79\x76\x0a\xc5\x40\x37\x11\x7b\x38\x7d\xe0\xe2\x3e\x4a\x56\x53\x48\x34\x9f\x2a\xbb\x28\x3b\x85\xce\x64\xa7\xbf\x91\xff\x8d\x62\xe0\xf6\xf2\xeb\xcb\x19\x99\x43\x20\x41\xaa\x88\x22\x63\xcb\x22\xd6\xf7\x8e\xfb\x95\xf8\xe1\xc4\x1b\x41\x95\x40\x58\x66\x42\x8a\x28\x7c\x75\xe4\x39\xbf\xb4\xdf\x3c\x72\x1d\x0d\xd9\x7b\x2e\x21\x88\x13\x2d\xb7\x35\x2d\x69\xd7\xcb\x08\xa2\x12\x19\x01\x61\xb2\x7b\x56\xe2\x17\xf5\x16\x3c\x82\x8c\xcb\x31\x6f\xdf\x0c\xfb\x7d\xb0\x7b\x8e\x06\x78\xf9\x91\xb2\xb3\xaf\xee\x3a\x2a\x95\x97\xa4\x63\xd6\x58\xca\xc3\x19\xc9\x8a\x34\xe5\x62\x20\x6f\x95\x90\xdd\x98\x9f\x0f\x0b\x63\x52\xff\x55\x42\x48\x26\xe4\xef\xf6\x8f\x12\xbf\x91\x7d\x38\x3a\x7a\xf1\xed\xd9\xff\xbc\x3c\x3a\xfa\xf8\x77\x77\x99\x2f\xa9\x54\x0e\x5f\x35\x52\xf2\x8c\xed\x03\xbe\x04\x54\x87\x3b\x65\xf9\x9a\xb6\xb6\xe6\x41\xc0\x8b\x24\x97\x04\x74\xfe\x87\x0f\x6e\xd4\xf3\x2b\xfb\x6b\xca\xc3\xf3\xab\x11\xd4\x2b\x6f\x65\xfe\xd1\x13\x6e\x0e\xa5\x26\xd9\x7b\xa1\xea\xf7\x8d\x48\xe8\xa4\x04\xf8\xf1\x0d\x88\x0a\x24\x76\x8c\x20\x2d\xaf\x6d\x78\x10\x51\x9e\x43\x6e\x5a\xa2\x2f\xc6\xe1\xcb\x89\x49\x71\x95\xfe\xfa\x67\x9b\xaf\x9e\xf9\xa3\x57\xef\x48\x15\xb6\x34\xec\xef\x3d\x34\xd0\x77\x33\x2e\x92\x5a\xb9\x91\x8e\x20\x49\x76\xc1\x56\xf3\xab\x73\x93\xb7\xf3\xc4\xc3\x30\x5e\x87\xd9\x61\x7b\x2a\x4d\x66\x12\x2d\xde\x3c\x92\x46\xb3\x89\x1b\x7c\x59\x3f\x56\xcd\xbc\x51\xb7\x9d\x1a\x3a\x19\x89\xa3\x75\xa4\x73\xfb\x6d\x72\xc6\xb1\xfa\xa3\x1f\xa4\x85\xbb\x22\xd0\xef\xac\xd9\x9a\x8b\xed\xc4\xfc\xca\xd2\x3b\xb6\x66\x82\xc6\x53\x9d\x94\x36\xb1\xcd\x8c\x23\x6f\xdf\x52\x0d\xd4\x18\xde\x6d\xe5\xc4\x1b\x53\x8e\x21\x28\x04\x58\x5f\xf1\xd6\xec\x0f\x2c\x7c\x52\x4d\x66\x67\xed\xe2\x20\x0b\xd2\xba\x5b\x74\xa6\x9b\x91\x7f\xf0\x23\x8f\x20\x4a\xc8\x06\x12\xfd\xe0\x8a\x19\x63\x09\x01\x05\x38\x98\xc3\xd1\xa7\x91\x1b\xf3\xe8\xab\x16\x3c\xf4\x9b\x28\xeb\x47\xbd\x34\xff\xd1\x64\x7b\xe9\xbc\x98\x34\x32\xd0\x29\xb9\xbe\xfb\xcd\x91\x9d\x6a\x4c\xd6\x8d\xd6\x87\x3a\xd7\xba\xc8\xc1\xbd\xa0\xd2\x69\x47\x90\x24\x8d\x50\xa7\xd5\x07\x59\x6d\xcf\x19\x45\xf1\xd9\x57\xcf\xbc\x51\x37\xb1\x83\x5b\x7f\x46\xfe\xf7\xf8\xaf\x5f\xfc\x30\x3d\x79\x75\x7c\xfc\xe1\xcb\xe9\x7f\x7c\xfc\xe2\xf8\xaf\xbe\xfc\xe1\xdf\x4e\x5e\x9d\xfc\x60\x7e\xf9\xe2\xe4\xe4\xf8\xf8\xc3\xb7\xef\xbe\xb9\xbd\x3a\xfb\x18\x9d\xfc\xf0\x21\x29\xd6\xf7\xea\xb7\x1f\x8e\x3f\xb0\xb3\x8f\x8e\x44\x4e\x4e\x5e\x3d\x1f\xc1\xe4\xe7\x69\x79\x0e\x9a\x46\x49\x3e\xe5\x62\xaa\xa6\x6f\x46\x72\x51\xb8\x1f\xb0\xcc\xf0\xce\xf6\x9d\xf6\x23\x53\x97\x68\x66\x69\x95\xfb\xea\xd1\x4f\x6d\x3b\x34\x2c\x3e\xd5\x6e\x98\xb1\x40\xb0\xfc\x71\xfd\x12\x8a\xa6\x36\x3b\x1c\x69\x92\x26\x86\xff\x09\x75\xfd\x63\xb8\x34\xf4\xa2\xd7\x5d\xdd\xcf\x2e\x83\x64\x4d\x9f\x48\xb7\x37\x9c\x2a\xc1\x6f\x0a\x40\x3f\x4d\xf3\x9e\x39\xf9\xa2\x0e\x90\x46\x74\x97\xa0\xbb\xa4\xc7\x5d\x72\x23\xe5\x10\x7d\x25\x23\x7d\x25\x23\x1e\x77\xe5\x7a\x3a\x1c\x70\x1c\xd1\x6e\x1f\xba\xae\xfc\xc7\x92\xcd\xb0\xd3\xbe\x2e\x43\xfa\xf8\xc2\x97\xad\xae\xfa\x32\x14\xdb\x4b\x92\xd4\x0f\x51\x8f\x1f\x65\x85\x5e\xe9\xc8\x42\x25\xd8\x0a\x8d\xda\x73\xdc\x00\x45\x52\x5e\xb7\x67\x5d\x8a\x99\xf7\x78\xfb\x94\x0d\x17\x38\xee\xc9\xb5\x1e\xde\x56\x1d\x9d\xa5\x61\x23\x37\x1b\xef\xf1\xf7\xd3\x31\x3b\x88\xeb\xee\xa1\x76\x04\x47\xa2\xe4\xb0\x9d\xe3\x9f\xec\x7d\x6d\x6f\x1b\xc9\x91\xff\x7b\x7e\x8a\x86\x13\x80\x16\x40\x31\x36\xfe\xfb\x4f\x00\x65\xb1\x80\x56\x56\x2e\xce\xda\xb2\xce\xd2\x7a\x91\x1c\x0e\x97\x26\xa7\x49\x75\x3c\x9c\x9e\x9d\x07\x4a\xdc\x4f\x7f\xa8\xea\xea\xee\x19\x8a\xe2\x54\xd3\xc3\xb5\x36\x37\xa2\x5f\x58\x14\xa7\xd8\x8f\xd5\xd5\x55\xbf\xfa\xd5\xe1\x27\xc7\x11\x4e\x8d\xf8\x13\x23\xfa\xb4\x88\x3d\x29\x3a\x4f\x89\xb0\xd6\xa2\x2a\x73\x45\x9f\x0c\xb1\xa7\x42\x84\x1a\x86\xdd\xa9\x16\xfa\xe1\x6c\x14\x39\x1e\xe7\x59\xb8\x9e\xeb\x44\x65\x15\x5c\x27\xb1\x5c\x74\x5e\xa8\x5c\x65\x0c\x65\x47\xca\x16\xc8\xc0\xe1\xd4\xd4\x59\x7b\x50\xa7\x0d\xeb\xf0\xa8\x80\x07\x6b\x74\x1e\xaa\x70\x6e\xb6\xcc\xe0\x41\xdb\x0c\xda\xe6\x28\xda\x86\x16\xda\x6f\x56\xd5\xf4\x6c\xa4\xa5\x0c\x96\xcb\x5f\x0f\x0f\x48\x14\x96\x47\x81\x02\xa6\x7a\xad\x32\x55\x96\xd7\x85\x99\xa9\x33\x7e\x9b\x6e\xf5\x4a\x67\x4b\x8f\xde\x73\x62\xc0\xce\x99\x79\x85\xc0\xb3\x36\xf9\xca\x6a\x61\x93\xf5\x6f\xef\x0a\x55\xde\x99\x94\x71\x2b\x89\xab\x03\xc3\x23\x25\x71\xb7\x6b\x5d\x69\x99\xbe\x51\xa9\xdc\x30\x68\x70\x8e\xdd\x1c\x4b\x9c\xf3\x0c\x1a\x52\xd6\x88\x1a\x7e\x16\x73\x04\xb4\x0d\xa6\xae\xbe\xfa\xa8\x30\xf7\x62\xa1\x64\xa2\x7b\xd8\x8c\x5e\xce\xb0\x1b\x87\xdd\x38\xec\xc6\x83\x77\x63\x9e\xea\xb9\x2c\x23\xf6\xe1\x55\xbd\x9a\xa9\x02\xf6\x9b\x7b\xd8\xed\xbd\x44\xe5\xa9\xd9\x74\x02\x7f\xf9\x5d\xe6\x76\xd7\xc6\x3b\xdf\x03\x12\x26\xa6\x27\x9f\xf0\x31\xb1\xc2\xe7\x9e\xc0\xfa\xf7\xec\x42\xfa\x14\x5a\x4a\x7f\x98\x01\xe9\xa0\x6d\x03\x40\x93\x11\x9b\x69\x3f\xd5\x21\xd7\xd6\x1f\x44\x9c\xbe\x6f\x6d\xd7\xcd\x8e\xaf\xf7\x84\x6d\x12\x17\x82\xd2\xea\x24\x3c\xe4\x1a\xd7\x1a\x4c\x21\x2b\xcb\x0a\x37\x62\x57\x00\xc0\x91\x68\xf0\x8f\x61\xab\x54\xe2\x22\x1f\x80\xbc\x25\xf1\x2c\x99\xe3\xb3\x71\xef\xb7\x5f\x6c\x12\x98\xc7\x12\x38\xe9\x4c\x16\x3d\x5e\xdb\x02\x44\xa2\x00\x7a\x04\xa7\x9b\xb8\x33\xf7\xb4\x40\x19\x52\x2d\xfa\x21\x27\x49\x8a\x4a\x93\xb9\xd4\x5a\x4a\xf5\x73\x53\xc1\xac\xc7\x01\x4f\xdb\x5b\xe3\xbd\xdc\x08\x59\x98\x3a\x4b\x88\x00\xd5\x33\xfd\xbd\xdf\xea\xc0\x95\xc9\xd4\x88\x89\xb7\x82\x24\x44\x2a\x52\xee\xe9\x08\x81\x2b\x07\x22\x80\xaf\xa7\xaf\x5f\xf5\x3e\x5d\x5c\x37\x40\x6b\x8a\xb0\x7d\x70\x71\x24\x1e\x53\x18\x15\xe7\x15\x70\xfb\xb5\xf7\x96\x82\x89\xb3\x9f\x86\xe1\x89\xd6\xe2\x7c\x20\x9a\x40\x26\xa7\x88\x0f\xd2\x0b\x4a\x6d\x07\x99\xa7\x80\x71\xe3\x4d\x50\xc8\x5f\x7f\x89\xdc\x53\x10\xa4\xa9\x33\x0f\x06\x3b\x69\x65\x00\xb0\x24\xa2\x98\xe9\xa8\xef\x8b\x74\x59\xcf\x7a\xd1\x55\xa4\x70\xc0\x05\x45\x04\x96\xdc\x30\xb4\xdf\x5a\xe3\x72\x8f\xda\x72\xc3\xc5\x12\x09\x68\xc3\x17\xe2\xa5\x95\x06\x14\xb0\xc6\x54\x27\xbd\x2f\x33\x1a\x3a\x28\x21\x16\x3d\x7c\x97\x2e\xd7\x28\x7f\x7a\x1c\x19\x32\x45\x18\x6b\xde\x38\xb2\x64\x7e\xaf\xee\xe4\x5a\x95\xa2\xd4\x2b\x9d\x42\x4e\x36\x68\xc0\x1b\xdb\x5b\x31\xab\xab\x9d\x91\x26\x96\xe4\xa7\x33\xb4\x76\x64\x5f\x45\xaf\x9e\x46\xb3\x5a\xfb\xab\xb5\x18\x78\xed\xc4\x05\xe3\xfa\x0c\x33\x0c\x7a\xdf\x8f\x01\x9c\x16\xab\xda\x96\xbc\x14\xea\x61\x9e\xd6\xa5\x5e\xf7\xad\xc7\xf8\x71\x4a\x6f\x71\x3c\xc3\x88\x26\x41\xea\xce\x46\xec\xad\x61\x0f\x85\x58\x8f\x56\xbc\x49\x79\xee\x76\xc9\xa3\x2f\x9a\xd8\xf3\xdf\x64\xac\xb0\x64\x08\x62\x96\x01\x36\xe9\x38\x71\x8d\x4b\x57\x9e\x0a\x38\x91\xe0\x2b\x3c\xe3\xdf\xd3\x2f\x27\x0e\xd6\x19\xf0\x37\xbb\x8b\x82\xc5\x69\x63\x36\x1f\x94\x4d\x48\xbd\xba\xb0\x7f\xe8\x94\x3b\x53\xb0\xb5\x20\x4a\x62\xad\x1c\x00\x37\x22\xaf\x82\xa7\x2c\x08\x07\x57\x9f\x26\xb1\x8f\xad\x76\x7f\x74\x6b\x92\x6c\xa0\x0b\x2b\xe1\xe4\x26\xaf\xa1\x54\x29\xa5\x66\xae\x79\xb6\x7e\x5c\x43\xe1\x45\xf8\xc1\xf7\x66\x17\x71\x28\xa3\xc9\x63\x47\x2a\x76\x26\x56\x50\x74\x62\xa6\xab\x40\x19\x51\x46\x60\x3b\x72\xb0\x65\x6d\x59\x1f\xe0\x26\x9b\x17\x34\x6b\x1a\xd2\x49\x67\x9e\x3f\x3a\x22\x85\x97\x1c\xf3\x40\x2a\x38\xaf\x1c\x07\xa0\x98\xa9\xea\x5e\xa9\x4c\xbc\x7a\xf5\xea\x15\xaa\xb9\x57\x7f\xfa\xd3\x9f\x44\x04\x74\x56\x8a\x44\xcd\xf5\xea\xb1\x40\x94\xf6\xff\x5f\xbf\x9e\x8a\xbf\x9f\xbf\x7f\x87\x94\x01\x39\xf3\x08\x87\x7f\x33\x03\x50\x7c\x6c\x29\x08\x6a\x7d\x49\x39\x11\x7f\xbb\xf9\x70\xe5\xb4\x24\x5f\x68\x5b\x0a\xee\x01\x3f\x4d\xad\x53\x83\x2d\xf1\xd5\x1f\xbf\xf9\x66\x2a\xde\xe8\x02\x79\xd6\x00\xda\xda\x38\xcf\x73\x77\x5e\x70\x4a\xde\xba\x1f\x89\xe4\xe4\x8d\xca\xd0\xa5\xaa\x60\xe1\x93\xbd\xbf\x02\xfe\x15\x38\xd5\x99\x97\x37\xb7\x05\x53\x60\xee\x86\xb6\xd1\xc5\xc4\x06\x8a\x88\x63\x43\x52\xb1\x07\xe6\xb9\x0b\xff\x16\x3a\x05\xcb\x22\x51\x80\x72\xff\xac\xc4\xa2\xfc\x8f\xc2\xd4\x79\xa8\x01\x55\xa8\xb2\x4e\xab\x0e\xea\xa7\xf6\x6b\xe6\x6e\x4d\x61\xef\x94\xaa\x62\xa3\xd0\xe3\xdc\x42\x71\xde\x92\xc8\xc3\x66\xa7\x72\x78\xbb\x68\x5e\x03\x26\x3e\x06\x7d\x8a\xcb\x91\x29\x11\xd0\xc7\xda\x97\xf3\x79\x23\xab\xad\x0c\x2f\x6f\x65\x25\x21\xa6\xcd\x16\xed\x72\xbc\xf3\xc2\x80\x09\x00\x19\xbb\xae\xee\xb9\x3b\x2f\xc1\xf1\x02\x73\xcf\x97\x89\xb5\xc6\xe0\x02\x29\x74\xab\xe2\x2a\x9a\x6f\x70\xec\xd0\xdb\x38\x0a\xd3\x51\x44\x11\xc5\xc6\x58\xba\x02\xe0\x2a\x01\x54\x6c\xf9\xb8\x27\x6c\xb9\xbe\xc7\x5e\x3a\xee\xe3\xd2\xae\xec\x3a\x6b\x7c\x0b\x5b\x66\xb3\xa6\x3c\x01\xa0\x28\x71\x9f\x8a\x78\xf8\xef\xe2\x8b\xc4\x7b\x16\xe5\x51\x93\xcc\x47\x60\x06\x7e\xee\x7f\x30\xd7\x80\xd5\xad\xce\xed\x00\xaa\xa2\xc0\xbb\x2b\x66\xc0\xdb\x4a\x05\x2b\x59\x7c\x8e\x68\xa6\x8b\x47\x4f\xf1\xd2\x48\xee\x80\x99\x0a\x95\xc7\x1d\x07\x6d\x8c\x8a\x24\xbb\x1f\x3a\x27\xc6\xd3\xe9\x18\x27\x08\x6e\xd9\x65\x05\x06\x13\x6a\x39\x78\x9f\xbb\x98\xa2\xb6\xf4\xd6\xa6\x06\x24\x5a\xe0\x9b\xa5\xdc\x6b\xd9\xbc\xda\xb1\xe5\x02\xca\x6d\xcd\xf6\x8a\x1c\x66\xe0\x1c\x84\xc7\xde\xea\xf2\x2d\xed\x61\x44\xdd\xe0\xf6\xe2\xb7\x37\xf2\x1e\xd4\x7c\xad\x22\x8c\xb2\x18\xd3\x2c\x4a\xa4\x20\x43\x6e\xdb\x40\xa3\xd2\x20\x51\xa4\x2a\xd1\x76\x59\xa4\x54\xa8\x03\xd8\x69\x9d\x45\xca\x6c\xda\x72\x4f\xd8\x68\x91\x12\x77\x5a\x74\xed\x66\xf3\x0d\x3c\x6f\x08\x84\x39\x46\x45\x8b\x5e\x57\xa7\x63\xe3\xb4\x62\x4b\x37\x36\xee\x07\x81\x10\xc5\x3b\x63\x23\x65\x36\x4c\xb9\x7d\x26\x5a\xa4\xd4\x2d\x83\xee\x49\x43\x2d\x52\xec\x63\xb3\x6e\x87\xb9\x16\x29\x33\xc6\xb8\x3b\xdc\xc4\x3b\xd4\xd0\x73\xd6\x16\xcf\x41\xfa\x84\xd6\xb9\xbd\x6b\x9c\x75\x79\x48\x2f\x8e\x92\x48\xd6\x36\x96\xe5\xca\xbd\x09\x05\xec\xe1\xef\xe5\xc6\xd9\x15\x32\x8b\x9d\xd1\x59\x69\xd2\xba\x02\x2f\x43\x75\x17\x44\x35\x0f\xd7\x9c\x9f\x15\xec\x5e\xae\x3e\x2d\x9e\xbf\x5e\x68\xe3\x60\x8e\xef\x3c\x9d\xad\x31\x27\xfa\x17\x1c\x34\x5c\xd7\xdb\x61\xa9\x0d\xf0\xe9\xa8\x51\x65\xbb\xe4\xc2\x8b\xe7\x9c\x8b\x0f\xee\x0c\x18\xcf\x7f\x53\x8c\xa7\xbf\x2f\xc4\x38\x7e\x28\xed\x28\x98\xf4\xcf\x08\x5d\x8e\xbc\x52\x6f\xf4\x01\xa1\x19\x78\x50\x24\xe4\xce\xd9\x88\xf2\x4e\x16\xce\xe9\x98\xea\x85\xda\x59\xee\x67\xd7\x8b\x36\x45\x6e\x92\x51\xff\x46\x3c\x94\x5f\x7b\x8a\xdd\xb7\xa3\x83\xe3\x9f\xc0\x3c\x80\xc1\x84\x7d\x4b\x44\x01\x24\x90\x42\x46\x4c\xb1\x02\x4b\x3f\x61\xf1\x8c\x30\x60\xe0\xa4\xf2\x56\x12\x5c\x17\x5f\xbc\x88\x00\x2f\xc0\x3f\x5f\x81\xb2\x26\xfe\x6f\xe0\x7a\x19\x8f\x4b\x2f\xd4\xb6\x35\xd2\xcc\xc6\xf3\x91\x6a\x59\xd3\x71\xf2\x92\x04\x9e\xc0\xc5\xf1\x3d\xf2\x29\x44\xa6\x52\xf2\xd3\x28\x69\x9c\xff\x40\x01\x91\xdf\x61\x43\x12\x5d\x1c\x4d\x45\x94\xfa\x17\xf5\x0e\xc8\x27\xb8\x8b\x24\x8a\x4b\xe0\x30\x1e\x81\x83\x38\x04\xda\x8b\xf7\xd6\xe0\x5d\x03\x63\x5e\xb0\x7e\xa1\x72\x76\xea\x56\x71\xf4\x09\x4f\x3c\x77\x50\xbc\x8f\xf4\x85\xbb\x7e\xe3\x2a\x86\x31\x64\xcb\x44\xa6\x0f\x58\xf0\x32\x2d\x8d\xa5\xa7\x9d\x03\x3f\x20\x7e\x07\xb1\x75\xd0\xca\x65\xcb\x84\x46\xac\xe4\x83\x5e\xd5\x2b\x51\x97\xb0\x4f\x4d\xd6\x96\xe5\x1b\xce\x96\x79\xef\x82\xc2\xb0\xb5\x56\x3a\x43\xe1\xed\xbb\x21\xfc\xe5\xc6\xad\x1f\xb6\xe0\xe0\x2d\xc3\x3a\xe1\xee\xba\x50\xd6\x2b\x98\x28\x6a\x35\x8e\x12\xff\x78\x06\x5c\x57\x9a\x3a\x8b\x14\x8a\xef\x20\x72\x2b\x37\xc9\xb6\x9e\x61\x8b\xcc\x74\xda\xae\xb1\xe9\x2a\xf5\xfa\x09\xac\x33\x3a\xc8\xf8\xb6\x66\x30\x78\x50\x1b\xec\x56\x06\x40\x4a\x7d\xba\xac\x75\x72\xb8\x1a\x78\xf6\xbc\x13\x3d\x70\x4e\x44\x9d\xf3\x07\xc1\x92\x9a\x46\xab\x9d\x89\x89\x33\x5e\xe7\x3e\x78\x3b\x8a\x70\x0a\xb0\xa1\x66\x51\xfa\x2f\x07\xba\xa8\xb2\x52\x59\x65\x83\xe2\x17\xa9\xd4\xab\xe8\xce\x5e\x7b\x29\xae\xb5\x73\x90\xe3\x30\x21\x42\xf2\x76\x63\x18\xab\x23\x98\x33\xd8\xa2\x18\x16\xa0\x56\x0f\xc7\x17\xee\x71\x17\x48\x70\xe5\x30\xa4\xb8\xde\x35\x86\xa3\x58\x16\x2f\xc7\x3f\x8d\x54\x77\xc2\x15\xc0\x34\x01\xad\x12\xa1\x7f\xdc\x01\xb3\xa5\x32\xb8\x06\x44\x58\x14\xa7\x4e\x89\x84\xb7\xec\x3b\x38\x9a\x6c\xbe\xa2\xe8\x23\x39\x06\x5f\xf7\x68\xae\x7e\x02\x97\x19\x56\xe7\x44\xeb\xf8\x23\xc9\x72\x21\x4e\xa6\x48\x00\xf5\x13\x7c\x10\x41\x7b\x21\x74\xcb\x07\xca\x1d\x72\x05\x89\xf1\x0c\x9c\x86\x55\xdd\xb7\xd2\xb3\x89\xa3\x67\xa3\xc8\xb1\xa7\x44\xbe\x01\x49\x31\x20\x29\x06\x24\xc5\x80\xa4\x18\x90\x14\x11\x48\x8a\x48\x62\x9e\x01\x46\xf1\x6f\x01\xa3\xb0\xb3\x3e\x89\x3b\x27\xdd\x19\xdd\x8d\xa1\xf0\x7e\x5c\xfe\x64\x75\x63\x28\xdc\x65\x99\x2d\x72\xc0\x50\x0c\x18\x8a\x01\x43\x31\x60\x28\x06\x0c\xc5\x80\xa1\x18\x30\x14\x03\x86\x62\xc0\x50\x1c\x84\xa1\x38\x16\xef\x92\xad\x85\xc2\x14\x2a\x7e\xcd\x80\xbc\x73\x44\x5d\xf5\x82\x1e\x21\x0a\x5e\xb7\x67\x4c\x32\x66\x66\xeb\xb9\x30\x80\x75\xce\xda\xb8\xf1\xc1\xee\x55\xe7\x53\xb5\xad\x19\x8f\x8e\xb2\x45\x22\x56\xe3\xf3\xa5\x2b\x65\x08\x73\x44\xda\x7b\xac\xea\xd6\x7a\xf8\x48\x9f\x07\x7b\x7c\xad\xc1\x2a\x74\x7a\x04\x72\x4d\x03\x61\x06\x15\x21\x1d\x7d\x99\x39\x9f\x76\x47\xc7\xf9\xf7\x82\x79\x5e\x77\x7d\x24\x6a\x99\xd8\x70\x69\x8f\x22\x99\x73\x4f\xb5\x24\x86\x61\x69\x0f\x8b\x55\x0b\xe7\x48\x98\xd3\xed\xd0\x7e\xba\xf2\xb6\xfb\x39\xc5\xc8\xc7\x4f\x40\x28\xf0\x21\xeb\x20\x5e\xb7\x9f\x85\x28\xc9\x7b\x99\x6d\xb8\x62\x3b\x3f\xcb\x1c\x22\xdb\xf3\x0b\x99\xcb\xf9\xde\x4a\xd1\xd1\x22\xdf\x7d\x6d\x1e\xbd\xa8\xa9\xef\x3e\xe3\xa2\x3a\x7f\x63\x8f\x9b\x8b\x54\x96\x65\x0f\x62\x19\x5d\xa1\xea\xf1\x3a\x5b\x32\x95\x31\xd5\xb6\x27\x76\x9d\xa7\x6b\x2c\x43\x35\xb0\x62\xb9\xd7\xc8\x80\x34\x68\x38\x8b\x0b\x19\x84\x8e\xbe\x4c\xb9\xc8\xc5\x42\x67\x9d\x0b\xb2\xd5\xa1\x73\x7a\xa4\xd9\x9d\x89\x90\x01\x52\x82\xce\xc3\xa9\x78\xdb\x69\x6d\x51\xc1\x2c\x5d\x8a\x54\x2d\xaa\x66\xd9\xf3\x8b\x8f\x6f\x26\x60\xc4\x15\x6a\x2b\xcf\x5a\x76\x02\xe0\x42\x12\xb6\xae\x08\xa4\xb3\x92\x9f\x95\x13\x2b\x2a\x63\x44\x2a\x8b\x25\xde\x60\xe0\xa6\x02\x08\xa3\x2e\x8c\x0a\x73\x8d\x6f\xe1\x36\xd0\x0b\x5a\xac\xd5\x69\x9d\x7d\xce\xcc\x7d\x76\x8a\x5e\xf1\xb2\x13\xc1\x01\x40\x3d\x5b\xa4\xc9\x14\x5f\x71\x63\xef\x22\xc8\xa4\xf9\x81\x16\xfa\xe8\xbd\xcd\xb0\xa7\x8d\xd1\x69\x25\x9b\xac\x8f\x91\xce\x0b\x6d\xa0\xe4\x3e\xee\xfc\x6e\xa5\xd2\xea\xca\x35\x3d\x0b\x81\xe5\xd2\x77\x89\xc9\xf6\xd9\x39\x6e\x95\x49\x55\xc1\x29\x76\xce\xf2\xf3\xb6\x1a\x7e\x4b\x68\x09\xc0\x49\x88\x5b\xff\x3d\xe0\x08\x97\x55\x25\x61\x02\x38\x71\x42\x6a\xa1\x82\x32\x5d\x1b\x01\xd7\x6b\x08\xb3\x49\x22\x17\xa2\x79\xad\x0a\x9d\xa7\x4a\x7c\xfb\x59\x6d\x26\xe8\xad\x9b\x28\x0c\xc8\x7d\xd7\x29\xde\x33\x8f\x58\x79\xf0\x0b\x2c\x4d\x59\x99\x42\x7c\xeb\xfe\xf7\x5d\xd7\xdd\x99\x6f\x1b\x09\x61\x5b\xd6\xfd\xb9\xad\xe1\xbc\xc4\xc7\x84\xce\x12\x3d\x97\x95\xeb\x37\x0e\x87\x22\x8f\x15\x2f\xe8\x8a\xfd\x9c\x12\xe4\xd9\xc2\x6e\xf1\x2d\xc4\xdc\x35\x05\x96\x96\xba\x8a\x25\xb4\xe1\x20\x94\x69\x6a\xee\x55\x42\xee\x47\xdc\x6d\x57\x86\x8e\x00\x35\x11\xd7\x18\x5f\x0b\xef\xb0\xc4\x83\x07\xed\xca\x5c\x3e\xa8\x79\x5d\xb1\x3c\xfc\x6c\xb5\x41\xb7\xe7\xe8\xe9\xf8\xc1\x26\xf0\x86\x49\x40\xb7\x92\x43\x15\x86\x6d\xc5\x90\x2b\x48\xab\x43\xd8\x7c\xff\xbc\xc0\x35\x9f\xd3\x7b\x0c\xef\x39\x5f\x97\x2e\x05\xe2\x8e\x27\x61\x61\x3b\x4f\xc1\x25\x16\xa8\xfe\x33\x4b\x22\x6e\xe3\xb9\x59\xcd\x74\x66\xf7\x31\x01\x29\x4d\xa3\x95\x6e\xca\x99\x74\x69\xf0\x08\xb7\x4b\x51\x13\xea\x3a\x1a\x3d\xab\x1f\xdc\x08\x35\x0a\x56\x48\xf0\xae\x00\xa1\x14\xba\x22\x4d\x56\xde\x69\x5e\x66\xbd\x0b\xfd\xc2\xa0\x4c\xc5\x27\x2c\x39\xe4\x5a\x66\x4f\x21\x3b\xfe\x30\x5e\xe2\xf2\xe7\x9a\x1b\x11\x6c\xa0\x2c\xe8\x31\x27\x08\xa6\xfa\xe7\x5a\xaf\x65\x0a\x16\x45\x65\x20\x57\x31\x99\xcb\x82\x37\x1d\xe0\xfa\xc7\x19\x9c\x88\xd2\x90\x2f\x1c\x2d\xa4\xb9\xcc\xbc\x1a\x0e\xab\xb1\xcb\x5e\xa2\xa9\x80\x30\x2d\xf0\xd9\xe8\x79\x9d\xca\x42\x80\xee\x5a\x02\xe6\xbe\xef\x39\x0f\x5b\x8e\xcd\x27\xba\x35\xf9\xb7\xdb\x12\x9a\xab\x00\x76\x93\xa5\x54\x66\x88\xc5\x6e\x43\xce\xc8\x96\x32\x10\x2f\x09\x83\x4c\xfb\x0f\x0a\xb8\xa0\xee\x66\xc9\xf4\x0a\x70\xd2\xa0\x9a\xab\x5a\x64\x80\x7a\x99\x99\x42\x25\x27\xee\x3b\x99\xe1\x1f\xaf\xc5\xa6\xe2\x7b\x8f\x8c\x9a\x50\xd4\xda\x33\x17\x36\xf0\xd3\x3c\xa9\x6e\xc9\x04\x25\xb9\x30\x05\x56\xdb\x7f\x99\x18\x74\x98\xab\xb5\x9e\x03\xe9\xd6\x3f\x54\xc1\x3b\xbc\x60\xab\x64\x0a\x98\x13\xd7\xb4\xb3\x02\x58\xa1\x22\x88\x97\x2c\xc5\x2b\xf1\x12\x45\xb3\x64\xea\x15\x20\xf4\x65\xa5\xd2\xcd\x89\xe3\x70\x2a\x37\x65\xa5\x58\x98\xfd\x46\x58\xe5\x8f\xdf\x8c\xfa\x0e\xa6\x60\x17\xa3\x57\xf2\x27\x57\x6c\x3f\x8c\x3c\x0a\x3a\xe4\x6c\xf2\x66\x96\x99\xba\x53\xc5\x29\x31\xf8\x06\xab\x79\x08\x2c\xc1\x46\xe1\x04\x92\x3a\x77\x34\xf9\x05\xfd\x2f\xd8\x1b\x50\xec\x75\x89\xfa\xc2\x6a\xfc\x9e\xb5\x45\xaf\x2e\x51\x50\x3d\xb9\x49\xcd\x72\x73\x93\x03\x4a\xf6\xc2\x64\x65\x55\xa0\x86\x3c\x1b\xb1\xa7\xec\xaf\xe6\x7e\xeb\x86\x82\xc2\x84\x9c\x17\xa6\xa4\x89\xa4\xaf\xd9\x2b\x54\x88\xc4\xac\xa4\xce\x4a\x00\xb7\xe7\xa9\x9c\x3b\xf3\x76\x1e\x9a\xe5\x2e\x11\x77\x7a\x79\x77\x2a\xd7\x52\xa7\x72\xa6\x53\x5d\xed\x77\x1d\x59\x14\x41\xdf\x97\x04\xea\x93\xeb\x6e\x68\x65\xf3\xa6\xd3\x1d\x70\xdd\x79\xa1\x77\x35\xae\x77\x5f\xd9\x39\x32\xa1\x01\x96\x12\x0e\x9f\x72\xfe\x87\x69\x5f\xeb\xab\xa7\x2b\x38\x6f\xa1\x32\x5a\x55\x17\x7b\x22\x59\x5b\xeb\x15\xb6\x69\x96\x08\xe0\xbe\x73\xe3\x73\x6d\xca\x6a\x59\xa8\x9b\xff\x7c\xe7\xdd\xf5\x08\xe9\x41\x0f\xea\xe8\x0b\x36\x2e\x24\xd1\x30\x1b\xf6\x63\xa9\x0a\x88\x8a\xa0\x07\xa6\xd1\x20\x10\x61\x8d\x9a\x26\xf8\xe0\x49\x99\xd6\x1c\x02\xb4\x46\xe9\x6f\x88\x9d\x21\x88\xce\x9e\x74\x4c\xc1\xb2\x90\x0b\x99\xc9\xb3\xd1\x61\x77\x4c\x46\xe4\x65\x08\x92\x0c\x41\x92\x3e\x83\x24\x9d\x1f\xea\xf8\xc0\x4a\x55\x07\x2f\x77\x59\x57\xa6\x9c\xcb\x28\xef\xb6\x04\xf4\x7f\xd3\x95\x0d\x0d\xb0\xa8\x47\x29\xee\x4c\xa1\x7f\x01\x08\x47\xda\x91\xc0\xed\xbe\x59\x15\xce\x2a\xa6\x88\x25\x20\x3d\x3d\xa3\xb1\xf5\xd8\xf2\x62\x33\xbc\x75\xa3\x32\xc8\x2d\xed\x08\x0b\xb7\x7a\x7c\x89\x4f\xa0\xf6\x6a\x0c\x97\xd3\xd7\xde\xfb\xbc\x57\x20\x17\x21\xb0\x92\x0f\x1f\xa9\xca\x41\x44\x0b\x7f\xcc\x73\x55\x50\x1a\x26\x35\x2b\x7b\x5c\x35\xc1\x65\x6d\x77\xdd\x67\x2a\x23\xfe\xdf\xc9\x88\x69\xaf\x77\xc0\xa0\x28\x4d\xf6\x4c\xbc\x1e\xf5\x61\xd0\xaf\x74\x76\xc0\xf0\xbc\x33\xf7\xfd\x0e\xcf\xeb\x67\x3a\x3c\x15\x44\x39\xaa\x8b\xeb\x1f\x7f\xac\x74\xaa\x7f\xc1\x0b\xca\xb5\x2a\xe6\x2a\xab\xe4\x32\xc6\x61\x7e\x8b\x82\x84\x5c\x2b\x08\xb6\x89\x8b\xeb\x1f\x45\x1d\x44\xba\x41\x74\x43\xf7\x9c\x07\xc3\xb2\x13\xf4\x3c\x1e\x94\x92\xfd\x78\x48\xf6\x4a\x0c\xd5\x4f\x9e\xe3\x80\x75\x9e\x42\x42\x24\xba\x04\x4d\xf8\x51\xc1\xc9\x07\x14\x97\xd7\x58\x1c\xab\x2e\xf6\x86\x13\x5b\x03\xf9\x4e\xc9\xb5\x7a\x32\x26\x8a\x07\x49\xb8\x61\x3c\x29\x12\x46\xd2\xb5\x01\x8e\x19\x80\x53\x61\x2b\x00\x81\x9f\x25\xb2\x48\x26\x30\x8c\x42\xaf\xe4\x52\x51\x76\xd1\x5c\x66\xfb\x13\xa0\x8a\x3a\x83\xeb\x8a\x14\x99\xc9\x4e\x0b\x63\x2a\x80\x43\x15\xa3\x2f\xd1\xe8\xff\x92\x6b\x69\x61\xdf\x25\x73\x7c\xfe\x76\xfe\xe9\xfc\x7f\x3e\x5c\xdf\xbe\xfd\x70\x75\x13\x47\x17\xcf\xb0\x49\x72\x93\x7c\x70\xbc\xda\xcc\xf6\xf8\xcf\xbb\xa8\x69\x93\xef\x7b\xd7\xf4\x7d\xe1\xe9\x8c\x93\xc4\x89\xa5\xfd\x7a\x71\xd0\xf3\xd0\xa4\x48\x6e\x75\xc6\x96\xa2\xe2\xd5\x67\xfc\xe6\x5c\xee\x58\x14\x5b\x0d\x23\x08\xad\x2a\xba\xee\xfc\x6d\xef\x86\x81\xfa\x2f\xa4\xda\x7d\xe2\x76\xcf\xbe\x8a\xcb\x6c\xfd\x49\xb6\x43\x03\x59\xdc\x3a\x77\x3f\x24\xc0\xba\x2c\x2e\x5c\x8f\xbb\xbc\x0a\xbc\x55\x48\x41\xf9\xce\x20\x73\x07\x43\xc2\xae\x7e\x45\xe4\x67\x1c\xbf\x8e\xee\x61\x9e\xd1\xf1\x27\xea\xcb\x9e\xb2\x14\x0c\x99\x62\x47\xe9\x0a\xa8\x6d\xbc\xd6\xa6\x2e\x1d\x00\xb7\xb9\x36\x58\x32\xdd\x38\x97\x2e\x13\xcd\x6f\x06\xf4\xb4\x40\xfc\x1b\xdc\x43\x7a\xae\x0e\x17\x4d\x89\x73\xee\x77\x3a\x5a\xe0\xa2\x02\xde\x83\x74\x1d\x93\xe2\xe1\x87\xd0\xb5\x57\x67\x79\x5d\xd1\x04\x06\x37\x4b\x36\xbf\x93\xd9\x92\x4b\xac\x02\xb0\x81\xe6\x84\x94\x9b\xac\x92\x0f\x2e\x69\x42\x95\x73\x99\xab\xc4\xdd\xd8\x12\x53\x73\xf6\x1b\xbc\x7e\xff\xfb\x89\xd0\xea\x4c\xfc\xbe\x21\x7c\x2a\x2e\x49\x5e\x63\x35\x40\xbb\x59\x12\x33\x0c\x65\xcc\x42\x19\x13\x70\xb9\x2e\x65\x91\x20\x6d\xb2\x59\xb4\x70\xdd\x6e\xc0\x59\x92\x15\x3a\xd5\x21\x09\x29\x33\x55\x2b\xad\x5a\xbc\x78\x31\x1d\x1f\x65\x2b\x41\xb5\xfe\xe8\xed\x44\xe5\xfd\xc1\x5e\x79\x4a\x69\x30\xf1\xdc\x14\x25\xbd\xf0\xcb\x11\xbc\x73\x42\x2f\x28\x70\x41\x71\x28\x8c\x16\x70\xd6\x51\x8c\xae\x74\x09\xde\x48\x85\xf7\x83\xda\x30\x6b\x73\x3f\x1e\x0c\x04\x41\x51\xc0\x18\xec\x0b\x19\x18\xb9\x79\x6b\x3f\xbe\xdd\x11\xf0\x85\x9d\x6d\xbe\xf5\x09\x31\x54\x50\x9c\xdb\xd0\xe8\x45\x16\xcb\xdd\xb3\xb3\xb9\x7b\x98\x27\x23\x44\xc6\x50\xb8\x1d\x81\x7d\xf2\x48\x0c\x94\x87\xb1\x50\x1e\x3c\x8f\xb1\x99\x2f\xac\xec\x17\xbf\x5f\x22\x84\x0a\x4a\x96\x01\x98\xc6\x81\x39\x30\xdc\x3b\xd1\xf6\x0f\x37\x4f\xe3\x90\x6c\x27\xa6\x01\x1e\x5e\x18\x2b\x3a\x54\x77\x8d\x83\xf2\x6a\x91\x21\xe4\x26\x39\x73\x15\x8e\xf8\x2b\x1c\x2e\x53\x10\x2c\x99\xc2\xfe\x98\xb4\x7f\xc5\x84\x9d\x89\xf8\xa7\x7f\xd3\x96\x56\xff\xaf\xf1\xf8\xdb\x1f\x2e\xff\xfe\xdd\x78\xfc\xdf\xff\xe4\xaf\xf9\x20\xa5\x71\xf9\x6a\x89\xb2\xf0\x5e\x40\x80\x82\xea\xe0\x4b\xc6\xc7\xc8\xda\x3a\x9f\xcf\x81\x6f\x08\x05\xc0\x2d\xbd\xaa\xcb\x29\x14\x89\x7c\x7b\xed\x7f\xcd\x4d\xf2\xf6\x3a\x42\x7a\xe3\xa9\x72\x3a\x3e\xe2\xe1\x10\x34\xc9\xc1\x1b\x95\x9e\x77\x4b\x82\x82\xaa\xf0\xdf\xbf\xc0\x52\x61\x14\x21\x6b\xbf\x74\x29\xa0\xa8\x62\xa5\x32\xb4\x1b\x55\xb1\x82\xab\xdb\xc4\xe1\x4e\x00\x6a\x20\x5e\xac\x5f\xbf\x38\xfa\x51\xb4\x70\xcd\x3f\x78\x68\xae\x43\x56\x2a\xed\x1b\x7f\x90\x46\x88\x0c\x94\x63\x0e\x45\x29\xce\xaf\xdf\x8a\xb5\x1d\xf7\x23\x0f\x43\xbc\x0e\xf3\xc3\x76\x2c\x4d\xe6\xe2\x98\x7f\xe9\x49\xa3\x39\x79\x6e\xa6\xfc\xb5\x8a\x2b\x58\x08\x2c\x0c\xea\xe4\x94\xd6\x1f\x0f\x88\xe4\xc4\x85\x05\x4b\xf1\xd2\xbe\x39\x9d\xe7\x35\x5f\x11\xd0\x33\xd6\x29\x3b\x71\xbf\xaa\xfc\x4e\xad\x54\x21\xd3\x53\xca\x50\x9c\xf8\xaf\x89\x13\xef\x9f\x22\xaf\x6f\xb3\xc1\x8f\xbf\xe5\x64\xc4\x10\xe9\x4b\xe7\xce\xeb\x02\x78\xbf\xd3\x4d\xa8\x80\x77\x54\x4d\xe6\x67\xad\x1b\x40\xbf\x6f\x59\x78\x77\x0b\x16\x46\x3c\xf3\x67\x38\x38\x5d\x23\x84\xba\x9c\x9e\x72\xe2\xf9\x5c\x40\x02\x5c\xcc\xc1\x0f\x54\x8e\x8f\xbb\x6b\xc1\xa1\xbd\xd6\x25\x0f\xe2\xea\x7e\xa2\xc8\x7e\x0f\x27\xfc\x3d\x98\xf4\xf7\xd1\x64\xdd\x90\x3e\x24\x20\x4e\x5d\x81\x7b\xc1\x46\xa8\x22\x44\x7a\x56\x6e\xf5\x90\x1b\xb8\x55\xfa\x7d\xdc\x3a\x73\xa2\x24\xbe\x78\xfd\x22\xe2\xf3\xcf\x9e\xc0\xb5\x27\x12\xd7\xb6\xfa\x3e\x3b\x74\xda\xc7\x1f\xdd\x99\xe4\x65\x85\x0b\xea\xf8\xb9\x1d\x87\xae\x89\xc7\x3a\x0d\x6d\x52\x7a\xbf\x7e\x89\x56\xda\x3d\x53\xa6\x80\x68\xd5\xb8\x0c\x59\xf7\x47\xd4\xf5\x7d\xb8\x34\xda\x0c\x03\x87\xd9\x65\x50\x13\xd6\x95\xcf\x47\x97\xf6\x1a\x51\xfc\x24\xf3\xb3\x62\xc1\xd8\xbf\x60\x35\x0e\xee\x92\xc1\x5d\xb2\xc7\x5d\x12\xc9\x4f\x38\xf8\x4a\xac\xaf\x24\xe2\xe3\xcf\x97\x89\x03\x63\xaf\xdd\x4e\xfb\xf6\x1a\xa2\xeb\x8b\x59\xec\x74\xd5\x87\x50\xec\x5e\x91\xa2\x7d\x89\xea\x3f\xca\x0a\xbd\xa2\xc8\xc2\x56\x06\x8e\xbf\xc7\x75\x48\x04\x56\x5d\x60\x9a\x33\x8b\xe0\x82\x2f\x47\xfd\x9d\x53\x3e\x5c\xc0\x3c\x93\x5b\x3d\xbc\x6d\x95\xde\xf1\x07\x13\x16\x20\x1f\xf5\x7f\x9e\xc6\x9c\x20\xdc\xd3\x63\x28\xf3\xf4\x9b\x2c\xf3\xb4\xe2\x86\xef\x29\x84\x1f\x77\x32\xc4\x9e\x0a\x11\x6a\x18\x76\xa7\x5a\xe8\x87\xb3\x51\xe4\x78\x9c\x67\x7e\x3c\x85\x4e\x54\x56\x41\x92\x72\x01\xb7\x89\x1c\xa8\x1a\x32\x56\x0a\x3a\xbc\x1c\xd5\xb0\x73\xd5\xf9\x41\x9d\x36\xac\xc3\xa3\x02\x1e\xac\xd1\x79\xa8\xc2\xb9\xd9\x32\x83\x07\x6d\x33\x68\x9b\xa3\x68\x1b\x5a\x68\xbf\x59\x55\xd3\xb3\x91\x96\x7e\x6d\xc2\xa3\x5d\xbc\x28\x47\x81\x02\xa6\x7a\xad\x32\x55\x96\xd7\x85\x99\xa9\x33\x7e\x9b\x6e\xf5\x4a\x67\x4b\x8f\xde\x73\x62\xc0\xce\x99\x79\x85\xc0\xb3\x36\xf9\xca\x6a\x21\x75\x5a\x17\xea\xf6\xae\x50\xe5\x9d\x49\x19\xb7\x12\x3e\xb4\x99\x8f\x5b\x76\xb7\x6b\x5d\x69\x99\xbe\x51\xa9\xdc\xb0\x73\xd7\x8f\xd7\x1c\x9b\xe5\xfe\x0c\x1a\x52\xd6\x98\x7d\xf7\x2c\xe6\x08\x92\xf9\x4d\x5d\x7d\xf5\x51\x61\xee\x45\x48\x1d\xd6\x3d\x6c\x46\x2f\x67\xd8\x8d\xc3\x6e\x1c\x76\xe3\xc1\xbb\x31\x3a\xed\xeb\xea\x71\x8e\x17\xed\xbd\x44\xe5\xa9\xd9\x74\x02\x7f\xf9\x5d\xe6\x76\x77\xdd\xa8\xbc\x15\xd1\x93\x4f\xcd\x62\x79\x6d\xcb\xe3\x58\x2e\xa4\x46\x8d\x30\xfa\xc3\x0c\x58\x89\x6c\x1b\x28\xfd\x51\xd2\xa7\x3a\xe4\x0a\x57\x8d\x48\x86\xd6\x76\xdd\xec\xf8\x7a\x0f\x28\x15\xea\xac\xe2\x42\x50\x5a\x9d\xbc\x0e\x55\x32\xda\x83\x29\x64\x15\x51\x7b\xb7\x51\x9c\x26\x90\x72\x50\x95\x40\x17\xf9\x88\xad\x2f\x32\x3e\x63\x91\x8d\x47\xdd\x55\xb0\x49\x60\x1e\x4b\x20\x7f\x31\x59\xf4\x78\x6d\x0b\x10\x89\xaa\xa0\x44\x18\x24\x8e\xdc\x99\x7b\x7e\x35\x47\x8b\x7e\xc8\x49\x12\x50\x3d\x16\x66\x85\x53\x00\x98\x30\x58\xdb\x8d\xa9\x60\x32\x61\xc1\xd3\xf6\xd6\x78\x2f\x37\x42\x16\xa6\xce\x12\xcb\xbe\x16\xe8\x77\xde\x6f\x75\xe0\xca\x64\xbc\x28\x8e\x2e\x1b\x75\x16\x08\xa6\xa4\x4b\x28\x93\x21\xc1\x9b\xf1\x7a\xfa\xfa\x55\xef\xd3\xc5\x75\x03\xb4\xa6\x08\xea\x40\xd8\xa8\x04\x92\xce\xe0\x98\x3a\xaf\x80\xdb\xaf\xbd\xb7\x34\xa6\xb0\x61\xab\xb5\xef\xa9\x94\x26\x08\x38\x45\x7c\x90\x5e\x60\xa0\x1c\xc0\x3a\x32\x39\x05\x8c\x1b\x6f\x82\x02\xf9\xcd\x4b\x2c\x64\x08\x80\xd6\x46\x9d\xab\x93\xf8\xc2\x6a\xec\x7a\x88\x71\x17\xe9\xb2\x9e\xf5\xa2\xab\x48\xe1\x80\x0b\x8a\xf2\xe7\xb9\x61\x68\xbf\xb5\xc6\xe5\x1e\xb5\xe5\x86\x8b\x25\x12\xd0\x86\x2f\xc4\x4b\x2b\x0d\x78\xde\x8c\xa9\x4e\x7a\x5f\x66\x34\x74\x97\x0f\x79\x11\x3d\x7c\x97\x2e\xd7\x28\x7f\x7a\x1c\x47\xfc\xaa\x57\xad\xd3\x62\xdf\x38\xb2\x64\x7e\xaf\xee\xe4\x5a\x95\xa2\xd4\x2b\x9d\xca\x22\xc5\x5c\x82\x1b\xdb\x5b\x31\xab\xab\x9d\x91\x26\x96\xe4\xa7\x33\xb4\x76\x64\x5f\x45\xaf\x9e\x46\xb3\x5a\xfb\xab\xb5\x18\x78\xed\xc4\x05\xe3\xfa\x0c\x33\x8c\xc0\x40\x37\x06\x70\x5a\xac\xea\xaa\x96\x69\xba\x11\xea\x61\x9e\xd6\xa5\x5e\xf7\xad\xc7\xf8\x71\x4a\x6f\x71\x3c\xc3\x88\x26\x41\xea\xce\x46\xec\xad\x61\x0f\x85\x58\x8f\x56\xbc\x49\x79\xee\x76\xc9\xa3\x2f\x9a\xe0\xf6\x23\x96\xa8\x0e\x91\xa2\x11\xc4\x2c\x03\x6c\xd2\x91\xce\x19\x97\xb0\x3c\x15\x70\x22\xc1\x87\x4b\xd5\x29\xd2\x89\x83\x75\x06\x44\x8b\xee\xa2\x60\x71\xda\x98\xcd\x27\xee\xef\x4c\xea\xd5\x85\xfd\x43\xa7\xdc\x99\x82\xad\xd5\x26\xb4\x5e\xab\x62\x13\x58\x43\xc2\xc1\xd5\xa7\x49\xec\x63\xab\xdd\x1f\xdd\x9a\x24\x1b\x93\xc1\x42\x3f\x43\xb9\xdb\xa1\xdc\xed\x50\xee\x76\x28\x77\x3b\x94\xbb\x8d\x28\x77\xeb\x63\xda\x6c\xd1\x8f\xea\xc4\x0e\x15\x6f\x7f\x8b\x15\x6f\xfd\xc4\xc7\x95\x77\x74\xf6\x04\xa3\xe8\x2d\x5b\xa6\x8b\x47\x4f\x19\x45\x6f\xd9\x42\xc9\xee\x1f\x8a\xde\x0e\x45\x6f\x87\xa2\xb7\x43\xd1\xdb\xa1\xe8\xed\x50\xf4\x76\x28\x7a\x3b\x14\xbd\x1d\x8a\xde\x1e\x56\xf4\x76\xc0\x78\xfe\x1f\xc7\x78\xfa\xfb\x42\x8c\xe3\x87\xd2\x8e\x82\x49\xff\x8c\xd0\xe5\xc8\x2b\xf5\x46\x1f\x10\x9a\x81\x07\x45\x42\xee\x9c\x8d\x28\xef\x64\xe1\x9c\x8e\xa9\x5e\x28\x40\xaa\x8c\x22\x52\x94\xf7\xf3\x4e\x1f\x6a\xc4\x43\x7d\x93\x7a\xc5\xfb\xec\x56\x07\xc7\x3f\x01\x01\x29\x0c\x26\x34\x91\x88\x02\x48\x20\x85\x8c\x98\x62\x85\x98\xc9\xf9\x67\xeb\xa2\xf2\x03\x06\xc5\xff\xbd\x95\x04\x17\xdb\x17\x2f\x22\xc0\x0b\xf0\xcf\x97\xa1\xaa\xb1\x1a\x8e\x12\xc0\xf5\x32\x1e\x97\x5e\xa8\x6d\x6b\xa4\x99\x6d\x0d\x6c\x5c\x16\xb4\xd3\x3c\xc7\xf2\x09\x5c\x1c\x2d\x35\x6f\x64\x2a\x25\x3f\x8d\x92\xc6\xf9\x0f\x14\x10\xf9\x1d\x36\x24\xd1\xc5\xd1\x54\x44\xa9\x7f\x51\xef\xba\x59\xff\xc3\x4f\x14\x97\xc0\x61\x3c\x02\x07\x71\x08\xb4\x17\xef\xad\xc1\xbb\x06\xc6\xbc\x60\xfd\xa6\x66\x2e\x53\xb7\x8a\xa3\x4f\x78\xb0\x26\xed\xfa\xbd\x24\x7d\xe1\xae\xdf\xb8\x8a\x61\x0c\xd9\x32\x91\xe9\x03\x16\xbc\x4c\x4b\x63\x09\x6a\xe7\x40\x97\x87\xdf\x41\x6c\x1d\xb4\x72\xd9\x32\xa1\x11\x2b\xf9\x00\xbc\xca\xa2\x2e\x61\x9f\x9a\x8c\xea\x02\xb8\x1d\xeb\x1a\xce\x96\x79\xef\x82\xc2\xa0\x9d\x88\xb4\x79\xeb\x6e\x08\x7f\xb9\x71\xeb\x87\x2d\xd8\xdf\xae\x04\x16\x4e\x75\xd7\x85\xb2\x5e\xc1\x44\x51\xab\x71\x94\xf8\xc7\x33\xe0\xba\xd2\x34\x84\x79\x43\x51\x98\x6d\x3d\xc3\x16\x99\xe9\x94\x5c\x67\xa4\x68\x5c\x89\x3f\x3f\x81\x75\x46\x07\x19\xdf\xd6\x0c\x06\x0f\x6a\x83\xdd\xca\x00\x18\x9c\x4f\x97\xb5\x4e\x0e\x57\x03\xcf\x9e\x77\xa2\x07\xce\x89\xa8\x73\xfe\x20\x58\x52\xd3\x68\xb5\x33\x31\x69\x7a\xcd\x31\xec\xa2\x46\x11\x4e\x01\x36\xd4\x2c\x4a\xff\xe5\x40\x17\x55\x82\x83\xdc\x06\xc5\x2f\x52\xa9\x57\xd1\x9d\xbd\xf6\x52\x5c\x6b\xe7\x20\xc7\x61\x42\x44\x07\x01\xbc\x7b\x85\xb1\x3a\x82\x39\x83\x2d\x8a\x61\x01\x6a\xf5\x70\x7c\xe1\x1e\x77\x81\x84\x8c\x26\x58\x8a\xeb\x5d\x63\x38\x8a\x65\xf1\x72\xfc\xd3\x48\x75\xe7\xca\x48\xe5\x26\xa0\x55\x22\xf4\x8f\x3b\x60\xb6\x54\x06\xd7\x80\x08\x8b\xe2\xd4\x29\x91\xf0\x96\x7d\x07\x47\x93\xcd\x57\x14\x7d\x24\xc7\xe0\xeb\x1e\xcd\xd5\x4f\xe0\x32\x5b\x18\x24\x80\xb9\x53\xbe\x24\xbe\x0b\x71\x32\x45\x02\xa8\x9f\xe0\x83\x08\xda\x0b\xa1\x5b\x3e\x50\xee\x90\x2b\x48\x8c\x67\xe0\x34\xac\xea\xbe\x95\x9e\x4d\x1c\x3d\x1b\x45\x8e\x3d\x25\xf2\x0d\x48\x8a\x01\x49\x31\x20\x29\x06\x24\xc5\x80\xa4\x88\x40\x52\x44\x12\xf3\x0c\x30\x8a\x7f\x0b\x18\x85\x9d\xf5\x49\xdc\x39\xe9\xce\xe8\x6e\x0c\x85\xf7\xe3\xf2\x27\xab\x1b\x43\xe1\x2e\xcb\x6c\x91\x03\x86\x62\xc0\x50\x0c\x18\x8a\x01\x43\x31\x60\x28\x06\x0c\xc5\x80\xa1\x18\x30\x14\x03\x86\xe2\x20\x0c\xc5\xb1\x78\x97\x2c\xbf\x27\x53\xa8\xf8\x35\x03\xf2\xce\x11\x75\xd5\x0b\x7a\x84\x28\x78\xdd\x9e\x31\xc9\x98\x99\xad\xe7\xc2\x00\xd6\x39\x6b\xe3\xc6\x07\xbb\x57\x9d\x4f\xd5\xb6\x66\x3c\x3a\xca\x16\x89\x58\x8d\xcf\x97\xae\x94\x21\xcc\x11\x69\xef\xb1\xaa\x87\xf2\xf5\x43\xf9\xfa\x3e\xcb\xd7\x3b\x1b\xf3\x1c\xb9\x6d\xba\x7d\xcf\x2a\xeb\x82\xd1\x9c\x86\x82\xef\x1f\xb2\x0e\x8e\xf4\x53\x1f\xd0\xd8\x5b\x1b\x7e\x4b\x6c\xe7\x67\x99\x43\x64\x7b\x7e\x21\x73\x39\xdf\x5b\x03\x39\x5a\xe4\xbb\xaf\x4d\x79\x17\x35\xf5\xdd\xc7\x51\x54\xe7\x6f\xec\xc9\x70\x91\xca\xb2\xec\x41\x2c\xa3\x2b\x90\xce\x9b\xd4\xa9\xce\x96\x67\x23\xd6\x39\x7a\xe3\x1f\x70\x87\xe9\x53\x05\x91\xa1\x74\x57\xb1\xdc\x6b\x11\x40\xce\x32\x1c\x9c\x85\x0c\x62\x47\x5f\xa6\x5e\xe4\x62\xa1\xb3\xce\x25\xd9\xea\xd2\x39\x3d\xd2\xec\xd0\x44\xc8\x80\xff\x40\x4f\xdf\x54\xbc\xed\x34\x8d\xa8\xba\x95\x2e\x45\xaa\x16\x55\xa3\xa0\xb7\xb8\xf8\xf8\x66\x02\x16\x57\xa1\xb6\x92\xa2\x65\x27\x5a\x2d\x64\x4c\xeb\x8a\x10\x35\x2b\xf9\x59\x39\xb1\xa2\x32\x46\xa4\x50\xb6\x1e\xae\x1b\x70\xad\x00\x38\x50\x17\xa0\x84\xb9\xca\xb7\x40\x16\xe8\xb2\x2c\xd6\xea\xb4\xce\x3e\x67\xe6\x3e\x3b\x45\x17\x76\xd9\x09\xb7\x00\x54\x9d\xad\xa8\x64\x8a\xaf\xb8\xb5\x77\xb1\x59\xd2\xfc\x40\x0b\x7d\xa8\xdd\xa6\xc3\xd3\xd6\xe8\x34\x69\x4d\xd6\xc7\x48\xe7\x85\x36\x50\xc8\x1d\xf7\x7e\xb7\x5a\x69\x75\xe5\x9a\x9e\x85\x28\x70\xe9\xbb\xc4\xa4\xe6\xec\x1c\xb7\xca\xa4\xaa\xe0\x54\x26\x67\x39\x65\x5b\x0d\xbf\x25\x68\x03\x80\x1a\xc4\xad\xff\x1e\xf0\x5a\xcb\xaa\x92\x30\x01\x9c\xa0\x1e\xb5\x50\x41\x4d\xad\x8d\x80\xbb\x30\xc4\xc4\x24\x31\x01\xd1\xbc\x56\x85\xce\x53\x25\xbe\xfd\xac\x36\x13\x74\xad\x4d\x14\x46\xcf\xbe\xeb\x14\xef\x69\x42\xac\x3c\xf8\x05\x96\xa6\xac\x4c\x21\xbe\x75\xff\xfb\xae\xeb\xa2\xcb\xb7\x8e\x84\xb0\x2d\xeb\xfe\xdc\xd6\x70\x5e\xe2\x63\x42\x67\x89\x9e\xcb\xca\xf5\x1b\x87\x43\x91\x7b\x89\x17\x21\xc5\x7e\x4e\x09\x9f\x6c\xa1\x6b\xf8\x16\x02\xe4\x9a\x02\x4b\xcb\x33\xc5\x12\xda\xf0\xe6\xc9\x34\x35\xf7\x2a\x21\x5f\x21\xee\xb6\x2b\x43\x47\x80\x9a\x88\x6b\x0c\x86\x85\x77\x58\xe2\xc1\xdd\x75\x65\x2e\x1f\xd4\xbc\xae\x58\xee\x78\xb6\xda\xa0\xab\x6e\xf4\x74\xfc\x60\xb3\x6d\xc3\x24\xa0\x0f\xc8\x41\x00\xc3\xb6\x62\xc8\x15\xa4\xd5\x21\xc6\xbd\x7f\x5e\xe0\x4e\xce\xe9\x3d\xc6\xe2\x9c\x63\x4a\x97\x02\xd1\x81\x93\xb0\xb0\xdd\xb5\xfe\x12\xab\x49\xff\x99\x25\x11\xb7\xf1\xdc\xac\x66\x3a\xb3\xfb\x98\x50\x8f\xa6\xd1\x4a\x37\xe5\x4c\x6e\x33\x78\x84\xdb\xa5\xa8\x09\x75\x1d\x8d\x9e\xd5\x0f\x6e\x84\x9a\xa5\xfc\xc1\x15\x02\xec\x4f\xe8\x37\x34\x59\x79\xa7\x73\x86\x5c\xe1\xa8\x60\x70\x50\xa6\xe2\x13\xd6\x07\x72\x2d\xb3\xa7\x90\x1d\x7f\x18\x2f\x71\xf9\x73\xcd\x0d\xdf\x35\x20\x11\xf4\x98\x13\x04\x53\xfd\x73\xad\xd7\x32\x05\x8b\xa2\x32\x90\x58\x98\xcc\x65\xc1\x9b\x0e\xf0\xd3\x63\x63\x27\xa2\x34\xe4\xb8\x46\x0b\x09\x32\xf4\x9c\x1a\x0e\xab\xb1\xcb\x5e\xa2\xa9\x80\x98\x2a\x90\xcf\xe8\x79\x9d\xca\x42\x80\xee\x5a\x02\xbc\xbf\xef\x39\x0f\x5b\x8e\x4d\xfe\xb9\x35\xf9\xb7\xdb\x12\x9a\xab\x00\x76\x93\xe5\x3f\x66\x88\xc5\x6e\x43\x82\xc7\x96\x32\x10\x2f\x09\x30\x4c\xfb\x0f\xaa\xad\xa0\xee\x66\xc9\xf4\x0a\x70\xd2\xe0\x85\xab\x5a\xcc\x7d\x7a\x99\x99\x42\x25\x27\xee\x3b\x99\xb1\x1a\xaf\xc5\xa6\xe2\x7b\x0f\x63\x9a\x50\x88\xd9\xd3\x0c\x36\xc0\xce\x3c\xa9\x6e\xc9\x04\x25\xb9\x30\x05\x96\xc6\x7f\x99\x18\xf4\x6e\xab\xb5\x9e\x03\x43\xd6\x3f\x54\xc1\x3b\xbc\x60\xab\x64\x0a\x68\x0e\xd7\xb4\xb3\x02\xb2\xa0\x22\x3c\x96\x2c\xc5\x2b\xf1\x12\x45\xb3\x64\xea\x15\x40\xe0\x65\xa5\xd2\xcd\x89\x23\x5c\x2a\x37\x65\xa5\x58\x00\xfb\x46\x0c\xe4\x8f\xdf\x8c\xfa\x8e\x7c\x60\x17\xa3\x57\xf2\x27\x57\x19\x3f\x8c\x3c\x0a\x3a\xe4\x6c\xf2\x66\x96\x99\xba\x53\xc5\x29\x31\xf8\x06\xab\x79\x08\xd9\xc0\x86\xcc\x04\x46\x39\x77\x34\xf9\x05\xfd\x2f\xd8\x1b\x50\x99\x75\x89\xfa\xc2\x6a\xfc\x9e\xb5\x45\xaf\xfe\x4b\x50\x3d\xb9\x49\xcd\x72\x73\x93\x03\xa4\xf5\xc2\x64\x65\x55\xa0\x86\x3c\x1b\xb1\xa7\xec\xaf\xe6\x7e\xeb\x86\x82\xc2\x84\x9c\x17\xa6\xa4\x89\xa4\xaf\xd9\x2b\x54\x88\xc4\xac\xa4\xce\x4a\x40\xa2\xe7\xa9\x9c\x3b\xf3\x76\x1e\x9a\xe5\x2e\x11\x77\x7a\x79\x77\x2a\xd7\x52\xa7\x72\xa6\x53\x5d\xed\x77\x1e\xd9\x90\x7f\xdf\x97\x04\x93\xff\x2f\x7b\x57\xdb\xe3\xb6\x91\xa4\xbf\xeb\x57\x34\x66\x8d\x93\x0d\x68\xb4\xf1\xe1\x70\x1f\x74\x41\x80\xc9\x44\x41\x8c\xf8\x45\xf0\x28\xce\xe5\x16\x8b\xa4\x45\xb6\xa4\x3e\x53\x6c\x86\x4d\xca\xa3\xfd\xf5\x8b\xaa\x7e\x21\xa9\xd1\x88\xd5\x34\xb5\x19\x67\x19\xe7\x83\x67\x2c\x96\x9a\xdd\xd5\xd5\x5d\x55\x4f\x3d\x85\xef\xe4\x5e\xb7\x1a\x65\xdd\xd3\x69\xcf\x8e\x9e\x74\xe8\x5d\x43\xea\xd3\x2e\x3b\x45\x26\x0c\xc0\xf0\xb7\xe1\x53\x2e\xfe\x30\xed\x4b\xbf\x7a\x72\xc1\x69\x8a\xda\x3a\xaa\x96\x0f\x28\x5e\x3e\x96\x7c\x6d\xf7\x7f\xa2\x5c\x60\x93\x1d\x9e\xe8\xbb\x16\xd4\x71\x43\x41\x7e\x42\xff\x8c\xa7\x4c\xdc\x17\x22\x4f\x01\xdb\x50\x16\x5b\x88\x17\xed\x65\x2c\xf2\x89\xc5\x11\xb4\x55\x5a\xa4\x0f\x13\x44\x78\xc1\x01\x34\xbc\xf5\xa5\x6a\x23\x9c\x30\x29\xa6\xfe\x3b\xce\x88\x85\x19\x9b\xb0\x28\x91\x70\xdf\x92\xf1\x84\x45\x4a\x7d\x94\x82\xfd\x87\xfb\x9d\xf9\x2e\x3d\xfa\x0c\xa3\x15\xe5\x87\xac\x50\xb7\x6a\xb7\x0b\x9b\xb9\xe5\x56\x9c\x7a\x6d\x0f\x7c\x86\x37\xc7\x17\x5f\xbe\xbe\x7b\x54\x22\x63\x11\xac\xeb\x1a\x7d\x4e\x0c\x6a\x69\x11\x95\xb9\x60\x3f\x2c\x97\x8b\x3b\x20\xfc\xdb\x95\xa9\x8c\xce\x1f\x27\x84\x97\x8c\xa5\x86\x1a\xb7\xf7\x02\x3e\x05\x24\x4b\x0b\x6c\x34\x51\xe6\x67\xa3\x7d\x8d\xf7\x7d\x2d\xf8\x5e\x3c\x1a\xb4\x44\xe5\xcd\x72\x75\x7f\x80\xc8\xdd\xa8\xb5\xdc\x36\xf7\x23\x01\x93\x0c\x09\x4a\x1c\x0b\x60\xda\xd2\x98\xe7\xf1\x04\x27\x43\xee\xf8\x06\xd5\xe7\x6c\xdb\xe1\x88\xa7\x70\xd9\xc9\xcb\x14\x6c\x0a\x67\xa9\x4a\xaf\x73\xa5\x0a\x48\x30\x3e\x7e\x05\xa0\x64\x51\xed\xb4\xdd\xf1\xfc\x76\x2b\xa2\x8f\xc4\x99\x9a\xa7\x30\xd7\x00\x48\xb2\xcf\xb3\xbb\x9b\xf7\x2c\x02\x09\x50\x75\x98\xb0\x42\x6d\x04\x1c\xc8\x9f\x35\xb6\x1a\x8d\xea\x6c\xd4\x57\x48\x90\xa0\x4a\x0f\x5e\xf6\x21\xc9\xac\x6e\x46\x53\xe1\xa7\xd3\xba\xd2\xdd\x96\x32\xa6\x64\x1c\xbd\xd2\xba\x14\xf9\x4f\x79\x32\xa3\x0d\xf6\x5d\xca\x7e\xf4\xc7\x81\xb9\x54\xbd\xcb\x44\xfa\xea\x3b\x68\xd5\x96\x62\xfc\x07\x25\xa2\x11\x04\x63\x05\x3b\xef\xcc\x4c\x98\x72\x2b\x50\x33\x0d\x98\x28\x51\x99\x2a\x74\x3a\xad\x49\x80\xeb\x06\x5e\x36\x3c\x5f\xf9\xbb\x9b\x9f\x96\x3f\xfc\xe7\xaf\x8b\xf7\xef\xfe\xf7\x97\x5f\x6f\x5f\xbf\x9a\xbf\x5d\xfe\xfa\xea\xdc\x89\x09\xd2\x4e\x3d\x74\x37\xbf\x7d\x3f\x5f\x82\x07\xed\x6f\x1d\xb7\xc7\x27\xc1\xe8\x33\x16\x3b\x53\xf1\x3b\xc7\x8d\x4a\x9d\x63\xf7\xf9\xfa\xf2\xb7\x9b\x8d\xd1\xe7\xc5\xfe\xd0\x04\x50\x02\xad\xff\xba\x20\xf9\x4d\x35\xa4\x40\x96\x5c\x82\xf6\x5b\x0b\x30\xa3\x0f\xe7\xf4\x46\x6d\x0c\xcc\x82\xa1\x44\xde\x76\x21\x6c\x5e\x7d\x55\x2a\xbc\xf6\xf9\x12\xbc\x9e\xa3\xdd\xf3\x74\xff\x81\x37\xe3\x46\x69\x37\x82\x6b\x2b\xc0\xdc\x67\x6f\xdd\x1b\xb7\x5d\x39\x69\x5a\x58\x5d\x86\xda\x3f\x75\xa6\xd6\xf5\xd4\x7b\x05\x20\x6d\x2f\xdf\x11\xb1\x9b\xdb\x3c\xfe\x60\xdf\xe5\x0c\xc1\x38\x41\x26\x3b\x41\x42\x0e\xbc\x8a\x7b\xa9\x4a\xed\xa0\x54\x75\xdd\x20\xc9\x74\xf3\xac\x5d\x4d\x81\xdf\x0c\x68\xca\x21\x39\x02\xbe\x83\x8c\x44\x77\xd1\xb6\x04\xc2\xfd\xec\x2e\x2e\x48\xb2\xa8\x55\xb2\x0f\x01\xeb\xfa\x29\x74\xe3\x95\x69\x56\x16\x76\x01\x7d\xcc\xa6\x4c\xa3\x2d\x4f\x37\xd4\x12\x79\x38\xc2\xea\x0b\xa2\x0f\x69\xc1\xef\x1d\xfc\x55\xe8\x88\x67\x22\x36\x15\x07\x9c\xc5\xaa\xa4\x12\xca\x3f\x7b\x06\x57\xfe\x19\x7b\x56\x13\x3e\x65\x73\x2b\xaf\xa6\x0d\x30\x6e\x92\xc4\x14\xe3\x5c\xab\x8a\x90\x1e\xfc\xf1\x0d\xcf\x63\x24\xc0\x54\xeb\x06\x42\xcf\x4d\x38\x49\xb2\xc0\x88\x0b\xdc\xde\x52\x55\x34\x0a\xe4\xd8\xd5\xd5\x74\x7c\x91\xad\xd4\xde\x4d\xfa\xc4\x76\xb2\x8d\x9a\xe1\x9e\xfc\x98\xd1\x20\x22\xf3\x6c\x08\xfd\xd6\xab\x23\x3a\x31\x72\x6d\xa3\x5a\x36\x48\x89\xa1\x24\x8a\x1e\x85\xd8\x4a\x57\xaa\x87\xa4\x46\x3f\x8a\x03\xb1\xcb\xea\xc3\xc9\xc0\x0c\xb9\xcd\x26\xc0\x2d\x83\xd7\x1a\xc5\x12\x05\x86\x8e\x3b\x20\xb7\x75\x72\xcc\x4b\x0f\x6d\xb6\xad\x61\xa9\x03\x0d\x56\xb2\xd0\x93\xe9\xe4\x70\xcf\x70\x88\x05\x88\x0c\x21\xe3\xb9\x00\x8f\xd8\x85\xb8\xc4\xba\xf1\x89\x75\x5e\xc7\x50\x0c\x33\x09\xc7\xec\xf7\x4b\x80\x50\x66\x61\xcf\xe0\x81\x74\x44\x33\x53\xfd\xdd\xae\x88\xdb\x2e\xb8\x75\xe2\x05\xbc\xfa\x83\x81\xc4\xae\xb6\x6b\x5c\x19\xaf\x46\x59\x6b\xa6\xe2\x99\xeb\x55\x41\xd7\x70\x80\x8f\xc5\xbc\xe0\x53\xd8\x1f\x93\xe6\x8f\x08\xbd\x9e\xb0\xdf\xfc\x2f\x4d\x93\xdc\xbf\x8d\xc7\x5f\xff\x38\xff\xe5\x9b\xf1\xf8\xef\xbf\xd1\x75\xbe\x92\x52\x73\xbe\x1a\xa2\x30\x78\x38\x05\x78\x10\x98\x0e\xba\x64\x7c\xcc\xde\xb6\x6e\xa2\x08\x98\x23\x50\x00\x44\x87\x8a\x52\x4f\xa1\xdd\xd7\xab\x85\xff\x31\x53\xf1\xab\x45\x80\xf4\xda\x53\x7a\x3a\xbe\xe0\xe1\x50\x59\x92\xce\x1b\xd5\x3e\xef\x54\xc2\x46\xdc\xe1\xaf\xdf\x83\xaa\x10\xda\xc9\x34\xff\x48\xcd\xa0\x3d\x56\x21\x52\xbc\x37\x8a\x7c\x07\xae\xdb\xc4\x25\x25\x31\x72\x73\xb5\x7f\x79\x75\xf1\xa3\x68\xed\x86\xdf\x79\x6a\x16\x55\x7d\x91\xdd\x37\xfe\x20\x0d\x10\x59\x91\xc7\x38\x88\x0d\xbb\x59\xbc\x62\x7b\x33\xef\x17\x9e\x86\x70\x1b\xe6\xa7\xed\x52\x96\xcc\xd5\x02\x7c\xdf\x93\x45\x73\xf2\xdc\x4a\x79\xb7\x8a\x2a\x98\x31\x6c\xf1\xe6\xe4\x68\x53\x64\x00\x70\xb5\xd8\x41\xeb\x35\x7b\x6e\x7e\x39\x8d\xb2\x92\x6e\x08\xec\x33\x06\x00\x3f\x71\x3f\x8a\x6c\x2b\x76\x22\xe7\xc9\xb5\xad\x35\x99\xf8\xaf\x09\x13\xef\x9f\xb2\x94\x6a\xf5\x01\x3f\xfc\x96\x17\x64\xb9\x10\x4e\x8c\xca\x1c\x18\x5c\x93\x43\xd5\xcb\xe8\xa2\x96\xcc\xaf\x5a\x3b\xba\xf2\x9c\x5a\xf8\x70\x0b\x76\x99\x98\xf9\x33\x1c\x82\xfd\x01\x42\x1d\xe4\x5b\x4f\x7c\x65\x3e\x48\x00\xc7\x1c\xe2\x40\x7a\x7c\xd9\x5d\x0b\x79\x81\xbd\xd4\x34\xfc\x93\xfb\x2f\x88\xb6\xb1\x3b\x75\x63\x67\xfa\xc6\x07\x8b\x75\x67\xed\xa1\xcd\xd2\x96\x05\x84\x17\x0c\x1a\x23\x40\xa4\x4f\xf8\x88\xfb\x4c\x81\x57\xe9\xf7\x71\xe3\xcc\x09\x92\x78\xf5\xf2\x2a\xe0\xf3\x4f\x9e\x8a\xaf\x27\x3a\xbe\xa6\xf9\x9e\x75\x5d\xf6\xf1\x7b\x77\x26\x79\x59\x95\x83\x3a\x7e\x6a\xc7\xa1\x1b\xe2\xa5\x4e\x43\x93\xc1\xe9\x37\x2e\x61\xb3\x42\xe6\xda\x41\x94\x89\x99\x98\xb1\xae\xea\x27\x2f\x68\xeb\xfb\x08\x69\xd8\x4d\x6f\x5f\xb5\xdb\xbd\x0c\xb2\x65\xae\x11\x32\x86\xb4\xf7\x08\xf1\xb4\x32\x3f\x0a\x12\xc6\xf1\x33\xb4\x71\x08\x97\x0c\xe1\x92\x33\xe1\x92\x40\xa6\xa9\x21\x56\x62\x62\x25\x01\x1f\x7f\xba\x35\xd5\x98\x7b\x6d\x0f\xda\x37\x75\xc8\xba\x2f\x6a\x7d\x32\x54\x5f\xa5\x62\xcf\x8a\x64\x4d\x27\xaa\xff\x2c\x2b\xbc\x95\xcd\x2c\x1c\xc1\xb3\xbd\x1f\xd7\x22\x11\xf8\x11\x81\x33\x48\xad\xab\x10\xbc\x1e\xf5\x77\x4e\xf9\x74\x01\xf1\x4c\x6e\xbc\xe1\xb2\xd1\x44\xc1\x1f\x4c\xd4\x4e\xce\xa1\xe7\x69\xc8\x09\x42\x3d\x3d\x86\x86\x1d\x5f\x64\xc3\x8e\x5d\x08\x51\x56\xf0\xc9\x10\x7a\x2a\x04\x98\x61\xd0\x7a\xb1\x96\xf7\xb3\x51\xe0\x7c\xdc\xa4\x7e\x3e\x99\x44\x4c\xd2\x5a\xc2\xb4\x00\x91\x9a\xc8\x44\x4a\xaa\x4f\x84\x3f\x8e\x34\xd2\x85\xea\xfc\xa4\x4e\x6b\xb7\xc3\x8b\x02\x1e\xcc\xa5\xb3\xab\xc1\xb9\x3b\xba\x06\x0f\xd6\x66\xb0\x36\x17\xb1\x36\x56\xd1\xbe\x58\x53\xd3\xf3\x25\x2d\xf9\xa3\xf9\x30\x4e\x15\xcd\x5f\x04\x0a\x98\xc8\xbd\x48\x85\xd6\x8b\x5c\xad\xc4\x8c\x3e\xa6\xa5\xdc\xc9\x74\xe3\xd1\x7b\x4e\x0c\xc4\x0d\x56\xde\x20\xd0\x6e\x9b\x74\x63\xb5\xe6\x32\x29\x73\xb1\xdc\xe6\x42\x6f\x55\x42\xf0\x4a\xc2\x88\xf0\x42\xe2\xa6\x40\x5e\x21\x79\xf2\x9d\x48\xf8\x81\x5c\xd8\x78\xb9\xe1\x98\x12\xc8\x27\x30\x10\x5d\x22\x31\xcf\x93\x58\x23\xa8\xf4\x54\x65\xf1\x87\xcf\x0a\x71\x2f\x02\xd4\x5b\xf6\xb0\x19\xbd\x9c\x61\x37\x0e\xbb\x71\xd8\x8d\x9d\x77\x23\x36\xf8\xd2\x01\xfb\xf0\x6d\xb9\x5b\x01\x21\xed\xda\x3f\xec\xf6\x5e\x2c\xb2\x44\x1d\x5a\x81\xbf\xf4\x57\xa6\xbe\xee\xbe\xd6\x43\x25\xe0\x4d\x3e\xd4\xdb\x1e\x35\x6f\x1e\x97\x0a\x21\xd5\xba\xbd\xd8\x7f\x58\x41\x29\x88\x19\x83\x65\xbe\xe2\xf6\x53\x2d\x72\x99\xeb\x2b\xc1\xab\xd1\xb6\x79\x76\x74\xbb\x07\xf5\xb6\x65\x5a\x50\x21\x28\x8d\x97\x5c\x54\x7c\xe7\xcd\xc9\x64\xbc\x08\xe8\xa2\x58\x6b\x33\x50\x55\x6c\xdb\x7e\x4f\x2e\xf3\x51\x23\xa9\x25\xc9\x1c\xcf\x48\xb4\xb1\x41\xbe\x0a\x0e\x09\xae\xc7\x1c\x98\x01\x54\x1a\x3c\x5f\xc7\x02\x58\x2c\x0a\x68\xf6\x02\x85\x23\x5b\xf5\xc9\x2a\x28\x41\x2a\x43\x5e\x8f\xcc\x4a\x12\xb5\x92\x2a\xc0\x84\x81\x6e\xd7\x96\x82\x48\x93\x02\x4f\x1b\xaf\xf1\x13\x3f\x30\x9e\xab\x32\x8d\x0d\x35\x4f\xc5\xcd\xf0\xe6\xe8\x05\xde\xaa\x94\x96\xc5\x91\xba\xc6\x98\x6d\x61\x4a\x52\x03\xe1\x39\x87\x68\xc6\xcb\xe9\xcb\xaf\x7a\x5f\x2e\x6a\x18\xa0\xb1\x44\xc0\xe8\x6d\xb2\x12\xc8\x48\x80\x6a\xed\xa2\x02\x6e\xbf\xf6\x3e\xd2\x90\x16\x55\x8d\xd1\xbe\xb1\x4d\xd1\x40\xc0\x35\xe2\x83\xe4\x1a\x13\xe5\x00\xd6\xe1\xf1\x35\x60\xdc\x68\x0b\x54\x31\x23\x3c\xc7\x96\x54\x00\x68\xad\x75\x2c\x79\x11\xde\x22\x87\xdc\xd9\x2a\xcc\x91\xd6\xe5\xaa\x17\x5b\x65\x0d\x0e\x84\xa0\x6c\x4f\x0d\x6a\x1a\xda\x6f\xad\xb1\x3e\x63\xb6\xdc\x74\x91\x44\x02\xda\xf0\x8a\x3d\x37\xd2\x80\x04\x48\xa9\xe2\x45\xef\x6a\x66\xa7\x6e\x7e\x9f\x85\x53\x16\xcd\x5d\xad\x51\xf6\xf8\x3c\x8e\xe8\xfd\x4b\x1a\xa7\xc5\xb9\x79\x24\xc9\xfc\x56\x6c\xf9\x5e\x68\xa6\xe5\x4e\x26\x3c\x4f\xb0\x96\xe0\xce\xbc\x2d\x5b\x95\xc5\xc9\x4c\x13\x49\xf2\xe3\x15\x5a\x27\xaa\xaf\x82\xb5\xa7\x36\xac\xc6\xfe\x6a\x28\x03\x6d\x9c\xa8\x30\xee\x9d\x61\x85\x11\x18\xe8\xe6\x00\x4e\x8b\x5d\x59\x94\x3c\x49\x0e\x4c\xdc\x47\x49\xa9\xe5\xbe\x6f\x3b\x46\xcf\x53\xfa\x1b\xc7\x13\xcc\x68\x5a\x48\xdd\x6c\x44\xde\x1a\xe6\x50\x08\x8d\x68\x85\x5f\x29\x6f\xdc\x2e\x79\xf0\x45\x13\xdc\x7e\x96\x42\xa4\x45\x24\xab\x25\x31\x75\x05\x9b\x74\x8c\x44\xca\x95\x2d\x4f\x19\x9c\x48\xf0\x61\xdd\x6e\x16\x9d\x38\xd0\x33\x60\xe1\x72\x8e\x82\xc1\x69\x63\x35\x1f\xfb\xb4\x55\x89\x37\x17\xe6\x1f\x5a\xe5\xae\x04\x6c\xad\x26\xdb\xe9\x5e\xe4\x87\x1a\xa1\xa9\x3f\xb8\xfa\xbc\x12\xfb\xdc\x6a\xfb\x47\x8f\x16\xc9\xe4\x64\xb0\x65\xc3\xd0\xb8\x70\x68\x5c\x38\x34\x2e\x1c\x1a\x17\x0e\x8d\x0b\x03\x1a\x17\xfa\x9c\x36\x59\xf4\x83\x8e\x7f\x43\xef\xc2\x2f\xb1\x77\xa1\x5f\xf8\xb0\x46\x5d\xee\x3e\x41\x68\x5f\x48\x96\xe9\xf2\xd1\x53\x42\xfb\x42\xb2\x50\x7b\xef\x1f\xda\x17\x0e\xed\x0b\x87\xf6\x85\x43\xfb\xc2\xa1\x7d\xe1\xd0\xbe\x70\x68\x5f\x38\xb4\x2f\x1c\xda\x17\x76\x6b\x5f\x38\x60\x3c\xff\xcd\x31\x9e\xde\x5f\x08\x09\xfc\xd8\xb2\xa3\xea\x4a\xff\x84\xd0\xe5\xc8\x2b\xf5\x9d\xec\x90\x9a\x81\x07\x59\x6c\xc3\x39\x07\xa6\xb7\x3c\x77\x41\xc7\x44\xae\x05\x20\x55\x46\x01\x25\xca\x99\x8a\x2f\x10\xa5\x04\xf2\xfb\x72\x47\xfb\xec\xd1\x0b\x8e\x7f\x06\x0e\x65\x98\x4c\x18\xa2\x25\x0a\xb0\x02\x6d\xca\x88\x28\x96\xb1\x15\x8f\x3e\x9a\x10\x95\x9f\x30\x43\x59\x6a\x6f\x49\xe0\xd8\x5e\x5d\x05\x80\x17\xe0\x7f\xdf\xa3\xa4\xc4\x56\x09\x82\x01\xd7\xcb\x78\xac\xbd\x50\x33\xd6\xc0\x6b\xb6\xb9\x60\xa3\x5a\xd8\x9d\xc6\x9e\x5b\x81\x2f\xc0\x71\x7c\x83\x7c\x0a\x81\xa5\x94\xf4\x32\x4a\x3b\xcf\x7f\xb5\x09\x91\xbf\xe0\x40\x62\x99\x5f\xcc\x44\x68\xf9\x0f\xf1\xba\xbd\x73\x66\xf5\x5f\x10\x97\x40\x37\x1e\x81\x4e\x1c\x02\x4d\xe5\x5d\x2a\xf4\x35\x30\xe7\x05\xfa\x9b\xa8\x88\x27\x4e\x8b\x83\x4f\x78\xb8\x4d\x1a\xfd\x9d\x5b\x7b\xe1\xdc\x6f\xd4\x62\x98\x43\xb2\x4c\x64\xfa\x00\x85\xe7\x89\x56\x86\xa6\x36\x02\xba\x3c\xfc\x0e\xcb\xd6\x61\x35\x97\x2c\x13\x06\xb1\xe3\xf7\x72\x57\xee\x58\xa9\x61\x9f\xaa\xd4\xf6\xd6\x74\x3b\xd6\x0d\x9c\x2c\xf3\x93\x4b\x0a\x83\x75\xda\xc9\x14\x85\x37\x7d\x43\xf8\x97\x3b\xa7\x3f\x64\xc1\xde\xbb\x62\xd8\x55\xcf\xb9\x0b\xba\xdc\xc1\x42\xd9\x51\xe3\x2c\xd1\x8f\x67\xc0\x75\x25\x49\x95\xe6\xad\x3a\x06\x1c\xdb\x19\xb2\xc8\x54\x26\x36\x74\x66\x0d\x8d\xeb\xff\xe4\x17\xb0\x4c\xed\x41\x46\xbf\x6b\x56\x17\x1e\xb4\x06\xa7\x8d\x01\x50\x3a\x5f\x6f\x4a\x19\x77\x37\x03\x4f\x9e\x77\xa2\x07\xce\x89\xa0\x73\xbe\x13\x2c\xa9\x7e\x69\x35\x2b\x31\xa9\x47\xcd\x57\x6d\x8d\x09\x1e\x04\x05\xc8\x50\xb3\x20\xfb\x97\x01\x5d\x94\x86\x00\xb9\x49\x8a\xdf\x26\x5c\xee\x82\x5f\x76\xe1\xa5\xb8\xd1\x46\x20\xc7\x61\x42\x18\xa7\x6d\x9d\x6a\xae\x2e\x70\x9d\xc1\x11\x85\xb0\x00\x35\xde\x70\x7c\xeb\x1e\x77\x89\x04\xd7\x42\x81\xb3\xc5\xa9\x39\x1c\x85\xb2\x78\x39\xfe\x69\xa4\xba\x73\x3d\x46\x32\x55\xa1\x55\x02\xec\x8f\x3b\x60\x8e\x4c\x06\xf5\x02\x51\x29\xc5\xb5\x33\x22\xd5\xaf\xcc\x6f\x70\x36\xc9\x7c\x45\xc1\x47\x72\x08\xbe\xee\xc1\x5a\xfd\x0c\x21\xb3\xb5\x42\x02\x98\xad\xf0\x1d\x93\x5d\x8a\x93\x28\x12\x40\xfd\x16\x3e\x88\xa0\xbd\x2a\x75\x4b\x07\xca\x75\x71\x41\x42\x22\x03\xd7\x95\x56\xf7\x6d\xf4\x74\x4b\x4b\x91\x47\xe6\xde\x16\xf2\x0d\x48\x8a\x01\x49\x31\x20\x29\x06\x24\xc5\x80\xa4\x08\x40\x52\x04\x12\xf3\x0c\x30\x8a\x3f\x05\x8c\xc2\xac\xfa\x24\xec\x9c\x74\x67\x74\x3b\x86\xc2\xc7\x71\xe9\x8b\xd5\x8e\xa1\x70\xce\x32\x59\xe4\x80\xa1\x18\x30\x14\x03\x86\x62\xc0\x50\x0c\x18\x8a\x01\x43\x31\x60\x28\x06\x0c\xc5\x80\xa1\xe8\x84\xa1\xb8\x14\xef\x92\xe1\xf7\x24\x0a\x65\xff\xca\x84\xbc\x0b\x44\xbd\xed\x05\x3d\x62\x29\x78\xdd\x9e\x51\xf1\x98\x58\xad\xe7\xd2\x00\x26\x38\x6b\xf2\xc6\x9d\xc3\xab\x2e\xa6\x6a\x46\x33\x1e\x5d\x64\x8b\x04\x68\xe3\xd3\xa5\x2b\x25\x08\xd3\x3c\x7f\xeb\x16\x66\x36\x22\xa9\xc4\xd2\xb6\x3e\x65\xa9\x10\x31\x44\x8f\x18\x14\xa3\x36\xee\x89\xe0\x19\x14\x2c\x11\xfc\xec\x05\x71\x03\x3d\x52\xb1\x20\x00\x14\x0c\xba\x63\x3a\xa7\x6e\x03\x7c\x54\xee\xce\x0d\xbf\x54\x79\x0c\x5b\x4e\xc1\x51\xbc\xc9\x79\x7a\xde\xc9\x85\x6b\x1b\x34\x77\x37\x25\x02\x77\xb6\xe9\x28\x93\xa9\x2e\x78\x92\x7c\x76\x53\x65\xa8\xe5\x8b\xcb\x44\xa6\x1b\xe2\x8c\xdd\xf9\x07\xdc\x4e\x3a\xdf\x13\xf5\xdc\x95\x65\x27\xf2\x0d\xb8\xa5\x7b\x91\xc3\xde\xc9\x79\x25\x7c\xf4\x79\xbe\x93\xeb\xc2\x7e\xee\x33\x47\x2f\x76\x63\x1f\xa9\xbf\xd6\xe4\x74\xd3\xf8\xb3\x42\xd9\xf9\x96\xf2\x13\x30\xba\xd8\x4c\xb7\x5e\x17\xc9\x5b\x01\x2b\x55\xd1\xa4\x2c\x6c\x52\x7d\xc7\x3f\x0a\x27\x96\x15\x4a\xb1\x84\xe7\x1b\xd1\xec\x5b\x3c\x1d\xf5\xb2\x43\x7b\x69\x41\x0f\xc0\x9a\x3b\xa4\xaa\x55\xf9\x13\x23\xb4\xb3\xeb\x03\x23\xf4\xd9\x36\x53\x11\x6b\x37\x48\xeb\xa9\xa6\xd2\x3e\x66\x3a\xcb\xa5\x82\x1e\xe2\xb7\x09\xd7\xba\xfd\xa0\x6b\xbc\xca\xc2\x3e\x0b\x89\x20\xed\x5f\x89\xc8\xce\xd7\x3a\x6f\x85\x4a\x44\x4e\x69\x4e\x4c\x8a\xcb\x34\x06\xbe\xb4\xd9\x4d\xc8\x6b\xb2\xa5\xff\x1e\xd8\x40\xbc\x28\x38\x2c\x00\x25\xae\x6f\x47\x28\xa0\xad\xce\x81\xc1\x75\xd8\x36\xf0\x47\x32\x10\xbb\xae\x45\x2e\xb3\x44\xb0\xaf\x3f\x8a\xc3\x04\xbd\xeb\x89\xc0\x00\xfa\x37\xad\xe2\x3d\x53\x80\x91\x07\x3f\x80\x6a\xf2\x42\xe5\xec\x6b\xf7\xb7\x6f\xa6\xa3\xfe\x42\x3f\x66\x64\xed\x9f\x3b\x9a\xce\x39\x3e\xc6\x64\x1a\x43\xd3\x7d\xf7\xde\x38\x1d\xc2\x7a\x98\xb4\x24\x09\xbe\xe7\xd4\x42\x14\x0d\x7a\x05\x7f\x85\x18\x99\xba\x40\x6d\xa8\x66\x48\x42\x6b\x0e\x3d\x4f\x12\xf5\x49\xc4\x36\x5c\x80\xbb\xed\xad\xb2\x47\x80\x98\xb0\x05\xc6\xc3\xab\xdf\x90\xc4\x83\xc7\xfb\x56\xcd\xef\x45\x54\x16\xa4\x88\x1c\xd9\x6c\xd8\xdb\x6e\xf0\x72\xfc\x68\x0a\xee\xaa\x45\x40\x37\xd0\xa1\x80\xaa\x6d\x45\x90\xcb\xac\x55\x87\xeb\xc0\xf9\x75\x81\x6b\x39\xe5\xed\x31\x1c\xef\x7c\x53\xa9\x19\x02\x84\x26\x95\x62\xbb\x9b\xfd\x1c\x1b\xca\xfe\x0f\x49\x22\x6e\xe3\x48\xed\x56\x32\x35\xfb\xd8\x02\x9f\x54\x6d\x94\x6e\xc9\x89\xf4\x46\xf0\x08\xf5\x95\x82\x16\xd4\xbd\x68\xf0\xaa\xbe\x73\x33\x54\xef\xe6\x0d\xde\x10\x10\xc0\x60\xe8\x40\xa5\x7a\x2b\x33\x82\x5c\xe6\x2e\x77\x38\x29\x53\xf6\x01\x5b\x84\xb8\x91\x99\x53\xc8\xcc\x3f\xcc\x17\x9b\xff\x5e\x52\x23\xf8\xb5\xac\xa8\x7d\xcc\x09\x82\xa5\xfe\xbd\x94\x7b\x9e\xc0\x8d\xa2\x50\x50\x5b\x14\x47\x3c\xa7\x2d\x07\x84\xea\x70\xb0\x13\xa6\x95\x8d\x5d\xe1\x0d\x09\x8a\x74\x9c\x19\xae\xb4\xb1\xed\xbe\x64\x97\x02\xd2\x2a\xc0\x3f\x21\xa3\x32\xe1\x39\x03\xdb\xb5\x01\x8c\x6c\xdf\x6b\x5e\x6d\x39\x32\xff\xdf\xd1\xe2\x2f\x8f\x25\xd4\xb5\x00\x76\x93\xa1\x40\x25\x88\xc5\xd7\x06\x8c\xf7\x91\x31\x60\xcf\x2d\x66\xd0\xee\x3f\x68\xb8\x80\xb6\x9b\x24\xd3\x1b\xc0\x49\x8d\x1a\xaa\x68\x90\x77\xc9\x4d\xaa\x72\x11\xbf\x70\xdf\x49\x0c\xd7\x7a\x2b\x36\x65\xdf\x7a\x24\xc3\xc4\x66\x99\x3c\xd3\x58\x0d\xef\x48\x93\xea\x54\xa6\x32\x92\x6b\x95\x63\x77\xec\xe7\xb1\xc2\x00\x97\xd8\xcb\x08\x48\x72\xfe\x4f\xe4\xb4\xc3\x0b\xb6\x4a\x2a\x80\xe9\x6c\x6f\x77\x56\x95\x5c\x2c\x2c\x24\x83\x6b\xf6\x15\x7b\x8e\xa2\x49\x32\xe5\x0e\x50\xb0\xbc\x10\xc9\xe1\x85\xe3\x5c\xd1\x07\x5d\x08\x12\xc6\xb6\x16\x06\xfd\xef\xff\x1a\xf5\x1d\xfc\xec\xd6\xce\xfe\x83\x6b\x8e\x5d\xcd\x3c\x0a\xea\x72\x36\xf9\x6b\x96\x9a\xba\x53\xc5\x19\x31\xf8\x06\x63\x79\x6c\x72\x93\x9c\x35\xaf\x48\xa5\xdc\xd1\xe4\x15\xfa\xff\x61\x6f\x40\x73\xc6\x0d\xda\x0b\x63\xf1\x7b\xb6\x16\xbd\x86\x30\xc0\xf4\x64\x2a\x51\x9b\xc3\x5d\x06\xa8\xb6\x5b\x95\xea\x22\x47\x0b\x39\x1b\x91\x97\xec\x07\xf5\xe9\xc8\x43\x41\x61\x8c\x47\xb9\xd2\x76\x21\xed\xd7\x9c\x15\xca\x58\xac\x76\x5c\xa6\x1a\xc0\xa8\x59\xc2\x23\x77\xbd\x8d\xaa\x61\x39\x27\x62\x2b\x37\xdb\x6b\xbe\xe7\x32\xe1\x2b\x99\xc8\xe2\x30\x6a\xcf\xfa\xf5\xed\x24\xd8\x77\x72\xaf\x5b\x8d\xb2\xee\xe9\xb4\x27\x48\x4e\x3a\xf4\xe7\x5d\x76\x8a\x4c\x18\x80\xa1\x70\xc2\xa7\x5c\xfc\x61\xda\x97\x7e\xf5\xe4\x82\xd3\x14\xb5\x75\x54\x2d\x1f\xc8\x72\xb5\x13\xc5\x56\x94\x8f\x2c\x71\xbb\x13\x14\x4b\x0d\xc5\x0c\xef\x05\xec\x52\x60\xd3\x58\x20\xa3\x78\x99\x9f\x8d\xe9\x34\x14\xe6\xb5\x80\x48\xde\x63\x01\xaa\x6a\x88\x10\x9f\x19\xb5\xd6\x55\xe5\x7e\x24\xb0\xf1\x20\x12\x8d\x63\x01\xf0\x42\x1a\xf3\x3c\x9e\x60\x1c\x47\xee\xf8\x06\x1d\xae\xb3\xfd\x25\x23\xec\x35\xcd\xf2\x32\x05\xcd\xe1\x2c\x55\xe9\x75\xae\x54\x01\x91\xe4\xc7\x0d\x3d\x25\x5c\x9e\xa9\xf8\x9d\xe3\x00\x23\x4e\x93\xff\xbc\x8b\x18\xb9\xdb\xe8\xf9\x59\x1b\x7d\x9e\x83\x5b\xeb\xb6\xfd\x54\x22\x41\x37\xd5\x90\x02\xd9\xe0\x5a\xb7\x8b\x75\xe7\xd3\xfd\x8c\x3e\x9c\xf9\x09\xd6\xc3\xa3\x81\xd9\xa4\x9f\xc8\xdb\xac\x5e\xd3\xbe\x2b\x60\xac\xb5\x6a\xed\xa1\xe6\x3d\x5b\xeb\x79\xba\xff\xc0\x9b\xce\x51\xda\x8d\xc8\xd1\x0a\x30\x46\xdb\xf7\xde\x6d\xb3\xab\x34\x2d\xb4\x61\xc9\xd6\x30\x5b\x4b\x4d\xc7\xa9\xf7\x0a\x40\x94\x5c\xbe\xf3\x4f\xb7\xbb\xe1\xf8\x83\x7d\x97\x33\x44\x9a\x04\x99\xec\x04\xd9\x26\xf0\x07\xed\xa5\x2a\xb5\x4b\x19\xd6\x75\x83\x24\xd3\xcd\xb3\x4f\xb3\xf8\xcd\x80\x4e\x32\x44\x00\x6d\xf3\xfd\xee\xa2\x2d\xd4\xcf\xfd\xec\xec\x36\x92\x09\x69\x95\xec\x43\x40\x29\x7e\x0a\xdd\x78\x65\x0a\xbd\x88\x6d\x96\xdc\xa3\x52\xd2\x68\xcb\xd3\x0d\xb5\x14\x0c\x02\xa7\xf5\x05\xd1\x87\xb4\xe0\xf7\x0e\xe6\x21\x74\xc4\x33\x11\x1b\x64\x1d\x67\xb1\x2a\xa9\xc4\xa9\xcf\x9e\x4d\x98\x14\x33\xf6\xac\x26\x7c\xca\xe6\x56\x5e\x4d\x1b\x60\xdc\x24\x89\x29\x3a\x73\xab\x8a\x78\x15\x2e\x9d\x1b\x9e\xc7\x48\xf4\xa4\xd6\x8d\x4c\xb4\x9b\x70\x92\x64\x81\x6e\x05\xc0\xa6\x52\x55\x34\x80\xe0\xec\xea\x6a\x3a\xbe\xc8\x56\x6a\xef\x9a\x78\x62\x3b\xd9\x86\x84\x70\x4d\x78\xcc\x68\x10\x33\xd0\x36\x4e\x74\xeb\xd5\x11\xc0\x4c\x4c\xae\xad\xeb\x66\x3d\x71\xf4\x97\x28\x7a\x14\x62\x2b\x1d\x24\x1d\x8b\xf7\xfb\x6d\x29\xec\x39\x01\x68\xba\x1f\x3e\xee\x80\x00\xee\xc9\x31\x2f\x3d\x84\xc7\xb6\x40\xa3\x0e\x34\x58\xc9\x42\xab\x0d\x4f\x0e\xf7\x0c\x57\xc6\xd0\xbb\xf7\x4f\xd0\xbb\xd7\xef\x97\x00\xa1\x43\xfb\x5e\x68\xdf\x0b\xc8\x3c\x91\xc4\x5d\x6d\xd7\xb8\x32\x5e\x8d\xf2\x8d\x4c\xc5\x33\xc7\xc9\x4c\xd7\xf0\x9d\x28\x78\xcc\x0b\x3e\x85\x8d\x31\x69\xfe\x88\x48\x96\x09\xfb\xcd\xff\xd2\x34\x83\xfb\xdb\x78\xfc\xf5\x8f\xf3\x5f\xbe\x19\x8f\xff\xfe\x1b\x5d\xe7\x2b\x29\x35\xe7\xab\x21\xca\x00\x1c\x20\x07\x0e\xa6\x83\x2e\x19\x1f\xb3\xb7\xad\x9b\x28\x82\x0a\x49\x14\x00\xce\x71\x51\xea\x29\xb4\xb5\x78\xb5\xf0\x3f\x66\x2a\x7e\xb5\x08\x90\x5e\x7b\x4a\x4f\xc7\x17\x3c\x1c\x2a\x4b\xd2\x79\xa3\xda\xe7\x9d\x4a\xd8\xb0\x12\xfc\xf5\x7b\x50\x15\x02\x6d\x7a\xf3\x8f\xd4\x0c\xda\x40\x14\x22\xc5\x7b\xa3\xc8\x77\xe0\xba\x4d\x5c\xe4\x1d\x82\xad\xec\x6a\xff\xf2\xea\xe2\x47\xd1\xda\x0d\xbf\xf3\xd4\x2c\x2a\x1c\xad\xdd\x37\xfe\x20\x0d\x10\x59\x15\x49\xbb\x3c\x32\xbb\x59\xbc\x62\x7b\x33\xef\x17\x9e\x86\x70\x1b\xe6\xa7\xed\x52\x96\x2c\x17\x86\xbe\xfd\xfb\x9e\x2c\x9a\x93\xe7\x56\xca\xbb\x55\x54\xc1\x8c\x61\x2b\x13\x27\x47\x1b\x9e\x0b\xc0\x64\x40\xa7\x93\xdf\x4b\x01\xb7\xf5\xe7\xe6\x97\xd3\x28\x2b\xe9\x86\xc0\x3e\x63\x98\x3d\x26\xee\x47\x91\x6d\xc5\x4e\xe4\x3c\xb9\xb6\x98\xca\x89\xff\x9a\x30\xf1\xfe\x29\x4b\x1d\x52\x1f\xf0\xc3\x6f\x79\x41\x96\x0b\x21\xfa\xa8\xcc\x81\xa9\x2c\x39\x54\x9c\xfd\x17\xb5\x64\x7e\xd5\x42\xb0\xb2\x0f\xd5\xc2\x87\x5b\x90\x4d\x79\xe6\xcf\x70\x88\x75\x8e\xc2\x0b\x31\xf4\xc4\xdf\x84\x40\x02\x38\xe6\xe0\x6c\xeb\xf1\x65\x77\x2d\x44\x93\xf7\x52\xd3\x92\xfc\xee\xbf\x20\x7a\xa2\xee\x14\x45\x9d\x69\x8a\x1e\x2c\xd6\x9d\xb5\x87\x36\x15\x51\x16\x10\x5e\x30\x29\xc7\x00\x91\x3e\xde\x2d\xee\x33\x05\x5e\xa5\xdf\xc7\x8d\x33\x27\x48\xe2\xd5\xcb\xab\x80\xcf\x3f\x79\xca\x99\x9e\x68\x67\x9a\xe6\x7b\xd6\x75\xd9\xc7\xef\xdd\x99\xe4\x65\x55\x0e\xea\xf8\xa9\x1d\x87\x6e\x88\x97\x3a\x0d\x0d\x8c\xbe\xdf\xb8\x44\xa3\x50\x80\x28\x13\x33\x31\x63\x5d\xd5\x09\x5c\xd0\xd6\xf7\x11\xd2\x68\xd6\x44\x74\xbb\x97\x41\x17\x1b\xd7\xf0\x0f\x43\xda\x7b\xc4\x31\x59\x99\x1f\x05\x09\xc8\xf3\x19\xda\x38\x84\x4b\x86\x70\xc9\x99\x70\x49\x20\xa3\xc2\x10\x2b\x31\xb1\x92\x80\x8f\x3f\xdd\xda\x21\xcc\xbd\xb6\x07\xed\x9b\x3a\x64\xdd\x17\xb5\x3e\x19\xaa\xaf\x52\xb1\x67\x45\xb2\xa6\x13\xd5\x7f\x96\x15\xde\xca\x66\x16\x8e\x30\x88\xde\x8f\x6b\x91\x08\x3c\x40\x50\x1b\xaf\xd6\x55\x08\x5e\x8f\xfa\x3b\xa7\x7c\xba\x80\x78\x26\x37\xde\x70\xd9\x20\x0b\xf6\x07\x13\xb5\x63\x61\xe8\x79\x1a\x72\x82\x50\x4f\x8f\x81\x98\xfa\x8b\x24\xa6\xde\x85\x10\x42\x04\x9f\x0c\xa1\xa7\x42\x80\x19\x06\xad\x17\x6b\x79\x3f\x1b\x05\xce\xc7\x4d\xea\xe7\x93\xc9\x58\xa4\x05\x94\x69\x60\xa1\x62\x06\x4d\xfc\x52\x52\x11\x0e\xfc\x71\xe4\x48\x2e\x54\xe7\x27\x75\x5a\xbb\x1d\x5e\x14\xf0\x60\x2e\x9d\x5d\x0d\xce\xdd\xd1\x35\x78\xb0\x36\x83\xb5\xb9\x88\xb5\xb1\x8a\xf6\xc5\x9a\x9a\x9e\x2f\x69\x26\xbb\xf5\x54\xf0\x80\xb6\x32\xf4\x22\x50\xc0\x04\x6a\xc2\x85\xd6\x8b\x5c\xad\xc4\x8c\x3e\xa6\xa5\xdc\xc9\x74\xe3\xd1\x7b\x4e\x0c\xc4\x0d\x56\xde\x20\xd0\x6e\x9b\x74\x63\xb5\xe6\x32\x29\x73\xb1\xdc\xe6\x42\x6f\x55\x42\xf0\x4a\xc2\x08\x5f\x42\xe2\xa6\x50\xa1\x2d\x79\xf2\x9d\x48\xf8\x81\x5c\xbd\x73\xb9\xe1\x98\x3a\x9f\x27\x30\x10\x5d\x22\x4d\xc0\x93\x58\x23\x28\x67\x52\x65\xf1\x87\xcf\x0a\x71\x2f\x42\xf1\x84\xec\x61\x33\x7a\x39\xc3\x6e\x1c\x76\xe3\xb0\x1b\x3b\xef\x46\x6c\x64\xa1\x03\xf6\xe1\xdb\x72\xb7\x02\xe2\xb5\xb5\x7f\xd8\xed\xbd\x58\x64\x89\x3a\xb4\x02\x7f\xe9\xaf\x4c\x7d\xdd\x7d\x8d\x2b\x3c\xe0\x4d\x3e\xd4\xe9\xfd\x1f\xc1\xfa\xf7\x1c\x42\xaa\xb1\x9a\xdb\x7f\x58\x41\x5d\xb6\x19\x83\x25\x79\xe1\x96\xfb\xbc\x45\x2e\x73\xfc\xc9\xbc\x1a\x6d\x9b\x67\x47\xb7\x7b\x50\x54\x56\xa6\x05\x15\x82\xd2\x78\xc9\x45\xc5\xeb\xd9\x9c\x4c\x60\xf6\xa1\x77\x0b\xaa\xd1\xe9\x56\x65\x89\xb6\xaf\x81\xcb\x7c\x84\x32\xa2\x8e\x67\x24\x7a\xb4\x20\x5f\x05\x87\x04\xd7\x63\x0e\xe5\xaf\x2a\x0d\x9e\xaf\x63\x01\x2c\x16\x05\x30\x22\x41\xe1\xc8\x56\x7d\xb2\x0a\x4a\x90\xca\xb0\x78\x3d\xb3\x92\x80\xec\x26\x57\x3b\x5c\x02\xc0\x84\x81\x6e\xd7\x96\x82\xc8\x05\x00\x4f\x1b\xaf\xf1\x13\x3f\x30\x9e\xab\x32\x8d\x0d\xff\x44\x55\x80\xfc\xe6\xe8\x05\xde\xaa\x94\x96\xc5\x91\xba\xc6\x0c\x69\x61\x4a\x52\x03\xb1\x27\x87\x68\xc6\xcb\xe9\xcb\xaf\x7a\x5f\x2e\x6a\x18\xa0\xb1\x44\xc0\x5c\x69\xb2\x12\x58\x76\x8b\x73\xea\xa2\x02\x6e\xbf\xf6\x3e\xd2\x90\x56\x0c\x8d\xd1\xbe\xb1\xcd\x3f\x40\xc0\x35\xe2\x83\xe4\x1a\x13\xe5\x00\xd6\xe1\xf1\x35\x60\xdc\x68\x0b\x54\x95\xff\x3e\xc7\xd6\x0b\x00\x68\xad\x31\x73\xbf\x08\xa7\x82\x27\x77\x70\x08\x73\xa4\x75\xb9\xea\xc5\x56\x59\x83\x03\x21\x28\xcb\x1d\x4d\x4d\x43\xfb\xad\x35\xd6\x67\xcc\x96\x9b\x2e\x92\x48\x40\x1b\x5e\xb1\xe7\x46\x1a\x30\x5d\x28\x55\xbc\xe8\x5d\xcd\xec\xd4\xcd\xef\xb3\x70\x5e\x8e\xb9\xab\x35\xca\x1e\x9f\xc7\x11\x9d\xa7\xbb\x71\x5a\x9c\x9b\x47\x92\xcc\x6f\x05\xb0\xcb\x69\xa6\xe5\x4e\x26\x3c\x4f\xb0\x96\xe0\xce\xbc\x2d\x5b\x95\xc5\xc9\x4c\x13\x49\xf2\xe3\x15\x5a\x27\xaa\xaf\x82\xb5\xa7\x36\xac\xc6\xfe\x6a\x28\x03\x6d\x9c\xa8\x30\xee\x9d\x61\x85\x11\x18\xe8\xe6\x00\x4e\x8b\x5d\x59\x94\x3c\x49\x0e\x4c\xdc\x47\x49\xa9\xe5\xbe\x6f\x3b\x46\xcf\x53\xfa\x1b\xc7\x13\xcc\x68\x5a\x48\xdd\x6c\x44\xde\x1a\xe6\x50\x08\x8d\x68\x85\x5f\x29\x6f\xdc\x2e\x79\xf0\x45\x13\xdc\x7e\xb6\x4e\xbe\x45\x24\xab\x25\x31\x75\x05\x9b\x74\xb4\x1b\xca\x95\x2d\x4f\x19\x9c\x48\xf0\x61\xdd\x6e\x16\x9d\x38\xd0\x33\xa0\x9a\x71\x8e\x82\xc1\x69\x63\x35\x1f\xfb\xb4\x55\x89\x37\x17\xe6\x1f\x5a\xe5\xae\x04\x6c\xad\x26\xa5\xdf\x5e\xe4\x87\x1a\x6b\x9f\x3f\xb8\xfa\xbc\x12\xfb\xdc\x6a\xfb\x47\x8f\x16\xc9\xe4\x64\xb0\xbd\xf3\xd0\xa0\x67\x68\xd0\x33\x34\xe8\x19\x1a\xf4\x0c\x0d\x7a\x02\x1a\xf4\xf8\x9c\x36\x59\xb4\xab\xf1\x1e\x7a\xf4\x7c\xd1\x3d\x7a\xfc\xc2\x87\x35\xa4\x70\xf7\x09\x42\x9b\x1e\xb2\x4c\x97\x8f\x9e\x12\xda\xf4\x90\x85\xda\x7b\xff\xd0\xa6\x67\x68\xd3\x33\xb4\xe9\x19\xda\xf4\x0c\x6d\x7a\x86\x36\x3d\x43\x9b\x9e\xa1\x4d\xcf\xd0\xa6\xa7\x5b\x9b\x9e\x01\xe3\xf9\x6f\x8e\xf1\xf4\xfe\x42\x48\xe0\xc7\x96\x1d\x55\x57\xfa\x27\x84\x2e\x47\x5e\xa9\xef\x64\x87\xd4\x0c\x3c\xc8\x62\x1b\xce\x39\x30\xbd\xe5\xb9\x0b\x3a\x26\x72\x2d\x00\xa9\x32\x0a\x28\x51\xce\x54\x7c\x81\x28\x25\x30\x3c\x97\xbb\x4e\xcb\x3f\xfe\x19\x59\xfd\x0f\x19\x42\x31\x2d\x51\x80\x15\x68\x53\x46\x44\xb1\x8c\xad\x78\xf4\xd1\x84\xa8\xfc\x84\x41\xbb\x42\x7f\x4b\x02\xef\xf4\xea\x2a\x00\xbc\x00\xff\x7b\x22\xfe\x52\x0b\xdf\xef\x64\x3c\xd6\x5e\xa8\x19\x6b\xe0\x35\xdb\x5c\xb0\x51\x2d\xec\x4e\x63\xcf\xad\xc0\x17\xe0\x38\xbe\x41\x3e\x85\xc0\x52\x4a\x7a\x19\xa5\x9d\xe7\xbf\xda\x84\xc8\x5f\x70\x20\xb1\xcc\x2f\x66\x22\xb4\xfc\x87\x78\x0d\x5c\x14\x54\x25\x09\xe2\x12\xe8\xc6\x23\xd0\x89\x43\xa0\xa9\xbc\x4b\x85\xbe\x06\xe6\xbc\x40\x7f\x13\x15\xf1\x84\xd9\xd9\x0d\x3e\xe1\xe1\x36\x69\xf4\x77\x6e\xed\x85\x73\xbf\x51\x8b\x61\x0e\xc9\x32\x91\xe9\x03\x14\x9e\x27\x5a\x19\x9a\xda\x08\xe8\xf2\xf0\x3b\x2c\x5b\x87\xd5\x5c\xb2\x4c\x18\xc4\x8e\xdf\xcb\x5d\xb9\x63\xa5\x86\x7d\xaa\xd2\xa6\x2c\x3f\x70\xb2\xcc\x4f\x2e\x29\x0c\x5b\x6b\x27\x53\x14\xde\xf4\x0d\xe1\x5f\xee\x9c\xfe\x90\x05\x7b\xef\x8a\x61\xeb\x28\xe7\x2e\xe8\x72\x07\x0b\x65\x47\x8d\xb3\x44\x3f\x9e\x01\xd7\x95\x24\x55\x9a\xb7\xa2\xc5\x3e\xb6\x33\x64\x91\xa9\x4c\x6c\xe8\xcc\x1a\x1a\xd7\xe4\xc4\x2f\x60\x99\xda\x83\x8c\x7e\xd7\xac\x2e\x3c\x68\x0d\x4e\x1b\x03\x20\x4e\xbe\xde\x94\x32\xee\x6e\x06\x9e\x3c\xef\x44\x0f\x9c\x13\x41\xe7\x7c\x27\x58\x52\xfd\xd2\x6a\x56\x62\x52\x8f\x9a\x63\xda\x45\x8c\x02\x82\x02\x64\xa8\x59\x90\xfd\xcb\x80\x2e\x4a\x17\x22\x2d\x4c\x52\xfc\x36\xe1\x72\x17\xfc\xb2\x0b\x2f\xc5\x8d\x36\x02\x39\x0e\x13\xc2\x38\x6d\xeb\x54\x73\x75\x81\xeb\x0c\x8e\x28\x84\x05\xa8\xf1\x86\xe3\x5b\xf7\xb8\x4b\x24\xa4\x76\x81\x39\x5b\x9c\x9a\xc3\x51\x28\x8b\x97\xe3\x9f\x46\xaa\x3b\x47\xa4\x9f\xa9\x0a\xad\x12\x60\x7f\xdc\x01\x73\x64\x32\xa8\x17\x88\x4a\x29\xae\x9d\x11\xa9\x7e\x65\x7e\x83\xb3\x49\xe6\x2b\x0a\x3e\x92\x43\xf0\x75\x0f\xd6\xea\x67\x08\x99\xad\x15\x12\xc0\x6c\x05\x7b\x6f\x65\xb9\x14\x27\x51\x24\xe4\x68\x2c\x7c\x10\x41\x7b\x55\xea\x96\x0e\x94\xeb\xe2\x82\x84\x44\x06\xae\x2b\xad\xee\xdb\xe8\x99\xc2\xd1\xd9\x28\x70\xee\x6d\x21\xdf\x80\xa4\x18\x90\x14\x03\x92\x62\x40\x52\x0c\x48\x8a\x00\x24\x45\x20\x31\xcf\x00\xa3\xf8\x53\xc0\x28\xcc\xaa\x4f\xc2\xce\x49\x77\x46\xb7\x63\x28\x7c\x1c\x97\xbe\x58\xed\x18\x0a\xe7\x2c\x93\x45\x0e\x18\x8a\x01\x43\x31\x60\x28\x06\x0c\xc5\x80\xa1\x18\x30\x14\x03\x86\xa2\x3b\x86\xe2\x9f\xec\x7d\xef\x6f\xdb\x46\xd2\xff\x7b\xfd\x15\x0b\x7f\x0b\x28\x01\x64\x7d\x1b\x5c\x71\x2f\x7c\x45\x01\xd7\x71\x70\x7e\xda\x24\x46\xec\xa4\xb8\x3b\x1c\xae\x6b\x72\x2d\x6d\x43\x71\x59\x2e\x29\xdb\xfd\xeb\x1f\xcc\xee\xcc\x92\x94\x65\x72\x56\xa1\xae\x6e\x1f\x45\x7d\x51\xdb\xe4\x68\x7f\xce\xef\xf9\xcc\x21\x87\xe2\xff\x74\x0e\xc5\xbe\x70\x97\x3c\xbe\x27\x93\xa8\xf8\x6f\x06\xe4\xc9\x11\xf5\x6e\x94\xec\x11\x84\xe0\xa5\x3b\x63\xd2\x29\xb3\x5a\x8f\xc2\x00\xde\x39\xeb\xe3\xc6\x3b\xbb\x57\xc9\xa7\xea\x47\x33\x9d\xec\xe5\x8a\x44\x9c\xc6\xe7\x0b\x57\xca\x20\x46\x40\xda\x3d\x5a\x35\xcf\xa9\x98\x0d\x07\xb2\xf9\xde\xc9\xa4\xa8\x87\x1e\x89\xda\x51\x1f\xd9\x1c\x91\x24\x73\x9b\xb0\xed\xc3\x61\x59\xba\xcb\xe2\x6f\xf0\xa9\xc3\xb6\x19\xf6\x3d\xab\x7c\x28\x8d\xe6\xd8\x05\x29\x7e\x82\xda\xff\xf7\xf9\x00\x46\xfa\x71\x08\x68\xbc\x95\xf9\x03\x97\xec\xe0\xb3\xcc\x25\xf2\x33\x3f\x93\x85\x4c\x7a\x1b\x10\x47\x93\xfc\xf1\xf7\x86\xbc\x8b\xda\xfa\x61\x71\x14\x35\xf9\x2b\x2f\x19\xce\x32\x69\xed\x08\x64\x19\x53\x81\x72\xde\xb4\xce\x74\xbe\x38\x99\xb0\xe4\xe8\x55\x78\x81\x84\x69\x7f\x5b\xe4\x3e\xab\x65\xa5\xca\x05\x78\xa6\xd6\xaa\x04\xf1\x59\xca\x86\xf8\xe4\xcb\x98\x0c\x75\x1b\x3f\x99\xb0\x15\x84\x53\x7c\xa5\x3d\xad\xd9\xf6\xe6\xe8\xbd\x44\x45\x7f\xeb\xf4\x19\xe8\x5d\xa5\xda\x28\x8d\x96\x83\x39\x6b\x4d\xdd\xb4\xae\x30\xaf\x66\x25\x3f\x2b\x22\x2b\x2a\x63\x44\x26\xcb\x85\x4b\xdc\x06\xe3\x02\x92\x82\x86\xd2\x4a\x98\x67\x7d\xa4\x56\xeb\x90\x5b\xe7\xfb\x2a\x99\xf2\x77\xbc\xe0\xdb\x30\x2d\x71\x7f\x60\x84\x21\xe0\xee\x8b\xe2\xf1\x82\x0c\x2a\xb6\x26\x1f\x63\xa5\x8b\x52\x1b\xe8\xa2\xee\x38\xc0\x30\x73\xe9\x4c\xe5\x12\xdf\x85\x58\xb0\x0d\x53\x62\x02\x74\x0e\xae\x5b\x65\x32\x55\x72\xfa\x93\xb3\x5c\xb3\x9d\x81\x5f\x63\x82\x03\xa4\x36\x88\xeb\xf0\x3d\xe0\xbb\x96\x55\x25\x61\x03\x38\xa1\x3d\x1c\xa1\x82\xce\x5a\x0f\x02\x2c\x62\x88\x8c\x49\xc4\x03\xc2\x7d\xad\x4a\x5d\x64\x4a\x7c\xfb\x59\x3d\xcc\x9c\x83\x6d\xa6\x5c\x0c\xed\xbb\x41\xf2\x01\x2c\xc4\xd3\x83\x1f\xe0\x68\xca\xca\x94\xe2\x5b\xfa\xbf\xef\x86\xcc\x5d\xbe\x8e\x24\x84\x1f\xd9\xf0\x73\x1b\xcb\x79\xee\x5e\x13\x3a\x4f\x75\x22\x2b\x9a\xb7\x5b\x0e\x85\xf1\x42\x5e\x9c\xd4\xcd\x73\x8e\x59\xca\x3e\x81\xcd\xfd\xca\xa5\xc9\xb5\x09\x5a\x8f\x36\xc5\x22\xda\xf2\xe9\xc9\x2c\x33\x77\x2a\x45\x8f\xa1\xbb\x6d\xef\x0c\x8a\x00\x35\x13\x97\x2e\x24\xd6\xfc\x86\x45\x1e\x9c\x5e\xef\xcc\xf9\xbd\x4a\xea\x8a\xe5\x94\x67\xb3\x0d\x34\x78\xa3\xb7\xe3\x07\x80\xfd\x6e\x6f\x82\xf3\x04\x51\x22\x60\x73\xad\x18\x74\x05\x72\x75\x88\x74\xf7\xef\x0b\x58\xe6\x9c\xd9\xbb\x88\x1c\xb9\xa7\xb4\x15\x2e\x47\x70\xd6\x1c\x6c\x32\xee\xcf\x5d\x4f\xe9\xbf\xb1\x28\xba\x6b\x9c\x98\xd5\x8d\xce\xfd\x3d\xc6\xdc\x47\xd3\x1a\x25\x6d\x39\x13\xe1\x0c\x5e\xe1\x4e\x29\x6a\x43\x69\xa2\xd1\xbb\xfa\x9e\x56\xa8\xdd\xd0\x1f\x1c\x22\x80\x01\xe5\xbc\x87\x26\xb7\x4b\x5d\x30\xe8\x0a\x02\x84\x71\x8b\x32\x17\x9f\x5c\x97\x20\x1a\x99\x97\x42\x7e\xfd\x61\xbd\xc4\xf9\xaf\x35\x37\x88\xd7\x4a\x8c\xc0\xd7\x88\x10\x6c\xf5\xaf\xb5\x5e\xcb\x0c\x34\x8a\xca\x40\x79\x61\x9a\xc8\x92\xb7\x1d\xe0\xad\x77\x83\x9d\x09\x6b\xd0\x7d\xed\x34\x24\xa8\xd3\x23\x36\xdc\x9c\xc6\x21\x7d\x09\xb7\x02\x22\xab\x00\x41\xa3\x93\x3a\x93\xa5\x00\xde\xb5\x80\x34\xf9\xb1\xf7\xbc\xb9\x72\x6c\x08\xd0\x8d\xcd\xbf\xde\xa4\xd0\x3e\x05\x70\x9b\x3c\x0a\x32\x83\xac\x9b\x36\x94\x79\x6c\x30\x03\xf1\x02\xd3\x86\xf1\xfe\x41\xcf\x15\xc7\xbb\x59\x34\x03\x03\x9c\xb5\xd0\xe1\xaa\x0e\x7e\x9f\x5e\xe4\xa6\x54\xe9\x4b\xfa\x4e\x66\x32\x4e\xe0\x62\x73\xf1\x7d\x48\x66\x9a\x61\xa0\x39\x80\x0d\xb6\x52\x9e\x79\x54\xe9\xc8\x34\x4c\xf2\xd6\x94\xae\x41\xfe\x8b\xd4\x38\x1f\xb7\x5a\xeb\x04\x70\xb2\xfe\xa9\x4a\x9e\xf0\x82\xab\x92\x2b\x00\x3b\x5c\xe3\xcd\x6a\xf2\x0b\x2a\xcc\xca\x92\x56\x7c\x2d\x5e\x38\xd2\x2c\x9a\x7a\x05\x49\xf5\xb2\x52\xd9\xc3\x4b\x82\x5d\xb2\x0f\xb6\x52\xac\x34\xfb\x56\x24\xe4\xaf\xdf\x4c\xc6\x8e\x7f\xb8\x29\x46\x9f\xe4\x4f\xd4\x1f\xbf\x59\x79\x47\x68\x17\xd9\x14\xd4\x2c\x33\x27\xa9\x42\x4c\x0c\xbe\xc1\x73\x1e\xcc\x6f\x60\x27\xce\x34\xb8\x72\x24\x9a\xc2\x81\xfe\x05\xee\x06\xf4\x67\x5d\x38\x7e\xe1\x39\xfe\xc8\xdc\x62\x54\x2f\x26\xb0\x9e\xc2\x64\x66\xf1\x70\x55\x40\x62\xeb\x99\xc9\x6d\x55\x3a\x0e\x79\x32\x61\x6f\xd9\xdf\xcd\xdd\x86\x85\xe2\x88\x09\x99\x94\xc6\xe2\x46\xe2\xd7\xf4\x12\x15\x22\x35\x2b\xa9\x73\x0b\xf9\xe8\x45\x26\x13\x52\x6f\x93\x66\x58\x64\x44\x2c\xf5\x62\x79\x2c\xd7\x52\x67\xf2\x46\x67\xba\xea\x77\x21\xf9\xc0\xff\xd8\x46\x02\xce\x89\xa6\xdb\x8c\xb2\x6d\xe9\x0c\xc7\x48\xb7\x1a\xf4\xfd\x26\x3b\x87\x26\x0c\xc0\xa3\xb8\xb9\xb7\xc8\xff\x30\x1f\xeb\x7c\x8d\x64\x82\xf3\x0e\xea\xe0\xa8\x06\x1e\x70\x43\x7b\x42\xa7\x1a\x36\x80\x64\x5d\x19\x9b\xc8\x28\x9f\x94\x84\xf4\xda\xb6\x03\xca\x0f\xc1\x27\x16\x49\xb1\x34\xa5\xfe\x0d\xa2\xa4\xd9\x40\x8d\x24\x7d\xb7\x2a\xe7\xe2\xdc\xe1\xd5\x21\x82\xb6\x28\xeb\xdc\xef\xb2\x0b\x48\xa3\x94\x86\xf4\xa7\xd2\x64\x99\x2a\x7b\x4f\x9e\x97\x87\x4e\x39\x5a\xaa\x5c\xc8\xa4\x82\xdc\x60\x99\x6f\xd2\x0a\x4d\x91\x67\x42\x2d\xe6\xe2\xa6\xd6\xbd\xf5\x91\xba\x12\xd5\x9d\x4e\x14\x78\x94\x72\x51\xd4\x37\x99\xb6\x4b\xc8\xab\x73\x4a\x99\x76\x5a\x9e\x83\x96\x05\x49\xec\x44\xaa\xc8\x8c\xec\x75\x07\x48\x01\x56\x6e\xa6\x08\x38\x1c\xb2\x06\x40\xfc\xba\xe5\x9c\x4f\xbe\xcc\xaa\x55\x39\xd4\xa8\x0d\x84\x97\x3a\x1b\x7b\xee\xde\x70\xcb\xde\x3a\x15\x74\xc9\x82\x4b\xac\x97\x20\x37\xd2\xb8\x92\xf7\x1f\x10\x2d\x3d\x62\x84\x1f\x8b\x42\x95\x58\xce\x85\xc3\xca\x1f\xa3\xaf\x53\xf5\xe7\x90\x92\x55\x19\xf1\x97\x97\x13\xa6\x12\x31\x90\x2b\x8b\xe5\x76\x27\xe2\xd5\x64\x0c\x2d\x63\xa5\xf3\x1d\x96\xe7\x47\x73\x37\xee\xf2\xbc\x7a\xa6\xcb\x53\x81\xeb\xb5\x3a\xbb\xfc\xf8\xb1\xd2\x99\xfe\xcd\x5d\xe9\x4b\x55\x26\x2a\xaf\xe4\x22\xc6\x8b\x77\xed\x08\x09\xb9\x56\x10\x09\x10\x67\x97\x1f\x45\xdd\x90\xa4\x45\xa4\xa5\x7b\xce\x8b\xe1\xab\x9c\x47\x5e\x0f\x2c\xed\x7c\xbc\x24\xbd\x14\x9b\x2e\x0a\xcf\x71\xc1\x06\xa4\x29\x56\x02\xe4\x2a\x81\x15\xb9\x34\xa6\x27\xed\xa3\xb3\x74\x67\xe1\x25\x01\x6f\x89\x42\x96\x72\x05\xb8\xf5\x58\xda\xa2\x73\xb1\x21\x2f\x9f\xa4\xeb\x58\xd3\x4a\xe6\x70\x24\x51\x47\x44\xd2\x96\xbc\x17\xa9\xac\xe4\x8d\x04\x43\x53\xaf\x82\xc5\x25\x7b\x0b\xdc\x75\x2e\x56\x3a\xcb\xb4\xf5\x76\xf8\x17\x8a\x97\x66\x4c\xd7\xbe\xdf\x4e\xc4\x29\xa3\xc2\xe7\x86\x39\xb5\x07\xe6\x5d\x1c\xfc\xb5\x0a\xd9\xe3\x77\x52\x7b\xb9\x2b\x5b\x2b\xd6\x40\xfe\x17\xc6\x64\x23\x9c\x1f\x21\x74\x9a\xa9\xdd\x27\xdd\x54\xb8\xa3\x27\x42\x56\x9d\x01\xf7\x52\x14\xa0\x65\x90\x27\x17\x8a\xa5\xc0\x17\x90\x66\x8a\xd2\xda\x47\x9b\x63\xa6\xe4\xe7\xd7\xaa\xc2\x1d\xe6\x35\x92\xe9\x4c\x77\x68\x9a\x21\x43\xb2\x7e\xea\x16\xd2\x3f\x64\xc2\x30\x35\x71\xa3\xc0\x55\x01\xfd\x41\x94\x75\x55\xf3\x60\x43\x98\x05\xc4\x35\xd1\x03\x0f\x1a\xcb\x10\x60\x72\x61\xac\xd5\xa0\xea\xb4\x86\x03\x13\x1e\x65\xe5\x56\xf2\xfe\x47\x44\x13\xd9\xe1\x74\x10\x10\x09\x2c\x5c\x67\xb9\xc6\xde\x60\xfc\x42\x60\x55\x00\x08\xb0\xc3\x50\xad\xfe\x0d\xf7\x35\x6c\x4f\xe7\x70\xf6\x52\x14\xd0\x78\x21\x59\xce\x84\xce\x93\xac\x4e\x61\xd7\x5c\xb6\xb4\x3b\xcc\xe0\x4d\xd2\xf9\x71\x6d\xdb\x3b\x64\xc7\x99\xb6\x17\x25\x17\x69\x16\x35\x65\x9d\x6f\x30\x2c\x37\xce\xd6\xe0\x04\x18\xf7\x95\x64\xe4\xe4\x8d\xbb\x91\x0c\x69\x96\x6a\x0b\x7a\xfd\x07\x05\x3e\x98\xa4\x52\xe9\xa5\x6b\x19\x57\x97\xbd\x11\xfb\xce\xfc\x7f\x54\x72\xad\x9e\x4c\x3f\x40\xeb\xaf\x31\xe3\x9f\x24\x2a\x44\x19\x46\x01\xb6\x21\xa4\x19\xba\x71\x40\x65\x4a\x9e\xca\x32\x9d\x39\x06\xae\x57\x72\xa1\x50\x10\x78\x9b\xa8\x8f\x64\x9d\x83\x4f\x40\x8a\xdc\xe4\xc7\xa5\x31\x15\xc8\xdb\x72\xf2\x25\x16\xca\xad\x92\x55\x5d\xf6\x09\x40\x9e\x90\x94\x35\x44\xd6\xf3\x45\xc4\x51\x43\x23\xcc\x94\xb4\x6f\x81\xc8\xac\x55\xd1\x4a\x7f\x4c\x87\x5c\x30\x50\x8a\x01\x2a\x43\x59\x83\xa3\x0a\x8d\xc2\x51\x2c\x38\xdf\xdc\xea\xa2\xb1\xa9\x6d\xc4\x2c\x7f\xc2\x6c\xd8\x3b\x6a\x92\xd5\x36\xce\xed\x28\xe3\x6b\x11\x64\xa0\xe5\x74\x46\xf7\x56\xde\x6f\x5e\xf7\xd6\xe8\xc8\x68\xef\x3d\x65\xa8\x24\x49\x2a\x4a\x1e\xe1\xaa\x77\xa6\x74\x55\xc9\x4a\x9d\x2d\x55\xf2\x19\x76\xa0\x5c\xcb\x2c\x62\x7e\xf4\x8a\xbb\x6b\x09\x10\x21\x6f\xa4\x05\xb2\x24\x71\xdb\x93\x1e\x65\xfc\x5e\xa5\x05\x08\xc1\x8f\x65\xf6\xc6\x94\x7f\x01\x17\x43\x0c\x1f\xf6\x2f\xb4\xe8\x88\x8f\x1f\x7e\x9c\x8c\xe0\x7d\x5e\xc9\xb5\xca\x23\x06\xf2\x16\x9e\xa7\x9a\xe3\xfe\xc5\xe1\x71\x8a\x6e\x1e\xce\x69\xb9\xa8\x61\x7a\x83\xaf\x6c\x0c\xab\xa1\xe0\x67\x44\x25\x32\x98\xa5\x84\x76\xc8\x20\xcd\xce\x49\xf3\x8e\xb1\xfe\x39\x46\xac\x34\xc6\xd5\x55\x9e\x46\xce\xed\xca\xc7\x26\xee\x14\xbe\x2e\x72\x75\x07\x86\xa6\xb1\xda\xd7\x94\x0f\x92\xe3\x72\x0e\xb4\x60\x03\xe1\xe1\x81\xee\x92\x41\x15\xb9\x66\x9b\xab\xa1\xaa\xce\x18\xdd\x55\x76\x7b\x3e\x48\x87\xa1\x32\x44\x3c\xc8\x78\xe8\x17\xb9\x96\xbe\xb8\xb0\x67\x51\x3a\xb3\xfb\x9f\xd3\x4f\xa7\xff\x79\x7f\x79\x7d\xf1\xfe\xdd\x55\x5c\x53\x22\xc6\x8a\x16\x26\x7d\x4f\xdd\x5b\x98\xe3\x09\xcf\x53\xa2\x1f\x99\xe1\x4f\xab\x43\x93\x2f\xe3\x07\x4e\xe9\xe1\x88\xd5\x5d\x8e\x1e\xfb\xd8\x75\x16\xe1\xb4\x19\x52\x64\x0f\x1f\xd6\x41\x02\x8f\xf5\xfa\x84\x3f\x9c\xf3\x2d\xc7\x62\x63\x60\x58\xaa\xa5\xca\x21\x2d\xa9\x1b\x92\x33\xd0\x67\x10\x65\x60\x00\x08\x1a\x39\xc0\x76\x9e\xaf\x3f\xc9\x6e\x3e\x4b\x1e\x77\xd2\xe9\x1f\x12\x00\x0f\x80\x14\x67\x34\xe3\xa1\x50\x18\x5f\x2a\xf1\x31\x64\x9f\x44\xe2\xda\x36\xaf\x88\x3a\x60\xc8\xaf\x15\x67\xff\xb9\x78\x7d\xfe\xee\xfa\xe2\xcd\xc5\xf9\x87\xa1\xc9\x45\x9d\xf1\xdd\xc3\xf9\xd3\x4f\x38\x97\x9e\xf6\x67\x0c\x9a\x62\x4b\x8b\x34\x51\x94\x6a\xad\x4d\x6d\xa9\xd0\xab\x7d\x36\x58\x34\x69\x9d\x2d\xb9\x86\xc2\x65\x70\x79\x4d\x90\xb4\x09\x8c\x4a\x27\x9d\xed\x89\x23\x8d\x00\x0d\xf4\x33\x85\xaf\x5c\x0b\x08\x6b\xb2\x75\x0c\x1c\x7b\x58\x42\x1a\xaf\xce\x8b\xba\xc2\x0d\x0c\xb9\x24\x75\x9e\x2c\x65\xbe\xe0\x02\xf8\x41\xae\x6b\x7b\x43\xec\x43\x5e\xc9\x7b\x72\x3d\x29\x9b\xc8\x42\xa5\x14\xb6\x4c\x4d\xcd\xb9\x6f\xf0\xf9\xea\xab\x99\xd0\xea\x44\x7c\xd5\x22\x3e\x17\xe7\x48\xaf\x75\x1a\x60\xdc\x2c\x8a\xb9\xcb\xbf\xb9\x69\xda\xe5\x41\x9e\xc0\x42\x96\xa9\x6b\xcf\x61\x6e\x3b\xf5\x83\xb4\xe0\x2c\xca\xca\x65\x82\x40\xb1\x7b\x6e\xaa\x0e\x7c\x8f\x38\x3a\x9a\x4f\xf7\x72\x95\xde\x94\x26\x1e\xce\xee\xca\x35\x47\x43\xe0\xce\xed\x4c\x83\x59\x37\x88\xa9\x7d\x67\xe1\x38\x7a\xc5\xf7\x16\xb3\x6d\x30\x79\xca\xa5\xb8\x70\xce\x51\x0c\xaf\x44\xd7\xb8\x87\x5c\xfe\x41\x3d\x7c\x50\x6c\xe4\xd5\xee\x62\xb8\xcc\x7d\xcc\x72\x04\x0d\xc3\xf1\x76\x4f\x96\x77\xf6\xe3\xc7\x1d\x91\x73\xbb\x75\xcc\x2d\xdc\x0b\xeb\x86\xcf\x1d\x68\xf4\x21\x8b\x95\x4c\x5b\x87\xdb\x83\x70\x1e\x41\x32\x06\x2a\x78\x0f\x28\xe7\x7b\x42\x3a\xdf\x0d\xed\x7c\xe7\x7d\x8c\xad\xb0\x66\x55\x59\x87\xfb\x12\x41\x54\x60\x51\x36\xe4\x16\xef\x58\x6b\x1d\x6b\x69\xc6\xd6\x03\xef\x52\x55\xcf\x54\xc0\x9b\x8f\x4b\x70\xda\x95\x77\x4d\x1b\xe6\xd5\x01\xdd\x2a\x4c\x7a\x42\x9d\x34\xf9\x27\x7c\xa5\x2a\x09\xd1\xce\x39\xdc\x8f\x59\xf7\x47\x57\x18\x3e\x13\x3f\x87\x5f\x66\xae\xc0\xe8\x5f\xd3\xe9\xb7\x3f\x9c\xff\xe3\xbb\xe9\xf4\xdf\x3f\xf3\xcf\x7c\x43\xa5\x65\x7c\x75\x48\xf9\x9a\x34\x28\x5b\x02\xd6\xc1\xa7\xec\x5e\x43\x6d\xeb\x34\x49\x20\x30\xe6\x08\x80\xd7\xbb\xaa\xed\x1c\x9a\x91\x5f\x5c\x86\x1f\x0b\x93\x5e\x5c\x46\x50\x6f\xbd\x65\xe7\xd3\x3d\x0a\x87\x86\x93\xec\x7c\x51\xf1\x7d\x3a\x12\x98\x09\x08\xff\xfb\x06\x8e\x0a\xa3\xd9\x6d\xf7\xa3\xad\x80\xe6\xdd\x95\x82\xf4\x2e\x01\x5d\xe2\xc1\x74\x9b\x91\x9f\xdc\xc5\xcb\x8f\xd6\xaf\x8e\xf6\x2e\x8a\x6e\x69\xf8\x3b\x2f\xcd\x65\xd3\x41\x06\xef\x4d\x10\xa4\x11\x24\x1b\x68\x5b\x2a\xfd\x11\xa7\x97\x17\x62\xed\xd7\x7d\xcf\xcb\x10\xcf\xc3\xc2\xb2\xed\x8b\x93\x11\x52\xc1\x9b\x91\x38\x1a\xd1\xa3\x9d\x0a\x66\x15\x97\xb0\xf0\x59\x82\x44\xc7\xfa\x7c\x2d\x28\xa3\x83\xfe\xf4\xae\xf0\xdf\x8a\x17\xfe\x97\xf3\xa4\xa8\xf9\x8c\x00\xdf\xf1\x49\x3b\x33\xfa\x51\x15\x4b\xb5\x52\xa5\xcc\x8e\x11\x09\x63\x16\xbe\x26\x8e\x7c\x78\x0b\xb3\x82\xda\x03\x7e\xfc\x2d\x2f\x27\x0c\x92\xee\x3f\xc8\xaa\x0e\xb9\x98\x4d\xa7\xe5\xbd\x72\xb2\xb0\x6b\xc3\x55\x9f\x7d\xc7\x22\xb8\x5b\xbc\x8f\x24\xc8\x70\x30\x62\x22\x88\x52\x41\xba\x9d\xa1\xc3\x1e\x43\x33\x2a\x5f\x83\xb1\x6d\xa7\xfb\xbd\xb5\x42\xa4\x7a\xad\x2d\xaf\x2e\x8b\xfe\x45\x35\x95\xd8\xbd\xb1\xc4\xce\xcd\x25\x1e\x6d\xd6\x15\xf2\x43\xcc\x1e\xaf\x2b\x70\x2f\xf8\x0c\xc6\x08\x92\x21\x1d\x45\xdd\x17\x06\xac\xca\x70\x8f\x3b\x32\x27\x8a\xe2\xd1\xab\xa3\x88\xe7\x9f\x7d\xa3\x80\x91\x9a\x05\x74\xd9\xf7\xc9\xae\xdb\x3e\xfd\x40\x32\x29\xd0\x6a\x0c\xd4\xe9\x73\x13\x87\x34\xc4\x7d\x49\x43\x0f\x7e\x34\xae\x5f\xa2\x03\xef\xc4\xa4\x09\x39\x58\xe9\xd4\x36\xe8\x4e\x7b\xe4\xf5\x63\xb8\x34\xba\x48\x56\xbb\xe9\x65\x90\x88\x38\x17\xce\xed\x0d\xde\x47\x09\xde\x28\x9d\x12\xcd\xcf\x8a\x55\x7b\xf9\x05\xa7\xf1\xe0\x2e\x39\xb8\x4b\x7a\xdc\x25\x91\x38\xd8\x07\x5f\x89\xf7\x95\x44\x3c\xfe\x7c\x11\xdf\x5c\xec\x75\xd8\x69\xdf\x3d\x43\x68\xbe\x98\xdb\xad\xae\xfa\x26\x14\xdb\x4b\x52\x74\x8d\xa8\xf1\xa3\xac\x30\x2b\x8c\x2c\x6c\x94\x8d\x07\x3b\x6e\x80\x22\x74\x6f\x80\x34\x3a\x73\xdb\xb8\xe0\xed\x64\x3c\x39\x15\xc2\x05\x4c\x99\xdc\x99\xe1\x75\xa7\xc5\x63\x10\x4c\x2e\xeb\x7d\x32\xbe\x3c\x8d\x91\x20\x5c\xe9\x71\x68\x27\xfa\x87\x6c\x27\xba\xe2\x86\xef\x31\x84\x1f\x27\x19\x62\xa5\x42\x04\x1b\x86\xdb\xa9\x6e\xf5\xfd\xc9\x24\x72\x3d\x4e\xf3\xb0\x9e\x42\xa7\x2a\xaf\x00\x59\xa7\x04\x6b\xa2\x00\x7c\xb1\x9c\xc1\xec\x90\xd9\x62\x4b\x0b\x72\xd5\x85\x45\x9d\xb7\xb4\xc3\xbd\x26\x3c\x78\xa5\x73\x57\x86\x73\xb5\xa1\x06\x1f\xb8\xcd\x81\xdb\xec\x85\xdb\xe0\x41\xfb\xc3\xb2\x9a\x91\x95\xb4\xec\xf7\x46\xeb\xdc\x06\xe6\xb7\x97\x54\xc0\x4c\xaf\x55\xae\xac\xbd\x2c\xcd\x8d\x3a\xe1\x8f\xe9\x5a\xaf\x74\xbe\x08\xd9\x7b\x44\x06\xf4\x9c\x9b\xc0\x10\x78\xda\x26\x9f\x59\xdd\x4a\x9d\xd5\xa5\x62\x56\xc9\xc5\x95\xbe\xc6\xa4\xd7\xc3\x07\x40\x35\xb5\xcc\x5e\xab\x4c\x3e\xb0\x01\x97\xf6\x37\x1c\x0f\xcd\xf4\x0c\x06\x62\x6b\x07\x1b\xfc\x2c\xf6\x08\xea\xfb\x4c\x5d\xfd\xee\xab\xc2\xbc\x8b\x80\x77\xa3\x47\xb8\x8c\x81\xce\xe1\x36\x1e\x6e\xe3\xe1\x36\xee\x7c\x1b\xa3\x61\x41\xde\x85\x32\x36\x7a\x99\xee\x9e\xaf\xbd\x1b\x4c\xfc\xe5\x4f\x99\x3b\xdd\x75\xab\xc3\x6b\xc4\x4c\x3e\xb5\x9b\x32\x77\x35\x8f\x7d\xb9\x90\x5a\xbd\x68\xf1\x0f\x37\x80\xb4\xe0\xc7\x80\xf0\x38\x12\x9f\x1a\xa0\x2b\xa8\xeb\xa5\x6c\x46\x3b\x64\xd9\xf1\xf9\x1e\xe0\x80\xd5\x79\xc5\x4d\x41\xe9\x4c\xf2\xb2\xe9\xc6\xd6\x5d\x4c\x21\x2b\xdf\x46\x2f\xb2\x93\x74\x0b\x49\x0e\xbb\x51\x53\xe4\x23\xb6\x8f\xdd\xf4\x84\xd5\xd4\x26\xca\x56\x71\x43\x02\xf5\x58\x02\x62\xa1\xc9\xa3\xd7\x6b\x93\x80\x48\x01\xe8\x63\x05\xd2\x4d\x2c\xcd\x1d\x1e\x50\x06\x55\xe1\xf0\x46\x0b\xa4\xa4\xd2\x06\xad\x02\x72\xc2\xe0\x6c\xb7\xb6\x82\x09\xdf\x0a\x6f\x7b\xab\xf1\x4e\x3e\x08\x59\x9a\x3a\x4f\x3d\x64\x70\x83\x19\xf9\x76\x63\x02\xef\x4c\xce\x8b\xe2\x40\x13\xfd\xd0\xcf\x0b\xd3\x94\xb4\x85\x76\x6c\x12\xbc\x19\xaf\xe6\xaf\xbe\x1e\x7d\xbb\xb8\x6e\x80\xce\x16\xb9\xf1\x81\xe1\x88\x38\xb8\xb0\x2a\xe4\x15\xa0\xfb\x3a\xfa\x48\x63\x1a\x68\x77\x46\xfb\x16\x5b\xb6\x03\x81\x63\x97\x1f\xa4\x6f\x5d\xa0\x1c\x92\x75\x64\x7a\x0c\x39\x6e\xbc\x0d\x6a\x10\x1b\x5f\xb8\x86\xd9\x90\xd0\xda\xea\xa7\xfa\x32\xbe\x81\x2f\xbb\xef\x76\x9c\x21\x6d\xeb\x9b\x51\x78\x15\x32\x1c\x70\x41\x61\xc7\x4f\x6e\x18\x3a\x5c\xad\xa9\xed\x61\x5b\xb4\x5c\x2c\x92\x90\x6d\x78\x24\x5e\x78\x6a\x00\x4e\x6c\x4c\xf5\x72\xf4\x63\x86\x4b\x77\x7e\x5f\x94\xd1\xcb\x77\x4e\xb5\x46\xc5\xd3\xeb\x38\xe1\x77\x57\xed\x48\x8b\xbe\x75\x64\xd1\xfc\x5e\x2d\xe5\x5a\x59\x61\xf5\x4a\x67\xb2\xcc\x5c\x2d\xc1\x95\x9f\xad\xb8\xa9\xab\xad\x91\x26\x16\xe5\xa7\x2b\xb4\xb6\x54\x5f\x45\x9f\x9e\xd6\xb0\x3a\xf7\xab\x73\x18\x78\xe3\x74\x07\x86\xe6\x0c\x3b\xec\x12\x03\x69\x0d\x40\x5a\xac\xea\xaa\x96\x59\xf6\x20\xd4\x7d\x92\xd5\x56\xf7\x41\x05\xee\x74\xc0\xf8\x71\xca\xa0\x71\x3c\xc3\x88\x26\xa6\xd4\x9d\x4c\xd8\x57\xc3\x0b\x85\x58\x8f\x56\xbc\x4a\x79\x4a\xb7\xe4\xd1\x17\xcd\xdc\xf5\x43\x68\xd3\x01\x92\xa2\x15\xc4\xb4\x4d\xda\x24\x21\x25\x1b\x2a\x59\x9e\x0b\x90\x48\xf0\xb0\x1d\x66\x8b\x44\x0e\xce\x19\xa0\x83\x93\xa1\xe0\xf3\xb4\x5d\x35\x9f\xb8\x5b\x9a\x2c\xb0\x0b\xff\x87\x41\xba\x37\x0a\xae\x56\xb7\x0b\x8b\x03\xfc\x6c\x1a\xad\x04\xc1\x35\xa6\x4a\x1c\x62\xab\xc3\x8f\x6e\x6c\x92\x8f\xc9\xb8\x86\x92\x85\x29\x6a\xc0\x98\xcf\x17\x71\xed\xa2\x63\x06\x0a\x1f\xcc\x1f\x1c\x6e\x6d\xf5\xc4\x90\x9f\xec\xe0\x8b\x3d\x79\x99\x34\x9d\xd7\xae\xdd\xbb\x37\x41\x00\x6f\x68\xb2\x69\x5b\x20\x34\x91\xad\x7c\x99\x4d\x7c\x45\x44\xea\xec\x70\xfb\xde\x4e\x43\x5e\xfe\x50\xb7\x36\xee\x45\x98\xbd\x8d\x56\xbc\x6c\xa2\x5d\x2a\x9b\x4d\x78\x5b\x52\x83\x4d\xf1\xeb\xbf\x7e\xf3\xcd\x5c\xbc\xd6\xa5\x4a\x10\x05\xa2\x25\xcf\x0b\x92\x17\xfd\xe8\x49\xdd\x7f\xbe\x2f\x2e\x71\x11\xe8\xba\xee\x21\x4e\x50\xdf\x6f\x75\xe4\x65\x93\xec\xe9\xdc\x8b\xa8\x70\xd8\x26\x85\x29\x77\x43\xbb\xd7\x2d\x3d\x7b\xb7\x74\xe1\x65\xd3\x7c\xdc\xad\x37\xaa\xff\x6e\x9c\x5b\x28\xce\x5b\x12\x29\x6c\xb6\x32\x87\x8b\xdb\xb6\x19\x30\x0b\x31\xe8\x63\x3e\x3a\x3c\x7c\x0a\xa9\x4b\x0a\x5b\xbf\x96\xd5\x46\x85\x57\xd0\xb2\xd2\x26\xa6\xcd\x26\x4d\x35\xde\xd8\x33\x1d\x2a\x76\x73\x94\x8d\x24\x2f\xc1\xf1\x02\x7b\xcf\xa7\xb9\x34\x56\x39\xe5\x83\x60\xf7\x21\xec\x0e\xa7\x04\x94\x56\x10\x3b\xf8\x6b\xb7\x0a\x1c\x3d\x2a\xf4\x71\x69\xad\x25\xd0\xcd\xb4\x05\x1e\x09\x7d\x53\x1e\xcf\x84\x4d\x37\xcc\x38\x50\x77\xdd\x70\xad\x3f\xd9\x75\xde\xfa\x16\x36\x4d\x37\x1a\x2c\x84\xc6\x04\x28\x2c\xdc\xc7\x36\x34\xe1\xbb\xf8\x24\x9d\xee\x8f\x75\xd4\x48\xf3\x51\x32\x03\xbf\xf6\xbf\x51\xd7\xe0\xca\xd5\x85\x5f\x40\x55\x96\xce\x76\x75\x15\xf0\x80\x73\x69\xc5\x4a\x96\x9f\x23\x86\x49\xf1\xe8\xb9\x33\x1a\xd1\x1d\xe0\xd0\x09\x40\x9c\xaf\x41\x1e\xa5\x62\xe5\xfb\x3a\xb3\x89\xa2\xde\x0f\x93\x73\x2d\x97\xdd\x06\x81\x95\xdd\xea\xe1\x1c\xd3\x8a\x39\xea\x4a\x6f\x5c\xea\xb7\xb2\x80\x2b\x81\x20\x09\x58\x7b\x2d\xdb\xa6\x1d\x9b\x2e\x64\xb9\xad\xd9\x5e\x91\xdd\x14\x9c\x9d\xf2\xb1\x37\xa6\x7c\x8d\x77\xd8\x65\xdd\x38\x46\xc1\x1f\x6f\xa4\x1d\xd4\xfe\xac\x22\x94\xb2\x18\xd5\x2c\x8a\xa4\x40\x45\x6e\x53\x41\xc3\x66\x33\x51\xa0\x2a\xd1\x7a\x59\x24\x55\x07\xc3\x3b\xa4\x9d\x45\xd2\x6c\xeb\x72\x4f\xe8\x68\x91\x14\xb7\x6a\x74\xdd\x61\xf3\x15\xbc\xa0\x08\x34\x7b\xec\x18\x2d\xf0\xde\xc0\x63\xe3\xb8\x62\x87\x37\xb6\xec\x83\x06\x10\x25\x38\x63\x23\x69\xb6\x54\xb9\x3e\x15\x2d\x92\xea\x86\x42\xf7\xa4\xa2\x16\x49\xf6\xb1\x5a\xb7\x45\x5d\x8b\xa4\x19\xa3\xdc\xed\xae\xe2\xed\xaa\xe8\x91\xb6\xc5\x73\x90\x3e\xc1\x75\xae\x97\x2d\x59\x57\x34\xe5\xc5\x51\x14\x51\xdb\x76\x88\xe4\x45\x50\xa1\xa0\x25\xde\x5b\x2f\x39\x61\x2b\x64\x1e\xbb\xa3\x37\xd6\x64\x75\x05\x5e\x86\x6a\xd9\x90\x6a\x0b\xd7\x82\x5f\x15\x4c\x1f\x95\x29\xe7\x1a\x74\xf2\x37\x10\x6d\x09\xe6\xf8\xc9\xa3\x6c\x8d\x91\xe8\x5f\x20\x68\xb8\xae\xb7\xdd\x4a\x1b\xe0\xe9\xa8\x55\x65\xbb\xe4\x9a\x0f\xcf\x39\x17\x1f\xdc\x39\xe4\x78\xfe\x49\x73\x3c\x83\xbd\x10\xe3\xf8\xc1\xb2\xa3\x46\xa5\x7f\x46\xd9\xe5\x0e\x57\xea\xb5\xde\x21\x34\x03\x2f\x8a\x14\xdd\x39\x0f\xc2\x2e\x65\x49\x4e\x47\xc2\x85\x9f\x44\x94\x28\xf7\x37\x5f\xda\x55\x89\x87\xa6\x7c\xf5\x8a\xf7\xec\xc6\x04\xa7\x3f\x81\x7a\x00\x8b\x09\x43\x44\xa0\x00\x24\x88\x21\x23\x26\x59\x21\x6e\x64\xf2\xd9\xf7\x5d\x0a\x0b\x06\x4e\xaa\xa0\x25\x81\xb9\x78\x74\x14\x91\xbc\x00\xff\x85\xde\xa9\x00\x3d\x4f\x2d\xaa\xa7\x53\x1b\x88\xfa\xb1\x46\xaa\xd9\x4e\x3e\xfa\x66\xaf\x78\xd3\x42\x0f\x9e\x97\x60\x38\xfa\xd6\x2d\x91\xa5\x94\xfc\x32\x4a\x5c\xe7\xff\x8f\x01\x91\xff\xe7\x06\x92\xea\x72\x6f\x2c\xc2\xea\xdf\x14\x03\x72\xbb\xf9\x44\x61\x09\xec\x86\x23\xb0\x13\x86\x40\xf7\xf0\x5e\x1b\x67\x6b\x84\xbe\x16\x99\x49\x64\x46\xa7\x38\x5a\xc2\x23\xce\x1d\xb4\x6a\x44\x7e\x41\xe6\xb7\x3b\xc5\xb0\x86\x6c\x9a\x0e\xe9\x03\x0e\xbc\xcc\xac\xf1\x10\xb5\x09\xc0\xe5\xb9\xef\x40\xb4\x0e\x3c\xb9\x6c\x9a\x30\x08\xea\xf4\x50\xbb\x56\x1b\x26\xef\xd2\x0a\x03\x67\xd3\xbc\xa3\xa0\x30\x5c\x2d\x6c\xea\xb3\x61\x1b\xc2\x5f\xae\xe8\xfc\xb0\x09\x07\xeb\x4a\xb8\x6e\xff\x64\x2e\xd8\x7a\x05\x8c\x06\x47\xed\x56\x89\x2f\x9e\x21\xaf\x2b\xcb\x9a\x30\x6f\xd3\xc9\x70\x93\xcf\xb0\x49\xe6\x3a\x43\xd7\x19\x32\x1a\x6a\x9e\x11\x36\xb0\xce\x51\x90\xf1\x75\xcd\x46\xe1\x71\xdc\x60\x3b\x33\x00\xac\xfa\xe3\x45\xad\xd3\xdd\xd9\xc0\xb3\xc7\x9d\x18\x01\x73\x22\x4a\xce\xef\x94\x96\xd4\x56\x5a\xfd\x4e\xcc\x48\x79\x4d\x42\xf0\x76\x12\xe1\x14\x60\xa7\x9a\x45\xf1\xbf\x02\xe0\xa2\x6c\xa5\xf2\xca\x07\xc5\xcf\x32\xa9\x57\xd1\x93\xbd\x0c\x54\x68\xb4\x09\xd0\xa1\x9c\x10\x31\xd0\x20\x8c\x3e\xcd\x5a\xed\x41\x9d\x71\x23\x8a\x41\x01\xea\xcc\x70\x7a\x46\xaf\x53\x20\x21\xc7\x0d\x96\xe2\x72\xdb\x1a\x4e\x62\x51\xbc\x08\x7f\xda\xa1\x64\x50\xef\xd3\xc2\x34\xd9\x2a\x11\xfc\x87\x04\xcc\x06\xcb\xe0\x2a\x10\xcd\xa1\x38\x26\x26\xd2\xfc\xca\xff\xc6\xad\x26\x1b\xaf\x28\x5a\x24\xc7\xe4\xd7\x3d\xda\xab\x9f\xc0\x65\x76\x6b\x1c\x00\xcc\x52\x89\x0f\x48\x8b\x42\x9c\x4c\x92\x90\xd4\x8f\xe9\x83\x2e\x69\xaf\x09\xdd\xf2\x13\xe5\x76\x31\x41\x62\x3c\x03\xc7\xcd\xa9\x1e\x9b\xe9\xf9\xc2\xd1\x93\x49\xe4\xda\x63\x21\xdf\x21\x93\xe2\x90\x49\x71\xc8\xa4\x38\x64\x52\x1c\x32\x29\x22\x32\x29\x22\x81\x79\x0e\x69\x14\x7f\x8a\x34\x0a\xbf\xeb\xb3\x38\x39\x49\x32\x7a\x38\x87\x22\xf8\x71\xf9\x9b\x35\x9c\x43\x41\xc6\x32\x9b\xe4\x21\x87\xe2\x90\x43\x71\xc8\xa1\x38\xe4\x50\x1c\x72\x28\x0e\x39\x14\x87\x1c\x8a\x43\x0e\xc5\x21\x87\x62\xa7\x1c\x8a\x7d\xe1\x2e\x79\x7c\x4f\x26\x51\xf1\xdf\x0c\xc8\x93\x23\xea\xdd\x28\xd9\x23\x08\xc1\x4b\x77\xc6\xa4\x53\x66\xb5\x1e\x85\x01\xbc\x73\xd6\xc7\x8d\x77\x76\xaf\x92\x4f\xd5\x8f\x66\x3a\xd9\xcb\x15\x89\x38\x8d\xcf\x17\xae\x94\x41\x8c\x80\xb4\x7b\xb4\x6a\x9e\xe6\x9d\x0d\x07\xb2\xf9\x2a\x7c\x52\xd4\x43\x8f\x44\xed\xa8\x8f\x6c\x8e\x48\x92\xb9\x4d\xd8\xf6\xe1\xb0\x2c\xed\x65\x61\x3c\x04\xd5\x92\x69\x9d\xf5\x76\x84\xef\x32\xe7\xf0\x02\xf1\xaa\xa7\x3b\xce\xf6\x29\x84\x2b\x55\x2e\xc0\xe8\x5f\xab\x12\x38\x53\x29\x1b\xc2\x93\x2f\xdb\x3f\x79\x7b\x0b\x28\x47\x03\xab\xdd\x99\xd4\x29\xbe\xd2\x9e\xd2\x4c\xc8\x26\xc0\xee\x5c\x29\x73\x71\x31\x28\x7b\xb0\x7d\x90\xb6\x22\x53\xb7\x95\x30\x75\x28\x58\x3d\xfb\xf0\x7a\x06\x22\xad\x54\x1b\x55\xa7\x72\x30\x1d\xa8\x29\x49\xd5\x15\xa6\x2c\xac\xe4\x67\x45\x64\x45\x65\x8c\xc8\x64\xb9\x50\xd8\x55\x1b\x5b\x02\xcf\xbf\xfc\x04\x3d\x8a\x62\x3b\x9f\x50\xb9\x56\xc7\x75\xfe\x39\x37\x77\xf9\xb1\xf3\x11\xda\xc1\x78\x36\xa4\x2d\xf9\x96\x35\xa6\x7c\x66\x70\x81\xb8\x3f\x30\xc2\x10\xcb\xf4\xf5\xc6\x78\x39\x06\x75\x06\x93\x8f\xb1\xd2\x45\xa9\x4d\xa9\xab\x87\xb3\x4c\x5a\x3b\xac\x46\x74\xa6\x72\x89\xef\x42\x98\xcd\x86\x29\x31\xb1\x0f\x07\xd7\xad\x32\x99\xc2\xae\xfb\x27\x93\x2f\xf6\x7a\x75\x06\x7e\x8d\xb1\x63\x88\x1a\x8b\xeb\xf0\x3d\xe0\x16\x94\x55\x25\x61\x03\x38\x51\x13\x1c\xa1\x82\xa6\x45\x0f\x02\x8c\x0d\x08\x3a\x48\x84\x5a\xc1\x7d\xad\x4a\x5d\x64\x4a\x7c\xfb\x59\x3d\xcc\x9c\xef\x62\xa6\x5c\x78\xe2\xbb\x41\xf2\x01\x87\xc1\xd3\x83\x1f\xe0\x68\xca\xca\x94\xe2\x5b\xfa\xbf\xef\x86\x2c\x09\xbe\xf8\x11\xc2\x8f\x6c\xf8\xb9\x8d\xe5\x3c\x77\xaf\x09\x9d\xa7\x3a\x91\x15\xcd\xdb\x2d\x87\x42\xfb\x9d\x17\x82\x72\xf3\x9c\x63\x02\xa8\xcf\x0d\x72\xbf\x72\x19\x48\x6d\x82\xd6\x03\xf9\xb0\x88\xb6\xdc\x25\x32\xcb\xcc\x9d\x4a\xd1\x19\xe3\x6e\xdb\x3b\x83\x22\x40\xcd\xc4\xa5\x8b\x36\x34\xbf\x61\x91\x07\x7f\xc2\x3b\x73\x7e\xaf\x92\xba\x62\xf9\x3b\xd9\x6c\x03\x6d\x89\xe8\xed\xf8\xc1\x97\x33\x36\x9b\xe0\x8c\x6c\xca\xb1\x6a\xae\x15\x83\xae\x40\xae\x0e\x41\xc4\xfe\x7d\x01\xa3\x87\x33\x7b\x17\xec\x20\xcb\x5f\x5b\xe1\xb2\x30\x67\xcd\xc1\x26\xbb\xe9\xdc\xb5\xeb\xfd\x1b\x8b\xa2\xbb\xc6\x89\x59\xdd\xe8\xdc\xdf\x63\x4c\x2b\x33\xad\x51\xd2\x96\x33\xc1\xa3\xe0\x15\xee\x94\xa2\x36\x94\x26\x1a\xbd\xab\xef\x69\x85\xda\xbd\xd2\xc1\xd6\x04\x78\x1d\xe7\x98\x31\xb9\x5d\x6a\x5e\x9d\x31\x05\xc2\x60\x51\xe6\xe2\x93\x6b\xc0\x42\x23\xf3\x52\xc8\xaf\x3f\xac\x97\x38\xff\xb5\xe6\xc6\x47\x5a\x31\x67\x7c\x8d\x08\xc1\x56\xff\x5a\xeb\xb5\xcc\x40\xa3\xa8\x0c\x54\x6e\xa5\x89\x2c\x79\xdb\x01\x8e\x50\xb7\x83\x33\x61\x0d\x7a\x06\x9d\x86\x94\xc8\x3c\xb0\xe1\xe6\x34\x0e\xe9\x4b\xb8\x15\x10\xb4\x02\x74\x0f\x9d\xd4\x99\x2c\x05\xf0\xae\x05\xe4\x4f\x8f\xbd\xe7\xcd\x95\x63\xa3\x2b\x6e\x6c\xfe\xf5\x26\x85\xf6\x29\x80\xdb\xe4\x01\x66\x19\x64\xdd\xb4\x21\x83\x7e\x83\x19\x88\x17\x98\x91\x89\xf7\x0f\xda\x59\x38\xde\xcd\xa2\x19\x18\xe0\xac\x05\xbc\x55\x75\xa0\xd1\xf4\x22\x37\xa5\x4a\x5f\xd2\x77\x32\x9d\xe1\x81\x8b\xcd\xc5\xf7\x21\x4f\x64\x86\x31\xbc\x80\xe3\xd6\xca\x26\xe5\x51\xa5\x23\xd3\x30\xc9\x5b\x53\xba\xde\xe3\x2f\x52\xe3\xdc\x87\x6a\xad\x13\x80\x20\xfa\xa7\x2a\x79\xc2\x0b\xae\x4a\xae\x00\x47\x6e\x8d\x37\xab\x09\xdd\x56\x98\xf0\x22\xad\xf8\x5a\xbc\x70\xa4\x59\x34\xf5\x0a\xf2\x95\x65\xa5\xb2\x87\x97\x84\x68\x63\x1f\x6c\xa5\x58\x19\xcc\x2d\x27\xf3\x5f\xbf\x99\x8c\xed\x5a\x76\x53\x8c\x3e\xc9\x9f\xa8\xf5\x78\xb3\xf2\x8e\xd0\x2e\xb2\x29\xa8\x59\x66\x4e\x52\x85\x98\x18\x7c\x83\xe7\x3c\x18\x3a\x66\xe7\x24\x34\x90\x5d\x24\x9a\xc2\x81\xfe\x05\xee\x06\xb4\xbe\x5c\x38\x7e\xe1\x39\xfe\xc8\xdc\x62\x54\x07\x11\xb0\x9e\xc2\x64\x66\xf1\x70\x55\x40\xce\xe0\x99\xc9\x6d\x55\x3a\x0e\x79\x32\x61\x6f\xd9\xdf\xcd\xdd\x86\x85\xe2\x88\x09\x99\x94\xc6\xe2\x46\xe2\xd7\xf4\x12\x15\x22\x35\x2b\xa9\x73\x0b\xa9\xbe\x45\x26\x13\x52\x6f\x93\x66\x58\x64\x44\x2c\xf5\x62\x79\x2c\xd7\x52\x67\xf2\x46\x67\xba\x7a\x98\x0c\xc7\x54\xc7\x36\x12\x70\x4e\x34\xdd\x66\x94\x6d\x4b\x67\x38\xfc\xb4\xd5\xa0\xa7\x8e\xbf\xdb\x4d\x76\x0e\x4d\x18\x80\x07\xc8\x72\x6f\x91\xff\x61\x3e\xd6\xf9\x1a\xc9\x04\xe7\x1d\xd4\xc1\x51\x0d\x3c\x50\xeb\x93\xc9\x6e\xc6\x4f\xaa\x2d\x94\x88\x7c\x50\x70\x3b\x01\xa3\xe4\xd2\xe1\xb4\xd7\x65\xaf\x2f\xa7\x73\x50\x7e\x54\x72\xad\x9e\x74\x4a\xd5\xba\xbd\xb9\x65\xf8\x9e\x27\x69\xbb\x9e\x80\xe0\xd7\x77\x63\x80\x54\x90\x3c\x95\x65\x3a\x73\x7e\x1b\xbd\x92\x0b\x85\x69\x6e\x89\xeb\xcf\x2d\xca\x3a\x87\xf3\x20\x45\x6e\xf2\xe3\xd2\xf4\xe6\xe6\x41\xfd\xc3\x93\x7f\xe6\x04\x1f\x0a\x93\xbe\x27\x44\x35\xe6\xf2\x84\xe7\xc9\x43\xd4\x46\x7a\x7b\xbc\x5a\x93\x2f\x33\x64\x5b\x3d\xcb\x9f\x8b\xc7\xe7\xb4\x19\x52\x24\xa6\xde\xe0\xb5\x40\xb3\x3d\x5f\x9f\xf0\x87\x73\xbe\x05\x3b\x72\x63\x60\x18\x3a\xed\xf7\xa5\x12\x0a\x77\xc3\xc7\x0d\xe0\xfe\xe2\x41\x0f\x09\xfb\x23\x73\xe5\xf3\x7c\xfd\x49\x76\x8d\xa0\x7c\x37\x38\x4c\x24\xe0\x99\x73\xe8\x60\x3c\xc4\x3f\x79\xa7\x10\xdd\x8f\x83\xee\xb4\x81\xca\x98\x6d\xf3\x8a\xc8\xcb\xd9\x7f\xff\xa4\xdd\x74\xc0\xe9\x27\x9c\x4b\x0f\x1c\x29\x83\xa6\xd8\x02\x59\x0a\x3d\xad\xd6\xda\xd4\x96\x02\xaf\xed\xb3\xc1\xa2\x49\xeb\x6c\x29\x03\x31\x5c\x06\x67\x0c\x83\xa7\x0f\x04\xa1\x4e\xd4\xee\xa4\x31\x61\x92\x7e\x26\x4e\xee\x20\x99\xac\xc9\xd6\x31\xa9\x3d\x61\x09\x69\xbc\x3a\x87\x8e\xce\x98\x6b\x10\x72\x7b\xf2\x64\x29\xf3\x05\xb7\xa0\x0e\x1c\xa4\xed\x0d\xb1\x0f\x79\x25\xef\x29\x59\x46\xd9\x44\x16\x2a\xf5\xf9\x89\x52\xa4\xa6\xe6\xc2\xcf\x7e\xf5\xd5\x4c\x68\x75\x22\xbe\x6a\x11\x9f\x8b\x73\xa4\xd7\x3a\x0d\x30\x6e\x16\xc5\xdc\x19\x6d\x37\x0d\x7c\x2d\x28\x97\x0b\x59\xa6\x0e\x2e\xcb\xdc\x76\xe2\xf9\xb4\xe0\x2c\xca\xca\x99\x0f\x90\x7c\x96\x9b\xaa\x93\x4e\x2f\x8e\x8e\xe6\xd3\xbd\x5c\xa5\xe1\xde\x93\x5b\xae\x13\xb6\x75\x04\xf5\xe0\x29\xa6\xc1\x8c\xe3\xa3\x3f\xe8\x2c\x1c\x47\x48\x09\x13\xfa\x16\x4d\x34\xb4\xb8\x9d\x5d\xc4\x39\x47\x31\xbc\x92\x12\xfb\x1d\x04\xc2\xb8\x8d\x99\x03\xb2\x02\xef\xec\xc7\x8f\x3b\xc2\x51\xbb\x75\xcc\xd7\x21\x11\x0a\x1b\xc9\x71\x07\x1a\x7d\xc8\x62\x6b\x36\xb7\x0e\xb7\x07\x71\xe4\xd0\x01\xf9\x4f\xd0\x01\x39\xdc\x97\x08\xa2\x87\x26\xc8\xd0\x04\x19\xf2\x1b\x55\x96\xee\xca\xbb\xa6\x0d\xf3\xea\x14\xc1\x14\x26\x3d\x21\x64\x6b\xfe\x09\x5f\xa9\x4a\xa6\xb2\x92\x73\xb8\x18\xb3\xee\x8f\x2e\x51\x6b\x26\x7e\x0e\xbf\xf4\x2d\xf5\xfe\x35\x9d\x7e\xfb\xc3\xf9\x3f\xbe\x9b\x4e\xff\xfd\x33\xff\xcc\x37\x54\x5a\xc6\x57\x87\x94\x4f\x64\x80\x58\x37\xb0\x0e\x3e\x65\xf7\x1a\x6a\x5b\xa7\x49\x02\x75\xa6\x8e\x00\x18\xc5\x55\x6d\xe7\xd0\x1c\xe4\xe2\x32\xfc\x58\x98\xf4\xe2\x32\x82\x7a\xeb\x2d\x3b\x9f\xee\x51\x38\x34\x9c\x64\xe7\x8b\x8a\xef\xd3\x91\x40\xf7\x11\xfc\xef\x1b\x38\x2a\x0c\xf0\xf9\xee\x47\x5b\x01\xcd\x34\x2a\x95\x3b\xbd\x51\x95\x2b\x30\xdd\x66\xe4\x61\x07\xa7\xaa\x38\x5a\xbf\x3a\xda\xbb\x28\xba\xa5\xe1\xef\xbc\x34\x97\x4d\x36\x32\xde\x9b\x20\x48\x23\x48\x36\xa5\xe6\x14\x2f\x16\xa7\x97\x17\x62\xed\xd7\x7d\xcf\xcb\x10\xcf\xc3\xc2\xb2\xed\x8b\x93\x51\xe6\xe0\x9b\x91\x38\x1a\xd1\xa3\x9d\x0a\x66\x15\x97\xb0\x10\xae\x21\x0c\xd1\xb1\x3e\x25\x11\x72\x2f\xa0\x5f\x8c\x4b\xc4\xb3\xe2\x85\xff\xe5\x3c\x29\x6a\x3e\x23\xc0\x77\x7c\xba\xdc\x8c\x7e\x54\xc5\x52\xad\x54\x29\xb3\x63\xcc\x4c\x9d\x85\xaf\x89\x23\x1f\xde\x42\x00\x96\xf6\x80\x1f\x7f\xcb\x4b\x36\x5d\x70\xc5\x27\x75\x09\x78\x6f\xd9\x43\xd3\xf9\x60\xaf\x9c\x2c\xec\x5a\x4c\xc6\xf1\xe3\x63\x11\xdc\x2d\x0e\x93\xfa\x24\xc8\x70\xf0\x71\x4e\xe2\xcb\x59\xec\x2c\x68\x42\x40\x01\x0c\x73\xf0\x03\xd9\xe9\x7e\x6f\x2d\x78\x8f\xd7\xda\xf2\x82\xf9\xf4\x2f\x0a\xe4\x69\x77\xa0\xa7\x9d\xc1\x9e\x1e\x6d\xd6\x15\xf2\x43\x0c\x39\xd4\x15\xb8\x17\x7c\x68\x31\x82\x64\x40\x63\x53\xf7\x85\x01\xab\x32\xdc\xe3\x8e\xcc\x89\xa2\x78\xf4\xea\x28\xe2\xf9\x67\x0f\xdc\x33\x12\x78\x4f\x97\x7d\x9f\xec\xba\xed\xd3\x0f\x24\x93\x02\xad\xc6\x40\x9d\x3e\x37\x71\x48\x43\xdc\x97\x34\xf4\xc5\x08\xe3\xfa\x25\x3a\xe5\x16\x4c\x9a\x2e\x02\x33\xb5\x4d\xb5\xc5\x1e\x79\xfd\x18\x2e\x8d\x6e\x65\xc9\x6e\x7a\x19\xf4\x02\xa2\xb6\x89\xce\xa5\xbd\x76\xf9\x4a\x48\xf3\xb3\x62\x25\xec\x7c\xc1\x69\x3c\xb8\x4b\x0e\xee\x92\x1e\x77\x49\x24\x2e\xc5\xc1\x57\xe2\x7d\x25\x11\x8f\x3f\xdf\x0a\x2c\x17\x7b\x1d\x76\xda\x77\xcf\x10\x9a\x2f\xe6\x76\xab\xab\xbe\x09\xc5\xf6\x92\x14\x5d\x23\x6a\xfc\x28\x2b\xcc\x0a\x23\x0b\x1b\xb9\x86\xc1\x8e\x1b\xa0\x08\x68\x4a\x80\x30\x60\x6e\x1b\x17\xbc\x9d\x8c\x27\xa7\x42\xb8\x80\x29\x93\x3b\x33\xbc\xee\x40\x2e\x07\xc1\xc4\xed\xfb\x18\x2b\x4f\x63\x24\x08\x57\x7a\x1c\xe0\xbd\xff\x90\xf0\xde\xab\x18\x58\x8d\x68\xc9\x10\x2b\x15\x22\xd8\x30\x9c\x7a\x75\xab\xef\x4f\x26\x91\xeb\x71\x9a\x87\xf5\x14\x3a\x55\x79\x05\xe5\x18\x25\x58\x13\x05\x14\xa5\xe5\xac\x62\x1b\xf8\x10\xc4\x14\xb9\xea\xc2\xa2\xce\x5b\xda\xe1\x5e\x13\x1e\xbc\xd2\xb9\x2b\xc3\xb9\xda\x50\x83\x0f\xdc\xe6\xc0\x6d\xf6\xc2\x6d\xf0\xa0\xfd\x61\x59\xcd\xc8\x4a\x9a\x8f\x6e\x3d\x97\x7c\x40\xac\x00\xdd\x4b\x2a\x60\xa6\xd7\x2a\x57\xd6\x5e\x96\xe6\x46\x9d\xf0\xc7\x74\xad\x57\x3a\x5f\x84\xec\x3d\x22\x03\x7e\x83\x9b\xc0\x10\x78\xda\x26\x9f\x59\xdd\x4a\x9d\xd5\xa5\xba\x5e\x96\xca\x2e\x4d\xc6\xb0\x4a\xe2\x60\x73\x62\xfc\xa6\x50\x89\xad\x65\xf6\x5a\x65\xf2\x81\x5d\xa5\xb3\xbf\xe1\xf8\x7a\x9e\x67\x30\x10\x5b\x27\x89\xb2\xf6\x59\xec\x11\x94\x2d\x99\xba\xfa\xdd\x57\x85\x79\x17\xa1\x48\x42\x8f\x70\x19\x03\x9d\xc3\x6d\x3c\xdc\xc6\xc3\x6d\xdc\xf9\x36\xba\x76\x20\x36\xe2\x1e\xbe\xab\x57\x37\x00\x5f\x77\x1b\x5e\xa6\xbb\x97\xaa\x22\x33\x0f\x83\x89\xbf\xfc\x29\x73\xa7\xbb\x6e\x21\xae\x47\xcc\xe4\x53\xbb\x49\xc2\x13\xb9\xfe\x23\xbb\x90\x5a\xd8\xf0\xf8\x87\x1b\xa8\xbf\xf6\x63\x40\x20\x17\x89\x08\xf2\x03\x74\x05\xa1\x50\xcb\x66\xb4\x43\x96\x1d\x9f\xef\x41\xf1\x58\x9d\x57\xdc\x14\x94\xce\x24\x2f\x1b\x74\xd4\xee\x62\x0a\x59\x45\xf4\x5c\x6a\x81\x12\x37\xe5\x87\xd8\x1d\x82\x22\x1f\xb1\xb8\xb2\xd3\x13\x16\xc8\x5c\x94\xad\xe2\x86\x04\xea\xb1\x84\x32\x57\x93\x47\xaf\xd7\x26\x01\x91\xaa\x0a\xa0\xe1\xa1\x70\x64\x69\xee\xf8\x5d\x3c\x84\x2b\x52\x2f\x90\x12\x80\xda\x94\x66\xe5\xb6\x00\x72\xc2\xe0\x6c\xb7\xb6\x82\x59\xf3\x0f\x6f\x7b\xab\xf1\x4e\x3e\x08\x59\x9a\x3a\x4f\x3d\xce\x44\x53\x68\xfc\x76\x63\x02\xef\x4c\xce\x8b\xe2\x68\xdb\xc2\xd7\xc4\x34\x25\x6d\x01\x1e\x55\x82\x37\xe3\xd5\xfc\xd5\xd7\xa3\x6f\x17\xd7\x0d\xd0\xd9\x22\xc0\xff\xf4\x51\x09\x57\x5e\xeb\xd6\x94\xbc\x02\x74\x5f\x47\x1f\x69\x4c\x43\x8b\xce\x68\xdf\x62\x0b\x15\x20\x70\xec\xf2\x83\xf4\xad\x0b\x94\x43\xb2\x8e\x4c\x8f\x21\xc7\x8d\xb7\x41\x4d\x99\xef\x0b\xd7\xc0\x02\x12\x5a\x5b\xf8\xe6\x2f\xe3\x01\xf5\xd9\x7d\x30\xe2\x0c\x69\x5b\xdf\x8c\xc2\xab\x90\xe1\x80\x0b\x0a\x11\xb8\xb9\x61\xe8\x70\xb5\xa6\xb6\x87\x6d\xd1\x72\xb1\x48\x42\xb6\xe1\x91\x78\xe1\xa9\x01\xa2\x85\x31\xd5\xcb\xd1\x8f\x19\x2e\xdd\xf9\x7d\x11\x8f\xbf\x71\x4e\xb5\x46\xc5\xd3\xeb\x38\xe1\xa3\x9d\x77\xa4\x45\xdf\x3a\xb2\x68\x7e\xaf\x96\x72\xad\xac\xb0\x7a\xa5\x33\x59\x66\xae\x96\xe0\xca\xcf\x56\xdc\xd4\xd5\xd6\x48\x13\x8b\xf2\xd3\x15\x5a\x5b\xaa\xaf\xa2\x4f\x4f\x6b\x58\x9d\xfb\xd5\x39\x0c\xbc\x71\xba\x03\x43\x73\x86\x1d\x76\x89\x81\xb4\x06\x20\x2d\x56\x75\x55\xcb\x2c\x7b\x10\xea\x3e\xc9\x6a\xab\xd7\x63\xf3\x31\x7e\x9c\x32\x68\x1c\xcf\x30\xa2\x89\x29\x75\x27\x13\xf6\xd5\xf0\x42\x21\xd6\xa3\x15\xaf\x52\x9e\xd2\x2d\x79\xf4\x45\x33\x77\xfd\xb0\x1e\x7e\x80\xa4\x68\x05\x31\x6d\x93\x36\x49\xf0\x1a\x86\xca\x95\xe7\x02\x24\x12\x3c\x6c\x87\xd9\x22\x91\x83\x73\x06\x90\x32\x64\x28\xf8\x3c\x6d\x57\xcd\x27\xee\x96\x26\x0b\xec\xc2\xff\x61\x90\xee\x8d\x82\xab\xd5\x85\xee\x5b\xab\xf2\xa1\x85\xce\x17\x04\xd7\x98\x2a\x71\x88\xad\x0e\x3f\xba\xb1\x49\x3e\x26\xe3\x9a\x64\x1f\xda\x1c\x1d\xda\x1c\x1d\xda\x1c\xfd\x69\xda\x1c\xfd\x2f\x7b\x57\xf7\x1b\xb9\x8d\xe4\xdf\xf5\x57\x10\xbe\x07\xdb\x40\xbb\x2f\x9b\x5b\xec\x83\x13\x0c\xe0\xcc\x78\xf7\x06\xc9\x3a\x83\xb1\x91\x00\xfb\x26\xb7\xe8\xb6\x76\xd4\x62\x47\x1f\xe3\xf5\x1e\xee\x7f\x5f\x54\x91\x45\x4a\x6a\xb5\x58\x54\x77\xe7\x93\xe3\x00\x99\x71\xb7\x4a\x45\xb2\x58\x2c\xd6\xaf\x3e\xf6\xfc\x25\xb6\x39\x3a\x6a\x9b\x23\x8b\x69\xb3\x49\xef\xf4\x07\x8a\x9d\x8e\x7e\x8b\x9d\x8e\xec\xc2\x87\xb5\xf5\x20\x7b\x82\xd1\xec\x88\x4d\x93\xf0\xe8\x25\xa3\xd9\x11\x9b\xa8\xb1\xfb\x63\xb3\xa3\xd8\xec\x28\x36\x3b\x8a\xcd\x8e\x62\xb3\xa3\xd8\xec\x28\x36\x3b\x8a\xcd\x8e\x62\xb3\xa3\x79\xcd\x8e\x62\x8c\xe7\x1f\x3c\xc6\xd3\xde\x17\x42\x1c\x3f\x26\xed\xc8\x99\xf4\xbf\xa2\xe8\x72\xac\x2b\xf5\x2e\x9f\x01\xcd\xc0\x83\x22\x33\xee\x9c\x57\x51\x3f\xa7\x15\xd5\x83\x2b\xf2\x27\x09\x91\x2a\x49\x40\x8a\xf2\x56\x65\x27\xf0\x52\x42\x25\xe7\x76\x33\x6b\xf9\xcf\x7f\x84\x7a\x9f\x30\x99\xc0\xa2\x29\x14\x60\x08\x1a\xc8\x88\x49\x56\x88\xc7\x74\xf5\x49\xbb\xa8\xec\x84\x41\xd3\x47\x6b\x25\xc1\xc5\xf6\xec\x2c\x20\x78\x01\xfe\xb3\x05\xf7\xdb\x5a\xda\xbe\x26\xe7\xe7\xb5\x25\xaa\x79\x0d\x34\xb3\xb5\x81\x8d\x62\x61\x76\x9a\xb8\x30\x04\x2f\xe1\xe2\xf8\x77\xac\xa7\x10\x98\x4a\xc9\x4f\xa3\x34\xf3\xfc\xdf\x06\x10\xf9\x2f\x64\x24\xcb\xab\x93\xa9\x88\x3a\xff\xb7\xfc\xce\xdf\x67\xcb\xfd\x09\xaa\x25\x30\xaf\x8e\xc0\xac\x1a\x02\x7d\xe1\x7d\x50\x78\xd7\x40\xcc\x0b\xe4\xb7\x50\xab\xb4\x20\x29\x0e\x3e\xe1\xc1\x9a\xd4\xf2\x7b\x6b\xf4\x05\x5d\xbf\x51\x8a\x61\x0e\xd9\x34\xb1\xd2\x07\x08\x7c\x5a\xd4\x4a\x97\xa7\x5d\x41\xb9\x3c\x7c\x87\xa9\xd6\x61\x24\x97\x4d\x13\x98\xd8\xa4\xff\xca\x37\xed\x46\xb4\x35\xec\x53\x55\x9a\x4e\x5c\xb4\x63\x89\x71\x36\xcd\x17\x02\x85\x61\x6b\x6d\xf2\x12\x89\xf7\xef\x86\xf0\xc9\x3d\xc9\x0f\x9b\xb0\xbd\x5d\x09\x6c\x11\x45\xd7\x85\xba\xdd\xc0\x42\x19\xae\x71\x96\xf8\xc7\x33\xc4\x75\x15\x85\x83\x79\x5d\xf9\xeb\xa1\x9e\x61\x93\x2c\xf3\xc2\xb8\xce\x8c\xa2\xa1\x66\x26\x76\x01\xdb\xd2\x1c\x64\x7c\x5b\xd3\x19\x3c\xa8\x0d\xc6\x95\x01\x14\x4c\xbe\x5a\xb7\x79\x36\x5f\x0d\xfc\xea\xeb\x4e\x1c\xa1\xe6\x44\xd0\x39\x3f\x2b\x2c\xa9\x6b\xb4\xea\x95\x58\x74\xbd\xe6\x08\xbb\xc8\x24\xc0\x29\xc0\x0e\x35\x0b\xd2\x7f\x5b\x28\x17\x55\x83\x83\x5c\x83\xe2\x6f\x8b\x34\xdf\x04\x0f\xf6\x83\xa5\x42\xdc\xae\x80\x0e\xc5\x84\x88\x94\xb7\x75\xdc\x5c\x9d\xc0\x9c\x41\x8e\x42\xaa\x00\xf5\x46\x78\xfe\x96\x1e\x27\x20\xa1\x34\x0b\x9c\x8a\x0f\x63\x73\x98\x84\x56\xf1\xa2\xfa\xd3\x58\xea\x8e\x0a\xe6\x6f\x95\x8b\x56\x09\xd0\x3f\x74\xc0\x0c\x54\x06\xd7\x80\x70\x42\x71\x45\x4a\xc4\xfd\x4a\xff\x06\x67\x93\x5d\xaf\x28\xf8\x48\x0e\x89\xaf\xdb\x59\xab\x1f\xc1\x65\xf6\xa4\xb0\x00\xcc\xb3\x14\x1f\x0d\x2d\x82\x38\x99\x24\x21\xa8\xdf\x84\x0f\x62\xd0\x9e\x83\x6e\xf9\x81\x72\x73\xae\x20\x21\x9e\x81\x2b\x27\xd5\xc7\x56\x7a\x3a\x71\xf4\x3a\x09\x9c\x7b\x93\xc8\x17\x23\x29\x62\x24\x45\x8c\xa4\x88\x91\x14\x31\x92\x22\x20\x92\x22\xb0\x30\x4f\x0c\xa3\xf8\x5d\x84\x51\xe8\x55\x5f\x84\x9d\x93\x74\x46\xfb\x63\x28\xac\x1f\x97\xbf\x58\xfe\x18\x0a\xba\x2c\xb3\x49\xc6\x18\x8a\x18\x43\x11\x63\x28\x62\x0c\x45\x8c\xa1\x88\x31\x14\x31\x86\x22\xc6\x50\xc4\x18\x8a\x59\x31\x14\xa7\xaa\xbb\xa4\xeb\x7b\x32\x89\x8a\x9f\x13\x90\x27\x47\xd4\xdd\x51\xa2\x47\x4c\x09\x5e\xda\x33\x2a\x3b\x67\x66\xeb\x11\x0c\xa0\x9d\xb3\x1a\x37\x9e\xed\x5e\x25\x9f\xaa\xe6\xe6\x3c\x39\xc9\x16\x09\x90\xc6\x5f\x6f\xb9\x52\x06\x31\xc8\x4b\xcb\xda\x22\x2f\xd7\xd7\x09\x4b\x20\xee\xed\x03\x24\x15\xe3\x7d\x3d\xa1\x03\x4d\xb5\x9e\x14\x6c\x48\xbd\x83\xf5\xaf\x52\x47\x34\x39\xcc\xfe\xa7\xb6\xb8\xd7\x09\x5b\xc2\x6f\xcc\x23\xdd\xe1\x2c\xc6\xbb\xf8\x4e\x12\x15\xd3\x3d\x7e\x17\xa0\x38\x2a\x39\xc8\xed\x4b\xbd\x41\x17\x2e\xf1\x2f\x6f\x0c\x30\xbc\x49\x3f\x49\x22\x2b\x1a\xa5\x44\x91\x56\x6b\x8c\x3c\x84\xd3\x11\x50\x6d\x1f\x2e\xca\x96\xb2\xa3\xf4\x04\x86\xe0\x10\xdd\x18\x44\x55\xbf\xb2\xa2\x6c\x66\x7d\x80\xc3\x7a\xd0\xf4\x5a\x8b\xa4\x57\x33\xab\xf2\x18\x33\xbd\xad\x72\x05\xed\x7f\xdf\x16\x69\x5d\xfb\x95\x75\x6f\x28\x1f\xcc\xb3\x00\x66\xd4\x76\x48\xcc\x0a\x73\xde\x79\x73\x3d\xda\x3d\x2b\xc1\xf2\x2d\xf4\x18\x7f\x30\x08\x1d\x60\x73\xe2\xc1\xbe\x07\x9c\x2f\x69\xd3\xa4\xb0\x00\x1c\xdf\xb4\xe1\x50\x42\x6b\x98\x57\xd3\x63\x1e\xdd\xbc\xb6\x5f\x3c\xb4\x9a\xaf\xf2\x6d\x21\xc5\xd7\x9f\xe4\xeb\x02\x6f\x88\x0b\x89\x4e\xe0\x37\x5e\xf2\x36\xdb\x5d\xd3\x83\x7f\xd8\xae\xf3\x5f\xd3\xdf\xde\x2c\x93\xe3\xb9\x2f\x34\x67\xfe\xef\x0d\xa6\xf3\x16\x1f\x13\x79\x99\xe5\xab\xb4\xa1\x71\xe3\x74\x48\x73\x4b\xe2\x39\xfa\x71\x9c\x4b\x13\x66\xa7\x23\x30\xf0\x57\x18\xe7\xd1\x25\x58\xeb\x72\x29\x2c\xa2\x9d\x4b\x69\x5a\x14\xea\x45\x66\xe6\xca\x8b\xbb\xed\x4e\x99\x23\x40\x2e\xc4\x07\xf4\xe9\xba\xdf\xb0\xc8\xc3\xad\xed\x4e\xdd\xfe\x4b\xae\xda\x86\xe5\x55\x62\xab\x0d\x63\xb1\x05\x2f\xc7\xb7\x3a\x69\xcc\x2d\x02\x5e\x65\x28\x92\xc5\x6d\x2b\x06\x5d\x61\xb4\x3a\x40\x35\xd3\xeb\x02\xa6\x25\x67\xf4\xe8\x52\xa6\xfb\x55\x5e\x0b\x0c\x72\x59\x38\xc1\x26\xeb\xf4\x16\x9b\xa2\x7e\xc5\xa2\x88\xdb\x78\xa5\x36\x8f\x79\xa9\xf7\xb1\x09\xde\x51\x1d\x2e\x69\xc9\x99\x25\x7a\xe0\x11\xee\x90\x82\x16\x94\x06\x1a\xbc\xaa\xdf\xd3\x0c\x75\x3b\x52\x83\x45\x0f\x45\x4c\xf0\xfa\xab\xca\xfa\x39\xdf\x32\xe8\x0a\xaa\x68\x80\x93\xb2\x14\x3f\x60\x9b\x0b\xe2\x4c\xd7\x16\xd0\xf3\x0f\xf3\x25\x6e\x7f\x6a\xb9\x5e\xe8\x0e\xb2\x67\x1e\x23\x42\xb0\xd4\x3f\xb5\xf9\xe7\xb4\x00\x8b\xa2\x51\x90\x1f\x93\xad\xd2\x8a\xb7\x1c\xe0\x6e\x42\x66\x17\xa2\x56\xc6\xff\x82\x16\x12\x24\x9a\x90\x1a\x76\xd2\xe8\xb3\x97\xcc\x52\x00\x34\x00\x35\x14\xf2\x55\x5b\xa4\x95\x00\xdd\xb5\x86\x38\xcf\x63\xaf\xb9\xdb\x72\xec\x1a\x76\x83\xc5\x7f\x18\x52\xe8\x4a\x01\xec\x26\x5d\xc6\x93\x41\x16\x87\x0d\x71\xca\x03\x65\x20\x2e\x4c\xdc\x9b\xd9\x7f\xd0\x34\x00\x75\x37\x8b\xa6\x55\x80\x8b\x4e\x79\xa3\xa6\x57\x80\x2a\x5f\x97\xaa\x92\xd9\x25\xbd\x93\xe9\x72\xb4\x5a\x6c\x29\xbe\xb1\x68\xfc\xc2\x20\x25\xb6\x5a\x56\x27\x66\x8f\x47\x95\x44\xc6\x29\xc9\x27\x55\x61\x87\xe7\x8b\x4c\xa1\x93\x46\x7e\xce\x57\x50\xe8\xe5\x1f\xb2\xe2\x1d\x5e\xb0\x55\x4a\x09\xd5\xba\x3e\x9b\x9d\xe5\x00\xb2\xc6\x84\x15\xa4\xb5\xf8\x42\x5c\x20\x69\x16\xcd\x7c\x03\x91\x9c\x69\x23\x8b\xd7\x4b\xaa\x1b\x52\xbf\xd6\x8d\x64\xc5\x89\x76\x5c\x79\x7f\xf9\x73\x72\x6c\x07\xde\xbc\x96\xec\x3f\x50\x83\x67\x37\xf3\x48\x68\xce\xd9\x64\xcd\x2c\xb5\xa4\x53\x85\x94\x18\xbc\x41\x6b\x1e\x03\xd0\xb1\x91\x5f\x57\x18\x89\x8e\x26\x2b\xd0\xff\x84\xbd\x01\x0d\x06\xd7\xa8\x2f\xb4\xc6\x3f\xb2\xb6\x38\xea\x35\x1c\x54\xcf\x56\x15\x6a\xfd\x7a\xbf\x85\xc8\xac\xb7\xaa\xac\x9b\x0a\x35\xe4\x75\xc2\x5e\xb2\xff\x55\x2f\x83\x1b\x0a\x12\x13\xe9\xaa\x52\xb5\x59\x48\xf3\x9a\x49\xa2\x42\x64\x6a\x93\xe6\x65\x0d\x01\x95\xdb\x22\x5d\x91\x79\xbb\x72\x6c\xd1\x25\xe2\x39\x5f\x3f\x5f\xa5\x9f\xd3\xbc\x48\x1f\xf3\x22\x6f\x5e\x13\x3f\x72\x75\xec\x4b\x82\x19\x13\x0d\xd7\x71\xd9\xbd\xe9\xf8\x9d\xfc\xa3\x17\xfa\xe9\x2b\x3b\x87\x26\x30\xa0\xcb\x10\xe1\x53\xe4\x7f\x58\x1e\x4b\xbe\x8e\x74\x05\xe7\x09\xaa\x97\x2b\xcf\x17\xda\xed\xba\x4a\xf7\x61\x97\xfe\x1b\x10\x35\x75\x9b\x10\x10\xde\x35\x4a\x7b\x08\xdf\xa6\xdb\x74\xe5\xf5\x05\xb1\x94\xc2\x61\x13\x33\xf1\x61\x26\x0b\x09\x1a\xf9\x83\x2a\xf2\xd5\x08\xa7\xbd\xad\x80\x59\x41\xcf\xe9\x76\x2b\xcb\x9a\x6c\x47\xe8\xe6\x0c\x1b\xe1\xde\x78\xde\xc0\xcb\x64\x40\xda\x89\x1e\x79\x39\x64\xea\x14\x12\x82\x5e\x28\xc3\x06\x29\xbe\x03\x76\x46\x5a\x92\xca\x72\x2c\x93\xe9\xca\x7c\x7f\xe4\x83\x8f\x12\x0e\x15\x08\xd7\x19\xf9\xf0\x9b\x74\xf5\xa9\xdd\x3e\x3c\xcb\x72\xcf\xf3\x13\x6b\x92\xc9\x8d\x02\xb2\x9e\xa9\xba\x2d\x31\xaf\xe3\x3e\xdd\x6c\x0b\xf9\xee\x1b\x83\xda\x6e\x94\x9e\x2f\xb0\x63\x69\xc2\x76\xe8\x4c\xb9\xd8\x31\x2a\xf6\xef\xf9\xba\xda\x53\x0e\xb5\xc7\xc3\x5f\xe1\xcb\x80\x7d\x1a\xd3\x0e\xfa\x22\x7d\x84\x59\x2e\xe5\x0b\xf5\x19\x0e\x7a\x3b\x68\xe3\x9b\x8e\x32\xf6\xbc\xff\x63\x0b\x62\xf2\x2c\x9d\x0b\xd1\x96\x3f\x03\x95\x9f\x1b\x98\x6a\xd3\x16\x0d\xfa\x46\xa8\xe8\xf1\x98\x2e\xdd\x56\xaa\xb1\x61\x7a\xa0\x42\xb3\xbc\xae\x5a\x7c\x91\x78\x6c\xb3\xb5\x34\x97\x94\xfe\x99\xf4\x6f\x55\x8e\xd8\x97\xd3\x7b\x58\xe2\xca\xed\x71\xa6\x8f\xad\xf1\xce\x19\xb5\xff\x14\x9a\x9a\x5c\x7f\xcd\x68\x66\xad\xe8\xce\x6c\x77\xf7\xd6\x28\x49\x21\xbe\xbc\x4c\x3c\x36\xe3\x1e\xf8\xd7\xa4\x05\x5d\x8b\x2f\x93\x39\x46\x24\x59\x24\xdf\xca\x57\xc6\x68\xed\x21\x0c\xee\x02\x38\xe7\xec\xa0\x77\x0c\x91\x85\x78\x94\x35\xd4\xd3\x1b\xa5\x2a\x3a\xee\xd6\x9e\xe6\x21\x7e\x96\xee\xb0\x83\x00\x7b\x90\xa0\xcb\x24\x58\x6f\x4f\x68\xdc\x01\xde\x70\x9d\x4c\x0e\x5c\x27\xdd\xb6\x6e\x07\x5b\xb4\x00\x34\x4a\xc7\x67\x09\x2a\x05\x49\xd7\x4d\xd5\xae\x9a\x76\x34\x43\xd1\x8a\xc6\xb8\x77\x76\x7a\x63\x4c\x43\x1b\xe3\x90\x06\x78\x52\xc5\x1a\xa2\x20\x80\x77\xa2\x40\xbe\x6d\xd0\x02\x55\x5b\xc8\x7a\xdc\x5a\x99\x66\x87\x1c\xfb\x37\x93\x6c\xed\xb0\xf6\xce\x96\x06\x87\x87\xf7\xb3\xe4\xe9\x0c\x6d\xec\xbe\xfd\x66\x96\x9f\x79\x6a\x70\x25\xab\x4a\x66\xef\x5a\x90\x23\x27\x16\xef\xf5\x35\x59\xff\x5a\x5f\xaa\xbd\xf5\xaf\x7b\xe3\x7c\x30\xc5\x20\xc1\x81\x59\xe9\x0b\xa8\x7e\x19\x1c\x00\xf4\x81\xdf\x49\x0f\x1b\xd5\x6c\x17\x74\xb7\xd4\x69\x93\xd7\x4f\xaf\x3d\x5b\x13\x0a\xb3\x56\xd2\x44\x68\x59\x37\xab\x87\x2c\x45\x5b\xa3\xfd\xb8\xc0\x9a\xb1\x79\x83\xf1\x89\xab\x67\x05\x41\xac\x29\xbe\x56\x3b\x79\x3e\xe7\xaa\x60\xb8\x0a\xd0\x0e\x86\x20\x99\xca\xda\xc3\x1d\xd6\x20\x72\x48\x76\x88\x42\x99\x2a\xe5\x0d\x20\xb3\x2b\x44\x97\x55\x78\x09\x85\x57\x88\x35\x5e\xe8\xeb\x86\x32\x18\x5f\x24\x44\x1a\x79\x6f\x03\xf9\x52\x2e\x2d\xfe\xd6\x61\x69\x23\xf1\x1c\x2b\x0a\x62\xdf\x2c\xd4\x98\x8a\x19\xc5\x80\xa1\xa8\x46\x2d\x2e\xc8\xf2\xa2\xb6\xef\x0b\x1b\x6b\x31\x94\x33\x0f\xd9\xb1\x25\x5e\x08\xd9\xac\x96\x97\x0b\x3c\xd6\xa1\x32\x48\x8a\xa3\x7f\x7c\x85\xcb\x15\x68\x23\x2f\xd5\xe6\xb9\x52\xed\x1a\x8b\x7c\x50\x14\x8b\x39\xbd\xac\xa7\x08\xb4\x1b\x80\x71\xe5\x5a\x9c\xe9\x49\x3d\x4b\x58\xbe\x4c\x60\x25\x77\xa0\x9a\x73\x0e\xe0\xc9\x58\x55\xb2\xde\xaa\x12\xe9\xe2\x27\xb7\x6e\x5c\x3e\x27\x33\x91\xbc\xa8\x2f\x9d\x00\xc0\xe1\x4f\xeb\x0f\x47\x11\xfc\x6e\x03\x15\xe1\xad\xdc\x2c\x8f\x7c\x1f\xbd\xa1\x0c\x75\x27\x99\x4e\x4a\x04\x54\xb7\xa7\x31\x7b\xa8\x0a\x74\x94\x9a\xca\x18\x7a\x44\xf9\x06\x4e\x55\x30\xcc\x70\xca\xc1\x49\x85\xa2\x9a\x37\xe7\xa0\xc8\x4b\x75\xa5\xb6\x8c\x2a\xd9\x37\xa2\x6c\x8b\xc2\xcf\xa0\x28\x95\x39\x23\xfd\xbc\x1a\x46\x28\x75\x9a\xc9\x0b\x4f\x0b\x77\x77\xba\x2c\x79\x3d\xcc\xfb\x6b\xa2\x65\xad\x36\x18\x33\xae\x02\x40\xf8\xb5\x5a\xe5\xe8\xf0\x83\xd9\x65\x10\x15\x23\x62\xaa\xa5\xdf\x3f\xe9\x61\x83\xb5\x7e\xb3\xce\x06\xe0\x3d\xb5\x33\x74\x88\xd1\x87\xdd\xdb\x9f\x82\xae\x42\x62\xd2\x15\xe0\xda\x04\x2a\xe7\xb5\xe9\xa0\xc6\x19\x35\x7b\x17\xed\x1d\xc0\x5e\xc6\x43\x52\xc6\x21\x33\xcb\xd2\x40\x65\x6e\xc2\xfb\x6a\x1b\x77\x8b\x30\xcd\x22\x61\x11\xb3\xae\xe4\xb4\x74\xce\x4c\x24\x5b\xc9\xc2\x82\xab\x26\xb9\x23\x80\xa4\xe6\x85\x3b\xaf\xe1\x32\x15\x04\x57\xee\x59\x11\x38\xa6\x71\xfd\xfb\x88\x25\xcd\x6e\x10\xe1\x1e\x72\x19\xf4\xe4\xa4\x7d\xbf\xff\x87\x16\xeb\x80\xf1\x7f\x3c\x02\xb2\xe7\x7e\x30\x49\x02\xe2\xe8\xd5\x93\x91\xc4\x1d\xa4\x2f\x90\x22\x9c\x73\xef\xcb\x85\xb8\x53\x0d\xfc\x8f\xfc\xeb\xef\x94\xac\xef\x54\x83\xff\xe4\x0b\x98\xfe\xf9\x5b\xa3\x33\x7e\xbe\xfb\x79\x12\x1c\xf4\x3c\x1c\xb0\x44\x37\xa5\x76\xb9\x83\xde\x33\x41\xb3\xa1\x3b\xab\x87\x82\xd3\x52\x80\x91\xf9\xbe\x84\x7c\x02\x33\xb9\xe1\x31\xbc\x84\x70\x23\x7b\x04\xa3\x97\xaa\xbc\x42\xab\x81\x10\x92\x40\xa2\x5d\xfe\x0c\x92\xab\xaa\xde\x8a\x87\xe8\x35\x3a\xe8\x46\x59\x3d\x16\x9b\x7f\xc3\xb0\xd8\xef\x9a\xc5\xce\xab\x02\x89\xe2\x1c\x42\xbb\x0b\xd8\x47\x79\xb9\x2e\xac\xd1\x4a\x80\x23\xdc\xae\x02\x89\x62\x42\x69\x23\xab\x6d\x25\x0d\x20\x98\x96\xe4\x2d\x31\x99\xa7\x73\x78\x45\xaf\x2b\x60\x2a\x32\x13\x19\x9a\xfa\xc0\x74\x03\xb0\xf8\x3a\x5f\xe9\x88\xc8\x40\x92\x5b\xb0\xd5\xc2\xc4\x3a\xf0\x38\x3e\x68\x2b\xd3\x83\x61\xb3\xc5\x0d\x99\x9d\x1f\x7a\x4e\xa2\xc8\x7e\x64\xc2\x65\x74\xac\x91\xa3\xc1\xf7\x57\xb8\x5f\xb1\x57\xa7\xaf\xf5\x4e\x63\xeb\x99\x42\x7a\xc9\x49\x84\x2b\xda\x7a\xd1\xd6\x8b\xb6\x5e\xb4\xf5\xa2\xad\x17\x6d\xbd\x68\xeb\x45\x5b\xef\x8f\x61\xeb\x05\xbd\x40\x7b\x18\xaf\x93\x40\xbd\xf8\x23\x3e\x36\xf4\x72\xba\x24\x05\xee\x96\xee\xbb\x3b\xbb\xb9\x3a\x0f\xe8\x46\x35\xd9\x7e\x55\x5a\xae\xa5\xf8\xd3\xd5\x9f\xbe\xf8\x22\x30\xe8\xf1\x7f\xbe\x3c\x72\xd0\x23\x57\xa2\xae\x3a\x3e\x65\xef\x57\xf5\x2a\x24\x47\x5a\x57\x9e\xb8\xec\x43\x85\x0e\x46\x1f\xdf\x3f\xf5\x11\x42\xf3\x22\x50\xa4\x1d\x88\x50\x3c\xfa\x64\xb9\x8b\x08\xe9\xba\x4e\x62\x23\x1b\x68\x76\xdc\x85\x14\x72\x28\xce\x68\x10\x59\x8e\x82\x36\x79\xdc\x86\x04\xd4\x58\xa1\x32\x34\x20\x7d\xcb\x49\xee\x3d\xa4\xbb\x63\xeb\x72\xbf\x92\x69\x4d\x19\x72\x34\x02\xb5\x01\x8e\x73\x4f\x4f\x6f\x41\xca\x1d\x06\x27\x69\x2d\xc4\x85\x5c\xae\x97\x22\x6b\x91\x68\x5a\x8a\x76\x9b\xa5\x8d\xbc\x5c\x74\x62\x83\x3d\x64\x01\x6b\x85\x4c\x0b\x93\x38\xde\x54\x90\xe8\x0e\xdd\xfe\x4a\x6a\x5b\x09\xf1\xc9\x76\x5e\x19\xfd\x46\xa1\xce\x07\xce\x60\x72\x9c\x6b\xc6\x50\x17\x30\xce\x99\x9e\x14\x7e\x34\xe2\xbd\xdc\x7b\x73\x05\xa0\x86\x65\xc7\x81\x4f\x1a\xbf\x8c\x72\xf8\xfd\x47\x1f\xae\x17\x74\x34\xf6\x98\x36\xe0\x19\x80\xc3\x60\x1d\x8d\x30\xcc\xbf\xeb\xf7\x20\xb6\xa5\x29\x91\xd0\xd9\x89\x88\xb9\xca\x0d\x8c\x89\x45\xf4\xe6\xee\x9d\x34\x55\x75\x29\x22\xa7\xbb\x3e\xa8\x9e\x10\x44\x0c\xf0\x05\x00\x7a\xfc\x68\xee\x2c\x20\x6b\x77\x83\x45\x5f\x26\xc7\xbf\xb9\x46\xe4\x2b\x22\x5f\x11\xf9\x8a\xc8\x57\x44\xbe\x22\xf2\x15\x91\xaf\x88\x7c\x45\xe4\x2b\x22\x5f\x11\xf9\x8a\xc8\x57\x44\xbe\x22\xf2\x15\x91\xaf\x88\x7c\x45\xe4\x2b\x22\x5f\x11\xf9\xfa\xfd\x23\x5f\x5c\xd2\xbc\x89\xbc\xda\x01\xaf\xea\xe4\x60\x56\x19\x5f\xda\xaa\x6c\x76\x12\x1c\x80\x0a\x16\xe7\xd8\xc9\x81\x43\x90\x61\x2f\x49\x80\xee\xae\xa0\xb9\x5e\x63\x8a\xbc\x00\x31\x83\xd6\xe9\x76\x48\xd8\xae\x02\x52\x28\x75\xce\x10\x28\x80\x5a\x4d\x96\xa0\xc4\xb2\x31\x80\x37\x5c\xd4\x97\x13\xd9\x1d\x3c\x83\xcd\x26\xa0\xc4\xec\xba\x98\x5d\x17\xb3\xeb\x4e\x90\x5d\xf7\x9c\xd6\x28\xe5\xc6\x44\xd8\x9b\x6c\xe7\xa1\xde\xd1\x60\x80\x23\x7d\xc5\xca\xb5\xf3\x71\x7c\xf2\x4c\x3c\xb8\xc1\x19\x91\xa4\x26\x94\x46\xb0\xf4\x3c\x64\x26\x44\x42\x66\x1f\xfa\xe3\xf3\xbc\x44\x18\xbf\x00\xc0\x72\x22\xcd\x32\x68\x8a\x22\xab\x2b\x9c\xf0\x46\x89\xa7\xbc\xcc\x46\x46\xe7\x25\x6a\xe6\x33\x39\xde\x55\x78\xb0\x6c\xfe\x07\x26\xf0\xd9\xde\x41\x34\xcc\x9f\x63\x10\x16\x4e\x4e\x7e\xae\xfc\x39\xbc\xbd\xd3\x71\xcf\x7b\x64\x30\x01\x37\xc6\x03\xf0\x53\x2b\xab\x57\x5d\x8e\xd8\xde\x62\x49\xcb\xd4\x0b\x26\x65\xd3\x8a\x10\x6a\x20\x42\xac\x01\x6c\x4b\xce\xa8\xe7\x8c\xfc\x10\x0c\x75\x67\x12\x86\x84\xe0\x28\x48\xd1\xdf\x16\x40\x51\xc0\x0e\xd4\x93\x69\xfd\x53\x5d\xad\xbd\x0b\x7e\x07\x11\x87\x9d\xa8\xc1\xef\xe4\xa4\x17\x84\x51\xe9\x18\x1b\x50\x10\x55\x8c\x15\xf4\x39\xee\x02\x29\x6a\x37\xdf\xa4\xf3\x2e\x90\x62\xc7\xd5\x67\x78\x0a\x99\xec\x79\x42\x3c\xd3\x91\xb7\xb3\x54\x9f\x5c\xad\x55\xeb\xd3\x0b\xa6\x28\x76\xbd\x80\xb3\xfd\x7a\x07\xdd\x35\x9d\x8b\xe1\xc0\x69\x51\x63\xc5\x4a\x83\x49\x8a\x11\xf7\xe0\x98\xc3\x6f\x06\xe1\x81\x8b\x70\xdc\xe9\x37\x83\x2e\xc8\xf0\x21\x9e\xc2\x83\x16\x6f\x8e\xdf\x6f\x67\xe9\x8c\x2b\x09\x14\x87\xf3\x02\x06\x93\xb4\x2d\x45\xcc\x12\x19\x87\x97\x9d\xf1\x30\xec\x81\xfe\x0c\x7d\x87\xbb\x2e\xb6\x19\x44\xc7\xfc\x87\x07\xf2\xb9\xc7\x87\xd8\x61\x79\x06\xd1\x51\x3f\xe2\x6c\x57\xda\x89\xdc\x69\x33\x5d\x6a\x33\x4f\xcd\x83\x77\x0c\xdf\x13\x34\xfc\xc3\xf3\x0c\x1d\xe6\x66\x9b\xe9\x6a\x63\x7a\x8f\x8e\x35\x1b\x68\xc6\xe9\xae\x06\x21\x93\x31\xa7\xe3\xc2\x51\xd6\xbd\xa7\xed\x3a\xcc\x6b\x5b\x09\xda\x3f\xa9\x27\xf1\x7f\xb6\x5c\xff\xff\x07\xf1\x04\x3d\x43\x6b\x08\x3b\x35\xae\xf4\x0e\x1d\xf2\x90\x75\x5e\x19\x44\x1a\x38\xdb\xa9\xa2\x9d\x96\x74\xd5\x07\x3b\x78\x68\x51\x87\xd9\x76\x2f\xcf\xe0\x20\x02\x8b\xc6\x16\x6d\x3e\xfb\x24\x5f\xcf\x16\x3d\x8d\x18\x44\x12\x48\xbc\x2f\xcf\x5c\xb3\xda\x9e\xc2\x26\x4b\x34\x88\xa4\x82\x8e\xe0\x67\x48\xe7\x6c\x24\xb2\x75\x96\xc1\x3e\x63\xb7\x04\x3f\x62\xbb\x10\xb1\xa5\xbc\x27\xa8\xee\x71\xeb\x0b\x24\xe7\x8b\xfb\x88\x49\x58\x38\x7b\xf5\x7e\xd7\xde\x14\x17\xe4\xcd\x49\xd7\xb0\x3a\xcd\xe5\x57\x09\xd7\x04\xe9\x47\x30\xc3\x55\x4e\x17\xe5\x16\x67\xe4\x27\x3e\xaf\x5d\x3f\xa6\xb3\x84\x45\x34\xf4\x64\x98\xa1\x17\x42\xf5\x9e\xb7\x88\xa1\x67\x35\x1f\xc8\x6b\xee\xaa\x4d\x5b\x97\x7a\x26\x2e\xc8\x1f\x72\x99\x78\xc9\x9a\x1f\x55\x99\xce\x72\x1d\x22\x65\x93\x5f\x59\x4a\xd6\x4b\xc2\x26\x09\x7e\x84\x5e\x52\xcf\x40\x62\xc8\xe1\xcf\xf4\x4c\xbb\x1f\x27\xaf\xd4\x9a\xa8\xc3\x36\x16\x88\xc5\xc6\x64\x22\xe5\xcb\x73\xd5\x96\x25\x70\xa9\x4a\x72\x70\x6b\x65\x86\x6a\x82\x9c\x73\xc8\x3e\x9b\x24\xce\x17\x28\xc3\xce\x5a\xdb\xe0\x7a\xbc\xaf\x81\xeb\xaf\x84\x1c\x0a\x4f\xe9\xe9\xee\x8f\x2a\xcd\xa6\x85\x8e\x31\x86\x2f\x7d\x3d\x07\x67\x1f\xcc\x38\x18\x65\x7a\x34\x7c\x0d\xa6\x1b\x73\x74\x19\x35\xc5\xf1\x4d\xc3\x93\x65\x72\x92\x9d\x13\x62\x03\x5d\x75\xd9\x4b\x8e\xac\x5f\x67\x26\xb2\xbd\x9c\x24\x91\x6d\xe0\x1c\xfd\x8d\xe7\xb1\xf5\x07\x13\x93\xd9\x62\x32\xdb\xe9\x92\xd9\x70\xe4\xa8\xa5\x6d\x56\x9b\x87\xa8\xcb\x79\x0b\xc8\x6a\xf3\xd0\xa4\x9c\x37\x97\xd5\x86\xbd\xa6\x60\x32\x01\x96\xa9\xa4\x2b\x5c\x4d\x28\x9b\xcf\xce\xa6\xbe\xf9\x35\x05\x92\xd6\x03\x9d\x01\x9c\x02\x66\x39\xd0\x1d\x1e\xb2\x60\xeb\xc2\x86\xaf\x6a\xac\x90\xbd\xd0\x00\x28\xc0\x51\x80\xa3\xb8\x4e\x9f\x1a\x5d\xf6\xf6\xe6\x63\x99\x59\xbd\x0d\xf2\x0e\x4f\xea\xda\x39\xe4\xd0\x66\xb8\x80\x03\xbe\x00\xc1\x81\x23\x98\xb4\x69\x12\x6e\x93\xda\xae\xbe\x46\xae\xd7\xf9\x67\x59\x3a\xf3\x01\x22\x05\x18\x54\xd3\xc6\x05\x29\x78\xcc\x2d\x10\xa0\x52\xf9\x24\x59\xf8\xcc\xac\x5d\xb3\xc6\x4b\xb1\x67\xf6\xb0\xcc\x19\x2f\x49\xbd\x91\xac\x19\xf3\x75\xe7\xfc\x7d\x33\xdf\x90\x71\x06\x0c\xee\x56\x6b\xc2\xb8\xe5\x77\x06\x4c\x72\x3c\xbf\x7d\x4f\x30\xfc\x5f\xdf\x03\xa8\x1c\x01\x6e\x9b\x05\xb5\x85\x22\x14\xc3\x7b\x3c\xef\xa9\xc1\xa0\xf7\xc3\x6b\x16\x32\x63\x92\x15\x0e\x96\xe8\x1e\x22\xe3\xb7\x6f\x36\xcd\xa0\x5b\x7a\xe0\x15\x70\x74\xf5\xc7\x06\x91\x1c\x15\x4a\x8b\x31\xf0\xcc\x18\xf8\x31\xd8\x0c\xa7\x34\x88\xa4\x39\xff\x77\x5d\x18\xfc\xc1\xcf\xb8\xf5\xd0\x0f\xad\xd9\x01\xd3\x30\x0a\x93\xc1\x5c\x04\x74\xb7\x26\x1b\x78\x1a\x22\xd3\xd5\xa0\x02\x89\x12\x7b\x7b\xe0\xb1\x40\xb9\x84\xff\xe6\x43\x63\xbf\x54\x28\xfc\x28\x1c\x16\xce\x47\x67\x63\x92\x61\xbe\x2f\x28\x3e\x90\xea\x8e\x57\x75\x37\x28\x3e\x90\xe2\x08\x7f\x7b\x00\xad\x63\xb1\xda\x01\xb3\x02\x49\x6a\x3a\xd3\x40\x56\x20\x49\x8c\x22\x8f\x15\x91\x7e\x2f\x15\x91\x66\x01\x54\x87\x81\x53\x33\xd6\xb4\xa7\x73\x8e\x09\x4a\x9d\x08\x90\x3a\x29\x18\xc5\x03\xa2\x42\xa0\x79\x06\x08\xd5\x07\x96\xd8\x94\x0f\x07\xa0\x02\x77\x40\xd0\xd7\x9d\xab\xfd\x3a\x09\x14\x42\xf7\xe8\xa1\x80\xd3\x29\xc0\xa6\xe3\x03\x4d\x01\xda\x3b\x70\x7f\x87\xe8\xab\xce\x25\xfd\x3a\xf9\x25\x41\x25\x3e\xa0\xc4\xc9\x76\xe8\x28\x62\x1e\x98\xd4\x91\x31\x9e\xde\x98\x06\x92\x76\x3d\x2a\x4c\xa2\xe3\x20\x92\xf3\xaa\x74\xd6\x8b\x45\x71\x9f\xdf\x65\x12\x18\x62\x51\x1e\x82\x47\x47\x01\x85\x02\x24\x9d\x6b\x5b\x84\x00\x41\x6c\x5d\xc7\xd9\x62\x0c\x62\xe0\x7e\x2d\x9b\x9c\x5c\xb0\xd7\x09\x6b\xdf\x0d\x92\xaa\xba\xbb\xa4\xeb\xe0\xc7\x86\x67\x7b\x29\x0a\xe3\x0b\x4f\x3f\xab\x3c\x13\xdb\xb6\x81\x84\x0f\x5e\x76\xd5\x04\x4d\x93\x77\x15\xb3\xab\x5c\x76\x55\x6f\x79\x3a\xf9\x37\x1e\x8a\x7b\x20\x11\x4f\x8a\x95\x87\x28\x25\x60\x85\xa5\x58\x79\x88\x9a\x04\x2c\xb7\x4c\x9c\x14\x2b\x0f\x4d\x4a\xc0\xfa\x0d\xa5\x58\xed\x5b\xe7\x98\x67\x15\xf3\xac\x62\x9e\x55\xcc\xb3\x8a\x79\x56\x31\xcf\x2a\xe6\x59\xc5\x3c\xab\x98\x67\x15\xf3\xac\x62\x9e\x55\xcc\xb3\x8a\x79\x56\x31\xcf\x2a\xe6\x59\xc5\x3c\xab\x98\x67\x15\xf3\xac\x62\x9e\x55\xcc\xb3\x8a\x79\x56\x31\xcf\x2a\xe6\x59\xc5\x3c\xab\x98\x67\xf5\xb3\xe5\x59\xf5\x20\x9b\xf1\x64\xab\x49\xa2\x62\x90\xae\xc4\x4c\xb6\xf2\xd0\x44\x18\x92\x9b\x6c\xd5\x1d\x82\x87\xee\xf8\x00\xa7\x33\xae\x3c\x24\x7b\xf9\x58\xdc\x8c\x2b\x0f\xcd\x7e\x3e\x56\x48\xc6\x95\x87\xf0\x6e\x97\x31\x7f\xc6\x95\x8f\x24\xe5\x63\xc5\x8c\xab\x98\x71\x15\x33\xae\x62\xc6\x55\xcc\xb8\x8a\x19\x57\x31\xe3\x2a\x66\x5c\xc5\x8c\xab\x98\x71\x15\x33\xae\x62\xc6\x55\xcc\xb8\x8a\x19\x57\x31\xe3\x2a\x66\x5c\xc5\x8c\xab\x98\x71\x15\x33\xae\x62\xc6\x55\xcc\xb8\xfa\x45\x33\xae\x3c\x5f\x68\x54\x01\x07\xcf\x7e\x7f\xcc\xa4\x06\x19\xec\x54\xed\x66\x46\xd7\xfa\x83\xa5\x0b\x32\x9b\x36\x4d\x0a\x6e\x7d\xd0\x8d\xe6\x8d\x13\x6a\x16\xd2\xf3\xe0\xf4\x6a\x4c\xde\x8b\x95\x2e\x29\x9a\x0a\x5c\xd4\xe2\x6b\x7b\xde\x2f\xe4\xd3\x93\x5c\x35\x6f\x44\x5b\x4f\xad\xa6\xb5\x08\xc0\x8a\xb6\x67\xed\xd7\xf4\xb7\x37\xcb\x64\xbe\x1b\x41\x73\x70\x9d\x30\x15\xda\x2d\x7e\x5d\xe4\x65\x96\xaf\xac\x43\x44\x0f\x57\x53\x82\x49\xda\xf8\x0d\x75\xbd\x13\xf4\xf9\x80\x5f\x87\x2d\xd0\x23\x54\x1b\x1f\xbf\xd5\x3f\x0b\xda\x25\x93\x84\xad\x21\x21\xc5\x9d\x32\x10\x94\x5c\x88\x0f\x98\xeb\xe4\x7e\x83\x5e\x9e\x3b\xa5\x73\xd0\xe4\x32\x39\x70\xbf\x79\x5c\x2f\xbd\x29\x34\xdb\xde\x4d\x1c\x39\x5a\xb4\x8c\x38\xd1\x33\x47\xf2\x04\x5d\x48\x07\x5e\x4e\xce\xe5\x27\xf9\xea\xae\xb7\xc6\xc5\x83\x37\xd0\x69\x15\x6e\x85\x8c\xae\x83\xfa\xb6\xf9\x95\x71\xb4\xaa\xcd\x63\x5e\x6a\x26\xf5\x6b\x69\xd1\x27\x89\x02\x57\xb4\x3c\xe0\x63\x2b\xb0\x19\x46\x7d\xf0\xe4\x13\xb3\xec\x15\xf8\x7e\xbf\x8f\x67\xe8\xb5\x49\x58\x97\x67\xe3\xcb\xb1\x9c\xc0\xbd\x9f\xe6\x0c\xc7\x7a\xfb\x53\x9b\x16\x4b\x00\x67\xd2\xb6\xf0\xc4\x33\x37\x8a\xbe\x6e\x08\xec\x18\xf5\x2f\x79\x91\xad\xd2\x2a\xc3\x56\x66\x38\xa3\xd3\xab\x59\x03\x56\x93\x36\x06\x1f\x58\xa5\xa5\x55\x63\x4e\x52\xb0\xf2\x60\x2a\xb6\x69\xd5\xe4\xab\xb6\x48\xa7\xaf\x8b\xb0\xf7\xd7\xaa\x7a\x3d\x78\xed\x9c\xb8\xdf\xcb\x95\x2a\xb3\x9a\xbd\x88\x0f\xc3\x27\xbb\xab\x09\xd2\xbe\x95\x55\x8e\x70\xc8\x04\x45\x81\x55\x35\x87\x1b\xef\xc2\xe4\xd2\x19\xd9\x57\x4f\xa4\xdb\xac\xc2\xf0\xec\x1e\xc0\x25\x5f\xf2\xda\x34\x3f\xb4\x37\xa6\x5c\xa7\xbf\x5e\xd2\xbb\xba\xea\x73\x6a\x26\x85\xf8\xe6\x55\x64\x5a\x76\x16\x22\x6f\xc8\x6a\xa8\xa5\x6d\xc1\x4a\xdb\xd0\x2c\xab\x25\x3b\x49\xf5\x49\x55\x12\x80\x97\x8b\x0c\xb2\x61\x1b\x0d\xb8\x5e\x2e\xc5\x3f\x64\x05\x37\xc7\x4c\x94\x72\xad\xd1\x3e\xb3\x6d\xbd\x45\x47\x1f\xe1\x90\x93\xa9\x69\xe9\xfa\x85\xb8\x40\x92\x22\xdf\x6c\x64\x06\x79\x64\xc5\xeb\xa5\xc6\xaf\x09\x23\x5e\x26\xac\xc0\x8b\xbf\xfc\x39\x39\x34\xe0\x02\x87\xc0\x96\xae\x1f\xe0\xdb\x7d\x35\x8d\x04\x86\xa2\x62\x8e\xf7\x09\xb2\x20\xe3\xa3\x0e\x46\xea\x1b\x6d\xb5\x48\xe7\x92\xc0\x51\xd1\x56\xc8\xfe\x09\x72\x9a\x8a\x4a\xae\x61\xdf\x9a\x1d\x77\xe0\xce\x64\x5a\x66\xe3\xe6\xdd\xc4\xc3\x79\xb9\x06\xe7\xc3\x75\x32\x39\xfb\xef\xf5\xb7\x20\x2b\x57\x81\x65\x24\xee\x5f\xcb\x4c\xd6\x39\x78\x04\xc4\xb7\xed\xa3\xac\x4a\xd9\xb8\xa8\xb5\x4a\xb5\xe3\xc9\x4e\x14\xdf\x91\x7e\x4e\xf3\x22\x7d\x1c\x09\x0f\x98\x36\x91\x56\x45\x5a\xd7\x77\xe9\x46\x5e\x27\x5e\x89\x79\x0b\xdf\xa5\x6b\x82\x19\x26\x7a\x32\x2a\x55\x40\xb2\xfa\xce\x58\xf6\x2d\x2f\x3c\x6f\x36\x3b\xa6\x7d\xe7\x4f\xb4\xd9\x93\x19\x8b\xd9\x14\xf5\xbd\x5c\x55\xb2\x61\x0c\x01\x46\xea\x2e\x3a\xf0\x90\x78\x56\x45\x46\xd7\xc3\x87\xef\xee\xc5\x0a\x26\xeb\x09\x6d\xbf\xfd\x9a\x15\x9e\xc7\x35\x11\xcf\xaa\x6e\xe0\xda\x18\xce\xf9\xa4\x0c\x35\x72\x6d\x54\xbf\x8d\xd8\xb9\x4e\x26\x87\xf6\x56\x95\x4f\xf9\xba\x35\xbb\x56\x3d\x09\x8a\xe9\x40\x3d\xd7\xb1\xf7\xe1\x48\xed\xbc\x60\x4c\xaa\x46\x2f\xd7\xd3\x82\x44\x57\x74\xc6\x22\x58\xc6\xe0\xe6\x21\xd6\x95\x6a\xb1\xdf\x08\x51\xe8\x06\x29\x61\xc1\x88\xf1\x8d\x3e\xcd\x0e\x25\x23\xdf\x4c\xb2\x35\x51\xc7\x02\x1e\xde\xcf\x12\xd8\x25\x7b\x29\x0a\x72\x50\xec\xd7\x50\x7f\x84\x2a\x13\x23\x85\x07\x9c\xab\x25\x24\x88\x2d\xf6\xf0\x8d\x3d\x7c\x4f\xd4\xc3\xb7\xeb\xbb\xe8\x07\xc7\x0d\x81\x04\x9f\x87\x98\x53\x4d\x82\x5f\x2f\xe2\x3f\xec\x3d\x6b\x73\xe3\x36\x92\xdf\xf9\x2b\x50\xae\xad\x1a\x29\x91\xe8\x99\x64\x2b\x77\xe1\x6d\x76\xca\x71\x66\x92\xbd\xcc\xc3\x65\x3b\x9b\xba\xb5\x7d\xb7\x10\x09\x49\x88\x49\x80\x47\x82\xb6\x75\x9b\xfd\xef\x57\x8d\x07\x1f\x12\x5f\x92\xc7\x93\xc7\x76\xcd\x17\x8f\x08\x36\x1b\x40\xa3\xd1\xef\x1e\x63\xdc\x69\x3d\xd8\x27\xc2\x5a\xa7\x2b\xca\xac\xa8\x44\xd7\x3c\x18\x25\xd0\x39\x65\xd6\xdc\x4e\xb9\x09\x56\xe6\x49\x1a\xf3\x90\x2b\x4b\xc7\xe4\x39\x99\x68\x52\xe5\xea\x19\x30\x72\x21\xe7\x32\x9d\xfa\x83\x70\x4f\x8c\x1d\x7d\x10\x41\x22\xa4\xfb\xfe\x20\x4c\x8b\x08\x9c\x8e\x5c\x8e\xc6\x65\x1c\x17\xae\x9f\x74\x26\xc2\x0e\x49\xa9\x6f\x4f\x0c\x5b\xa9\xa2\x78\xb6\x2a\x4f\xe8\xd5\x1d\x01\x94\xb4\x90\xe9\xd3\x55\x9e\xd8\x3e\x00\xe3\xde\xda\x99\xba\x0b\xfd\x6a\x2e\x41\x9d\x21\x8d\x84\xab\x23\x9b\x01\xca\xb3\xdc\x98\xf1\x47\x47\x1b\x8d\x3a\x45\x9d\x13\xe8\x44\x7c\xbf\x7c\x5d\x8c\xe2\xfa\x40\x51\x5c\x97\x3b\xe1\x5b\x35\xb7\xc5\x1e\x31\x00\x76\x11\x7f\x73\xe1\x5b\xe7\xfd\x16\xbd\xbd\x00\x93\xee\xa8\xad\x12\xd5\x7d\x88\xdc\x69\xa4\x3b\x51\x5b\xb3\x46\x08\xcf\x7e\x4b\x4d\xc8\xb7\xca\xb8\xd1\xdf\x8c\x62\x74\x8f\xde\xa4\x47\x87\x6f\x9d\xec\x04\x6d\xed\x7d\xb2\x3a\x83\xa2\xb6\xeb\x12\xec\x09\xb1\x35\x12\x6a\xa7\x26\xc1\x9e\x40\xeb\xf8\x7d\x9c\xa0\xad\x47\xa3\xf9\xad\x02\x14\xdf\x34\x0a\x25\x0c\xb8\xf2\xda\xff\x69\xf7\xc1\x9a\xde\x69\x41\xd7\x44\xbb\x58\xa1\xd5\x19\x2e\x47\xf4\x31\xda\xfe\xb7\xb0\x29\x16\x69\xc6\xac\xa1\x91\x0a\x67\xfe\x7b\x44\x21\x86\x27\x28\xc2\x80\x11\x6b\xbf\xaf\x88\xb5\xd7\xa0\x70\x8f\xde\x9d\x26\xd7\x7b\x1a\x59\x4f\x6b\x7c\x28\xeb\xa1\xac\x87\xb2\x1e\xca\x7a\x28\xeb\xa1\xac\x87\xb2\x1e\xca\x7a\x28\xeb\x3d\x46\xd6\xfb\x18\x05\x2f\x7e\x7c\x92\x82\x17\x60\x8c\x73\xe1\xbb\xbf\x83\x8a\x17\xa5\x4d\xf9\x5f\xb3\xd8\x85\x73\x1f\x75\x96\x81\xc0\xa6\xc2\x1f\xa4\xa9\xb0\x68\xab\x5d\x31\x00\x76\x7c\x2f\xe1\xb2\x76\xc5\x00\xc4\xb2\xb2\x85\xf7\x61\xd4\x8c\x6d\x5e\x30\xe2\x9e\xe9\xac\x0c\xde\xae\xb9\x82\xbb\x6c\x94\x1c\x07\x36\x69\x3d\x58\x4b\xc4\xef\xcf\xc7\xc4\xb9\x8f\xbe\x1a\x1b\x48\x9f\x6c\x25\xa1\xec\x22\x3c\x5e\xd7\x6f\xb8\xd8\xfc\xdd\xa4\x22\xed\x73\x65\xc9\xe8\xfc\x7a\x93\x5b\xaf\xe1\x5c\xda\x68\xfb\xfa\xfe\x68\xae\xa3\x9d\x88\x7b\xd8\x02\xc0\x7b\xbc\xb0\x3a\x0b\xd0\xee\xbb\xad\x4d\xf7\xbd\x0f\xaf\xb9\xa2\xe7\x0b\x3d\x5f\xe8\xf9\x42\xcf\x17\x7a\xbe\xd0\xf3\x85\x9e\x2f\xf4\x7c\xa1\xe7\x0b\x3d\x5f\xe8\xf9\x42\xcf\x17\x7a\xbe\xd0\xf3\x85\x9e\x2f\xf4\x7c\xa1\xe7\x0b\x3d\x5f\xe8\xf9\xfa\xfd\x7b\xbe\xc6\x82\x1e\xb7\x90\xf3\x5d\x83\xb5\xf7\x68\x54\x47\x0c\xaa\x55\x77\x0e\xbc\x51\x8c\x7d\xab\x99\xb3\xf3\x73\xec\xe4\xc0\xe9\x3a\xda\xde\x88\x82\xc5\xe3\x7a\x38\xbb\x4e\xcd\x3d\x10\xb1\x87\x73\xd9\xc3\xb9\x25\xf5\xaa\x72\x2f\x61\x76\x1d\x66\xd7\xfd\x0a\xb2\xeb\xb0\x73\x33\x76\x6e\xc6\xce\xcd\xd8\xb9\x19\x3b\x37\x63\xe7\x66\xec\xdc\x8c\x9d\x9b\xb1\x73\x33\x76\x6e\xc6\xce\xcd\xd8\xb9\x19\x3b\x37\x63\xe7\x66\xec\xdc\x8c\x9d\x9b\xb1\x73\x33\x76\x6e\xc6\xce\xcd\xd8\xb9\x19\x3b\x37\x63\xe7\x66\xec\xdc\x8c\x9d\x9b\x7f\xd3\x9d\x9b\xed\x02\x60\x32\xdb\x13\x27\xb3\xe9\x87\xcd\x8e\xcc\x03\x40\xf7\xe8\xd7\x5c\x65\xb5\x0d\xc0\x1c\xdf\xaf\xb9\xf4\xb2\x8d\x41\x13\xfb\x35\x63\xbf\x66\xec\xd7\x8c\xfd\x9a\xb1\x5f\x33\xf6\x6b\xc6\x7e\xcd\xd8\xaf\x19\xfb\x35\x63\xbf\x66\xec\xd7\x8c\xfd\x9a\xb1\x5f\x33\xf6\x6b\xc6\x7e\xcd\xd8\xaf\x19\xfb\x35\x63\xbf\x66\xec\xd7\x8c\xfd\x9a\xb1\x5f\xf3\x2f\xd5\xaf\x59\xaf\xe1\x89\x50\xdc\x99\x60\x03\x6f\xd4\xb9\xdb\x4a\xaa\xaa\x9f\x92\xba\x81\x5f\x37\x3c\xeb\x84\x48\xac\x2d\x9c\xde\x49\x1e\x91\xb4\x50\x90\xf0\x31\x2e\xbb\xaa\x07\xa6\xcd\xbb\xc2\xec\xaa\x2a\xbb\xaa\xb1\x3d\xb5\xfc\x9b\x01\x88\x1d\x2e\x91\x81\x14\xab\x01\xa0\x2e\x01\x6b\xbf\x14\xab\x01\xa0\x36\x01\xab\xda\xa6\x31\x29\x56\x03\x30\x5d\x02\xd6\x6f\x28\xc5\xaa\x6b\x9f\x31\xcf\x0a\xf3\xac\x30\xcf\x0a\xf3\xac\x30\xcf\x0a\xf3\xac\x30\xcf\x0a\xf3\xac\x30\xcf\x0a\xf3\xac\x30\xcf\x0a\xf3\xac\x30\xcf\x0a\xf3\xac\x30\xcf\x0a\xf3\xac\x30\xcf\x0a\xf3\xac\x30\xcf\x0a\xf3\xac\x30\xcf\x0a\xf3\xac\x30\xcf\x0a\xf3\xac\x30\xcf\xea\xa3\xe5\x59\x35\x5c\x36\xed\xc9\x56\xbd\x40\xc9\x56\xba\xd2\xc8\x64\xab\x01\x98\xda\x0d\x39\x36\xd9\xaa\x3e\x85\x01\xb8\xed\x13\xec\xcf\xb8\x1a\x00\xd9\xc8\xc7\x1a\x9b\x71\x35\x00\xb3\x99\x8f\xb5\x4f\xc6\xd5\x00\xe0\xdd\x2e\x63\xc3\x19\x57\x43\x20\x5d\x3e\x16\x66\x5c\x61\xc6\x15\x66\x5c\x61\xc6\x15\x66\x5c\x61\xc6\x15\x66\x5c\x61\xc6\x15\x66\x5c\x61\xc6\x15\x66\x5c\x61\xc6\x15\x66\x5c\x61\xc6\x15\x66\x5c\x61\xc6\x15\x66\x5c\x61\xc6\x15\x66\x5c\x61\xc6\x15\x66\x5c\xfd\xa2\x19\x57\x03\x03\x94\x8c\xe1\xe2\xe9\xb6\xc7\xf4\x72\x90\xad\x93\x6a\xcc\xcc\xda\xb4\x7e\x59\xc2\x05\x9a\xa5\x4a\x51\x30\xeb\x03\x6f\xb4\x5f\xec\x61\xb3\x90\x9e\x07\xb7\x97\xb2\x79\x2f\x25\x75\x31\xa2\x32\x30\x51\x93\x3f\x95\xf7\xfd\x8c\x2d\x97\x2c\x54\x7f\x26\x45\xde\xb7\x9b\xa5\x44\x00\x52\x74\x79\xd7\xfe\xc9\xfd\xf5\x67\xdf\x3b\xdc\x8c\x60\x30\x08\xbc\x91\x0c\xed\x95\x1e\x4e\xb8\x88\x78\x58\x1a\x44\xcc\x74\x0d\x24\x58\xa4\x64\x58\x50\x37\x27\xc1\xdc\x0f\x7a\x38\x1c\x81\x06\xa0\xdc\xda\xf8\x4b\xfe\x33\x73\xa7\xa4\x17\x70\x29\x48\x30\xf2\x4e\x5a\x17\x14\x9b\x91\x33\x9d\xeb\x54\xfd\xa2\xad\x3c\xef\xa4\xc9\x41\x63\xbe\xf7\xc8\xf3\x36\x60\x7a\x69\x2c\xa1\x3d\xf6\xd5\xc2\x39\x43\x8b\xa1\x91\x8a\xf4\xec\x95\xdc\x03\x17\xd2\x81\xfd\xde\xb5\xbc\x65\x9b\x4a\xbd\xb5\x26\x1e\xad\x81\xf6\xb3\xf0\x92\xc8\x9c\x3a\x68\xb4\xcd\xff\xb0\x86\x56\x99\x2c\xb8\x30\x48\x9a\xcf\xba\x4d\xef\x05\x0a\x58\xb9\xed\x01\x1b\x5b\xac\xab\xfa\xe4\x8f\x5e\x7c\x87\xec\xe8\x1d\x78\xdf\x6d\xe3\xd9\xb6\xda\x78\xa3\x94\x67\x6b\xcb\x29\x31\x01\xbd\xdf\xad\x99\x9e\xeb\xab\xff\x2d\x68\xec\x83\x73\x86\x16\xf1\x40\x3c\xb3\x92\x6e\xb8\x05\xb0\x23\xd4\xdf\xf3\x38\x0a\x69\x16\xe9\x56\x66\x7a\x45\xfb\x77\x33\x07\x5f\x0d\x55\xd6\x3f\x10\x52\x51\xb2\xb1\x8a\x52\x74\xe5\x41\x4a\x52\x9a\x29\x1e\x16\x31\xed\x57\x17\xe1\xec\xaf\x64\xb6\x79\xf4\xde\x55\xe4\x7e\xc1\x42\x29\xa2\x7c\xf4\x26\x5e\x6e\xbf\x59\xdf\x4d\xa0\xf6\x94\x65\x5c\xbb\x43\x7a\x20\x12\xed\xe8\xdd\x3e\x78\x13\x9b\x4b\x67\x69\x5f\x2e\x1d\x6f\x2b\x19\xc6\xc0\xe9\x01\xbf\xe4\x3d\xcf\x6d\xf3\xc3\x52\x63\xe2\x26\xfd\x75\xea\xbe\x55\x67\x9f\x7d\x2b\x49\xc8\xd7\x1b\x12\x19\xda\x99\x11\xae\x9c\xd4\x90\xb3\xb2\x05\xab\x3b\x86\x76\x5b\x4b\xb0\xbd\x50\x97\x32\x63\xe0\x78\x99\x44\x90\x0d\xab\x4c\x01\xcc\xa9\x4f\xfe\xc6\x32\xd0\x1c\x23\x22\xd8\xca\xd4\x57\xb4\xc7\x76\xb0\xe8\xe8\x02\x2e\x39\x46\x6d\x4b\xd7\xe7\x64\xa2\x41\x12\x9e\x24\x2c\x82\x3c\xb2\x78\x33\x35\xfe\x6b\xe7\x23\xf6\xbd\x51\x81\x17\x5f\xfc\xd1\x7b\x6c\xc0\x85\x9e\xc2\x68\xea\xfa\x2b\x8c\x6e\xb2\x69\x0d\x60\x9b\x54\xec\xf5\xde\x03\x16\x68\xbc\xd5\xc0\xe8\xfa\x46\x97\x5c\xa4\xa6\x24\x8c\x61\xd1\x25\x91\xfd\x04\x74\x4a\x49\xc6\x56\x70\x6e\xed\x89\x7b\xe4\xc9\x1c\x29\x99\xb5\x8b\x77\x3d\x2f\xff\x24\x17\xf6\x0a\xe6\x62\x15\x78\xbd\x7b\x70\x2a\xc5\x92\xaf\x0a\xbb\xd2\x4e\x00\xaf\x62\x34\xec\x2f\xda\xc1\x6d\xff\x5e\xd0\xf0\xb6\x48\xdb\x16\x2e\x63\xb9\x92\x19\xd3\x64\x1d\x51\x45\x17\x50\x9e\x31\xe1\x2b\x0b\xfd\x27\xb9\xc8\x67\xa6\xb2\x53\x64\xbc\x91\x5c\x2c\x33\x5a\xe1\xea\xed\x27\x5c\x39\x9d\x2c\xf0\x06\x49\xcd\x45\x17\xd4\xe7\x33\x83\xe3\xc3\x85\xe5\xd8\x20\x02\xf9\xe4\x2f\x10\x05\x12\xae\x59\xd2\x95\xbf\xc3\x73\x12\xb3\xa5\x22\xb2\x50\x0e\xd6\xe9\xf9\x37\xce\xf3\x9d\xb1\xd4\x1c\xcc\x32\x39\x1b\xfa\x48\x4a\x01\x77\x0a\x6f\xdf\x63\x42\xee\xb5\xd6\x9a\xd0\x5b\xe6\xc0\x11\x25\x25\x89\x29\xd8\x63\x4d\x99\x5f\x23\xa5\x74\xa8\x2e\x83\x64\xf4\x30\xbf\x2d\x16\x2c\x13\x4c\xb1\x7c\xae\x2f\xe3\xec\x8e\xcd\x0b\x71\x2b\xe4\xbd\x98\x6b\xce\x99\x07\x44\x65\x45\x9b\x76\x58\x6f\xaa\x1b\x78\x8f\x35\x1a\x0e\x9e\x8a\xc6\xae\x59\x5b\xa0\x5d\x67\x57\x4b\xc0\x52\x23\xcd\x4a\x42\xd5\x91\x40\x87\xac\x4d\x9a\x71\x99\x71\xb5\x39\x8d\x69\x9e\xbf\xa3\x09\x0b\x86\x91\x3a\xb3\xef\x90\x10\x5e\xaa\x13\x94\x77\xc0\x8c\x4b\x37\xfc\x88\x2f\x9f\xbb\xb1\xee\xa3\xd6\x86\xc7\xb2\xf2\x17\x38\x62\xde\x61\x6a\x4a\xcc\x13\xae\x3a\x9f\xee\x6f\x1b\xa6\x62\xf3\x7e\xd9\x37\x60\x3e\xf2\x52\xa9\x46\xf6\xd0\x8d\x9d\x25\x55\x8a\x65\x22\x20\xff\x3d\xb9\xfe\xf4\xe7\xf9\xf4\xe5\x64\x72\xf5\x7c\xfe\xe5\xcd\xa7\x93\x6b\x5f\xff\xf1\xc9\xf4\xe5\xf4\x67\xf7\x9f\x4f\xa7\xd3\xc9\xe4\xea\xfb\xb7\xdf\x5e\x9e\xbd\xba\xe1\xd3\x9f\xaf\x44\x91\xdc\x9a\xff\xfd\x3c\xb9\x62\xaf\x6e\x46\x02\x99\x4e\x5f\xfe\xa1\x07\xa9\xc6\xf1\xe3\x42\xcd\x65\x36\x37\x33\xe9\x3c\x74\x2d\x9b\xff\xec\x8d\xde\x1f\xfb\xe3\xc2\x9e\x84\x84\x3e\xf0\x04\x0a\x05\x24\xb2\x30\xe6\x67\x5b\x50\xa1\x07\x9f\x92\xe4\x4a\x8b\x08\x79\x0b\x5c\x9b\x8b\xa5\x0c\xc8\x5a\xa9\x34\x0f\x8e\x8f\x2b\x9c\x7d\x2e\x8f\x23\x19\xe6\xc7\xa1\x14\x21\x4b\x95\xfe\xa3\xba\x34\x8e\x13\x2a\xe8\x8a\xcd\xed\x87\xe7\x25\xf8\x79\x49\x9e\xc7\xcf\x3a\xd1\x19\x64\x5e\xc4\x15\xa1\x40\xd2\xfc\xd5\x92\xe6\xb9\xdd\xa1\x6d\xe2\xe4\xe2\x11\xc4\xe9\x0c\x6b\x5a\xae\x2b\xbf\xc0\x73\x22\x13\xae\xdc\x1d\x4b\x2b\x1e\xd8\x27\xc9\x71\xe5\x44\x7b\x90\xdf\x89\x3d\x4a\xba\xc4\x87\x69\x96\xcd\x1e\xd2\x98\x87\x5c\xc5\x9b\xca\x16\x3b\xab\xc4\xbf\x1e\xd0\x10\x74\x20\x08\x4f\x52\xe3\x01\xd2\x92\xce\xdc\x59\x60\xad\xe2\xfa\x2b\x3e\x5e\x03\x03\x2a\x29\x1c\x8d\x7f\x68\xfc\x43\xe3\x1f\x1a\xff\xd0\xf8\x87\xc6\x3f\x34\xfe\xa1\xf1\x0f\x8d\x7f\xbf\xa0\xf1\xaf\x0a\x98\xb8\x48\x33\x46\xa3\x53\x29\x20\xec\x0f\xb8\x4c\xe0\x0d\x2e\xfd\x77\xf2\x7e\xcb\x8c\xa2\x81\x10\x1a\x66\x32\xb7\x1b\x62\xc1\x93\x48\x26\x50\x11\xb8\x6b\xa5\x4c\xf8\xa1\x8b\x6d\x08\x2b\x34\x9c\x41\x62\xcd\x57\xeb\x39\xbd\xa3\x3c\xa6\x0b\x1e\x83\xd9\x24\xe9\x8a\x42\xd8\x43\x94\x74\xd8\x59\xc4\xab\xef\xb6\xda\xf7\x3a\x40\x92\x56\xbb\x5f\x87\x85\x8f\xd0\xaa\x7c\x63\x0f\xc3\xab\xf7\xfa\xf2\x0f\xdd\xf9\x47\x5a\xec\xfa\x49\xa7\xe7\xeb\xb0\xd3\x8a\x09\x2a\x42\xf6\x23\x17\x91\xbc\x6f\xd9\x8d\xe6\x3e\x00\x6b\xbf\x37\x43\x41\x73\x53\x19\x0f\x75\xc5\xd4\x7b\x90\x2b\x23\x9e\x67\x45\xaa\xb9\x9b\xb9\xb6\xbb\xa2\xe1\x38\xf3\x49\x91\xae\x32\x1a\x59\xc1\xa5\x32\xeb\x19\x5b\x33\x58\x8d\xe9\x06\x42\x3c\x7c\xf2\x23\x57\x6b\x30\xbe\x6a\x2d\x60\xad\xb3\x94\xdb\xce\x47\x56\x08\x42\xed\x28\x9e\xb4\xc8\x9f\x9d\xd4\x36\x24\xd3\x47\x56\x31\x1b\x45\xa9\x70\xd4\x62\x69\x8f\x87\x59\x29\x92\x2b\xba\xc9\x81\x51\x89\x19\x61\x2b\x9f\xfc\x71\xed\x1d\xc8\x45\xdc\x3a\x8d\x42\xe5\x42\x51\x11\x81\x4c\x13\x66\x52\xd4\x6a\x71\xce\x08\x03\xce\xad\xcd\xd6\x5c\x90\x1f\x2e\x4f\x67\x70\x6f\x75\x80\x24\x8e\xcb\x8a\x9a\x77\xc0\x4c\xec\xb0\x59\xf4\xc5\xcf\xcc\xcb\xc5\x6e\x7d\xe8\xa6\xef\xed\x79\xc6\xba\x8f\x87\x60\xea\x5e\x66\xb7\x67\x12\x8c\x01\x6d\x04\xd0\x58\xd1\x73\x4b\xf1\x96\x65\x66\x70\xf0\x43\x97\xb4\x57\xf7\x98\x94\x4e\x80\xae\xdc\x01\x1a\x45\xd0\x1b\x8a\x09\xba\x88\xad\x1a\xac\x2b\x3c\x0a\xc1\x42\x60\x79\xfa\x0b\x1b\xd8\x34\x16\x2a\xbd\x3d\xb5\x40\x31\x12\x31\xb1\x69\x5f\x5c\x10\x3e\x17\xa5\x64\xe3\xed\x47\xea\x16\x9b\xc0\x1b\x24\xad\x57\x7a\x24\xe0\xe8\x56\x90\xa4\x76\x09\x5b\x5f\x36\x1b\xb0\x90\x32\x66\x54\x78\x7b\xec\x1d\x38\x8d\x32\x1e\x0d\xee\xcc\x99\xb5\x09\x58\xbf\x89\x5b\xd0\x8c\x89\x88\x65\x2c\xaa\x59\x99\x16\x0c\x1c\xb4\x80\x7a\x1b\x17\x81\xcb\x11\xa2\x33\x69\x1c\x83\x3d\x08\xea\x1f\x33\x45\xee\x9d\x2a\xa9\x25\x04\x57\xc6\xd4\x89\x78\x0f\xa9\xcc\xd9\x87\x63\x37\x34\xe5\x7f\x65\x59\x3e\x96\xe1\x9c\x9c\xfd\x85\xdc\x99\xf1\x8e\xfa\x76\x66\x0d\xb6\x48\x38\x3a\x87\x1d\x58\x42\x6e\xb9\x88\x46\x21\xf3\x3d\x17\xd1\x93\x61\x21\x3a\xdd\x35\x5b\x58\x80\x5f\xe7\xc9\xb0\xd0\xaf\x8f\x42\x03\x22\xa6\xf5\x68\x5d\x23\xe1\xbf\x4e\xde\xbe\x81\xbc\x92\xff\xbc\x78\xff\xee\xd0\x6f\x6b\xe4\x46\x7d\x7a\x93\x96\x2b\xa0\x51\x20\x13\xcb\x12\x40\xa3\xa9\x32\x46\xba\x42\x64\x99\x28\x3a\xcb\xde\xce\x07\x13\x4e\xe6\xe4\xa7\x5c\x8a\xc3\x26\xd9\x7f\x3b\x54\x67\xa3\xf5\x31\xd0\x69\xeb\x03\x20\x9d\xd6\x07\x69\x87\x89\xe5\xc0\xeb\x24\x93\x85\x62\xdf\xc9\x5c\xb5\xd3\xea\x0e\x79\xb0\x07\x70\x11\xd0\x98\xac\xed\x3b\xb0\x3b\x34\x0c\x59\x9e\x93\x8b\x8d\x88\x58\xde\x12\xfb\xdf\xb3\x82\x2a\x2b\x72\xc5\xa2\xd3\x93\xaf\x0b\x11\xc5\x43\x18\x98\x50\x80\xb7\x26\xe7\xa2\x72\xa9\x90\x10\xd8\xd3\x52\x5b\x23\x09\x2d\xd4\x1a\xbc\x9f\x10\x14\x6f\xa1\xef\x00\x25\xa5\x02\xca\xb2\x3b\x96\x81\xc7\x5f\x51\x13\x82\x01\xf2\xa5\x8b\x06\x58\x14\x1c\xd8\xa6\x4b\x4e\xa8\x3d\xca\xbd\xfd\x98\x64\xe8\x10\x1f\xe9\xc1\xad\x33\x84\x6a\xd2\x6b\x19\x47\x4e\x91\x39\x7b\xf5\x96\x30\x11\xca\x88\x45\xf5\xe9\xef\x22\x36\xb0\x01\xbd\x96\xcb\x06\x4e\x10\xb0\x68\x51\xaa\x7f\xd0\x05\x88\x97\x68\xce\x48\x48\xe7\x0b\xbd\x9d\x7e\x98\x29\xaf\xc3\x4a\xd0\x7d\xe7\x0f\x62\xdc\x49\xeb\x1d\x0f\x72\x45\x55\xb1\xb5\x2f\x8d\xa9\x39\xca\xbd\xd0\x03\x6d\x74\xba\xd5\xa6\x16\x5a\xb3\x89\x40\x26\x56\x9a\x45\xb5\x92\x79\xf7\xf6\x1b\xfd\x20\xd8\x93\x60\x04\x7b\x50\x23\xf6\x44\x87\xc6\x5a\x0b\x05\xbc\x62\xbf\x46\xd2\x98\x0a\xc1\xa2\xfd\x97\x16\xf0\x62\x77\x5c\x6e\x2f\x57\xf7\xd7\xef\xad\xda\xe9\xde\x73\x28\x98\x52\x22\x2c\xfa\x80\xdb\x4b\x6a\x62\x6a\xe0\x1d\xea\x62\x6d\x4c\xe1\xd4\x01\x2c\xb7\xbe\xee\x1e\x8c\x58\x1a\xcb\x0d\x38\xcd\xaa\xed\x6f\x0f\xbb\xb1\x39\x5c\x25\x7e\x2d\x83\xfa\x37\x5c\xe3\x05\xf7\xc8\x39\x03\x3f\x1f\xed\x18\xb4\x85\xfe\xbb\x22\x59\xb0\x0c\xd0\xca\xec\x6b\xce\x17\x6d\xbd\x8f\x0d\xd9\xde\x1b\xb4\xb1\x75\x56\x36\x1a\xb6\xaf\xf1\x84\xae\xd8\x28\xa4\x41\xcc\xd0\xa3\x9b\x9a\xc7\x70\x79\x8a\x01\xca\x25\x24\xa6\xb9\xba\xcc\xa8\xc8\xb5\xaf\xfd\x92\x27\xe3\x10\x72\x45\x64\x6a\xb8\x00\x24\xb2\x60\x21\xf0\x61\x30\xe0\x6c\x5c\xda\x8d\xfe\xcf\xc0\x42\x42\x09\x9c\xb9\xe2\x09\x3b\x74\x1e\x09\xcb\xf3\xb1\xab\xf9\xe3\x7a\xb3\x85\xfb\x92\x72\xab\xa2\x19\xfa\x9d\x11\xbe\xd4\xfe\x65\x1e\x1d\x8a\x90\x9e\xf5\xd8\xb5\xd4\x2a\x07\xe8\x75\x80\x96\xa5\xea\x8a\x3e\xe5\xb2\x89\x6e\x07\x50\xa3\xd9\xf4\xad\x76\xbf\x8a\x56\x62\xfd\xd8\xf3\xb4\x8d\xaf\x75\xa3\x64\x6c\x14\x29\x3c\xe6\x4c\x0d\xd8\x1d\x9a\xec\xa2\x75\x4c\x17\x86\xf3\xe6\xd2\xb4\x8c\xe8\xe1\xc1\x5b\x4b\xf6\x4d\x0b\x8f\xdc\x8a\x2b\x34\x64\xe8\x6a\x56\x55\x16\xf0\x36\x4b\xdb\x2d\xb3\x03\xab\xd7\x5b\x65\xe1\xde\x4b\x42\x98\x9b\x20\x0f\xfa\x51\xaf\xec\x4d\xe5\x1b\x64\x72\x0e\x6b\x06\xce\x62\xb9\xd2\xe6\x27\xb1\x9a\x91\x6f\x98\x36\xfd\x45\x33\xf2\x43\xaa\xff\x02\x63\x42\x1b\xf6\x5f\xeb\xbb\xef\x3b\x46\x63\xb5\xde\xcc\xc8\x19\x2d\x72\xd0\xca\xbf\xc9\xf8\x52\x97\x81\x7a\x6f\xac\x03\x11\x13\x33\x72\x26\xa3\x0b\x16\x16\x3a\xb8\x4f\x26\x29\x55\x7c\x11\xb3\x36\xfd\xc6\xde\x49\x4e\xf2\x2b\x57\x59\xad\x4b\xd5\xbf\xdd\x02\xd5\xa9\xd1\x37\x56\xe1\xe8\xd4\xcd\xde\x45\xaa\x40\x7e\x9e\xa2\x3c\xce\x81\x8c\x75\xcf\x21\x0a\x36\xe8\xd2\xd8\x1c\x16\x59\xd6\x75\x72\x6b\xd8\xf1\x5c\xeb\xfa\x2e\x26\xd0\x27\xf3\xf9\xdc\xa4\x42\xe6\x2a\x2b\x20\x82\x00\x82\x5b\x15\x68\xdd\xe6\xb2\x8a\x78\xd6\x46\x6e\xf0\xaf\xc8\x19\xa1\xb5\x4a\x0f\xd6\xbc\xa1\x0d\x1b\xa0\xa6\xaf\x89\x0f\x5f\x2e\x72\xbf\xda\x4b\x9f\x90\xd7\x10\xe4\xfa\x40\x93\xb4\x7d\xbb\xcc\x19\x24\xaf\xa5\xb4\xd7\xbf\x41\xec\x1f\xf0\x84\x1c\x1f\x93\xf3\xa6\x37\xd1\xc8\x82\x7a\xad\xf3\x6e\x51\x60\x29\xe5\xb3\xdc\xad\x91\x91\x1b\x7c\x07\xf0\x7b\x21\xef\x45\x1b\xaa\x1a\x0f\x9a\x75\xb0\xfc\xeb\xa3\x13\xe3\x9f\x88\xd9\xf5\xd1\x8c\x5c\x1f\xd5\xa8\xf3\xda\xa6\x4f\x5f\x1f\x39\x2a\xbd\x3e\x72\x9f\xfb\x54\x6b\x8b\x6f\x21\x9c\xf9\x7b\xb6\xf9\x0a\x3e\xd2\x0e\xbf\x31\xfe\xc2\x68\xcb\x9b\xaf\x74\x1c\x74\x09\x0b\xea\x13\x5d\x6e\x52\xf6\x15\xf4\xf4\xac\xff\xf8\x96\xa6\xc3\xd0\x4b\x22\xcb\xc9\xd5\x0d\xa8\x5b\x77\x2f\xfc\x8a\xf0\xfe\x0e\xfa\x77\x70\x7d\x54\xad\xc8\x0c\x62\xa8\x74\x94\xc2\x75\x7b\x8e\x70\x03\xd5\xe0\xfa\x48\x23\x7b\x7d\x44\x1a\x53\x0e\xae\x8f\x00\x2d\xf8\x39\x93\x4a\x2e\x8a\x65\x70\x7d\xb4\xd8\x28\x96\xcf\x5e\xcc\x32\x96\xce\x80\xb7\x7c\x55\x7d\xf5\xfa\xe8\xef\xed\x53\x10\x6e\xc6\x0d\x83\xda\x3f\x8f\x0e\x90\xf3\x0e\x14\x52\x76\x5f\x73\xae\x49\x78\x52\xf9\xb0\xcb\xc9\x74\x00\x25\x44\x95\x50\x98\xad\xa7\x07\x47\xdc\xd0\xa4\x56\xe7\x85\x9e\xa4\x2d\x66\x5f\x39\x24\xc1\x65\xd2\x0d\x74\xcd\x48\x01\x96\xab\x18\x0c\xbd\x15\x16\x24\x5c\x43\x9d\xcd\xc8\xb7\xd5\x4b\x68\xe9\xb9\xd6\x9e\x22\xad\x74\x77\x43\xd5\x09\x91\x30\xc4\xcc\xef\xde\x09\x6a\xc0\x57\x6c\xe1\x43\x03\x1e\x80\x82\x19\x22\x55\x70\x48\xfc\x81\x5b\xf9\xa3\x0a\x68\x76\xac\xc6\x90\xac\x8b\x84\x0a\x7d\xfb\x02\x9e\x0e\x8e\x8b\xa5\xea\xfa\x1c\xfc\x73\x2c\x99\x2e\xc0\xb7\x64\xcd\xf9\x76\x1f\xed\x56\x25\x74\x03\xfb\x04\xc5\x1e\xe0\xe0\xd8\x09\x74\x2d\x46\x42\x1f\xde\x30\xb1\x52\xeb\x80\x7c\xfe\xd9\xbf\x7d\xf1\xef\x87\xae\x85\xd3\x90\xbf\x65\xc2\xba\xd0\x46\x2d\xcb\xee\x6b\xdb\xc1\x1b\x3e\xb0\x09\x48\xd2\xf0\x57\xe5\x18\x6f\xa0\x9a\x60\x8d\xfe\xb5\x66\x0a\xa5\xe4\x20\xc7\x23\x22\x45\x2a\x85\xaf\x2f\x04\x7d\x67\x8a\x90\x69\xb9\x78\xaf\x8f\xf0\x92\xaf\xc7\x1b\xf2\xe2\x33\xd3\xd4\x0f\x3e\xba\xcb\xd1\xaf\x1e\x6e\xfc\xdd\x29\xf6\x41\xfe\x72\xb6\x85\x3f\xcf\x9d\x87\x17\xe8\xd5\xd4\xc2\x85\xba\xb6\x36\xc2\x6e\xe8\x26\xde\xba\x8d\xad\xac\x20\xc2\xc1\xd3\xd1\x17\x6b\x61\x03\x66\x03\xf2\xfc\x40\xa1\x16\xc4\x5a\x9a\x8f\xa4\x11\x33\xb4\x12\x4b\x28\xb0\xf1\x55\x46\x93\x84\x2a\x1e\x12\x1e\x31\x01\x76\x3f\x96\x8d\x39\x40\xb0\x5e\x16\x60\xa5\x19\xdb\xb5\x7e\x96\x5b\x2e\x5a\x3b\x52\x67\x99\x8c\x8a\x90\x65\x6d\xa2\xb5\xa5\xfc\xa5\x8b\x4c\x0c\x6b\xdb\x06\xcc\x03\x82\xf0\x36\xd6\x8e\x64\x7d\x62\x2e\xd6\xb6\xcb\xc1\x66\xd7\x97\x51\x70\x5b\xe6\x56\x79\x77\x91\x43\xe6\x8a\xbf\xb7\xba\x55\x19\x2d\x62\x62\x23\x20\xb6\x80\x6b\xcf\x45\x27\x58\x4a\x56\x05\xcd\xa8\x50\x8c\x45\x20\x94\x01\xc3\xd8\x8d\x38\xa1\xe4\x94\x26\x2c\x3e\x85\x9c\xa8\x7e\xde\x01\xd5\x55\xca\xa8\x26\x57\xd8\xd5\xc5\xac\x8c\x60\x38\x2f\x9e\x7f\xd6\x43\x61\xe5\x28\x6f\x28\x50\xfd\xea\x64\xfe\x37\x3a\xff\xbf\x9b\x89\xfd\xe3\xf9\xfc\xcb\xff\x99\x05\x37\x9f\xd4\xfe\x7b\xd3\x1d\x5f\x3e\xc8\xda\xda\x0c\x87\x1d\xa4\x6a\xaf\x4f\xb9\x6c\x12\xd6\xcc\x86\x63\x90\xcb\xac\x60\x33\xf2\x9a\xc6\x39\x9b\x91\x1f\x4c\x62\x93\x7f\x90\x33\xe3\x08\x40\xb5\xcb\x44\xfa\xb1\xfe\x46\xf7\x73\xfb\xed\x43\x97\x64\xb4\x47\x47\x59\x8f\x4e\x75\x30\xb8\xa8\xd1\x97\xe6\xc3\x64\x29\xa5\x6f\xe5\x73\x3f\x94\xc9\x71\xf9\xbc\x6b\x69\x88\x56\x22\xde\x42\x68\x45\xc5\x6c\x7d\xfd\xad\xed\x13\x91\x2b\x90\xbf\x6d\xc4\x90\x73\xa6\x75\x1f\xe6\x98\xdf\x32\x52\x8a\xd9\x86\xb5\x83\x01\x48\x6b\x1e\xd9\x82\xab\x8c\x66\x9b\x6a\x36\x50\x67\x54\xc0\xa1\x29\x72\xb6\x2c\xba\x23\xe2\x26\x39\x63\xc4\x87\xc4\xb0\xdd\x3b\xc2\x96\x49\x76\x61\x47\xda\x60\x13\x4a\xb1\x8c\x75\xbc\x5c\x37\xaa\x3c\x49\x65\xa6\xa8\x50\xae\xce\xe7\x8a\x3d\x10\x5e\xc5\x99\xf3\x9c\x4c\x22\x91\xbf\x78\xf1\xd9\xe7\x17\xc5\xc2\xc4\x47\xbd\x4e\xd4\xf1\xf4\xe5\x04\x62\x50\x81\x63\x46\xe0\x61\x78\x9d\xa8\xe9\xf0\x59\xfd\xfc\xc5\x17\x83\xe7\x70\x72\x65\x4e\xdb\xcd\xe4\x6a\x6e\xff\xfa\xc4\xfd\x34\x7d\x39\xb9\xf6\x7b\x9f\x4f\x3f\x01\xd4\x6a\x67\xf8\xe6\x6a\x5e\x1d\x60\x1f\x52\x46\x6a\xcf\xa6\x7f\x78\x0a\x47\xdd\xae\x78\xdd\x3a\xcc\x0a\x6c\xad\xcf\xcc\xe5\xd2\xfa\xc8\x6c\x7d\xeb\xa3\x0e\xb5\xe9\x40\x7f\xde\x56\x60\x16\xa8\x67\xf3\x84\xa6\x73\x88\x01\x0f\xbc\x91\x5f\xdf\x05\x01\xc3\xa0\xfa\x59\xea\x75\x9f\x78\x6f\x8f\xfd\x58\xca\x2c\x64\xd6\x96\x12\x78\xfb\x58\xf6\x60\x9f\xec\x8b\xaf\x29\x8f\x8b\x36\x95\x79\x58\xcc\xef\x41\x6d\x57\x68\x0b\xbc\x5e\x46\x07\x47\xb0\x92\x1d\x9d\xb0\x95\x5b\x2f\x51\xe5\xc6\x07\xcc\x41\x7e\x01\x5f\x69\x9f\x4f\xd2\x59\xc7\x3a\xa7\xd5\x2e\x9f\xf5\x09\x5e\xe9\x9a\xe6\x2c\xd8\x67\x15\xba\x84\xb4\x9e\x57\x14\x64\x03\xab\xce\x48\x90\x9e\x37\x6d\x64\xdd\x89\xd2\x0a\x7f\x1e\xec\x35\xb7\xbb\xbd\x3f\xd8\x7a\xb4\x76\x7e\x34\x54\x50\x0b\x61\x84\xc4\x71\x30\xca\xd7\x7e\x29\x16\xe5\xbd\x12\x78\x0d\x91\x81\xfc\xe3\x9f\x5e\x25\x3d\x18\xcd\xd4\x30\x5d\x3b\x3d\xf0\xfc\x07\xe4\xc8\xdc\xd3\x69\x5c\x64\x34\xb6\xff\xad\xee\x87\x80\x5c\xdd\x78\x00\x12\x22\xc6\xed\xc2\xe6\x01\xb9\xba\xf1\xfe\x7f\x00\xd7\x52\xc1\xd4\x17\xe6\x06\x00"),
+		},
+		"/install/cluster/webhook.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "webhook.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 897,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x52\x4d\x6f\xdb\x30\x0c\xbd\xeb\x57\x3c\x34\x67\xbb\xe8\x4e\x83\x6f\x45\x97\xed\x56\x14\x5d\xd7\x9e\x69\x89\x4e\xb8\x2a\xa2\x21\xc9\x2e\x82\xc0\xff\x7d\x70\xfc\xd5\xa1\x37\xd2\x7c\xe6\xfb\x10\x77\x66\x87\x97\x23\xc3\x12\xea\x2e\x38\xcf\x90\x04\x09\x7f\xd9\x66\x76\xa8\xcf\xc8\x47\x46\xe2\xd8\x8b\xe5\xc2\x12\xb4\xe5\x48\x59\x23\xb4\x19\xeb\x90\x8e\xd2\x64\x34\x51\x4f\x66\xb7\x61\xc3\x01\x96\x63\x96\x46\x2c\x65\x1e\xb1\xe3\xe8\x83\xeb\xa3\xea\xfb\xb2\xae\xc4\x9b\xe4\xa3\x76\x19\x92\x3f\xcf\xcd\x0e\x56\x3b\xef\x10\x34\xa3\x1e\xa5\x79\xcf\x0e\x49\x47\x9c\x24\x68\xf0\x67\x44\x3e\x48\xca\x1c\xd9\x41\xc3\xa6\xa4\x34\x3b\x73\xb9\x14\x90\x06\xe5\x7d\x2b\xbf\x39\xf6\x1c\xcb\xfd\xa9\x66\xe7\xd8\x3d\x45\xed\xc5\x71\xc4\x30\x18\x6a\xe5\x95\x63\x12\x0d\x15\xc8\x9d\x24\x8d\xe5\xb4\x35\x52\x16\x0d\xe5\xfb\xf7\x54\x8a\xde\xf6\x77\xe6\x5d\x82\xab\xf0\x4a\x5e\x1c\x65\x09\x87\xb7\x49\xe8\x83\x86\x46\x0e\xdd\x04\x37\x27\xce\xe4\x28\x53\x65\x80\x40\x27\xae\x90\xce\xc1\x71\x92\x54\xf4\xeb\x9f\xc5\xe2\x11\xf0\x54\xb3\x4f\x23\x1a\xa0\xb6\xdd\xe0\x06\xa0\x10\x34\x5f\xd7\xce\x80\x25\xb2\x9a\x33\x95\x9b\x5b\xd1\xdb\xe9\xad\x0a\x4b\xd3\xf3\x55\xb8\xc9\xb1\xe3\x1b\x33\x13\xa5\xca\x14\xb3\x9c\x7e\x21\x28\xd7\x42\xd4\x60\x73\xff\xcc\xbd\xf0\xc7\x9c\xca\x95\xb8\x40\x7f\x67\x00\xeb\x85\x43\x9e\xec\xfe\xa7\x67\x6a\xbe\xf8\x5d\x8e\xe4\x93\xdb\x05\x95\x5a\xb2\x5c\xe1\x72\x29\x1f\x97\x6e\x18\xe6\x79\x4b\xf9\x58\xe1\x76\x4e\x8b\x8b\x75\x9f\x68\xd1\xdf\x8d\xd6\xbf\xad\xdf\x0c\xd0\x90\xf8\x2e\xf2\x93\x7a\xb1\xe7\x0a\x3f\x49\xbc\x01\x92\x38\xde\x37\x0d\xdb\x9c\x2a\x3c\x6a\x60\x03\xc4\xce\xf3\xec\x87\x5a\xf9\x15\xb5\x6b\xe7\x5c\x8b\x55\xf4\x94\x05\xb0\xdd\xc5\x0a\x99\xb9\xaf\xdd\x64\xed\xf3\xf4\xe1\x79\x7f\xff\xb2\x9f\x9b\x3f\x4f\x3f\x96\x26\x72\xd2\x2e\x5a\xfe\xc2\xc4\xe9\x7a\xa2\x1c\x1c\x86\xc1\xfc\x1b\x00\xe1\x66\x3a\x9c\x81\x03\x00\x00"),
 		},
 		"/install/cluster_role_jaeger.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "cluster_role_jaeger.yml.tmpl",
 			modTime:          time.Time{},
 			uncompressedSize: 1613,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x94\x41\x6f\xdb\x3a\x0c\xc7\xef\xfa\x14\x44\x73\x28\xf0\x80\xb8\x78\xb7\x87\xdc\x1e\x76\xd8\x76\x59\x81\xb5\xd8\x9d\x96\x69\x5b\x8d\x24\x0a\x24\x9d\x6e\x0d\xf2\xdd\x07\xc7\x4e\x8b\x26\x69\x9b\xde\x24\x86\xfc\xf1\xff\x8f\x29\x2e\xdc\x02\xbe\xb7\x60\x3d\x09\x41\x50\xe0\x98\x40\x87\x52\x58\x6c\x0c\xe6\x97\x5f\x32\x43\x26\x6a\xdc\x02\x5a\x96\x31\x0c\x3e\x0e\x6a\x24\x20\x1c\x09\x50\xe1\xb6\x90\xa0\xb1\x7c\x1b\x6a\x30\x5c\x93\x82\x47\x21\xb7\x00\x6e\x21\x64\x35\x8c\x31\xe4\x6e\x5f\xca\x73\xaa\x5b\xb8\xed\x76\x09\xa1\x85\xcc\x06\xd5\xff\x25\xdc\x91\x6c\x48\xaa\xdb\x98\xee\x66\x19\xbb\x9d\x73\xa3\xcc\xfb\x9e\xe0\x01\xa9\x23\x79\xdd\xd9\x7a\x34\xc0\x18\x61\x50\x12\x75\x0b\x78\xc4\x6c\xfb\x46\x3c\x86\x0e\x35\xda\xf3\x10\x1b\xa8\x09\x12\xa5\x9a\x44\x81\x5b\xb7\x70\x58\xc2\x2f\x12\x0d\x9c\x57\x20\x35\xfa\x0a\x07\xeb\x59\xc2\x13\x5a\xe0\x5c\xad\xff\xd3\x2a\xf0\xcd\xe6\x5f\xb7\x0e\xb9\x59\xc1\x97\xa9\xf3\x4f\x8e\xe4\x12\x19\x36\x68\xb8\x72\x00\x5e\x68\x5f\x70\x1f\x12\xa9\x61\x2a\x2b\xc8\x43\x8c\x0e\x20\x63\xa2\xd5\x2c\x62\xf9\xec\x5b\x86\x48\xba\x72\x4b\xc0\x12\xbe\x0a\x0f\x45\x47\xca\x12\xae\xae\x1c\x80\x90\xf2\x20\x9e\xe6\x58\xe1\x46\xf7\x07\x25\xd9\x04\x4f\xd3\x85\x72\x53\x38\x64\x9b\x6e\x65\xf4\xa0\x46\xd9\x36\x1c\x87\x44\x3e\x62\x48\x73\xe2\x86\x0e\x59\x9e\x73\x1b\xba\x84\xe5\xc0\xf3\x42\xf6\x8a\x8d\xde\xf3\x30\xa5\x6f\x48\xea\x59\xc1\xf5\x3f\xd7\xa7\x52\xb1\xec\x31\x47\x62\x1b\x2a\x91\xff\xa4\xe7\x96\x0d\x52\xe2\xac\x87\x36\x42\x25\x06\x8f\xcf\x77\x35\x34\x6a\x87\x38\x07\x3e\xea\x99\x38\x07\x63\x09\xb9\xab\x3c\x0b\xb1\x56\x9e\xd3\xa9\x88\xd9\xcc\x9c\x7d\x04\xee\xc8\xa6\x3f\x63\xfc\x64\x74\xda\x83\x7e\x1b\xe5\x71\x20\xce\xb8\x3b\x96\xff\x81\xdb\x4f\xba\xcb\x64\x8f\x2c\xeb\xd1\xdd\x34\x77\xa7\x02\x42\xee\x84\x54\xe9\x12\x5c\x8d\xe6\xfb\x53\xc4\x03\xd7\xf3\x34\x08\xe7\xf9\xf2\x11\x4a\x78\x30\xaa\xb8\x50\xd6\x3e\xb4\x76\x56\xda\x3e\xe7\x12\x58\xe4\xae\x1b\x3d\xbe\x8f\xa3\x88\x6a\xc1\x2b\xa1\xf8\xfe\x22\xee\xf4\xc6\x4c\xd0\x8f\xf4\x73\xcc\xb1\xec\x02\xb3\x6f\xad\x81\x53\xe0\xbc\x88\xc6\x3d\x54\x87\xdc\x84\xdc\x7d\xe2\xe5\xbc\x31\x6a\x3f\x30\xd1\x2b\x47\x2f\x5b\xe3\xbd\xd7\x76\xd3\x86\x8c\x31\x3c\xd1\xf1\xbc\x0f\xa5\x39\x3b\xe7\x6b\x6c\xd7\x58\xa9\x49\x48\x4f\xe1\xac\xbb\x7d\x86\xbe\x1c\xa7\x05\x7b\xe2\xcf\x6d\xb7\x94\x9b\xdd\xce\xfd\x0d\x00\x00\xff\xff\xa2\x7a\x5d\x30\x4d\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x94\xc1\x8e\xe3\x36\x0c\x86\xef\x7a\x0a\x62\x73\x58\xa0\x40\xbc\xe8\xad\xc8\xad\xe8\xa1\xed\xa5\x0b\x74\x17\xbd\xd3\x32\x6d\x6b\x23\x89\x02\x49\x65\xda\x09\xf2\xee\x85\x1d\x67\x06\x13\x67\x66\x32\xa7\x44\x14\xf9\xf1\xff\x13\x8a\x1b\xb7\x81\x3f\x7b\xb0\x91\x84\x20\x28\x70\x4c\xa0\xb5\x14\x16\x9b\x82\xf9\xf9\x26\x33\x64\xa2\xce\x6d\xa0\x67\x99\xc2\xe0\x63\x55\x23\x01\xe1\x48\x80\x0a\x5f\x0b\x09\x1a\xcb\x1f\xb5\x05\xc3\x3d\x29\x78\x14\x72\x1b\xe0\x1e\x42\x56\xc3\x18\x43\x1e\xe6\x52\x5e\x52\xdd\xc6\x1d\x8f\x5b\x08\x3d\x64\x36\x68\x7e\x2d\xe1\x1b\xc9\x81\xa4\xf9\x1a\xd3\xb7\x45\xc6\xe9\xe4\xdc\x24\xf3\xfb\x48\xf0\x03\x69\x20\x79\xd9\xd9\x46\x34\xc0\x18\xa1\x2a\x89\xba\x0d\x3c\x60\xb6\xb9\x11\x4f\xa1\x4b\x8d\x8e\x5c\x63\x07\x2d\x41\xa2\xd4\x92\x28\x70\xef\x36\x0e\x4b\xf8\x87\x44\x03\xe7\x1d\x48\x8b\xbe\xc1\x6a\x23\x4b\x78\x44\x0b\x9c\x9b\xfd\x2f\xda\x04\xfe\x72\xf8\xd9\xed\x43\xee\x76\xf0\xdb\xb9\xf3\xdf\x1c\xc9\x25\x32\xec\xd0\x70\xe7\x00\xbc\xd0\x5c\xf0\x3d\x24\x52\xc3\x54\x76\x90\x6b\x8c\x0e\x20\x63\xa2\xdd\x22\x62\xfb\xe4\x5b\x6a\x24\xdd\xb9\x2d\x60\x09\xbf\x0b\xd7\xa2\x13\x65\x0b\x9f\x3e\x39\x00\x21\xe5\x2a\x9e\x96\x58\xe1\x4e\xe7\x4b\x25\x39\x04\x4f\xe7\x03\xe5\xae\x70\xc8\x76\x3e\x95\xc9\x83\x1a\x65\x3b\x70\xac\x89\x7c\xc4\x90\x96\xc4\x03\x5d\xb2\x3c\xe7\x3e\x0c\x09\xcb\x85\xe7\x85\xec\x05\x1b\xbd\xe7\x7a\x4e\x3f\x90\xb4\x8b\x82\xcf\x3f\x7d\x5e\x4b\xc5\x52\x74\x2d\xb6\xa3\x12\xf9\xbf\xf4\xd4\xb2\x43\x4a\x9c\xf5\xd2\x46\xa8\xc4\xe0\xf1\xe9\xac\x86\x46\x7d\x8d\x4a\x77\xf5\x4c\x9c\x83\xb1\x84\x3c\x34\x9e\x85\x58\x1b\xcf\x69\x2d\x62\x31\xb3\x64\x5f\x81\x07\xb2\xf9\x73\xfe\xcb\x68\xdd\x83\xfe\x35\xca\xd3\x40\xdc\x70\x77\x2d\xff\x1d\xb7\x1f\x74\x97\xc9\x1e\x58\xf6\x93\xbb\xf3\xdc\xad\x05\x84\x3c\x08\xa9\xd2\x3d\xb8\x16\xcd\x8f\x6b\xc4\x0f\x6e\x97\x69\x10\xce\xcb\xe1\x3d\x94\x70\x35\x6a\xb8\x50\xd6\x31\xf4\x76\x53\xda\x9c\x73\x0f\x2c\xf2\x30\x4c\x1e\xdf\xc6\x51\x44\xb5\xe0\x95\x50\xfc\x78\x97\xdf\xf3\x1b\x33\x41\x3f\xd1\x6f\x31\xa7\xb2\xf7\x39\xaf\xae\x81\x35\x70\x59\x44\xd3\x06\x6c\x43\xee\x42\x1e\x3e\xf0\x72\x5e\x19\xb5\xbf\x30\x5d\xf0\xd7\x5b\xe3\xad\xd7\xf6\xa5\x0f\x19\x63\x78\x24\xb9\x92\x50\x4b\x77\x73\xce\xf7\xd8\xef\xb1\x51\x93\x90\x1e\xc3\x4d\x77\x73\x86\x3e\x7f\xad\xba\x82\x4f\xbf\xa8\x3b\x1e\x29\x77\xa7\x93\xfb\x7f\x00\xa2\x7a\x5d\x30\x4d\x06\x00\x00"),
 		},
 		"/install/cluster_role_kafka.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "cluster_role_kafka.yml.tmpl",
 			modTime:          time.Time{},
 			uncompressedSize: 466,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x90\x41\x8b\x14\x31\x10\x85\xef\xf9\x15\x8f\xdd\xeb\x76\x8b\x37\xe9\x9b\x28\x78\x10\x2f\x8b\x78\x11\x0f\x35\xe9\x5a\xa7\xec\x74\x2a\x54\x55\x7a\xd9\xf9\xf5\xd2\x2d\xa3\xe2\x80\xc7\xe4\x7d\x8f\xf7\x25\xf7\x78\x5b\x8a\x3e\xc3\x5f\xea\xcc\x2e\x3e\x38\xdb\xc6\x86\xee\x6c\x08\x45\x51\x5d\x7a\xc3\x42\x4f\x0b\x21\x77\x0f\x5d\x8d\x5d\xbb\x65\x76\x50\x20\x97\xee\xc1\x86\xc2\x1b\x97\x74\x8f\xcf\x67\xbe\x76\xb2\xce\x0c\x71\x48\xc5\xc7\xbd\xfe\x89\x83\xde\x53\xd0\x23\x87\x09\x6f\x54\xc6\x1f\xb4\x51\xa2\x26\x5f\xd8\x5c\xb4\x4e\xb0\x13\xe5\x91\x7a\x9c\xd5\xe4\x42\x21\x5a\xc7\xe5\x8d\x8f\xa2\xaf\xb6\xd7\x69\x91\x3a\x4f\x78\xf7\x6b\xf0\x51\x0b\xa7\x95\x83\x66\x0a\x9a\x12\x50\x69\xe5\xe9\xdf\x67\x0c\x87\x77\x02\x0a\x9d\xb8\xf8\xce\x01\xd4\xda\x1f\xf0\xb8\xb9\x1e\xf6\xa1\xff\xa7\xf1\xd2\x78\x82\xd4\x27\x23\x0f\xeb\x39\xba\xf1\x0d\x94\x75\x6d\x5a\xb9\xc6\x8d\x4f\xb2\x5e\xd8\xa7\x34\x80\x9a\x7c\x30\xed\xed\x70\x1a\x70\x77\x88\x8e\x1e\x26\xeb\x45\x46\xd1\xbb\x04\xfc\xfe\xe8\xbf\x19\xdf\x93\x8d\xed\xe4\x13\xbe\xe2\x3b\xc7\x03\x8a\x78\x3c\xe0\x99\x22\x9f\xf1\x2d\xfd\x0c\x00\x00\xff\xff\x56\xab\xb2\x23\xd2\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x90\x41\x8b\x14\x31\x10\x85\xef\xf9\x15\x8f\xdd\xeb\x76\x8b\x37\xe9\x9b\x28\x78\x10\x2f\x8b\x78\x11\x0f\x35\xe9\x5a\xa7\xec\x74\x2a\x54\x55\x7a\xd9\xf9\xf5\xd2\x2d\xa3\xe2\x80\xc7\xe4\x7d\x8f\xf7\x25\xf7\x78\x5b\x8a\x3e\xc3\x5f\xea\xcc\x2e\x3e\x38\xdb\xc6\x86\xee\x6c\x08\x45\x51\x5d\x7a\xc3\x42\x4f\x0b\x21\x77\x0f\x5d\x8d\x5d\xbb\x65\x76\x50\x20\x97\xee\xc1\x86\xc2\x1b\x97\x74\x8f\xcf\x67\xbe\x76\xb2\xce\x0c\x71\x48\xc5\xc7\xbd\xfe\x89\x83\xde\x53\xd0\x23\x87\x09\x6f\x54\xc6\x1f\xb4\x51\xa2\x26\x5f\xd8\x5c\xb4\x4e\xb0\x13\xe5\x91\x7a\x9c\xd5\xe4\x42\x21\x5a\xc7\xe5\x8d\x8f\xa2\xaf\xb6\xd7\x69\x91\x3a\x4f\x78\xf7\x6b\xf0\x51\x0b\xa7\x95\x83\x66\x0a\x9a\x12\x50\x69\xe5\xe9\xdf\x67\x0c\x87\x77\x02\x0a\x9d\xb8\xf8\xce\x01\xd4\xda\x1f\xf0\xb8\xb9\x1e\xf6\xa1\xff\xa7\xf1\xd2\x78\x82\xd4\x27\x23\x0f\xeb\x39\xba\xf1\x0d\x94\x75\x6d\x5a\xb9\xc6\x8d\x4f\xb2\x5e\xd8\xa7\x34\x80\x9a\x7c\x30\xed\xed\x70\x1a\x70\x77\x88\x8e\x1e\x26\xeb\x45\x46\xd1\xbb\x04\xfc\xfe\xe8\xbf\x19\xdf\x93\x8d\xed\xe4\x13\xbe\xe2\x3b\xc7\x03\x8a\x78\x3c\xe0\x99\x22\x9f\xf1\x2d\xfd\x1c\x00\x56\xab\xb2\x23\xd2\x01\x00\x00"),
 		},
 		"/install/cluster_role_olm.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "cluster_role_olm.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 1754,
+			uncompressedSize: 1910,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x54\x41\x6b\xdc\x4c\x0c\xbd\xcf\xaf\x10\x7b\x0c\x6b\x87\xef\xf6\xe1\x5b\xc9\xa1\x97\x42\xa1\x81\x5e\x4a\x0e\xb3\x63\xed\xae\xf0\x78\x34\x48\x63\x87\x64\xd9\xff\x5e\xc6\xeb\xe9\xa6\x8d\x4d\x0b\x2d\x81\x9c\xc4\xca\xd2\xbe\x27\xbd\xa7\xa9\xa0\xa3\xd0\x36\x70\xe7\x07\x4d\x28\x5f\xd8\xa3\x01\xb0\x91\xbe\xa2\x28\x71\x68\x40\x76\xd6\xd5\x76\x48\x47\x16\x7a\xb6\x89\x38\xd4\xdd\xff\x5a\x13\xdf\x8e\xff\x19\x80\x1e\x93\x6d\x6d\xb2\x8d\x01\x00\x08\xb6\xc7\x06\xf4\x29\xb4\xa8\xa4\x15\x47\x14\x9b\x58\x2a\x77\xf9\xfb\x4a\xd8\x63\xb5\xa3\xd0\x52\x38\xe8\xd4\xe1\xed\x0e\xbd\x5e\xba\x33\x70\xbc\xb6\xcf\xb9\xf2\x33\x43\xfe\xee\x7b\x7a\x8a\xd8\x40\x81\x5d\x28\x70\xdc\x47\x0e\x18\xd2\x02\x4b\x03\x20\x83\xc7\x89\x4c\x95\x77\xf0\x51\x78\x88\x33\xb7\x6a\x7d\x11\xd3\x77\x41\xe5\x41\x1c\xfe\x28\x9f\x47\xce\x13\xeb\xeb\xd4\x4f\x3b\xa8\x60\x73\x73\xbb\xa7\x60\x3d\x3d\xa3\xe8\x66\x4a\x8e\x28\x3b\x6d\xe0\x1b\x1c\x30\x6d\xc1\x93\xa6\x2d\x38\x41\x9b\x70\x0b\x43\x6c\xa7\xd8\xa2\xc7\x84\xf0\xb0\x4c\xf8\xc5\xdc\xcb\x14\x37\x37\x9b\x7f\x81\x5f\xa2\x63\xef\xd1\xe5\xc5\x6c\xe1\xd1\x26\x77\xdc\x42\xcc\x01\x1e\x8c\x31\xa7\x53\x05\xb4\x87\xfa\x43\xa4\x7b\x94\x11\xa5\xbe\xe3\xa0\xec\xf1\x13\x85\x0e\xce\x67\xf3\x56\x4e\xbc\xa0\x7a\x0a\xdd\x3b\xf3\xdf\xcc\xbc\xe6\x88\x41\x8f\xb4\x4f\xeb\xd6\xbb\xce\xa8\x0b\x5a\xae\xba\x28\x6b\x84\xa1\xcd\x6a\xbc\x96\xeb\xb3\xef\xef\x87\x18\x59\xd2\x1b\xaa\xc5\xbe\x7f\x67\x2a\x45\xeb\x3a\x7b\x40\xad\x4b\xbd\xd6\x8e\x05\x39\x87\x7e\x59\xae\xb9\xa5\xb7\x81\xf6\xa8\x69\x49\xb2\x7c\x7e\x6b\x67\xfe\xe7\x40\xa5\xf2\x30\xf5\x97\x57\x62\xd8\xa9\x13\x8a\x59\xa1\x92\xa3\xa0\xc9\x7a\x1f\xbd\x0d\xbf\xb0\x29\xde\x29\x97\x5f\x3c\xf4\xe2\x91\x78\x9c\x6f\xfe\x2f\xc9\xce\x8f\xa5\xa2\x8c\xe4\x70\xbc\xd8\x6a\x6d\x37\x57\x54\x73\x3a\x61\x68\xcf\x67\xf3\x3d\x00\x00\xff\xff\xdc\x86\x26\xe0\xda\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x54\x4d\x8b\xdb\x40\x0c\xbd\xfb\x57\x08\xf7\xb6\xc4\x5e\x7a\x2b\xbe\x95\x3d\xf4\x52\x28\xec\x42\x2f\x25\x87\xc9\x8c\x9c\x88\x8c\x47\x83\x34\xce\x92\x0d\xf9\xef\xc5\x5f\xc9\xb6\xb1\x69\x61\x61\x61\x4f\x83\x65\x69\xf4\x9e\x9e\xe6\x15\xb0\xa7\xe0\x2a\x78\xf0\xad\x26\x94\x47\xf6\x98\x01\x98\x48\x3f\x51\x94\x38\x54\x20\x1b\x63\x4b\xd3\xa6\x1d\x0b\xbd\x98\x44\x1c\xca\xfd\x17\x2d\x89\xef\x0f\x9f\x33\x80\x06\x93\x71\x26\x99\x2a\x03\x00\x08\xa6\xc1\x0a\xf4\x18\x1c\x2a\x69\xc1\x11\xc5\x24\x96\xc2\x0e\xd7\x17\xc2\x1e\x8b\x0d\x05\x47\x61\xab\x7d\x85\x37\x1b\xf4\x3a\x54\x77\x8d\xe3\xb5\x7c\x8c\x4d\x9f\x5d\xcb\x7f\xfd\x4f\xc7\x88\x15\x4c\x6d\x67\x12\x2c\x37\x91\x03\x86\x34\x83\x32\x03\x90\xd6\x63\x0f\xa6\xe8\x66\xf0\x4d\xb8\x8d\x23\xb6\x62\x79\x10\xfd\x7f\x41\xe5\x56\x2c\x5e\xd2\x47\xca\x1d\x63\xbd\x0d\xfd\x31\x83\x02\xf2\xbb\xfb\x9a\x82\xf1\xf4\x82\xa2\x79\x1f\x3c\xa0\x6c\xb4\x82\x5f\xb0\xc5\xb4\x02\x4f\x9a\x56\x60\x05\x4d\xc2\x15\xb4\xd1\xf5\x67\x34\xc9\xee\x56\xe0\xd0\x63\x42\x58\xcf\xe3\x7e\x45\x7f\x1e\x69\x7e\x97\xbf\x0d\xc6\xd0\x7f\x3a\x2d\x7b\x8f\xb6\x5b\x94\x15\x3c\x0f\x00\x7b\x9c\x3d\xbe\x4f\xf0\x88\xc6\x29\xa4\x1d\x42\x64\x07\x8a\xb6\x15\x4a\x47\xd0\x64\x82\x33\xe2\x00\x43\xcd\x62\xd1\x01\x85\x3e\xab\x5b\x29\x8d\xc6\x22\x70\x7d\xe1\x32\xcf\x34\xcf\xe7\x09\x5e\xae\xd0\x1b\x4a\xb0\xce\xb2\xec\x74\x2a\x80\x6a\x28\xbf\x46\x7a\x42\x39\xa0\x94\x0f\x1c\x94\x3d\x7e\xa7\xb0\x87\xf3\x39\x7b\xaf\x57\x32\x74\xf5\x14\xf6\x1f\xec\x6d\x8c\xc8\x4b\x8e\x18\x74\x47\x75\x5a\x7e\x16\x57\x8e\xb7\x6a\x2c\xad\x16\xac\x7b\x8d\x30\xb8\x4e\x8d\x5b\xb9\x7e\xf8\xe6\xa9\x8d\x91\x25\xbd\xa3\x5a\xec\x9b\x0f\xa6\x52\x34\x76\x6f\xb6\xa8\xe5\x94\xaf\xa5\x65\x41\xee\x8e\x66\x5e\xae\xb1\xa4\x31\x81\x6a\xd4\x34\x27\x59\x67\x4d\x4b\xde\xf3\xff\x8d\xa6\xcc\x6d\x8f\x78\x0c\x6a\xbb\x51\x2b\x14\x3b\x85\x26\xab\xa4\xa0\xc9\x78\x1f\xbd\x09\x7f\xa1\x99\x76\x67\xb2\xa3\x69\x87\x5e\x39\xd7\xf3\xc5\x88\xde\x04\x76\x34\x72\x45\x39\x90\xc5\xc3\xb0\x56\x4b\xb3\xb9\x76\xcd\x4e\x27\x0c\xee\x7c\xce\x7e\x0f\x00\x9d\xd6\x1d\x4b\x76\x07\x00\x00"),
 		},
 		"/install/cluster_role_public_api.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "cluster_role_public_api.yml.tmpl",
 			modTime:          time.Time{},
 			uncompressedSize: 442,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x90\xb1\x4e\x73\x31\x0c\x46\xf7\x3c\x85\x5f\xe0\xf6\xd7\xbf\xa1\xbb\x32\xb0\x33\xb0\xfb\xe6\x7e\x80\x69\x1a\x47\xb6\x53\xb8\x3c\x3d\x4a\x55\x44\x45\x51\xc7\xc4\x47\xc7\x47\xe6\x26\x4f\x30\x17\xad\x33\xd9\xc2\x79\xc7\x3d\x5e\xd5\xe4\x93\x43\xb4\xee\xf6\x77\xbe\x13\xfd\x77\xfc\x9f\xf6\x52\xd7\x99\xee\x4b\xf7\x80\x3d\x6a\x41\x3a\x20\x78\xe5\xe0\x39\x11\x55\x3e\x60\x26\xdf\xea\x0a\x17\x9f\x86\x64\x5a\x51\xf0\xc2\xa1\x96\x88\x0a\x2f\x28\x3e\x48\x22\x6e\xed\x07\x3d\xfd\x7c\x3f\xc6\xaa\xdb\xd3\xd8\x1a\x66\x92\xfa\x6c\xec\x61\x3d\x47\x37\x5c\x41\x59\x0f\x4d\x2b\x6a\x5c\x14\xb5\xbe\x14\xc9\x93\x8e\xb2\x66\xfa\xb1\x25\xeb\x05\x3e\xa7\x89\xb8\xc9\x83\x69\x6f\xa7\xbc\x89\x06\x81\x1a\x92\x2f\x2f\x90\x88\x0c\xae\xdd\x32\xce\x58\xe8\x1e\xd5\x70\x14\xbc\x8f\xcc\x23\x6c\x39\x4f\xb2\x81\x03\x7f\x8b\x7f\x5f\xf6\xda\xeb\x7d\x79\x43\x0e\xce\x19\xee\xb7\xfc\x5f\x01\x00\x00\xff\xff\x57\xd5\x32\xfa\xba\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x90\xb1\x4e\x33\x31\x10\x84\x7b\x3f\xc5\xbe\xc0\xe5\xd7\xdf\xa1\x6b\x29\xe8\x29\xe8\xf7\x7c\x03\x2c\x71\xbc\xd6\xee\x3a\x70\x3c\x3d\x72\x14\x14\x44\x50\x4a\x7b\x3e\x7d\x3b\x1a\x6e\xf2\x04\x73\xd1\x3a\x93\x2d\x9c\x77\xdc\xe3\x55\x4d\x3e\x39\x44\xeb\x6e\x7f\xe7\x3b\xd1\x7f\xc7\xff\x69\x2f\x75\x9d\xe9\xbe\x74\x0f\xd8\xa3\x16\xa4\x03\x82\x57\x0e\x9e\x13\x51\xe5\x03\x66\xf2\xad\xae\x70\xf1\x69\x48\xa6\x15\x05\x2f\x1c\x6a\x89\xa8\xf0\x82\xe2\x83\x24\xe2\xd6\x2e\xe8\xe9\xe7\xfb\x31\x4e\xdd\x4e\x63\x6b\x98\x49\xea\xb3\xb1\x87\xf5\x1c\xdd\x70\x05\x65\x3d\x34\xad\xa8\x71\x11\x4d\xad\x2f\x45\xf2\xa4\xa3\x59\x33\xfd\xd8\x92\xf5\x02\x9f\xd3\x44\xdc\xe4\xc1\xb4\xb7\x53\xbd\x89\x06\x81\x1a\x92\x7f\x2e\x90\x88\x0c\xae\xdd\x32\xce\x58\xe8\x1e\xd5\x70\x14\xbc\x8f\x9a\x47\xd8\x72\x4e\xb2\x81\x03\x7f\x8b\x7f\x2f\x7b\xed\xf5\xbe\xbc\x21\x07\xe7\x0c\xf7\x5b\xfe\xaf\x01\x00\x57\xd5\x32\xfa\xba\x01\x00\x00"),
 		},
 		"/install/grant": &vfsgen۰DirInfo{
 			name:    "grant",
@@ -359,63 +429,77 @@ var assets = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 1119,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x92\xbf\x6f\xdb\x4e\x0c\xc5\xf7\xfb\x2b\x1e\xa0\xe5\xfb\x1d\xac\xa2\x5b\xa1\xcd\xcd\x90\x76\x69\x00\x07\xed\x7e\x96\x28\x87\xf1\xe9\x28\x90\x27\x07\xad\xa0\xff\xbd\x38\xd9\xaa\x85\x24\xe8\x8f\xa5\xdd\x74\x14\xf9\xde\x87\xc4\x2b\x5c\x81\x8f\x2d\xd2\x03\x29\x81\x0d\x12\x3a\xd8\xd0\xf7\xa2\x29\x17\xe3\xf5\x4f\x14\x44\xa2\xc6\x15\x68\x45\x73\x19\x75\x18\x2c\x91\x42\x25\x10\xbc\xe1\xae\x27\xf5\x49\xf4\xc3\xb0\x47\xf2\x47\x32\xd4\x5e\xc9\x15\x90\x16\x1c\x2d\xf9\x10\x38\x1e\xe6\x51\xb9\xb4\xba\xc2\x8d\xe3\x06\xdc\x22\x4a\x42\xb9\xed\xf9\x9e\xf4\x44\x5a\xde\x85\xee\xfe\x82\x31\x4d\xce\x65\xcc\xff\x6e\xce\x7e\xff\xef\x24\xd0\x7b\x8e\x4d\x16\x5b\x58\x1e\x3d\x1d\x48\x37\x8b\xee\xe2\x37\xf3\xf2\xbc\x05\x0e\x7c\xa2\x88\xe8\x3b\xb2\xde\xd7\x54\x62\x1b\x82\x3c\xd9\x2c\xd1\x0d\x21\x71\x1f\x08\xfb\xb3\xae\xe5\x35\x55\x3a\x34\xdc\xb6\xa4\x14\xd3\x75\xd0\x4a\x57\xb8\x0d\x8e\x1c\x9b\x0a\x17\xa6\x15\x92\x03\x7c\xcf\x5f\x48\x8d\x25\x56\xd0\xbd\xaf\x4b\x3f\xa4\x07\x51\xfe\xe6\x13\x4b\x2c\x8f\xef\xac\x64\x79\x73\x7a\xeb\x80\x8e\x92\x6f\x7c\xf2\x95\x03\x30\x7b\x54\xb0\xaf\xb1\x21\x63\xdb\x8c\x23\xca\x4f\x8b\x2d\xa6\x69\xf3\x6c\x4b\x07\xd8\xb0\x7f\xa4\x3a\x59\x9e\x5f\x98\xf2\x09\xb9\xa6\x6d\x5d\xcb\x10\xd3\x4a\xf8\xe5\x38\xae\x6b\x55\x78\x6e\xf7\x3b\x92\x3f\x58\xff\x40\x34\xe7\x65\x47\xed\x79\xe5\x17\x67\xfc\x05\xaf\xef\xf9\x56\x65\xe8\x7f\x72\xd9\x39\x2d\xb7\xea\x63\xb2\x57\xb3\xb1\x32\x43\x92\xb9\x65\x30\x52\x57\xe4\x97\xcf\xa1\xc8\xb5\x6e\x7e\x35\x4d\xfe\x66\x85\x3c\xc5\x8b\x10\x6e\x76\xff\x3a\x00\xb9\xfe\xd9\x48\xff\xd2\x3d\x5f\x8f\x59\x06\x58\x89\xaf\x99\xdc\x38\x52\x6c\xa6\xc9\x7d\x0f\x00\x00\xff\xff\xbf\x51\x98\xb6\x5f\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x92\x3f\x6f\xdb\x40\x0c\xc5\xf7\xfb\x14\x0f\xd0\xd2\x0e\x56\xd1\xad\xd0\xe6\x66\x48\xbb\x34\x80\x83\x76\x3f\x4b\x94\xc3\xf8\x74\x14\xc8\x93\x83\x56\xd0\x77\x2f\x4e\xb1\x6a\x21\x09\xfa\x67\x69\x36\x1d\x45\xbe\xf7\x23\xf1\x0a\x57\xe0\x73\x8b\x74\x47\x4a\x60\x83\x84\x0e\x36\xf4\xbd\x68\xca\xc5\x78\xf9\x13\x05\x91\xa8\x71\x05\x5a\xd1\x5c\x46\x1d\x06\x4b\xa4\x50\x09\x04\x6f\xb8\xe9\x49\x7d\x12\xfd\x34\xec\x91\xfc\x91\x0c\xb5\x57\x72\x05\xa4\x05\x47\x4b\x3e\x04\x8e\x87\x79\x54\xce\xad\xae\x70\xe3\xb8\x01\xb7\x88\x92\x50\x6e\x7b\xbe\x25\x3d\x91\x96\x37\xa1\xbb\x3d\x63\x4c\x93\x73\x19\xf3\xcd\xd5\xa3\xdf\xdb\x9d\x04\xfa\xc8\xb1\xc9\x62\x0b\xcb\xbd\xa7\x03\xe9\x66\xd1\x5d\xfc\x66\x5e\x9e\xb7\xc0\x81\x4f\x14\x11\x7d\x47\xd6\xfb\x9a\x4a\x6c\x43\x90\x07\x9b\xd7\xe9\x86\x90\xb8\x0f\x84\xfd\xa3\xae\xe5\x35\x55\x3a\x34\xdc\xb6\xa4\x14\xd3\x65\xd0\x4a\x57\xb8\x0d\x8e\x1c\x9b\x0a\x67\xa6\x15\x92\x03\x7c\xcf\xdf\x48\x8d\x25\x56\xd0\xbd\xaf\x4b\x3f\xa4\x3b\x51\xfe\xe1\x13\x4b\x2c\x8f\x1f\xac\x64\x79\x77\x7a\xef\x80\x8e\x92\x6f\x7c\xf2\x95\x03\x30\x7b\x54\xb0\xef\xb1\x21\x63\xdb\x8c\x23\xca\x2f\x8b\x2d\xa6\x69\xf3\x64\x4b\x07\xd8\xb0\xbf\xa7\x3a\x59\x9e\x5f\x98\xf2\x09\xb9\xa6\x6d\x5d\xcb\x10\xd3\x4a\xf8\xf9\x38\x2e\x6b\x55\x78\x6a\xf7\x37\x92\xbf\x58\xff\x41\x34\xe7\x65\x47\x6d\x46\xc6\xf3\x33\xfe\x81\xd7\xf7\x7c\xad\x32\xf4\xbf\xb9\xec\x9c\x96\x6b\xf5\x31\xd9\x8b\xd9\x58\x99\x21\xc9\xdc\x32\x18\xa9\x2b\xf2\xcb\xe7\x50\xe4\x5a\x37\xbf\x9a\x26\x7f\xb3\x42\x1e\xe2\x59\x08\x57\xbb\xd7\x0e\x40\xae\x7f\x35\xd2\xff\x74\xcf\x97\x63\x96\x01\x56\xe2\x6b\x26\x37\x8e\x14\x9b\x69\x72\x3f\x07\x00\xbf\x51\x98\xb6\x5f\x04\x00\x00"),
 		},
 		"/install/grant/grant_cluster_role_kafka.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "grant_cluster_role_kafka.yml.tmpl",
 			modTime:          time.Time{},
 			uncompressedSize: 758,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x91\x49\x8f\x9b\x40\x10\x85\xef\xfd\x2b\x9e\xc4\x19\xa2\xdc\x22\x6e\x93\x45\x39\xc5\x33\x72\x96\x7b\xd1\x94\xc7\x1d\x37\x5d\xa4\xab\xb0\xe5\x20\xfe\x7b\x04\x8c\x3d\x5b\x92\x13\x6a\x6a\x79\xdf\x7b\x55\xb8\x02\x5f\x06\x35\x34\x0c\xc2\x87\x38\xa8\x71\xde\x4a\xe4\xf7\x21\xb5\x21\xdd\x83\x14\xb6\x67\x1c\x68\x77\x20\x28\xe7\x63\xf0\xac\xe8\x06\x35\x57\x2c\x43\xfe\xd7\x10\x32\xb7\xd8\x65\xe9\x40\x3e\x8b\x2a\x12\x75\xac\x3d\x79\xd6\x0a\x77\x59\x8e\xa1\xe5\x75\xcd\xed\xdd\xa7\xed\xcd\xb7\xdb\xad\x2b\x2e\xbb\x40\xde\xcb\x90\x0c\xa7\x60\xfb\xa5\x27\xb1\x67\x55\xca\x67\xf4\x9c\xbb\xa0\x1a\x24\x29\x4c\x10\x12\x6c\xc8\xc9\x15\xb8\xcf\x94\x0c\xb6\x17\x65\x28\x75\xfc\xb2\xf3\x2a\xb5\xf9\xf8\x52\x47\x2b\x37\x7b\xde\x88\x71\xe5\x0a\xdc\xe0\xa9\xd9\xe6\xe1\x6b\xf2\x3c\x0c\x9c\x42\x8c\x90\x14\xcf\xae\x00\xc5\x28\xa7\x45\xe2\xa9\x2a\xd9\x0a\x7f\x71\x5e\xaa\x97\x9e\x11\xf9\xc8\xd1\x15\x8e\xfa\xf0\x83\xf3\xdc\x5a\x23\x37\xe4\x2b\x1a\x6c\x2f\x39\xfc\x26\x0b\x92\xaa\xc3\x3b\xad\x82\xbc\x39\xbe\x75\x87\x90\xda\xfa\x2f\x87\x70\x1d\x1b\xb5\x64\x54\x3b\x2c\x2a\x35\xf4\x9c\x5a\xd6\xa0\xa5\xf4\x9c\xc9\x24\x97\xe3\x88\x6a\x73\x21\xc0\x34\x95\xcb\xd9\x1c\x10\xa9\xe1\xa8\xf3\x28\x40\x7d\xff\x38\xbb\xfc\xb9\x3c\x66\x84\xe7\x55\x1d\x9a\x9f\xec\x4d\x6b\x57\x62\x25\xfb\xba\xc6\x79\xb3\xa6\xf9\x6f\x96\x87\xca\x42\x52\x63\x1c\x1f\xb9\xa6\xe9\xba\xec\xbb\x72\xbe\xae\x18\xc7\x6a\x7e\x4f\x93\xcb\x12\x79\xcb\xbb\x19\xf7\x55\x1c\xaf\x15\xe7\x03\x73\xbe\x5a\xa5\x3e\x7c\xce\x32\xf4\xff\xc9\xd9\xfd\x09\x00\x00\xff\xff\x3d\xd0\xda\xcc\xf6\x02\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x51\xc9\x8e\xdb\x30\x0c\xbd\xeb\x2b\x1e\xe0\xb3\x5d\xf4\x56\xf8\x36\x5d\xd0\x53\x33\x83\x74\xb9\xd3\x32\x33\x51\x23\x8b\xae\x48\x27\x48\x03\xff\x7b\x61\x65\x92\xd9\xda\x9e\x04\x8a\x8f\x7c\x0b\x2b\x57\xe1\xcb\xa4\x86\x8e\x41\xf8\x10\x27\x35\xce\x6b\x89\xfc\x3e\xa4\x3e\xa4\x7b\x90\xc2\xb6\x8c\x1d\x6d\x76\x04\xe5\xbc\x0f\x9e\x15\xc3\xa4\xe6\xaa\x32\xe4\x7f\x4d\x21\x73\x8f\x4d\x96\x01\xe4\xb3\xa8\x22\xd1\xc0\x3a\x92\x67\x6d\x70\x97\x65\x1f\x7a\x3e\xaf\xb9\xbd\xfb\xb4\xbe\xf9\x76\xbb\x76\xd5\x65\x17\xc8\x7b\x99\x92\xe1\x10\x6c\x5b\x30\x89\x3d\xab\x52\x3e\x62\xe4\x3c\x04\xd5\x20\x49\x61\x82\x90\x60\x53\x4e\xae\xc2\x7d\xa6\x64\xb0\xad\x28\x43\x69\xe0\x97\xc8\x2b\xd5\xea\xe3\x4b\x1e\x6d\xdc\xe2\x79\x25\xc6\x8d\xab\x70\x83\xa7\x66\xbb\x87\xd7\xe4\x79\x18\x38\x84\x18\x21\x29\x1e\x5d\x05\x8a\x51\x0e\x45\xe9\x53\x56\xb2\xc2\x7a\x75\x5e\xab\x97\x91\x11\x79\xcf\xd1\x55\x8e\xc6\xf0\x83\xf3\x02\x6d\x91\x3b\xf2\x0d\x4d\xb6\x95\x1c\x7e\x93\x05\x49\xcd\xee\x9d\x36\x41\xde\xec\xdf\xba\x5d\x48\x7d\xfb\x97\x43\xb8\x81\x8d\x7a\x32\x6a\x1d\x4a\xbe\x2d\xf4\x98\x7a\xd6\xa0\xb5\x8c\x9c\xc9\x24\xd7\xa7\x13\x9a\xd5\x45\x01\xe6\xb9\x2e\x67\x73\x40\xa4\x8e\xa3\x2e\xa3\x00\x8d\xe3\xe3\x6c\xf9\xb9\x14\x8b\x84\xe7\x5d\x9d\xba\x9f\xec\x4d\x5b\x57\xe3\xac\xec\xeb\x39\xce\x9b\x73\x9a\xff\xd6\xf2\xd0\x29\x59\xb4\x38\x9d\x1e\x75\xcd\xf3\x75\xd9\x77\xe5\x0b\xb0\x60\x96\x7a\x9e\x5d\x96\xc8\x6b\xde\x2c\x72\x5f\xc5\xf1\x9a\x71\x39\x30\xe7\xab\x55\x1a\xc3\xe7\x2c\xd3\xf8\x9f\x9c\xdd\x9f\x01\x00\x3d\xd0\xda\xcc\xf6\x02\x00\x00"),
 		},
 		"/install/grant/grant_cluster_role_olm.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "grant_cluster_role_olm.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 1284,
+			uncompressedSize: 1326,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x91\xbf\x4e\xf3\x40\x10\xc4\xfb\x7b\x8a\x95\x5c\x9f\x3f\x7d\x1d\x72\x17\x52\xd0\x20\x40\x89\x44\x7f\xb9\xdb\x90\xc5\xf6\xed\x69\xf7\x1c\x29\x58\x7e\x77\x64\x3b\x42\x22\x81\x74\x04\xba\xfb\xb3\xfa\xcd\xec\x4c\x61\x0a\x78\x12\xde\x53\x40\x85\x84\xd2\x92\x2a\x71\x54\xd8\xb2\x80\x1e\x62\x40\x25\xb5\x9c\x50\x5c\x1e\x5f\x50\xf6\xe4\xd1\x3a\xef\xb9\x8b\x19\x32\x9b\x02\xbc\xa0\xcb\x08\x79\x87\x10\xd1\xa3\xaa\x93\x03\x2c\x9b\x4e\x33\xca\x8a\x1b\xbc\xa5\x18\x28\xbe\xcc\xcc\x71\x6a\xc2\xc5\x70\x4a\x53\x53\x18\x0b\x2e\xd1\x33\xca\x68\xa2\x02\xd9\x38\x5f\xba\x2e\xef\x58\xe8\xcd\x65\xe2\x58\xd6\x37\x5a\x12\xff\xdb\xff\x37\x00\x35\xc5\x50\x7d\xa1\x64\x00\x5a\xcc\x2e\xb8\xec\x2a\x03\x00\x10\x5d\x8b\xd5\xf9\x3a\xb6\xef\xa1\x7c\x70\x2d\x6a\x72\x1e\x61\x18\xac\x9f\x59\x56\xb8\x41\xbb\x39\xfa\x36\x00\xe3\x7d\x85\xdb\x99\xe6\x12\xdd\x09\x77\xe9\x82\xbf\x69\xee\xcc\xde\x45\x2f\xdf\x49\x6b\xb7\x79\x45\x9f\x75\xd4\xb6\x47\xe6\x7a\x0e\x6e\x31\xe7\x76\x09\xfb\xf1\x37\xad\x58\xc1\xe9\xc6\xc6\xf4\xbd\x05\xda\x42\xb9\x48\x34\x62\x51\xca\x25\x47\xe5\x06\xef\x29\xd6\xd3\xc4\x6f\x77\x32\xdb\x69\x28\xd6\x57\x6a\xe2\x93\xe0\x35\xf2\xc7\x18\xc6\xf3\x79\x15\x8f\x4d\xbb\xee\x52\x62\xc9\x7f\xa1\x09\x6e\xda\xeb\x34\x30\x0b\xfd\x7c\xf2\x18\xc3\x30\x98\xf7\x00\x00\x00\xff\xff\xd4\x3d\x79\x83\x04\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x91\xbd\x8e\xd3\x40\x10\xc7\xfb\x7d\x8a\x91\x5c\xaf\x11\x1d\x72\x77\x5c\x41\x83\x00\xdd\x49\xf4\x93\xdd\x09\x37\x64\x3d\xb3\x9a\x59\x47\x3a\x22\xbf\x3b\xb2\x7d\x20\x91\x40\x3a\x02\xa5\xbd\xa3\xdf\xff\xab\x0b\x1d\x7c\x32\x3d\x72\x26\x87\x4a\x36\xb2\x3b\xab\x38\xec\xd5\xc0\x9f\x25\x93\xb3\x47\xad\x64\xd8\x96\x3f\x64\x47\x4e\x14\x31\x25\x9d\xa4\x41\xd3\xd0\x41\x32\xc2\x46\xd0\x9e\x08\x84\x12\xb9\xa3\x3d\xc3\x7d\x99\xbc\x91\x3d\x68\xa1\xb7\x2c\x99\xe5\xcb\xc6\x5c\xae\x56\x9c\xe4\x73\x9a\x87\x0e\x50\x32\x34\x05\x23\xcc\x2b\xb0\xe0\x8e\x8a\x83\xee\x81\x9b\x83\xe0\x48\x5e\x31\x51\xe8\x42\x04\xac\xfc\x99\x6c\xb1\x3b\x80\xed\x30\xf5\x38\xb5\x27\x35\xfe\x86\x8d\x55\xfa\xc3\x1b\xef\x59\x5f\x1d\x5f\x07\x80\x03\x4b\x1e\x7e\xe3\x29\x00\x8c\xd4\x30\x63\xc3\x21\x00\xc0\xaa\x30\x5c\x06\x8f\xa7\x13\xf4\x1f\x7e\xa8\xc3\x3c\xc7\xb4\xb1\xa2\x69\xa1\xb8\x7b\x49\x18\x00\x96\xef\x07\xda\x6f\x34\xac\xfc\xce\x74\xaa\x57\xfc\xad\x77\x17\xf6\xae\x7a\xf9\x93\xb4\x4f\xbb\xaf\x94\x9a\x2f\xda\xf1\x25\xf2\xe3\x56\xf1\xdd\xd6\xf0\x35\xec\xcf\xb7\xb5\xe0\x01\xce\x13\x87\x70\x3a\x45\xe0\x3d\xf4\x77\x95\x17\x2c\x59\x7f\xaf\xe2\x5a\xe8\x3d\xcb\x61\xbd\xf8\xd7\x9b\x6c\x76\x0a\xcb\xe1\x46\x4b\xfc\x22\x78\x8b\xfe\x49\xf2\xd2\xf4\xe5\x14\x1f\xcb\xf8\x38\xd5\xaa\xd6\xfe\x87\x25\xb4\x8c\xb7\x59\x60\x13\xfa\xfb\xcd\x93\xe4\x79\x0e\xdf\x07\x00\x66\xa6\xab\x36\x2e\x05\x00\x00"),
+		},
+		"/install/grant/grant_cluster_role_operator.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "grant_cluster_role_operator.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 683,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x52\xb1\x6e\xdb\x30\x10\xdd\xf9\x15\x0f\xd6\x5a\xaa\xe8\x56\x68\x6b\x3b\x74\x28\xd0\xc1\x01\x92\xf9\x44\x9d\xed\x8b\x25\x92\x21\x4f\x06\x1c\x41\xff\x1e\x48\xb2\x63\x03\x36\x9c\x91\xc7\xf7\xee\xbd\x7b\x77\x85\x29\xf0\x22\xba\x83\xb5\xae\xed\xb3\x72\xfa\x86\x5a\x7c\x93\xa1\x3b\x46\x3e\xfa\x86\xb3\x64\x1b\x22\x27\xd2\x90\x90\x39\x1d\xc4\xb1\x25\xe7\x42\xef\xd5\x14\xd0\x30\x43\xff\x2c\xec\x75\x68\x19\x61\x33\x97\x2e\xa4\x09\x42\x0a\x51\x38\xf2\xe8\xc8\xd3\x96\x27\x88\x29\x3e\x25\x90\x38\x87\x3e\x39\xce\x13\x9d\xda\x16\x9e\x3a\xce\x91\x1c\x67\x53\x98\x61\xb0\x90\x0d\xf8\x0d\xe5\x3f\xf1\x0d\x56\x57\x7a\x2b\x8c\xa3\x31\x16\x14\xe5\x99\x53\x96\xe0\x2b\xa4\x9a\x5c\x49\xbd\xee\x42\x92\x77\x52\x09\xbe\xdc\xff\xcc\xa5\x84\xef\x87\x1f\x06\xd8\x8b\x6f\x2a\x5c\xf5\xf8\x2d\xbe\x11\xbf\x35\x40\xc7\x4a\x0d\x29\x55\x06\xc0\x6c\xa2\xba\xcd\xc1\x0e\x03\xca\xff\x67\x83\x93\xfe\x04\x6e\xa9\xe6\x36\x2f\x44\x80\x62\xbc\x30\x4f\xb5\xf3\x73\x32\xf2\xd5\xbf\x1e\x23\x57\x38\x2b\xde\x01\xb8\xd0\xc5\xe0\xd9\xeb\x1d\x83\x06\x48\xa1\xe5\x35\x6f\x16\x3b\x14\xe5\x6f\x0a\x7d\x7c\x90\xcc\x8c\xbb\x09\xe6\x51\x0a\x06\xc8\x7d\xfd\xca\x4e\xe7\xa1\xed\x29\xd6\xa7\xe5\x46\x7e\x9d\x4e\xe4\x71\x03\x5c\xf6\x5c\xe1\x26\xd5\x79\xef\xec\x1b\x8c\xa3\xf9\x18\x00\x9a\x41\xad\x4e\xab\x02\x00\x00"),
 		},
 		"/install/grant/grant_cluster_role_public_api.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "grant_cluster_role_public_api.yml.tmpl",
 			modTime:          time.Time{},
 			uncompressedSize: 813,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x91\xcb\x6e\xdb\x30\x10\x45\xf7\xfc\x8a\x0b\x68\x2d\x15\xdd\x15\xda\xb9\x0f\x74\x55\x27\x70\x1f\xfb\x11\x35\xb1\x58\xd3\x1c\x82\x43\xc9\x4d\x0d\xff\x7b\x41\xc9\x49\xed\xb8\x0d\xb4\x10\xc8\xb9\x98\x7b\x74\x54\x99\x0a\x5f\x46\xcd\xe8\x18\x84\x0f\x7e\xd4\xcc\x69\x23\x9e\xdf\xbb\xd0\xbb\xb0\x05\x29\xf2\xc0\x10\x1a\xf3\x10\x93\xfc\x7a\x34\x15\x86\x72\x29\x48\x3c\x39\x3e\x20\xcb\x8e\x83\x42\x1e\xe6\xe0\xa8\x9c\x40\xa1\x47\x1e\x9c\x9e\x93\xa6\x82\x58\x3b\x26\x50\x9e\x33\x76\xa9\xa9\xd5\x4a\x64\x78\x9e\xd8\x37\xa6\x2a\x0f\xee\x93\x4c\xae\xe7\xa5\xf4\xee\xfe\xd3\x66\xf5\xed\x6e\x03\xe5\x34\x39\xcb\x20\x6b\x65\x0c\x19\x07\x97\x87\x39\x11\xd8\xb2\x2a\xa5\x42\x15\x39\xed\x9d\xaa\x93\x30\xd3\xb9\x80\x3c\xa6\x80\x6d\xa2\x50\x6a\x45\x19\x4a\x7b\x7e\x99\xcb\x03\x9b\x6a\xa9\x5a\x7f\x7c\xd9\xa4\x33\x17\xd6\x92\xb9\x31\x15\x56\xb8\x54\xd3\x9d\xdf\x59\xae\xd5\xe1\xe0\xbc\x87\x04\x5f\xa8\xc8\x7b\x39\xcc\xac\x97\xbd\x67\x11\x81\xf6\xac\x91\x2c\x5f\xaa\x30\x95\xa1\xe8\x7e\x70\x2a\xd1\x16\xa9\x23\xdb\x14\xfb\x92\xdc\x6f\xca\x4e\x42\xb3\x7b\xa7\x8d\x93\x37\xd3\x5b\xb3\x73\xa1\x6f\xff\xf1\xdb\xcc\x9e\x33\xf5\x94\xa9\x35\x98\x5b\x5a\xe8\x63\xe8\x59\x9d\xd6\x12\x39\x51\x96\x54\x1f\x8f\xcd\xfa\x09\xe0\x74\xaa\x4b\x47\xdd\xb3\xe7\x6d\x99\x1a\xc0\x53\xc7\x5e\xcb\x06\x80\x62\xfc\xbb\x62\xbe\x79\x3a\x14\x92\xeb\xa9\x8e\xdd\x4f\xb6\x59\x5b\x53\x63\x01\xfc\xba\x58\x5d\x2d\x52\xff\x8f\x74\x9e\xcc\x44\x2d\xae\xf9\x9e\x97\x7d\x57\x4e\xcf\x2b\x8e\xc7\xa6\x9c\x4f\x27\x93\xc4\xf3\x86\x1f\x0a\xee\x8d\x95\xdb\xc6\x9b\x8f\xa5\xe8\x3e\x27\x19\xe3\x2b\xc2\xcd\x9f\x00\x00\x00\xff\xff\x20\x54\x76\x95\x2d\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x91\xcb\x8e\xdb\x30\x0c\x45\xf7\xfa\x0a\x02\x5e\xdb\x45\x77\x85\x77\xe9\x03\x5d\x35\x33\x48\x1f\x7b\x5a\xe6\xc4\x6a\x14\x51\x10\x29\xa7\xd3\x41\xfe\xbd\x90\x9c\xb4\x79\xb4\x85\x17\x86\xc4\x0b\xde\xe3\xe3\xc6\x34\xf0\x29\x8b\xc2\x40\x80\xf0\xce\x67\x51\x4a\x1b\xf6\xf4\xd6\x85\xd1\x85\x2d\xa0\x80\x4e\x04\x8c\x59\xa7\x98\xf8\xc7\xb3\x69\x60\x2a\x97\x0c\x89\x66\x47\x07\x50\xde\x51\x10\xe0\xa7\x1a\xcc\x42\x09\x30\x8c\xa0\x93\x93\x53\xd2\x34\xc0\xd6\xe6\x04\xa8\x35\x63\x97\x9a\x56\x2c\x47\x02\x4f\x33\xf9\xce\x34\xe5\x81\xc7\xc4\xb3\x1b\x69\x29\x7d\x78\xfc\xb0\x59\x7d\x79\xd8\x80\x50\x9a\x9d\x25\x40\x6b\x39\x07\x85\x83\xd3\xa9\x26\x02\x59\x12\xc1\x54\xa8\x22\xa5\xbd\x13\x71\x1c\x2a\x9d\x0b\xa0\x39\x05\xd8\x26\x0c\xa5\x96\x85\x40\x70\x4f\xb7\x39\x9d\xc8\x34\x4b\xd5\xfa\xfd\x6d\x93\x54\x2e\x58\xb3\x52\x67\x1a\x58\xc1\xa5\x9a\xe1\xf4\x56\xbe\x56\x07\x07\xe7\x3d\x70\xf0\x85\x0a\xbd\xe7\x43\x65\xbd\xec\x3d\x89\x08\xb8\x27\x89\x68\xe9\x52\x85\x69\x0c\x46\xf7\x8d\x52\x89\xf6\x90\x06\xb4\x1d\x66\x9d\x38\xb9\x9f\xa8\x8e\x43\xb7\x7b\x23\x9d\xe3\x57\xf3\x6b\xb3\x73\x61\xec\xff\xf2\xdb\xcc\x9e\x14\x47\x54\xec\x0d\xd4\x96\x1e\xe4\x39\x8c\x24\x4e\x5a\x8e\x94\x50\x39\xb5\x2f\x2f\xdd\xfa\x0c\x70\x3c\xb6\xa5\xa3\x1d\xc9\xd3\xb6\x4c\x0d\x80\xc7\x81\xbc\x94\x0d\x00\x18\xe3\x9f\x15\xf5\xe6\x7c\x28\x24\xd7\x53\xc9\xc3\x77\xb2\x2a\xbd\x69\x61\x01\xfc\xbc\x58\x5d\x2d\x52\xff\x8d\x74\x9a\x54\x25\x3d\x5c\xf3\xfd\x5e\xf6\x55\xe8\x1c\xac\x99\x72\x3e\x1e\x4d\x62\x4f\x1b\x7a\x2a\xb8\x77\x56\xee\x1b\xef\x3e\x16\xa3\xfb\x98\x38\xc7\xff\x08\x37\xbf\x06\x00\x20\x54\x76\x95\x2d\x03\x00\x00"),
 		},
 		"/install/grant/grant_role.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "grant_role.yml.tmpl",
 			modTime:          time.Time{},
 			uncompressedSize: 344,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x8f\x31\x4f\x03\x31\x0c\x85\x77\xff\x0a\xab\x13\x0c\x17\xc4\x86\x32\xb2\x30\x20\x31\x54\x82\xdd\xbd\xb8\x60\xda\x73\x42\x92\x63\x20\xf2\x7f\x47\xd1\xdd\x0d\x08\x84\xd4\xcd\x96\xdf\xfb\xfc\x1e\x25\x79\xe1\x5c\x24\xaa\xc7\x7c\xa0\xd1\xd1\x5c\xdf\x62\x96\x2f\xaa\x12\xd5\x9d\xee\x8a\x93\x78\xf3\x79\x0b\x27\xd1\xe0\xb1\x35\x74\x8f\xa2\x01\xcd\xee\x45\x83\xe8\x2b\x4c\x5c\x29\x50\x25\x0f\x88\x4a\x13\x2f\xa2\x7d\x3c\x33\x9a\x0d\x7d\x7e\x2e\x9c\xd1\x0c\x5a\x1b\x50\x8e\x78\xc5\x1f\x2b\x64\xd7\x55\xbb\xeb\x7e\x5b\xbc\x25\xd1\xb8\x02\x9e\xb6\x75\x73\xb2\x06\x33\xc8\xf1\xcc\x7b\x3e\xf6\x67\x94\xe4\x21\xc7\x39\xfd\x13\x1c\x10\x7f\xe5\xfe\x2b\x26\x94\xf9\xf0\xce\x63\x2d\x1e\x86\x8b\xb8\xbd\xda\x0f\xe0\xd6\xf5\x3b\x00\x00\xff\xff\xa4\x76\x76\xec\x58\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x8f\xb1\x4e\x03\x31\x0c\x86\x77\x3f\x85\xd5\x09\x86\x0b\x62\x43\x19\x59\x18\x90\x18\x2a\x95\xdd\xbd\xb8\x60\xda\x73\x42\x92\x63\x20\xf2\xbb\xa3\xe8\xee\x06\x04\x42\x62\x4b\xe4\xff\xff\xfc\x99\x92\x3c\x73\x2e\x12\xd5\x63\x3e\xd2\xe8\x68\xae\xaf\x31\xcb\x27\x55\x89\xea\xce\x77\xc5\x49\xbc\xf9\xb8\x85\xb3\x68\xf0\xd8\x1a\xba\x47\xd1\x80\x66\xf7\xa2\x41\xf4\x05\x26\xae\x14\xa8\x92\x07\x44\xa5\x89\x97\xd0\x3e\x5e\x18\xcd\x86\xfe\x3e\x14\xce\x68\x06\xad\x0d\x28\x27\xbc\xe2\xf7\x15\xb2\xeb\xa9\xdd\x75\x9f\x2d\xdd\x92\x68\x5c\x01\x4f\xdb\x77\x6b\xb2\x06\x33\xc8\xf1\xc2\x7b\x3e\xf5\x65\x94\xe4\x21\xc7\x39\xfd\x21\x0e\x88\x3f\xbc\x7f\xd3\x84\x32\x1f\xdf\x78\xac\xc5\xc3\xf0\x2f\x6e\x3f\xed\x1b\xf0\x50\x38\xa3\x19\x7c\x0d\x00\xa4\x76\x76\xec\x58\x01\x00\x00"),
 		},
 		"/install/operator_deployment.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "operator_deployment.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 2705,
+			uncompressedSize: 4569,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x4d\x8f\xdb\x36\x10\xbd\xfb\x57\x0c\x94\x83\x13\x20\xd2\x6e\x2e\x3d\x10\xd8\xb6\x46\xbc\x45\x03\x6c\x76\x05\xdb\xdd\x1e\x92\x20\xa0\xa5\xb1\x4c\x94\x22\x19\x72\x2c\xd7\x15\xf4\xdf\x0b\x4a\xb2\x2c\xd9\x5e\x3b\x39\xc4\x07\x43\xe2\x7c\xbc\x37\x43\xce\xa3\xb8\x11\xcf\x68\x9d\xd0\x8a\x01\x37\xc6\xdd\x14\xef\x46\xff\x08\x95\x32\x98\xa2\x91\x7a\x97\xa3\xa2\x51\x8e\xc4\x53\x4e\x9c\x8d\x00\xca\x32\x04\xb1\x82\x68\x8a\xc5\x7c\x63\x8c\xb6\x04\x55\x35\x02\xe0\x4a\x69\xe2\x24\xb4\x72\xde\x0d\xe0\x55\xf3\x0f\x73\x24\x07\x1c\xc8\x8a\x2c\x43\x0b\x5a\x01\xad\x85\x83\xb4\x4b\x0f\xa4\x41\x17\x68\xb7\x56\x10\xb6\x41\xb4\x46\x20\xcc\x8d\xe4\x84\x91\x33\x98\x44\x22\xe7\x19\x82\xb1\xda\xa0\xa5\x1d\x70\x95\x82\xd1\xa2\x8e\xee\xc5\xb8\x9d\x4a\xd1\x09\x17\x7a\x37\x4e\xda\x42\x13\xe7\xc8\x22\xcf\xa3\x1e\xb1\x7a\x3d\xd2\x06\x95\x5b\x8b\x15\x45\x42\xdf\xb4\x14\x1d\x83\xf1\xa7\x32\x58\x59\x9d\x07\xac\x0c\x7c\x37\x02\x16\x7c\xf0\xfe\xf3\x3a\xcd\x82\x67\xc1\xdb\x40\xf1\x1c\x03\x16\x9c\x20\xb2\xb2\x8c\x16\x3c\xab\xaa\xa0\x7a\x1b\xac\x04\xca\x34\xe6\xb4\xf6\x9e\xbe\x8c\x61\x51\x89\x56\xc4\x85\x42\xeb\x3e\xfd\xf6\xfa\xf7\xc8\xa7\xbc\xbb\xfb\x7c\x9a\xf3\x73\xf0\xe6\x4b\xd3\x81\xa0\xfa\x32\xae\x77\x01\x55\x5a\xf7\xdd\xc7\xb0\xd3\xba\x47\x00\x92\x2f\x51\xb6\x9b\xc1\x8d\x39\x38\xd5\x2b\xfb\x17\x5f\xf8\x65\x2b\xed\x0c\x32\xe8\x25\x1e\x9a\x13\x9d\x1b\xad\x50\xd1\x39\x16\xbe\x4a\xcf\xc0\xa2\x91\x22\xe1\x8e\xc1\xbb\x11\x80\x43\x89\x89\x6f\x55\x9d\x2c\xe7\x94\xac\x1f\x7a\x64\x2f\x15\x75\x9d\xfa\x55\xf2\x3f\x40\x1f\xba\x43\xd8\x52\xed\x0d\x82\xff\xc9\x01\xeb\x6b\xbc\xaf\x33\xff\x0e\xee\x3f\xc4\x1e\x60\xbf\x01\xf5\x33\xda\x42\x24\x38\x49\x12\xbd\x51\xf4\x78\x91\xeb\xe1\x64\xee\xa3\xc3\xab\xd5\xbd\x3a\x3c\x41\x3d\x2e\xb0\x15\x52\x02\x97\x5b\xbe\x73\xe0\x88\x5b\x02\xbd\x21\x58\xa2\x50\x19\x38\xac\x27\xdf\x4f\xad\x45\x89\xdc\x21\x44\x75\x14\xf3\xe3\x03\x86\x0b\xdb\xcf\xa7\xa0\x9b\xd5\x46\x40\xb6\x7a\x23\x53\x58\x62\xa7\x1d\x84\x0a\x96\xbb\x46\x3a\x5a\xb1\x39\x88\x52\x2f\x15\x84\x21\xcc\xf0\xdb\x46\x58\x74\xd0\x93\xb1\x3b\x20\xbb\xc1\x97\x1c\xeb\xe9\x8b\x37\x52\xc6\x5a\x8a\x64\x07\x77\x30\x69\xea\x7a\xad\x69\x8d\x76\x2b\x1c\xf6\xe0\x20\xd5\xe8\x40\x69\x5a\x0b\x95\xbd\x39\xca\x39\xc5\x02\xa5\xef\x9e\x97\xb3\x42\xa4\xe8\xf5\x51\xe1\xb6\xd5\x2a\xa1\xea\x1a\xb8\x73\x3a\x11\x9c\x30\x6d\xd6\x1b\x09\x3b\xd3\xec\xda\xca\xa0\x2c\x9b\xf6\x55\x55\xa7\x40\xad\xcb\xa9\x64\x77\x26\x38\xae\x8b\xb5\x65\x75\xa1\x28\x1d\x5e\x72\xff\xb0\x7a\xd4\x14\x5b\x74\xfe\xa2\xe8\x82\x5a\x71\x6a\x7e\x1e\xb1\x37\x24\xe1\xe1\x70\xc5\xda\x12\x83\x5f\x6e\x6f\x6f\x6f\x3b\xf3\x7e\x8a\x72\x24\x2b\x92\xc3\x64\xb4\x55\x64\x04\xd1\x83\xce\x1e\x7c\x0f\xe1\xb6\x87\xc2\x6d\xd6\x03\xf1\x30\xe3\x30\xfc\x8f\x9b\x50\x7a\xd7\xbb\xb2\xec\xc2\xaa\x6a\x3c\xc8\x3a\x64\x8b\xaa\x18\xa6\x69\xe8\xfc\x3d\x59\xbc\xff\xf3\xeb\xe3\xe4\xe3\xfd\x3c\x9e\xbc\xbf\xef\x79\x00\x14\x5c\x6e\xf0\x0f\xab\x73\x36\x58\x06\xa8\xe5\x7f\x86\xab\xe3\xf5\xd6\xe2\x2f\x06\xd6\x89\x4a\x2d\xff\xce\xf0\x04\xcf\xc0\xc7\x4f\xd3\x1a\xfc\x67\xe1\x9e\x81\x7c\x8a\xef\x67\x93\xc5\xd3\xec\x05\x5c\x06\xa7\xf7\x54\x70\xbc\x5b\x27\x9f\x09\xc7\x20\xd3\xfb\xe7\xaf\xf3\xbf\xe2\xf8\x69\xb6\x38\x0b\x51\x96\x83\x63\x1b\x5c\xd8\xb8\x42\xcb\x4d\x8e\x1f\xbd\xb6\x0d\x4e\xdb\x0b\xb2\x15\xfa\xda\x7b\x98\xb9\x0f\x6c\x3a\x73\xd3\x33\x09\x25\xe8\xfd\x19\x31\x4c\x74\x9e\x73\x95\xf6\x81\x96\xdc\xad\x7b\xaf\x61\xd2\x7b\x09\x8c\x76\x94\x79\x21\x09\x9f\xe1\xd7\x06\xe1\x66\xbf\xf6\x4d\x46\xf4\x2f\x05\xc7\x13\x3d\xf6\xc5\x73\xe2\x4b\xee\xb0\x1d\xed\xf1\x77\xce\xed\x7e\x88\xf6\x08\x61\xd1\x7c\xe4\xfd\xe4\x66\x35\x59\xaf\x5f\x19\xc3\x74\x98\x1b\xda\x4d\x85\x65\x50\x56\xa3\xff\x03\x00\x00\xff\xff\x1b\x6b\xa9\x19\x91\x0a\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x57\x5f\x6f\xdb\x48\x0e\x7f\xf7\xa7\x20\x94\x07\xb7\x40\xe4\xa4\xb8\xe2\x70\x27\x20\x77\x6b\x24\x5e\x6c\x81\x34\x31\xec\x6c\xfb\xd0\x16\xc1\x58\xa2\xed\x41\x46\x33\xd3\x19\xca\xae\xd7\xf0\x77\x5f\x50\xff\xe5\x3f\x49\x0a\xec\xbe\x2c\x0c\x24\xd2\x0c\xc9\x21\x7f\x43\xfe\x48\x09\x2b\x3f\xa1\xf3\xd2\xe8\x08\x84\xb5\xfe\x62\xf5\xae\xf7\x24\x75\x12\xc1\x0d\x5a\x65\x36\x29\x6a\xea\xa5\x48\x22\x11\x24\xa2\x1e\xc0\x76\x1b\x82\x9c\xc3\xe0\x06\x57\xd3\xcc\x5a\xe3\x08\x76\xbb\x1e\x80\xd0\xda\x90\x20\x69\xb4\x67\x31\x80\xb3\xe2\x2f\x4c\x91\x3c\x08\x20\x27\x17\x0b\x74\x60\x34\xd0\x52\x7a\x48\x6a\xf3\x40\x06\xcc\x0a\xdd\xda\x49\xc2\x52\x89\x96\x08\x84\xa9\x55\x82\x70\xe0\x2d\xc6\x03\x99\x8a\x05\x82\x75\xc6\xa2\xa3\x0d\x08\x9d\x80\x35\x52\x13\x90\x69\xe9\xf8\x8d\x4e\xd0\x4b\x1f\xb2\x98\x20\xe3\xa0\xd0\xf3\xe4\x50\xa4\x83\x96\x63\xf9\xfa\xc0\x58\xd4\x7e\x29\xe7\x34\x90\xe6\xa2\x74\xd1\x47\xd0\xff\xb2\x0d\xe6\xce\xa4\x41\xb4\x0d\x18\x8d\x20\x0a\x3e\xb0\xfc\x34\x37\xf3\x20\x16\xc1\x79\xa0\x45\x8a\x41\x14\x1c\x9c\x18\x6d\xb7\x83\x07\xb1\xd8\xed\x82\xdd\x79\x30\x97\xa8\x92\xb1\xa0\x25\x4b\x72\x18\xdd\xa0\x62\xa3\x49\x48\x8d\xce\x7f\xf9\xff\x9b\x5f\x06\x6c\xf2\xea\xea\xeb\xa1\xcd\xaf\xc1\xdb\x6f\x05\x02\xc1\xee\x5b\x3f\xbf\x05\xd4\x49\x8e\x3b\xeb\x44\x70\xa0\xd1\x03\x50\x62\x86\xaa\xbc\x0c\x61\x6d\x23\x94\xaf\x54\x2f\x1c\xf8\xf3\xbb\xb4\xb1\x18\x41\xcb\x70\x77\x3b\x36\xa9\x35\x1a\x35\x1d\xf3\x82\xaf\x8e\x3d\x70\x68\x95\x8c\x85\x8f\xe0\x5d\x0f\xc0\xa3\xc2\x98\xa1\xca\x8d\xa5\x82\xe2\xe5\x6d\xcb\xd9\xe7\x82\x7a\xd9\xf5\x17\x9d\xff\x09\xf7\xa1\x4e\xc2\xd2\xd5\x56\x21\x00\x74\x21\x7e\xd9\xef\x97\x3d\x7f\x85\xef\x3f\xe5\x3d\x40\x75\x01\xfc\xf3\xe8\x56\x32\xc6\x61\x1c\x9b\x4c\xd3\xdd\xf3\x18\x63\x9c\x39\x49\x9b\x6b\xa3\x09\x7f\x50\x13\xa2\xcb\xf4\xd0\xdf\x19\x3d\x31\x86\x22\x20\x97\x61\xbd\xe5\x31\x66\x7f\xc6\xce\xcc\xa5\xc2\x46\x05\xa0\x88\x64\x92\x69\x92\x29\xde\xe0\x5c\x64\x8a\xca\xed\xa6\x04\x2a\x85\xf0\x45\x18\xcf\x9a\x27\xc8\xeb\x12\xd6\x52\x29\x10\x6a\x2d\x36\x1e\x3c\x09\x47\x60\x32\x82\x19\x4a\xbd\x00\x8f\x4c\x12\x39\x3d\x38\x54\x28\x3c\xc2\x20\xd7\x8a\xb8\x4e\xc1\x0a\xd9\xb2\x0c\x1f\x34\xd4\xa4\x50\x30\xd5\xda\x64\x2a\x81\x19\xd6\x24\x45\xa8\x61\xb6\xc9\x0d\x56\xac\xd6\xb0\x5f\xcb\x14\x84\x21\x4c\xf0\x7b\x26\x1d\x7a\x68\xf1\xe5\x55\x17\xb6\x3d\xc1\xbc\xcc\xc7\x99\x52\x63\xa3\x64\xbc\x81\x2b\x18\x16\x71\xbd\x31\xb4\x44\xb7\x96\x1e\x5b\xc7\x41\x62\xd0\x83\x36\xb4\x94\x7a\xf1\x76\xcf\xe6\x0d\xae\x50\x31\x7a\xcc\x9b\x2b\x99\x20\x13\xb1\xc6\x75\x49\x8a\x92\xa9\x18\x41\x78\x6f\x62\x29\x08\x93\x62\xbd\xe0\xca\xc6\x54\xfd\x94\xef\x46\xb0\xdd\x16\xf0\xed\x76\x35\xd5\x95\x22\x87\xbd\x61\xb7\xeb\x6a\x37\x71\x45\x65\x58\xb5\x2a\x2a\x8f\xcf\x89\x7f\x98\xdf\x19\x1a\x3b\xf4\xdc\x91\x6a\xa5\x92\x05\xf9\x0d\x80\x4f\x6c\x55\x63\xd8\x24\xd7\xd8\x38\x8a\xe0\xdf\x97\x97\x97\x97\xf5\x76\x55\xae\x29\x92\x93\xb1\x3f\xad\xf6\xdf\xf7\xef\xff\x75\xa0\xb5\xc6\xd9\xd2\x98\xa7\x7a\xbd\x8c\x7d\x41\x30\xb8\x35\x8b\x5b\x46\x1e\x2e\x5b\xbe\x09\xb7\x68\xb9\xc6\xa7\xf4\xc3\xf0\x0f\x61\x43\xc5\xa2\x57\xdb\x6d\xad\xb6\xdb\xf5\x6b\x39\xb6\x5a\x31\x7d\xf1\x43\xbd\x6a\x9b\x69\x6e\x87\x13\x69\x94\x5a\xda\x70\xb2\xaf\x99\x53\x41\x28\x95\x7b\xeb\xad\x88\xd1\x9f\x43\x2b\x81\x9a\x75\x30\x0e\x44\xc7\x4a\x6c\xd2\x54\x80\x47\x2b\x5c\x9e\x15\x4a\x7a\x02\x33\x6f\x99\x6a\x8b\xb7\x9e\xab\xca\xfd\x3c\x7c\xb8\xfe\xed\xf1\x6e\xf8\x71\x34\x1d\x0f\xaf\x47\x2d\x89\x2a\x43\xee\x55\x0a\xad\xa0\xf8\xb7\x12\x2a\xc3\x5f\x9d\x49\xdb\xe1\xf1\x2f\xef\xa1\x13\x9c\xef\xaf\x97\x3b\xdc\x5d\xa3\x9a\x99\x07\x4d\x69\xf8\x2f\x7d\xa3\xd2\x01\x09\xb7\xc0\x9c\xed\x0a\x18\xfa\xdf\x5a\x76\xd8\x1f\x4e\xbc\x7c\xa4\x19\x2a\xd5\x88\x1d\x75\x2f\x82\x20\x38\xa5\xfd\x99\x21\x7f\x59\x7f\xbb\xdd\x97\xdc\xed\x8e\xda\xfc\x7b\xe0\xa9\xef\xb0\x25\x5d\x26\x59\xd7\xe3\xea\xa6\x6a\x3f\xa7\x65\xd3\xee\x8a\x9d\xb8\xf2\xc7\xe9\xe8\x76\x74\xfd\x70\x3f\x39\x8c\xa1\xc0\xe0\xc0\xec\x11\x14\x74\x72\xfc\xac\xf1\xfd\x4d\x9e\x5c\x87\xb6\xff\x1a\x7c\x8e\x1c\x79\x3f\x1e\x4d\x86\x0f\xf7\x93\x13\xe7\x46\x70\x38\xb3\x05\xbd\x7d\x28\x5b\x2d\xe0\x68\x5c\x37\xa3\x4f\x8f\xd3\xdf\xc7\xe3\xfb\xc9\xc3\xd1\x23\xb6\xdb\x0e\xb3\x06\xcf\xb0\xc4\xc9\xf6\x0d\x4c\x0a\x66\x3d\x76\x72\x25\x15\x2e\x70\xe4\x63\xa1\xf2\x4e\x12\xc1\x5c\x28\xdf\x0e\x3e\x16\x56\xcc\xa4\x92\x24\xb1\x43\x5d\x00\x89\x33\xb6\xbb\x12\xc2\xf0\xf6\xb6\x5e\x59\x19\x95\xa5\xf8\x91\xc7\x8c\x96\xe6\xc9\xc6\x1e\x72\xe5\xd6\x62\x00\x29\x2b\x16\x75\x7d\xd1\xd9\x0a\xbb\xe4\x1b\xc6\xe8\xe8\x84\x1e\xa5\xf6\xe2\xe9\x3f\x3e\xac\x44\x79\xf8\x41\x77\xc1\xff\xa4\x5e\xe4\x9a\x0d\xe5\xf3\x80\x2a\x92\x7b\xad\x36\x7b\x23\xcd\x69\x97\xab\xc1\xd0\x9f\x38\x3f\x36\x7a\x7e\x71\x4c\xe8\xe8\x49\x52\x4b\xba\xae\xba\x4e\x0d\x19\x77\xa2\x34\x15\x3a\x69\x63\x38\x13\x7e\xd9\x7a\x0d\xe3\xd6\x4b\x60\x8d\xa7\x05\x8f\x1b\xe1\x27\xf8\x5f\x01\xde\x45\xb5\xf6\x5d\x0d\xe8\x07\x05\xfb\xed\xbc\xcf\x69\x25\x48\xcc\x84\xc7\xb2\xaf\xf7\x4f\x77\xe1\x4e\xd3\xae\x7a\x61\x75\x42\xb8\x2a\x3e\x25\xff\xd9\x69\x58\x58\xad\x0d\xbe\xd2\x1c\x72\x6b\xbe\x91\x2e\x82\xed\xae\xd7\x6d\x9d\x67\x30\x2d\xb2\x12\x38\x2b\xe5\x5c\xc6\x82\x90\x1b\x2e\x4f\x67\x2b\xa1\x64\x22\x88\x07\xd9\x32\x95\xcf\xf3\xa9\xad\x7c\xa9\x4d\x48\x0f\x46\xab\x0d\xa0\x16\x33\x85\x09\xac\x97\x58\x8c\x77\x1e\x63\x87\x04\xf8\x43\x7a\xf2\x7b\x27\x57\xbe\x1f\xad\xa7\x42\xb1\xc1\xad\x5a\x39\xf1\xe1\x10\x1e\x35\x02\x60\x2c\x5f\xab\x50\x9d\x7c\x6f\x42\x7f\xa8\x4a\x24\xff\x46\x14\x31\x07\xca\x13\x49\x92\xf0\x53\x39\xb7\xf3\x67\x85\xe4\xa8\x8c\xe6\x39\x86\x9d\x4e\xaa\x11\xfc\x09\xeb\x7c\x3c\xab\x40\xe3\xe2\x93\x0b\x48\x85\x85\xb5\xa4\x25\x64\x3a\x41\xe7\x63\xc3\x95\x21\x35\xf0\x39\x35\xc0\x5e\x09\xbf\x44\x5f\xbe\xd6\xdf\x02\x67\x60\x05\x2d\xcf\x41\xb0\xc6\xdc\x09\x4f\x2e\x8b\x29\x73\xf8\x78\xf9\x3e\xac\x83\x67\x3a\x41\x37\xd8\xa4\x6a\x40\xa9\x55\x95\xee\x1e\xbc\xaf\x61\x8f\xc2\xe5\x8f\xa2\x93\xd0\xaf\x57\x3f\xc0\xf9\xcf\x01\x00\xa9\x87\xbd\xc1\xd9\x11\x00\x00"),
 		},
 		"/install/operator_install.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "operator_install.yml.tmpl",
 			modTime:          time.Time{},
 			uncompressedSize: 1108,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x91\x31\x8f\xdb\x30\x0c\x85\x77\xff\x0a\xe2\x76\xab\xe8\x56\x68\x6b\x71\x40\xd1\xad\xb8\x04\xdd\x19\x99\x76\xd8\xc8\xa2\x20\xc9\x01\x52\x43\xff\xbd\xb0\x2d\xd7\x09\xd2\xa6\x37\x66\x14\xf9\x44\xf2\x7d\xaf\x06\xf4\xfc\x83\x42\x64\x71\x1a\xce\x1f\x2b\x80\x13\xbb\x46\xc3\x8e\xc2\x99\x0d\x7d\x36\x46\x06\x97\x2a\x80\x9e\x12\x36\x98\x50\x57\x00\x00\x0e\x7b\xd2\x10\x2f\xae\xa1\xc8\xb1\x16\x4f\x01\x93\x84\xb9\x67\xf1\x40\x36\x2e\x3a\x00\xf4\x7e\x13\x96\xda\xfa\x54\x2c\x1f\xfe\xd7\x4f\x17\x4f\x1a\x6e\x16\xdc\x0a\x8c\xf4\x5e\x1c\xb9\xf4\xb7\x7b\xaa\xba\xf8\x79\x13\x4b\x5f\xd8\x35\xec\xba\x0a\x6e\x4c\x87\x03\x1a\x85\x43\x3a\x4a\xe0\x5f\x98\x58\x9c\x3a\x7d\x9a\x27\xcf\x38\xde\xe5\x5b\xb3\x8b\x09\xad\x7d\x3a\xff\x00\x71\x38\xfc\x24\x93\xe6\x7b\xea\x7f\x85\xfb\x28\xd0\x20\x96\xde\xa8\x5d\xfc\x6c\x30\xaf\x7e\x8d\x23\xa8\xa9\x04\x39\x57\x8b\x65\xfe\x1a\x64\xf0\x0f\xd8\x4e\xc1\x5c\x87\xc0\x3d\x76\xa4\xc4\x93\x8b\x47\x6e\xd3\x1f\xfa\xcb\xbe\x6f\x53\x77\x97\x02\x61\x7f\x97\xc8\x73\xd1\x7e\x04\x32\x7a\x32\xcb\x9d\x09\xbb\x72\x71\x5d\xf4\x2f\xe3\xa8\xf6\xd8\xe5\xfc\x52\x76\xb6\x41\xfa\xd5\xd4\xc6\x59\xcd\x24\x72\xd6\xab\x7c\x56\x8c\x23\xb7\xa0\x5e\xe9\xbc\x1b\xbc\x97\x90\x4a\x79\xcb\xeb\x8a\xdf\x1e\xbb\xf2\x87\x6c\xa4\x3b\xe5\xab\x98\x13\x85\x59\x5f\x3a\xdc\x4f\x23\xbf\x8b\x65\x73\xd9\x0e\x8a\xe6\x48\xcd\x60\xa9\xd1\x90\xc2\x40\xeb\x48\xd7\xe4\x5c\xfd\x0e\x00\x00\xff\xff\xb3\x2e\xe6\xf6\x54\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x52\xc1\x8e\xd4\x30\x0c\xbd\xe7\x2b\xac\xbd\x37\x68\x6f\x28\x37\xd0\x4a\x88\x1b\xda\x59\x71\xf7\xa4\x6e\xc7\x4c\x1a\x47\x49\x5a\x69\xa8\xf2\xef\xa8\x9d\x94\xa1\xda\x65\xe0\xb8\xc7\xf8\x3d\xdb\xef\x3d\xa7\x01\x0c\xfc\x9d\x62\x62\xf1\x06\xa6\x47\x05\x70\x66\xdf\x1a\x38\x50\x9c\xd8\xd2\x27\x6b\x65\xf4\x59\x01\x0c\x94\xb1\xc5\x8c\x46\x01\x00\x78\x1c\xc8\x40\xba\xf8\x96\x12\xa7\x46\x02\x45\xcc\x12\x57\xcc\xe1\x91\x5c\xba\xf2\x00\x30\x84\x1b\xb1\xd6\xb6\xa7\x66\xf9\xf0\x2f\x3c\x5f\x02\x19\xd8\x2d\xd8\x13\xac\x0c\x41\x3c\xf9\xfc\x96\x1e\xd5\x54\x3f\xcf\xe2\xe8\x33\xfb\x96\x7d\xaf\x60\x67\x3a\x1e\xd1\x6a\x1c\xf3\x49\x22\xff\xc4\xcc\xe2\xf5\xf9\xe3\x3a\x79\x7a\xfc\x5f\xdf\x86\x7d\xca\xe8\xdc\xbb\xf3\x0f\x90\xc6\xe3\x0f\xb2\x79\xd5\xd3\xfc\xed\xb8\xf7\x0e\x1a\xc5\xd1\x33\x75\x4b\xff\xf6\x39\x96\x30\xff\xe8\x9a\x67\xd0\x4b\x09\x4a\x59\xab\x18\xf8\x4b\x94\x31\xdc\xc9\x56\xa9\xfd\xcf\xe3\x01\x7b\xd2\x12\xc8\xa7\x13\x77\xf9\x77\xfa\xd7\x7d\x5f\x17\xf4\x90\x23\xe1\xf0\xea\x22\xef\x2b\xed\x7b\x41\xa6\x40\xf6\xaa\x33\x63\x5f\x15\x37\x95\xff\x30\xcf\xfa\x05\xfb\x52\x1e\xea\xce\x2e\xca\xb0\x99\xba\xe5\xac\xd7\x24\x4a\x31\x1b\x7d\x65\xcc\x33\x77\xa0\x9f\x68\x3a\x8c\x21\x48\xcc\xb5\x0c\xf0\x46\x7e\x2f\xd8\xd7\x1e\x72\x89\x5e\x31\x9f\xc4\x9e\x29\xae\xfc\x8a\xf0\xb0\x8c\xfc\x26\x8e\xed\xe5\x26\x28\xd9\x13\xb5\xa3\xa3\xd6\x40\x8e\x23\x6d\x23\x7d\x5b\x8a\xfa\x35\x00\xb3\x2e\xe6\xf6\x54\x04\x00\x00"),
+		},
+		"/install/operator_webhook.yml.tmpl": &vfsgen۰CompressedFileInfo{
+			name:             "operator_webhook.yml.tmpl",
+			modTime:          time.Time{},
+			uncompressedSize: 774,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x91\xcd\x8a\xdd\x30\x0c\x85\xf7\x7e\x0a\x41\xb6\x4d\x4a\xe9\x6c\x9a\x87\x68\x0b\x85\xee\x15\xfb\xdc\x7b\xcd\x24\x92\xb1\xd5\x0c\x61\x98\x77\x2f\x76\x92\x3b\x85\xfe\xed\x66\x17\x49\x27\xdf\x91\x8f\x3a\xd7\xd1\x37\xe4\x35\x7a\x90\x5e\xc8\x6e\x20\x0e\x4b\x2c\x25\xaa\x10\x4b\x20\xaf\xb2\x22\xb7\xf2\x09\xd3\x4d\xf5\xb1\x9c\x42\x4d\xc8\x6c\x9a\x07\xfa\x22\xae\x23\x4d\x90\x72\x8b\x17\x6b\x94\x52\xa1\x72\x25\x8f\x6c\xf1\x12\x3d\x1b\xa8\xc0\x67\x18\xc5\x42\x57\x48\xfd\x17\x81\xa6\xed\x55\xee\xd1\x7b\xde\x49\x0d\xdc\x16\x58\xf4\x87\xfc\x22\xbc\xcf\x02\xd2\xac\xdb\x02\xb1\x77\x84\xb9\xe0\xe9\x86\x8c\x83\x55\x6d\x5c\x57\x8d\x4c\x69\x02\xa5\xac\x6b\x0c\x08\xae\x73\x9c\xe2\xf7\xfd\x3d\x23\xad\x1f\xdc\x63\x94\x30\x9e\x09\xb8\x05\xc6\x81\x8d\x47\x47\x24\xbc\x60\xa4\xb2\x49\x40\x89\xa5\x3f\x7d\xfb\x23\x85\x43\x51\x12\x7b\x8c\xf4\xfc\x3c\x7c\x3e\xab\x97\x17\x47\x34\xf3\x84\xb9\x54\x0e\x11\xa7\xf4\x0a\x6a\x9d\xb3\x18\xa2\xbe\xff\xf7\xd4\xb6\x84\xf1\x1e\xc8\x6f\x63\xaf\x4b\x52\x81\xd8\x1f\x36\x75\x44\x2c\xa2\xc6\x16\x55\x8e\x55\x8e\x98\x87\x09\xc6\xc3\xfd\x60\xd5\xe8\xb8\x57\x5f\xef\xd5\xef\x87\xea\xff\x13\x41\xd3\xba\x92\xe0\x2b\x3c\x69\xb6\xe6\xd2\xb7\xcf\x91\x1e\x1e\x3e\x36\x4f\xe3\x7c\x85\x7d\x6d\xbd\x4f\x7b\xb3\x60\x86\x37\xcd\x55\xfe\xd7\xa4\xdf\x30\xa9\x9f\x03\x00\x9b\xec\xdc\xce\x06\x03\x00\x00"),
 		},
 		"/install/role.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "role.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 8282,
+			uncompressedSize: 8726,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\xcd\x8e\xdb\x36\x10\xbe\xe7\x29\x88\xcd\x21\x40\xb0\x96\xd1\x5b\xb1\xe7\xfe\x1c\x0a\xf4\xd0\x43\x2f\x45\x0f\x23\x6a\x2c\x73\x4d\x72\x58\x72\xe4\x8d\x13\xe4\xdd\x0b\x4a\x96\x4c\xd9\x92\x2d\x7b\x6d\x67\x11\xe4\x64\x69\x38\x9e\x7f\x0e\x3f\x8e\x66\x62\xa5\x6c\xf1\x24\xbe\x7c\xc9\xfe\x50\xb6\xf8\xfa\xf5\x9d\x10\xe0\xd4\xdf\xe8\x83\x22\xfb\x24\x7c\x0e\x32\x83\x8a\x97\xe4\xd5\x67\x60\x45\x36\x5b\xfd\x1c\x32\x45\xf3\xf5\x4f\xef\x84\x30\xc8\x50\x00\xc3\xd3\x3b\x21\x84\xb0\x60\xb0\x16\xf5\x17\x69\xac\x45\x09\xa1\x21\x47\x1d\x9a\xf5\x28\xda\x3d\x89\xb0\xb1\x05\x06\x15\xb6\xb4\xf6\x35\x0a\x3d\xb5\xce\x1b\x87\x4f\x82\x1c\x7a\x60\xf2\x03\x0c\x92\x8c\x23\x8b\x96\x77\x62\x66\x09\xbb\xaf\x34\xd6\xc6\xcc\xa2\x97\xbf\x7b\xaa\xdc\xd6\xb6\x99\x78\x78\xa8\x1f\x3c\x06\xaa\xbc\xc4\x8e\x1e\xd0\xaf\x95\x44\x90\x92\x2a\xcb\x8d\x55\x6b\xf4\x79\xc7\xa0\x8c\x43\x1f\xc8\x02\xe3\x79\x92\x63\xbc\x82\x03\x89\x03\x42\x4b\xe4\xa3\xc2\x66\xc2\x79\x7a\x46\xc9\x19\x39\xb4\x61\xa9\x16\x9c\x29\x1a\xd6\xb3\xe5\x3c\x47\x4b\x0e\x2c\x97\xdb\x67\xfc\xc4\x68\x63\x39\x84\x61\xf1\xcf\x94\xa7\xa2\xc5\x3f\x51\xec\xa3\xd0\x2a\xf0\xa3\x90\x1e\x81\xf1\x51\x14\xa8\x31\xfe\x56\xae\xa8\xdf\x5f\xa2\x82\x96\x2c\x49\x6b\x94\xb1\xba\xc4\xbf\xe7\x85\xd0\x51\x11\x92\xc7\x39\x7e\x42\xd9\xcf\x5b\xbb\x8c\xb6\x70\xa4\xda\x04\xce\x44\xcc\x99\x0a\x8c\x96\xd7\xa4\x2b\x83\x52\x83\x32\xed\xa2\x24\xbb\x50\xa5\x01\x17\x3a\x61\xd2\x23\x87\x93\x25\x31\xec\x7c\xeb\x74\x1b\x84\x7d\xaf\xb7\xe1\x38\xd7\x79\x8f\x4e\x2b\x59\xef\x4a\x49\x96\x7d\x94\xe7\xc3\xd1\xc5\x79\x90\xa0\xf1\x5a\x06\x3f\x0a\x77\xcc\x6e\x70\x6e\xa4\x64\x0a\x40\x43\x36\xec\x22\x5a\xa0\xd3\xb4\x31\x68\x87\x28\x89\xd1\x9d\x5f\xc9\x7f\x13\x4a\x8f\x33\x30\x30\x2e\x2a\x9d\xb0\xa6\xa4\xbb\x86\xe2\xd4\x1e\x7a\x45\x40\x94\x2d\x3d\x86\xd0\x15\xba\x45\x7e\x21\xbf\x72\xa4\x95\x54\x38\x10\xa4\x13\x61\x7b\x03\x85\xb3\x75\x41\xd9\x72\x7b\xda\x0c\x07\xad\xef\xf9\xed\xcd\x1a\xdb\x87\xb9\xb2\x85\xb2\x65\xd7\x69\xd6\x49\xd6\xb4\x32\x8a\x3d\xd8\x72\x97\x9f\xae\xeb\xcf\x63\x39\x56\xbd\xfe\xa5\xa9\x4c\x5f\x7b\x0c\x63\x89\xe9\xf3\x34\xb6\xfd\x57\x11\xc3\x30\x31\xfd\xc3\x50\xcc\xa6\xb4\xa2\x99\xc8\x2b\xa5\x8b\x09\x67\x4f\xcd\xd7\xb4\xd3\x30\x40\x9a\xbf\x60\xbe\x24\x5a\xf5\xd6\xee\x9c\xcf\xcb\x9c\x99\x2b\x1b\x18\x2c\xab\xe6\xd8\x3f\xb6\x9c\x2b\x0b\x7e\xd3\xf3\x70\x2e\x35\xd9\xbd\xed\xd4\x38\x77\x5d\x63\xc3\xbc\x40\x06\xa5\xf7\x42\xda\xc4\xef\xda\xaa\xda\xe2\xbd\xbc\xaa\xe2\x89\x31\x41\xdf\xae\x15\xf6\x2b\xeb\x80\xde\x6b\x6c\x87\xab\x0b\x65\x41\xab\xcf\xed\xa1\x79\xe5\x8a\x7b\x2f\x7e\x23\x2f\x0c\x59\xc5\xe4\x95\x2d\xc5\xa2\xb2\x35\x6f\x10\xf9\xa6\x03\xb1\xb3\x50\xac\x86\xa2\x32\xde\x70\x0e\xc0\x4d\x4a\x18\xf6\xa9\x63\x8b\x4e\x75\x2f\x0d\xfa\x6b\x9e\x5f\x3a\xc0\x57\xe3\x9f\xda\xeb\xee\xb5\x71\x7e\xcc\xca\x9d\x87\x99\x24\x8f\x14\x32\x49\xe6\x84\xe1\xdb\xff\x0c\xda\x98\x9a\xd5\x19\x32\xa8\x79\x0c\x60\xec\x1f\x97\xc7\x63\xd2\x79\xf7\x5e\xfc\xfa\xe7\x2f\xaf\x4a\xda\xeb\x4b\x39\x76\xf5\x1c\xe4\x6a\xbc\xa4\x0f\xfb\xce\x21\x4f\x2b\xe5\xa2\x06\x73\xe9\x26\x4c\x0e\xaf\x81\x8d\x78\x8d\x43\x47\x19\x28\x71\x82\x69\x35\x5f\x60\x8f\xd0\x21\xfa\x84\xd4\x3c\x1e\xd2\x0d\x38\x97\x1c\xe3\xa9\x90\x79\x1f\xff\x27\x4b\x0c\xe5\xdd\x8f\xab\x0b\xc2\xa0\x8c\x23\xbf\x7f\x53\x99\x58\x0f\x97\x44\xfd\x55\xf9\xf6\x54\xf1\x14\x85\x35\xdf\xdd\xa3\xcf\x68\x9c\x86\x49\x06\x3a\x4f\x32\x02\xd4\xa2\xfd\x4f\xd8\x93\xd1\x3f\xbe\x5a\x6a\xb3\xc3\xe5\x01\xf7\xdb\xc6\x45\x9a\xee\xb6\x13\x92\x89\xcf\xb0\x41\x0f\x1f\x1f\xba\xa7\xa4\xfb\x3f\x7c\xe3\x8b\x42\x32\xad\xf8\xfe\xa7\x13\xbd\xfb\x4c\xaa\xff\xbe\x63\x8e\x29\xf7\xa6\xdb\x47\xe7\x5c\x2c\x7d\xe2\xf6\xd4\x87\xf5\x13\xae\x54\x13\x6f\x25\xc7\x10\xfc\xb5\x03\x72\x43\xac\x7f\xc7\x16\xf9\x03\xe6\xfd\x80\x79\x6f\x07\xe6\xed\x4d\x83\x4e\x01\xc0\x33\x33\x73\xa0\x39\x99\x74\x1d\xca\x1c\x45\x77\x63\x9f\x94\xc6\x40\x9e\xde\x0d\x31\x49\x63\x6f\xd8\x76\x8f\x3e\xf5\xfd\xe3\xbd\x7e\x32\xa6\xe0\xee\x6f\x93\x92\x0b\xee\x05\xed\xcb\x5c\x56\x81\xc9\xcc\x96\xb4\x9d\x7f\xdc\x3e\xaa\x12\x0c\xea\x0c\x1c\xc8\x25\x66\xe4\xcb\xe3\x48\xf5\x0a\xf6\x8c\xd8\x31\x79\x3c\x33\x13\xa0\xd1\xb3\x01\x0b\xe5\x0e\x60\x39\x4f\x06\x79\x89\xd5\xee\x0b\xc3\xd0\x0c\x27\x65\xac\xbf\xb2\xde\xd8\x2b\x65\x19\xcb\x28\x49\x6f\xc6\x83\x5b\x7a\x58\x80\x85\x02\xc2\x32\x27\xf0\xd7\x1b\x2c\x8f\xdd\x4b\x62\x60\x6c\x99\xad\x2c\xb0\x5a\x63\x56\xe0\x7a\xd8\xb0\x1e\xfa\x1f\x6d\xc7\x63\xdf\x92\x62\xd7\x9d\xa4\x46\x2e\xc1\x5a\xdc\x1f\xfe\x4e\x54\xf3\x0c\x58\xa2\x67\x0f\x32\xea\x1a\xdb\x6b\x1f\x3e\x7e\x48\xa4\x27\xc4\xff\x03\x00\x00\xff\xff\xe9\x29\xfe\x50\x5a\x20\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\x4d\x6f\xe3\x38\x0f\xbe\xf7\x57\x08\x9d\x43\x81\xa2\x76\xf0\xde\x5e\xf4\xbc\x1f\x87\x05\xf6\xb0\x87\xbd\x2c\xf6\x40\x4b\x8c\xa3\x46\x16\xb5\x92\x9c\x4e\x66\x30\xff\x7d\x21\xc7\x76\xe5\x58\x4e\xdc\xb4\xf1\x16\x83\x39\xc5\x26\x69\x7e\x3c\xa4\x28\x4a\xc9\xd8\x56\x6a\xf1\xc8\xbe\x7e\xcd\x7f\x93\x5a\x7c\xfb\x76\xc3\x18\x18\xf9\x27\x5a\x27\x49\x3f\x32\x5b\x00\xcf\xa1\xf6\x1b\xb2\xf2\x0b\x78\x49\x3a\xdf\xfe\xdf\xe5\x92\x56\xbb\xff\xdd\x30\x56\xa1\x07\x01\x1e\x1e\x6f\x18\x63\x4c\x43\x85\x8d\xaa\x3f\x48\x61\xa3\x8a\x31\x05\x05\x2a\x77\xe0\x07\xd5\xe6\x91\xb9\xbd\x16\xe8\xa4\x6b\x69\xdd\x6b\x50\x7a\x8e\xef\xf7\x06\x1f\x19\x19\xb4\xe0\xc9\x26\x04\x38\x55\x86\x34\x6a\xff\xa2\x26\x8b\xc4\x6d\xad\xb0\x71\x26\x0b\x51\xfe\x6a\xa9\x36\xad\x6f\x19\xbb\xbd\x6d\x1e\x2c\x3a\xaa\x2d\xc7\x9e\xee\xd0\xee\x24\x47\xe0\x9c\x6a\xed\x0f\x5e\xed\xd0\x16\xbd\x80\xac\x0c\x5a\x47\x1a\x3c\xbe\x4e\x73\xc0\xcb\x19\xe0\x98\x50\x5a\xa2\x6f\x9f\x94\x74\xdd\xe3\x33\x78\xbe\x39\x69\x23\x63\xc6\xd2\x13\x72\x9f\x93\x41\xed\x36\x72\xed\x73\x49\x69\xf3\xad\xe4\xa4\xf1\x84\x95\xa2\x75\x20\x7c\x8e\x9f\x3d\xea\x50\x25\x2e\xad\xfe\x89\x8a\x58\x35\xfb\x2b\xa8\x7d\x68\xc2\x79\x60\xdc\x22\x78\x7c\x60\x02\x15\x86\xdf\xda\x88\xe6\xbd\x89\xb0\x23\x73\x52\x0a\x79\x28\xba\x07\x66\x02\x83\xfd\x7d\x32\xf8\x71\x84\x24\x5c\xf4\xb8\xc2\xcf\xc8\x87\x59\xed\xd8\xa8\x85\x21\xd9\xa5\x37\x63\x21\xa3\xd2\x79\xd4\x7e\x47\xaa\xae\x90\x2b\x90\x55\xc7\xe4\xa4\xd7\xb2\xac\xc0\x74\x04\x87\xdc\xa2\x77\x43\xd5\x89\x82\x49\x63\xd0\xc5\xde\x61\x31\x0e\xbe\x45\xe5\x22\x0c\x2c\x1a\x25\x79\xb3\x74\x39\x69\x6f\x03\xa6\xd6\x9d\x64\xae\x1c\x07\x85\x0b\xf9\x0d\xc6\xb8\xb4\xe7\x02\xb0\x22\xed\x5e\x80\x15\x68\x14\xed\x2b\xd4\x29\x4a\xe4\x74\x1f\x57\xf4\x6d\x44\x19\x48\x3a\x0f\x1e\xd7\xb5\x8a\x44\x63\xd2\xa2\x50\x9c\x5b\x51\x6f\x00\x44\xea\xd2\xa2\x73\x7d\xbd\x6b\xf4\xcf\x64\xb7\x86\x94\xe4\x12\x13\x20\x8d\x29\x03\x7d\x1f\xa0\x70\xa6\x0a\xbe\x90\x5a\x48\x5d\x76\x11\xe0\x2e\x82\x47\xc9\x4a\x7a\x0b\xba\x44\x37\xea\xc1\xab\x90\xf7\xba\xa3\x37\xfd\x42\x51\x19\xbf\x0e\x04\xa6\x10\x18\xca\x1c\x16\xe3\x3f\x35\x79\x48\x13\xe3\x0f\x52\x98\x3d\xcf\x80\x20\x63\x45\x2d\x95\x98\xd1\xf2\x1b\xb9\x43\xfb\x72\x09\xd2\xea\x19\x8b\x0d\xd1\x76\xc0\x5b\xb8\x81\x5d\x16\xcc\x4a\x6a\xe7\x41\x7b\x79\xd8\x84\x4f\xb1\x0b\xa9\xc1\xee\x63\x21\xb7\xe2\x8a\xf4\x51\xdd\x1e\x82\x7b\x5f\x67\xdd\x4a\xa0\x07\xa9\x8e\x20\x3d\xe0\xf7\xde\xa6\xba\xe2\x4d\x65\x6e\x5e\x55\x85\xd6\x3c\xc3\xde\x4b\xcf\x69\xd1\x9e\xa2\x0f\x3a\xc8\x98\xbb\x96\x1a\x94\xfc\x82\xf6\x08\x9e\xf7\xa9\xb8\x4f\xec\x17\xb2\xac\x22\x2d\x3d\x59\xa9\x4b\xb6\xae\x75\x23\xeb\x58\xb1\xef\x47\xca\xcc\x89\x6d\x0a\x95\x08\x97\xa3\xf8\x47\xc3\x44\x4c\x48\xc7\xd4\x8b\xf5\xa3\x5d\x3c\xf1\xc5\x83\x5e\x3b\x6f\x34\x51\xf7\xaf\x87\x72\x99\xf2\xf2\x25\xc2\x9c\x93\x45\x72\x39\xa7\xea\x8c\xe3\xed\x37\x49\x1f\x63\xb7\xb8\xc5\x13\x96\xa7\x76\xf2\x61\xb6\xcf\x61\xd2\x47\xf7\x89\xfd\xfc\xfb\x4f\x6f\x4a\xda\xdb\x4b\x39\x4c\x4b\x05\xf0\xad\x9b\xe0\xa7\xfa\xce\x58\xa6\xd3\x72\x51\x83\xb9\x74\x11\xf6\xeb\x3f\xc5\x7b\x97\x4d\x47\x56\x50\xe2\x0c\xd7\x1a\x39\xe7\x2d\x42\xe5\xc6\xa4\x03\x77\x4c\xaf\xc0\x98\x68\x1b\x8f\x38\x6e\x35\x9c\xb7\x23\x96\x87\x72\x3a\xaa\x2b\x6d\x57\x17\xc0\x20\x2b\x43\xd6\x1f\x79\x3a\xb3\x1e\x2e\x41\xfd\x4d\xf9\xb6\x54\xfb\x39\x06\x1b\xb9\xa5\xd0\x6f\xe7\x57\xa9\xcb\xf6\x3e\x62\x02\x84\x19\x63\xef\xf5\x9d\x6d\xcc\xed\xd3\x1e\x1a\x12\x42\x3a\x5b\x9b\xa0\xae\xa8\x45\x89\x7e\x29\xb7\xa0\xf6\x14\x76\x64\xa9\xcb\xb4\x6f\xcd\xa5\x0f\x69\x0f\xca\x90\xe8\xa4\xd1\x2e\xe5\x5f\x5f\xf2\x1e\x2b\xa3\x60\x56\x11\x1a\x4b\x3c\x9c\x73\x44\xf7\x8d\x3b\xd2\xd1\x76\xc0\x23\xea\xa1\x8b\x73\x3c\xa6\x2f\x1e\xea\xab\x66\x3c\x45\x8b\x75\xbb\xe8\x8e\x2d\xed\xd0\xed\x7d\x17\xc2\xed\x7d\xb4\xc3\xdf\x2e\x0b\xe0\xa9\x1b\xa0\x6e\x26\xfb\xee\x6f\x7c\x06\x47\xd7\xd8\x8d\xd7\x2a\x4a\x1f\x71\xe7\xde\x00\x4c\x8b\x9c\xde\x8c\x3e\xc2\xca\x72\x89\x33\xe4\xf4\x41\x6e\xc6\x21\x3a\x31\x2e\xa6\xce\xa1\xa9\xac\x5d\x19\x97\x4b\xe7\xcb\x19\x87\xbc\x8f\x1f\xc5\x8f\xf9\xfe\xc7\x7c\x7f\x85\xf9\x7e\x90\x80\xf3\x93\xff\x2b\x33\x33\xb2\x1c\x5d\x71\x9e\xc2\xe5\x64\xb8\x93\x7f\xf4\xa5\x4d\x5a\x52\x7d\x52\xc3\xf3\xe0\xd2\x75\xc1\xe4\x74\x83\xda\xf7\x3b\x1a\x0e\x73\x72\x3e\xcc\xff\x38\x33\x17\x9c\x16\xbb\x97\x15\xaf\x9d\xa7\x2a\xdb\x90\xf3\x0b\x79\xcb\xa1\x42\x95\x83\x01\xbe\xc1\x9c\x6c\x79\x7a\xb6\xbd\xbe\x3f\xb3\x2f\xef\x32\x16\x4e\x62\xbe\x02\x0d\xe5\xcb\x4c\x66\x2c\x55\xe8\x37\x58\x3b\x3c\x1a\x4d\x5b\xc5\x63\xc1\xe6\x1f\xf1\x85\xa2\x93\xda\x63\x19\x34\xaa\xfd\x34\xd8\xa5\x85\x35\x68\x10\xe0\x36\x05\x81\x15\x4b\x39\xd7\x00\x15\xae\x13\x34\x78\xb9\xc3\x5c\xe0\x2e\xed\x60\x8b\xe8\xb4\x5f\x4d\xa2\x27\xac\x34\xad\x7a\x96\x19\xbe\x01\xad\x51\x5d\x66\xe6\x09\xb0\x44\xeb\x2d\xf0\x60\x6b\x6a\x0d\xde\xdd\xdf\x45\xda\x6f\x18\x63\x2c\x63\x77\xf7\x77\x37\xff\x0e\x00\x3e\xb1\x30\x24\x16\x22\x00\x00"),
 		},
 		"/prometheus-config.yml": &vfsgen۰CompressedFileInfo{
 			name:             "prometheus-config.yml",
 			modTime:          time.Time{},
 			uncompressedSize: 18150,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x9c\x51\x73\xda\xb8\x16\xc7\xdf\xfd\x29\xce\x84\xce\xb4\xbd\x97\x3a\x6d\xee\xed\x3e\x78\xdb\xce\x50\x92\xb6\xcc\xa6\x64\x07\x92\xed\x74\x3a\xdd\x19\x61\x1f\x8c\x5a\x59\xf2\x4a\x32\x81\x6f\xbf\x63\x1b\x83\x8d\x4d\x80\x84\x60\x41\xfb\xd4\xfa\xfc\x65\xf4\xfb\xeb\xc8\x3a\x07\x28\x0d\xab\x01\x6d\x11\x4e\x25\xf5\x47\x1a\x9e\xb5\x9f\xc3\xd9\xcb\x57\xbf\x41\x0f\x3d\xf8\x44\x74\x13\x3a\xdc\xb5\xad\x58\x74\x49\x5d\xe4\x0a\x3d\x88\xb8\x87\x12\xf4\x08\xa1\x15\x12\x77\x84\x59\xa4\x09\x7f\xa1\x54\x54\x70\x38\xb3\x5f\xc2\xb3\x58\x70\x32\x0b\x9d\x3c\xff\xdd\x6a\xc0\x54\x44\x10\x90\x29\x70\xa1\x21\x52\x08\x7a\x44\x15\x0c\x29\x43\xc0\x89\x8b\xa1\x06\xca\xc1\x15\x41\xc8\x28\xe1\x2e\xc2\x2d\xd5\xa3\xe4\x65\x66\x37\xb1\xad\x06\x7c\x9d\xdd\x42\x0c\x34\xa1\x1c\x08\xb8\x22\x9c\x82\x18\xe6\x75\x40\x74\x32\xe1\xf8\xcf\x48\xeb\xd0\x39\x3d\xbd\xbd\xbd\xb5\x49\x32\x59\x5b\x48\xff\x94\xa5\x42\x75\x7a\xd9\x69\x5f\x74\xfb\x17\x2f\xce\xec\x97\xc9\x90\x1b\xce\x50\x29\x90\xf8\x4f\x44\x25\x7a\x30\x98\x02\x09\x43\x46\x5d\x32\x60\x08\x8c\xdc\x82\x90\x40\x7c\x89\xe8\x81\x16\xf1\x7c\x6f\x25\xd5\x94\xfb\x4d\x50\x62\xa8\x6f\x89\x44\xab\x01\x1e\x55\x5a\xd2\x41\xa4\x0b\x66\x65\xb3\xa3\xaa\x20\x10\x1c\x08\x87\x93\x56\x1f\x3a\xfd\x13\x78\xdf\xea\x77\xfa\x4d\xab\x01\x5f\x3a\xd7\x9f\xae\x6e\xae\xe1\x4b\xab\xd7\x6b\x75\xaf\x3b\x17\x7d\xb8\xea\x41\xfb\xaa\x7b\xde\xb9\xee\x5c\x75\xfb\x70\xf5\x01\x5a\xdd\xaf\xf0\x47\xa7\x7b\xde\x04\xa4\x7a\x84\x12\x70\x12\xca\x78\xfe\x42\x02\x8d\x6d\x44\x2f\xf6\xac\x8f\x58\x98\xc0\x50\xa4\x13\x52\x21\xba\x74\x48\x5d\x60\x84\xfb\x11\xf1\x11\x7c\x31\x46\xc9\x29\xf7\x21\x44\x19\x50\x15\x2f\xa6\x02\xc2\x3d\xab\x01\x8c\x06\x54\x13\x9d\x5c\x29\x41\xc5\x19\x62\x29\x4d\xa4\x3e\x47\x46\xa6\x7d\x74\x95\x03\xaf\x2d\xa5\x98\x03\x43\xc2\x14\x5a\x03\x46\xdc\x9f\x8c\x2a\x7d\x35\xf8\x81\xae\xee\x92\x00\x95\x03\xdf\x4e\x7e\x90\x31\xb1\xe3\x09\x38\xff\x39\xf9\x6e\xc9\x88\xa1\x72\xe2\x39\x4f\xb9\x87\x8a\x2a\x68\x93\x00\x19\xb4\x05\xd7\x38\xd1\x16\xc0\x0b\x08\x89\xd6\x28\xb9\x03\x4f\xa9\xb0\xd5\x4c\x67\xbb\xb1\xee\x8d\x9b\xea\xde\x3e\xfb\xf6\x77\xf3\xfb\x7f\x9f\x37\x41\x4f\x43\x7c\x3b\xbb\xda\x04\x4e\x02\xcc\x62\xef\xde\xbc\xbb\x24\x4a\x5f\x4c\xdc\x11\xe1\x3e\xb6\xe3\xbc\x43\x8d\xde\x35\x0d\x50\x69\x12\x84\x4f\xad\x38\x81\xe2\x21\x0e\x94\x5e\xc9\xbe\x7b\x6c\x32\x74\x84\x2c\x74\x20\x16\x42\xa6\x84\xb9\x14\x8a\xda\x78\x9e\x0e\xb4\xaf\x6e\xba\xd7\x17\xbd\xe4\x0a\x23\x03\x64\xca\x49\xfe\x0e\x30\x43\x70\xe0\xc9\xab\xd9\x95\x74\x84\xfb\xe8\xc6\x7c\x20\x94\x45\x12\xef\x61\xcb\xf2\xc8\x95\xa6\xcc\x84\x46\x5b\xd2\x8f\x93\x7b\x43\x13\x8a\xda\x1c\x76\x12\xd8\x31\x66\x23\xdb\x1c\xc0\x70\x8c\x6c\x09\x5b\x48\x3f\x7b\xf0\xdd\x87\x3a\x5b\x22\x35\xcf\xdb\x3c\xf9\xf2\xcd\xed\xb2\x3c\x07\x3f\x0f\x42\x31\xba\xdb\x75\xde\x15\x70\x9c\x93\x9b\xd2\xa6\xda\x4a\xd4\x5c\xc8\x4c\xce\x0e\x1f\xb2\xf8\xe4\xdf\x8c\x34\x53\x57\xb2\x16\x82\x66\xd2\x5e\x0b\x4d\xd8\x66\xa8\x89\xb4\x92\x73\x11\x31\x0a\x72\xf6\x14\x55\x9f\x08\xf7\xd6\xa5\xee\x92\x36\x87\x99\x45\x20\x1f\x2a\x52\x55\x71\x95\xc9\xca\xee\xec\x74\x41\xe3\xfb\x10\xd6\x43\x0f\x19\x1d\xa3\xa4\xa8\xd6\x2c\x6b\x79\x40\x61\x71\xd3\x30\x94\xe2\xc6\xa1\x7f\x26\x93\x3f\xa5\x70\x51\x29\xca\xfd\xf8\x28\xb9\x93\xbb\xa4\xce\x41\x7f\x26\x13\x1a\x44\x01\x2c\x04\x30\x57\x3c\x04\xfb\x63\xeb\xe6\xe3\xc5\x8e\xa1\x91\xf0\x6d\xa8\x4b\xf2\x3c\x36\x12\x7e\x10\xcc\x74\x2b\xe4\x65\x75\x9e\x98\xf2\x43\x59\xe8\x8d\x37\xf4\x8a\x8d\xbc\xd3\xfd\xfb\x08\x7c\xc9\xd9\xb1\xc5\xb2\x56\xe8\x73\xb4\x49\xd4\xb0\x65\x55\x51\x80\x52\x95\xc0\xb3\xe2\x60\x7e\x8c\xde\x89\x5d\x52\xe7\xa0\xb3\x18\x14\x83\x0f\x27\xb6\x1a\xd0\x13\x91\xc6\x87\x14\xd2\x32\xbe\x41\x99\xde\xcc\x3a\x3a\x99\xac\x03\x4f\xce\x0a\xc5\x49\x8a\xf0\x38\xf8\x26\x54\xd5\xfb\xa7\x36\xa7\xc6\xde\x3f\x7b\xed\x15\xf7\xde\x90\x6b\xae\xbf\x97\x39\x4b\xa4\x3b\xa9\x4a\x57\x2e\xb7\x49\xf5\x78\xad\x56\x98\x53\x9f\x6f\x6c\xc3\x03\x0e\xfc\x55\x26\x98\x52\xaf\xd7\xe9\x81\x31\xf5\x7b\x8d\x26\xd4\x52\xcf\xd7\xc8\x6b\x52\x7d\x5f\xa3\x0d\x46\x54\xfb\x5b\xf2\x5b\x8d\xcc\x69\x21\x1f\xd2\x02\x84\xd9\x4d\x0e\xa5\x0d\x98\x4f\xb8\x54\x25\x2d\x50\x1e\xcf\x0a\x13\x5a\x82\x7a\x1d\x30\xa7\x3d\xa8\xd7\x87\xda\x5b\x85\x5a\xf0\x6b\x6e\x1b\xaa\x98\x2b\xa9\x77\x52\x33\xdf\x99\x06\x26\xb5\x10\xc6\xd8\x62\x4e\x3b\xb1\xb5\x25\x0f\x28\x2b\xee\x32\xc4\x94\xd6\xc2\x14\x3f\x8c\x69\x33\x0c\x31\xa4\x96\x96\xc3\x10\x76\x93\xda\x0f\x43\x2c\x31\xa2\x15\xb9\xf7\x71\x92\x7e\xbd\x27\xfd\x4c\xe5\x28\x3f\x92\xc9\xa6\x57\x61\x8c\x9b\x03\x2f\xb5\x6a\x7d\x94\x63\xea\xde\xf7\x2d\x5c\x35\x1b\x5d\x75\xdc\x9e\x47\x32\xf9\x9a\xe3\xba\x83\x36\xd3\x55\x1c\xb1\x85\xd0\x26\x2e\xcc\xe6\x53\x61\x82\x5a\x70\xee\x60\x9f\xac\xe6\x46\xc2\x37\x03\xcf\x09\x97\x4f\xd2\x03\xc4\xa6\x1b\x52\xd3\x4a\xe8\xd9\x81\x79\x78\xdc\xc9\x93\x7d\x23\xf2\x82\xb2\x74\x36\x1c\x20\xf9\x48\x22\xf1\xd4\x7b\x26\xdc\x9f\x6b\xba\xad\xa2\x34\xcf\x9e\x06\x20\x1f\x39\x24\xf8\x0e\xd7\x28\x65\x14\xae\x7b\xff\xa9\x2c\xaf\x30\x61\x39\xba\x77\x23\x26\xc3\x37\x83\x48\xd9\xd4\x5b\x72\x61\xfe\xaf\xd9\x3d\x17\x17\x42\x21\x73\x96\x89\x10\xd3\x24\xce\x19\xd5\x8d\x82\x4b\xe1\x53\x97\xb0\x5e\xc4\x35\x0d\xf0\x03\x89\x98\x5e\x79\x40\x4e\x86\xf6\x8a\x11\x39\xc3\xba\x51\x30\x40\x09\x62\x08\x2c\x15\x82\x4c\x95\x30\x5c\x48\x8b\xc8\x65\x37\x53\xd2\x8a\x2f\x35\xe4\x9c\x5c\xb8\xfb\xbf\x45\x01\x22\x64\xbc\x04\xff\x9f\x5f\x98\x53\x3b\xf0\xe4\xf5\xa3\xb9\x6b\xb0\x95\x65\x27\x97\x8d\xac\xf0\x31\x67\xe3\x3e\xd3\xb1\x35\xf6\x7b\xa8\x42\xc1\x15\xde\x55\xd2\x4f\x86\xf6\x92\x32\xe7\x59\x6b\x8c\x92\xf8\x08\x59\x78\x51\xcc\x3f\xb2\x51\xfb\x4d\xb7\x5a\xad\xaa\xde\xa0\x15\x5b\xb4\x7a\x93\xd6\x96\x5f\xdd\x28\xe8\xf0\xb1\x70\xd3\xff\xde\x73\xf7\xd6\xcc\x09\x2b\x77\x24\x5d\x8a\x1f\xe5\x13\xed\x90\xdd\xda\x67\x62\x7d\x26\x93\x0d\x77\xe3\x92\xb2\xa2\xa9\xda\x7e\x37\x1e\x5e\x6a\x1d\xbc\x5f\x7b\x4d\x2e\xca\x37\x35\xab\xa8\xac\x68\xe1\x8e\x3e\xb9\x8e\xd4\xc2\x7d\x9a\x95\xb4\xbc\xc9\xe7\x6b\x6b\xde\x5a\x9d\x0c\xed\x92\xb6\xd4\x3a\x67\xc1\xa3\xcd\xb8\xe3\x70\x6c\xcf\x65\xd8\x16\x3d\xd2\xfa\xe6\xe8\x57\xe9\x2f\x8f\xc0\xb4\x3d\xa7\xd9\x0d\x6f\x8f\xd0\xfd\x89\x5e\x2b\xfd\x75\x83\x58\xb1\x89\x7d\xab\xc7\x55\x7a\x19\x71\x37\x95\x67\xbf\xa2\x10\xeb\x8f\x3e\x1d\x7f\x21\x73\xf7\x9c\xb6\xf7\xf3\x75\x2b\x57\xeb\xf7\x74\xff\x09\xfb\x0b\xd8\xfa\x6f\x00\x00\x00\xff\xff\x99\x2c\x87\x35\xe6\x46\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x9c\x6d\x6f\x1a\x3b\x16\xc7\xdf\xf3\x29\x8e\x42\xa4\xb6\xbb\x94\xb4\xd9\xed\xbe\x98\x4d\x23\x51\x92\xb6\x68\x13\xb2\x02\x72\xab\xaa\xea\x95\xcc\x70\x00\xb7\x1e\xcf\x5c\xdb\x43\xe0\xdb\x5f\x79\x1e\xc0\x8c\x27\x03\x49\x78\x18\x92\x77\xad\xcf\xdf\x13\xff\x7f\x3e\xc6\xe7\x00\x49\xb5\x52\x85\xa6\x1f\xcc\x04\x1d\x8d\x15\xbc\x6e\xbe\x81\xd3\x77\xef\xff\x03\x1d\x1c\xc0\x57\xa2\x6a\xd0\xe2\x6e\xbd\xa2\x45\x57\xd4\x45\x2e\x71\x00\x21\x1f\xa0\x00\x35\x46\x68\x04\xc4\x1d\x63\x1a\xa9\xc1\x1f\x28\x24\xf5\x39\x9c\xd6\xdf\xc1\x6b\x2d\x38\x4a\x42\x47\x6f\xfe\x5b\xa9\xc2\xcc\x0f\xc1\x23\x33\xe0\xbe\x82\x50\x22\xa8\x31\x95\x30\xa4\x0c\x01\xa7\x2e\x06\x0a\x28\x07\xd7\xf7\x02\x46\x09\x77\x11\xee\xa8\x1a\x83\x5a\x3c\xbf\x5e\xa9\xc2\xf7\xe4\x11\x7e\x5f\x11\xca\x81\x80\xeb\x07\x33\xf0\x87\xa6\x0e\x88\x8a\x16\x0c\x00\x30\x56\x2a\x70\x4e\x4e\xee\xee\xee\xea\x24\x5a\x6c\xdd\x17\xa3\x13\x16\x0b\xe5\xc9\x55\xab\x79\xd9\xee\x5e\xbe\x3d\xad\xbf\x8b\xa6\xdc\x72\x86\x52\x82\xc0\xbf\x42\x2a\x70\x00\xfd\x19\x90\x20\x60\xd4\x25\x7d\x86\xc0\xc8\x1d\xf8\x02\xc8\x48\x20\x0e\x40\xf9\x7a\xbd\x77\x82\x2a\xca\x47\x35\x90\xfe\x50\xdd\x11\x81\x95\x2a\x0c\xa8\x54\x82\xf6\x43\xb5\x04\x2b\x5d\x1d\x95\x4b\x02\x9f\x03\xe1\x70\xd4\xe8\x42\xab\x7b\x04\x9f\x1a\xdd\x56\xb7\x56\xa9\xc2\xb7\x56\xef\xeb\xcd\x6d\x0f\xbe\x35\x3a\x9d\x46\xbb\xd7\xba\xec\xc2\x4d\x07\x9a\x37\xed\x8b\x56\xaf\x75\xd3\xee\xc2\xcd\x67\x68\xb4\xbf\xc3\xff\x5a\xed\x8b\x1a\x20\x55\x63\x14\x80\xd3\x40\xe8\xf5\xfb\x02\xa8\xc6\x88\x03\xcd\xac\x8b\xb8\xb4\x80\xa1\x1f\xef\x9e\x0c\xd0\xa5\x43\xea\x02\x23\x7c\x14\x92\x11\xc2\xc8\x9f\xa0\xe0\x94\x8f\x20\x40\xe1\x51\xa9\x37\x53\x02\xe1\x83\x4a\x15\x18\xf5\xa8\x22\x2a\x1a\xb1\x4c\xe9\x0c\xa9\x48\x45\x84\xba\x40\x46\x66\x5d\x74\xa5\x03\x1f\x2a\x52\x32\x07\x86\x84\x49\xac\xf4\x19\x71\x7f\x33\x2a\xd5\x4d\xff\x17\xba\xaa\x4d\x3c\x94\x0e\xfc\x38\xfa\x45\x26\xa4\xae\x17\xe0\xfc\xe3\xe8\x67\x45\x84\x0c\xa5\xa3\xd7\x3c\xe3\x03\x94\x54\x42\x93\x78\xc8\xa0\xe9\x73\x85\x53\x55\x01\x78\x0b\x01\x51\x0a\x05\x77\xe0\x15\xf5\xeb\x32\xd1\xd5\x5d\xad\x3b\x73\x63\xdd\xc7\xd7\x3f\xfe\xac\xfd\xfc\xe7\x9b\x1a\xa8\x59\x80\x1f\x93\xd1\x1a\x70\xe2\x61\x1a\x3b\x3f\x3b\xbf\x22\x52\x5d\x4e\xdd\x31\xe1\x23\x6c\xea\xbc\x43\x85\x83\x1e\xf5\x50\x2a\xe2\x05\xaf\x2a\x3a\x81\xf4\x14\x07\xac\x9f\x54\x2f\x9e\x1b\x4d\x1d\x23\x0b\x1c\xd0\x42\x48\x95\x30\x97\xc2\xb2\x56\xaf\xd3\x81\xe6\xcd\x6d\xbb\x77\xd9\x89\x66\x33\xd2\x47\x26\x9d\xe8\xdf\x00\x89\x05\x07\x8e\xdf\x27\x23\xf1\x0c\x77\xeb\x60\x3e\x13\xca\x42\x81\x8f\xc0\x92\x9d\x79\x2f\x94\x44\x58\x6a\x24\x5d\x9d\xdc\x6b\x42\x58\xd6\x1a\xb6\xa3\xc0\x86\x6d\x56\xd3\xc3\x01\x0c\x27\xc8\x32\xb6\x7d\x31\x4a\x5f\xf8\x1e\xe3\x3a\xdd\x22\x39\xcf\x5b\xd3\x79\xf6\xe1\x75\x5b\x6e\x98\x9f\x07\x17\x87\x60\x0b\xfb\xbc\x29\xc3\x3a\x27\xd7\x75\x1b\x6b\x73\xad\x1a\xa1\x72\xfa\x6c\xf1\x21\xd3\x37\xff\x7a\x4e\x53\x75\xae\xd7\xa5\x60\x39\xdd\xf6\x7c\x45\xd8\x7a\x56\x23\x69\xae\xcf\x45\xa4\x54\x26\x93\x57\x51\xf9\x95\xf0\xc1\xaa\xd4\xcd\x68\x0d\x9b\x69\x04\xcc\xd0\xb2\xab\x3c\x5f\xb6\x33\x9b\xce\x46\x37\x54\x1f\x77\xc2\x3a\x38\x40\x46\x27\x28\x28\xca\x15\xdb\x6a\x4f\x58\xda\xdc\x38\x0c\x56\xbc\x74\xd6\xaf\xc9\xf4\xff\xc2\x77\x51\x4a\xca\x47\xfa\x2a\x29\xf4\x6d\xa9\x0d\xd3\xd7\x64\x4a\xbd\xd0\x83\x85\x00\xe6\x8a\xa7\xd8\xfe\xd2\xb8\xfd\x72\xb9\x61\xd3\x48\xf8\x43\x5c\x5b\x72\xd3\x36\x12\x7e\x10\x9e\xe9\x83\x2c\xd3\x02\xc7\x94\x1f\xca\x46\x9b\xe7\xaf\xd0\xaf\x75\x50\xe3\x6a\xdb\x1a\x2e\x99\xbf\xe8\xee\x78\xc0\xb6\xe6\xe8\x0d\xb7\x51\xb4\x64\xdb\x2a\x43\x0f\x85\xb4\x8c\xa7\xc5\xc1\xfc\x1a\x2d\xb4\x6d\xa9\x0d\xd3\x69\x6c\x71\x23\x6f\xc8\x71\xa5\x0a\x1d\x3f\x54\xf8\x94\x42\x5a\xe8\x07\xd8\xee\xed\xc2\xb8\xd0\xbe\x2d\x37\xfc\x6f\xae\x8e\x8e\x16\xeb\xc0\xf1\x69\xf2\xff\xf8\x09\xd1\xa8\xdc\x8e\xfd\x32\x54\xd5\xbb\x77\x9d\x66\xec\x7a\xbe\x53\x75\xae\xf3\xa5\xe0\x21\x78\xdf\x7b\xc5\xbd\x33\xcb\x99\x9a\xba\xd0\x73\x46\x6b\x98\x7e\x74\xfd\x9d\xf5\x69\x39\xb5\xe1\x6d\x72\xbb\xcb\x54\x8f\xef\x15\x45\x79\xea\xf3\xb5\x31\x3c\xe1\xc2\xbf\x0f\x42\x59\xea\xf5\x7d\x32\x28\x4d\xfd\xbe\x47\x08\xe6\xf9\x2d\xf4\xbf\xc9\x7a\x7e\x8f\x7e\xcb\x54\xdf\xef\x11\x43\x5a\xa6\xcc\xaf\xf0\x42\x08\x96\xda\x40\x90\xc6\x16\xd5\xc0\xb6\xfc\x57\xaa\x29\x69\x5f\x3c\xa5\x05\x08\xd2\x87\xd8\x58\xec\xba\xbe\x90\x8b\x2d\x37\xc0\x6c\xae\x0d\x98\x2f\xd8\x60\x15\x3f\x65\x61\x65\x7b\x28\xca\xd0\x12\xec\x97\x40\x9a\xe2\xeb\x31\x48\xd5\xb9\x14\x96\x82\x87\xc6\x61\xef\xad\xc2\x5e\xec\x67\x5a\x81\x42\xff\x19\xad\x01\xe0\xd1\x6d\x43\x9e\xe7\x5c\xd7\x36\xd0\x4d\xa7\x41\x99\x5a\x88\xd2\x60\x29\x4f\x3b\xf1\x60\x24\x4f\x28\x2b\x8a\x80\x94\xa5\xb5\x28\x0b\x8f\xd2\xb4\x19\x25\x01\x62\x9e\xf7\x42\x16\x9b\x6c\x39\x4a\xe2\xbd\x4c\xed\x47\x49\x90\xa4\x25\xd1\xbc\x44\x28\x04\x62\xa9\x0d\x1c\x69\x6c\x51\x6d\x6c\xff\x3a\x89\xbe\x9c\x99\x7c\xa6\xf2\x2c\x3f\x92\x49\x97\x97\x03\x66\xbe\x72\x2b\x47\xf4\x17\x04\x51\x4c\xa8\xfb\xd8\xb7\x70\x65\x32\xdb\x62\x72\x4d\xa6\x17\xa1\x88\xbe\xe6\x58\x48\xc3\xd0\xe5\x5c\xb1\x4b\xa1\x75\x28\x24\xeb\xc9\x81\x90\xae\xd4\x62\xb0\x61\xdf\x48\xf8\x7a\xc6\x0d\xa1\xe9\x5c\xdf\xa4\x07\x68\x9b\xae\xe9\x9a\xe6\x9a\x4e\x2e\xcc\xc3\xf3\x1d\xbd\xb2\xaf\xe5\x7c\x49\x69\x78\x8f\xc6\x0f\x70\xc7\x7b\x63\x81\x64\x20\x3f\x31\xdf\xfd\xbd\xa2\xdb\x5a\x96\x9a\xde\xe3\x00\x98\x91\x43\x32\xdf\xe2\x0a\x85\x08\x83\x55\xef\x3f\xd9\xf2\x1c\x08\xd9\xe8\xce\x41\x4c\x87\x67\xfd\x50\xd6\xe9\x20\x43\x61\xfe\xbf\xe4\x99\x8b\x70\xe0\x0b\x03\x99\x1f\x60\x7c\x0e\xd2\xa1\xf3\xb3\xf3\x76\xe8\x5d\xf9\x23\xea\x12\xd6\x09\xb9\xa2\x1e\x7e\x26\x21\x53\xf7\x5e\x90\xd3\x61\xfd\x9e\x19\x06\xb0\x76\xe8\xf5\x51\xe8\xdf\x55\x60\xb1\x10\x44\xfc\x6c\x18\x2e\xa4\xcb\x96\x6d\x9a\xb1\xd3\x25\x98\xf1\x14\x83\x64\xe2\xd7\x81\xe3\x7f\xcd\xc7\xb4\x65\x07\x8e\xff\x3d\x1f\x98\xbb\x76\xe0\xf8\xc3\xd6\xe8\x96\x18\xa5\x4d\x32\x0b\x32\x87\xa3\x81\x71\x97\xe9\xd8\x98\x8c\x3a\x28\x03\x9f\x4b\x2c\x2a\xe9\xa7\xc3\x7a\x46\x69\x30\x6b\x4c\x50\xe8\xdf\xf1\x48\xc3\x8b\x62\x7e\xcb\xa0\x76\x9b\x6e\x7b\x45\x95\x4b\x2b\x07\x58\x3e\xb3\xfd\xe5\x57\x3b\xf4\x5a\x7c\xe2\xbb\xd1\xf8\x8a\xa3\x69\x08\x73\x4f\x24\xcd\xc4\x57\x21\xcb\x01\x66\xe1\xca\x83\x95\xcd\xb0\xdd\xa5\xd8\x81\xd3\xda\x65\x62\x5d\x93\xa9\x79\xc6\x0a\x58\x65\x94\x39\x4d\xd5\xc3\x4f\xe3\xe1\xa5\xd6\xc1\xf3\xda\x69\x72\x51\xbe\x2e\x2c\xca\xef\x83\x95\xb4\x70\x7b\x81\xb5\xcb\xe4\x7a\xa6\x08\x77\x09\x2b\x6a\x79\xa3\x8f\xde\x56\xbc\xb5\x3a\x1d\xd6\x2d\xad\x01\x2c\x8a\x41\x1a\x7c\xb6\x19\xf7\x3c\x88\xed\x32\xc1\xda\xa1\xf7\x80\x1e\x69\x75\x73\xf4\x52\xfa\xcb\x67\x00\x6d\xc7\x69\x76\xcb\x9b\x63\xd4\xef\x6a\x35\xe2\xbf\x6e\xa0\x5f\x0c\xd6\xc1\x77\xff\xbc\x5c\x96\x21\x77\xe3\x1f\x93\xfe\x15\x05\xad\x7f\xf6\xe9\xf8\x82\xe0\xee\x38\x6d\x1f\xc7\xf5\x41\x54\x5f\x62\xc2\xbe\x00\xac\x7f\x0f\x00\x99\x2c\x87\x35\xe6\x46\x00\x00"),
 		},
 		"/route": &vfsgen۰DirInfo{
 			name:    "route",
@@ -424,9 +508,9 @@ var assets = func() http.FileSystem {
 		"/route/route.yml.tmpl": &vfsgen۰CompressedFileInfo{
 			name:             "route.yml.tmpl",
 			modTime:          time.Time{},
-			uncompressedSize: 484,
+			uncompressedSize: 1227,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x51\xb1\x6e\xeb\x30\x0c\xdc\xfd\x15\x44\x76\xfb\xe1\xa1\x19\x0a\xed\x05\x3a\x06\x49\xd1\x9d\x95\x99\x98\xa8\x43\x0a\x14\x9d\xd6\x08\xf2\xef\x85\xe5\xb8\x81\xa7\x6e\xd2\xdd\xf1\x74\x27\xd6\x80\x89\xdf\xc9\x32\xab\x04\x30\x1d\x9c\x1a\x4d\x24\xb9\xe3\xa3\x37\xac\xff\x2e\xff\x2b\x80\x4f\x96\x36\xc0\x7e\x62\x2b\x80\x33\x39\xb6\xe8\x18\x2a\x00\x80\x1e\x3f\xa8\xcf\xf3\x19\x00\x53\x0a\x90\x47\x69\x29\x73\xbe\x63\xcb\x75\xb2\xfb\x8b\xf7\x31\x51\x00\x96\xa3\x61\x76\x1b\xa2\x0f\x46\x45\x86\x22\xea\xe8\xac\xf2\xfb\x56\x54\xc9\xda\x53\x83\x7d\xea\x70\x9d\x5a\x2f\x64\x17\xa6\xaf\x1a\x53\xaa\x4b\xab\x00\x1b\xb7\x81\x36\x65\x56\xf0\x4c\xab\x18\x39\x51\x9c\x6d\x3b\xcd\x1e\xe0\x7a\x6d\x0e\x4b\xaa\x52\xfb\x55\xb3\x4f\x53\xb7\x5b\x51\x25\x35\x5f\x62\x38\xda\x89\x7c\x37\x21\xf0\xbc\xdd\x3e\x15\xd8\x1f\x5f\xc2\x92\x29\x0e\x46\x2f\xed\x89\xde\xc8\xce\x2c\xa5\xc6\x4e\x7b\x8e\x63\x80\x3d\xb5\x6c\x14\x7d\x71\x7b\x28\x02\x18\x91\x44\x1b\xd3\x4c\xba\x2e\x96\xf3\x3e\x0e\x53\xc7\x48\x77\x6c\xdd\xa9\x56\x1c\xbc\x4b\xa6\xdf\x63\xf5\x13\x00\x00\xff\xff\x30\x52\x94\xc8\xe4\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x54\x4d\x8b\xdb\x40\x0c\xbd\xfb\x57\x88\xbd\xdb\xbb\xa5\x7b\x08\x73\x2d\x85\x96\x96\x12\x36\xa1\xf7\xc9\x58\x89\x87\x38\x9a\x41\x92\xb3\x1b\x8c\xff\x7b\x19\x3b\x76\xe2\x94\xfd\xb8\x2d\xb9\xc4\x4f\xa3\xf7\xde\x48\xcf\x6e\xdb\x1c\xfc\x16\x8a\x5f\xcd\x06\x99\x50\x51\xba\x2e\xcb\xc1\x46\xff\x17\x59\x7c\x20\x03\x84\xfa\x1c\x78\xef\x69\x57\xec\x17\x52\xf8\x70\x7f\xfc\x92\x01\xec\x3d\x95\x06\x7e\xd2\x8e\x51\x24\x03\x38\xa0\xda\xd2\xaa\x35\x19\x00\x40\x6d\x37\x58\xcb\xf0\x1f\xc0\xc6\x68\x40\x4e\x54\xa2\x78\x39\x63\xe3\x63\x22\x7c\xaf\xae\xa7\x88\x06\x3c\x6d\xd9\x8a\x72\xe3\xb4\x61\xec\x69\xc8\x1e\x70\xd6\x29\x11\x9d\xc9\xc6\x5b\xad\x46\x8e\xb3\xcd\xe2\x5b\x6d\x45\xfe\xd8\x03\x76\x5d\xdf\xef\x07\x7c\x82\x0d\xb4\xed\xdb\x5d\x89\x1a\xa9\xec\xba\xd7\x45\xd6\xbf\x57\x2b\x74\x8c\x7a\x16\xd1\x71\x10\x39\x54\x41\x74\x9a\x4a\x3e\x13\x7b\x0a\x8d\xe2\x8f\x20\x4a\x17\x7b\x00\xd2\x13\xbd\x6e\xed\x5a\xeb\x62\x2d\xb5\x72\x53\xe3\x4c\xd7\x7c\x40\xae\x52\x8d\xa3\x3d\x80\x68\xb5\x9a\xdc\x26\xfb\x09\x30\x70\x3f\x21\xc3\x91\x75\xbf\x9d\x25\xe3\xd6\xbf\x5c\x95\x36\xd6\xed\x91\xca\x4b\x7f\xfa\x09\xf2\xd1\x3b\x9c\x83\xb7\x7b\xcc\x83\x6d\xb4\x8a\x1c\x5e\x4e\x37\xe7\x62\x60\xbd\xed\x05\xa0\xe6\xb0\x41\x36\xb0\x78\x58\x3c\xf4\x6b\xc1\x5a\xf0\xbf\x1c\x73\x1a\x70\x11\x22\x92\x54\x7e\xab\x37\x41\xee\xe7\xf1\x59\x31\xb6\x44\x41\xad\xfa\x40\x93\x96\x0b\x24\xa1\xc6\xc2\xd6\xb1\xb2\x73\xd7\xe1\x98\x86\x88\xcf\xb9\x8d\x31\xef\x6f\x65\xe0\x4e\xb9\xc1\xbb\xb7\xde\x89\x54\xfa\x58\x0c\xae\x87\xac\x96\x77\xa8\xcb\x84\xc0\xe2\xf1\xf1\xeb\x3c\xd0\x00\x9e\x04\x5d\xc3\xf8\xbd\xdc\xe1\x1a\xf9\xe0\xa9\xbf\xc6\x32\xd4\xde\x9d\x0c\x3c\x61\xe9\x19\x9d\x8e\x6c\x97\x13\x06\x18\x91\x1c\x9f\xe2\x50\xd4\x30\x52\x0e\x1f\x96\xd5\x10\x94\xec\xbd\x7c\xb4\x6d\x0e\x48\x65\xd7\x65\xff\x06\x00\xe4\x40\x99\xf5\xcb\x04\x00\x00"),
 		},
 		"/upgrade": &vfsgen۰DirInfo{
 			name:    "upgrade",
@@ -437,7 +521,7 @@ var assets = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 791,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x8f\x41\x8f\xd3\x30\x10\x85\xef\xf9\x15\xa3\xdc\x9d\x6a\xc5\xcd\x37\x04\x17\xd0\x6a\xb5\x02\x2d\xf7\xb1\x3d\xed\x9a\xda\x1e\xcb\x33\x09\xaa\xaa\xfe\x77\xd4\xa4\xd0\xaa\x14\x15\x36\xb7\xbc\x79\xdf\x7b\xcf\x06\xb0\xc6\x6f\xd4\x24\x72\xb1\xe0\x50\xfd\xeb\x6a\x7a\xe8\x00\xb6\xb1\x04\x0b\x9f\xd9\x75\x00\x99\x14\x03\x2a\xda\x0e\x00\xa0\x60\x26\x0b\x63\xdd\x34\x0c\x64\x82\x33\x39\x6e\x1a\x6a\xe4\xd2\x01\x48\x25\xbf\xd8\x2a\x36\x4c\x89\x52\x94\x6c\xe1\x61\x96\x3c\xe7\x9a\xe8\xe8\x94\x5f\x92\x52\xae\x09\x95\x16\xe6\xba\xea\x6e\xdd\xf2\x25\x74\x94\xe4\x8c\x00\x7c\x67\x67\xee\x82\xe7\xad\xf3\x1f\xb5\x29\x7a\x7a\xef\x3d\x8f\x45\x9f\x66\x58\x76\x25\x90\x44\x31\x81\xd6\x38\x26\xfd\x6d\xf6\x5c\x14\x63\xa1\x76\xd1\x6a\xfe\x65\x2a\x40\xcc\xb8\x21\x0b\xfd\x7e\x0f\xc3\xd7\x53\xfe\xf0\x81\x73\xe5\x42\x45\x65\x78\x59\xf0\xe1\xd3\xd1\x07\x87\x43\x7f\xc1\x7a\xce\x19\x4b\xb8\x7c\xe9\xb1\xb7\x5f\x71\xd5\x15\x15\x6d\xbb\xca\xb1\xe8\x20\xaf\xfd\xb5\xc5\x98\x51\xa8\xfd\x21\xff\x6d\xc5\x47\x54\x74\x28\x34\xbc\x08\xb5\xab\x15\xa7\xbc\x8a\x22\xff\x9f\xf7\x8c\x22\x3f\xb8\x85\xdb\x99\x63\x4b\x6f\x98\xf8\xe5\xf1\x76\xda\x44\xcd\xb1\xd0\xf9\xd2\x48\x14\x9b\x3e\x73\x8a\x7e\x67\xe1\x89\x26\x6a\xf3\xd1\xa1\xdf\xf2\x7a\xfd\x18\x73\x54\x0b\xef\xba\x9f\x01\x00\x00\xff\xff\x78\x78\xf4\x35\x17\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x8e\x41\xcf\x12\x31\x10\x86\xef\xfb\x2b\x26\xdc\xbb\xc4\x78\xeb\xcd\xe8\x45\xf3\xe5\xcb\x17\x0d\xde\xa7\xed\x00\x95\xb6\xd3\x74\x66\xd7\x10\xc2\x7f\x37\x0b\xe8\x12\xc4\x20\xe9\xad\xf3\x3e\xcf\xfb\x1a\xc0\x1a\xbf\x53\x93\xc8\xc5\x82\x43\xf5\xdb\xe5\xf8\xae\x03\xd8\xc5\x12\x2c\x7c\x61\xd7\x01\x64\x52\x0c\xa8\x68\x3b\x00\x80\x82\x99\x2c\x0c\x75\xd3\x30\x90\x09\xce\xe4\xb8\x69\xa8\x91\x4b\x07\x20\x95\xfc\x39\x56\xb1\x61\x4a\x94\xa2\x64\x0b\x93\x11\xc0\x73\xae\x89\xa6\xa4\xfc\xfe\x52\xca\x35\xa1\xd2\x99\xb9\xad\x7a\x58\x37\x05\x00\x12\x3a\x4a\x32\x23\x00\x3f\xd8\x99\x87\xe0\xbc\x75\x7a\x42\x6d\x8c\x9e\x3e\x78\xcf\x43\xd1\xd7\x13\x2c\xfb\x12\x48\xa2\x98\x40\x6b\x1c\x92\xfe\x09\x7b\x2e\x8a\xb1\x50\xbb\x6a\x35\xff\x33\x15\x20\x66\xdc\x90\x85\xc5\xe1\x00\xfd\xb7\x8b\xbf\xff\xc8\xb9\x72\xa1\xa2\xd2\xaf\xce\x78\xff\x79\xca\xc1\xf1\xb8\xb8\x62\x3d\xe7\x8c\x25\xcc\x9d\xd3\x33\xb0\x58\x72\xd5\x25\x15\x6d\xfb\xca\xb1\x68\x2f\xdb\x6b\xea\x14\x31\x66\x10\x6a\x7f\x7d\xff\x6b\xc5\x27\x54\x74\x28\xd4\xaf\x84\xda\xcd\x8a\x8b\xaf\xa2\xc8\xf3\xbe\x37\x14\xf9\xc9\x2d\xdc\x77\x0e\x2d\x3d\xaf\x5c\x7d\x7d\xb9\x6f\x1b\xa9\x39\x16\x9a\x2f\x8d\x44\xb1\xe9\x1b\xa7\xe8\xf7\x16\x5e\x69\xa4\x76\x3a\x3a\xf4\x3b\x5e\xaf\x5f\x62\x8e\x6a\xe1\x7d\xf7\x6b\x00\x78\x78\xf4\x35\x17\x03\x00\x00"),
 		},
 	}
 	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
@@ -459,6 +543,7 @@ var assets = func() http.FileSystem {
 	}
 	fs["/addons/jaeger"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/addons/jaeger/server_operator.yml.tmpl"].(os.FileInfo),
+		fs["/addons/jaeger/syndesis-jaeger-network-policy.yml.tmpl"].(os.FileInfo),
 		fs["/addons/jaeger/syndesis-jaeger.yml.tmpl"].(os.FileInfo),
 	}
 	fs["/addons/knative"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
@@ -477,20 +562,24 @@ var assets = func() http.FileSystem {
 		fs["/addons/ops/addon-ops-integrations-servicemonitor.yml"].(os.FileInfo),
 		fs["/addons/ops/addon-ops-jvm-dashboard.yml"].(os.FileInfo),
 		fs["/addons/ops/addon-ops-meta-alerting-rules.yml"].(os.FileInfo),
+		fs["/addons/ops/addon-ops-network-policies.yml.tmpl"].(os.FileInfo),
 		fs["/addons/ops/addon-ops-server-alerting-rules.yml"].(os.FileInfo),
 		fs["/addons/ops/addon-ops-servicemonitor.yml"].(os.FileInfo),
 	}
 	fs["/addons/publicApi"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/addons/publicApi/addon-public-network-policies.yml.tmpl"].(os.FileInfo),
 		fs["/addons/publicApi/addon-public-oauthproxy.yml.tmpl"].(os.FileInfo),
 	}
 	fs["/addons/todo"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/addons/todo/04-todo-example.yml.tmpl"].(os.FileInfo),
+		fs["/addons/todo/05-todo-network-policy.yml.tmpl"].(os.FileInfo),
 	}
 	fs["/backup"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/backup/syndesis-backup-job.yml.tmpl"].(os.FileInfo),
 		fs["/backup/syndesis-restore-job.yml.tmpl"].(os.FileInfo),
 	}
 	fs["/database"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/database/syndesis-db-network-policy.yml.tmpl"].(os.FileInfo),
 		fs["/database/syndesis-db.yml.tmpl"].(os.FileInfo),
 	}
 	fs["/infrastructure"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
@@ -506,6 +595,10 @@ var assets = func() http.FileSystem {
 		fs["/infrastructure/05-syndesis-cluster-security.yml.tmpl"].(os.FileInfo),
 		fs["/infrastructure/05-syndesis-security.yml.tmpl"].(os.FileInfo),
 		fs["/infrastructure/06-syndesis-prometheus.yml.tmpl"].(os.FileInfo),
+		fs["/infrastructure/07-syndesis-autoscalers.yml.tmpl"].(os.FileInfo),
+		fs["/infrastructure/07-syndesis-disruption-budgets.yml.tmpl"].(os.FileInfo),
+		fs["/infrastructure/08-syndesis-network-policies.yml.tmpl"].(os.FileInfo),
+		fs["/infrastructure/09-syndesis-truststore.yml.tmpl"].(os.FileInfo),
 	}
 	fs["/install"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/install/app.yml.tmpl"].(os.FileInfo),
@@ -517,16 +610,19 @@ var assets = func() http.FileSystem {
 		fs["/install/grant"].(os.FileInfo),
 		fs["/install/operator_deployment.yml.tmpl"].(os.FileInfo),
 		fs["/install/operator_install.yml.tmpl"].(os.FileInfo),
+		fs["/install/operator_webhook.yml.tmpl"].(os.FileInfo),
 		fs["/install/role.yml.tmpl"].(os.FileInfo),
 	}
 	fs["/install/cluster"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/install/cluster/jaeger.yml.tmpl"].(os.FileInfo),
-		fs["/install/cluster/syndesis.yml"].(os.FileInfo),
+		fs["/install/cluster/syndesis.yml.tmpl"].(os.FileInfo),
+		fs["/install/cluster/webhook.yml.tmpl"].(os.FileInfo),
 	}
 	fs["/install/grant"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/install/grant/grant_cluster_role_jaeger.yml.tmpl"].(os.FileInfo),
 		fs["/install/grant/grant_cluster_role_kafka.yml.tmpl"].(os.FileInfo),
 		fs["/install/grant/grant_cluster_role_olm.yml.tmpl"].(os.FileInfo),
+		fs["/install/grant/grant_cluster_role_operator.yml.tmpl"].(os.FileInfo),
 		fs["/install/grant/grant_cluster_role_public_api.yml.tmpl"].(os.FileInfo),
 		fs["/install/grant/grant_role.yml.tmpl"].(os.FileInfo),
 	}
@@ -2,6 +2,7 @@ package generator_test
 
 import (
 	"context"
+	"io/ioutil"
 	"path/filepath"
 	"reflect"
 	"strings"
@@ -15,7 +16,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
 	"github.com/syndesisio/syndesis/install/operator/pkg/generator"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/capabilities"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/yaml"
 
@@ -709,10 +712,327 @@ func TestAuditingFeatureToggle(t *testing.T) {
 	}
 }
 
+func TestHighAvailability(t *testing.T) {
+	syndesis := &v1beta2.Syndesis{
+		Spec: v1beta2.SyndesisSpec{
+			HighAvailability: v1beta2.HighAvailabilitySpec{
+				Enabled:  true,
+				Replicas: 3,
+			},
+		},
+	}
+
+	ui := deploymentOf(t, renderResource(t, syndesis, "./infrastructure/03-syndesis-ui.yml.tmpl"))
+	replicas, _, _ := unstructured.NestedFieldNoCopy(ui.Object, "spec", "replicas")
+	assert.EqualValues(t, 3, replicas)
+	constraints, _, _ := unstructured.NestedSlice(ui.Object, "spec", "template", "spec", "topologySpreadConstraints")
+	require.Len(t, constraints, 2)
+	assert.Equal(t, "kubernetes.io/hostname", constraints[0].(map[string]interface{})["topologyKey"])
+	assert.Equal(t, "topology.kubernetes.io/zone", constraints[1].(map[string]interface{})["topologyKey"])
+
+	proxy := deploymentOf(t, renderResource(t, syndesis, "./infrastructure/04-syndesis-oauth-proxy.yml.tmpl"))
+	strategy, _, _ := unstructured.NestedString(proxy.Object, "spec", "strategy", "type")
+	assert.Equal(t, "Rolling", strategy)
+
+	// Meta only scales out with a volume shared between the nodes
+	meta := deploymentOf(t, renderResource(t, syndesis, "./infrastructure/04-syndesis-meta.yml.tmpl"))
+	replicas, _, _ = unstructured.NestedFieldNoCopy(meta.Object, "spec", "replicas")
+	assert.EqualValues(t, 1, replicas)
+
+	// Server keeps a single replica
+	server := deploymentOf(t, renderResource(t, syndesis, "./infrastructure/04-syndesis-server.yml.tmpl"))
+	replicas, _, _ = unstructured.NestedFieldNoCopy(server.Object, "spec", "replicas")
+	assert.EqualValues(t, 1, replicas)
+
+	budgets := renderResource(t, syndesis, "./infrastructure/07-syndesis-disruption-budgets.yml.tmpl")
+	require.Len(t, budgets, 2)
+	assert.Equal(t, "policy/v1", budgets[0].GetAPIVersion())
+	assert.Equal(t, "syndesis-ui", budgets[0].GetName())
+	assert.Equal(t, "syndesis-oauthproxy", budgets[1].GetName())
+
+	syndesis.Spec.Components.Meta.Resources.VolumeAccessMode = v1beta2.VolumeAccessMode("ReadWriteMany")
+	budgets = renderResource(t, syndesis, "./infrastructure/07-syndesis-disruption-budgets.yml.tmpl")
+	require.Len(t, budgets, 3)
+	assert.Equal(t, "syndesis-meta", budgets[2].GetName())
+
+	config, err := configuration.GetProperties(context.TODO(), "../../build/conf/config-test.yaml", syntesting.FakeClientTools(), &v1beta2.Syndesis{})
+	require.NoError(t, err)
+	budgets, err = generator.Render("./infrastructure/07-syndesis-disruption-budgets.yml.tmpl", config)
+	require.NoError(t, err)
+	assert.Empty(t, budgets)
+}
+
+func TestAutoscaling(t *testing.T) {
+	syndesis := &v1beta2.Syndesis{
+		Spec: v1beta2.SyndesisSpec{
+			Components: v1beta2.ComponentsSpec{
+				Server: v1beta2.ServerConfiguration{
+					Autoscaling: v1beta2.AutoscalingSpec{
+						Enabled:     true,
+						MinReplicas: 2,
+					},
+				},
+				Meta: v1beta2.MetaConfiguration{
+					Resources: v1beta2.ResourcesWithPersistentVolume{
+						VolumeAccessMode: v1beta2.VolumeAccessMode(corev1.ReadWriteMany),
+					},
+					Autoscaling: v1beta2.AutoscalingSpec{
+						Enabled:     true,
+						MaxReplicas: 5,
+					},
+				},
+			},
+		},
+	}
+
+	server := deploymentOf(t, renderResource(t, syndesis, "./infrastructure/04-syndesis-server.yml.tmpl"))
+	assert.Equal(t, "true", server.GetAnnotations()["syndesis.io/autoscaled"])
+	replicas, _, _ := unstructured.NestedFieldNoCopy(server.Object, "spec", "replicas")
+	assert.EqualValues(t, 2, replicas)
+
+	meta := deploymentOf(t, renderResource(t, syndesis, "./infrastructure/04-syndesis-meta.yml.tmpl"))
+	assert.Equal(t, "true", meta.GetAnnotations()["syndesis.io/autoscaled"])
+	replicas, _, _ = unstructured.NestedFieldNoCopy(meta.Object, "spec", "replicas")
+	assert.EqualValues(t, 1, replicas)
+
+	autoscalers := renderResource(t, syndesis, "./infrastructure/07-syndesis-autoscalers.yml.tmpl")
+	require.Len(t, autoscalers, 2)
+
+	assert.Equal(t, "autoscaling/v2", autoscalers[0].GetAPIVersion())
+	assert.Equal(t, "syndesis-server", autoscalers[0].GetName())
+	target, _, _ := unstructured.NestedString(autoscalers[0].Object, "spec", "scaleTargetRef", "name")
+	assert.Equal(t, "syndesis-server", target)
+	minReplicas, _, _ := unstructured.NestedFieldNoCopy(autoscalers[0].Object, "spec", "minReplicas")
+	assert.EqualValues(t, 2, minReplicas)
+	metrics, _, _ := unstructured.NestedSlice(autoscalers[0].Object, "spec", "metrics")
+	require.Len(t, metrics, 1)
+	name, _, _ := unstructured.NestedString(metrics[0].(map[string]interface{}), "resource", "name")
+	assert.Equal(t, "cpu", name)
+
+	assert.Equal(t, "syndesis-meta", autoscalers[1].GetName())
+	maxReplicas, _, _ := unstructured.NestedFieldNoCopy(autoscalers[1].Object, "spec", "maxReplicas")
+	assert.EqualValues(t, 5, maxReplicas)
+	metrics, _, _ = unstructured.NestedSlice(autoscalers[1].Object, "spec", "metrics")
+	require.Len(t, metrics, 1)
+	name, _, _ = unstructured.NestedString(metrics[0].(map[string]interface{}), "resource", "name")
+	assert.Equal(t, "memory", name)
+
+	// Meta is only scaled out when its volume can be mounted on other nodes
+	syndesis.Spec.Components.Meta.Resources.VolumeAccessMode = v1beta2.ReadWriteOnce
+	meta = deploymentOf(t, renderResource(t, syndesis, "./infrastructure/04-syndesis-meta.yml.tmpl"))
+	assert.NotContains(t, meta.GetAnnotations(), "syndesis.io/autoscaled")
+	autoscalers = renderResource(t, syndesis, "./infrastructure/07-syndesis-autoscalers.yml.tmpl")
+	require.Len(t, autoscalers, 1)
+	assert.Equal(t, "syndesis-server", autoscalers[0].GetName())
+
+	config, err := configuration.GetProperties(context.TODO(), "../../build/conf/config-test.yaml", syntesting.FakeClientTools(), &v1beta2.Syndesis{})
+	require.NoError(t, err)
+	autoscalers, err = generator.Render("./infrastructure/07-syndesis-autoscalers.yml.tmpl", config)
+	require.NoError(t, err)
+	assert.Empty(t, autoscalers)
+	server = deploymentOf(t, renderResource(t, &v1beta2.Syndesis{}, "./infrastructure/04-syndesis-server.yml.tmpl"))
+	assert.NotContains(t, server.GetAnnotations(), "syndesis.io/autoscaled")
+}
+
+func TestNetworkPolicies(t *testing.T) {
+	syndesis := &v1beta2.Syndesis{
+		Spec: v1beta2.SyndesisSpec{
+			NetworkPolicies: v1beta2.NetworkPoliciesSpec{
+				Enabled: true,
+			},
+		},
+	}
+
+	policies := renderResource(t, syndesis, "./infrastructure/08-syndesis-network-policies.yml.tmpl")
+	byName := map[string]unstructured.Unstructured{}
+	for _, policy := range policies {
+		assert.Equal(t, "NetworkPolicy", policy.GetKind())
+		byName[policy.GetName()] = policy
+	}
+	assert.Contains(t, byName, "syndesis-oauthproxy")
+	assert.Contains(t, byName, "syndesis-ui")
+	assert.Contains(t, byName, "syndesis-meta")
+	assert.Contains(t, byName, "syndesis-prometheus")
+	assert.Contains(t, byName, "syndesis-integrations")
+
+	server := byName["syndesis-server"]
+	ingress, _, _ := unstructured.NestedSlice(server.Object, "spec", "ingress")
+	require.Len(t, ingress, 1)
+	from, _, _ := unstructured.NestedSlice(ingress[0].(map[string]interface{}), "from")
+	require.Len(t, from, 2)
+	component, _, _ := unstructured.NestedString(from[0].(map[string]interface{}), "podSelector", "matchLabels", "syndesis.io/component")
+	assert.Equal(t, "syndesis-oauthproxy", component)
+	component, _, _ = unstructured.NestedString(from[1].(map[string]interface{}), "podSelector", "matchLabels", "syndesis.io/component")
+	assert.Equal(t, "integration", component)
+
+	db := renderResource(t, syndesis, "./database/syndesis-db-network-policy.yml.tmpl")
+	require.Len(t, db, 1)
+	assert.Equal(t, "syndesis-db", db[0].GetName())
+	assert.Equal(t, []string{"syndesis-server", "syndesis-meta", "integration", ""}, databaseClients(t, db[0]))
+
+	config, err := configuration.GetProperties(context.TODO(), "../../build/conf/config-test.yaml", syntesting.FakeClientTools(), syndesis)
+	require.NoError(t, err)
+	todo, err := generator.Render("./addons/todo/05-todo-network-policy.yml.tmpl", config)
+	require.NoError(t, err)
+	assert.Empty(t, todo)
+	syndesis.Spec.Addons.Todo.Enabled = true
+	todo = renderResource(t, syndesis, "./addons/todo/05-todo-network-policy.yml.tmpl")
+	require.Len(t, todo, 1)
+	db = renderResource(t, syndesis, "./database/syndesis-db-network-policy.yml.tmpl")
+	require.Len(t, db, 1)
+	assert.Equal(t, []string{"syndesis-server", "syndesis-meta", "integration", "todo", ""}, databaseClients(t, db[0]))
+
+	config, err = configuration.GetProperties(context.TODO(), "../../build/conf/config-test.yaml", syntesting.FakeClientTools(), &v1beta2.Syndesis{})
+	require.NoError(t, err)
+	policies, err = generator.Render("./infrastructure/08-syndesis-network-policies.yml.tmpl", config)
+	require.NoError(t, err)
+	assert.Empty(t, policies)
+	policies, err = generator.Render("./database/syndesis-db-network-policy.yml.tmpl", config)
+	require.NoError(t, err)
+	assert.Empty(t, policies)
+}
+
+// Lists the components of the pods allowed to connect to the database, empty
+// for the selector of the jobs
+func databaseClients(t *testing.T, policy unstructured.Unstructured) []string {
+	ingress, _, _ := unstructured.NestedSlice(policy.Object, "spec", "ingress")
+	require.Len(t, ingress, 1)
+	from, _, _ := unstructured.NestedSlice(ingress[0].(map[string]interface{}), "from")
+	components := []string{}
+	for _, peer := range from {
+		component, _, _ := unstructured.NestedString(peer.(map[string]interface{}), "podSelector", "matchLabels", "syndesis.io/component")
+		components = append(components, component)
+	}
+	return components
+}
+
+func TestTrustedCABundle(t *testing.T) {
+	syndesis := &v1beta2.Syndesis{
+		Spec: v1beta2.SyndesisSpec{
+			TrustedCABundle: v1beta2.TrustedCABundleSpec{
+				ConfigMapName: "corporate-ca",
+				Key:           "ca.pem",
+			},
+		},
+	}
+
+	for _, path := range []string{"./infrastructure/04-syndesis-server.yml.tmpl", "./infrastructure/04-syndesis-meta.yml.tmpl"} {
+		deployment := deploymentOf(t, renderResource(t, syndesis, path))
+		initContainers, _, _ := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "initContainers")
+		require.Len(t, initContainers, 1, path)
+		name, _, _ := unstructured.NestedString(initContainers[0].(map[string]interface{}), "name")
+		assert.Equal(t, "truststore", name)
+
+		containers, _, _ := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "containers")
+		env, _, _ := unstructured.NestedSlice(containers[0].(map[string]interface{}), "env")
+		for _, variable := range env {
+			if variable := variable.(map[string]interface{}); variable["name"] == "JAVA_OPTIONS" {
+				assert.Contains(t, variable["value"], "-Djavax.net.ssl.trustStore=/etc/syndesis/truststore/cacerts")
+			}
+		}
+
+		volumes, _, _ := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "volumes")
+		found := false
+		for _, volume := range volumes {
+			volume := volume.(map[string]interface{})
+			if volume["name"] != "trusted-ca-bundle" {
+				continue
+			}
+			found = true
+			sources, _, _ := unstructured.NestedSlice(volume, "projected", "sources")
+			require.Len(t, sources, 2)
+			configMap, _, _ := unstructured.NestedString(sources[1].(map[string]interface{}), "configMap", "name")
+			assert.Equal(t, "corporate-ca", configMap)
+			items, _, _ := unstructured.NestedSlice(sources[1].(map[string]interface{}), "configMap", "items")
+			require.Len(t, items, 1)
+			assert.Equal(t, "ca.pem", items[0].(map[string]interface{})["key"])
+		}
+		assert.True(t, found, path)
+	}
+
+	truststore := renderResource(t, syndesis, "./infrastructure/09-syndesis-truststore.yml.tmpl")
+	require.Len(t, truststore, 1)
+	assert.Equal(t, "syndesis-truststore", truststore[0].GetName())
+	script, _, _ := unstructured.NestedString(truststore[0].Object, "data", "generate-truststore.sh")
+	assert.Contains(t, script, "keytool -importcert")
+
+	serverConfig := renderResource(t, syndesis, "./infrastructure/03-syndesis-server-config.yml.tmpl")
+	applicationYml, _, _ := unstructured.NestedString(serverConfig[0].Object, "data", "application.yml")
+	assert.Contains(t, applicationYml, "trustedCaBundleConfigMap: 'corporate-ca'")
+	assert.Contains(t, applicationYml, "trustedCaBundleKey: 'ca.pem'")
+
+	server := deploymentOf(t, renderResource(t, &v1beta2.Syndesis{}, "./infrastructure/04-syndesis-server.yml.tmpl"))
+	_, found, _ := unstructured.NestedSlice(server.Object, "spec", "template", "spec", "initContainers")
+	assert.False(t, found)
+}
+
+func deploymentOf(t *testing.T, resources []unstructured.Unstructured) unstructured.Unstructured {
+	for _, res := range resources {
+		if res.GetKind() == "DeploymentConfig" || res.GetKind() == "Deployment" {
+			return res
+		}
+	}
+	require.Fail(t, "no deployment rendered")
+	return unstructured.Unstructured{}
+}
+
 // TestGeneratorProperty makes sure that no matter what configuration
 // is provided resulting YAML files can be parsed. The
 // generator.Render* methods use Go templates and parse the results
 // as YAML.
+// The CRD is applied client-side by config/Makefile, which keeps the whole
+// resource in its last-applied-configuration annotation
+func TestCRDFitsLastAppliedConfiguration(t *testing.T) {
+	const maxAnnotationsSize = 256 * 1024
+
+	for _, olm := range []bool{true, false} {
+		crds, err := generator.Render("./install/cluster/syndesis.yml.tmpl", struct {
+			Olm       bool
+			Namespace string
+			ApiServer capabilities.ApiServerSpec
+		}{Olm: olm, Namespace: "syndesis", ApiServer: capabilities.ApiServerSpec{EmbeddedProvider: true}})
+		require.NoError(t, err)
+		require.Len(t, crds, 1)
+
+		data, err := crds[0].MarshalJSON()
+		require.NoError(t, err)
+		assert.Less(t, len(data), maxAnnotationsSize)
+	}
+
+	data, err := ioutil.ReadFile("../../config/crd/bases/syndesis.io_syndesises.yaml")
+	require.NoError(t, err)
+	crd := unstructured.Unstructured{}
+	require.NoError(t, yaml.Unmarshal(data, &crd.Object))
+	data, err = crd.MarshalJSON()
+	require.NoError(t, err)
+	assert.Less(t, len(data), maxAnnotationsSize)
+}
+
+// The jobs are scheduled as the components, without the schema of the
+// affinity and spread constraints in the CRD
+func TestCRDJobSchedulingSchemaless(t *testing.T) {
+	data, err := ioutil.ReadFile("../../config/crd/bases/syndesis.io_syndesises.yaml")
+	require.NoError(t, err)
+	crd := unstructured.Unstructured{}
+	require.NoError(t, yaml.Unmarshal(data, &crd.Object))
+
+	versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	var schema map[string]interface{}
+	for _, v := range versions {
+		if version := v.(map[string]interface{}); version["name"] == "v1beta2" {
+			schema, _, _ = unstructured.NestedMap(version, "schema", "openAPIV3Schema", "properties", "spec", "properties", "jobScheduling", "properties")
+		}
+	}
+	require.NotNil(t, schema)
+
+	for _, path := range [][]string{{"affinity"}, {"topologySpreadConstraints", "items"}} {
+		preserved, _, _ := unstructured.NestedBool(schema, append(path, "x-kubernetes-preserve-unknown-fields")...)
+		assert.True(t, preserved, path)
+		_, found, _ := unstructured.NestedMap(schema, append(path, "properties")...)
+		assert.False(t, found, path)
+	}
+}
+
 func TestGeneratorProperty(t *testing.T) {
 	// if the test fails, comment above and use this instead
 	// pass in the seed to troubleshoot
@@ -731,6 +1051,13 @@ func TestGeneratorProperty(t *testing.T) {
 	arbitraries.RegisterGen(gen.Struct(reflect.TypeOf(v1beta2.MavenConfiguration{}), map[string]gopter.Gen{
 		"Repositories": gen.MapOf(gen.Identifier(), gen.Identifier()), // we can't have Maven repositories with illegal characters
 	}))
+	arbitraries.RegisterGen(gen.Struct(reflect.TypeOf(v1beta2.PodOverrides{}), map[string]gopter.Gen{
+		// pod overrides are applied to the rendered resources, not to the templates
+		"Env":          gen.Const([]corev1.EnvVar(nil)),
+		"EnvFrom":      gen.Const([]corev1.EnvFromSource(nil)),
+		"Volumes":      gen.Const([]v1beta2.PodVolume(nil)),
+		"VolumeMounts": gen.Const([]corev1.VolumeMount(nil)),
+	}))
 
 	properties := gopter.NewProperties(parameters)
 
@@ -0,0 +1,423 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package generator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var log = logf.Log.WithName("generator")
+
+// Directories of the templates that may be overridden
+var overridableDirs = []string{"infrastructure", "addons", "database"}
+
+// Templates layered over the compiled ones, if any
+var templateOverrides http.FileSystem
+
+// GetAssetsFS returns the templates compiled into the operator, along with
+// the ones of the template overrides directory, if any
+func GetAssetsFS() http.FileSystem {
+	if templateOverrides == nil {
+		return compiledAssetsFS()
+	}
+	return NewLayeredFS(compiledAssetsFS(), templateOverrides)
+}
+
+// UseTemplateOverrides layers the templates of the directory over the
+// compiled ones, replacing or adding to the templates of the infrastructure,
+// addons and database directories. Each template overridden is logged with
+// its checksum. A directory that doesn't exist is ignored.
+func UseTemplateOverrides(dir string) error {
+	if dir == "" {
+		templateOverrides = nil
+		return nil
+	}
+
+	if info, err := os.Stat(dir); os.IsNotExist(err) {
+		log.Info("No template overrides", "directory", dir)
+		templateOverrides = nil
+		return nil
+	} else if err != nil {
+		return err
+	} else if !info.IsDir() {
+		templateOverrides = nil
+		return &os.PathError{Op: "open", Path: dir, Err: os.ErrInvalid}
+	}
+
+	overrides, err := templatesDirFS(dir)
+	if err != nil {
+		return err
+	}
+	if err := logTemplateOverrides(compiledAssetsFS(), overrides, "/"); err != nil {
+		return err
+	}
+
+	templateOverrides = overrides
+	return nil
+}
+
+// Logs the templates of the overrides replacing or adding to the compiled
+// ones. The links are followed, the templates of a mounted config map being
+// links to its hidden ..data directory.
+func logTemplateOverrides(compiled http.FileSystem, overrides http.FileSystem, name string) error {
+	dir, err := overrides.Open(name)
+	if err != nil {
+		return err
+	}
+	entries, err := dir.Readdir(-1)
+	dir.Close()
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		entryName := path.Join(name, entry.Name())
+		f, err := overrides.Open(entryName)
+		if err != nil {
+			return err
+		}
+		info, err := f.Stat()
+		if err != nil {
+			f.Close()
+			return err
+		}
+
+		if info.IsDir() {
+			f.Close()
+			if err := logTemplateOverrides(compiled, overrides, entryName); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if !isOverridable(entryName) {
+			f.Close()
+			log.Info("Ignoring template outside of the overridable directories", "template", entryName, "directories", overridableDirs)
+			continue
+		}
+
+		sum := sha256.New()
+		_, err = io.Copy(sum, f)
+		f.Close()
+		if err != nil {
+			return err
+		}
+		checksum := hex.EncodeToString(sum.Sum(nil))
+		if c, err := compiled.Open(entryName); err == nil {
+			c.Close()
+			log.Info("Template replaced by override", "template", entryName, "sha256", checksum)
+		} else {
+			log.Info("Template added by override", "template", entryName, "sha256", checksum)
+		}
+	}
+	return nil
+}
+
+func isOverridable(name string) bool {
+	for _, dir := range overridableDirs {
+		if name == "/"+dir || strings.HasPrefix(name, "/"+dir+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// NewLayeredFS returns a file system serving the files of the overlay in
+// place of the ones of the base in the overridable directories, the listings
+// of the directories merging both
+func NewLayeredFS(base http.FileSystem, overlay http.FileSystem) http.FileSystem {
+	return &layeredFS{base: base, overlay: overlay}
+}
+
+type layeredFS struct {
+	base    http.FileSystem
+	overlay http.FileSystem
+}
+
+func (fs *layeredFS) Open(name string) (http.File, error) {
+	name = path.Clean("/" + name)
+	if !isOverridable(name) {
+		return fs.base.Open(name)
+	}
+
+	o, err := fs.overlay.Open(name)
+	if err != nil {
+		return fs.base.Open(name)
+	}
+	info, err := o.Stat()
+	if err != nil {
+		o.Close()
+		return nil, err
+	}
+	if !info.IsDir() {
+		return o, nil
+	}
+
+	b, err := fs.base.Open(name)
+	if err != nil {
+		// Directory only found in the overlay, ie. a new addon
+		return o, nil
+	}
+	return &layeredDir{File: b, name: name, overlay: fs.overlay, overlayDir: o}, nil
+}
+
+// A directory of both file systems, listing the entries of the overlay in
+// place of the ones of the base with the same name
+type layeredDir struct {
+	http.File
+	name       string
+	overlay    http.FileSystem
+	overlayDir http.File
+	listed     bool
+}
+
+func (d *layeredDir) Readdir(count int) ([]os.FileInfo, error) {
+	if d.listed {
+		if count > 0 {
+			return nil, io.EOF
+		}
+		return []os.FileInfo{}, nil
+	}
+
+	base, err := d.File.Readdir(-1)
+	if err != nil {
+		return nil, err
+	}
+	overlay, err := d.overlayDir.Readdir(-1)
+	if err != nil {
+		return nil, err
+	}
+	d.listed = true
+
+	entries := map[string]os.FileInfo{}
+	for _, info := range base {
+		entries[info.Name()] = info
+	}
+	for _, info := range overlay {
+		if strings.HasPrefix(info.Name(), ".") {
+			continue
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			if info, err = d.statOverlay(info.Name()); err != nil {
+				return nil, err
+			}
+		}
+		entries[info.Name()] = info
+	}
+
+	infos := make([]os.FileInfo, 0, len(entries))
+	for _, info := range entries {
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Name() < infos[j].Name()
+	})
+	return infos, nil
+}
+
+// Stats the entry of the overlay directory, following the links
+func (d *layeredDir) statOverlay(name string) (os.FileInfo, error) {
+	f, err := d.overlay.Open(path.Join(d.name, name))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return f.Stat()
+}
+
+func (d *layeredDir) Close() error {
+	d.overlayDir.Close()
+	return d.File.Close()
+}
+
+// Separator of the directories in the keys of a config map of templates, the
+// keys not allowing slashes
+const keySeparator = "_"
+
+// Serves the templates of the directory. The templates of a config map
+// mounted without items are named by its keys, with the separator in place
+// of the slashes of their path, as infrastructure_04-syndesis-server.yml.tmpl
+// or addons_todo_04-todo-example.yml.tmpl. Otherwise the templates are laid
+// out in the directories they override.
+func templatesDirFS(dir string) (http.FileSystem, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := map[string]string{}
+	ignored := []string{}
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		if p, ok := keyTemplatePath(entry.Name()); ok {
+			keys[p] = entry.Name()
+		} else {
+			ignored = append(ignored, entry.Name())
+		}
+	}
+	if len(keys) == 0 {
+		return http.Dir(dir), nil
+	}
+
+	for _, key := range ignored {
+		log.Info("Ignoring template outside of the overridable directories", "template", key, "directories", overridableDirs)
+	}
+	return &keyedFS{dir: dir, keys: keys}, nil
+}
+
+// Path of the template named by a key of a config map, false if the key
+// doesn't name a template of the overridable directories
+func keyTemplatePath(key string) (string, bool) {
+	parts := strings.SplitN(key, keySeparator, 3)
+	switch {
+	case len(parts) == 3 && parts[0] == "addons":
+		return "/addons/" + parts[1] + "/" + parts[2], true
+	case len(parts) >= 2 && (parts[0] == "infrastructure" || parts[0] == "database"):
+		return "/" + parts[0] + "/" + strings.Join(parts[1:], keySeparator), true
+	}
+	return "", false
+}
+
+// The templates of a config map, served at the paths named by its keys
+type keyedFS struct {
+	dir string
+	// Keys of the templates, by path
+	keys map[string]string
+}
+
+func (fs *keyedFS) Open(name string) (http.File, error) {
+	name = path.Clean("/" + name)
+	if key, ok := fs.keys[name]; ok {
+		f, err := os.Open(filepath.Join(fs.dir, key))
+		if err != nil {
+			return nil, err
+		}
+		return &keyedFile{File: f, name: path.Base(name)}, nil
+	}
+
+	children := map[string]os.FileInfo{}
+	for p, key := range fs.keys {
+		if !strings.HasPrefix(p, strings.TrimSuffix(name, "/")+"/") {
+			continue
+		}
+		child := strings.SplitN(strings.TrimPrefix(p, strings.TrimSuffix(name, "/")+"/"), "/", 2)
+		if len(child) > 1 {
+			children[child[0]] = keyedDirInfo(child[0])
+			continue
+		}
+		info, err := os.Stat(filepath.Join(fs.dir, key))
+		if err != nil {
+			return nil, err
+		}
+		children[child[0]] = renamedInfo{FileInfo: info, name: child[0]}
+	}
+	if len(children) == 0 {
+		return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+	}
+
+	infos := make([]os.FileInfo, 0, len(children))
+	for _, info := range children {
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Name() < infos[j].Name()
+	})
+	return &keyedDir{name: path.Base(name), entries: infos}, nil
+}
+
+// A template of a config map, named after its path rather than its key
+type keyedFile struct {
+	*os.File
+	name string
+}
+
+func (f *keyedFile) Stat() (os.FileInfo, error) {
+	info, err := f.File.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return renamedInfo{FileInfo: info, name: f.name}, nil
+}
+
+type renamedInfo struct {
+	os.FileInfo
+	name string
+}
+
+func (i renamedInfo) Name() string {
+	return i.name
+}
+
+// A directory of the paths named by the keys of a config map
+type keyedDir struct {
+	name    string
+	entries []os.FileInfo
+	listed  bool
+}
+
+func (d *keyedDir) Close() error {
+	return nil
+}
+
+func (d *keyedDir) Read([]byte) (int, error) {
+	return 0, &os.PathError{Op: "read", Path: d.name, Err: os.ErrInvalid}
+}
+
+func (d *keyedDir) Seek(int64, int) (int64, error) {
+	return 0, &os.PathError{Op: "seek", Path: d.name, Err: os.ErrInvalid}
+}
+
+func (d *keyedDir) Readdir(count int) ([]os.FileInfo, error) {
+	if d.listed {
+		if count > 0 {
+			return nil, io.EOF
+		}
+		return []os.FileInfo{}, nil
+	}
+	d.listed = true
+	return d.entries, nil
+}
+
+func (d *keyedDir) Stat() (os.FileInfo, error) {
+	return keyedDirInfo(d.name), nil
+}
+
+type keyedDirInfo string
+
+func (i keyedDirInfo) Name() string       { return string(i) }
+func (i keyedDirInfo) Size() int64        { return 0 }
+func (i keyedDirInfo) Mode() os.FileMode  { return os.ModeDir | 0555 }
+func (i keyedDirInfo) ModTime() time.Time { return time.Time{} }
+func (i keyedDirInfo) IsDir() bool        { return true }
+func (i keyedDirInfo) Sys() interface{}   { return nil }
@@ -0,0 +1,147 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package generator_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndesisio/syndesis/install/operator/pkg/generator"
+)
+
+const overriddenConfigMap = `
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: {{ .Name }}
+`
+
+func TestUseTemplateOverrides(t *testing.T) {
+	dir, err := ioutil.TempDir("", "templates")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// Laid out as a mounted config map, the templates linking to its data
+	data := filepath.Join(dir, "..data", "infrastructure")
+	require.NoError(t, os.MkdirAll(data, 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(data, "04-syndesis-server.yml.tmpl"), []byte(overriddenConfigMap), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(data, "99-extra.yml"), []byte("- apiVersion: v1\n  kind: ConfigMap\n  metadata:\n    name: extra\n"), 0644))
+	require.NoError(t, os.Symlink(filepath.Join("..data", "infrastructure"), filepath.Join(dir, "infrastructure")))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "ignored.yml"), []byte("{}"), 0644))
+
+	require.NoError(t, generator.UseTemplateOverrides(dir))
+	defer generator.UseTemplateOverrides("")
+
+	resources, err := generator.Render("./infrastructure/04-syndesis-server.yml.tmpl", struct{ Name string }{Name: "overridden"})
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	assert.Equal(t, "overridden", resources[0].GetName())
+
+	// The listings merge the compiled and added templates
+	f, err := generator.GetAssetsFS().Open("./infrastructure/")
+	require.NoError(t, err)
+	defer f.Close()
+	infos, err := f.Readdir(-1)
+	require.NoError(t, err)
+	names := []string{}
+	for _, info := range infos {
+		names = append(names, info.Name())
+	}
+	assert.Contains(t, names, "03-syndesis-ui.yml.tmpl")
+	assert.Contains(t, names, "99-extra.yml")
+	assert.Equal(t, 1, countOf(names, "04-syndesis-server.yml.tmpl"))
+
+	resources, err = generator.Render("./infrastructure/99-extra.yml", nil)
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	assert.Equal(t, "extra", resources[0].GetName())
+
+	// Only the overridable directories are layered
+	_, err = generator.GetAssetsFS().Open("/ignored.yml")
+	assert.Error(t, err)
+}
+
+func TestUseTemplateOverridesConfigMapKeys(t *testing.T) {
+	dir, err := ioutil.TempDir("", "templates")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// Mounted without items, the keys of the config map naming the templates
+	data := filepath.Join(dir, "..data")
+	require.NoError(t, os.MkdirAll(data, 0755))
+	templates := map[string]string{
+		"infrastructure_04-syndesis-server.yml.tmpl": overriddenConfigMap,
+		"addons_extra_01-extra_config.yml":           "- apiVersion: v1\n  kind: ConfigMap\n  metadata:\n    name: extra\n",
+		"ignored.yml":                                "{}",
+	}
+	for key, template := range templates {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(data, key), []byte(template), 0644))
+		require.NoError(t, os.Symlink(filepath.Join("..data", key), filepath.Join(dir, key)))
+	}
+
+	require.NoError(t, generator.UseTemplateOverrides(dir))
+	defer generator.UseTemplateOverrides("")
+
+	resources, err := generator.Render("./infrastructure/04-syndesis-server.yml.tmpl", struct{ Name string }{Name: "overridden"})
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	assert.Equal(t, "overridden", resources[0].GetName())
+
+	// The listings merge the compiled and overridden templates
+	f, err := generator.GetAssetsFS().Open("./infrastructure/")
+	require.NoError(t, err)
+	defer f.Close()
+	infos, err := f.Readdir(-1)
+	require.NoError(t, err)
+	names := []string{}
+	for _, info := range infos {
+		names = append(names, info.Name())
+	}
+	assert.Contains(t, names, "03-syndesis-ui.yml.tmpl")
+	assert.Equal(t, 1, countOf(names, "04-syndesis-server.yml.tmpl"))
+
+	// A new addon
+	resources, err = generator.RenderDir("./addons/extra/", nil)
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	assert.Equal(t, "extra", resources[0].GetName())
+
+	_, err = generator.GetAssetsFS().Open("/ignored.yml")
+	assert.Error(t, err)
+}
+
+func TestUseTemplateOverridesMissingDirectory(t *testing.T) {
+	require.NoError(t, generator.UseTemplateOverrides(filepath.Join(os.TempDir(), "no-such-templates")))
+
+	f, err := generator.GetAssetsFS().Open("./infrastructure/04-syndesis-server.yml.tmpl")
+	require.NoError(t, err)
+	f.Close()
+}
+
+func countOf(names []string, name string) int {
+	count := 0
+	for _, n := range names {
+		if n == name {
+			count++
+		}
+	}
+	return count
+}
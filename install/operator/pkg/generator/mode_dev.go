@@ -7,6 +7,6 @@ import (
 	"net/http"
 )
 
-func GetAssetsFS() http.FileSystem {
+func compiledAssetsFS() http.FileSystem {
 	return dev.GetAssetsFS()
 }
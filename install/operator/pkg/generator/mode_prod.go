@@ -6,6 +6,6 @@ import (
 	"net/http"
 )
 
-func GetAssetsFS() http.FileSystem {
+func compiledAssetsFS() http.FileSystem {
 	return assets
 }
@@ -39,7 +39,14 @@ type SyndesisOperatorAction interface {
 	Execute(ctx context.Context, syndesis *v1beta2.Syndesis) error
 }
 
-// NewOperatorActions gives the default set of actions operator will perform
+// Implemented by the actions running something in the background
+type stoppable interface {
+	stop()
+}
+
+// NewOperatorActions gives the default set of actions operator will perform.
+// The actions keep state about the syndesis resource they reconcile, so each
+// syndesis resource gets its own set.
 func NewOperatorActions(mgr manager.Manager, clientTools *clienttools.ClientTools) []SyndesisOperatorAction {
 	return []SyndesisOperatorAction{
 		newCheckUpdatesAction(mgr, clientTools),
@@ -53,6 +60,16 @@ func NewOperatorActions(mgr manager.Manager, clientTools *clienttools.ClientTool
 	}
 }
 
+// StopOperatorActions stops what the actions of a syndesis resource, which is
+// gone, still run in the background
+func StopOperatorActions(actions []SyndesisOperatorAction) {
+	for _, a := range actions {
+		if s, ok := a.(stoppable); ok {
+			s.stop()
+		}
+	}
+}
+
 func newBaseAction(mgr manager.Manager, clientTools *clienttools.ClientTools, typeS string) baseAction {
 	return baseAction{
 		actionLog.WithValues("type", typeS),
@@ -62,6 +79,16 @@ func newBaseAction(mgr manager.Manager, clientTools *clienttools.ClientTools, ty
 	}
 }
 
+// Publishes a normal event on the syndesis resource
+func (a baseAction) event(syndesis *v1beta2.Syndesis, reason string, messageFmt string, args ...interface{}) {
+	a.clientTools.EventRecorder().Eventf(syndesis, corev1.EventTypeNormal, reason, messageFmt, args...)
+}
+
+// Publishes a warning event on the syndesis resource
+func (a baseAction) warning(syndesis *v1beta2.Syndesis, reason string, messageFmt string, args ...interface{}) {
+	a.clientTools.EventRecorder().Eventf(syndesis, corev1.EventTypeWarning, reason, messageFmt, args...)
+}
+
 func syndesisPhaseIs(syndesis *v1beta2.Syndesis, statuses ...v1beta2.SyndesisPhase) bool {
 	if syndesis == nil {
 		return false
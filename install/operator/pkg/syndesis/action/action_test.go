@@ -0,0 +1,85 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package action
+
+import (
+	"context"
+	"testing"
+
+	cron "github.com/robfig/cron/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type stoppableTest struct {
+	SyndesisOperatorAction
+	stopped bool
+}
+
+func (s *stoppableTest) stop() { s.stopped = true }
+
+func Test_StopOperatorActions(t *testing.T) {
+	first, second := &stoppableTest{}, &stoppableTest{}
+	other := &stoppableTest{}
+
+	testCases := []struct {
+		name    string
+		actions []SyndesisOperatorAction
+		stopped []*stoppableTest
+	}{
+		{"No actions", nil, nil},
+		{"Only stoppable actions", []SyndesisOperatorAction{first, second}, []*stoppableTest{first, second}},
+		{"Mixed actions", []SyndesisOperatorAction{&installAction{}, other}, []*stoppableTest{other}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			StopOperatorActions(tc.actions)
+			for _, s := range tc.stopped {
+				assert.True(t, s.stopped)
+			}
+		})
+	}
+}
+
+func Test_backupActionPerResource(t *testing.T) {
+	newAction := func() *backupAction {
+		return &backupAction{baseAction{actionLog, &clienttools.ClientTools{}, nil, nil}, cron.New()}
+	}
+	newSyndesis := func(namespace string, schedule v1beta2.BackupSchedule) *v1beta2.Syndesis {
+		return &v1beta2.Syndesis{
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: namespace},
+			Spec:       v1beta2.SyndesisSpec{Backup: v1beta2.BackupConfig{Schedule: schedule}},
+		}
+	}
+
+	daily, hourly, unscheduled := newAction(), newAction(), newAction()
+	defer StopOperatorActions([]SyndesisOperatorAction{daily, hourly, unscheduled})
+
+	// Each syndesis resource gets its own scheduler, which would otherwise
+	// refuse a second backup job
+	require.NoError(t, daily.Execute(context.TODO(), newSyndesis("tenant-a", "daily")))
+	require.NoError(t, hourly.Execute(context.TODO(), newSyndesis("tenant-b", "hourly")))
+	require.NoError(t, unscheduled.Execute(context.TODO(), newSyndesis("tenant-c", "")))
+
+	assert.Len(t, daily.cron.Entries(), 1)
+	assert.Len(t, hourly.cron.Entries(), 1)
+	assert.Empty(t, unscheduled.cron.Entries())
+}
@@ -19,7 +19,9 @@ package action
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
@@ -27,18 +29,30 @@ import (
 	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/backup"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/events"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/maintenance"
+	"k8s.io/apimachinery/pkg/types"
 )
 
-var c = cron.New()
+// Local directory where backups are prepared before being uploaded
+const backupDir = "/tmp/foo"
+
+// Keeps apart the backups of syndesis resources in different namespaces
+func backupDirOf(syndesis *v1beta2.Syndesis) string {
+	return filepath.Join(backupDir, syndesis.Namespace)
+}
 
 // Manages syndesis backups
 type backupAction struct {
 	baseAction
+	// Scheduler of the backup job of the syndesis resource
+	cron *cron.Cron
 }
 
 func newBackupAction(mgr manager.Manager, clientTools *clienttools.ClientTools) SyndesisOperatorAction {
 	return &backupAction{
 		newBaseAction(mgr, clientTools, "backup"),
+		cron.New(),
 	}
 }
 
@@ -50,23 +64,16 @@ func (a *backupAction) CanExecute(syndesis *v1beta2.Syndesis) bool {
 
 // Schedule a cronjob for systematic backups
 func (a *backupAction) Execute(ctx context.Context, syndesis *v1beta2.Syndesis) error {
-	entries := c.Entries()
+	entries := a.cron.Entries()
 
 	if s := syndesis.Spec.Backup.Schedule; s != "" {
 		if len(entries) == 0 {
 			a.log.Info("scheduling backup job", "frequency", string(s))
-			c.AddFunc(strings.Join([]string{"@", string(s)}, ""), func() {
-				b, err := backup.NewBackup(ctx, a.clientTools, syndesis, "/tmp/foo")
-				if err != nil {
-					a.log.Error(err, "backup initialisation failed with error")
-					return
-				}
-
-				b.SetDelete(true)
-				b.Run()
+			a.cron.AddFunc(strings.Join([]string{"@", string(s)}, ""), func() {
+				a.runScheduledBackup(ctx, types.NamespacedName{Namespace: syndesis.Namespace, Name: syndesis.Name})
 			})
 
-			c.Start()
+			a.cron.Start()
 		} else if len(entries) == 1 {
 			syndesis.Status.Backup.Next = entries[0].Next.String()
 			syndesis.Status.Backup.Previous = entries[0].Prev.String()
@@ -74,19 +81,83 @@ func (a *backupAction) Execute(ctx context.Context, syndesis *v1beta2.Syndesis)
 			client, _ := a.clientTools.RuntimeClient()
 			return client.Status().Update(ctx, syndesis)
 		} else {
-			return fmt.Errorf("unsopported number of entries for cron instance, cron %v", c)
+			return fmt.Errorf("unsopported number of entries for cron instance, cron %v", a.cron)
 		}
 	} else {
 		if len(entries) == 1 {
 			e := entries[0]
 
 			a.log.Info("removing backup job from scheduler", "job", e.ID)
-			c.Remove(e.ID)
-			c.Stop()
+			a.cron.Remove(e.ID)
+			a.cron.Stop()
+
+			syndesis.RemoveBackupHealthy()
+			client, _ := a.clientTools.RuntimeClient()
+			return client.Status().Update(ctx, syndesis)
 		} else if len(entries) > 1 {
-			return fmt.Errorf("unsopported number of entries for cron instance, cron %v", c)
+			return fmt.Errorf("unsopported number of entries for cron instance, cron %v", a.cron)
 		}
 	}
 
 	return nil
 }
+
+// Stops scheduling backups of a syndesis resource which is gone
+func (a *backupAction) stop() {
+	a.cron.Stop()
+}
+
+// Runs a scheduled backup of the latest version of the syndesis resource, unless
+// its reconciliation is paused or it's outside of its maintenance windows
+func (a *backupAction) runScheduledBackup(ctx context.Context, name types.NamespacedName) {
+	client, _ := a.clientTools.RuntimeClient()
+	syndesis := &v1beta2.Syndesis{}
+	if err := client.Get(ctx, name, syndesis); err != nil {
+		a.log.Error(err, "unable to read syndesis resource for scheduled backup", "name", name.Name)
+		return
+	}
+
+	if syndesis.IsReconcilePaused() {
+		a.log.Info("scheduled backup skipped, reconciliation is paused", "name", syndesis.Name)
+		a.event(syndesis, events.ReasonBackupSkipped, "Scheduled backup skipped, reconciliation is paused")
+		return
+	}
+	if open, err := maintenance.InWindow(syndesis.Spec.MaintenanceWindows, time.Now()); err != nil || !open {
+		a.log.Info("scheduled backup skipped, outside of the maintenance windows", "name", syndesis.Name)
+		a.event(syndesis, events.ReasonBackupSkipped, "Scheduled backup skipped, outside of the maintenance windows")
+		return
+	}
+
+	b, err := backup.NewBackup(ctx, a.clientTools, syndesis, backupDirOf(syndesis))
+	if err != nil {
+		a.log.Error(err, "backup initialisation failed with error")
+		a.warning(syndesis, events.ReasonBackupFailed, "Scheduled backup failed: %v", err)
+		return
+	}
+
+	b.SetDelete(true)
+	a.event(syndesis, events.ReasonBackupStarted, "Scheduled backup started")
+	a.setBackupHealthy(ctx, syndesis, b.Run())
+}
+
+// Records the outcome of a scheduled backup in the BackupHealthy condition
+func (a *backupAction) setBackupHealthy(ctx context.Context, syndesis *v1beta2.Syndesis, backupErr error) {
+	if backupErr != nil {
+		a.log.Error(backupErr, "scheduled backup failed", "name", syndesis.Name)
+		a.warning(syndesis, events.ReasonBackupFailed, "Scheduled backup failed: %v", backupErr)
+	} else {
+		a.event(syndesis, events.ReasonBackupSucceeded, "Scheduled backup succeeded")
+	}
+
+	client, _ := a.clientTools.RuntimeClient()
+	target := &v1beta2.Syndesis{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: syndesis.Namespace, Name: syndesis.Name}, target); err != nil {
+		a.log.Error(err, "unable to read syndesis resource to record backup status", "name", syndesis.Name)
+		return
+	}
+
+	target.SetBackupHealthy(backupErr)
+	if err := client.Status().Update(ctx, target); err != nil {
+		a.log.Error(err, "unable to record backup status", "name", syndesis.Name)
+	}
+}
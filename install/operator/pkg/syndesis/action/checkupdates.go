@@ -8,6 +8,8 @@ import (
 
 	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/events"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/maintenance"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 )
 
@@ -38,9 +40,16 @@ func (a checkUpdatesAction) Execute(ctx context.Context, syndesis *v1beta2.Synde
 	if syndesis.Status.Version == a.operatorVersion {
 		// Everything fine
 		return nil
-	} else {
-		return a.setPhaseToUpgrading(ctx, syndesis)
 	}
+
+	if open, err := maintenance.InWindow(syndesis.Spec.MaintenanceWindows, time.Now()); err != nil || !open {
+		a.log.Info("Upgrade of Syndesis resource deferred until the next maintenance window", "name", syndesis.Name, "target version", a.operatorVersion)
+		if err == nil {
+			a.event(syndesis, events.ReasonUpgradeDeferred, "Upgrade to %s deferred until the next maintenance window", a.operatorVersion)
+		}
+		return err
+	}
+	return a.setPhaseToUpgrading(ctx, syndesis)
 }
 
 /*
@@ -50,16 +59,17 @@ func (a checkUpdatesAction) Execute(ctx context.Context, syndesis *v1beta2.Synde
  */
 func (a checkUpdatesAction) setPhaseToUpgrading(ctx context.Context, syndesis *v1beta2.Syndesis) (err error) {
 	target := syndesis.DeepCopy()
-	target.Status.Phase = v1beta2.SyndesisPhaseUpgrading
+	target.SetProgressing(v1beta2.SyndesisPhaseUpgrading, v1beta2.SyndesisStatusReasonMissing, "Upgrading from "+syndesis.Status.Version+" to "+a.operatorVersion)
 	target.Status.TargetVersion = a.operatorVersion
-	target.Status.Reason = v1beta2.SyndesisStatusReasonMissing
-	target.Status.Description = "Upgrading from " + syndesis.Status.Version + " to " + a.operatorVersion
 	target.Status.LastUpgradeFailure = nil
 	target.Status.UpgradeAttempts = 0
 	target.Status.ForceUpgrade = false
 
 	client, _ := a.clientTools.RuntimeClient()
 	err = client.Status().Update(ctx, target)
+	if err == nil {
+		a.event(syndesis, events.ReasonUpgradeStarted, "Upgrading from %s to %s", syndesis.Status.Version, a.operatorVersion)
+	}
 	time.Sleep(3 * time.Second)
 	return
 }
@@ -0,0 +1,157 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package action
+
+import (
+	"context"
+	"sort"
+
+	oappsv1 "github.com/openshift/api/apps/v1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	componentLabel      = "syndesis.io/component"
+	typeLabel           = "syndesis.io/type"
+	infrastructureValue = "infrastructure"
+)
+
+// A workload deployed by the operator for one of the syndesis components or addons
+type component struct {
+	name           string
+	infrastructure bool // Core infrastructure component, as opposed to an example or addon operator
+	failed         bool // The component will not become ready without intervention
+	status         v1beta2.ComponentStatus
+}
+
+// Lists the DeploymentConfigs and Deployments owned by the syndesis resource
+func listComponents(ctx context.Context, cl client.Client, syndesis *v1beta2.Syndesis) ([]component, error) {
+	selector, err := labels.Parse("owner=" + string(syndesis.GetUID()))
+	if err != nil {
+		return nil, err
+	}
+	options := client.ListOptions{
+		Namespace:     syndesis.Namespace,
+		LabelSelector: selector,
+	}
+
+	dcs := oappsv1.DeploymentConfigList{}
+	if err := cl.List(ctx, &dcs, &options); err != nil && !util.IsNoKindMatchError(err) {
+		// Only Deployments when there are no DeploymentConfigs on the cluster
+		return nil, err
+	}
+
+	deployments := appsv1.DeploymentList{}
+	if err := cl.List(ctx, &deployments, &options); err != nil {
+		return nil, err
+	}
+
+	components := make([]component, 0, len(dcs.Items)+len(deployments.Items))
+	for i := range dcs.Items {
+		components = append(components, deploymentConfigComponent(&dcs.Items[i]))
+	}
+	for i := range deployments.Items {
+		components = append(components, deploymentComponent(&deployments.Items[i]))
+	}
+
+	sort.Slice(components, func(i, j int) bool {
+		return components[i].name < components[j].name
+	})
+	return components, nil
+}
+
+func deploymentConfigComponent(dc *oappsv1.DeploymentConfig) component {
+	c := newComponent(dc.ObjectMeta, dc.Spec.Template)
+	c.status.DesiredReplicas = dc.Spec.Replicas
+	c.status.ReadyReplicas = dc.Status.ReadyReplicas
+	c.status.Ready = dc.Status.ObservedGeneration >= dc.Generation && replicasReady(c.status.DesiredReplicas, dc.Status.UpdatedReplicas, dc.Status.ReadyReplicas)
+
+	c.failed = dc.Spec.Replicas != dc.Status.Replicas && dc.Status.Replicas == 0 && !isProcessing(dc)
+	for _, condition := range dc.Status.Conditions {
+		if (condition.Type == oappsv1.DeploymentProgressing && condition.Status == corev1.ConditionFalse) ||
+			(condition.Type == oappsv1.DeploymentReplicaFailure && condition.Status == corev1.ConditionTrue) {
+			c.status.Message = condition.Message
+		}
+	}
+	return c
+}
+
+func deploymentComponent(d *appsv1.Deployment) component {
+	c := newComponent(d.ObjectMeta, &d.Spec.Template)
+	c.status.DesiredReplicas = 1
+	if d.Spec.Replicas != nil {
+		c.status.DesiredReplicas = *d.Spec.Replicas
+	}
+	c.status.ReadyReplicas = d.Status.ReadyReplicas
+	c.status.Ready = d.Status.ObservedGeneration >= d.Generation && replicasReady(c.status.DesiredReplicas, d.Status.UpdatedReplicas, d.Status.ReadyReplicas)
+
+	for _, condition := range d.Status.Conditions {
+		if condition.Type == appsv1.DeploymentProgressing && condition.Status == corev1.ConditionFalse {
+			c.failed = d.Status.ReadyReplicas == 0
+			c.status.Message = condition.Message
+		}
+		if condition.Type == appsv1.DeploymentReplicaFailure && condition.Status == corev1.ConditionTrue {
+			c.status.Message = condition.Message
+		}
+	}
+	return c
+}
+
+// Whether the desired replicas are all running the latest revision and ready.
+// The workloads are only ready once their controller has observed their
+// latest generation, as the replicas otherwise refer to the previous one.
+// While rolling out, the replicas of the previous revision may still be ready
+// alongside the surge ones.
+func replicasReady(desired int32, updated int32, ready int32) bool {
+	return updated >= desired && ready >= desired
+}
+
+func newComponent(meta metav1.ObjectMeta, template *corev1.PodTemplateSpec) component {
+	c := component{
+		name:           meta.Name,
+		infrastructure: meta.Labels[typeLabel] == infrastructureValue,
+	}
+	if name, ok := meta.Labels[componentLabel]; ok && name != "" {
+		c.name = name
+	}
+	if template != nil && len(template.Spec.Containers) > 0 {
+		c.status.Image = template.Spec.Containers[0].Image
+	}
+	return c
+}
+
+// Builds the status.components map, keeping the previous transition
+// time of the components whose readiness did not change
+func componentsStatus(components []component, previous map[string]v1beta2.ComponentStatus, now metav1.Time) map[string]v1beta2.ComponentStatus {
+	statuses := make(map[string]v1beta2.ComponentStatus, len(components))
+	for _, c := range components {
+		status := c.status
+		if old, ok := previous[c.name]; ok && old.Ready == status.Ready && old.LastTransitionTime != nil {
+			status.LastTransitionTime = old.LastTransitionTime
+		} else {
+			status.LastTransitionTime = &now
+		}
+		statuses[c.name] = status
+	}
+	return statuses
+}
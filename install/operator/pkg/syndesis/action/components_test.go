@@ -0,0 +1,188 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package action
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	oappsv1 "github.com/openshift/api/apps/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	rtfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func podTemplate(image string) *corev1.PodTemplateSpec {
+	return &corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "main", Image: image}},
+		},
+	}
+}
+
+func Test_listComponents(t *testing.T) {
+	syndesis := &v1beta2.Syndesis{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "syndesis", UID: "1234"}}
+
+	server := &oappsv1.DeploymentConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "syndesis-server",
+			Namespace: "syndesis",
+			Labels:    map[string]string{"owner": "1234", componentLabel: "syndesis-server", typeLabel: infrastructureValue},
+		},
+		Spec:   oappsv1.DeploymentConfigSpec{Replicas: 1, Template: podTemplate("syndesis-server:latest")},
+		Status: oappsv1.DeploymentConfigStatus{Replicas: 1, UpdatedReplicas: 1, ReadyReplicas: 1},
+	}
+	db := &oappsv1.DeploymentConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "syndesis-db",
+			Namespace: "syndesis",
+			Labels:    map[string]string{"owner": "1234", componentLabel: "syndesis-db", typeLabel: infrastructureValue},
+		},
+		Spec: oappsv1.DeploymentConfigSpec{Replicas: 1, Template: podTemplate("postgresql:12")},
+		Status: oappsv1.DeploymentConfigStatus{
+			Conditions: []oappsv1.DeploymentCondition{
+				{Type: oappsv1.DeploymentProgressing, Status: corev1.ConditionFalse, Message: "replication controller timed out"},
+			},
+		},
+	}
+	replicas := int32(1)
+	jaeger := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "jaeger-operator",
+			Namespace: "syndesis",
+			Labels:    map[string]string{"owner": "1234"},
+		},
+		Spec: appsv1.DeploymentSpec{Replicas: &replicas, Template: *podTemplate("jaeger-operator:1.13")},
+	}
+	other := &oappsv1.DeploymentConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "i-integration", Namespace: "syndesis"},
+		Spec:       oappsv1.DeploymentConfigSpec{Replicas: 1, Template: podTemplate("i-integration:0.1")},
+	}
+
+	s := scheme.Scheme
+	require.NoError(t, oappsv1.AddToScheme(s))
+	cl := rtfake.NewFakeClientWithScheme(s, server, db, jaeger, other)
+
+	components, err := listComponents(context.TODO(), cl, syndesis)
+	require.NoError(t, err)
+	require.Len(t, components, 3)
+
+	assert.Equal(t, "jaeger-operator", components[0].name)
+	assert.False(t, components[0].infrastructure)
+	assert.False(t, components[0].status.Ready)
+
+	assert.Equal(t, "syndesis-db", components[1].name)
+	assert.True(t, components[1].failed)
+	assert.Equal(t, "replication controller timed out", components[1].status.Message)
+
+	assert.Equal(t, "syndesis-server", components[2].name)
+	assert.True(t, components[2].status.Ready)
+	assert.Equal(t, "syndesis-server:latest", components[2].status.Image)
+}
+
+// A client of a cluster without DeploymentConfigs, as Kubernetes
+type noDeploymentConfigsClient struct {
+	client.Client
+}
+
+func (c noDeploymentConfigsClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	if _, ok := list.(*oappsv1.DeploymentConfigList); ok {
+		return &meta.NoKindMatchError{GroupKind: oappsv1.GroupVersion.WithKind("DeploymentConfig").GroupKind(), SearchedVersions: []string{"v1"}}
+	}
+	return c.Client.List(ctx, list, opts...)
+}
+
+func Test_listComponentsWithoutDeploymentConfigs(t *testing.T) {
+	syndesis := &v1beta2.Syndesis{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "syndesis", UID: "1234"}}
+	server := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "syndesis-server",
+			Namespace: "syndesis",
+			Labels:    map[string]string{"owner": "1234", componentLabel: "syndesis-server", typeLabel: infrastructureValue},
+		},
+		Spec: appsv1.DeploymentSpec{Template: *podTemplate("syndesis-server:latest")},
+	}
+
+	cl := noDeploymentConfigsClient{rtfake.NewFakeClientWithScheme(scheme.Scheme, server)}
+	components, err := listComponents(context.TODO(), cl, syndesis)
+	require.NoError(t, err)
+	require.Len(t, components, 1)
+	assert.Equal(t, "syndesis-server", components[0].name)
+}
+
+func Test_deploymentComponent(t *testing.T) {
+	replicas := int32(1)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "syndesis-server", Generation: 2},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas, Template: *podTemplate("syndesis-server:latest")},
+		Status:     appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 1, UpdatedReplicas: 1, ReadyReplicas: 1},
+	}
+	// The replicas refer to the previous generation until it's observed
+	assert.False(t, deploymentComponent(deployment).status.Ready)
+
+	deployment.Status.ObservedGeneration = 2
+	assert.True(t, deploymentComponent(deployment).status.Ready)
+
+	dc := &oappsv1.DeploymentConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "syndesis-meta", Generation: 3},
+		Spec:       oappsv1.DeploymentConfigSpec{Replicas: 1, Template: podTemplate("syndesis-meta:latest")},
+		Status:     oappsv1.DeploymentConfigStatus{ObservedGeneration: 2, Replicas: 1, UpdatedReplicas: 1, ReadyReplicas: 1},
+	}
+	assert.False(t, deploymentConfigComponent(dc).status.Ready)
+
+	dc.Status.ObservedGeneration = 3
+	assert.True(t, deploymentConfigComponent(dc).status.Ready)
+}
+
+func Test_replicasReady(t *testing.T) {
+	assert.True(t, replicasReady(1, 1, 1))
+	assert.True(t, replicasReady(0, 0, 0))
+	// Some replicas not ready yet
+	assert.False(t, replicasReady(3, 3, 2))
+	// Rolling out, the replicas of the previous revision still being ready
+	assert.False(t, replicasReady(3, 1, 3))
+	// Surge replica ready before the previous revision is scaled down
+	assert.True(t, replicasReady(3, 3, 4))
+}
+
+func Test_componentsStatus(t *testing.T) {
+	before := metav1.NewTime(time.Now().Add(-time.Hour))
+	now := metav1.Now()
+
+	previous := map[string]v1beta2.ComponentStatus{
+		"syndesis-server": {Ready: true, LastTransitionTime: &before},
+		"syndesis-meta":   {Ready: true, LastTransitionTime: &before},
+	}
+	components := []component{
+		{name: "syndesis-server", status: v1beta2.ComponentStatus{Ready: true}},
+		{name: "syndesis-meta", status: v1beta2.ComponentStatus{Ready: false}},
+		{name: "syndesis-ui", status: v1beta2.ComponentStatus{Ready: true}},
+	}
+
+	statuses := componentsStatus(components, previous, now)
+	assert.Equal(t, &before, statuses["syndesis-server"].LastTransitionTime)
+	assert.Equal(t, &now, statuses["syndesis-meta"].LastTransitionTime)
+	assert.Equal(t, &now, statuses["syndesis-ui"].LastTransitionTime)
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package action
+
+import (
+	"context"
+
+	oappsv1 "github.com/openshift/api/apps/v1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/events"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Moves the components from their DeploymentConfigs to Deployments. The
+// DeploymentConfigs are scaled down first, for the database volume to be
+// released before its Deployment mounts it, and then deleted. The persistent
+// volume claims are not owned by the DeploymentConfigs and are kept. Returns
+// false while the pods of the DeploymentConfigs are still running.
+func (a *installAction) migrateDeploymentConfigs(ctx context.Context, cl client.Client, syndesis *v1beta2.Syndesis) (bool, error) {
+	// Integrations are deployed by the server and migrated by it
+	selector, err := labels.Parse("app=syndesis,syndesis.io/type!=integration")
+	if err != nil {
+		return false, err
+	}
+
+	dcs := oappsv1.DeploymentConfigList{}
+	if err := cl.List(ctx, &dcs, &client.ListOptions{Namespace: syndesis.Namespace, LabelSelector: selector}); err != nil {
+		if util.IsNoKindMatchError(err) {
+			// No DeploymentConfigs on the cluster
+			return true, nil
+		}
+		return false, err
+	}
+
+	migrated := true
+	for i := range dcs.Items {
+		dc := &dcs.Items[i]
+		if !metav1.IsControlledBy(dc, syndesis) {
+			continue
+		}
+
+		if dc.Spec.Replicas != 0 {
+			a.log.Info("scaling down deployment config to migrate it to a deployment", "name", dc.Name)
+			if err := cl.Patch(ctx, dc, client.RawPatch(types.MergePatchType, []byte(`{"spec":{"replicas":0}}`))); err != nil {
+				return false, err
+			}
+			migrated = false
+			continue
+		}
+
+		if dc.Status.Replicas != 0 {
+			a.log.Info("waiting for deployment config to scale down before migrating it to a deployment", "name", dc.Name, "replicas", dc.Status.Replicas)
+			migrated = false
+			continue
+		}
+
+		// DeploymentConfigs orphan their replication controllers by default
+		if err := cl.Delete(ctx, dc, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !k8serrors.IsNotFound(err) {
+			return false, err
+		}
+		a.log.Info("deployment config migrated to a deployment", "name", dc.Name)
+		a.event(syndesis, events.ReasonResourceDeleted, "Deleted DeploymentConfig %s, replaced by a Deployment", dc.Name)
+	}
+
+	return migrated, nil
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package action
+
+import (
+	"context"
+	"testing"
+
+	oappsv1 "github.com/openshift/api/apps/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	rtfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_migrateDeploymentConfigs(t *testing.T) {
+	s := scheme.Scheme
+	require.NoError(t, apis.AddToScheme(s))
+	require.NoError(t, oappsv1.Install(s))
+
+	syndesis := &v1beta2.Syndesis{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "syndesis", UID: "1234"}}
+	controller := true
+	ownerRefs := []metav1.OwnerReference{{Name: "app", UID: "1234", Controller: &controller}}
+
+	db := &oappsv1.DeploymentConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "syndesis-db", Namespace: "syndesis", Labels: map[string]string{"app": "syndesis"}, OwnerReferences: ownerRefs},
+		Spec:       oappsv1.DeploymentConfigSpec{Replicas: 1},
+		Status:     oappsv1.DeploymentConfigStatus{Replicas: 1},
+	}
+	integration := &oappsv1.DeploymentConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "i-integration", Namespace: "syndesis", Labels: map[string]string{"app": "syndesis", "syndesis.io/type": "integration"}},
+		Spec:       oappsv1.DeploymentConfigSpec{Replicas: 1},
+	}
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "syndesis-db", Namespace: "syndesis", Labels: map[string]string{"app": "syndesis"}, OwnerReferences: ownerRefs},
+	}
+
+	cl := rtfake.NewFakeClientWithScheme(s, syndesis, db, integration, pvc)
+	clientTools := &clienttools.ClientTools{}
+	clientTools.SetRuntimeClient(cl)
+	recorder := record.NewFakeRecorder(10)
+	clientTools.SetEventRecorder(recorder)
+	a := &installAction{baseAction: baseAction{log: actionLog, clientTools: clientTools}}
+
+	// Scaled down first
+	migrated, err := a.migrateDeploymentConfigs(context.TODO(), cl, syndesis)
+	require.NoError(t, err)
+	assert.False(t, migrated)
+	require.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Namespace: "syndesis", Name: "syndesis-db"}, db))
+	assert.EqualValues(t, 0, db.Spec.Replicas)
+
+	// Waiting for the pods to stop
+	migrated, err = a.migrateDeploymentConfigs(context.TODO(), cl, syndesis)
+	require.NoError(t, err)
+	assert.False(t, migrated)
+
+	// Deleted once stopped, keeping the volume
+	db.Status.Replicas = 0
+	require.NoError(t, cl.Update(context.TODO(), db))
+	migrated, err = a.migrateDeploymentConfigs(context.TODO(), cl, syndesis)
+	require.NoError(t, err)
+	assert.True(t, migrated)
+	assert.Contains(t, <-recorder.Events, "ResourceDeleted")
+
+	err = cl.Get(context.TODO(), types.NamespacedName{Namespace: "syndesis", Name: "syndesis-db"}, &oappsv1.DeploymentConfig{})
+	assert.True(t, k8serrors.IsNotFound(err))
+	assert.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Namespace: "syndesis", Name: "syndesis-db"}, &corev1.PersistentVolumeClaim{}))
+	assert.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Namespace: "syndesis", Name: "i-integration"}, &oappsv1.DeploymentConfig{}))
+}
@@ -0,0 +1,227 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package action
+
+import (
+	"context"
+	"sort"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	"github.com/syndesisio/syndesis/install/operator/pkg/openshift/serviceaccount"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/events"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	customMetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Number of resources differing from their rendered configuration, reported
+// for the syndesis resources in drift detection
+var driftedResources = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "syndesis_drifted_resources",
+		Help: "Number of resources differing from their rendered configuration, for the Syndesis resources in drift detection",
+	},
+	[]string{"namespace", "name"},
+)
+
+func init() {
+	customMetrics.Registry.MustRegister(driftedResources)
+}
+
+// ResourceDrift is the difference between a resource on the cluster and
+// its rendered configuration
+type ResourceDrift struct {
+	Kind string
+	Name string
+	// Unified diff from the live resource to the one that would be applied,
+	// from an empty document when the resource is missing
+	Diff string
+}
+
+func (d ResourceDrift) String() string {
+	return d.Kind + "/" + d.Name
+}
+
+// DetectDrift compares the resources rendered for the syndesis resource with
+// those on the cluster, and returns the ones that differ, including those
+// that would be pruned. Nothing is changed on the cluster, the applies being
+// dry runs.
+func DetectDrift(ctx context.Context, clientTools *clienttools.ClientTools, syndesis *v1beta2.Syndesis) ([]ResourceDrift, error) {
+	rtClient, err := clientTools.RuntimeClient()
+	if err != nil {
+		return nil, err
+	}
+
+	config, secret, err := loadConfiguration(ctx, clientTools, syndesis)
+	if err != nil {
+		return nil, err
+	}
+
+	drifts := []ResourceDrift{}
+	rendered := inventory{}
+	detect := func(o client.Object) error {
+		rendered.add(o)
+		diff, err := util.Diff(ctx, rtClient, o)
+		if err != nil {
+			if util.IsNoKindMatchError(err) {
+				// Optional custom resource definition not installed
+				return nil
+			}
+			return err
+		}
+		if diff != "" {
+			drifts = append(drifts, ResourceDrift{
+				Kind: o.GetObjectKind().GroupVersionKind().Kind,
+				Name: o.GetName(),
+				Diff: diff,
+			})
+		}
+		return nil
+	}
+
+	sa := renderServiceAccount(syndesis, secret)
+	if err := detect(sa); err != nil {
+		return nil, err
+	}
+	if !config.Kubernetes {
+		token, err := serviceaccount.GetServiceAccountToken(ctx, rtClient, sa.Name, syndesis.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		config.OpenShiftOauthClientSecret = token
+	}
+
+	overrides := newResourceOverrides(clientTools.GetScheme(), syndesis)
+	route, err := renderSyndesisRoute(clientTools.GetScheme(), syndesis, config, overrides)
+	if err != nil {
+		return nil, err
+	}
+	if err := detect(route); err != nil {
+		return nil, err
+	}
+	if err := config.SetRoute(ctx, rtClient, syndesis); err != nil {
+		return nil, err
+	}
+
+	resources, err := renderResources(ctx, rtClient, syndesis, config, overrides)
+	if err != nil {
+		return nil, err
+	}
+	all := resources.components
+	for _, addon := range resources.addons {
+		if addon.err != nil {
+			return nil, addon.err
+		}
+		all = append(all, addon.resources...)
+	}
+	for i := range all {
+		if err := detect(&all[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	// The resources no longer rendered are pruned
+	previous, err := readInventory(ctx, rtClient, syndesis)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range previous.entries() {
+		if rendered.contains(e) {
+			continue
+		}
+		res := e.object()
+		if err := rtClient.Get(ctx, client.ObjectKey{Namespace: e.Namespace, Name: e.Name}, res); err != nil {
+			if k8serrors.IsNotFound(err) || util.IsNoKindMatchError(err) {
+				continue
+			}
+			return nil, err
+		}
+		if len(res.GetOwnerReferences()) == 0 || res.GetOwnerReferences()[0].UID != syndesis.GetUID() {
+			continue
+		}
+		diff, err := util.DeletionDiff(res)
+		if err != nil {
+			return nil, err
+		}
+		drifts = append(drifts, ResourceDrift{Kind: e.Kind, Name: e.Name, Diff: diff})
+	}
+
+	sort.Slice(drifts, func(i, j int) bool {
+		return drifts[i].String() < drifts[j].String()
+	})
+	return drifts, nil
+}
+
+// Reports in the Drifted condition and metric the resources differing from
+// their rendered configuration, leaving them unchanged
+func (a *installAction) reportDrift(ctx context.Context, syndesis *v1beta2.Syndesis) error {
+	drifts, err := DetectDrift(ctx, a.clientTools, syndesis)
+	if err != nil {
+		return err
+	}
+
+	name := types.NamespacedName{Namespace: syndesis.Namespace, Name: syndesis.Name}
+	driftedResources.WithLabelValues(name.Namespace, name.Name).Set(float64(len(drifts)))
+	a.driftReported = &name
+
+	resources := make([]string, 0, len(drifts))
+	for _, d := range drifts {
+		resources = append(resources, d.String())
+	}
+
+	target := syndesis.DeepCopy()
+	target.SetDrifted(resources)
+	previous := meta.FindStatusCondition(syndesis.Status.Conditions, v1beta2.SyndesisConditionDrifted)
+	current := meta.FindStatusCondition(target.Status.Conditions, v1beta2.SyndesisConditionDrifted)
+	if previous != nil && previous.Status == current.Status && previous.Message == current.Message {
+		return nil
+	}
+
+	for _, d := range drifts {
+		a.log.Info("resource drifted from its rendered configuration", "kind", d.Kind, "name", d.Name, "namespace", syndesis.Namespace)
+		a.warning(syndesis, events.ReasonResourceDrifted, "%s %s differs from its rendered configuration", d.Kind, d.Name)
+	}
+
+	rtClient, _ := a.clientTools.RuntimeClient()
+	return rtClient.Status().Update(ctx, target)
+}
+
+// Removes the Drifted condition and metric once drift detection is turned off
+func (a *installAction) stopReportingDrift(ctx context.Context, syndesis *v1beta2.Syndesis) error {
+	a.stop()
+
+	if meta.FindStatusCondition(syndesis.Status.Conditions, v1beta2.SyndesisConditionDrifted) == nil {
+		return nil
+	}
+
+	syndesis.RemoveDrifted()
+	rtClient, _ := a.clientTools.RuntimeClient()
+	return rtClient.Status().Update(ctx, syndesis)
+}
+
+// Stops reporting the metric of a syndesis resource which is gone
+func (a *installAction) stop() {
+	if a.driftReported != nil {
+		driftedResources.DeleteLabelValues(a.driftReported.Namespace, a.driftReported.Name)
+		a.driftReported = nil
+	}
+}
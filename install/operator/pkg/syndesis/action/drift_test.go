@@ -0,0 +1,185 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package action
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/events"
+	syntesting "github.com/syndesisio/syndesis/install/operator/pkg/syndesis/testing"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+)
+
+// Client tools of a Kubernetes cluster, where the syndesis resource is exposed
+// by an ingress and needs no service account token
+func driftClientTools(t *testing.T, syndesis *v1beta2.Syndesis) *clienttools.ClientTools {
+	os.Setenv("KUBERNETES", "true")
+	t.Cleanup(func() { os.Unsetenv("KUBERNETES") })
+	configuration.TemplateConfig = "../../../build/conf/config-test.yaml"
+
+	clientTools := syntesting.FakeClientTools()
+	require.NoError(t, apis.AddToScheme(clientTools.GetScheme()))
+	cl, _ := clientTools.RuntimeClient()
+	require.NoError(t, cl.Create(context.TODO(), syndesis))
+	return clientTools
+}
+
+func newDriftSyndesis() *v1beta2.Syndesis {
+	syndesis := &v1beta2.Syndesis{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "syndesis", UID: "1234"}}
+	syndesis.Spec.RouteHostname = "syndesis.example.com"
+	return syndesis
+}
+
+func driftedNames(drifts []ResourceDrift) []string {
+	names := []string{}
+	for _, d := range drifts {
+		names = append(names, d.String())
+	}
+	return names
+}
+
+func Test_DetectDrift(t *testing.T) {
+	syndesis := newDriftSyndesis()
+	syndesis.Spec.Overrides = []v1beta2.ResourceOverride{{
+		APIVersion: "v1",
+		Kind:       "Service",
+		Name:       "syndesis-ui",
+		Patch:      "metadata:\n  annotations:\n    example.com/overridden: \"true\"",
+	}}
+	clientTools := driftClientTools(t, syndesis)
+	cl, _ := clientTools.RuntimeClient()
+	ctx := context.TODO()
+
+	// Nothing installed yet, the components and addons are all missing
+	drifts, err := DetectDrift(ctx, clientTools, syndesis)
+	require.NoError(t, err)
+	names := driftedNames(drifts)
+	assert.Contains(t, names, "ServiceAccount/syndesis-oauth-client")
+	assert.Contains(t, names, "Ingress/syndesis")
+	assert.Contains(t, names, "Deployment/syndesis-server")
+	assert.Contains(t, names, "Service/syndesis-public-oauthproxy")
+	assert.IsIncreasing(t, names)
+
+	// The services installed the way the install action does are as rendered
+	config, _, err := loadConfiguration(ctx, clientTools, syndesis)
+	require.NoError(t, err)
+	rendered, err := renderResources(ctx, cl, syndesis, config, newResourceOverrides(clientTools.GetScheme(), syndesis))
+	require.NoError(t, err)
+	for i := range rendered.components {
+		if rendered.components[i].GetKind() == "Service" {
+			_, _, _, err := util.Apply(ctx, cl, &rendered.components[i])
+			require.NoError(t, err)
+		}
+	}
+
+	drifts, err = DetectDrift(ctx, clientTools, syndesis)
+	require.NoError(t, err)
+	names = driftedNames(drifts)
+	assert.NotContains(t, names, "Service/syndesis-server")
+	assert.NotContains(t, names, "Service/syndesis-ui")
+	assert.Contains(t, names, "Deployment/syndesis-server")
+
+	// Changing a live resource, and a resource of the inventory no longer rendered
+	server := &corev1.Service{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "syndesis", Name: "syndesis-server"}, server))
+	server.Labels["example.com/changed"] = "true"
+	require.NoError(t, cl.Update(ctx, server))
+
+	old := &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Name: "syndesis-old", Namespace: "syndesis", OwnerReferences: []metav1.OwnerReference{{Name: "app", UID: "1234"}}},
+	}
+	require.NoError(t, cl.Create(ctx, old))
+	previous := inventory{}
+	previous.add(old)
+	require.NoError(t, writeInventory(ctx, cl, syndesis, previous))
+
+	drifts, err = DetectDrift(ctx, clientTools, syndesis)
+	require.NoError(t, err)
+	found := map[string]string{}
+	for _, d := range drifts {
+		found[d.String()] = d.Diff
+	}
+	require.Contains(t, found, "Service/syndesis-server")
+	assert.Contains(t, found["Service/syndesis-server"], "-    example.com/changed: \"true\"")
+	require.Contains(t, found, "ConfigMap/syndesis-old")
+	assert.Contains(t, found["ConfigMap/syndesis-old"], "-kind: ConfigMap")
+
+	// The overrides are part of the rendered configuration
+	syndesis.Spec.Overrides = append(syndesis.Spec.Overrides, v1beta2.ResourceOverride{
+		APIVersion: "v1",
+		Kind:       "Service",
+		Name:       "syndesis-server",
+		Patch:      "metadata:\n  labels:\n    example.com/changed: \"true\"",
+	})
+	drifts, err = DetectDrift(ctx, clientTools, syndesis)
+	require.NoError(t, err)
+	assert.NotContains(t, driftedNames(drifts), "Service/syndesis-server")
+}
+
+func Test_reportDrift(t *testing.T) {
+	syndesis := newDriftSyndesis()
+	clientTools := driftClientTools(t, syndesis)
+	cl, _ := clientTools.RuntimeClient()
+	recorder := record.NewFakeRecorder(100)
+	clientTools.SetEventRecorder(recorder)
+	a := &installAction{baseAction: baseAction{log: actionLog, clientTools: clientTools}}
+	ctx := context.TODO()
+
+	drifts, err := DetectDrift(ctx, clientTools, syndesis)
+	require.NoError(t, err)
+	require.NotEmpty(t, drifts)
+
+	require.NoError(t, a.reportDrift(ctx, syndesis))
+	assert.Equal(t, float64(len(drifts)), testutil.ToFloat64(driftedResources.WithLabelValues("syndesis", "app")))
+	require.Len(t, recorder.Events, len(drifts))
+	assert.Contains(t, <-recorder.Events, events.ReasonResourceDrifted)
+
+	reported := &v1beta2.Syndesis{}
+	require.NoError(t, cl.Get(ctx, types.NamespacedName{Namespace: "syndesis", Name: "app"}, reported))
+	drifted := meta.FindStatusCondition(reported.Status.Conditions, v1beta2.SyndesisConditionDrifted)
+	require.NotNil(t, drifted)
+	assert.Equal(t, metav1.ConditionTrue, drifted.Status)
+	assert.Contains(t, drifted.Message, "Deployment/syndesis-server")
+
+	// Reported once while the resources are unchanged
+	for len(recorder.Events) > 0 {
+		<-recorder.Events
+	}
+	require.NoError(t, a.reportDrift(ctx, reported))
+	assert.Len(t, recorder.Events, 0)
+
+	// Turning drift detection off removes the condition and the metric
+	require.NoError(t, a.stopReportingDrift(ctx, reported))
+	assert.Nil(t, meta.FindStatusCondition(reported.Status.Conditions, v1beta2.SyndesisConditionDrifted))
+	assert.Nil(t, a.driftReported)
+	assert.Equal(t, 0, testutil.CollectAndCount(driftedResources))
+}
@@ -40,15 +40,11 @@ func (a *initializeAction) Execute(ctx context.Context, syndesis *v1beta2.Syndes
 
 	if len(list.Items) > 1 && syndesis.Status.Phase != v1beta2.SyndesisPhaseInstalled {
 		// We want one instance per namespace at most
-		target.Status.Phase = v1beta2.SyndesisPhaseNotInstalled
-		target.Status.Reason = v1beta2.SyndesisStatusReasonDuplicate
-		target.Status.Description = "Cannot install two Syndesis resources in the same namespace"
+		target.SetDegraded(v1beta2.SyndesisStatusReasonDuplicate, "Cannot install two Syndesis resources in the same namespace")
 		a.log.Error(nil, "Cannot initialize Syndesis resource because its a duplicate", "name", syndesis.Name)
 	} else {
 		syndesisVersion := pkg.DefaultOperatorTag
-		target.Status.Phase = v1beta2.SyndesisPhaseInstalling
-		target.Status.Reason = v1beta2.SyndesisStatusReasonMissing
-		target.Status.Description = ""
+		target.SetProgressing(v1beta2.SyndesisPhaseInstalling, v1beta2.SyndesisStatusReasonMissing, "")
 		target.Status.Version = syndesisVersion
 		a.log.Info("Syndesis resource initialized", "name", syndesis.Name, "version", syndesisVersion)
 	}
@@ -3,17 +3,20 @@ package action
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/syndesisio/syndesis/install/operator/pkg"
-	"github.com/syndesisio/syndesis/install/operator/pkg/generator"
 	"github.com/syndesisio/syndesis/install/operator/pkg/openshift/serviceaccount"
 	"github.com/syndesisio/syndesis/install/operator/pkg/util"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -27,6 +30,7 @@ import (
 	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/events"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/olm"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/operation"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -40,31 +44,60 @@ const (
 // Install syndesis into the namespace, taking resources from the bundled template.
 type installAction struct {
 	baseAction
+	// Optional kinds already reported as not installed in the cluster
+	kindsReportedNotAvailable map[schema.GroupVersionKind]time.Time
+	// Syndesis resource whose drift is reported by the metric, if any
+	driftReported *types.NamespacedName
+	// Resources whose fields set by other actors were taken over by the current run
+	conflicts []string
+	// Resources which would be deleted by the current dry run of the pruning
+	pruneDryRun []*unstructured.Unstructured
+	// Resources last reported by the dry runs of the pruning
+	pruneDryRunReported string
 }
 
 func newInstallAction(mgr manager.Manager, clientTools *clienttools.ClientTools) SyndesisOperatorAction {
 	return &installAction{
 		newBaseAction(mgr, clientTools, "install"),
+		map[schema.GroupVersionKind]time.Time{},
+		nil,
+		nil,
+		nil,
+		"",
 	}
 }
 
 func (a *installAction) installResource(ctx context.Context, rtClient client.Client, syndesis *v1beta2.Syndesis, res unstructured.Unstructured) (*unstructured.Unstructured, error) {
 	operation.SetNamespaceAndOwnerReference(res, syndesis)
-	o, modificationType, err := util.CreateOrUpdate(ctx, rtClient, &res)
+	o, modificationType, conflicts, err := util.Apply(ctx, rtClient, &res)
+	if len(conflicts) > 0 {
+		// The rendered configuration wins over the changes made by someone else
+		managers := strings.Join(conflicts, ", ")
+		a.log.Info("fields set by other actors taken over", "kind", res.GetKind(), "name", res.GetName(), "namespace", res.GetNamespace(), "managers", managers)
+		a.warning(syndesis, events.ReasonResourceConflict, "Fields of %s %s set by %s were taken over", res.GetKind(), res.GetName(), managers)
+		a.conflicts = append(a.conflicts, fmt.Sprintf("%s %s (%s)", res.GetKind(), res.GetName(), managers))
+	}
 	if err != nil {
 		if util.IsNoKindMatchError(err) {
 			gvk := res.GroupVersionKind()
-			if _, found := kindsReportedNotAvailable[gvk]; !found {
-				kindsReportedNotAvailable[gvk] = time.Now()
+			if _, found := a.kindsReportedNotAvailable[gvk]; !found {
+				a.kindsReportedNotAvailable[gvk] = time.Now()
 				a.log.Info("optional custom resource definition is not installed.", "group", gvk.Group, "version", gvk.Version, "kind", gvk.Kind)
 			}
 		} else {
 			a.log.Info("failed to create or replace resource", "kind", res.GetKind(), "name", res.GetName(), "namespace", res.GetNamespace())
+			a.warning(syndesis, events.ReasonResourceFailed, "Failed to install %s %s: %v", res.GetKind(), res.GetName(), err)
 			return nil, err
 		}
 	} else {
 		if modificationType != controllerutil.OperationResultNone {
 			a.log.Info("resource "+string(modificationType), "kind", res.GetKind(), "name", res.GetName(), "namespace", res.GetNamespace())
+			switch modificationType {
+			case controllerutil.OperationResultCreated:
+				a.event(syndesis, events.ReasonResourceCreated, "Created %s %s", res.GetKind(), res.GetName())
+			default:
+				a.event(syndesis, events.ReasonResourceUpdated, "Updated %s %s", res.GetKind(), res.GetName())
+			}
 		}
 	}
 
@@ -94,8 +127,6 @@ func (a *installAction) CanExecute(syndesis *v1beta2.Syndesis) bool {
 	)
 }
 
-var kindsReportedNotAvailable = map[schema.GroupVersionKind]time.Time{}
-
 func (a *installAction) Execute(ctx context.Context, syndesis *v1beta2.Syndesis) error {
 	if syndesisPhaseIs(syndesis, v1beta2.SyndesisPhaseInstalling) {
 		a.log.Info("installing Syndesis resource", "name", syndesis.Name)
@@ -103,54 +134,67 @@ func (a *installAction) Execute(ctx context.Context, syndesis *v1beta2.Syndesis)
 		a.log.Info("installing Syndesis resource for the first time after upgrading", "name", syndesis.Name)
 	}
 
-	resourcesThatShouldExist := map[types.UID]bool{}
+	if syndesisPhaseIs(syndesis, v1beta2.SyndesisPhaseInstalled) && syndesis.IsDriftDetection() {
+		// Only reporting the changes made to the resources, without reverting them
+		return a.reportDrift(ctx, syndesis)
+	}
+	if err := a.stopReportingDrift(ctx, syndesis); err != nil {
+		return err
+	}
+
+	applied := inventory{}
+	a.conflicts = nil
 
 	rtClient, _ := a.clientTools.RuntimeClient()
-	// Load configuration to to use as context for generate pkg
-	config, err := configuration.GetProperties(ctx, configuration.TemplateConfig, a.clientTools, syndesis)
+	config, secret, err := loadConfiguration(ctx, a.clientTools, syndesis)
 	if err != nil {
 		a.log.Error(err, "Error occurred while initialising configuration")
 		return err
 	}
 
-	// Check if an image secret exists, to be used to connect to registries that require authentication
-	secret := &corev1.Secret{}
-	err = rtClient.Get(ctx, types.NamespacedName{Namespace: syndesis.Namespace, Name: SyndesisPullSecret}, secret)
-	if err != nil {
-		if k8serrors.IsNotFound(err) {
-			secret = nil
-		} else {
+	// Pods rejected by the pod security standard of the namespace are reported
+	podSecurity := a.enforcedPodSecurity(ctx, syndesis)
+
+	if config.Deployments {
+		if migrated, err := a.migrateDeploymentConfigs(ctx, rtClient, syndesis); err != nil {
 			return err
+		} else if !migrated {
+			// Installing once the DeploymentConfigs have released their volumes
+			return nil
 		}
 	}
 
-	if secret != nil {
-		config.ImagePullSecrets = append(config.ImagePullSecrets, secret.Name)
-	}
-
-	serviceAccount, err := installServiceAccount(ctx, rtClient, syndesis, secret)
+	serviceAccount, err := installServiceAccount(ctx, rtClient, renderServiceAccount(syndesis, secret))
 	if err != nil {
 		return err
 	}
-	resourcesThatShouldExist[serviceAccount.GetUID()] = true
+	applied.add(serviceAccount)
 
-	token, err := serviceaccount.GetServiceAccountToken(ctx, rtClient, serviceAccount.Name, syndesis.Namespace)
-	if err != nil {
-		a.log.Info("Unable to get service account token", "error message", err.Error())
-		return nil
+	// The service account is the OAuth client of the OpenShift proxy
+	if !config.Kubernetes {
+		token, err := serviceaccount.GetServiceAccountToken(ctx, rtClient, serviceAccount.Name, syndesis.Namespace)
+		if err != nil {
+			a.log.Info("Unable to get service account token", "error message", err.Error())
+			return nil
+		}
+		config.OpenShiftOauthClientSecret = token
 	}
-	config.OpenShiftOauthClientSecret = token
+
+	// Patches of the resources set on the syndesis resource
+	overrides := newResourceOverrides(a.clientTools.GetScheme(), syndesis)
 
 	// Render the route resource...
-	all, err := generator.RenderDir("./route/", config)
+	route, err := renderSyndesisRoute(a.scheme, syndesis, config, overrides)
 	if err != nil {
 		return err
 	}
 
-	routes, _ := util.SeperateStructuredAndUnstructured(a.scheme, all)
-	syndesisRoute, err := installSyndesisRoute(ctx, rtClient, syndesis, routes)
+	syndesisRoute, err := installSyndesisRoute(ctx, rtClient, route)
 	if err != nil {
 		a.log.Info("Unable to set route syndesis", "error message", err.Error())
+		if config.Kubernetes && config.Syndesis.RouteHostname == "" {
+			a.warning(syndesis, events.ReasonResourceFailed, "The route hostname must be set for the ingress exposing Syndesis on Kubernetes")
+		}
 		return nil
 	}
 
@@ -158,27 +202,18 @@ func (a *installAction) Execute(ctx context.Context, syndesis *v1beta2.Syndesis)
 		return err
 	}
 
-	resourcesThatShouldExist[syndesisRoute.GetUID()] = true
+	applied.add(syndesisRoute)
 
 	// Render the remaining syndesis resources...
-	all, err = generator.RenderDir("./infrastructure/", config)
+	rendered, err := renderResources(ctx, rtClient, syndesis, config, overrides)
 	if err != nil {
-		return err
+		return err // Fail-fast for core components
 	}
 
 	a.logResourcePersistentVolume(syndesis, "syndesis-meta", config.Syndesis.Components.Meta.Resources)
 	a.logResourcePersistentVolume(syndesis, "syndesis-prometheus", config.Syndesis.Components.Prometheus.Resources)
-
-	// Render the database resource if needed...
 	if syndesis.Spec.Components.Database.ExternalDbURL == "" {
-		dbResources, err := generator.RenderDir("./database/", config)
-		if err != nil {
-			return err
-		}
-
 		a.logResourcePersistentVolume(syndesis, "syndesis-db", config.Syndesis.Components.Database.Resources)
-
-		all = append(all, dbResources...)
 	}
 
 	// Link the image secret to service accounts
@@ -190,25 +225,20 @@ func (a *installAction) Execute(ctx context.Context, syndesis *v1beta2.Syndesis)
 	}
 
 	// Install the resources..
-	for _, res := range all {
-		err = PreProcessForAffinityTolerations(ctx, rtClient, syndesis, &res)
-		if err != nil {
-			return err // Fail-fast for core components
-		}
-
+	for _, res := range rendered.components {
 		o, err := a.installResource(ctx, rtClient, syndesis, res)
 		if err != nil {
 			return err // Fail-fast for core components
 		}
-		resourcesThatShouldExist[o.GetUID()] = true
-	}
-
-	addonsInfo := configuration.GetAddonsInfo(*config)
-	for _, addonInfo := range addonsInfo {
-		if !addonInfo.IsEnabled() {
-			continue
+		if o.GetUID() != "" {
+			applied.add(o)
 		}
+	}
 
+	// Resources left out by a failure, which are not to be pruned
+	incomplete := false
+	for _, addon := range rendered.addons {
+		addonInfo := addon.info
 		a.log.V(pkg.DEBUG_LOGGING_LVL).Info("Installing addon", "Name", addonInfo.Name())
 
 		if config.ApiServer.OlmSupport && addonInfo.GetOlmSpec() != nil && addonInfo.GetOlmSpec().Package != "" {
@@ -220,24 +250,18 @@ func (a *installAction) Execute(ctx context.Context, syndesis *v1beta2.Syndesis)
 			// should be delegated to the OLM & only if that's not possible should it
 			// be installed from syndesis' own resources.
 			//
-			err := olm.SubscribeOperator(ctx, a.clientTools, config, addonInfo.GetOlmSpec())
+			err := olm.SubscribeOperator(ctx, a.clientTools, config, addonInfo.GetOlmSpec(), syndesis)
 			if err != nil {
 				a.log.Error(err, "A subscription to an OLM operator failed", "Addon Name", addonInfo.Name(), "Package", addonInfo.GetOlmSpec().Package)
+				a.warning(syndesis, events.ReasonSubscriptionFailed, "Failed to subscribe to operator %s for addon %s: %v", addonInfo.GetOlmSpec().Package, addonInfo.Name(), err)
+				incomplete = true
 				continue
 			}
 		}
 
-		addonDir := "./addons/" + addonInfo.Name() + "/"
-		f, err := generator.GetAssetsFS().Open(addonDir)
-		if err != nil {
-			a.log.Error(err, "Unsupported addon configured", "addon", addonInfo.Name())
-			continue
-		}
-		f.Close()
-
-		resources, err := generator.RenderDir(addonDir, config)
-		if err != nil {
-			a.log.Error(err, "Rendering of addon resources failed", "addon", addonInfo.Name())
+		if addon.err != nil {
+			a.log.Error(addon.err, "Rendering of addon resources failed", "addon", addonInfo.Name())
+			incomplete = true
 			continue
 		}
 
@@ -246,90 +270,110 @@ func (a *installAction) Execute(ctx context.Context, syndesis *v1beta2.Syndesis)
 		// If there is an error do NOT fail-fast but
 		// try and continue to install the other addons
 		//
-		for _, res := range resources {
-			err = PreProcessForAffinityTolerations(ctx, rtClient, syndesis, &res)
-			if err != nil {
-				a.log.Error(err, "Install of addon failed", "addon", addonInfo.Name())
-				break
-			}
-
+		for _, res := range addon.resources {
 			o, err := a.installResource(ctx, rtClient, syndesis, res)
 			if err != nil {
 				a.log.Error(err, "Install of addon failed", "addon", addonInfo.Name())
+				incomplete = true
 				break
 			}
-			resourcesThatShouldExist[o.GetUID()] = true
+			if o.GetUID() != "" {
+				applied.add(o)
+			}
 		}
 	}
 
-	// Find resources which need to be deleted.
-	labelSelector, err := labels.Parse("owner=" + string(syndesis.GetUID()))
-	if err != nil {
-		panic(err)
-	}
-	options := client.ListOptions{
-		Namespace:     syndesis.Namespace,
-		LabelSelector: labelSelector,
-	}
-
-	api, _ := a.clientTools.ApiClient()
-	err = ListAllTypesInChunks(ctx, api, rtClient, options, func(list []unstructured.Unstructured) error {
-		for _, res := range list {
-			if resourcesThatShouldExist[res.GetUID()] {
-				continue
-			}
-			if res.GetOwnerReferences() == nil || len(res.GetOwnerReferences()) == 0 {
-				continue
-			}
-			if res.GetOwnerReferences()[0].UID != syndesis.GetUID() {
-				continue
-			}
-
-			// Found a resource that should not exist!
-			err := rtClient.Delete(ctx, &res)
-			if err != nil {
-				if !k8serrors.IsNotFound(err) {
-					a.log.Error(err, "could not deleted", "kind", res.GetKind(), "name", res.GetName(), "namespace", res.GetNamespace())
-				}
-			} else {
-				a.log.Info("resource deleted", "kind", res.GetKind(), "name", res.GetName(), "namespace", res.GetNamespace())
-			}
-		}
-		return nil
-	})
-	if err != nil {
+	// Delete the resources no longer rendered
+	if err := a.prune(ctx, rtClient, syndesis, applied, incomplete); err != nil {
 		return err
 	}
 
 	addRouteAnnotation(syndesis, syndesisRoute)
 	target := syndesis.DeepCopy()
+	target.SetOverridden(overrides.errors())
+	a.reportOverrides(syndesis, target)
+	target.SetPodSecurityCompatible(podSecurity, podSecurityIncompatibleComponents(syndesis, podSecurity))
+	a.reportPodSecurity(syndesis, target)
+	target.SetConflicted(a.conflicts)
 	if syndesis.Status.Phase == v1beta2.SyndesisPhaseInstalling {
 		// Installation completed, set the next state
-		target.Status.Phase = v1beta2.SyndesisPhaseStarting
-		target.Status.Reason = v1beta2.SyndesisStatusReasonMissing
-		target.Status.Description = ""
+		target.SetProgressing(v1beta2.SyndesisPhaseStarting, v1beta2.SyndesisStatusReasonMissing, "")
+		target.SetObservedGeneration()
 		err := rtClient.Status().Update(ctx, target)
 		if err != nil {
 			return err
 		}
 
 		a.log.Info("Syndesis resource installed", "name", target.Name)
+		a.event(syndesis, events.ReasonInstalled, "Installed the Syndesis resources")
 	} else if syndesis.Status.Phase == v1beta2.SyndesisPhasePostUpgradeRun {
 		// Installation completed, set the next state
-		target.Status.Phase = v1beta2.SyndesisPhasePostUpgradeRunSucceed
-		target.Status.Reason = v1beta2.SyndesisStatusReasonMissing
-		target.Status.Description = ""
+		target.SetProgressing(v1beta2.SyndesisPhasePostUpgradeRunSucceed, v1beta2.SyndesisStatusReasonMissing, "")
+		target.SetObservedGeneration()
 		err := rtClient.Status().Update(ctx, target)
 		if err != nil {
 			return err
 		}
 
 		a.log.Info("Syndesis resource installed after upgrading", "name", target.Name)
+		a.event(syndesis, events.ReasonInstalled, "Installed the Syndesis resources after upgrading")
+	} else if syndesis.Status.ObservedGeneration != syndesis.Generation {
+		// Changes to the spec have been applied to the resources, the
+		// installation is ready again once the components have rolled them out
+		target.SetProgressing(v1beta2.SyndesisPhaseStarting, v1beta2.SyndesisStatusReasonMissing, "")
+		target.SetObservedGeneration()
+		if err := rtClient.Status().Update(ctx, target); err != nil {
+			return err
+		}
+	} else if !equality.Semantic.DeepEqual(syndesis.Status, target.Status) {
+		// Overrides or pod security compliance changed since the previous reconciliation
+		if err := rtClient.Status().Update(ctx, target); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+// Warns about the overrides failing to be applied, once when they start to fail
+func (a *installAction) reportOverrides(syndesis *v1beta2.Syndesis, target *v1beta2.Syndesis) {
+	current := meta.FindStatusCondition(target.Status.Conditions, v1beta2.SyndesisConditionOverridden)
+	if current == nil || current.Status == metav1.ConditionTrue {
+		return
+	}
+
+	previous := meta.FindStatusCondition(syndesis.Status.Conditions, v1beta2.SyndesisConditionOverridden)
+	if previous != nil && previous.Status == current.Status && previous.Message == current.Message {
+		return
+	}
+
+	a.log.Info("overrides of the rendered resources not applied", "name", syndesis.Name, "message", current.Message)
+	a.warning(syndesis, events.ReasonOverrideFailed, "%s", current.Message)
+}
+
+// Loads the configuration used as context to render the resources, along with
+// the secret to connect to registries that require authentication, if any
+func loadConfiguration(ctx context.Context, clientTools *clienttools.ClientTools, syndesis *v1beta2.Syndesis) (*configuration.Config, *corev1.Secret, error) {
+	config, err := configuration.GetProperties(ctx, configuration.TemplateConfig, clientTools, syndesis)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Check if an image secret exists, to be used to connect to registries that require authentication
+	rtClient, _ := clientTools.RuntimeClient()
+	secret := &corev1.Secret{}
+	err = rtClient.Get(ctx, types.NamespacedName{Namespace: syndesis.Namespace, Name: SyndesisPullSecret}, secret)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return config, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	config.ImagePullSecrets = append(config.ImagePullSecrets, secret.Name)
+	return config, secret, nil
+}
+
 func ListAllTypesInChunks(ctx context.Context, api kubernetes.Interface, c client.Client, options client.ListOptions, handler func([]unstructured.Unstructured) error) error {
 	types, err := getTypes(api)
 	if err != nil {
@@ -383,15 +427,9 @@ func getTypes(api kubernetes.Interface) ([]metav1.TypeMeta, error) {
 	return types, nil
 }
 
-func installServiceAccount(ctx context.Context, cl client.Client, syndesis *v1beta2.Syndesis, secret *corev1.Secret) (*corev1.ServiceAccount, error) {
-	sa := newSyndesisServiceAccount()
-	if secret != nil {
-		linkImagePullSecret(sa, secret)
-	}
-
-	operation.SetNamespaceAndOwnerReference(sa, syndesis)
+func installServiceAccount(ctx context.Context, cl client.Client, sa *corev1.ServiceAccount) (*corev1.ServiceAccount, error) {
 	// We don't replace the service account if already present, to let Kubernetes generate its tokens
-	o, _, err := util.CreateOrUpdate(ctx, cl, sa)
+	o, _, _, err := util.Apply(ctx, cl, sa)
 	if err != nil {
 		return nil, err
 	}
@@ -421,7 +459,7 @@ func newSyndesisServiceAccount() *corev1.ServiceAccount {
 	return &sa
 }
 
-func addRouteAnnotation(syndesis *v1beta2.Syndesis, route *v1.Route) {
+func addRouteAnnotation(syndesis *v1beta2.Syndesis, route client.Object) {
 	annotations := syndesis.ObjectMeta.Annotations
 	if annotations == nil {
 		annotations = make(map[string]string)
@@ -430,28 +468,39 @@ func addRouteAnnotation(syndesis *v1beta2.Syndesis, route *v1.Route) {
 	annotations["syndesis.io/applicationUrl"] = extractApplicationUrl(route)
 }
 
-func extractApplicationUrl(route *v1.Route) string {
+func extractApplicationUrl(route client.Object) string {
 	scheme := "http"
-	if route.Spec.TLS != nil {
-		scheme = "https"
+	host := ""
+	switch r := route.(type) {
+	case *v1.Route:
+		if r.Spec.TLS != nil {
+			scheme = "https"
+		}
+		host = r.Spec.Host
+	case *networkingv1.Ingress:
+		if len(r.Spec.TLS) > 0 {
+			scheme = "https"
+		}
+		if len(r.Spec.Rules) > 0 {
+			host = r.Spec.Rules[0].Host
+		}
 	}
-	return scheme + "://" + route.Spec.Host
+	return scheme + "://" + host
 }
 
-func installSyndesisRoute(ctx context.Context, cl client.Client, syndesis *v1beta2.Syndesis, objects []runtime.Object) (*v1.Route, error) {
-	route, err := findSyndesisRoute(objects)
-	if err != nil {
-		return nil, err
+// Installs the route exposing Syndesis, or the ingress in the Kubernetes profile
+func installSyndesisRoute(ctx context.Context, cl client.Client, o client.Object) (client.Object, error) {
+	if ingress, ok := o.(*networkingv1.Ingress); ok {
+		return installSyndesisIngress(ctx, cl, ingress)
 	}
-
-	operation.SetNamespaceAndOwnerReference(route, syndesis)
+	route := o.(*v1.Route)
 
 	// We don't replace the route if already present, to let OpenShift generate its host
-	o, _, err := util.CreateOrUpdate(ctx, cl, route)
+	applied, _, _, err := util.Apply(ctx, cl, route)
 	if err != nil {
 		return nil, err
 	}
-	route.SetUID(o.GetUID())
+	route.SetUID(applied.GetUID())
 
 	if route.Spec.Host != "" {
 		return route, nil
@@ -471,11 +520,28 @@ func installSyndesisRoute(ctx context.Context, cl client.Client, syndesis *v1bet
 	return route, nil
 }
 
-func findSyndesisRoute(resources []runtime.Object) (*v1.Route, error) {
+func installSyndesisIngress(ctx context.Context, cl client.Client, ingress *networkingv1.Ingress) (*networkingv1.Ingress, error) {
+	// Unlike routes, ingresses have no generated host
+	if len(ingress.Spec.Rules) == 0 || ingress.Spec.Rules[0].Host == "" {
+		return nil, errors.New("hostname not set on syndesis ingress, the route hostname is required on Kubernetes")
+	}
+
+	applied, _, _, err := util.Apply(ctx, cl, ingress)
+	if err != nil {
+		return nil, err
+	}
+	ingress.SetUID(applied.GetUID())
+	return ingress, nil
+}
+
+func findSyndesisRoute(resources []runtime.Object) (client.Object, error) {
 	for _, res := range resources {
 		if route, ok := isSyndesisRoute(res); ok {
 			return route, nil
 		}
+		if ingress, ok := res.(*networkingv1.Ingress); ok && ingress.Name == SyndesisRouteName {
+			return ingress, nil
+		}
 	}
 	return nil, errors.New("syndesis route not found")
 }
@@ -494,6 +560,10 @@ func linkImageSecretToServiceAccounts(ctx context.Context, cl client.Client, syn
 	builder := &corev1.ServiceAccount{}
 	err := cl.Get(ctx, types.NamespacedName{Namespace: syndesis.Namespace, Name: "builder"}, builder)
 	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			// No builds, as on Kubernetes
+			return nil
+		}
 		return err
 	}
 	linked := linkImagePullSecret(builder, secret)
@@ -545,58 +615,15 @@ func linkSecret(sa *corev1.ServiceAccount, secret string) bool {
 
 func PreProcessForAffinityTolerations(ctx context.Context, cl client.Client, syndesis *v1beta2.Syndesis, resource *unstructured.Unstructured) error {
 	kind := resource.GetKind()
-	if kind != "DeploymentConfig" && kind != "Jaeger" {
-		return nil // only deployment-configs and jaeger CR to be processed
+	if kind != "DeploymentConfig" && kind != "Deployment" && kind != "Jaeger" {
+		return nil // only deployment-configs, deployments and jaeger CR to be processed
 	}
 
-	if syndesis.Spec.InfraScheduling.Affinity == nil && len(syndesis.Spec.InfraScheduling.Tolerations) == 0 {
-		return nil // No infra-scheduling defined so ignore
+	if kind == "Jaeger" {
+		// Jaeger does not need the same prefix nor has components scheduling
+		return operation.SetScheduling(resource.UnstructuredContent(), operation.InfraScheduling(syndesis.Spec.InfraScheduling), "spec")
 	}
 
-	if syndesis.Spec.InfraScheduling.Affinity != nil {
-		affinityData, err := runtime.DefaultUnstructuredConverter.ToUnstructured(syndesis.Spec.InfraScheduling.Affinity)
-		if err != nil {
-			return err
-		}
-
-		path := make([]string, 0)
-		if kind == "DeploymentConfig" {
-			path = append(path, "spec", "template")
-		}
-
-		// Jaeger does not need the same prefix
-		path = append(path, "spec", "affinity")
-
-		err = unstructured.SetNestedField(resource.UnstructuredContent(), affinityData, path...)
-		if err != nil {
-			return err
-		}
-	}
-
-	if len(syndesis.Spec.InfraScheduling.Tolerations) > 0 {
-		tolerations := make([]interface{}, len(syndesis.Spec.InfraScheduling.Tolerations))
-
-		for i, toleration := range syndesis.Spec.InfraScheduling.Tolerations {
-			tolerationData, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&toleration)
-			if err != nil {
-				return err
-			}
-			tolerations[i] = tolerationData
-		}
-
-		path := make([]string, 0)
-		if kind == "DeploymentConfig" {
-			path = append(path, "spec", "template")
-		}
-
-		// Jaeger does not need the same prefix
-		path = append(path, "spec", "tolerations")
-
-		err := unstructured.SetNestedSlice(resource.UnstructuredContent(), tolerations, path...)
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
+	scheduling := operation.ComponentScheduling(syndesis, resource.GetLabels()["syndesis.io/component"])
+	return operation.SetScheduling(resource.UnstructuredContent(), scheduling, "spec", "template", "spec")
 }
@@ -2,6 +2,7 @@ package action_test
 
 import (
 	"context"
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -14,6 +15,7 @@ import (
 	syntesting "github.com/syndesisio/syndesis/install/operator/pkg/syndesis/testing"
 	"k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 func renderResource(t *testing.T, ctx context.Context, clientTools *clienttools.ClientTools, syndesis *v1beta2.Syndesis, resourcePath string) []unstructured.Unstructured {
@@ -193,3 +195,261 @@ func TestPreProcessJaegerCR(t *testing.T) {
 	assert.True(t, found)
 	assert.NotNil(t, toleration)
 }
+
+func TestPreProcessForAffinityTolerationsDeployment(t *testing.T) {
+	os.Setenv("DEPLOYMENTS", "true")
+	defer os.Unsetenv("DEPLOYMENTS")
+
+	syndesis := &v1beta2.Syndesis{
+		Spec: v1beta2.SyndesisSpec{
+			InfraScheduling: v1beta2.SchedulingSpec{
+				Tolerations: []v1.Toleration{
+					{
+						Key:      "tol_333",
+						Operator: v1.TolerationOpEqual,
+						Effect:   v1.TaintEffectNoSchedule,
+					},
+				},
+			},
+		},
+	}
+
+	clientTools := syntesting.FakeClientTools()
+	rtClient, err := clientTools.RuntimeClient()
+	require.NoError(t, err)
+
+	ctx := context.TODO()
+	resources := renderResource(t, ctx, clientTools, syndesis, "./infrastructure/04-syndesis-server.yml.tmpl")
+	assert.Equal(t, "apps/v1", resources[1].GetAPIVersion())
+	assert.Equal(t, "Deployment", resources[1].GetKind())
+
+	err = action.PreProcessForAffinityTolerations(ctx, rtClient, syndesis, &resources[1])
+	require.NoError(t, err)
+
+	toleration, found, err := unstructured.NestedFieldNoCopy(resources[1].UnstructuredContent(), "spec", "template", "spec", "tolerations")
+	assert.True(t, found)
+	assert.NotNil(t, toleration)
+}
+
+func TestPreProcessForComponentScheduling(t *testing.T) {
+	syndesis := &v1beta2.Syndesis{
+		Spec: v1beta2.SyndesisSpec{
+			InfraScheduling: v1beta2.SchedulingSpec{
+				Affinity: &v1.Affinity{
+					PodAntiAffinity: &v1.PodAntiAffinity{
+						PreferredDuringSchedulingIgnoredDuringExecution: []v1.WeightedPodAffinityTerm{
+							{Weight: 100, PodAffinityTerm: v1.PodAffinityTerm{TopologyKey: "kubernetes.io/hostname"}},
+						},
+					},
+				},
+				Tolerations: []v1.Toleration{
+					{Key: "infra", Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoSchedule},
+				},
+			},
+			Components: v1beta2.ComponentsSpec{
+				Server: v1beta2.ServerConfiguration{
+					Scheduling: v1beta2.ComponentSchedulingSpec{
+						Affinity: &v1beta2.Affinity{Affinity: v1.Affinity{
+							NodeAffinity: &v1.NodeAffinity{
+								RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+									NodeSelectorTerms: []v1.NodeSelectorTerm{
+										{MatchExpressions: []v1.NodeSelectorRequirement{{Key: "storage", Operator: v1.NodeSelectorOpExists}}},
+									},
+								},
+							},
+						}},
+						Tolerations: []v1.Toleration{
+							{Key: "infra", Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoSchedule},
+							{Key: "storage", Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoSchedule},
+						},
+						NodeSelector:      map[string]string{"node-role.kubernetes.io/storage": ""},
+						PriorityClassName: "syndesis-critical",
+						TopologySpreadConstraints: []v1beta2.TopologySpreadConstraint{
+							{TopologySpreadConstraint: v1.TopologySpreadConstraint{MaxSkew: 1, TopologyKey: "topology.kubernetes.io/zone", WhenUnsatisfiable: v1.DoNotSchedule}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	clientTools := syntesting.FakeClientTools()
+	rtClient, err := clientTools.RuntimeClient()
+	require.NoError(t, err)
+
+	ctx := context.TODO()
+	resources := renderResource(t, ctx, clientTools, syndesis, "./infrastructure/04-syndesis-server.yml.tmpl")
+	err = action.PreProcessForAffinityTolerations(ctx, rtClient, syndesis, &resources[1])
+	require.NoError(t, err)
+
+	podSpec, _, _ := unstructured.NestedMap(resources[1].UnstructuredContent(), "spec", "template", "spec")
+	affinity := podSpec["affinity"].(map[string]interface{})
+	assert.Contains(t, affinity, "nodeAffinity")
+	assert.Contains(t, affinity, "podAntiAffinity")
+	assert.Len(t, podSpec["tolerations"], 2)
+	assert.Equal(t, map[string]interface{}{"node-role.kubernetes.io/storage": ""}, podSpec["nodeSelector"])
+	assert.Equal(t, "syndesis-critical", podSpec["priorityClassName"])
+	assert.Len(t, podSpec["topologySpreadConstraints"], 1)
+
+	// The other components only get the infra scheduling
+	resources = renderResource(t, ctx, clientTools, syndesis, "./infrastructure/04-syndesis-meta.yml.tmpl")
+	for i := range resources {
+		if resources[i].GetKind() != "DeploymentConfig" {
+			continue
+		}
+		err = action.PreProcessForAffinityTolerations(ctx, rtClient, syndesis, &resources[i])
+		require.NoError(t, err)
+
+		podSpec, _, _ = unstructured.NestedMap(resources[i].UnstructuredContent(), "spec", "template", "spec")
+		affinity = podSpec["affinity"].(map[string]interface{})
+		assert.NotContains(t, affinity, "nodeAffinity")
+		assert.Contains(t, affinity, "podAntiAffinity")
+		assert.Len(t, podSpec["tolerations"], 1)
+		assert.NotContains(t, podSpec, "nodeSelector")
+		assert.NotContains(t, podSpec, "priorityClassName")
+	}
+}
+
+func TestRenderIngressForKubernetes(t *testing.T) {
+	os.Setenv("KUBERNETES", "true")
+	defer os.Unsetenv("KUBERNETES")
+
+	syndesis := &v1beta2.Syndesis{
+		Spec: v1beta2.SyndesisSpec{
+			RouteHostname: "syndesis.example.com",
+			Ingress: v1beta2.IngressSpec{
+				ClassName: "nginx",
+				TLSSecret: "syndesis-tls",
+			},
+		},
+	}
+
+	clientTools := syntesting.FakeClientTools()
+	resources := renderResource(t, context.TODO(), clientTools, syndesis, "./route/route.yml.tmpl")
+	require.Len(t, resources, 1)
+	assert.Equal(t, "Ingress", resources[0].GetKind())
+
+	className, _, _ := unstructured.NestedString(resources[0].UnstructuredContent(), "spec", "ingressClassName")
+	assert.Equal(t, "nginx", className)
+	rules, _, _ := unstructured.NestedSlice(resources[0].UnstructuredContent(), "spec", "rules")
+	require.Len(t, rules, 1)
+	assert.Equal(t, "syndesis.example.com", rules[0].(map[string]interface{})["host"])
+	tls, _, _ := unstructured.NestedSlice(resources[0].UnstructuredContent(), "spec", "tls")
+	require.Len(t, tls, 1)
+	assert.Equal(t, "syndesis-tls", tls[0].(map[string]interface{})["secretName"])
+
+	proxy := renderResource(t, context.TODO(), clientTools, syndesis, "./infrastructure/04-syndesis-oauth-proxy.yml.tmpl")
+	containers, _, _ := unstructured.NestedSlice(proxy[1].UnstructuredContent(), "spec", "template", "spec", "containers")
+	require.Len(t, containers, 1)
+	assert.Contains(t, containers[0].(map[string]interface{})["args"], "--provider=oidc")
+	assert.Contains(t, containers[0].(map[string]interface{})["args"], "--redirect-url=https://syndesis.example.com/oauth/callback")
+}
+
+func TestPreProcessForPodSecurity(t *testing.T) {
+	syndesis := &v1beta2.Syndesis{
+		Spec: v1beta2.SyndesisSpec{
+			Components: v1beta2.ComponentsSpec{
+				Database: v1beta2.DatabaseConfiguration{
+					DisableRestrictedPodSecurity: true,
+				},
+			},
+		},
+	}
+
+	clientTools := syntesting.FakeClientTools()
+	resources := renderResource(t, context.TODO(), clientTools, syndesis, "./infrastructure/04-syndesis-server.yml.tmpl")
+	assert.Equal(t, "DeploymentConfig", resources[1].GetKind())
+
+	err := action.PreProcessForPodSecurity(syndesis, &resources[1])
+	require.NoError(t, err)
+
+	content := resources[1].UnstructuredContent()
+	runAsNonRoot, _, _ := unstructured.NestedBool(content, "spec", "template", "spec", "securityContext", "runAsNonRoot")
+	assert.True(t, runAsNonRoot)
+	seccomp, _, _ := unstructured.NestedString(content, "spec", "template", "spec", "securityContext", "seccompProfile", "type")
+	assert.Equal(t, "RuntimeDefault", seccomp)
+	containers, _, _ := unstructured.NestedSlice(content, "spec", "template", "spec", "containers")
+	for _, c := range containers {
+		escalation, found, _ := unstructured.NestedBool(c.(map[string]interface{}), "securityContext", "allowPrivilegeEscalation")
+		assert.True(t, found)
+		assert.False(t, escalation)
+		drop, _, _ := unstructured.NestedStringSlice(c.(map[string]interface{}), "securityContext", "capabilities", "drop")
+		assert.Equal(t, []string{"ALL"}, drop)
+	}
+
+	// Left out of the restricted standard
+	resources = renderResource(t, context.TODO(), clientTools, syndesis, "./database/syndesis-db.yml.tmpl")
+	for i := range resources {
+		if resources[i].GetKind() != "DeploymentConfig" {
+			continue
+		}
+		err = action.PreProcessForPodSecurity(syndesis, &resources[i])
+		require.NoError(t, err)
+		_, found, _ := unstructured.NestedFieldNoCopy(resources[i].UnstructuredContent(), "spec", "template", "spec", "securityContext")
+		assert.False(t, found)
+	}
+}
+
+func TestPreProcessForPodOverrides(t *testing.T) {
+	replicas := int32(2)
+	syndesis := &v1beta2.Syndesis{
+		Spec: v1beta2.SyndesisSpec{
+			Components: v1beta2.ComponentsSpec{
+				Server: v1beta2.ServerConfiguration{
+					PodOverrides: v1beta2.PodOverrides{
+						Env: []v1.EnvVar{
+							{Name: "HTTPS_PROXY", Value: "http://proxy.corp:3128"},
+							{Name: "CLIENT_STATE_TID", Value: "2"},
+						},
+						Labels:      map[string]string{"cost-center": "1234"},
+						Annotations: map[string]string{"vault.hashicorp.com/agent-inject": "true"},
+						Replicas:    &replicas,
+						Volumes: []v1beta2.PodVolume{
+							{Name: "extra", EmptyDir: &v1.EmptyDirVolumeSource{}},
+						},
+						VolumeMounts: []v1.VolumeMount{
+							{Name: "extra", MountPath: "/extra"},
+						},
+						LivenessProbe: &v1beta2.ProbeTimings{InitialDelaySeconds: 600},
+					},
+				},
+			},
+		},
+	}
+
+	clientTools := syntesting.FakeClientTools()
+	resources := renderResource(t, context.TODO(), clientTools, syndesis, "./infrastructure/04-syndesis-server.yml.tmpl")
+	assert.Equal(t, "DeploymentConfig", resources[1].GetKind())
+
+	err := action.PreProcessForPodOverrides(syndesis, &resources[1])
+	require.NoError(t, err)
+
+	content := resources[1].UnstructuredContent()
+	r, _, _ := unstructured.NestedInt64(content, "spec", "replicas")
+	assert.Equal(t, int64(2), r)
+	labels, _, _ := unstructured.NestedStringMap(content, "spec", "template", "metadata", "labels")
+	assert.Equal(t, "1234", labels["cost-center"])
+	assert.Equal(t, "syndesis-server", labels["syndesis.io/component"])
+	annotations, _, _ := unstructured.NestedStringMap(content, "spec", "template", "metadata", "annotations")
+	assert.Equal(t, "true", annotations["vault.hashicorp.com/agent-inject"])
+	volumes, _, _ := unstructured.NestedSlice(content, "spec", "template", "spec", "volumes")
+	assert.Len(t, volumes, 2)
+
+	containers, _, _ := unstructured.NestedSlice(content, "spec", "template", "spec", "containers")
+	container := v1.Container{}
+	require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(containers[0].(map[string]interface{}), &container))
+	assert.Contains(t, container.Env, v1.EnvVar{Name: "HTTPS_PROXY", Value: "http://proxy.corp:3128"})
+	assert.Contains(t, container.Env, v1.EnvVar{Name: "CLIENT_STATE_TID", Value: "2"})
+	assert.NotContains(t, container.Env, v1.EnvVar{Name: "CLIENT_STATE_TID", Value: "1"})
+	assert.Contains(t, container.VolumeMounts, v1.VolumeMount{Name: "extra", MountPath: "/extra"})
+	assert.Equal(t, int32(600), container.LivenessProbe.InitialDelaySeconds)
+	assert.Equal(t, int32(20), container.LivenessProbe.PeriodSeconds)
+
+	// Other components are left unchanged
+	meta := renderResource(t, context.TODO(), clientTools, syndesis, "./infrastructure/04-syndesis-meta.yml.tmpl")
+	for i := range meta {
+		before := meta[i].DeepCopy()
+		require.NoError(t, action.PreProcessForPodOverrides(syndesis, &meta[i]))
+		assert.Equal(t, before, &meta[i])
+	}
+}
@@ -0,0 +1,284 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/events"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/operation"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// Config map keeping the inventory of the resources applied for the syndesis resource
+	InventoryConfigMap = "syndesis-inventory"
+	inventoryKey       = "resources"
+)
+
+// A resource applied for the syndesis resource
+type inventoryEntry struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+}
+
+// Identifies the resource whatever the version it's served with
+func (e inventoryEntry) id() string {
+	gv, _ := schema.ParseGroupVersion(e.APIVersion)
+	return gv.Group + "/" + e.Kind + "/" + e.Namespace + "/" + e.Name
+}
+
+func (e inventoryEntry) object() *unstructured.Unstructured {
+	o := &unstructured.Unstructured{}
+	o.SetAPIVersion(e.APIVersion)
+	o.SetKind(e.Kind)
+	o.SetNamespace(e.Namespace)
+	o.SetName(e.Name)
+	return o
+}
+
+// Resources applied for the syndesis resource, by id
+type inventory map[string]inventoryEntry
+
+func (i inventory) add(o client.Object) {
+	gvk := o.GetObjectKind().GroupVersionKind()
+	if gvk.Empty() {
+		// Typed resources read from the cluster come without their kind
+		gvk, _ = apiutil.GVKForObject(o, scheme.Scheme)
+	}
+	e := inventoryEntry{
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Namespace:  o.GetNamespace(),
+		Name:       o.GetName(),
+	}
+	i[e.id()] = e
+}
+
+func (i inventory) contains(e inventoryEntry) bool {
+	_, found := i[e.id()]
+	return found
+}
+
+func (i inventory) entries() []inventoryEntry {
+	entries := make([]inventoryEntry, 0, len(i))
+	for _, e := range i {
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(a, b int) bool {
+		return entries[a].id() < entries[b].id()
+	})
+	return entries
+}
+
+// Reads the inventory of the resources previously applied, nil if none was recorded
+func readInventory(ctx context.Context, cl client.Client, syndesis *v1beta2.Syndesis) (inventory, error) {
+	cm := &corev1.ConfigMap{}
+	if err := cl.Get(ctx, types.NamespacedName{Namespace: syndesis.Namespace, Name: InventoryConfigMap}, cm); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	entries := []inventoryEntry{}
+	if err := json.Unmarshal([]byte(cm.Data[inventoryKey]), &entries); err != nil {
+		return nil, err
+	}
+	i := inventory{}
+	for _, e := range entries {
+		i[e.id()] = e
+	}
+	return i, nil
+}
+
+// Records the inventory of the resources applied, owned by the syndesis
+// resource so that it's removed with it
+func writeInventory(ctx context.Context, cl client.Client, syndesis *v1beta2.Syndesis, i inventory) error {
+	data, err := json.Marshal(i.entries())
+	if err != nil {
+		return err
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: syndesis.Namespace,
+			Name:      InventoryConfigMap,
+		},
+	}
+	_, err = controllerutil.CreateOrUpdate(ctx, cl, cm, func() error {
+		operation.SetNamespaceAndOwnerReference(cm, syndesis)
+		cm.Labels = map[string]string{
+			"app":   "syndesis",
+			"owner": string(syndesis.GetUID()),
+		}
+		cm.Data = map[string]string{inventoryKey: string(data)}
+		return nil
+	})
+	return err
+}
+
+// Deletes the resources of the previous inventory which are no longer applied,
+// then records the applied ones as the new inventory. With the prune dry-run
+// annotation the resources are only reported, and kept in the inventory.
+// Nothing is deleted when the install is incomplete, the resources left out
+// being kept in the inventory.
+func (a *installAction) prune(ctx context.Context, cl client.Client, syndesis *v1beta2.Syndesis, applied inventory, incomplete bool) error {
+	previous, err := readInventory(ctx, cl, syndesis)
+	if err != nil {
+		return err
+	}
+
+	if incomplete {
+		a.log.Info("pruning skipped, some resources could not be installed", "name", syndesis.Name)
+		if previous == nil {
+			// The owned resources are looked up once the install completes
+			return nil
+		}
+		for _, e := range previous.entries() {
+			applied[e.id()] = e
+		}
+		return writeInventory(ctx, cl, syndesis, applied)
+	}
+
+	a.pruneDryRun = nil
+	defer a.reportPruneDryRun(syndesis)
+
+	if previous == nil {
+		// Installed before the inventory was introduced, looks up the owned resources once
+		if err := a.pruneOwnedResources(ctx, cl, syndesis, applied); err != nil {
+			return err
+		}
+		return writeInventory(ctx, cl, syndesis, applied)
+	}
+
+	for _, e := range previous.entries() {
+		if applied.contains(e) {
+			continue
+		}
+
+		res := e.object()
+		if err := cl.Get(ctx, client.ObjectKey{Namespace: e.Namespace, Name: e.Name}, res); err != nil {
+			if k8serrors.IsNotFound(err) || util.IsNoKindMatchError(err) {
+				// Already gone
+				continue
+			}
+			a.log.Error(err, "could not read resource to prune", "kind", e.Kind, "name", e.Name, "namespace", e.Namespace)
+			applied[e.id()] = e
+			continue
+		}
+
+		if !a.pruneResource(ctx, cl, syndesis, res) {
+			applied[e.id()] = e
+		}
+	}
+
+	return writeInventory(ctx, cl, syndesis, applied)
+}
+
+// Deletes the resources labelled as owned by the syndesis resource which
+// are no longer applied, listing all the namespaced types of the cluster
+func (a *installAction) pruneOwnedResources(ctx context.Context, cl client.Client, syndesis *v1beta2.Syndesis, applied inventory) error {
+	labelSelector, err := labels.Parse("owner=" + string(syndesis.GetUID()))
+	if err != nil {
+		return err
+	}
+	options := client.ListOptions{
+		Namespace:     syndesis.Namespace,
+		LabelSelector: labelSelector,
+	}
+
+	api, _ := a.clientTools.ApiClient()
+	return ListAllTypesInChunks(ctx, api, cl, options, func(list []unstructured.Unstructured) error {
+		for _, res := range list {
+			if applied.contains(inventoryEntry{APIVersion: res.GetAPIVersion(), Kind: res.GetKind(), Namespace: res.GetNamespace(), Name: res.GetName()}) {
+				continue
+			}
+			if res.GetName() == InventoryConfigMap && res.GetKind() == "ConfigMap" {
+				continue
+			}
+			if !a.pruneResource(ctx, cl, syndesis, &res) {
+				applied.add(&res)
+			}
+		}
+		return nil
+	})
+}
+
+// Deletes a resource owned by the syndesis resource, unless pruning is a dry
+// run. Returns whether the resource is gone or not owned.
+func (a *installAction) pruneResource(ctx context.Context, cl client.Client, syndesis *v1beta2.Syndesis, res *unstructured.Unstructured) bool {
+	if len(res.GetOwnerReferences()) == 0 || res.GetOwnerReferences()[0].UID != syndesis.GetUID() {
+		// Not managed by the operator anymore
+		return true
+	}
+
+	if syndesis.IsPruneDryRun() {
+		a.pruneDryRun = append(a.pruneDryRun, res)
+		return false
+	}
+
+	if err := cl.Delete(ctx, res); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return true
+		}
+		a.log.Error(err, "could not deleted", "kind", res.GetKind(), "name", res.GetName(), "namespace", res.GetNamespace())
+		a.warning(syndesis, events.ReasonResourceFailed, "Failed to delete %s %s: %v", res.GetKind(), res.GetName(), err)
+		return false
+	}
+
+	a.log.Info("resource deleted", "kind", res.GetKind(), "name", res.GetName(), "namespace", res.GetNamespace())
+	a.event(syndesis, events.ReasonResourceDeleted, "Deleted %s %s", res.GetKind(), res.GetName())
+	return true
+}
+
+// Reports the resources which would be deleted by the dry run of the pruning,
+// once each time they change
+func (a *installAction) reportPruneDryRun(syndesis *v1beta2.Syndesis) {
+	resources := make([]string, 0, len(a.pruneDryRun))
+	for _, res := range a.pruneDryRun {
+		resources = append(resources, res.GetKind()+"/"+res.GetName())
+	}
+	sort.Strings(resources)
+	reported := strings.Join(resources, ",")
+	if reported == a.pruneDryRunReported {
+		return
+	}
+	a.pruneDryRunReported = reported
+
+	for _, res := range a.pruneDryRun {
+		a.log.Info("resource would be deleted, pruning is a dry run", "kind", res.GetKind(), "name", res.GetName(), "namespace", res.GetNamespace())
+		a.event(syndesis, events.ReasonResourcePruneDryRun, "Would delete %s %s, pruning is a dry run", res.GetKind(), res.GetName())
+	}
+}
@@ -0,0 +1,195 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package action
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	gofake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	rtfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_prune(t *testing.T) {
+	ownerRefs := []metav1.OwnerReference{{Name: "app", UID: "1234"}}
+	newConfigMap := func(name string, ownerRefs []metav1.OwnerReference) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "syndesis", OwnerReferences: ownerRefs},
+		}
+	}
+
+	s := scheme.Scheme
+	require.NoError(t, apis.AddToScheme(s))
+
+	testCases := []struct {
+		name       string
+		dryRun     bool
+		incomplete bool
+		inventory  []string
+		remaining  []string
+		event      string
+	}{
+		{"Prune", false, false, []string{"syndesis-current", "syndesis-other"}, []string{"syndesis-current"}, "ResourceDeleted"},
+		{"DryRun", true, false, []string{"syndesis-current", "syndesis-old", "syndesis-other"}, []string{"syndesis-current", "syndesis-old"}, "ResourcePruneDryRun"},
+		{"Incomplete", false, true, []string{"syndesis-current", "syndesis-old", "syndesis-other"}, []string{"syndesis-current", "syndesis-gone", "syndesis-old", "syndesis-other"}, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			syndesis := &v1beta2.Syndesis{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "syndesis", UID: "1234"}}
+			if tc.dryRun {
+				syndesis.Annotations = map[string]string{v1beta2.PruneDryRunAnnotation: "true"}
+			}
+			current := newConfigMap("syndesis-current", ownerRefs)
+			old := newConfigMap("syndesis-old", ownerRefs)
+			other := newConfigMap("syndesis-other", nil)
+
+			cl := rtfake.NewFakeClientWithScheme(s, syndesis, current, old, other)
+			previous := inventory{}
+			previous.add(current)
+			previous.add(old)
+			previous.add(other)
+			previous.add(newConfigMap("syndesis-gone", ownerRefs))
+			require.NoError(t, writeInventory(context.TODO(), cl, syndesis, previous))
+
+			clientTools := &clienttools.ClientTools{}
+			clientTools.SetRuntimeClient(cl)
+			recorder := record.NewFakeRecorder(10)
+			clientTools.SetEventRecorder(recorder)
+			a := &installAction{baseAction: baseAction{log: actionLog, clientTools: clientTools}}
+
+			applied := inventory{}
+			applied.add(current)
+			require.NoError(t, a.prune(context.TODO(), cl, syndesis, applied, tc.incomplete))
+
+			for _, cm := range tc.inventory {
+				assert.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Namespace: "syndesis", Name: cm}, &corev1.ConfigMap{}), cm)
+			}
+			err := cl.Get(context.TODO(), types.NamespacedName{Namespace: "syndesis", Name: "syndesis-old"}, &corev1.ConfigMap{})
+			assert.Equal(t, tc.dryRun || tc.incomplete, err == nil)
+
+			result, err := readInventory(context.TODO(), cl, syndesis)
+			require.NoError(t, err)
+			names := []string{}
+			for _, e := range result.entries() {
+				names = append(names, e.Name)
+			}
+			assert.Equal(t, tc.remaining, names)
+
+			if tc.event == "" {
+				assert.Len(t, recorder.Events, 0)
+			} else {
+				require.Len(t, recorder.Events, 1)
+				assert.Contains(t, <-recorder.Events, tc.event)
+			}
+		})
+	}
+}
+
+func Test_pruneWithoutInventory(t *testing.T) {
+	s := scheme.Scheme
+	require.NoError(t, apis.AddToScheme(s))
+
+	syndesis := &v1beta2.Syndesis{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "syndesis", UID: "1234"}}
+	cl := rtfake.NewFakeClientWithScheme(s, syndesis)
+	clientTools := &clienttools.ClientTools{}
+	clientTools.SetRuntimeClient(cl)
+	clientTools.SetApiClient(gofake.NewSimpleClientset())
+	a := &installAction{baseAction: baseAction{log: actionLog, clientTools: clientTools}}
+
+	applied := inventory{}
+	applied.add(&corev1.Service{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Service"}, ObjectMeta: metav1.ObjectMeta{Name: "syndesis-server", Namespace: "syndesis"}})
+	require.NoError(t, a.prune(context.TODO(), cl, syndesis, applied, false))
+
+	result, err := readInventory(context.TODO(), cl, syndesis)
+	require.NoError(t, err)
+	assert.Equal(t, []inventoryEntry{{APIVersion: "v1", Kind: "Service", Namespace: "syndesis", Name: "syndesis-server"}}, result.entries())
+}
+
+func Test_inventoryContains(t *testing.T) {
+	i := inventory{}
+	// The kind of typed resources is looked up when missing
+	i.add(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "syndesis-server-config", Namespace: "syndesis"}})
+	i.add(&unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "syndesis-server", "namespace": "syndesis"},
+	}})
+
+	assert.True(t, i.contains(inventoryEntry{APIVersion: "v1", Kind: "ConfigMap", Namespace: "syndesis", Name: "syndesis-server-config"}))
+	assert.False(t, i.contains(inventoryEntry{APIVersion: "v1", Kind: "Secret", Namespace: "syndesis", Name: "syndesis-server-config"}))
+	// Whatever the version the resource is served with
+	assert.True(t, i.contains(inventoryEntry{APIVersion: "apps/v1beta2", Kind: "Deployment", Namespace: "syndesis", Name: "syndesis-server"}))
+}
+
+func Test_pruneDryRunReportedOnChange(t *testing.T) {
+	s := scheme.Scheme
+	require.NoError(t, apis.AddToScheme(s))
+
+	ownerRefs := []metav1.OwnerReference{{Name: "app", UID: "1234"}}
+	syndesis := &v1beta2.Syndesis{ObjectMeta: metav1.ObjectMeta{
+		Name:        "app",
+		Namespace:   "syndesis",
+		UID:         "1234",
+		Annotations: map[string]string{v1beta2.PruneDryRunAnnotation: "true"},
+	}}
+	old := &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Name: "syndesis-old", Namespace: "syndesis", OwnerReferences: ownerRefs},
+	}
+	older := old.DeepCopy()
+	older.Name = "syndesis-older"
+
+	cl := rtfake.NewFakeClientWithScheme(s, syndesis, old, older)
+	previous := inventory{}
+	previous.add(old)
+	require.NoError(t, writeInventory(context.TODO(), cl, syndesis, previous))
+
+	clientTools := &clienttools.ClientTools{}
+	clientTools.SetRuntimeClient(cl)
+	recorder := record.NewFakeRecorder(10)
+	clientTools.SetEventRecorder(recorder)
+	a := &installAction{baseAction: baseAction{log: actionLog, clientTools: clientTools}}
+
+	require.NoError(t, a.prune(context.TODO(), cl, syndesis, inventory{}, false))
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, "Would delete ConfigMap syndesis-old")
+
+	// Unchanged
+	require.NoError(t, a.prune(context.TODO(), cl, syndesis, inventory{}, false))
+	assert.Len(t, recorder.Events, 0)
+
+	// Another resource to prune
+	previous.add(older)
+	require.NoError(t, writeInventory(context.TODO(), cl, syndesis, previous))
+	require.NoError(t, a.prune(context.TODO(), cl, syndesis, inventory{}, false))
+	require.Len(t, recorder.Events, 2)
+	assert.Contains(t, <-recorder.Events, "Would delete ConfigMap syndesis-old")
+	assert.Contains(t, <-recorder.Events, "Would delete ConfigMap syndesis-older")
+}
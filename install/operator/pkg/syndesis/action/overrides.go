@@ -0,0 +1,123 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package action
+
+import (
+	"encoding/json"
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"sigs.k8s.io/yaml"
+)
+
+// The overrides of a syndesis resource, patching the rendered resources they
+// target before they are installed
+type resourceOverrides struct {
+	scheme    *runtime.Scheme
+	overrides []v1beta2.ResourceOverride
+	applied   []bool
+	failures  []string
+}
+
+func newResourceOverrides(scheme *runtime.Scheme, syndesis *v1beta2.Syndesis) *resourceOverrides {
+	return &resourceOverrides{
+		scheme:    scheme,
+		overrides: syndesis.Spec.Overrides,
+		applied:   make([]bool, len(syndesis.Spec.Overrides)),
+	}
+}
+
+// Applies the overrides targeting the resource. The ones failing are
+// recorded and left out, the resource being installed without them.
+func (o *resourceOverrides) apply(res *unstructured.Unstructured) {
+	for i, override := range o.overrides {
+		if override.APIVersion != res.GetAPIVersion() || override.Kind != res.GetKind() || override.Name != res.GetName() {
+			continue
+		}
+
+		o.applied[i] = true
+		if err := o.patch(res, override); err != nil {
+			o.failures = append(o.failures, fmt.Sprintf("%s: %v", overrideTarget(override), err))
+		}
+	}
+}
+
+func (o *resourceOverrides) patch(res *unstructured.Unstructured, override v1beta2.ResourceOverride) error {
+	original, err := res.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	patch, err := yaml.YAMLToJSON([]byte(override.Patch))
+	if err != nil {
+		return err
+	}
+
+	var patched []byte
+	switch override.Type {
+	case v1beta2.PatchTypeJSON:
+		p, err := jsonpatch.DecodePatch(patch)
+		if err != nil {
+			return err
+		}
+		if patched, err = p.Apply(original); err != nil {
+			return err
+		}
+	case v1beta2.PatchTypeStrategicMerge, "":
+		typed, err := o.scheme.New(res.GroupVersionKind())
+		if runtime.IsNotRegisteredError(err) {
+			// No patch strategies known for custom resources
+			if patched, err = jsonpatch.MergePatch(original, patch); err != nil {
+				return err
+			}
+			break
+		} else if err != nil {
+			return err
+		}
+		if patched, err = strategicpatch.StrategicMergePatch(original, patch, typed); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported patch type %s", override.Type)
+	}
+
+	content := map[string]interface{}{}
+	if err := json.Unmarshal(patched, &content); err != nil {
+		return err
+	}
+	res.SetUnstructuredContent(content)
+	return nil
+}
+
+// The overrides not applied, either failing or targeting no rendered resource
+func (o *resourceOverrides) errors() []string {
+	errors := append([]string{}, o.failures...)
+	for i, override := range o.overrides {
+		if !o.applied[i] {
+			errors = append(errors, fmt.Sprintf("%s: no such rendered resource", overrideTarget(override)))
+		}
+	}
+	return errors
+}
+
+func overrideTarget(override v1beta2.ResourceOverride) string {
+	return override.APIVersion + "/" + override.Kind + "/" + override.Name
+}
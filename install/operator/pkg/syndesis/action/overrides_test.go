@@ -0,0 +1,135 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package action
+
+import (
+	"testing"
+
+	oappsv1 "github.com/openshift/api/apps/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+func renderedServer() *unstructured.Unstructured {
+	res := &unstructured.Unstructured{}
+	res.SetUnstructuredContent(map[string]interface{}{
+		"apiVersion": "apps.openshift.io/v1",
+		"kind":       "DeploymentConfig",
+		"metadata": map[string]interface{}{
+			"name": "syndesis-server",
+		},
+		"spec": map[string]interface{}{
+			"replicas": int64(1),
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{
+							"name":  "syndesis-server",
+							"image": "syndesis/syndesis-server:latest",
+						},
+					},
+				},
+			},
+		},
+	})
+	return res
+}
+
+func Test_resourceOverrides(t *testing.T) {
+	s := scheme.Scheme
+	require.NoError(t, oappsv1.AddToScheme(s))
+
+	syndesis := &v1beta2.Syndesis{
+		Spec: v1beta2.SyndesisSpec{
+			Overrides: []v1beta2.ResourceOverride{
+				{
+					APIVersion: "apps.openshift.io/v1",
+					Kind:       "DeploymentConfig",
+					Name:       "syndesis-server",
+					Patch: `
+spec:
+  template:
+    spec:
+      terminationGracePeriodSeconds: 60
+      containers:
+      - name: sidecar
+        image: sidecar:latest
+`,
+				},
+				{
+					APIVersion: "apps.openshift.io/v1",
+					Kind:       "DeploymentConfig",
+					Name:       "syndesis-server",
+					Type:       v1beta2.PatchTypeJSON,
+					Patch:      `[{"op": "replace", "path": "/spec/replicas", "value": 3}]`,
+				},
+				{
+					APIVersion: "jaegertracing.io/v1",
+					Kind:       "Jaeger",
+					Name:       "syndesis-jaeger",
+					Patch:      `{"spec": {"strategy": "production"}}`,
+				},
+				{
+					APIVersion: "apps.openshift.io/v1",
+					Kind:       "DeploymentConfig",
+					Name:       "syndesis-server",
+					Type:       v1beta2.PatchTypeJSON,
+					Patch:      `[{"op": "remove", "path": "/spec/missing"}]`,
+				},
+				{
+					APIVersion: "v1",
+					Kind:       "Service",
+					Name:       "syndesis-missing",
+					Patch:      `{}`,
+				},
+			},
+		},
+	}
+
+	overrides := newResourceOverrides(s, syndesis)
+
+	server := renderedServer()
+	overrides.apply(server)
+
+	dc := &oappsv1.DeploymentConfig{}
+	require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(server.UnstructuredContent(), dc))
+	assert.Equal(t, int32(3), dc.Spec.Replicas)
+	assert.Equal(t, int64(60), *dc.Spec.Template.Spec.TerminationGracePeriodSeconds)
+	// Containers are merged by name
+	images := map[string]string{}
+	for _, c := range dc.Spec.Template.Spec.Containers {
+		images[c.Name] = c.Image
+	}
+	assert.Equal(t, map[string]string{"syndesis-server": "syndesis/syndesis-server:latest", "sidecar": "sidecar:latest"}, images)
+
+	jaeger := &unstructured.Unstructured{}
+	jaeger.SetAPIVersion("jaegertracing.io/v1")
+	jaeger.SetKind("Jaeger")
+	jaeger.SetName("syndesis-jaeger")
+	overrides.apply(jaeger)
+	strategy, _, _ := unstructured.NestedString(jaeger.UnstructuredContent(), "spec", "strategy")
+	assert.Equal(t, "production", strategy)
+
+	errors := overrides.errors()
+	require.Len(t, errors, 2)
+	assert.Contains(t, errors[0], "apps.openshift.io/v1/DeploymentConfig/syndesis-server")
+	assert.Equal(t, "v1/Service/syndesis-missing: no such rendered resource", errors[1])
+}
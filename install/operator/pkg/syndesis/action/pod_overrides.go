@@ -0,0 +1,175 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package action
+
+import (
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Overrides of the components, by value of their syndesis.io/component label
+func podOverridesByComponent(syndesis *v1beta2.Syndesis) map[string]v1beta2.PodOverrides {
+	components := syndesis.Spec.Components
+	return map[string]v1beta2.PodOverrides{
+		"syndesis-server":     components.Server.PodOverrides,
+		"syndesis-meta":       components.Meta.PodOverrides,
+		"syndesis-ui":         components.UI.PodOverrides,
+		"syndesis-db":         components.Database.PodOverrides,
+		"syndesis-prometheus": components.Prometheus.PodOverrides,
+		"syndesis-oauthproxy": components.Oauth.PodOverrides,
+	}
+}
+
+// PreProcessForPodOverrides applies the pod overrides of the syndesis
+// resource to the deployment or deployment config of its component
+func PreProcessForPodOverrides(syndesis *v1beta2.Syndesis, resource *unstructured.Unstructured) error {
+	kind := resource.GetKind()
+	if kind != "DeploymentConfig" && kind != "Deployment" {
+		return nil // only deployment-configs and deployments to be processed
+	}
+
+	overrides, ok := podOverridesByComponent(syndesis)[resource.GetLabels()["syndesis.io/component"]]
+	if !ok {
+		return nil
+	}
+
+	content := resource.UnstructuredContent()
+
+	if overrides.Replicas != nil {
+		if err := unstructured.SetNestedField(content, int64(*overrides.Replicas), "spec", "replicas"); err != nil {
+			return err
+		}
+	}
+
+	if err := mergeNestedStringMap(content, overrides.Labels, "spec", "template", "metadata", "labels"); err != nil {
+		return err
+	}
+
+	if err := mergeNestedStringMap(content, overrides.Annotations, "spec", "template", "metadata", "annotations"); err != nil {
+		return err
+	}
+
+	if len(overrides.Volumes) > 0 {
+		volumes, _, err := unstructured.NestedSlice(content, "spec", "template", "spec", "volumes")
+		if err != nil {
+			return err
+		}
+		for i := range overrides.Volumes {
+			volume, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&overrides.Volumes[i])
+			if err != nil {
+				return err
+			}
+			volumes = append(volumes, volume)
+		}
+		if err := unstructured.SetNestedSlice(content, volumes, "spec", "template", "spec", "volumes"); err != nil {
+			return err
+		}
+	}
+
+	if len(overrides.Env) == 0 && len(overrides.EnvFrom) == 0 && len(overrides.VolumeMounts) == 0 &&
+		overrides.LivenessProbe == nil && overrides.ReadinessProbe == nil {
+		return nil
+	}
+
+	containers, found, err := unstructured.NestedSlice(content, "spec", "template", "spec", "containers")
+	if err != nil || !found || len(containers) == 0 {
+		return err
+	}
+
+	// The main container of the component comes first
+	data, ok := containers[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	container := corev1.Container{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(data, &container); err != nil {
+		return err
+	}
+
+	overrideContainer(&container, overrides)
+
+	if containers[0], err = runtime.DefaultUnstructuredConverter.ToUnstructured(&container); err != nil {
+		return err
+	}
+
+	return unstructured.SetNestedSlice(content, containers, "spec", "template", "spec", "containers")
+}
+
+func overrideContainer(container *corev1.Container, overrides v1beta2.PodOverrides) {
+	for _, env := range overrides.Env {
+		replaced := false
+		for i := range container.Env {
+			if container.Env[i].Name == env.Name {
+				container.Env[i] = env
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			container.Env = append(container.Env, env)
+		}
+	}
+
+	container.EnvFrom = append(container.EnvFrom, overrides.EnvFrom...)
+	container.VolumeMounts = append(container.VolumeMounts, overrides.VolumeMounts...)
+
+	overrideProbe(container.LivenessProbe, overrides.LivenessProbe)
+	overrideProbe(container.ReadinessProbe, overrides.ReadinessProbe)
+}
+
+func overrideProbe(probe *corev1.Probe, timings *v1beta2.ProbeTimings) {
+	if probe == nil || timings == nil {
+		return // no probe to tune
+	}
+
+	if timings.InitialDelaySeconds > 0 {
+		probe.InitialDelaySeconds = timings.InitialDelaySeconds
+	}
+	if timings.TimeoutSeconds > 0 {
+		probe.TimeoutSeconds = timings.TimeoutSeconds
+	}
+	if timings.PeriodSeconds > 0 {
+		probe.PeriodSeconds = timings.PeriodSeconds
+	}
+	if timings.SuccessThreshold > 0 {
+		probe.SuccessThreshold = timings.SuccessThreshold
+	}
+	if timings.FailureThreshold > 0 {
+		probe.FailureThreshold = timings.FailureThreshold
+	}
+}
+
+func mergeNestedStringMap(content map[string]interface{}, values map[string]string, fields ...string) error {
+	if len(values) == 0 {
+		return nil
+	}
+
+	merged, _, err := unstructured.NestedStringMap(content, fields...)
+	if err != nil {
+		return err
+	}
+	if merged == nil {
+		merged = map[string]string{}
+	}
+	for k, v := range values {
+		merged[k] = v
+	}
+
+	return unstructured.SetNestedStringMap(content, merged, fields...)
+}
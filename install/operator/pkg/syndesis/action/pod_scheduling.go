@@ -9,7 +9,8 @@ import (
 	oappsv1 "github.com/openshift/api/apps/v1"
 	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -51,24 +52,34 @@ func (a *podSchedulingAction) Execute(ctx context.Context, syndesis *v1beta2.Syn
 }
 
 func (a *podSchedulingAction) executeIntegrationScheduling(ctx context.Context, syndesis *v1beta2.Syndesis) {
-	list := oappsv1.DeploymentConfigList{
-		TypeMeta: metav1.TypeMeta{
-			Kind: "DeploymentConfig",
-		},
-	}
 	selector, _ := labels.Parse("syndesis.io/type=integration")
 	options := client.ListOptions{
 		Namespace:     syndesis.Namespace,
 		LabelSelector: selector,
 	}
 
+	// Integrations are deployed with DeploymentConfigs or Deployments
 	rtClient, _ := a.clientTools.RuntimeClient()
-	if err := rtClient.List(ctx, &list, &options); err != nil {
-		a.log.Error(err, "Error listing DeploymentConfig to apply integration scheduling", "selector", selector)
+	integrations := []client.Object{}
+	dcs := oappsv1.DeploymentConfigList{}
+	if err := rtClient.List(ctx, &dcs, &options); err != nil {
+		if !util.IsNoKindMatchError(err) {
+			a.log.Error(err, "Error listing DeploymentConfig to apply integration scheduling", "selector", selector)
+		}
+	}
+	for i := range dcs.Items {
+		integrations = append(integrations, &dcs.Items[i])
+	}
+	deployments := appsv1.DeploymentList{}
+	if err := rtClient.List(ctx, &deployments, &options); err != nil {
+		a.log.Error(err, "Error listing Deployment to apply integration scheduling", "selector", selector)
+	}
+	for i := range deployments.Items {
+		integrations = append(integrations, &deployments.Items[i])
 	}
 
 	a.currentIntegrationScheduling = syndesis.Spec.IntegrationScheduling
-	if len(list.Items) == 0 {
+	if len(integrations) == 0 {
 		return
 	}
 
@@ -115,16 +126,15 @@ func (a *podSchedulingAction) executeIntegrationScheduling(ctx context.Context,
 	}
 
 	payload, _ := json.Marshal(ops)
-	for _, depl := range list.Items {
-		a.log.Info("Patching Integration: dc/" + depl.GetName() + " with new affinity/toleration values, this action will restart the integration pod.")
-		dc := &oappsv1.DeploymentConfig{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      depl.Name,
-				Namespace: syndesis.Namespace,
-			}}
-		err := rtClient.Patch(ctx, dc, client.RawPatch(types.JSONPatchType, payload))
+	for _, integration := range integrations {
+		kind := "dc"
+		if _, ok := integration.(*appsv1.Deployment); ok {
+			kind = "deployment"
+		}
+		a.log.Info("Patching Integration: " + kind + "/" + integration.GetName() + " with new affinity/toleration values, this action will restart the integration pod.")
+		err := rtClient.Patch(ctx, integration, client.RawPatch(types.JSONPatchType, payload))
 		if err != nil {
-			a.log.Error(err, "Error patching dc/"+depl.GetName())
+			a.log.Error(err, "Error patching "+kind+"/"+integration.GetName())
 		}
 	}
 }
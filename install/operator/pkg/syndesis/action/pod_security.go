@@ -0,0 +1,88 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package action
+
+import (
+	"context"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/events"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/operation"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// PreProcessForPodSecurity makes the pods of the deployment configs,
+// deployments and jobs comply with the restricted pod security standard,
+// unless their component is left out of it
+func PreProcessForPodSecurity(syndesis *v1beta2.Syndesis, resource *unstructured.Unstructured) error {
+	kind := resource.GetKind()
+	if kind != "DeploymentConfig" && kind != "Deployment" && kind != "Job" {
+		return nil // only deployment-configs, deployments and jobs to be processed
+	}
+
+	component := resource.GetLabels()["syndesis.io/component"]
+	return operation.SetComponentPodSecurity(resource.UnstructuredContent(), syndesis, component, "spec", "template", "spec")
+}
+
+// Reads the pod security standard enforced in the namespace of the syndesis
+// resource, empty if none or if the namespace cannot be read. The namespace is
+// read without the cache of the runtime client, which would need to list and
+// watch the namespaces of the cluster when the operator is only granted to get
+// them by its cluster role.
+func (a *installAction) enforcedPodSecurity(ctx context.Context, syndesis *v1beta2.Syndesis) string {
+	api, err := a.clientTools.ApiClient()
+	if err != nil {
+		a.log.Info("Unable to read the pod security standard of the namespace", "namespace", syndesis.Namespace, "error message", err.Error())
+		return ""
+	}
+
+	namespace, err := api.CoreV1().Namespaces().Get(ctx, syndesis.Namespace, metav1.GetOptions{})
+	if err != nil {
+		a.log.Info("Unable to read the pod security standard of the namespace", "namespace", syndesis.Namespace, "error message", err.Error())
+		return ""
+	}
+	return namespace.Labels[operation.PodSecurityEnforceLabel]
+}
+
+// Lists the components whose pods are rejected by the pod security standard
+// enforced in the namespace. The pods comply with the restricted standard, and
+// so with the less restrictive ones, unless left out of it.
+func podSecurityIncompatibleComponents(syndesis *v1beta2.Syndesis, level string) []string {
+	if level != operation.PodSecurityRestricted {
+		return nil
+	}
+	return operation.UnrestrictedComponents(syndesis)
+}
+
+// Warns about the components rejected by the pod security standard of the
+// namespace, once when they start to be
+func (a *installAction) reportPodSecurity(syndesis *v1beta2.Syndesis, target *v1beta2.Syndesis) {
+	current := meta.FindStatusCondition(target.Status.Conditions, v1beta2.SyndesisConditionPodSecurityCompatible)
+	if current == nil || current.Status == metav1.ConditionTrue {
+		return
+	}
+
+	previous := meta.FindStatusCondition(syndesis.Status.Conditions, v1beta2.SyndesisConditionPodSecurityCompatible)
+	if previous != nil && previous.Status == current.Status && previous.Message == current.Message {
+		return
+	}
+
+	a.log.Info("components not complying with the pod security standard of the namespace", "name", syndesis.Name, "message", current.Message)
+	a.warning(syndesis, events.ReasonPodSecurityIncompatible, "%s", current.Message)
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package action
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/events"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	gofake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+	rtfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_reportPodSecurity(t *testing.T) {
+	s := scheme.Scheme
+	require.NoError(t, apis.AddToScheme(s))
+
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "syndesis", Labels: map[string]string{"pod-security.kubernetes.io/enforce": "restricted"}},
+	}
+	syndesis := &v1beta2.Syndesis{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "syndesis"}}
+	syndesis.Spec.Components.Database.DisableRestrictedPodSecurity = true
+
+	// Only granted to get the namespaces, as by the cluster role of the operator
+	api := gofake.NewSimpleClientset(namespace)
+	api.PrependReactor("*", "namespaces", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetVerb() == "get" {
+			return false, nil, nil
+		}
+		return true, nil, k8serrors.NewForbidden(corev1.Resource("namespaces"), "", errors.New("only get is granted"))
+	})
+	clientTools := &clienttools.ClientTools{}
+	clientTools.SetRuntimeClient(rtfake.NewFakeClientWithScheme(s))
+	clientTools.SetApiClient(api)
+	recorder := record.NewFakeRecorder(10)
+	clientTools.SetEventRecorder(recorder)
+	a := &installAction{baseAction: baseAction{log: actionLog, clientTools: clientTools}}
+
+	level := a.enforcedPodSecurity(context.TODO(), syndesis)
+	assert.Equal(t, "restricted", level)
+
+	target := syndesis.DeepCopy()
+	target.SetPodSecurityCompatible(level, podSecurityIncompatibleComponents(syndesis, level))
+	a.reportPodSecurity(syndesis, target)
+	compatible := meta.FindStatusCondition(target.Status.Conditions, v1beta2.SyndesisConditionPodSecurityCompatible)
+	require.NotNil(t, compatible)
+	assert.Equal(t, metav1.ConditionFalse, compatible.Status)
+	assert.Contains(t, compatible.Message, "syndesis-db")
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, events.ReasonPodSecurityIncompatible)
+
+	// Reported once
+	a.reportPodSecurity(target, target.DeepCopy())
+	assert.Len(t, recorder.Events, 0)
+
+	// Every component complies with the less restrictive standards
+	assert.Empty(t, podSecurityIncompatibleComponents(syndesis, "baseline"))
+
+	// Unknown when the namespace cannot be read
+	other := &v1beta2.Syndesis{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "other"}}
+	assert.Equal(t, "", a.enforcedPodSecurity(context.TODO(), other))
+}
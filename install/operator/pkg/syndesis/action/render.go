@@ -0,0 +1,158 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package action
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	"github.com/syndesisio/syndesis/install/operator/pkg/generator"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/operation"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Resources rendered for an enabled addon
+type renderedAddon struct {
+	info      configuration.AddonInfo
+	resources []unstructured.Unstructured
+	// Failure to render or prepare the resources, the addon being left out
+	err error
+}
+
+// Resources rendered for the syndesis resource, once its route is known
+type renderedResources struct {
+	components []unstructured.Unstructured
+	addons     []renderedAddon
+}
+
+// Renders the service account of the syndesis resource, the OAuth client of
+// the OpenShift proxy
+func renderServiceAccount(syndesis *v1beta2.Syndesis, secret *corev1.Secret) *corev1.ServiceAccount {
+	sa := newSyndesisServiceAccount()
+	if secret != nil {
+		linkImagePullSecret(sa, secret)
+	}
+	operation.SetNamespaceAndOwnerReference(sa, syndesis)
+	return sa
+}
+
+// Renders the route exposing Syndesis, or the ingress in the Kubernetes profile
+func renderSyndesisRoute(scheme *runtime.Scheme, syndesis *v1beta2.Syndesis, config *configuration.Config, overrides *resourceOverrides) (client.Object, error) {
+	all, err := generator.RenderDir("./route/", config)
+	if err != nil {
+		return nil, err
+	}
+	for i := range all {
+		overrides.apply(&all[i])
+	}
+
+	routes, _ := util.SeperateStructuredAndUnstructured(scheme, all)
+	route, err := findSyndesisRoute(routes)
+	if err != nil {
+		return nil, err
+	}
+	operation.SetNamespaceAndOwnerReference(route, syndesis)
+	return route, nil
+}
+
+// Renders the resources of the components and of the enabled addons, the
+// same way whether they are applied by the install or compared with those on
+// the cluster by drift detection. The components fail the rendering, while
+// the failure of an addon is kept with it for the other addons to be rendered.
+func renderResources(ctx context.Context, cl client.Client, syndesis *v1beta2.Syndesis, config *configuration.Config, overrides *resourceOverrides) (*renderedResources, error) {
+	components, err := renderComponents(syndesis, config)
+	if err != nil {
+		return nil, err
+	}
+	for i := range components {
+		if err := prepareResource(ctx, cl, syndesis, overrides, &components[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	rendered := &renderedResources{components: components}
+	for _, addonInfo := range configuration.GetAddonsInfo(*config) {
+		if !addonInfo.IsEnabled() {
+			continue
+		}
+
+		addon := renderedAddon{info: addonInfo}
+		addon.resources, addon.err = renderAddon(addonInfo.Name(), config)
+		for i := 0; addon.err == nil && i < len(addon.resources); i++ {
+			addon.err = prepareResource(ctx, cl, syndesis, overrides, &addon.resources[i])
+		}
+		if addon.err != nil {
+			addon.resources = nil
+		}
+		rendered.addons = append(rendered.addons, addon)
+	}
+	return rendered, nil
+}
+
+// Prepares a rendered resource for the syndesis resource, with the scheduling,
+// the pod security and the pod overrides of its component, then the patches
+// of the syndesis resource
+func prepareResource(ctx context.Context, cl client.Client, syndesis *v1beta2.Syndesis, overrides *resourceOverrides, res *unstructured.Unstructured) error {
+	if err := PreProcessForAffinityTolerations(ctx, cl, syndesis, res); err != nil {
+		return err
+	}
+	if err := PreProcessForPodSecurity(syndesis, res); err != nil {
+		return err
+	}
+	if err := PreProcessForPodOverrides(syndesis, res); err != nil {
+		return err
+	}
+	overrides.apply(res)
+	operation.SetNamespaceAndOwnerReference(*res, syndesis)
+	return nil
+}
+
+// Renders the resources of the syndesis components, the database being left
+// out when an external one is used
+func renderComponents(syndesis *v1beta2.Syndesis, config *configuration.Config) ([]unstructured.Unstructured, error) {
+	all, err := generator.RenderDir("./infrastructure/", config)
+	if err != nil {
+		return nil, err
+	}
+
+	if syndesis.Spec.Components.Database.ExternalDbURL == "" {
+		dbResources, err := generator.RenderDir("./database/", config)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, dbResources...)
+	}
+	return all, nil
+}
+
+// Renders the resources of an addon, failing if the addon is not supported
+func renderAddon(name string, config *configuration.Config) ([]unstructured.Unstructured, error) {
+	addonDir := "./addons/" + name + "/"
+	f, err := generator.GetAssetsFS().Open(addonDir)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported addon %s: %v", name, err)
+	}
+	f.Close()
+
+	return generator.RenderDir(addonDir, config)
+}
@@ -3,15 +3,15 @@ package action
 import (
 	"context"
 	"errors"
+	"reflect"
 
 	v1 "github.com/openshift/api/apps/v1"
 	synpkg "github.com/syndesisio/syndesis/install/operator/pkg"
 	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/events"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/labels"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 )
 
@@ -29,66 +29,63 @@ func newStartupAction(mgr manager.Manager, clientTools *clienttools.ClientTools)
 func (a *startupAction) CanExecute(syndesis *v1beta2.Syndesis) bool {
 	return syndesisPhaseIs(syndesis,
 		v1beta2.SyndesisPhaseStarting,
-		v1beta2.SyndesisPhaseStartupFailed)
+		v1beta2.SyndesisPhaseStartupFailed,
+		v1beta2.SyndesisPhaseInstalled)
 }
 
 func (a *startupAction) Execute(ctx context.Context, syndesis *v1beta2.Syndesis) error {
-
-	list := v1.DeploymentConfigList{
-		TypeMeta: metav1.TypeMeta{
-			Kind:       "DeploymentConfig",
-			APIVersion: "apps.openshift.io/v1",
-		},
-	}
-	selector, err := labels.Parse("syndesis.io/app=syndesis,syndesis.io/type=infrastructure")
+	rtClient, _ := a.clientTools.RuntimeClient()
+	components, err := listComponents(ctx, rtClient, syndesis)
 	if err != nil {
 		return err
 	}
-	options := client.ListOptions{
-		Namespace:     syndesis.Namespace,
-		LabelSelector: selector,
-	}
 
-	rtClient, _ := a.clientTools.RuntimeClient()
-	if err := rtClient.List(ctx, &list, &options); err != nil {
-		return err
-	}
+	target := syndesis.DeepCopy()
+	target.Status.Components = componentsStatus(components, syndesis.Status.Components, metav1.Now())
 
-	if len(list.Items) == 0 {
-		return errors.New("no deployment configs detected in the namespace")
+	if syndesisPhaseIs(syndesis, v1beta2.SyndesisPhaseInstalled) {
+		// Once installed, only keep the health of the components up to date
+		if reflect.DeepEqual(target.Status.Components, syndesis.Status.Components) {
+			return nil
+		}
+		return rtClient.Status().Update(ctx, target)
 	}
 
 	ready := true
+	found := false
 	var failedDeployment *string
-	for _, depl := range list.Items {
-		if depl.Spec.Replicas != depl.Status.ReadyReplicas {
-			a.log.V(synpkg.DEBUG_LOGGING_LVL).Info("Not ready", "desired", depl.Spec.Replicas, "actual", depl.Status.ReadyReplicas, "deployment", depl.Name)
+	for i, c := range components {
+		if !c.infrastructure {
+			continue
+		}
+		found = true
+		if !c.status.Ready {
+			a.log.V(synpkg.DEBUG_LOGGING_LVL).Info("Not ready", "desired", c.status.DesiredReplicas, "actual", c.status.ReadyReplicas, "component", c.name)
 			ready = false
 		}
-		if depl.Spec.Replicas != depl.Status.Replicas && depl.Status.Replicas == 0 && !isProcessing(&depl) {
-			failedDeployment = &depl.Name
+		if c.failed {
+			failedDeployment = &components[i].name
 		}
 	}
 
+	if !found {
+		return errors.New("no deployments or deployment configs detected in the namespace")
+	}
+
 	if ready {
-		target := syndesis.DeepCopy()
-		target.Status.Phase = v1beta2.SyndesisPhaseInstalled
-		target.Status.Reason = v1beta2.SyndesisStatusReasonMissing
-		target.Status.Description = ""
+		target.SetReady("")
 		a.log.Info("Syndesis resource installed successfully", "name", syndesis.Name)
+		a.event(syndesis, events.ReasonStarted, "All Syndesis components are ready")
 		return rtClient.Status().Update(ctx, target)
 	} else if failedDeployment != nil {
-		target := syndesis.DeepCopy()
-		target.Status.Phase = v1beta2.SyndesisPhaseStartupFailed
-		target.Status.Reason = v1beta2.SyndesisStatusReasonDeploymentNotReady
-		target.Status.Description = "Some Syndesis deployments failed to startup within the allowed time frame"
+		target.SetDegraded(v1beta2.SyndesisStatusReasonDeploymentNotReady, "Some Syndesis deployments failed to startup within the allowed time frame: "+*failedDeployment)
 		a.log.V(synpkg.DEBUG_LOGGING_LVL).Info("Startup failed for Syndesis resource. Deployment not ready", "name", syndesis.Name, "deployment", *failedDeployment)
+		if !syndesisPhaseIs(syndesis, v1beta2.SyndesisPhaseStartupFailed) {
+			a.warning(syndesis, events.ReasonResourceFailed, "Deployment %s failed to startup", *failedDeployment)
+		}
 		return rtClient.Status().Update(ctx, target)
 	} else {
-		target := syndesis.DeepCopy()
-		target.Status.Phase = v1beta2.SyndesisPhaseStarting
-		target.Status.Reason = v1beta2.SyndesisStatusReasonMissing
-		target.Status.Description = ""
+		target.SetProgressing(v1beta2.SyndesisPhaseStarting, v1beta2.SyndesisStatusReasonMissing, "")
 		a.log.V(synpkg.DEBUG_LOGGING_LVL).Info("Waiting for Syndesis resource to startup", "name", syndesis.Name)
 		return rtClient.Status().Update(ctx, target)
 	}
@@ -0,0 +1,219 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package action
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/backup"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/events"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/olm"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// Finalizer holding the deletion of a syndesis resource until its teardown is done
+const SyndesisFinalizer = "syndesis.io/finalizer"
+
+// Persistent volume claims kept by the RetainData deletion policy
+var retainedVolumeClaims = map[string]bool{
+	"syndesis-db":         true,
+	"syndesis-meta":       true,
+	"syndesis-prometheus": true,
+}
+
+var teardownLog = actionLog.WithValues("type", "teardown")
+
+// Returned by the teardown while the backup before deletion is running, the
+// teardown is to be retried later
+var ErrBackupInProgress = errors.New("backup before deletion in progress")
+
+// Backups before deletion run outside of the reconciliation, by uid of the
+// syndesis resource they back up
+var teardownBackups = struct {
+	sync.Mutex
+	running map[types.UID]*teardownBackup
+}{running: map[types.UID]*teardownBackup{}}
+
+type teardownBackup struct {
+	done chan struct{}
+	err  error
+}
+
+var runBackup = runBackupInBackground
+
+// Runs a backup for the syndesis resource in the background and reports its
+// outcome on the following teardown attempts
+func runBackupInBackground(clientTools *clienttools.ClientTools, syndesis *v1beta2.Syndesis) (*teardownBackup, error) {
+	// The backup outlives the reconciliation that started it
+	b, err := backup.NewBackup(context.Background(), clientTools, syndesis, backupDirOf(syndesis))
+	if err != nil {
+		return nil, err
+	}
+	b.SetDelete(true)
+	if !b.UploadEnabled() {
+		return nil, backup.ErrNoUploadDestination
+	}
+
+	tb := &teardownBackup{done: make(chan struct{})}
+	go func() {
+		defer close(tb.done)
+		tb.err = b.Run()
+	}()
+	return tb, nil
+}
+
+// Cleans up what garbage collection does not remove when the syndesis resource
+// is deleted, according to its deletion policy, then releases its finalizer.
+// Resources in the namespace are owned by the syndesis resource and are left to
+// the garbage collector, except for the retained volume claims.
+func Teardown(ctx context.Context, clientTools *clienttools.ClientTools, syndesis *v1beta2.Syndesis) error {
+	if !controllerutil.ContainsFinalizer(syndesis, SyndesisFinalizer) {
+		return nil
+	}
+
+	rtClient, _ := clientTools.RuntimeClient()
+	teardownLog.Info("tearing down syndesis resource", "name", syndesis.Name, "deletionPolicy", syndesis.Spec.DeletionPolicy)
+
+	switch syndesis.Spec.DeletionPolicy {
+	case v1beta2.DeletionPolicyBackupThenDelete:
+		// A failed backup holds the deletion, changing the policy to Delete releases it
+		if err := backupBeforeDeletion(clientTools, syndesis); err != nil {
+			return err
+		}
+	case v1beta2.DeletionPolicyRetainData:
+		if err := retainVolumeClaims(ctx, rtClient, syndesis); err != nil {
+			return err
+		}
+	}
+
+	if err := removeClusterRoleBindings(ctx, clientTools, syndesis); err != nil {
+		return err
+	}
+
+	if err := configuration.RemoveConsoleLink(ctx, rtClient, syndesis); err != nil {
+		return err
+	}
+
+	if err := olm.Unsubscribe(ctx, clientTools, syndesis); err != nil {
+		return err
+	}
+
+	target := syndesis.DeepCopy()
+	controllerutil.RemoveFinalizer(target, SyndesisFinalizer)
+	return rtClient.Update(ctx, target)
+}
+
+// Starts the backup before deletion, then reports ErrBackupInProgress until it
+// is done. A failed backup is started again on the next attempt.
+func backupBeforeDeletion(clientTools *clienttools.ClientTools, syndesis *v1beta2.Syndesis) error {
+	recorder := clientTools.EventRecorder()
+
+	teardownBackups.Lock()
+	defer teardownBackups.Unlock()
+
+	tb, ok := teardownBackups.running[syndesis.GetUID()]
+	if !ok {
+		started, err := runBackup(clientTools, syndesis)
+		if err != nil {
+			recorder.Eventf(syndesis, corev1.EventTypeWarning, events.ReasonBackupFailed, "Backup before deletion failed: %v", err)
+			return err
+		}
+		teardownBackups.running[syndesis.GetUID()] = started
+		recorder.Event(syndesis, corev1.EventTypeNormal, events.ReasonBackupStarted, "Backup before deletion started")
+		return ErrBackupInProgress
+	}
+
+	select {
+	case <-tb.done:
+	default:
+		return ErrBackupInProgress
+	}
+
+	delete(teardownBackups.running, syndesis.GetUID())
+	if tb.err != nil {
+		recorder.Eventf(syndesis, corev1.EventTypeWarning, events.ReasonBackupFailed, "Backup before deletion failed: %v", tb.err)
+		return tb.err
+	}
+	recorder.Event(syndesis, corev1.EventTypeNormal, events.ReasonBackupSucceeded, "Backup before deletion succeeded")
+	return nil
+}
+
+// Detaches the data volume claims from the syndesis resource so that they
+// are not garbage collected along with it
+func retainVolumeClaims(ctx context.Context, rtClient client.Client, syndesis *v1beta2.Syndesis) error {
+	selector, err := labels.Parse("owner=" + string(syndesis.GetUID()))
+	if err != nil {
+		return err
+	}
+
+	pvcs := corev1.PersistentVolumeClaimList{}
+	if err := rtClient.List(ctx, &pvcs, &client.ListOptions{Namespace: syndesis.Namespace, LabelSelector: selector}); err != nil {
+		return err
+	}
+
+	for _, pvc := range pvcs.Items {
+		if !retainedVolumeClaims[pvc.Name] {
+			continue
+		}
+
+		pvc.SetOwnerReferences(nil)
+		delete(pvc.Labels, "owner")
+		if err := rtClient.Update(ctx, &pvc); err != nil {
+			return err
+		}
+		teardownLog.Info("persistent volume claim retained", "name", pvc.Name)
+	}
+
+	return nil
+}
+
+// Cluster role bindings cannot be owned by a namespaced resource so are never
+// garbage collected, they are found through their owner label instead
+func removeClusterRoleBindings(ctx context.Context, clientTools *clienttools.ClientTools, syndesis *v1beta2.Syndesis) error {
+	api, err := clientTools.ApiClient()
+	if err != nil {
+		return err
+	}
+
+	crbs, err := api.RbacV1().ClusterRoleBindings().List(ctx, metav1.ListOptions{
+		LabelSelector: "owner=" + string(syndesis.GetUID()),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, crb := range crbs.Items {
+		err := api.RbacV1().ClusterRoleBindings().Delete(ctx, crb.Name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+		teardownLog.Info("cluster role binding deleted", "name", crb.Name)
+	}
+
+	return nil
+}
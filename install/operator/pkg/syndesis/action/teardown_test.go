@@ -0,0 +1,215 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package action
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	consolev1 "github.com/openshift/api/console/v1"
+	olmapiv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/backup"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
+	syntesting "github.com/syndesisio/syndesis/install/operator/pkg/syndesis/testing"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gofake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	rtfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_Teardown(t *testing.T) {
+	deleted := metav1.Now()
+	owner := map[string]string{"owner": "1234"}
+	ownerRefs := []metav1.OwnerReference{{Name: "app", UID: "1234"}}
+
+	newPvc := func(name string) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "syndesis", Labels: owner, OwnerReferences: ownerRefs},
+		}
+	}
+	ownedCrb := &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "syndesis-server-syndesis-kafka", Labels: owner}}
+	otherCrb := &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "cluster-admin"}}
+	ownedSub := &olmapiv1alpha1.Subscription{ObjectMeta: metav1.ObjectMeta{Name: "jaeger-product", Namespace: "openshift-operators", Labels: owner}}
+	otherSub := &olmapiv1alpha1.Subscription{ObjectMeta: metav1.ObjectMeta{Name: "camel-k", Namespace: "openshift-operators"}}
+
+	testCases := []struct {
+		name     string
+		policy   v1beta2.DeletionPolicy
+		retained bool
+	}{
+		{"Delete", v1beta2.DeletionPolicyDelete, false},
+		{"Default", "", false},
+		{"RetainData", v1beta2.DeletionPolicyRetainData, true},
+	}
+
+	s := scheme.Scheme
+	require.NoError(t, apis.AddToScheme(s))
+	require.NoError(t, consolev1.Install(s))
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			syndesis := &v1beta2.Syndesis{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "app",
+					Namespace:         "syndesis",
+					UID:               "1234",
+					DeletionTimestamp: &deleted,
+					Finalizers:        []string{SyndesisFinalizer},
+				},
+				Spec: v1beta2.SyndesisSpec{DeletionPolicy: tc.policy},
+			}
+			link := &consolev1.ConsoleLink{ObjectMeta: metav1.ObjectMeta{Name: "app-syndesis"}}
+
+			clientTools := &clienttools.ClientTools{}
+			clientTools.SetRuntimeClient(rtfake.NewFakeClientWithScheme(s, syndesis, link, newPvc("syndesis-db"), newPvc("syndesis-other")))
+			clientTools.SetApiClient(gofake.NewSimpleClientset(ownedCrb, otherCrb))
+			clientTools.SetOlmClient(syntesting.OlmClient(ownedSub, otherSub))
+
+			require.NoError(t, Teardown(context.TODO(), clientTools, syndesis))
+
+			rtClient, _ := clientTools.RuntimeClient()
+			result := &v1beta2.Syndesis{}
+			require.NoError(t, rtClient.Get(context.TODO(), types.NamespacedName{Namespace: "syndesis", Name: "app"}, result))
+			assert.NotContains(t, result.Finalizers, SyndesisFinalizer)
+
+			assert.Error(t, rtClient.Get(context.TODO(), types.NamespacedName{Name: "app-syndesis"}, &consolev1.ConsoleLink{}))
+
+			db := &corev1.PersistentVolumeClaim{}
+			require.NoError(t, rtClient.Get(context.TODO(), types.NamespacedName{Namespace: "syndesis", Name: "syndesis-db"}, db))
+			assert.Equal(t, tc.retained, len(db.OwnerReferences) == 0)
+			other := &corev1.PersistentVolumeClaim{}
+			require.NoError(t, rtClient.Get(context.TODO(), types.NamespacedName{Namespace: "syndesis", Name: "syndesis-other"}, other))
+			assert.Len(t, other.OwnerReferences, 1)
+
+			api, _ := clientTools.ApiClient()
+			crbs, err := api.RbacV1().ClusterRoleBindings().List(context.TODO(), metav1.ListOptions{})
+			require.NoError(t, err)
+			require.Len(t, crbs.Items, 1)
+			assert.Equal(t, "cluster-admin", crbs.Items[0].Name)
+
+			olmClient, _ := clientTools.OlmClient()
+			subs, err := olmClient.OperatorsV1alpha1().Subscriptions("").List(context.TODO(), metav1.ListOptions{})
+			require.NoError(t, err)
+			require.Len(t, subs.Items, 1)
+			assert.Equal(t, "camel-k", subs.Items[0].Name)
+		})
+	}
+}
+
+func Test_Teardown_BackupThenDelete(t *testing.T) {
+	deleted := metav1.Now()
+
+	testCases := []struct {
+		name      string
+		backupErr error
+		events    []string
+	}{
+		{"Succeeded", nil, []string{"Normal BackupStarted", "Normal BackupSucceeded"}},
+		{"Failed", assert.AnError, []string{"Normal BackupStarted", "Warning BackupFailed"}},
+	}
+
+	s := scheme.Scheme
+	require.NoError(t, apis.AddToScheme(s))
+	require.NoError(t, consolev1.Install(s))
+
+	newClientTools := func(syndesis *v1beta2.Syndesis) (*clienttools.ClientTools, *record.FakeRecorder) {
+		recorder := record.NewFakeRecorder(10)
+		clientTools := &clienttools.ClientTools{}
+		clientTools.SetRuntimeClient(rtfake.NewFakeClientWithScheme(s, syndesis))
+		clientTools.SetApiClient(gofake.NewSimpleClientset())
+		clientTools.SetOlmClient(syntesting.OlmClient())
+		clientTools.SetEventRecorder(recorder)
+		return clientTools, recorder
+	}
+	newSyndesis := func() *v1beta2.Syndesis {
+		return &v1beta2.Syndesis{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "app",
+				Namespace:         "syndesis",
+				UID:               "1234",
+				DeletionTimestamp: &deleted,
+				Finalizers:        []string{SyndesisFinalizer},
+			},
+			Spec: v1beta2.SyndesisSpec{DeletionPolicy: v1beta2.DeletionPolicyBackupThenDelete},
+		}
+	}
+	finalizers := func(clientTools *clienttools.ClientTools) []string {
+		rtClient, _ := clientTools.RuntimeClient()
+		result := &v1beta2.Syndesis{}
+		require.NoError(t, rtClient.Get(context.TODO(), types.NamespacedName{Namespace: "syndesis", Name: "app"}, result))
+		return result.Finalizers
+	}
+	reasons := func(recorder *record.FakeRecorder) []string {
+		var r []string
+		for len(recorder.Events) > 0 {
+			e := strings.Fields(<-recorder.Events)
+			r = append(r, e[0]+" "+e[1])
+		}
+		return r
+	}
+
+	t.Run("No upload destination", func(t *testing.T) {
+		syndesis := newSyndesis()
+		clientTools, recorder := newClientTools(syndesis)
+
+		// Without the s3 secret the backup would only be kept locally, then deleted
+		assert.Equal(t, backup.ErrNoUploadDestination, Teardown(context.TODO(), clientTools, syndesis))
+		assert.Contains(t, finalizers(clientTools), SyndesisFinalizer)
+		assert.Equal(t, []string{"Warning BackupFailed"}, reasons(recorder))
+	})
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tb := &teardownBackup{done: make(chan struct{})}
+			started := 0
+			runBackup = func(*clienttools.ClientTools, *v1beta2.Syndesis) (*teardownBackup, error) {
+				started++
+				return tb, nil
+			}
+			defer func() { runBackup = runBackupInBackground }()
+
+			syndesis := newSyndesis()
+			clientTools, recorder := newClientTools(syndesis)
+
+			assert.Equal(t, ErrBackupInProgress, Teardown(context.TODO(), clientTools, syndesis))
+			assert.Equal(t, ErrBackupInProgress, Teardown(context.TODO(), clientTools, syndesis))
+			assert.Contains(t, finalizers(clientTools), SyndesisFinalizer)
+
+			tb.err = tc.backupErr
+			close(tb.done)
+			err := Teardown(context.TODO(), clientTools, syndesis)
+			assert.Equal(t, tc.backupErr, err)
+			assert.Equal(t, 1, started)
+			if tc.backupErr == nil {
+				assert.NotContains(t, finalizers(clientTools), SyndesisFinalizer)
+			} else {
+				assert.Contains(t, finalizers(clientTools), SyndesisFinalizer)
+			}
+			assert.Equal(t, tc.events, reasons(recorder))
+			assert.NotContains(t, teardownBackups.running, syndesis.GetUID())
+		})
+	}
+}
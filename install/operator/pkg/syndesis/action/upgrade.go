@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/events"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/upgrade"
 
 	"github.com/syndesisio/syndesis/install/operator/pkg"
@@ -14,8 +15,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 )
 
-var u upgrade.Upgrader
-
 const (
 	UpgradePodPrefix = "syndesis-upgrade-"
 )
@@ -24,12 +23,15 @@ const (
 type upgradeAction struct {
 	baseAction
 	operatorVersion string
+	// The upgrade in progress, kept through the whole upgrade / rollback process
+	upgrader upgrade.Upgrader
 }
 
 func newUpgradeAction(mgr manager.Manager, clientTools *clienttools.ClientTools) SyndesisOperatorAction {
 	return &upgradeAction{
 		newBaseAction(mgr, clientTools, "upgrade"),
 		"",
+		nil,
 	}
 }
 
@@ -50,16 +52,16 @@ func (a *upgradeAction) Execute(ctx context.Context, syndesis *v1beta2.Syndesis)
 
 		// Initialize the upgrade object, this happens only once, this object lives throughout
 		// the whole upgrade / rollback process
-		if u == nil {
-			var err error
-			u, err = upgrade.Build(ctx, a.log, syndesis, a.clientTools)
+		if a.upgrader == nil {
+			u, err := upgrade.Build(ctx, a.log, syndesis, a.clientTools)
 			if err != nil {
 				return err
 			}
+			a.upgrader = u
 		}
 
 		a.log.Info("Upgrading syndesis resource ", "name", syndesis.Name, "current version", syndesis.Status.Version, "target version", targetVersion)
-		err := u.Upgrade()
+		err := a.upgrader.Upgrade()
 		if err == nil {
 			// If upgrade finished correctly, we go to the post upgrade run, meaning we want to do an install
 			// run and make sure it succeed
@@ -67,29 +69,46 @@ func (a *upgradeAction) Execute(ctx context.Context, syndesis *v1beta2.Syndesis)
 			return a.setPhaseToRun(ctx, syndesis)
 		} else {
 			a.log.Error(err, "Failure while upgrading Syndesis", "name", syndesis.Name, "target version", targetVersion)
-			if err := u.Rollback(); err != nil {
+			a.warning(syndesis, events.ReasonUpgradeFailed, "Upgrade to %s failed: %v", targetVersion, err)
+			if err := a.upgrader.Rollback(); err != nil {
 				a.log.Error(err, "Failure while rolling back Syndesis, some manual steps might be required", "name", syndesis.Name, "target version", targetVersion)
+				a.warning(syndesis, events.ReasonUpgradeRollbackFailed, "Rollback of the upgrade to %s failed, some manual steps might be required: %v", targetVersion, err)
+			} else {
+				a.event(syndesis, events.ReasonUpgradeRolledBack, "Rolled back the upgrade to %s", targetVersion)
 			}
 			return a.setPhaseToFailureBackoff(ctx, syndesis, targetVersion)
 		}
 	} else if syndesis.Status.Phase == v1beta2.SyndesisPhasePostUpgradeRunSucceed {
 		// We land here only if the install phase after upgrading finished correctly
 		a.log.Info("syndesis resource post upgrade ran successfully", "name", syndesis.Name, "previous version", syndesis.Status.Version, "target version", targetVersion)
-		return a.completeUpgrade(ctx, syndesis, targetVersion)
+		if err := a.completeUpgrade(ctx, syndesis, targetVersion); err != nil {
+			return err
+		}
+		// Done with this upgrade, the next one starts afresh
+		a.upgrader = nil
+		return nil
 	} else if syndesis.Status.Phase == v1beta2.SyndesisPhasePostUpgradeRun {
 		// If the first run of the install action failed, we land here. We need to retry
 		// this few times to consider the cases where install action return error due to
 		// race conditions or when the syndesis custom resource was changed in the meantime. 3 times seems
 		// to be enough
 		a.log.Info("failure while running post upgrade run", "name", syndesis.Name, "target version", targetVersion)
-		if u.InstallFailed() < 4 {
+		if a.upgrader == nil {
+			// The operator restarted during the upgrade, the steps to roll back are lost
+			a.log.Info("no upgrade in progress to roll back, retrying the post upgrade run", "name", syndesis.Name)
+			return nil
+		}
+		if a.upgrader.InstallFailed() < 4 {
 			a.log.Info("attempting again to run post upgrade", "name", syndesis.Name)
 		} else {
 			a.log.Info("syndesis first run after upgrade failed repeatedly, attempting to rollback now")
-			if err := u.Rollback(); err != nil {
+			a.warning(syndesis, events.ReasonUpgradeFailed, "Install run after the upgrade to %s failed repeatedly", targetVersion)
+			if err := a.upgrader.Rollback(); err != nil {
 				a.log.Error(err, "failure while rolling back Syndesis, some manual steps might be required", "name", syndesis.Name, "target version", targetVersion)
+				a.warning(syndesis, events.ReasonUpgradeRollbackFailed, "Rollback of the upgrade to %s failed, some manual steps might be required: %v", targetVersion, err)
 			} else {
 				a.log.Info("syndesis successfully rolled back", "name", syndesis.Name)
+				a.event(syndesis, events.ReasonUpgradeRolledBack, "Rolled back the upgrade to %s", targetVersion)
 			}
 			return a.setPhaseToFailureBackoff(ctx, syndesis, targetVersion)
 		}
@@ -105,10 +124,8 @@ func (a *upgradeAction) Execute(ctx context.Context, syndesis *v1beta2.Syndesis)
  */
 func (a *upgradeAction) completeUpgrade(ctx context.Context, syndesis *v1beta2.Syndesis, newVersion string) (err error) {
 	target := syndesis.DeepCopy()
-	target.Status.Phase = v1beta2.SyndesisPhaseInstalled
+	target.SetReady("")
 	target.Status.TargetVersion = ""
-	target.Status.Reason = v1beta2.SyndesisStatusReasonMissing
-	target.Status.Description = ""
 	target.Status.Version = newVersion
 	target.Status.LastUpgradeFailure = nil
 	target.Status.UpgradeAttempts = 0
@@ -116,15 +133,16 @@ func (a *upgradeAction) completeUpgrade(ctx context.Context, syndesis *v1beta2.S
 
 	rtClient, _ := a.clientTools.RuntimeClient()
 	err = rtClient.Status().Update(ctx, target)
+	if err == nil {
+		a.event(syndesis, events.ReasonUpgradeSucceeded, "Upgraded from %s to %s", syndesis.Status.Version, newVersion)
+	}
 	time.Sleep(3 * time.Second)
 	return
 }
 
 func (a *upgradeAction) setPhaseToRun(ctx context.Context, syndesis *v1beta2.Syndesis) (err error) {
 	target := syndesis.DeepCopy()
-	target.Status.Phase = v1beta2.SyndesisPhasePostUpgradeRun
-	target.Status.Reason = v1beta2.SyndesisStatusReasonPostUpgradeRun
-	target.Status.Description = "Perform the first install run after syndesis resource was upgraded"
+	target.SetProgressing(v1beta2.SyndesisPhasePostUpgradeRun, v1beta2.SyndesisStatusReasonPostUpgradeRun, "Perform the first install run after syndesis resource was upgraded")
 
 	rtClient, _ := a.clientTools.RuntimeClient()
 	err = rtClient.Status().Update(ctx, target)
@@ -134,9 +152,7 @@ func (a *upgradeAction) setPhaseToRun(ctx context.Context, syndesis *v1beta2.Syn
 
 func (a *upgradeAction) setPhaseToFailureBackoff(ctx context.Context, syndesis *v1beta2.Syndesis, targetVersion string) (err error) {
 	target := syndesis.DeepCopy()
-	target.Status.Phase = v1beta2.SyndesisPhaseUpgradeFailureBackoff
-	target.Status.Reason = v1beta2.SyndesisStatusReasonUpgradeFailed
-	target.Status.Description = "Syndesis upgrade from " + syndesis.Status.Version + " to " + targetVersion + " failed (it will be retried again)"
+	target.SetDegraded(v1beta2.SyndesisStatusReasonUpgradeFailed, "Syndesis upgrade from "+syndesis.Status.Version+" to "+targetVersion+" failed (it will be retried again)")
 	target.Status.LastUpgradeFailure = &metav1.Time{
 		Time: time.Now(),
 	}
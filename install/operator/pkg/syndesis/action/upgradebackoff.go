@@ -8,6 +8,7 @@ import (
 
 	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/maintenance"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 )
 
@@ -41,9 +42,7 @@ func (a *upgradeBackoffAction) Execute(ctx context.Context, syndesis *v1beta2.Sy
 		a.log.Error(nil, "Upgrade of Syndesis resource failed too many times and will not be retried", "name", syndesis.Name)
 
 		target := syndesis.DeepCopy()
-		target.Status.Phase = v1beta2.SyndesisPhaseUpgradeFailed
-		target.Status.Reason = v1beta2.SyndesisStatusReasonTooManyUpgradeAttempts
-		target.Status.Description = "Upgrade failed too many times and will not be retried"
+		target.SetDegraded(v1beta2.SyndesisStatusReasonTooManyUpgradeAttempts, "Upgrade failed too many times and will not be retried")
 		target.Status.ForceUpgrade = false
 
 		return rtClient.Status().Update(ctx, target)
@@ -72,6 +71,11 @@ func (a *upgradeBackoffAction) Execute(ctx context.Context, syndesis *v1beta2.Sy
 
 	nextAttempt := lastFailure.Add(delay)
 
+	if open, err := maintenance.InWindow(syndesis.Spec.MaintenanceWindows, now); err != nil || !open {
+		a.log.Info("Upgrade of Syndesis resource will be retried in the next maintenance window", "name", syndesis.Name)
+		return err
+	}
+
 	if now.After(nextAttempt) {
 		a.log.Info("Restarting upgrade process for Syndesis resource", "name", syndesis.Name)
 
@@ -80,9 +84,7 @@ func (a *upgradeBackoffAction) Execute(ctx context.Context, syndesis *v1beta2.Sy
 		currentAttemptStr := strconv.Itoa(int(syndesis.Status.UpgradeAttempts + 1))
 
 		target := syndesis.DeepCopy()
-		target.Status.Phase = v1beta2.SyndesisPhaseUpgrading
-		target.Status.Reason = v1beta2.SyndesisStatusReasonMissing
-		target.Status.Description = "Upgrading from " + currentVersion + " to " + targetVersion + " (attempt " + currentAttemptStr + ")"
+		target.SetProgressing(v1beta2.SyndesisPhaseUpgrading, v1beta2.SyndesisStatusReasonMissing, "Upgrading from "+currentVersion+" to "+targetVersion+" (attempt "+currentAttemptStr+")")
 		target.Status.ForceUpgrade = true
 
 		return rtClient.Status().Update(ctx, target)
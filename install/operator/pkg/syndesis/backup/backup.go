@@ -53,6 +53,9 @@ import (
 
 var backupLog = logf.Log.WithName("backup")
 
+// Returned when a backup that is deleted locally has nowhere to be uploaded to
+var ErrNoUploadDestination = errors.New("no upload destination configured, create the " + secret + " secret")
+
 const (
 	pollTimeout  = 600 * time.Second
 	pollInterval = 5 * time.Second
@@ -212,23 +215,45 @@ func (b *Backup) Run() (err error) {
 	}
 
 	if !b.localOnly {
-		uploader := []Uploader{&S3{Backup: b, file: zipped}}
-
-		for _, u := range uploader {
+		uploaded := false
+		for _, u := range b.uploaders(zipped) {
 			if u.Enabled() {
 				if err = u.Upload(b.backupDir); err != nil {
 					b.log.Error(err, "error uploading backup file to source", "source", u)
 					return
 				}
+				uploaded = true
 				break
 			}
 		}
+
+		// The local copy is about to be removed, the backup would be lost
+		if !uploaded && b.delete {
+			err = ErrNoUploadDestination
+			b.log.Error(err, "backup not kept")
+			return
+		}
 	}
 
 	b.log.Info("backup for syndesis done")
 	return
 }
 
+// Can the backup be uploaded to a remote datastore with current settings
+func (b *Backup) UploadEnabled() bool {
+	for _, u := range b.uploaders("") {
+		if u.Enabled() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (b *Backup) uploaders(file string) []Uploader {
+	return []Uploader{&S3{Backup: b, file: file}}
+}
+
 // Restore backup from a zipped file or from a backup dir
 // Restore database and openshift resources
 func (b *Backup) Restore() (err error) {
@@ -313,6 +338,7 @@ func (b *Backup) backupResources() error {
 		{APIVersion: "image.openshift.io/v1", Kind: "ImageStream"},
 		{APIVersion: "build.openshift.io/v1", Kind: "BuildConfig"},
 		{APIVersion: "apps.openshift.io/v1", Kind: "DeploymentConfig"},
+		{APIVersion: "apps/v1", Kind: "Deployment"},
 		{APIVersion: "route.openshift.io/v1", Kind: "Route"},
 	}
 
@@ -417,8 +443,11 @@ func (b *Backup) RestoreResources() (err error) {
 	}
 
 	for _, res := range resources {
-		res.SetResourceVersion("")
-		_, _, err := util.CreateOrUpdate(b.context, client, &res)
+		// The backed up resource is applied as a new configuration
+		for _, field := range []string{"resourceVersion", "uid", "selfLink", "managedFields"} {
+			unstructured.RemoveNestedField(res.Object, "metadata", field)
+		}
+		_, _, _, err := util.Apply(b.context, client, &res)
 		if err != nil {
 			b.log.Error(nil, "error while restoring resources", "resources", res.GetName(), "kind", res.GetKind())
 			return err
@@ -552,7 +581,13 @@ func (b *Backup) backupDatabase() error {
 		// and jobs without a namespace require cluster-level privileges.
 		//
 		operation.SetNamespaceAndOwnerReference(res, b.syndesis)
-		_, _, err := util.CreateOrUpdate(b.context, client, &res)
+		if err := operation.SetJobScheduling(res, b.syndesis); err != nil {
+			return err
+		}
+		if err := operation.SetRestrictedPodSecurity(res.UnstructuredContent(), "spec", "template", "spec"); err != nil {
+			return err
+		}
+		_, _, _, err := util.Apply(b.context, client, &res)
 		if err != nil {
 			return err
 		}
@@ -640,7 +675,13 @@ func (b *Backup) RestoreDb() (err error) {
 		// complete with Name, UID & Kind.
 		//
 		operation.SetNamespaceAndOwnerReference(res, b.syndesis)
-		_, _, err := util.CreateOrUpdate(b.context, client, &res)
+		if err := operation.SetJobScheduling(res, b.syndesis); err != nil {
+			return err
+		}
+		if err := operation.SetRestrictedPodSecurity(res.UnstructuredContent(), "spec", "template", "spec"); err != nil {
+			return err
+		}
+		_, _, _, err := util.Apply(b.context, client, &res)
 		if err != nil {
 			return err
 		}
@@ -25,12 +25,15 @@ import (
 )
 
 type ApiServerSpec struct {
-	Version          string // Set to the kubernetes version of the API Server
-	ImageStreams     bool   // Set to true if the API Server supports imagestreams
-	Routes           bool   // Set to true if the API Server supports routes
-	EmbeddedProvider bool   // Set to true if the API Server support an embedded authenticaion provider, eg. openshift
-	OlmSupport       bool   // Set to true if the API Server supports an Operation-Lifecyle-Manager
-	ConsoleLink      bool   // Set to true if the API Server support the openshift console link API
+	Version                string // Set to the kubernetes version of the API Server
+	ImageStreams           bool   // Set to true if the API Server supports imagestreams
+	Routes                 bool   // Set to true if the API Server supports routes
+	EmbeddedProvider       bool   // Set to true if the API Server support an embedded authenticaion provider, eg. openshift
+	OlmSupport             bool   // Set to true if the API Server supports an Operation-Lifecyle-Manager
+	ConsoleLink            bool   // Set to true if the API Server support the openshift console link API
+	DeploymentConfigs      bool   // Set to true if the API Server supports openshift deployment configs
+	PodDisruptionBudgetsV1 bool   // Set to true if the API Server supports the policy/v1 pod disruption budgets
+	AutoscalersV2          bool   // Set to true if the API Server supports the autoscaling/v2 horizontal pod autoscalers
 }
 
 type RequiredApiSpec struct {
@@ -39,6 +42,9 @@ type RequiredApiSpec struct {
 	oauthclientauthorizations string
 	packagemanifests          string
 	consolelinks              string
+	deploymentconfigs         string
+	poddisruptionbudgets      string
+	horizontalpodautoscalers  string
 }
 
 var RequiredApi = RequiredApiSpec{
@@ -47,6 +53,9 @@ var RequiredApi = RequiredApiSpec{
 	oauthclientauthorizations: "oauthclientauthorizations.oauth.openshift.io/v1",
 	packagemanifests:          "packagemanifests.packages.operators.coreos.com/v1",
 	consolelinks:              "consolelinks.console.openshift.io/v1",
+	deploymentconfigs:         "deploymentconfigs.apps.openshift.io/v1",
+	poddisruptionbudgets:      "poddisruptionbudgets.policy/v1",
+	horizontalpodautoscalers:  "horizontalpodautoscalers.autoscaling/v2",
 }
 
 func contains(a []string, x string) bool {
@@ -102,6 +111,9 @@ func ApiCapabilities(clientTools *clienttools.ClientTools) (*ApiServerSpec, erro
 	apiSpec.EmbeddedProvider = contains(resIndex, RequiredApi.oauthclientauthorizations)
 	apiSpec.OlmSupport = contains(resIndex, RequiredApi.packagemanifests)
 	apiSpec.ConsoleLink = contains(resIndex, RequiredApi.consolelinks)
+	apiSpec.DeploymentConfigs = contains(resIndex, RequiredApi.deploymentconfigs)
+	apiSpec.PodDisruptionBudgetsV1 = contains(resIndex, RequiredApi.poddisruptionbudgets)
+	apiSpec.AutoscalersV2 = contains(resIndex, RequiredApi.horizontalpodautoscalers)
 
 	return &apiSpec, nil
 }
@@ -48,6 +48,40 @@ func Test_ApiCapabilities(t *testing.T) {
 			{Name: "oauthclientauthorizations"},
 		},
 	}
+	res7 := metav1.APIResourceList{
+		GroupVersion: "apps.openshift.io/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "deploymentconfigs"},
+		},
+	}
+
+	res8 := metav1.APIResourceList{
+		GroupVersion: "policy/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "poddisruptionbudgets"},
+		},
+	}
+
+	res9 := metav1.APIResourceList{
+		GroupVersion: "autoscaling/v2",
+		APIResources: []metav1.APIResource{
+			{Name: "horizontalpodautoscalers"},
+		},
+	}
+
+	res10 := metav1.APIResourceList{
+		GroupVersion: "policy/v1beta1",
+		APIResources: []metav1.APIResource{
+			{Name: "poddisruptionbudgets"},
+		},
+	}
+
+	res11 := metav1.APIResourceList{
+		GroupVersion: "autoscaling/v2beta2",
+		APIResources: []metav1.APIResource{
+			{Name: "horizontalpodautoscalers"},
+		},
+	}
 
 	res4 := metav1.APIResourceList{
 		GroupVersion: "something.openshift.io/v1",
@@ -66,12 +100,31 @@ func Test_ApiCapabilities(t *testing.T) {
 	}{
 		{
 			"Relevant APIs available for fully true api spec",
-			[]*metav1.APIResourceList{&res1, &res2, &res3},
+			[]*metav1.APIResourceList{&res1, &res2, &res3, &res7, &res8, &res9},
 			ApiServerSpec{
-				Version:          "1.16",
-				Routes:           true,
-				ImageStreams:     true,
-				EmbeddedProvider: true,
+				Version:                "1.16",
+				Routes:                 true,
+				ImageStreams:           true,
+				EmbeddedProvider:       true,
+				DeploymentConfigs:      true,
+				PodDisruptionBudgetsV1: true,
+				AutoscalersV2:          true,
+			},
+		},
+		{
+			"Only older pod disruption budgets so expect false",
+			[]*metav1.APIResourceList{&res10},
+			ApiServerSpec{
+				Version:                "1.16",
+				PodDisruptionBudgetsV1: false,
+			},
+		},
+		{
+			"Only older horizontal pod autoscalers so expect false",
+			[]*metav1.APIResourceList{&res11},
+			ApiServerSpec{
+				Version:       "1.16",
+				AutoscalersV2: false,
 			},
 		},
 		{
@@ -132,6 +185,18 @@ func Test_ApiCapabilities(t *testing.T) {
 			if apiSpec.EmbeddedProvider != tc.expected.EmbeddedProvider {
 				t.Error("Expected api specification embedded provider not returned")
 			}
+
+			if apiSpec.DeploymentConfigs != tc.expected.DeploymentConfigs {
+				t.Error("Expected api specification deployment configs not returned")
+			}
+
+			if apiSpec.PodDisruptionBudgetsV1 != tc.expected.PodDisruptionBudgetsV1 {
+				t.Error("Expected api specification policy/v1 pod disruption budgets not returned")
+			}
+
+			if apiSpec.AutoscalersV2 != tc.expected.AutoscalersV2 {
+				t.Error("Expected api specification autoscaling/v2 horizontal pod autoscalers not returned")
+			}
 		})
 	}
 }
@@ -31,6 +31,7 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 )
@@ -43,6 +44,7 @@ type ClientTools struct {
 	apiClient     kubernetes.Interface
 	coreV1Client  corev1.CoreV1Interface
 	olmClient     olmcli.Interface
+	eventRecorder record.EventRecorder
 }
 
 func (ck *ClientTools) RestConfig() (c *rest.Config) {
@@ -166,3 +168,16 @@ func (ck *ClientTools) OlmClient() (olmcli.Interface, error) {
 func (ck *ClientTools) SetOlmClient(c olmcli.Interface) {
 	ck.olmClient = c
 }
+
+// EventRecorder publishes events on the syndesis resource. It drops them
+// unless one has been set, ie. outside of the operator
+func (ck *ClientTools) EventRecorder() record.EventRecorder {
+	if ck.eventRecorder == nil {
+		return &record.FakeRecorder{}
+	}
+	return ck.eventRecorder
+}
+
+func (ck *ClientTools) SetEventRecorder(r record.EventRecorder) {
+	ck.eventRecorder = r
+}
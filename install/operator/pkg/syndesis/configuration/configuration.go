@@ -25,8 +25,10 @@ import (
 	"net/url"
 	"os"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	consolev1 "github.com/openshift/api/console/v1"
@@ -50,8 +52,18 @@ import (
 	"github.com/syndesisio/syndesis/install/operator/pkg/util"
 )
 
+const (
+	// Label of the ConfigMaps OpenShift injects the trusted CA bundle of the cluster into
+	InjectTrustedCABundleLabel = "config.openshift.io/inject-trusted-cabundle"
+	// Key of the injected trusted CA bundle
+	InjectedTrustedCABundleKey = "ca-bundle.crt"
+)
+
 var random = rand.New(rand.NewSource(time.Now().UnixNano()))
 
+// Guards random, which is not safe for use by concurrent reconciliations
+var randomLock sync.Mutex
+
 // Location from where the template configuration is located
 var TemplateConfig string
 
@@ -62,6 +74,8 @@ type Config struct {
 	Productized                bool
 	Version                    string                     // Syndesis version
 	DevSupport                 bool                       // If set to true, pull docker images from imagetag instead of upstream source
+	Deployments                bool                       // If set to true, render apps/v1 Deployments instead of OpenShift DeploymentConfigs
+	Kubernetes                 bool                       // If set to true, render the Kubernetes profile: an ingress, docker images and an OIDC proxy
 	Scheduled                  bool                       // Legacy parameter to set scheduled:true in the imagestreams, but we dont use many imagestreams nowadays
 	ProductName                string                     // Usually syndesis or fuse-online
 	PrometheusRules            string                     // If some extra rules for prometheus need to be specified, they are defined here
@@ -76,11 +90,15 @@ type Config struct {
 }
 
 type SyndesisConfig struct {
-	DemoData      bool           // Enables starting up with demo data
-	SHA           bool           // Whether we use SHA reference for docker images. If false, tag are used instead
-	RouteHostname string         // The external hostname to access Syndesis
-	Components    ComponentsSpec // Server, Meta, Ui, Name specifications and configurations
-	Addons        AddonsSpec     // Addons specifications and configurations
+	DemoData         bool                          // Enables starting up with demo data
+	SHA              bool                          // Whether we use SHA reference for docker images. If false, tag are used instead
+	RouteHostname    string                        // The external hostname to access Syndesis
+	Ingress          IngressConfiguration          // Ingress exposing Syndesis in the Kubernetes profile
+	HighAvailability HighAvailabilityConfiguration // Replicas and spreading of the components in high-availability mode
+	NetworkPolicies  NetworkPoliciesConfiguration  // Network policies restricting the traffic to the components and addons
+	TrustedCABundle  TrustedCABundleConfiguration  // Additional certificate authorities trusted by the Java components and integrations
+	Components       ComponentsSpec                // Server, Meta, Ui, Name specifications and configurations
+	Addons           AddonsSpec                    // Addons specifications and configurations
 }
 
 // Components
@@ -101,12 +119,35 @@ type AMQConfiguration struct {
 	Image string // Docker image for AMQ
 }
 
+type HighAvailabilityConfiguration struct {
+	Enabled     bool   // Runs the ui, oauth-proxy and, with a ReadWriteMany volume, meta with multiple replicas
+	Replicas    int32  // Number of replicas of the components
+	TopologyKey string // Topology key the replicas are spread across, besides the nodes
+}
+
+type NetworkPoliciesConfiguration struct {
+	Enabled bool // Renders the network policies of the components and addons enabled
+}
+
+type TrustedCABundleConfiguration struct {
+	ConfigMapName string // ConfigMap holding the PEM encoded certificates, none if empty
+	Key           string // Key of the certificates in the ConfigMap
+}
+
+type IngressConfiguration struct {
+	ClassName string // Class of the ingress controller, the default one if empty
+	TLSSecret string // Secret holding the TLS certificate of the route hostname
+}
+
 type OauthConfiguration struct {
-	Image           string // Docker image for proxy
-	CookieSecret    string // Secret to use to encrypt oauth cookies
-	DisableSarCheck bool   // Enable or disable SAR checks all together
-	SarNamespace    string // The user needs to have permissions to at least get a list of pods in the given project in order to be granted access to the Syndesis installation
-	Environment     map[string]string // Environment variables to be applied to dc/syndesis-oauthproxy
+	Image             string            // Docker image for proxy
+	OidcImage         string            // Docker image for proxy authenticating against an OIDC issuer, in the Kubernetes profile
+	OidcIssuerURL     string            // OpenID Connect issuer authenticating the users, in the Kubernetes profile
+	CredentialsSecret string            // Secret holding the client id and secret of the OIDC issuer
+	CookieSecret      string            // Secret to use to encrypt oauth cookies
+	DisableSarCheck   bool              // Enable or disable SAR checks all together
+	SarNamespace      string            // The user needs to have permissions to at least get a list of pods in the given project in order to be granted access to the Syndesis installation
+	Environment       map[string]string // Environment variables to be applied to dc/syndesis-oauthproxy
 }
 
 type UIConfiguration struct {
@@ -155,12 +196,22 @@ type ServerConfiguration struct {
 	ClientStateEncryptionKey     string         // Key used to perform encryption of client side stored state
 	ConnectionPool               ConnectionPool // Database connection pool parameters
 	JavaOptions                  string
+	Autoscaling                  AutoscalingConfiguration // Horizontal pod autoscaler of the server
 }
 
 type MetaConfiguration struct {
 	Image       string                        // Docker image for syndesis meta
 	Resources   ResourcesWithPersistentVolume // Resources for meta pod, memory
 	JavaOptions string
+	Autoscaling AutoscalingConfiguration // Horizontal pod autoscaler of meta
+}
+
+type AutoscalingConfiguration struct {
+	Enabled                           bool  // Renders a horizontal pod autoscaler, leaving the replicas to it
+	MinReplicas                       int32 // Lower limit of the number of replicas
+	MaxReplicas                       int32 // Upper limit of the number of replicas
+	TargetCPUUtilizationPercentage    int32 // Target average CPU utilization, none if 0
+	TargetMemoryUtilizationPercentage int32 // Target average memory utilization, none if 0
 }
 
 type UpgradeConfiguration struct {
@@ -415,6 +466,25 @@ func GetProperties(ctx context.Context, file string, clientTools *clienttools.Cl
 		return nil, err
 	}
 
+	// DeploymentConfigs can only be rendered where the API Server serves them
+	if clientTools != nil && !configuration.ApiServer.DeploymentConfigs {
+		configuration.Deployments = true
+	}
+
+	// Without routes, the API Server is taken for a vanilla Kubernetes
+	if clientTools != nil && !configuration.ApiServer.Routes {
+		configuration.Kubernetes = true
+	}
+	if configuration.Kubernetes {
+		configuration.setKubernetesProfile()
+	}
+
+	if rtClient != nil && configuration.Syndesis.TrustedCABundle.ConfigMapName == "" {
+		if err := configuration.injectedTrustedCABundle(ctx, rtClient, syndesis); err != nil {
+			return nil, err
+		}
+	}
+
 	if rtClient != nil && len(syndesis.Spec.Components.Database.ExternalDbURL) > 0 {
 		if err := configuration.externalDatabase(ctx, rtClient, syndesis); err != nil {
 			return nil, err
@@ -424,6 +494,13 @@ func GetProperties(ctx context.Context, file string, clientTools *clienttools.Cl
 	return configuration, nil
 }
 
+// The Kubernetes profile has neither DeploymentConfigs nor image streams
+func (config *Config) setKubernetesProfile() {
+	config.Deployments = true
+	// Development images are pushed to image streams
+	config.DevSupport = false
+}
+
 // Load configuration from config file. Config file is expected to be a yaml
 // The returned configuration is parsed to JSON and returned as a Config object
 func (config *Config) loadFromFile(file string) error {
@@ -449,6 +526,11 @@ func (config *Config) loadFromFile(file string) error {
 // Set Config.RouteHostname based on the Spec.Host property of the syndesis route
 // If an environment variable is set to overwrite the route, take that instead
 func (config *Config) SetRoute(ctx context.Context, client client.Client, syndesis *v1beta2.Syndesis) error {
+	if config.Kubernetes {
+		// Ingresses are only created with the route hostname set
+		return nil
+	}
+
 	if os.Getenv("ROUTE_HOSTNAME") == "" {
 		syndesisRoute := &routev1.Route{}
 
@@ -486,6 +568,27 @@ func (config *Config) externalDatabase(ctx context.Context, client client.Client
 	return nil
 }
 
+// Use the ConfigMap of the namespace OpenShift injected the trusted CA bundle
+// of the cluster into, once it has been injected
+func (config *Config) injectedTrustedCABundle(ctx context.Context, cl client.Client, syndesis *v1beta2.Syndesis) error {
+	configMaps := &corev1.ConfigMapList{}
+	if err := cl.List(ctx, configMaps, client.InNamespace(syndesis.Namespace), client.MatchingLabels{InjectTrustedCABundleLabel: "true"}); err != nil {
+		return err
+	}
+
+	sort.Slice(configMaps.Items, func(i, j int) bool {
+		return configMaps.Items[i].Name < configMaps.Items[j].Name
+	})
+	for _, configMap := range configMaps.Items {
+		if configMap.Data[InjectedTrustedCABundleKey] != "" {
+			config.Syndesis.TrustedCABundle.ConfigMapName = configMap.Name
+			config.Syndesis.TrustedCABundle.Key = InjectedTrustedCABundleKey
+			return nil
+		}
+	}
+	return nil
+}
+
 func getSyndesisConfigurationSecret(ctx context.Context, client client.Client, namespace string) (*corev1.Secret, error) {
 	secret := corev1.Secret{}
 	if err := client.Get(ctx, util.NewObjectKey(SyndesisGlobalConfigSecret, namespace), &secret); err != nil {
@@ -593,6 +696,8 @@ func (config *Config) setConfigFromEnv() error {
 	}
 
 	config.DevSupport = setBoolFromEnv("DEV_SUPPORT", config.DevSupport)
+	config.Deployments = setBoolFromEnv("DEPLOYMENTS", config.Deployments)
+	config.Kubernetes = setBoolFromEnv("KUBERNETES", config.Kubernetes)
 	config.Syndesis.Components.Server.Features.TestSupport = setBoolFromEnv("TEST_SUPPORT", config.Syndesis.Components.Server.Features.TestSupport)
 
 	return nil
@@ -696,6 +801,8 @@ func generatePassword(size int) string {
 	}
 
 	result := make([]rune, size)
+	randomLock.Lock()
+	defer randomLock.Unlock()
 	for i := 0; i < size; i++ {
 		result[i] = alphabet[random.Intn(len(alphabet))]
 	}
@@ -768,6 +875,26 @@ func (config *Config) SetConsoleLink(ctx context.Context, client client.Client,
 	return nil
 }
 
+// Removes the console link of the syndesis resource, the link being a
+// cluster resource it is not garbage collected with the syndesis resource
+func RemoveConsoleLink(ctx context.Context, client client.Client, syndesis *v1beta2.Syndesis) error {
+	consoleLink := &consolev1.ConsoleLink{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: consoleLinkName(syndesis),
+		},
+	}
+
+	if err := client.Delete(ctx, consoleLink); err != nil {
+		if k8serrors.IsNotFound(err) || util.IsNoKindMatchError(err) {
+			return nil
+		}
+		return err
+	}
+
+	log.Info("Console link deleted", "name", consoleLink.Name)
+	return nil
+}
+
 func reconcileConsoleLink(ctx context.Context, syndesis *v1beta2.Syndesis, routeHost string, link *consolev1.ConsoleLink, client client.Client) error {
 	updateConsoleLink := false
 	url := "https://" + routeHost
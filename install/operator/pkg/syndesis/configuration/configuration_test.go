@@ -22,9 +22,13 @@ import (
 	"reflect"
 	"testing"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	rtfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/capabilities"
 )
@@ -106,6 +110,8 @@ func Test_setConfigFromEnv(t *testing.T) {
 				Productized: true,
 				ProductName: "something",
 				DevSupport:  true,
+				Deployments: true,
+				Kubernetes:  true,
 				ApiServer: capabilities.ApiServerSpec{
 					Version:          "1.16",
 					Routes:           true,
@@ -195,6 +201,7 @@ func Test_setConfigFromEnv(t *testing.T) {
 				"RELATED_IMAGE_OAUTH": "OAUTH_IMAGE", "RELATED_IMAGE_PROMETHEUS": "PROMETHEUS_IMAGE",
 				"RELATED_IMAGE_UPGRADE": "UPGRADE_IMAGE", "DATABASE_NAMESPACE": "DATABASE_NAMESPACE", "RELATED_IMAGE_DATABASE": "DATABASE_IMAGE",
 				"RELATED_IMAGE_PSQL_EXPORTER": "PSQL_EXPORTER_IMAGE", "DEV_SUPPORT": "true", "TEST_SUPPORT": "false",
+				"DEPLOYMENTS": "true", "KUBERNETES": "true",
 				"INTEGRATION_LIMIT": "30", "DEPLOY_INTEGRATIONS": "true",
 				"META_VOLUME_NAME": "nfs0020", "META_STORAGE_CLASS": "nfs-storage-class10",
 				"META_VOLUME_ACCESS_MODE": "ReadWriteOnce",
@@ -373,6 +380,33 @@ func Test_OAuthEnvironment(t *testing.T) {
 	assert.Equal(t, c.Syndesis.Components.Oauth.Environment, s.Spec.Components.Oauth.Environment)
 }
 
+func Test_KubernetesProfile(t *testing.T) {
+	c := getConfigLiteral()
+	c.DevSupport = true
+	s := &v1beta2.Syndesis{
+		Spec: v1beta2.SyndesisSpec{
+			Ingress: v1beta2.IngressSpec{
+				ClassName: "nginx",
+				TLSSecret: "syndesis-tls",
+			},
+			Components: v1beta2.ComponentsSpec{
+				Oauth: v1beta2.OauthConfiguration{
+					CredentialsSecret: "syndesis-oidc",
+					OidcIssuerURL:     "https://issuer.example.com",
+				},
+			},
+		},
+	}
+
+	assert.NoError(t, c.setSyndesisFromCustomResource(s))
+	c.setKubernetesProfile()
+	assert.True(t, c.Deployments)
+	assert.False(t, c.DevSupport)
+	assert.Equal(t, IngressConfiguration{ClassName: "nginx", TLSSecret: "syndesis-tls"}, c.Syndesis.Ingress)
+	assert.Equal(t, "syndesis-oidc", c.Syndesis.Components.Oauth.CredentialsSecret)
+	assert.Equal(t, "https://issuer.example.com", c.Syndesis.Components.Oauth.OidcIssuerURL)
+}
+
 
 func Test_generatePasswords(t *testing.T) {
 	tests := []struct {
@@ -431,6 +465,8 @@ func getConfigLiteral() *Config {
 		AllowLocalHost:             false,
 		Productized:                false,
 		DevSupport:                 false,
+		Deployments:                false,
+		Kubernetes:                 false,
 		Scheduled:                  true,
 		PrometheusRules:            "",
 		OpenShiftProject:           "",
@@ -439,6 +475,17 @@ func getConfigLiteral() *Config {
 		Syndesis: SyndesisConfig{
 			RouteHostname: "",
 			SHA:           false,
+			HighAvailability: HighAvailabilityConfiguration{
+				Enabled:     false,
+				Replicas:    2,
+				TopologyKey: "topology.kubernetes.io/zone",
+			},
+			NetworkPolicies: NetworkPoliciesConfiguration{
+				Enabled: false,
+			},
+			TrustedCABundle: TrustedCABundleConfiguration{
+				Key: "ca-bundle.crt",
+			},
 			Addons: AddonsSpec{
 				Jaeger: JaegerConfiguration{
 					Enabled: false,
@@ -469,7 +516,8 @@ func getConfigLiteral() *Config {
 			},
 			Components: ComponentsSpec{
 				Oauth: OauthConfiguration{
-					Image: "quay.io/openshift/origin-oauth-proxy:v4.0.0",
+					Image:     "quay.io/openshift/origin-oauth-proxy:v4.0.0",
+					OidcImage: "quay.io/oauth2-proxy/oauth2-proxy:v7.1.3",
 				},
 				UI: UIConfiguration{
 					Image: "docker.io/syndesis/syndesis-ui:latest",
@@ -505,6 +553,11 @@ func getConfigLiteral() *Config {
 							},
 						},
 					},
+					Autoscaling: AutoscalingConfiguration{
+						MinReplicas:                    1,
+						MaxReplicas:                    3,
+						TargetCPUUtilizationPercentage: 80,
+					},
 				},
 				Meta: MetaConfiguration{
 					Image: "docker.io/syndesis/syndesis-meta:latest",
@@ -518,6 +571,11 @@ func getConfigLiteral() *Config {
 						VolumeCapacity:   "1Gi",
 						VolumeAccessMode: string(v1beta2.ReadWriteOnce),
 					},
+					Autoscaling: AutoscalingConfiguration{
+						MinReplicas:                       1,
+						MaxReplicas:                       3,
+						TargetMemoryUtilizationPercentage: 80,
+					},
 				},
 				Database: DatabaseConfiguration{
 					Image: "postgresql:9.6",
@@ -642,6 +700,39 @@ func TestConfig_SetRoute(t *testing.T) {
 	}
 }
 
+func Test_injectedTrustedCABundle(t *testing.T) {
+	syndesis := &v1beta2.Syndesis{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "syndesis"}}
+	injected := func(name string, bundle string) *corev1.ConfigMap {
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "syndesis",
+				Labels:    map[string]string{InjectTrustedCABundleLabel: "true"},
+			},
+		}
+		if bundle != "" {
+			configMap.Data = map[string]string{InjectedTrustedCABundleKey: bundle}
+		}
+		return configMap
+	}
+
+	config := getConfigLiteral()
+	require.NoError(t, config.injectedTrustedCABundle(context.TODO(), rtfake.NewFakeClient(), syndesis))
+	assert.Empty(t, config.Syndesis.TrustedCABundle.ConfigMapName)
+
+	// Not injected yet
+	config = getConfigLiteral()
+	require.NoError(t, config.injectedTrustedCABundle(context.TODO(), rtfake.NewFakeClient(injected("a-bundle", "")), syndesis))
+	assert.Empty(t, config.Syndesis.TrustedCABundle.ConfigMapName)
+
+	config = getConfigLiteral()
+	config.Syndesis.TrustedCABundle.Key = "custom.crt"
+	cl := rtfake.NewFakeClient(injected("a-bundle", ""), injected("c-bundle", "pem"), injected("b-bundle", "pem"))
+	require.NoError(t, config.injectedTrustedCABundle(context.TODO(), cl, syndesis))
+	assert.Equal(t, "b-bundle", config.Syndesis.TrustedCABundle.ConfigMapName)
+	assert.Equal(t, InjectedTrustedCABundleKey, config.Syndesis.TrustedCABundle.Key)
+}
+
 func Test_setIntFromEnv(t *testing.T) {
 	type args struct {
 		env     string
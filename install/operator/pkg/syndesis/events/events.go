@@ -0,0 +1,64 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package events lists the reasons of the events published on a Syndesis
+// resource, so that they stay consistent across the operator.
+package events
+
+const (
+	// Component used as the source of the events
+	Component = "syndesis-operator"
+
+	// Resources installed for the syndesis resource
+	ReasonResourceCreated = "ResourceCreated"
+	ReasonResourceUpdated = "ResourceUpdated"
+	ReasonResourceDeleted = "ResourceDeleted"
+	ReasonResourceFailed  = "ResourceFailed"
+	// Resource no longer rendered but kept, the pruning being a dry run
+	ReasonResourcePruneDryRun = "ResourcePruneDryRun"
+	// Fields of a resource set by another actor were taken over
+	ReasonResourceConflict = "ResourceConflict"
+	// Resources differing from their rendered configuration, in drift detection
+	ReasonResourceDrifted = "ResourceDrifted"
+	// Patches of the rendered resources that could not be applied
+	ReasonOverrideFailed = "OverrideFailed"
+	// Components rejected by the pod security standard of the namespace
+	ReasonPodSecurityIncompatible = "PodSecurityIncompatible"
+
+	// Transitions of the installation
+	ReasonInstalled = "Installed"
+	ReasonStarted   = "Started"
+
+	// Upgrade, run step by step and rolled back on failure
+	ReasonUpgradeStarted        = "UpgradeStarted"
+	ReasonUpgradeDeferred       = "UpgradeDeferred"
+	ReasonUpgradeStepRun        = "UpgradeStepRun"
+	ReasonUpgradeStepFailed     = "UpgradeStepFailed"
+	ReasonUpgradeRolledBack     = "UpgradeRolledBack"
+	ReasonUpgradeRollbackFailed = "UpgradeRollbackFailed"
+	ReasonUpgradeSucceeded      = "UpgradeSucceeded"
+	ReasonUpgradeFailed         = "UpgradeFailed"
+
+	// Backups, scheduled or taken before deletion
+	ReasonBackupStarted   = "BackupStarted"
+	ReasonBackupSucceeded = "BackupSucceeded"
+	ReasonBackupFailed    = "BackupFailed"
+	ReasonBackupSkipped   = "BackupSkipped"
+
+	// Subscriptions to operators of the operator-lifecycle-manager for addons
+	ReasonSubscriptionCreated = "SubscriptionCreated"
+	ReasonSubscriptionFailed  = "SubscriptionFailed"
+)
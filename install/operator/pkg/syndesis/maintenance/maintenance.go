@@ -0,0 +1,74 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package maintenance evaluates the maintenance windows of a Syndesis
+// resource, the time windows disruptive operations are restricted to.
+package maintenance
+
+import (
+	"fmt"
+	"time"
+
+	cron "github.com/robfig/cron/v3"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+)
+
+// Parses the schedule of a window, checking it stays open for some time
+func Parse(window v1beta2.MaintenanceWindow) (cron.Schedule, error) {
+	if window.Duration.Duration <= 0 {
+		return nil, fmt.Errorf("maintenance window duration must be positive, got %s", window.Duration.Duration)
+	}
+	return cron.ParseStandard(window.Schedule)
+}
+
+// Whether one of the windows is open at the given time, which is always
+// the case when there are no windows
+func InWindow(windows []v1beta2.MaintenanceWindow, now time.Time) (bool, error) {
+	if len(windows) == 0 {
+		return true, nil
+	}
+
+	now = now.UTC()
+	for _, window := range windows {
+		schedule, err := Parse(window)
+		if err != nil {
+			return false, err
+		}
+
+		// The last opening of the window, if still open, is the first one
+		// after the window duration subtracted from now
+		if opening := schedule.Next(now.Add(-window.Duration.Duration)); !opening.After(now) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// When the next window opens after the given time, zero if there are no windows
+func NextWindow(windows []v1beta2.MaintenanceWindow, now time.Time) (time.Time, error) {
+	next := time.Time{}
+	for _, window := range windows {
+		schedule, err := Parse(window)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		if opening := schedule.Next(now.UTC()); next.IsZero() || opening.Before(next) {
+			next = opening
+		}
+	}
+	return next, nil
+}
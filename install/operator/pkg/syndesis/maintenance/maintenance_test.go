@@ -0,0 +1,82 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package maintenance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_InWindow(t *testing.T) {
+	// Saturdays from 02:00 to 06:00 and the first of the month from 22:00 to 23:00
+	windows := []v1beta2.MaintenanceWindow{
+		{Schedule: "0 2 * * 6", Duration: metav1.Duration{Duration: 4 * time.Hour}},
+		{Schedule: "0 22 1 * *", Duration: metav1.Duration{Duration: time.Hour}},
+	}
+
+	testCases := []struct {
+		name string
+		now  string
+		open bool
+	}{
+		{"Before the window", "2021-05-15T01:59:00Z", false},
+		{"At the opening", "2021-05-15T02:00:00Z", true},
+		{"In the window", "2021-05-15T05:30:00Z", true},
+		{"At the closing", "2021-05-15T06:00:00Z", false},
+		{"In the other window", "2021-06-01T22:15:00Z", true},
+		{"In another timezone", "2021-05-15T07:30:00+02:00", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			now, err := time.Parse(time.RFC3339, tc.now)
+			require.NoError(t, err)
+
+			open, err := InWindow(windows, now)
+			require.NoError(t, err)
+			assert.Equal(t, tc.open, open)
+		})
+	}
+
+	open, err := InWindow(nil, time.Now())
+	require.NoError(t, err)
+	assert.True(t, open)
+
+	_, err = InWindow([]v1beta2.MaintenanceWindow{{Schedule: "sometimes", Duration: metav1.Duration{Duration: time.Hour}}}, time.Now())
+	assert.Error(t, err)
+}
+
+func Test_NextWindow(t *testing.T) {
+	windows := []v1beta2.MaintenanceWindow{
+		{Schedule: "0 2 * * 6", Duration: metav1.Duration{Duration: 4 * time.Hour}},
+		{Schedule: "0 22 1 * *", Duration: metav1.Duration{Duration: time.Hour}},
+	}
+
+	now, _ := time.Parse(time.RFC3339, "2021-05-31T12:00:00Z")
+	next, err := NextWindow(windows, now)
+	require.NoError(t, err)
+	assert.Equal(t, "2021-06-01T22:00:00Z", next.Format(time.RFC3339))
+
+	next, err = NextWindow(nil, now)
+	require.NoError(t, err)
+	assert.True(t, next.IsZero())
+}
@@ -18,6 +18,7 @@ package olm
 
 import (
 	"github.com/syndesisio/syndesis/install/operator/pkg/generator"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/capabilities"
 	"gopkg.in/yaml.v2"
 )
 
@@ -27,7 +28,12 @@ type crd struct {
 
 // Build the content of the crd file
 func (c *crd) build() (err error) {
-	g, err := generator.Render("./install/cluster/syndesis.yml", nil)
+	// Olm serves the older versions through the conversion webhook of the csv
+	g, err := generator.Render("./install/cluster/syndesis.yml.tmpl", struct {
+		Olm       bool
+		Namespace string
+		ApiServer capabilities.ApiServerSpec
+	}{Olm: true})
 	if err != nil {
 		return err
 	}
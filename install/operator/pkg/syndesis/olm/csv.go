@@ -23,6 +23,7 @@ import (
 
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/capabilities"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/webhook"
 
 	"github.com/syndesisio/syndesis/install/operator/pkg/generator"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -30,6 +31,9 @@ import (
 	"gopkg.in/yaml.v2"
 )
 
+// Port the webhooks are served on by the operator
+const webhookPort = 9443
+
 type csv struct {
 	config *configuration.Config
 	image  string
@@ -72,7 +76,8 @@ type Spec struct {
 	InstallModes              []InstallMode `yaml:"installModes"`
 	Install                   Install
 	Customresourcedefinitions CustomResourceDefinitions
-	RelatedImages             []Image `yaml:"relatedImages"`
+	Webhookdefinitions        []WebhookDefinition `yaml:"webhookdefinitions,omitempty"`
+	RelatedImages             []Image             `yaml:"relatedImages"`
 }
 
 type Metadata struct {
@@ -166,6 +171,26 @@ type CustomResourceDefinition struct {
 	Description string
 }
 
+type WebhookDefinition struct {
+	Type                    string
+	GenerateName            string        `yaml:"generateName"`
+	DeploymentName          string        `yaml:"deploymentName"`
+	ContainerPort           int           `yaml:"containerPort"`
+	WebhookPath             string        `yaml:"webhookPath"`
+	AdmissionReviewVersions []string      `yaml:"admissionReviewVersions"`
+	SideEffects             string        `yaml:"sideEffects,omitempty"`
+	FailurePolicy           string        `yaml:"failurePolicy,omitempty"`
+	Rules                   []WebhookRule `yaml:",omitempty"`
+	ConversionCRDs          []string      `yaml:"conversionCRDs,omitempty"`
+}
+
+type WebhookRule struct {
+	APIGroups   []string `yaml:"apiGroups"`
+	APIVersions []string `yaml:"apiVersions"`
+	Operations  []string
+	Resources   []string
+}
+
 // In order to build the body for both upstream and downstream,
 // set variables accordingly
 func (c *csv) setVariables() {
@@ -311,10 +336,10 @@ func (c *csv) build() (err error) {
 					Supported: true,
 				}, {
 					Type:      "MultiNamespace",
-					Supported: false,
+					Supported: true,
 				}, {
 					Type:      "AllNamespaces",
-					Supported: false,
+					Supported: true,
 				},
 			},
 			Install: Install{
@@ -337,6 +362,37 @@ func (c *csv) build() (err error) {
 					Description: "Syndesis CRD",
 				}},
 			},
+			//
+			// The operator-lifecycle-manager provides the serving certificate
+			// of the webhooks and injects its ca into their configurations
+			//
+			Webhookdefinitions: []WebhookDefinition{
+				{
+					Type:                    "ValidatingAdmissionWebhook",
+					GenerateName:            "vsyndesis.syndesis.io",
+					DeploymentName:          c.name,
+					ContainerPort:           webhookPort,
+					WebhookPath:             webhook.ValidatePath,
+					AdmissionReviewVersions: []string{"v1"},
+					SideEffects:             "None",
+					FailurePolicy:           "Fail",
+					Rules: []WebhookRule{{
+						APIGroups:   []string{"syndesis.io"},
+						APIVersions: []string{"v1beta2"},
+						Operations:  []string{"CREATE", "UPDATE"},
+						Resources:   []string{"syndesises"},
+					}},
+				}, {
+					Type:                    "ConversionWebhook",
+					GenerateName:            "csyndesis.syndesis.io",
+					DeploymentName:          c.name,
+					ContainerPort:           webhookPort,
+					WebhookPath:             webhook.ConvertPath,
+					AdmissionReviewVersions: []string{"v1", "v1beta1"},
+					SideEffects:             "None",
+					ConversionCRDs:          []string{"syndesises.syndesis.io"},
+				},
+			},
 			RelatedImages: []Image{}, // Has to be specified but populated downstream
 		},
 	}
@@ -409,21 +465,25 @@ func (c *csv) loadRolesFromTemplate(templates ...string) (m []map[string]interfa
 // Load syndesis-operator deployment from template file
 func (c *csv) loadDeploymentFromTemplate() (r interface{}, err error) {
 	context := struct {
-		DatabaseImage   string
-		Image           string
-		Tag             string
-		AmqImage        string
-		TodoImage       string
-		OauthImage      string
-		UiImage         string
-		S2iImage        string
-		PrometheusImage string
-		UpgradeImage    string
-		MetaImage       string
-		ServerImage     string
-		ExporterImage   string
-		DevSupport      bool
-		LogLevel        int
+		DatabaseImage     string
+		Image             string
+		Tag               string
+		AmqImage          string
+		TodoImage         string
+		OauthImage        string
+		UiImage           string
+		S2iImage          string
+		PrometheusImage   string
+		UpgradeImage      string
+		MetaImage         string
+		ServerImage       string
+		ExporterImage     string
+		DevSupport        bool
+		LogLevel          int
+		Olm               bool
+		AllNamespaces     bool
+		WatchNamespaces   string
+		NamespaceSelector string
 	}{
 		Image:           c.image,
 		Tag:             c.tag,
@@ -440,6 +500,7 @@ func (c *csv) loadDeploymentFromTemplate() (r interface{}, err error) {
 		ExporterImage:   c.config.Syndesis.Components.Database.Exporter.Image,
 		DevSupport:      false, // Never be true in CSV generation - here for template compatibility
 		LogLevel:        0,     // Never to be more in CSV generation - here for template compatibility
+		Olm:             true,  // Watched namespaces are given by the operator group
 	}
 
 	g, err := generator.Render("./install/operator_deployment.yml.tmpl", context)
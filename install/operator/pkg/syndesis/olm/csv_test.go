@@ -122,12 +122,12 @@ func Test_csv_loadRolesFromTemplate(t *testing.T) {
 		{
 			"operator-role",
 			"./install/role.yml.tmpl",
-			47,
+			49,
 		},
 		{
 			"olm-roles",
 			"./install/cluster_role_olm.yml.tmpl",
-			6,
+			7,
 		},
 		{
 			"kafka-roles",
@@ -27,8 +27,11 @@ import (
 	olmcli "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/clientset/versioned"
 	olmpkgsvr "github.com/operator-framework/operator-lifecycle-manager/pkg/package-server/apis/operators/v1"
 	synpkg "github.com/syndesisio/syndesis/install/operator/pkg"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
 	conf "github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/events"
+	corev1 "k8s.io/api/core/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -39,6 +42,9 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
 
+// Label given to the subscriptions and operator-groups created for a syndesis resource
+const ownerLabel = "owner"
+
 var pollTimeout = 180 * time.Second
 var pollInterval = 15 * time.Second
 var sublog = logf.Log.WithName("subscription")
@@ -53,7 +59,7 @@ var sublog = logf.Log.WithName("subscription")
 // by the operator and tidied up. If they were not created by this operator
 // then they remain independent and will not be tidied up if the CR is removed.
 //
-func SubscribeOperator(ctx context.Context, clientTools *clienttools.ClientTools, configuration *conf.Config, olmSpec *conf.OlmSpec) error {
+func SubscribeOperator(ctx context.Context, clientTools *clienttools.ClientTools, configuration *conf.Config, olmSpec *conf.OlmSpec, syndesis *v1beta2.Syndesis) error {
 	rtClient, err := clientTools.RuntimeClient()
 	if err != nil {
 		return err
@@ -112,7 +118,7 @@ func SubscribeOperator(ctx context.Context, clientTools *clienttools.ClientTools
 	//
 	// 4b. No csv listed so try and install an operator-group or use an existing one if available
 	//
-	ns, err := findOrCreateOperatorGroup(ctx, rtClient, olmClient, configuration, pkgManifest, channel)
+	ns, err := findOrCreateOperatorGroup(ctx, rtClient, olmClient, configuration, pkgManifest, channel, syndesis)
 	if err != nil {
 		return err
 	}
@@ -120,7 +126,7 @@ func SubscribeOperator(ctx context.Context, clientTools *clienttools.ClientTools
 	//
 	// 4c. Create the subscription
 	//
-	sub, err := createSubscription(ctx, rtClient, ns, pkgManifest, channel)
+	sub, err := createSubscription(ctx, rtClient, ns, pkgManifest, channel, syndesis)
 	if err != nil {
 		return err
 	}
@@ -130,6 +136,8 @@ func SubscribeOperator(ctx context.Context, clientTools *clienttools.ClientTools
 		return err
 	}
 
+	clientTools.EventRecorder().Eventf(syndesis, corev1.EventTypeNormal, events.ReasonSubscriptionCreated,
+		"Subscribed to operator %s on channel %s in namespace %s", pkgManifest.Name, channel.Name, ns)
 	return nil
 }
 
@@ -188,7 +196,7 @@ func findPackageCSV(ctx context.Context, rtClient client.Client, channel *olmpkg
 	return &csv, nil
 }
 
-func createOperatorGroup(ctx context.Context, rtClient client.Client, configuration *conf.Config, pkgName string, channel *olmpkgsvr.PackageChannel) (*olmapiv1.OperatorGroup, error) {
+func createOperatorGroup(ctx context.Context, rtClient client.Client, configuration *conf.Config, pkgName string, channel *olmpkgsvr.PackageChannel, syndesis *v1beta2.Syndesis) (*olmapiv1.OperatorGroup, error) {
 	sublog.V(synpkg.DEBUG_LOGGING_LVL).Info("Creating operator group for package in namespace", "Channel", channel.Name, "Namespace", configuration.OpenShiftProject)
 
 	ogName := fmt.Sprintf("%s-%s-og", configuration.OpenShiftProject, pkgName)
@@ -201,7 +209,10 @@ func createOperatorGroup(ctx context.Context, rtClient client.Client, configurat
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: configuration.OpenShiftProject,
 			Name:      ogName,
-			Labels:    map[string]string{configuration.ProductName: configuration.OpenShiftProject},
+			Labels: map[string]string{
+				configuration.ProductName: configuration.OpenShiftProject,
+				ownerLabel:                string(syndesis.GetUID()),
+			},
 		},
 		Spec: olmapiv1.OperatorGroupSpec{}, // all namespaces by default
 	}
@@ -223,7 +234,7 @@ func createOperatorGroup(ctx context.Context, rtClient client.Client, configurat
 // Find or create a compatible operator-group and
 // return the namespace in which is it located
 //
-func findOrCreateOperatorGroup(ctx context.Context, rtClient client.Client, olmClient olmcli.Interface, configuration *conf.Config, pkgManifest *olmpkgsvr.PackageManifest, channel *olmpkgsvr.PackageChannel) (string, error) {
+func findOrCreateOperatorGroup(ctx context.Context, rtClient client.Client, olmClient olmcli.Interface, configuration *conf.Config, pkgManifest *olmpkgsvr.PackageManifest, channel *olmpkgsvr.PackageChannel, syndesis *v1beta2.Syndesis) (string, error) {
 
 	//
 	// 1. Check the install mode of the packagemanifest to see if its ALL
@@ -307,7 +318,7 @@ func findOrCreateOperatorGroup(ctx context.Context, rtClient client.Client, olmC
 		// Use-case: 2, 4
 		// No operator groups installed so can create one
 		//
-		if og, err := createOperatorGroup(ctx, rtClient, configuration, pkgManifest.Status.PackageName, channel); err != nil {
+		if og, err := createOperatorGroup(ctx, rtClient, configuration, pkgManifest.Status.PackageName, channel, syndesis); err != nil {
 			return "", err
 		} else {
 			//
@@ -353,7 +364,7 @@ func findOrCreateOperatorGroup(ctx context.Context, rtClient client.Client, olmC
 	return configuration.OpenShiftProject, nil
 }
 
-func createSubscription(ctx context.Context, rtClient client.Client, namespace string, pkgManifest *olmpkgsvr.PackageManifest, channel *olmpkgsvr.PackageChannel) (*olmapiv1alpha1.Subscription, error) {
+func createSubscription(ctx context.Context, rtClient client.Client, namespace string, pkgManifest *olmpkgsvr.PackageManifest, channel *olmpkgsvr.PackageChannel, syndesis *v1beta2.Syndesis) (*olmapiv1alpha1.Subscription, error) {
 	sublog.V(synpkg.DEBUG_LOGGING_LVL).Info("Creating subscription for package in namespace", "Channel", channel.Name, "Package", pkgManifest.Name, "Namespace", namespace)
 
 	//
@@ -363,6 +374,7 @@ func createSubscription(ctx context.Context, rtClient client.Client, namespace s
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: namespace,
 			Name:      pkgManifest.Status.PackageName,
+			Labels:    map[string]string{ownerLabel: string(syndesis.GetUID())},
 		},
 		Spec: &olmapiv1alpha1.SubscriptionSpec{
 			InstallPlanApproval:    olmapiv1alpha1.ApprovalAutomatic,
@@ -383,6 +395,51 @@ func createSubscription(ctx context.Context, rtClient client.Client, namespace s
 	return sub, nil
 }
 
+//
+// Removes the subscriptions and operator-groups created for the
+// syndesis resource. Those found already in place when subscribing
+// are not labelled as owned by syndesis and so are left untouched.
+//
+func Unsubscribe(ctx context.Context, clientTools *clienttools.ClientTools, syndesis *v1beta2.Syndesis) error {
+	olmClient, err := clientTools.OlmClient()
+	if err != nil {
+		return err
+	}
+
+	// Subscriptions and operator-groups can be created in other namespaces
+	// so use the olm client as the runtime client is restricted to ours
+	options := metav1.ListOptions{LabelSelector: ownerLabel + "=" + string(syndesis.GetUID())}
+
+	subs, err := olmClient.OperatorsV1alpha1().Subscriptions("").List(ctx, options)
+	if err != nil {
+		if k8serr.IsNotFound(err) {
+			return nil // No operator-lifecycle-manager support
+		}
+		return err
+	}
+	for _, sub := range subs.Items {
+		sublog.Info("Deleting subscription", "Name", sub.Name, "Namespace", sub.Namespace)
+		err := olmClient.OperatorsV1alpha1().Subscriptions(sub.Namespace).Delete(ctx, sub.Name, metav1.DeleteOptions{})
+		if err != nil && !k8serr.IsNotFound(err) {
+			return err
+		}
+	}
+
+	ogs, err := olmClient.OperatorsV1().OperatorGroups("").List(ctx, options)
+	if err != nil {
+		return err
+	}
+	for _, og := range ogs.Items {
+		sublog.Info("Deleting operator-group", "Name", og.Name, "Namespace", og.Namespace)
+		err := olmClient.OperatorsV1().OperatorGroups(og.Namespace).Delete(ctx, og.Name, metav1.DeleteOptions{})
+		if err != nil && !k8serr.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func isAllNamespace(og olmapiv1.OperatorGroup) bool {
 	return len(og.Spec.TargetNamespaces) == 0 && (og.Spec.Selector == nil || len(og.Spec.Selector.MatchLabels) == 0)
 }
@@ -0,0 +1,115 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operation
+
+import (
+	"sort"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Label of the namespaces naming the pod security standard they enforce
+const PodSecurityEnforceLabel = "pod-security.kubernetes.io/enforce"
+
+// The pod security standard the pods comply with unless left out of it
+const PodSecurityRestricted = "restricted"
+
+// Components left out of the restricted pod security standard, by value of
+// their syndesis.io/component label
+func unrestrictedByComponent(syndesis *v1beta2.Syndesis) map[string]bool {
+	components := syndesis.Spec.Components
+	return map[string]bool{
+		"syndesis-server":     components.Server.DisableRestrictedPodSecurity,
+		"syndesis-meta":       components.Meta.DisableRestrictedPodSecurity,
+		"syndesis-ui":         components.UI.DisableRestrictedPodSecurity,
+		"syndesis-db":         components.Database.DisableRestrictedPodSecurity,
+		"syndesis-prometheus": components.Prometheus.DisableRestrictedPodSecurity,
+		"syndesis-oauthproxy": components.Oauth.DisableRestrictedPodSecurity,
+	}
+}
+
+// UnrestrictedComponents lists the components left out of the restricted pod
+// security standard
+func UnrestrictedComponents(syndesis *v1beta2.Syndesis) []string {
+	components := []string{}
+	for component, unrestricted := range unrestrictedByComponent(syndesis) {
+		if unrestricted {
+			components = append(components, component)
+		}
+	}
+	sort.Strings(components)
+	return components
+}
+
+// SetComponentPodSecurity makes the pod spec found at the path of the content
+// comply with the restricted pod security standard, unless the component is
+// left out of it
+func SetComponentPodSecurity(content map[string]interface{}, syndesis *v1beta2.Syndesis, component string, path ...string) error {
+	if unrestrictedByComponent(syndesis)[component] {
+		return nil
+	}
+	return SetRestrictedPodSecurity(content, path...)
+}
+
+// SetRestrictedPodSecurity makes the pod spec found at the path of the content
+// comply with the restricted pod security standard: the pod runs as a non-root
+// user with the default seccomp profile of the runtime, and its containers
+// drop all their capabilities and cannot escalate their privileges
+func SetRestrictedPodSecurity(content map[string]interface{}, path ...string) error {
+	field := func(names ...string) []string {
+		return append(append([]string{}, path...), names...)
+	}
+
+	if err := unstructured.SetNestedField(content, true, field("securityContext", "runAsNonRoot")...); err != nil {
+		return err
+	}
+	if _, found, _ := unstructured.NestedFieldNoCopy(content, field("securityContext", "seccompProfile")...); !found {
+		if err := unstructured.SetNestedField(content, "RuntimeDefault", field("securityContext", "seccompProfile", "type")...); err != nil {
+			return err
+		}
+	}
+
+	for _, containersField := range []string{"initContainers", "containers"} {
+		containers, found, err := unstructured.NestedSlice(content, field(containersField)...)
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+
+		for _, c := range containers {
+			container, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if err := unstructured.SetNestedField(container, false, "securityContext", "allowPrivilegeEscalation"); err != nil {
+				return err
+			}
+			if err := unstructured.SetNestedStringSlice(container, []string{"ALL"}, "securityContext", "capabilities", "drop"); err != nil {
+				return err
+			}
+		}
+
+		if err := unstructured.SetNestedSlice(content, containers, field(containersField)...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+)
+
+func Test_SetRestrictedPodSecurity(t *testing.T) {
+	podSpec := map[string]interface{}{
+		"securityContext": map[string]interface{}{
+			"seccompProfile": map[string]interface{}{"type": "Localhost", "localhostProfile": "syndesis.json"},
+		},
+		"initContainers": []interface{}{
+			map[string]interface{}{"name": "init"},
+		},
+		"containers": []interface{}{
+			map[string]interface{}{"name": "main", "securityContext": map[string]interface{}{"runAsUser": int64(1000)}},
+		},
+	}
+
+	require.NoError(t, SetRestrictedPodSecurity(podSpec))
+
+	assert.Equal(t, map[string]interface{}{
+		"runAsNonRoot":   true,
+		"seccompProfile": map[string]interface{}{"type": "Localhost", "localhostProfile": "syndesis.json"},
+	}, podSpec["securityContext"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"name": "init", "securityContext": map[string]interface{}{
+			"allowPrivilegeEscalation": false,
+			"capabilities":             map[string]interface{}{"drop": []interface{}{"ALL"}},
+		}},
+	}, podSpec["initContainers"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"name": "main", "securityContext": map[string]interface{}{
+			"runAsUser":                int64(1000),
+			"allowPrivilegeEscalation": false,
+			"capabilities":             map[string]interface{}{"drop": []interface{}{"ALL"}},
+		}},
+	}, podSpec["containers"])
+}
+
+func Test_UnrestrictedComponents(t *testing.T) {
+	syndesis := &v1beta2.Syndesis{}
+	assert.Empty(t, UnrestrictedComponents(syndesis))
+
+	syndesis.Spec.Components.UI.DisableRestrictedPodSecurity = true
+	syndesis.Spec.Components.Database.DisableRestrictedPodSecurity = true
+	assert.Equal(t, []string{"syndesis-db", "syndesis-ui"}, UnrestrictedComponents(syndesis))
+
+	podSpec := map[string]interface{}{}
+	require.NoError(t, SetComponentPodSecurity(podSpec, syndesis, "syndesis-db"))
+	assert.Empty(t, podSpec)
+	require.NoError(t, SetComponentPodSecurity(podSpec, syndesis, "syndesis-server"))
+	assert.Contains(t, podSpec, "securityContext")
+}
@@ -0,0 +1,216 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operation
+
+import (
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Scheduling of the components, by value of their syndesis.io/component label
+func schedulingByComponent(syndesis *v1beta2.Syndesis) map[string]v1beta2.ComponentSchedulingSpec {
+	components := syndesis.Spec.Components
+	return map[string]v1beta2.ComponentSchedulingSpec{
+		"syndesis-server":     components.Server.Scheduling,
+		"syndesis-meta":       components.Meta.Scheduling,
+		"syndesis-ui":         components.UI.Scheduling,
+		"syndesis-db":         components.Database.Scheduling,
+		"syndesis-prometheus": components.Prometheus.Scheduling,
+		"syndesis-oauthproxy": components.Oauth.Scheduling,
+	}
+}
+
+// ComponentScheduling merges the scheduling of the component over the
+// infra scheduling
+func ComponentScheduling(syndesis *v1beta2.Syndesis, component string) v1beta2.ComponentSchedulingSpec {
+	return mergeScheduling(syndesis.Spec.InfraScheduling, schedulingByComponent(syndesis)[component])
+}
+
+// JobScheduling merges the job scheduling over the infra scheduling
+func JobScheduling(syndesis *v1beta2.Syndesis) v1beta2.ComponentSchedulingSpec {
+	return mergeScheduling(syndesis.Spec.InfraScheduling, syndesis.Spec.JobScheduling.ComponentSchedulingSpec)
+}
+
+func mergeScheduling(infra v1beta2.SchedulingSpec, scheduling v1beta2.ComponentSchedulingSpec) v1beta2.ComponentSchedulingSpec {
+	merged := InfraScheduling(infra)
+
+	if affinity := scheduling.Affinity; affinity != nil {
+		if merged.Affinity == nil {
+			merged.Affinity = &v1beta2.Affinity{}
+		}
+		if affinity.NodeAffinity != nil {
+			merged.Affinity.NodeAffinity = affinity.NodeAffinity
+		}
+		if affinity.PodAffinity != nil {
+			merged.Affinity.PodAffinity = affinity.PodAffinity
+		}
+		if affinity.PodAntiAffinity != nil {
+			merged.Affinity.PodAntiAffinity = affinity.PodAntiAffinity
+		}
+	}
+
+	for i := range scheduling.Tolerations {
+		if !hasToleration(merged.Tolerations, &scheduling.Tolerations[i]) {
+			merged.Tolerations = append(merged.Tolerations, scheduling.Tolerations[i])
+		}
+	}
+
+	merged.NodeSelector = scheduling.NodeSelector
+	merged.PriorityClassName = scheduling.PriorityClassName
+	merged.TopologySpreadConstraints = scheduling.TopologySpreadConstraints
+	return merged
+}
+
+// InfraScheduling is the scheduling of the pods of the infrastructure
+// components, without scheduling of their own
+func InfraScheduling(infra v1beta2.SchedulingSpec) v1beta2.ComponentSchedulingSpec {
+	scheduling := v1beta2.ComponentSchedulingSpec{}
+	if infra.Affinity != nil {
+		scheduling.Affinity = &v1beta2.Affinity{Affinity: *infra.Affinity.DeepCopy()}
+	}
+	for i := range infra.Tolerations {
+		scheduling.Tolerations = append(scheduling.Tolerations, *infra.Tolerations[i].DeepCopy())
+	}
+	return scheduling
+}
+
+func hasToleration(tolerations []corev1.Toleration, toleration *corev1.Toleration) bool {
+	for _, t := range tolerations {
+		if t.MatchToleration(toleration) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetScheduling sets the scheduling in the pod spec found at the path of
+// the content
+func SetScheduling(content map[string]interface{}, scheduling v1beta2.ComponentSchedulingSpec, path ...string) error {
+	field := func(name string) []string {
+		return append(append([]string{}, path...), name)
+	}
+
+	if scheduling.Affinity != nil {
+		affinityData, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&scheduling.Affinity.Affinity)
+		if err != nil {
+			return err
+		}
+
+		err = unstructured.SetNestedField(content, affinityData, field("affinity")...)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(scheduling.Tolerations) > 0 {
+		tolerations := make([]interface{}, len(scheduling.Tolerations))
+
+		for i, toleration := range scheduling.Tolerations {
+			tolerationData, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&toleration)
+			if err != nil {
+				return err
+			}
+			tolerations[i] = tolerationData
+		}
+
+		err := unstructured.SetNestedSlice(content, tolerations, field("tolerations")...)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(scheduling.NodeSelector) > 0 {
+		nodeSelector, _, err := unstructured.NestedStringMap(content, field("nodeSelector")...)
+		if err != nil {
+			return err
+		}
+		if nodeSelector == nil {
+			nodeSelector = map[string]string{}
+		}
+		for k, v := range scheduling.NodeSelector {
+			nodeSelector[k] = v
+		}
+
+		err = unstructured.SetNestedStringMap(content, nodeSelector, field("nodeSelector")...)
+		if err != nil {
+			return err
+		}
+	}
+
+	if scheduling.PriorityClassName != "" {
+		err := unstructured.SetNestedField(content, scheduling.PriorityClassName, field("priorityClassName")...)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(scheduling.TopologySpreadConstraints) > 0 {
+		constraints := make([]interface{}, len(scheduling.TopologySpreadConstraints))
+
+		for i, constraint := range scheduling.TopologySpreadConstraints {
+			constraintData, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&constraint.TopologySpreadConstraint)
+			if err != nil {
+				return err
+			}
+			constraints[i] = constraintData
+		}
+
+		err := unstructured.SetNestedSlice(content, constraints, field("topologySpreadConstraints")...)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetJobScheduling sets the job scheduling and resources in the pods of
+// a job spawned by the operator
+func SetJobScheduling(resource unstructured.Unstructured, syndesis *v1beta2.Syndesis) error {
+	if resource.GetKind() != "Job" {
+		return nil
+	}
+
+	content := resource.UnstructuredContent()
+	if err := SetScheduling(content, JobScheduling(syndesis), "spec", "template", "spec"); err != nil {
+		return err
+	}
+
+	resources := syndesis.Spec.JobScheduling.Resources
+	if len(resources.Limits) == 0 && len(resources.Requests) == 0 {
+		return nil
+	}
+
+	resourcesData, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&resources)
+	if err != nil {
+		return err
+	}
+
+	containers, _, err := unstructured.NestedSlice(content, "spec", "template", "spec", "containers")
+	if err != nil {
+		return err
+	}
+	for _, c := range containers {
+		if container, ok := c.(map[string]interface{}); ok {
+			container["resources"] = runtime.DeepCopyJSON(resourcesData)
+		}
+	}
+
+	return unstructured.SetNestedSlice(content, containers, "spec", "template", "spec", "containers")
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func Test_SetJobScheduling(t *testing.T) {
+	syndesis := &v1beta2.Syndesis{
+		Spec: v1beta2.SyndesisSpec{
+			InfraScheduling: v1beta2.SchedulingSpec{
+				Tolerations: []corev1.Toleration{
+					{Key: "infra", Operator: corev1.TolerationOpExists},
+				},
+			},
+			JobScheduling: v1beta2.JobSchedulingSpec{
+				ComponentSchedulingSpec: v1beta2.ComponentSchedulingSpec{
+					Tolerations: []corev1.Toleration{
+						{Key: "batch", Operator: corev1.TolerationOpExists},
+					},
+					PriorityClassName: "low",
+				},
+				Resources: corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+				},
+			},
+		},
+	}
+
+	job := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "batch/v1",
+		"kind":       "Job",
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "backup-db-compiler"},
+						map[string]interface{}{"name": "backup-db-logger"},
+					},
+				},
+			},
+		},
+	}}
+
+	require.NoError(t, SetJobScheduling(job, syndesis))
+
+	podSpec, _, _ := unstructured.NestedMap(job.Object, "spec", "template", "spec")
+	assert.Len(t, podSpec["tolerations"], 2)
+	assert.Equal(t, "low", podSpec["priorityClassName"])
+	for _, c := range podSpec["containers"].([]interface{}) {
+		memory, _, _ := unstructured.NestedString(c.(map[string]interface{}), "resources", "limits", "memory")
+		assert.Equal(t, "256Mi", memory)
+	}
+
+	// Only jobs are scheduled as jobs
+	deployment := unstructured.Unstructured{Object: map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment"}}
+	require.NoError(t, SetJobScheduling(deployment, syndesis))
+	assert.NotContains(t, deployment.Object, "spec")
+}
+
+func Test_ComponentScheduling(t *testing.T) {
+	syndesis := &v1beta2.Syndesis{
+		Spec: v1beta2.SyndesisSpec{
+			InfraScheduling: v1beta2.SchedulingSpec{
+				Affinity: &corev1.Affinity{PodAntiAffinity: &corev1.PodAntiAffinity{}},
+				Tolerations: []corev1.Toleration{
+					{Key: "infra", Operator: corev1.TolerationOpExists},
+				},
+			},
+			Components: v1beta2.ComponentsSpec{
+				Database: v1beta2.DatabaseConfiguration{
+					Scheduling: v1beta2.ComponentSchedulingSpec{
+						Affinity: &v1beta2.Affinity{Affinity: corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{}}},
+						Tolerations: []corev1.Toleration{
+							{Key: "infra", Operator: corev1.TolerationOpExists},
+							{Key: "storage", Operator: corev1.TolerationOpExists},
+						},
+						NodeSelector: map[string]string{"storage": "ssd"},
+					},
+				},
+			},
+		},
+	}
+
+	db := ComponentScheduling(syndesis, "syndesis-db")
+	assert.NotNil(t, db.Affinity.NodeAffinity)
+	assert.NotNil(t, db.Affinity.PodAntiAffinity)
+	assert.Len(t, db.Tolerations, 2)
+	assert.Equal(t, map[string]string{"storage": "ssd"}, db.NodeSelector)
+
+	ui := ComponentScheduling(syndesis, "syndesis-ui")
+	assert.Nil(t, ui.Affinity.NodeAffinity)
+	assert.Len(t, ui.Tolerations, 1)
+	assert.Empty(t, ui.NodeSelector)
+
+	// The infra scheduling is left untouched
+	assert.Nil(t, syndesis.Spec.InfraScheduling.Affinity.NodeAffinity)
+	assert.Len(t, syndesis.Spec.InfraScheduling.Tolerations, 1)
+}
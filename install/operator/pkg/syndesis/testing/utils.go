@@ -29,8 +29,11 @@ import (
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/capabilities"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	discoveryfake "k8s.io/client-go/discovery/fake"
 	clientset "k8s.io/client-go/kubernetes"
 	corefake "k8s.io/client-go/kubernetes/fake"
@@ -92,7 +95,42 @@ func fakeClient() client.Client {
 		},
 	}
 
-	return rtfake.NewFakeClientWithScheme(scheme, synDbDeployment)
+	return ApplyClient(rtfake.NewFakeClientWithScheme(scheme, synDbDeployment))
+}
+
+// A fake runtime client handling server-side apply, which the fake client of
+// controller-runtime does not. The applied configuration creates the resource
+// or is merged into it, without tracking the ownership of the fields.
+func ApplyClient(c client.Client) client.Client {
+	return &applyClient{c}
+}
+
+type applyClient struct {
+	client.Client
+}
+
+func (c *applyClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	options := &client.PatchOptions{}
+	options.ApplyOptions(opts)
+	if patch.Type() != types.ApplyPatchType || len(options.DryRun) > 0 {
+		// Dry runs leave the object unchanged, as the applied configuration
+		return c.Client.Patch(ctx, obj, patch, opts...)
+	}
+
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(obj.GetObjectKind().GroupVersionKind())
+	if err := c.Get(ctx, client.ObjectKeyFromObject(obj), existing); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return err
+		}
+		return c.Create(ctx, obj)
+	}
+
+	data, err := patch.Data(obj)
+	if err != nil {
+		return err
+	}
+	return c.Client.Patch(ctx, obj, client.RawPatch(types.MergePatchType, data))
 }
 
 func CoreV1Client(initObjs ...runtime.Object) corev1client.CoreV1Interface {
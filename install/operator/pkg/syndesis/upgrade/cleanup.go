@@ -20,6 +20,7 @@ import (
 	v1 "github.com/openshift/api/build/v1"
 
 	v12 "github.com/openshift/api/apps/v1"
+	"github.com/syndesisio/syndesis/install/operator/pkg/util"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -65,7 +66,8 @@ func (c *cleanup) deleteDeploymentConfigs() (err error) {
 	for _, dcName := range []string{"syndesis-meta", "syndesis-server", "syndesis-ui", "syndesis-prometheus", "todo"} {
 		dc := &v12.DeploymentConfig{}
 		if err := c.client().Get(c.context, client.ObjectKey{Name: dcName, Namespace: c.namespace}, dc); err != nil {
-			if !k8serrors.IsNotFound(err) {
+			// Not found once migrated to Deployments, or on clusters without them
+			if !k8serrors.IsNotFound(err) && !util.IsNoKindMatchError(err) {
 				c.log.Error(err, "Failed to delete DeploymentConfig", "name", dcName)
 			}
 		} else {
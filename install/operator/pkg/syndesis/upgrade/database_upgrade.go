@@ -23,19 +23,18 @@ import (
 	"regexp"
 	"strconv"
 
-	oappsv1 "github.com/openshift/api/apps/v1"
 	"github.com/spf13/afero"
 	synpkg "github.com/syndesisio/syndesis/install/operator/pkg"
 	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/operation"
 	"github.com/syndesisio/syndesis/install/operator/pkg/util"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var upgradeMetadata = metav1.ObjectMeta{
@@ -152,20 +151,20 @@ func (u *databaseUpgrade) currentFromRunningDatabase() (float64, error) {
 		databaseConfiguration.URL)
 }
 
-// Patches the `syndesis-db` DeploymentConfig setting the number
-// of replicas to 0
+// Patches the `syndesis-db` Deployment, or DeploymentConfig if not
+// migrated yet, setting the number of replicas to 0
 func (u *databaseUpgrade) scaleDownDatabase() error {
 	u.log.Info("Scaling down the database deployment", "deployment", "syndesis-db")
-	if err := u.client().Patch(u.context, &oappsv1.DeploymentConfig{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      "syndesis-db",
-			Namespace: u.namespace,
-		},
-	}, client.RawPatch(types.MergePatchType, []byte(`{"spec":{"replicas":0}}`))); err != nil {
+	tracker, err := u.trackerFor("syndesis-db")
+	if err != nil {
+		return err
+	}
+
+	if err := u.setReplicas(tracker, 0); err != nil {
 		return err
 	}
 
-	if err := u.awaitScale("syndesis-db", newDeploymentConfigTracker()); err != nil {
+	if err := u.awaitScale("syndesis-db", tracker); err != nil {
 		return err
 	}
 
@@ -199,7 +198,7 @@ func (u *databaseUpgrade) deployUpgrade() error {
 	metadata := upgradeMetadata.DeepCopy()
 	metadata.SetNamespace(u.namespace)
 
-	return u.client().Create(u.context, &appsv1.Deployment{
+	deployment := &appsv1.Deployment{
 		ObjectMeta: *metadata,
 		Spec: appsv1.DeploymentSpec{
 			Replicas: &one,
@@ -293,7 +292,24 @@ func (u *databaseUpgrade) deployUpgrade() error {
 				},
 			},
 		},
-	})
+	}
+
+	// Scheduled with the database, whose volume it mounts, and with its pod security
+	podSpec, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&deployment.Spec.Template.Spec)
+	if err != nil {
+		return err
+	}
+	if err := operation.SetScheduling(podSpec, operation.ComponentScheduling(u.syndesis, "syndesis-db")); err != nil {
+		return err
+	}
+	if err := operation.SetComponentPodSecurity(podSpec, u.syndesis, "syndesis-db"); err != nil {
+		return err
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(podSpec, &deployment.Spec.Template.Spec); err != nil {
+		return err
+	}
+
+	return u.client().Create(u.context, deployment)
 }
 
 // simple strategy to load the version of the database left in /data/postgresql.txt by the init container
@@ -29,6 +29,7 @@ import (
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/configuration"
 	"go.uber.org/zap"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	cgofake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -193,8 +194,17 @@ func TestRunDatabaseUpgrade(t *testing.T) {
 		},
 		syndesis: &v1beta2.Syndesis{
 			Spec: v1beta2.SyndesisSpec{
+				InfraScheduling: v1beta2.SchedulingSpec{
+					Tolerations: []corev1.Toleration{
+						{Key: "infra", Operator: corev1.TolerationOpExists},
+					},
+				},
 				Components: v1beta2.ComponentsSpec{
-					Database: v1beta2.DatabaseConfiguration{},
+					Database: v1beta2.DatabaseConfiguration{
+						Scheduling: v1beta2.ComponentSchedulingSpec{
+							NodeSelector: map[string]string{"storage": "ssd"},
+						},
+					},
 				},
 			},
 		},
@@ -221,6 +231,11 @@ func TestRunDatabaseUpgrade(t *testing.T) {
 		t.Fatalf("Expected the database upgrade deployment to be created, but there's a deployment named: %v", deployment.ObjectMeta.Name)
 	}
 
+	podSpec := deployment.Spec.Template.Spec
+	if len(podSpec.Tolerations) != 1 || podSpec.NodeSelector["storage"] != "ssd" {
+		t.Fatalf("Expected the database upgrade deployment to be scheduled with the database, but got: %v", podSpec)
+	}
+
 	for _, container := range deployment.Spec.Template.Spec.Containers {
 		if container.Name == "postgresql" {
 			for _, env := range container.Env {
@@ -122,7 +122,13 @@ func (m *migration) dbMigration() (err error) {
 	// install the resources
 	for _, res := range resources {
 		operation.SetNamespaceAndOwnerReference(res, m.syndesis)
-		_, _, err := util.CreateOrUpdate(m.context, client, &res)
+		if err := operation.SetJobScheduling(res, m.syndesis); err != nil {
+			return err
+		}
+		if err := operation.SetRestrictedPodSecurity(res.UnstructuredContent(), "spec", "template", "spec"); err != nil {
+			return err
+		}
+		_, _, _, err := util.Apply(m.context, client, &res)
 		if err != nil {
 			return err
 		}
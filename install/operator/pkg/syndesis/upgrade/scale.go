@@ -19,7 +19,6 @@ package upgrade
 import (
 	"time"
 
-	appsv1 "github.com/openshift/api/apps/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 )
@@ -86,7 +85,7 @@ func dirToS(d direction) string {
 	return [...]string{"up", "down"}[d]
 }
 
-// Scale DeploymentConfigs up or down
+// Scale Deployments, or DeploymentConfigs not migrated yet, up or down
 func (s *scale) scale() (err error) {
 	var replicas int32
 	var dcs = []string{"syndesis-meta", "syndesis-server"}
@@ -95,46 +94,42 @@ func (s *scale) scale() (err error) {
 		replicas = 1
 	}
 
-	rtClient, err := s.clientTools.RuntimeClient()
-	if err != nil {
-		return err
-	}
-
 	// Scale up or down
-	s.log.Info("scale DeploymentConfig", "direction", dirToS(s.dir), "deployments", dcs)
+	s.log.Info("scale deployments", "direction", dirToS(s.dir), "deployments", dcs)
+	trackers := map[string]scaleTracker{}
 	for _, dn := range dcs {
-		dc := &appsv1.DeploymentConfig{}
-		if err = rtClient.Get(s.context, types.NamespacedName{Namespace: s.namespace, Name: dn}, dc); err != nil {
+		tracker, err := s.trackerFor(dn)
+		if err != nil {
 			return err
 		}
+		trackers[dn] = tracker
 
-		if dc.Spec.Replicas != replicas {
-			s.log.Info("scaling DeploymentConfigs", "name", dn, "desired replicas", replicas, "replicas", dc.Spec.Replicas)
-			dc.Spec.Replicas = replicas
-			if err = rtClient.Update(s.context, dc); err != nil {
+		if tracker.replicas() != replicas {
+			s.log.Info("scaling deployment", "name", dn, "desired replicas", replicas, "replicas", tracker.replicas())
+			if err = s.setReplicas(tracker, replicas); err != nil {
 				return err
 			}
 		}
 	}
 
-	// Wait for DeploymentConfigs to correctly scale
-	s.log.Info("waiting for DeploymentConfig to scale", "direction", dirToS(s.dir), "deployments", dcs)
+	// Wait for deployments to correctly scale
+	s.log.Info("waiting for deployments to scale", "direction", dirToS(s.dir), "deployments", dcs)
 	err = wait.Poll(s.interval, s.timeout, func() (done bool, err error) {
 		for i, dn := range dcs {
-			dc := &appsv1.DeploymentConfig{}
-			if err = rtClient.Get(s.context, types.NamespacedName{Namespace: s.namespace, Name: dn}, dc); err != nil {
+			tracker := trackers[dn]
+			if err = s.client().Get(s.context, types.NamespacedName{Namespace: s.namespace, Name: dn}, tracker.obj()); err != nil {
 				return false, err
 			}
 
-			if dc.Status.AvailableReplicas == replicas {
-				s.log.Info("deploymentConfig successfully scaled", "name", dn, "desired replicas", replicas, "available replicas", dc.Status.AvailableReplicas)
+			if tracker.availableReplicas() == replicas {
+				s.log.Info("deployment successfully scaled", "name", dn, "desired replicas", replicas, "available replicas", tracker.availableReplicas())
 				if len(dcs) == 1 {
 					dcs = dcs[:len(dcs)-1]
 				} else {
 					dcs = append(dcs[:i], dcs[i+1:]...)
 				}
 			} else {
-				s.log.Info("waiting for DeploymentConfig to reach desired number of replicas", "name", dn, "desired replicas", replicas, "available replicas", dc.Status.AvailableReplicas)
+				s.log.Info("waiting for deployment to reach desired number of replicas", "name", dn, "desired replicas", replicas, "available replicas", tracker.availableReplicas())
 			}
 
 			if len(dcs) == 0 {
@@ -149,7 +144,7 @@ func (s *scale) scale() (err error) {
 }
 
 /*
- * Scale should always run and rollback, we want the DeploymentConfigs replicas set to one
+ * Scale should always run and rollback, we want the deployments replicas set to one
  * even if some steps failed rolling back
  */
 func (s *scale) canRollback() bool {
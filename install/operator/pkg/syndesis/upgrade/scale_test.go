@@ -17,9 +17,21 @@
 package upgrade
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/go-logr/zapr"
+	oappsv1 "github.com/openshift/api/apps/v1"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func Test_scale_down(t *testing.T) {
@@ -42,3 +54,40 @@ func Test_scale_dirToS(t *testing.T) {
 	assert.EqualValues(t, "up", dirToS(up))
 	assert.EqualValues(t, "down", dirToS(down))
 }
+
+func Test_scale_deploymentsAndDeploymentConfigs(t *testing.T) {
+	schemeToUse := scheme.Scheme
+	require.NoError(t, oappsv1.Install(schemeToUse))
+
+	// The server is migrated to a Deployment, meta still runs as a DeploymentConfig
+	replicas := int32(1)
+	cl := fake.NewFakeClientWithScheme(schemeToUse,
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "syndesis-server", Namespace: "syndesis"},
+			Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		},
+		&oappsv1.DeploymentConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "syndesis-meta", Namespace: "syndesis"},
+			Spec:       oappsv1.DeploymentConfigSpec{Replicas: 1},
+		},
+	)
+
+	clientTools := &clienttools.ClientTools{}
+	clientTools.SetRuntimeClient(cl)
+	s := newScale(step{
+		log:         zapr.NewLogger(zap.NewNop()),
+		context:     context.TODO(),
+		clientTools: clientTools,
+		namespace:   "syndesis",
+	}).down()
+	s.interval = time.Millisecond
+	require.NoError(t, s.run())
+
+	deployment := &appsv1.Deployment{}
+	require.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Namespace: "syndesis", Name: "syndesis-server"}, deployment))
+	assert.EqualValues(t, 0, *deployment.Spec.Replicas)
+
+	dc := &oappsv1.DeploymentConfig{}
+	require.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Namespace: "syndesis", Name: "syndesis-meta"}, dc))
+	assert.EqualValues(t, 0, dc.Spec.Replicas)
+}
@@ -18,12 +18,14 @@ package upgrade
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"strings"
 	"time"
 
 	sbackup "github.com/syndesisio/syndesis/install/operator/pkg/syndesis/backup"
 	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/events"
 	"github.com/syndesisio/syndesis/install/operator/pkg/util"
 	"k8s.io/client-go/kubernetes"
 	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
@@ -35,6 +37,7 @@ import (
 	oappsv1 "github.com/openshift/api/apps/v1"
 	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
 	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -73,6 +76,7 @@ type step struct {
 }
 
 type stepRunner interface {
+	stepName() string
 	infoRun()
 	infoRollback()
 	canRun() (r bool)
@@ -113,8 +117,10 @@ func (u *upgrade) Upgrade() (err error) {
 			step.infoRun()
 			if err = step.run(); err != nil {
 				u.attempts = append(u.attempts, failure{S: step, T: time.Now(), Err: err})
+				u.event(v1.EventTypeWarning, events.ReasonUpgradeStepFailed, "Upgrade step %s failed: %v", step.stepName(), err)
 				return
 			}
+			u.event(v1.EventTypeNormal, events.ReasonUpgradeStepRun, "Ran upgrade step %s", step.stepName())
 		}
 	}
 
@@ -132,6 +138,7 @@ func (u *upgrade) Rollback() (err error) {
 				step.infoRollback()
 				if err = step.rollback(); err != nil {
 					u.log.Error(err, "an error has encountered while rolling back, some manual steps might be required")
+					u.event(v1.EventTypeWarning, events.ReasonUpgradeRollbackFailed, "Rollback of upgrade step %s failed: %v", step.stepName(), err)
 				}
 			}
 		}
@@ -158,6 +165,11 @@ func (u *upgrade) InstallFailed() (count int) {
 	return
 }
 
+// Publishes an event on the syndesis resource being upgraded
+func (u *upgrade) event(eventType string, reason string, messageFmt string, args ...interface{}) {
+	u.clientTools.EventRecorder().Eventf(u.syndesis, eventType, reason, messageFmt, args...)
+}
+
 // build the upgrade struct
 func Build(ctx context.Context, log logr.Logger, syndesis *v1beta2.Syndesis, clientTools *clienttools.ClientTools) (Upgrader, error) {
 	base := step{
@@ -203,6 +215,10 @@ func Build(ctx context.Context, log logr.Logger, syndesis *v1beta2.Syndesis, cli
 	return u, nil
 }
 
+func (s step) stepName() string {
+	return s.name
+}
+
 func (s step) canRun() (r bool) {
 	return !s.executed
 }
@@ -281,6 +297,8 @@ func (s step) apiClient() kubernetes.Interface {
 type scaleTracker interface {
 	obj() client.Object
 	hasScaled() bool
+	replicas() int32
+	availableReplicas() int32
 }
 
 type deploymentTracker struct {
@@ -305,6 +323,17 @@ func (d *deploymentTracker) hasScaled() bool {
 	return *d.deployment.Spec.Replicas == d.deployment.Status.ReadyReplicas
 }
 
+func (d *deploymentTracker) replicas() int32 {
+	if d.deployment.Spec.Replicas == nil {
+		return 1
+	}
+	return *d.deployment.Spec.Replicas
+}
+
+func (d *deploymentTracker) availableReplicas() int32 {
+	return d.deployment.Status.AvailableReplicas
+}
+
 type deploymentConfigTracker struct {
 	deployment oappsv1.DeploymentConfig
 }
@@ -323,6 +352,42 @@ func (d *deploymentConfigTracker) hasScaled() bool {
 	return d.deployment.Status.Replicas == d.deployment.Status.ReadyReplicas
 }
 
+func (d *deploymentConfigTracker) replicas() int32 {
+	return d.deployment.Spec.Replicas
+}
+
+func (d *deploymentConfigTracker) availableReplicas() int32 {
+	return d.deployment.Status.AvailableReplicas
+}
+
+// Returns a tracker of the Deployment of a component or, when not migrated
+// yet, of its DeploymentConfig
+func (s *step) trackerFor(name string) (scaleTracker, error) {
+	key := types.NamespacedName{Namespace: s.namespace, Name: name}
+
+	deployment := newDeploymentTracker()
+	err := s.client().Get(s.context, key, deployment.obj())
+	if err == nil {
+		return deployment, nil
+	}
+	if !k8serr.IsNotFound(err) {
+		return nil, err
+	}
+
+	dc := newDeploymentConfigTracker()
+	if err := s.client().Get(s.context, key, dc.obj()); err != nil {
+		return nil, err
+	}
+	return dc, nil
+}
+
+// Sets the number of replicas of the Deployment or DeploymentConfig, under
+// the field manager of the operator so that the next apply takes them over
+func (s *step) setReplicas(tracker scaleTracker, replicas int32) error {
+	patch := fmt.Sprintf(`{"spec":{"replicas":%d}}`, replicas)
+	return s.client().Patch(s.context, tracker.obj(), client.RawPatch(types.MergePatchType, []byte(patch)), client.FieldOwner(util.FieldManager))
+}
+
 // Waits at most 15min for the Deployment or DeploymentConfig to reach the desired scale
 func (s *step) awaitScale(name string, tracker scaleTracker) error {
 	if err := wait.PollImmediate(time.Second*3, time.Minute*15, func() (done bool, err error) {
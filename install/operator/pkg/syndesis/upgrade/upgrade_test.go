@@ -21,6 +21,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/clienttools"
+	"k8s.io/client-go/tools/record"
 )
 
 type stepTestOk struct{ step }
@@ -45,33 +48,74 @@ func TestUpgrade_InstallFailed(t *testing.T) {
 }
 
 func TestUpgrade_Upgrade(t *testing.T) {
-	// Test successful upgrade
-	u := &upgrade{
-		steps:    []stepRunner{stepTestOk{}, stepTestOk{}},
-		attempts: []result{},
+	testCases := []struct {
+		name    string
+		steps   []stepRunner
+		failed  bool
+		attempt result
+		events  []string
+	}{
+		{
+			"Succeeded",
+			[]stepRunner{stepTestOk{step{name: "first"}}, stepTestOk{step{name: "second"}}},
+			false,
+			succeed{},
+			[]string{
+				"Normal UpgradeStepRun Ran upgrade step first",
+				"Normal UpgradeStepRun Ran upgrade step second",
+			},
+		},
+		{
+			"Failed",
+			[]stepRunner{stepTestOk{step{name: "first"}}, stepTestFail{step{name: "failing"}}, stepTestOk{step{name: "last"}}},
+			true,
+			failure{},
+			[]string{
+				"Normal UpgradeStepRun Ran upgrade step first",
+				"Warning UpgradeStepFailed Upgrade step failing failed: ",
+			},
+		},
 	}
 
-	err := u.Upgrade()
-	assert.NoError(t, err)
-	assert.NotEmpty(t, u.attempts)
-	assert.IsType(t, succeed{}, u.attempts[0])
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(10)
+			clientTools := &clienttools.ClientTools{}
+			clientTools.SetEventRecorder(recorder)
+			u := &upgrade{
+				steps:       tc.steps,
+				attempts:    []result{},
+				syndesis:    &v1beta2.Syndesis{},
+				clientTools: clientTools,
+			}
 
-	// Test failed upgrade
-	u = &upgrade{
-		steps:    []stepRunner{stepTestOk{}, stepTestFail{}, stepTestOk{}},
-		attempts: []result{},
+			err := u.Upgrade()
+			if tc.failed {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NotEmpty(t, u.attempts)
+			assert.IsType(t, tc.attempt, u.attempts[0])
+			if tc.failed {
+				assert.IsType(t, stepTestFail{}, u.attempts[0].step())
+			}
+
+			events := []string{}
+			for len(recorder.Events) > 0 {
+				events = append(events, <-recorder.Events)
+			}
+			assert.Equal(t, tc.events, events)
+		})
 	}
-	err = u.Upgrade()
-	assert.Error(t, err)
-	assert.NotEmpty(t, u.attempts)
-	assert.IsType(t, failure{}, u.attempts[0])
-	assert.IsType(t, stepTestFail{}, u.attempts[0].step())
 }
 
 func TestUpgrade_Rollback(t *testing.T) {
 	u := &upgrade{
-		steps:    []stepRunner{stepTestOk{}, stepTestFail{}, stepTestOk{}},
-		attempts: []result{},
+		steps:       []stepRunner{stepTestOk{}, stepTestFail{}, stepTestOk{}},
+		attempts:    []result{},
+		syndesis:    &v1beta2.Syndesis{},
+		clientTools: &clienttools.ClientTools{},
 	}
 	err := u.Upgrade()
 	assert.Error(t, err)
@@ -0,0 +1,182 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package validation checks a Syndesis custom resource for the mistakes that
+// would otherwise only be found when the operator tries to install it.
+// It's used by the validating admission webhook of the operator but does
+// not need a cluster, apart from the check for duplicates.
+package validation
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/maintenance"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Scheme expected for an external database url, the url being used in a jdbc connection string
+const externalDbScheme = "postgresql"
+
+// Labels of the pods selected by the deployments of the components, which
+// the pod overrides cannot change
+var selectorLabels = []string{"app", "syndesis.io/app", "syndesis.io/component"}
+
+// Limits of the number of replicas of the autoscaled components when not set,
+// as in the default configuration
+const (
+	defaultMinReplicas = 1
+	defaultMaxReplicas = 3
+)
+
+// Validate the spec of the syndesis resource, returning all the errors found
+func Validate(syndesis *v1beta2.Syndesis) field.ErrorList {
+	errs := field.ErrorList{}
+	spec := field.NewPath("spec")
+
+	components := spec.Child("components")
+	c := syndesis.Spec.Components
+	errs = append(errs, validateResources(components.Child("server", "resources"), c.Server.Resources)...)
+	errs = append(errs, validateResourcesWithPersistentVolume(components.Child("meta", "resources"), c.Meta.Resources)...)
+	errs = append(errs, validateResourcesWithPersistentVolume(components.Child("database", "resources"), c.Database.Resources)...)
+	errs = append(errs, validateResourcesWithPersistentVolume(components.Child("prometheus", "resources"), c.Prometheus.Resources)...)
+	errs = append(errs, validateResources(components.Child("grafana", "resources"), c.Grafana.Resources)...)
+	errs = append(errs, validateQuantity(components.Child("upgrade", "resources", "volumeCapacity"), c.Upgrade.Resources.VolumeCapacity)...)
+
+	errs = append(errs, validateAutoscaling(components.Child("server", "autoscaling"), c.Server.Autoscaling)...)
+	errs = append(errs, validateAutoscaling(components.Child("meta", "autoscaling"), c.Meta.Autoscaling)...)
+	// The replicas of meta share its volume
+	if c.Meta.Autoscaling.Enabled && c.Meta.Resources.VolumeAccessMode != v1beta2.VolumeAccessMode(corev1.ReadWriteMany) {
+		errs = append(errs, field.Forbidden(components.Child("meta", "autoscaling", "enabled"), "requires the volume of meta to be ReadWriteMany"))
+	}
+
+	errs = append(errs, validatePodOverrides(components.Child("server", "podOverrides"), c.Server.PodOverrides, true)...)
+	errs = append(errs, validatePodOverrides(components.Child("meta", "podOverrides"), c.Meta.PodOverrides, true)...)
+	errs = append(errs, validatePodOverrides(components.Child("ui", "podOverrides"), c.UI.PodOverrides, true)...)
+	errs = append(errs, validatePodOverrides(components.Child("oauth", "podOverrides"), c.Oauth.PodOverrides, true)...)
+	// The database and prometheus write to a volume which can't be shared
+	errs = append(errs, validatePodOverrides(components.Child("database", "podOverrides"), c.Database.PodOverrides, false)...)
+	errs = append(errs, validatePodOverrides(components.Child("prometheus", "podOverrides"), c.Prometheus.PodOverrides, false)...)
+
+	if dbURL := c.Database.ExternalDbURL; dbURL != "" {
+		path := components.Child("database", "externalDbURL")
+		if u, err := url.Parse(dbURL); err != nil {
+			errs = append(errs, field.Invalid(path, dbURL, err.Error()))
+		} else if u.Scheme != externalDbScheme || u.Host == "" {
+			errs = append(errs, field.Invalid(path, dbURL, "must be of the form "+externalDbScheme+"://host:port/database"))
+		}
+	}
+
+	for i, window := range syndesis.Spec.MaintenanceWindows {
+		if _, err := maintenance.Parse(window); err != nil {
+			errs = append(errs, field.Invalid(spec.Child("maintenanceWindows").Index(i), window, err.Error()))
+		}
+	}
+
+	jaeger := syndesis.Spec.Addons.Jaeger
+	if jaeger.ClientOnly && jaeger.OperatorOnly {
+		errs = append(errs, field.Forbidden(spec.Child("addons", "jaeger", "operatorOnly"), "cannot be set together with clientOnly"))
+	}
+
+	return errs
+}
+
+// Checks that no other syndesis resource is in the namespace of the given one,
+// only one syndesis being supported per namespace
+func ValidateUnique(ctx context.Context, cl client.Client, syndesis *v1beta2.Syndesis) (*field.Error, error) {
+	list := v1beta2.SyndesisList{}
+	if err := cl.List(ctx, &list, &client.ListOptions{Namespace: syndesis.Namespace}); err != nil {
+		return nil, err
+	}
+
+	for _, other := range list.Items {
+		if other.Name != syndesis.Name {
+			return field.Forbidden(field.NewPath("metadata", "namespace"), "Syndesis resource "+other.Name+" already exists in the namespace, only one is supported"), nil
+		}
+	}
+
+	return nil, nil
+}
+
+func validateAutoscaling(path *field.Path, autoscaling v1beta2.AutoscalingSpec) field.ErrorList {
+	min, max := autoscaling.MinReplicas, autoscaling.MaxReplicas
+	if min == 0 {
+		min = defaultMinReplicas
+	}
+	if max == 0 {
+		max = defaultMaxReplicas
+	}
+	if min > max {
+		return field.ErrorList{field.Invalid(path.Child("minReplicas"), min, "must not be greater than maxReplicas")}
+	}
+	return nil
+}
+
+func validatePodOverrides(path *field.Path, overrides v1beta2.PodOverrides, scalable bool) field.ErrorList {
+	errs := field.ErrorList{}
+	if overrides.Replicas != nil && !scalable {
+		errs = append(errs, field.Forbidden(path.Child("replicas"), "the component runs a single replica"))
+	}
+
+	for _, label := range selectorLabels {
+		if _, ok := overrides.Labels[label]; ok {
+			errs = append(errs, field.Forbidden(path.Child("labels").Key(label), "selects the pods of the component"))
+		}
+	}
+
+	for i, volume := range overrides.Volumes {
+		sources := 0
+		for _, set := range []bool{volume.ConfigMap != nil, volume.Secret != nil, volume.PersistentVolumeClaim != nil, volume.EmptyDir != nil} {
+			if set {
+				sources++
+			}
+		}
+		if sources != 1 {
+			errs = append(errs, field.Invalid(path.Child("volumes").Index(i), volume.Name, "must have exactly one of configMap, secret, persistentVolumeClaim or emptyDir"))
+		}
+	}
+	return errs
+}
+
+func validateResources(path *field.Path, resources v1beta2.Resources) field.ErrorList {
+	errs := validateResourceParams(path.Child("limit"), resources.Limit)
+	return append(errs, validateResourceParams(path.Child("request"), resources.Request)...)
+}
+
+func validateResourcesWithPersistentVolume(path *field.Path, resources v1beta2.ResourcesWithPersistentVolume) field.ErrorList {
+	errs := validateResourceParams(path.Child("limit"), resources.Limit)
+	errs = append(errs, validateResourceParams(path.Child("request"), resources.Request)...)
+	return append(errs, validateQuantity(path.Child("volumeCapacity"), resources.VolumeCapacity)...)
+}
+
+func validateResourceParams(path *field.Path, params v1beta2.ResourceParams) field.ErrorList {
+	errs := validateQuantity(path.Child("memory"), params.Memory)
+	return append(errs, validateQuantity(path.Child("cpu"), params.CPU)...)
+}
+
+func validateQuantity(path *field.Path, value string) field.ErrorList {
+	if value == "" {
+		return nil
+	}
+	if _, err := resource.ParseQuantity(value); err != nil {
+		return field.ErrorList{field.Invalid(path, value, err.Error())}
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package validation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/kubernetes/scheme"
+	rtfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_Validate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		spec   func(spec *v1beta2.SyndesisSpec)
+		fields []string
+	}{
+		{
+			"Empty spec",
+			func(spec *v1beta2.SyndesisSpec) {},
+			[]string{},
+		},
+		{
+			"Valid quantities and external database",
+			func(spec *v1beta2.SyndesisSpec) {
+				spec.Components.Server.Resources.Limit.Memory = "800Mi"
+				spec.Components.Database.Resources.VolumeCapacity = "1Gi"
+				spec.Components.Database.ExternalDbURL = "postgresql://mydb:5432"
+			},
+			[]string{},
+		},
+		{
+			"Unparseable quantities",
+			func(spec *v1beta2.SyndesisSpec) {
+				spec.Components.Server.Resources.Limit.Memory = "800MB"
+				spec.Components.Meta.Resources.Request.CPU = "lots"
+				spec.Components.Upgrade.Resources.VolumeCapacity = "1 Gi"
+			},
+			[]string{
+				"spec.components.server.resources.limit.memory",
+				"spec.components.meta.resources.request.cpu",
+				"spec.components.upgrade.resources.volumeCapacity",
+			},
+		},
+		{
+			"Malformed external database url",
+			func(spec *v1beta2.SyndesisSpec) {
+				spec.Components.Database.ExternalDbURL = "mydb:5432"
+			},
+			[]string{"spec.components.database.externalDbURL"},
+		},
+		{
+			"Jaeger client and operator only",
+			func(spec *v1beta2.SyndesisSpec) {
+				spec.Addons.Jaeger.ClientOnly = true
+				spec.Addons.Jaeger.OperatorOnly = true
+			},
+			[]string{"spec.addons.jaeger.operatorOnly"},
+		},
+		{
+			"Invalid maintenance windows",
+			func(spec *v1beta2.SyndesisSpec) {
+				spec.MaintenanceWindows = []v1beta2.MaintenanceWindow{
+					{Schedule: "0 2 * * 6", Duration: metav1.Duration{Duration: 4 * time.Hour}},
+					{Schedule: "at night", Duration: metav1.Duration{Duration: time.Hour}},
+					{Schedule: "0 2 * * *"},
+				}
+			},
+			[]string{"spec.maintenanceWindows[1]", "spec.maintenanceWindows[2]"},
+		},
+		{
+			"Valid autoscaling",
+			func(spec *v1beta2.SyndesisSpec) {
+				spec.Components.Server.Autoscaling = v1beta2.AutoscalingSpec{Enabled: true, MinReplicas: 2, MaxReplicas: 2}
+				spec.Components.Meta.Autoscaling = v1beta2.AutoscalingSpec{Enabled: true, MaxReplicas: 5}
+				spec.Components.Meta.Resources.VolumeAccessMode = v1beta2.VolumeAccessMode(corev1.ReadWriteMany)
+			},
+			[]string{},
+		},
+		{
+			"Minimum replicas above the maximum",
+			func(spec *v1beta2.SyndesisSpec) {
+				spec.Components.Server.Autoscaling = v1beta2.AutoscalingSpec{Enabled: true, MinReplicas: 4, MaxReplicas: 2}
+				spec.Components.Meta.Autoscaling = v1beta2.AutoscalingSpec{Enabled: true, MinReplicas: 5}
+				spec.Components.Meta.Resources.VolumeAccessMode = v1beta2.VolumeAccessMode(corev1.ReadWriteMany)
+			},
+			[]string{"spec.components.server.autoscaling.minReplicas", "spec.components.meta.autoscaling.minReplicas"},
+		},
+		{
+			"Meta autoscaled without a ReadWriteMany volume",
+			func(spec *v1beta2.SyndesisSpec) {
+				spec.Components.Meta.Autoscaling.Enabled = true
+			},
+			[]string{"spec.components.meta.autoscaling.enabled"},
+		},
+		{
+			"Valid pod overrides",
+			func(spec *v1beta2.SyndesisSpec) {
+				replicas := int32(2)
+				spec.Components.Server.PodOverrides = v1beta2.PodOverrides{
+					Replicas: &replicas,
+					Labels:   map[string]string{"team": "integration"},
+					Volumes:  []v1beta2.PodVolume{{Name: "certificates", Secret: &corev1.SecretVolumeSource{SecretName: "certificates"}}},
+				}
+			},
+			[]string{},
+		},
+		{
+			"Invalid pod overrides",
+			func(spec *v1beta2.SyndesisSpec) {
+				replicas := int32(2)
+				spec.Components.Meta.PodOverrides = v1beta2.PodOverrides{
+					Labels: map[string]string{"syndesis.io/component": "other"},
+					Volumes: []v1beta2.PodVolume{
+						{Name: "certificates"},
+						{Name: "cache", EmptyDir: &corev1.EmptyDirVolumeSource{}, ConfigMap: &corev1.ConfigMapVolumeSource{}},
+					},
+				}
+				spec.Components.Database.PodOverrides.Replicas = &replicas
+				spec.Components.Prometheus.PodOverrides.Replicas = &replicas
+			},
+			[]string{
+				"spec.components.meta.podOverrides.labels[syndesis.io/component]",
+				"spec.components.meta.podOverrides.volumes[0]",
+				"spec.components.meta.podOverrides.volumes[1]",
+				"spec.components.database.podOverrides.replicas",
+				"spec.components.prometheus.podOverrides.replicas",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			syndesis := &v1beta2.Syndesis{}
+			tc.spec(&syndesis.Spec)
+
+			fields := []string{}
+			for _, err := range Validate(syndesis) {
+				fields = append(fields, err.Field)
+			}
+			assert.Equal(t, tc.fields, fields)
+		})
+	}
+}
+
+func Test_ValidateUnique(t *testing.T) {
+	existing := &v1beta2.Syndesis{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "syndesis"}}
+
+	s := scheme.Scheme
+	require.NoError(t, apis.AddToScheme(s))
+	cl := rtfake.NewFakeClientWithScheme(s, existing)
+
+	err, clientErr := ValidateUnique(context.TODO(), cl, existing)
+	require.NoError(t, clientErr)
+	assert.Nil(t, err)
+
+	other := &v1beta2.Syndesis{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "syndesis"}}
+	err, clientErr = ValidateUnique(context.TODO(), cl, other)
+	require.NoError(t, clientErr)
+	require.NotNil(t, err)
+	assert.Equal(t, field.ErrorTypeForbidden, err.Type)
+
+	elsewhere := &v1beta2.Syndesis{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "elsewhere"}}
+	err, clientErr = ValidateUnique(context.TODO(), cl, elsewhere)
+	require.NoError(t, clientErr)
+	assert.Nil(t, err)
+}
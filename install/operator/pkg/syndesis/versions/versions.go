@@ -495,7 +495,7 @@ func (api syndesisApi) updateApis() error {
 	api.log.Info("updating syndesis api",
 		"from name", fromName, "from version", fromVersion,
 		"to name", api.v1beta2.Name, "to version", api.v1beta2.Status.Version)
-	if _, _, err := util.CreateOrUpdate(api.context, api.client, api.v1beta2); err != nil {
+	if _, _, _, err := util.Apply(api.context, api.client, api.v1beta2); err != nil {
 		return err
 	}
 
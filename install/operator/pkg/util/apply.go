@@ -0,0 +1,306 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package util
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/spf13/pflag"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// FieldManager owns the fields of the resources applied by the operator
+const FieldManager = "syndesis-operator"
+
+// AutoscaledAnnotation marks the workloads whose replicas are left to a
+// horizontal pod autoscaler
+const AutoscaledAnnotation = "syndesis.io/autoscaled"
+
+var (
+	deploymentConfigKind      = schema.GroupKind{Group: "apps.openshift.io", Kind: "DeploymentConfig"}
+	deploymentKind            = schema.GroupKind{Group: "apps", Kind: "Deployment"}
+	routeKind                 = schema.GroupKind{Group: "route.openshift.io", Kind: "Route"}
+	persistentVolumeClaimKind = schema.GroupKind{Kind: "PersistentVolumeClaim"}
+	secretKind                = schema.GroupKind{Kind: "Secret"}
+
+	// Field manager in the message of a conflict cause, followed by the
+	// api version for the managers of update operations
+	conflictManager = regexp.MustCompile(`^conflict with "([^"]*)"`)
+)
+
+var FlagSet *pflag.FlagSet = nil
+var showResourceDiffs = false
+var KnownDockerImages = map[string]bool{}
+
+func init() {
+	FlagSet = pflag.NewFlagSet("util", pflag.ExitOnError)
+	FlagSet.BoolVar(&showResourceDiffs, "print-resource-diffs", false, "Enable printing resource diffs for resources that get updated.")
+}
+
+// Apply creates or updates the resource with server-side apply, under the
+// syndesis-operator field manager, forcing the ownership of the fields it
+// sets. The field managers of the other actors which were setting some of
+// these fields are returned along with the applied resource, those fields
+// being taken over. The fields set by the operator through updates, before
+// it used server-side apply, are recorded under the same field manager and
+// are taken over silently. On failure the resource as found on the cluster
+// is returned, or the desired one if not found.
+func Apply(ctx context.Context, cl client.Client, o client.Object) (*unstructured.Unstructured, controllerutil.OperationResult, []string, error) {
+	desired, existing, err := prepareApply(ctx, cl, o)
+	if err != nil {
+		return desired, controllerutil.OperationResultNone, nil, err
+	}
+
+	applied := desired.DeepCopy()
+	err = cl.Patch(ctx, applied, client.Apply, client.FieldOwner(FieldManager))
+	var conflicts []string
+	if k8serrors.IsConflict(err) {
+		conflicts = conflictingManagers(err)
+		applied = desired.DeepCopy()
+		err = cl.Patch(ctx, applied, client.Apply, client.FieldOwner(FieldManager), client.ForceOwnership)
+	}
+	if err != nil {
+		if existing != nil {
+			return existing, controllerutil.OperationResultNone, nil, err
+		}
+		return desired, controllerutil.OperationResultNone, nil, err
+	}
+
+	if existing == nil {
+		return applied, controllerutil.OperationResultCreated, conflicts, nil
+	}
+	if applied.GetResourceVersion() == existing.GetResourceVersion() {
+		return applied, controllerutil.OperationResultNone, conflicts, nil
+	}
+
+	if showResourceDiffs {
+		fmt.Println("resource", desired.GetKind(), "update:", desired.GetName())
+		fmt.Println(UnifiedDiff(Dump(existing), Dump(applied)))
+	}
+	return applied, controllerutil.OperationResultUpdated, conflicts, nil
+}
+
+// Diff returns the changes that applying the resource would make to the one
+// on the cluster, as a unified diff, without changing it. The diff is empty
+// when the resource is up to date. The apply is run as a dry run forcing the
+// ownership of the fields, so that those changed by other actors show up too.
+func Diff(ctx context.Context, cl client.Client, o client.Object) (string, error) {
+	desired, existing, err := prepareApply(ctx, cl, o)
+	if err != nil {
+		return "", err
+	}
+
+	applied := desired.DeepCopy()
+	if err := cl.Patch(ctx, applied, client.Apply, client.FieldOwner(FieldManager), client.ForceOwnership, client.DryRunAll); err != nil {
+		return "", err
+	}
+
+	live := ""
+	if existing != nil {
+		live = Dump(withoutClusterFields(existing).Object)
+	}
+	return UnifiedDiff(live, Dump(withoutClusterFields(applied).Object))
+}
+
+// DeletionDiff returns the deletion of the resource, as a unified diff
+func DeletionDiff(o *unstructured.Unstructured) (string, error) {
+	return UnifiedDiff(Dump(withoutClusterFields(o).Object), "")
+}
+
+// Converts the resource to its applied configuration and reads it from the
+// cluster, nil if not found. The fields filled in by the cluster are
+// preserved in the applied configuration.
+func prepareApply(ctx context.Context, cl client.Client, o client.Object) (*unstructured.Unstructured, *unstructured.Unstructured, error) {
+	desired, err := ToUnstructured(o)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Only the fields the operator cares about belong to the applied configuration
+	unstructured.RemoveNestedField(desired.Object, "metadata", "creationTimestamp")
+	unstructured.RemoveNestedField(desired.Object, "metadata", "resourceVersion")
+	unstructured.RemoveNestedField(desired.Object, "status")
+
+	found := &unstructured.Unstructured{}
+	found.SetGroupVersionKind(desired.GroupVersionKind())
+	if err := cl.Get(ctx, client.ObjectKey{Namespace: desired.GetNamespace(), Name: desired.GetName()}, found); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return desired, nil, err
+		}
+		found = nil
+	}
+
+	preserveFields(desired, found)
+	return desired, found, nil
+}
+
+// Strips the resource of the fields maintained by the cluster, which don't
+// tell whether it has drifted, and hides the values of secrets
+func withoutClusterFields(o *unstructured.Unstructured) *unstructured.Unstructured {
+	c := o.DeepCopy()
+	for _, field := range []string{"managedFields", "resourceVersion", "generation", "uid", "creationTimestamp", "selfLink"} {
+		unstructured.RemoveNestedField(c.Object, "metadata", field)
+	}
+	unstructured.RemoveNestedField(c.Object, "status")
+
+	if c.GroupVersionKind().GroupKind() == secretKind {
+		for _, field := range []string{"data", "stringData"} {
+			if values, found, _ := unstructured.NestedMap(c.Object, field); found {
+				for key, value := range values {
+					sum := sha256.Sum256([]byte(fmt.Sprint(value)))
+					values[key] = "sha256:" + hex.EncodeToString(sum[:])
+				}
+				unstructured.SetNestedMap(c.Object, values, field)
+			}
+		}
+	}
+	return c
+}
+
+// Returns the field managers, other than the operator, named by the causes
+// of an apply conflict
+func conflictingManagers(err error) []string {
+	status, ok := err.(k8serrors.APIStatus)
+	if !ok || status.Status().Details == nil {
+		return nil
+	}
+
+	found := map[string]bool{}
+	for _, cause := range status.Status().Details.Causes {
+		if m := conflictManager.FindStringSubmatch(cause.Message); m != nil && m[1] != FieldManager {
+			found[m[1]] = true
+		}
+	}
+
+	managers := make([]string, 0, len(found))
+	for manager := range found {
+		managers = append(managers, manager)
+	}
+	sort.Strings(managers)
+	return managers
+}
+
+// Keeps the value found on the cluster for the fields that are filled in by
+// other controllers, or that can't change once set
+func preserveFields(desired *unstructured.Unstructured, existing *unstructured.Unstructured) {
+	switch desired.GroupVersionKind().GroupKind() {
+	case routeKind:
+		// Generated by OpenShift unless set
+		preserveBlankField(desired, existing, "spec", "host")
+	case persistentVolumeClaimKind:
+		if existing != nil {
+			preserveField(desired, existing, "spec", "resources", "requests", "storage")
+		}
+	case deploymentConfigKind, deploymentKind:
+		preserveTriggeredImages(desired, existing)
+		// Scaled by the autoscaler once created, so left out of the applied
+		// config for the operator to give up its ownership
+		if existing != nil && desired.GetAnnotations()[AutoscaledAnnotation] == "true" {
+			unstructured.RemoveNestedField(desired.Object, "spec", "replicas")
+		}
+	}
+}
+
+func preserveTriggeredImages(desired *unstructured.Unstructured, existing *unstructured.Unstructured) {
+	if existing == nil {
+		return
+	}
+
+	// Images of containers left blank are set by the image change triggers,
+	// or the image trigger annotation of deployments, unless switching from
+	// a docker image to an image stream
+	containers, found, _ := unstructured.NestedSlice(desired.Object, "spec", "template", "spec", "containers")
+	existingContainers, _, _ := unstructured.NestedSlice(existing.Object, "spec", "template", "spec", "containers")
+	if found {
+		for _, c := range containers {
+			container, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			image, _ := container["image"].(string)
+			if strings.TrimSpace(image) != "" {
+				continue
+			}
+			if existingImage, ok := findByName(existingContainers, container["name"])["image"].(string); ok && existingImage != "" {
+				if KnownDockerImages[existingImage] {
+					container["image"] = " "
+				} else {
+					container["image"] = existingImage
+				}
+			}
+		}
+		unstructured.SetNestedSlice(desired.Object, containers, "spec", "template", "spec", "containers")
+	}
+
+	// The namespace of the image streams is resolved by OpenShift
+	triggers, found, _ := unstructured.NestedSlice(desired.Object, "spec", "triggers")
+	existingTriggers, _, _ := unstructured.NestedSlice(existing.Object, "spec", "triggers")
+	if found {
+		for i, t := range triggers {
+			trigger, ok := t.(map[string]interface{})
+			if !ok || i >= len(existingTriggers) {
+				continue
+			}
+			existingTrigger, ok := existingTriggers[i].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if namespace, ok, _ := unstructured.NestedString(existingTrigger, "imageChangeParams", "from", "namespace"); ok {
+				if _, ok, _ := unstructured.NestedMap(trigger, "imageChangeParams", "from"); ok {
+					unstructured.SetNestedField(trigger, namespace, "imageChangeParams", "from", "namespace")
+				}
+			}
+		}
+		unstructured.SetNestedSlice(desired.Object, triggers, "spec", "triggers")
+	}
+}
+
+// Sets the field to its value on the cluster, if any
+func preserveField(desired *unstructured.Unstructured, existing *unstructured.Unstructured, fields ...string) {
+	if value, found, _ := unstructured.NestedFieldNoCopy(existing.Object, fields...); found {
+		unstructured.SetNestedField(desired.Object, value, fields...)
+	}
+}
+
+// Sets a blank field to its value on the cluster, or leaves it out for it to be generated
+func preserveBlankField(desired *unstructured.Unstructured, existing *unstructured.Unstructured, fields ...string) {
+	if value, _, _ := unstructured.NestedString(desired.Object, fields...); strings.TrimSpace(value) != "" {
+		return
+	}
+	unstructured.RemoveNestedField(desired.Object, fields...)
+	if existing != nil {
+		preserveField(desired, existing, fields...)
+	}
+}
+
+func findByName(list []interface{}, name interface{}) map[string]interface{} {
+	for _, item := range list {
+		if m, ok := item.(map[string]interface{}); ok && m["name"] == name {
+			return m
+		}
+	}
+	return map[string]interface{}{}
+}
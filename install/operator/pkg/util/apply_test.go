@@ -0,0 +1,209 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func Test_preserveFields(t *testing.T) {
+	KnownDockerImages["docker.io/syndesis/syndesis-meta:latest"] = true
+	defer delete(KnownDockerImages, "docker.io/syndesis/syndesis-meta:latest")
+
+	testCases := []struct {
+		name     string
+		desired  map[string]interface{}
+		existing map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			"Route host left out to be generated",
+			map[string]interface{}{"apiVersion": "route.openshift.io/v1", "kind": "Route", "spec": map[string]interface{}{"host": ""}},
+			nil,
+			map[string]interface{}{"apiVersion": "route.openshift.io/v1", "kind": "Route", "spec": map[string]interface{}{}},
+		},
+		{
+			"Route host generated",
+			map[string]interface{}{"apiVersion": "route.openshift.io/v1", "kind": "Route", "spec": map[string]interface{}{"host": ""}},
+			map[string]interface{}{"spec": map[string]interface{}{"host": "syndesis.example.com"}},
+			map[string]interface{}{"apiVersion": "route.openshift.io/v1", "kind": "Route", "spec": map[string]interface{}{"host": "syndesis.example.com"}},
+		},
+		{
+			"Route host set",
+			map[string]interface{}{"apiVersion": "route.openshift.io/v1", "kind": "Route", "spec": map[string]interface{}{"host": "my.example.com"}},
+			map[string]interface{}{"spec": map[string]interface{}{"host": "syndesis.example.com"}},
+			map[string]interface{}{"apiVersion": "route.openshift.io/v1", "kind": "Route", "spec": map[string]interface{}{"host": "my.example.com"}},
+		},
+		{
+			"Volume claim storage kept",
+			map[string]interface{}{"apiVersion": "v1", "kind": "PersistentVolumeClaim", "spec": map[string]interface{}{"resources": map[string]interface{}{"requests": map[string]interface{}{"storage": "1Gi"}}}},
+			map[string]interface{}{"spec": map[string]interface{}{"resources": map[string]interface{}{"requests": map[string]interface{}{"storage": "5Gi"}}}},
+			map[string]interface{}{"apiVersion": "v1", "kind": "PersistentVolumeClaim", "spec": map[string]interface{}{"resources": map[string]interface{}{"requests": map[string]interface{}{"storage": "5Gi"}}}},
+		},
+		{
+			"Deployment config images from triggers",
+			deploymentConfig([]interface{}{
+				map[string]interface{}{"name": "syndesis-server", "image": " "},
+				map[string]interface{}{"name": "syndesis-meta", "image": " "},
+				map[string]interface{}{"name": "syndesis-ui", "image": "docker.io/syndesis/syndesis-ui:latest"},
+			}, "syndesis"),
+			deploymentConfig([]interface{}{
+				map[string]interface{}{"name": "syndesis-server", "image": "image-registry/syndesis/syndesis-server@sha256:1234"},
+				map[string]interface{}{"name": "syndesis-meta", "image": "docker.io/syndesis/syndesis-meta:latest"},
+				map[string]interface{}{"name": "syndesis-ui", "image": "docker.io/syndesis/syndesis-ui:old"},
+			}, "openshift"),
+			deploymentConfig([]interface{}{
+				map[string]interface{}{"name": "syndesis-server", "image": "image-registry/syndesis/syndesis-server@sha256:1234"},
+				map[string]interface{}{"name": "syndesis-meta", "image": " "},
+				map[string]interface{}{"name": "syndesis-ui", "image": "docker.io/syndesis/syndesis-ui:latest"},
+			}, "openshift"),
+		},
+		{
+			"Deployment images from trigger annotation",
+			map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment", "spec": map[string]interface{}{"template": map[string]interface{}{"spec": map[string]interface{}{"containers": []interface{}{
+				map[string]interface{}{"name": "syndesis-server", "image": " "},
+			}}}}},
+			map[string]interface{}{"spec": map[string]interface{}{"template": map[string]interface{}{"spec": map[string]interface{}{"containers": []interface{}{
+				map[string]interface{}{"name": "syndesis-server", "image": "image-registry/syndesis/syndesis-server@sha256:1234"},
+			}}}}},
+			map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment", "spec": map[string]interface{}{"template": map[string]interface{}{"spec": map[string]interface{}{"containers": []interface{}{
+				map[string]interface{}{"name": "syndesis-server", "image": "image-registry/syndesis/syndesis-server@sha256:1234"},
+			}}}}},
+		},
+		{
+			"Autoscaled deployment replicas left to the autoscaler",
+			map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": map[string]interface{}{"annotations": map[string]interface{}{AutoscaledAnnotation: "true"}}, "spec": map[string]interface{}{"replicas": int64(1)}},
+			map[string]interface{}{"spec": map[string]interface{}{"replicas": int64(3)}},
+			map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": map[string]interface{}{"annotations": map[string]interface{}{AutoscaledAnnotation: "true"}}, "spec": map[string]interface{}{}},
+		},
+		{
+			"Deployment config replicas applied",
+			map[string]interface{}{"apiVersion": "apps.openshift.io/v1", "kind": "DeploymentConfig", "spec": map[string]interface{}{"replicas": int64(1)}},
+			map[string]interface{}{"spec": map[string]interface{}{"replicas": int64(3)}},
+			map[string]interface{}{"apiVersion": "apps.openshift.io/v1", "kind": "DeploymentConfig", "spec": map[string]interface{}{"replicas": int64(1)}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			desired := &unstructured.Unstructured{Object: tc.desired}
+			var existing *unstructured.Unstructured
+			if tc.existing != nil {
+				existing = &unstructured.Unstructured{Object: tc.existing}
+			}
+
+			preserveFields(desired, existing)
+			assert.Equal(t, tc.expected, desired.Object)
+		})
+	}
+}
+
+func deploymentConfig(containers []interface{}, triggerNamespace string) map[string]interface{} {
+	return map[string]interface{}{
+		"apiVersion": "apps.openshift.io/v1",
+		"kind":       "DeploymentConfig",
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{"containers": containers},
+			},
+			"triggers": []interface{}{
+				map[string]interface{}{
+					"type": "ImageChange",
+					"imageChangeParams": map[string]interface{}{
+						"from": map[string]interface{}{"kind": "ImageStreamTag", "name": "syndesis-server:latest", "namespace": triggerNamespace},
+					},
+				},
+			},
+		},
+	}
+}
+
+func Test_withoutClusterFields(t *testing.T) {
+	secret := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata": map[string]interface{}{
+			"name":            "syndesis-global-config",
+			"resourceVersion": "1234",
+			"uid":             "5678",
+			"managedFields":   []interface{}{map[string]interface{}{"manager": FieldManager}},
+		},
+		"data":   map[string]interface{}{"POSTGRESQL_PASSWORD": "c2VjcmV0"},
+		"status": map[string]interface{}{},
+	}}
+
+	stripped := withoutClusterFields(secret)
+	assert.Equal(t, map[string]interface{}{"name": "syndesis-global-config"}, stripped.Object["metadata"])
+	assert.NotContains(t, stripped.Object, "status")
+	assert.NotContains(t, Dump(stripped.Object), "c2VjcmV0")
+	assert.Contains(t, Dump(stripped.Object), "POSTGRESQL_PASSWORD: sha256:")
+
+	// The original resource is left unchanged
+	assert.Equal(t, "1234", secret.GetResourceVersion())
+	assert.Equal(t, "c2VjcmV0", secret.Object["data"].(map[string]interface{})["POSTGRESQL_PASSWORD"])
+}
+
+func Test_conflictingManagers(t *testing.T) {
+	conflict := func(messages ...string) error {
+		causes := []metav1.StatusCause{}
+		for _, message := range messages {
+			causes = append(causes, metav1.StatusCause{Type: metav1.CauseTypeFieldManagerConflict, Message: message})
+		}
+		return &k8serrors.StatusError{ErrStatus: metav1.Status{
+			Reason:  metav1.StatusReasonConflict,
+			Details: &metav1.StatusDetails{Causes: causes},
+		}}
+	}
+
+	testCases := []struct {
+		name     string
+		err      error
+		expected []string
+	}{
+		{
+			name:     "not a status error",
+			err:      assert.AnError,
+			expected: nil,
+		},
+		{
+			name:     "no details",
+			err:      &k8serrors.StatusError{ErrStatus: metav1.Status{Reason: metav1.StatusReasonConflict}},
+			expected: nil,
+		},
+		{
+			name:     "other actors, sorted and deduplicated",
+			err:      conflict(`conflict with "kubectl-edit" using apps/v1: .spec.replicas`, `conflict with "hpa" using apps/v1: .spec.replicas`, `conflict with "kubectl-edit" using apps/v1: .spec.template`),
+			expected: []string{"hpa", "kubectl-edit"},
+		},
+		{
+			name:     "operator's own update entries",
+			err:      conflict(`conflict with "syndesis-operator" with subresource "status" using apps/v1: .spec.replicas`),
+			expected: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, conflictingManagers(tc.err))
+		})
+	}
+}
@@ -0,0 +1,47 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package util
+
+import (
+	"os"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// Environment variable with the label selector of the namespaces watched by
+// the operator, when it watches all of them
+const WatchNamespaceSelectorEnvVar = "WATCH_NAMESPACE_SELECTOR"
+
+// WatchNamespaces splits the value of the WATCH_NAMESPACE variable, either a
+// namespace or a comma separated list of namespaces. An empty value means that
+// all namespaces are watched, for which no namespace is returned.
+func WatchNamespaces(value string) []string {
+	namespaces := []string{}
+	for _, ns := range strings.Split(value, ",") {
+		if ns = strings.TrimSpace(ns); ns != "" {
+			namespaces = append(namespaces, ns)
+		}
+	}
+	return namespaces
+}
+
+// WatchNamespaceSelector returns the selector of the namespaces watched by the
+// operator, which selects all of them unless WATCH_NAMESPACE_SELECTOR is set
+func WatchNamespaceSelector() (labels.Selector, error) {
+	return labels.Parse(os.Getenv(WatchNamespaceSelectorEnvVar))
+}
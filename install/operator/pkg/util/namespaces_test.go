@@ -0,0 +1,48 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package util
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_WatchNamespaces(t *testing.T) {
+	assert.Equal(t, []string{}, WatchNamespaces(""))
+	assert.Equal(t, []string{"syndesis"}, WatchNamespaces("syndesis"))
+	assert.Equal(t, []string{"tenant-a", "tenant-b"}, WatchNamespaces("tenant-a, tenant-b,"))
+}
+
+func Test_WatchNamespaceSelector(t *testing.T) {
+	defer os.Unsetenv(WatchNamespaceSelectorEnvVar)
+
+	os.Unsetenv(WatchNamespaceSelectorEnvVar)
+	selector, err := WatchNamespaceSelector()
+	assert.NoError(t, err)
+	assert.True(t, selector.Empty())
+
+	os.Setenv(WatchNamespaceSelectorEnvVar, "syndesis.io/tenant=true")
+	selector, err = WatchNamespaceSelector()
+	assert.NoError(t, err)
+	assert.Equal(t, "syndesis.io/tenant=true", selector.String())
+
+	os.Setenv(WatchNamespaceSelectorEnvVar, "syndesis.io/tenant in")
+	_, err = WatchNamespaceSelector()
+	assert.Error(t, err)
+}
@@ -0,0 +1,98 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhook
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	"github.com/syndesisio/syndesis/install/operator/pkg/syndesis/validation"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// Rejects the syndesis resources that could not be installed
+type validator struct {
+	client  client.Client
+	decoder *admission.Decoder
+}
+
+var _ admission.Handler = &validator{}
+var _ admission.DecoderInjector = &validator{}
+
+func newValidator(cl client.Client) *validator {
+	return &validator{client: cl}
+}
+
+func (v *validator) InjectDecoder(d *admission.Decoder) error {
+	v.decoder = d
+	return nil
+}
+
+func (v *validator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	syndesis := &v1beta2.Syndesis{}
+	if err := v.decoder.Decode(req, syndesis); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if syndesis.GetDeletionTimestamp() != nil {
+		// Never get in the way of the removal of the finalizer
+		return admission.Allowed("")
+	}
+
+	// Resources stored invalid, before the webhook was in place or under older
+	// rules, are left to be updated as long as their spec is unchanged, as when
+	// the operator sets its finalizer
+	if req.Operation == admissionv1.Update {
+		previous := &v1beta2.Syndesis{}
+		if err := v.decoder.DecodeRaw(req.OldObject, previous); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		if equality.Semantic.DeepEqual(previous.Spec, syndesis.Spec) {
+			return admission.Allowed("")
+		}
+	}
+
+	errs := validation.Validate(syndesis)
+
+	// Existing duplicates, from before the webhook was in place, can still be updated
+	if req.Operation == admissionv1.Create {
+		duplicate, err := validation.ValidateUnique(ctx, v.client, syndesis)
+		if err != nil {
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+		if duplicate != nil {
+			errs = append(errs, duplicate)
+		}
+	}
+
+	if len(errs) > 0 {
+		invalid := k8serrors.NewInvalid(v1beta2.SchemaGroupVersionKind.GroupKind(), syndesis.Name, errs)
+		return admission.Response{
+			AdmissionResponse: admissionv1.AdmissionResponse{
+				Allowed: false,
+				Result:  &invalid.ErrStatus,
+			},
+		}
+	}
+
+	return admission.Allowed("")
+}
@@ -0,0 +1,95 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis"
+	"github.com/syndesisio/syndesis/install/operator/pkg/apis/syndesis/v1beta2"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	rtfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func Test_ValidatorHandle(t *testing.T) {
+	s := scheme.Scheme
+	require.NoError(t, apis.AddToScheme(s))
+
+	existing := &v1beta2.Syndesis{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "syndesis"}}
+	v := newValidator(rtfake.NewFakeClientWithScheme(s, existing))
+	decoder, err := admission.NewDecoder(s)
+	require.NoError(t, err)
+	require.NoError(t, v.InjectDecoder(decoder))
+
+	encode := func(syndesis *v1beta2.Syndesis) runtime.RawExtension {
+		if syndesis == nil {
+			return runtime.RawExtension{}
+		}
+		syndesis.APIVersion = v1beta2.SchemaGroupVersionKind.GroupVersion().String()
+		syndesis.Kind = v1beta2.SchemaGroupVersionKind.Kind
+		raw, err := json.Marshal(syndesis)
+		require.NoError(t, err)
+		return runtime.RawExtension{Raw: raw}
+	}
+	request := func(operation admissionv1.Operation, syndesis *v1beta2.Syndesis, previous *v1beta2.Syndesis) admission.Request {
+		return admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: operation,
+			Object:    encode(syndesis),
+			OldObject: encode(previous),
+		}}
+	}
+
+	// Updating the existing resource is fine
+	updated := existing.DeepCopy()
+	updated.Spec.Components.Server.Resources.Limit.Memory = "800Mi"
+	response := v.Handle(context.TODO(), request(admissionv1.Update, updated, existing.DeepCopy()))
+	assert.True(t, response.Allowed)
+
+	// A second resource in the namespace is not
+	duplicate := &v1beta2.Syndesis{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "syndesis"}}
+	response = v.Handle(context.TODO(), request(admissionv1.Create, duplicate, nil))
+	assert.False(t, response.Allowed)
+	assert.Contains(t, response.Result.Message, "metadata.namespace")
+
+	// Nor is an invalid spec
+	invalid := existing.DeepCopy()
+	invalid.Spec.Components.Server.Resources.Limit.Memory = "800MB"
+	response = v.Handle(context.TODO(), request(admissionv1.Update, invalid, existing.DeepCopy()))
+	assert.False(t, response.Allowed)
+	assert.Contains(t, response.Result.Message, "spec.components.server.resources.limit.memory")
+
+	// Unless stored invalid and only its metadata changes, as when the
+	// operator sets its finalizer
+	finalized := invalid.DeepCopy()
+	finalized.Finalizers = []string{"syndesis.io/finalizer"}
+	response = v.Handle(context.TODO(), request(admissionv1.Update, finalized, invalid.DeepCopy()))
+	assert.True(t, response.Allowed)
+
+	// Any change of its spec is validated
+	changed := finalized.DeepCopy()
+	changed.Spec.Components.Server.Resources.Limit.CPU = "1"
+	response = v.Handle(context.TODO(), request(admissionv1.Update, changed, finalized.DeepCopy()))
+	assert.False(t, response.Allowed)
+}
@@ -0,0 +1,66 @@
+/*
+ * Copyright (C) 2021 Red Hat, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhook
+
+import (
+	"os"
+	"path/filepath"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	crwebhook "sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+)
+
+const (
+	// Path the validating webhook of the syndesis resource is served on
+	ValidatePath = "/validate-syndesis-io-v1beta2-syndesis"
+	// Path the conversion webhook between the versions of the syndesis resource is served on
+	ConvertPath = "/convert"
+	// Service the webhooks are called through
+	ServiceName = "syndesis-operator-webhook"
+	// Secret of the serving certificate, mounted by the operator deployment
+	CertSecret = "syndesis-operator-webhook-cert"
+)
+
+var log = logf.Log.WithName("webhook")
+
+// Where the serving certificate is expected, either mounted by the
+// operator-lifecycle-manager or from the secret of the webhook service
+var certDir = filepath.Join(os.TempDir(), "k8s-webhook-server", "serving-certs")
+
+// AddToManager registers the admission and conversion webhooks with the
+// manager, as long as a serving certificate has been provided. Without one
+// the webhook server could not start so the operator runs without its webhooks,
+// any webhook configuration still pointing at it then rejects the requests.
+func AddToManager(mgr manager.Manager) error {
+	if _, err := os.Stat(filepath.Join(certDir, "tls.crt")); err != nil {
+		if os.IsNotExist(err) {
+			log.Error(err, "No serving certificate found, the admission and conversion webhooks are NOT served. "+
+				"Provide the "+CertSecret+" secret for the "+ServiceName+" service to enable them", "dir", certDir)
+			return nil
+		}
+		return err
+	}
+
+	server := mgr.GetWebhookServer()
+	server.CertDir = certDir
+	server.Register(ValidatePath, &crwebhook.Admission{Handler: newValidator(mgr.GetClient())})
+	// Converts between the versions registered in the scheme of the manager, through v1beta2
+	server.Register(ConvertPath, &conversion.Webhook{})
+	return nil
+}